@@ -70,6 +70,8 @@ type Node interface {
 	Type() string // Type property is a string that contains the variant type of the node
 	Location() SourceLocation
 	Errs() []Error
+	AddComment(c Comment)
+	SetTrailingComment(c Comment)
 	Copy() Node
 
 	// All node must support json marshalling
@@ -94,6 +96,7 @@ func (*MemberAssignment) node()    {}
 func (*StringExpression) node()      {}
 func (*ParenExpression) node()       {}
 func (*ArrayExpression) node()       {}
+func (*DictExpression) node()        {}
 func (*FunctionExpression) node()    {}
 func (*BinaryExpression) node()      {}
 func (*CallExpression) node()        {}
@@ -106,6 +109,7 @@ func (*ObjectExpression) node()      {}
 func (*UnaryExpression) node()       {}
 
 func (*Property) node()   {}
+func (*DictItem) node()   {}
 func (*Identifier) node() {}
 
 func (*TextPart) node()         {}
@@ -125,6 +129,11 @@ func (*UnsignedIntegerLiteral) node() {}
 type BaseNode struct {
 	Loc    *SourceLocation `json:"location,omitempty"`
 	Errors []Error         `json:"errors,omitempty"`
+	// Comments are the comments that precede the node in the source.
+	Comments []Comment `json:"comments,omitempty"`
+	// TrailingComment is the comment that follows the node
+	// on the line where the node ends.
+	TrailingComment *Comment `json:"trailing_comment,omitempty"`
 }
 
 // Location is the source location of the Node
@@ -139,6 +148,25 @@ func (b BaseNode) Errs() []Error {
 	return b.Errors
 }
 
+func (b BaseNode) comments() []Comment {
+	return b.Comments
+}
+
+// AddComment adds a comment that precedes the node in the source.
+func (b *BaseNode) AddComment(c Comment) {
+	b.Comments = append(b.Comments, c)
+}
+
+func (b BaseNode) trailingComment() *Comment {
+	return b.TrailingComment
+}
+
+// SetTrailingComment sets the comment that follows the node
+// on the line where the node ends.
+func (b *BaseNode) SetTrailingComment(c Comment) {
+	b.TrailingComment = &c
+}
+
 func (b BaseNode) Copy() BaseNode {
 	// Note b is already shallow copy because of the non pointer receiver
 	b.Loc = b.Loc.Copy()
@@ -147,6 +175,15 @@ func (b BaseNode) Copy() BaseNode {
 		copy(cpy, b.Errors)
 		b.Errors = cpy
 	}
+	if len(b.Comments) > 0 {
+		cpy := make([]Comment, len(b.Comments))
+		copy(cpy, b.Comments)
+		b.Comments = cpy
+	}
+	if b.TrailingComment != nil {
+		c := *b.TrailingComment
+		b.TrailingComment = &c
+	}
 	return b
 }
 
@@ -160,6 +197,12 @@ func (e Error) Error() string {
 	return e.Msg
 }
 
+// Comment is a line comment in the source.
+type Comment struct {
+	// Text is the text of the comment including the leading //.
+	Text string `json:"text"`
+}
+
 // Package represents a complete package source tree
 type Package struct {
 	BaseNode
@@ -196,6 +239,8 @@ type File struct {
 	Package  *PackageClause       `json:"package"`
 	Imports  []*ImportDeclaration `json:"imports"`
 	Body     []Statement          `json:"body"`
+	// Eof holds the comments after the last statement.
+	Eof []Comment `json:"eof,omitempty"`
 }
 
 // Type is the abstract type
@@ -224,6 +269,11 @@ func (f *File) Copy() Node {
 			nf.Body[i] = s.Copy().(Statement)
 		}
 	}
+
+	if len(f.Eof) > 0 {
+		nf.Eof = make([]Comment, len(f.Eof))
+		copy(nf.Eof, f.Eof)
+	}
 	return nf
 }
 
@@ -520,6 +570,7 @@ type Expression interface {
 func (*StringExpression) expression()       {}
 func (*ParenExpression) expression()        {}
 func (*ArrayExpression) expression()        {}
+func (*DictExpression) expression()         {}
 func (*FunctionExpression) expression()     {}
 func (*BinaryExpression) expression()       {}
 func (*BooleanLiteral) expression()         {}
@@ -984,6 +1035,61 @@ func (e *ArrayExpression) Copy() Node {
 	return ne
 }
 
+// DictExpression is used to create and directly specify the elements of a dictionary
+type DictExpression struct {
+	BaseNode
+	Elements []*DictItem `json:"elements"`
+}
+
+// Type is the abstract type
+func (*DictExpression) Type() string { return "DictExpression" }
+
+func (e *DictExpression) Copy() Node {
+	if e == nil {
+		return e
+	}
+	ne := new(DictExpression)
+	*ne = *e
+	ne.BaseNode = e.BaseNode.Copy()
+
+	if len(e.Elements) > 0 {
+		ne.Elements = make([]*DictItem, len(e.Elements))
+		for i, el := range e.Elements {
+			ne.Elements[i] = el.Copy().(*DictItem)
+		}
+	}
+
+	return ne
+}
+
+// DictItem is a single key/value pair of a dictionary expression
+type DictItem struct {
+	BaseNode
+	Key Expression `json:"key"`
+	Val Expression `json:"val"`
+}
+
+// Type is the abstract type
+func (*DictItem) Type() string { return "DictItem" }
+
+func (i *DictItem) Copy() Node {
+	if i == nil {
+		return i
+	}
+	ni := new(DictItem)
+	*ni = *i
+	ni.BaseNode = i.BaseNode.Copy()
+
+	if i.Key != nil {
+		ni.Key = i.Key.Copy().(Expression)
+	}
+	if i.Val != nil {
+		ni.Val = i.Val.Copy().(Expression)
+	}
+
+	return ni
+}
+
 // ObjectExpression allows the declaration of an anonymous object within a declaration.
 type ObjectExpression struct {
 	BaseNode
@@ -61,6 +61,39 @@ func (f *formatter) writeComment(comment string) {
 	f.writeRune('\n')
 }
 
+// formatComments writes each comment on its own line
+// and indents the line that follows them.
+func (f *formatter) formatComments(comments []Comment) {
+	for _, c := range comments {
+		f.writeString(c.Text)
+		f.writeRune('\n')
+		f.writeIndent()
+	}
+}
+
+// formatTrailingComment writes the trailing comment of a node, if it has one,
+// on the line of the node.
+func (f *formatter) formatTrailingComment(n Node) {
+	if c, ok := n.(interface{ trailingComment() *Comment }); ok && c.trailingComment() != nil {
+		f.writeRune(' ')
+		f.writeString(c.trailingComment().Text)
+	}
+}
+
+// hasBlankLine reports whether there is a blank line in the source
+// between two statements. The comments of the next statement
+// are on the lines that precede it.
+func hasBlankLine(prev, next Statement) bool {
+	end, start := prev.Location().End, next.Location().Start
+	if !end.IsValid() || !start.IsValid() {
+		return false
+	}
+	if c, ok := next.(interface{ comments() []Comment }); ok {
+		start.Line -= len(c.comments())
+	}
+	return start.Line-end.Line > 1
+}
+
 // Logic for handling operator precedence and parenthesis formatting.
 
 const (
@@ -255,6 +288,7 @@ func (f *formatter) formatFile(n *File, includePkg bool) {
 
 		f.writeIndent()
 		f.formatNode(imp)
+		f.formatTrailingComment(imp)
 	}
 
 	if len(n.Imports) > 0 && len(n.Body) > 0 {
@@ -267,13 +301,27 @@ func (f *formatter) formatFile(n *File, includePkg bool) {
 			f.writeRune(sep)
 
 			// separate different statements with double newline
-			if n.Body[i-1].Type() != n.Body[i].Type() {
+			// and keep the blank lines of the source
+			if n.Body[i-1].Type() != n.Body[i].Type() || hasBlankLine(n.Body[i-1], c) {
 				f.writeRune(sep)
 			}
 		}
 
 		f.writeIndent()
 		f.formatNode(c)
+		f.formatTrailingComment(c)
+	}
+
+	if len(n.Eof) > 0 {
+		if n.Package != nil || len(n.Imports) > 0 || len(n.Body) > 0 {
+			f.writeRune(sep)
+		}
+		for i, c := range n.Eof {
+			if i != 0 {
+				f.writeRune(sep)
+			}
+			f.writeString(c.Text)
+		}
 	}
 }
 
@@ -290,13 +338,15 @@ func (f *formatter) formatBlock(n *Block) {
 
 		if i != 0 {
 			// separate different statements with double newline
-			if n.Body[i-1].Type() != n.Body[i].Type() {
+			// and keep the blank lines of the source
+			if n.Body[i-1].Type() != n.Body[i].Type() || hasBlankLine(n.Body[i-1], c) {
 				f.writeRune(sep)
 			}
 		}
 
 		f.writeIndent()
 		f.formatNode(c)
+		f.formatTrailingComment(c)
 	}
 
 	if len(n.Body) > 0 {
@@ -311,6 +361,7 @@ func (f *formatter) formatBlock(n *Block) {
 func (f *formatter) formatPackageClause(n *PackageClause) {
 	f.writeString("package ")
 	f.formatNode(n.Name)
+	f.formatTrailingComment(n)
 	f.writeRune('\n')
 }
 
@@ -339,6 +390,11 @@ func (f *formatter) formatOptionStatement(n *OptionStatement) {
 	f.formatNode(n.Assignment)
 }
 
+func (f *formatter) formatBuiltinStatement(n *BuiltinStatement) {
+	f.writeString("builtin ")
+	f.formatNode(n.ID)
+}
+
 func (f *formatter) formatTestStatement(n *TestStatement) {
 	f.writeString("test ")
 	f.formatNode(n.Assignment)
@@ -371,6 +427,33 @@ func (f *formatter) formatArrayExpression(n *ArrayExpression) {
 	f.writeRune(']')
 }
 
+func (f *formatter) formatDictExpression(n *DictExpression) {
+	f.writeRune('[')
+
+	if len(n.Elements) == 0 {
+		f.writeRune(':')
+	}
+
+	sep := ", "
+	for i, c := range n.Elements {
+		if i != 0 {
+			f.writeString(sep)
+		}
+
+		f.formatNode(c)
+	}
+
+	f.writeRune(']')
+}
+
+func (f *formatter) formatDictItem(n *DictItem) {
+	f.formatNode(n.Key)
+	f.writeString(": ")
+	if n.Val != nil {
+		f.formatNode(n.Val)
+	}
+}
+
 func (f *formatter) formatFunctionExpression(n *FunctionExpression) {
 	f.writeRune('(')
 
@@ -460,8 +543,11 @@ func (f *formatter) formatPipeExpression(n *PipeExpression) {
 	f.writeRune('\n')
 	f.indent()
 	f.writeIndent()
+	// Comments before the pipe are attached to the call
+	// and are written before the pipe operator.
+	f.formatComments(n.Call.Comments)
 	f.writeString("|> ")
-	f.formatNode(n.Call)
+	f.formatCallExpression(n.Call)
 }
 
 func (f *formatter) formatConditionalExpression(n *ConditionalExpression) {
@@ -506,13 +592,24 @@ func (f *formatter) formatObjectExpressionAsFunctionArgument(n *ObjectExpression
 
 func (f *formatter) formatObjectExpressionBraces(n *ObjectExpression, braces bool) {
 	multiline := len(n.Properties) > 3
+	// Comments are written on their own line. The comments of an
+	// object without braces precede its first property.
+	comments := !braces && len(n.Comments) > 0
+	for _, p := range n.Properties {
+		if len(p.Comments) > 0 || p.TrailingComment != nil {
+			comments = true
+		}
+	}
+	if comments {
+		multiline = true
+	}
 
 	if braces {
 		f.writeRune('{')
 	}
 
 	if n.With != nil {
-		f.formatIdentifier(n.With)
+		f.formatNode(n.With)
 		f.writeString(" with ")
 	}
 
@@ -522,27 +619,30 @@ func (f *formatter) formatObjectExpressionBraces(n *ObjectExpression, braces boo
 		f.writeIndent()
 	}
 
-	var sep string
-	if multiline {
-		sep = ",\n"
-	} else {
-		sep = ", "
+	if !braces {
+		f.formatComments(n.Comments)
 	}
 
 	for i, c := range n.Properties {
-		if i != 0 {
-			f.writeString(sep)
-
-			if multiline {
+		if multiline {
+			if i != 0 {
 				f.writeIndent()
 			}
+			f.formatNode(c)
+			// The trailing comment of a property follows its comma.
+			f.writeRune(',')
+			f.formatTrailingComment(c)
+			f.writeRune('\n')
+			continue
 		}
 
+		if i != 0 {
+			f.writeString(", ")
+		}
 		f.formatNode(c)
 	}
 
 	if multiline {
-		f.writeString(sep)
 		f.unIndent()
 		f.writeIndent()
 	}
@@ -561,6 +661,7 @@ func (f *formatter) formatProperty(n *Property) {
 }
 
 func (f *formatter) formatFunctionArgument(n *Property) {
+	f.formatComments(n.Comments)
 	if n.Value == nil {
 		f.formatNode(n.Key)
 		return
@@ -686,6 +787,10 @@ func (f *formatter) formatNode(n Node) {
 	//save current indentation
 	currInd := f.indentation
 
+	if c, ok := n.(interface{ comments() []Comment }); ok {
+		f.formatComments(c.comments())
+	}
+
 	switch n := n.(type) {
 	case *Package:
 		f.formatPackage(n)
@@ -699,6 +804,8 @@ func (f *formatter) formatNode(n Node) {
 		f.formatImportDeclaration(n)
 	case *OptionStatement:
 		f.formatOptionStatement(n)
+	case *BuiltinStatement:
+		f.formatBuiltinStatement(n)
 	case *TestStatement:
 		f.formatTestStatement(n)
 	case *ExpressionStatement:
@@ -729,6 +836,10 @@ func (f *formatter) formatNode(n Node) {
 		f.formatConditionalExpression(n)
 	case *ArrayExpression:
 		f.formatArrayExpression(n)
+	case *DictExpression:
+		f.formatDictExpression(n)
+	case *DictItem:
+		f.formatDictItem(n)
 	case *Identifier:
 		f.formatIdentifier(n)
 	case *PipeLiteral:
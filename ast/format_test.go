@@ -115,6 +115,14 @@ a[i]`,
 			name:   "array_expr",
 			script: `a[(i+1)]`,
 		},
+		{
+			name:   "dict",
+			script: `a = ["a": 1, "b": 2]`,
+		},
+		{
+			name:   "empty dict",
+			script: `a = [:]`,
+		},
 		{
 			name:   "conditional",
 			script: `test?cons:alt`,
@@ -337,6 +345,110 @@ highestAverage = (n, columns=["_value"], by, tables=<-) =>
 	formatTestHelper(t, testCases)
 }
 
+func TestFormat_Comments(t *testing.T) {
+	testCases := []formatTestCase{
+		{
+			name: "statements",
+			script: `// define a
+a = 1
+
+// yield a
+a
+	|> yield()`,
+		},
+		{
+			name: "imports",
+			script: `// the package
+package foo
+
+
+// imports
+import "strings"
+
+strings.title(v: "a")`,
+		},
+		{
+			name: "pipes",
+			script: `from(bucket: "telegraf")
+	// the last hour
+	|> range(start: -1h)
+	// the cpu measurement
+	|> filter(fn: (r) =>
+		(r._measurement == "cpu"))`,
+		},
+		{
+			name: "function arguments",
+			script: `f(
+	// the first argument
+	a: 1,
+	// the second argument
+	b: 2,
+)`,
+		},
+		{
+			name: "end of file",
+			script: `a = 1
+// end of file
+// second line`,
+		},
+		{
+			name:   "only comments",
+			script: `// nothing here`,
+		},
+		{
+			name: "builtin",
+			script: `// foo is a builtin
+builtin foo`,
+		},
+		{
+			name: "trailing comments",
+			script: `package foo // the package
+
+
+import "strings" // for title
+
+a = 1 // one
+b = strings.title(v: "b") // bee
+
+builtin c // see`,
+		},
+		{
+			name: "trailing comments in a block",
+			script: `f = (r) => {
+	a = 1 // one
+	b = 2
+
+	return a + b // sum
+} // the function`,
+		},
+		{
+			name: "trailing comments of properties",
+			script: `option task = {
+	name: "foo", // the name
+	every: 1h,
+}
+
+f(
+	a: 1, // the first argument
+	b: 2, // the second argument
+)`,
+		},
+		{
+			name: "blank lines",
+			script: `a = 1
+b = 2
+
+c = 3
+
+// d
+d = 4
+e = 5`,
+		},
+	}
+
+	formatTestHelper(t, testCases)
+}
+
 func TestFormat_Associativity(t *testing.T) {
 	testCases := []formatTestCase{
 		{
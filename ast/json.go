@@ -745,6 +745,55 @@ func (e *ArrayExpression) UnmarshalJSON(data []byte) error {
 	}
 	return nil
 }
+func (e *DictExpression) MarshalJSON() ([]byte, error) {
+	type Alias DictExpression
+	raw := struct {
+		Type string `json:"type"`
+		*Alias
+	}{
+		Type:  e.Type(),
+		Alias: (*Alias)(e),
+	}
+	return json.Marshal(raw)
+}
+func (i *DictItem) MarshalJSON() ([]byte, error) {
+	type Alias DictItem
+	raw := struct {
+		Type string `json:"type"`
+		*Alias
+	}{
+		Type:  i.Type(),
+		Alias: (*Alias)(i),
+	}
+	return json.Marshal(raw)
+}
+func (i *DictItem) UnmarshalJSON(data []byte) error {
+	type Alias DictItem
+	raw := struct {
+		*Alias
+		Key json.RawMessage `json:"key"`
+		Val json.RawMessage `json:"val"`
+	}{}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	if raw.Alias != nil {
+		*i = *(*DictItem)(raw.Alias)
+	}
+
+	key, err := unmarshalExpression(raw.Key)
+	if err != nil {
+		return err
+	}
+	i.Key = key
+
+	val, err := unmarshalExpression(raw.Val)
+	if err != nil {
+		return err
+	}
+	i.Val = val
+	return nil
+}
 func (e *ObjectExpression) MarshalJSON() ([]byte, error) {
 	type Alias ObjectExpression
 	raw := struct {
@@ -1167,6 +1216,8 @@ func unmarshalNode(msg json.RawMessage) (Node, error) {
 		node = new(ConditionalExpression)
 	case "ArrayExpression":
 		node = new(ArrayExpression)
+	case "DictExpression":
+		node = new(DictExpression)
 	case "Identifier":
 		node = new(Identifier)
 	case "PipeLiteral":
@@ -1199,6 +1250,8 @@ func unmarshalNode(msg json.RawMessage) (Node, error) {
 		node = new(FunctionExpression)
 	case "Property":
 		node = new(Property)
+	case "DictItem":
+		node = new(DictItem)
 	case "BadExpression":
 		// Rust does not support plain nil if not using Options.
 		// The places where we use BadExpressions in the Rust parser
@@ -297,6 +297,16 @@ func TestJSONMarshal(t *testing.T) {
 			},
 			want: `{"type":"ArrayExpression","elements":[{"type":"StringLiteral","value":"hello"}]}`,
 		},
+		{
+			name: "dict expression",
+			node: &ast.DictExpression{
+				Elements: []*ast.DictItem{{
+					Key: &ast.StringLiteral{Value: "a"},
+					Val: &ast.IntegerLiteral{Value: 1},
+				}},
+			},
+			want: `{"type":"DictExpression","elements":[{"type":"DictItem","key":{"type":"StringLiteral","value":"a"},"val":{"type":"IntegerLiteral","value":"1"}}]}`,
+		},
 		{
 			name: "object expression",
 			node: &ast.ObjectExpression{
@@ -263,6 +263,25 @@ func walk(v Visitor, node Node) {
 				walk(w, e)
 			}
 		}
+	case *DictExpression:
+		if n == nil {
+			return
+		}
+		w := v.Visit(n)
+		if w != nil {
+			for _, e := range n.Elements {
+				walk(w, e)
+			}
+		}
+	case *DictItem:
+		if n == nil {
+			return
+		}
+		w := v.Visit(n)
+		if w != nil {
+			walk(w, n.Key)
+			walk(w, n.Val)
+		}
 	case *FunctionExpression:
 		if n == nil {
 			return
@@ -22,13 +22,18 @@ var executeCmd = &cobra.Command{
 
 func init() {
 	rootCmd.AddCommand(executeCmd)
+	addControllerFlags(executeCmd)
 }
 
 func execute(cmd *cobra.Command, args []string) error {
+	q, err := newQuerier()
+	if err != nil {
+		return err
+	}
 	deps := flux.NewDefaultDependencies()
 	deps.Deps.FilesystemService = filesystem.SystemFS
 	ctx := deps.Inject(context.Background())
-	r := repl.New(ctx, deps, querier{})
+	r := repl.New(ctx, deps, q)
 	if err := r.Input(args[0]); err != nil {
 		return fmt.Errorf("failed to execute query: %v", err)
 	}
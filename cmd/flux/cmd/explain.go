@@ -0,0 +1,63 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"time"
+
+	"github.com/influxdata/flux"
+	_ "github.com/influxdata/flux/builtin"
+	"github.com/influxdata/flux/dependencies/filesystem"
+	"github.com/influxdata/flux/lang"
+	"github.com/influxdata/flux/memory"
+	"github.com/spf13/cobra"
+)
+
+// explainCmd represents the explain command
+var explainCmd = &cobra.Command{
+	Use:   "explain",
+	Short: "Explain how a Flux script is planned and executed",
+	Long: `Print the logical and physical plans of a Flux script from string or file (use @ as prefix to the file)
+together with the planner rules that were applied. With --analyze, the script is also executed
+and the rows, tables, memory and time of each transformation are reported.`,
+	Args: cobra.ExactArgs(1),
+	RunE: explain,
+}
+
+var analyze bool
+
+func init() {
+	rootCmd.AddCommand(explainCmd)
+	explainCmd.Flags().BoolVarP(&analyze, "analyze", "a", false, "execute the script and report runtime numbers for each transformation")
+}
+
+func explain(cmd *cobra.Command, args []string) error {
+	scriptSource := args[0]
+
+	var script string
+	if scriptSource[0] == '@' {
+		scriptBytes, err := ioutil.ReadFile(scriptSource[1:])
+		if err != nil {
+			return err
+		}
+		script = string(scriptBytes)
+	} else {
+		script = scriptSource
+	}
+
+	program, err := lang.Compile(script, time.Now())
+	if err != nil {
+		return err
+	}
+
+	deps := flux.NewDefaultDependencies()
+	deps.Deps.FilesystemService = filesystem.SystemFS
+	ctx := deps.Inject(context.Background())
+	e, err := program.Explain(ctx, &memory.Allocator{}, analyze)
+	if err != nil {
+		return fmt.Errorf("failed to explain query: %v", err)
+	}
+	fmt.Print(e)
+	return nil
+}
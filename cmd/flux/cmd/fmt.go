@@ -0,0 +1,305 @@
+package cmd
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/influxdata/flux/ast"
+	"github.com/influxdata/flux/parser"
+	"github.com/spf13/cobra"
+)
+
+// fmtCmd represents the fmt command
+var fmtCmd = &cobra.Command{
+	Use:   "fmt [flags] [paths...]",
+	Short: "Format Flux source files",
+	Long: `Format Flux source files.
+Directories are searched recursively for *.flux files. Standard input is
+formatted when no path is given. By default, the formatted source is written
+to standard output.`,
+	RunE:         fmtRun,
+	SilenceUsage: true,
+}
+
+var fmtFlags struct {
+	write bool
+	list  bool
+	diff  bool
+}
+
+func init() {
+	rootCmd.AddCommand(fmtCmd)
+	fmtCmd.Flags().BoolVarP(&fmtFlags.write, "write", "w", false, "write the result to the source file instead of standard output")
+	fmtCmd.Flags().BoolVarP(&fmtFlags.list, "list", "l", false, "list the files whose formatting differs")
+	fmtCmd.Flags().BoolVarP(&fmtFlags.diff, "diff", "d", false, "print a unified diff instead of the formatted source")
+}
+
+// errFormat is returned when one or more files could not be formatted.
+// The reason is reported for each file as it is encountered.
+var errFormat = errors.New("some files could not be formatted")
+
+func fmtRun(cmd *cobra.Command, args []string) error {
+	f := &formatter{
+		w:     os.Stdout,
+		errw:  os.Stderr,
+		write: fmtFlags.write,
+		list:  fmtFlags.list,
+		diff:  fmtFlags.diff,
+	}
+	if len(args) == 0 {
+		if f.write {
+			return errors.New("cannot use -w with standard input")
+		}
+		src, err := ioutil.ReadAll(os.Stdin)
+		if err != nil {
+			return err
+		}
+		return f.format("<standard input>", src, 0)
+	}
+
+	failed := false
+	for _, path := range args {
+		if err := f.formatPath(path); err != nil {
+			fmt.Fprintln(f.errw, err)
+			failed = true
+		}
+	}
+	if failed {
+		return errFormat
+	}
+	return nil
+}
+
+// formatter formats Flux files and reports the result
+// according to the flags of the fmt command.
+type formatter struct {
+	w, errw io.Writer
+
+	write bool
+	list  bool
+	diff  bool
+}
+
+// formatPath formats the file or the *.flux files within the directory.
+func (f *formatter) formatPath(path string) error {
+	info, err := os.Stat(path)
+	if err != nil {
+		return err
+	}
+	if !info.IsDir() {
+		return f.formatFile(path, info.Mode())
+	}
+
+	failed := false
+	err = filepath.Walk(path, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() || filepath.Ext(path) != ".flux" {
+			return nil
+		}
+		if err := f.formatFile(path, info.Mode()); err != nil {
+			fmt.Fprintln(f.errw, err)
+			failed = true
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	if failed {
+		return errFormat
+	}
+	return nil
+}
+
+func (f *formatter) formatFile(path string, mode os.FileMode) error {
+	src, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	return f.format(path, src, mode)
+}
+
+// format formats the source of the named file.
+func (f *formatter) format(name string, src []byte, mode os.FileMode) error {
+	formatted, err := formatSource(string(src))
+	if err != nil {
+		return fmt.Errorf("%s: %v", name, err)
+	}
+
+	if !f.list && !f.write && !f.diff {
+		_, err := io.WriteString(f.w, formatted)
+		return err
+	}
+	if formatted == string(src) {
+		return nil
+	}
+
+	if f.list {
+		fmt.Fprintln(f.w, name)
+	}
+	if f.write {
+		if err := ioutil.WriteFile(name, []byte(formatted), mode.Perm()); err != nil {
+			return err
+		}
+	}
+	if f.diff {
+		_, err := io.WriteString(f.w, unifiedDiff(name, string(src), formatted))
+		return err
+	}
+	return nil
+}
+
+// formatSource parses and formats Flux source code.
+// Sources with syntax errors are not formatted.
+func formatSource(src string) (string, error) {
+	pkg := parser.ParseSource(src)
+	if ast.Check(pkg) > 0 {
+		return "", ast.GetError(pkg)
+	}
+	formatted := ast.Format(pkg.Files[0])
+	if formatted != "" && !strings.HasSuffix(formatted, "\n") {
+		formatted += "\n"
+	}
+	return formatted, nil
+}
+
+// diffContext is the number of unchanged lines shown around each change.
+const diffContext = 3
+
+// unifiedDiff returns the differences between the original
+// and the formatted source of a file in the unified format.
+func unifiedDiff(name, a, b string) string {
+	x, y := splitLines(a), splitLines(b)
+	edits := diffLines(x, y)
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "diff -u %s.orig %s\n", name, name)
+	fmt.Fprintf(&buf, "--- %s.orig\n", name)
+	fmt.Fprintf(&buf, "+++ %s\n", name)
+
+	for i := 0; i < len(edits); {
+		// Find the next change and the end of the hunk that contains it.
+		// Changes separated by no more than twice the context are
+		// shown in the same hunk.
+		for i < len(edits) && edits[i].op == ' ' {
+			i++
+		}
+		if i == len(edits) {
+			break
+		}
+		start := i - diffContext
+		if start < 0 {
+			start = 0
+		}
+		end := i
+		for j := i; j < len(edits); j++ {
+			if edits[j].op != ' ' {
+				end = j + 1
+			} else if j-end >= 2*diffContext {
+				break
+			}
+		}
+		end += diffContext
+		if end > len(edits) {
+			end = len(edits)
+		}
+
+		hunk := edits[start:end]
+		var xn, yn int
+		for _, e := range hunk {
+			if e.op != '+' {
+				xn++
+			}
+			if e.op != '-' {
+				yn++
+			}
+		}
+		fmt.Fprintf(&buf, "@@ -%s +%s @@\n", hunkRange(hunk[0].x, xn), hunkRange(hunk[0].y, yn))
+		for _, e := range hunk {
+			buf.WriteByte(e.op)
+			buf.WriteString(e.line)
+			if !strings.HasSuffix(e.line, "\n") {
+				buf.WriteString("\n\\ No newline at end of file\n")
+			}
+		}
+		i = end
+	}
+	return buf.String()
+}
+
+// hunkRange formats the start line and the number of lines of a hunk.
+// The start is the line before the hunk when it is empty.
+func hunkRange(start, n int) string {
+	if n == 0 {
+		return fmt.Sprintf("%d,0", start)
+	}
+	if n == 1 {
+		return fmt.Sprintf("%d", start+1)
+	}
+	return fmt.Sprintf("%d,%d", start+1, n)
+}
+
+func splitLines(s string) []string {
+	lines := strings.SplitAfter(s, "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return lines
+}
+
+// edit is a line of a diff. The op is ' ' for an unchanged line,
+// '-' for a removed line and '+' for an added line. The indexes
+// are those of the line, or of the next line, in each input.
+type edit struct {
+	op   byte
+	line string
+	x, y int
+}
+
+// diffLines computes the edits that turn x into y from
+// the longest common subsequence of their lines.
+func diffLines(x, y []string) []edit {
+	// lcs[i][j] is the length of the longest common
+	// subsequence of x[i:] and y[j:].
+	lcs := make([][]int, len(x)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(y)+1)
+	}
+	for i := len(x) - 1; i >= 0; i-- {
+		for j := len(y) - 1; j >= 0; j-- {
+			if x[i] == y[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else if lcs[i+1][j] >= lcs[i][j+1] {
+				lcs[i][j] = lcs[i+1][j]
+			} else {
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+
+	var edits []edit
+	i, j := 0, 0
+	for i < len(x) || j < len(y) {
+		switch {
+		case i < len(x) && j < len(y) && x[i] == y[j]:
+			edits = append(edits, edit{op: ' ', line: x[i], x: i, y: j})
+			i++
+			j++
+		case j == len(y) || i < len(x) && lcs[i+1][j] >= lcs[i][j+1]:
+			edits = append(edits, edit{op: '-', line: x[i], x: i, y: j})
+			i++
+		default:
+			edits = append(edits, edit{op: '+', line: y[j], x: i, y: j})
+			j++
+		}
+	}
+	return edits
+}
@@ -0,0 +1,125 @@
+package cmd
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+const unformatted = `// the input
+a = from(bucket:"telegraf") |> range(start:-1h) // the last hour
+
+// the output
+a |> yield()
+`
+
+const formatted = `// the input
+a = from(bucket: "telegraf")
+	|> range(start: -1h) // the last hour
+
+// the output
+a
+	|> yield()
+`
+
+func TestFormatter(t *testing.T) {
+	for _, tc := range []struct {
+		name  string
+		f     formatter
+		want  string
+		write bool
+	}{
+		{
+			name: "stdout",
+			want: formatted,
+		},
+		{
+			name: "list",
+			f:    formatter{list: true},
+			want: "{path}\n",
+		},
+		{
+			name:  "write",
+			f:     formatter{write: true},
+			write: true,
+		},
+		{
+			name: "diff",
+			f:    formatter{diff: true},
+			want: "diff -u {path}.orig {path}\n" +
+				"--- {path}.orig\n" +
+				"+++ {path}\n" +
+				"@@ -1,5 +1,7 @@\n" +
+				" // the input\n" +
+				"-a = from(bucket:\"telegraf\") |> range(start:-1h) // the last hour\n" +
+				"+a = from(bucket: \"telegraf\")\n" +
+				"+\t|> range(start: -1h) // the last hour\n" +
+				" \n" +
+				" // the output\n" +
+				"-a |> yield()\n" +
+				"+a\n" +
+				"+\t|> yield()\n",
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			dir := writeFluxTests(t, map[string]string{
+				"a.flux":      unformatted,
+				"b.flux":      formatted,
+				"ignored.txt": unformatted,
+			})
+			defer os.RemoveAll(dir)
+			path := filepath.Join(dir, "a.flux")
+
+			var out, errOut bytes.Buffer
+			tc.f.w, tc.f.errw = &out, &errOut
+			if err := tc.f.formatPath(path); err != nil {
+				t.Fatal(err)
+			}
+
+			want := strings.Replace(tc.want, "{path}", path, -1)
+			if got := out.String(); got != want {
+				t.Errorf("unexpected output -want/+got:\n%s", cmp.Diff(want, got))
+			}
+
+			src, err := ioutil.ReadFile(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			wantSrc := unformatted
+			if tc.write {
+				wantSrc = formatted
+			}
+			if got := string(src); got != wantSrc {
+				t.Errorf("unexpected file contents -want/+got:\n%s", cmp.Diff(wantSrc, got))
+			}
+		})
+	}
+}
+
+func TestFormatter_Directory(t *testing.T) {
+	dir := writeFluxTests(t, map[string]string{
+		"a.flux":      unformatted,
+		"b.flux":      formatted,
+		"c.flux":      "a = (1",
+		"ignored.txt": unformatted,
+	})
+	defer os.RemoveAll(dir)
+
+	var out, errOut bytes.Buffer
+	f := formatter{w: &out, errw: &errOut, list: true}
+	if err := f.formatPath(dir); err != errFormat {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want, got := filepath.Join(dir, "a.flux")+"\n", out.String(); got != want {
+		t.Errorf("unexpected output -want/+got:\n%s", cmp.Diff(want, got))
+	}
+	if errOut.Len() == 0 {
+		t.Error("expected the syntax error in c.flux to be reported")
+	}
+}
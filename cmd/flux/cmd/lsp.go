@@ -0,0 +1,28 @@
+package cmd
+
+import (
+	"context"
+	"os"
+
+	_ "github.com/influxdata/flux/builtin"
+	"github.com/influxdata/flux/lsp"
+	"github.com/spf13/cobra"
+)
+
+// lspCmd represents the lsp command
+var lspCmd = &cobra.Command{
+	Use:   "lsp",
+	Short: "Run a Flux language server",
+	Long: `Run a Language Server Protocol server for Flux that communicates over stdin and stdout.
+The server reports parse and type errors and supports completion, hover,
+go to definition and formatting.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		s := lsp.NewServer(os.Stdin, os.Stdout, nil)
+		return s.Serve(context.Background())
+	},
+	SilenceUsage: true,
+}
+
+func init() {
+	rootCmd.AddCommand(lspCmd)
+}
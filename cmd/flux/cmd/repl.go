@@ -2,10 +2,12 @@ package cmd
 
 import (
 	"context"
+	"runtime"
+
 	"github.com/influxdata/flux"
 	_ "github.com/influxdata/flux/builtin"
+	"github.com/influxdata/flux/control"
 	"github.com/influxdata/flux/dependencies/filesystem"
-	"github.com/influxdata/flux/memory"
 	"github.com/influxdata/flux/repl"
 	"github.com/spf13/cobra"
 )
@@ -15,32 +17,54 @@ var replCmd = &cobra.Command{
 	Use:   "repl",
 	Short: "Launch a Flux REPL",
 	Long:  "Launch a Flux REPL (Read-Eval-Print-Loop)",
-	Run: func(cmd *cobra.Command, args []string) {
+	RunE: func(cmd *cobra.Command, args []string) error {
+		q, err := newQuerier()
+		if err != nil {
+			return err
+		}
 		deps := flux.NewDefaultDependencies()
 		deps.Deps.FilesystemService = filesystem.SystemFS
 		// inject the dependencies to the context.
 		// one useful example is socket.from, kafka.to, and sql.from/sql.to where we need
 		// to access the url validator in deps to validate the user-specified url.
 		ctx := deps.Inject(context.Background())
-		r := repl.New(ctx, deps, querier{})
+		r := repl.New(ctx, deps, q)
 		r.Run()
+		return nil
 	},
 }
 
 func init() {
 	rootCmd.AddCommand(replCmd)
+	addControllerFlags(replCmd)
+}
+
+var controllerConfig control.Config
+
+// addControllerFlags adds the flags that limit the resources of the queries of the command.
+func addControllerFlags(cmd *cobra.Command) {
+	cmd.Flags().IntVar(&controllerConfig.ConcurrencyQuota, "concurrency", runtime.NumCPU(), "number of queries that may execute at the same time")
+	cmd.Flags().IntVar(&controllerConfig.QueueSize, "queue-size", 0, "number of queries that may wait to be executed, 0 is unbounded")
+	cmd.Flags().Int64Var(&controllerConfig.MemoryBytesQuota, "memory", 0, "number of bytes that the queries may allocate together, 0 is unlimited")
+	cmd.Flags().Int64Var(&controllerConfig.MemoryBytesQuotaPerQuery, "query-memory", 0, "number of bytes that a single query may allocate, 0 is unlimited")
 }
 
-type querier struct{}
+// querier executes the queries of the REPL with a controller.
+type querier struct {
+	c *control.Controller
+}
 
-func (querier) Query(ctx context.Context, deps flux.Dependencies, c flux.Compiler) (flux.ResultIterator, error) {
-	program, err := c.Compile(ctx)
+func newQuerier() (querier, error) {
+	c, err := control.New(controllerConfig)
 	if err != nil {
-		return nil, err
+		return querier{}, err
 	}
+	return querier{c: c}, nil
+}
+
+func (q querier) Query(ctx context.Context, deps flux.Dependencies, c flux.Compiler) (flux.ResultIterator, error) {
 	ctx = deps.Inject(ctx)
-	alloc := &memory.Allocator{}
-	qry, err := program.Start(ctx, alloc)
+	qry, err := q.c.Query(ctx, c)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,602 @@
+package cmd
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/ast"
+	_ "github.com/influxdata/flux/builtin"
+	"github.com/influxdata/flux/dependencies/filesystem"
+	"github.com/influxdata/flux/dependencies/sideeffect"
+	executepkg "github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/interpreter"
+	"github.com/influxdata/flux/lang"
+	"github.com/influxdata/flux/memory"
+	"github.com/influxdata/flux/parser"
+	"github.com/influxdata/flux/semantic"
+	"github.com/influxdata/flux/stdlib"
+	"github.com/spf13/cobra"
+)
+
+// testCmd represents the test command
+var testCmd = &cobra.Command{
+	Use:   "test [paths...]",
+	Short: "Run the Flux tests in *_test.flux files",
+	Long: `Run the test statements of every *_test.flux file in the given files and directories.
+Directories are searched recursively and the current directory is used when no path is given.
+
+A file is skipped when it contains a line comment of the form:
+
+    // flux:skip <reason>
+
+The -run pattern is matched against the names of the test cases, and the files
+without a matching test case are not run.
+
+Imports are resolved from the directory of the test file before the standard library.
+The import path of the directory is the name of its package, so that the tests of
+package foo can import "foo" and the package in the subdirectory bar as "foo/bar".`,
+	RunE:         test,
+	SilenceUsage: true,
+}
+
+var testFlags struct {
+	run     string
+	junit   string
+	verbose bool
+}
+
+func init() {
+	rootCmd.AddCommand(testCmd)
+	testCmd.Flags().StringVar(&testFlags.run, "run", "", "run only the test cases whose name matches the regular expression")
+	testCmd.Flags().StringVar(&testFlags.junit, "junit", "", "write a JUnit XML report to the file")
+	testCmd.Flags().BoolVarP(&testFlags.verbose, "verbose", "v", false, "report the tests that pass and are skipped")
+}
+
+func test(cmd *cobra.Command, args []string) error {
+	if len(args) == 0 {
+		args = []string{"."}
+	}
+	var runRe *regexp.Regexp
+	if testFlags.run != "" {
+		re, err := regexp.Compile(testFlags.run)
+		if err != nil {
+			return fmt.Errorf("invalid -run pattern: %v", err)
+		}
+		runRe = re
+	}
+
+	tests, err := findFluxTests(args)
+	if err != nil {
+		return err
+	}
+
+	r := &testRunner{
+		w:       os.Stdout,
+		run:     runRe,
+		verbose: testFlags.verbose,
+	}
+	results := r.Run(tests)
+
+	if testFlags.junit != "" {
+		f, err := os.Create(testFlags.junit)
+		if err != nil {
+			return err
+		}
+		if err := writeJUnit(f, results); err != nil {
+			_ = f.Close()
+			return err
+		}
+		if err := f.Close(); err != nil {
+			return err
+		}
+	}
+
+	for _, res := range results {
+		if res.Failed() {
+			fmt.Fprintln(r.w, "FAIL")
+			return fmt.Errorf("%d of %d tests failed", countFailed(results), len(results))
+		}
+	}
+	fmt.Fprintln(r.w, "PASS")
+	return nil
+}
+
+// fluxTest is a single *_test.flux file.
+type fluxTest struct {
+	// Name is the name of the file without the _test.flux suffix.
+	Name string
+	// Suite is the directory that contains the file.
+	Suite string
+	// Path is the path to the file.
+	Path string
+}
+
+// findFluxTests returns the *_test.flux files in the paths.
+// Directories are searched recursively. Files that are named
+// explicitly are returned even without the _test.flux suffix.
+func findFluxTests(paths []string) ([]fluxTest, error) {
+	var tests []fluxTest
+	add := func(path string) {
+		tests = append(tests, fluxTest{
+			Name:  strings.TrimSuffix(filepath.Base(path), "_test.flux"),
+			Suite: filepath.Dir(path),
+			Path:  path,
+		})
+	}
+	for _, p := range paths {
+		fi, err := os.Stat(p)
+		if err != nil {
+			return nil, err
+		}
+		if !fi.IsDir() {
+			add(p)
+			continue
+		}
+		if err := filepath.Walk(p, func(path string, info os.FileInfo, err error) error {
+			if err != nil {
+				return err
+			}
+			if !info.IsDir() && strings.HasSuffix(info.Name(), "_test.flux") {
+				add(path)
+			}
+			return nil
+		}); err != nil {
+			return nil, err
+		}
+	}
+	sort.SliceStable(tests, func(i, j int) bool {
+		return tests[i].Path < tests[j].Path
+	})
+	return tests, nil
+}
+
+// testResult is the outcome of running a fluxTest.
+type testResult struct {
+	Test     fluxTest
+	Duration time.Duration
+	// Skip is the reason the test was skipped.
+	Skip string
+	// Err is the error that caused the test to fail.
+	Err error
+	// Output contains the results of testing.inspect for a failed test.
+	Output string
+}
+
+func (r *testResult) Failed() bool {
+	return r.Err != nil
+}
+
+func (r *testResult) Skipped() bool {
+	return r.Skip != ""
+}
+
+func countFailed(results []*testResult) (n int) {
+	for _, res := range results {
+		if res.Failed() {
+			n++
+		}
+	}
+	return n
+}
+
+// testRunner runs Flux tests and reports their outcome in the style of go test.
+type testRunner struct {
+	w       io.Writer
+	run     *regexp.Regexp
+	verbose bool
+}
+
+// Run runs the tests that match the run pattern and returns their results.
+func (r *testRunner) Run(tests []fluxTest) []*testResult {
+	results := make([]*testResult, 0, len(tests))
+	for _, t := range tests {
+		res := r.runTest(t)
+		if res == nil {
+			continue
+		}
+		results = append(results, res)
+
+		switch {
+		case res.Failed():
+			fmt.Fprintf(r.w, "--- FAIL: %s (%s) (%.2fs)\n", t.Name, t.Path, res.Duration.Seconds())
+			fmt.Fprintf(r.w, "    %v\n", res.Err)
+			if res.Output != "" {
+				fmt.Fprintln(r.w, res.Output)
+			}
+		case res.Skipped():
+			if r.verbose {
+				fmt.Fprintf(r.w, "--- SKIP: %s (%s)\n    %s\n", t.Name, t.Path, res.Skip)
+			}
+		default:
+			if r.verbose {
+				fmt.Fprintf(r.w, "--- PASS: %s (%.2fs)\n", t.Name, res.Duration.Seconds())
+			}
+		}
+	}
+	return results
+}
+
+// runTest runs the test cases of a file. It returns nil
+// when the file has no test case that matches the run pattern.
+func (r *testRunner) runTest(t fluxTest) *testResult {
+	res := &testResult{Test: t}
+	start := time.Now()
+	defer func() {
+		res.Duration = time.Since(start)
+	}()
+
+	src, err := ioutil.ReadFile(t.Path)
+	if err != nil {
+		res.Err = err
+		return res
+	}
+	pkg := parser.ParseSource(string(src))
+	if r.run != nil && ast.Check(pkg) == 0 && !r.selectTestCases(pkg.Files[0]) {
+		return nil
+	}
+	if reason, ok := skipReason(src); ok {
+		res.Skip = reason
+		return res
+	}
+
+	if ast.Check(pkg) > 0 {
+		res.Err = ast.GetError(pkg)
+		return res
+	}
+	imp, err := newLocalImporter(filepath.Dir(t.Path), pkg)
+	if err != nil {
+		res.Err = err
+		return res
+	}
+
+	pkg.Package = "main"
+	pkg.Files[0].Name = filepath.Base(t.Path)
+	if pkg.Files[0].Package != nil {
+		pkg.Files[0].Package.Name.Name = "main"
+	}
+
+	// Type errors stop the package from being evaluated,
+	// so the test cases that expect them are checked first.
+	if failed, err := stdlib.TestingTypeError(pkg, imp); failed {
+		res.Err = err
+		return res
+	}
+
+	// testing.run
+	pkg.Files = append(pkg.Files, stdlib.TestingRunCalls(pkg))
+	if res.Err = runFluxTest(pkg, imp.Copy(), nil); res.Err == nil {
+		return res
+	}
+
+	// testing.inspect
+	var out bytes.Buffer
+	pkg.Files[len(pkg.Files)-1] = stdlib.TestingInspectCalls(pkg)
+	if err := runFluxTest(pkg, imp.Copy(), &out); err != nil {
+		fmt.Fprintf(&out, "error while executing testing.inspect: %v\n", err)
+	}
+	res.Output = out.String()
+	return res
+}
+
+// selectTestCases removes the test cases whose name does not match
+// the run pattern from the file and reports whether any are left.
+func (r *testRunner) selectTestCases(file *ast.File) bool {
+	var n int
+	body := file.Body[:0]
+	for _, stmt := range file.Body {
+		if tc, ok := stmt.(*ast.TestStatement); ok {
+			if !r.run.MatchString(tc.Assignment.ID.Name) {
+				continue
+			}
+			n++
+		}
+		body = append(body, stmt)
+	}
+	file.Body = body
+	return n > 0
+}
+
+// runFluxTest executes the test package. When w is not nil,
+// the results are formatted as tables and written to it.
+func runFluxTest(pkg *ast.Package, imp interpreter.Importer, w io.Writer) error {
+	program := lang.CompileAST(pkg, time.Now(), lang.WithImporter(imp))
+
+	deps := flux.NewDefaultDependencies()
+	deps.Deps.FilesystemService = filesystem.SystemFS
+	// Sinks record their calls instead of sending them
+	// so they can be checked with testing.sideEffects.
+	ctx := sideeffect.NewRecorder().Inject(deps.Inject(context.Background()))
+	q, err := program.Start(ctx, &memory.Allocator{})
+	if err != nil {
+		return fmt.Errorf("failed to execute test: %v", err)
+	}
+	defer q.Done()
+
+	var firstErr error
+	for res := range q.Results() {
+		var err error
+		if w != nil {
+			err = executepkg.FormatResult(w, res)
+		} else {
+			err = res.Tables().Do(func(flux.Table) error {
+				return nil
+			})
+		}
+		if err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	q.Done()
+	if err := q.Err(); err != nil {
+		return err
+	}
+	return firstErr
+}
+
+// localImporter resolves imports from the directory of a test file
+// before it falls back to the standard library. The import path
+// of the directory is the name of its package and its subdirectories
+// are imported by their path relative to it.
+type localImporter struct {
+	dir    string
+	root   string
+	stdlib interpreter.Importer
+	pkgs   map[string]*interpreter.Package
+}
+
+// newLocalImporter returns an importer with the packages
+// that the package under test imports from the directory.
+// The name of the package in the directory is taken from its
+// other .flux files, or from the test package without its _test suffix.
+func newLocalImporter(dir string, pkg *ast.Package) (*localImporter, error) {
+	imp := &localImporter{
+		dir:    dir,
+		stdlib: flux.StdLib(),
+		pkgs:   make(map[string]*interpreter.Package),
+	}
+	lib, err := parseLibrary(dir)
+	if err != nil {
+		return nil, err
+	}
+	if lib != nil {
+		imp.root = lib.Package
+	} else if clause := pkg.Files[0].Package; clause != nil {
+		imp.root = strings.TrimSuffix(clause.Name.Name, "_test")
+	}
+	if err := imp.load(pkg, make(map[string]bool)); err != nil {
+		return nil, err
+	}
+	return imp, nil
+}
+
+// load evaluates the local packages that the package imports.
+// Imports that are neither in the standard library nor in the directory
+// are left to be reported when the package is evaluated.
+func (imp *localImporter) load(pkg *ast.Package, loading map[string]bool) error {
+	for _, file := range pkg.Files {
+		for _, dec := range file.Imports {
+			importPath := dec.Path.Value
+			if _, ok := imp.Import(importPath); ok {
+				continue
+			}
+			if loading[importPath] {
+				return fmt.Errorf("import cycle through package %q", importPath)
+			}
+			lib, err := imp.resolve(importPath)
+			if err != nil {
+				return err
+			} else if lib == nil {
+				continue
+			}
+
+			loading[importPath] = true
+			if err := imp.load(lib, loading); err != nil {
+				return err
+			}
+			delete(loading, importPath)
+
+			semPkg, err := semantic.New(lib)
+			if err != nil {
+				return fmt.Errorf("failed to import package %q: %v", importPath, err)
+			}
+			p := interpreter.NewPackage(lib.Package)
+			itrp := interpreter.NewInterpreter(p)
+			if _, err := itrp.Eval(context.Background(), semPkg, flux.Prelude().Nest(p), imp); err != nil {
+				return fmt.Errorf("failed to import package %q: %v", importPath, err)
+			}
+			imp.pkgs[importPath] = p
+		}
+	}
+	return nil
+}
+
+// resolve returns the package at the import path in the directory.
+// It returns nil when the import path is outside of the directory
+// or there is no package at it.
+func (imp *localImporter) resolve(importPath string) (*ast.Package, error) {
+	if imp.root == "" {
+		return nil, nil
+	}
+	if importPath == imp.root {
+		return parseLibrary(imp.dir)
+	}
+	if rel := strings.TrimPrefix(importPath, imp.root+"/"); rel != importPath {
+		return parseLibrary(filepath.Join(imp.dir, filepath.FromSlash(rel)))
+	}
+	return nil, nil
+}
+
+// parseLibrary parses the .flux files of the directory that are not tests.
+// It returns nil when the directory has none.
+func parseLibrary(dir string) (*ast.Package, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "*.flux"))
+	if err != nil {
+		return nil, err
+	}
+	var lib *ast.Package
+	for _, name := range files {
+		if strings.HasSuffix(name, "_test.flux") {
+			continue
+		}
+		src, err := ioutil.ReadFile(name)
+		if err != nil {
+			return nil, err
+		}
+		pkg := parser.ParseSource(string(src))
+		if ast.Check(pkg) > 0 {
+			return nil, fmt.Errorf("failed to parse %s: %v", name, ast.GetError(pkg))
+		}
+		file := pkg.Files[0]
+		file.Name = filepath.Base(name)
+		if file.Package == nil {
+			return nil, fmt.Errorf("%s has no package clause", name)
+		}
+		if lib == nil {
+			lib = &ast.Package{Package: file.Package.Name.Name}
+		} else if lib.Package != file.Package.Name.Name {
+			return nil, fmt.Errorf("%s is in package %s, expected %s", name, file.Package.Name.Name, lib.Package)
+		}
+		lib.Files = append(lib.Files, file)
+	}
+	return lib, nil
+}
+
+// Copy returns a copy of the importer, so that the options
+// that one test run sets in a package do not leak into the next.
+func (imp *localImporter) Copy() *localImporter {
+	pkgs := make(map[string]*interpreter.Package, len(imp.pkgs))
+	for k, p := range imp.pkgs {
+		pkgs[k] = p.Copy()
+	}
+	return &localImporter{
+		dir:    imp.dir,
+		root:   imp.root,
+		stdlib: flux.StdLib(),
+		pkgs:   pkgs,
+	}
+}
+
+func (imp *localImporter) Import(path string) (semantic.PackageType, bool) {
+	if p, ok := imp.pkgs[path]; ok {
+		return semantic.PackageType{
+			Name: p.Name(),
+			Type: p.PolyType(),
+		}, true
+	}
+	return imp.stdlib.Import(path)
+}
+
+func (imp *localImporter) ImportPackageObject(path string) (*interpreter.Package, bool) {
+	if p, ok := imp.pkgs[path]; ok {
+		return p, true
+	}
+	return imp.stdlib.ImportPackageObject(path)
+}
+
+var skipDirective = regexp.MustCompile(`^\s*//\s*flux:skip\b\s*(.*)$`)
+
+// skipReason reports whether the source contains a skip directive
+// and returns the reason given with it.
+func skipReason(src []byte) (string, bool) {
+	scanner := bufio.NewScanner(bytes.NewReader(src))
+	for scanner.Scan() {
+		if m := skipDirective.FindStringSubmatch(scanner.Text()); m != nil {
+			reason := strings.TrimSpace(m[1])
+			if reason == "" {
+				reason = "skipped"
+			}
+			return reason, true
+		}
+	}
+	return "", false
+}
+
+type junitTestSuites struct {
+	XMLName xml.Name         `xml:"testsuites"`
+	Suites  []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name     string          `xml:"name,attr"`
+	Tests    int             `xml:"tests,attr"`
+	Failures int             `xml:"failures,attr"`
+	Skipped  int             `xml:"skipped,attr"`
+	Time     string          `xml:"time,attr"`
+	Cases    []junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	Classname string        `xml:"classname,attr"`
+	Time      string        `xml:"time,attr"`
+	Failure   *junitMessage `xml:"failure,omitempty"`
+	Skipped   *junitMessage `xml:"skipped,omitempty"`
+}
+
+type junitMessage struct {
+	Message string `xml:"message,attr"`
+	Body    string `xml:",chardata"`
+}
+
+// writeJUnit writes the results as a JUnit XML report
+// with a test suite for each directory.
+func writeJUnit(w io.Writer, results []*testResult) error {
+	var report junitTestSuites
+	suites := make(map[string]int)
+	durations := make(map[string]time.Duration)
+	for _, res := range results {
+		idx, ok := suites[res.Test.Suite]
+		if !ok {
+			idx = len(report.Suites)
+			suites[res.Test.Suite] = idx
+			report.Suites = append(report.Suites, junitTestSuite{Name: res.Test.Suite})
+		}
+		suite := &report.Suites[idx]
+		tc := junitTestCase{
+			Name:      res.Test.Name,
+			Classname: res.Test.Suite,
+			Time:      formatSeconds(res.Duration),
+		}
+		switch {
+		case res.Failed():
+			suite.Failures++
+			tc.Failure = &junitMessage{Message: res.Err.Error(), Body: res.Output}
+		case res.Skipped():
+			suite.Skipped++
+			tc.Skipped = &junitMessage{Message: res.Skip}
+		}
+		suite.Tests++
+		suite.Cases = append(suite.Cases, tc)
+		durations[res.Test.Suite] += res.Duration
+	}
+	for i := range report.Suites {
+		report.Suites[i].Time = formatSeconds(durations[report.Suites[i].Name])
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(report); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+func formatSeconds(d time.Duration) string {
+	return fmt.Sprintf("%.3f", d.Seconds())
+}
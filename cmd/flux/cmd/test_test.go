@@ -0,0 +1,188 @@
+package cmd
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+const passingTest = `package main
+
+import "testing"
+
+inData = "
+#datatype,string,long,dateTime:RFC3339,double,string
+#group,false,false,false,false,true
+#default,_result,,,,
+,result,table,_time,_value,_field
+,,0,2018-05-22T19:53:26Z,1.0,f
+"
+
+outData = "
+#datatype,string,long,dateTime:RFC3339,double,string
+#group,false,false,false,false,true
+#default,_result,,,,
+,result,table,_time,_value,_field
+,,0,2018-05-22T19:53:26Z,%s,f
+"
+
+t_double = (table=<-) => table |> map(fn: (r) => ({r with _value: r._value * 2.0}))
+
+test _double = () =>
+	({input: testing.loadStorage(csv: inData), want: testing.loadMem(csv: outData), fn: t_double})
+`
+
+func writeFluxTests(t *testing.T, files map[string]string) string {
+	t.Helper()
+	dir, err := ioutil.TempDir("", "flux-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for name, src := range files {
+		path := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(path, []byte(src), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return dir
+}
+
+func TestTestRunner(t *testing.T) {
+	dir := writeFluxTests(t, map[string]string{
+		"pass_test.flux": strings.Replace(passingTest, "%s", "2.0", 1),
+		"fail_test.flux": strings.Replace(passingTest, "%s", "3.0", 1),
+		"skip_test.flux": "// flux:skip not implemented\n" + passingTest,
+		"helper.flux":    "package main\n",
+	})
+	defer os.RemoveAll(dir)
+
+	tests, err := findFluxTests([]string{dir})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want, got := 3, len(tests); want != got {
+		t.Fatalf("unexpected number of tests -want/+got:\n\t- %d\n\t+ %d", want, got)
+	}
+
+	var out bytes.Buffer
+	r := &testRunner{w: &out}
+	results := r.Run(tests)
+
+	outcomes := make(map[string]string)
+	for _, res := range results {
+		switch {
+		case res.Failed():
+			outcomes[res.Test.Name] = "fail"
+		case res.Skipped():
+			outcomes[res.Test.Name] = res.Skip
+		default:
+			outcomes[res.Test.Name] = "pass"
+		}
+	}
+	want := map[string]string{
+		"fail": "fail",
+		"pass": "pass",
+		"skip": "not implemented",
+	}
+	for name, outcome := range want {
+		if got := outcomes[name]; got != outcome {
+			t.Errorf("unexpected outcome for %s -want/+got:\n\t- %s\n\t+ %s", name, outcome, got)
+		}
+	}
+	if !strings.Contains(out.String(), "Result: diff") {
+		t.Errorf("expected the diff of the failed test in the output:\n%s", out.String())
+	}
+
+	var report bytes.Buffer
+	if err := writeJUnit(&report, results); err != nil {
+		t.Fatal(err)
+	}
+	for _, s := range []string{`tests="3"`, `failures="1"`, `skipped="1"`} {
+		if !strings.Contains(report.String(), s) {
+			t.Errorf("expected %s in the JUnit report:\n%s", s, report.String())
+		}
+	}
+}
+
+const libraryTest = `package mylib_test
+
+import "testing"
+import "mylib"
+import "mylib/units"
+
+inData = "
+#datatype,string,long,dateTime:RFC3339,double,string
+#group,false,false,false,false,true
+#default,_result,,,,
+,result,table,_time,_value,_field
+,,0,2018-05-22T19:53:26Z,1.0,f
+"
+
+outData = "
+#datatype,string,long,dateTime:RFC3339,double,string
+#group,false,false,false,false,true
+#default,_result,,,,
+,result,table,_time,_value,_field
+,,0,2018-05-22T19:53:26Z,2.0,f
+"
+
+t_%[1]s = (table=<-) => table |> map(fn: (r) => ({r with _value: %[2]s(v: r._value)}))
+
+test _%[1]s = () =>
+	({input: testing.loadStorage(csv: inData), want: testing.loadMem(csv: outData), fn: t_%[1]s})
+`
+
+func TestTestRunner_LibraryPackage(t *testing.T) {
+	dir := writeFluxTests(t, map[string]string{
+		"mylib.flux":       "package mylib\n\ndouble = (v) => v * 2.0\n",
+		"units/units.flux": "package units\n\npercent = (v) => v * 100.0\n",
+		"double_test.flux": fmt.Sprintf(libraryTest, "double", "mylib.double"),
+		// The test of percent fails since the output expects the value to be doubled.
+		"percent_test.flux": fmt.Sprintf(libraryTest, "percent", "units.percent"),
+	})
+	defer os.RemoveAll(dir)
+
+	tests, err := findFluxTests([]string{dir})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tc := range []struct {
+		run  string
+		want map[string]string
+	}{
+		{run: "", want: map[string]string{"double": "pass", "percent": "fail"}},
+		{run: "^_double$", want: map[string]string{"double": "pass"}},
+		{run: "percent", want: map[string]string{"percent": "fail"}},
+		{run: "^_missing$", want: map[string]string{}},
+	} {
+		var out bytes.Buffer
+		r := &testRunner{w: &out}
+		if tc.run != "" {
+			r.run = regexp.MustCompile(tc.run)
+		}
+		got := make(map[string]string)
+		for _, res := range r.Run(tests) {
+			got[res.Test.Name] = "pass"
+			if res.Failed() {
+				got[res.Test.Name] = "fail"
+			}
+		}
+		if !cmp.Equal(tc.want, got) {
+			t.Errorf("run %q: unexpected outcomes -want/+got:\n%s\n%s", tc.run, cmp.Diff(tc.want, got), out.String())
+		}
+		if got["percent"] == "fail" && !strings.Contains(out.String(), "Result: diff") {
+			t.Errorf("run %q: expected the diff of the failed test in the output:\n%s", tc.run, out.String())
+		}
+	}
+}
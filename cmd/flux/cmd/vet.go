@@ -0,0 +1,106 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	_ "github.com/influxdata/flux/builtin"
+	"github.com/influxdata/flux/lint"
+	"github.com/influxdata/flux/parser"
+	"github.com/spf13/cobra"
+)
+
+// vetCmd represents the vet command
+var vetCmd = &cobra.Command{
+	Use:   "vet [flags] [paths...]",
+	Short: "Report likely mistakes in Flux scripts",
+	Long:  vetLong(),
+	RunE:  vet,
+	Args:  cobra.MinimumNArgs(1),
+
+	SilenceUsage: true,
+}
+
+func vetLong() string {
+	var b strings.Builder
+	b.WriteString(`Report likely mistakes in Flux scripts.
+Directories are searched recursively for *.flux files.
+Every check is enabled by default and can be disabled with --<check>=false.
+
+Checks:
+`)
+	for _, a := range lint.Analyzers() {
+		fmt.Fprintf(&b, "\n    %-16s %s", a.Name, a.Doc)
+	}
+	return b.String()
+}
+
+// vetFlags holds whether each analyzer is enabled.
+var vetFlags = make(map[string]*bool)
+
+func init() {
+	rootCmd.AddCommand(vetCmd)
+	for _, a := range lint.Analyzers() {
+		vetFlags[a.Name] = vetCmd.Flags().Bool(a.Name, true, a.Doc)
+	}
+}
+
+// errVet is returned when mistakes are reported.
+var errVet = errors.New("flux vet found problems")
+
+func vet(cmd *cobra.Command, args []string) error {
+	var analyzers []*lint.Analyzer
+	for _, a := range lint.Analyzers() {
+		if *vetFlags[a.Name] {
+			analyzers = append(analyzers, a)
+		}
+	}
+
+	failed := false
+	for _, path := range args {
+		err := filepath.Walk(path, func(path string, info os.FileInfo, err error) error {
+			if err != nil {
+				return err
+			}
+			if info.IsDir() || filepath.Ext(path) != ".flux" {
+				return nil
+			}
+			n, err := vetFile(os.Stdout, path, analyzers)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "%s: %v\n", path, err)
+			}
+			if err != nil || n > 0 {
+				failed = true
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+	if failed {
+		return errVet
+	}
+	return nil
+}
+
+// vetFile writes the diagnostics for the file and returns how many there are.
+func vetFile(w io.Writer, path string, analyzers []*lint.Analyzer) (int, error) {
+	src, err := ioutil.ReadFile(path)
+	if err != nil {
+		return 0, err
+	}
+	diagnostics, err := lint.Run(parser.ParseSource(string(src)), analyzers)
+	if err != nil {
+		return 0, err
+	}
+	for _, d := range diagnostics {
+		fmt.Fprintf(w, "%s:%v\n", path, d)
+	}
+	return len(diagnostics), nil
+}
@@ -0,0 +1,37 @@
+package cmd
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/influxdata/flux/lint"
+)
+
+func TestVetFile(t *testing.T) {
+	dir := writeFluxTests(t, map[string]string{
+		"a.flux": `import "strings"
+
+from(bucket: "telegraf")
+	|> yield()
+`,
+	})
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "a.flux")
+
+	unused, _ := lint.Lookup("unused")
+	var out bytes.Buffer
+	n, err := vetFile(&out, path, []*lint.Analyzer{unused})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != 1 {
+		t.Errorf("unexpected number of diagnostics: %d", n)
+	}
+	want := path + `:1:1: "strings" imported and not used (unused)` + "\n"
+	if got := out.String(); got != want {
+		t.Errorf("unexpected output -want/+got:\n%s", cmp.Diff(want, got))
+	}
+}
@@ -48,6 +48,12 @@ func Eval(ctx context.Context, flux string, opts ...ScopeMutator) ([]interpreter
 
 // EvalAST accepts a Flux AST and evaluates it to produce a set of side effects (as a slice of values) and a scope.
 func EvalAST(ctx context.Context, astPkg *ast.Package, opts ...ScopeMutator) ([]interpreter.SideEffect, values.Scope, error) {
+	return EvalASTWithImporter(ctx, astPkg, StdLib(), opts...)
+}
+
+// EvalASTWithImporter is like EvalAST, but the imports of the AST are resolved with the importer
+// instead of the standard library.
+func EvalASTWithImporter(ctx context.Context, astPkg *ast.Package, importer interpreter.Importer, opts ...ScopeMutator) ([]interpreter.SideEffect, values.Scope, error) {
 	semPkg, err := semantic.New(astPkg)
 	if err != nil {
 		return nil, nil, err
@@ -63,7 +69,7 @@ func EvalAST(ctx context.Context, astPkg *ast.Package, opts ...ScopeMutator) ([]
 		opt(scope)
 	}
 
-	sideEffects, err := itrp.Eval(ctx, semPkg, scope, StdLib())
+	sideEffects, err := itrp.Eval(ctx, semPkg, scope, importer)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -533,6 +539,9 @@ func (t *TableObject) Regexp() *regexp.Regexp {
 func (t *TableObject) Array() values.Array {
 	panic(values.UnexpectedKind(semantic.Object, semantic.Array))
 }
+func (t *TableObject) Dict() values.Dictionary {
+	panic(values.UnexpectedKind(semantic.Object, semantic.Dictionary))
+}
 func (t *TableObject) Object() values.Object {
 	return t
 }
@@ -689,6 +698,9 @@ func (f *function) Regexp() *regexp.Regexp {
 func (f *function) Array() values.Array {
 	panic(values.UnexpectedKind(semantic.Function, semantic.Array))
 }
+func (f *function) Dict() values.Dictionary {
+	panic(values.UnexpectedKind(semantic.Function, semantic.Dictionary))
+}
 func (f *function) Object() values.Object {
 	panic(values.UnexpectedKind(semantic.Function, semantic.Object))
 }
@@ -776,6 +788,43 @@ func (a Arguments) GetRequiredDuration(name string) (Duration, error) {
 	return d, nil
 }
 
+// LocationPolyType is the type of a location argument.
+// A location is an object with a zone name and a fixed offset.
+var LocationPolyType = semantic.NewObjectPolyType(
+	map[string]semantic.PolyType{
+		"zone":   semantic.String,
+		"offset": semantic.Duration,
+	},
+	semantic.LabelSet{"zone", "offset"},
+	nil,
+)
+
+func (a Arguments) GetLocation(name string) (Location, bool, error) {
+	v, ok := a.Get(name)
+	if !ok {
+		return values.UTC, false, nil
+	}
+	l, err := ToLocation(v)
+	return l, true, err
+}
+
+// ToLocation converts a location object into a Location.
+func ToLocation(value values.Value) (Location, error) {
+	if value.Type().Nature() != semantic.Object {
+		return Location{}, errors.Newf(codes.Invalid, "location must be an object, got %v", value.Type())
+	}
+	obj := value.Object()
+	zone, ok := obj.Get("zone")
+	if !ok || zone.Type().Nature() != semantic.String {
+		return Location{}, errors.New(codes.Invalid, "location must have a zone string")
+	}
+	offset, ok := obj.Get("offset")
+	if !ok || offset.Type().Nature() != semantic.Duration {
+		return Location{}, errors.New(codes.Invalid, "location must have an offset duration")
+	}
+	return values.LoadLocation(zone.Str(), offset.Duration())
+}
+
 func ToQueryTime(value values.Value) (Time, error) {
 	switch value.Type().Nature() {
 	case semantic.Time:
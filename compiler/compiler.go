@@ -12,19 +12,37 @@ func Compile(scope Scope, f *semantic.FunctionExpression, in semantic.Type) (Fun
 	if scope == nil {
 		scope = NewScope()
 	}
+	typeSol, fnType, err := inferTypes(scope, f, in)
+	if err != nil {
+		return nil, err
+	}
+
+	root, err := compile(f.Block.Body, typeSol, scope, make(map[string]*semantic.FunctionExpression))
+	if err != nil {
+		return nil, errors.Wrapf(err, codes.Inherit, "cannot compile @ %v", f.Location())
+	}
+	return compiledFn{
+		root:       root,
+		fnType:     fnType,
+		inputScope: nestScope(scope),
+	}, nil
+}
+
+// inferTypes solves the types of the function for the given input type.
+func inferTypes(scope Scope, f *semantic.FunctionExpression, in semantic.Type) (semantic.TypeSolution, semantic.Type, error) {
 	if in.Nature() != semantic.Object {
-		return nil, errors.Newf(codes.Invalid, "function input must be an object @ %v", f.Location())
+		return nil, nil, errors.Newf(codes.Invalid, "function input must be an object @ %v", f.Location())
 	}
 	extern := values.BuildExternAssignments(f, scope)
 
 	typeSol, err := semantic.InferTypes(extern, flux.StdLib())
 	if err != nil {
-		return nil, errors.Wrapf(err, codes.Inherit, "compile function @ %v", f.Location())
+		return nil, nil, errors.Wrapf(err, codes.Inherit, "compile function @ %v", f.Location())
 	}
 
 	pt, err := typeSol.PolyTypeOf(f)
 	if err != nil {
-		return nil, errors.Wrapf(err, codes.Inherit, "retreiving compile function @ %v", f.Location())
+		return nil, nil, errors.Wrapf(err, codes.Inherit, "retreiving compile function @ %v", f.Location())
 	}
 	props := in.Properties()
 	parameters := make(map[string]semantic.PolyType, len(props))
@@ -36,22 +54,13 @@ func Compile(scope Scope, f *semantic.FunctionExpression, in semantic.Type) (Fun
 		Return:     typeSol.Fresh(),
 	})
 	if err := typeSol.AddConstraint(pt, fpt); err != nil {
-		return nil, errors.Wrapf(err, codes.Inherit, "cannot add type constraint @ %v", f.Location())
+		return nil, nil, errors.Wrapf(err, codes.Inherit, "cannot add type constraint @ %v", f.Location())
 	}
 	fnType, err := typeSol.TypeOf(f)
 	if err != nil {
-		return nil, errors.Wrapf(err, codes.Inherit, "cannot compile polymorphic function @ %v", f.Location())
-	}
-
-	root, err := compile(f.Block.Body, typeSol, scope, make(map[string]*semantic.FunctionExpression))
-	if err != nil {
-		return nil, errors.Wrapf(err, codes.Inherit, "cannot compile @ %v", f.Location())
+		return nil, nil, errors.Wrapf(err, codes.Inherit, "cannot compile polymorphic function @ %v", f.Location())
 	}
-	return compiledFn{
-		root:       root,
-		fnType:     fnType,
-		inputScope: nestScope(scope),
-	}, nil
+	return typeSol, fnType, nil
 }
 
 // monoType ignores any errors when reading the type of a node.
@@ -157,6 +166,29 @@ func compile(n semantic.Node, typeSol semantic.TypeSolution, scope Scope, funcEx
 			t:     semantic.NewArrayType(elements[0].Type()),
 			array: elements,
 		}, nil
+	case *semantic.DictExpression:
+		t, err := typeSol.TypeOf(n)
+		if err != nil {
+			return nil, err
+		} else if t == nil {
+			return nil, errors.New(codes.Internal, "expecting dictionary type")
+		}
+		elements := make([]dictItemEvaluator, len(n.Elements))
+		for i, item := range n.Elements {
+			key, err := compile(item.Key, typeSol, scope, funcExprs)
+			if err != nil {
+				return nil, err
+			}
+			val, err := compile(item.Val, typeSol, scope, funcExprs)
+			if err != nil {
+				return nil, err
+			}
+			elements[i] = dictItemEvaluator{key: key, val: val}
+		}
+		return &dictEvaluator{
+			t:        t,
+			elements: elements,
+		}, nil
 	case *semantic.IdentifierExpression:
 		// Create type instance of the function
 		if fe, ok := funcExprs[n.Name]; ok {
@@ -402,6 +434,7 @@ type CompilationCache struct {
 	fn       *semantic.FunctionExpression
 	scope    Scope
 	compiled map[semantic.Type]funcErr
+	vectors  map[semantic.Type]vectorFuncErr
 }
 
 func NewCompilationCache(fn *semantic.FunctionExpression, scope Scope) *CompilationCache {
@@ -409,6 +442,7 @@ func NewCompilationCache(fn *semantic.FunctionExpression, scope Scope) *Compilat
 		fn:       fn,
 		scope:    scope,
 		compiled: make(map[semantic.Type]funcErr),
+		vectors:  make(map[semantic.Type]vectorFuncErr),
 	}
 }
 
@@ -432,6 +466,26 @@ type funcErr struct {
 	Err error
 }
 
+// CompileVector returns a vectorized function based on the provided type.
+// The result will be cached for subsequent calls.
+func (c *CompilationCache) CompileVector(in semantic.Type) (VectorFunc, error) {
+	f, ok := c.vectors[in]
+	if ok {
+		return f.F, f.Err
+	}
+	fun, err := CompileVector(c.scope, c.fn, in)
+	c.vectors[in] = vectorFuncErr{
+		F:   fun,
+		Err: err,
+	}
+	return fun, err
+}
+
+type vectorFuncErr struct {
+	F   VectorFunc
+	Err error
+}
+
 // CompileFnParam is a utility function for compiling an `fn` parameter for rename or drop/keep. In addition
 // to the function expression, it takes two types to verify the result against:
 // a single argument type, and a single return type.
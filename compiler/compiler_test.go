@@ -514,6 +514,51 @@ func TestCompileAndEval(t *testing.T) {
 			want:    values.NewBool(true),
 			wantErr: false,
 		},
+		{
+			name: "dictionary literal",
+			// f = (r) => ["a": r.a, "b": 2]
+			fn: &semantic.FunctionExpression{
+				Block: &semantic.FunctionBlock{
+					Parameters: &semantic.FunctionParameters{
+						List: []*semantic.FunctionParameter{
+							{Key: &semantic.Identifier{Name: "r"}},
+						},
+					},
+					Body: &semantic.DictExpression{
+						Elements: []*semantic.DictItem{
+							{
+								Key: &semantic.StringLiteral{Value: "a"},
+								Val: &semantic.MemberExpression{
+									Object:   &semantic.IdentifierExpression{Name: "r"},
+									Property: "a",
+								},
+							},
+							{
+								Key: &semantic.StringLiteral{Value: "b"},
+								Val: &semantic.IntegerLiteral{Value: 2},
+							},
+						},
+					},
+				},
+			},
+			inType: semantic.NewObjectType(map[string]semantic.Type{
+				"r": semantic.NewObjectType(map[string]semantic.Type{
+					"a": semantic.Int,
+				}),
+			}),
+			input: values.NewObjectWithValues(map[string]values.Value{
+				"r": values.NewObjectWithValues(map[string]values.Value{
+					"a": values.NewInt(1),
+				}),
+			}),
+			want: func() values.Value {
+				b := values.NewDictBuilder(semantic.NewDictType(semantic.String, semantic.Int))
+				_ = b.Insert(values.NewString("a"), values.NewInt(1))
+				_ = b.Insert(values.NewString("b"), values.NewInt(2))
+				return b.Dict()
+			}(),
+			wantErr: false,
+		},
 		{
 			name: "conditional",
 			// f = (t, c, a) => if t then c else a
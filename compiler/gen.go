@@ -0,0 +1,4 @@
+package compiler
+
+//go:generate -command tmpl ../gotool.sh github.com/benbjohnson/tmpl
+//go:generate tmpl -data=@vector.tmpldata -o vector.gen.go vector.gen.go.tmpl
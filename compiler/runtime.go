@@ -255,6 +255,38 @@ func (e *arrayEvaluator) Eval(ctx context.Context, scope Scope) (values.Value, e
 	return arr, nil
 }
 
+type dictItemEvaluator struct {
+	key Evaluator
+	val Evaluator
+}
+
+type dictEvaluator struct {
+	t        semantic.Type
+	elements []dictItemEvaluator
+}
+
+func (e *dictEvaluator) Type() semantic.Type {
+	return e.t
+}
+
+func (e *dictEvaluator) Eval(ctx context.Context, scope Scope) (values.Value, error) {
+	builder := values.NewDictBuilder(e.t)
+	for _, item := range e.elements {
+		k, err := eval(ctx, item.key, scope)
+		if err != nil {
+			return nil, err
+		}
+		v, err := eval(ctx, item.val, scope)
+		if err != nil {
+			return nil, err
+		}
+		if err := builder.Insert(k, v); err != nil {
+			return nil, err
+		}
+	}
+	return builder.Dict(), nil
+}
+
 type logicalEvaluator struct {
 	t           semantic.Type
 	operator    ast.LogicalOperatorKind
@@ -683,6 +715,9 @@ func (f *functionValue) Regexp() *regexp.Regexp {
 func (f *functionValue) Array() values.Array {
 	panic(values.UnexpectedKind(semantic.Function, semantic.Array))
 }
+func (f *functionValue) Dict() values.Dictionary {
+	panic(values.UnexpectedKind(semantic.Function, semantic.Dictionary))
+}
 func (f *functionValue) Object() values.Object {
 	panic(values.UnexpectedKind(semantic.Function, semantic.Object))
 }
@@ -0,0 +1,1586 @@
+// Generated by tmpl
+// https://github.com/benbjohnson/tmpl
+//
+// DO NOT EDIT!
+// Source: vector.gen.go.tmpl
+
+package compiler
+
+import (
+	"math"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/memory"
+	"github.com/influxdata/flux/ast"
+	"github.com/influxdata/flux/codes"
+	"github.com/influxdata/flux/internal/errors"
+	"github.com/influxdata/flux/semantic"
+)
+
+// vectorBinaryFuncs contains the kernels for the binary operators
+// whose operands have the same type.
+var vectorBinaryFuncs = map[vectorBinarySignature]vectorBinaryFunc{
+	{Operator: ast.AdditionOperator, Nature: semantic.Int}:            vectorIntAddition,
+	{Operator: ast.SubtractionOperator, Nature: semantic.Int}:         vectorIntSubtraction,
+	{Operator: ast.MultiplicationOperator, Nature: semantic.Int}:      vectorIntMultiplication,
+	{Operator: ast.DivisionOperator, Nature: semantic.Int}:            vectorIntDivision,
+	{Operator: ast.ModuloOperator, Nature: semantic.Int}:              vectorIntModulo,
+	{Operator: ast.LessThanOperator, Nature: semantic.Int}:            vectorIntLessThan,
+	{Operator: ast.LessThanEqualOperator, Nature: semantic.Int}:       vectorIntLessThanEqual,
+	{Operator: ast.GreaterThanOperator, Nature: semantic.Int}:         vectorIntGreaterThan,
+	{Operator: ast.GreaterThanEqualOperator, Nature: semantic.Int}:    vectorIntGreaterThanEqual,
+	{Operator: ast.EqualOperator, Nature: semantic.Int}:               vectorIntEqual,
+	{Operator: ast.NotEqualOperator, Nature: semantic.Int}:            vectorIntNotEqual,
+	{Operator: ast.AdditionOperator, Nature: semantic.UInt}:           vectorUIntAddition,
+	{Operator: ast.SubtractionOperator, Nature: semantic.UInt}:        vectorUIntSubtraction,
+	{Operator: ast.MultiplicationOperator, Nature: semantic.UInt}:     vectorUIntMultiplication,
+	{Operator: ast.DivisionOperator, Nature: semantic.UInt}:           vectorUIntDivision,
+	{Operator: ast.ModuloOperator, Nature: semantic.UInt}:             vectorUIntModulo,
+	{Operator: ast.LessThanOperator, Nature: semantic.UInt}:           vectorUIntLessThan,
+	{Operator: ast.LessThanEqualOperator, Nature: semantic.UInt}:      vectorUIntLessThanEqual,
+	{Operator: ast.GreaterThanOperator, Nature: semantic.UInt}:        vectorUIntGreaterThan,
+	{Operator: ast.GreaterThanEqualOperator, Nature: semantic.UInt}:   vectorUIntGreaterThanEqual,
+	{Operator: ast.EqualOperator, Nature: semantic.UInt}:              vectorUIntEqual,
+	{Operator: ast.NotEqualOperator, Nature: semantic.UInt}:           vectorUIntNotEqual,
+	{Operator: ast.AdditionOperator, Nature: semantic.Float}:          vectorFloatAddition,
+	{Operator: ast.SubtractionOperator, Nature: semantic.Float}:       vectorFloatSubtraction,
+	{Operator: ast.MultiplicationOperator, Nature: semantic.Float}:    vectorFloatMultiplication,
+	{Operator: ast.DivisionOperator, Nature: semantic.Float}:          vectorFloatDivision,
+	{Operator: ast.ModuloOperator, Nature: semantic.Float}:            vectorFloatModulo,
+	{Operator: ast.LessThanOperator, Nature: semantic.Float}:          vectorFloatLessThan,
+	{Operator: ast.LessThanEqualOperator, Nature: semantic.Float}:     vectorFloatLessThanEqual,
+	{Operator: ast.GreaterThanOperator, Nature: semantic.Float}:       vectorFloatGreaterThan,
+	{Operator: ast.GreaterThanEqualOperator, Nature: semantic.Float}:  vectorFloatGreaterThanEqual,
+	{Operator: ast.EqualOperator, Nature: semantic.Float}:             vectorFloatEqual,
+	{Operator: ast.NotEqualOperator, Nature: semantic.Float}:          vectorFloatNotEqual,
+	{Operator: ast.AdditionOperator, Nature: semantic.String}:         vectorStringAddition,
+	{Operator: ast.LessThanOperator, Nature: semantic.String}:         vectorStringLessThan,
+	{Operator: ast.LessThanEqualOperator, Nature: semantic.String}:    vectorStringLessThanEqual,
+	{Operator: ast.GreaterThanOperator, Nature: semantic.String}:      vectorStringGreaterThan,
+	{Operator: ast.GreaterThanEqualOperator, Nature: semantic.String}: vectorStringGreaterThanEqual,
+	{Operator: ast.EqualOperator, Nature: semantic.String}:            vectorStringEqual,
+	{Operator: ast.NotEqualOperator, Nature: semantic.String}:         vectorStringNotEqual,
+	{Operator: ast.LessThanOperator, Nature: semantic.Time}:           vectorTimeLessThan,
+	{Operator: ast.LessThanEqualOperator, Nature: semantic.Time}:      vectorTimeLessThanEqual,
+	{Operator: ast.GreaterThanOperator, Nature: semantic.Time}:        vectorTimeGreaterThan,
+	{Operator: ast.GreaterThanEqualOperator, Nature: semantic.Time}:   vectorTimeGreaterThanEqual,
+	{Operator: ast.EqualOperator, Nature: semantic.Time}:              vectorTimeEqual,
+	{Operator: ast.NotEqualOperator, Nature: semantic.Time}:           vectorTimeNotEqual,
+	{Operator: ast.EqualOperator, Nature: semantic.Bool}:              vectorBoolEqual,
+	{Operator: ast.NotEqualOperator, Nature: semantic.Bool}:           vectorBoolNotEqual,
+}
+
+// vectorSelectFuncs contains the kernels for conditional expressions.
+var vectorSelectFuncs = map[semantic.Nature]vectorSelectFunc{
+	semantic.Int:    vectorIntSelect,
+	semantic.UInt:   vectorUIntSelect,
+	semantic.Float:  vectorFloatSelect,
+	semantic.String: vectorStringSelect,
+	semantic.Time:   vectorTimeSelect,
+	semantic.Bool:   vectorBoolSelect,
+}
+
+// vectorIntOperands returns the array or the value of each operand.
+func vectorIntOperands(l, r vector) (la, ra *array.Int64, lc, rc int64) {
+	if l.arr != nil {
+		la = l.arr.(*array.Int64)
+	} else if !l.val.IsNull() {
+		lc = l.val.Int()
+	}
+	if r.arr != nil {
+		ra = r.arr.(*array.Int64)
+	} else if !r.val.IsNull() {
+		rc = r.val.Int()
+	}
+	return la, ra, lc, rc
+}
+
+func vectorIntAddition(lv, rv vector, n int, mem memory.Allocator) (vector, error) {
+	la, ra, lc, rc := vectorIntOperands(lv, rv)
+	b := array.NewInt64Builder(mem)
+	b.Resize(n)
+	for i := 0; i < n; i++ {
+		l, r := lc, rc
+		if la != nil {
+			if la.IsNull(i) {
+				b.AppendNull()
+				continue
+			}
+			l = la.Value(i)
+		}
+		if ra != nil {
+			if ra.IsNull(i) {
+				b.AppendNull()
+				continue
+			}
+			r = ra.Value(i)
+		}
+
+		b.Append(l + r)
+	}
+	return vector{arr: b.NewArray()}, nil
+}
+
+func vectorIntSubtraction(lv, rv vector, n int, mem memory.Allocator) (vector, error) {
+	la, ra, lc, rc := vectorIntOperands(lv, rv)
+	b := array.NewInt64Builder(mem)
+	b.Resize(n)
+	for i := 0; i < n; i++ {
+		l, r := lc, rc
+		if la != nil {
+			if la.IsNull(i) {
+				b.AppendNull()
+				continue
+			}
+			l = la.Value(i)
+		}
+		if ra != nil {
+			if ra.IsNull(i) {
+				b.AppendNull()
+				continue
+			}
+			r = ra.Value(i)
+		}
+
+		b.Append(l - r)
+	}
+	return vector{arr: b.NewArray()}, nil
+}
+
+func vectorIntMultiplication(lv, rv vector, n int, mem memory.Allocator) (vector, error) {
+	la, ra, lc, rc := vectorIntOperands(lv, rv)
+	b := array.NewInt64Builder(mem)
+	b.Resize(n)
+	for i := 0; i < n; i++ {
+		l, r := lc, rc
+		if la != nil {
+			if la.IsNull(i) {
+				b.AppendNull()
+				continue
+			}
+			l = la.Value(i)
+		}
+		if ra != nil {
+			if ra.IsNull(i) {
+				b.AppendNull()
+				continue
+			}
+			r = ra.Value(i)
+		}
+
+		b.Append(l * r)
+	}
+	return vector{arr: b.NewArray()}, nil
+}
+
+func vectorIntDivision(lv, rv vector, n int, mem memory.Allocator) (vector, error) {
+	la, ra, lc, rc := vectorIntOperands(lv, rv)
+	b := array.NewInt64Builder(mem)
+	b.Resize(n)
+	for i := 0; i < n; i++ {
+		l, r := lc, rc
+		if la != nil {
+			if la.IsNull(i) {
+				b.AppendNull()
+				continue
+			}
+			l = la.Value(i)
+		}
+		if ra != nil {
+			if ra.IsNull(i) {
+				b.AppendNull()
+				continue
+			}
+			r = ra.Value(i)
+		}
+
+		if r == 0 {
+			b.Release()
+			return vector{}, errors.New(codes.FailedPrecondition, "cannot divide by zero")
+		}
+
+		b.Append(l / r)
+	}
+	return vector{arr: b.NewArray()}, nil
+}
+
+func vectorIntModulo(lv, rv vector, n int, mem memory.Allocator) (vector, error) {
+	la, ra, lc, rc := vectorIntOperands(lv, rv)
+	b := array.NewInt64Builder(mem)
+	b.Resize(n)
+	for i := 0; i < n; i++ {
+		l, r := lc, rc
+		if la != nil {
+			if la.IsNull(i) {
+				b.AppendNull()
+				continue
+			}
+			l = la.Value(i)
+		}
+		if ra != nil {
+			if ra.IsNull(i) {
+				b.AppendNull()
+				continue
+			}
+			r = ra.Value(i)
+		}
+
+		if r == 0 {
+			b.Release()
+			return vector{}, errors.New(codes.FailedPrecondition, "cannot mod zero")
+		}
+
+		b.Append(l % r)
+	}
+	return vector{arr: b.NewArray()}, nil
+}
+
+func vectorIntLessThan(lv, rv vector, n int, mem memory.Allocator) (vector, error) {
+	la, ra, lc, rc := vectorIntOperands(lv, rv)
+	b := array.NewBooleanBuilder(mem)
+	b.Resize(n)
+	for i := 0; i < n; i++ {
+		l, r := lc, rc
+		if la != nil {
+			if la.IsNull(i) {
+				b.AppendNull()
+				continue
+			}
+			l = la.Value(i)
+		}
+		if ra != nil {
+			if ra.IsNull(i) {
+				b.AppendNull()
+				continue
+			}
+			r = ra.Value(i)
+		}
+		b.Append(l < r)
+	}
+	return vector{arr: b.NewArray()}, nil
+}
+
+func vectorIntLessThanEqual(lv, rv vector, n int, mem memory.Allocator) (vector, error) {
+	la, ra, lc, rc := vectorIntOperands(lv, rv)
+	b := array.NewBooleanBuilder(mem)
+	b.Resize(n)
+	for i := 0; i < n; i++ {
+		l, r := lc, rc
+		if la != nil {
+			if la.IsNull(i) {
+				b.AppendNull()
+				continue
+			}
+			l = la.Value(i)
+		}
+		if ra != nil {
+			if ra.IsNull(i) {
+				b.AppendNull()
+				continue
+			}
+			r = ra.Value(i)
+		}
+		b.Append(l <= r)
+	}
+	return vector{arr: b.NewArray()}, nil
+}
+
+func vectorIntGreaterThan(lv, rv vector, n int, mem memory.Allocator) (vector, error) {
+	la, ra, lc, rc := vectorIntOperands(lv, rv)
+	b := array.NewBooleanBuilder(mem)
+	b.Resize(n)
+	for i := 0; i < n; i++ {
+		l, r := lc, rc
+		if la != nil {
+			if la.IsNull(i) {
+				b.AppendNull()
+				continue
+			}
+			l = la.Value(i)
+		}
+		if ra != nil {
+			if ra.IsNull(i) {
+				b.AppendNull()
+				continue
+			}
+			r = ra.Value(i)
+		}
+		b.Append(l > r)
+	}
+	return vector{arr: b.NewArray()}, nil
+}
+
+func vectorIntGreaterThanEqual(lv, rv vector, n int, mem memory.Allocator) (vector, error) {
+	la, ra, lc, rc := vectorIntOperands(lv, rv)
+	b := array.NewBooleanBuilder(mem)
+	b.Resize(n)
+	for i := 0; i < n; i++ {
+		l, r := lc, rc
+		if la != nil {
+			if la.IsNull(i) {
+				b.AppendNull()
+				continue
+			}
+			l = la.Value(i)
+		}
+		if ra != nil {
+			if ra.IsNull(i) {
+				b.AppendNull()
+				continue
+			}
+			r = ra.Value(i)
+		}
+		b.Append(l >= r)
+	}
+	return vector{arr: b.NewArray()}, nil
+}
+
+func vectorIntEqual(lv, rv vector, n int, mem memory.Allocator) (vector, error) {
+	la, ra, lc, rc := vectorIntOperands(lv, rv)
+	b := array.NewBooleanBuilder(mem)
+	b.Resize(n)
+	for i := 0; i < n; i++ {
+		l, r := lc, rc
+		if la != nil {
+			if la.IsNull(i) {
+				b.AppendNull()
+				continue
+			}
+			l = la.Value(i)
+		}
+		if ra != nil {
+			if ra.IsNull(i) {
+				b.AppendNull()
+				continue
+			}
+			r = ra.Value(i)
+		}
+		b.Append(l == r)
+	}
+	return vector{arr: b.NewArray()}, nil
+}
+
+func vectorIntNotEqual(lv, rv vector, n int, mem memory.Allocator) (vector, error) {
+	la, ra, lc, rc := vectorIntOperands(lv, rv)
+	b := array.NewBooleanBuilder(mem)
+	b.Resize(n)
+	for i := 0; i < n; i++ {
+		l, r := lc, rc
+		if la != nil {
+			if la.IsNull(i) {
+				b.AppendNull()
+				continue
+			}
+			l = la.Value(i)
+		}
+		if ra != nil {
+			if ra.IsNull(i) {
+				b.AppendNull()
+				continue
+			}
+			r = ra.Value(i)
+		}
+		b.Append(l != r)
+	}
+	return vector{arr: b.NewArray()}, nil
+}
+
+func vectorIntSelect(test *array.Boolean, cv, av vector, n int, mem memory.Allocator) vector {
+	ca, aa, cc, ac := vectorIntOperands(cv, av)
+	b := array.NewInt64Builder(mem)
+	b.Resize(n)
+	for i := 0; i < n; i++ {
+		var (
+			arr   = aa
+			v     = ac
+			valid = av.arr != nil || !av.val.IsNull()
+		)
+		if test.IsValid(i) && test.Value(i) {
+			arr, v, valid = ca, cc, cv.arr != nil || !cv.val.IsNull()
+		}
+		if arr != nil {
+			if arr.IsNull(i) {
+				b.AppendNull()
+				continue
+			}
+			v = arr.Value(i)
+		} else if !valid {
+			b.AppendNull()
+			continue
+		}
+		b.Append(v)
+	}
+	return vector{arr: b.NewArray()}
+}
+
+// vectorUIntOperands returns the array or the value of each operand.
+func vectorUIntOperands(l, r vector) (la, ra *array.Uint64, lc, rc uint64) {
+	if l.arr != nil {
+		la = l.arr.(*array.Uint64)
+	} else if !l.val.IsNull() {
+		lc = l.val.UInt()
+	}
+	if r.arr != nil {
+		ra = r.arr.(*array.Uint64)
+	} else if !r.val.IsNull() {
+		rc = r.val.UInt()
+	}
+	return la, ra, lc, rc
+}
+
+func vectorUIntAddition(lv, rv vector, n int, mem memory.Allocator) (vector, error) {
+	la, ra, lc, rc := vectorUIntOperands(lv, rv)
+	b := array.NewUint64Builder(mem)
+	b.Resize(n)
+	for i := 0; i < n; i++ {
+		l, r := lc, rc
+		if la != nil {
+			if la.IsNull(i) {
+				b.AppendNull()
+				continue
+			}
+			l = la.Value(i)
+		}
+		if ra != nil {
+			if ra.IsNull(i) {
+				b.AppendNull()
+				continue
+			}
+			r = ra.Value(i)
+		}
+
+		b.Append(l + r)
+	}
+	return vector{arr: b.NewArray()}, nil
+}
+
+func vectorUIntSubtraction(lv, rv vector, n int, mem memory.Allocator) (vector, error) {
+	la, ra, lc, rc := vectorUIntOperands(lv, rv)
+	b := array.NewUint64Builder(mem)
+	b.Resize(n)
+	for i := 0; i < n; i++ {
+		l, r := lc, rc
+		if la != nil {
+			if la.IsNull(i) {
+				b.AppendNull()
+				continue
+			}
+			l = la.Value(i)
+		}
+		if ra != nil {
+			if ra.IsNull(i) {
+				b.AppendNull()
+				continue
+			}
+			r = ra.Value(i)
+		}
+
+		b.Append(l - r)
+	}
+	return vector{arr: b.NewArray()}, nil
+}
+
+func vectorUIntMultiplication(lv, rv vector, n int, mem memory.Allocator) (vector, error) {
+	la, ra, lc, rc := vectorUIntOperands(lv, rv)
+	b := array.NewUint64Builder(mem)
+	b.Resize(n)
+	for i := 0; i < n; i++ {
+		l, r := lc, rc
+		if la != nil {
+			if la.IsNull(i) {
+				b.AppendNull()
+				continue
+			}
+			l = la.Value(i)
+		}
+		if ra != nil {
+			if ra.IsNull(i) {
+				b.AppendNull()
+				continue
+			}
+			r = ra.Value(i)
+		}
+
+		b.Append(l * r)
+	}
+	return vector{arr: b.NewArray()}, nil
+}
+
+func vectorUIntDivision(lv, rv vector, n int, mem memory.Allocator) (vector, error) {
+	la, ra, lc, rc := vectorUIntOperands(lv, rv)
+	b := array.NewUint64Builder(mem)
+	b.Resize(n)
+	for i := 0; i < n; i++ {
+		l, r := lc, rc
+		if la != nil {
+			if la.IsNull(i) {
+				b.AppendNull()
+				continue
+			}
+			l = la.Value(i)
+		}
+		if ra != nil {
+			if ra.IsNull(i) {
+				b.AppendNull()
+				continue
+			}
+			r = ra.Value(i)
+		}
+
+		if r == 0 {
+			b.Release()
+			return vector{}, errors.New(codes.FailedPrecondition, "cannot divide by zero")
+		}
+
+		b.Append(l / r)
+	}
+	return vector{arr: b.NewArray()}, nil
+}
+
+func vectorUIntModulo(lv, rv vector, n int, mem memory.Allocator) (vector, error) {
+	la, ra, lc, rc := vectorUIntOperands(lv, rv)
+	b := array.NewUint64Builder(mem)
+	b.Resize(n)
+	for i := 0; i < n; i++ {
+		l, r := lc, rc
+		if la != nil {
+			if la.IsNull(i) {
+				b.AppendNull()
+				continue
+			}
+			l = la.Value(i)
+		}
+		if ra != nil {
+			if ra.IsNull(i) {
+				b.AppendNull()
+				continue
+			}
+			r = ra.Value(i)
+		}
+
+		if r == 0 {
+			b.Release()
+			return vector{}, errors.New(codes.FailedPrecondition, "cannot mod zero")
+		}
+
+		b.Append(l % r)
+	}
+	return vector{arr: b.NewArray()}, nil
+}
+
+func vectorUIntLessThan(lv, rv vector, n int, mem memory.Allocator) (vector, error) {
+	la, ra, lc, rc := vectorUIntOperands(lv, rv)
+	b := array.NewBooleanBuilder(mem)
+	b.Resize(n)
+	for i := 0; i < n; i++ {
+		l, r := lc, rc
+		if la != nil {
+			if la.IsNull(i) {
+				b.AppendNull()
+				continue
+			}
+			l = la.Value(i)
+		}
+		if ra != nil {
+			if ra.IsNull(i) {
+				b.AppendNull()
+				continue
+			}
+			r = ra.Value(i)
+		}
+		b.Append(l < r)
+	}
+	return vector{arr: b.NewArray()}, nil
+}
+
+func vectorUIntLessThanEqual(lv, rv vector, n int, mem memory.Allocator) (vector, error) {
+	la, ra, lc, rc := vectorUIntOperands(lv, rv)
+	b := array.NewBooleanBuilder(mem)
+	b.Resize(n)
+	for i := 0; i < n; i++ {
+		l, r := lc, rc
+		if la != nil {
+			if la.IsNull(i) {
+				b.AppendNull()
+				continue
+			}
+			l = la.Value(i)
+		}
+		if ra != nil {
+			if ra.IsNull(i) {
+				b.AppendNull()
+				continue
+			}
+			r = ra.Value(i)
+		}
+		b.Append(l <= r)
+	}
+	return vector{arr: b.NewArray()}, nil
+}
+
+func vectorUIntGreaterThan(lv, rv vector, n int, mem memory.Allocator) (vector, error) {
+	la, ra, lc, rc := vectorUIntOperands(lv, rv)
+	b := array.NewBooleanBuilder(mem)
+	b.Resize(n)
+	for i := 0; i < n; i++ {
+		l, r := lc, rc
+		if la != nil {
+			if la.IsNull(i) {
+				b.AppendNull()
+				continue
+			}
+			l = la.Value(i)
+		}
+		if ra != nil {
+			if ra.IsNull(i) {
+				b.AppendNull()
+				continue
+			}
+			r = ra.Value(i)
+		}
+		b.Append(l > r)
+	}
+	return vector{arr: b.NewArray()}, nil
+}
+
+func vectorUIntGreaterThanEqual(lv, rv vector, n int, mem memory.Allocator) (vector, error) {
+	la, ra, lc, rc := vectorUIntOperands(lv, rv)
+	b := array.NewBooleanBuilder(mem)
+	b.Resize(n)
+	for i := 0; i < n; i++ {
+		l, r := lc, rc
+		if la != nil {
+			if la.IsNull(i) {
+				b.AppendNull()
+				continue
+			}
+			l = la.Value(i)
+		}
+		if ra != nil {
+			if ra.IsNull(i) {
+				b.AppendNull()
+				continue
+			}
+			r = ra.Value(i)
+		}
+		b.Append(l >= r)
+	}
+	return vector{arr: b.NewArray()}, nil
+}
+
+func vectorUIntEqual(lv, rv vector, n int, mem memory.Allocator) (vector, error) {
+	la, ra, lc, rc := vectorUIntOperands(lv, rv)
+	b := array.NewBooleanBuilder(mem)
+	b.Resize(n)
+	for i := 0; i < n; i++ {
+		l, r := lc, rc
+		if la != nil {
+			if la.IsNull(i) {
+				b.AppendNull()
+				continue
+			}
+			l = la.Value(i)
+		}
+		if ra != nil {
+			if ra.IsNull(i) {
+				b.AppendNull()
+				continue
+			}
+			r = ra.Value(i)
+		}
+		b.Append(l == r)
+	}
+	return vector{arr: b.NewArray()}, nil
+}
+
+func vectorUIntNotEqual(lv, rv vector, n int, mem memory.Allocator) (vector, error) {
+	la, ra, lc, rc := vectorUIntOperands(lv, rv)
+	b := array.NewBooleanBuilder(mem)
+	b.Resize(n)
+	for i := 0; i < n; i++ {
+		l, r := lc, rc
+		if la != nil {
+			if la.IsNull(i) {
+				b.AppendNull()
+				continue
+			}
+			l = la.Value(i)
+		}
+		if ra != nil {
+			if ra.IsNull(i) {
+				b.AppendNull()
+				continue
+			}
+			r = ra.Value(i)
+		}
+		b.Append(l != r)
+	}
+	return vector{arr: b.NewArray()}, nil
+}
+
+func vectorUIntSelect(test *array.Boolean, cv, av vector, n int, mem memory.Allocator) vector {
+	ca, aa, cc, ac := vectorUIntOperands(cv, av)
+	b := array.NewUint64Builder(mem)
+	b.Resize(n)
+	for i := 0; i < n; i++ {
+		var (
+			arr   = aa
+			v     = ac
+			valid = av.arr != nil || !av.val.IsNull()
+		)
+		if test.IsValid(i) && test.Value(i) {
+			arr, v, valid = ca, cc, cv.arr != nil || !cv.val.IsNull()
+		}
+		if arr != nil {
+			if arr.IsNull(i) {
+				b.AppendNull()
+				continue
+			}
+			v = arr.Value(i)
+		} else if !valid {
+			b.AppendNull()
+			continue
+		}
+		b.Append(v)
+	}
+	return vector{arr: b.NewArray()}
+}
+
+// vectorFloatOperands returns the array or the value of each operand.
+func vectorFloatOperands(l, r vector) (la, ra *array.Float64, lc, rc float64) {
+	if l.arr != nil {
+		la = l.arr.(*array.Float64)
+	} else if !l.val.IsNull() {
+		lc = l.val.Float()
+	}
+	if r.arr != nil {
+		ra = r.arr.(*array.Float64)
+	} else if !r.val.IsNull() {
+		rc = r.val.Float()
+	}
+	return la, ra, lc, rc
+}
+
+func vectorFloatAddition(lv, rv vector, n int, mem memory.Allocator) (vector, error) {
+	la, ra, lc, rc := vectorFloatOperands(lv, rv)
+	b := array.NewFloat64Builder(mem)
+	b.Resize(n)
+	for i := 0; i < n; i++ {
+		l, r := lc, rc
+		if la != nil {
+			if la.IsNull(i) {
+				b.AppendNull()
+				continue
+			}
+			l = la.Value(i)
+		}
+		if ra != nil {
+			if ra.IsNull(i) {
+				b.AppendNull()
+				continue
+			}
+			r = ra.Value(i)
+		}
+
+		b.Append(l + r)
+	}
+	return vector{arr: b.NewArray()}, nil
+}
+
+func vectorFloatSubtraction(lv, rv vector, n int, mem memory.Allocator) (vector, error) {
+	la, ra, lc, rc := vectorFloatOperands(lv, rv)
+	b := array.NewFloat64Builder(mem)
+	b.Resize(n)
+	for i := 0; i < n; i++ {
+		l, r := lc, rc
+		if la != nil {
+			if la.IsNull(i) {
+				b.AppendNull()
+				continue
+			}
+			l = la.Value(i)
+		}
+		if ra != nil {
+			if ra.IsNull(i) {
+				b.AppendNull()
+				continue
+			}
+			r = ra.Value(i)
+		}
+
+		b.Append(l - r)
+	}
+	return vector{arr: b.NewArray()}, nil
+}
+
+func vectorFloatMultiplication(lv, rv vector, n int, mem memory.Allocator) (vector, error) {
+	la, ra, lc, rc := vectorFloatOperands(lv, rv)
+	b := array.NewFloat64Builder(mem)
+	b.Resize(n)
+	for i := 0; i < n; i++ {
+		l, r := lc, rc
+		if la != nil {
+			if la.IsNull(i) {
+				b.AppendNull()
+				continue
+			}
+			l = la.Value(i)
+		}
+		if ra != nil {
+			if ra.IsNull(i) {
+				b.AppendNull()
+				continue
+			}
+			r = ra.Value(i)
+		}
+
+		b.Append(l * r)
+	}
+	return vector{arr: b.NewArray()}, nil
+}
+
+func vectorFloatDivision(lv, rv vector, n int, mem memory.Allocator) (vector, error) {
+	la, ra, lc, rc := vectorFloatOperands(lv, rv)
+	b := array.NewFloat64Builder(mem)
+	b.Resize(n)
+	for i := 0; i < n; i++ {
+		l, r := lc, rc
+		if la != nil {
+			if la.IsNull(i) {
+				b.AppendNull()
+				continue
+			}
+			l = la.Value(i)
+		}
+		if ra != nil {
+			if ra.IsNull(i) {
+				b.AppendNull()
+				continue
+			}
+			r = ra.Value(i)
+		}
+
+		if r == 0 {
+			b.Release()
+			return vector{}, errors.New(codes.FailedPrecondition, "cannot divide by zero")
+		}
+
+		b.Append(l / r)
+	}
+	return vector{arr: b.NewArray()}, nil
+}
+
+func vectorFloatModulo(lv, rv vector, n int, mem memory.Allocator) (vector, error) {
+	la, ra, lc, rc := vectorFloatOperands(lv, rv)
+	b := array.NewFloat64Builder(mem)
+	b.Resize(n)
+	for i := 0; i < n; i++ {
+		l, r := lc, rc
+		if la != nil {
+			if la.IsNull(i) {
+				b.AppendNull()
+				continue
+			}
+			l = la.Value(i)
+		}
+		if ra != nil {
+			if ra.IsNull(i) {
+				b.AppendNull()
+				continue
+			}
+			r = ra.Value(i)
+		}
+
+		if r == 0 {
+			b.Release()
+			return vector{}, errors.New(codes.FailedPrecondition, "cannot mod zero")
+		}
+
+		b.Append(math.Mod(l, r))
+	}
+	return vector{arr: b.NewArray()}, nil
+}
+
+func vectorFloatLessThan(lv, rv vector, n int, mem memory.Allocator) (vector, error) {
+	la, ra, lc, rc := vectorFloatOperands(lv, rv)
+	b := array.NewBooleanBuilder(mem)
+	b.Resize(n)
+	for i := 0; i < n; i++ {
+		l, r := lc, rc
+		if la != nil {
+			if la.IsNull(i) {
+				b.AppendNull()
+				continue
+			}
+			l = la.Value(i)
+		}
+		if ra != nil {
+			if ra.IsNull(i) {
+				b.AppendNull()
+				continue
+			}
+			r = ra.Value(i)
+		}
+		b.Append(l < r)
+	}
+	return vector{arr: b.NewArray()}, nil
+}
+
+func vectorFloatLessThanEqual(lv, rv vector, n int, mem memory.Allocator) (vector, error) {
+	la, ra, lc, rc := vectorFloatOperands(lv, rv)
+	b := array.NewBooleanBuilder(mem)
+	b.Resize(n)
+	for i := 0; i < n; i++ {
+		l, r := lc, rc
+		if la != nil {
+			if la.IsNull(i) {
+				b.AppendNull()
+				continue
+			}
+			l = la.Value(i)
+		}
+		if ra != nil {
+			if ra.IsNull(i) {
+				b.AppendNull()
+				continue
+			}
+			r = ra.Value(i)
+		}
+		b.Append(l <= r)
+	}
+	return vector{arr: b.NewArray()}, nil
+}
+
+func vectorFloatGreaterThan(lv, rv vector, n int, mem memory.Allocator) (vector, error) {
+	la, ra, lc, rc := vectorFloatOperands(lv, rv)
+	b := array.NewBooleanBuilder(mem)
+	b.Resize(n)
+	for i := 0; i < n; i++ {
+		l, r := lc, rc
+		if la != nil {
+			if la.IsNull(i) {
+				b.AppendNull()
+				continue
+			}
+			l = la.Value(i)
+		}
+		if ra != nil {
+			if ra.IsNull(i) {
+				b.AppendNull()
+				continue
+			}
+			r = ra.Value(i)
+		}
+		b.Append(l > r)
+	}
+	return vector{arr: b.NewArray()}, nil
+}
+
+func vectorFloatGreaterThanEqual(lv, rv vector, n int, mem memory.Allocator) (vector, error) {
+	la, ra, lc, rc := vectorFloatOperands(lv, rv)
+	b := array.NewBooleanBuilder(mem)
+	b.Resize(n)
+	for i := 0; i < n; i++ {
+		l, r := lc, rc
+		if la != nil {
+			if la.IsNull(i) {
+				b.AppendNull()
+				continue
+			}
+			l = la.Value(i)
+		}
+		if ra != nil {
+			if ra.IsNull(i) {
+				b.AppendNull()
+				continue
+			}
+			r = ra.Value(i)
+		}
+		b.Append(l >= r)
+	}
+	return vector{arr: b.NewArray()}, nil
+}
+
+func vectorFloatEqual(lv, rv vector, n int, mem memory.Allocator) (vector, error) {
+	la, ra, lc, rc := vectorFloatOperands(lv, rv)
+	b := array.NewBooleanBuilder(mem)
+	b.Resize(n)
+	for i := 0; i < n; i++ {
+		l, r := lc, rc
+		if la != nil {
+			if la.IsNull(i) {
+				b.AppendNull()
+				continue
+			}
+			l = la.Value(i)
+		}
+		if ra != nil {
+			if ra.IsNull(i) {
+				b.AppendNull()
+				continue
+			}
+			r = ra.Value(i)
+		}
+		b.Append(l == r)
+	}
+	return vector{arr: b.NewArray()}, nil
+}
+
+func vectorFloatNotEqual(lv, rv vector, n int, mem memory.Allocator) (vector, error) {
+	la, ra, lc, rc := vectorFloatOperands(lv, rv)
+	b := array.NewBooleanBuilder(mem)
+	b.Resize(n)
+	for i := 0; i < n; i++ {
+		l, r := lc, rc
+		if la != nil {
+			if la.IsNull(i) {
+				b.AppendNull()
+				continue
+			}
+			l = la.Value(i)
+		}
+		if ra != nil {
+			if ra.IsNull(i) {
+				b.AppendNull()
+				continue
+			}
+			r = ra.Value(i)
+		}
+		b.Append(l != r)
+	}
+	return vector{arr: b.NewArray()}, nil
+}
+
+func vectorFloatSelect(test *array.Boolean, cv, av vector, n int, mem memory.Allocator) vector {
+	ca, aa, cc, ac := vectorFloatOperands(cv, av)
+	b := array.NewFloat64Builder(mem)
+	b.Resize(n)
+	for i := 0; i < n; i++ {
+		var (
+			arr   = aa
+			v     = ac
+			valid = av.arr != nil || !av.val.IsNull()
+		)
+		if test.IsValid(i) && test.Value(i) {
+			arr, v, valid = ca, cc, cv.arr != nil || !cv.val.IsNull()
+		}
+		if arr != nil {
+			if arr.IsNull(i) {
+				b.AppendNull()
+				continue
+			}
+			v = arr.Value(i)
+		} else if !valid {
+			b.AppendNull()
+			continue
+		}
+		b.Append(v)
+	}
+	return vector{arr: b.NewArray()}
+}
+
+// vectorStringOperands returns the array or the value of each operand.
+func vectorStringOperands(l, r vector) (la, ra *array.Binary, lc, rc string) {
+	if l.arr != nil {
+		la = l.arr.(*array.Binary)
+	} else if !l.val.IsNull() {
+		lc = l.val.Str()
+	}
+	if r.arr != nil {
+		ra = r.arr.(*array.Binary)
+	} else if !r.val.IsNull() {
+		rc = r.val.Str()
+	}
+	return la, ra, lc, rc
+}
+
+func vectorStringAddition(lv, rv vector, n int, mem memory.Allocator) (vector, error) {
+	la, ra, lc, rc := vectorStringOperands(lv, rv)
+	b := array.NewBinaryBuilder(mem, arrow.BinaryTypes.String)
+	b.Resize(n)
+	for i := 0; i < n; i++ {
+		l, r := lc, rc
+		if la != nil {
+			if la.IsNull(i) {
+				b.AppendNull()
+				continue
+			}
+			l = la.ValueString(i)
+		}
+		if ra != nil {
+			if ra.IsNull(i) {
+				b.AppendNull()
+				continue
+			}
+			r = ra.ValueString(i)
+		}
+
+		b.AppendString(l + r)
+	}
+	return vector{arr: b.NewArray()}, nil
+}
+
+func vectorStringLessThan(lv, rv vector, n int, mem memory.Allocator) (vector, error) {
+	la, ra, lc, rc := vectorStringOperands(lv, rv)
+	b := array.NewBooleanBuilder(mem)
+	b.Resize(n)
+	for i := 0; i < n; i++ {
+		l, r := lc, rc
+		if la != nil {
+			if la.IsNull(i) {
+				b.AppendNull()
+				continue
+			}
+			l = la.ValueString(i)
+		}
+		if ra != nil {
+			if ra.IsNull(i) {
+				b.AppendNull()
+				continue
+			}
+			r = ra.ValueString(i)
+		}
+		b.Append(l < r)
+	}
+	return vector{arr: b.NewArray()}, nil
+}
+
+func vectorStringLessThanEqual(lv, rv vector, n int, mem memory.Allocator) (vector, error) {
+	la, ra, lc, rc := vectorStringOperands(lv, rv)
+	b := array.NewBooleanBuilder(mem)
+	b.Resize(n)
+	for i := 0; i < n; i++ {
+		l, r := lc, rc
+		if la != nil {
+			if la.IsNull(i) {
+				b.AppendNull()
+				continue
+			}
+			l = la.ValueString(i)
+		}
+		if ra != nil {
+			if ra.IsNull(i) {
+				b.AppendNull()
+				continue
+			}
+			r = ra.ValueString(i)
+		}
+		b.Append(l <= r)
+	}
+	return vector{arr: b.NewArray()}, nil
+}
+
+func vectorStringGreaterThan(lv, rv vector, n int, mem memory.Allocator) (vector, error) {
+	la, ra, lc, rc := vectorStringOperands(lv, rv)
+	b := array.NewBooleanBuilder(mem)
+	b.Resize(n)
+	for i := 0; i < n; i++ {
+		l, r := lc, rc
+		if la != nil {
+			if la.IsNull(i) {
+				b.AppendNull()
+				continue
+			}
+			l = la.ValueString(i)
+		}
+		if ra != nil {
+			if ra.IsNull(i) {
+				b.AppendNull()
+				continue
+			}
+			r = ra.ValueString(i)
+		}
+		b.Append(l > r)
+	}
+	return vector{arr: b.NewArray()}, nil
+}
+
+func vectorStringGreaterThanEqual(lv, rv vector, n int, mem memory.Allocator) (vector, error) {
+	la, ra, lc, rc := vectorStringOperands(lv, rv)
+	b := array.NewBooleanBuilder(mem)
+	b.Resize(n)
+	for i := 0; i < n; i++ {
+		l, r := lc, rc
+		if la != nil {
+			if la.IsNull(i) {
+				b.AppendNull()
+				continue
+			}
+			l = la.ValueString(i)
+		}
+		if ra != nil {
+			if ra.IsNull(i) {
+				b.AppendNull()
+				continue
+			}
+			r = ra.ValueString(i)
+		}
+		b.Append(l >= r)
+	}
+	return vector{arr: b.NewArray()}, nil
+}
+
+func vectorStringEqual(lv, rv vector, n int, mem memory.Allocator) (vector, error) {
+	la, ra, lc, rc := vectorStringOperands(lv, rv)
+	b := array.NewBooleanBuilder(mem)
+	b.Resize(n)
+	for i := 0; i < n; i++ {
+		l, r := lc, rc
+		if la != nil {
+			if la.IsNull(i) {
+				b.AppendNull()
+				continue
+			}
+			l = la.ValueString(i)
+		}
+		if ra != nil {
+			if ra.IsNull(i) {
+				b.AppendNull()
+				continue
+			}
+			r = ra.ValueString(i)
+		}
+		b.Append(l == r)
+	}
+	return vector{arr: b.NewArray()}, nil
+}
+
+func vectorStringNotEqual(lv, rv vector, n int, mem memory.Allocator) (vector, error) {
+	la, ra, lc, rc := vectorStringOperands(lv, rv)
+	b := array.NewBooleanBuilder(mem)
+	b.Resize(n)
+	for i := 0; i < n; i++ {
+		l, r := lc, rc
+		if la != nil {
+			if la.IsNull(i) {
+				b.AppendNull()
+				continue
+			}
+			l = la.ValueString(i)
+		}
+		if ra != nil {
+			if ra.IsNull(i) {
+				b.AppendNull()
+				continue
+			}
+			r = ra.ValueString(i)
+		}
+		b.Append(l != r)
+	}
+	return vector{arr: b.NewArray()}, nil
+}
+
+func vectorStringSelect(test *array.Boolean, cv, av vector, n int, mem memory.Allocator) vector {
+	ca, aa, cc, ac := vectorStringOperands(cv, av)
+	b := array.NewBinaryBuilder(mem, arrow.BinaryTypes.String)
+	b.Resize(n)
+	for i := 0; i < n; i++ {
+		var (
+			arr   = aa
+			v     = ac
+			valid = av.arr != nil || !av.val.IsNull()
+		)
+		if test.IsValid(i) && test.Value(i) {
+			arr, v, valid = ca, cc, cv.arr != nil || !cv.val.IsNull()
+		}
+		if arr != nil {
+			if arr.IsNull(i) {
+				b.AppendNull()
+				continue
+			}
+			v = arr.ValueString(i)
+		} else if !valid {
+			b.AppendNull()
+			continue
+		}
+		b.AppendString(v)
+	}
+	return vector{arr: b.NewArray()}
+}
+
+// vectorTimeOperands returns the array or the value of each operand.
+func vectorTimeOperands(l, r vector) (la, ra *array.Int64, lc, rc int64) {
+	if l.arr != nil {
+		la = l.arr.(*array.Int64)
+	} else if !l.val.IsNull() {
+		lc = int64(l.val.Time())
+	}
+	if r.arr != nil {
+		ra = r.arr.(*array.Int64)
+	} else if !r.val.IsNull() {
+		rc = int64(r.val.Time())
+	}
+	return la, ra, lc, rc
+}
+
+func vectorTimeLessThan(lv, rv vector, n int, mem memory.Allocator) (vector, error) {
+	la, ra, lc, rc := vectorTimeOperands(lv, rv)
+	b := array.NewBooleanBuilder(mem)
+	b.Resize(n)
+	for i := 0; i < n; i++ {
+		l, r := lc, rc
+		if la != nil {
+			if la.IsNull(i) {
+				b.AppendNull()
+				continue
+			}
+			l = la.Value(i)
+		}
+		if ra != nil {
+			if ra.IsNull(i) {
+				b.AppendNull()
+				continue
+			}
+			r = ra.Value(i)
+		}
+		b.Append(l < r)
+	}
+	return vector{arr: b.NewArray()}, nil
+}
+
+func vectorTimeLessThanEqual(lv, rv vector, n int, mem memory.Allocator) (vector, error) {
+	la, ra, lc, rc := vectorTimeOperands(lv, rv)
+	b := array.NewBooleanBuilder(mem)
+	b.Resize(n)
+	for i := 0; i < n; i++ {
+		l, r := lc, rc
+		if la != nil {
+			if la.IsNull(i) {
+				b.AppendNull()
+				continue
+			}
+			l = la.Value(i)
+		}
+		if ra != nil {
+			if ra.IsNull(i) {
+				b.AppendNull()
+				continue
+			}
+			r = ra.Value(i)
+		}
+		b.Append(l <= r)
+	}
+	return vector{arr: b.NewArray()}, nil
+}
+
+func vectorTimeGreaterThan(lv, rv vector, n int, mem memory.Allocator) (vector, error) {
+	la, ra, lc, rc := vectorTimeOperands(lv, rv)
+	b := array.NewBooleanBuilder(mem)
+	b.Resize(n)
+	for i := 0; i < n; i++ {
+		l, r := lc, rc
+		if la != nil {
+			if la.IsNull(i) {
+				b.AppendNull()
+				continue
+			}
+			l = la.Value(i)
+		}
+		if ra != nil {
+			if ra.IsNull(i) {
+				b.AppendNull()
+				continue
+			}
+			r = ra.Value(i)
+		}
+		b.Append(l > r)
+	}
+	return vector{arr: b.NewArray()}, nil
+}
+
+func vectorTimeGreaterThanEqual(lv, rv vector, n int, mem memory.Allocator) (vector, error) {
+	la, ra, lc, rc := vectorTimeOperands(lv, rv)
+	b := array.NewBooleanBuilder(mem)
+	b.Resize(n)
+	for i := 0; i < n; i++ {
+		l, r := lc, rc
+		if la != nil {
+			if la.IsNull(i) {
+				b.AppendNull()
+				continue
+			}
+			l = la.Value(i)
+		}
+		if ra != nil {
+			if ra.IsNull(i) {
+				b.AppendNull()
+				continue
+			}
+			r = ra.Value(i)
+		}
+		b.Append(l >= r)
+	}
+	return vector{arr: b.NewArray()}, nil
+}
+
+func vectorTimeEqual(lv, rv vector, n int, mem memory.Allocator) (vector, error) {
+	la, ra, lc, rc := vectorTimeOperands(lv, rv)
+	b := array.NewBooleanBuilder(mem)
+	b.Resize(n)
+	for i := 0; i < n; i++ {
+		l, r := lc, rc
+		if la != nil {
+			if la.IsNull(i) {
+				b.AppendNull()
+				continue
+			}
+			l = la.Value(i)
+		}
+		if ra != nil {
+			if ra.IsNull(i) {
+				b.AppendNull()
+				continue
+			}
+			r = ra.Value(i)
+		}
+		b.Append(l == r)
+	}
+	return vector{arr: b.NewArray()}, nil
+}
+
+func vectorTimeNotEqual(lv, rv vector, n int, mem memory.Allocator) (vector, error) {
+	la, ra, lc, rc := vectorTimeOperands(lv, rv)
+	b := array.NewBooleanBuilder(mem)
+	b.Resize(n)
+	for i := 0; i < n; i++ {
+		l, r := lc, rc
+		if la != nil {
+			if la.IsNull(i) {
+				b.AppendNull()
+				continue
+			}
+			l = la.Value(i)
+		}
+		if ra != nil {
+			if ra.IsNull(i) {
+				b.AppendNull()
+				continue
+			}
+			r = ra.Value(i)
+		}
+		b.Append(l != r)
+	}
+	return vector{arr: b.NewArray()}, nil
+}
+
+func vectorTimeSelect(test *array.Boolean, cv, av vector, n int, mem memory.Allocator) vector {
+	ca, aa, cc, ac := vectorTimeOperands(cv, av)
+	b := array.NewInt64Builder(mem)
+	b.Resize(n)
+	for i := 0; i < n; i++ {
+		var (
+			arr   = aa
+			v     = ac
+			valid = av.arr != nil || !av.val.IsNull()
+		)
+		if test.IsValid(i) && test.Value(i) {
+			arr, v, valid = ca, cc, cv.arr != nil || !cv.val.IsNull()
+		}
+		if arr != nil {
+			if arr.IsNull(i) {
+				b.AppendNull()
+				continue
+			}
+			v = arr.Value(i)
+		} else if !valid {
+			b.AppendNull()
+			continue
+		}
+		b.Append(v)
+	}
+	return vector{arr: b.NewArray()}
+}
+
+// vectorBoolOperands returns the array or the value of each operand.
+func vectorBoolOperands(l, r vector) (la, ra *array.Boolean, lc, rc bool) {
+	if l.arr != nil {
+		la = l.arr.(*array.Boolean)
+	} else if !l.val.IsNull() {
+		lc = l.val.Bool()
+	}
+	if r.arr != nil {
+		ra = r.arr.(*array.Boolean)
+	} else if !r.val.IsNull() {
+		rc = r.val.Bool()
+	}
+	return la, ra, lc, rc
+}
+
+func vectorBoolEqual(lv, rv vector, n int, mem memory.Allocator) (vector, error) {
+	la, ra, lc, rc := vectorBoolOperands(lv, rv)
+	b := array.NewBooleanBuilder(mem)
+	b.Resize(n)
+	for i := 0; i < n; i++ {
+		l, r := lc, rc
+		if la != nil {
+			if la.IsNull(i) {
+				b.AppendNull()
+				continue
+			}
+			l = la.Value(i)
+		}
+		if ra != nil {
+			if ra.IsNull(i) {
+				b.AppendNull()
+				continue
+			}
+			r = ra.Value(i)
+		}
+		b.Append(l == r)
+	}
+	return vector{arr: b.NewArray()}, nil
+}
+
+func vectorBoolNotEqual(lv, rv vector, n int, mem memory.Allocator) (vector, error) {
+	la, ra, lc, rc := vectorBoolOperands(lv, rv)
+	b := array.NewBooleanBuilder(mem)
+	b.Resize(n)
+	for i := 0; i < n; i++ {
+		l, r := lc, rc
+		if la != nil {
+			if la.IsNull(i) {
+				b.AppendNull()
+				continue
+			}
+			l = la.Value(i)
+		}
+		if ra != nil {
+			if ra.IsNull(i) {
+				b.AppendNull()
+				continue
+			}
+			r = ra.Value(i)
+		}
+		b.Append(l != r)
+	}
+	return vector{arr: b.NewArray()}, nil
+}
+
+func vectorBoolSelect(test *array.Boolean, cv, av vector, n int, mem memory.Allocator) vector {
+	ca, aa, cc, ac := vectorBoolOperands(cv, av)
+	b := array.NewBooleanBuilder(mem)
+	b.Resize(n)
+	for i := 0; i < n; i++ {
+		var (
+			arr   = aa
+			v     = ac
+			valid = av.arr != nil || !av.val.IsNull()
+		)
+		if test.IsValid(i) && test.Value(i) {
+			arr, v, valid = ca, cc, cv.arr != nil || !cv.val.IsNull()
+		}
+		if arr != nil {
+			if arr.IsNull(i) {
+				b.AppendNull()
+				continue
+			}
+			v = arr.Value(i)
+		} else if !valid {
+			b.AppendNull()
+			continue
+		}
+		b.Append(v)
+	}
+	return vector{arr: b.NewArray()}
+}
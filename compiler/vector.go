@@ -0,0 +1,834 @@
+package compiler
+
+import (
+	"context"
+	"sort"
+
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/memory"
+	"github.com/influxdata/flux"
+	fluxarrow "github.com/influxdata/flux/arrow"
+	"github.com/influxdata/flux/ast"
+	"github.com/influxdata/flux/codes"
+	"github.com/influxdata/flux/internal/errors"
+	"github.com/influxdata/flux/semantic"
+	"github.com/influxdata/flux/values"
+)
+
+// VectorFunc is a function that is evaluated for all of the rows
+// of a table at once instead of one row at a time.
+// The record parameter of the function is bound to the columns
+// of the table and every expression is evaluated over whole arrays.
+type VectorFunc interface {
+	// Type returns the type of the value returned by the function.
+	Type() semantic.Type
+
+	// Eval evaluates a function that does not return a record
+	// and returns an array with the value for each row.
+	// The caller must release the array.
+	//
+	// An error is returned if any row cannot be evaluated, such as
+	// for a division by zero. Evaluating the rows one at a time with
+	// the Func that was compiled from the same function returns the
+	// error for the row that caused it.
+	Eval(ctx context.Context, cr flux.ColReader, mem memory.Allocator) (array.Interface, error)
+
+	// EvalRecord evaluates a function that returns a record and
+	// returns the columns of the record sorted by label.
+	// The caller must release the arrays.
+	// Errors are returned in the same way as Eval.
+	EvalRecord(ctx context.Context, cr flux.ColReader, mem memory.Allocator) ([]flux.ColMeta, []array.Interface, error)
+}
+
+// CompileVector compiles a function whose only parameter is a record
+// into a VectorFunc. The function may use arithmetic, comparison,
+// logical and regular expression operators, conditional expressions
+// and the properties of the record. A function that returns a record
+// must return an object expression which may extend the record parameter.
+//
+// An error with codes.Unimplemented is returned for any other function.
+// Such functions must be evaluated one row at a time with Compile.
+func CompileVector(scope Scope, f *semantic.FunctionExpression, in semantic.Type) (VectorFunc, error) {
+	if scope == nil {
+		scope = NewScope()
+	}
+	if f.Block.Parameters == nil || len(f.Block.Parameters.List) != 1 {
+		return nil, errVectorUnsupported
+	}
+	typeSol, fnType, err := inferTypes(scope, f, in)
+	if err != nil {
+		return nil, err
+	}
+	vc := &vectorCompiler{
+		typeSol:    typeSol,
+		scope:      scope,
+		recordName: f.Block.Parameters.List[0].Key.Name,
+	}
+
+	body := f.Block.Body
+	if block, ok := body.(*semantic.Block); ok {
+		if len(block.Body) != 1 {
+			return nil, errVectorUnsupported
+		}
+		body = block.ReturnStatement().Argument
+	}
+
+	fn := &vectorFn{t: fnType.FunctionSignature().Return}
+	if obj, ok := body.(*semantic.ObjectExpression); ok {
+		fn.record, err = vc.compileRecord(obj)
+	} else {
+		fn.root, err = vc.compile(body)
+		if err == nil && !isColumnNature(fn.root.Type().Nature()) {
+			err = errVectorUnsupported
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return fn, nil
+}
+
+var errVectorUnsupported = errors.New(codes.Unimplemented, "function cannot be vectorized")
+
+// vector is the value of an expression for every row of a table.
+// It holds an array when the value differs between rows and
+// a single value when it is the same for every row.
+type vector struct {
+	arr array.Interface
+	val values.Value
+}
+
+func (v vector) Release() {
+	if v.arr != nil {
+		v.arr.Release()
+	}
+}
+
+// isNull reports whether the value of the vector is null for every row.
+func (v vector) isNull() bool {
+	return v.arr == nil && v.val.IsNull()
+}
+
+// bool returns the value of a boolean vector for a row
+// and whether that value is not null.
+func (v vector) bool(i int) (value, valid bool) {
+	if v.arr == nil {
+		if v.val.IsNull() {
+			return false, false
+		}
+		return v.val.Bool(), true
+	}
+	b := v.arr.(*array.Boolean)
+	if b.IsNull(i) {
+		return false, false
+	}
+	return b.Value(i), true
+}
+
+// array returns an array with the value of the vector for each of the n rows.
+func (v vector) array(typ semantic.Nature, n int, mem memory.Allocator) array.Interface {
+	if v.arr != nil {
+		v.arr.Retain()
+		return v.arr
+	}
+	b := fluxarrow.NewBuilder(colType(typ), mem)
+	defer b.Release()
+	b.Reserve(n)
+	for i := 0; i < n; i++ {
+		if v.val.IsNull() {
+			b.AppendNull()
+			continue
+		}
+		_ = fluxarrow.AppendValue(b, v.val)
+	}
+	return b.NewArray()
+}
+
+func colType(n semantic.Nature) flux.ColType {
+	switch n {
+	case semantic.Int:
+		return flux.TInt
+	case semantic.UInt:
+		return flux.TUInt
+	case semantic.Float:
+		return flux.TFloat
+	case semantic.String:
+		return flux.TString
+	case semantic.Bool:
+		return flux.TBool
+	case semantic.Time:
+		return flux.TTime
+	default:
+		return flux.TInvalid
+	}
+}
+
+// isColumnNature reports whether values of the type can be stored in a column.
+func isColumnNature(n semantic.Nature) bool {
+	return colType(n) != flux.TInvalid
+}
+
+type vectorEnv struct {
+	cr  flux.ColReader
+	n   int
+	mem memory.Allocator
+}
+
+type vectorEvaluator interface {
+	Type() semantic.Type
+	Eval(ctx context.Context, env *vectorEnv) (vector, error)
+}
+
+type vectorBinarySignature struct {
+	Operator ast.OperatorKind
+	Nature   semantic.Nature
+}
+
+// vectorBinaryFunc evaluates a binary operator where at least
+// one of the operands is an array and neither operand is null
+// for every row.
+type vectorBinaryFunc func(l, r vector, n int, mem memory.Allocator) (vector, error)
+
+// vectorSelectFunc chooses the value of the consequent for the rows where
+// the test is true and the value of the alternate for the other rows.
+type vectorSelectFunc func(test *array.Boolean, c, a vector, n int, mem memory.Allocator) vector
+
+type vectorCompiler struct {
+	typeSol    semantic.TypeSolution
+	scope      Scope
+	recordName string
+}
+
+func (vc *vectorCompiler) typeOf(n semantic.Node) (semantic.Type, error) {
+	t, err := vc.typeSol.TypeOf(n)
+	if err != nil || t == nil {
+		return nil, errVectorUnsupported
+	}
+	return t, nil
+}
+
+func (vc *vectorCompiler) compileRecord(obj *semantic.ObjectExpression) (*vectorRecord, error) {
+	r := &vectorRecord{
+		properties: make(map[string]vectorEvaluator, len(obj.Properties)),
+	}
+	if obj.With != nil {
+		if obj.With.Name != vc.recordName {
+			return nil, errVectorUnsupported
+		}
+		r.with = true
+	}
+	for _, p := range obj.Properties {
+		e, err := vc.compile(p.Value)
+		if err != nil {
+			return nil, err
+		}
+		if !isColumnNature(e.Type().Nature()) {
+			return nil, errVectorUnsupported
+		}
+		r.properties[p.Key.Key()] = e
+	}
+	return r, nil
+}
+
+func (vc *vectorCompiler) compile(n semantic.Node) (vectorEvaluator, error) {
+	switch n := n.(type) {
+	case *semantic.BooleanLiteral:
+		return &vectorValue{v: values.NewBool(n.Value)}, nil
+	case *semantic.IntegerLiteral:
+		return &vectorValue{v: values.NewInt(n.Value)}, nil
+	case *semantic.UnsignedIntegerLiteral:
+		return &vectorValue{v: values.NewUInt(n.Value)}, nil
+	case *semantic.FloatLiteral:
+		return &vectorValue{v: values.NewFloat(n.Value)}, nil
+	case *semantic.StringLiteral:
+		return &vectorValue{v: values.NewString(n.Value)}, nil
+	case *semantic.RegexpLiteral:
+		return &vectorValue{v: values.NewRegexp(n.Value)}, nil
+	case *semantic.DateTimeLiteral:
+		return &vectorValue{v: values.NewTime(values.ConvertTime(n.Value))}, nil
+	case *semantic.IdentifierExpression:
+		if n.Name == vc.recordName {
+			return nil, errVectorUnsupported
+		}
+		v, ok := vc.scope.Lookup(n.Name)
+		if !ok {
+			return nil, errVectorUnsupported
+		}
+		if nature := v.Type().Nature(); !isColumnNature(nature) && nature != semantic.Regexp {
+			return nil, errVectorUnsupported
+		}
+		return &vectorValue{v: v}, nil
+	case *semantic.MemberExpression:
+		obj, ok := n.Object.(*semantic.IdentifierExpression)
+		if !ok || obj.Name != vc.recordName {
+			return nil, errVectorUnsupported
+		}
+		t, err := vc.typeOf(n)
+		if err != nil {
+			return nil, err
+		}
+		if !isColumnNature(t.Nature()) {
+			return nil, errVectorUnsupported
+		}
+		return &vectorColumn{t: t, label: n.Property}, nil
+	case *semantic.BinaryExpression:
+		return vc.compileBinary(n)
+	case *semantic.LogicalExpression:
+		l, err := vc.compile(n.Left)
+		if err != nil {
+			return nil, err
+		}
+		r, err := vc.compile(n.Right)
+		if err != nil {
+			return nil, err
+		}
+		if l.Type() != semantic.Bool || r.Type() != semantic.Bool {
+			return nil, errVectorUnsupported
+		}
+		return &vectorLogical{operator: n.Operator, left: l, right: r}, nil
+	case *semantic.UnaryExpression:
+		arg, err := vc.compile(n.Argument)
+		if err != nil {
+			return nil, err
+		}
+		switch {
+		case n.Operator == ast.ExistsOperator:
+		case n.Operator == ast.NotOperator && arg.Type() == semantic.Bool:
+		case n.Operator == ast.AdditionOperator && isColumnNature(arg.Type().Nature()):
+			return arg, nil
+		case n.Operator == ast.SubtractionOperator && (arg.Type() == semantic.Int || arg.Type() == semantic.Float):
+		default:
+			return nil, errVectorUnsupported
+		}
+		return &vectorUnary{operator: n.Operator, arg: arg}, nil
+	case *semantic.ConditionalExpression:
+		test, err := vc.compile(n.Test)
+		if err != nil {
+			return nil, err
+		}
+		c, err := vc.compile(n.Consequent)
+		if err != nil {
+			return nil, err
+		}
+		a, err := vc.compile(n.Alternate)
+		if err != nil {
+			return nil, err
+		}
+		sel, ok := vectorSelectFuncs[c.Type().Nature()]
+		if !ok || test.Type() != semantic.Bool || a.Type() != c.Type() {
+			return nil, errVectorUnsupported
+		}
+		return &vectorConditional{test: test, consequent: c, alternate: a, sel: sel}, nil
+	default:
+		return nil, errVectorUnsupported
+	}
+}
+
+func (vc *vectorCompiler) compileBinary(n *semantic.BinaryExpression) (vectorEvaluator, error) {
+	l, err := vc.compile(n.Left)
+	if err != nil {
+		return nil, err
+	}
+	r, err := vc.compile(n.Right)
+	if err != nil {
+		return nil, err
+	}
+	t, err := vc.typeOf(n)
+	if err != nil {
+		return nil, err
+	}
+	lt, rt := l.Type().Nature(), r.Type().Nature()
+
+	switch n.Operator {
+	case ast.RegexpMatchOperator, ast.NotRegexpMatchOperator:
+		re, ok := r.(*vectorValue)
+		if !ok || lt != semantic.String || rt != semantic.Regexp {
+			return nil, errVectorUnsupported
+		}
+		return &vectorRegexp{
+			not:   n.Operator == ast.NotRegexpMatchOperator,
+			left:  l,
+			regex: re.v,
+		}, nil
+	}
+
+	// Comparing an integer literal with a float column is common,
+	// such as r._value > 0, so the literal is converted to a float.
+	if isComparison(n.Operator) {
+		if lt == semantic.Float {
+			r, rt = floatLiteral(r, rt)
+		} else if rt == semantic.Float {
+			l, lt = floatLiteral(l, lt)
+		}
+	}
+	if lt != rt {
+		return nil, errVectorUnsupported
+	}
+	f, ok := vectorBinaryFuncs[vectorBinarySignature{Operator: n.Operator, Nature: lt}]
+	if !ok {
+		return nil, errVectorUnsupported
+	}
+	scalar, err := values.LookupBinaryFunction(values.BinaryFuncSignature{
+		Operator: n.Operator,
+		Left:     lt,
+		Right:    rt,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &vectorBinary{
+		t:      t,
+		left:   l,
+		right:  r,
+		f:      f,
+		scalar: scalar,
+	}, nil
+}
+
+func isComparison(op ast.OperatorKind) bool {
+	switch op {
+	case ast.LessThanOperator, ast.LessThanEqualOperator,
+		ast.GreaterThanOperator, ast.GreaterThanEqualOperator,
+		ast.EqualOperator, ast.NotEqualOperator:
+		return true
+	default:
+		return false
+	}
+}
+
+// floatLiteral converts an integer literal to a float literal.
+// Other evaluators are returned unchanged.
+func floatLiteral(e vectorEvaluator, n semantic.Nature) (vectorEvaluator, semantic.Nature) {
+	v, ok := e.(*vectorValue)
+	if !ok || v.v.IsNull() {
+		return e, n
+	}
+	switch n {
+	case semantic.Int:
+		return &vectorValue{v: values.NewFloat(float64(v.v.Int()))}, semantic.Float
+	case semantic.UInt:
+		return &vectorValue{v: values.NewFloat(float64(v.v.UInt()))}, semantic.Float
+	default:
+		return e, n
+	}
+}
+
+type vectorFn struct {
+	t      semantic.Type
+	root   vectorEvaluator
+	record *vectorRecord
+}
+
+func (f *vectorFn) Type() semantic.Type {
+	return f.t
+}
+
+func (f *vectorFn) Eval(ctx context.Context, cr flux.ColReader, mem memory.Allocator) (array.Interface, error) {
+	if f.root == nil {
+		return nil, errors.New(codes.Internal, "function returns a record")
+	}
+	env := &vectorEnv{cr: cr, n: cr.Len(), mem: mem}
+	v, err := f.root.Eval(ctx, env)
+	if err != nil {
+		return nil, err
+	}
+	defer v.Release()
+	return v.array(f.root.Type().Nature(), env.n, mem), nil
+}
+
+func (f *vectorFn) EvalRecord(ctx context.Context, cr flux.ColReader, mem memory.Allocator) ([]flux.ColMeta, []array.Interface, error) {
+	if f.record == nil {
+		return nil, nil, errors.New(codes.Internal, "function does not return a record")
+	}
+	env := &vectorEnv{cr: cr, n: cr.Len(), mem: mem}
+	return f.record.Eval(ctx, env)
+}
+
+type vectorRecord struct {
+	with       bool
+	properties map[string]vectorEvaluator
+}
+
+func (e *vectorRecord) Eval(ctx context.Context, env *vectorEnv) ([]flux.ColMeta, []array.Interface, error) {
+	labels := make([]string, 0, len(e.properties)+len(env.cr.Cols()))
+	for label := range e.properties {
+		labels = append(labels, label)
+	}
+	if e.with {
+		for _, c := range env.cr.Cols() {
+			if _, ok := e.properties[c.Label]; !ok {
+				labels = append(labels, c.Label)
+			}
+		}
+	}
+	sort.Strings(labels)
+
+	cols := make([]flux.ColMeta, len(labels))
+	arrs := make([]array.Interface, 0, len(labels))
+	for i, label := range labels {
+		var typ flux.ColType
+		p, ok := e.properties[label]
+		if ok {
+			typ = colType(p.Type().Nature())
+		} else {
+			p = &vectorColumn{label: label}
+			typ = env.cr.Cols()[colIdx(label, env.cr.Cols())].Type
+		}
+		v, err := p.Eval(ctx, env)
+		if err != nil {
+			for _, arr := range arrs {
+				arr.Release()
+			}
+			return nil, nil, err
+		}
+		cols[i] = flux.ColMeta{Label: label, Type: typ}
+		arrs = append(arrs, v.array(semanticNature(typ), env.n, env.mem))
+		v.Release()
+	}
+	return cols, arrs, nil
+}
+
+func semanticNature(typ flux.ColType) semantic.Nature {
+	switch typ {
+	case flux.TInt:
+		return semantic.Int
+	case flux.TUInt:
+		return semantic.UInt
+	case flux.TFloat:
+		return semantic.Float
+	case flux.TString:
+		return semantic.String
+	case flux.TBool:
+		return semantic.Bool
+	case flux.TTime:
+		return semantic.Time
+	default:
+		return semantic.Invalid
+	}
+}
+
+func colIdx(label string, cols []flux.ColMeta) int {
+	for j, c := range cols {
+		if c.Label == label {
+			return j
+		}
+	}
+	return -1
+}
+
+type vectorValue struct {
+	v values.Value
+}
+
+func (e *vectorValue) Type() semantic.Type {
+	return e.v.Type()
+}
+
+func (e *vectorValue) Eval(ctx context.Context, env *vectorEnv) (vector, error) {
+	return vector{val: e.v}, nil
+}
+
+type vectorColumn struct {
+	t     semantic.Type
+	label string
+}
+
+func (e *vectorColumn) Type() semantic.Type {
+	return e.t
+}
+
+func (e *vectorColumn) Eval(ctx context.Context, env *vectorEnv) (vector, error) {
+	j := colIdx(e.label, env.cr.Cols())
+	if j < 0 {
+		return vector{val: values.Null}, nil
+	}
+	typ := env.cr.Cols()[j].Type
+	if e.t != nil && colType(e.t.Nature()) != typ {
+		return vector{}, errors.Newf(codes.Invalid, "column %q is %s, not %s", e.label, typ, e.t.Nature())
+	}
+	var arr array.Interface
+	switch typ {
+	case flux.TInt:
+		arr = env.cr.Ints(j)
+	case flux.TUInt:
+		arr = env.cr.UInts(j)
+	case flux.TFloat:
+		arr = env.cr.Floats(j)
+	case flux.TString:
+		arr = env.cr.Strings(j)
+	case flux.TBool:
+		arr = env.cr.Bools(j)
+	case flux.TTime:
+		arr = env.cr.Times(j)
+	default:
+		return vector{}, errVectorUnsupported
+	}
+	arr.Retain()
+	return vector{arr: arr}, nil
+}
+
+type vectorBinary struct {
+	t           semantic.Type
+	left, right vectorEvaluator
+	f           vectorBinaryFunc
+	scalar      values.BinaryFunction
+}
+
+func (e *vectorBinary) Type() semantic.Type {
+	return e.t
+}
+
+func (e *vectorBinary) Eval(ctx context.Context, env *vectorEnv) (vector, error) {
+	l, err := e.left.Eval(ctx, env)
+	if err != nil {
+		return vector{}, err
+	}
+	defer l.Release()
+	r, err := e.right.Eval(ctx, env)
+	if err != nil {
+		return vector{}, err
+	}
+	defer r.Release()
+
+	if l.arr == nil && r.arr == nil {
+		v, err := e.scalar(l.val, r.val)
+		if err != nil {
+			return vector{}, err
+		}
+		return vector{val: v}, nil
+	} else if l.isNull() || r.isNull() {
+		return vector{val: values.Null}, nil
+	}
+	return e.f(l, r, env.n, env.mem)
+}
+
+type vectorRegexp struct {
+	not   bool
+	left  vectorEvaluator
+	regex values.Value
+}
+
+func (e *vectorRegexp) Type() semantic.Type {
+	return semantic.Bool
+}
+
+func (e *vectorRegexp) Eval(ctx context.Context, env *vectorEnv) (vector, error) {
+	l, err := e.left.Eval(ctx, env)
+	if err != nil {
+		return vector{}, err
+	}
+	defer l.Release()
+	if l.isNull() || e.regex.IsNull() {
+		return vector{val: values.Null}, nil
+	}
+	re := e.regex.Regexp()
+	if l.arr == nil {
+		return vector{val: values.NewBool(re.MatchString(l.val.Str()) != e.not)}, nil
+	}
+
+	// Tag values repeat, so remember the result for each value.
+	matches := make(map[string]bool)
+	vs := l.arr.(*array.Binary)
+	b := array.NewBooleanBuilder(env.mem)
+	b.Resize(env.n)
+	for i := 0; i < env.n; i++ {
+		if vs.IsNull(i) {
+			b.AppendNull()
+			continue
+		}
+		s := vs.ValueString(i)
+		m, ok := matches[s]
+		if !ok {
+			m = re.MatchString(s)
+			matches[s] = m
+		}
+		b.Append(m != e.not)
+	}
+	return vector{arr: b.NewArray()}, nil
+}
+
+type vectorLogical struct {
+	operator    ast.LogicalOperatorKind
+	left, right vectorEvaluator
+}
+
+func (e *vectorLogical) Type() semantic.Type {
+	return semantic.Bool
+}
+
+func (e *vectorLogical) Eval(ctx context.Context, env *vectorEnv) (vector, error) {
+	l, err := e.left.Eval(ctx, env)
+	if err != nil {
+		return vector{}, err
+	}
+	defer l.Release()
+
+	// The right side is only evaluated when the left side
+	// does not decide the result for every row.
+	if l.arr == nil {
+		v, valid := l.bool(0)
+		switch e.operator {
+		case ast.AndOperator:
+			if !valid || !v {
+				return vector{val: values.NewBool(false)}, nil
+			}
+		case ast.OrOperator:
+			if valid && v {
+				return vector{val: values.NewBool(true)}, nil
+			}
+		}
+		return e.right.Eval(ctx, env)
+	}
+
+	r, err := e.right.Eval(ctx, env)
+	if err != nil {
+		return vector{}, err
+	}
+	defer r.Release()
+
+	b := array.NewBooleanBuilder(env.mem)
+	b.Resize(env.n)
+	for i := 0; i < env.n; i++ {
+		lv, lvalid := l.bool(i)
+		switch e.operator {
+		case ast.AndOperator:
+			if !lvalid || !lv {
+				b.Append(false)
+				continue
+			}
+		case ast.OrOperator:
+			if lvalid && lv {
+				b.Append(true)
+				continue
+			}
+		}
+		if rv, rvalid := r.bool(i); rvalid {
+			b.Append(rv)
+		} else {
+			b.AppendNull()
+		}
+	}
+	return vector{arr: b.NewArray()}, nil
+}
+
+type vectorUnary struct {
+	operator ast.OperatorKind
+	arg      vectorEvaluator
+}
+
+func (e *vectorUnary) Type() semantic.Type {
+	if e.operator == ast.ExistsOperator {
+		return semantic.Bool
+	}
+	return e.arg.Type()
+}
+
+func (e *vectorUnary) Eval(ctx context.Context, env *vectorEnv) (vector, error) {
+	v, err := e.arg.Eval(ctx, env)
+	if err != nil {
+		return vector{}, err
+	}
+	defer v.Release()
+
+	if v.arr == nil {
+		switch {
+		case e.operator == ast.ExistsOperator:
+			return vector{val: values.NewBool(!v.val.IsNull())}, nil
+		case v.val.IsNull():
+			return vector{val: values.Null}, nil
+		case e.operator == ast.NotOperator:
+			return vector{val: values.NewBool(!v.val.Bool())}, nil
+		case v.val.Type() == semantic.Int:
+			return vector{val: values.NewInt(-v.val.Int())}, nil
+		default:
+			return vector{val: values.NewFloat(-v.val.Float())}, nil
+		}
+	}
+
+	switch arr := v.arr.(type) {
+	case *array.Boolean:
+		if e.operator != ast.ExistsOperator {
+			b := array.NewBooleanBuilder(env.mem)
+			b.Resize(env.n)
+			for i := 0; i < env.n; i++ {
+				if arr.IsNull(i) {
+					b.AppendNull()
+					continue
+				}
+				b.Append(!arr.Value(i))
+			}
+			return vector{arr: b.NewArray()}, nil
+		}
+	case *array.Int64:
+		if e.operator != ast.ExistsOperator {
+			b := array.NewInt64Builder(env.mem)
+			b.Resize(env.n)
+			for i := 0; i < env.n; i++ {
+				if arr.IsNull(i) {
+					b.AppendNull()
+					continue
+				}
+				b.Append(-arr.Value(i))
+			}
+			return vector{arr: b.NewArray()}, nil
+		}
+	case *array.Float64:
+		if e.operator != ast.ExistsOperator {
+			b := array.NewFloat64Builder(env.mem)
+			b.Resize(env.n)
+			for i := 0; i < env.n; i++ {
+				if arr.IsNull(i) {
+					b.AppendNull()
+					continue
+				}
+				b.Append(-arr.Value(i))
+			}
+			return vector{arr: b.NewArray()}, nil
+		}
+	}
+
+	b := array.NewBooleanBuilder(env.mem)
+	b.Resize(env.n)
+	for i := 0; i < env.n; i++ {
+		b.Append(v.arr.IsValid(i))
+	}
+	return vector{arr: b.NewArray()}, nil
+}
+
+type vectorConditional struct {
+	test, consequent, alternate vectorEvaluator
+	sel                         vectorSelectFunc
+}
+
+func (e *vectorConditional) Type() semantic.Type {
+	return e.consequent.Type()
+}
+
+func (e *vectorConditional) Eval(ctx context.Context, env *vectorEnv) (vector, error) {
+	t, err := e.test.Eval(ctx, env)
+	if err != nil {
+		return vector{}, err
+	}
+	defer t.Release()
+
+	// Only one branch is evaluated when the test
+	// has the same value for every row.
+	if t.arr == nil {
+		if v, valid := t.bool(0); valid && v {
+			return e.consequent.Eval(ctx, env)
+		}
+		return e.alternate.Eval(ctx, env)
+	}
+
+	c, err := e.consequent.Eval(ctx, env)
+	if err != nil {
+		return vector{}, err
+	}
+	defer c.Release()
+	a, err := e.alternate.Eval(ctx, env)
+	if err != nil {
+		return vector{}, err
+	}
+	defer a.Release()
+	return e.sel(t.arr.(*array.Boolean), c, a, env.n, env.mem), nil
+}
@@ -0,0 +1,367 @@
+package compiler_test
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/google/go-cmp/cmp"
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/codes"
+	"github.com/influxdata/flux/compiler"
+	"github.com/influxdata/flux/dependencies/dependenciestest"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/execute/executetest"
+	"github.com/influxdata/flux/internal/errors"
+	"github.com/influxdata/flux/parser"
+	"github.com/influxdata/flux/semantic"
+	"github.com/influxdata/flux/values"
+)
+
+// functionExpression parses the source of a function into its semantic graph.
+func functionExpression(t testing.TB, source string) *semantic.FunctionExpression {
+	t.Helper()
+	pkg, err := semantic.New(parser.ParseSource(source))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return pkg.Files[0].Body[0].(*semantic.ExpressionStatement).Expression.(*semantic.FunctionExpression)
+}
+
+// vectorTable returns a table with a column of each type and some null values.
+func vectorTable() *executetest.Table {
+	return &executetest.Table{
+		ColMeta: []flux.ColMeta{
+			{Label: "_time", Type: flux.TTime},
+			{Label: "_value", Type: flux.TFloat},
+			{Label: "n", Type: flux.TInt},
+			{Label: "u", Type: flux.TUInt},
+			{Label: "host", Type: flux.TString},
+			{Label: "ok", Type: flux.TBool},
+		},
+		Data: [][]interface{}{
+			{execute.Time(1), 1.5, int64(3), uint64(2), "a", true},
+			{execute.Time(2), -2.0, int64(-4), uint64(5), "b", false},
+			{execute.Time(3), nil, int64(7), nil, "ab", nil},
+			{execute.Time(4), 0.0, nil, uint64(0), nil, true},
+			{execute.Time(5), 4.25, int64(0), uint64(9), "c", false},
+		},
+	}
+}
+
+// evalRows evaluates the function one row at a time with the row compiler.
+func evalRows(t *testing.T, fn *semantic.FunctionExpression, scope compiler.Scope, cr flux.ColReader, in semantic.Type) []values.Value {
+	t.Helper()
+	f, err := compiler.Compile(scope, fn, in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := dependenciestest.Default().Inject(context.Background())
+	vs := make([]values.Value, cr.Len())
+	for i := range vs {
+		r := values.NewObject()
+		for j, c := range cr.Cols() {
+			r.Set(c.Label, execute.ValueForRow(cr, i, j))
+		}
+		v, err := f.Eval(ctx, values.NewObjectWithValues(map[string]values.Value{"r": r}))
+		if err != nil {
+			t.Fatal(err)
+		}
+		vs[i] = v
+	}
+	return vs
+}
+
+func arrayValues(arr array.Interface) []values.Value {
+	vs := make([]values.Value, arr.Len())
+	for i := range vs {
+		if arr.IsNull(i) {
+			vs[i] = values.Null
+			continue
+		}
+		switch arr := arr.(type) {
+		case *array.Int64:
+			vs[i] = values.NewInt(arr.Value(i))
+		case *array.Uint64:
+			vs[i] = values.NewUInt(arr.Value(i))
+		case *array.Float64:
+			vs[i] = values.NewFloat(arr.Value(i))
+		case *array.Binary:
+			vs[i] = values.NewString(arr.ValueString(i))
+		case *array.Boolean:
+			vs[i] = values.NewBool(arr.Value(i))
+		}
+	}
+	return vs
+}
+
+// nullValues replaces typed nulls so results of both compilers can be compared.
+func nullValues(vs []values.Value) []values.Value {
+	for i, v := range vs {
+		if v.IsNull() {
+			vs[i] = values.Null
+		} else if v.Type() == semantic.Time {
+			vs[i] = values.NewInt(int64(v.Time()))
+		}
+	}
+	return vs
+}
+
+func TestCompileVector(t *testing.T) {
+	scope := compiler.NewScope()
+	scope.Set("threshold", values.NewFloat(1.0))
+	scope.Set("pattern", values.NewRegexp(regexp.MustCompile("^a")))
+	scope.Set("limit", values.NewUInt(5))
+	scope.Set("flag", values.NewBool(true))
+
+	testCases := []string{
+		`(r) => r._value + 1.0`,
+		`(r) => r._value * r._value - 2.0`,
+		`(r) => r.n / 2`,
+		`(r) => r.n % 3`,
+		`(r) => r.u + r.u`,
+		`(r) => r._value / 2.0`,
+		`(r) => r._value > 0`,
+		`(r) => r._value > threshold`,
+		`(r) => r.n <= 3`,
+		`(r) => r.u != limit`,
+		`(r) => r.host == "a"`,
+		`(r) => r.host < "b"`,
+		`(r) => r.host + "-suffix"`,
+		`(r) => r.host =~ /^a/`,
+		`(r) => r.host !~ pattern`,
+		`(r) => r._time >= 1970-01-01T00:00:00.000000003Z`,
+		`(r) => r.ok`,
+		`(r) => not r.ok`,
+		`(r) => exists r._value`,
+		`(r) => -r.n`,
+		`(r) => -r._value`,
+		`(r) => r.ok and r._value > 1.0`,
+		`(r) => r.ok or r.host == "c"`,
+		`(r) => r._value > 1.0 and r.n > 0`,
+		`(r) => not flag and r.ok`,
+		`(r) => flag or r.ok`,
+		`(r) => if r.ok then r._value else 0.0`,
+		`(r) => if r.n > 0 then r.host else "negative"`,
+		`(r) => if flag then r.n else 0`,
+		`(r) => if r.ok then r._time else r._time`,
+		`(r) => 1 + 2`,
+		`(r) => { return r.n * 2 }`,
+	}
+	for _, source := range testCases {
+		source := source
+		t.Run(source, func(t *testing.T) {
+			fn := functionExpression(t, source)
+			if err := vectorTable().Do(func(cr flux.ColReader) error {
+				in := recordType(cr)
+				want := nullValues(evalRows(t, fn, scope, cr, in))
+
+				f, err := compiler.CompileVector(scope, fn, in)
+				if err != nil {
+					t.Fatal(err)
+				}
+				ctx := dependenciestest.Default().Inject(context.Background())
+				arr, err := f.Eval(ctx, cr, executetest.UnlimitedAllocator)
+				if err != nil {
+					t.Fatal(err)
+				}
+				defer arr.Release()
+
+				if got := arrayValues(arr); !cmp.Equal(want, got, CmpOptions...) {
+					t.Errorf("unexpected values -want/+got:\n%s", cmp.Diff(want, got, CmpOptions...))
+				}
+				return nil
+			}); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
+
+func TestCompileVector_Record(t *testing.T) {
+	fn := functionExpression(t, `(r) => ({r with _value: r._value * 2.0, doubled: r.n * 2})`)
+	if err := vectorTable().Do(func(cr flux.ColReader) error {
+		f, err := compiler.CompileVector(nil, fn, recordType(cr))
+		if err != nil {
+			t.Fatal(err)
+		}
+		ctx := dependenciestest.Default().Inject(context.Background())
+		cols, arrs, err := f.EvalRecord(ctx, cr, executetest.UnlimitedAllocator)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer func() {
+			for _, arr := range arrs {
+				arr.Release()
+			}
+		}()
+
+		wantCols := []flux.ColMeta{
+			{Label: "_time", Type: flux.TTime},
+			{Label: "_value", Type: flux.TFloat},
+			{Label: "doubled", Type: flux.TInt},
+			{Label: "host", Type: flux.TString},
+			{Label: "n", Type: flux.TInt},
+			{Label: "ok", Type: flux.TBool},
+			{Label: "u", Type: flux.TUInt},
+		}
+		if !cmp.Equal(wantCols, cols) {
+			t.Fatalf("unexpected columns -want/+got:\n%s", cmp.Diff(wantCols, cols))
+		}
+		if got, want := arrayValues(arrs[1]), []values.Value{
+			values.NewFloat(3), values.NewFloat(-4), values.Null, values.NewFloat(0), values.NewFloat(8.5),
+		}; !cmp.Equal(want, got, CmpOptions...) {
+			t.Errorf("unexpected _value -want/+got:\n%s", cmp.Diff(want, got, CmpOptions...))
+		}
+		if got, want := arrayValues(arrs[2]), []values.Value{
+			values.NewInt(6), values.NewInt(-8), values.NewInt(14), values.Null, values.NewInt(0),
+		}; !cmp.Equal(want, got, CmpOptions...) {
+			t.Errorf("unexpected doubled -want/+got:\n%s", cmp.Diff(want, got, CmpOptions...))
+		}
+		if arrs[3] != cr.Strings(4) {
+			t.Error("expected the host column to be passed through")
+		}
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestCompileVector_Unsupported(t *testing.T) {
+	for _, source := range []string{
+		`(r) => "host = ${r.host}"`,
+		`(r) => r`,
+		`(r) => ({r with host: r.host})._value`,
+		"(r) => {\n\tn = r.n\n\treturn n\n}",
+		`(r) => [r.n]`,
+		`(r, x) => r.n + x`,
+		`(r) => ({doubled: [r.n]})`,
+	} {
+		source := source
+		t.Run(source, func(t *testing.T) {
+			fn := functionExpression(t, source)
+			if err := vectorTable().Do(func(cr flux.ColReader) error {
+				in := semantic.NewObjectType(map[string]semantic.Type{
+					"r": recordType(cr).Properties()["r"],
+					"x": semantic.Int,
+				})
+				_, err := compiler.CompileVector(nil, fn, in)
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				if got, want := errors.Code(err), codes.Unimplemented; got != want {
+					t.Errorf("unexpected error code: got %v, want %v: %s", got, want, err)
+				}
+				return nil
+			}); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
+
+func TestCompileVector_DivideByZero(t *testing.T) {
+	fn := functionExpression(t, `(r) => if r.n != 0 then 10 / r.n else 0`)
+	if err := vectorTable().Do(func(cr flux.ColReader) error {
+		f, err := compiler.CompileVector(nil, fn, recordType(cr))
+		if err != nil {
+			t.Fatal(err)
+		}
+		// Both branches are evaluated for every row, so a row
+		// that the conditional skips can still cause an error.
+		// The caller must evaluate such rows one at a time.
+		ctx := dependenciestest.Default().Inject(context.Background())
+		if _, err := f.Eval(ctx, cr, executetest.UnlimitedAllocator); err == nil {
+			t.Fatal("expected error")
+		} else if got, want := errors.Code(err), codes.FailedPrecondition; got != want {
+			t.Errorf("unexpected error code: got %v, want %v: %s", got, want, err)
+		}
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func recordType(cr flux.ColReader) semantic.Type {
+	properties := make(map[string]semantic.Type, len(cr.Cols()))
+	for _, c := range cr.Cols() {
+		properties[c.Label] = execute.ConvertToKind(c.Type)
+	}
+	return semantic.NewObjectType(map[string]semantic.Type{
+		"r": semantic.NewObjectType(properties),
+	})
+}
+
+func benchmarkFunction(b *testing.B, source string, vectorized bool) {
+	b.Helper()
+	const n = 1000
+	tbl := &executetest.Table{
+		ColMeta: []flux.ColMeta{
+			{Label: "_value", Type: flux.TFloat},
+			{Label: "host", Type: flux.TString},
+		},
+	}
+	for i := 0; i < n; i++ {
+		host := "a"
+		if i%3 == 0 {
+			host = "b"
+		}
+		tbl.Data = append(tbl.Data, []interface{}{float64(i), host})
+	}
+	fn := functionExpression(b, source)
+	ctx := dependenciestest.Default().Inject(context.Background())
+
+	if err := tbl.Do(func(cr flux.ColReader) error {
+		in := recordType(cr)
+		if vectorized {
+			f, err := compiler.CompileVector(nil, fn, in)
+			if err != nil {
+				b.Fatal(err)
+			}
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				arr, err := f.Eval(ctx, cr, executetest.UnlimitedAllocator)
+				if err != nil {
+					b.Fatal(err)
+				}
+				arr.Release()
+			}
+			return nil
+		}
+
+		f, err := compiler.Compile(nil, fn, in)
+		if err != nil {
+			b.Fatal(err)
+		}
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			for row := 0; row < cr.Len(); row++ {
+				r := values.NewObject()
+				for j, c := range cr.Cols() {
+					r.Set(c.Label, execute.ValueForRow(cr, row, j))
+				}
+				if _, err := f.Eval(ctx, values.NewObjectWithValues(map[string]values.Value{"r": r})); err != nil {
+					b.Fatal(err)
+				}
+			}
+		}
+		return nil
+	}); err != nil {
+		b.Fatal(err)
+	}
+}
+
+func BenchmarkCompileVector(b *testing.B) {
+	for _, source := range []string{
+		`(r) => r._value > 10.0 and r.host == "a"`,
+		`(r) => r._value * 2.0 + 1.0`,
+	} {
+		b.Run(source+"/row", func(b *testing.B) {
+			benchmarkFunction(b, source, false)
+		})
+		b.Run(source+"/vector", func(b *testing.B) {
+			benchmarkFunction(b, source, true)
+		})
+	}
+}
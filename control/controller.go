@@ -0,0 +1,430 @@
+// Package control implements a controller that executes the queries of
+// several clients within shared resource limits.
+//
+// The controller admits queries from a queue that is ordered by the priority
+// in the resources of their plan. It limits the number of queries that execute
+// at the same time and the memory that they allocate together.
+package control
+
+import (
+	"container/heap"
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/codes"
+	"github.com/influxdata/flux/internal/errors"
+	"github.com/influxdata/flux/lang"
+	"github.com/influxdata/flux/memory"
+	"github.com/influxdata/flux/plan"
+)
+
+// Config configures the resource limits of a Controller.
+type Config struct {
+	// ConcurrencyQuota is the number of queries that may execute at the same time.
+	ConcurrencyQuota int
+	// QueueSize is the number of queries that may wait to be executed.
+	// A zero value indicates that the queue is unbounded.
+	QueueSize int
+	// MemoryBytesQuota is the number of bytes that the queries may allocate together.
+	// A zero value indicates unlimited.
+	MemoryBytesQuota int64
+	// MemoryBytesQuotaPerQuery is the number of bytes that a single query may allocate.
+	// A zero value indicates unlimited.
+	MemoryBytesQuotaPerQuery int64
+}
+
+func (c Config) validate() error {
+	if c.ConcurrencyQuota <= 0 {
+		return errors.New(codes.Invalid, "ConcurrencyQuota must be positive")
+	}
+	if c.QueueSize < 0 {
+		return errors.New(codes.Invalid, "QueueSize must not be negative")
+	}
+	if c.MemoryBytesQuota < 0 || c.MemoryBytesQuotaPerQuery < 0 {
+		return errors.New(codes.Invalid, "memory quotas must not be negative")
+	}
+	return nil
+}
+
+// QueryID identifies a query of a Controller.
+type QueryID uint64
+
+// State is the state of a query of a Controller.
+type State int
+
+const (
+	// Compiling is the state of a query that is compiled and planned.
+	Compiling State = iota
+	// Queued is the state of a query that waits to be executed.
+	Queued
+	// Executing is the state of a query that has been admitted for execution.
+	Executing
+)
+
+func (s State) String() string {
+	switch s {
+	case Compiling:
+		return "compiling"
+	case Queued:
+		return "queued"
+	case Executing:
+		return "executing"
+	default:
+		return "unknown"
+	}
+}
+
+// QueryInfo describes a query of a Controller.
+type QueryInfo struct {
+	ID    QueryID
+	State State
+	// Priority is the priority of the plan of the query.
+	// It is known once the query is queued or executing.
+	Priority    flux.Priority
+	SubmittedAt time.Time
+	// MemoryBytes is the number of bytes that the query was granted.
+	MemoryBytes int64
+}
+
+// Controller queues the queries that clients submit and executes them
+// in the order of their priority within its resource limits.
+// It is safe for concurrent use.
+type Controller struct {
+	config Config
+
+	mu          sync.Mutex
+	lastID      QueryID
+	queries     map[QueryID]*Query
+	queue       queryQueue
+	executing   int
+	memoryBytes int64
+}
+
+// New creates a Controller with the resource limits of the config.
+func New(config Config) (*Controller, error) {
+	if err := config.validate(); err != nil {
+		return nil, err
+	}
+	return &Controller{
+		config:  config,
+		queries: make(map[QueryID]*Query),
+	}, nil
+}
+
+// Query compiles the query of the compiler and starts its program once
+// the controller admits it. It blocks while the query waits in the queue.
+// The returned query must be done to release the resources it holds.
+func (c *Controller) Query(ctx context.Context, compiler flux.Compiler) (*Query, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	q := c.submit(cancel)
+
+	start := time.Now()
+	program, err := compiler.Compile(ctx)
+	if err != nil {
+		q.finish()
+		return nil, errors.Wrap(err, codes.Inherit, "failed to compile query")
+	}
+	q.compileDuration = time.Since(start)
+
+	ctx = lang.ContextWithAdmitter(ctx, q)
+	fq, err := program.Start(ctx, c.newAllocator(q))
+	if err != nil {
+		q.finish()
+		return nil, err
+	}
+	q.Query = fq
+	return q, nil
+}
+
+// Queries returns the queries that are queued or executing in the order they were submitted.
+func (c *Controller) Queries() []QueryInfo {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	infos := make([]QueryInfo, 0, len(c.queries))
+	for _, q := range c.queries {
+		infos = append(infos, QueryInfo{
+			ID:          q.id,
+			State:       q.state,
+			Priority:    q.priority,
+			SubmittedAt: q.submittedAt,
+			MemoryBytes: q.memoryBytes,
+		})
+	}
+	sort.Slice(infos, func(i, j int) bool {
+		return infos[i].ID < infos[j].ID
+	})
+	return infos
+}
+
+// Cancel cancels the query with the id. A queued query is removed from the queue
+// and an executing query stops. The client must still mark the query as done.
+func (c *Controller) Cancel(id QueryID) error {
+	c.mu.Lock()
+	q, ok := c.queries[id]
+	c.mu.Unlock()
+	if !ok {
+		return errors.Newf(codes.NotFound, "query %d not found", id)
+	}
+	q.cancel()
+	return nil
+}
+
+func (c *Controller) submit(cancel context.CancelFunc) *Query {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.lastID++
+	q := &Query{
+		c:           c,
+		id:          c.lastID,
+		cancel:      cancel,
+		state:       Compiling,
+		submittedAt: time.Now(),
+		index:       -1,
+	}
+	c.queries[q.id] = q
+	return q
+}
+
+// admit waits until the query may execute. The query is admitted once,
+// so the programs that it starts while it is executing do not wait again.
+func (c *Controller) admit(ctx context.Context, q *Query, ps *plan.Spec) error {
+	c.mu.Lock()
+	if q.state == Executing {
+		c.mu.Unlock()
+		return nil
+	}
+	q.priority = ps.Resources.Priority
+	if c.executing < c.config.ConcurrencyQuota && c.queue.Len() == 0 {
+		c.execute(q)
+		c.mu.Unlock()
+		return nil
+	}
+	if c.config.QueueSize > 0 && c.queue.Len() >= c.config.QueueSize {
+		c.mu.Unlock()
+		return errors.Newf(codes.ResourceExhausted, "query queue is full with %d queries", c.config.QueueSize)
+	}
+	q.state = Queued
+	q.queuedAt = time.Now()
+	q.ready = make(chan struct{})
+	heap.Push(&c.queue, q)
+	c.mu.Unlock()
+
+	select {
+	case <-q.ready:
+		return nil
+	case <-ctx.Done():
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		if q.index >= 0 {
+			heap.Remove(&c.queue, q.index)
+			q.queueDuration += time.Since(q.queuedAt)
+		}
+		// A query that was admitted while it was canceled
+		// is released once it is done.
+		return errors.Wrap(ctx.Err(), codes.Canceled, "query was canceled while it was queued")
+	}
+}
+
+// execute marks the query as executing. The lock must be held.
+func (c *Controller) execute(q *Query) {
+	if !q.queuedAt.IsZero() {
+		q.queueDuration += time.Since(q.queuedAt)
+	}
+	q.state = Executing
+	c.executing++
+}
+
+// admitNext admits the queries with the highest priority
+// while there is room for them to execute. The lock must be held.
+func (c *Controller) admitNext() {
+	for c.executing < c.config.ConcurrencyQuota && c.queue.Len() > 0 {
+		q := heap.Pop(&c.queue).(*Query)
+		c.execute(q)
+		close(q.ready)
+	}
+}
+
+// release releases the resources that the query holds.
+func (c *Controller) release(q *Query) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, ok := c.queries[q.id]; !ok {
+		return
+	}
+	delete(c.queries, q.id)
+	if q.state == Executing {
+		c.executing--
+	} else if q.index >= 0 {
+		heap.Remove(&c.queue, q.index)
+	}
+	c.memoryBytes -= q.memoryBytes
+	q.memoryBytes = 0
+	c.admitNext()
+}
+
+// memoryGrant is the least number of bytes that is granted to a query at once,
+// so its allocator does not request more memory for every allocation.
+const memoryGrant = 1024 * 1024
+
+// requestMemory grants memory to the query from the memory of the controller.
+func (c *Controller) requestMemory(q *Query, want int64) (int64, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	// The query is granted at least the bytes it wants, up to the memoryGrant,
+	// if its own quota and the quota of the controller have room for them.
+	available := int64(-1)
+	if quota := c.config.MemoryBytesQuotaPerQuery; quota > 0 {
+		available = quota - q.memoryBytes
+		if available < want {
+			return 0, errors.Newf(codes.ResourceExhausted, "query memory quota of %d bytes exceeded", quota)
+		}
+	}
+	if quota := c.config.MemoryBytesQuota; quota > 0 {
+		if n := quota - c.memoryBytes; available < 0 || n < available {
+			available = n
+		}
+		if available < want {
+			return 0, errors.Newf(codes.ResourceExhausted, "controller memory quota of %d bytes exceeded", quota)
+		}
+	}
+	got := want
+	if got < memoryGrant {
+		got = memoryGrant
+	}
+	if available >= 0 && got > available {
+		got = available
+	}
+	q.memoryBytes += got
+	c.memoryBytes += got
+	return got, nil
+}
+
+func (c *Controller) freeMemory(q *Query, bytes int64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if bytes > q.memoryBytes {
+		bytes = q.memoryBytes
+	}
+	q.memoryBytes -= bytes
+	c.memoryBytes -= bytes
+}
+
+// newAllocator creates the allocator of the query. The allocator requests
+// the memory it uses from the controller when the memory is limited.
+func (c *Controller) newAllocator(q *Query) *memory.Allocator {
+	if c.config.MemoryBytesQuota == 0 && c.config.MemoryBytesQuotaPerQuery == 0 {
+		return &memory.Allocator{}
+	}
+	limit := int64(0)
+	return &memory.Allocator{
+		Limit:   &limit,
+		Manager: queryMemoryManager{q: q},
+	}
+}
+
+// queryMemoryManager is the memory.Manager of the allocator of a query.
+type queryMemoryManager struct {
+	q *Query
+}
+
+func (m queryMemoryManager) RequestMemory(want int64) (int64, error) {
+	return m.q.c.requestMemory(m.q, want)
+}
+
+func (m queryMemoryManager) FreeMemory(bytes int64) {
+	m.q.c.freeMemory(m.q, bytes)
+}
+
+// Query is a query of a Controller.
+type Query struct {
+	flux.Query
+
+	c      *Controller
+	id     QueryID
+	cancel context.CancelFunc
+
+	// The fields below are guarded by the lock of the controller.
+	state           State
+	priority        flux.Priority
+	submittedAt     time.Time
+	queuedAt        time.Time
+	compileDuration time.Duration
+	queueDuration   time.Duration
+	memoryBytes     int64
+	// ready is closed when the queued query is admitted.
+	ready chan struct{}
+	// index is the index of the query in the queue or -1.
+	index int
+}
+
+// ID returns the id of the query in the controller.
+func (q *Query) ID() QueryID {
+	return q.id
+}
+
+// Admit implements lang.Admitter.
+func (q *Query) Admit(ctx context.Context, ps *plan.Spec) error {
+	return q.c.admit(ctx, q, ps)
+}
+
+// Done marks the query as done and releases its resources in the controller.
+func (q *Query) Done() {
+	q.Query.Done()
+	q.finish()
+}
+
+func (q *Query) finish() {
+	q.cancel()
+	q.c.release(q)
+}
+
+// Statistics reports the statistics of the query
+// with the time it spent to be compiled and queued.
+func (q *Query) Statistics() flux.Statistics {
+	stats := q.Query.Statistics()
+	q.c.mu.Lock()
+	defer q.c.mu.Unlock()
+	stats.CompileDuration += q.compileDuration
+	stats.QueueDuration += q.queueDuration
+	return stats
+}
+
+// queryQueue is a priority queue of queries. The queries with a lower
+// priority value come first and queries with the same priority
+// come in the order they were submitted.
+type queryQueue []*Query
+
+func (qq queryQueue) Len() int { return len(qq) }
+
+func (qq queryQueue) Less(i, j int) bool {
+	if qq[i].priority != qq[j].priority {
+		return qq[i].priority < qq[j].priority
+	}
+	return qq[i].id < qq[j].id
+}
+
+func (qq queryQueue) Swap(i, j int) {
+	qq[i], qq[j] = qq[j], qq[i]
+	qq[i].index = i
+	qq[j].index = j
+}
+
+func (qq *queryQueue) Push(x interface{}) {
+	q := x.(*Query)
+	q.index = len(*qq)
+	*qq = append(*qq, q)
+}
+
+func (qq *queryQueue) Pop() interface{} {
+	old := *qq
+	n := len(old)
+	q := old[n-1]
+	old[n-1] = nil
+	q.index = -1
+	*qq = old[:n-1]
+	return q
+}
@@ -0,0 +1,238 @@
+package control_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/influxdata/flux"
+	_ "github.com/influxdata/flux/builtin"
+	"github.com/influxdata/flux/codes"
+	"github.com/influxdata/flux/control"
+	"github.com/influxdata/flux/dependencies/dependenciestest"
+	"github.com/influxdata/flux/internal/spec"
+	"github.com/influxdata/flux/lang"
+	"github.com/influxdata/flux/plan"
+)
+
+const script = `
+import "csv"
+
+data = "
+#datatype,string,long,long
+#group,false,false,false
+#default,_result,,
+,result,table,value
+,,0,1
+,,0,2
+"
+
+csv.from(csv: data)
+`
+
+// compiler compiles the script with the priority.
+type compiler struct {
+	priority flux.Priority
+}
+
+func (c compiler) Compile(ctx context.Context) (flux.Program, error) {
+	sp, err := spec.FromScript(ctx, time.Now(), script)
+	if err != nil {
+		return nil, err
+	}
+	sp.Resources.Priority = c.priority
+	ps, err := plan.PlannerBuilder{}.Build().Plan(sp)
+	if err != nil {
+		return nil, err
+	}
+	return &lang.Program{PlanSpec: ps}, nil
+}
+
+func (compiler) CompilerType() flux.CompilerType {
+	return "test"
+}
+
+func newController(t *testing.T, config control.Config) *control.Controller {
+	t.Helper()
+	c, err := control.New(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return c
+}
+
+func query(t *testing.T, c *control.Controller, priority flux.Priority) *control.Query {
+	t.Helper()
+	ctx := dependenciestest.Default().Inject(context.Background())
+	q, err := c.Query(ctx, compiler{priority: priority})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return q
+}
+
+// waitQueued waits until n queries of the controller are queued.
+func waitQueued(t *testing.T, c *control.Controller, n int) []control.QueryInfo {
+	t.Helper()
+	deadline := time.Now().Add(10 * time.Second)
+	for time.Now().Before(deadline) {
+		var queued []control.QueryInfo
+		for _, info := range c.Queries() {
+			if info.State == control.Queued {
+				queued = append(queued, info)
+			}
+		}
+		if len(queued) == n {
+			return queued
+		}
+		time.Sleep(time.Millisecond)
+	}
+	t.Fatalf("timed out waiting for %d queued queries: %v", n, c.Queries())
+	return nil
+}
+
+func TestController_Priority(t *testing.T) {
+	c := newController(t, control.Config{ConcurrencyQuota: 1})
+	first := query(t, c, flux.High)
+
+	admitted := make(chan *control.Query, 2)
+	for i, priority := range []flux.Priority{10, 1} {
+		go func(priority flux.Priority) {
+			ctx := dependenciestest.Default().Inject(context.Background())
+			q, err := c.Query(ctx, compiler{priority: priority})
+			if err != nil {
+				t.Error(err)
+				close(admitted)
+				return
+			}
+			admitted <- q
+		}(priority)
+		waitQueued(t, c, i+1)
+	}
+	time.Sleep(10 * time.Millisecond)
+
+	infos := c.Queries()
+	if want, got := 3, len(infos); want != got {
+		t.Fatalf("unexpected number of queries -want/+got:\n\t- %d\n\t+ %d", want, got)
+	}
+	if want, got := control.Executing, infos[0].State; want != got {
+		t.Errorf("unexpected state of the first query -want/+got:\n\t- %s\n\t+ %s", want, got)
+	}
+
+	first.Done()
+	for _, want := range []flux.Priority{1, 10} {
+		select {
+		case q, ok := <-admitted:
+			if !ok {
+				t.FailNow()
+			}
+			if got := q.Statistics().QueueDuration; got <= 0 {
+				t.Errorf("expected the queue duration of the query to be positive, got %s", got)
+			}
+			var got flux.Priority
+			for _, info := range c.Queries() {
+				if info.ID == q.ID() {
+					got = info.Priority
+				}
+			}
+			if want != got {
+				t.Errorf("unexpected priority of the admitted query -want/+got:\n\t- %d\n\t+ %d", want, got)
+			}
+			q.Done()
+		case <-time.After(10 * time.Second):
+			t.Fatal("timed out waiting for a query to be admitted")
+		}
+	}
+	if got := c.Queries(); len(got) != 0 {
+		t.Errorf("expected no queries after they are done, got %v", got)
+	}
+}
+
+func TestController_CancelQueued(t *testing.T) {
+	c := newController(t, control.Config{ConcurrencyQuota: 1})
+	first := query(t, c, flux.High)
+	defer first.Done()
+
+	errC := make(chan error, 1)
+	go func() {
+		ctx := dependenciestest.Default().Inject(context.Background())
+		_, err := c.Query(ctx, compiler{})
+		errC <- err
+	}()
+	queued := waitQueued(t, c, 1)
+	if err := c.Cancel(queued[0].ID); err != nil {
+		t.Fatal(err)
+	}
+
+	select {
+	case err := <-errC:
+		if want, got := codes.Canceled, flux.ErrorCode(err); want != got {
+			t.Errorf("unexpected error code -want/+got:\n\t- %s\n\t+ %s", want, got)
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatal("timed out waiting for the query to be canceled")
+	}
+	if got := c.Queries(); len(got) != 1 {
+		t.Errorf("expected only the executing query, got %v", got)
+	}
+	if err := c.Cancel(queued[0].ID); flux.ErrorCode(err) != codes.NotFound {
+		t.Errorf("expected canceling a removed query to fail with not found, got %v", err)
+	}
+}
+
+func TestController_QueueFull(t *testing.T) {
+	c := newController(t, control.Config{ConcurrencyQuota: 1, QueueSize: 1})
+	first := query(t, c, flux.High)
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		ctx := dependenciestest.Default().Inject(context.Background())
+		q, err := c.Query(ctx, compiler{})
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		q.Done()
+	}()
+	waitQueued(t, c, 1)
+
+	ctx := dependenciestest.Default().Inject(context.Background())
+	_, err := c.Query(ctx, compiler{})
+	if want, got := codes.ResourceExhausted, flux.ErrorCode(err); want != got {
+		t.Errorf("unexpected error code -want/+got:\n\t- %s\n\t+ %s", want, got)
+	}
+
+	first.Done()
+	<-done
+}
+
+func TestController_MemoryQuota(t *testing.T) {
+	c := newController(t, control.Config{
+		ConcurrencyQuota:         1,
+		MemoryBytesQuotaPerQuery: 8,
+	})
+	q := query(t, c, flux.High)
+	var err error
+	for res := range q.Results() {
+		if err = res.Tables().Do(func(flux.Table) error { return nil }); err != nil {
+			break
+		}
+	}
+	q.Done()
+	if err == nil {
+		err = q.Err()
+	}
+	if err == nil {
+		t.Fatal("expected the query to exceed its memory quota")
+	}
+	if got := c.Queries(); len(got) != 0 {
+		t.Errorf("expected no queries after the query is done, got %v", got)
+	}
+}
+
+func TestNew_Invalid(t *testing.T) {
+	if _, err := control.New(control.Config{}); flux.ErrorCode(err) != codes.Invalid {
+		t.Errorf("expected a zero concurrency quota to be invalid, got %v", err)
+	}
+}
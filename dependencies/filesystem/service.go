@@ -18,3 +18,11 @@ type Service interface {
 	Create(fpath string) (File, error)
 	Stat(fpath string) (os.FileInfo, error)
 }
+
+// Remover is implemented by a Service that can remove files.
+// Features that create temporary files, such as spilling
+// query data to disk, are only available with a Service
+// that implements this interface.
+type Remover interface {
+	Remove(fpath string) error
+}
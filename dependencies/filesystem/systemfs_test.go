@@ -102,6 +102,26 @@ func TestSystemFS_Stat(t *testing.T) {
 	}
 }
 
+func TestSystemFS_Remove(t *testing.T) {
+	tmpfile, err := ioutil.TempFile("", "flux-systemfs-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = os.Remove(tmpfile.Name()) }()
+	if err := tmpfile.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	fs := filesystem.SystemFS.(filesystem.Remover)
+	if err := fs.Remove(tmpfile.Name()); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := os.Stat(tmpfile.Name()); !os.IsNotExist(err) {
+		t.Fatalf("expected file to be removed, got error: %v", err)
+	}
+}
+
 func TestReadFile(t *testing.T) {
 	tmpfile, err := ioutil.TempFile("", "flux-systemfs-test")
 	if err != nil {
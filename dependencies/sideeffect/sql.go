@@ -0,0 +1,108 @@
+package sideeffect
+
+import (
+	"context"
+	"database/sql/driver"
+
+	"github.com/influxdata/flux/codes"
+	"github.com/influxdata/flux/internal/errors"
+)
+
+// SQLConnector returns a connector whose connections record
+// every statement that is executed instead of sending it to a database.
+// It is used with sql.OpenDB in place of the driver with the given name.
+func (r *Recorder) SQLConnector(driverName string) driver.Connector {
+	return sqlConnector{r: r, driverName: driverName}
+}
+
+type sqlConnector struct {
+	r          *Recorder
+	driverName string
+}
+
+func (c sqlConnector) Connect(ctx context.Context) (driver.Conn, error) {
+	return &sqlConn{c: c}, nil
+}
+
+func (c sqlConnector) Driver() driver.Driver {
+	return sqlDriver{c: c}
+}
+
+type sqlDriver struct {
+	c sqlConnector
+}
+
+func (d sqlDriver) Open(name string) (driver.Conn, error) {
+	return &sqlConn{c: d.c}, nil
+}
+
+type sqlConn struct {
+	c sqlConnector
+}
+
+func (c *sqlConn) Prepare(query string) (driver.Stmt, error) {
+	return &sqlStmt{c: c, query: query}, nil
+}
+
+func (c *sqlConn) Close() error {
+	return nil
+}
+
+func (c *sqlConn) Begin() (driver.Tx, error) {
+	return sqlTx{}, nil
+}
+
+func (c *sqlConn) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	vs := make([]interface{}, len(args))
+	for i, arg := range args {
+		vs[i] = arg.Value
+	}
+	c.exec(query, vs)
+	return driver.RowsAffected(0), nil
+}
+
+func (c *sqlConn) exec(query string, args []interface{}) {
+	c.c.r.Record(Effect{
+		Kind:   "sql",
+		Method: "exec",
+		Target: c.c.driverName,
+		Data:   query,
+		Args:   args,
+	})
+}
+
+type sqlTx struct{}
+
+func (sqlTx) Commit() error {
+	return nil
+}
+
+func (sqlTx) Rollback() error {
+	return nil
+}
+
+type sqlStmt struct {
+	c     *sqlConn
+	query string
+}
+
+func (s *sqlStmt) Close() error {
+	return nil
+}
+
+func (s *sqlStmt) NumInput() int {
+	return -1
+}
+
+func (s *sqlStmt) Exec(args []driver.Value) (driver.Result, error) {
+	vs := make([]interface{}, len(args))
+	for i, arg := range args {
+		vs[i] = arg
+	}
+	s.c.exec(s.query, vs)
+	return driver.RowsAffected(0), nil
+}
+
+func (s *sqlStmt) Query(args []driver.Value) (driver.Rows, error) {
+	return nil, errors.New(codes.Unimplemented, "queries cannot be made while side effects are recorded")
+}
@@ -19,7 +19,7 @@ type aggregateTransformation struct {
 }
 
 type AggregateConfig struct {
-	plan.DefaultCost
+	plan.AggregateCost
 	Columns []string `json:"columns"`
 }
 
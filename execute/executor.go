@@ -64,6 +64,10 @@ type executionState struct {
 
 	dispatcher *poolDispatcher
 	logger     *zap.Logger
+
+	// profiler records the runtime numbers for each node
+	// when the query is profiled.
+	profiler *Profiler
 }
 
 func (e *executor) Execute(ctx context.Context, p *plan.Spec, a *memory.Allocator) (map[string]flux.Result, <-chan flux.Metadata, error) {
@@ -95,6 +99,13 @@ func (e *executor) createExecutionState(ctx context.Context, p *plan.Spec, a *me
 		// TODO(nathanielc): Have the planner specify the dispatcher throughput
 		dispatcher: newPoolDispatcher(10, e.logger),
 	}
+	if dir := p.Resources.SpillDirectory; dir != "" {
+		ctx = ContextWithSpillDirectory(ctx, dir)
+	}
+	// Queries are only profiled when they are analyzed or traced.
+	if es.profiler = ProfilerFromContext(ctx); es.profiler == nil && flux.IsExperimentalTracingEnabled() {
+		es.profiler = NewProfiler()
+	}
 	v := &createExecutionNodeVisitor{
 		ctx:   ctx,
 		es:    es,
@@ -107,8 +118,8 @@ func (e *executor) createExecutionState(ctx context.Context, p *plan.Spec, a *me
 
 	// Only sources can be a MetadataNode at the moment so allocate enough
 	// space for all of them to report metadata. Not all of them will necessarily
-	// report metadata.
-	es.metaCh = make(chan flux.Metadata, len(es.sources))
+	// report metadata. The profiles of the nodes are reported last.
+	es.metaCh = make(chan flux.Metadata, len(es.sources)+1)
 
 	return v.es, nil
 }
@@ -181,6 +192,14 @@ func (v *createExecutionNodeVisitor) Visit(node plan.Node) error {
 		ec.parents[i] = DatasetIDFromNodeID(pred.ID())
 	}
 
+	// When profiling, each node gets its own allocator
+	// so the memory it uses can be attributed to it.
+	var np *nodeProfiler
+	if v.es.profiler != nil {
+		np = v.es.profiler.newNode(node, v.es.alloc)
+		ec.alloc = np.alloc
+	}
+
 	// If node is a leaf, create a source
 	if len(node.Predecessors()) == 0 {
 		createSourceFn, ok := procedureToSource[kind]
@@ -195,6 +214,12 @@ func (v *createExecutionNodeVisitor) Visit(node plan.Node) error {
 			return err
 		}
 
+		if np != nil {
+			source = &profiledSource{
+				profiledNode: profiledNode{Node: source, n: np},
+				src:          source,
+			}
+		}
 		v.es.sources = append(v.es.sources, source)
 		v.nodes[node] = source
 	} else {
@@ -218,10 +243,15 @@ func (v *createExecutionNodeVisitor) Visit(node plan.Node) error {
 		}
 		ds.SetTriggerSpec(ppn.TriggerSpec)
 		v.nodes[node] = ds
+		if np != nil {
+			tr = &profiledTransformation{t: tr, n: np}
+			v.nodes[node] = &profiledNode{Node: ds, n: np}
+		}
 
 		for _, p := range nonYieldPredecessors(node) {
 			executionNode := v.nodes[p]
 			transport := newConsecutiveTransport(v.es.dispatcher, tr)
+			transport.profile = np
 			v.es.transports = append(v.es.transports, transport)
 			executionNode.AddTransformation(transport)
 		}
@@ -280,13 +310,12 @@ func (es *executionState) do(ctx context.Context) {
 		}(src)
 	}
 
+	es.dispatcher.Start(es.resources.ConcurrencyQuota, ctx)
 	go func() {
+		// The metadata channel is closed once the sources and the
+		// transformations are done so the profiles are complete.
 		defer close(es.metaCh)
-		wg.Wait()
-	}()
 
-	es.dispatcher.Start(es.resources.ConcurrencyQuota, ctx)
-	go func() {
 		// Wait for all transports to finish
 		for _, t := range es.transports {
 			select {
@@ -304,6 +333,15 @@ func (es *executionState) do(ctx context.Context) {
 		if err != nil {
 			es.abort(err)
 		}
+
+		wg.Wait()
+		if es.profiler == nil {
+			return
+		}
+		es.metaCh <- es.profiler.Metadata()
+		if flux.IsExperimentalTracingEnabled() {
+			es.profiler.finishSpans(ctx)
+		}
 	}()
 }
 
@@ -313,6 +351,9 @@ type executionContext struct {
 	es            *executionState
 	parents       []DatasetID
 	streamContext streamContext
+
+	// alloc overrides the allocator of the execution state.
+	alloc *memory.Allocator
 }
 
 func resolveTime(qt flux.Time, now time.Time) Time {
@@ -332,6 +373,9 @@ func (ec executionContext) StreamContext() StreamContext {
 }
 
 func (ec executionContext) Allocator() *memory.Allocator {
+	if ec.alloc != nil {
+		return ec.alloc
+	}
 	return ec.es.alloc
 }
 
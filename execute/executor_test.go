@@ -3,6 +3,7 @@ package execute_test
 import (
 	"context"
 	"math"
+	"strings"
 	"testing"
 	"time"
 
@@ -19,6 +20,8 @@ import (
 	"github.com/influxdata/flux/plan/plantest"
 	"github.com/influxdata/flux/semantic"
 	"github.com/influxdata/flux/stdlib/universe"
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/mocktracer"
 	"go.uber.org/zap/zaptest"
 )
 
@@ -799,3 +802,186 @@ func TestExecutor_Execute(t *testing.T) {
 		})
 	}
 }
+
+// profiledPlanSpec returns a plan that sums two tables.
+func profiledPlanSpec() *plan.Spec {
+	return plantest.CreatePlanSpec(&plantest.PlanSpec{
+		Nodes: []plan.Node{
+			plan.CreatePhysicalNode("from-test", executetest.NewFromProcedureSpec(
+				[]*executetest.Table{
+					{
+						KeyCols: []string{"t0"},
+						ColMeta: []flux.ColMeta{
+							{Label: "t0", Type: flux.TString},
+							{Label: "_value", Type: flux.TFloat},
+						},
+						Data: [][]interface{}{
+							{"a", 1.0},
+							{"a", 2.0},
+							{"a", 3.0},
+						},
+					},
+					{
+						KeyCols: []string{"t0"},
+						ColMeta: []flux.ColMeta{
+							{Label: "t0", Type: flux.TString},
+							{Label: "_value", Type: flux.TFloat},
+						},
+						Data: [][]interface{}{
+							{"b", 4.0},
+							{"b", 5.0},
+						},
+					},
+				},
+			)),
+			plan.CreatePhysicalNode("sum", &universe.SumProcedureSpec{
+				AggregateConfig: execute.DefaultAggregateConfig,
+			}),
+			plan.CreatePhysicalNode("yield", executetest.NewYieldProcedureSpec("_result")),
+		},
+		Edges: [][2]int{
+			{0, 1},
+			{1, 2},
+		},
+		Resources: flux.ResourceManagement{
+			ConcurrencyQuota: 1,
+			MemoryBytesQuota: math.MaxInt64,
+		},
+		Now: time.Now(),
+	})
+}
+
+func TestExecutor_Profiler(t *testing.T) {
+	profiler := execute.NewProfiler()
+	ctx := executetest.NewTestExecuteDependencies().Inject(context.Background())
+	ctx = execute.ContextWithProfiler(ctx, profiler)
+
+	exe := execute.NewExecutor(zaptest.NewLogger(t))
+	results, metaCh, err := exe.Execute(ctx, profiledPlanSpec(), executetest.UnlimitedAllocator)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, r := range results {
+		if err := r.Tables().Do(func(tbl flux.Table) error {
+			_, err := executetest.ConvertTable(tbl)
+			return err
+		}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	md := make(flux.Metadata)
+	for m := range metaCh {
+		md.AddAll(m)
+	}
+
+	profiles := profiler.Profiles()
+	for i := range profiles {
+		if profiles[i].Duration <= 0 {
+			t.Errorf("expected a duration for node %s", profiles[i].NodeID)
+		}
+		if profiles[i].MaxAllocated > profiles[i].Allocated {
+			t.Errorf("max allocated exceeds the total allocated for node %s", profiles[i].NodeID)
+		}
+		if got := md[execute.ProfileMetadataKey(profiles[i].NodeID)]; len(got) != 1 || got[0] != profiles[i] {
+			t.Errorf("unexpected metadata for node %s: %v", profiles[i].NodeID, got)
+		}
+		profiles[i].Duration = 0
+		profiles[i].QueueDuration = 0
+		profiles[i].Allocated = 0
+		profiles[i].MaxAllocated = 0
+	}
+	want := []execute.NodeProfile{
+		{
+			NodeID:    "from-test",
+			Kind:      executetest.FromTestKind,
+			TablesOut: 2,
+			RowsOut:   5,
+		},
+		{
+			NodeID:    "sum",
+			Kind:      universe.SumKind,
+			TablesIn:  2,
+			RowsIn:    5,
+			TablesOut: 2,
+			RowsOut:   2,
+		},
+	}
+	if !cmp.Equal(want, profiles) {
+		t.Errorf("unexpected profiles -want/+got:\n%s", cmp.Diff(want, profiles))
+	}
+}
+
+func TestExecutor_NotProfiled(t *testing.T) {
+	ctx := executetest.NewTestExecuteDependencies().Inject(context.Background())
+	exe := execute.NewExecutor(zaptest.NewLogger(t))
+	results, metaCh, err := exe.Execute(ctx, profiledPlanSpec(), executetest.UnlimitedAllocator)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, r := range results {
+		if err := r.Tables().Do(func(tbl flux.Table) error {
+			_, err := executetest.ConvertTable(tbl)
+			return err
+		}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// Queries are not profiled unless they are analyzed.
+	for m := range metaCh {
+		for key := range m {
+			if strings.HasPrefix(key, "flux/profile/") {
+				t.Errorf("unexpected profile metadata %s", key)
+			}
+		}
+	}
+}
+
+// TestExecutor_ProfilerSpans enables experimental tracing,
+// so it must run after the tests of queries that are not profiled.
+func TestExecutor_ProfilerSpans(t *testing.T) {
+	flux.EnableExperimentalTracing()
+
+	// Spans are started with the global tracer.
+	tracer := mocktracer.New()
+	defer opentracing.SetGlobalTracer(opentracing.GlobalTracer())
+	opentracing.SetGlobalTracer(tracer)
+	parent := tracer.StartSpan("execute")
+	ctx := executetest.NewTestExecuteDependencies().Inject(context.Background())
+	ctx = opentracing.ContextWithSpan(ctx, parent)
+
+	exe := execute.NewExecutor(zaptest.NewLogger(t))
+	results, metaCh, err := exe.Execute(ctx, profiledPlanSpec(), executetest.UnlimitedAllocator)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, r := range results {
+		if err := r.Tables().Do(func(tbl flux.Table) error {
+			tbl.Done()
+			return nil
+		}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for range metaCh {
+	}
+
+	spans := make(map[string]*mocktracer.MockSpan)
+	for _, span := range tracer.FinishedSpans() {
+		if span.ParentID == parent.Context().(mocktracer.MockSpanContext).SpanID {
+			spans[span.OperationName] = span
+		}
+	}
+	for _, id := range []string{"from-test", "sum"} {
+		span, ok := spans[id]
+		if !ok {
+			t.Errorf("missing span for node %s", id)
+			continue
+		}
+		if want, got := int64(2), span.Tag("tables_out"); want != got {
+			t.Errorf("unexpected tables out for node %s -want/+got:\n\t- %v\n\t+ %v", id, want, got)
+		}
+		if span.FinishTime.Before(span.StartTime) {
+			t.Errorf("span for node %s finished before it started", id)
+		}
+	}
+}
@@ -0,0 +1,320 @@
+package execute
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/memory"
+	"github.com/influxdata/flux/plan"
+	"github.com/opentracing/opentracing-go"
+)
+
+// profileMetadataPrefix is the prefix of the metadata keys
+// that hold the profile of each node.
+const profileMetadataPrefix = "flux/profile/"
+
+// ProfileMetadataKey returns the key of the query metadata
+// that holds the NodeProfile for the plan node with the given ID.
+func ProfileMetadataKey(id plan.NodeID) string {
+	return profileMetadataPrefix + string(id)
+}
+
+// NodeProfile contains the runtime numbers collected for one node
+// of the physical plan while a query executes.
+type NodeProfile struct {
+	NodeID plan.NodeID        `json:"node_id"`
+	Kind   plan.ProcedureKind `json:"kind"`
+
+	// TablesIn and RowsIn count the tables and rows the node received.
+	// They are always zero for sources.
+	TablesIn int64 `json:"tables_in"`
+	RowsIn   int64 `json:"rows_in"`
+	// TablesOut and RowsOut count the tables and rows the node produced.
+	// Rows are only counted when a table is read by the next node.
+	TablesOut int64 `json:"tables_out"`
+	RowsOut   int64 `json:"rows_out"`
+
+	// Allocated is the total number of bytes allocated by the node
+	// and MaxAllocated is the most memory it held at any one time.
+	Allocated    int64 `json:"allocated"`
+	MaxAllocated int64 `json:"max_allocated"`
+
+	// Duration is the wall time spent inside of the node.
+	Duration time.Duration `json:"duration"`
+	// QueueDuration is the time the messages for the node waited
+	// in the dispatcher queue before a worker processed them.
+	QueueDuration time.Duration `json:"queue_duration"`
+}
+
+// Profiler collects a NodeProfile for each node of a query plan.
+// The executor only profiles a query when a Profiler is attached to
+// the context given to Execute with ContextWithProfiler, as in the analyze
+// mode of explain, or when experimental tracing is enabled.
+// The profiles are reported in the metadata of the query.
+type Profiler struct {
+	mu    sync.Mutex
+	nodes []*nodeProfiler
+}
+
+// NewProfiler creates an empty Profiler.
+func NewProfiler() *Profiler {
+	return &Profiler{}
+}
+
+// Profiles returns the profile of each node in the order
+// the nodes were created by the executor.
+// The profiles are only complete once all of the results
+// of the query have been consumed.
+func (p *Profiler) Profiles() []NodeProfile {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	profiles := make([]NodeProfile, len(p.nodes))
+	for i, n := range p.nodes {
+		profiles[i] = n.profile()
+	}
+	return profiles
+}
+
+// Metadata returns the profiles as query metadata
+// keyed by ProfileMetadataKey.
+func (p *Profiler) Metadata() flux.Metadata {
+	md := make(flux.Metadata)
+	for _, np := range p.Profiles() {
+		md.Add(ProfileMetadataKey(np.NodeID), np)
+	}
+	return md
+}
+
+// finishSpans records a span for each node that was active with the
+// tags of its profile. The spans are children of the span in ctx.
+func (p *Profiler) finishSpans(ctx context.Context) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for _, n := range p.nodes {
+		start, end := atomic.LoadInt64(&n.start), atomic.LoadInt64(&n.end)
+		if start == 0 {
+			continue
+		}
+		np := n.profile()
+		span, _ := opentracing.StartSpanFromContext(ctx, string(np.NodeID), opentracing.StartTime(time.Unix(0, start)))
+		span.SetTag("kind", string(np.Kind))
+		span.SetTag("tables_in", np.TablesIn)
+		span.SetTag("rows_in", np.RowsIn)
+		span.SetTag("tables_out", np.TablesOut)
+		span.SetTag("rows_out", np.RowsOut)
+		span.SetTag("allocated", np.Allocated)
+		span.SetTag("max_allocated", np.MaxAllocated)
+		span.SetTag("duration", np.Duration.String())
+		span.SetTag("queue_duration", np.QueueDuration.String())
+		span.FinishWithOptions(opentracing.FinishOptions{FinishTime: time.Unix(0, end)})
+	}
+}
+
+func (p *Profiler) newNode(node plan.Node, parent *memory.Allocator) *nodeProfiler {
+	np := &nodeProfiler{
+		id:    node.ID(),
+		kind:  node.Kind(),
+		alloc: &memory.Allocator{},
+	}
+	if parent != nil {
+		np.alloc.Allocator = parent
+	}
+	p.mu.Lock()
+	p.nodes = append(p.nodes, np)
+	p.mu.Unlock()
+	return np
+}
+
+type profilerKey struct{}
+
+// ContextWithProfiler returns a context that instructs the executor
+// to record the runtime numbers for each node in p.
+func ContextWithProfiler(ctx context.Context, p *Profiler) context.Context {
+	return context.WithValue(ctx, profilerKey{}, p)
+}
+
+// ProfilerFromContext returns the Profiler attached to the context
+// or nil if there is none.
+func ProfilerFromContext(ctx context.Context) *Profiler {
+	p, _ := ctx.Value(profilerKey{}).(*Profiler)
+	return p
+}
+
+// nodeProfiler records the numbers for a single node.
+type nodeProfiler struct {
+	id   plan.NodeID
+	kind plan.ProcedureKind
+
+	tablesIn, rowsIn   int64
+	tablesOut, rowsOut int64
+	duration           int64
+	queueDuration      int64
+
+	// start and end are the unix times in nanoseconds
+	// of the first and last time the node was active.
+	start, end int64
+
+	// alloc is the allocator given to the node.
+	// It forwards allocations to the query allocator.
+	alloc *memory.Allocator
+}
+
+func (n *nodeProfiler) profile() NodeProfile {
+	return NodeProfile{
+		NodeID:        n.id,
+		Kind:          n.kind,
+		TablesIn:      atomic.LoadInt64(&n.tablesIn),
+		RowsIn:        atomic.LoadInt64(&n.rowsIn),
+		TablesOut:     atomic.LoadInt64(&n.tablesOut),
+		RowsOut:       atomic.LoadInt64(&n.rowsOut),
+		Allocated:     n.alloc.TotalAllocated(),
+		MaxAllocated:  n.alloc.MaxAllocated(),
+		Duration:      time.Duration(atomic.LoadInt64(&n.duration)),
+		QueueDuration: time.Duration(atomic.LoadInt64(&n.queueDuration)),
+	}
+}
+
+func (n *nodeProfiler) track(start time.Time) {
+	now := time.Now()
+	atomic.AddInt64(&n.duration, int64(now.Sub(start)))
+	atomic.CompareAndSwapInt64(&n.start, 0, start.UnixNano())
+	atomic.StoreInt64(&n.end, now.UnixNano())
+}
+
+// queued records the time a transport of the node
+// waited in the dispatcher queue.
+func (n *nodeProfiler) queued(d time.Duration) {
+	atomic.AddInt64(&n.queueDuration, int64(d))
+}
+
+// countTable counts the table and its rows. Tables that are already
+// in memory are counted right away, other tables are counted when they are read.
+func (n *nodeProfiler) countTable(tbl flux.Table, tables, rows *int64) flux.Table {
+	atomic.AddInt64(tables, 1)
+	switch t := tbl.(type) {
+	case flux.ColReader:
+		atomic.AddInt64(rows, int64(t.Len()))
+	case flux.BufferedTable:
+		var count int64
+		for i, nbuf := 0, t.BufferN(); i < nbuf; i++ {
+			count += int64(t.Buffer(i).Len())
+		}
+		atomic.AddInt64(rows, count)
+	default:
+		return &profiledTable{Table: tbl, rows: rows}
+	}
+	return tbl
+}
+
+// profiledTransformation measures the time spent in a transformation
+// and counts the tables and rows that it receives.
+type profiledTransformation struct {
+	t Transformation
+	n *nodeProfiler
+}
+
+func (t *profiledTransformation) RetractTable(id DatasetID, key flux.GroupKey) error {
+	defer t.n.track(time.Now())
+	return t.t.RetractTable(id, key)
+}
+
+func (t *profiledTransformation) Process(id DatasetID, tbl flux.Table) error {
+	defer t.n.track(time.Now())
+	return t.t.Process(id, t.n.countTable(tbl, &t.n.tablesIn, &t.n.rowsIn))
+}
+
+func (t *profiledTransformation) UpdateWatermark(id DatasetID, mark Time) error {
+	defer t.n.track(time.Now())
+	return t.t.UpdateWatermark(id, mark)
+}
+
+func (t *profiledTransformation) UpdateProcessingTime(id DatasetID, pt Time) error {
+	defer t.n.track(time.Now())
+	return t.t.UpdateProcessingTime(id, pt)
+}
+
+func (t *profiledTransformation) Finish(id DatasetID, err error) {
+	defer t.n.track(time.Now())
+	t.t.Finish(id, err)
+}
+
+// profiledOutput counts the tables and rows that a node
+// sends to the first of its downstream transformations.
+type profiledOutput struct {
+	t Transformation
+	n *nodeProfiler
+}
+
+func (t *profiledOutput) RetractTable(id DatasetID, key flux.GroupKey) error {
+	return t.t.RetractTable(id, key)
+}
+
+func (t *profiledOutput) Process(id DatasetID, tbl flux.Table) error {
+	return t.t.Process(id, t.n.countTable(tbl, &t.n.tablesOut, &t.n.rowsOut))
+}
+
+func (t *profiledOutput) UpdateWatermark(id DatasetID, mark Time) error {
+	return t.t.UpdateWatermark(id, mark)
+}
+
+func (t *profiledOutput) UpdateProcessingTime(id DatasetID, pt Time) error {
+	return t.t.UpdateProcessingTime(id, pt)
+}
+
+func (t *profiledOutput) Finish(id DatasetID, err error) {
+	t.t.Finish(id, err)
+}
+
+// profiledNode wraps the execution node of a plan node so the
+// output of the node is counted.
+type profiledNode struct {
+	Node
+	n       *nodeProfiler
+	counted bool
+}
+
+func (pn *profiledNode) AddTransformation(t Transformation) {
+	if !pn.counted {
+		// Every downstream transformation receives the same tables
+		// so only the first one needs to be counted.
+		t = &profiledOutput{t: t, n: pn.n}
+		pn.counted = true
+	}
+	pn.Node.AddTransformation(t)
+}
+
+// profiledSource measures the time spent running a source.
+type profiledSource struct {
+	profiledNode
+	src Source
+}
+
+func (s *profiledSource) Run(ctx context.Context) {
+	defer s.n.track(time.Now())
+	s.src.Run(ctx)
+}
+
+func (s *profiledSource) Metadata() flux.Metadata {
+	if mdn, ok := s.src.(MetadataNode); ok {
+		return mdn.Metadata()
+	}
+	return nil
+}
+
+// profiledTable counts the rows of a table as they are read.
+type profiledTable struct {
+	flux.Table
+	rows *int64
+}
+
+func (t *profiledTable) Do(f func(flux.ColReader) error) error {
+	return t.Table.Do(func(cr flux.ColReader) error {
+		atomic.AddInt64(t.rows, int64(cr.Len()))
+		return f(cr)
+	})
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"regexp"
 
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/memory"
 	"github.com/influxdata/flux"
 	"github.com/influxdata/flux/codes"
 	"github.com/influxdata/flux/compiler"
@@ -17,6 +19,7 @@ type dynamicFn struct {
 	inRecord         values.Object
 
 	preparedFn compiler.Func
+	inType     semantic.Type
 
 	recordName string
 	record     *Record
@@ -54,7 +57,8 @@ func (f *dynamicFn) prepare(cols []flux.ColMeta, extraTypes map[string]semantic.
 	}
 
 	// Compile fn for given types
-	fn, err := f.compilationCache.Compile(semantic.NewObjectType(extraTypes))
+	f.inType = semantic.NewObjectType(extraTypes)
+	fn, err := f.compilationCache.Compile(f.inType)
 	if err != nil {
 		return err
 	}
@@ -153,6 +157,7 @@ func (f *TablePredicateFn) Eval(ctx context.Context, tbl flux.Table) (bool, erro
 
 type rowFn struct {
 	dynamicFn
+	preparedVector compiler.VectorFunc
 }
 
 func newRowFn(fn *semantic.FunctionExpression, scope compiler.Scope) (rowFn, error) {
@@ -161,6 +166,17 @@ func newRowFn(fn *semantic.FunctionExpression, scope compiler.Scope) (rowFn, err
 	}, nil
 }
 
+// prepareVector compiles the function to be evaluated over whole columns.
+// It must be called after prepare. Functions that cannot be vectorized
+// are left to be evaluated one row at a time.
+func (f *rowFn) prepareVector() {
+	fn, err := f.compilationCache.CompileVector(f.inType)
+	if err != nil {
+		fn = nil
+	}
+	f.preparedVector = fn
+}
+
 func (f *rowFn) eval(ctx context.Context, row int, cr flux.ColReader, extraParams map[string]values.Value) (values.Value, error) {
 	for r, col := range f.recordCols {
 		f.record.Set(r, ValueForRow(cr, row, col))
@@ -194,9 +210,27 @@ func (f *RowPredicateFn) Prepare(cols []flux.ColMeta) error {
 	if f.preparedFn.Type() != semantic.Bool {
 		return errors.New(codes.Invalid, "row predicate function does not evaluate to a boolean")
 	}
+	f.prepareVector()
 	return nil
 }
 
+// Vectorized reports whether the prepared function can be evaluated with EvalColumns.
+func (f *RowPredicateFn) Vectorized() bool {
+	return f.preparedVector != nil
+}
+
+// EvalColumns evaluates the predicate for every row of the column reader.
+// Rows where the predicate evaluates to null are not valid in the returned array.
+// If an error is returned, the rows must be evaluated one at a time
+// to find the row that caused it.
+func (f *RowPredicateFn) EvalColumns(ctx context.Context, cr flux.ColReader, mem memory.Allocator) (*array.Boolean, error) {
+	arr, err := f.preparedVector.Eval(ctx, cr, mem)
+	if err != nil {
+		return nil, err
+	}
+	return arr.(*array.Boolean), nil
+}
+
 func (f *RowPredicateFn) InputType() semantic.Type {
 	sig := f.preparedFn.FunctionSignature()
 	return sig.Parameters[f.recordName]
@@ -242,9 +276,24 @@ func (f *RowMapFn) Prepare(cols []flux.ColMeta) error {
 	if k != semantic.Object {
 		return errors.Newf(codes.Invalid, "map function must return an object, got %s", k.String())
 	}
+	f.prepareVector()
 	return nil
 }
 
+// Vectorized reports whether the prepared function can be evaluated with EvalColumns.
+func (f *RowMapFn) Vectorized() bool {
+	return f.preparedVector != nil
+}
+
+// EvalColumns evaluates the function for every row of the column reader
+// and returns the columns of the resulting records sorted by label.
+// Columns that are passed through unchanged are the arrays of the column reader.
+// If an error is returned, the rows must be evaluated one at a time
+// to find the row that caused it.
+func (f *RowMapFn) EvalColumns(ctx context.Context, cr flux.ColReader, mem memory.Allocator) ([]flux.ColMeta, []array.Interface, error) {
+	return f.preparedVector.EvalRecord(ctx, cr, mem)
+}
+
 func (f *RowMapFn) Type() semantic.Type {
 	return f.preparedFn.Type()
 }
@@ -383,6 +432,9 @@ func (r *Record) Regexp() *regexp.Regexp {
 func (r *Record) Array() values.Array {
 	panic(values.UnexpectedKind(semantic.Object, semantic.Array))
 }
+func (r *Record) Dict() values.Dictionary {
+	panic(values.UnexpectedKind(semantic.Object, semantic.Dictionary))
+}
 func (r *Record) Object() values.Object {
 	return r
 }
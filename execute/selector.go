@@ -19,7 +19,7 @@ type selectorTransformation struct {
 }
 
 type SelectorConfig struct {
-	plan.DefaultCost
+	plan.AggregateCost
 	Column string `json:"column"`
 }
 
@@ -0,0 +1,18 @@
+package execute
+
+import "context"
+
+type spillDirectoryKey struct{}
+
+// ContextWithSpillDirectory returns a context that allows transformations
+// to spill data to temporary files in the given directory.
+func ContextWithSpillDirectory(ctx context.Context, dir string) context.Context {
+	return context.WithValue(ctx, spillDirectoryKey{}, dir)
+}
+
+// SpillDirectory returns the directory where transformations may spill data
+// to disk. If spilling has not been enabled, it returns an empty string.
+func SpillDirectory(ctx context.Context) string {
+	dir, _ := ctx.Value(spillDirectoryKey{}).(string)
+	return dir
+}
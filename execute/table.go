@@ -1324,8 +1324,9 @@ func (b *ColListTableBuilder) SliceColumns(start, stop int) error {
 		default:
 			panic(fmt.Errorf("unexpected column type %v", c.Meta().Type))
 		}
-		b.nrows = stop - start
+		b.cols[i].sliceNils(start, stop)
 	}
+	b.nrows = stop - start
 
 	return nil
 }
@@ -1333,6 +1334,7 @@ func (b *ColListTableBuilder) SliceColumns(start, stop int) error {
 func (b *ColListTableBuilder) ClearData() {
 	for _, c := range b.cols {
 		c.Clear()
+		c.clearNils()
 	}
 	b.nrows = 0
 }
@@ -1340,6 +1342,7 @@ func (b *ColListTableBuilder) ClearData() {
 func (b *ColListTableBuilder) Release() {
 	for _, c := range b.cols {
 		c.Release()
+		c.clearNils()
 	}
 	b.nrows = 0
 }
@@ -1523,6 +1526,9 @@ type columnBuilder interface {
 	Equal(i, j int) bool
 	Less(i, j int) bool
 	Swap(i, j int)
+
+	clearNils()
+	sliceNils(start, stop int)
 }
 
 type columnBuilderBase struct {
@@ -1539,6 +1545,29 @@ func (c *columnBuilderBase) IsNil(i int) bool {
 	return c.nils[i]
 }
 
+// clearNils forgets which rows were nil so the builder
+// can be reused after its data has been cleared.
+func (c *columnBuilderBase) clearNils() {
+	if len(c.nils) > 0 {
+		c.nils = make(map[int]bool)
+	}
+}
+
+// sliceNils keeps the nil markers for the rows in the range [start:stop]
+// and renumbers them so they match the re-sliced data.
+func (c *columnBuilderBase) sliceNils(start, stop int) {
+	if len(c.nils) == 0 {
+		return
+	}
+	nils := make(map[int]bool)
+	for i := range c.nils {
+		if i >= start && i < stop {
+			nils[i-start] = true
+		}
+	}
+	c.nils = nils
+}
+
 func (c *columnBuilderBase) SetNil(i int, isNil bool) {
 	if isNil {
 		c.nils[i] = isNil
@@ -323,6 +323,50 @@ func TestColListTable_SetNil(t *testing.T) {
 	}
 }
 
+func TestColListTable_ClearData(t *testing.T) {
+	key := execute.NewGroupKey(nil, nil)
+	tb := execute.NewColListTableBuilder(key, &memory.Allocator{})
+
+	// Add a column for the value.
+	idx, _ := tb.AddCol(flux.ColMeta{
+		Label: execute.DefaultValueColLabel,
+		Type:  flux.TFloat,
+	})
+
+	// Add a nil value and then clear the data.
+	_ = tb.AppendNil(idx)
+	_ = tb.AppendFloat(idx, 1.0)
+	tb.ClearData()
+
+	// The rows appended after clearing the data should not be nil.
+	_ = tb.AppendFloat(idx, 2.0)
+	_ = tb.AppendFloat(idx, 3.0)
+
+	// Build the table and then verify the arrow table.
+	tbl, err := tb.Table()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if err := tbl.Do(func(cr flux.ColReader) error {
+		vs := cr.Floats(idx)
+		if got, want := vs.Len(), 2; got != want {
+			t.Errorf("unexpected length -want/+got\n\t- %d\n\t+ %d", want, got)
+			return nil
+		}
+
+		if vs.IsNull(0) {
+			t.Error("first value should not be null")
+		}
+		if vs.IsNull(1) {
+			t.Error("second value should not be null")
+		}
+		return nil
+	}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+}
+
 func TestCopyTable(t *testing.T) {
 	alloc := &memory.Allocator{}
 
@@ -5,6 +5,7 @@ import (
 	"reflect"
 	"sync"
 	"sync/atomic"
+	"time"
 
 	"github.com/influxdata/flux"
 	"github.com/opentracing/opentracing-go"
@@ -29,6 +30,12 @@ type consecutiveTransport struct {
 
 	schedulerState int32
 	inflight       int32
+
+	// scheduled is the unix time in nanoseconds when the
+	// transport was last handed to the dispatcher.
+	scheduled int64
+	// profile records the time spent waiting for the dispatcher.
+	profile *nodeProfiler
 }
 
 func newConsecutiveTransport(dispatcher Dispatcher, t Transformation) *consecutiveTransport {
@@ -137,6 +144,9 @@ const (
 // schedule indicates that there is work available to schedule.
 func (t *consecutiveTransport) schedule() {
 	if t.tryTransition(idle, running) {
+		if t.profile != nil {
+			atomic.StoreInt64(&t.scheduled, time.Now().UnixNano())
+		}
 		t.dispatcher.Schedule(t.processMessages)
 	}
 }
@@ -152,6 +162,9 @@ func (t *consecutiveTransport) transition(new int32) {
 }
 
 func (t *consecutiveTransport) processMessages(ctx context.Context, throughput int) {
+	if t.profile != nil {
+		t.profile.queued(time.Since(time.Unix(0, atomic.LoadInt64(&t.scheduled))))
+	}
 PROCESS:
 	i := 0
 	for m := t.messages.Pop(); m != nil; m = t.messages.Pop() {
@@ -12,6 +12,10 @@ type Window struct {
 	Every  Duration
 	Period Duration
 	Offset Duration
+
+	// Location is the location whose civil time the window
+	// boundaries are aligned with. The zero value is UTC.
+	Location values.Location
 }
 
 // NewWindow creates a window with the given parameters,
@@ -33,6 +37,22 @@ func NewWindow(every, period, offset Duration) (Window, error) {
 	return w, nil
 }
 
+// NewWindowInLocation creates a window in the same way as NewWindow,
+// but the window boundaries are aligned with the civil time of the location.
+// A window of one day in a location with daylight saving time will
+// always start at midnight, even if the day is 23 or 25 hours long.
+func NewWindowInLocation(every, period, offset Duration, location values.Location) (Window, error) {
+	w, err := NewWindow(every, period, offset)
+	if err != nil {
+		return Window{}, err
+	}
+	if err := location.Validate(); err != nil {
+		return Window{}, err
+	}
+	w.Location = location
+	return w, nil
+}
+
 type truncateFunc func(t Time, d Duration) Time
 
 func (w *Window) getTruncateFunc(d Duration) (truncateFunc, error) {
@@ -68,6 +88,37 @@ func (w Window) IsValid() error {
 // that contains the given time t.  For underlapping windows that
 // do not contain time t, the window directly after time t will be returned.
 func (w Window) GetEarliestBounds(t Time) Bounds {
+	ct := w.Location.ToCivil(t)
+	b := w.getEarliestCivilBounds(ct)
+	if w.isCivil() {
+		return Bounds{
+			Start: w.Location.FromCivil(b.Start),
+			Stop:  w.Location.FromCivil(b.Stop),
+		}
+	}
+	// Windows shorter than a day are aligned with the civil time of t,
+	// but they keep their length across daylight saving time transitions.
+	shift := t - ct
+	return Bounds{
+		Start: b.Start + shift,
+		Stop:  b.Stop + shift,
+	}
+}
+
+// isCivil reports whether the window is a number of days, weeks, months or years.
+// These windows are stepped through in civil time, so a day is 23 or 25 hours long
+// across a daylight saving time transition. Shorter windows are stepped
+// through in absolute time so that no hour is skipped or repeated.
+func (w Window) isCivil() bool {
+	if w.Location.IsUTC() {
+		return false
+	}
+	return w.Every.Months() != 0 || w.Every.Nanoseconds()%int64(24*time.Hour) == 0
+}
+
+// getEarliestCivilBounds returns the earliest bounds that contain
+// the civil time t. The returned bounds are also in civil time.
+func (w Window) getEarliestCivilBounds(t Time) Bounds {
 	// translate to not-offset coordinate
 	t = t.Add(w.Offset.Mul(-1))
 
@@ -94,9 +145,27 @@ func (w Window) GetOverlappingBounds(b Bounds) []Bounds {
 	c := (b.Duration().Duration() / w.Every.Duration()) + (w.Period.Duration() / w.Every.Duration())
 	bs := make([]Bounds, 0, c)
 
-	bi := w.GetEarliestBounds(b.Start)
-	for bi.Start < b.Stop {
-		bs = append(bs, bi)
+	if !w.isCivil() {
+		for bi := w.GetEarliestBounds(b.Start); bi.Start < b.Stop; {
+			bs = append(bs, bi)
+			bi.Start = bi.Start.Add(w.Every)
+			bi.Stop = bi.Stop.Add(w.Every)
+		}
+		return bs
+	}
+
+	// Windows of days, months and years are stepped through in civil time
+	// so that their boundaries stay aligned across daylight saving time transitions.
+	bi := w.getEarliestCivilBounds(w.Location.ToCivil(b.Start))
+	for {
+		bounds := Bounds{
+			Start: w.Location.FromCivil(bi.Start),
+			Stop:  w.Location.FromCivil(bi.Stop),
+		}
+		if bounds.Start >= b.Stop {
+			break
+		}
+		bs = append(bs, bounds)
 		bi.Start = bi.Start.Add(w.Every)
 		bi.Stop = bi.Stop.Add(w.Every)
 	}
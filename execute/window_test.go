@@ -174,6 +174,20 @@ func TestWindow_GetEarliestBounds(t *testing.T) {
 				Stop:  execute.Time(5*time.Minute + 30*time.Second),
 			},
 		},
+		{
+			name: "hourly in location in repeated hour",
+			w: execute.Window{
+				Every:    mustParseDuration("1h"),
+				Period:   mustParseDuration("1h"),
+				Location: values.Location{Name: "America/New_York"},
+			},
+			// 1:30am EST, after the clocks were set back from 2am EDT.
+			t: mustParseTime("2019-11-03T06:30:00Z"),
+			want: execute.Bounds{
+				Start: mustParseTime("2019-11-03T06:00:00Z"),
+				Stop:  mustParseTime("2019-11-03T07:00:00Z"),
+			},
+		},
 	}
 
 	for _, tc := range testcases {
@@ -372,6 +386,105 @@ func TestWindow_GetOverlappingBounds(t *testing.T) {
 				{Start: ts("2019-12-01T00:00:00Z"), Stop: ts("2020-03-01T00:00:00Z")},
 			},
 		},
+		{
+			name: "daily in location across dst start",
+			w: execute.Window{
+				Every:    ds("1d"),
+				Period:   ds("1d"),
+				Location: values.Location{Name: "America/New_York"},
+			},
+			b: execute.Bounds{
+				Start: ts("2019-03-09T12:00:00Z"),
+				Stop:  ts("2019-03-11T12:00:00Z"),
+			},
+			want: []execute.Bounds{
+				{Start: ts("2019-03-09T05:00:00Z"), Stop: ts("2019-03-10T05:00:00Z")},
+				{Start: ts("2019-03-10T05:00:00Z"), Stop: ts("2019-03-11T04:00:00Z")},
+				{Start: ts("2019-03-11T04:00:00Z"), Stop: ts("2019-03-12T04:00:00Z")},
+			},
+		},
+		{
+			name: "daily in location across dst end",
+			w: execute.Window{
+				Every:    ds("1d"),
+				Period:   ds("1d"),
+				Location: values.Location{Name: "Europe/Berlin"},
+			},
+			b: execute.Bounds{
+				Start: ts("2019-10-26T12:00:00Z"),
+				Stop:  ts("2019-10-27T12:00:00Z"),
+			},
+			want: []execute.Bounds{
+				{Start: ts("2019-10-25T22:00:00Z"), Stop: ts("2019-10-26T22:00:00Z")},
+				{Start: ts("2019-10-26T22:00:00Z"), Stop: ts("2019-10-27T23:00:00Z")},
+			},
+		},
+		{
+			name: "hourly in location across dst start",
+			w: execute.Window{
+				Every:    ds("1h"),
+				Period:   ds("1h"),
+				Location: values.Location{Name: "America/New_York"},
+			},
+			b: execute.Bounds{
+				Start: ts("2019-03-10T06:00:00Z"),
+				Stop:  ts("2019-03-10T08:00:00Z"),
+			},
+			// 2am is skipped, so the window after 1am EST starts at 3am EDT.
+			want: []execute.Bounds{
+				{Start: ts("2019-03-10T06:00:00Z"), Stop: ts("2019-03-10T07:00:00Z")},
+				{Start: ts("2019-03-10T07:00:00Z"), Stop: ts("2019-03-10T08:00:00Z")},
+			},
+		},
+		{
+			name: "hourly in location across dst end",
+			w: execute.Window{
+				Every:    ds("1h"),
+				Period:   ds("1h"),
+				Location: values.Location{Name: "America/New_York"},
+			},
+			b: execute.Bounds{
+				Start: ts("2019-11-03T04:30:00Z"),
+				Stop:  ts("2019-11-03T07:00:00Z"),
+			},
+			// 1am is repeated, so there is a window for 1am EDT and for 1am EST.
+			want: []execute.Bounds{
+				{Start: ts("2019-11-03T04:00:00Z"), Stop: ts("2019-11-03T05:00:00Z")},
+				{Start: ts("2019-11-03T05:00:00Z"), Stop: ts("2019-11-03T06:00:00Z")},
+				{Start: ts("2019-11-03T06:00:00Z"), Stop: ts("2019-11-03T07:00:00Z")},
+			},
+		},
+		{
+			name: "monthly in location",
+			w: execute.Window{
+				Every:    ds("1mo"),
+				Period:   ds("1mo"),
+				Location: values.Location{Name: "Asia/Tokyo"},
+			},
+			b: execute.Bounds{
+				Start: ts("2019-01-15T00:00:00Z"),
+				Stop:  ts("2019-02-15T00:00:00Z"),
+			},
+			want: []execute.Bounds{
+				{Start: ts("2018-12-31T15:00:00Z"), Stop: ts("2019-01-31T15:00:00Z")},
+				{Start: ts("2019-01-31T15:00:00Z"), Stop: ts("2019-02-28T15:00:00Z")},
+			},
+		},
+		{
+			name: "daily with fixed offset",
+			w: execute.Window{
+				Every:    ds("1d"),
+				Period:   ds("1d"),
+				Location: values.Location{Name: "UTC", Offset: ds("-5h")},
+			},
+			b: execute.Bounds{
+				Start: ts("2019-03-10T12:00:00Z"),
+				Stop:  ts("2019-03-11T00:00:00Z"),
+			},
+			want: []execute.Bounds{
+				{Start: ts("2019-03-10T05:00:00Z"), Stop: ts("2019-03-11T05:00:00Z")},
+			},
+		},
 	}
 
 	for _, tc := range testcases {
@@ -0,0 +1,138 @@
+package influxql
+
+import (
+	"regexp"
+	"time"
+)
+
+// Query is a list of statements separated by semicolons.
+type Query struct {
+	Statements []*SelectStatement
+}
+
+// SelectStatement represents an InfluxQL SELECT statement.
+type SelectStatement struct {
+	// Fields are the expressions returned by the statement.
+	Fields []*Field
+
+	// Sources are the measurements to read from.
+	Sources []*Measurement
+
+	// Condition is the WHERE clause, if any.
+	Condition Expr
+
+	// Dimensions are the GROUP BY expressions.
+	Dimensions []Expr
+
+	// Fill is the fill option used for empty windows.
+	Fill FillOption
+	// FillValue is the value used when Fill is NumberFill.
+	FillValue Expr
+
+	// Descending is true when the results are ordered by time descending.
+	Descending bool
+
+	Limit, Offset   int
+	SLimit, SOffset int
+}
+
+// Field is a single expression in the SELECT clause.
+type Field struct {
+	Expr  Expr
+	Alias string
+}
+
+// Measurement is a measurement in the FROM clause.
+// Either Name or Regex is set.
+type Measurement struct {
+	Database        string
+	RetentionPolicy string
+	Name            string
+	Regex           *regexp.Regexp
+}
+
+// FillOption is the fill option of a SELECT statement.
+type FillOption int
+
+const (
+	NullFill FillOption = iota
+	NoFill
+	NumberFill
+	PreviousFill
+	LinearFill
+)
+
+// Expr is an InfluxQL expression.
+type Expr interface {
+	expr()
+}
+
+// VarRef is a reference to a tag, field or the time column.
+type VarRef struct {
+	Val string
+	// Type is the explicit type hint given with the :: syntax, if any.
+	Type string
+}
+
+// Call is a function call.
+type Call struct {
+	Name string
+	Args []Expr
+}
+
+// BinaryExpr is an expression with an operator between two expressions.
+type BinaryExpr struct {
+	Op  Token
+	LHS Expr
+	RHS Expr
+}
+
+// ParenExpr is a parenthesized expression.
+type ParenExpr struct {
+	Expr Expr
+}
+
+// Wildcard is the * in SELECT * or GROUP BY *.
+type Wildcard struct{}
+
+// StringLiteral is a single quoted string.
+type StringLiteral struct {
+	Val string
+}
+
+// IntegerLiteral is an integer.
+type IntegerLiteral struct {
+	Val int64
+}
+
+// NumberLiteral is a floating point number.
+type NumberLiteral struct {
+	Val float64
+}
+
+// BooleanLiteral is true or false.
+type BooleanLiteral struct {
+	Val bool
+}
+
+// DurationLiteral is a duration such as 5m.
+type DurationLiteral struct {
+	Val time.Duration
+}
+
+// RegexLiteral is a regular expression delimited by slashes.
+type RegexLiteral struct {
+	Val *regexp.Regexp
+}
+
+func (*VarRef) expr()          {}
+func (*Call) expr()            {}
+func (*BinaryExpr) expr()      {}
+func (*ParenExpr) expr()       {}
+func (*Wildcard) expr()        {}
+func (*StringLiteral) expr()   {}
+func (*IntegerLiteral) expr()  {}
+func (*NumberLiteral) expr()   {}
+func (*BooleanLiteral) expr()  {}
+func (*DurationLiteral) expr() {}
+func (*RegexLiteral) expr()    {}
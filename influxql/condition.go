@@ -0,0 +1,349 @@
+package influxql
+
+import (
+	"strings"
+	"time"
+
+	"github.com/influxdata/flux/ast"
+	"github.com/influxdata/flux/codes"
+	"github.com/influxdata/flux/internal/errors"
+)
+
+// timeRange is the time range selected by the conditions on time.
+// The start is inclusive and the stop is exclusive.
+type timeRange struct {
+	start, stop       time.Time
+	hasStart, hasStop bool
+}
+
+func (tr *timeRange) setStart(t time.Time) {
+	if !tr.hasStart || t.After(tr.start) {
+		tr.start, tr.hasStart = t, true
+	}
+}
+
+func (tr *timeRange) setStop(t time.Time) {
+	if !tr.hasStop || t.Before(tr.stop) {
+		tr.stop, tr.hasStop = t, true
+	}
+}
+
+// condition is the WHERE clause of a statement split
+// into the parts that are transpiled separately.
+type condition struct {
+	timeRange timeRange
+	// tags contains the conditions that only reference tags.
+	tags Expr
+	// fields contains the conditions that reference at least one field.
+	fields Expr
+	// fieldNames are the fields referenced by the field conditions.
+	fieldNames []string
+}
+
+// splitCondition splits the top level conjunctions of the condition into
+// conditions on time, conditions on tags and conditions on fields.
+// Identifiers are considered fields if they are selected by the statement,
+// have a field data type hint or are compared to a number or boolean.
+// Other identifiers are considered tags.
+func (t *Transpiler) splitCondition(cond Expr, selected map[string]bool) (*condition, error) {
+	c := new(condition)
+	if cond == nil {
+		return c, nil
+	}
+	for _, expr := range conjunctions(cond, nil) {
+		if isTimeCondition(expr) {
+			if err := t.applyTimeCondition(&c.timeRange, expr); err != nil {
+				return nil, err
+			}
+			continue
+		} else if referencesTime(expr) {
+			return nil, errors.New(codes.Invalid, "conditions on time must be combined with AND at the top level of the WHERE clause")
+		}
+
+		var fields []string
+		walkComparisons(expr, func(ref *VarRef, other Expr) {
+			if isFieldRef(ref, other, selected) {
+				fields = append(fields, ref.Val)
+			}
+		})
+		if len(fields) == 0 {
+			c.tags = and(c.tags, expr)
+			continue
+		}
+		c.fields = and(c.fields, expr)
+		for _, name := range fields {
+			c.fieldNames = appendUnique(c.fieldNames, name)
+		}
+	}
+	return c, nil
+}
+
+func and(lhs, rhs Expr) Expr {
+	if lhs == nil {
+		return rhs
+	}
+	return &BinaryExpr{Op: AND, LHS: lhs, RHS: rhs}
+}
+
+// conjunctions returns the expressions joined with AND at the top level.
+func conjunctions(expr Expr, exprs []Expr) []Expr {
+	switch e := expr.(type) {
+	case *ParenExpr:
+		return conjunctions(e.Expr, exprs)
+	case *BinaryExpr:
+		if e.Op == AND {
+			exprs = conjunctions(e.LHS, exprs)
+			return conjunctions(e.RHS, exprs)
+		}
+	}
+	return append(exprs, expr)
+}
+
+func isTimeRef(expr Expr) bool {
+	ref, ok := expr.(*VarRef)
+	return ok && strings.ToLower(ref.Val) == "time"
+}
+
+func isTimeCondition(expr Expr) bool {
+	e, ok := expr.(*BinaryExpr)
+	if !ok {
+		return false
+	}
+	switch e.Op {
+	case EQ, LT, LTE, GT, GTE:
+		return isTimeRef(e.LHS) || isTimeRef(e.RHS)
+	}
+	return false
+}
+
+func referencesTime(expr Expr) bool {
+	switch e := expr.(type) {
+	case *VarRef:
+		return isTimeRef(e)
+	case *ParenExpr:
+		return referencesTime(e.Expr)
+	case *BinaryExpr:
+		return referencesTime(e.LHS) || referencesTime(e.RHS)
+	case *Call:
+		for _, arg := range e.Args {
+			if referencesTime(arg) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// applyTimeCondition narrows the time range with a comparison on time.
+func (t *Transpiler) applyTimeCondition(tr *timeRange, expr Expr) error {
+	e := expr.(*BinaryExpr)
+	op, other := e.Op, e.RHS
+	if !isTimeRef(e.LHS) {
+		// Flip the comparison so time is on the left.
+		other = e.LHS
+		switch op {
+		case LT:
+			op = GT
+		case LTE:
+			op = GTE
+		case GT:
+			op = LT
+		case GTE:
+			op = LTE
+		}
+	}
+
+	ts, err := t.evalTime(other)
+	if err != nil {
+		return err
+	}
+	switch op {
+	case EQ:
+		tr.setStart(ts)
+		tr.setStop(ts.Add(1))
+	case GT:
+		tr.setStart(ts.Add(1))
+	case GTE:
+		tr.setStart(ts)
+	case LT:
+		tr.setStop(ts)
+	case LTE:
+		tr.setStop(ts.Add(1))
+	}
+	return nil
+}
+
+// evalTime evaluates an expression that is compared with time.
+func (t *Transpiler) evalTime(expr Expr) (time.Time, error) {
+	switch e := expr.(type) {
+	case *ParenExpr:
+		return t.evalTime(e.Expr)
+	case *Call:
+		if e.Name == "now" && len(e.Args) == 0 {
+			return t.now(), nil
+		}
+	case *StringLiteral:
+		for _, layout := range []string{time.RFC3339Nano, "2006-01-02 15:04:05.999999999", "2006-01-02"} {
+			if ts, err := time.Parse(layout, e.Val); err == nil {
+				return ts.UTC(), nil
+			}
+		}
+		return time.Time{}, errors.Newf(codes.Invalid, "invalid time %q", e.Val)
+	case *IntegerLiteral:
+		return time.Unix(0, e.Val).UTC(), nil
+	case *BinaryExpr:
+		if e.Op != ADD && e.Op != SUB {
+			break
+		}
+		ts, err := t.evalTime(e.LHS)
+		if err != nil {
+			return time.Time{}, err
+		}
+		var d time.Duration
+		switch rhs := e.RHS.(type) {
+		case *DurationLiteral:
+			d = rhs.Val
+		case *IntegerLiteral:
+			d = time.Duration(rhs.Val)
+		default:
+			return time.Time{}, errors.New(codes.Invalid, "time arithmetic requires a duration")
+		}
+		if e.Op == SUB {
+			d = -d
+		}
+		return ts.Add(d), nil
+	}
+	return time.Time{}, errors.New(codes.Invalid, "invalid time comparison; time must be compared with a time string, integer or now()")
+}
+
+// walkComparisons calls f for every identifier in a comparison
+// with the expression it is compared against.
+func walkComparisons(expr Expr, f func(ref *VarRef, other Expr)) {
+	switch e := expr.(type) {
+	case *ParenExpr:
+		walkComparisons(e.Expr, f)
+	case *VarRef:
+		f(e, nil)
+	case *BinaryExpr:
+		if e.Op.Precedence() == 3 {
+			if ref, ok := e.LHS.(*VarRef); ok {
+				f(ref, e.RHS)
+			} else {
+				walkComparisons(e.LHS, f)
+			}
+			if ref, ok := e.RHS.(*VarRef); ok {
+				f(ref, e.LHS)
+			} else {
+				walkComparisons(e.RHS, f)
+			}
+			return
+		}
+		walkComparisons(e.LHS, f)
+		walkComparisons(e.RHS, f)
+	}
+}
+
+func isFieldRef(ref *VarRef, other Expr, selected map[string]bool) bool {
+	switch ref.Type {
+	case "tag":
+		return false
+	case "field", "float", "integer", "unsigned", "string", "boolean":
+		return true
+	}
+	if selected[ref.Val] {
+		return true
+	}
+	switch other.(type) {
+	case *StringLiteral, *RegexLiteral:
+		return false
+	}
+	return true
+}
+
+// fluxCondition converts a condition into the body of a Flux row function.
+func fluxCondition(expr Expr) (ast.Expression, error) {
+	switch e := expr.(type) {
+	case *ParenExpr:
+		inner, err := fluxCondition(e.Expr)
+		if err != nil {
+			return nil, err
+		}
+		return &ast.ParenExpression{Expression: inner}, nil
+	case *VarRef:
+		return column(e.Val), nil
+	case *StringLiteral:
+		return &ast.StringLiteral{Value: e.Val}, nil
+	case *IntegerLiteral:
+		return &ast.IntegerLiteral{Value: e.Val}, nil
+	case *NumberLiteral:
+		return &ast.FloatLiteral{Value: e.Val}, nil
+	case *BooleanLiteral:
+		return &ast.BooleanLiteral{Value: e.Val}, nil
+	case *RegexLiteral:
+		return &ast.RegexpLiteral{Value: e.Val}, nil
+	case *BinaryExpr:
+		lhs, err := fluxOperand(e.LHS, e.RHS)
+		if err != nil {
+			return nil, err
+		}
+		rhs, err := fluxOperand(e.RHS, e.LHS)
+		if err != nil {
+			return nil, err
+		}
+		switch e.Op {
+		case AND:
+			return &ast.LogicalExpression{Operator: ast.AndOperator, Left: lhs, Right: rhs}, nil
+		case OR:
+			return &ast.LogicalExpression{Operator: ast.OrOperator, Left: lhs, Right: rhs}, nil
+		}
+		op, ok := binaryOperators[e.Op]
+		if !ok {
+			return nil, errors.Newf(codes.Invalid, "unsupported operator %s", e.Op)
+		}
+		return &ast.BinaryExpression{Operator: op, Left: lhs, Right: rhs}, nil
+	}
+	return nil, errors.Newf(codes.Invalid, "unsupported expression %T in WHERE clause", expr)
+}
+
+// fluxOperand converts an operand of a binary expression.
+// Integers compared with a field are converted to floats
+// unless the field has an integer data type hint because
+// Flux does not compare integers with floats.
+func fluxOperand(expr, other Expr) (ast.Expression, error) {
+	if i, ok := expr.(*IntegerLiteral); ok {
+		switch ref, _ := other.(*VarRef); {
+		case ref == nil:
+		case ref.Type == "integer":
+		case ref.Type == "unsigned":
+			return &ast.UnsignedIntegerLiteral{Value: uint64(i.Val)}, nil
+		default:
+			return &ast.FloatLiteral{Value: float64(i.Val)}, nil
+		}
+	}
+	return fluxCondition(expr)
+}
+
+var binaryOperators = map[Token]ast.OperatorKind{
+	ADD:      ast.AdditionOperator,
+	SUB:      ast.SubtractionOperator,
+	MUL:      ast.MultiplicationOperator,
+	DIV:      ast.DivisionOperator,
+	MOD:      ast.ModuloOperator,
+	EQ:       ast.EqualOperator,
+	NEQ:      ast.NotEqualOperator,
+	LT:       ast.LessThanOperator,
+	LTE:      ast.LessThanEqualOperator,
+	GT:       ast.GreaterThanOperator,
+	GTE:      ast.GreaterThanEqualOperator,
+	EQREGEX:  ast.RegexpMatchOperator,
+	NEQREGEX: ast.NotRegexpMatchOperator,
+}
+
+func appendUnique(list []string, s string) []string {
+	for _, v := range list {
+		if v == s {
+			return list
+		}
+	}
+	return append(list, s)
+}
@@ -0,0 +1,112 @@
+package influxql
+
+import (
+	"github.com/influxdata/flux/ast"
+	"github.com/influxdata/flux/codes"
+	"github.com/influxdata/flux/internal/errors"
+)
+
+// aggregate is a call to an aggregate or selector in the SELECT clause.
+type aggregate struct {
+	name   string
+	field  *VarRef
+	output string
+	// q is the quantile for percentile.
+	q float64
+}
+
+// isSelector reports whether the function selects a point
+// rather than computing a new value.
+func (a *aggregate) isSelector() bool {
+	switch a.name {
+	case "first", "last", "min", "max", "percentile":
+		return true
+	}
+	return false
+}
+
+// isFloat reports whether the function always produces a float.
+func (a *aggregate) isFloat() bool {
+	switch a.name {
+	case "mean", "median", "stddev":
+		return true
+	}
+	return false
+}
+
+func newAggregate(call *Call) (*aggregate, error) {
+	a := &aggregate{name: call.Name}
+	nargs := 1
+	switch call.Name {
+	case "count", "mean", "median", "sum", "spread", "stddev", "first", "last", "min", "max":
+	case "percentile":
+		nargs = 2
+	default:
+		return nil, errors.Newf(codes.Unimplemented, "function %s() is not supported", call.Name)
+	}
+	if len(call.Args) != nargs {
+		return nil, errors.Newf(codes.Invalid, "invalid number of arguments for %s, expected %d, got %d", call.Name, nargs, len(call.Args))
+	}
+
+	ref, ok := call.Args[0].(*VarRef)
+	if !ok {
+		return nil, errors.Newf(codes.Unimplemented, "%s() only supports a field as its argument", call.Name)
+	}
+	a.field = ref
+
+	if call.Name == "percentile" {
+		switch n := call.Args[1].(type) {
+		case *IntegerLiteral:
+			a.q = float64(n.Val) / 100
+		case *NumberLiteral:
+			a.q = n.Val / 100
+		default:
+			return nil, errors.New(codes.Invalid, "expected a number as the second argument of percentile()")
+		}
+		if a.q < 0 || a.q > 1 {
+			return nil, errors.New(codes.Invalid, "percentile must be between 0 and 100")
+		}
+	}
+	return a, nil
+}
+
+// function returns the Flux function used for the aggregate with aggregateWindow.
+func (a *aggregate) function() ast.Expression {
+	switch a.name {
+	case "median", "percentile":
+		// (column, tables=<-) => tables |> quantile(...)
+		return &ast.FunctionExpression{
+			Params: []*ast.Property{
+				{Key: &ast.Identifier{Name: "column"}},
+				{Key: &ast.Identifier{Name: "tables"}, Value: &ast.PipeLiteral{}},
+			},
+			Body: pipeline(&ast.Identifier{Name: "tables"}, a.call(&ast.Identifier{Name: "column"})),
+		}
+	}
+	return &ast.Identifier{Name: a.name}
+}
+
+// call returns a call to the aggregate with the given column.
+// The column is omitted if it is nil.
+func (a *aggregate) call(col ast.Expression) *ast.CallExpression {
+	name := a.name
+	var args []*ast.Property
+	switch a.name {
+	case "median":
+		name = "quantile"
+		args = append(args,
+			property("q", &ast.FloatLiteral{Value: 0.5}),
+			property("method", &ast.StringLiteral{Value: "exact_mean"}),
+		)
+	case "percentile":
+		name = "quantile"
+		args = append(args,
+			property("q", &ast.FloatLiteral{Value: a.q}),
+			property("method", &ast.StringLiteral{Value: "exact_selector"}),
+		)
+	}
+	if col != nil {
+		args = append(args, property("column", col))
+	}
+	return call(name, args...)
+}
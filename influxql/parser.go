@@ -0,0 +1,522 @@
+package influxql
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/influxdata/flux/codes"
+	"github.com/influxdata/flux/internal/errors"
+)
+
+// ParseQuery parses a query string into its statements.
+// Only SELECT statements are supported.
+func ParseQuery(s string) (*Query, error) {
+	p := &parser{s: scanner{s: s}}
+	q := new(Query)
+	for {
+		if tok, _, _ := p.scan(); tok == EOF {
+			break
+		} else if tok == SEMICOLON {
+			continue
+		}
+		p.unscan()
+
+		stmt, err := p.parseSelectStatement()
+		if err != nil {
+			return nil, err
+		}
+		q.Statements = append(q.Statements, stmt)
+
+		if tok, pos, lit := p.scan(); tok != SEMICOLON && tok != EOF {
+			return nil, newParseError(tok, lit, pos, ";")
+		}
+	}
+	if len(q.Statements) == 0 {
+		return nil, errors.New(codes.Invalid, "query must contain at least one statement")
+	}
+	return q, nil
+}
+
+type bufferedToken struct {
+	tok Token
+	pos int
+	lit string
+}
+
+// parser is a recursive descent parser for the InfluxQL SELECT statement.
+type parser struct {
+	s        scanner
+	buf      bufferedToken
+	buffered bool
+}
+
+// scan returns the next non-whitespace token.
+func (p *parser) scan() (Token, int, string) {
+	if p.buffered {
+		p.buffered = false
+		return p.buf.tok, p.buf.pos, p.buf.lit
+	}
+	for {
+		tok, pos, lit := p.s.scan()
+		if tok != WS {
+			p.buf = bufferedToken{tok: tok, pos: pos, lit: lit}
+			return tok, pos, lit
+		}
+	}
+}
+
+// unscan pushes the last token back onto the buffer.
+func (p *parser) unscan() {
+	p.buffered = true
+}
+
+// scanRegex scans a regular expression if it is the next token.
+// The regular expression cannot be scanned with the other tokens
+// because the opening slash is ambiguous with division.
+func (p *parser) scanRegex() (*regexp.Regexp, bool, error) {
+	tok, pos, _ := p.scan()
+	if tok != DIV {
+		p.unscan()
+		return nil, false, nil
+	}
+	p.s.pos = pos
+	tok, pos, lit := p.s.scanRegex()
+	if tok != REGEX {
+		return nil, false, newParseError(tok, lit, pos, "regex")
+	}
+	re, err := regexp.Compile(lit)
+	if err != nil {
+		return nil, false, errors.Wrapf(err, codes.Invalid, "invalid regex at position %d", pos)
+	}
+	return re, true, nil
+}
+
+func newParseError(tok Token, lit string, pos int, expected ...string) error {
+	found := tok.String()
+	if lit != "" {
+		found = lit
+	}
+	return errors.Newf(codes.Invalid, "found %s, expected %s at position %d", found, strings.Join(expected, ", "), pos)
+}
+
+func (p *parser) expect(expected Token) error {
+	if tok, pos, lit := p.scan(); tok != expected {
+		return newParseError(tok, lit, pos, expected.String())
+	}
+	return nil
+}
+
+func (p *parser) parseSelectStatement() (*SelectStatement, error) {
+	if err := p.expect(SELECT); err != nil {
+		return nil, err
+	}
+	stmt := new(SelectStatement)
+
+	for {
+		field, err := p.parseField()
+		if err != nil {
+			return nil, err
+		}
+		stmt.Fields = append(stmt.Fields, field)
+		if tok, _, _ := p.scan(); tok != COMMA {
+			p.unscan()
+			break
+		}
+	}
+
+	if err := p.expect(FROM); err != nil {
+		return nil, err
+	}
+	for {
+		m, err := p.parseMeasurement()
+		if err != nil {
+			return nil, err
+		}
+		stmt.Sources = append(stmt.Sources, m)
+		if tok, _, _ := p.scan(); tok != COMMA {
+			p.unscan()
+			break
+		}
+	}
+
+	if tok, _, _ := p.scan(); tok == WHERE {
+		cond, err := p.parseExpr()
+		if err != nil {
+			return nil, err
+		}
+		stmt.Condition = cond
+	} else {
+		p.unscan()
+	}
+
+	if tok, _, _ := p.scan(); tok == GROUP {
+		if err := p.expect(BY); err != nil {
+			return nil, err
+		}
+		dims, err := p.parseDimensions()
+		if err != nil {
+			return nil, err
+		}
+		stmt.Dimensions = dims
+	} else {
+		p.unscan()
+	}
+
+	if tok, _, _ := p.scan(); tok == FILL {
+		if err := p.parseFill(stmt); err != nil {
+			return nil, err
+		}
+	} else {
+		p.unscan()
+	}
+
+	if tok, _, _ := p.scan(); tok == ORDER {
+		if err := p.parseOrderBy(stmt); err != nil {
+			return nil, err
+		}
+	} else {
+		p.unscan()
+	}
+
+	for _, opt := range []struct {
+		tok Token
+		v   *int
+	}{
+		{tok: LIMIT, v: &stmt.Limit},
+		{tok: OFFSET, v: &stmt.Offset},
+		{tok: SLIMIT, v: &stmt.SLimit},
+		{tok: SOFFSET, v: &stmt.SOffset},
+	} {
+		if tok, _, _ := p.scan(); tok != opt.tok {
+			p.unscan()
+			continue
+		}
+		tok, pos, lit := p.scan()
+		if tok != INTEGER {
+			return nil, newParseError(tok, lit, pos, "integer")
+		}
+		n, err := strconv.Atoi(lit)
+		if err != nil {
+			return nil, errors.Wrapf(err, codes.Invalid, "invalid %s at position %d", opt.tok, pos)
+		}
+		*opt.v = n
+	}
+	return stmt, nil
+}
+
+func (p *parser) parseField() (*Field, error) {
+	expr, err := p.parseExpr()
+	if err != nil {
+		return nil, err
+	}
+	field := &Field{Expr: expr}
+	if tok, _, _ := p.scan(); tok == AS {
+		tok, pos, lit := p.scan()
+		if tok != IDENT {
+			return nil, newParseError(tok, lit, pos, "identifier")
+		}
+		field.Alias = lit
+	} else {
+		p.unscan()
+	}
+	return field, nil
+}
+
+// parseMeasurement parses a measurement name that may be
+// qualified with a database and retention policy.
+func (p *parser) parseMeasurement() (*Measurement, error) {
+	m := new(Measurement)
+	if re, ok, err := p.parseMeasurementRegex(); err != nil {
+		return nil, err
+	} else if ok {
+		m.Regex = re
+		return m, nil
+	}
+
+	var segments []string
+	for {
+		tok, pos, lit := p.scan()
+		switch tok {
+		case IDENT:
+			segments = append(segments, lit)
+			if tok, _, _ := p.scan(); tok != DOT {
+				p.unscan()
+				return m.qualify(segments, pos)
+			}
+		case DOT:
+			// An empty segment uses the default retention policy as in db..cpu.
+			segments = append(segments, "")
+		default:
+			return nil, newParseError(tok, lit, pos, "identifier", "regex")
+		}
+		if len(segments) == 2 {
+			if re, ok, err := p.parseMeasurementRegex(); err != nil {
+				return nil, err
+			} else if ok {
+				m.Regex = re
+				return m.qualify(append(segments, ""), pos)
+			}
+		}
+	}
+}
+
+func (p *parser) parseMeasurementRegex() (*regexp.Regexp, bool, error) {
+	return p.scanRegex()
+}
+
+func (m *Measurement) qualify(segments []string, pos int) (*Measurement, error) {
+	switch len(segments) {
+	case 1:
+		m.Name = segments[0]
+	case 2:
+		m.RetentionPolicy, m.Name = segments[0], segments[1]
+	case 3:
+		m.Database, m.RetentionPolicy, m.Name = segments[0], segments[1], segments[2]
+	default:
+		return nil, errors.Newf(codes.Invalid, "too many segments in measurement at position %d", pos)
+	}
+	return m, nil
+}
+
+func (p *parser) parseDimensions() ([]Expr, error) {
+	var dims []Expr
+	for {
+		if re, ok, err := p.scanRegex(); err != nil {
+			return nil, err
+		} else if ok {
+			dims = append(dims, &RegexLiteral{Val: re})
+		} else {
+			expr, err := p.parseExpr()
+			if err != nil {
+				return nil, err
+			}
+			dims = append(dims, expr)
+		}
+		if tok, _, _ := p.scan(); tok != COMMA {
+			p.unscan()
+			return dims, nil
+		}
+	}
+}
+
+func (p *parser) parseFill(stmt *SelectStatement) error {
+	if err := p.expect(LPAREN); err != nil {
+		return err
+	}
+	expr, err := p.parseExpr()
+	if err != nil {
+		return err
+	}
+	switch expr := expr.(type) {
+	case *VarRef:
+		switch strings.ToLower(expr.Val) {
+		case "null":
+			stmt.Fill = NullFill
+		case "none":
+			stmt.Fill = NoFill
+		case "previous":
+			stmt.Fill = PreviousFill
+		case "linear":
+			stmt.Fill = LinearFill
+		default:
+			return errors.Newf(codes.Invalid, "fill(%s) must be fill(none|null|previous|linear|<num>)", expr.Val)
+		}
+	case *IntegerLiteral, *NumberLiteral:
+		stmt.Fill = NumberFill
+		stmt.FillValue = expr
+	default:
+		return errors.New(codes.Invalid, "fill must be fill(none|null|previous|linear|<num>)")
+	}
+	return p.expect(RPAREN)
+}
+
+func (p *parser) parseOrderBy(stmt *SelectStatement) error {
+	if err := p.expect(BY); err != nil {
+		return err
+	}
+	tok, pos, lit := p.scan()
+	if tok != IDENT || strings.ToLower(lit) != "time" {
+		return errors.Newf(codes.Invalid, "only ORDER BY time supported at position %d", pos)
+	}
+	switch tok, _, _ := p.scan(); tok {
+	case DESC:
+		stmt.Descending = true
+	case ASC:
+	default:
+		p.unscan()
+	}
+	return nil
+}
+
+// parseExpr parses an expression using precedence climbing.
+func (p *parser) parseExpr() (Expr, error) {
+	return p.parseBinaryExpr(1)
+}
+
+func (p *parser) parseBinaryExpr(minPrec int) (Expr, error) {
+	lhs, err := p.parseUnaryExpr()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		op, _, _ := p.scan()
+		prec := op.Precedence()
+		if prec < minPrec || prec == 0 {
+			p.unscan()
+			return lhs, nil
+		}
+
+		var rhs Expr
+		if op == EQREGEX || op == NEQREGEX {
+			re, ok, err := p.scanRegex()
+			if err != nil {
+				return nil, err
+			} else if !ok {
+				tok, pos, lit := p.scan()
+				return nil, newParseError(tok, lit, pos, "regex")
+			}
+			rhs = &RegexLiteral{Val: re}
+		} else if rhs, err = p.parseBinaryExpr(prec + 1); err != nil {
+			return nil, err
+		}
+		lhs = &BinaryExpr{Op: op, LHS: lhs, RHS: rhs}
+	}
+}
+
+func (p *parser) parseUnaryExpr() (Expr, error) {
+	tok, pos, lit := p.scan()
+	switch tok {
+	case LPAREN:
+		expr, err := p.parseExpr()
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expect(RPAREN); err != nil {
+			return nil, err
+		}
+		return &ParenExpr{Expr: expr}, nil
+	case IDENT:
+		if next, _, _ := p.scan(); next == LPAREN {
+			return p.parseCall(lit)
+		} else if next == DOUBLECOLON {
+			tok, pos, typ := p.scan()
+			if tok != IDENT {
+				return nil, newParseError(tok, typ, pos, "data type")
+			}
+			return &VarRef{Val: lit, Type: strings.ToLower(typ)}, nil
+		}
+		p.unscan()
+		return &VarRef{Val: lit}, nil
+	case MUL:
+		return &Wildcard{}, nil
+	case STRING:
+		return &StringLiteral{Val: lit}, nil
+	case INTEGER:
+		v, err := strconv.ParseInt(lit, 10, 64)
+		if err != nil {
+			return nil, errors.Wrapf(err, codes.Invalid, "invalid integer at position %d", pos)
+		}
+		return &IntegerLiteral{Val: v}, nil
+	case NUMBER:
+		v, err := strconv.ParseFloat(lit, 64)
+		if err != nil {
+			return nil, errors.Wrapf(err, codes.Invalid, "invalid number at position %d", pos)
+		}
+		return &NumberLiteral{Val: v}, nil
+	case DURATION:
+		d, err := parseDuration(lit)
+		if err != nil {
+			return nil, errors.Wrapf(err, codes.Invalid, "invalid duration at position %d", pos)
+		}
+		return &DurationLiteral{Val: d}, nil
+	case TRUE, FALSE:
+		return &BooleanLiteral{Val: tok == TRUE}, nil
+	case SUB:
+		// Negative numbers and durations.
+		expr, err := p.parseUnaryExpr()
+		if err != nil {
+			return nil, err
+		}
+		switch expr := expr.(type) {
+		case *IntegerLiteral:
+			expr.Val = -expr.Val
+			return expr, nil
+		case *NumberLiteral:
+			expr.Val = -expr.Val
+			return expr, nil
+		case *DurationLiteral:
+			expr.Val = -expr.Val
+			return expr, nil
+		}
+		return nil, errors.Newf(codes.Invalid, "unary minus is only supported on numbers at position %d", pos)
+	}
+	return nil, newParseError(tok, lit, pos, "identifier", "string", "number", "bool")
+}
+
+func (p *parser) parseCall(name string) (Expr, error) {
+	call := &Call{Name: strings.ToLower(name)}
+	if tok, _, _ := p.scan(); tok == RPAREN {
+		return call, nil
+	}
+	p.unscan()
+	for {
+		arg, err := p.parseExpr()
+		if err != nil {
+			return nil, err
+		}
+		call.Args = append(call.Args, arg)
+		tok, pos, lit := p.scan()
+		switch tok {
+		case COMMA:
+		case RPAREN:
+			return call, nil
+		default:
+			return nil, newParseError(tok, lit, pos, ",", ")")
+		}
+	}
+}
+
+// parseDuration parses an InfluxQL duration which may
+// consist of multiple units such as 1h30m.
+func parseDuration(s string) (time.Duration, error) {
+	var d time.Duration
+	for i := 0; i < len(s); {
+		start := i
+		for i < len(s) && isDigit(rune(s[i])) {
+			i++
+		}
+		n, err := strconv.ParseInt(s[start:i], 10, 64)
+		if err != nil {
+			return 0, err
+		}
+		start = i
+		for i < len(s) && !isDigit(rune(s[i])) {
+			i++
+		}
+		var unit time.Duration
+		switch s[start:i] {
+		case "ns":
+			unit = time.Nanosecond
+		case "u", "µ":
+			unit = time.Microsecond
+		case "ms":
+			unit = time.Millisecond
+		case "s":
+			unit = time.Second
+		case "m":
+			unit = time.Minute
+		case "h":
+			unit = time.Hour
+		case "d":
+			unit = 24 * time.Hour
+		case "w":
+			unit = 7 * 24 * time.Hour
+		default:
+			return 0, errors.Newf(codes.Invalid, "invalid duration unit %q", s[start:i])
+		}
+		d += time.Duration(n) * unit
+	}
+	return d, nil
+}
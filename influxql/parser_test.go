@@ -0,0 +1,153 @@
+package influxql_test
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/influxdata/flux/influxql"
+)
+
+var compareRegexp = cmp.Comparer(func(x, y *regexp.Regexp) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return x.String() == y.String()
+})
+
+func TestParseQuery(t *testing.T) {
+	for _, tt := range []struct {
+		name string
+		s    string
+		want *influxql.Query
+	}{
+		{
+			name: "raw fields",
+			s:    `SELECT usage_user, "usage system" AS sys FROM cpu`,
+			want: &influxql.Query{Statements: []*influxql.SelectStatement{{
+				Fields: []*influxql.Field{
+					{Expr: &influxql.VarRef{Val: "usage_user"}},
+					{Expr: &influxql.VarRef{Val: "usage system"}, Alias: "sys"},
+				},
+				Sources: []*influxql.Measurement{{Name: "cpu"}},
+			}}},
+		},
+		{
+			name: "aggregates",
+			s: `SELECT MEAN(value), percentile(value::integer, 95) FROM db.rp.cpu, db..mem, /disk.*/
+WHERE time >= now() - 1h AND (host = 'a' OR host =~ /b.*/) AND value > 1.5
+GROUP BY time(5m, 1m), host fill(previous) ORDER BY time DESC LIMIT 10 OFFSET 2 SLIMIT 3 SOFFSET 4`,
+			want: &influxql.Query{Statements: []*influxql.SelectStatement{{
+				Fields: []*influxql.Field{
+					{Expr: &influxql.Call{Name: "mean", Args: []influxql.Expr{&influxql.VarRef{Val: "value"}}}},
+					{Expr: &influxql.Call{Name: "percentile", Args: []influxql.Expr{
+						&influxql.VarRef{Val: "value", Type: "integer"},
+						&influxql.IntegerLiteral{Val: 95},
+					}}},
+				},
+				Sources: []*influxql.Measurement{
+					{Database: "db", RetentionPolicy: "rp", Name: "cpu"},
+					{Database: "db", Name: "mem"},
+					{Regex: regexp.MustCompile(`disk.*`)},
+				},
+				Condition: &influxql.BinaryExpr{
+					Op: influxql.AND,
+					LHS: &influxql.BinaryExpr{
+						Op: influxql.AND,
+						LHS: &influxql.BinaryExpr{
+							Op:  influxql.GTE,
+							LHS: &influxql.VarRef{Val: "time"},
+							RHS: &influxql.BinaryExpr{
+								Op:  influxql.SUB,
+								LHS: &influxql.Call{Name: "now"},
+								RHS: &influxql.DurationLiteral{Val: time.Hour},
+							},
+						},
+						RHS: &influxql.ParenExpr{Expr: &influxql.BinaryExpr{
+							Op: influxql.OR,
+							LHS: &influxql.BinaryExpr{
+								Op:  influxql.EQ,
+								LHS: &influxql.VarRef{Val: "host"},
+								RHS: &influxql.StringLiteral{Val: "a"},
+							},
+							RHS: &influxql.BinaryExpr{
+								Op:  influxql.EQREGEX,
+								LHS: &influxql.VarRef{Val: "host"},
+								RHS: &influxql.RegexLiteral{Val: regexp.MustCompile(`b.*`)},
+							},
+						}},
+					},
+					RHS: &influxql.BinaryExpr{
+						Op:  influxql.GT,
+						LHS: &influxql.VarRef{Val: "value"},
+						RHS: &influxql.NumberLiteral{Val: 1.5},
+					},
+				},
+				Dimensions: []influxql.Expr{
+					&influxql.Call{Name: "time", Args: []influxql.Expr{
+						&influxql.DurationLiteral{Val: 5 * time.Minute},
+						&influxql.DurationLiteral{Val: time.Minute},
+					}},
+					&influxql.VarRef{Val: "host"},
+				},
+				Fill:       influxql.PreviousFill,
+				Descending: true,
+				Limit:      10,
+				Offset:     2,
+				SLimit:     3,
+				SOffset:    4,
+			}}},
+		},
+		{
+			name: "multiple statements",
+			s:    `SELECT * FROM cpu GROUP BY * fill(1); SELECT max(v) FROM mem;`,
+			want: &influxql.Query{Statements: []*influxql.SelectStatement{
+				{
+					Fields:     []*influxql.Field{{Expr: &influxql.Wildcard{}}},
+					Sources:    []*influxql.Measurement{{Name: "cpu"}},
+					Dimensions: []influxql.Expr{&influxql.Wildcard{}},
+					Fill:       influxql.NumberFill,
+					FillValue:  &influxql.IntegerLiteral{Val: 1},
+				},
+				{
+					Fields:  []*influxql.Field{{Expr: &influxql.Call{Name: "max", Args: []influxql.Expr{&influxql.VarRef{Val: "v"}}}}},
+					Sources: []*influxql.Measurement{{Name: "mem"}},
+				},
+			}},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := influxql.ParseQuery(tt.s)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !cmp.Equal(tt.want, got, compareRegexp) {
+				t.Errorf("unexpected query -want/+got:\n%s", cmp.Diff(tt.want, got, compareRegexp))
+			}
+		})
+	}
+}
+
+func TestParseQuery_Error(t *testing.T) {
+	for _, tt := range []struct {
+		s    string
+		want string
+	}{
+		{s: ``, want: "query must contain at least one statement"},
+		{s: `SHOW DATABASES`, want: "found SHOW, expected SELECT at position 0"},
+		{s: `SELECT value cpu`, want: "found cpu, expected FROM at position 13"},
+		{s: `SELECT value FROM cpu LIMIT x`, want: "found x, expected integer at position 28"},
+		{s: `SELECT value FROM cpu WHERE host =~ 'a'`, want: "found a, expected regex at position 36"},
+	} {
+		t.Run(tt.s, func(t *testing.T) {
+			_, err := influxql.ParseQuery(tt.s)
+			if err == nil {
+				t.Fatal("expected error")
+			}
+			if got := err.Error(); got != tt.want {
+				t.Errorf("unexpected error -want/+got:\n\t- %s\n\t+ %s", tt.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,381 @@
+package influxql
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Token is a lexical token of the InfluxQL language.
+type Token int
+
+const (
+	ILLEGAL Token = iota
+	EOF
+	WS
+
+	IDENT
+	NUMBER
+	INTEGER
+	DURATION
+	STRING
+	REGEX
+	BADSTRING
+
+	// Operators
+	ADD
+	SUB
+	MUL
+	DIV
+	MOD
+	EQ
+	NEQ
+	LT
+	LTE
+	GT
+	GTE
+	EQREGEX
+	NEQREGEX
+
+	LPAREN
+	RPAREN
+	COMMA
+	DOT
+	SEMICOLON
+	DOUBLECOLON
+
+	// Keywords
+	AND
+	OR
+	AS
+	ASC
+	BY
+	DESC
+	FALSE
+	FILL
+	FROM
+	GROUP
+	LIMIT
+	OFFSET
+	ORDER
+	SELECT
+	SLIMIT
+	SOFFSET
+	TRUE
+	WHERE
+)
+
+var tokens = [...]string{
+	ILLEGAL:   "ILLEGAL",
+	EOF:       "EOF",
+	WS:        "WS",
+	IDENT:     "IDENT",
+	NUMBER:    "NUMBER",
+	INTEGER:   "INTEGER",
+	DURATION:  "DURATION",
+	STRING:    "STRING",
+	REGEX:     "REGEX",
+	BADSTRING: "BADSTRING",
+
+	ADD:      "+",
+	SUB:      "-",
+	MUL:      "*",
+	DIV:      "/",
+	MOD:      "%",
+	EQ:       "=",
+	NEQ:      "!=",
+	LT:       "<",
+	LTE:      "<=",
+	GT:       ">",
+	GTE:      ">=",
+	EQREGEX:  "=~",
+	NEQREGEX: "!~",
+
+	LPAREN:      "(",
+	RPAREN:      ")",
+	COMMA:       ",",
+	DOT:         ".",
+	SEMICOLON:   ";",
+	DOUBLECOLON: "::",
+
+	AND:     "AND",
+	OR:      "OR",
+	AS:      "AS",
+	ASC:     "ASC",
+	BY:      "BY",
+	DESC:    "DESC",
+	FALSE:   "FALSE",
+	FILL:    "FILL",
+	FROM:    "FROM",
+	GROUP:   "GROUP",
+	LIMIT:   "LIMIT",
+	OFFSET:  "OFFSET",
+	ORDER:   "ORDER",
+	SELECT:  "SELECT",
+	SLIMIT:  "SLIMIT",
+	SOFFSET: "SOFFSET",
+	TRUE:    "TRUE",
+	WHERE:   "WHERE",
+}
+
+var keywords = make(map[string]Token)
+
+func init() {
+	for tok := AND; tok <= WHERE; tok++ {
+		keywords[strings.ToLower(tokens[tok])] = tok
+	}
+}
+
+// String returns the string representation of the token.
+func (tok Token) String() string {
+	if tok >= 0 && int(tok) < len(tokens) {
+		return tokens[tok]
+	}
+	return ""
+}
+
+// Precedence returns the operator precedence of the binary operator token.
+func (tok Token) Precedence() int {
+	switch tok {
+	case OR:
+		return 1
+	case AND:
+		return 2
+	case EQ, NEQ, EQREGEX, NEQREGEX, LT, LTE, GT, GTE:
+		return 3
+	case ADD, SUB:
+		return 4
+	case MUL, DIV, MOD:
+		return 5
+	}
+	return 0
+}
+
+// scanner converts a query string into tokens.
+type scanner struct {
+	s   string
+	pos int
+}
+
+func (s *scanner) read() rune {
+	if s.pos >= len(s.s) {
+		s.pos++
+		return 0
+	}
+	r, w := utf8.DecodeRuneInString(s.s[s.pos:])
+	s.pos += w
+	return r
+}
+
+func (s *scanner) unread(r rune) {
+	if r == 0 {
+		s.pos--
+		return
+	}
+	s.pos -= utf8.RuneLen(r)
+}
+
+func (s *scanner) peek() rune {
+	r := s.read()
+	s.unread(r)
+	return r
+}
+
+// scan returns the next token, its position and its literal value.
+func (s *scanner) scan() (tok Token, pos int, lit string) {
+	pos = s.pos
+	r := s.read()
+	switch {
+	case r == 0:
+		return EOF, pos, ""
+	case unicode.IsSpace(r):
+		for unicode.IsSpace(s.peek()) {
+			s.read()
+		}
+		return WS, pos, s.s[pos:s.pos]
+	case isIdentStart(r):
+		s.unread(r)
+		lit = s.scanIdent()
+		if tok, ok := keywords[strings.ToLower(lit)]; ok {
+			return tok, pos, lit
+		}
+		return IDENT, pos, lit
+	case isDigit(r), r == '.' && isDigit(s.peek()):
+		s.unread(r)
+		return s.scanNumber()
+	}
+
+	switch r {
+	case '"':
+		lit, ok := s.scanQuoted('"')
+		if !ok {
+			return BADSTRING, pos, lit
+		}
+		return IDENT, pos, lit
+	case '\'':
+		lit, ok := s.scanQuoted('\'')
+		if !ok {
+			return BADSTRING, pos, lit
+		}
+		return STRING, pos, lit
+	case '+':
+		return ADD, pos, ""
+	case '-':
+		if s.peek() == '-' {
+			// Comments extend to the end of the line.
+			for r := s.read(); r != '\n' && r != 0; r = s.read() {
+			}
+			return WS, pos, ""
+		}
+		return SUB, pos, ""
+	case '*':
+		return MUL, pos, ""
+	case '/':
+		return DIV, pos, ""
+	case '%':
+		return MOD, pos, ""
+	case '=':
+		if s.peek() == '~' {
+			s.read()
+			return EQREGEX, pos, ""
+		}
+		return EQ, pos, ""
+	case '!':
+		switch s.read() {
+		case '=':
+			return NEQ, pos, ""
+		case '~':
+			return NEQREGEX, pos, ""
+		}
+	case '<':
+		switch s.read() {
+		case '=':
+			return LTE, pos, ""
+		case '>':
+			return NEQ, pos, ""
+		default:
+			s.pos = pos + 1
+		}
+		return LT, pos, ""
+	case '>':
+		if s.peek() == '=' {
+			s.read()
+			return GTE, pos, ""
+		}
+		return GT, pos, ""
+	case '(':
+		return LPAREN, pos, ""
+	case ')':
+		return RPAREN, pos, ""
+	case ',':
+		return COMMA, pos, ""
+	case '.':
+		return DOT, pos, ""
+	case ';':
+		return SEMICOLON, pos, ""
+	case ':':
+		if s.peek() == ':' {
+			s.read()
+			return DOUBLECOLON, pos, ""
+		}
+	}
+	return ILLEGAL, pos, string(r)
+}
+
+// scanRegex scans a regular expression delimited by slashes.
+// The opening slash must be the next character.
+func (s *scanner) scanRegex() (tok Token, pos int, lit string) {
+	pos = s.pos
+	if s.read() != '/' {
+		return ILLEGAL, pos, ""
+	}
+	var b strings.Builder
+	for {
+		r := s.read()
+		switch r {
+		case 0:
+			return BADSTRING, pos, b.String()
+		case '/':
+			return REGEX, pos, b.String()
+		case '\\':
+			if s.peek() == '/' {
+				r = s.read()
+			} else {
+				b.WriteRune(r)
+				r = s.read()
+			}
+		}
+		b.WriteRune(r)
+	}
+}
+
+func (s *scanner) scanIdent() string {
+	start := s.pos
+	r := s.read()
+	for isIdentChar(r) {
+		r = s.read()
+	}
+	s.unread(r)
+	return s.s[start:s.pos]
+}
+
+func (s *scanner) scanNumber() (tok Token, pos int, lit string) {
+	pos = s.pos
+	tok = INTEGER
+	for isDigit(s.peek()) {
+		s.read()
+	}
+	if s.peek() == '.' {
+		tok = NUMBER
+		s.read()
+		for isDigit(s.peek()) {
+			s.read()
+		}
+	}
+	if tok == INTEGER && isIdentStart(s.peek()) {
+		// A number followed by a unit is a duration.
+		// Durations may be composed of multiple units such as 1h30m.
+		for isIdentChar(s.peek()) {
+			s.read()
+		}
+		return DURATION, pos, s.s[pos:s.pos]
+	}
+	return tok, pos, s.s[pos:s.pos]
+}
+
+func (s *scanner) scanQuoted(quote rune) (string, bool) {
+	var b strings.Builder
+	for {
+		r := s.read()
+		switch r {
+		case 0, '\n':
+			return b.String(), false
+		case quote:
+			return b.String(), true
+		case '\\':
+			switch next := s.read(); next {
+			case 'n':
+				b.WriteRune('\n')
+			case '\\', '"', '\'':
+				b.WriteRune(next)
+			default:
+				b.WriteRune(r)
+				b.WriteRune(next)
+			}
+		default:
+			b.WriteRune(r)
+		}
+	}
+}
+
+func isIdentStart(r rune) bool {
+	return unicode.IsLetter(r) || r == '_'
+}
+
+func isIdentChar(r rune) bool {
+	return isIdentStart(r) || isDigit(r)
+}
+
+func isDigit(r rune) bool {
+	return r >= '0' && r <= '9'
+}
@@ -0,0 +1,751 @@
+package influxql
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/influxdata/flux/ast"
+	"github.com/influxdata/flux/codes"
+	"github.com/influxdata/flux/internal/errors"
+)
+
+// DefaultRetentionPolicy is the retention policy used when
+// neither the query nor the transpiler specifies one.
+const DefaultRetentionPolicy = "autogen"
+
+// A Transpiler converts InfluxQL SELECT statements into a Flux file
+// that reads the data with influxdb.from.
+//
+// Each database and retention policy maps to the bucket "<db>/<rp>".
+// The result of each statement is yielded with the index of the
+// statement as its name and contains one table for each series with
+// a column for the time and for each expression in the SELECT clause.
+type Transpiler struct {
+	// Database is the database used for measurements that
+	// are not qualified with a database.
+	Database string
+	// RetentionPolicy is the retention policy used for measurements
+	// that are not qualified with a retention policy.
+	RetentionPolicy string
+	// Now is the time used for now(). It defaults to the current time.
+	Now time.Time
+}
+
+// Transpile converts a query into a Flux file.
+func (t *Transpiler) Transpile(q *Query) (*ast.File, error) {
+	file := &ast.File{
+		Imports: []*ast.ImportDeclaration{
+			{Path: &ast.StringLiteral{Value: "influxdata/influxdb"}},
+			{Path: &ast.StringLiteral{Value: "internal/influxql"}},
+		},
+	}
+	for i, stmt := range q.Statements {
+		stmts, err := t.transpileSelect(i, stmt)
+		if err != nil {
+			return nil, errors.Wrapf(err, codes.Inherit, "error transpiling statement %d", i)
+		}
+		file.Body = append(file.Body, stmts...)
+	}
+	return file, nil
+}
+
+func (t *Transpiler) now() time.Time {
+	if t.Now.IsZero() {
+		return time.Now().UTC()
+	}
+	return t.Now
+}
+
+// selectStatement holds the state of a statement as it is transpiled.
+type selectStatement struct {
+	*Transpiler
+	stmt *SelectStatement
+	id   int
+
+	// raw contains the fields selected without a function
+	// and aggregates contains the functions in the SELECT clause.
+	// Only one of them is set.
+	raw        []*Field
+	wildcard   bool
+	aggregates []*aggregate
+
+	// fields contains every field that is read.
+	fields []string
+
+	// tags contains the tags in the GROUP BY clause and
+	// groupAll is set when the statement is grouped by *.
+	tags     []string
+	groupAll bool
+
+	every, offset time.Duration
+	windowed      bool
+
+	cond *condition
+}
+
+func (t *Transpiler) transpileSelect(id int, stmt *SelectStatement) ([]ast.Statement, error) {
+	s := &selectStatement{Transpiler: t, stmt: stmt, id: id}
+	if err := s.readFields(); err != nil {
+		return nil, err
+	}
+	if err := s.readDimensions(); err != nil {
+		return nil, err
+	}
+
+	selected := make(map[string]bool, len(s.fields))
+	for _, name := range s.fields {
+		selected[name] = true
+	}
+	cond, err := t.splitCondition(stmt.Condition, selected)
+	if err != nil {
+		return nil, err
+	}
+	s.cond = cond
+	if !s.wildcard {
+		for _, name := range cond.fieldNames {
+			s.fields = appendUnique(s.fields, name)
+		}
+	}
+
+	if s.windowed && !cond.timeRange.hasStart {
+		return nil, errors.New(codes.Invalid, "aggregate functions with GROUP BY time require a WHERE time clause with a lower limit")
+	}
+	if s.stmt.Fill == LinearFill {
+		return nil, errors.New(codes.Unimplemented, "fill(linear) is not supported")
+	}
+
+	source, err := s.source()
+	if err != nil {
+		return nil, err
+	}
+	name := fmt.Sprintf("data%d", id)
+	var result ast.Expression
+	if s.aggregates != nil {
+		result = s.aggregatePipeline(&ast.Identifier{Name: name})
+	} else {
+		result = s.rawPipeline(&ast.Identifier{Name: name})
+	}
+	return []ast.Statement{
+		&ast.VariableAssignment{
+			ID:   &ast.Identifier{Name: name},
+			Init: source,
+		},
+		&ast.ExpressionStatement{Expression: result},
+	}, nil
+}
+
+// readFields reads the expressions in the SELECT clause.
+func (s *selectStatement) readFields() error {
+	for _, f := range s.stmt.Fields {
+		switch e := f.Expr.(type) {
+		case *Wildcard:
+			s.wildcard = true
+		case *VarRef:
+			if e.Type == "tag" {
+				return errors.Newf(codes.Unimplemented, "selecting tag %q is not supported", e.Val)
+			}
+			s.raw = append(s.raw, f)
+			s.fields = appendUnique(s.fields, e.Val)
+		case *Call:
+			a, err := newAggregate(e)
+			if err != nil {
+				return err
+			}
+			a.output = f.Alias
+			if a.output == "" {
+				a.output = a.name
+			}
+			s.aggregates = append(s.aggregates, a)
+			s.fields = appendUnique(s.fields, a.field.Val)
+		default:
+			return errors.New(codes.Unimplemented, "only fields, wildcards and functions of a field are supported in the SELECT clause")
+		}
+	}
+	if s.aggregates != nil && (s.raw != nil || s.wildcard) {
+		return errors.New(codes.Invalid, "mixing aggregate and non-aggregate queries is not supported")
+	}
+
+	// Outputs with the same name are suffixed with a number.
+	names := make(map[string]int)
+	for _, a := range s.aggregates {
+		if n := names[a.output]; n > 0 {
+			names[a.output]++
+			a.output = a.output + "_" + strconv.Itoa(n)
+		} else {
+			names[a.output] = 1
+		}
+	}
+	return nil
+}
+
+// readDimensions reads the GROUP BY clause.
+func (s *selectStatement) readDimensions() error {
+	for _, dim := range s.stmt.Dimensions {
+		switch d := dim.(type) {
+		case *Call:
+			if d.Name != "time" {
+				return errors.Newf(codes.Invalid, "only time() calls allowed in dimensions, got %s()", d.Name)
+			} else if s.windowed {
+				return errors.New(codes.Invalid, "multiple time dimensions not allowed")
+			} else if s.aggregates == nil {
+				return errors.New(codes.Invalid, "GROUP BY requires at least one aggregate function")
+			}
+			if len(d.Args) < 1 || len(d.Args) > 2 {
+				return errors.New(codes.Invalid, "time dimension expected 1 or 2 arguments")
+			}
+			every, ok := d.Args[0].(*DurationLiteral)
+			if !ok || every.Val <= 0 {
+				return errors.New(codes.Invalid, "time dimension must have a positive duration")
+			}
+			s.every, s.windowed = every.Val, true
+			if len(d.Args) == 2 {
+				offset, ok := d.Args[1].(*DurationLiteral)
+				if !ok {
+					return errors.New(codes.Invalid, "time dimension offset must be a duration")
+				}
+				s.offset = offset.Val % s.every
+				if s.offset < 0 {
+					s.offset += s.every
+				}
+			}
+		case *VarRef:
+			s.tags = appendUnique(s.tags, d.Val)
+		case *Wildcard:
+			s.groupAll = true
+		default:
+			return errors.New(codes.Unimplemented, "only time(), tags and * are supported in the GROUP BY clause")
+		}
+	}
+	return nil
+}
+
+// bucket returns the bucket for the measurement.
+func (s *selectStatement) bucket(m *Measurement) (string, error) {
+	db, rp := m.Database, m.RetentionPolicy
+	if db == "" {
+		db = s.Database
+	}
+	if rp == "" {
+		rp = s.RetentionPolicy
+	}
+	if rp == "" {
+		rp = DefaultRetentionPolicy
+	}
+	if db == "" {
+		return "", errors.New(codes.Invalid, "database name required")
+	}
+	return db + "/" + rp, nil
+}
+
+// source returns the expression that reads the data of the statement.
+// The data is filtered by the measurements, tags and fields and grouped
+// by the GROUP BY tags. If the fields must be pivoted into columns
+// before they can be filtered, the pivoted and filtered data is returned.
+func (s *selectStatement) source() (ast.Expression, error) {
+	// Group the measurements by their bucket.
+	var buckets []string
+	measurements := make(map[string][]*Measurement)
+	for _, m := range s.stmt.Sources {
+		bucket, err := s.bucket(m)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := measurements[bucket]; !ok {
+			buckets = append(buckets, bucket)
+		}
+		measurements[bucket] = append(measurements[bucket], m)
+	}
+
+	tagCond, err := s.tagCondition()
+	if err != nil {
+		return nil, err
+	}
+	rangeCall := s.rangeCall()
+
+	sources := make([]ast.Expression, len(buckets))
+	for i, bucket := range buckets {
+		pred := measurementCondition(measurements[bucket])
+		if tagCond != nil {
+			pred = andExpr(pred, tagCond)
+		}
+		if !s.wildcard {
+			pred = andExpr(pred, fieldCondition(s.fields))
+		}
+		sources[i] = pipeline(
+			call("influxdb.from", property("bucket", &ast.StringLiteral{Value: bucket})),
+			rangeCall,
+			call("filter", property("fn", rowFn(pred))),
+		)
+	}
+
+	var source ast.Expression
+	if len(sources) == 1 {
+		source = sources[0]
+	} else {
+		source = call("union", property("tables", &ast.ArrayExpression{Elements: sources}))
+	}
+
+	var calls []*ast.CallExpression
+	if s.aggregates == nil || s.cond.fields != nil {
+		calls = append(calls, pivotCall())
+	}
+	if s.cond.fields != nil {
+		pred, err := fluxCondition(s.cond.fields)
+		if err != nil {
+			return nil, err
+		}
+		calls = append(calls, call("filter", property("fn", rowFn(pred))))
+	}
+	if !s.groupAll {
+		keys := []string{"_start", "_stop", "_measurement"}
+		calls = append(calls, call("group", property("columns", stringList(append(keys, s.tags...)...))))
+		if s.needsSort() {
+			calls = append(calls, sortCall(false))
+		}
+	}
+	if len(calls) == 0 {
+		return source, nil
+	}
+	return pipeline(source, calls...), nil
+}
+
+// needsSort reports whether the data must be sorted by time
+// after the series have been merged by group.
+func (s *selectStatement) needsSort() bool {
+	for _, a := range s.aggregates {
+		if a.name == "first" || a.name == "last" {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *selectStatement) tagCondition() (ast.Expression, error) {
+	if s.cond.tags == nil {
+		return nil, nil
+	}
+	pred, err := fluxCondition(s.cond.tags)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := s.cond.tags.(*BinaryExpr); ok && s.cond.tags.(*BinaryExpr).Op == OR {
+		pred = &ast.ParenExpression{Expression: pred}
+	}
+	return pred, nil
+}
+
+func (s *selectStatement) rangeCall() *ast.CallExpression {
+	tr := s.cond.timeRange
+	var start, stop ast.Expression
+	if tr.hasStart {
+		start = &ast.DateTimeLiteral{Value: tr.start}
+	} else {
+		start = member("influxql", "minTime")
+	}
+	if tr.hasStop {
+		stop = &ast.DateTimeLiteral{Value: tr.stop}
+	} else if s.windowed {
+		stop = &ast.DateTimeLiteral{Value: s.now()}
+	} else {
+		stop = member("influxql", "maxTime")
+	}
+	return call("range", property("start", start), property("stop", stop))
+}
+
+// rawPipeline returns the pipeline for a statement without aggregates.
+// The source has already been pivoted so each field is a column.
+func (s *selectStatement) rawPipeline(source ast.Expression) ast.Expression {
+	var calls []*ast.CallExpression
+	if s.wildcard {
+		calls = append(calls, call("drop", property("columns", stringList("_start", "_stop"))))
+	} else {
+		// Keep the selected fields and the group by tags.
+		keep := []string{"_time", "_measurement"}
+		keep = append(keep, s.tags...)
+		var exists ast.Expression
+		for _, f := range s.raw {
+			ref := f.Expr.(*VarRef)
+			keep = appendUnique(keep, ref.Val)
+			exists = orExpr(exists, &ast.UnaryExpression{Operator: ast.ExistsOperator, Argument: column(ref.Val)})
+		}
+		if len(s.fields) > len(s.raw) {
+			// Drop the rows where only the fields referenced by the condition exist.
+			calls = append(calls, call("filter", property("fn", rowFn(exists))))
+		}
+		calls = append(calls, call("keep", property("columns", stringList(keep...))))
+		if rename := s.renameCall(); rename != nil {
+			calls = append(calls, rename)
+		}
+	}
+	return s.finish(pipeline(source, calls...))
+}
+
+// renameCall renames the selected fields to their aliases.
+func (s *selectStatement) renameCall() *ast.CallExpression {
+	var body ast.Expression = &ast.Identifier{Name: "column"}
+	for i := len(s.raw) - 1; i >= 0; i-- {
+		f := s.raw[i]
+		if f.Alias == "" {
+			continue
+		}
+		body = &ast.ConditionalExpression{
+			Test: &ast.BinaryExpression{
+				Operator: ast.EqualOperator,
+				Left:     &ast.Identifier{Name: "column"},
+				Right:    &ast.StringLiteral{Value: f.Expr.(*VarRef).Val},
+			},
+			Consequent: &ast.StringLiteral{Value: f.Alias},
+			Alternate:  body,
+		}
+	}
+	if _, ok := body.(*ast.Identifier); ok {
+		return nil
+	}
+	return call("rename", property("fn", columnFn(body)))
+}
+
+// aggregatePipeline returns the pipeline for a statement with aggregates.
+// Each aggregate is computed on its own copy of the source and the results
+// are pivoted so each aggregate is a column.
+func (s *selectStatement) aggregatePipeline(source ast.Expression) ast.Expression {
+	// The time of the result is the start of the window unless the
+	// statement only contains a single selector.
+	useStart := len(s.aggregates) > 1 || !s.aggregates[0].isSelector()
+
+	branches := make([]ast.Expression, len(s.aggregates))
+	for i, a := range s.aggregates {
+		var calls []*ast.CallExpression
+		if s.cond.fields != nil {
+			// The source has been pivoted so the field must be
+			// moved back into the value column.
+			calls = append(calls,
+				call("rename", property("fn", columnFn(&ast.ConditionalExpression{
+					Test: &ast.BinaryExpression{
+						Operator: ast.EqualOperator,
+						Left:     &ast.Identifier{Name: "column"},
+						Right:    &ast.StringLiteral{Value: a.field.Val},
+					},
+					Consequent: &ast.StringLiteral{Value: "_value"},
+					Alternate:  &ast.Identifier{Name: "column"},
+				}))),
+				call("filter", property("fn", rowFn(&ast.UnaryExpression{
+					Operator: ast.ExistsOperator,
+					Argument: column("_value"),
+				}))),
+			)
+			var others []string
+			for _, f := range s.fields {
+				if f != a.field.Val {
+					others = append(others, f)
+				}
+			}
+			if len(others) > 0 {
+				calls = append(calls, call("drop", property("columns", stringList(others...))))
+			}
+		} else if len(s.fields) > 1 {
+			calls = append(calls, call("filter", property("fn", rowFn(fieldCondition([]string{a.field.Val})))))
+		}
+
+		if s.windowed {
+			args := []*ast.Property{property("every", durationLiteral(s.every))}
+			if s.offset != 0 {
+				args = append(args, property("offset", durationLiteral(s.offset)))
+			}
+			args = append(args,
+				property("fn", a.function()),
+				property("timeSrc", &ast.StringLiteral{Value: "_start"}),
+			)
+			if s.stmt.Fill == NoFill {
+				args = append(args, property("createEmpty", &ast.BooleanLiteral{Value: false}))
+			}
+			calls = append(calls, call("aggregateWindow", args...))
+			if fill := s.fillCall(a); fill != nil {
+				calls = append(calls, fill)
+			}
+		} else {
+			calls = append(calls, a.call(nil))
+			if useStart {
+				if a.isSelector() {
+					calls = append(calls, call("drop", property("columns", stringList("_time"))))
+				}
+				calls = append(calls, s.timeCall())
+			}
+		}
+		calls = append(calls, call("set",
+			property("key", &ast.StringLiteral{Value: "_field"}),
+			property("value", &ast.StringLiteral{Value: a.output}),
+		))
+		if s.cond.fields != nil && len(s.aggregates) > 1 {
+			// The field is not part of the group key of pivoted data so
+			// it is added to keep the aggregates apart in the union.
+			calls = append(calls, call("group",
+				property("columns", stringList("_time", "_value")),
+				property("mode", &ast.StringLiteral{Value: "except"}),
+			))
+		}
+		branches[i] = pipeline(source, calls...)
+	}
+
+	var result ast.Expression
+	if len(branches) == 1 {
+		result = branches[0]
+	} else {
+		result = call("union", property("tables", &ast.ArrayExpression{Elements: branches}))
+	}
+	return s.finish(pipeline(result,
+		pivotCall(),
+		call("drop", property("columns", stringList("_start", "_stop"))),
+	))
+}
+
+// timeCall returns the call that sets the time of an aggregate without
+// GROUP BY time to the start of the time range. Like InfluxQL, the time
+// is the epoch when the WHERE clause has no lower limit on time.
+func (s *selectStatement) timeCall() *ast.CallExpression {
+	if !s.cond.timeRange.hasStart {
+		return call("map", property("fn", rowFn(&ast.ObjectExpression{
+			With: &ast.Identifier{Name: "r"},
+			Properties: []*ast.Property{
+				property("_time", member("influxql", "epoch")),
+			},
+		})))
+	}
+	return call("duplicate",
+		property("column", &ast.StringLiteral{Value: "_start"}),
+		property("as", &ast.StringLiteral{Value: "_time"}),
+	)
+}
+
+// fillCall returns the call that fills the empty windows of the aggregate.
+func (s *selectStatement) fillCall(a *aggregate) *ast.CallExpression {
+	switch s.stmt.Fill {
+	case PreviousFill:
+		return call("fill", property("usePrevious", &ast.BooleanLiteral{Value: true}))
+	case NumberFill:
+	default:
+		return nil
+	}
+
+	var v float64
+	switch n := s.stmt.FillValue.(type) {
+	case *IntegerLiteral:
+		v = float64(n.Val)
+	case *NumberLiteral:
+		v = n.Val
+	}
+	// The type of the fill value must match the type of the column.
+	var value ast.Expression
+	switch {
+	case a.name == "count" || (!a.isFloat() && a.field.Type == "integer"):
+		value = &ast.IntegerLiteral{Value: int64(v)}
+	case !a.isFloat() && a.field.Type == "unsigned":
+		value = &ast.UnsignedIntegerLiteral{Value: uint64(v)}
+	default:
+		value = &ast.FloatLiteral{Value: v}
+	}
+	return call("fill", property("value", value))
+}
+
+// finish sorts the result, applies the limits and yields the result.
+func (s *selectStatement) finish(result ast.Expression) ast.Expression {
+	calls := []*ast.CallExpression{sortCall(s.stmt.Descending)}
+	if s.stmt.Limit > 0 || s.stmt.Offset > 0 {
+		calls = append(calls, call("limit", limitArgs(s.stmt.Limit, s.stmt.Offset)...))
+	}
+	if s.stmt.SLimit > 0 || s.stmt.SOffset > 0 {
+		calls = append(calls, call("influxql.slimit", limitArgs(s.stmt.SLimit, s.stmt.SOffset)...))
+	}
+	calls = append(calls, call("yield", property("name", &ast.StringLiteral{Value: strconv.Itoa(s.id)})))
+	return pipeline(result, calls...)
+}
+
+func limitArgs(n, offset int) []*ast.Property {
+	if n <= 0 {
+		// An offset without a limit returns every row after the offset.
+		n = math.MaxInt64
+	}
+	args := []*ast.Property{property("n", &ast.IntegerLiteral{Value: int64(n)})}
+	if offset > 0 {
+		args = append(args, property("offset", &ast.IntegerLiteral{Value: int64(offset)}))
+	}
+	return args
+}
+
+func measurementCondition(measurements []*Measurement) ast.Expression {
+	var pred ast.Expression
+	for _, m := range measurements {
+		var cmp ast.Expression
+		if m.Regex != nil {
+			cmp = &ast.BinaryExpression{
+				Operator: ast.RegexpMatchOperator,
+				Left:     column("_measurement"),
+				Right:    &ast.RegexpLiteral{Value: m.Regex},
+			}
+		} else {
+			cmp = &ast.BinaryExpression{
+				Operator: ast.EqualOperator,
+				Left:     column("_measurement"),
+				Right:    &ast.StringLiteral{Value: m.Name},
+			}
+		}
+		pred = orExpr(pred, cmp)
+	}
+	if len(measurements) > 1 {
+		pred = &ast.ParenExpression{Expression: pred}
+	}
+	return pred
+}
+
+func fieldCondition(fields []string) ast.Expression {
+	var pred ast.Expression
+	for _, f := range fields {
+		pred = orExpr(pred, &ast.BinaryExpression{
+			Operator: ast.EqualOperator,
+			Left:     column("_field"),
+			Right:    &ast.StringLiteral{Value: f},
+		})
+	}
+	if len(fields) > 1 {
+		pred = &ast.ParenExpression{Expression: pred}
+	}
+	return pred
+}
+
+func pivotCall() *ast.CallExpression {
+	return call("pivot",
+		property("rowKey", stringList("_time")),
+		property("columnKey", stringList("_field")),
+		property("valueColumn", &ast.StringLiteral{Value: "_value"}),
+	)
+}
+
+func sortCall(desc bool) *ast.CallExpression {
+	args := []*ast.Property{property("columns", stringList("_time"))}
+	if desc {
+		args = append(args, property("desc", &ast.BooleanLiteral{Value: true}))
+	}
+	return call("sort", args...)
+}
+
+func pipeline(arg ast.Expression, calls ...*ast.CallExpression) ast.Expression {
+	for _, c := range calls {
+		arg = &ast.PipeExpression{Argument: arg, Call: c}
+	}
+	return arg
+}
+
+func call(fn string, args ...*ast.Property) *ast.CallExpression {
+	var callee ast.Expression = &ast.Identifier{Name: fn}
+	if i := strings.Index(fn, "."); i >= 0 {
+		callee = member(fn[:i], fn[i+1:])
+	}
+	expr := &ast.CallExpression{Callee: callee}
+	if len(args) > 0 {
+		expr.Arguments = []ast.Expression{&ast.ObjectExpression{Properties: args}}
+	}
+	return expr
+}
+
+func property(key string, value ast.Expression) *ast.Property {
+	return &ast.Property{Key: &ast.Identifier{Name: key}, Value: value}
+}
+
+func member(o, p string) *ast.MemberExpression {
+	return &ast.MemberExpression{
+		Object:   &ast.Identifier{Name: o},
+		Property: &ast.Identifier{Name: p},
+	}
+}
+
+// column returns a reference to the column of the row r.
+func column(name string) *ast.MemberExpression {
+	var prop ast.PropertyKey = &ast.Identifier{Name: name}
+	if !isFluxIdent(name) {
+		prop = &ast.StringLiteral{Value: name}
+	}
+	return &ast.MemberExpression{Object: &ast.Identifier{Name: "r"}, Property: prop}
+}
+
+func isFluxIdent(name string) bool {
+	for i, r := range name {
+		if !isIdentStart(r) && (i == 0 || !isDigit(r)) {
+			return false
+		}
+	}
+	return name != "" && !fluxKeywords[name]
+}
+
+// fluxKeywords are the identifiers that cannot be used as a property name.
+var fluxKeywords = map[string]bool{
+	"and": true, "or": true, "not": true, "empty": true, "in": true,
+	"import": true, "package": true, "return": true, "option": true,
+	"builtin": true, "test": true, "if": true, "then": true, "else": true, "exists": true,
+}
+
+func rowFn(body ast.Expression) *ast.FunctionExpression {
+	return &ast.FunctionExpression{
+		Params: []*ast.Property{{Key: &ast.Identifier{Name: "r"}}},
+		Body:   body,
+	}
+}
+
+func columnFn(body ast.Expression) *ast.FunctionExpression {
+	return &ast.FunctionExpression{
+		Params: []*ast.Property{{Key: &ast.Identifier{Name: "column"}}},
+		Body:   body,
+	}
+}
+
+func andExpr(lhs, rhs ast.Expression) ast.Expression {
+	if lhs == nil {
+		return rhs
+	}
+	return &ast.LogicalExpression{Operator: ast.AndOperator, Left: lhs, Right: rhs}
+}
+
+func orExpr(lhs, rhs ast.Expression) ast.Expression {
+	if lhs == nil {
+		return rhs
+	}
+	return &ast.LogicalExpression{Operator: ast.OrOperator, Left: lhs, Right: rhs}
+}
+
+func stringList(strs ...string) *ast.ArrayExpression {
+	list := make([]ast.Expression, len(strs))
+	for i, str := range strs {
+		list[i] = &ast.StringLiteral{Value: str}
+	}
+	return &ast.ArrayExpression{Elements: list}
+}
+
+// durationLiteral converts a duration into a Flux duration literal
+// using the largest units that represent it exactly.
+func durationLiteral(d time.Duration) *ast.DurationLiteral {
+	units := []struct {
+		unit string
+		d    time.Duration
+	}{
+		{"w", 7 * 24 * time.Hour},
+		{"d", 24 * time.Hour},
+		{"h", time.Hour},
+		{"m", time.Minute},
+		{"s", time.Second},
+		{"ms", time.Millisecond},
+		{"us", time.Microsecond},
+		{"ns", time.Nanosecond},
+	}
+	lit := new(ast.DurationLiteral)
+	for _, u := range units {
+		if n := d / u.d; n != 0 {
+			lit.Values = append(lit.Values, ast.Duration{Magnitude: int64(n), Unit: u.unit})
+			d -= n * u.d
+		}
+	}
+	if len(lit.Values) == 0 {
+		lit.Values = append(lit.Values, ast.Duration{Magnitude: 0, Unit: "s"})
+	}
+	return lit
+}
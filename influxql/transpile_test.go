@@ -0,0 +1,258 @@
+package influxql_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/influxdata/flux/ast"
+	"github.com/influxdata/flux/influxql"
+)
+
+func TestTranspiler(t *testing.T) {
+	for _, tt := range []struct {
+		name string
+		s    string
+		want string
+	}{
+		{
+			name: "raw",
+			s:    `SELECT usage_user, usage_system AS sys FROM cpu WHERE host = 'a' AND usage_idle > 10 AND time >= '2020-01-01T00:00:00Z' LIMIT 10 OFFSET 2`,
+			want: `import "influxdata/influxdb"
+import "internal/influxql"
+
+data0 = influxdb.from(bucket: "telegraf/autogen")
+	|> range(start: 2020-01-01T00:00:00Z, stop: influxql.maxTime)
+	|> filter(fn: (r) =>
+		(r._measurement == "cpu" and r.host == "a" and (r._field == "usage_user" or r._field == "usage_system" or r._field == "usage_idle")))
+	|> pivot(rowKey: ["_time"], columnKey: ["_field"], valueColumn: "_value")
+	|> filter(fn: (r) =>
+		(r.usage_idle > 10.0))
+	|> group(columns: ["_start", "_stop", "_measurement"])
+
+data0
+	|> filter(fn: (r) =>
+		(exists r.usage_user or exists r.usage_system))
+	|> keep(columns: ["_time", "_measurement", "usage_user", "usage_system"])
+	|> rename(fn: (column) =>
+		(if column == "usage_system" then "sys" else column))
+	|> sort(columns: ["_time"])
+	|> limit(n: 10, offset: 2)
+	|> yield(name: "0")`,
+		},
+		{
+			name: "wildcard",
+			s:    `SELECT * FROM /cpu|mem/ WHERE time > 0 AND time <= now() ORDER BY time DESC`,
+			want: `import "influxdata/influxdb"
+import "internal/influxql"
+
+data0 = influxdb.from(bucket: "telegraf/autogen")
+	|> range(start: 1970-01-01T00:00:00.000000001Z, stop: 2020-01-02T00:00:00.000000001Z)
+	|> filter(fn: (r) =>
+		(r._measurement =~ /cpu|mem/))
+	|> pivot(rowKey: ["_time"], columnKey: ["_field"], valueColumn: "_value")
+	|> group(columns: ["_start", "_stop", "_measurement"])
+
+data0
+	|> drop(columns: ["_start", "_stop"])
+	|> sort(columns: ["_time"], desc: true)
+	|> yield(name: "0")`,
+		},
+		{
+			name: "group by time",
+			s:    `SELECT mean(usage_user), percentile(usage_user, 95) AS p95 FROM telegraf.autogen.cpu WHERE time >= now() - 1h GROUP BY time(5m, 1m), host fill(0) SLIMIT 2 SOFFSET 1`,
+			want: `import "influxdata/influxdb"
+import "internal/influxql"
+
+data0 = influxdb.from(bucket: "telegraf/autogen")
+	|> range(start: 2020-01-01T23:00:00Z, stop: 2020-01-02T00:00:00Z)
+	|> filter(fn: (r) =>
+		(r._measurement == "cpu" and r._field == "usage_user"))
+	|> group(columns: ["_start", "_stop", "_measurement", "host"])
+
+union(tables: [data0
+	|> aggregateWindow(
+		every: 5m,
+		offset: 1m,
+		fn: mean,
+		timeSrc: "_start",
+	)
+	|> fill(value: 0.0)
+	|> set(key: "_field", value: "mean"), data0
+	|> aggregateWindow(
+		every: 5m,
+		offset: 1m,
+		fn: (column, tables=<-) =>
+			(tables
+				|> quantile(q: 0.95, method: "exact_selector", column: column)),
+		timeSrc: "_start",
+	)
+	|> fill(value: 0.0)
+	|> set(key: "_field", value: "p95")])
+	|> pivot(rowKey: ["_time"], columnKey: ["_field"], valueColumn: "_value")
+	|> drop(columns: ["_start", "_stop"])
+	|> sort(columns: ["_time"])
+	|> influxql.slimit(n: 2, offset: 1)
+	|> yield(name: "0")`,
+		},
+		{
+			name: "aggregate with field condition",
+			s:    `SELECT count(usage_user), median(usage_user) FROM cpu WHERE usage_system >= 20 GROUP BY *`,
+			want: `import "influxdata/influxdb"
+import "internal/influxql"
+
+data0 = influxdb.from(bucket: "telegraf/autogen")
+	|> range(start: influxql.minTime, stop: influxql.maxTime)
+	|> filter(fn: (r) =>
+		(r._measurement == "cpu" and (r._field == "usage_user" or r._field == "usage_system")))
+	|> pivot(rowKey: ["_time"], columnKey: ["_field"], valueColumn: "_value")
+	|> filter(fn: (r) =>
+		(r.usage_system >= 20.0))
+
+union(tables: [data0
+	|> rename(fn: (column) =>
+		(if column == "usage_user" then "_value" else column))
+	|> filter(fn: (r) =>
+		(exists r._value))
+	|> drop(columns: ["usage_system"])
+	|> count()
+	|> map(fn: (r) =>
+		({r with _time: influxql.epoch}))
+	|> set(key: "_field", value: "count")
+	|> group(columns: ["_time", "_value"], mode: "except"), data0
+	|> rename(fn: (column) =>
+		(if column == "usage_user" then "_value" else column))
+	|> filter(fn: (r) =>
+		(exists r._value))
+	|> drop(columns: ["usage_system"])
+	|> quantile(q: 0.5, method: "exact_mean")
+	|> map(fn: (r) =>
+		({r with _time: influxql.epoch}))
+	|> set(key: "_field", value: "median")
+	|> group(columns: ["_time", "_value"], mode: "except")])
+	|> pivot(rowKey: ["_time"], columnKey: ["_field"], valueColumn: "_value")
+	|> drop(columns: ["_start", "_stop"])
+	|> sort(columns: ["_time"])
+	|> yield(name: "0")`,
+		},
+		{
+			name: "selector",
+			s:    `SELECT last("used-percent") FROM disk GROUP BY path; SELECT max(v), max(v) FROM m`,
+			want: `import "influxdata/influxdb"
+import "internal/influxql"
+
+data0 = influxdb.from(bucket: "telegraf/autogen")
+	|> range(start: influxql.minTime, stop: influxql.maxTime)
+	|> filter(fn: (r) =>
+		(r._measurement == "disk" and r._field == "used-percent"))
+	|> group(columns: ["_start", "_stop", "_measurement", "path"])
+	|> sort(columns: ["_time"])
+
+data0
+	|> last()
+	|> set(key: "_field", value: "last")
+	|> pivot(rowKey: ["_time"], columnKey: ["_field"], valueColumn: "_value")
+	|> drop(columns: ["_start", "_stop"])
+	|> sort(columns: ["_time"])
+	|> yield(name: "0")
+
+data1 = influxdb.from(bucket: "telegraf/autogen")
+	|> range(start: influxql.minTime, stop: influxql.maxTime)
+	|> filter(fn: (r) =>
+		(r._measurement == "m" and r._field == "v"))
+	|> group(columns: ["_start", "_stop", "_measurement"])
+
+union(tables: [data1
+	|> max()
+	|> drop(columns: ["_time"])
+	|> map(fn: (r) =>
+		({r with _time: influxql.epoch}))
+	|> set(key: "_field", value: "max"), data1
+	|> max()
+	|> drop(columns: ["_time"])
+	|> map(fn: (r) =>
+		({r with _time: influxql.epoch}))
+	|> set(key: "_field", value: "max_1")])
+	|> pivot(rowKey: ["_time"], columnKey: ["_field"], valueColumn: "_value")
+	|> drop(columns: ["_start", "_stop"])
+	|> sort(columns: ["_time"])
+	|> yield(name: "1")`,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			q, err := influxql.ParseQuery(tt.s)
+			if err != nil {
+				t.Fatal(err)
+			}
+			transpiler := &influxql.Transpiler{
+				Database: "telegraf",
+				Now:      time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC),
+			}
+			file, err := transpiler.Transpile(q)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := ast.Format(file); !cmp.Equal(tt.want, got) {
+				t.Errorf("unexpected flux -want/+got:\n%s", cmp.Diff(tt.want, got))
+			}
+		})
+	}
+}
+
+func TestTranspiler_Error(t *testing.T) {
+	for _, tt := range []struct {
+		s    string
+		db   string
+		want string
+	}{
+		{
+			s:    `SELECT value FROM cpu`,
+			want: "error transpiling statement 0: database name required",
+		},
+		{
+			s:    `SELECT value, mean(value) FROM cpu`,
+			db:   "db",
+			want: "error transpiling statement 0: mixing aggregate and non-aggregate queries is not supported",
+		},
+		{
+			s:    `SELECT mean(value) FROM cpu GROUP BY time(1m)`,
+			db:   "db",
+			want: "error transpiling statement 0: aggregate functions with GROUP BY time require a WHERE time clause with a lower limit",
+		},
+		{
+			s:    `SELECT value FROM cpu GROUP BY time(1m)`,
+			db:   "db",
+			want: "error transpiling statement 0: GROUP BY requires at least one aggregate function",
+		},
+		{
+			s:    `SELECT mean(value) FROM cpu WHERE time > now() - 1h GROUP BY time(1m) fill(linear)`,
+			db:   "db",
+			want: "error transpiling statement 0: fill(linear) is not supported",
+		},
+		{
+			s:    `SELECT derivative(value) FROM cpu`,
+			db:   "db",
+			want: "error transpiling statement 0: function derivative() is not supported",
+		},
+		{
+			s:    `SELECT value FROM cpu WHERE host = 'a' OR time > now()`,
+			db:   "db",
+			want: "error transpiling statement 0: conditions on time must be combined with AND at the top level of the WHERE clause",
+		},
+	} {
+		t.Run(tt.s, func(t *testing.T) {
+			q, err := influxql.ParseQuery(tt.s)
+			if err != nil {
+				t.Fatal(err)
+			}
+			transpiler := &influxql.Transpiler{Database: tt.db}
+			_, err = transpiler.Transpile(q)
+			if err == nil {
+				t.Fatal("expected error")
+			}
+			if got := err.Error(); got != tt.want {
+				t.Errorf("unexpected error -want/+got:\n\t- %s\n\t+ %s", tt.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,337 @@
+// Package columnar contains the pieces shared by the sources and sinks
+// that read and write columnar files such as Parquet and Arrow IPC.
+package columnar
+
+import (
+	"encoding/json"
+	"io"
+	"sync"
+
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/arrow"
+	"github.com/influxdata/flux/codes"
+	"github.com/influxdata/flux/dependencies/filesystem"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/internal/errors"
+	"github.com/influxdata/flux/internal/execute/table"
+	"github.com/influxdata/flux/memory"
+)
+
+// GroupKeyMetadataKey is the key of the file metadata that stores
+// the group key columns of the tables that were written to the file
+// as a JSON list of column names.
+const GroupKeyMetadataKey = "flux.groupKey"
+
+// EncodeGroupKey encodes group key columns for the file metadata.
+func EncodeGroupKey(cols []string) string {
+	if cols == nil {
+		cols = []string{}
+	}
+	data, _ := json.Marshal(cols)
+	return string(data)
+}
+
+// DecodeGroupKey decodes group key columns from the file metadata.
+func DecodeGroupKey(s string) ([]string, error) {
+	var cols []string
+	if err := json.Unmarshal([]byte(s), &cols); err != nil {
+		return nil, errors.Wrapf(err, codes.Invalid, "invalid %s metadata", GroupKeyMetadataKey)
+	}
+	return cols, nil
+}
+
+// Project returns the indices of the columns that should be read.
+// All of the columns are read if columns is empty.
+// Columns that do not exist are ignored.
+func Project(names []string, columns []string) []int {
+	if len(columns) == 0 {
+		idx := make([]int, len(names))
+		for i := range idx {
+			idx[i] = i
+		}
+		return idx
+	}
+	keep := make(map[string]bool, len(columns))
+	for _, c := range columns {
+		keep[c] = true
+	}
+	idx := make([]int, 0, len(columns))
+	for i, n := range names {
+		if keep[n] {
+			idx = append(idx, i)
+		}
+	}
+	return idx
+}
+
+// GroupKeyColumns determines the group key of the tables read from a file.
+// An explicit group key must only contain columns that are read.
+// Otherwise the group key stored in the metadata of the file is used
+// without the columns that were not read.
+func GroupKeyColumns(cols []flux.ColMeta, groupKey []string, explicit bool, metadata string, ok bool) ([]string, error) {
+	exists := make(map[string]bool, len(cols))
+	for _, c := range cols {
+		exists[c.Label] = true
+	}
+	if explicit {
+		for _, k := range groupKey {
+			if !exists[k] {
+				return nil, errors.Newf(codes.Invalid, "group key column %q does not exist", k)
+			}
+		}
+		return groupKey, nil
+	}
+	if !ok {
+		return nil, nil
+	}
+	stored, err := DecodeGroupKey(metadata)
+	if err != nil {
+		return nil, err
+	}
+	key := stored[:0]
+	for _, k := range stored {
+		if exists[k] {
+			key = append(key, k)
+		}
+	}
+	return key, nil
+}
+
+var emptyKey = execute.NewGroupKey(nil, nil)
+
+// Tables groups the buffers read from a file into tables by the
+// values of the group key columns. Each buffer must have the same
+// columns and is released by Tables.
+// A single table with an empty group key is returned when there
+// are no group key columns, even if there are no rows.
+func Tables(buffers []*arrow.TableBuffer, cols []flux.ColMeta, groupKey []string, alloc *memory.Allocator) ([]flux.Table, error) {
+	defer func() {
+		for _, buf := range buffers {
+			buf.Release()
+		}
+	}()
+
+	if len(groupKey) == 0 {
+		builder := execute.NewColListTableBuilder(emptyKey, alloc)
+		for _, c := range cols {
+			if _, err := builder.AddCol(c); err != nil {
+				return nil, err
+			}
+		}
+		for _, buf := range buffers {
+			if err := execute.AppendCols(buf, builder); err != nil {
+				return nil, err
+			}
+		}
+		tbl, err := builder.Table()
+		if err != nil {
+			return nil, err
+		}
+		return []flux.Table{tbl}, nil
+	}
+
+	on := make(map[string]bool, len(groupKey))
+	for _, k := range groupKey {
+		on[k] = true
+	}
+	builders := execute.NewGroupLookup()
+	for _, buf := range buffers {
+		for i := 0; i < buf.Len(); i++ {
+			key := execute.GroupKeyForRowOn(i, buf, on)
+			v, ok := builders.Lookup(key)
+			if !ok {
+				b := execute.NewColListTableBuilder(key, alloc)
+				for _, c := range cols {
+					if _, err := b.AddCol(c); err != nil {
+						return nil, err
+					}
+				}
+				builders.Set(key, b)
+				v = b
+			}
+			if err := execute.AppendRecord(i, buf, v.(*execute.ColListTableBuilder)); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	var (
+		tables []flux.Table
+		err    error
+	)
+	builders.Range(func(_ flux.GroupKey, v interface{}) {
+		if err != nil {
+			return
+		}
+		var tbl flux.Table
+		tbl, err = v.(*execute.ColListTableBuilder).Table()
+		tables = append(tables, tbl)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return tables, nil
+}
+
+// Collector buffers the tables that are written to a file
+// and combines their columns into a single schema.
+type Collector struct {
+	mu     sync.Mutex
+	tables []flux.BufferedTable
+	cols   []flux.ColMeta
+	key    []string
+}
+
+// Add buffers a table. It is an error for a column to have
+// a different type than a column with the same label in another table.
+func (c *Collector) Add(tbl flux.BufferedTable) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, col := range tbl.Cols() {
+		if idx := execute.ColIdx(col.Label, c.cols); idx < 0 {
+			c.cols = append(c.cols, col)
+		} else if c.cols[idx].Type != col.Type {
+			tbl.Done()
+			return errors.Newf(codes.Invalid, "column %q has type %s and %s in different tables", col.Label, c.cols[idx].Type, col.Type)
+		}
+	}
+	for _, col := range tbl.Key().Cols() {
+		if !contains(c.key, col.Label) {
+			c.key = append(c.key, col.Label)
+		}
+	}
+	c.tables = append(c.tables, tbl)
+	return nil
+}
+
+func contains(ss []string, s string) bool {
+	for _, v := range ss {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+// Columns returns the union of the columns of the tables.
+func (c *Collector) Columns() []flux.ColMeta {
+	return c.cols
+}
+
+// GroupKey returns the union of the group key columns of the tables.
+func (c *Collector) GroupKey() []string {
+	return c.key
+}
+
+// Tables calls f with the buffers of each table that is not empty.
+// Every buffer has the columns returned by Columns and the columns
+// that are missing from a table are filled with nulls.
+func (c *Collector) Tables(mem *memory.Allocator, f func(buffers []flux.ColReader) error) error {
+	for _, tbl := range c.tables {
+		if tbl.BufferN() == 0 {
+			continue
+		}
+		buffers := make([]flux.ColReader, 0, tbl.BufferN())
+		for i, n := 0, tbl.BufferN(); i < n; i++ {
+			buffers = append(buffers, c.widen(tbl.Buffer(i), mem))
+		}
+		err := f(buffers)
+		for _, buf := range buffers {
+			buf.Release()
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// widen returns a buffer with every column of the collector.
+func (c *Collector) widen(cr flux.ColReader, mem *memory.Allocator) flux.ColReader {
+	buf := &arrow.TableBuffer{
+		GroupKey: cr.Key(),
+		Columns:  c.cols,
+		Values:   make([]array.Interface, len(c.cols)),
+	}
+	for j, col := range c.cols {
+		if idx := execute.ColIdx(col.Label, cr.Cols()); idx >= 0 {
+			vs := table.Values(cr, idx)
+			vs.Retain()
+			buf.Values[j] = vs
+			continue
+		}
+		b := arrow.NewBuilder(col.Type, mem)
+		for i := 0; i < cr.Len(); i++ {
+			b.AppendNull()
+		}
+		buf.Values[j] = b.NewArray()
+		b.Release()
+	}
+	return buf
+}
+
+// Release releases the buffered tables.
+func (c *Collector) Release() {
+	for _, tbl := range c.tables {
+		tbl.Done()
+	}
+	c.tables = nil
+}
+
+// ReaderAt reads from a file of the filesystem service at an offset.
+// The file service does not require files to implement io.ReaderAt
+// so the file is positioned with Seek before each read.
+type ReaderAt struct {
+	mu sync.Mutex
+	f  filesystem.File
+}
+
+// NewReaderAt creates a ReaderAt for the file.
+func NewReaderAt(f filesystem.File) *ReaderAt {
+	return &ReaderAt{f: f}
+}
+
+func (r *ReaderAt) ReadAt(p []byte, off int64) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, err := r.f.Seek(off, io.SeekStart); err != nil {
+		return 0, err
+	}
+	n, err := io.ReadFull(r.f, p)
+	if err == io.ErrUnexpectedEOF {
+		err = io.EOF
+	}
+	return n, err
+}
+
+// Seek implements io.Seeker so the reader can be
+// used by readers that determine the size of the file.
+func (r *ReaderAt) Seek(offset int64, whence int) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.f.Seek(offset, whence)
+}
+
+// Read implements io.Reader by reading from the current offset.
+func (r *ReaderAt) Read(p []byte) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.f.Read(p)
+}
+
+// OpenFile opens a file with the filesystem service
+// and returns it with its size.
+func OpenFile(fs filesystem.Service, fpath string) (filesystem.File, int64, error) {
+	f, err := fs.Open(fpath)
+	if err != nil {
+		return nil, 0, errors.Wrapf(err, codes.Inherit, "failed to open %q", fpath)
+	}
+	fi, err := f.Stat()
+	if err != nil {
+		_ = f.Close()
+		return nil, 0, errors.Wrapf(err, codes.Inherit, "failed to stat %q", fpath)
+	}
+	return f, fi.Size(), nil
+}
@@ -0,0 +1,90 @@
+package columnar
+
+import (
+	"github.com/influxdata/flux/plan"
+	"github.com/influxdata/flux/stdlib/universe"
+)
+
+// Projector is implemented by the procedure specs of file sources
+// that are able to read only some of the columns of a file.
+type Projector interface {
+	plan.PhysicalProcedureSpec
+	// Project returns a copy of the spec that reads only the given columns.
+	// It returns false if the columns cannot be pushed into the source.
+	Project(columns []string) (plan.PhysicalProcedureSpec, bool)
+}
+
+// KeepRule merges a call to keep with a list of columns into the
+// file source that produces its input, so the columns that are not
+// kept are never read from the file.
+type KeepRule struct {
+	RuleName string
+	Source   plan.ProcedureKind
+}
+
+func (r KeepRule) Name() string {
+	return r.RuleName
+}
+
+func (r KeepRule) Pattern() plan.Pattern {
+	return plan.Pat(universe.SchemaMutationKind, plan.Pat(r.Source))
+}
+
+func (r KeepRule) Rewrite(node plan.Node) (plan.Node, bool, error) {
+	spec := node.ProcedureSpec().(*universe.SchemaMutationProcedureSpec)
+	if len(spec.Mutations) != 1 {
+		return node, false, nil
+	}
+	keep, ok := spec.Mutations[0].(*universe.KeepOpSpec)
+	if !ok || len(keep.Columns) == 0 || keep.Predicate.Fn != nil {
+		return node, false, nil
+	}
+
+	fromNode := node.Predecessors()[0]
+	if len(fromNode.Successors()) != 1 {
+		return node, false, nil
+	}
+	from, ok := fromNode.ProcedureSpec().(Projector)
+	if !ok {
+		return node, false, nil
+	}
+	mergedSpec, ok := from.Project(keep.Columns)
+	if !ok {
+		return node, false, nil
+	}
+	mergedNode, err := plan.MergeToPhysicalNode(node, fromNode, mergedSpec)
+	if err != nil {
+		return nil, false, err
+	}
+	return mergedNode, true, nil
+}
+
+// ProjectColumns combines the columns read by a source with the
+// columns that are kept. It returns false if the group key of the
+// source would lose a column or if no column would be left to read,
+// since reading no columns means reading all of them.
+// A nil group key is the group key stored in the file, which
+// drops the columns that are not read on its own.
+func ProjectColumns(columns, groupKey, keep []string) ([]string, bool) {
+	for _, k := range groupKey {
+		if !contains(keep, k) {
+			return nil, false
+		}
+	}
+	if len(columns) == 0 {
+		if len(keep) == 0 {
+			return nil, false
+		}
+		return append([]string(nil), keep...), true
+	}
+	projected := make([]string, 0, len(columns))
+	for _, c := range columns {
+		if contains(keep, c) {
+			projected = append(projected, c)
+		}
+	}
+	if len(projected) == 0 {
+		return nil, false
+	}
+	return projected, true
+}
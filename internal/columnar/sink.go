@@ -0,0 +1,88 @@
+package columnar
+
+import (
+	"io"
+
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/codes"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/internal/errors"
+	"github.com/influxdata/flux/memory"
+)
+
+// WriteFunc writes the tables of the collector to a file.
+type WriteFunc func(w io.WriteSeeker, c *Collector, mem *memory.Allocator) error
+
+// WriteTransformation buffers the tables that pass through it and
+// writes them to a file of the filesystem service when it finishes.
+// A columnar file has a single schema that is only known once
+// every table has been seen, so the file cannot be written sooner.
+type WriteTransformation struct {
+	d     *execute.PassthroughDataset
+	deps  flux.Dependencies
+	mem   *memory.Allocator
+	file  string
+	write WriteFunc
+	c     Collector
+}
+
+// NewWriteTransformation creates a transformation that writes its tables to file.
+func NewWriteTransformation(id execute.DatasetID, deps flux.Dependencies, mem *memory.Allocator, file string, write WriteFunc) (*WriteTransformation, *execute.PassthroughDataset) {
+	d := execute.NewPassthroughDataset(id)
+	t := &WriteTransformation{
+		d:     d,
+		deps:  deps,
+		mem:   mem,
+		file:  file,
+		write: write,
+	}
+	return t, d
+}
+
+func (t *WriteTransformation) RetractTable(id execute.DatasetID, key flux.GroupKey) error {
+	return t.d.RetractTable(key)
+}
+
+func (t *WriteTransformation) Process(id execute.DatasetID, tbl flux.Table) error {
+	buf, err := execute.CopyTable(tbl)
+	if err != nil {
+		return err
+	}
+	if err := t.c.Add(buf.Copy()); err != nil {
+		buf.Done()
+		return err
+	}
+	return t.d.Process(buf)
+}
+
+func (t *WriteTransformation) UpdateWatermark(id execute.DatasetID, mark execute.Time) error {
+	return t.d.UpdateWatermark(mark)
+}
+
+func (t *WriteTransformation) UpdateProcessingTime(id execute.DatasetID, pt execute.Time) error {
+	return t.d.UpdateProcessingTime(pt)
+}
+
+func (t *WriteTransformation) Finish(id execute.DatasetID, err error) {
+	if err == nil {
+		err = t.writeFile()
+	}
+	t.c.Release()
+	t.d.Finish(err)
+}
+
+func (t *WriteTransformation) writeFile() error {
+	fs, err := t.deps.FilesystemService()
+	if err != nil {
+		return err
+	}
+	f, err := fs.Create(t.file)
+	if err != nil {
+		return errors.Wrapf(err, codes.Inherit, "failed to create %q", t.file)
+	}
+	if err := t.write(f, &t.c, t.mem); err != nil {
+		_ = f.Close()
+		return errors.Wrapf(err, codes.Inherit, "failed to write %q", t.file)
+	}
+	return f.Close()
+}
@@ -1,6 +1,8 @@
 package table
 
 import (
+	"context"
+
 	"github.com/apache/arrow/go/arrow/array"
 	"github.com/apache/arrow/go/arrow/memory"
 	"github.com/influxdata/flux"
@@ -17,6 +19,11 @@ type BufferedBuilder struct {
 	Columns   []flux.ColMeta
 	Buffers   []*arrow.TableBuffer
 	Allocator memory.Allocator
+
+	// Spiller is used by Spill to write the buffers to disk.
+	Spiller *Spiller
+
+	runs []*Run
 }
 
 // NewBufferedBuilder constructs a new BufferedBuilder.
@@ -38,7 +45,7 @@ func GetBufferedBuilder(key flux.GroupKey, cache *BuilderCache) (builder *Buffer
 // It ensures the schemas are compatible and will backfill previous
 // buffers with nil for new columns that didn't previously exist.
 func (b *BufferedBuilder) AppendBuffer(cr flux.ColReader) error {
-	if len(b.Buffers) == 0 {
+	if len(b.Buffers) == 0 && len(b.runs) == 0 {
 		// If there are no buffers, then take the columns
 		// from the column reader and append the buffer directly.
 		b.Columns = cr.Cols()
@@ -130,7 +137,32 @@ func (b *BufferedBuilder) getAllocator() memory.Allocator {
 	return mem
 }
 
+// Spill writes the buffers to disk with the Spiller and releases them.
+// The spilled buffers are read back when the table is constructed.
+func (b *BufferedBuilder) Spill() error {
+	if len(b.Buffers) == 0 {
+		return nil
+	}
+	buffers := make([]flux.ColReader, 0, len(b.Buffers))
+	for _, buf := range b.Buffers {
+		buffers = append(buffers, buf)
+	}
+	run, err := b.Spiller.WriteRun(b.GroupKey, b.Columns, buffers...)
+	if err != nil {
+		return err
+	}
+	for _, buf := range b.Buffers {
+		buf.Release()
+	}
+	b.Buffers = nil
+	b.runs = append(b.runs, run)
+	return nil
+}
+
 func (b *BufferedBuilder) Table() (flux.Table, error) {
+	if len(b.runs) > 0 {
+		return b.spilledTable()
+	}
 	buffers := make([]flux.ColReader, 0, len(b.Buffers))
 	for _, buf := range b.Buffers {
 		buffers = append(buffers, buf)
@@ -143,8 +175,55 @@ func (b *BufferedBuilder) Table() (flux.Table, error) {
 	}, nil
 }
 
+// spilledTable constructs a table that reads the spilled runs
+// before the buffers that are still in memory.
+func (b *BufferedBuilder) spilledTable() (flux.Table, error) {
+	runs, buffers, cols := b.runs, b.Buffers, b.Columns
+	b.runs, b.Buffers = nil, nil
+	mem := b.getAllocator()
+	return Stream(b.GroupKey, cols, func(ctx context.Context, w *StreamWriter) error {
+		defer func() {
+			for _, r := range runs {
+				_ = r.Remove()
+			}
+			for _, buf := range buffers {
+				buf.Release()
+			}
+		}()
+		for _, r := range runs {
+			if err := r.Do(mem, func(cr *arrow.TableBuffer) error {
+				// Columns may have been added after the run was written.
+				vs := make([]array.Interface, len(cols))
+				for j, c := range cols {
+					if idx := execute.ColIdx(c.Label, cr.Columns); idx >= 0 {
+						vs[j] = cr.Values[idx]
+						vs[j].Retain()
+					} else {
+						vs[j] = b.newNullColumn(c.Type, cr.Len(), mem)
+					}
+				}
+				return w.UnsafeWrite(vs)
+			}); err != nil {
+				return err
+			}
+		}
+		for len(buffers) > 0 {
+			buf := buffers[0]
+			buffers = buffers[1:]
+			if err := w.UnsafeWriteBuffer(buf); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
 func (b *BufferedBuilder) Release() {
 	for _, buf := range b.Buffers {
 		buf.Release()
 	}
+	for _, r := range b.runs {
+		_ = r.Remove()
+	}
+	b.runs = nil
 }
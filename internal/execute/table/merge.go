@@ -0,0 +1,388 @@
+package table
+
+import (
+	"bytes"
+	"container/heap"
+	"context"
+
+	"github.com/apache/arrow/go/arrow/array"
+	arrowmemory "github.com/apache/arrow/go/arrow/memory"
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/arrow"
+	"github.com/influxdata/flux/codes"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/internal/errors"
+)
+
+// mergeBufferSize is the number of rows in each buffer
+// produced when merging runs.
+const mergeBufferSize = 1024
+
+// MergeOptions configures how runs are merged by MergeRuns.
+type MergeOptions struct {
+	// Key is the group key of the resulting table.
+	Key flux.GroupKey
+
+	// Columns are the columns of the resulting table.
+	// A run that is missing a column contributes null values for it.
+	Columns []flux.ColMeta
+
+	// SortBy lists the columns each run is sorted by.
+	SortBy []string
+
+	// Desc indicates the runs are sorted in descending order.
+	Desc bool
+
+	// Combine merges rows that have equal values in the SortBy
+	// columns into a single row. Non-null values from later runs
+	// take precedence over values from earlier runs.
+	Combine bool
+
+	// Allocator is used to allocate the resulting table.
+	Allocator arrowmemory.Allocator
+}
+
+// MergeRuns combines runs that are each sorted by the same columns
+// into a single table that is also sorted by those columns.
+// Null values sort before all other values, as they do for
+// execute.ColListTableBuilder. Rows with equal sort values
+// retain the order of the runs they came from.
+//
+// Only one buffer from each run is held in memory at a time.
+// The runs are removed once the table has been consumed.
+func MergeRuns(runs []*Run, opts MergeOptions) (flux.Table, error) {
+	if opts.Allocator == nil {
+		opts.Allocator = arrowmemory.DefaultAllocator
+	}
+	return Stream(opts.Key, opts.Columns, func(ctx context.Context, w *StreamWriter) error {
+		m := &runMerger{opts: opts}
+		defer m.close(runs)
+		if err := m.open(runs); err != nil {
+			return err
+		}
+		return m.merge(ctx, w)
+	})
+}
+
+type runMerger struct {
+	opts    MergeOptions
+	cursors runCursors
+}
+
+func (m *runMerger) open(runs []*Run) error {
+	for i, r := range runs {
+		rr, err := r.open(m.opts.Allocator)
+		if err != nil {
+			return err
+		}
+		c := &runCursor{
+			index:   i,
+			rr:      rr,
+			colMap:  make([]int, len(m.opts.Columns)),
+			sortIdx: make([]int, len(m.opts.SortBy)),
+		}
+		for j, col := range m.opts.Columns {
+			c.colMap[j] = execute.ColIdx(col.Label, r.cols)
+		}
+		for j, label := range m.opts.SortBy {
+			c.sortIdx[j] = execute.ColIdx(label, r.cols)
+		}
+		m.cursors.desc = m.opts.Desc
+		m.cursors.items = append(m.cursors.items, c)
+		if ok, err := c.advance(); err != nil {
+			return err
+		} else if !ok {
+			m.cursors.items = m.cursors.items[:len(m.cursors.items)-1]
+			c.close()
+		}
+	}
+	heap.Init(&m.cursors)
+	return nil
+}
+
+func (m *runMerger) close(runs []*Run) {
+	for _, c := range m.cursors.items {
+		c.close()
+	}
+	for _, r := range runs {
+		_ = r.Remove()
+	}
+}
+
+func (m *runMerger) merge(ctx context.Context, w *StreamWriter) error {
+	builders := m.newBuilders()
+	n := 0
+	for m.cursors.Len() > 0 {
+		c := m.cursors.items[0]
+		if m.opts.Combine {
+			if err := m.appendCombined(builders); err != nil {
+				return err
+			}
+		} else {
+			for j, b := range builders {
+				appendValueFrom(b, c.buf, c.colMap[j], c.row)
+			}
+			if err := m.next(c); err != nil {
+				return err
+			}
+		}
+
+		if n++; n == mergeBufferSize {
+			if err := m.flush(w, builders); err != nil {
+				return err
+			}
+			builders = m.newBuilders()
+			n = 0
+		}
+	}
+	if n > 0 {
+		return m.flush(w, builders)
+	}
+	for _, b := range builders {
+		b.Release()
+	}
+	return nil
+}
+
+// appendCombined appends a single row that combines every
+// row at the head of the cursors that is equal to the first one.
+func (m *runMerger) appendCombined(builders []array.Builder) error {
+	first := m.cursors.items[0]
+	var equal []*runCursor
+	for m.cursors.Len() > 0 && (len(equal) == 0 || compareRows(first, m.cursors.items[0]) == 0) {
+		equal = append(equal, heap.Pop(&m.cursors).(*runCursor))
+	}
+
+	for j, b := range builders {
+		// Equal rows are popped in run order so the
+		// last non-null value belongs to the latest run.
+		src := -1
+		for i, c := range equal {
+			if idx := c.colMap[j]; idx >= 0 && c.buf.Values[idx].IsValid(c.row) {
+				src = i
+			}
+		}
+		if src < 0 {
+			b.AppendNull()
+			continue
+		}
+		c := equal[src]
+		appendValueFrom(b, c.buf, c.colMap[j], c.row)
+	}
+
+	for _, c := range equal {
+		if ok, err := c.advance(); err != nil {
+			return err
+		} else if ok {
+			heap.Push(&m.cursors, c)
+		} else {
+			c.close()
+		}
+	}
+	return nil
+}
+
+// next advances the cursor at the head of the heap.
+func (m *runMerger) next(c *runCursor) error {
+	ok, err := c.advance()
+	if err != nil {
+		return err
+	}
+	if ok {
+		heap.Fix(&m.cursors, 0)
+		return nil
+	}
+	heap.Pop(&m.cursors)
+	c.close()
+	return nil
+}
+
+func (m *runMerger) newBuilders() []array.Builder {
+	builders := make([]array.Builder, len(m.opts.Columns))
+	for j, c := range m.opts.Columns {
+		builders[j] = arrow.NewBuilder(c.Type, m.opts.Allocator)
+	}
+	return builders
+}
+
+func (m *runMerger) flush(w *StreamWriter, builders []array.Builder) error {
+	vs := make([]array.Interface, len(builders))
+	for j, b := range builders {
+		vs[j] = b.NewArray()
+		b.Release()
+	}
+	return w.Write(vs)
+}
+
+// runCursor tracks the current row of a run that is being merged.
+type runCursor struct {
+	index   int
+	rr      *runReader
+	buf     *arrow.TableBuffer
+	row     int
+	colMap  []int
+	sortIdx []int
+}
+
+// advance moves the cursor to the next row. It returns false
+// when there are no more rows in the run.
+func (c *runCursor) advance() (bool, error) {
+	if c.buf != nil {
+		c.row++
+		if c.row < c.buf.Len() {
+			return true, nil
+		}
+		c.buf.Release()
+		c.buf = nil
+	}
+
+	for {
+		buf, err := c.rr.Next()
+		if err != nil {
+			return false, err
+		} else if buf == nil {
+			return false, nil
+		} else if buf.Len() == 0 {
+			buf.Release()
+			continue
+		}
+		c.buf, c.row = buf, 0
+		return true, nil
+	}
+}
+
+func (c *runCursor) close() {
+	if c.buf != nil {
+		c.buf.Release()
+		c.buf = nil
+	}
+	if c.rr != nil {
+		c.rr.Close()
+		c.rr = nil
+	}
+}
+
+// runCursors is a heap of cursors ordered by their current row.
+type runCursors struct {
+	items []*runCursor
+	desc  bool
+}
+
+func (h *runCursors) Len() int { return len(h.items) }
+
+func (h *runCursors) Less(i, j int) bool {
+	x, y := h.items[i], h.items[j]
+	cmp := compareRows(x, y)
+	if cmp == 0 {
+		return x.index < y.index
+	}
+	// Null values sort first in both directions so the
+	// comparison only flips when neither value is null.
+	if h.desc && !nullCompared(x, y) {
+		return cmp > 0
+	}
+	return cmp < 0
+}
+
+func (h *runCursors) Swap(i, j int) { h.items[i], h.items[j] = h.items[j], h.items[i] }
+
+func (h *runCursors) Push(x interface{}) { h.items = append(h.items, x.(*runCursor)) }
+
+func (h *runCursors) Pop() interface{} {
+	n := len(h.items)
+	c := h.items[n-1]
+	h.items = h.items[:n-1]
+	return c
+}
+
+// compareRows compares the sort columns of the current
+// rows of two cursors in column order.
+func compareRows(x, y *runCursor) int {
+	for i := range x.sortIdx {
+		if cmp := compareValues(x.buf, x.sortIdx[i], x.row, y.buf, y.sortIdx[i], y.row); cmp != 0 {
+			return cmp
+		}
+	}
+	return 0
+}
+
+// nullCompared reports whether the first sort column that differs
+// between the two rows differs because one of the values is null.
+func nullCompared(x, y *runCursor) bool {
+	for i := range x.sortIdx {
+		if compareValues(x.buf, x.sortIdx[i], x.row, y.buf, y.sortIdx[i], y.row) != 0 {
+			return isNull(x.buf, x.sortIdx[i], x.row) || isNull(y.buf, y.sortIdx[i], y.row)
+		}
+	}
+	return false
+}
+
+func isNull(buf *arrow.TableBuffer, j, i int) bool {
+	return j < 0 || buf.Values[j].IsNull(i)
+}
+
+// compareValues compares two values with nulls sorting first.
+// The columns are assumed to have the same type.
+func compareValues(x *arrow.TableBuffer, xj, xi int, y *arrow.TableBuffer, yj, yi int) int {
+	xnull, ynull := isNull(x, xj, xi), isNull(y, yj, yi)
+	if xnull || ynull {
+		switch {
+		case xnull && ynull:
+			return 0
+		case xnull:
+			return -1
+		default:
+			return 1
+		}
+	}
+
+	switch xv := x.Values[xj].(type) {
+	case *array.Int64:
+		return compareOrdered(xv.Value(xi) < y.Values[yj].(*array.Int64).Value(yi), xv.Value(xi) > y.Values[yj].(*array.Int64).Value(yi))
+	case *array.Uint64:
+		return compareOrdered(xv.Value(xi) < y.Values[yj].(*array.Uint64).Value(yi), xv.Value(xi) > y.Values[yj].(*array.Uint64).Value(yi))
+	case *array.Float64:
+		return compareOrdered(xv.Value(xi) < y.Values[yj].(*array.Float64).Value(yi), xv.Value(xi) > y.Values[yj].(*array.Float64).Value(yi))
+	case *array.Binary:
+		return bytes.Compare(xv.Value(xi), y.Values[yj].(*array.Binary).Value(yi))
+	case *array.Boolean:
+		a, b := xv.Value(xi), y.Values[yj].(*array.Boolean).Value(yi)
+		return compareOrdered(!a && b, a && !b)
+	default:
+		panic(errors.Newf(codes.Internal, "unsupported array type %T", xv))
+	}
+}
+
+func compareOrdered(less, greater bool) int {
+	switch {
+	case less:
+		return -1
+	case greater:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// appendValueFrom appends the value at row i of column j in the
+// buffer to the builder. A negative column index appends a null.
+func appendValueFrom(b array.Builder, buf *arrow.TableBuffer, j, i int) {
+	if j < 0 || buf.Values[j].IsNull(i) {
+		b.AppendNull()
+		return
+	}
+	switch b := b.(type) {
+	case *array.Int64Builder:
+		b.Append(buf.Values[j].(*array.Int64).Value(i))
+	case *array.Uint64Builder:
+		b.Append(buf.Values[j].(*array.Uint64).Value(i))
+	case *array.Float64Builder:
+		b.Append(buf.Values[j].(*array.Float64).Value(i))
+	case *array.BinaryBuilder:
+		b.Append(buf.Values[j].(*array.Binary).Value(i))
+	case *array.BooleanBuilder:
+		b.Append(buf.Values[j].(*array.Boolean).Value(i))
+	default:
+		panic(errors.Newf(codes.Internal, "unsupported builder type %T", b))
+	}
+}
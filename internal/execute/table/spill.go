@@ -0,0 +1,361 @@
+package table
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+
+	arrowlib "github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/ipc"
+	arrowmemory "github.com/apache/arrow/go/arrow/memory"
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/arrow"
+	"github.com/influxdata/flux/codes"
+	"github.com/influxdata/flux/dependencies/filesystem"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/internal/errors"
+	"github.com/influxdata/flux/memory"
+)
+
+// DefaultSpillThreshold is the fraction of the memory limit
+// at which a Spiller will start writing data to disk.
+const DefaultSpillThreshold = 0.75
+
+// spillFileID is used to give each spill file a unique name.
+var spillFileID uint64
+
+// Spiller writes buffered table data to temporary files when
+// a query is close to its memory limit. The memory used by the
+// data can then be released and the data is read back from
+// the files when it is needed.
+type Spiller struct {
+	// FS is the filesystem used to create the temporary files.
+	// It must implement filesystem.Remover.
+	FS filesystem.Service
+
+	// Dir is the directory where the temporary files are created.
+	Dir string
+
+	// Allocator is the allocator whose limit determines
+	// when the data should be spilled.
+	Allocator *memory.Allocator
+
+	// Threshold is the fraction of the memory limit at which
+	// data should be spilled. If this is zero, the
+	// DefaultSpillThreshold is used.
+	Threshold float64
+}
+
+// NewSpiller returns the Spiller for a transformation.
+// It returns nil if spilling has not been enabled for the query
+// or if the filesystem service does not support removing files.
+// A nil Spiller never spills.
+func NewSpiller(a execute.Administration) *Spiller {
+	dir := execute.SpillDirectory(a.Context())
+	if dir == "" {
+		return nil
+	}
+	fs, err := flux.GetDependencies(a.Context()).FilesystemService()
+	if err != nil {
+		return nil
+	}
+	if _, ok := fs.(filesystem.Remover); !ok {
+		return nil
+	}
+	return &Spiller{
+		FS:        fs,
+		Dir:       dir,
+		Allocator: a.Allocator(),
+	}
+}
+
+// ShouldSpill reports whether the allocator is close
+// enough to its limit that buffered data should be spilled.
+func (s *Spiller) ShouldSpill() bool {
+	if s == nil {
+		return false
+	}
+	threshold := s.Threshold
+	if threshold == 0 {
+		threshold = DefaultSpillThreshold
+	}
+	return s.Allocator.NearLimit(threshold)
+}
+
+// WriteRun writes the buffers to a new temporary file as a run
+// of arrow record batches. Each buffer must have the given columns.
+// The buffers are not released by this method.
+func (s *Spiller) WriteRun(key flux.GroupKey, cols []flux.ColMeta, buffers ...flux.ColReader) (*Run, error) {
+	return s.writeRun(key, cols, func(write func(cr flux.ColReader) error) error {
+		for _, cr := range buffers {
+			if err := write(cr); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// WriteBuilder writes the rows of the builder to a new temporary file
+// as a run. The rows are converted in small batches so that writing
+// the run does not require a second copy of the builder in memory.
+// The builder is not modified by this method.
+func (s *Spiller) WriteBuilder(b *execute.ColListTableBuilder) (*Run, error) {
+	key, cols, mem := b.Key(), b.Cols(), s.arrowAllocator()
+	return s.writeRun(key, cols, func(write func(cr flux.ColReader) error) error {
+		for start, n := 0, b.NRows(); start < n; start += mergeBufferSize {
+			stop := start + mergeBufferSize
+			if stop > n {
+				stop = n
+			}
+
+			builders := make([]array.Builder, len(cols))
+			for j, c := range cols {
+				builders[j] = arrow.NewBuilder(c.Type, mem)
+				builders[j].Reserve(stop - start)
+			}
+			for i := start; i < stop; i++ {
+				row := b.GetRow(i)
+				for j, c := range cols {
+					v, _ := row.Get(c.Label)
+					if err := arrow.AppendValue(builders[j], v); err != nil {
+						return err
+					}
+				}
+			}
+
+			cr := &arrow.TableBuffer{
+				GroupKey: key,
+				Columns:  cols,
+				Values:   make([]array.Interface, len(cols)),
+			}
+			for j, builder := range builders {
+				cr.Values[j] = builder.NewArray()
+				builder.Release()
+			}
+			err := write(cr)
+			cr.Release()
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (s *Spiller) arrowAllocator() arrowmemory.Allocator {
+	if s.Allocator == nil {
+		return arrowmemory.DefaultAllocator
+	}
+	return s.Allocator
+}
+
+func (s *Spiller) writeRun(key flux.GroupKey, cols []flux.ColMeta, fn func(write func(cr flux.ColReader) error) error) (*Run, error) {
+	fpath := filepath.Join(s.Dir, fmt.Sprintf("flux-spill-%d-%d.arrow", os.Getpid(), atomic.AddUint64(&spillFileID, 1)))
+	f, err := s.FS.Create(fpath)
+	if err != nil {
+		return nil, errors.Wrap(err, codes.Internal, "failed to create spill file")
+	}
+
+	r := &Run{
+		fs:   s.FS,
+		path: fpath,
+		key:  key,
+		cols: cols,
+	}
+	if err := r.write(f, fn); err != nil {
+		_ = f.Close()
+		_ = r.Remove()
+		return nil, err
+	}
+	if err := f.Close(); err != nil {
+		_ = r.Remove()
+		return nil, errors.Wrap(err, codes.Internal, "failed to close spill file")
+	}
+	return r, nil
+}
+
+// Run is a sequence of table buffers that have
+// been written to a temporary file.
+type Run struct {
+	fs    filesystem.Service
+	path  string
+	key   flux.GroupKey
+	cols  []flux.ColMeta
+	nrows int
+}
+
+// Key returns the group key of the data in the run.
+func (r *Run) Key() flux.GroupKey {
+	return r.key
+}
+
+// Cols returns the columns of the data in the run.
+func (r *Run) Cols() []flux.ColMeta {
+	return r.cols
+}
+
+// Len returns the number of rows in the run.
+func (r *Run) Len() int {
+	return r.nrows
+}
+
+func (r *Run) write(f filesystem.File, fn func(write func(cr flux.ColReader) error) error) error {
+	schema := runSchema(r.cols)
+	w := ipc.NewWriter(f, ipc.WithSchema(schema))
+	if err := fn(func(cr flux.ColReader) error {
+		if cr.Len() == 0 {
+			return nil
+		}
+		vs := make([]array.Interface, len(r.cols))
+		for j, c := range r.cols {
+			vs[j] = Values(cr, j)
+			if c.Type == flux.TString {
+				// Flux stores strings in binary arrays, but the
+				// writer requires a string array for the schema.
+				vs[j] = array.NewStringData(vs[j].Data())
+				defer vs[j].Release()
+			}
+		}
+		rec := array.NewRecord(schema, vs, int64(cr.Len()))
+		defer rec.Release()
+		if err := w.Write(rec); err != nil {
+			return errors.Wrap(err, codes.Internal, "failed to write spill file")
+		}
+		r.nrows += cr.Len()
+		return nil
+	}); err != nil {
+		_ = w.Close()
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return errors.Wrap(err, codes.Internal, "failed to write spill file")
+	}
+	return nil
+}
+
+// Do reads the run and calls f with each buffer that was written
+// to it in the order they were written. The buffer is released
+// after f returns so f must retain any arrays it keeps.
+func (r *Run) Do(mem arrowmemory.Allocator, f func(cr *arrow.TableBuffer) error) error {
+	rr, err := r.open(mem)
+	if err != nil {
+		return err
+	}
+	defer rr.Close()
+
+	for {
+		cr, err := rr.Next()
+		if err != nil {
+			return err
+		} else if cr == nil {
+			return nil
+		}
+		err = f(cr)
+		cr.Release()
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// Remove deletes the temporary file for the run.
+func (r *Run) Remove() error {
+	if err := r.fs.(filesystem.Remover).Remove(r.path); err != nil {
+		return errors.Wrap(err, codes.Internal, "failed to remove spill file")
+	}
+	return nil
+}
+
+func (r *Run) open(mem arrowmemory.Allocator) (*runReader, error) {
+	if mem == nil {
+		mem = arrowmemory.DefaultAllocator
+	}
+	f, err := r.fs.Open(r.path)
+	if err != nil {
+		return nil, errors.Wrap(err, codes.Internal, "failed to open spill file")
+	}
+	rdr, err := ipc.NewReader(f, ipc.WithAllocator(mem))
+	if err != nil {
+		_ = f.Close()
+		return nil, errors.Wrap(err, codes.Internal, "failed to read spill file")
+	}
+	return &runReader{run: r, f: f, rdr: rdr}, nil
+}
+
+// runReader reads the buffers of a run one at a time.
+type runReader struct {
+	run *Run
+	f   filesystem.File
+	rdr *ipc.Reader
+}
+
+// Next returns the next buffer in the run. The caller is
+// responsible for releasing the buffer. It returns nil
+// when there are no more buffers.
+func (rr *runReader) Next() (*arrow.TableBuffer, error) {
+	if !rr.rdr.Next() {
+		if err := rr.rdr.Err(); err != nil {
+			return nil, errors.Wrap(err, codes.Internal, "failed to read spill file")
+		}
+		return nil, nil
+	}
+
+	rec := rr.rdr.Record()
+	cr := &arrow.TableBuffer{
+		GroupKey: rr.run.key,
+		Columns:  rr.run.cols,
+		Values:   make([]array.Interface, len(rr.run.cols)),
+	}
+	for j, c := range rr.run.cols {
+		vs := rec.Column(j)
+		if c.Type == flux.TString {
+			// The reader constructs string arrays, but flux
+			// stores strings in binary arrays.
+			cr.Values[j] = array.NewBinaryData(vs.Data())
+			continue
+		}
+		vs.Retain()
+		cr.Values[j] = vs
+	}
+	return cr, nil
+}
+
+func (rr *runReader) Close() {
+	rr.rdr.Release()
+	_ = rr.f.Close()
+}
+
+// runSchema constructs the arrow schema used to store the columns.
+// The fields are named by their index since column labels do not
+// need to be valid arrow field names.
+func runSchema(cols []flux.ColMeta) *arrowlib.Schema {
+	fields := make([]arrowlib.Field, len(cols))
+	for j, c := range cols {
+		fields[j] = arrowlib.Field{
+			Name:     fmt.Sprintf("c%d", j),
+			Type:     runDataType(c.Type),
+			Nullable: true,
+		}
+	}
+	return arrowlib.NewSchema(fields, nil)
+}
+
+func runDataType(typ flux.ColType) arrowlib.DataType {
+	switch typ {
+	case flux.TInt, flux.TTime:
+		return arrowlib.PrimitiveTypes.Int64
+	case flux.TUInt:
+		return arrowlib.PrimitiveTypes.Uint64
+	case flux.TFloat:
+		return arrowlib.PrimitiveTypes.Float64
+	case flux.TString:
+		return arrowlib.BinaryTypes.String
+	case flux.TBool:
+		return arrowlib.FixedWidthTypes.Boolean
+	default:
+		panic(errors.Newf(codes.Internal, "unimplemented column type: %s", typ))
+	}
+}
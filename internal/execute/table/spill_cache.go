@@ -0,0 +1,132 @@
+package table
+
+import (
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/codes"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/internal/errors"
+)
+
+// BuilderDataCache is a data cache that constructs its tables
+// with table builders, such as the cache created by
+// execute.NewTableBuilderCache.
+type BuilderDataCache interface {
+	execute.DataCache
+	execute.TableBuilderCache
+}
+
+// SpillCache wraps a BuilderDataCache so that its builders can
+// be written to disk as sorted runs. When the table for a group key
+// is requested, the runs for the group key are merged with the rows
+// that are still held by the builder.
+//
+// The builders must be of type *execute.ColListTableBuilder.
+type SpillCache struct {
+	BuilderDataCache
+
+	spiller *Spiller
+	sortBy  []string
+	desc    bool
+	combine bool
+	runs    *execute.GroupLookup
+}
+
+// NewSpillCache constructs a SpillCache. Each builder is sorted by the
+// given columns before it is spilled, and the runs are merged back in
+// that order. If combine is true, rows with equal values in the sort
+// columns are combined as described by MergeOptions.
+func NewSpillCache(cache BuilderDataCache, spiller *Spiller, sortBy []string, desc, combine bool) *SpillCache {
+	return &SpillCache{
+		BuilderDataCache: cache,
+		spiller:          spiller,
+		sortBy:           sortBy,
+		desc:             desc,
+		combine:          combine,
+		runs:             execute.NewGroupLookup(),
+	}
+}
+
+// ShouldSpill reports whether the builders should be spilled.
+func (c *SpillCache) ShouldSpill() bool {
+	return c.spiller.ShouldSpill()
+}
+
+// Spill writes the rows of every builder to disk and releases
+// the memory used by them. The builders keep their columns
+// so they can continue to be used.
+func (c *SpillCache) Spill() error {
+	var err error
+	c.ForEachBuilder(func(key flux.GroupKey, builder execute.TableBuilder) {
+		if err != nil {
+			return
+		}
+		err = c.spill(key, builder)
+	})
+	return err
+}
+
+func (c *SpillCache) spill(key flux.GroupKey, builder execute.TableBuilder) error {
+	if builder.NRows() == 0 {
+		return nil
+	}
+	b, ok := builder.(*execute.ColListTableBuilder)
+	if !ok {
+		return errors.Newf(codes.Internal, "cannot spill table builder of type %T", builder)
+	}
+	if len(c.sortBy) > 0 {
+		b.Sort(c.sortBy, c.desc)
+	}
+	run, err := c.spiller.WriteBuilder(b)
+	if err != nil {
+		return err
+	}
+	b.Release()
+
+	var runs []*Run
+	if v, ok := c.runs.Lookup(key); ok {
+		runs = v.([]*Run)
+	}
+	c.runs.Set(key, append(runs, run))
+	return nil
+}
+
+// Table returns the table for the group key. If any of the rows
+// were spilled, the table is produced by merging the runs.
+func (c *SpillCache) Table(key flux.GroupKey) (flux.Table, error) {
+	v, ok := c.runs.Lookup(key)
+	if !ok {
+		return c.BuilderDataCache.Table(key)
+	}
+
+	builder, _ := c.TableBuilder(key)
+	if err := c.spill(key, builder); err != nil {
+		return nil, err
+	}
+	v, _ = c.runs.Delete(key)
+	return MergeRuns(v.([]*Run), MergeOptions{
+		Key:       key,
+		Columns:   builder.Cols(),
+		SortBy:    c.sortBy,
+		Desc:      c.desc,
+		Combine:   c.combine,
+		Allocator: c.spiller.arrowAllocator(),
+	})
+}
+
+func (c *SpillCache) DiscardTable(key flux.GroupKey) {
+	c.removeRuns(key)
+	c.BuilderDataCache.DiscardTable(key)
+}
+
+func (c *SpillCache) ExpireTable(key flux.GroupKey) {
+	c.removeRuns(key)
+	c.BuilderDataCache.ExpireTable(key)
+}
+
+func (c *SpillCache) removeRuns(key flux.GroupKey) {
+	if v, ok := c.runs.Delete(key); ok {
+		for _, r := range v.([]*Run) {
+			_ = r.Remove()
+		}
+	}
+}
@@ -0,0 +1,358 @@
+package table_test
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/dependencies/filesystem"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/execute/executetest"
+	"github.com/influxdata/flux/internal/execute/table"
+	"github.com/influxdata/flux/memory"
+	"github.com/influxdata/flux/plan"
+)
+
+func newSpiller(t *testing.T) (*table.Spiller, func()) {
+	t.Helper()
+	dir, err := ioutil.TempDir("", "flux-spill")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &table.Spiller{
+		FS:        filesystem.SystemFS,
+		Dir:       dir,
+		Allocator: &memory.Allocator{},
+	}, func() {
+		files, err := ioutil.ReadDir(dir)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(files) > 0 {
+			t.Errorf("spill files were not removed: %d remaining", len(files))
+		}
+		_ = os.RemoveAll(dir)
+	}
+}
+
+func newBuilder(t *testing.T, tbl *executetest.Table) *execute.ColListTableBuilder {
+	t.Helper()
+	tbl.Normalize()
+	b := execute.NewColListTableBuilder(tbl.Key(), &memory.Allocator{})
+	if err := execute.AddTableCols(tbl, b); err != nil {
+		t.Fatal(err)
+	}
+	if err := execute.AppendTable(tbl, b); err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
+
+func TestMergeRuns(t *testing.T) {
+	cols := []flux.ColMeta{
+		{Label: "t0", Type: flux.TString},
+		{Label: "_time", Type: flux.TTime},
+		{Label: "_value", Type: flux.TFloat},
+	}
+	for _, tt := range []struct {
+		name    string
+		runs    [][][]interface{}
+		desc    bool
+		combine bool
+		want    [][]interface{}
+	}{
+		{
+			name: "ascending",
+			runs: [][][]interface{}{
+				{
+					{"a", execute.Time(1), 1.0},
+					{"a", execute.Time(4), 4.0},
+					{"a", execute.Time(5), 5.0},
+				},
+				{
+					{"a", execute.Time(2), 2.0},
+					{"a", execute.Time(3), 3.0},
+					{"a", execute.Time(6), 6.0},
+				},
+			},
+			want: [][]interface{}{
+				{"a", execute.Time(1), 1.0},
+				{"a", execute.Time(2), 2.0},
+				{"a", execute.Time(3), 3.0},
+				{"a", execute.Time(4), 4.0},
+				{"a", execute.Time(5), 5.0},
+				{"a", execute.Time(6), 6.0},
+			},
+		},
+		{
+			name: "descending with nulls",
+			runs: [][][]interface{}{
+				{
+					{"a", nil, 0.0},
+					{"a", execute.Time(5), 5.0},
+					{"a", execute.Time(1), 1.0},
+				},
+				{
+					{"a", execute.Time(6), 6.0},
+					{"a", execute.Time(2), 2.0},
+				},
+			},
+			desc: true,
+			want: [][]interface{}{
+				{"a", nil, 0.0},
+				{"a", execute.Time(6), 6.0},
+				{"a", execute.Time(5), 5.0},
+				{"a", execute.Time(2), 2.0},
+				{"a", execute.Time(1), 1.0},
+			},
+		},
+		{
+			name: "combine",
+			runs: [][][]interface{}{
+				{
+					{"a", execute.Time(1), 1.0},
+					{"a", execute.Time(2), nil},
+				},
+				{
+					{"a", execute.Time(1), nil},
+					{"a", execute.Time(2), 2.0},
+					{"a", execute.Time(3), 3.0},
+				},
+			},
+			combine: true,
+			want: [][]interface{}{
+				{"a", execute.Time(1), 1.0},
+				{"a", execute.Time(2), 2.0},
+				{"a", execute.Time(3), 3.0},
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			spiller, cleanup := newSpiller(t)
+			defer cleanup()
+
+			var (
+				runs []*table.Run
+				key  flux.GroupKey
+			)
+			for _, data := range tt.runs {
+				b := newBuilder(t, &executetest.Table{
+					KeyCols: []string{"t0"},
+					ColMeta: cols,
+					Data:    data,
+				})
+				run, err := spiller.WriteBuilder(b)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if got, want := run.Len(), len(data); got != want {
+					t.Fatalf("unexpected run length -want/+got:\n\t- %d\n\t+ %d", want, got)
+				}
+				key = b.Key()
+				b.Release()
+				runs = append(runs, run)
+			}
+
+			tbl, err := table.MergeRuns(runs, table.MergeOptions{
+				Key:     key,
+				Columns: cols,
+				SortBy:  []string{"_time"},
+				Desc:    tt.desc,
+				Combine: tt.combine,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := executetest.ConvertTable(tbl)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got.Normalize()
+
+			want := &executetest.Table{
+				KeyCols: []string{"t0"},
+				ColMeta: cols,
+				Data:    tt.want,
+			}
+			want.Normalize()
+			if !cmp.Equal(want, got) {
+				t.Errorf("unexpected table -want/+got:\n%s", cmp.Diff(want, got))
+			}
+		})
+	}
+}
+
+func TestBufferedBuilder_Spill(t *testing.T) {
+	spiller, cleanup := newSpiller(t)
+	defer cleanup()
+
+	in := []*executetest.Table{
+		{
+			KeyCols: []string{"t0"},
+			ColMeta: []flux.ColMeta{
+				{Label: "t0", Type: flux.TString},
+				{Label: "_time", Type: flux.TTime},
+				{Label: "_value", Type: flux.TFloat},
+			},
+			Data: [][]interface{}{
+				{"a", execute.Time(1), 1.0},
+				{"a", execute.Time(2), 2.0},
+			},
+		},
+		{
+			KeyCols: []string{"t0"},
+			ColMeta: []flux.ColMeta{
+				{Label: "t0", Type: flux.TString},
+				{Label: "_time", Type: flux.TTime},
+				{Label: "_value", Type: flux.TFloat},
+				{Label: "t1", Type: flux.TString},
+			},
+			Data: [][]interface{}{
+				{"a", execute.Time(3), 3.0, "x"},
+			},
+		},
+	}
+
+	var b *table.BufferedBuilder
+	for i, tbl := range in {
+		tbl.Normalize()
+		if b == nil {
+			b = table.NewBufferedBuilder(tbl.Key(), memory.DefaultAllocator)
+			b.Spiller = spiller
+		}
+		if err := tbl.Do(func(cr flux.ColReader) error {
+			return b.AppendBuffer(cr)
+		}); err != nil {
+			t.Fatal(err)
+		}
+		if i == 0 {
+			if err := b.Spill(); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+
+	tbl, err := b.Table()
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := executetest.ConvertTable(tbl)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got.Normalize()
+
+	want := &executetest.Table{
+		KeyCols: []string{"t0"},
+		ColMeta: []flux.ColMeta{
+			{Label: "t0", Type: flux.TString},
+			{Label: "_time", Type: flux.TTime},
+			{Label: "_value", Type: flux.TFloat},
+			{Label: "t1", Type: flux.TString},
+		},
+		Data: [][]interface{}{
+			{"a", execute.Time(1), 1.0, nil},
+			{"a", execute.Time(2), 2.0, nil},
+			{"a", execute.Time(3), 3.0, "x"},
+		},
+	}
+	want.Normalize()
+	if !cmp.Equal(want, got) {
+		t.Errorf("unexpected table -want/+got:\n%s", cmp.Diff(want, got))
+	}
+}
+
+func TestSpillCache(t *testing.T) {
+	spiller, cleanup := newSpiller(t)
+	defer cleanup()
+
+	// Spill as soon as any memory has been allocated.
+	limit := int64(1 << 30)
+	spiller.Allocator = &memory.Allocator{Limit: &limit}
+	spiller.Threshold = 1e-9
+
+	builders := execute.NewTableBuilderCache(spiller.Allocator)
+	builders.SetTriggerSpec(plan.DefaultTriggerSpec)
+	cache := table.NewSpillCache(builders, spiller, []string{"_time"}, false, false)
+
+	in := []*executetest.Table{
+		{
+			KeyCols: []string{"t0"},
+			ColMeta: []flux.ColMeta{
+				{Label: "t0", Type: flux.TString},
+				{Label: "_time", Type: flux.TTime},
+				{Label: "_value", Type: flux.TFloat},
+			},
+			Data: [][]interface{}{
+				{"a", execute.Time(4), 4.0},
+				{"a", execute.Time(1), 1.0},
+				{"a", execute.Time(3), 3.0},
+			},
+		},
+		{
+			KeyCols: []string{"t0"},
+			ColMeta: []flux.ColMeta{
+				{Label: "t0", Type: flux.TString},
+				{Label: "_time", Type: flux.TTime},
+				{Label: "_value", Type: flux.TFloat},
+			},
+			Data: [][]interface{}{
+				{"a", execute.Time(5), 5.0},
+				{"a", execute.Time(2), 2.0},
+			},
+		},
+	}
+	var key flux.GroupKey
+	for _, tbl := range in {
+		tbl.Normalize()
+		key = tbl.Key()
+		builder, created := cache.TableBuilder(key)
+		if created {
+			if err := execute.AddTableCols(tbl, builder); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if err := execute.AppendTable(tbl, builder); err != nil {
+			t.Fatal(err)
+		}
+		if !cache.ShouldSpill() {
+			t.Fatal("expected cache to spill")
+		}
+		if err := cache.Spill(); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tbl, err := cache.Table(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := executetest.ConvertTable(tbl)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got.Normalize()
+	cache.ExpireTable(key)
+
+	want := &executetest.Table{
+		KeyCols: []string{"t0"},
+		ColMeta: []flux.ColMeta{
+			{Label: "t0", Type: flux.TString},
+			{Label: "_time", Type: flux.TTime},
+			{Label: "_value", Type: flux.TFloat},
+		},
+		Data: [][]interface{}{
+			{"a", execute.Time(1), 1.0},
+			{"a", execute.Time(2), 2.0},
+			{"a", execute.Time(3), 3.0},
+			{"a", execute.Time(4), 4.0},
+			{"a", execute.Time(5), 5.0},
+		},
+	}
+	want.Normalize()
+	if !cmp.Equal(want, got) {
+		t.Errorf("unexpected table -want/+got:\n%s", cmp.Diff(want, got))
+	}
+}
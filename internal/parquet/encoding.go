@@ -0,0 +1,276 @@
+package parquet
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/binary"
+	"io/ioutil"
+	"math"
+
+	"github.com/influxdata/flux/codes"
+	"github.com/influxdata/flux/internal/errors"
+)
+
+var errCorruptPage = errors.New(codes.Invalid, "corrupt parquet page")
+
+// decodeHybrid decodes n values encoded with the RLE/bit-packing
+// hybrid encoding. It returns the values and the number of bytes read.
+func decodeHybrid(data []byte, bitWidth int, n int) ([]uint32, int, error) {
+	if bitWidth < 0 || bitWidth > 32 {
+		return nil, 0, errCorruptPage
+	}
+	values := make([]uint32, 0, n)
+	pos := 0
+	for len(values) < n {
+		header, sz := binary.Uvarint(data[pos:])
+		if sz <= 0 {
+			return nil, 0, errCorruptPage
+		}
+		pos += sz
+		if header&1 == 0 {
+			// A run of the same value repeated.
+			count := int(header >> 1)
+			width := (bitWidth + 7) / 8
+			if len(data)-pos < width {
+				return nil, 0, errCorruptPage
+			}
+			var v uint32
+			for i := 0; i < width; i++ {
+				v |= uint32(data[pos+i]) << (8 * uint(i))
+			}
+			pos += width
+			for i := 0; i < count && len(values) < n; i++ {
+				values = append(values, v)
+			}
+			continue
+		}
+
+		// Groups of 8 bit-packed values.
+		count := int(header>>1) * 8
+		size := int(header>>1) * bitWidth
+		if len(data)-pos < size {
+			// Writers may omit the padding of the last group.
+			size = len(data) - pos
+		}
+		packed := data[pos : pos+size]
+		pos += size
+		for i := 0; i < count && len(values) < n; i++ {
+			bit := i * bitWidth
+			if (bit+bitWidth+7)/8 > len(packed) {
+				return nil, 0, errCorruptPage
+			}
+			var v uint64
+			for b := bit / 8; b < (bit+bitWidth+7)/8; b++ {
+				v |= uint64(packed[b]) << (8 * uint(b-bit/8))
+			}
+			values = append(values, uint32(v>>uint(bit%8)&(1<<uint(bitWidth)-1)))
+		}
+	}
+	return values, pos, nil
+}
+
+// encodeLevels encodes definition levels with a bit width of one
+// as a sequence of runs with the RLE/bit-packing hybrid encoding.
+func encodeLevels(valid []bool) []byte {
+	var buf bytes.Buffer
+	var b [binary.MaxVarintLen64]byte
+	for i := 0; i < len(valid); {
+		j := i + 1
+		for j < len(valid) && valid[j] == valid[i] {
+			j++
+		}
+		n := binary.PutUvarint(b[:], uint64(j-i)<<1)
+		buf.Write(b[:n])
+		if valid[i] {
+			buf.WriteByte(1)
+		} else {
+			buf.WriteByte(0)
+		}
+		i = j
+	}
+	return buf.Bytes()
+}
+
+// decodePlain decodes n values of the given physical type
+// that are encoded with the plain encoding.
+// The values are returned as a slice of bool, int32, int64,
+// float32, float64, or []byte. INT96 values are returned
+// as unix nanoseconds.
+func decodePlain(physical int32, data []byte, n int) (interface{}, error) {
+	size := 0
+	switch physical {
+	case typeInt32, typeFloat:
+		size = 4
+	case typeInt64, typeDouble:
+		size = 8
+	case typeInt96:
+		size = 12
+	}
+	if size > 0 && len(data) < n*size {
+		return nil, errCorruptPage
+	}
+
+	switch physical {
+	case typeBoolean:
+		if len(data) < (n+7)/8 {
+			return nil, errCorruptPage
+		}
+		vs := make([]bool, n)
+		for i := range vs {
+			vs[i] = data[i/8]&(1<<uint(i%8)) != 0
+		}
+		return vs, nil
+	case typeInt32:
+		vs := make([]int32, n)
+		for i := range vs {
+			vs[i] = int32(binary.LittleEndian.Uint32(data[i*4:]))
+		}
+		return vs, nil
+	case typeInt64:
+		vs := make([]int64, n)
+		for i := range vs {
+			vs[i] = int64(binary.LittleEndian.Uint64(data[i*8:]))
+		}
+		return vs, nil
+	case typeInt96:
+		// The first 8 bytes are the nanoseconds within the day
+		// and the last 4 bytes are the julian day.
+		vs := make([]int64, n)
+		for i := range vs {
+			b := data[i*12:]
+			nanos := int64(binary.LittleEndian.Uint64(b))
+			day := int64(binary.LittleEndian.Uint32(b[8:]))
+			vs[i] = (day-julianUnixEpoch)*nanosPerDay + nanos
+		}
+		return vs, nil
+	case typeFloat:
+		vs := make([]float32, n)
+		for i := range vs {
+			vs[i] = math.Float32frombits(binary.LittleEndian.Uint32(data[i*4:]))
+		}
+		return vs, nil
+	case typeDouble:
+		vs := make([]float64, n)
+		for i := range vs {
+			vs[i] = math.Float64frombits(binary.LittleEndian.Uint64(data[i*8:]))
+		}
+		return vs, nil
+	case typeByteArray:
+		vs := make([][]byte, n)
+		pos := 0
+		for i := range vs {
+			if len(data)-pos < 4 {
+				return nil, errCorruptPage
+			}
+			l := int(binary.LittleEndian.Uint32(data[pos:]))
+			pos += 4
+			if l < 0 || len(data)-pos < l {
+				return nil, errCorruptPage
+			}
+			vs[i] = data[pos : pos+l]
+			pos += l
+		}
+		return vs, nil
+	default:
+		return nil, errors.Newf(codes.Unimplemented, "parquet physical type %d is not supported", physical)
+	}
+}
+
+const (
+	nanosPerDay = int64(86400) * 1e9
+	// julianUnixEpoch is the julian day of the unix epoch.
+	julianUnixEpoch = 2440588
+)
+
+// decompress returns the uncompressed data of a page.
+func decompress(codec int32, data []byte, size int) ([]byte, error) {
+	switch codec {
+	case codecUncompressed:
+		return data, nil
+	case codecSnappy:
+		return decodeSnappy(data, size)
+	case codecGzip:
+		r, err := gzip.NewReader(bytes.NewReader(data))
+		if err != nil {
+			return nil, errors.Wrap(err, codes.Invalid, "corrupt gzip page")
+		}
+		out, err := ioutil.ReadAll(r)
+		if err != nil {
+			return nil, errors.Wrap(err, codes.Invalid, "corrupt gzip page")
+		}
+		return out, nil
+	default:
+		return nil, errors.Newf(codes.Unimplemented, "parquet compression codec %d is not supported", codec)
+	}
+}
+
+// decodeSnappy decodes a block in the snappy format.
+func decodeSnappy(src []byte, size int) ([]byte, error) {
+	n, sz := binary.Uvarint(src)
+	if sz <= 0 || int(n) != size {
+		return nil, errCorruptPage
+	}
+	src = src[sz:]
+	dst := make([]byte, 0, n)
+	for len(src) > 0 {
+		tag := src[0]
+		var length, offset int
+		switch tag & 0x03 {
+		case 0x00:
+			// A literal whose length may follow the tag.
+			length = int(tag >> 2)
+			src = src[1:]
+			if length >= 60 {
+				extra := length - 59
+				if len(src) < extra {
+					return nil, errCorruptPage
+				}
+				length = 0
+				for i := 0; i < extra; i++ {
+					length |= int(src[i]) << (8 * uint(i))
+				}
+				src = src[extra:]
+			}
+			length++
+			if length > len(src) {
+				return nil, errCorruptPage
+			}
+			dst = append(dst, src[:length]...)
+			src = src[length:]
+			continue
+		case 0x01:
+			if len(src) < 2 {
+				return nil, errCorruptPage
+			}
+			length = 4 + int(tag>>2)&0x07
+			offset = int(tag&0xe0)<<3 | int(src[1])
+			src = src[2:]
+		case 0x02:
+			if len(src) < 3 {
+				return nil, errCorruptPage
+			}
+			length = 1 + int(tag>>2)
+			offset = int(binary.LittleEndian.Uint16(src[1:]))
+			src = src[3:]
+		case 0x03:
+			if len(src) < 5 {
+				return nil, errCorruptPage
+			}
+			length = 1 + int(tag>>2)
+			offset = int(binary.LittleEndian.Uint32(src[1:]))
+			src = src[5:]
+		}
+		// Copies may overlap so they are copied a byte at a time.
+		if offset <= 0 || offset > len(dst) || len(dst)+length > size {
+			return nil, errCorruptPage
+		}
+		start := len(dst) - offset
+		for i := 0; i < length; i++ {
+			dst = append(dst, dst[start+i])
+		}
+	}
+	if len(dst) != size {
+		return nil, errCorruptPage
+	}
+	return dst, nil
+}
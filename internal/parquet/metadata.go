@@ -0,0 +1,533 @@
+package parquet
+
+// The physical types of parquet.
+const (
+	typeBoolean           = 0
+	typeInt32             = 1
+	typeInt64             = 2
+	typeInt96             = 3
+	typeFloat             = 4
+	typeDouble            = 5
+	typeByteArray         = 6
+	typeFixedLenByteArray = 7
+)
+
+// The repetition types of a schema element.
+const (
+	repetitionRequired = 0
+	repetitionOptional = 1
+	repetitionRepeated = 2
+)
+
+// The converted types that are needed to map a column to a flux type.
+const (
+	convertedUTF8            = 0
+	convertedDecimal         = 5
+	convertedDate            = 6
+	convertedTimeMillis      = 7
+	convertedTimeMicros      = 8
+	convertedTimestampMillis = 9
+	convertedTimestampMicros = 10
+	convertedUint8           = 11
+	convertedUint16          = 12
+	convertedUint32          = 13
+	convertedUint64          = 14
+	convertedInt64           = 18
+	convertedInterval        = 21
+)
+
+// The members of the logical type union.
+const (
+	logicalString    = 1
+	logicalDecimal   = 5
+	logicalDate      = 6
+	logicalTime      = 7
+	logicalTimestamp = 8
+	logicalInteger   = 10
+)
+
+// The units of a logical timestamp.
+const (
+	unitMillis = 1
+	unitMicros = 2
+	unitNanos  = 3
+)
+
+// The page encodings.
+const (
+	encodingPlain           = 0
+	encodingPlainDictionary = 2
+	encodingRLE             = 3
+	encodingBitPacked       = 4
+	encodingRLEDictionary   = 8
+)
+
+// The compression codecs.
+const (
+	codecUncompressed = 0
+	codecSnappy       = 1
+	codecGzip         = 2
+)
+
+// The page types.
+const (
+	pageData       = 0
+	pageDictionary = 2
+	pageDataV2     = 3
+)
+
+// noValue marks an optional enum field that is not set.
+const noValue = -1
+
+type fileMetaData struct {
+	Version   int32
+	Schema    []schemaElement
+	NumRows   int64
+	RowGroups []rowGroup
+	KeyValue  []keyValue
+	CreatedBy string
+}
+
+type schemaElement struct {
+	Type           int32
+	Repetition     int32
+	Name           string
+	NumChildren    int32
+	ConvertedType  int32
+	Logical        logicalType
+	hasLogicalType bool
+}
+
+// logicalType holds the member of the logical type union that is set
+// together with the parameters that are needed to read it.
+type logicalType struct {
+	Kind int16
+	// Unit is the unit of a timestamp.
+	Unit int16
+	// BitWidth and Signed describe an integer.
+	BitWidth int8
+	Signed   bool
+	// AdjustedToUTC is set for timestamps in UTC.
+	AdjustedToUTC bool
+}
+
+type keyValue struct {
+	Key   string
+	Value string
+}
+
+type rowGroup struct {
+	Columns       []columnChunk
+	TotalByteSize int64
+	NumRows       int64
+}
+
+type columnChunk struct {
+	FileOffset int64
+	Meta       columnMetaData
+}
+
+type columnMetaData struct {
+	Type                  int32
+	Encodings             []int32
+	Path                  []string
+	Codec                 int32
+	NumValues             int64
+	TotalUncompressedSize int64
+	TotalCompressedSize   int64
+	DataPageOffset        int64
+	DictionaryPageOffset  int64
+}
+
+type pageHeader struct {
+	Type             int32
+	UncompressedSize int32
+	CompressedSize   int32
+
+	// NumValues and Encoding are read from the header of the page type.
+	NumValues int32
+	Encoding  int32
+
+	// The fields below are only set for version 2 data pages.
+	NumNulls     int32
+	DefLevelsLen int32
+	RepLevelsLen int32
+	Compressed   bool
+}
+
+func newSchemaElement() schemaElement {
+	return schemaElement{
+		Type:          noValue,
+		Repetition:    noValue,
+		ConvertedType: noValue,
+	}
+}
+
+func readFileMetaData(r *thriftReader) (*fileMetaData, error) {
+	md := &fileMetaData{}
+	err := r.readStruct(func(id int16, typ byte) (err error) {
+		switch {
+		case id == 1 && typ == thriftI32:
+			md.Version, err = r.i32()
+		case id == 2 && typ == thriftList:
+			err = r.list(func(byte) error {
+				se, err := readSchemaElement(r)
+				md.Schema = append(md.Schema, se)
+				return err
+			})
+		case id == 3 && typ == thriftI64:
+			md.NumRows, err = r.zigzag()
+		case id == 4 && typ == thriftList:
+			err = r.list(func(byte) error {
+				rg, err := readRowGroup(r)
+				md.RowGroups = append(md.RowGroups, rg)
+				return err
+			})
+		case id == 5 && typ == thriftList:
+			err = r.list(func(byte) error {
+				kv, err := readKeyValue(r)
+				md.KeyValue = append(md.KeyValue, kv)
+				return err
+			})
+		case id == 6 && typ == thriftBinary:
+			md.CreatedBy, err = r.string()
+		default:
+			err = r.skip(typ)
+		}
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return md, nil
+}
+
+func readSchemaElement(r *thriftReader) (schemaElement, error) {
+	se := newSchemaElement()
+	err := r.readStruct(func(id int16, typ byte) (err error) {
+		switch {
+		case id == 1 && typ == thriftI32:
+			se.Type, err = r.i32()
+		case id == 3 && typ == thriftI32:
+			se.Repetition, err = r.i32()
+		case id == 4 && typ == thriftBinary:
+			se.Name, err = r.string()
+		case id == 5 && typ == thriftI32:
+			se.NumChildren, err = r.i32()
+		case id == 6 && typ == thriftI32:
+			se.ConvertedType, err = r.i32()
+		case id == 10 && typ == thriftStruct:
+			se.hasLogicalType = true
+			se.Logical, err = readLogicalType(r)
+		default:
+			err = r.skip(typ)
+		}
+		return err
+	})
+	return se, err
+}
+
+func readLogicalType(r *thriftReader) (logicalType, error) {
+	var lt logicalType
+	err := r.readStruct(func(id int16, typ byte) error {
+		if typ != thriftStruct {
+			return r.skip(typ)
+		}
+		lt.Kind = id
+		switch id {
+		case logicalTimestamp, logicalTime:
+			return r.readStruct(func(id int16, typ byte) error {
+				switch {
+				case id == 1 && (typ == thriftTrue || typ == thriftFalse):
+					lt.AdjustedToUTC = typ == thriftTrue
+					return nil
+				case id == 2 && typ == thriftStruct:
+					return r.readStruct(func(unit int16, typ byte) error {
+						lt.Unit = unit
+						return r.skip(typ)
+					})
+				default:
+					return r.skip(typ)
+				}
+			})
+		case logicalInteger:
+			return r.readStruct(func(id int16, typ byte) error {
+				switch {
+				case id == 1 && typ == thriftByte:
+					b, err := r.byte()
+					lt.BitWidth = int8(b)
+					return err
+				case id == 2 && (typ == thriftTrue || typ == thriftFalse):
+					lt.Signed = typ == thriftTrue
+					return nil
+				default:
+					return r.skip(typ)
+				}
+			})
+		default:
+			return r.skip(typ)
+		}
+	})
+	return lt, err
+}
+
+func readKeyValue(r *thriftReader) (keyValue, error) {
+	var kv keyValue
+	err := r.readStruct(func(id int16, typ byte) (err error) {
+		switch {
+		case id == 1 && typ == thriftBinary:
+			kv.Key, err = r.string()
+		case id == 2 && typ == thriftBinary:
+			kv.Value, err = r.string()
+		default:
+			err = r.skip(typ)
+		}
+		return err
+	})
+	return kv, err
+}
+
+func readRowGroup(r *thriftReader) (rowGroup, error) {
+	var rg rowGroup
+	err := r.readStruct(func(id int16, typ byte) (err error) {
+		switch {
+		case id == 1 && typ == thriftList:
+			err = r.list(func(byte) error {
+				cc, err := readColumnChunk(r)
+				rg.Columns = append(rg.Columns, cc)
+				return err
+			})
+		case id == 2 && typ == thriftI64:
+			rg.TotalByteSize, err = r.zigzag()
+		case id == 3 && typ == thriftI64:
+			rg.NumRows, err = r.zigzag()
+		default:
+			err = r.skip(typ)
+		}
+		return err
+	})
+	return rg, err
+}
+
+func readColumnChunk(r *thriftReader) (columnChunk, error) {
+	var cc columnChunk
+	err := r.readStruct(func(id int16, typ byte) (err error) {
+		switch {
+		case id == 2 && typ == thriftI64:
+			cc.FileOffset, err = r.zigzag()
+		case id == 3 && typ == thriftStruct:
+			cc.Meta, err = readColumnMetaData(r)
+		default:
+			err = r.skip(typ)
+		}
+		return err
+	})
+	return cc, err
+}
+
+func readColumnMetaData(r *thriftReader) (columnMetaData, error) {
+	var cm columnMetaData
+	err := r.readStruct(func(id int16, typ byte) (err error) {
+		switch {
+		case id == 1 && typ == thriftI32:
+			cm.Type, err = r.i32()
+		case id == 2 && typ == thriftList:
+			err = r.list(func(byte) error {
+				e, err := r.i32()
+				cm.Encodings = append(cm.Encodings, e)
+				return err
+			})
+		case id == 3 && typ == thriftList:
+			err = r.list(func(byte) error {
+				s, err := r.string()
+				cm.Path = append(cm.Path, s)
+				return err
+			})
+		case id == 4 && typ == thriftI32:
+			cm.Codec, err = r.i32()
+		case id == 5 && typ == thriftI64:
+			cm.NumValues, err = r.zigzag()
+		case id == 6 && typ == thriftI64:
+			cm.TotalUncompressedSize, err = r.zigzag()
+		case id == 7 && typ == thriftI64:
+			cm.TotalCompressedSize, err = r.zigzag()
+		case id == 9 && typ == thriftI64:
+			cm.DataPageOffset, err = r.zigzag()
+		case id == 11 && typ == thriftI64:
+			cm.DictionaryPageOffset, err = r.zigzag()
+		default:
+			err = r.skip(typ)
+		}
+		return err
+	})
+	return cm, err
+}
+
+func readPageHeader(r *thriftReader) (*pageHeader, error) {
+	ph := &pageHeader{Compressed: true}
+	// readHeader reads the header of a data or dictionary page.
+	// The fields they have in common have the same ids.
+	readHeader := func() error {
+		return r.readStruct(func(id int16, typ byte) (err error) {
+			switch {
+			case id == 1 && typ == thriftI32:
+				ph.NumValues, err = r.i32()
+			case id == 2 && typ == thriftI32:
+				ph.Encoding, err = r.i32()
+			default:
+				err = r.skip(typ)
+			}
+			return err
+		})
+	}
+	err := r.readStruct(func(id int16, typ byte) (err error) {
+		switch {
+		case id == 1 && typ == thriftI32:
+			ph.Type, err = r.i32()
+		case id == 2 && typ == thriftI32:
+			ph.UncompressedSize, err = r.i32()
+		case id == 3 && typ == thriftI32:
+			ph.CompressedSize, err = r.i32()
+		case (id == 5 || id == 7) && typ == thriftStruct:
+			err = readHeader()
+		case id == 8 && typ == thriftStruct:
+			err = r.readStruct(func(id int16, typ byte) (err error) {
+				switch {
+				case id == 1 && typ == thriftI32:
+					ph.NumValues, err = r.i32()
+				case id == 2 && typ == thriftI32:
+					ph.NumNulls, err = r.i32()
+				case id == 4 && typ == thriftI32:
+					ph.Encoding, err = r.i32()
+				case id == 5 && typ == thriftI32:
+					ph.DefLevelsLen, err = r.i32()
+				case id == 6 && typ == thriftI32:
+					ph.RepLevelsLen, err = r.i32()
+				case id == 7 && (typ == thriftTrue || typ == thriftFalse):
+					ph.Compressed = typ == thriftTrue
+				default:
+					err = r.skip(typ)
+				}
+				return err
+			})
+		default:
+			err = r.skip(typ)
+		}
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return ph, nil
+}
+
+func (md *fileMetaData) write(w *thriftWriter) {
+	w.structBegin()
+	w.i32Field(1, md.Version)
+	w.listField(2, thriftStruct, len(md.Schema))
+	for _, se := range md.Schema {
+		se.write(w)
+	}
+	w.i64Field(3, md.NumRows)
+	w.listField(4, thriftStruct, len(md.RowGroups))
+	for _, rg := range md.RowGroups {
+		rg.write(w)
+	}
+	if len(md.KeyValue) > 0 {
+		w.listField(5, thriftStruct, len(md.KeyValue))
+		for _, kv := range md.KeyValue {
+			w.structBegin()
+			w.stringField(1, kv.Key)
+			w.stringField(2, kv.Value)
+			w.structEnd()
+		}
+	}
+	if md.CreatedBy != "" {
+		w.stringField(6, md.CreatedBy)
+	}
+	w.structEnd()
+}
+
+func (se *schemaElement) write(w *thriftWriter) {
+	w.structBegin()
+	if se.Type != noValue {
+		w.i32Field(1, se.Type)
+	}
+	if se.Repetition != noValue {
+		w.i32Field(3, se.Repetition)
+	}
+	w.stringField(4, se.Name)
+	if se.NumChildren > 0 {
+		w.i32Field(5, se.NumChildren)
+	}
+	if se.ConvertedType != noValue {
+		w.i32Field(6, se.ConvertedType)
+	}
+	if se.hasLogicalType {
+		w.structField(10)
+		w.structField(se.Logical.Kind)
+		switch se.Logical.Kind {
+		case logicalTimestamp:
+			w.boolField(1, se.Logical.AdjustedToUTC)
+			w.structField(2)
+			w.structField(se.Logical.Unit)
+			w.structEnd()
+			w.structEnd()
+		case logicalInteger:
+			w.byteField(1, se.Logical.BitWidth)
+			w.boolField(2, se.Logical.Signed)
+		}
+		w.structEnd()
+		w.structEnd()
+	}
+	w.structEnd()
+}
+
+func (rg *rowGroup) write(w *thriftWriter) {
+	w.structBegin()
+	w.listField(1, thriftStruct, len(rg.Columns))
+	for _, cc := range rg.Columns {
+		w.structBegin()
+		w.i64Field(2, cc.FileOffset)
+		w.structField(3)
+		cm := cc.Meta
+		w.i32Field(1, cm.Type)
+		w.listField(2, thriftI32, len(cm.Encodings))
+		for _, e := range cm.Encodings {
+			w.zigzag(int64(e))
+		}
+		w.listField(3, thriftBinary, len(cm.Path))
+		for _, p := range cm.Path {
+			w.binary([]byte(p))
+		}
+		w.i32Field(4, cm.Codec)
+		w.i64Field(5, cm.NumValues)
+		w.i64Field(6, cm.TotalUncompressedSize)
+		w.i64Field(7, cm.TotalCompressedSize)
+		w.i64Field(9, cm.DataPageOffset)
+		if cm.DictionaryPageOffset > 0 {
+			w.i64Field(11, cm.DictionaryPageOffset)
+		}
+		w.structEnd()
+		w.structEnd()
+	}
+	w.i64Field(2, rg.TotalByteSize)
+	w.i64Field(3, rg.NumRows)
+	w.structEnd()
+}
+
+// write encodes the header of a version 1 data page
+// with the definition levels encoded with RLE.
+func (ph *pageHeader) write(w *thriftWriter) {
+	w.structBegin()
+	w.i32Field(1, ph.Type)
+	w.i32Field(2, ph.UncompressedSize)
+	w.i32Field(3, ph.CompressedSize)
+	w.structField(5)
+	w.i32Field(1, ph.NumValues)
+	w.i32Field(2, ph.Encoding)
+	w.i32Field(3, encodingRLE)
+	w.i32Field(4, encodingRLE)
+	w.structEnd()
+	w.structEnd()
+}
@@ -0,0 +1,372 @@
+package parquet
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/google/go-cmp/cmp"
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/arrow"
+	"github.com/influxdata/flux/memory"
+)
+
+// values returns the values of an array with nil for nulls.
+func values(arr array.Interface) []interface{} {
+	vs := make([]interface{}, arr.Len())
+	for i := range vs {
+		if arr.IsNull(i) {
+			continue
+		}
+		switch a := arr.(type) {
+		case *array.Boolean:
+			vs[i] = a.Value(i)
+		case *array.Int64:
+			vs[i] = a.Value(i)
+		case *array.Uint64:
+			vs[i] = a.Value(i)
+		case *array.Float64:
+			vs[i] = a.Value(i)
+		case *array.Binary:
+			vs[i] = a.ValueString(i)
+		}
+	}
+	return vs
+}
+
+func readAll(t *testing.T, data []byte) (*File, [][]interface{}) {
+	t.Helper()
+	f, err := Open(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	mem := &memory.Allocator{}
+	cols := make([][]interface{}, len(f.Columns()))
+	for rg := 0; rg < f.NumRowGroups(); rg++ {
+		for j := range cols {
+			arr, err := f.ReadColumn(rg, j, mem)
+			if err != nil {
+				t.Fatal(err)
+			}
+			cols[j] = append(cols[j], values(arr)...)
+			arr.Release()
+		}
+	}
+	return f, cols
+}
+
+func TestWriter_RoundTrip(t *testing.T) {
+	mem := &memory.Allocator{}
+	cols := []flux.ColMeta{
+		{Label: "_time", Type: flux.TTime},
+		{Label: "host", Type: flux.TString},
+		{Label: "i", Type: flux.TInt},
+		{Label: "u", Type: flux.TUInt},
+		{Label: "f", Type: flux.TFloat},
+		{Label: "b", Type: flux.TBool},
+	}
+	newBuffer := func(n int, offset int64) *arrow.TableBuffer {
+		buf := &arrow.TableBuffer{Columns: cols}
+		for _, c := range cols {
+			b := arrow.NewBuilder(c.Type, mem)
+			for i := 0; i < n; i++ {
+				v := offset + int64(i)
+				if v%3 == 2 {
+					b.AppendNull()
+					continue
+				}
+				switch b := b.(type) {
+				case *array.Int64Builder:
+					b.Append(v)
+				case *array.Uint64Builder:
+					b.Append(uint64(v))
+				case *array.Float64Builder:
+					b.Append(float64(v) / 2)
+				case *array.BinaryBuilder:
+					b.AppendString(string(rune('a' + v)))
+				case *array.BooleanBuilder:
+					b.Append(v%2 == 0)
+				}
+			}
+			buf.Values = append(buf.Values, b.NewArray())
+		}
+		return buf
+	}
+
+	var out bytes.Buffer
+	w, err := NewWriter(&out, cols, map[string]string{"flux.groupKey": `["host"]`})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The first row group has two pages and
+	// enough booleans to fill more than a byte.
+	if err := w.WriteRowGroup([]flux.ColReader{newBuffer(10, 0), newBuffer(0, 0), newBuffer(2, 10)}); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.WriteRowGroup([]flux.ColReader{newBuffer(3, 12)}); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	f, got := readAll(t, out.Bytes())
+	if want, got := int64(15), f.NumRows(); want != got {
+		t.Errorf("unexpected number of rows: want %d got %d", want, got)
+	}
+	if v, ok := f.Metadata("flux.groupKey"); !ok || v != `["host"]` {
+		t.Errorf("unexpected metadata: %q %v", v, ok)
+	}
+	for j, c := range f.Columns() {
+		if c.Name != cols[j].Label || c.Type != cols[j].Type {
+			t.Errorf("unexpected column %d: want %v got %s %s", j, cols[j], c.Name, c.Type)
+		}
+	}
+
+	want := make([][]interface{}, len(cols))
+	for v := int64(0); v < 15; v++ {
+		for j, c := range cols {
+			if v%3 == 2 {
+				want[j] = append(want[j], nil)
+				continue
+			}
+			switch c.Type {
+			case flux.TTime, flux.TInt:
+				want[j] = append(want[j], v)
+			case flux.TUInt:
+				want[j] = append(want[j], uint64(v))
+			case flux.TFloat:
+				want[j] = append(want[j], float64(v)/2)
+			case flux.TString:
+				want[j] = append(want[j], string(rune('a'+v)))
+			case flux.TBool:
+				want[j] = append(want[j], v%2 == 0)
+			}
+		}
+	}
+	if !cmp.Equal(want, got) {
+		t.Errorf("unexpected values -want/+got:\n%s", cmp.Diff(want, got))
+	}
+}
+
+func TestPageHeader_Encoding(t *testing.T) {
+	ph := pageHeader{
+		Type:             pageData,
+		UncompressedSize: 10,
+		CompressedSize:   10,
+		NumValues:        2,
+		Encoding:         encodingPlain,
+	}
+	var w thriftWriter
+	ph.write(&w)
+	want := []byte{
+		0x15, 0x00, // type
+		0x15, 0x14, // uncompressed_page_size
+		0x15, 0x14, // compressed_page_size
+		0x2c,       // data_page_header
+		0x15, 0x04, // num_values
+		0x15, 0x00, // encoding
+		0x15, 0x06, // definition_level_encoding
+		0x15, 0x06, // repetition_level_encoding
+		0x00,
+		0x00,
+	}
+	if got := w.buf.Bytes(); !bytes.Equal(want, got) {
+		t.Errorf("unexpected page header:\n\twant %x\n\tgot  %x", want, got)
+	}
+}
+
+func TestDecodeSnappy(t *testing.T) {
+	src := []byte{
+		0x0d,                // uncompressed length
+		0x08, 'a', 'b', 'c', // literal of 3 bytes
+		0x15, 0x03, // copy 9 bytes from offset 3
+		0x00, 'X', // literal of 1 byte
+	}
+	got, err := decodeSnappy(src, 13)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "abcabcabcabcX"; string(got) != want {
+		t.Errorf("unexpected output: want %q got %q", want, got)
+	}
+	if _, err := decodeSnappy(src, 12); err == nil {
+		t.Error("expected an error for the wrong length")
+	}
+}
+
+// TestOpen_Encodings reads a file that uses the features
+// that the writer does not: dictionaries, version 2 pages,
+// snappy compression, required columns and converted types.
+func TestOpen_Encodings(t *testing.T) {
+	var file bytes.Buffer
+	file.WriteString(magic)
+
+	writePage := func(header func(w *thriftWriter), body []byte) {
+		var w thriftWriter
+		w.structBegin()
+		header(&w)
+		w.structEnd()
+		file.Write(w.buf.Bytes())
+		file.Write(body)
+	}
+	plainStrings := func(vs ...string) []byte {
+		var b []byte
+		for _, v := range vs {
+			var l [4]byte
+			binary.LittleEndian.PutUint32(l[:], uint32(len(v)))
+			b = append(b, l[:]...)
+			b = append(b, v...)
+		}
+		return b
+	}
+
+	// An optional string column with a dictionary page and a
+	// version 1 data page with indices [1, 0, null, 1].
+	dictOffset := int64(file.Len())
+	dict := plainStrings("cpu0", "cpu1")
+	writePage(func(w *thriftWriter) {
+		w.i32Field(1, pageDictionary)
+		w.i32Field(2, int32(len(dict)))
+		w.i32Field(3, int32(len(dict)))
+		w.structField(7)
+		w.i32Field(1, 2)
+		w.i32Field(2, encodingPlainDictionary)
+		w.structEnd()
+	}, dict)
+	dataOffset := int64(file.Len())
+	data := []byte{
+		2, 0, 0, 0, // length of the definition levels
+		0x03, 0x0b, // one group of bit-packed levels: 1, 1, 0, 1
+		1,          // bit width of the indices
+		0x03, 0x05, // one group of bit-packed indices: 1, 0, 1
+	}
+	writePage(func(w *thriftWriter) {
+		w.i32Field(1, pageData)
+		w.i32Field(2, int32(len(data)))
+		w.i32Field(3, int32(len(data)))
+		w.structField(5)
+		w.i32Field(1, 4)
+		w.i32Field(2, encodingRLEDictionary)
+		w.i32Field(3, encodingRLE)
+		w.i32Field(4, encodingRLE)
+		w.structEnd()
+	}, data)
+	hostSize := int64(file.Len()) - dictOffset
+
+	// A required timestamp column in milliseconds stored in a snappy
+	// compressed version 2 page. The first value is repeated by a copy.
+	timeOffset := int64(file.Len())
+	var millis []byte
+	for _, v := range []int64{1000, 1000, 2000, 3000} {
+		var b [8]byte
+		binary.LittleEndian.PutUint64(b[:], uint64(v))
+		millis = append(millis, b[:]...)
+	}
+	compressed := []byte{byte(len(millis)), 0x1c}
+	compressed = append(compressed, millis[:8]...)
+	compressed = append(compressed, 0x11, 0x08) // copy 8 bytes from offset 8
+	compressed = append(compressed, 0x3c)
+	compressed = append(compressed, millis[16:]...)
+	writePage(func(w *thriftWriter) {
+		w.i32Field(1, pageDataV2)
+		w.i32Field(2, int32(len(millis)))
+		w.i32Field(3, int32(len(compressed)))
+		w.structField(8)
+		w.i32Field(1, 4)
+		w.i32Field(2, 0)
+		w.i32Field(3, 4)
+		w.i32Field(4, encodingPlain)
+		w.i32Field(5, 0)
+		w.i32Field(6, 0)
+		w.structEnd()
+	}, compressed)
+	timeSize := int64(file.Len()) - timeOffset
+
+	// An optional date column in a version 2 page
+	// with the values [1, null, null, 2].
+	dateOffset := int64(file.Len())
+	levels := []byte{0x03, 0x09}
+	days := []byte{1, 0, 0, 0, 2, 0, 0, 0}
+	writePage(func(w *thriftWriter) {
+		w.i32Field(1, pageDataV2)
+		w.i32Field(2, int32(len(levels)+len(days)))
+		w.i32Field(3, int32(len(levels)+len(days)))
+		w.structField(8)
+		w.i32Field(1, 4)
+		w.i32Field(2, 2)
+		w.i32Field(3, 4)
+		w.i32Field(4, encodingPlain)
+		w.i32Field(5, int32(len(levels)))
+		w.i32Field(6, 0)
+		w.boolField(7, false)
+		w.structEnd()
+	}, append(levels, days...))
+	dateSize := int64(file.Len()) - dateOffset
+
+	root := newSchemaElement()
+	root.Name = "schema"
+	root.NumChildren = 4
+	host := newSchemaElement()
+	host.Name, host.Type, host.Repetition, host.ConvertedType = "host", typeByteArray, repetitionOptional, convertedUTF8
+	ts := newSchemaElement()
+	ts.Name, ts.Type, ts.Repetition, ts.ConvertedType = "_time", typeInt64, repetitionRequired, convertedTimestampMillis
+	date := newSchemaElement()
+	date.Name, date.Type, date.Repetition, date.ConvertedType = "day", typeInt32, repetitionOptional, convertedDate
+	interval := newSchemaElement()
+	interval.Name, interval.Type, interval.Repetition, interval.ConvertedType = "interval", typeFixedLenByteArray, repetitionOptional, convertedInterval
+
+	md := fileMetaData{
+		Version: 1,
+		Schema:  []schemaElement{root, host, ts, date, interval},
+		NumRows: 4,
+		RowGroups: []rowGroup{{
+			NumRows: 4,
+			Columns: []columnChunk{
+				{Meta: columnMetaData{Type: typeByteArray, Codec: codecUncompressed, NumValues: 4, TotalCompressedSize: hostSize, DataPageOffset: dataOffset, DictionaryPageOffset: dictOffset}},
+				{Meta: columnMetaData{Type: typeInt64, Codec: codecSnappy, NumValues: 4, TotalCompressedSize: timeSize, DataPageOffset: timeOffset}},
+				{Meta: columnMetaData{Type: typeInt32, Codec: codecSnappy, NumValues: 4, TotalCompressedSize: dateSize, DataPageOffset: dateOffset}},
+				{Meta: columnMetaData{Type: typeFixedLenByteArray, Codec: codecUncompressed, DataPageOffset: int64(file.Len())}},
+			},
+		}},
+	}
+	var w thriftWriter
+	md.write(&w)
+	file.Write(w.buf.Bytes())
+	var footer [4]byte
+	binary.LittleEndian.PutUint32(footer[:], uint32(w.buf.Len()))
+	file.Write(footer[:])
+	file.WriteString(magic)
+
+	f, err := Open(bytes.NewReader(file.Bytes()), int64(file.Len()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantCols := []flux.ColType{flux.TString, flux.TTime, flux.TTime, flux.TInvalid}
+	for j, c := range f.Columns() {
+		if c.Type != wantCols[j] {
+			t.Errorf("unexpected type for column %q: want %s got %s", c.Name, wantCols[j], c.Type)
+		}
+	}
+
+	mem := &memory.Allocator{}
+	want := [][]interface{}{
+		{"cpu1", "cpu0", nil, "cpu1"},
+		{int64(1e9), int64(1e9), int64(2e9), int64(3e9)},
+		{nanosPerDay, nil, nil, 2 * nanosPerDay},
+	}
+	for j, w := range want {
+		arr, err := f.ReadColumn(0, j, mem)
+		if err != nil {
+			t.Fatalf("column %d: %v", j, err)
+		}
+		if got := values(arr); !cmp.Equal(w, got) {
+			t.Errorf("unexpected values for column %d -want/+got:\n%s", j, cmp.Diff(w, got))
+		}
+		arr.Release()
+	}
+	if _, err := f.ReadColumn(0, 3, mem); err == nil {
+		t.Error("expected an error for an unsupported column")
+	}
+}
@@ -0,0 +1,551 @@
+// Package parquet reads and writes files in the Apache Parquet format.
+//
+// Only flat schemas are supported. Each leaf column is mapped to a flux
+// column type and read into the arrow array that flux uses for that type.
+// Files are written with a single plain encoded, uncompressed page
+// for each column of a row group.
+package parquet
+
+import (
+	"encoding/binary"
+	"io"
+
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/memory"
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/arrow"
+	"github.com/influxdata/flux/codes"
+	"github.com/influxdata/flux/internal/errors"
+	)
+
+// magic starts and ends every parquet file.
+const magic = "PAR1"
+
+// Column describes a column of a parquet file.
+type Column struct {
+	Name string
+	// Type is the flux type of the column.
+	// It is TInvalid if the column cannot be read.
+	Type flux.ColType
+
+	physical int32
+	optional bool
+	// scale converts the values of time columns to nanoseconds.
+	scale int64
+}
+
+// File is a parquet file that is open for reading.
+type File struct {
+	r       io.ReaderAt
+	md      *fileMetaData
+	columns []Column
+}
+
+// Open reads the metadata of the parquet file with the given size.
+func Open(r io.ReaderAt, size int64) (*File, error) {
+	if size < int64(2*len(magic)+4) {
+		return nil, errors.New(codes.Invalid, "file is too small to be a parquet file")
+	}
+	var footer [8]byte
+	if _, err := r.ReadAt(footer[:], size-8); err != nil {
+		return nil, errors.Wrap(err, codes.Invalid, "failed to read parquet footer")
+	}
+	if string(footer[4:]) != magic {
+		return nil, errors.New(codes.Invalid, "file is not a parquet file")
+	}
+	mdLen := int64(binary.LittleEndian.Uint32(footer[:4]))
+	if mdLen > size-8-int64(len(magic)) {
+		return nil, errCorrupt
+	}
+	buf := make([]byte, mdLen)
+	if _, err := r.ReadAt(buf, size-8-mdLen); err != nil {
+		return nil, errors.Wrap(err, codes.Invalid, "failed to read parquet metadata")
+	}
+	md, err := readFileMetaData(&thriftReader{b: buf})
+	if err != nil {
+		return nil, err
+	}
+
+	f := &File{r: r, md: md}
+	if err := f.readSchema(); err != nil {
+		return nil, err
+	}
+	for _, rg := range md.RowGroups {
+		if len(rg.Columns) != len(f.columns) {
+			return nil, errCorrupt
+		}
+	}
+	return f, nil
+}
+
+func (f *File) readSchema() error {
+	if len(f.md.Schema) == 0 {
+		return errCorrupt
+	}
+	root, leaves := f.md.Schema[0], f.md.Schema[1:]
+	if int(root.NumChildren) != len(leaves) {
+		return errors.New(codes.Unimplemented, "nested parquet schemas are not supported")
+	}
+	f.columns = make([]Column, len(leaves))
+	for i, se := range leaves {
+		if se.NumChildren > 0 || se.Repetition == repetitionRepeated {
+			return errors.Newf(codes.Unimplemented, "column %q: nested and repeated parquet columns are not supported", se.Name)
+		}
+		typ, scale := columnType(se)
+		f.columns[i] = Column{
+			Name:     se.Name,
+			Type:     typ,
+			physical: se.Type,
+			optional: se.Repetition == repetitionOptional,
+			scale:    scale,
+		}
+	}
+	return nil
+}
+
+// columnType maps a schema element to a flux type. The scale
+// is the factor that converts the values of a time to nanoseconds.
+func columnType(se schemaElement) (flux.ColType, int64) {
+	lt := se.Logical
+	if !se.hasLogicalType {
+		lt.Kind = 0
+	}
+	switch se.Type {
+	case typeBoolean:
+		return flux.TBool, 0
+	case typeInt32:
+		switch {
+		case lt.Kind == logicalDate || se.ConvertedType == convertedDate:
+			return flux.TTime, nanosPerDay
+		case lt.Kind == logicalInteger && !lt.Signed,
+			se.ConvertedType == convertedUint8,
+			se.ConvertedType == convertedUint16,
+			se.ConvertedType == convertedUint32:
+			return flux.TUInt, 0
+		case lt.Kind == logicalTime, lt.Kind == logicalDecimal,
+			se.ConvertedType == convertedTimeMillis,
+			se.ConvertedType == convertedDecimal:
+			return flux.TInvalid, 0
+		}
+		return flux.TInt, 0
+	case typeInt64:
+		switch {
+		case lt.Kind == logicalTimestamp:
+			switch lt.Unit {
+			case unitMillis:
+				return flux.TTime, 1e6
+			case unitMicros:
+				return flux.TTime, 1e3
+			case unitNanos:
+				return flux.TTime, 1
+			}
+			return flux.TInvalid, 0
+		case se.ConvertedType == convertedTimestampMillis:
+			return flux.TTime, 1e6
+		case se.ConvertedType == convertedTimestampMicros:
+			return flux.TTime, 1e3
+		case lt.Kind == logicalInteger && !lt.Signed,
+			se.ConvertedType == convertedUint64:
+			return flux.TUInt, 0
+		case lt.Kind == logicalTime, lt.Kind == logicalDecimal,
+			se.ConvertedType == convertedTimeMicros,
+			se.ConvertedType == convertedDecimal:
+			return flux.TInvalid, 0
+		}
+		return flux.TInt, 0
+	case typeInt96:
+		return flux.TTime, 1
+	case typeFloat, typeDouble:
+		return flux.TFloat, 0
+	case typeByteArray:
+		if lt.Kind == logicalDecimal || se.ConvertedType == convertedDecimal {
+			return flux.TInvalid, 0
+		}
+		return flux.TString, 0
+	}
+	return flux.TInvalid, 0
+}
+
+// Columns returns the columns of the file.
+func (f *File) Columns() []Column {
+	return f.columns
+}
+
+// NumRows returns the number of rows in the file.
+func (f *File) NumRows() int64 {
+	return f.md.NumRows
+}
+
+// NumRowGroups returns the number of row groups in the file.
+func (f *File) NumRowGroups() int {
+	return len(f.md.RowGroups)
+}
+
+// Metadata returns the value of a key in the key-value metadata of the file.
+func (f *File) Metadata(key string) (string, bool) {
+	for _, kv := range f.md.KeyValue {
+		if kv.Key == key {
+			return kv.Value, true
+		}
+	}
+	return "", false
+}
+
+// ReadColumn reads a column of a row group into the arrow array
+// for the flux type of the column. Only the pages of that column are read.
+func (f *File) ReadColumn(rowGroup, col int, mem memory.Allocator) (array.Interface, error) {
+	c := f.columns[col]
+	if c.Type == flux.TInvalid {
+		return nil, errors.Newf(codes.Unimplemented, "column %q has a parquet type that is not supported", c.Name)
+	}
+	cm := f.md.RowGroups[rowGroup].Columns[col].Meta
+	if cm.Type != c.physical {
+		return nil, errCorrupt
+	}
+
+	start := cm.DataPageOffset
+	if cm.DictionaryPageOffset > 0 && cm.DictionaryPageOffset < start {
+		start = cm.DictionaryPageOffset
+	}
+	if cm.TotalCompressedSize < 0 || cm.TotalCompressedSize > 1<<31 {
+		return nil, errCorrupt
+	}
+	buf := make([]byte, cm.TotalCompressedSize)
+	if _, err := f.r.ReadAt(buf, start); err != nil {
+		return nil, errors.Wrapf(err, codes.Invalid, "failed to read column %q", c.Name)
+	}
+
+	cr := &columnReader{col: &c, codec: cm.Codec, b: arrow.NewBuilder(c.Type, mem)}
+	defer cr.b.Release()
+	cr.b.Reserve(int(cm.NumValues))
+	r := &thriftReader{b: buf}
+	for read := int64(0); read < cm.NumValues; {
+		ph, err := readPageHeader(r)
+		if err != nil {
+			return nil, err
+		}
+		if ph.CompressedSize < 0 || int(ph.CompressedSize) > len(buf)-r.pos {
+			return nil, errCorruptPage
+		}
+		page := buf[r.pos : r.pos+int(ph.CompressedSize)]
+		r.pos += int(ph.CompressedSize)
+
+		if err := cr.readPage(ph, page); err != nil {
+			return nil, errors.Wrapf(err, codes.Inherit, "failed to read column %q", c.Name)
+		}
+		if ph.Type == pageData || ph.Type == pageDataV2 {
+			read += int64(ph.NumValues)
+		}
+	}
+	return cr.b.NewArray(), nil
+}
+
+// columnReader decodes the pages of a column chunk.
+type columnReader struct {
+	col   *Column
+	codec int32
+	b     array.Builder
+	// dict holds the values of the dictionary page
+	// converted to the representation of the flux type.
+	dict interface{}
+}
+
+func (cr *columnReader) readPage(ph *pageHeader, page []byte) error {
+	switch ph.Type {
+	case pageDictionary:
+		data, err := decompress(cr.codec, page, int(ph.UncompressedSize))
+		if err != nil {
+			return err
+		}
+		vs, err := decodePlain(cr.col.physical, data, int(ph.NumValues))
+		if err != nil {
+			return err
+		}
+		cr.dict = cr.convert(vs)
+		return nil
+	case pageData:
+		data, err := decompress(cr.codec, page, int(ph.UncompressedSize))
+		if err != nil {
+			return err
+		}
+		var valid []bool
+		if cr.col.optional {
+			if len(data) < 4 {
+				return errCorruptPage
+			}
+			l := int(binary.LittleEndian.Uint32(data))
+			if l < 0 || l > len(data)-4 {
+				return errCorruptPage
+			}
+			if valid, err = decodeValid(data[4:4+l], int(ph.NumValues)); err != nil {
+				return err
+			}
+			data = data[4+l:]
+		}
+		return cr.readValues(ph, data, valid)
+	case pageDataV2:
+		levels := int(ph.RepLevelsLen) + int(ph.DefLevelsLen)
+		if ph.RepLevelsLen < 0 || ph.DefLevelsLen < 0 || levels > len(page) {
+			return errCorruptPage
+		}
+		var valid []bool
+		if cr.col.optional {
+			var err error
+			def := page[ph.RepLevelsLen:levels]
+			if valid, err = decodeValid(def, int(ph.NumValues)); err != nil {
+				return err
+			}
+		}
+		data := page[levels:]
+		if ph.Compressed {
+			var err error
+			if data, err = decompress(cr.codec, data, int(ph.UncompressedSize)-levels); err != nil {
+				return err
+			}
+		}
+		return cr.readValues(ph, data, valid)
+	default:
+		// Index pages and pages of unknown types are skipped.
+		return nil
+	}
+}
+
+// decodeValid decodes the definition levels of a flat optional column.
+func decodeValid(data []byte, n int) ([]bool, error) {
+	levels, _, err := decodeHybrid(data, 1, n)
+	if err != nil {
+		return nil, err
+	}
+	valid := make([]bool, n)
+	for i, l := range levels {
+		valid[i] = l == 1
+	}
+	return valid, nil
+}
+
+func (cr *columnReader) readValues(ph *pageHeader, data []byte, valid []bool) error {
+	n := int(ph.NumValues)
+	if valid != nil {
+		n = 0
+		for _, v := range valid {
+			if v {
+				n++
+			}
+		}
+	}
+
+	var vs interface{}
+	switch ph.Encoding {
+	case encodingPlain:
+		raw, err := decodePlain(cr.col.physical, data, n)
+		if err != nil {
+			return err
+		}
+		vs = cr.convert(raw)
+	case encodingPlainDictionary, encodingRLEDictionary:
+		if cr.dict == nil {
+			return errors.New(codes.Invalid, "dictionary encoded page without a dictionary")
+		}
+		if len(data) < 1 {
+			return errCorruptPage
+		}
+		idx, _, err := decodeHybrid(data[1:], int(data[0]), n)
+		if err != nil {
+			return err
+		}
+		if vs, err = gather(cr.dict, idx); err != nil {
+			return err
+		}
+	case encodingRLE:
+		if cr.col.physical != typeBoolean || len(data) < 4 {
+			return errors.New(codes.Unimplemented, "parquet RLE encoding is only supported for booleans")
+		}
+		bits, _, err := decodeHybrid(data[4:], 1, n)
+		if err != nil {
+			return err
+		}
+		bs := make([]bool, n)
+		for i, b := range bits {
+			bs[i] = b == 1
+		}
+		vs = bs
+	default:
+		return errors.Newf(codes.Unimplemented, "parquet encoding %d is not supported", ph.Encoding)
+	}
+	appendValues(cr.b, vs, valid)
+	return nil
+}
+
+// convert converts the decoded values of a physical type
+// to the values of the flux type of the column.
+func (cr *columnReader) convert(vs interface{}) interface{} {
+	switch cr.col.Type {
+	case flux.TInt, flux.TTime:
+		var out []int64
+		switch vs := vs.(type) {
+		case []int32:
+			out = make([]int64, len(vs))
+			for i, v := range vs {
+				out[i] = int64(v)
+			}
+		case []int64:
+			out = vs
+		}
+		if cr.col.scale > 1 {
+			for i := range out {
+				out[i] *= cr.col.scale
+			}
+		}
+		return out
+	case flux.TUInt:
+		switch vs := vs.(type) {
+		case []int32:
+			out := make([]uint64, len(vs))
+			for i, v := range vs {
+				out[i] = uint64(uint32(v))
+			}
+			return out
+		case []int64:
+			out := make([]uint64, len(vs))
+			for i, v := range vs {
+				out[i] = uint64(v)
+			}
+			return out
+		}
+	case flux.TFloat:
+		if vs, ok := vs.([]float32); ok {
+			out := make([]float64, len(vs))
+			for i, v := range vs {
+				out[i] = float64(v)
+			}
+			return out
+		}
+	}
+	return vs
+}
+
+// gather looks up the dictionary values for the indices.
+func gather(dict interface{}, idx []uint32) (interface{}, error) {
+	var n int
+	switch dict := dict.(type) {
+	case []bool:
+		n = len(dict)
+	case []int64:
+		n = len(dict)
+	case []uint64:
+		n = len(dict)
+	case []float64:
+		n = len(dict)
+	case [][]byte:
+		n = len(dict)
+	}
+	for _, i := range idx {
+		if int(i) >= n {
+			return nil, errors.New(codes.Invalid, "dictionary index out of range")
+		}
+	}
+
+	switch dict := dict.(type) {
+	case []bool:
+		out := make([]bool, len(idx))
+		for j, i := range idx {
+			out[j] = dict[i]
+		}
+		return out, nil
+	case []int64:
+		out := make([]int64, len(idx))
+		for j, i := range idx {
+			out[j] = dict[i]
+		}
+		return out, nil
+	case []uint64:
+		out := make([]uint64, len(idx))
+		for j, i := range idx {
+			out[j] = dict[i]
+		}
+		return out, nil
+	case []float64:
+		out := make([]float64, len(idx))
+		for j, i := range idx {
+			out[j] = dict[i]
+		}
+		return out, nil
+	case [][]byte:
+		out := make([][]byte, len(idx))
+		for j, i := range idx {
+			out[j] = dict[i]
+		}
+		return out, nil
+	}
+	return nil, errors.Newf(codes.Internal, "unexpected dictionary type %T", dict)
+}
+
+// appendValues appends the values to the builder. The values are
+// placed at the positions that are valid and the others are null.
+func appendValues(b array.Builder, vs interface{}, valid []bool) {
+	j, n := 0, len(valid)
+	next := func(i int) bool {
+		if valid != nil && !valid[i] {
+			b.AppendNull()
+			return false
+		}
+		return true
+	}
+	switch vs := vs.(type) {
+	case []bool:
+		if valid == nil {
+			n = len(vs)
+		}
+		bb := b.(*array.BooleanBuilder)
+		for i := 0; i < n; i++ {
+			if next(i) {
+				bb.Append(vs[j])
+				j++
+			}
+		}
+	case []int64:
+		if valid == nil {
+			n = len(vs)
+		}
+		ib := b.(*array.Int64Builder)
+		for i := 0; i < n; i++ {
+			if next(i) {
+				ib.Append(vs[j])
+				j++
+			}
+		}
+	case []uint64:
+		if valid == nil {
+			n = len(vs)
+		}
+		ub := b.(*array.Uint64Builder)
+		for i := 0; i < n; i++ {
+			if next(i) {
+				ub.Append(vs[j])
+				j++
+			}
+		}
+	case []float64:
+		if valid == nil {
+			n = len(vs)
+		}
+		fb := b.(*array.Float64Builder)
+		for i := 0; i < n; i++ {
+			if next(i) {
+				fb.Append(vs[j])
+				j++
+			}
+		}
+	case [][]byte:
+		if valid == nil {
+			n = len(vs)
+		}
+		sb := b.(*array.BinaryBuilder)
+		for i := 0; i < n; i++ {
+			if next(i) {
+				sb.Append(vs[j])
+				j++
+			}
+		}
+	}
+}
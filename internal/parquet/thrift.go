@@ -0,0 +1,285 @@
+package parquet
+
+import (
+	"bytes"
+	"encoding/binary"
+
+	"github.com/influxdata/flux/codes"
+	"github.com/influxdata/flux/internal/errors"
+)
+
+// The types of the thrift compact protocol.
+const (
+	thriftStop   = 0
+	thriftTrue   = 1
+	thriftFalse  = 2
+	thriftByte   = 3
+	thriftI16    = 4
+	thriftI32    = 5
+	thriftI64    = 6
+	thriftDouble = 7
+	thriftBinary = 8
+	thriftList   = 9
+	thriftSet    = 10
+	thriftMap    = 11
+	thriftStruct = 12
+)
+
+var errCorrupt = errors.New(codes.Invalid, "corrupt parquet metadata")
+
+// thriftReader decodes the thrift compact protocol
+// that parquet uses for its metadata.
+type thriftReader struct {
+	b   []byte
+	pos int
+}
+
+func (r *thriftReader) byte() (byte, error) {
+	if r.pos >= len(r.b) {
+		return 0, errCorrupt
+	}
+	b := r.b[r.pos]
+	r.pos++
+	return b, nil
+}
+
+func (r *thriftReader) varint() (uint64, error) {
+	v, n := binary.Uvarint(r.b[r.pos:])
+	if n <= 0 {
+		return 0, errCorrupt
+	}
+	r.pos += n
+	return v, nil
+}
+
+func (r *thriftReader) zigzag() (int64, error) {
+	v, err := r.varint()
+	if err != nil {
+		return 0, err
+	}
+	return int64(v>>1) ^ -int64(v&1), nil
+}
+
+func (r *thriftReader) i32() (int32, error) {
+	v, err := r.zigzag()
+	return int32(v), err
+}
+
+func (r *thriftReader) binary() ([]byte, error) {
+	n, err := r.varint()
+	if err != nil {
+		return nil, err
+	}
+	if n > uint64(len(r.b)-r.pos) {
+		return nil, errCorrupt
+	}
+	b := r.b[r.pos : r.pos+int(n)]
+	r.pos += int(n)
+	return b, nil
+}
+
+func (r *thriftReader) string() (string, error) {
+	b, err := r.binary()
+	return string(b), err
+}
+
+// listHeader reads the header of a list or set
+// and returns the number and type of its elements.
+func (r *thriftReader) listHeader() (int, byte, error) {
+	h, err := r.byte()
+	if err != nil {
+		return 0, 0, err
+	}
+	n := uint64(h >> 4)
+	if n == 15 {
+		if n, err = r.varint(); err != nil {
+			return 0, 0, err
+		}
+	}
+	if n > uint64(len(r.b)-r.pos) {
+		// Every element takes at least one byte.
+		return 0, 0, errCorrupt
+	}
+	return int(n), h & 0x0f, nil
+}
+
+// list reads a list and calls fn to read each element.
+func (r *thriftReader) list(fn func(typ byte) error) error {
+	n, typ, err := r.listHeader()
+	if err != nil {
+		return err
+	}
+	for i := 0; i < n; i++ {
+		if err := fn(typ); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// readStruct reads the fields of a struct and calls fn for each of them.
+// The function must read the value of the field or skip it.
+// Boolean fields store their value in the type and have nothing to read.
+func (r *thriftReader) readStruct(fn func(id int16, typ byte) error) error {
+	var last int16
+	for {
+		h, err := r.byte()
+		if err != nil {
+			return err
+		}
+		if h == thriftStop {
+			return nil
+		}
+		typ := h & 0x0f
+		id := last + int16(h>>4)
+		if h>>4 == 0 {
+			v, err := r.zigzag()
+			if err != nil {
+				return err
+			}
+			id = int16(v)
+		}
+		last = id
+		if err := fn(id, typ); err != nil {
+			return err
+		}
+	}
+}
+
+// skip reads and discards a value of the given type.
+func (r *thriftReader) skip(typ byte) error {
+	var err error
+	switch typ {
+	case thriftTrue, thriftFalse:
+	case thriftByte:
+		_, err = r.byte()
+	case thriftI16, thriftI32, thriftI64:
+		_, err = r.varint()
+	case thriftDouble:
+		if len(r.b)-r.pos < 8 {
+			return errCorrupt
+		}
+		r.pos += 8
+	case thriftBinary:
+		_, err = r.binary()
+	case thriftList, thriftSet:
+		err = r.list(func(typ byte) error {
+			if typ == thriftTrue || typ == thriftFalse {
+				// Booleans in a list are stored as a byte.
+				_, err := r.byte()
+				return err
+			}
+			return r.skip(typ)
+		})
+	case thriftMap:
+		var n uint64
+		if n, err = r.varint(); err != nil || n == 0 {
+			return err
+		}
+		var types byte
+		if types, err = r.byte(); err != nil {
+			return err
+		}
+		for i := uint64(0); i < n; i++ {
+			if err := r.skip(types >> 4); err != nil {
+				return err
+			}
+			if err := r.skip(types & 0x0f); err != nil {
+				return err
+			}
+		}
+	case thriftStruct:
+		err = r.readStruct(func(_ int16, typ byte) error {
+			return r.skip(typ)
+		})
+	default:
+		return errCorrupt
+	}
+	return err
+}
+
+// thriftWriter encodes values with the thrift compact protocol.
+type thriftWriter struct {
+	buf bytes.Buffer
+	// last is the id of the last field written
+	// for each struct that is being written.
+	last []int16
+}
+
+func (w *thriftWriter) varint(v uint64) {
+	var b [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(b[:], v)
+	w.buf.Write(b[:n])
+}
+
+func (w *thriftWriter) zigzag(v int64) {
+	w.varint(uint64((v << 1) ^ (v >> 63)))
+}
+
+func (w *thriftWriter) field(id int16, typ byte) {
+	top := len(w.last) - 1
+	if d := id - w.last[top]; d > 0 && d <= 15 {
+		w.buf.WriteByte(byte(d<<4) | typ)
+	} else {
+		w.buf.WriteByte(typ)
+		w.zigzag(int64(id))
+	}
+	w.last[top] = id
+}
+
+func (w *thriftWriter) structBegin() {
+	w.last = append(w.last, 0)
+}
+
+func (w *thriftWriter) structEnd() {
+	w.buf.WriteByte(thriftStop)
+	w.last = w.last[:len(w.last)-1]
+}
+
+func (w *thriftWriter) structField(id int16) {
+	w.field(id, thriftStruct)
+	w.structBegin()
+}
+
+func (w *thriftWriter) boolField(id int16, v bool) {
+	if v {
+		w.field(id, thriftTrue)
+	} else {
+		w.field(id, thriftFalse)
+	}
+}
+
+func (w *thriftWriter) byteField(id int16, v int8) {
+	w.field(id, thriftByte)
+	w.buf.WriteByte(byte(v))
+}
+
+func (w *thriftWriter) i32Field(id int16, v int32) {
+	w.field(id, thriftI32)
+	w.zigzag(int64(v))
+}
+
+func (w *thriftWriter) i64Field(id int16, v int64) {
+	w.field(id, thriftI64)
+	w.zigzag(v)
+}
+
+func (w *thriftWriter) binary(b []byte) {
+	w.varint(uint64(len(b)))
+	w.buf.Write(b)
+}
+
+func (w *thriftWriter) stringField(id int16, v string) {
+	w.field(id, thriftBinary)
+	w.binary([]byte(v))
+}
+
+func (w *thriftWriter) listField(id int16, typ byte, n int) {
+	w.field(id, thriftList)
+	if n < 15 {
+		w.buf.WriteByte(byte(n<<4) | typ)
+	} else {
+		w.buf.WriteByte(0xf0 | typ)
+		w.varint(uint64(n))
+	}
+}
@@ -0,0 +1,257 @@
+package parquet
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"math"
+	"sort"
+
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/codes"
+	"github.com/influxdata/flux/internal/errors"
+)
+
+// Writer writes flux data to a parquet file.
+// Every column of the file is optional so that it may contain nulls.
+type Writer struct {
+	w      io.Writer
+	offset int64
+	cols   []flux.ColMeta
+	md     fileMetaData
+}
+
+// NewWriter writes the header of a parquet file with the given columns.
+// The metadata is stored as the key-value metadata of the file.
+func NewWriter(w io.Writer, cols []flux.ColMeta, metadata map[string]string) (*Writer, error) {
+	pw := &Writer{
+		w:    w,
+		cols: cols,
+		md: fileMetaData{
+			Version:   1,
+			CreatedBy: "flux",
+		},
+	}
+
+	root := newSchemaElement()
+	root.Name = "schema"
+	root.NumChildren = int32(len(cols))
+	pw.md.Schema = append(pw.md.Schema, root)
+	for _, c := range cols {
+		se, err := schemaElementFor(c)
+		if err != nil {
+			return nil, err
+		}
+		pw.md.Schema = append(pw.md.Schema, se)
+	}
+
+	keys := make([]string, 0, len(metadata))
+	for k := range metadata {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		pw.md.KeyValue = append(pw.md.KeyValue, keyValue{Key: k, Value: metadata[k]})
+	}
+
+	if err := pw.write([]byte(magic)); err != nil {
+		return nil, err
+	}
+	return pw, nil
+}
+
+func schemaElementFor(c flux.ColMeta) (schemaElement, error) {
+	se := newSchemaElement()
+	se.Name = c.Label
+	se.Repetition = repetitionOptional
+	switch c.Type {
+	case flux.TBool:
+		se.Type = typeBoolean
+	case flux.TInt:
+		se.Type = typeInt64
+		se.ConvertedType = convertedInt64
+		se.hasLogicalType = true
+		se.Logical = logicalType{Kind: logicalInteger, BitWidth: 64, Signed: true}
+	case flux.TUInt:
+		se.Type = typeInt64
+		se.ConvertedType = convertedUint64
+		se.hasLogicalType = true
+		se.Logical = logicalType{Kind: logicalInteger, BitWidth: 64}
+	case flux.TFloat:
+		se.Type = typeDouble
+	case flux.TString:
+		se.Type = typeByteArray
+		se.ConvertedType = convertedUTF8
+		se.hasLogicalType = true
+		se.Logical = logicalType{Kind: logicalString}
+	case flux.TTime:
+		se.Type = typeInt64
+		se.hasLogicalType = true
+		se.Logical = logicalType{Kind: logicalTimestamp, Unit: unitNanos, AdjustedToUTC: true}
+	default:
+		return se, errors.Newf(codes.Invalid, "column %q of type %s cannot be written to parquet", c.Label, c.Type)
+	}
+	return se, nil
+}
+
+func (pw *Writer) write(b []byte) error {
+	n, err := pw.w.Write(b)
+	pw.offset += int64(n)
+	if err != nil {
+		return errors.Wrap(err, codes.Internal, "failed to write parquet file")
+	}
+	return nil
+}
+
+// WriteRowGroup writes the buffers as a row group of the file.
+// The columns of each buffer must match the columns of the writer.
+// Each buffer is written as a page of every column chunk.
+func (pw *Writer) WriteRowGroup(buffers []flux.ColReader) error {
+	for _, cr := range buffers {
+		cols := cr.Cols()
+		if len(cols) != len(pw.cols) {
+			return errors.New(codes.Internal, "buffer columns do not match parquet schema")
+		}
+		for j, c := range cols {
+			if c != pw.cols[j] {
+				return errors.New(codes.Internal, "buffer columns do not match parquet schema")
+			}
+		}
+	}
+
+	rg := rowGroup{}
+	for _, cr := range buffers {
+		rg.NumRows += int64(cr.Len())
+	}
+	for j, c := range pw.cols {
+		start := pw.offset
+		for _, cr := range buffers {
+			if cr.Len() == 0 {
+				continue
+			}
+			if err := pw.writePage(cr, j); err != nil {
+				return err
+			}
+		}
+		size := pw.offset - start
+		rg.TotalByteSize += size
+		rg.Columns = append(rg.Columns, columnChunk{
+			FileOffset: start,
+			Meta: columnMetaData{
+				Type:                  pw.md.Schema[j+1].Type,
+				Encodings:             []int32{encodingPlain, encodingRLE},
+				Path:                  []string{c.Label},
+				Codec:                 codecUncompressed,
+				NumValues:             rg.NumRows,
+				TotalUncompressedSize: size,
+				TotalCompressedSize:   size,
+				DataPageOffset:        start,
+			},
+		})
+	}
+	pw.md.RowGroups = append(pw.md.RowGroups, rg)
+	pw.md.NumRows += rg.NumRows
+	return nil
+}
+
+// writePage writes a data page with the values of column j.
+func (pw *Writer) writePage(cr flux.ColReader, j int) error {
+	n := cr.Len()
+	valid := make([]bool, n)
+	var values bytes.Buffer
+	var b [8]byte
+	switch pw.cols[j].Type {
+	case flux.TBool:
+		vs := cr.Bools(j)
+		packed := make([]byte, 0, n/8+1)
+		var cur byte
+		bits := 0
+		for i := 0; i < n; i++ {
+			if valid[i] = vs.IsValid(i); !valid[i] {
+				continue
+			}
+			if vs.Value(i) {
+				cur |= 1 << uint(bits)
+			}
+			if bits++; bits == 8 {
+				packed = append(packed, cur)
+				cur, bits = 0, 0
+			}
+		}
+		if bits > 0 {
+			packed = append(packed, cur)
+		}
+		values.Write(packed)
+	case flux.TInt, flux.TTime:
+		vs := cr.Ints(j)
+		if pw.cols[j].Type == flux.TTime {
+			vs = cr.Times(j)
+		}
+		for i := 0; i < n; i++ {
+			if valid[i] = vs.IsValid(i); valid[i] {
+				binary.LittleEndian.PutUint64(b[:], uint64(vs.Value(i)))
+				values.Write(b[:])
+			}
+		}
+	case flux.TUInt:
+		vs := cr.UInts(j)
+		for i := 0; i < n; i++ {
+			if valid[i] = vs.IsValid(i); valid[i] {
+				binary.LittleEndian.PutUint64(b[:], vs.Value(i))
+				values.Write(b[:])
+			}
+		}
+	case flux.TFloat:
+		vs := cr.Floats(j)
+		for i := 0; i < n; i++ {
+			if valid[i] = vs.IsValid(i); valid[i] {
+				binary.LittleEndian.PutUint64(b[:], math.Float64bits(vs.Value(i)))
+				values.Write(b[:])
+			}
+		}
+	case flux.TString:
+		vs := cr.Strings(j)
+		for i := 0; i < n; i++ {
+			if valid[i] = vs.IsValid(i); valid[i] {
+				v := vs.Value(i)
+				binary.LittleEndian.PutUint32(b[:4], uint32(len(v)))
+				values.Write(b[:4])
+				values.Write(v)
+			}
+		}
+	}
+
+	levels := encodeLevels(valid)
+	body := make([]byte, 4, 4+len(levels)+values.Len())
+	binary.LittleEndian.PutUint32(body, uint32(len(levels)))
+	body = append(body, levels...)
+	body = append(body, values.Bytes()...)
+
+	ph := pageHeader{
+		Type:             pageData,
+		UncompressedSize: int32(len(body)),
+		CompressedSize:   int32(len(body)),
+		NumValues:        int32(n),
+		Encoding:         encodingPlain,
+	}
+	var tw thriftWriter
+	ph.write(&tw)
+	if err := pw.write(tw.buf.Bytes()); err != nil {
+		return err
+	}
+	return pw.write(body)
+}
+
+// Close writes the metadata at the end of the file.
+// It does not close the underlying writer.
+func (pw *Writer) Close() error {
+	var tw thriftWriter
+	pw.md.write(&tw)
+	if err := pw.write(tw.buf.Bytes()); err != nil {
+		return err
+	}
+	var footer [8]byte
+	binary.LittleEndian.PutUint32(footer[:4], uint32(tw.buf.Len()))
+	copy(footer[4:], magic)
+	return pw.write(footer[:])
+}
@@ -0,0 +1,88 @@
+package parser
+
+import (
+	"sort"
+
+	"github.com/influxdata/flux/ast"
+	"github.com/influxdata/flux/internal/token"
+)
+
+// attachComments adds each comment that follows a statement, an import,
+// the package clause or an object property on the line where it ends
+// to that node as its trailing comment. Any other comment is added to
+// the outermost node that starts after it. Comments that are not
+// followed by a node are added to the end of the file.
+func attachComments(file *ast.File, f *token.File, comments []comment) {
+	if len(comments) == 0 {
+		return
+	}
+	sort.Slice(comments, func(i, j int) bool {
+		return comments[i].pos < comments[j].pos
+	})
+
+	// The nodes are sorted by their start position. Nodes that start
+	// at the same position keep the order of the walk so the outermost
+	// node comes first. The nodes that end on each line are kept
+	// in the order of the walk for the same reason.
+	var nodes []ast.Node
+	ends := make(map[int][]ast.Node)
+	trailers := make(map[ast.Node]bool)
+	ast.Walk(ast.CreateVisitor(func(n ast.Node) {
+		switch n := n.(type) {
+		case *ast.File:
+			return
+		case ast.Statement, *ast.ImportDeclaration, *ast.PackageClause:
+			trailers[n] = true
+		case *ast.ObjectExpression:
+			for _, p := range n.Properties {
+				trailers[p] = true
+			}
+		}
+		if loc := n.Location(); loc.Start.IsValid() {
+			nodes = append(nodes, n)
+			ends[loc.End.Line] = append(ends[loc.End.Line], n)
+		}
+	}), file)
+	sort.SliceStable(nodes, func(i, j int) bool {
+		return nodes[i].Location().Start.Less(nodes[j].Location().Start)
+	})
+
+	for _, c := range comments {
+		pos := f.Position(c.pos)
+		comment := ast.Comment{Text: c.text}
+		if n := trailingNode(ends[pos.Line], trailers); n != nil {
+			n.SetTrailingComment(comment)
+			continue
+		}
+		i := sort.Search(len(nodes), func(i int) bool {
+			return !nodes[i].Location().Start.Less(pos)
+		})
+		if i == len(nodes) {
+			file.Eof = append(file.Eof, comment)
+			continue
+		}
+		nodes[i].AddComment(comment)
+	}
+}
+
+// trailingNode returns the outermost node that can hold a trailing comment
+// among the nodes that end last on the line of a comment. A comment after
+// any other node, such as the closing parenthesis of a call, has no
+// trailing node because the formatter could not write it on the same line.
+func trailingNode(nodes []ast.Node, trailers map[ast.Node]bool) ast.Node {
+	if len(nodes) == 0 {
+		return nil
+	}
+	end := nodes[0].Location().End
+	for _, n := range nodes[1:] {
+		if e := n.Location().End; end.Less(e) {
+			end = e
+		}
+	}
+	for _, n := range nodes {
+		if n.Location().End == end && trailers[n] {
+			return n
+		}
+	}
+	return nil
+}
@@ -34,49 +34,78 @@ type Scanner interface {
 }
 
 // ParseFile parses Flux source and produces an ast.File.
+// Comments are attached to the node that follows them, or to the node
+// that precedes them on the same line, and the comments at the end
+// of the file are stored in ast.File.Eof.
 func ParseFile(f *token.File, src []byte) *ast.File {
+	s := &scannerComments{
+		Scanner: scanner.New(f, src),
+		seen:    make(map[token.Pos]bool),
+	}
 	p := &parser{
-		s: &scannerSkipComments{
-			Scanner: scanner.New(f, src),
-		},
+		s:      s,
 		src:    src,
 		blocks: make(map[token.Token]int),
 	}
-	return p.parseFile(f.Name())
+	file := p.parseFile(f.Name())
+	attachComments(file, f, s.comments)
+	return file
 }
 
-// scannerSkipComments is a temporary Scanner used for stripping comments
-// from the input stream. We want to attach comments to nodes within the
-// AST, but first we want to have feature parity with the old parser so
-// the easiest method is just to strip comments at the moment.
-type scannerSkipComments struct {
+// scannerComments is a Scanner that removes comments from the input stream
+// and records them so they can be attached to the AST after it is parsed.
+type scannerComments struct {
 	Scanner
+	comments []comment
+	// seen holds the positions of the recorded comments because
+	// a comment is scanned again when the parser unreads a token.
+	seen map[token.Pos]bool
+}
+
+// comment is a comment and the position at which it starts.
+type comment struct {
+	pos  token.Pos
+	text string
+}
+
+func (s *scannerComments) record(pos token.Pos, lit string) {
+	if s.seen[pos] {
+		return
+	}
+	s.seen[pos] = true
+	s.comments = append(s.comments, comment{
+		pos:  pos,
+		text: strings.TrimRight(lit, "\r\n"),
+	})
 }
 
-func (s *scannerSkipComments) Scan() (pos token.Pos, tok token.Token, lit string) {
+func (s *scannerComments) Scan() (pos token.Pos, tok token.Token, lit string) {
 	for {
 		pos, tok, lit = s.Scanner.Scan()
 		if tok != token.COMMENT {
 			return pos, tok, lit
 		}
+		s.record(pos, lit)
 	}
 }
 
-func (s *scannerSkipComments) ScanWithRegex() (pos token.Pos, tok token.Token, lit string) {
+func (s *scannerComments) ScanWithRegex() (pos token.Pos, tok token.Token, lit string) {
 	for {
 		pos, tok, lit = s.Scanner.ScanWithRegex()
 		if tok != token.COMMENT {
 			return pos, tok, lit
 		}
+		s.record(pos, lit)
 	}
 }
 
-func (s *scannerSkipComments) ScanStringExpr() (pos token.Pos, tok token.Token, lit string) {
+func (s *scannerComments) ScanStringExpr() (pos token.Pos, tok token.Token, lit string) {
 	for {
 		pos, tok, lit = s.Scanner.ScanStringExpr()
 		if tok != token.COMMENT {
 			return pos, tok, lit
 		}
+		s.record(pos, lit)
 	}
 }
 
@@ -413,17 +442,12 @@ func (p *parser) parseExpressionSuffix(expr ast.Expression) ast.Expression {
 func (p *parser) parseExpressionList() []ast.Expression {
 	var exprs []ast.Expression
 	for p.more() {
-		switch _, tok, _ := p.peek(); tok {
-		case token.IDENT, token.INT, token.FLOAT, token.STRING, token.DIV,
-			token.TIME, token.DURATION, token.PIPE_RECEIVE,
-			token.LPAREN, token.LBRACK, token.LBRACE,
-			token.ADD, token.SUB, token.NOT, token.EXISTS:
-			exprs = append(exprs, p.parseExpression())
-		default:
+		if _, tok, _ := p.peek(); !isExpressionStart(tok) {
 			// TODO(jsternberg): BadExpression.
 			p.consume()
 			continue
 		}
+		exprs = append(exprs, p.parseExpression())
 
 		if _, tok, _ := p.peek(); tok == token.COMMA {
 			p.consume()
@@ -432,6 +456,20 @@ func (p *parser) parseExpressionList() []ast.Expression {
 	return exprs
 }
 
+// isExpressionStart reports whether the token may begin an expression
+// within an expression list.
+func isExpressionStart(tok token.Token) bool {
+	switch tok {
+	case token.IDENT, token.INT, token.FLOAT, token.STRING, token.DIV,
+		token.TIME, token.DURATION, token.PIPE_RECEIVE,
+		token.LPAREN, token.LBRACK, token.LBRACE,
+		token.ADD, token.SUB, token.NOT, token.EXISTS:
+		return true
+	default:
+		return false
+	}
+}
+
 func (p *parser) parseConditionalExpression() ast.Expression {
 	if ifPos, tok, _ := p.peek(); tok == token.IF {
 		p.consume()
@@ -899,7 +937,7 @@ func (p *parser) parsePrimaryExpression() ast.Expression {
 	case token.PIPE_RECEIVE:
 		return p.parsePipeLiteral()
 	case token.LBRACK:
-		return p.parseArrayLiteral()
+		return p.parseArrayOrDictLiteral()
 	case token.LBRACE:
 		return p.parseObjectLiteral()
 	case token.LPAREN:
@@ -1035,9 +1073,34 @@ func (p *parser) parsePipeLiteral() *ast.PipeLiteral {
 	}
 }
 
-func (p *parser) parseArrayLiteral() ast.Expression {
+func (p *parser) parseArrayOrDictLiteral() ast.Expression {
 	start, _ := p.open(token.LBRACK, token.RBRACK)
-	exprs := p.parseExpressionList()
+	if _, tok, _ := p.peek(); tok == token.COLON {
+		// The empty dictionary is written as [:].
+		p.consume()
+		end, rbrack := p.close(token.RBRACK)
+		return &ast.DictExpression{
+			BaseNode: p.position(start, end+token.Pos(len(rbrack))),
+		}
+	}
+
+	var exprs []ast.Expression
+	if _, tok, _ := p.peek(); p.more() && isExpressionStart(tok) {
+		first := p.parseExpression()
+		if _, tok, _ := p.peek(); tok == token.COLON {
+			items := p.parseDictItemList(first)
+			end, rbrack := p.close(token.RBRACK)
+			return &ast.DictExpression{
+				Elements: items,
+				BaseNode: p.position(start, end+token.Pos(len(rbrack))),
+			}
+		}
+		exprs = append(exprs, first)
+		if _, tok, _ := p.peek(); tok == token.COMMA {
+			p.consume()
+		}
+	}
+	exprs = append(exprs, p.parseExpressionList()...)
 	end, rbrack := p.close(token.RBRACK)
 	return &ast.ArrayExpression{
 		Elements: exprs,
@@ -1045,6 +1108,65 @@ func (p *parser) parseArrayLiteral() ast.Expression {
 	}
 }
 
+func (p *parser) parseDictItemList(key ast.Expression) []*ast.DictItem {
+	var items []*ast.DictItem
+	for {
+		items = append(items, p.parseDictItemSuffix(key))
+		if !p.more() {
+			return items
+		}
+
+		if _, tok, lit := p.peek(); tok == token.COMMA {
+			p.consume()
+		} else {
+			p.errs = append(p.errs, ast.Error{
+				Msg: fmt.Sprintf("expected comma in dictionary, got %s (%q)", tok, lit),
+			})
+		}
+
+		key = nil
+		for key == nil && p.more() {
+			if _, tok, _ := p.peek(); !isExpressionStart(tok) {
+				// TODO(jsternberg): BadExpression.
+				p.consume()
+				continue
+			}
+			key = p.parseExpression()
+		}
+		if key == nil {
+			return items
+		}
+	}
+}
+
+func (p *parser) parseDictItemSuffix(key ast.Expression) *ast.DictItem {
+	item := &ast.DictItem{Key: key}
+	if _, tok, lit := p.peek(); tok == token.COLON {
+		p.consume()
+		item.Val = p.parseExpressionWhile(func() bool {
+			if _, tok, _ := p.peek(); tok == token.COMMA || tok == token.COLON {
+				return false
+			}
+			return p.more()
+		})
+		if item.Val == nil {
+			p.errs = append(p.errs, ast.Error{
+				Msg: "missing dictionary value",
+			})
+		}
+	} else {
+		p.errs = append(p.errs, ast.Error{
+			Msg: fmt.Sprintf("expected colon in dictionary item, got %s (%q)", tok, lit),
+		})
+	}
+	if item.Val == nil {
+		item.BaseNode = p.baseNode(p.sourceLocation(locStart(key), locEnd(key)))
+		return item
+	}
+	item.BaseNode = p.baseNode(p.sourceLocation(locStart(key), locEnd(item.Val)))
+	return item
+}
+
 func (p *parser) parseObjectLiteral() ast.Expression {
 	start, _ := p.open(token.LBRACE, token.RBRACE)
 	obj := p.parseObjectBody()
@@ -498,7 +498,7 @@ import "path/bar"
 								BaseNode: base("1:15", "4:6"),
 								Properties: []*ast.Property{
 									{
-										BaseNode: base("2:6", "2:17"),
+										BaseNode: withTrailingComment(base("2:6", "2:17"), "// Name of task"),
 										Key: &ast.Identifier{
 											BaseNode: base("2:6", "2:10"),
 											Name:     "name",
@@ -509,7 +509,7 @@ import "path/bar"
 										},
 									},
 									{
-										BaseNode: base("3:6", "3:15"),
+										BaseNode: withTrailingComment(base("3:6", "3:15"), "// Execution frequency of task"),
 										Key: &ast.Identifier{
 											BaseNode: base("3:6", "3:11"),
 											Name:     "every",
@@ -529,7 +529,7 @@ import "path/bar"
 						},
 					},
 					&ast.ExpressionStatement{
-						BaseNode: base("7:5", "7:22"),
+						BaseNode: withComments(base("7:5", "7:22"), "// Task will execute the following query"),
 						Expression: &ast.PipeExpression{
 							BaseNode: base("7:5", "7:22"),
 							Argument: &ast.CallExpression{
@@ -677,7 +677,7 @@ import "path/bar"
 				BaseNode: base("2:4", "2:10"),
 				Body: []ast.Statement{
 					&ast.ExpressionStatement{
-						BaseNode: base("2:4", "2:10"),
+						BaseNode: withComments(base("2:4", "2:10"), "// Comment"),
 						Expression: &ast.CallExpression{
 							BaseNode: base("2:4", "2:10"),
 							Callee: &ast.Identifier{
@@ -906,6 +906,70 @@ import "path/bar"
 				},
 			},
 		},
+		{
+			name: "declare variable as a dictionary",
+			raw:  `howdy = ["a": 1, "b": 2]`,
+			want: &ast.File{
+				Metadata: "parser-type=go",
+				BaseNode: base("1:1", "1:25"),
+				Body: []ast.Statement{
+					&ast.VariableAssignment{
+						BaseNode: base("1:1", "1:25"),
+						ID: &ast.Identifier{
+							BaseNode: base("1:1", "1:6"),
+							Name:     "howdy",
+						},
+						Init: &ast.DictExpression{
+							BaseNode: base("1:9", "1:25"),
+							Elements: []*ast.DictItem{
+								{
+									BaseNode: base("1:10", "1:16"),
+									Key: &ast.StringLiteral{
+										BaseNode: base("1:10", "1:13"),
+										Value:    "a",
+									},
+									Val: &ast.IntegerLiteral{
+										BaseNode: base("1:15", "1:16"),
+										Value:    1,
+									},
+								},
+								{
+									BaseNode: base("1:18", "1:24"),
+									Key: &ast.StringLiteral{
+										BaseNode: base("1:18", "1:21"),
+										Value:    "b",
+									},
+									Val: &ast.IntegerLiteral{
+										BaseNode: base("1:23", "1:24"),
+										Value:    2,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "declare variable as an empty dictionary",
+			raw:  `howdy = [:]`,
+			want: &ast.File{
+				Metadata: "parser-type=go",
+				BaseNode: base("1:1", "1:12"),
+				Body: []ast.Statement{
+					&ast.VariableAssignment{
+						BaseNode: base("1:1", "1:12"),
+						ID: &ast.Identifier{
+							BaseNode: base("1:1", "1:6"),
+							Name:     "howdy",
+						},
+						Init: &ast.DictExpression{
+							BaseNode: base("1:9", "1:12"),
+						},
+					},
+				},
+			},
+		},
 		{
 			name: "use variable to declare something",
 			raw: `howdy = 1
@@ -2217,7 +2281,7 @@ a = 5.0
 				BaseNode: base("2:1", "6:13"),
 				Body: []ast.Statement{
 					&ast.VariableAssignment{
-						BaseNode: base("2:1", "2:8"),
+						BaseNode: withComments(base("2:1", "2:8"), "// define a"),
 						ID: &ast.Identifier{
 							BaseNode: base("2:1", "2:2"),
 							Name:     "a",
@@ -2228,7 +2292,7 @@ a = 5.0
 						},
 					},
 					&ast.ExpressionStatement{
-						BaseNode: base("4:1", "6:13"),
+						BaseNode: withComments(base("4:1", "6:13"), "// eval this"),
 						Expression: &ast.LogicalExpression{
 							BaseNode: base("4:1", "6:13"),
 							Operator: ast.OrOperator,
@@ -2261,7 +2325,7 @@ a = 5.0
 								},
 							},
 							Right: &ast.BinaryExpression{
-								BaseNode: base("6:5", "6:13"),
+								BaseNode: withComments(base("6:5", "6:13"), "// or this"),
 								Operator: ast.EqualOperator,
 								Left: &ast.Identifier{
 									BaseNode: base("6:5", "6:6"),
@@ -5975,6 +6039,48 @@ string"
 				},
 			},
 		},
+		{
+			name: "missing dictionary value",
+			raw:  `["a": 1, "b"]`,
+			want: &ast.File{
+				Metadata: "parser-type=go",
+				BaseNode: base("1:1", "1:14"),
+				Body: []ast.Statement{
+					&ast.ExpressionStatement{
+						BaseNode: base("1:1", "1:14"),
+						Expression: &ast.DictExpression{
+							BaseNode: base("1:1", "1:14"),
+							Elements: []*ast.DictItem{
+								{
+									BaseNode: base("1:2", "1:8"),
+									Key: &ast.StringLiteral{
+										BaseNode: base("1:2", "1:5"),
+										Value:    "a",
+									},
+									Val: &ast.IntegerLiteral{
+										BaseNode: base("1:7", "1:8"),
+										Value:    1,
+									},
+								},
+								{
+									BaseNode: ast.BaseNode{
+										Loc: loc("1:10", "1:13"),
+										Errors: []ast.Error{
+											{Msg: `expected colon in dictionary item, got RBRACK ("]")`},
+										},
+									},
+									Key: &ast.StringLiteral{
+										BaseNode: base("1:10", "1:13"),
+										Value:    "b",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			nerrs: 1,
+		},
 		{
 			name: "integer literal overflow",
 			raw:  `100000000000000000000000000000`,
@@ -6064,6 +6170,18 @@ func base(start, end string, errors ...ast.Error) ast.BaseNode {
 	}
 }
 
+func withComments(base ast.BaseNode, comments ...string) ast.BaseNode {
+	for _, c := range comments {
+		base.Comments = append(base.Comments, ast.Comment{Text: c})
+	}
+	return base
+}
+
+func withTrailingComment(base ast.BaseNode, comment string) ast.BaseNode {
+	base.TrailingComment = &ast.Comment{Text: comment}
+	return base
+}
+
 func source(src string, loc *ast.SourceLocation) string {
 	if loc == nil ||
 		loc.Start.Line == 0 || loc.Start.Column == 0 ||
@@ -0,0 +1,421 @@
+// Package speckey encodes procedure specs into keys that identify
+// the results of the procedures.
+//
+// Unlike the JSON encoding of a spec, the key includes the values
+// of the fields that hold Flux values and the values in the scope
+// of the functions that a spec holds. Two specs have the same key
+// only when they compute the same results.
+package speckey
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/influxdata/flux/codes"
+	"github.com/influxdata/flux/internal/errors"
+	"github.com/influxdata/flux/interpreter"
+	"github.com/influxdata/flux/plan"
+	"github.com/influxdata/flux/semantic"
+	"github.com/influxdata/flux/values"
+)
+
+// maxDepth bounds the nesting of the encoded values
+// so values that refer to themselves cannot be encoded.
+const maxDepth = 64
+
+var (
+	valueType            = reflect.TypeOf((*values.Value)(nil)).Elem()
+	semanticNodeType     = reflect.TypeOf((*semantic.Node)(nil)).Elem()
+	scopeType            = reflect.TypeOf((*values.Scope)(nil)).Elem()
+	resolvedFunctionType = reflect.TypeOf(interpreter.ResolvedFunction{})
+	timeType             = reflect.TypeOf(time.Time{})
+	regexpType           = reflect.TypeOf((*regexp.Regexp)(nil))
+	contextType          = reflect.TypeOf((*context.Context)(nil)).Elem()
+	statisticsType       = reflect.TypeOf(plan.Statistics{})
+)
+
+// errUnencodable is returned when a spec holds a value that cannot be encoded.
+var errUnencodable = errors.New(codes.Invalid, "procedure spec cannot be encoded")
+
+// Write writes the key of the procedure spec to w.
+// It returns an error when the spec holds a value that cannot be encoded,
+// such as a Go function or a Flux function that is implemented in Go
+// and is not part of the scope of a Flux function.
+func Write(w io.Writer, spec plan.ProcedureSpec) error {
+	var sb strings.Builder
+	e := &encoder{w: &sb}
+	fmt.Fprintf(&sb, "%s %T ", spec.Kind(), spec)
+	if err := e.encode(reflect.ValueOf(spec), 0); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, sb.String())
+	return err
+}
+
+type encoder struct {
+	w *strings.Builder
+}
+
+func (e *encoder) printf(format string, args ...interface{}) {
+	fmt.Fprintf(e.w, format, args...)
+}
+
+func (e *encoder) encode(v reflect.Value, depth int) error {
+	if depth > maxDepth {
+		return errUnencodable
+	}
+	if !v.IsValid() {
+		e.printf("nil")
+		return nil
+	}
+
+	typ := v.Type()
+	switch {
+	case typ == contextType || typ == statisticsType:
+		// The statistics of a spec and the context it uses
+		// to estimate them do not change its results.
+		e.printf("-")
+		return nil
+	case typ == resolvedFunctionType:
+		if !v.CanInterface() {
+			return errUnencodable
+		}
+		return e.encodeResolvedFunction(v.Interface().(interpreter.ResolvedFunction), depth)
+	case typ == timeType:
+		if !v.CanInterface() {
+			return errUnencodable
+		}
+		e.printf("time(%s)", v.Interface().(time.Time).Format(time.RFC3339Nano))
+		return nil
+	case typ == regexpType:
+		if !v.CanInterface() {
+			return errUnencodable
+		}
+		if v.IsNil() {
+			e.printf("nil")
+			return nil
+		}
+		e.printf("regexp(%q)", v.Interface().(*regexp.Regexp).String())
+		return nil
+	case typ.Kind() != reflect.Interface && typ.Implements(valueType):
+		if !v.CanInterface() {
+			return errUnencodable
+		}
+		if typ.Kind() == reflect.Ptr && v.IsNil() {
+			e.printf("nil")
+			return nil
+		}
+		return e.encodeValue(v.Interface().(values.Value), depth)
+	case typ.Kind() != reflect.Interface && typ.Implements(semanticNodeType):
+		return e.encodeNode(v)
+	case typ == scopeType:
+		// A scope is only encoded as the part of a resolved
+		// function that its identifiers refer to.
+		return errUnencodable
+	}
+
+	switch typ.Kind() {
+	case reflect.Bool:
+		e.printf("%t", v.Bool())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		e.printf("%d", v.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		e.printf("%d", v.Uint())
+	case reflect.Float32, reflect.Float64:
+		e.printf("%s", strconv.FormatFloat(v.Float(), 'g', -1, 64))
+	case reflect.String:
+		e.printf("%q", v.String())
+	case reflect.Ptr, reflect.Interface:
+		if v.IsNil() {
+			e.printf("nil")
+			return nil
+		}
+		if typ.Kind() == reflect.Interface {
+			e.printf("%s", v.Elem().Type())
+		}
+		e.printf("(")
+		if err := e.encode(v.Elem(), depth+1); err != nil {
+			return err
+		}
+		e.printf(")")
+	case reflect.Slice, reflect.Array:
+		if typ.Kind() == reflect.Slice && v.IsNil() {
+			e.printf("nil")
+			return nil
+		}
+		e.printf("[")
+		for i := 0; i < v.Len(); i++ {
+			if i > 0 {
+				e.printf(",")
+			}
+			if err := e.encode(v.Index(i), depth+1); err != nil {
+				return err
+			}
+		}
+		e.printf("]")
+	case reflect.Map:
+		if v.IsNil() {
+			e.printf("nil")
+			return nil
+		}
+		// The entries are sorted by their encoding
+		// because the order of a map is not defined.
+		entries := make([]string, 0, v.Len())
+		iter := v.MapRange()
+		for iter.Next() {
+			sub := &encoder{w: &strings.Builder{}}
+			if err := sub.encode(iter.Key(), depth+1); err != nil {
+				return err
+			}
+			sub.printf(":")
+			if err := sub.encode(iter.Value(), depth+1); err != nil {
+				return err
+			}
+			entries = append(entries, sub.w.String())
+		}
+		sort.Strings(entries)
+		e.printf("{%s}", strings.Join(entries, ","))
+	case reflect.Struct:
+		e.printf("{")
+		for i := 0; i < v.NumField(); i++ {
+			if i > 0 {
+				e.printf(",")
+			}
+			e.printf("%s:", typ.Field(i).Name)
+			if err := e.encode(v.Field(i), depth+1); err != nil {
+				return err
+			}
+		}
+		e.printf("}")
+	case reflect.Func, reflect.Chan:
+		// Functions and channels have no value that identifies them.
+		if !v.IsNil() {
+			return errUnencodable
+		}
+		e.printf("nil")
+	default:
+		return errUnencodable
+	}
+	return nil
+}
+
+// encodeNode encodes a semantic node. Literals are part of the node,
+// so the JSON encoding of the node identifies it.
+func (e *encoder) encodeNode(v reflect.Value) error {
+	if !v.CanInterface() {
+		return errUnencodable
+	}
+	if v.Kind() == reflect.Ptr && v.IsNil() {
+		e.printf("nil")
+		return nil
+	}
+	data, err := json.Marshal(v.Interface())
+	if err != nil {
+		return errUnencodable
+	}
+	e.printf("%s", data)
+	return nil
+}
+
+// encodeResolvedFunction encodes the function expression and
+// the values in its scope that the identifiers of the expression refer to.
+// The identifiers that are not in the scope are the parameters
+// and the variables of the function and are part of the expression.
+func (e *encoder) encodeResolvedFunction(fn interpreter.ResolvedFunction, depth int) error {
+	if fn.Fn == nil {
+		e.printf("nil")
+		return nil
+	}
+	data, err := json.Marshal(fn.Fn)
+	if err != nil {
+		return errUnencodable
+	}
+	e.printf("fn(%s", data)
+	if err := e.encodeScope(fn.Fn, fn.Scope, depth); err != nil {
+		return err
+	}
+	e.printf(")")
+	return nil
+}
+
+// encodeScope encodes the values in the scope that the identifiers of the node refer to.
+func (e *encoder) encodeScope(node semantic.Node, scope values.Scope, depth int) error {
+	if scope == nil {
+		return nil
+	}
+	names := make(map[string]bool)
+	semantic.Walk(semantic.CreateVisitor(func(n semantic.Node) {
+		if id, ok := n.(*semantic.IdentifierExpression); ok {
+			names[id.Name] = true
+		}
+	}), node)
+	sorted := make([]string, 0, len(names))
+	for name := range names {
+		sorted = append(sorted, name)
+	}
+	sort.Strings(sorted)
+
+	for _, name := range sorted {
+		v, ok := scope.Lookup(name)
+		if !ok {
+			continue
+		}
+		e.printf(" %s=", name)
+		if err := e.encodeValue(v, depth+1); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (e *encoder) encodeValue(v values.Value, depth int) error {
+	if depth > maxDepth {
+		return errUnencodable
+	}
+	if v == nil {
+		e.printf("nil")
+		return nil
+	}
+	// Composite values are identified by their interfaces,
+	// because the type of a value that holds polymorphic functions
+	// has no nature.
+	switch v := v.(type) {
+	case values.Function:
+		return e.encodeFunction(v, depth)
+	case values.Object:
+		return e.encodeObject(v, depth)
+	case values.Array:
+		return e.encodeArray(v, depth)
+	case values.Dictionary:
+		return e.encodeDict(v, depth)
+	}
+	nature := v.Type().Nature()
+	if v.IsNull() {
+		e.printf("null(%v)", nature)
+		return nil
+	}
+	switch nature {
+	case semantic.String:
+		e.printf("string(%q)", v.Str())
+	case semantic.Bytes:
+		e.printf("bytes(%q)", v.Bytes())
+	case semantic.Int:
+		e.printf("int(%d)", v.Int())
+	case semantic.UInt:
+		e.printf("uint(%d)", v.UInt())
+	case semantic.Float:
+		e.printf("float(%s)", strconv.FormatFloat(v.Float(), 'g', -1, 64))
+	case semantic.Bool:
+		e.printf("bool(%t)", v.Bool())
+	case semantic.Time:
+		e.printf("time(%d)", int64(v.Time()))
+	case semantic.Duration:
+		e.printf("duration(%s)", v.Duration())
+	case semantic.Regexp:
+		e.printf("regexp(%q)", v.Regexp().String())
+	default:
+		return errUnencodable
+	}
+	return nil
+}
+
+func (e *encoder) encodeArray(arr values.Array, depth int) error {
+	var err error
+	e.printf("array[")
+	arr.Range(func(i int, v values.Value) {
+		if err != nil {
+			return
+		}
+		if i > 0 {
+			e.printf(",")
+		}
+		err = e.encodeValue(v, depth+1)
+	})
+	if err != nil {
+		return err
+	}
+	e.printf("]")
+	return nil
+}
+
+// encodeDict encodes the entries of the dictionary,
+// which are ranged in the order of their keys.
+func (e *encoder) encodeDict(dict values.Dictionary, depth int) error {
+	var err error
+	e.printf("dict[")
+	dict.Range(func(key, value values.Value) {
+		if err != nil {
+			return
+		}
+		if err = e.encodeValue(key, depth+1); err != nil {
+			return
+		}
+		e.printf(":")
+		if err = e.encodeValue(value, depth+1); err != nil {
+			return
+		}
+		e.printf(",")
+	})
+	if err != nil {
+		return err
+	}
+	e.printf("]")
+	return nil
+}
+
+func (e *encoder) encodeObject(obj values.Object, depth int) error {
+	names := make([]string, 0, obj.Len())
+	obj.Range(func(name string, _ values.Value) {
+		names = append(names, name)
+	})
+	sort.Strings(names)
+	e.printf("object{")
+	for i, name := range names {
+		if i > 0 {
+			e.printf(",")
+		}
+		e.printf("%s:", name)
+		v, _ := obj.Get(name)
+		if err := e.encodeValue(v, depth+1); err != nil {
+			return err
+		}
+	}
+	e.printf("}")
+	return nil
+}
+
+// encodeFunction encodes a function value. A function that is defined in Flux
+// is encoded with its expression and its scope. A function that is implemented
+// in Go is identified by its address, so its key is only the same
+// within the process that created the function.
+func (e *encoder) encodeFunction(fn values.Function, depth int) error {
+	if r, ok := fn.(interpreter.Resolver); ok {
+		node, err := r.Resolve()
+		if err != nil {
+			return errUnencodable
+		}
+		data, err := json.Marshal(node)
+		if err != nil {
+			return errUnencodable
+		}
+		e.printf("fn(%s", data)
+		if err := e.encodeScope(node, r.Scope(), depth); err != nil {
+			return err
+		}
+		e.printf(")")
+		return nil
+	}
+	if rv := reflect.ValueOf(fn); rv.Kind() == reflect.Ptr {
+		e.printf("builtin(%v %p)", fn, fn)
+		return nil
+	}
+	return errUnencodable
+}
@@ -0,0 +1,52 @@
+package speckey_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/influxdata/flux/internal/speckey"
+	"github.com/influxdata/flux/plan"
+	"github.com/influxdata/flux/values"
+)
+
+type testSpec struct {
+	plan.DefaultCost
+	Value values.Value
+	Fn    func() int
+}
+
+func (s *testSpec) Kind() plan.ProcedureKind { return "test" }
+func (s *testSpec) Copy() plan.ProcedureSpec { ns := *s; return &ns }
+
+func key(t *testing.T, spec plan.ProcedureSpec) (string, error) {
+	t.Helper()
+	var sb strings.Builder
+	err := speckey.Write(&sb, spec)
+	return sb.String(), err
+}
+
+func TestWrite(t *testing.T) {
+	keys := make(map[string]bool)
+	for _, v := range []values.Value{
+		values.NewFloat(0),
+		values.NewFloat(1),
+		values.NewInt(1),
+		values.NewString("1"),
+		values.NewNull(values.NewFloat(0).Type()),
+	} {
+		k, err := key(t, &testSpec{Value: v})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if keys[k] {
+			t.Errorf("duplicate key for value %v: %s", v, k)
+		}
+		keys[k] = true
+	}
+}
+
+func TestWrite_Unencodable(t *testing.T) {
+	if _, err := key(t, &testSpec{Fn: func() int { return 0 }}); err == nil {
+		t.Error("expected a spec with a Go function to be unencodable")
+	}
+}
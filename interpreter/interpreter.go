@@ -230,6 +230,7 @@ func (itrp *Interpreter) mutateFunctionScope(f function) (function, error) {
 	f.scope = f.scope.Copy()
 	copyPackages(f.scope)
 	mutatedPkg := false
+	var scopePkgs []string
 	for _, mut := range itrp.modifiedOptions {
 		// Check if the function is defined in the scope of package that was mutated
 		if f.pkg.Name() == mut.Package {
@@ -241,18 +242,48 @@ func (itrp *Interpreter) mutateFunctionScope(f function) (function, error) {
 			continue
 		}
 		// Apply the option to the scope
-		_, err := f.scope.SetOption(mut.Package, mut.Name, mut.Value)
+		set, err := f.scope.SetOption(mut.Package, mut.Name, mut.Value)
 		if err != nil {
 			return f, err
 		}
+		if set {
+			scopePkgs = append(scopePkgs, mut.Package)
+		}
 	}
 	if mutatedPkg {
 		// Reapply the package values to the scope.
 		f.scope = values.NewNestedScope(f.scope.Pop(), f.pkg)
 	}
+	for _, name := range scopePkgs {
+		if v, ok := f.scope.Lookup(name); ok {
+			if p, ok := v.(*Package); ok {
+				rebindPackageFunctions(p)
+			}
+		}
+	}
 	return f, nil
 }
 
+// rebindPackageFunctions rebinds the functions defined in a copied package
+// to the copy so that they observe the options that were set on it.
+// Without this, a function that is retrieved from the package and called
+// outside of the interpreter would see the original option values.
+func rebindPackageFunctions(p *Package) {
+	var fns []string
+	p.object.Range(func(k string, v values.Value) {
+		if fn, ok := v.(function); ok && fn.pkg != nil && fn.pkg.Name() == p.Name() {
+			fns = append(fns, k)
+		}
+	})
+	for _, k := range fns {
+		v, _ := p.object.Get(k)
+		fn := v.(function)
+		fn.pkg = p
+		fn.scope = values.NewNestedScope(fn.scope.Pop(), p)
+		p.object.Set(k, fn)
+	}
+}
+
 // copyPackages creates a copy of the scope and any packages in scope
 func copyPackages(scope values.Scope) {
 	if scope == nil {
@@ -311,6 +342,8 @@ func (itrp *Interpreter) doExpression(ctx context.Context, expr semantic.Express
 		return itrp.doStringExpression(ctx, e, scope)
 	case *semantic.ArrayExpression:
 		return itrp.doArray(ctx, e, scope)
+	case *semantic.DictExpression:
+		return itrp.doDict(ctx, e, scope)
 	case *semantic.IdentifierExpression:
 		value, ok := scope.Lookup(e.Name)
 		if !ok {
@@ -522,6 +555,28 @@ func (itrp *Interpreter) doArray(ctx context.Context, a *semantic.ArrayExpressio
 	return values.NewArrayWithBacking(elementType, elements), nil
 }
 
+func (itrp *Interpreter) doDict(ctx context.Context, d *semantic.DictExpression, scope values.Scope) (values.Value, error) {
+	dictType, ok := itrp.types[d]
+	if !ok || dictType == nil {
+		return nil, errors.New(codes.Internal, "expecting dictionary type")
+	}
+	builder := values.NewDictBuilder(dictType)
+	for _, item := range d.Elements {
+		k, err := itrp.doExpression(ctx, item.Key, scope)
+		if err != nil {
+			return nil, err
+		}
+		v, err := itrp.doExpression(ctx, item.Val, scope)
+		if err != nil {
+			return nil, err
+		}
+		if err := builder.Insert(k, v); err != nil {
+			return nil, err
+		}
+	}
+	return builder.Dict(), nil
+}
+
 func (itrp *Interpreter) doObject(ctx context.Context, m *semantic.ObjectExpression, scope values.Scope) (values.Value, error) {
 	obj := values.NewObject()
 	if m.With != nil {
@@ -786,6 +841,9 @@ func (f function) Regexp() *regexp.Regexp {
 func (f function) Array() values.Array {
 	panic(values.UnexpectedKind(semantic.Function, semantic.Array))
 }
+func (f function) Dict() values.Dictionary {
+	panic(values.UnexpectedKind(semantic.Function, semantic.Dictionary))
+}
 func (f function) Object() values.Object {
 	panic(values.UnexpectedKind(semantic.Function, semantic.Object))
 }
@@ -1071,6 +1129,19 @@ func (f function) resolveIdentifiers(n semantic.Node, localIdentifiers *[]string
 			}
 			n.Elements[i] = node.(semantic.Expression)
 		}
+	case *semantic.DictExpression:
+		for _, item := range n.Elements {
+			node, err := f.resolveIdentifiers(item.Key, localIdentifiers)
+			if err != nil {
+				return nil, err
+			}
+			item.Key = node.(semantic.Expression)
+			node, err = f.resolveIdentifiers(item.Val, localIdentifiers)
+			if err != nil {
+				return nil, err
+			}
+			item.Val = node.(semantic.Expression)
+		}
 	case *semantic.IndexExpression:
 		node, err := f.resolveIdentifiers(n.Array, localIdentifiers)
 		if err != nil {
@@ -1218,6 +1289,36 @@ func resolveValue(v values.Value) (semantic.Node, bool, error) {
 			return nil, false, err
 		}
 		return node, true, nil
+	case semantic.Dictionary:
+		dict := v.Dict()
+		node := new(semantic.DictExpression)
+		node.Elements = make([]*semantic.DictItem, 0, dict.Len())
+		var (
+			err error
+			ok  = true
+		)
+		dict.Range(func(key, val values.Value) {
+			if err != nil || !ok {
+				return
+			}
+			var kn, vn semantic.Node
+			kn, ok, err = resolveValue(key)
+			if err != nil || !ok {
+				return
+			}
+			vn, ok, err = resolveValue(val)
+			if err != nil || !ok {
+				return
+			}
+			node.Elements = append(node.Elements, &semantic.DictItem{
+				Key: kn.(semantic.Expression),
+				Val: vn.(semantic.Expression),
+			})
+		})
+		if err != nil || !ok {
+			return nil, false, err
+		}
+		return node, true, nil
 	default:
 		return nil, false, errors.Newf(codes.Internal, "cannot resolve value of type %v", k)
 	}
@@ -1053,6 +1053,9 @@ func (f *function) Regexp() *regexp.Regexp {
 func (f *function) Array() values.Array {
 	panic(values.UnexpectedKind(semantic.Function, semantic.Array))
 }
+func (f *function) Dict() values.Dictionary {
+	panic(values.UnexpectedKind(semantic.Function, semantic.Dictionary))
+}
 func (f *function) Object() values.Object {
 	panic(values.UnexpectedKind(semantic.Function, semantic.Object))
 }
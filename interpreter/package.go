@@ -114,6 +114,9 @@ func (p *Package) Regexp() *regexp.Regexp {
 func (p *Package) Array() values.Array {
 	panic(values.UnexpectedKind(semantic.Object, semantic.Array))
 }
+func (p *Package) Dict() values.Dictionary {
+	panic(values.UnexpectedKind(semantic.Object, semantic.Dictionary))
+}
 func (p *Package) Object() values.Object {
 	return p
 }
@@ -0,0 +1,30 @@
+package lang
+
+import (
+	"context"
+
+	"github.com/influxdata/flux/plan"
+)
+
+// Admitter decides when the plan of a program may be executed.
+// A program that is started with an Admitter in its context
+// waits until the Admitter admits its plan before it executes it.
+type Admitter interface {
+	// Admit blocks until the plan may be executed. It returns an error
+	// when the plan will not be executed, such as when the context is canceled.
+	Admit(ctx context.Context, ps *plan.Spec) error
+}
+
+type admitterKey struct{}
+
+// ContextWithAdmitter returns a context with the Admitter
+// that admits the programs that are started with it.
+func ContextWithAdmitter(ctx context.Context, a Admitter) context.Context {
+	return context.WithValue(ctx, admitterKey{}, a)
+}
+
+// admitterFromContext returns the Admitter of the context or nil.
+func admitterFromContext(ctx context.Context) Admitter {
+	a, _ := ctx.Value(admitterKey{}).(Admitter)
+	return a
+}
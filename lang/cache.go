@@ -0,0 +1,391 @@
+package lang
+
+import (
+	"container/list"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/codes"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/internal/errors"
+	"github.com/influxdata/flux/internal/speckey"
+	"github.com/influxdata/flux/plan"
+)
+
+// ResultCache stores the results of programs so that a program
+// with the same plan and the same now time is not executed again.
+//
+// Implementations must be safe for concurrent use so that
+// embedders can share a cache between programs.
+type ResultCache interface {
+	// Get returns the results that are stored with the key.
+	// The results are retained for the caller, who must release them.
+	Get(key string) (*CachedResults, bool)
+
+	// Set stores the results with the key. The cache retains the results
+	// while they are stored and releases them when they are removed.
+	Set(key string, results *CachedResults)
+}
+
+// WithResultCache configures the program to read its results from the cache
+// and to store the results that are not cached yet.
+// Plans that contain procedures with side effects are never cached.
+//
+// The key of the results includes the now time of the program,
+// so the cache is only used by programs that are compiled with the same now time.
+func WithResultCache(cache ResultCache) CompileOption {
+	return func(o *compileOptions) {
+		o.cache = cache
+	}
+}
+
+// ResultCacheKey returns the key of the results of the plan.
+// The key is a hash of the procedures of the plan and the now time.
+// The procedures are encoded with the values of their arguments
+// and the scope of their functions.
+// It returns false when the results of the plan cannot be cached,
+// because the plan contains a procedure with side effects
+// or a procedure spec that cannot be encoded.
+func ResultCacheKey(ps *plan.Spec) (string, bool) {
+	roots := make([]plan.Node, 0, len(ps.Roots))
+	for root := range ps.Roots {
+		roots = append(roots, root)
+	}
+	sort.Slice(roots, func(i, j int) bool {
+		return roots[i].ID() < roots[j].ID()
+	})
+
+	// The nodes are identified by the order they are visited
+	// so the key does not depend on the generated node IDs.
+	h := sha256.New()
+	fmt.Fprintf(h, "now=%s\n", ps.Now.UTC().Format(time.RFC3339Nano))
+	k := &planKeyer{
+		w:       h,
+		indexes: make(map[plan.Node]int),
+	}
+	for _, root := range roots {
+		if err := k.write(root); err != nil {
+			return "", false
+		}
+	}
+	return hex.EncodeToString(h.Sum(nil)), true
+}
+
+// errUncacheable is returned when a node cannot be part of a cache key.
+var errUncacheable = errors.New(codes.Invalid, "plan cannot be cached")
+
+// planKeyer writes the nodes of a plan to the cache key in a canonical order.
+type planKeyer struct {
+	w       io.Writer
+	indexes map[plan.Node]int
+}
+
+func (k *planKeyer) write(node plan.Node) error {
+	if _, ok := k.indexes[node]; ok {
+		return nil
+	}
+	preds := node.Predecessors()
+	for _, pred := range preds {
+		if err := k.write(pred); err != nil {
+			return err
+		}
+	}
+
+	// Yields are registered with side effects,
+	// but they only name the results of the program.
+	spec := node.ProcedureSpec()
+	if _, ok := spec.(plan.YieldProcedureSpec); !ok && plan.HasSideEffect(spec) {
+		return errUncacheable
+	}
+	index := len(k.indexes)
+	k.indexes[node] = index
+	fmt.Fprintf(k.w, "%d", index)
+	for _, pred := range preds {
+		fmt.Fprintf(k.w, " %d", k.indexes[pred])
+	}
+	fmt.Fprintf(k.w, "\n")
+	if err := speckey.Write(k.w, spec); err != nil {
+		return errUncacheable
+	}
+	fmt.Fprintf(k.w, "\n")
+	return nil
+}
+
+// CachedResults are the materialized results of a program.
+// They are reference counted and the tables are released
+// when the last reference is released.
+type CachedResults struct {
+	results []cachedResult
+	size    int64
+	refs    int32
+}
+
+type cachedResult struct {
+	name   string
+	tables []flux.BufferedTable
+}
+
+// materializeResults reads all of the tables of the results into memory.
+// The results are read concurrently because the executor
+// may produce the tables of the results in any order.
+// The returned results hold a single reference.
+func materializeResults(resultMap map[string]flux.Result) (*CachedResults, error) {
+	names := make([]string, 0, len(resultMap))
+	for name := range resultMap {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	cr := &CachedResults{
+		results: make([]cachedResult, len(names)),
+		refs:    1,
+	}
+	errs := make([]error, len(names))
+	var wg sync.WaitGroup
+	for i, name := range names {
+		wg.Add(1)
+		go func(i int, name string) {
+			defer wg.Done()
+			r := &cr.results[i]
+			r.name = name
+			errs[i] = resultMap[name].Tables().Do(func(tbl flux.Table) error {
+				buffered, err := execute.CopyTable(tbl)
+				if err != nil {
+					return err
+				}
+				r.tables = append(r.tables, buffered)
+				return nil
+			})
+		}(i, name)
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			cr.Release()
+			return nil, err
+		}
+	}
+	for _, r := range cr.results {
+		for _, tbl := range r.tables {
+			cr.size += tableSize(tbl)
+		}
+	}
+	return cr, nil
+}
+
+// tableSize returns the number of bytes that are allocated for the buffers of the table.
+func tableSize(tbl flux.BufferedTable) int64 {
+	var size int64
+	for i, n := 0, tbl.BufferN(); i < n; i++ {
+		cr := tbl.Buffer(i)
+		for j, col := range cr.Cols() {
+			var arr array.Interface
+			switch col.Type {
+			case flux.TBool:
+				arr = cr.Bools(j)
+			case flux.TInt:
+				arr = cr.Ints(j)
+			case flux.TUInt:
+				arr = cr.UInts(j)
+			case flux.TFloat:
+				arr = cr.Floats(j)
+			case flux.TString:
+				arr = cr.Strings(j)
+			case flux.TTime:
+				arr = cr.Times(j)
+			default:
+				continue
+			}
+			for _, buf := range arr.Data().Buffers() {
+				if buf != nil {
+					size += int64(buf.Cap())
+				}
+			}
+		}
+	}
+	return size
+}
+
+// Size returns the number of bytes that are allocated for the tables.
+func (r *CachedResults) Size() int64 {
+	return r.size
+}
+
+// Retain adds a reference to the results.
+func (r *CachedResults) Retain() {
+	atomic.AddInt32(&r.refs, 1)
+}
+
+// Release removes a reference to the results.
+func (r *CachedResults) Release() {
+	if atomic.AddInt32(&r.refs, -1) > 0 {
+		return
+	}
+	for _, res := range r.results {
+		for _, tbl := range res.tables {
+			tbl.Done()
+		}
+	}
+}
+
+// Results returns a copy of the results that can be read independently
+// of the cache. The caller must hold a reference to the results.
+func (r *CachedResults) Results() []flux.Result {
+	results := make([]flux.Result, len(r.results))
+	for i, res := range r.results {
+		tables := make([]flux.BufferedTable, len(res.tables))
+		for j, tbl := range res.tables {
+			tables[j] = tbl.Copy()
+		}
+		results[i] = &cachedResultCopy{
+			name:   res.name,
+			tables: tables,
+		}
+	}
+	return results
+}
+
+// cachedResultCopy is a flux.Result that reads a copy of the cached tables.
+type cachedResultCopy struct {
+	name   string
+	tables []flux.BufferedTable
+}
+
+func (r *cachedResultCopy) Name() string {
+	return r.name
+}
+
+func (r *cachedResultCopy) Tables() flux.TableIterator {
+	return r
+}
+
+func (r *cachedResultCopy) Do(f func(flux.Table) error) error {
+	for i, tbl := range r.tables {
+		if err := f(tbl); err != nil {
+			// Release the tables that were not passed to f.
+			r.tables = r.tables[i+1:]
+			r.done()
+			return err
+		}
+	}
+	return nil
+}
+
+// done releases the tables of the result without reading them.
+func (r *cachedResultCopy) done() {
+	for _, tbl := range r.tables {
+		tbl.Done()
+	}
+}
+
+// MemoryResultCache is a ResultCache that keeps the results in memory.
+// Results expire after the time to live and the least recently used
+// results are evicted when the total size exceeds the maximum size.
+type MemoryResultCache struct {
+	maxSize int64
+	ttl     time.Duration
+
+	mu      sync.Mutex
+	size    int64
+	lru     *list.List
+	entries map[string]*list.Element
+
+	// now returns the current time. It is replaced in tests.
+	now func() time.Time
+}
+
+type memoryCacheEntry struct {
+	key     string
+	results *CachedResults
+	expires time.Time
+}
+
+// NewMemoryResultCache creates an in-memory cache that holds up to maxSize bytes
+// of results for the time to live. A maxSize of zero does not limit the size
+// and a ttl of zero does not expire the results.
+func NewMemoryResultCache(maxSize int64, ttl time.Duration) *MemoryResultCache {
+	return &MemoryResultCache{
+		maxSize: maxSize,
+		ttl:     ttl,
+		lru:     list.New(),
+		entries: make(map[string]*list.Element),
+		now:     time.Now,
+	}
+}
+
+func (c *MemoryResultCache) Get(key string) (*CachedResults, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	entry := e.Value.(*memoryCacheEntry)
+	if c.expired(entry) {
+		c.remove(e)
+		return nil, false
+	}
+	c.lru.MoveToFront(e)
+	entry.results.Retain()
+	return entry.results, true
+}
+
+func (c *MemoryResultCache) Set(key string, results *CachedResults) {
+	if c.maxSize > 0 && results.Size() > c.maxSize {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if e, ok := c.entries[key]; ok {
+		c.remove(e)
+	}
+	results.Retain()
+	entry := &memoryCacheEntry{
+		key:     key,
+		results: results,
+	}
+	if c.ttl > 0 {
+		entry.expires = c.now().Add(c.ttl)
+	}
+	c.entries[key] = c.lru.PushFront(entry)
+	c.size += results.Size()
+
+	for c.maxSize > 0 && c.size > c.maxSize {
+		c.remove(c.lru.Back())
+	}
+}
+
+// Len returns the number of results in the cache.
+func (c *MemoryResultCache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.lru.Len()
+}
+
+// Size returns the number of bytes of the results in the cache.
+func (c *MemoryResultCache) Size() int64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.size
+}
+
+func (c *MemoryResultCache) expired(entry *memoryCacheEntry) bool {
+	return !entry.expires.IsZero() && !c.now().Before(entry.expires)
+}
+
+func (c *MemoryResultCache) remove(e *list.Element) {
+	entry := c.lru.Remove(e).(*memoryCacheEntry)
+	delete(c.entries, entry.key)
+	c.size -= entry.results.Size()
+	entry.results.Release()
+}
@@ -0,0 +1,125 @@
+package lang
+
+import (
+	"testing"
+	"time"
+
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/execute/executetest"
+	"github.com/influxdata/flux/memory"
+)
+
+func newTestResults(t *testing.T, alloc *memory.Allocator, n int) *CachedResults {
+	t.Helper()
+	tbl := &executetest.Table{
+		KeyCols: []string{"t0"},
+		ColMeta: []flux.ColMeta{
+			{Label: "_time", Type: flux.TTime},
+			{Label: "t0", Type: flux.TString},
+			{Label: "_value", Type: flux.TFloat},
+		},
+		Alloc: alloc,
+	}
+	for i := 0; i < n; i++ {
+		tbl.Data = append(tbl.Data, []interface{}{execute.Time(i), "a", float64(i)})
+	}
+	cached, err := materializeResults(map[string]flux.Result{
+		"_result": executetest.NewResult([]*executetest.Table{tbl}),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return cached
+}
+
+func TestMemoryResultCache_TTL(t *testing.T) {
+	alloc := &memory.Allocator{}
+	now := time.Date(2019, 10, 1, 0, 0, 0, 0, time.UTC)
+	cache := NewMemoryResultCache(0, time.Minute)
+	cache.now = func() time.Time { return now }
+
+	results := newTestResults(t, alloc, 10)
+	cache.Set("a", results)
+	results.Release()
+
+	now = now.Add(59 * time.Second)
+	cached, ok := cache.Get("a")
+	if !ok {
+		t.Fatal("expected results before they expire")
+	}
+	cached.Release()
+
+	now = now.Add(time.Second)
+	if _, ok := cache.Get("a"); ok {
+		t.Fatal("expected results to expire")
+	}
+	if got := cache.Len(); got != 0 {
+		t.Errorf("unexpected number of results: %d", got)
+	}
+	if got := alloc.Allocated(); got != 0 {
+		t.Errorf("expected expired results to be released, %d bytes allocated", got)
+	}
+}
+
+func TestMemoryResultCache_Evict(t *testing.T) {
+	alloc := &memory.Allocator{}
+	size := newTestResults(t, &memory.Allocator{}, 10).Size()
+	if size == 0 {
+		t.Fatal("expected results to have a size")
+	}
+	cache := NewMemoryResultCache(2*size, 0)
+
+	for _, key := range []string{"a", "b"} {
+		results := newTestResults(t, alloc, 10)
+		cache.Set(key, results)
+		results.Release()
+	}
+	// Use a so that b is the least recently used.
+	cached, ok := cache.Get("a")
+	if !ok {
+		t.Fatal("expected results for a")
+	}
+
+	results := newTestResults(t, alloc, 10)
+	cache.Set("c", results)
+	results.Release()
+
+	if _, ok := cache.Get("b"); ok {
+		t.Error("expected b to be evicted")
+	}
+	for _, key := range []string{"a", "c"} {
+		r, ok := cache.Get(key)
+		if !ok {
+			t.Errorf("expected results for %s", key)
+			continue
+		}
+		r.Release()
+	}
+	if got, want := cache.Size(), 2*size; got != want {
+		t.Errorf("unexpected cache size: want %d, got %d", want, got)
+	}
+
+	// Results that are larger than the cache are not stored.
+	results = newTestResults(t, alloc, 100)
+	cache.Set("d", results)
+	results.Release()
+	if _, ok := cache.Get("d"); ok {
+		t.Error("expected results larger than the cache to not be stored")
+	}
+
+	// The evicted results are still readable while a reference is held.
+	for _, res := range cached.Results() {
+		if err := res.Tables().Do(func(tbl flux.Table) error {
+			_, err := executetest.ConvertTable(tbl)
+			return err
+		}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	cached.Release()
+
+	if got, want := alloc.Allocated(), 2*size; got != want {
+		t.Errorf("expected only the cached results to be allocated: want %d, got %d", want, got)
+	}
+}
@@ -0,0 +1,242 @@
+package lang_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/influxdata/flux/execute/executetest"
+	"github.com/influxdata/flux/lang"
+	"github.com/influxdata/flux/memory"
+	"github.com/influxdata/flux/plan"
+	"github.com/influxdata/flux/plan/plantest"
+	"github.com/influxdata/flux/stdlib/sql"
+	"github.com/influxdata/flux/stdlib/universe"
+	"github.com/influxdata/flux/values"
+)
+
+func TestResultCacheKey(t *testing.T) {
+	now := time.Date(2019, 10, 1, 0, 0, 0, 0, time.UTC)
+	newPlan := func(ids [2]string, name string, now time.Time) *plan.Spec {
+		return plantest.CreatePlanSpec(&plantest.PlanSpec{
+			Nodes: []plan.Node{
+				plantest.CreatePhysicalMockNode(ids[0]),
+				plan.CreatePhysicalNode(plan.NodeID(ids[1]), &universe.YieldProcedureSpec{Name: name}),
+			},
+			Edges: [][2]int{{0, 1}},
+			Now:   now,
+		})
+	}
+
+	key, ok := lang.ResultCacheKey(newPlan([2]string{"a", "b"}, "_result", now))
+	if !ok {
+		t.Fatal("expected plan to be cacheable")
+	}
+	for _, tc := range []struct {
+		name string
+		plan *plan.Spec
+		same bool
+	}{
+		{
+			name: "node ids",
+			plan: newPlan([2]string{"c", "d"}, "_result", now),
+			same: true,
+		},
+		{
+			name: "procedure spec",
+			plan: newPlan([2]string{"a", "b"}, "other", now),
+		},
+		{
+			name: "now",
+			plan: newPlan([2]string{"a", "b"}, "_result", now.Add(time.Second)),
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			got, ok := lang.ResultCacheKey(tc.plan)
+			if !ok {
+				t.Fatal("expected plan to be cacheable")
+			}
+			if same := got == key; same != tc.same {
+				t.Errorf("unexpected key comparison: want same %v, got same %v", tc.same, same)
+			}
+		})
+	}
+
+	t.Run("literal argument", func(t *testing.T) {
+		newFillPlan := func(v float64) *plan.Spec {
+			return plantest.CreatePlanSpec(&plantest.PlanSpec{
+				Nodes: []plan.Node{
+					plantest.CreatePhysicalMockNode("0"),
+					plan.CreatePhysicalNode("1", &universe.FillProcedureSpec{
+						Column: "_value",
+						Value:  values.NewFloat(v),
+					}),
+				},
+				Edges: [][2]int{{0, 1}},
+				Now:   now,
+			})
+		}
+		zero, ok := lang.ResultCacheKey(newFillPlan(0))
+		if !ok {
+			t.Fatal("expected plan to be cacheable")
+		}
+		one, ok := lang.ResultCacheKey(newFillPlan(1))
+		if !ok {
+			t.Fatal("expected plan to be cacheable")
+		}
+		if zero == one {
+			t.Error("expected plans with different fill values to have different keys")
+		}
+	})
+
+	t.Run("side effect", func(t *testing.T) {
+		ps := plantest.CreatePlanSpec(&plantest.PlanSpec{
+			Nodes: []plan.Node{
+				plantest.CreatePhysicalMockNode("0"),
+				plan.CreatePhysicalNode("1", &sql.ToSQLProcedureSpec{Spec: &sql.ToSQLOpSpec{}}),
+			},
+			Edges: [][2]int{{0, 1}},
+			Now:   now,
+		})
+		if _, ok := lang.ResultCacheKey(ps); ok {
+			t.Error("expected plan with side effects to not be cacheable")
+		}
+	})
+}
+
+func TestProgram_ResultCache(t *testing.T) {
+	script := `
+import "csv"
+
+data = "
+#datatype,string,long,dateTime:RFC3339,string,double
+#group,false,false,false,true,false
+#default,_result,,,,
+,result,table,_time,_measurement,_value
+,,0,2018-05-22T19:53:26Z,cpu,1.0
+,,0,2018-05-22T19:53:36Z,cpu,2.0
+,,1,2018-05-22T19:53:26Z,mem,3.0
+"
+
+csv.from(csv: data)
+	|> range(start: 2018-05-22T19:53:30Z)
+`
+	cache := lang.NewMemoryResultCache(0, time.Minute)
+	now := time.Date(2019, 10, 1, 0, 0, 0, 0, time.UTC)
+
+	run := func(now time.Time) ([]*executetest.Table, interface{}) {
+		t.Helper()
+		program, err := lang.Compile(script, now, lang.WithResultCache(cache))
+		if err != nil {
+			t.Fatal(err)
+		}
+		ctx := executetest.NewTestExecuteDependencies().Inject(context.Background())
+		q, err := program.Start(ctx, &memory.Allocator{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		var tables []*executetest.Table
+		for res := range q.Results() {
+			tables = append(tables, getTablesFromResultOrFail(t, res)...)
+		}
+		q.Done()
+		if err := q.Err(); err != nil {
+			t.Fatal(err)
+		}
+		return tables, q.Statistics().Metadata["flux/result-cache"]
+	}
+
+	want, md := run(now)
+	if !cmp.Equal([]interface{}{"miss"}, md) {
+		t.Errorf("unexpected metadata for the first run: %v", md)
+	}
+	if len(want) != 2 {
+		t.Fatalf("unexpected number of tables: %d", len(want))
+	}
+	for i := 0; i < 2; i++ {
+		got, md := run(now)
+		if !cmp.Equal([]interface{}{"hit"}, md) {
+			t.Errorf("unexpected metadata for a cached run: %v", md)
+		}
+		if !cmp.Equal(want, got) {
+			t.Errorf("unexpected cached tables -want/+got:\n%s", cmp.Diff(want, got))
+		}
+	}
+
+	if _, md := run(now.Add(time.Second)); !cmp.Equal([]interface{}{"miss"}, md) {
+		t.Errorf("unexpected metadata for a different now: %v", md)
+	}
+	if got := cache.Len(); got != 2 {
+		t.Errorf("unexpected number of cached results: %d", got)
+	}
+}
+
+func TestProgram_ResultCache_Arguments(t *testing.T) {
+	prefix := `
+import "csv"
+
+data = "
+#datatype,string,long,dateTime:RFC3339,string,double
+#group,false,false,false,true,false
+#default,_result,,,,
+,result,table,_time,_measurement,_value
+,,0,2018-05-22T19:53:26Z,cpu,
+,,0,2018-05-22T19:53:36Z,cpu,2.0
+"
+`
+	for _, tc := range []struct {
+		name    string
+		scripts [2]string
+	}{
+		{
+			name: "literal",
+			scripts: [2]string{
+				`csv.from(csv: data) |> fill(value: 0.0)`,
+				`csv.from(csv: data) |> fill(value: 1.0)`,
+			},
+		},
+		{
+			name: "function scope",
+			scripts: [2]string{
+				`add = (v) => v + 1.0
+csv.from(csv: data) |> fill(value: 0.0) |> map(fn: (r) => ({r with _value: add(v: r._value)}))`,
+				`add = (v) => v + 2.0
+csv.from(csv: data) |> fill(value: 0.0) |> map(fn: (r) => ({r with _value: add(v: r._value)}))`,
+			},
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			cache := lang.NewMemoryResultCache(0, time.Minute)
+			now := time.Date(2019, 10, 1, 0, 0, 0, 0, time.UTC)
+
+			var results [2][]*executetest.Table
+			for i, script := range tc.scripts {
+				program, err := lang.Compile(prefix+script, now, lang.WithResultCache(cache))
+				if err != nil {
+					t.Fatal(err)
+				}
+				ctx := executetest.NewTestExecuteDependencies().Inject(context.Background())
+				q, err := program.Start(ctx, &memory.Allocator{})
+				if err != nil {
+					t.Fatal(err)
+				}
+				for res := range q.Results() {
+					results[i] = append(results[i], getTablesFromResultOrFail(t, res)...)
+				}
+				q.Done()
+				if err := q.Err(); err != nil {
+					t.Fatal(err)
+				}
+				if md := q.Statistics().Metadata["flux/result-cache"]; !cmp.Equal([]interface{}{"miss"}, md) {
+					t.Errorf("unexpected metadata for script %d: %v", i, md)
+				}
+			}
+			if cmp.Equal(results[0], results[1]) {
+				t.Error("expected the scripts to have different results")
+			}
+		})
+	}
+}
@@ -10,8 +10,10 @@ import (
 	"github.com/influxdata/flux/ast"
 	"github.com/influxdata/flux/codes"
 	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/influxql"
 	"github.com/influxdata/flux/internal/errors"
 	"github.com/influxdata/flux/internal/spec"
+	"github.com/influxdata/flux/interpreter"
 	"github.com/influxdata/flux/memory"
 	"github.com/influxdata/flux/plan"
 	"github.com/influxdata/flux/semantic"
@@ -21,8 +23,9 @@ import (
 )
 
 const (
-	FluxCompilerType = "flux"
-	ASTCompilerType  = "ast"
+	FluxCompilerType     = "flux"
+	ASTCompilerType      = "ast"
+	InfluxQLCompilerType = "influxql"
 )
 
 // AddCompilerMappings adds the Flux specific compiler mappings.
@@ -36,6 +39,12 @@ func AddCompilerMappings(mappings flux.CompilerMappings) error {
 	if err := mappings.Add(ASTCompilerType, func() flux.Compiler {
 		return new(ASTCompiler)
 
+	}); err != nil {
+		return err
+	}
+	if err := mappings.Add(InfluxQLCompilerType, func() flux.Compiler {
+		return new(InfluxQLCompiler)
+
 	}); err != nil {
 		return err
 	}
@@ -50,6 +59,12 @@ type compileOptions struct {
 
 	extern *ast.File
 
+	cache ResultCache
+
+	estimateStatistics bool
+
+	importer interpreter.Importer
+
 	planOptions struct {
 		logical  []plan.LogicalOption
 		physical []plan.PhysicalOption
@@ -72,6 +87,23 @@ func WithExtern(extern *ast.File) CompileOption {
 	}
 }
 
+// WithStatisticsEstimation allows sources to perform I/O while the program
+// is planned to estimate their statistics for cost-based rules.
+// For example, sql.from asks the database to explain its query.
+func WithStatisticsEstimation() CompileOption {
+	return func(o *compileOptions) {
+		o.estimateStatistics = true
+	}
+}
+
+// WithImporter resolves the imports of the program with the importer
+// instead of the standard library.
+func WithImporter(importer interpreter.Importer) CompileOption {
+	return func(o *compileOptions) {
+		o.importer = importer
+	}
+}
+
 func defaultOptions() *compileOptions {
 	o := new(compileOptions)
 	return o
@@ -148,6 +180,9 @@ func buildPlan(ctx context.Context, spec *flux.Spec, opts *compileOptions) (*pla
 	popts := planOptions.physical
 
 	pb.AddLogicalOptions(lopts...)
+	if opts.estimateStatistics {
+		pb.AddPhysicalOptions(plan.WithStatisticsEstimation(ctx))
+	}
 	pb.AddPhysicalOptions(popts...)
 
 	ps, err := pb.Build().Plan(spec)
@@ -192,6 +227,45 @@ func (ASTCompiler) CompilerType() flux.CompilerType {
 	return ASTCompilerType
 }
 
+// InfluxQLCompiler implements Compiler by transpiling an InfluxQL query into Flux.
+// The result of each statement is named with the index of the statement.
+type InfluxQLCompiler struct {
+	Query           string `json:"query"`
+	Database        string `json:"db"`
+	RetentionPolicy string `json:"rp"`
+	Now             time.Time
+}
+
+func (c InfluxQLCompiler) Compile(ctx context.Context) (flux.Program, error) {
+	now := c.Now
+	if now.IsZero() {
+		now = time.Now()
+	}
+	q, err := influxql.ParseQuery(c.Query)
+	if err != nil {
+		return nil, err
+	}
+	t := &influxql.Transpiler{
+		Database:        c.Database,
+		RetentionPolicy: c.RetentionPolicy,
+		Now:             now,
+	}
+	file, err := t.Transpile(q)
+	if err != nil {
+		return nil, err
+	}
+	astPkg := &ast.Package{
+		Package: "main",
+		Files:   []*ast.File{file},
+	}
+	// Ignore context, it will be provided upon Program Start.
+	return CompileAST(astPkg, now), nil
+}
+
+func (InfluxQLCompiler) CompilerType() flux.CompilerType {
+	return InfluxQLCompilerType
+}
+
 // PrependFile prepends a file onto the compiler's list of package files.
 func (c *ASTCompiler) PrependFile(file *ast.File) {
 	c.AST.Files = append([]*ast.File{file}, c.AST.Files...)
@@ -247,6 +321,33 @@ func (p *Program) Start(ctx context.Context, alloc *memory.Allocator) (flux.Quer
 		},
 	}
 
+	var cache ResultCache
+	if p.opts != nil {
+		cache = p.opts.cache
+	}
+	key, cacheable := "", false
+	if cache != nil {
+		key, cacheable = ResultCacheKey(p.PlanSpec)
+	}
+	if cacheable {
+		if cached, ok := cache.Get(key); ok {
+			q.stats.Metadata.Add(resultCacheMetadataKey, "hit")
+			q.wg.Add(1)
+			go p.processCachedResults(cctx, q, cached)
+			return q, nil
+		}
+		q.stats.Metadata.Add(resultCacheMetadataKey, "miss")
+	}
+
+	// Wait until the plan is admitted before it uses any resources to execute.
+	if a := admitterFromContext(ctx); a != nil {
+		if err := a.Admit(cctx, p.PlanSpec); err != nil {
+			s.Finish()
+			cancel()
+			return nil, err
+		}
+	}
+
 	e := execute.NewExecutor(p.Logger)
 	resultMap, md, err := e.Execute(cctx, p.PlanSpec, q.alloc)
 	if err != nil {
@@ -256,7 +357,11 @@ func (p *Program) Start(ctx context.Context, alloc *memory.Allocator) (flux.Quer
 
 	// There was no error so send the results downstream.
 	q.wg.Add(1)
-	go p.processResults(cctx, q, resultMap)
+	if cacheable {
+		go p.cacheResults(cctx, q, cache, key, resultMap)
+	} else {
+		go p.processResults(cctx, q, resultMap)
+	}
 
 	// Begin reading from the metadata channel.
 	q.wg.Add(1)
@@ -279,6 +384,45 @@ func (p *Program) processResults(ctx context.Context, q *query, resultMap map[st
 	}
 }
 
+// resultCacheMetadataKey is the metadata key that reports
+// whether the results were read from the result cache.
+const resultCacheMetadataKey = "flux/result-cache"
+
+// cacheResults materializes the results, stores them in the cache
+// and then sends them downstream.
+func (p *Program) cacheResults(ctx context.Context, q *query, cache ResultCache, key string, resultMap map[string]flux.Result) {
+	cached, err := materializeResults(resultMap)
+	if err != nil {
+		q.err = err
+		close(q.results)
+		q.wg.Done()
+		return
+	}
+	cache.Set(key, cached)
+	p.processCachedResults(ctx, q, cached)
+}
+
+// processCachedResults sends a copy of the cached results downstream
+// and releases the reference to them.
+func (p *Program) processCachedResults(ctx context.Context, q *query, cached *CachedResults) {
+	defer q.wg.Done()
+	defer close(q.results)
+
+	results := cached.Results()
+	cached.Release()
+	for i, res := range results {
+		select {
+		case q.results <- res:
+		case <-ctx.Done():
+			q.err = ctx.Err()
+			for _, res := range results[i:] {
+				res.(*cachedResultCopy).done()
+			}
+			return
+		}
+	}
+}
+
 func (p *Program) readMetadata(q *query, metaCh <-chan flux.Metadata) {
 	defer q.wg.Done()
 	for md := range metaCh {
@@ -313,7 +457,11 @@ func (p *AstProgram) getSpec(ctx context.Context, alloc *memory.Allocator) (*flu
 	}
 	ctx = deps.Inject(ctx)
 	s, cctx := opentracing.StartSpanFromContext(ctx, "eval")
-	sideEffects, scope, err := flux.EvalAST(cctx, p.Ast, flux.SetNowOption(p.Now))
+	importer := p.opts.importer
+	if importer == nil {
+		importer = flux.StdLib()
+	}
+	sideEffects, scope, err := flux.EvalASTWithImporter(cctx, p.Ast, importer, flux.SetNowOption(p.Now))
 	if err != nil {
 		return nil, nil, err
 	}
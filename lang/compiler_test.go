@@ -5,6 +5,7 @@ import (
 	"context"
 	"encoding/json"
 	"math"
+	"sort"
 	"strings"
 	"testing"
 	"time"
@@ -307,6 +308,78 @@ csv.from(csv: "foo,bar") |> range(start: 2017-10-10T00:00:00Z)
 	}
 }
 
+func TestInfluxQLCompiler(t *testing.T) {
+	c := lang.InfluxQLCompiler{
+		Query:    `SELECT mean(usage_user) FROM cpu WHERE time >= now() - 1h GROUP BY time(5m); SELECT usage_user FROM mem`,
+		Database: "telegraf",
+		Now:      parser.MustParseTime("2018-10-10T00:00:00Z").Value,
+	}
+
+	// serialize and deserialize and make sure they are equal
+	bs, err := json.Marshal(c)
+	if err != nil {
+		t.Error(err)
+	}
+	cc := lang.InfluxQLCompiler{}
+	if err := json.Unmarshal(bs, &cc); err != nil {
+		t.Error(err)
+	}
+	if diff := cmp.Diff(c, cc); diff != "" {
+		t.Errorf("compiler serialized/deserialized does not match: -want/+got:\n%v", diff)
+	}
+
+	program, err := c.Compile(context.Background())
+	if err != nil {
+		t.Fatalf("failed to compile InfluxQL: %v", err)
+	}
+	ctx := executetest.NewTestExecuteDependencies().Inject(context.Background())
+	q, err := program.Start(ctx, &memory.Allocator{})
+	if err != nil {
+		t.Fatalf("failed to start program: %v", err)
+	}
+	defer q.Done()
+
+	var names []string
+	for root := range program.(*lang.AstProgram).PlanSpec.Roots {
+		if y, ok := root.ProcedureSpec().(plan.YieldProcedureSpec); ok {
+			names = append(names, y.YieldName())
+		}
+	}
+	sort.Strings(names)
+	if want := []string{"0", "1"}; !cmp.Equal(want, names) {
+		t.Errorf("unexpected result names -want/+got:\n%s", cmp.Diff(want, names))
+	}
+}
+
+func TestInfluxQLCompiler_Error(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		c    lang.InfluxQLCompiler
+		want string
+	}{
+		{
+			name: "parse error",
+			c:    lang.InfluxQLCompiler{Query: `SHOW DATABASES`, Database: "telegraf"},
+			want: "found SHOW, expected SELECT at position 0",
+		},
+		{
+			name: "missing database",
+			c:    lang.InfluxQLCompiler{Query: `SELECT usage_user FROM cpu`},
+			want: "error transpiling statement 0: database name required",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := tc.c.Compile(context.Background())
+			if err == nil {
+				t.Fatal("expected error")
+			}
+			if got := err.Error(); got != tc.want {
+				t.Errorf("unexpected error -want/+got:\n\t- %s\n\t+ %s", tc.want, got)
+			}
+		})
+	}
+}
+
 func TestCompileOptions(t *testing.T) {
 	src := `import "csv"
 			csv.from(csv: "foo,bar")
@@ -0,0 +1,165 @@
+package lang
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"text/tabwriter"
+	"time"
+
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/codes"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/internal/errors"
+	"github.com/influxdata/flux/memory"
+	"github.com/influxdata/flux/plan"
+)
+
+// AppliedRule records a planner rule that rewrote a node of the plan.
+type AppliedRule struct {
+	Rule string
+	// Node is the ID of the node before it was rewritten.
+	Node plan.NodeID
+}
+
+// Explanation describes how a query was planned and,
+// if it was analyzed, how each node of the plan performed.
+type Explanation struct {
+	// LogicalPlan and PhysicalPlan are the formatted plans.
+	LogicalPlan  string
+	PhysicalPlan string
+
+	// LogicalRules and PhysicalRules are the rules that fired
+	// in the order they were applied.
+	LogicalRules  []AppliedRule
+	PhysicalRules []AppliedRule
+
+	// Analyzed reports whether the query was executed.
+	// The fields below are only set when it was.
+	Analyzed bool
+	// Profiles contains the numbers collected by the executor
+	// for each node of the physical plan.
+	Profiles []execute.NodeProfile
+	// Statistics are the statistics of the executed query.
+	Statistics flux.Statistics
+}
+
+// Explain plans the program without executing it and reports the
+// logical and physical plans with the rules that were applied to them.
+// If analyze is true, the query is also executed, its results are
+// discarded, and the runtime numbers for each node are reported.
+func (p *AstProgram) Explain(ctx context.Context, alloc *memory.Allocator, analyze bool) (*Explanation, error) {
+	sp, scope, err := p.getSpec(ctx, alloc)
+	if err != nil {
+		return nil, err
+	}
+	if err := p.updateOpts(scope); err != nil {
+		return nil, errors.Wrap(err, codes.Inherit, "error in reading options while explaining program")
+	}
+
+	e := &Explanation{}
+	lopts := append([]plan.LogicalOption{
+		plan.OnLogicalRuleApplied(func(rule plan.Rule, node plan.Node) {
+			e.LogicalRules = append(e.LogicalRules, AppliedRule{Rule: rule.Name(), Node: node.ID()})
+		}),
+	}, p.opts.planOptions.logical...)
+	popts := append([]plan.PhysicalOption{
+		plan.WithStatisticsEstimation(ctx),
+		plan.OnPhysicalRuleApplied(func(rule plan.Rule, node plan.Node) {
+			e.PhysicalRules = append(e.PhysicalRules, AppliedRule{Rule: rule.Name(), Node: node.ID()})
+		}),
+	}, p.opts.planOptions.physical...)
+
+	// The physical planner modifies the logical plan so it
+	// must be formatted before physical planning begins.
+	lp := plan.NewLogicalPlanner(lopts...)
+	ip, err := lp.CreateInitialPlan(sp)
+	if err != nil {
+		return nil, errors.Wrap(err, codes.Inherit, "error in building plan while explaining program")
+	}
+	ls, err := lp.Plan(ip)
+	if err != nil {
+		return nil, errors.Wrap(err, codes.Inherit, "error in building plan while explaining program")
+	}
+	e.LogicalPlan = fmt.Sprintf("%v", plan.Formatted(ls, plan.WithDetails()))
+
+	ps, err := plan.NewPhysicalPlanner(popts...).Plan(ls)
+	if err != nil {
+		return nil, errors.Wrap(err, codes.Inherit, "error in building plan while explaining program")
+	}
+	e.PhysicalPlan = fmt.Sprintf("%v", plan.Formatted(ps, plan.WithDetails(), plan.WithEstimates()))
+	p.PlanSpec = ps
+
+	if !analyze {
+		return e, nil
+	}
+
+	profiler := execute.NewProfiler()
+	start := time.Now()
+	q, err := p.Program.Start(execute.ContextWithProfiler(ctx, profiler), alloc)
+	if err != nil {
+		return nil, err
+	}
+	for res := range q.Results() {
+		if err := res.Tables().Do(func(tbl flux.Table) error {
+			return tbl.Do(func(flux.ColReader) error { return nil })
+		}); err != nil {
+			q.Cancel()
+			q.Done()
+			return nil, err
+		}
+	}
+	q.Done()
+	if err := q.Err(); err != nil {
+		return nil, err
+	}
+
+	e.Analyzed = true
+	e.Profiles = profiler.Profiles()
+	e.Statistics = q.Statistics()
+	e.Statistics.ExecuteDuration = time.Since(start)
+	return e, nil
+}
+
+// String formats the explanation as text for display.
+func (e *Explanation) String() string {
+	var buf bytes.Buffer
+	writeSection := func(title string, plan string, rules []AppliedRule) {
+		fmt.Fprintf(&buf, "%s:\n%s\n", title, plan)
+		if len(rules) == 0 {
+			buf.WriteString("Rules Applied: none\n\n")
+			return
+		}
+		buf.WriteString("Rules Applied:\n")
+		for _, r := range rules {
+			fmt.Fprintf(&buf, "  %s on %s\n", r.Rule, r.Node)
+		}
+		buf.WriteString("\n")
+	}
+	writeSection("Logical Plan", e.LogicalPlan, e.LogicalRules)
+	writeSection("Physical Plan", e.PhysicalPlan, e.PhysicalRules)
+
+	if !e.Analyzed {
+		return buf.String()
+	}
+
+	buf.WriteString("Profile:\n")
+	w := tabwriter.NewWriter(&buf, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(w, "  node\tkind\ttables in\trows in\ttables out\trows out\tallocated\tmax allocated\tduration\tqueued")
+	for _, np := range e.Profiles {
+		fmt.Fprintf(w, "  %s\t%s\t%d\t%d\t%d\t%d\t%d\t%d\t%v\t%v\n",
+			np.NodeID, np.Kind,
+			np.TablesIn, np.RowsIn,
+			np.TablesOut, np.RowsOut,
+			np.Allocated, np.MaxAllocated,
+			np.Duration, np.QueueDuration,
+		)
+	}
+	_ = w.Flush()
+	fmt.Fprintf(&buf, "\nExecute Duration: %v\nMax Allocated: %d\nTotal Allocated: %d\n",
+		e.Statistics.ExecuteDuration,
+		e.Statistics.MaxAllocated,
+		e.Statistics.TotalAllocated,
+	)
+	return buf.String()
+}
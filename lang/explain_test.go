@@ -0,0 +1,88 @@
+package lang_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/execute/executetest"
+	"github.com/influxdata/flux/lang"
+	"github.com/influxdata/flux/memory"
+	"github.com/influxdata/flux/plan"
+)
+
+func TestAstProgram_Explain(t *testing.T) {
+	script := `
+import "csv"
+
+data = "
+#datatype,string,long,string,double
+#group,false,false,true,false
+#default,_result,,,
+,result,table,t0,_value
+,,0,a,1.0
+,,0,a,2.0
+,,1,b,3.0
+"
+
+csv.from(csv: data)
+	|> filter(fn: (r) => true)
+	|> sum()
+`
+	for _, analyze := range []bool{false, true} {
+		program, err := lang.Compile(script, time.Unix(0, 0))
+		if err != nil {
+			t.Fatal(err)
+		}
+		ctx := executetest.NewTestExecuteDependencies().Inject(context.Background())
+		e, err := program.Explain(ctx, &memory.Allocator{}, analyze)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !strings.Contains(e.LogicalPlan, "filter1") {
+			t.Errorf("expected the filter in the logical plan:\n%s", e.LogicalPlan)
+		}
+		if strings.Contains(e.PhysicalPlan, "filter1") {
+			t.Errorf("expected the trivial filter to be removed:\n%s", e.PhysicalPlan)
+		}
+		wantRules := []lang.AppliedRule{{Rule: "RemoveTrivialFilterRule", Node: "filter1"}}
+		if !cmp.Equal(wantRules, e.PhysicalRules) {
+			t.Errorf("unexpected physical rules -want/+got:\n%s", cmp.Diff(wantRules, e.PhysicalRules))
+		}
+
+		if e.Analyzed != analyze {
+			t.Fatalf("unexpected analyzed flag: %v", e.Analyzed)
+		}
+		if !analyze {
+			if len(e.Profiles) != 0 {
+				t.Errorf("unexpected profiles: %v", e.Profiles)
+			}
+			continue
+		}
+
+		got := make(map[plan.NodeID]execute.NodeProfile)
+		for _, np := range e.Profiles {
+			np.Duration, np.QueueDuration, np.Allocated, np.MaxAllocated = 0, 0, 0, 0
+			got[np.NodeID] = np
+		}
+		want := map[plan.NodeID]execute.NodeProfile{
+			"fromCSV0": {NodeID: "fromCSV0", Kind: "fromCSV", TablesOut: 2, RowsOut: 3},
+			"sum2":     {NodeID: "sum2", Kind: "sum", TablesIn: 2, RowsIn: 3, TablesOut: 2, RowsOut: 2},
+		}
+		if !cmp.Equal(want, got) {
+			t.Errorf("unexpected profiles -want/+got:\n%s", cmp.Diff(want, got))
+		}
+		for id := range want {
+			if _, ok := e.Statistics.Metadata[execute.ProfileMetadataKey(id)]; !ok {
+				t.Errorf("missing profile metadata for node %s", id)
+			}
+		}
+		if !strings.Contains(e.String(), "Profile:") {
+			t.Errorf("expected the profile in the output:\n%s", e)
+		}
+	}
+}
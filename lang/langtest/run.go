@@ -0,0 +1,48 @@
+package langtest
+
+import (
+	"context"
+	"time"
+
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/execute/executetest"
+	"github.com/influxdata/flux/lang"
+	"github.com/influxdata/flux/memory"
+	"github.com/influxdata/flux/plan"
+)
+
+// RunScript compiles the script with the options and runs it with
+// the dependencies that are injected into the context.
+// It returns the normalized tables of all of the results
+// and the plan that was executed.
+func RunScript(ctx context.Context, script string, now time.Time, opts ...lang.CompileOption) ([]*executetest.Table, *plan.Spec, error) {
+	program, err := lang.Compile(script, now, opts...)
+	if err != nil {
+		return nil, nil, err
+	}
+	q, err := program.Start(ctx, &memory.Allocator{})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var tables []*executetest.Table
+	for res := range q.Results() {
+		if err := res.Tables().Do(func(tbl flux.Table) error {
+			cpy, err := executetest.ConvertTable(tbl)
+			if err != nil {
+				return err
+			}
+			tables = append(tables, cpy)
+			return nil
+		}); err != nil {
+			q.Done()
+			return nil, nil, err
+		}
+	}
+	q.Done()
+	if err := q.Err(); err != nil {
+		return nil, nil, err
+	}
+	executetest.NormalizeTables(tables)
+	return tables, program.PlanSpec, nil
+}
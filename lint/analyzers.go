@@ -0,0 +1,292 @@
+package lint
+
+import (
+	"strings"
+
+	"github.com/influxdata/flux/semantic"
+)
+
+// FilterPushdown reports a filter that follows aggregateWindow
+// when the predicate does not depend on the aggregated columns.
+// Placed before aggregateWindow, the filter can be pushed down to the source.
+var FilterPushdown = &Analyzer{
+	Name: "filterpushdown",
+	Doc:  "report filter calls after aggregateWindow that could be placed before it",
+	Run:  runFilterPushdown,
+}
+
+// aggregatedColumns are the columns that aggregateWindow changes.
+var aggregatedColumns = map[string]bool{
+	"_value": true,
+	"_time":  true,
+	"_start": true,
+	"_stop":  true,
+}
+
+func runFilterPushdown(pass *Pass) {
+	semantic.Walk(semantic.CreateVisitor(func(n semantic.Node) {
+		call, ok := n.(*semantic.CallExpression)
+		if !ok || !pass.isCall(call, "universe", "filter") {
+			return
+		}
+		pipe, ok := call.Pipe.(*semantic.CallExpression)
+		if !ok || !pass.isCall(pipe, "universe", "aggregateWindow") {
+			return
+		}
+		fn, ok := argument(call, "fn").(*semantic.FunctionExpression)
+		if !ok || len(fn.Block.Parameters.List) == 0 {
+			return
+		}
+		columns, ok := pass.recordColumns(fn)
+		if !ok {
+			return
+		}
+		for _, c := range columns {
+			if aggregatedColumns[c] {
+				return
+			}
+		}
+		pass.Reportf(call.Location(), "filter does not depend on the aggregated values and can be placed before aggregateWindow so it is pushed down")
+	}), pass.Pkg)
+}
+
+// recordColumns returns the columns that are read from the record
+// parameter of the function. It returns false when the record
+// is used in another way than reading one of its columns.
+func (p *Pass) recordColumns(fn *semantic.FunctionExpression) ([]string, bool) {
+	r := fn.Block.Parameters.List[0].Key
+	var obj *Object
+	for _, o := range p.objects {
+		if o.Kind == Parameter && o.Loc == r.Location() {
+			obj = o
+			break
+		}
+	}
+	if obj == nil {
+		return nil, false
+	}
+	var columns []string
+	for _, id := range p.refs[obj] {
+		m, ok := p.members[id]
+		if !ok {
+			return nil, false
+		}
+		columns = append(columns, m.Property)
+	}
+	return columns, true
+}
+
+// Unused reports variables and imports that are never used.
+// Variables that are declared in the package scope of a package
+// other than main are exported and are not reported.
+var Unused = &Analyzer{
+	Name: "unused",
+	Doc:  "report variables and imports that are declared and not used",
+	Run:  runUnused,
+}
+
+func runUnused(pass *Pass) {
+	main := isMain(pass.Pkg)
+	for _, obj := range pass.objects {
+		if len(pass.refs[obj]) > 0 || strings.HasPrefix(obj.Name, "_") {
+			continue
+		}
+		switch obj.Kind {
+		case Import:
+			pass.Reportf(obj.Loc, "%q imported and not used", obj.Path)
+		case Variable:
+			if obj.TopLevel && !main {
+				continue
+			}
+			pass.Reportf(obj.Loc, "%s declared and not used", obj.Name)
+		}
+	}
+}
+
+func isMain(pkg *semantic.Package) bool {
+	return pkg.Package == "" || pkg.Package == "main"
+}
+
+// MissingRange reports a call to from whose results are never limited with range.
+// Reading a bucket without a time range reads all of its data.
+var MissingRange = &Analyzer{
+	Name: "missingrange",
+	Doc:  "report from calls that are not followed by range",
+	Run:  runMissingRange,
+}
+
+func runMissingRange(pass *Pass) {
+	semantic.Walk(semantic.CreateVisitor(func(n semantic.Node) {
+		call, ok := n.(*semantic.CallExpression)
+		if !ok || !pass.isCall(call, "influxdata/influxdb", "from") {
+			return
+		}
+		if !pass.ranged(call, make(map[*Object]bool)) {
+			pass.Reportf(call.Location(), "from is not followed by range")
+		}
+	}), pass.Pkg)
+}
+
+// ranged reports whether the tables that are produced by the expression
+// are passed to range, or whether that cannot be determined.
+func (p *Pass) ranged(e semantic.Expression, seen map[*Object]bool) bool {
+	// Follow the pipe expressions to the end of the pipeline.
+	for {
+		call, ok := p.pipes[e]
+		if !ok {
+			break
+		}
+		if p.isCall(call, "universe", "range") {
+			return true
+		}
+		e = call
+	}
+
+	if p.statements[e] {
+		return false
+	}
+	obj, ok := p.inits[e]
+	if !ok {
+		// The tables are used in another way, for example
+		// returned from a function or passed as an argument.
+		return true
+	}
+	if seen[obj] {
+		return false
+	}
+	seen[obj] = true
+
+	refs := p.refs[obj]
+	if len(refs) == 0 {
+		// Variables in the package scope of a package other than main
+		// are exported and may be used by another package.
+		return obj.TopLevel && !isMain(p.Pkg)
+	}
+	for _, id := range refs {
+		if p.ranged(id, seen) {
+			return true
+		}
+	}
+	return false
+}
+
+// ShadowedImport reports declarations that hide an imported package.
+var ShadowedImport = &Analyzer{
+	Name: "shadowedimport",
+	Doc:  "report variables and parameters with the same name as an import",
+	Run:  runShadowedImport,
+}
+
+func runShadowedImport(pass *Pass) {
+	for _, obj := range pass.objects {
+		if imp := pass.shadows[obj]; imp != nil && obj.Kind != Import {
+			pass.Reportf(obj.Loc, "%s shadows the import of %q", obj.Name, imp.Path)
+		}
+	}
+}
+
+// DuplicateYield reports results that are yielded with the same name.
+var DuplicateYield = &Analyzer{
+	Name: "duplicateyield",
+	Doc:  "report yield calls that use a name that is already used",
+	Run:  runDuplicateYield,
+}
+
+// defaultYieldName is the name of a result when yield is called without one.
+const defaultYieldName = "_result"
+
+func runDuplicateYield(pass *Pass) {
+	names := make(map[string]*semantic.CallExpression)
+	semantic.Walk(semantic.CreateVisitor(func(n semantic.Node) {
+		call, ok := n.(*semantic.CallExpression)
+		if !ok || !pass.isCall(call, "universe", "yield") {
+			return
+		}
+		name := defaultYieldName
+		if arg := argument(call, "name"); arg != nil {
+			lit, ok := arg.(*semantic.StringLiteral)
+			if !ok {
+				return
+			}
+			name = lit.Value
+		}
+		if prev, ok := names[name]; ok {
+			pass.Reportf(call.Location(), "result %q is already yielded at %v", name, prev.Location().Start)
+			return
+		}
+		names[name] = call
+	}), pass.Pkg)
+}
+
+// Deprecated reports calls to deprecated functions
+// and calls that pass a deprecated parameter.
+var Deprecated = &Analyzer{
+	Name: "deprecated",
+	Doc:  "report uses of deprecated functions and parameters",
+	Run:  runDeprecated,
+}
+
+// Deprecation describes a function, or a parameter of a function,
+// that should no longer be used.
+type Deprecation struct {
+	Package  string
+	Function string
+	// Parameter is the deprecated parameter.
+	// When it is empty, the function is deprecated.
+	Parameter string
+	// Message tells what to use instead.
+	Message string
+}
+
+// Deprecations are the deprecated functions and parameters
+// that are reported by the Deprecated analyzer.
+var Deprecations = []Deprecation{
+	{
+		Package:   "universe",
+		Function:  "map",
+		Parameter: "mergeKey",
+		Message:   "the group key is no longer merged by default",
+	},
+}
+
+func runDeprecated(pass *Pass) {
+	semantic.Walk(semantic.CreateVisitor(func(n semantic.Node) {
+		call, ok := n.(*semantic.CallExpression)
+		if !ok {
+			return
+		}
+		path, name, ok := pass.Callee(call)
+		if !ok {
+			return
+		}
+		for _, d := range Deprecations {
+			if d.Package != path || d.Function != name {
+				continue
+			}
+			if d.Parameter == "" {
+				pass.Reportf(call.Location(), "%s is deprecated: %s", name, d.Message)
+			} else if argument(call, d.Parameter) != nil {
+				pass.Reportf(call.Location(), "parameter %s of %s is deprecated: %s", d.Parameter, name, d.Message)
+			}
+		}
+	}), pass.Pkg)
+}
+
+// isCall reports whether the call is a call to the function of the package.
+func (p *Pass) isCall(call *semantic.CallExpression, path, name string) bool {
+	cpath, cname, ok := p.Callee(call)
+	return ok && cpath == path && cname == name
+}
+
+// argument returns the value of the named argument of the call.
+func argument(call *semantic.CallExpression, name string) semantic.Expression {
+	if call.Arguments == nil {
+		return nil
+	}
+	for _, p := range call.Arguments.Properties {
+		if p.Key.Key() == name {
+			return p.Value
+		}
+	}
+	return nil
+}
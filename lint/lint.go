@@ -0,0 +1,118 @@
+// Package lint reports likely mistakes in Flux scripts.
+//
+// The checks are implemented as analyzers that walk the semantic graph
+// of a package. Each analyzer is run on its own so that they can be
+// enabled and disabled individually.
+package lint
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/ast"
+	"github.com/influxdata/flux/semantic"
+	"github.com/influxdata/flux/values"
+)
+
+// Analyzer is a check that reports diagnostics for a package.
+type Analyzer struct {
+	// Name identifies the analyzer. It is used to enable and disable it.
+	Name string
+	// Doc describes the mistake that the analyzer reports.
+	Doc string
+	// Run reports the diagnostics for the package of the pass.
+	Run func(pass *Pass)
+}
+
+// Analyzers returns all of the analyzers in the order they are run.
+func Analyzers() []*Analyzer {
+	return []*Analyzer{
+		FilterPushdown,
+		Unused,
+		MissingRange,
+		ShadowedImport,
+		DuplicateYield,
+		Deprecated,
+	}
+}
+
+// Lookup returns the analyzer with the name.
+func Lookup(name string) (*Analyzer, bool) {
+	for _, a := range Analyzers() {
+		if a.Name == name {
+			return a, true
+		}
+	}
+	return nil, false
+}
+
+// Diagnostic is a mistake that is reported by an analyzer.
+type Diagnostic struct {
+	Analyzer string
+	Loc      ast.SourceLocation
+	Message  string
+}
+
+func (d Diagnostic) String() string {
+	return fmt.Sprintf("%d:%d: %s (%s)", d.Loc.Start.Line, d.Loc.Start.Column, d.Message, d.Analyzer)
+}
+
+// Pass is the information that is given to an analyzer when it is run.
+type Pass struct {
+	Analyzer *Analyzer
+	// Pkg is the semantic graph of the package.
+	Pkg *semantic.Package
+	// Types is the solution to type inference for the package.
+	// It is nil when the package has type errors.
+	Types semantic.TypeSolution
+
+	*info
+	diagnostics []Diagnostic
+}
+
+// Reportf reports a diagnostic at the location.
+func (p *Pass) Reportf(loc ast.SourceLocation, format string, args ...interface{}) {
+	p.diagnostics = append(p.diagnostics, Diagnostic{
+		Analyzer: p.Analyzer.Name,
+		Loc:      loc,
+		Message:  fmt.Sprintf(format, args...),
+	})
+}
+
+// Run runs the analyzers on the package and returns their diagnostics
+// ordered by location. An error is returned when the package has
+// syntax errors or cannot be converted to a semantic graph.
+func Run(pkg *ast.Package, analyzers []*Analyzer) ([]Diagnostic, error) {
+	if ast.Check(pkg) > 0 {
+		return nil, ast.GetError(pkg)
+	}
+	sem, err := semantic.New(pkg)
+	if err != nil {
+		return nil, err
+	}
+	// Type errors are reported when the script is compiled
+	// so the analyzers that need types are skipped instead.
+	extern := values.BuildExternAssignments(sem, flux.Prelude())
+	types, err := semantic.InferTypes(extern, flux.StdLib())
+	if err != nil {
+		types = nil
+	}
+
+	info := resolve(sem)
+	var diagnostics []Diagnostic
+	for _, a := range analyzers {
+		pass := &Pass{
+			Analyzer: a,
+			Pkg:      sem,
+			Types:    types,
+			info:     info,
+		}
+		a.Run(pass)
+		diagnostics = append(diagnostics, pass.diagnostics...)
+	}
+	sort.SliceStable(diagnostics, func(i, j int) bool {
+		return diagnostics[i].Loc.Start.Less(diagnostics[j].Loc.Start)
+	})
+	return diagnostics, nil
+}
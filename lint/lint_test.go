@@ -0,0 +1,183 @@
+package lint_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	_ "github.com/influxdata/flux/builtin"
+	"github.com/influxdata/flux/lint"
+	"github.com/influxdata/flux/parser"
+)
+
+func TestAnalyzers(t *testing.T) {
+	testCases := []struct {
+		name     string
+		analyzer string
+		script   string
+		want     []string
+	}{
+		{
+			name:     "filter after aggregateWindow",
+			analyzer: "filterpushdown",
+			script: `from(bucket: "telegraf")
+	|> range(start: -1h)
+	|> aggregateWindow(every: 1m, fn: mean)
+	|> filter(fn: (r) => r._measurement == "cpu" and r.host == "a")`,
+			want: []string{
+				"4:5: filter does not depend on the aggregated values and can be placed before aggregateWindow so it is pushed down (filterpushdown)",
+			},
+		},
+		{
+			name:     "filter on aggregated values",
+			analyzer: "filterpushdown",
+			script: `from(bucket: "telegraf")
+	|> range(start: -1h)
+	|> aggregateWindow(every: 1m, fn: mean)
+	|> filter(fn: (r) => r._value > 10.0)`,
+		},
+		{
+			name:     "filter with record",
+			analyzer: "filterpushdown",
+			script: `f = (r) => r.host == "a"
+from(bucket: "telegraf")
+	|> range(start: -1h)
+	|> aggregateWindow(every: 1m, fn: mean)
+	|> filter(fn: (r) => f(r: r))`,
+		},
+		{
+			name:     "unused",
+			analyzer: "unused",
+			script: `import "strings"
+import "math"
+
+a = 1
+b = 2
+_c = 3
+f = (x) => {
+	y = x
+	z = 2
+	return y
+}
+f(x: b) + math.pi`,
+			want: []string{
+				`1:1: "strings" imported and not used (unused)`,
+				"4:1: a declared and not used (unused)",
+				"9:2: z declared and not used (unused)",
+			},
+		},
+		{
+			name:     "unused exported",
+			analyzer: "unused",
+			script: `package foo
+
+a = 1
+f = () => {
+	b = 1
+	return 2
+}`,
+			want: []string{
+				"5:2: b declared and not used (unused)",
+			},
+		},
+		{
+			name:     "options and tests",
+			analyzer: "unused",
+			script: `option now = () => 2020-01-01T00:00:00Z
+
+test t = () => ({input: 1, want: 1, fn: (tables=<-) => tables})`,
+		},
+		{
+			name:     "missing range",
+			analyzer: "missingrange",
+			script: `from(bucket: "a")
+	|> filter(fn: (r) => r._measurement == "cpu")
+	|> yield(name: "a")
+
+from(bucket: "b")
+	|> range(start: -1h)
+	|> yield(name: "b")`,
+			want: []string{
+				"1:1: from is not followed by range (missingrange)",
+			},
+		},
+		{
+			name:     "missing range through variables",
+			analyzer: "missingrange",
+			script: `a = from(bucket: "a")
+b = a |> filter(fn: (r) => r._measurement == "cpu")
+b |> range(start: -1h)
+
+c = from(bucket: "c")
+c |> yield(name: "c")
+
+f = () => from(bucket: "d")
+f() |> range(start: -1h)`,
+			want: []string{
+				"5:5: from is not followed by range (missingrange)",
+			},
+		},
+		{
+			name:     "shadowed import",
+			analyzer: "shadowedimport",
+			script: `import "strings"
+import s "strings"
+
+strings = 1
+f = (s) => s + strings`,
+			want: []string{
+				`4:1: strings shadows the import of "strings" (shadowedimport)`,
+				`5:6: s shadows the import of "strings" (shadowedimport)`,
+			},
+		},
+		{
+			name:     "duplicate yield",
+			analyzer: "duplicateyield",
+			script: `a = from(bucket: "a") |> range(start: -1h)
+a |> yield()
+a |> yield(name: "b")
+a |> yield(name: "_result")
+a |> yield(name: "b")`,
+			want: []string{
+				`4:6: result "_result" is already yielded at 2:6 (duplicateyield)`,
+				`5:6: result "b" is already yielded at 3:6 (duplicateyield)`,
+			},
+		},
+		{
+			name:     "deprecated parameter",
+			analyzer: "deprecated",
+			script: `from(bucket: "a")
+	|> range(start: -1h)
+	|> map(fn: (r) => ({r with _value: 1}), mergeKey: true)
+	|> map(fn: (r) => ({r with _value: 2}))`,
+			want: []string{
+				"3:5: parameter mergeKey of map is deprecated: the group key is no longer merged by default (deprecated)",
+			},
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			a, ok := lint.Lookup(tc.analyzer)
+			if !ok {
+				t.Fatalf("unknown analyzer %q", tc.analyzer)
+			}
+			diagnostics, err := lint.Run(parser.ParseSource(tc.script), []*lint.Analyzer{a})
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got []string
+			for _, d := range diagnostics {
+				got = append(got, d.String())
+			}
+			if !cmp.Equal(tc.want, got) {
+				t.Errorf("unexpected diagnostics -want/+got:\n%s", cmp.Diff(tc.want, got))
+			}
+		})
+	}
+}
+
+func TestRun_SyntaxError(t *testing.T) {
+	if _, err := lint.Run(parser.ParseSource("a = (1"), lint.Analyzers()); err == nil {
+		t.Fatal("expected an error")
+	}
+}
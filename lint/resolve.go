@@ -0,0 +1,241 @@
+package lint
+
+import (
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/ast"
+	"github.com/influxdata/flux/semantic"
+)
+
+// preludePackages are the packages whose names are in scope without
+// an import, listed in the order they are imported into the prelude.
+var preludePackages = []string{
+	"universe",
+	"influxdata/influxdb",
+}
+
+// ObjectKind is the kind of declaration that introduces a name.
+type ObjectKind int
+
+const (
+	Variable ObjectKind = iota
+	Parameter
+	Import
+)
+
+// Object is a name that is declared in the package.
+type Object struct {
+	Kind ObjectKind
+	Name string
+	// Loc is the location of the declaration.
+	Loc ast.SourceLocation
+	// Path is the path of an imported package.
+	Path string
+	// Init is the expression that is assigned to a variable.
+	Init semantic.Expression
+	// TopLevel reports whether the name is declared in the package scope.
+	TopLevel bool
+}
+
+// info is the result of resolving the names in a package.
+type info struct {
+	objects []*Object
+	uses    map[*semantic.IdentifierExpression]*Object
+	refs    map[*Object][]*semantic.IdentifierExpression
+
+	// pipes maps an expression to the call that it is piped into.
+	pipes map[semantic.Expression]*semantic.CallExpression
+	// members maps an identifier to the member expression that selects from it.
+	members map[*semantic.IdentifierExpression]*semantic.MemberExpression
+	// statements holds the expressions of the expression statements.
+	statements map[semantic.Expression]bool
+	// inits maps the initializer of a variable to the variable.
+	inits map[semantic.Expression]*Object
+	// shadows maps a declaration to the import that it hides.
+	shadows map[*Object]*Object
+}
+
+// Objects returns the names that are declared in the package
+// in the order they are declared.
+func (i *info) Objects() []*Object {
+	return i.objects
+}
+
+// ObjectOf returns the declaration the identifier refers to.
+// It returns nil for the names in the prelude.
+func (i *info) ObjectOf(id *semantic.IdentifierExpression) *Object {
+	return i.uses[id]
+}
+
+// Refs returns the identifiers that refer to the object.
+func (i *info) Refs(obj *Object) []*semantic.IdentifierExpression {
+	return i.refs[obj]
+}
+
+// Shadows returns the import that is hidden by the declaration.
+func (i *info) Shadows(obj *Object) *Object {
+	return i.shadows[obj]
+}
+
+// Callee returns the package path and the name of the function that is called.
+// It returns false when the function is not a member of a package,
+// for example when it is declared in the script.
+func (i *info) Callee(call *semantic.CallExpression) (path, name string, ok bool) {
+	switch callee := call.Callee.(type) {
+	case *semantic.IdentifierExpression:
+		if i.uses[callee] != nil {
+			return "", "", false
+		}
+		for _, path := range preludePackages {
+			pkg, ok := flux.StdLib().ImportPackageObject(path)
+			if !ok {
+				continue
+			}
+			if _, ok := pkg.Get(callee.Name); ok {
+				return path, callee.Name, true
+			}
+		}
+	case *semantic.MemberExpression:
+		id, ok := callee.Object.(*semantic.IdentifierExpression)
+		if !ok {
+			return "", "", false
+		}
+		if obj := i.uses[id]; obj != nil && obj.Kind == Import {
+			return obj.Path, callee.Property, true
+		}
+	}
+	return "", "", false
+}
+
+// resolve finds the declaration that each identifier refers to.
+func resolve(pkg *semantic.Package) *info {
+	i := &info{
+		uses:       make(map[*semantic.IdentifierExpression]*Object),
+		refs:       make(map[*Object][]*semantic.IdentifierExpression),
+		pipes:      make(map[semantic.Expression]*semantic.CallExpression),
+		members:    make(map[*semantic.IdentifierExpression]*semantic.MemberExpression),
+		statements: make(map[semantic.Expression]bool),
+		inits:      make(map[semantic.Expression]*Object),
+		shadows:    make(map[*Object]*Object),
+	}
+	r := &resolver{
+		info:  i,
+		scope: newScope(nil),
+		skip:  make(map[*semantic.NativeVariableAssignment]bool),
+	}
+	semantic.Walk(semantic.NewScopedVisitor(r), pkg)
+	return i
+}
+
+// scope maps the names that are declared in a block to their declarations.
+type scope struct {
+	parent *scope
+	names  map[string]*Object
+}
+
+func newScope(parent *scope) *scope {
+	return &scope{
+		parent: parent,
+		names:  make(map[string]*Object),
+	}
+}
+
+func (s *scope) lookup(name string) *Object {
+	for ; s != nil; s = s.parent {
+		if obj, ok := s.names[name]; ok {
+			return obj
+		}
+	}
+	return nil
+}
+
+// resolver walks the semantic graph while it tracks the names that are in scope.
+type resolver struct {
+	*info
+	scope *scope
+	// skip holds the assignments of options and tests,
+	// which do not declare a name that can be referred to.
+	skip map[*semantic.NativeVariableAssignment]bool
+}
+
+func (r *resolver) Nest() semantic.NestingVisitor {
+	return &resolver{
+		info:  r.info,
+		scope: newScope(r.scope),
+		skip:  r.skip,
+	}
+}
+
+func (r *resolver) Visit(n semantic.Node) semantic.Visitor {
+	switch n := n.(type) {
+	case *semantic.ImportDeclaration:
+		r.declareImport(n)
+	case *semantic.OptionStatement:
+		if a, ok := n.Assignment.(*semantic.NativeVariableAssignment); ok {
+			r.skip[a] = true
+		}
+	case *semantic.TestStatement:
+		r.skip[n.Assignment] = true
+	case *semantic.ExpressionStatement:
+		r.statements[n.Expression] = true
+	case *semantic.FunctionParameter:
+		r.declare(&Object{
+			Kind: Parameter,
+			Name: n.Key.Name,
+			Loc:  n.Key.Location(),
+		})
+	case *semantic.CallExpression:
+		if n.Pipe != nil {
+			r.pipes[n.Pipe] = n
+		}
+	case *semantic.MemberExpression:
+		if id, ok := n.Object.(*semantic.IdentifierExpression); ok {
+			r.members[id] = n
+		}
+	case *semantic.IdentifierExpression:
+		if obj := r.scope.lookup(n.Name); obj != nil {
+			r.uses[n] = obj
+			r.refs[obj] = append(r.refs[obj], n)
+		}
+	}
+	return r
+}
+
+func (r *resolver) Done(n semantic.Node) {
+	// Variables are in scope after their assignment
+	// so the assignment itself is resolved with the previous scope.
+	if n, ok := n.(*semantic.NativeVariableAssignment); ok && !r.skip[n] {
+		obj := &Object{
+			Kind: Variable,
+			Name: n.Identifier.Name,
+			Loc:  n.Identifier.Location(),
+			Init: n.Init,
+		}
+		r.declare(obj)
+		r.inits[n.Init] = obj
+	}
+}
+
+func (r *resolver) declare(obj *Object) {
+	obj.TopLevel = r.scope.parent == nil
+	if prev := r.scope.lookup(obj.Name); prev != nil && prev.Kind == Import {
+		r.shadows[obj] = prev
+	}
+	r.scope.names[obj.Name] = obj
+	r.objects = append(r.objects, obj)
+}
+
+func (r *resolver) declareImport(n *semantic.ImportDeclaration) {
+	obj := &Object{
+		Kind: Import,
+		Path: n.Path.Value,
+		Loc:  n.Location(),
+	}
+	if n.As != nil {
+		obj.Name = n.As.Name
+	} else if pkg, ok := flux.StdLib().Import(n.Path.Value); ok {
+		obj.Name = pkg.Name
+	} else {
+		return
+	}
+	r.declare(obj)
+}
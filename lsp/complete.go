@@ -0,0 +1,367 @@
+package lsp
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/ast"
+	"github.com/influxdata/flux/complete"
+	"github.com/influxdata/flux/semantic"
+	"github.com/influxdata/flux/values"
+)
+
+// completionTriggers are the characters that start a completion
+// of package members and function parameters.
+var completionTriggers = []string{".", "(", ","}
+
+// completion returns the suggestions for the position. It suggests
+// the members of a package after its name and a dot, the parameters
+// of a function where a call expects the name of an argument and
+// the names in scope otherwise. The text is used instead of the AST
+// because the document is usually incomplete while it is edited.
+func (d *document) completion(pos Position) *CompletionList {
+	before := d.text[:d.offset(pos)]
+	prefix := trailingIdent(before)
+	rest := before[:len(before)-len(prefix)]
+
+	var items []CompletionItem
+	if strings.HasSuffix(rest, ".") {
+		items = d.memberCompletions(trailingIdent(rest[:len(rest)-1]))
+	} else if call, ok := enclosingCall(rest); ok && call.expectsName {
+		items = d.parameterCompletions(call)
+	} else {
+		items = d.nameCompletions()
+	}
+
+	filtered := make([]CompletionItem, 0, len(items))
+	for _, item := range items {
+		if strings.HasPrefix(item.Label, prefix) {
+			filtered = append(filtered, item)
+		}
+	}
+	sort.SliceStable(filtered, func(i, j int) bool {
+		return filtered[i].Label < filtered[j].Label
+	})
+	return &CompletionList{Items: filtered}
+}
+
+// imports returns the import paths of the document by the name they are bound to.
+func (d *document) imports() map[string]string {
+	imports := make(map[string]string)
+	if len(d.pkg.Files) == 0 {
+		return imports
+	}
+	for _, imp := range d.pkg.Files[0].Imports {
+		if imp.Path == nil {
+			continue
+		}
+		if imp.As != nil {
+			imports[imp.As.Name] = imp.Path.Value
+		} else if pkg, ok := flux.StdLib().Import(imp.Path.Value); ok {
+			imports[pkg.Name] = imp.Path.Value
+		}
+	}
+	return imports
+}
+
+// packageCompleter returns a completer for the members of the package that is bound to name.
+func (d *document) packageCompleter(name string) (complete.Completer, bool) {
+	path, ok := d.imports()[name]
+	if !ok {
+		return complete.Completer{}, false
+	}
+	pkg, ok := flux.StdLib().ImportPackageObject(path)
+	if !ok {
+		return complete.Completer{}, false
+	}
+	return complete.NewCompleter(values.NewNestedScope(nil, pkg)), true
+}
+
+func (d *document) memberCompletions(name string) []CompletionItem {
+	c, ok := d.packageCompleter(name)
+	if !ok {
+		return nil
+	}
+	return valueCompletions(c)
+}
+
+// valueCompletions returns an item for each value of the completer.
+func valueCompletions(c complete.Completer) []CompletionItem {
+	names := c.Names()
+	items := make([]CompletionItem, 0, len(names))
+	for _, name := range names {
+		v, err := c.Value(name)
+		if err != nil {
+			continue
+		}
+		kind := CompletionKindVariable
+		if v.PolyType().Nature() == semantic.Function {
+			kind = CompletionKindFunction
+		}
+		items = append(items, CompletionItem{
+			Label:  name,
+			Kind:   kind,
+			Detail: fmt.Sprint(v.PolyType()),
+		})
+	}
+	return items
+}
+
+// nameCompletions suggests the names in the prelude,
+// the imported packages and the variables of the document.
+// The names in the document shadow the names in the prelude.
+func (d *document) nameCompletions() []CompletionItem {
+	names := make(map[string]CompletionItem)
+	for _, item := range valueCompletions(complete.DefaultCompleter()) {
+		names[item.Label] = item
+	}
+	for name, path := range d.imports() {
+		names[name] = CompletionItem{
+			Label:  name,
+			Kind:   CompletionKindModule,
+			Detail: fmt.Sprintf("import %q", path),
+		}
+	}
+	if len(d.pkg.Files) > 0 {
+		for _, stmt := range d.pkg.Files[0].Body {
+			var id *ast.Identifier
+			switch s := stmt.(type) {
+			case *ast.VariableAssignment:
+				id = s.ID
+			case *ast.OptionStatement:
+				if a, ok := s.Assignment.(*ast.VariableAssignment); ok {
+					id = a.ID
+				}
+			}
+			if id == nil {
+				continue
+			}
+			item := CompletionItem{
+				Label: id.Name,
+				Kind:  CompletionKindVariable,
+			}
+			if t, ok := d.variableType(id.Name); ok {
+				item.Detail = fmt.Sprint(t)
+				if t.Nature() == semantic.Function {
+					item.Kind = CompletionKindFunction
+				}
+			}
+			names[id.Name] = item
+		}
+	}
+	items := make([]CompletionItem, 0, len(names))
+	for _, item := range names {
+		items = append(items, item)
+	}
+	return items
+}
+
+// variableType returns the inferred type of a variable
+// that is assigned at the top level of the document.
+func (d *document) variableType(name string) (semantic.PolyType, bool) {
+	if d.sem == nil || d.types == nil {
+		return nil, false
+	}
+	for _, file := range d.sem.Files {
+		for _, stmt := range file.Body {
+			var a *semantic.NativeVariableAssignment
+			switch s := stmt.(type) {
+			case *semantic.NativeVariableAssignment:
+				a = s
+			case *semantic.OptionStatement:
+				a, _ = s.Assignment.(*semantic.NativeVariableAssignment)
+			}
+			if a == nil || a.Identifier.Name != name {
+				continue
+			}
+			t, err := d.types.PolyTypeOf(a.Init)
+			if err != nil {
+				return nil, false
+			}
+			return t, true
+		}
+	}
+	return nil, false
+}
+
+type functionType interface {
+	Signature() semantic.FunctionPolySignature
+}
+
+// signature returns the signature of the function that is called by callee,
+// which is either an identifier or a package member such as strings.toUpper.
+func (d *document) signature(callee string) (semantic.FunctionPolySignature, bool) {
+	var t semantic.PolyType
+	if i := strings.Index(callee, "."); i >= 0 {
+		c, ok := d.packageCompleter(callee[:i])
+		if !ok {
+			return semantic.FunctionPolySignature{}, false
+		}
+		v, err := c.Value(callee[i+1:])
+		if err != nil {
+			return semantic.FunctionPolySignature{}, false
+		}
+		t = v.PolyType()
+	} else if vt, ok := d.variableType(callee); ok {
+		t = vt
+	} else if v, err := complete.DefaultCompleter().Value(callee); err == nil {
+		t = v.PolyType()
+	}
+	ft, ok := t.(functionType)
+	if !ok {
+		return semantic.FunctionPolySignature{}, false
+	}
+	return ft.Signature(), true
+}
+
+// parameterCompletions suggests the parameters of the called function
+// that have not been given yet. The pipe parameter is not suggested.
+func (d *document) parameterCompletions(call callContext) []CompletionItem {
+	sig, ok := d.signature(call.callee)
+	if !ok {
+		return nil
+	}
+	var items []CompletionItem
+	for name, t := range sig.Parameters {
+		if name == sig.PipeArgument || call.given[name] {
+			continue
+		}
+		detail := fmt.Sprint(t)
+		if !isRequired(sig, name) {
+			detail += " (optional)"
+		}
+		items = append(items, CompletionItem{
+			Label:      name,
+			Kind:       CompletionKindField,
+			Detail:     detail,
+			InsertText: name + ": ",
+		})
+	}
+	return items
+}
+
+func isRequired(sig semantic.FunctionPolySignature, name string) bool {
+	for _, l := range sig.Required {
+		if l == name {
+			return true
+		}
+	}
+	return false
+}
+
+// callContext describes the call expression that encloses a position.
+type callContext struct {
+	// callee is the text of the called expression.
+	callee string
+	// given holds the names of the arguments before the position.
+	given map[string]bool
+	// expectsName reports whether the position is where an argument name is written.
+	expectsName bool
+}
+
+// enclosingCall finds the innermost call whose argument list is open
+// at the end of the text.
+func enclosingCall(text string) (callContext, bool) {
+	// open holds the offsets of the brackets that are not closed
+	// and args holds the offsets of the argument separators for each of them.
+	var (
+		open []int
+		args [][]int
+	)
+	scanBrackets(text, func(i int) {
+		switch text[i] {
+		case '(', '[', '{':
+			open = append(open, i)
+			args = append(args, nil)
+		case ')', ']', '}':
+			if len(open) > 0 {
+				open = open[:len(open)-1]
+				args = args[:len(args)-1]
+			}
+		case ',':
+			if len(args) > 0 {
+				args[len(args)-1] = append(args[len(args)-1], i)
+			}
+		}
+	})
+	if len(open) == 0 || text[open[len(open)-1]] != '(' {
+		return callContext{}, false
+	}
+	paren := open[len(open)-1]
+	callee := trailingCallee(strings.TrimRightFunc(text[:paren], unicode.IsSpace))
+	if callee == "" {
+		return callContext{}, false
+	}
+
+	call := callContext{
+		callee: callee,
+		given:  make(map[string]bool),
+	}
+	start := paren + 1
+	for _, end := range append(args[len(args)-1], len(text)) {
+		arg := text[start:end]
+		if j := strings.Index(arg, ":"); j > 0 {
+			call.given[strings.TrimSpace(arg[:j])] = true
+		}
+		start = end + 1
+	}
+	trimmed := strings.TrimRightFunc(text, unicode.IsSpace)
+	call.expectsName = strings.HasSuffix(trimmed, "(") || strings.HasSuffix(trimmed, ",")
+	return call, true
+}
+
+// scanBrackets calls fn with the offset of each bracket
+// and comma in the text that is not in a string or comment.
+func scanBrackets(text string, fn func(i int)) {
+	for i := 0; i < len(text); i++ {
+		switch text[i] {
+		case '"':
+			for i++; i < len(text) && text[i] != '"'; i++ {
+				if text[i] == '\\' {
+					i++
+				}
+			}
+		case '/':
+			if i+1 < len(text) && text[i+1] == '/' {
+				for i < len(text) && text[i] != '\n' {
+					i++
+				}
+			}
+		case '(', '[', '{', ')', ']', '}', ',':
+			fn(i)
+		}
+	}
+}
+
+// trailingCallee returns the identifier or member expression at the end of the text.
+func trailingCallee(text string) string {
+	start := len(text)
+	for {
+		id := trailingIdent(text[:start])
+		if id == "" {
+			return ""
+		}
+		start -= len(id)
+		if start == 0 || text[start-1] != '.' {
+			return text[start:]
+		}
+		start--
+	}
+}
+
+// trailingIdent returns the identifier at the end of the text.
+func trailingIdent(text string) string {
+	i := len(text)
+	for i > 0 {
+		r, size := utf8.DecodeLastRuneInString(text[:i])
+		if r != '_' && !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			break
+		}
+		i -= size
+	}
+	return text[i:]
+}
@@ -0,0 +1,281 @@
+package lsp
+
+import (
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/ast"
+	"github.com/influxdata/flux/parser"
+	"github.com/influxdata/flux/semantic"
+	"github.com/influxdata/flux/values"
+)
+
+// diagnosticSource is reported as the source of every diagnostic.
+const diagnosticSource = "flux"
+
+// document is an open Flux file and the result of analyzing it.
+type document struct {
+	uri  string
+	text string
+	// lines holds the byte offset at which each line starts.
+	lines []int
+
+	pkg *ast.Package
+	// syntaxErrors reports whether the file could not be parsed.
+	syntaxErrors bool
+	// sem is the semantic graph of the file. When the file has
+	// syntax errors, it holds the statements that could be parsed.
+	sem *semantic.Package
+	// types is the solution to type inference for sem.
+	// It is nil when sem has type errors.
+	types semantic.TypeSolution
+
+	diagnostics []Diagnostic
+}
+
+// newDocument parses the text and infers its types.
+// Parse and type errors are recorded as diagnostics.
+func newDocument(uri, text string) *document {
+	d := &document{
+		uri:         uri,
+		text:        text,
+		lines:       lineStarts(text),
+		diagnostics: []Diagnostic{},
+	}
+	d.analyze()
+	return d
+}
+
+func lineStarts(text string) []int {
+	lines := []int{0}
+	for i := 0; i < len(text); i++ {
+		if text[i] == '\n' {
+			lines = append(lines, i+1)
+		}
+	}
+	return lines
+}
+
+func (d *document) analyze() {
+	d.pkg = parser.ParseSource(d.text)
+	pkg := d.pkg
+	if ast.Check(d.pkg) > 0 {
+		d.syntaxErrors = true
+		ast.Walk(ast.CreateVisitor(func(n ast.Node) {
+			for _, err := range n.Errs() {
+				d.addDiagnostic(d.rangeOf(nodeLocation(n)), err.Msg)
+			}
+		}), d.pkg)
+		// The statements without errors are still analyzed
+		// so their types can be used while the document is edited.
+		pkg = validStatements(d.pkg)
+	}
+
+	sem, err := semantic.New(pkg)
+	if err != nil {
+		if !d.syntaxErrors {
+			d.addError(err)
+		}
+		return
+	}
+	d.sem = sem
+
+	extern := values.BuildExternAssignments(sem, flux.Prelude())
+	types, err := semantic.InferTypes(extern, flux.StdLib())
+	if err != nil {
+		if !d.syntaxErrors {
+			d.addError(err)
+		}
+		return
+	}
+	d.types = types
+}
+
+// nodeLocation returns the location of a node with errors.
+// The parser does not set the location of some invalid nodes
+// so the span of the nodes within them is used instead.
+func nodeLocation(n ast.Node) ast.SourceLocation {
+	loc := n.Location()
+	if loc.Start.Line > 0 {
+		return loc
+	}
+	ast.Walk(ast.CreateVisitor(func(c ast.Node) {
+		l := c.Location()
+		if l.Start.Line == 0 {
+			return
+		}
+		if loc.Start.Line == 0 || l.Start.Less(loc.Start) {
+			loc.Start = l.Start
+		}
+		if loc.End.Less(l.End) {
+			loc.End = l.End
+		}
+	}), n)
+	return loc
+}
+
+// validStatements returns a copy of the package
+// without the statements and imports that have errors.
+func validStatements(pkg *ast.Package) *ast.Package {
+	valid := pkg.Copy().(*ast.Package)
+	for _, file := range valid.Files {
+		imports := file.Imports[:0]
+		for _, imp := range file.Imports {
+			if ast.Check(imp) == 0 {
+				imports = append(imports, imp)
+			}
+		}
+		file.Imports = imports
+		body := file.Body[:0]
+		for _, stmt := range file.Body {
+			if ast.Check(stmt) == 0 {
+				body = append(body, stmt)
+			}
+		}
+		file.Body = body
+	}
+	return valid
+}
+
+func (d *document) addDiagnostic(r Range, msg string) {
+	d.diagnostics = append(d.diagnostics, Diagnostic{
+		Range:    r,
+		Severity: SeverityError,
+		Source:   diagnosticSource,
+		Message:  msg,
+	})
+}
+
+// errorLocation matches the location that is included
+// in the message of semantic and type errors.
+var errorLocation = regexp.MustCompile(`(\d+):(\d+)-(\d+):(\d+): `)
+
+// addError adds a diagnostic for an error from the semantic analysis.
+// The location in the message is used as the range of the diagnostic
+// and the start of the document is used when there is none.
+func (d *document) addError(err error) {
+	msg := err.Error()
+	m := errorLocation.FindStringSubmatchIndex(msg)
+	if m == nil {
+		d.addDiagnostic(Range{}, msg)
+		return
+	}
+	n := make([]int, 4)
+	for i := range n {
+		n[i], _ = strconv.Atoi(msg[m[2*i+2]:m[2*i+3]])
+	}
+	r := d.rangeOf(ast.SourceLocation{
+		Start: ast.Position{Line: n[0], Column: n[1]},
+		End:   ast.Position{Line: n[2], Column: n[3]},
+	})
+	d.addDiagnostic(r, strings.TrimSpace(msg[m[1]:]))
+}
+
+// format returns the edits that format the document with ast.Format.
+// No edits are returned when the document has syntax errors.
+func (d *document) format() []TextEdit {
+	if d.syntaxErrors || len(d.pkg.Files) == 0 {
+		return nil
+	}
+	formatted := ast.Format(d.pkg.Files[0])
+	if !strings.HasSuffix(formatted, "\n") {
+		formatted += "\n"
+	}
+	if formatted == d.text {
+		return []TextEdit{}
+	}
+	return []TextEdit{{
+		Range: Range{
+			Start: Position{},
+			End:   d.position(len(d.text)),
+		},
+		NewText: formatted,
+	}}
+}
+
+// offset converts a position to a byte offset into the text.
+// Positions past the end of a line are moved to the end of the line.
+func (d *document) offset(p Position) int {
+	if p.Line < 0 {
+		return 0
+	}
+	if p.Line >= len(d.lines) {
+		return len(d.text)
+	}
+	off := d.lines[p.Line]
+	for units := 0; off < len(d.text) && units < p.Character; {
+		r, size := utf8.DecodeRuneInString(d.text[off:])
+		if r == '\n' {
+			break
+		}
+		units += utf16Len(r)
+		off += size
+	}
+	return off
+}
+
+// position converts a byte offset into the text to a position.
+func (d *document) position(offset int) Position {
+	if offset > len(d.text) {
+		offset = len(d.text)
+	}
+	line := sort.Search(len(d.lines), func(i int) bool {
+		return d.lines[i] > offset
+	}) - 1
+	character := 0
+	for _, r := range d.text[d.lines[line]:offset] {
+		character += utf16Len(r)
+	}
+	return Position{Line: line, Character: character}
+}
+
+// astPosition converts a position to the line and byte column used by the AST.
+func (d *document) astPosition(p Position) ast.Position {
+	off := d.offset(p)
+	pos := d.position(off)
+	return ast.Position{
+		Line:   pos.Line + 1,
+		Column: off - d.lines[pos.Line] + 1,
+	}
+}
+
+// fromAST converts a position in the AST to a position.
+func (d *document) fromAST(p ast.Position) Position {
+	if p.Line < 1 {
+		return Position{}
+	}
+	if p.Line > len(d.lines) {
+		return d.position(len(d.text))
+	}
+	off := d.lines[p.Line-1]
+	if p.Column > 1 {
+		off += p.Column - 1
+	}
+	return d.position(off)
+}
+
+// rangeOf converts a source location to a range.
+func (d *document) rangeOf(loc ast.SourceLocation) Range {
+	return Range{
+		Start: d.fromAST(loc.Start),
+		End:   d.fromAST(loc.End),
+	}
+}
+
+func utf16Len(r rune) int {
+	if r >= 0x10000 {
+		return 2
+	}
+	return 1
+}
+
+// contains reports whether the position is within the location.
+// The end of the location is included so that a position
+// directly after an identifier refers to the identifier.
+func contains(loc ast.SourceLocation, p ast.Position) bool {
+	return !p.Less(loc.Start) && !loc.End.Less(p)
+}
@@ -0,0 +1,209 @@
+package lsp
+
+import (
+	"fmt"
+
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/ast"
+	"github.com/influxdata/flux/semantic"
+)
+
+// nodeAt returns the innermost identifier, identifier expression
+// or member expression in the semantic graph that contains the position.
+func (d *document) nodeAt(p ast.Position) semantic.Node {
+	var found semantic.Node
+	semantic.Walk(semantic.CreateVisitor(func(n semantic.Node) {
+		switch n.(type) {
+		case *semantic.Identifier,
+			*semantic.IdentifierExpression,
+			*semantic.MemberExpression:
+			if contains(n.Location(), p) {
+				found = n
+			}
+		}
+	}), d.sem)
+	return found
+}
+
+// hover returns the name and inferred type of the identifier at the position.
+func (d *document) hover(pos Position) *Hover {
+	if d.sem == nil || d.types == nil {
+		return nil
+	}
+	n := d.nodeAt(d.astPosition(pos))
+	if n == nil {
+		return nil
+	}
+
+	var name string
+	typed := n
+	switch n := n.(type) {
+	case *semantic.IdentifierExpression:
+		name = n.Name
+	case *semantic.MemberExpression:
+		name = n.Property
+	case *semantic.Identifier:
+		// Identifiers are not annotated with a type
+		// so the type is taken from their declaration.
+		name = n.Name
+		typed = d.declarationOf(n)
+	}
+	if typed == nil {
+		return nil
+	}
+	t, err := d.types.PolyTypeOf(typed)
+	if err != nil {
+		return nil
+	}
+	r := d.rangeOf(n.Location())
+	return &Hover{
+		Contents: MarkupContent{
+			Kind:  "markdown",
+			Value: fmt.Sprintf("```flux\n%s: %v\n```", name, t),
+		},
+		Range: &r,
+	}
+}
+
+// declarationOf returns the node that holds the type of a declared identifier.
+func (d *document) declarationOf(id *semantic.Identifier) semantic.Node {
+	var decl semantic.Node
+	semantic.Walk(semantic.CreateVisitor(func(n semantic.Node) {
+		switch n := n.(type) {
+		case *semantic.NativeVariableAssignment:
+			if n.Identifier == id {
+				decl = n.Init
+			}
+		case *semantic.FunctionParameter:
+			if n.Key == id {
+				decl = n
+			}
+		}
+	}), d.sem)
+	return decl
+}
+
+// definition returns the location where the identifier at the position is declared.
+// Identifiers that are declared outside of the document have no definition.
+func (d *document) definition(pos Position) *Location {
+	if d.sem == nil {
+		return nil
+	}
+	p := d.astPosition(pos)
+	r := &resolver{
+		pos:   p,
+		scope: newScope(nil),
+		found: new(resolution),
+	}
+	semantic.Walk(semantic.NewScopedVisitor(r), d.sem)
+	if !r.found.ok {
+		return nil
+	}
+	return &Location{
+		URI:   d.uri,
+		Range: d.rangeOf(r.found.loc),
+	}
+}
+
+// scope maps the names that are declared in a block to their locations.
+type scope struct {
+	parent *scope
+	names  map[string]ast.SourceLocation
+}
+
+func newScope(parent *scope) *scope {
+	return &scope{
+		parent: parent,
+		names:  make(map[string]ast.SourceLocation),
+	}
+}
+
+func (s *scope) lookup(name string) (ast.SourceLocation, bool) {
+	for ; s != nil; s = s.parent {
+		if loc, ok := s.names[name]; ok {
+			return loc, true
+		}
+	}
+	return ast.SourceLocation{}, false
+}
+
+// resolver walks the semantic graph while it tracks the names that are in scope
+// and finds the declaration of the identifier expression at a position.
+type resolver struct {
+	pos   ast.Position
+	scope *scope
+	// found is shared with the nested resolvers.
+	found *resolution
+}
+
+type resolution struct {
+	loc ast.SourceLocation
+	ok  bool
+}
+
+func (r *resolver) Nest() semantic.NestingVisitor {
+	return &resolver{
+		pos:   r.pos,
+		scope: newScope(r.scope),
+		found: r.found,
+	}
+}
+
+func (r *resolver) Visit(n semantic.Node) semantic.Visitor {
+	switch n := n.(type) {
+	case *semantic.ImportDeclaration:
+		r.declareImport(n)
+	case *semantic.FunctionParameter:
+		r.declare(n.Key)
+	case *semantic.FunctionParameters:
+		if n.Pipe != nil {
+			r.declare(n.Pipe)
+		}
+	case *semantic.Identifier:
+		// A declaration refers to itself.
+		if contains(n.Location(), r.pos) {
+			if loc, ok := r.scope.lookup(n.Name); ok && loc == n.Location() {
+				r.setFound(loc)
+			}
+		}
+	case *semantic.IdentifierExpression:
+		if contains(n.Location(), r.pos) {
+			if loc, ok := r.scope.lookup(n.Name); ok {
+				r.setFound(loc)
+			}
+		}
+	}
+	return r
+}
+
+func (r *resolver) Done(n semantic.Node) {
+	// Variables are in scope after their assignment
+	// so the assignment itself is resolved with the previous scope.
+	if n, ok := n.(*semantic.NativeVariableAssignment); ok {
+		r.declare(n.Identifier)
+		if contains(n.Identifier.Location(), r.pos) {
+			r.setFound(n.Identifier.Location())
+		}
+	}
+}
+
+func (r *resolver) setFound(loc ast.SourceLocation) {
+	r.found.loc = loc
+	r.found.ok = true
+}
+
+func (r *resolver) declare(id *semantic.Identifier) {
+	r.scope.names[id.Name] = id.Location()
+}
+
+func (r *resolver) declareImport(n *semantic.ImportDeclaration) {
+	if n.As != nil {
+		r.declare(n.As)
+		return
+	}
+	pkg, ok := flux.StdLib().Import(n.Path.Value)
+	if !ok {
+		return
+	}
+	r.scope.names[pkg.Name] = n.Location()
+}
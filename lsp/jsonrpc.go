@@ -0,0 +1,105 @@
+package lsp
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/textproto"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// conn reads and writes JSON-RPC messages that are framed
+// with a Content-Length header as described by the Language Server Protocol.
+type conn struct {
+	r *bufio.Reader
+
+	mu sync.Mutex
+	w  io.Writer
+}
+
+func newConn(r io.Reader, w io.Writer) *conn {
+	return &conn{
+		r: bufio.NewReader(r),
+		w: w,
+	}
+}
+
+// read returns the content of the next message.
+// It returns io.EOF when the input is closed between messages.
+func (c *conn) read() ([]byte, error) {
+	header, err := textproto.NewReader(c.r).ReadMIMEHeader()
+	if err != nil {
+		if err == io.EOF && len(header) == 0 {
+			return nil, io.EOF
+		}
+		return nil, fmt.Errorf("failed to read message header: %v", err)
+	}
+	length := header.Get("Content-Length")
+	if length == "" {
+		return nil, fmt.Errorf("message is missing the Content-Length header")
+	}
+	n, err := strconv.Atoi(strings.TrimSpace(length))
+	if err != nil || n < 0 {
+		return nil, fmt.Errorf("invalid Content-Length header %q", length)
+	}
+	data := make([]byte, n)
+	if _, err := io.ReadFull(c.r, data); err != nil {
+		return nil, fmt.Errorf("failed to read message content: %v", err)
+	}
+	return data, nil
+}
+
+// write encodes v as JSON and writes it as a single message.
+func (c *conn) write(v interface{}) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, err := fmt.Fprintf(c.w, "Content-Length: %d\r\n\r\n", len(data)); err != nil {
+		return err
+	}
+	_, err = c.w.Write(data)
+	return err
+}
+
+// reply writes the response to the request with the given id.
+// When err is not nil, an error response is written instead of the result.
+func (c *conn) reply(id *json.RawMessage, result interface{}, err error) error {
+	resp := response{
+		JSONRPC: "2.0",
+		ID:      id,
+	}
+	if err != nil {
+		rerr, ok := err.(*responseError)
+		if !ok {
+			rerr = &responseError{Code: codeInternalError, Message: err.Error()}
+		}
+		resp.Error = rerr
+		return c.write(resp)
+	}
+	data, err := json.Marshal(result)
+	if err != nil {
+		return err
+	}
+	raw := json.RawMessage(data)
+	resp.Result = &raw
+	return c.write(resp)
+}
+
+// notify writes a notification with the method and params.
+func (c *conn) notify(method string, params interface{}) error {
+	data, err := json.Marshal(params)
+	if err != nil {
+		return err
+	}
+	return c.write(request{
+		JSONRPC: "2.0",
+		Method:  method,
+		Params:  data,
+	})
+}
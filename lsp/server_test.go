@@ -0,0 +1,579 @@
+package lsp_test
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/textproto"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	_ "github.com/influxdata/flux/builtin"
+	"github.com/influxdata/flux/lsp"
+)
+
+const testURI = "file:///test.flux"
+
+type message struct {
+	ID     *int             `json:"id"`
+	Method string           `json:"method"`
+	Params json.RawMessage  `json:"params"`
+	Result json.RawMessage  `json:"result"`
+	Error  *json.RawMessage `json:"error"`
+}
+
+// client sends messages to a server that runs in the background.
+type client struct {
+	t        *testing.T
+	w        io.WriteCloser
+	messages chan message
+	done     chan error
+	nextID   int
+}
+
+func newClient(t *testing.T) *client {
+	sr, cw := io.Pipe()
+	cr, sw := io.Pipe()
+	c := &client{
+		t:        t,
+		w:        cw,
+		messages: make(chan message, 16),
+		done:     make(chan error, 1),
+	}
+	go func() {
+		s := lsp.NewServer(sr, sw, nil)
+		c.done <- s.Serve(context.Background())
+		_ = sw.Close()
+	}()
+	go func() {
+		defer close(c.messages)
+		r := bufio.NewReader(cr)
+		for {
+			header, err := textproto.NewReader(r).ReadMIMEHeader()
+			if err != nil {
+				return
+			}
+			n, _ := strconv.Atoi(header.Get("Content-Length"))
+			data := make([]byte, n)
+			if _, err := io.ReadFull(r, data); err != nil {
+				return
+			}
+			var m message
+			if err := json.Unmarshal(data, &m); err != nil {
+				t.Errorf("invalid message from server: %s", data)
+				return
+			}
+			c.messages <- m
+		}
+	}()
+	c.call("initialize", map[string]interface{}{}, nil)
+	c.notify("initialized", map[string]interface{}{})
+	return c
+}
+
+func (c *client) send(v interface{}) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		c.t.Fatal(err)
+	}
+	if _, err := fmt.Fprintf(c.w, "Content-Length: %d\r\n\r\n%s", len(data), data); err != nil {
+		c.t.Fatal(err)
+	}
+}
+
+func (c *client) notify(method string, params interface{}) {
+	c.send(map[string]interface{}{
+		"jsonrpc": "2.0",
+		"method":  method,
+		"params":  params,
+	})
+}
+
+// call sends a request and decodes the result of the response into result.
+// It returns the error of the response when there is one.
+func (c *client) call(method string, params interface{}, result interface{}) *json.RawMessage {
+	c.nextID++
+	id := c.nextID
+	c.send(map[string]interface{}{
+		"jsonrpc": "2.0",
+		"id":      id,
+		"method":  method,
+		"params":  params,
+	})
+	m := c.next(func(m message) bool { return m.ID != nil && *m.ID == id })
+	if m.Error != nil {
+		return m.Error
+	}
+	if result != nil {
+		if err := json.Unmarshal(m.Result, result); err != nil {
+			c.t.Fatalf("invalid result %s: %v", m.Result, err)
+		}
+	}
+	return nil
+}
+
+// next returns the next message that matches the predicate.
+func (c *client) next(match func(m message) bool) message {
+	timeout := time.After(10 * time.Second)
+	for {
+		select {
+		case m, ok := <-c.messages:
+			if !ok {
+				c.t.Fatal("server closed the connection")
+			}
+			if match(m) {
+				return m
+			}
+		case <-timeout:
+			c.t.Fatal("timed out waiting for a message")
+		}
+	}
+}
+
+// open opens a document and returns the diagnostics that are published for it.
+func (c *client) open(text string) []lsp.Diagnostic {
+	c.notify("textDocument/didOpen", lsp.DidOpenTextDocumentParams{
+		TextDocument: lsp.TextDocumentItem{
+			URI:        testURI,
+			LanguageID: "flux",
+			Version:    1,
+			Text:       text,
+		},
+	})
+	return c.diagnostics()
+}
+
+func (c *client) diagnostics() []lsp.Diagnostic {
+	m := c.next(func(m message) bool { return m.Method == "textDocument/publishDiagnostics" })
+	var params lsp.PublishDiagnosticsParams
+	if err := json.Unmarshal(m.Params, &params); err != nil {
+		c.t.Fatal(err)
+	}
+	return params.Diagnostics
+}
+
+func (c *client) close() {
+	c.call("shutdown", nil, nil)
+	c.notify("exit", nil)
+	if err := <-c.done; err != nil {
+		c.t.Fatalf("unexpected error from server: %v", err)
+	}
+	_ = c.w.Close()
+}
+
+func position(line, character int) lsp.TextDocumentPositionParams {
+	return lsp.TextDocumentPositionParams{
+		TextDocument: lsp.TextDocumentIdentifier{URI: testURI},
+		Position:     lsp.Position{Line: line, Character: character},
+	}
+}
+
+func TestServer_Initialize(t *testing.T) {
+	c := newClient(t)
+	defer c.close()
+
+	var got lsp.InitializeResult
+	if err := c.call("initialize", map[string]interface{}{}, &got); err != nil {
+		t.Fatalf("unexpected error: %s", *err)
+	}
+	want := lsp.ServerCapabilities{
+		TextDocumentSync:           lsp.TextDocumentSyncKindFull,
+		CompletionProvider:         &lsp.CompletionOptions{TriggerCharacters: []string{".", "(", ","}},
+		HoverProvider:              true,
+		DefinitionProvider:         true,
+		DocumentFormattingProvider: true,
+	}
+	if !cmp.Equal(want, got.Capabilities) {
+		t.Errorf("unexpected capabilities -want/+got:\n%s", cmp.Diff(want, got.Capabilities))
+	}
+}
+
+func TestServer_Diagnostics(t *testing.T) {
+	testCases := []struct {
+		name string
+		text string
+		want []lsp.Diagnostic
+	}{
+		{
+			name: "valid",
+			text: "x = 1\ny = x + 1\n",
+			want: []lsp.Diagnostic{},
+		},
+		{
+			name: "parse error",
+			text: "x = 1 +\n",
+			want: []lsp.Diagnostic{{
+				Range: lsp.Range{
+					Start: lsp.Position{Line: 0, Character: 4},
+					End:   lsp.Position{Line: 0, Character: 5},
+				},
+				Severity: lsp.SeverityError,
+				Source:   "flux",
+				Message:  "missing right hand side of expression",
+			}},
+		},
+		{
+			name: "type error",
+			text: "import \"strings\"\n\nx = strings.toUpper(v: 1)\n",
+			want: []lsp.Diagnostic{{
+				Range: lsp.Range{
+					Start: lsp.Position{Line: 2, Character: 4},
+					End:   lsp.Position{Line: 2, Character: 25},
+				},
+				Severity: lsp.SeverityError,
+				Source:   "flux",
+				Message:  "string != int",
+			}},
+		},
+		{
+			name: "undefined identifier",
+			text: "x = y\n",
+			want: []lsp.Diagnostic{{
+				Range: lsp.Range{
+					Start: lsp.Position{Line: 0, Character: 4},
+					End:   lsp.Position{Line: 0, Character: 5},
+				},
+				Severity: lsp.SeverityError,
+				Source:   "flux",
+				Message:  `undefined identifier "y"`,
+			}},
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			c := newClient(t)
+			defer c.close()
+
+			if got := c.open(tc.text); !cmp.Equal(tc.want, got) {
+				t.Errorf("unexpected diagnostics -want/+got:\n%s", cmp.Diff(tc.want, got))
+			}
+		})
+	}
+}
+
+func TestServer_DidChange(t *testing.T) {
+	c := newClient(t)
+	defer c.close()
+
+	if got := c.open("x = \n"); len(got) == 0 {
+		t.Fatal("expected diagnostics for the invalid document")
+	}
+	c.notify("textDocument/didChange", lsp.DidChangeTextDocumentParams{
+		TextDocument:   lsp.VersionedTextDocumentIdentifier{URI: testURI, Version: 2},
+		ContentChanges: []lsp.TextDocumentContentChangeEvent{{Text: "x = 1\n"}},
+	})
+	if got := c.diagnostics(); len(got) != 0 {
+		t.Fatalf("unexpected diagnostics: %v", got)
+	}
+}
+
+func labels(list lsp.CompletionList) []string {
+	labels := make([]string, 0, len(list.Items))
+	for _, item := range list.Items {
+		labels = append(labels, item.Label)
+	}
+	return labels
+}
+
+func TestServer_Completion(t *testing.T) {
+	testCases := []struct {
+		name string
+		text string
+		// line and character are the position of the completion.
+		line, character int
+		want            []string
+	}{
+		{
+			name:      "package members",
+			text:      "import \"strings\"\n\nx = strings.toU",
+			line:      2,
+			character: 15,
+			want:      []string{"toUpper"},
+		},
+		{
+			name:      "aliased package",
+			text:      "import s \"strings\"\n\nx = s.trimS",
+			line:      2,
+			character: 11,
+			want:      []string{"trimSpace", "trimSuffix"},
+		},
+		{
+			name:      "function parameters",
+			text:      "from(bucket: \"b\")\n    |> range(",
+			line:      1,
+			character: 13,
+			want:      []string{"start", "startColumn", "stop", "stopColumn", "timeColumn"},
+		},
+		{
+			name:      "remaining parameters",
+			text:      "from(bucket: \"b\")\n    |> range(start: -1h, st",
+			line:      1,
+			character: 27,
+			want:      []string{"startColumn", "stop", "stopColumn"},
+		},
+		{
+			name:      "package function parameters",
+			text:      "import \"strings\"\n\nx = strings.replace(v: \"a, b\", ",
+			line:      2,
+			character: 31,
+			want:      []string{"i", "t", "u"},
+		},
+		{
+			name:      "argument value",
+			text:      "limit = 10\nx = from(bucket: \"b\") |> limit(n: lim",
+			line:      1,
+			character: 37,
+			want:      []string{"limit"},
+		},
+		{
+			name:      "prelude",
+			text:      "x = fil",
+			line:      0,
+			character: 7,
+			want:      []string{"fill", "filter"},
+		},
+		{
+			name:      "user function parameters",
+			text:      "add = (a, b=1) => a + b\nx = add(",
+			line:      1,
+			character: 8,
+			want:      []string{"a", "b"},
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			c := newClient(t)
+			defer c.close()
+			c.open(tc.text)
+
+			var got lsp.CompletionList
+			if err := c.call("textDocument/completion", position(tc.line, tc.character), &got); err != nil {
+				t.Fatalf("unexpected error: %s", *err)
+			}
+			if !cmp.Equal(tc.want, labels(got)) {
+				t.Errorf("unexpected completion -want/+got:\n%s", cmp.Diff(tc.want, labels(got)))
+			}
+		})
+	}
+}
+
+func TestServer_CompletionDetail(t *testing.T) {
+	c := newClient(t)
+	defer c.close()
+	c.open("x = range(")
+
+	var got lsp.CompletionList
+	if err := c.call("textDocument/completion", position(0, 10), &got); err != nil {
+		t.Fatalf("unexpected error: %s", *err)
+	}
+	want := lsp.CompletionItem{
+		Label:      "start",
+		Kind:       lsp.CompletionKindField,
+		Detail:     "t1",
+		InsertText: "start: ",
+	}
+	if !cmp.Equal(want, got.Items[0]) {
+		t.Errorf("unexpected completion item -want/+got:\n%s", cmp.Diff(want, got.Items[0]))
+	}
+	if got, want := got.Items[1].Detail, "string (optional)"; got != want {
+		t.Errorf("unexpected detail for optional parameter: want %q, got %q", want, got)
+	}
+}
+
+func TestServer_Hover(t *testing.T) {
+	text := `import "strings"
+
+x = 1
+y = x + 2
+f = (s) => strings.toUpper(v: s)
+`
+	testCases := []struct {
+		name            string
+		line, character int
+		want            string
+	}{
+		{
+			name:      "variable",
+			line:      3,
+			character: 4,
+			want:      "x: int",
+		},
+		{
+			name:      "declaration",
+			line:      3,
+			character: 0,
+			want:      "y: int",
+		},
+		{
+			name:      "package member",
+			line:      4,
+			character: 22,
+			want:      "toUpper: (^v: string) -> string",
+		},
+		{
+			name:      "parameter",
+			line:      4,
+			character: 5,
+			want:      "s: string",
+		},
+	}
+	c := newClient(t)
+	defer c.close()
+	c.open(text)
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			var got *lsp.Hover
+			if err := c.call("textDocument/hover", position(tc.line, tc.character), &got); err != nil {
+				t.Fatalf("unexpected error: %s", *err)
+			}
+			if got == nil {
+				t.Fatal("expected a hover result")
+			}
+			want := "```flux\n" + tc.want + "\n```"
+			if got.Contents.Value != want {
+				t.Errorf("unexpected hover -want/+got:\n\t- %q\n\t+ %q", want, got.Contents.Value)
+			}
+		})
+	}
+
+	var got *lsp.Hover
+	if err := c.call("textDocument/hover", position(2, 4), &got); err != nil {
+		t.Fatalf("unexpected error: %s", *err)
+	}
+	if got != nil {
+		t.Errorf("expected no hover for a literal, got %v", got.Contents.Value)
+	}
+}
+
+func TestServer_Definition(t *testing.T) {
+	text := `import str "strings"
+
+x = 1
+f = (x) => x + 1
+y = f(x: x)
+z = str.toUpper(v: "a")
+a = "a"
+b = ["🙂", a]
+`
+	rng := func(line, start, end int) *lsp.Location {
+		return &lsp.Location{
+			URI: testURI,
+			Range: lsp.Range{
+				Start: lsp.Position{Line: line, Character: start},
+				End:   lsp.Position{Line: line, Character: end},
+			},
+		}
+	}
+	testCases := []struct {
+		name            string
+		line, character int
+		want            *lsp.Location
+	}{
+		{
+			name:      "variable",
+			line:      4,
+			character: 9,
+			want:      rng(2, 0, 1),
+		},
+		{
+			name:      "function",
+			line:      4,
+			character: 4,
+			want:      rng(3, 0, 1),
+		},
+		{
+			name:      "parameter shadows variable",
+			line:      3,
+			character: 11,
+			want:      rng(3, 5, 6),
+		},
+		{
+			name:      "import alias",
+			line:      5,
+			character: 5,
+			want:      rng(0, 7, 10),
+		},
+		{
+			name:      "declaration",
+			line:      2,
+			character: 0,
+			want:      rng(2, 0, 1),
+		},
+		{
+			name:      "builtin",
+			line:      5,
+			character: 10,
+			want:      nil,
+		},
+		{
+			// The emoji is two UTF-16 code units and four bytes long.
+			name:      "after multibyte characters",
+			line:      7,
+			character: 11,
+			want:      rng(6, 0, 1),
+		},
+	}
+	c := newClient(t)
+	defer c.close()
+	c.open(text)
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			var got *lsp.Location
+			if err := c.call("textDocument/definition", position(tc.line, tc.character), &got); err != nil {
+				t.Fatalf("unexpected error: %s", *err)
+			}
+			if !cmp.Equal(tc.want, got) {
+				t.Errorf("unexpected definition -want/+got:\n%s", cmp.Diff(tc.want, got))
+			}
+		})
+	}
+}
+
+func TestServer_Formatting(t *testing.T) {
+	c := newClient(t)
+	defer c.close()
+	c.open("x=1\nfrom(bucket:\"b\")|>range(start:-1h)")
+
+	params := lsp.DocumentFormattingParams{
+		TextDocument: lsp.TextDocumentIdentifier{URI: testURI},
+	}
+	var got []lsp.TextEdit
+	if err := c.call("textDocument/formatting", params, &got); err != nil {
+		t.Fatalf("unexpected error: %s", *err)
+	}
+	want := []lsp.TextEdit{{
+		Range: lsp.Range{
+			Start: lsp.Position{Line: 0, Character: 0},
+			End:   lsp.Position{Line: 1, Character: 34},
+		},
+		NewText: "x = 1\n\nfrom(bucket: \"b\")\n\t|> range(start: -1h)\n",
+	}}
+	if !cmp.Equal(want, got) {
+		t.Errorf("unexpected edits -want/+got:\n%s", cmp.Diff(want, got))
+	}
+}
+
+func TestServer_Errors(t *testing.T) {
+	c := newClient(t)
+	defer c.close()
+
+	if err := c.call("workspace/symbol", map[string]interface{}{}, nil); err == nil {
+		t.Error("expected an error for an unsupported method")
+	} else if !strings.Contains(string(*err), "-32601") {
+		t.Errorf("unexpected error: %s", *err)
+	}
+	if err := c.call("textDocument/hover", position(0, 0), nil); err == nil {
+		t.Error("expected an error for a document that is not open")
+	} else if !strings.Contains(string(*err), "document is not open") {
+		t.Errorf("unexpected error: %s", *err)
+	}
+}
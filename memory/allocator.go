@@ -76,7 +76,7 @@ func (a *Allocator) Reallocate(size int, b []byte) []byte {
 	}
 
 	sizediff := size - cap(b)
-	if err := a.Account(sizediff); err != nil {
+	if err := a.count(sizediff); err != nil {
 		panic(err)
 	}
 
@@ -87,10 +87,20 @@ func (a *Allocator) Reallocate(size int, b []byte) []byte {
 // Account will manually account for the amount of memory being used.
 // This is typically used for memory that is allocated outside of the
 // Allocator that must be recorded in some way.
+//
+// If the underlying allocator is itself an Allocator, the memory
+// is accounted for by it too. This allows an Allocator to track the
+// memory used by one part of a query while the parent Allocator
+// enforces the limit for the entire query.
 func (a *Allocator) Account(size int) error {
 	if size == 0 {
 		return nil
 	}
+	if parent, ok := a.Allocator.(*Allocator); ok {
+		if err := parent.Account(size); err != nil {
+			return err
+		}
+	}
 	return a.count(size)
 }
 
@@ -111,6 +121,20 @@ func (a *Allocator) TotalAllocated() int64 {
 	return atomic.LoadInt64(&a.totalAllocated)
 }
 
+// NearLimit reports whether the currently allocated memory has reached
+// the given fraction of the limit. It always returns false if there
+// is no limit. Memory that a Manager may still be able to provide
+// is not taken into account.
+func (a *Allocator) NearLimit(fraction float64) bool {
+	if a == nil || a.Limit == nil {
+		return false
+	}
+	a.mu.Lock()
+	limit := *a.Limit
+	a.mu.Unlock()
+	return float64(a.Allocated()) >= fraction*float64(limit)
+}
+
 // Free will reduce the amount of memory used by this Allocator.
 // In general, memory should be freed using the Reference returned
 // by Allocate. Not all code is capable of using this though so this
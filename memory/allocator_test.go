@@ -206,6 +206,68 @@ func TestAllocator_Free(t *testing.T) {
 	}
 }
 
+func TestAllocator_Parent(t *testing.T) {
+	mem := arrowmemory.NewCheckedAllocator(memory.DefaultAllocator)
+	defer mem.AssertSize(t, 0)
+
+	limit := int64(128)
+	parent := &memory.Allocator{Limit: &limit, Allocator: mem}
+	child := &memory.Allocator{Allocator: parent}
+
+	b := child.Allocate(64)
+	b = child.Reallocate(96, b)
+	if err := child.Account(16); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	mem.AssertSize(t, 96)
+	for _, a := range []*memory.Allocator{parent, child} {
+		if want, got := int64(112), a.Allocated(); want != got {
+			t.Fatalf("unexpected allocated count -want/+got\n\t- %d\n\t+ %d", want, got)
+		}
+	}
+
+	// The limit of the parent applies to the child.
+	if err := child.Account(32); err == nil {
+		t.Fatal("expected error")
+	} else if want, got := codes.ResourceExhausted, errors.Code(err); want != got {
+		t.Fatalf("unexpected error code -want/+got\n\t- %v\n\t+ %v", want, got)
+	}
+
+	child.Free(b)
+	_ = child.Account(-16)
+	for _, a := range []*memory.Allocator{parent, child} {
+		if want, got := int64(0), a.Allocated(); want != got {
+			t.Fatalf("unexpected allocated count -want/+got\n\t- %d\n\t+ %d", want, got)
+		}
+		if want, got := int64(112), a.MaxAllocated(); want != got {
+			t.Fatalf("unexpected max allocated count -want/+got\n\t- %d\n\t+ %d", want, got)
+		}
+	}
+}
+
+func TestAllocator_NearLimit(t *testing.T) {
+	if (&memory.Allocator{}).NearLimit(0.5) {
+		t.Fatal("allocator without a limit should never be near its limit")
+	}
+
+	limit := int64(128)
+	allocator := &memory.Allocator{Limit: &limit}
+	if err := allocator.Account(63); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if allocator.NearLimit(0.5) {
+		t.Fatal("allocator should not be near its limit")
+	}
+
+	if err := allocator.Account(1); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if !allocator.NearLimit(0.5) {
+		t.Fatal("allocator should be near its limit")
+	}
+}
+
 type MockMemoryManager struct {
 	Left      int64
 	RequestFn func(want int64) int64
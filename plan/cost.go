@@ -1,10 +1,80 @@
 package plan
 
+import (
+	"context"
+	"math"
+)
+
+// UnknownCardinality is used for statistics that cannot be estimated.
+const UnknownCardinality = -1
+
+// AssumedCardinality is the number of rows assumed for data
+// with an unknown cardinality when costs are compared.
+// It is intentionally large so that the planner prefers
+// alternatives that do not depend on the input being small.
+const AssumedCardinality = 1 << 20
+
+// Statistics are the estimated properties of the data produced by a plan node.
 type Statistics struct {
-	Cardinality      int64
+	// Cardinality is the estimated number of rows.
+	Cardinality int64
+	// GroupCardinality is the estimated number of tables.
 	GroupCardinality int64
 }
 
+// UnknownStatistics returns the statistics for data that cannot be estimated.
+func UnknownStatistics() Statistics {
+	return Statistics{
+		Cardinality:      UnknownCardinality,
+		GroupCardinality: UnknownCardinality,
+	}
+}
+
+// IsKnown reports whether the number of rows has been estimated.
+func (s Statistics) IsKnown() bool {
+	return s.Cardinality >= 0
+}
+
+// Rows returns the estimated number of rows, or AssumedCardinality
+// when the cardinality is unknown.
+func (s Statistics) Rows() int64 {
+	if !s.IsKnown() {
+		return AssumedCardinality
+	}
+	return s.Cardinality
+}
+
+// Groups returns the estimated number of tables, or one
+// when the group cardinality is unknown.
+func (s Statistics) Groups() int64 {
+	if s.GroupCardinality <= 0 {
+		return 1
+	}
+	return s.GroupCardinality
+}
+
+// SumStatistics combines the statistics of several inputs into the statistics
+// for their union. The result is unknown if any of the inputs are unknown.
+func SumStatistics(stats []Statistics) Statistics {
+	if len(stats) == 0 {
+		return UnknownStatistics()
+	}
+	var sum Statistics
+	for _, s := range stats {
+		if s.Cardinality < 0 || sum.Cardinality < 0 {
+			sum.Cardinality = UnknownCardinality
+		} else {
+			sum.Cardinality += s.Cardinality
+		}
+		if s.GroupCardinality < 0 || sum.GroupCardinality < 0 {
+			sum.GroupCardinality = UnknownCardinality
+		} else {
+			sum.GroupCardinality += s.GroupCardinality
+		}
+	}
+	return sum
+}
+
 // Cost stores various dimensions of the cost of a query plan
 type Cost struct {
 	Disk int64
@@ -25,9 +95,120 @@ func Add(a Cost, b Cost) Cost {
 	}
 }
 
+// Total collapses the dimensions of a cost into a single value
+// so that the costs of alternative plans can be compared.
+func (c Cost) Total() int64 {
+	return c.Disk + c.CPU + c.GPU + c.MEM + c.NET
+}
+
+// NLogN returns n * log2(n) which is the cost of sorting n rows.
+func NLogN(n int64) int64 {
+	if n <= 1 {
+		return n
+	}
+	return int64(float64(n) * math.Log2(float64(n)))
+}
+
+// DefaultCost is the cost of a procedure that visits each row of its
+// inputs once and produces the same number of rows.
+// Sources that embed it report unknown statistics.
 type DefaultCost struct {
 }
 
 func (c DefaultCost) Cost(inStats []Statistics) (Cost, Statistics) {
-	return Cost{}, Statistics{}
+	stats := SumStatistics(inStats)
+	return Cost{CPU: stats.Rows()}, stats
+}
+
+// AggregateCost is the cost of a procedure that visits each row of its
+// inputs once and produces a single row for each table.
+type AggregateCost struct {
+}
+
+func (c AggregateCost) Cost(inStats []Statistics) (Cost, Statistics) {
+	stats := SumStatistics(inStats)
+	cost := Cost{CPU: stats.Rows()}
+	if stats.GroupCardinality < 0 {
+		return cost, UnknownStatistics()
+	}
+	return cost, Statistics{
+		Cardinality:      stats.GroupCardinality,
+		GroupCardinality: stats.GroupCardinality,
+	}
+}
+
+// StatisticsEstimator is an optional interface that procedure specs
+// can implement when estimating their statistics requires I/O,
+// such as asking a database to explain a query.
+//
+// Estimating statistics is opt-in. The physical planner only calls
+// SetEstimationContext when it is created WithStatisticsEstimation,
+// and the spec must not perform any I/O until EstimateStatistics is called.
+// Estimate calls EstimateStatistics when it reaches the spec, so the I/O
+// is only performed when a cost-based rule or the formatter asks for
+// the estimates of a plan. The spec is expected to estimate its statistics
+// at most once and to report the result from Cost.
+// Failing to produce an estimate is not an error; the spec should
+// report unknown statistics instead.
+type StatisticsEstimator interface {
+	SetEstimationContext(ctx context.Context)
+	EstimateStatistics()
+}
+
+type costEstimator interface {
+	Cost(inStats []Statistics) (Cost, Statistics)
+}
+
+// Estimate returns the cumulative cost of the plan that produces the
+// given node and the statistics of the data produced by it.
+// It may be used with both logical and physical nodes.
+func Estimate(node Node) (Cost, Statistics) {
+	e := newEstimator()
+	stats := e.estimate(node)
+	var total Cost
+	for _, c := range e.costs {
+		total = Add(total, c)
+	}
+	return total, stats
+}
+
+// estimator computes and memoizes the self cost and output
+// statistics for the nodes of a plan.
+type estimator struct {
+	costs map[Node]Cost
+	stats map[Node]Statistics
+}
+
+func newEstimator() *estimator {
+	return &estimator{
+		costs: make(map[Node]Cost),
+		stats: make(map[Node]Statistics),
+	}
+}
+
+func (e *estimator) estimate(node Node) Statistics {
+	if stats, ok := e.stats[node]; ok {
+		return stats
+	}
+	preds := node.Predecessors()
+	inStats := make([]Statistics, len(preds))
+	for i, pred := range preds {
+		inStats[i] = e.estimate(pred)
+	}
+
+	var (
+		cost  Cost
+		stats Statistics
+	)
+	if se, ok := node.ProcedureSpec().(StatisticsEstimator); ok {
+		se.EstimateStatistics()
+	}
+	if ce, ok := node.ProcedureSpec().(costEstimator); ok {
+		cost, stats = ce.Cost(inStats)
+	} else {
+		cost, stats = DefaultCost{}.Cost(inStats)
+	}
+	e.costs[node] = cost
+	e.stats[node] = stats
+	return stats
 }
@@ -0,0 +1,249 @@
+package plan_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/influxdata/flux/plan"
+	"github.com/influxdata/flux/plan/plantest"
+)
+
+const statisticsKind = "statistics"
+
+// statisticsSpec is a source that reports fixed statistics.
+type statisticsSpec struct {
+	stats plan.Statistics
+}
+
+func (s statisticsSpec) Kind() plan.ProcedureKind {
+	return statisticsKind
+}
+
+func (s statisticsSpec) Copy() plan.ProcedureSpec {
+	return s
+}
+
+func (s statisticsSpec) Cost(inStats []plan.Statistics) (plan.Cost, plan.Statistics) {
+	return plan.Cost{NET: s.stats.Rows()}, s.stats
+}
+
+const estimatorKind = "estimator"
+
+// estimatorSpec is a source that counts the times
+// it performs I/O to estimate its statistics.
+type estimatorSpec struct {
+	ctx       context.Context
+	estimated bool
+	ios       int
+}
+
+func (s *estimatorSpec) Kind() plan.ProcedureKind {
+	return estimatorKind
+}
+
+func (s *estimatorSpec) Copy() plan.ProcedureSpec {
+	ns := *s
+	return &ns
+}
+
+func (s *estimatorSpec) Cost(inStats []plan.Statistics) (plan.Cost, plan.Statistics) {
+	if !s.estimated {
+		return plan.Cost{}, plan.UnknownStatistics()
+	}
+	stats := plan.Statistics{Cardinality: 10, GroupCardinality: 1}
+	return plan.Cost{NET: stats.Rows()}, stats
+}
+
+func (s *estimatorSpec) SetEstimationContext(ctx context.Context) {
+	s.ctx = ctx
+}
+
+func (s *estimatorSpec) EstimateStatistics() {
+	if s.ctx == nil || s.estimated {
+		return
+	}
+	s.estimated = true
+	s.ios++
+}
+
+const aggregateKind = "aggregate"
+
+type aggregateSpec struct {
+	plan.AggregateCost
+}
+
+func (aggregateSpec) Kind() plan.ProcedureKind {
+	return aggregateKind
+}
+
+func (s aggregateSpec) Copy() plan.ProcedureSpec {
+	return s
+}
+
+func TestSumStatistics(t *testing.T) {
+	for _, tt := range []struct {
+		name string
+		in   []plan.Statistics
+		want plan.Statistics
+	}{
+		{
+			name: "no inputs",
+			want: plan.UnknownStatistics(),
+		},
+		{
+			name: "known",
+			in: []plan.Statistics{
+				{Cardinality: 10, GroupCardinality: 2},
+				{Cardinality: 5, GroupCardinality: 1},
+			},
+			want: plan.Statistics{Cardinality: 15, GroupCardinality: 3},
+		},
+		{
+			name: "unknown groups",
+			in: []plan.Statistics{
+				{Cardinality: 10, GroupCardinality: plan.UnknownCardinality},
+				{Cardinality: 5, GroupCardinality: 1},
+			},
+			want: plan.Statistics{Cardinality: 15, GroupCardinality: plan.UnknownCardinality},
+		},
+		{
+			name: "unknown",
+			in: []plan.Statistics{
+				{Cardinality: 10, GroupCardinality: 2},
+				plan.UnknownStatistics(),
+			},
+			want: plan.UnknownStatistics(),
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := plan.SumStatistics(tt.in); !cmp.Equal(tt.want, got) {
+				t.Errorf("unexpected statistics -want/+got:\n%s", cmp.Diff(tt.want, got))
+			}
+		})
+	}
+}
+
+func TestEstimate(t *testing.T) {
+	for _, tt := range []struct {
+		name      string
+		plan      *plantest.PlanSpec
+		wantCost  plan.Cost
+		wantStats plan.Statistics
+	}{
+		{
+			name: "unknown source",
+			plan: &plantest.PlanSpec{
+				Nodes: []plan.Node{
+					plantest.CreatePhysicalMockNode("0"),
+					plantest.CreatePhysicalMockNode("1"),
+				},
+				Edges: [][2]int{{0, 1}},
+			},
+			wantCost:  plan.Cost{CPU: 2 * plan.AssumedCardinality},
+			wantStats: plan.UnknownStatistics(),
+		},
+		{
+			name: "aggregate",
+			plan: &plantest.PlanSpec{
+				Nodes: []plan.Node{
+					plan.CreatePhysicalNode("source", statisticsSpec{
+						stats: plan.Statistics{Cardinality: 100, GroupCardinality: 4},
+					}),
+					plantest.CreatePhysicalMockNode("mock"),
+					plan.CreatePhysicalNode("aggregate", aggregateSpec{}),
+				},
+				Edges: [][2]int{{0, 1}, {1, 2}},
+			},
+			wantCost:  plan.Cost{CPU: 200, NET: 100},
+			wantStats: plan.Statistics{Cardinality: 4, GroupCardinality: 4},
+		},
+		{
+			name: "shared predecessor",
+			// source counts once even though
+			// it is the input of both mock nodes
+			plan: &plantest.PlanSpec{
+				Nodes: []plan.Node{
+					plan.CreatePhysicalNode("source", statisticsSpec{
+						stats: plan.Statistics{Cardinality: 10, GroupCardinality: 1},
+					}),
+					plantest.CreatePhysicalMockNode("left"),
+					plantest.CreatePhysicalMockNode("right"),
+					plantest.CreatePhysicalMockNode("union"),
+				},
+				Edges: [][2]int{{0, 1}, {0, 2}, {1, 3}, {2, 3}},
+			},
+			wantCost:  plan.Cost{CPU: 40, NET: 10},
+			wantStats: plan.Statistics{Cardinality: 20, GroupCardinality: 2},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ps := plantest.CreatePlanSpec(tt.plan)
+			var root plan.Node
+			for r := range ps.Roots {
+				root = r
+			}
+			cost, stats := plan.Estimate(root)
+			if !cmp.Equal(tt.wantCost, cost) {
+				t.Errorf("unexpected cost -want/+got:\n%s", cmp.Diff(tt.wantCost, cost))
+			}
+			if !cmp.Equal(tt.wantStats, stats) {
+				t.Errorf("unexpected statistics -want/+got:\n%s", cmp.Diff(tt.wantStats, stats))
+			}
+		})
+	}
+}
+
+func TestPhysicalPlanner_StatisticsEstimation(t *testing.T) {
+	for _, tt := range []struct {
+		name      string
+		opts      []plan.PhysicalOption
+		wantIOs   int
+		wantStats plan.Statistics
+	}{
+		{
+			name:      "disabled",
+			wantStats: plan.UnknownStatistics(),
+		},
+		{
+			name:      "enabled",
+			opts:      []plan.PhysicalOption{plan.WithStatisticsEstimation(context.Background())},
+			wantIOs:   1,
+			wantStats: plan.Statistics{Cardinality: 10, GroupCardinality: 1},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			source := &estimatorSpec{}
+			spec := &plantest.PlanSpec{
+				Nodes: []plan.Node{
+					plan.CreateLogicalNode("source", source),
+					plantest.CreateLogicalMockNode("mock"),
+				},
+				Edges: [][2]int{{0, 1}},
+			}
+			ps, err := plan.NewPhysicalPlanner(tt.opts...).Plan(plantest.CreatePlanSpec(spec))
+			if err != nil {
+				t.Fatal(err)
+			}
+			// Planning without a cost-based rule does not estimate anything.
+			if source.ios != 0 {
+				t.Fatalf("expected no estimates while planning, got %d", source.ios)
+			}
+
+			var root plan.Node
+			for r := range ps.Roots {
+				root = r
+			}
+			var stats plan.Statistics
+			for i := 0; i < 2; i++ {
+				_, stats = plan.Estimate(root.Predecessors()[0])
+			}
+			if want, got := tt.wantIOs, source.ios; want != got {
+				t.Errorf("unexpected number of estimates -want/+got:\n\t- %d\n\t+ %d", want, got)
+			}
+			if !cmp.Equal(tt.wantStats, stats) {
+				t.Errorf("unexpected statistics -want/+got:\n%s", cmp.Diff(tt.wantStats, stats))
+			}
+		})
+	}
+}
@@ -3,6 +3,7 @@ package plan
 import (
 	"fmt"
 	"runtime/debug"
+	"strconv"
 	"strings"
 )
 
@@ -29,6 +30,15 @@ func WithDetails() FormatOption {
 	}
 }
 
+// WithEstimates returns a FormatOption that adds the estimated
+// statistics and self cost of each node to a formatted plan.
+// Unknown statistics are printed as a question mark.
+func WithEstimates() FormatOption {
+	return func(f *formatter) {
+		f.withEstimates = true
+	}
+}
+
 // Detailer provides an optional interface that ProcedureSpecs can implement.
 // Implementors of this interface will have their details appear in the
 // formatted output for a plan if the WithDetails() option is set.
@@ -37,8 +47,9 @@ type Detailer interface {
 }
 
 type formatter struct {
-	withDetails bool
-	p           *Spec
+	withDetails   bool
+	withEstimates bool
+	p             *Spec
 }
 
 func (f formatter) Format(fs fmt.State, c rune) {
@@ -53,8 +64,13 @@ func (f formatter) Format(fs fmt.State, c rune) {
 
 	_, _ = fmt.Fprintf(fs, "digraph {\n")
 	var edges []string
+	e := newEstimator()
 	_ = f.p.BottomUpWalk(func(pn Node) error {
 		_, _ = fmt.Fprintf(fs, "  %v\n", pn.ID())
+		if f.withEstimates {
+			stats := e.estimate(pn)
+			_, _ = fmt.Fprintf(fs, "  // %s\n", formatEstimate(e.costs[pn], stats))
+		}
 		if f.withDetails {
 			if d, ok := pn.ProcedureSpec().(Detailer); ok {
 				lines := strings.Split(strings.TrimSpace(d.PlanDetails()), "\n")
@@ -75,3 +91,33 @@ func (f formatter) Format(fs fmt.State, c rune) {
 	}
 	_, _ = fmt.Fprintf(fs, "}\n")
 }
+
+func formatEstimate(c Cost, s Statistics) string {
+	var b strings.Builder
+	b.WriteString("estimate: rows=")
+	b.WriteString(formatCardinality(s.Cardinality))
+	b.WriteString(" tables=")
+	b.WriteString(formatCardinality(s.GroupCardinality))
+	for _, dim := range []struct {
+		name string
+		v    int64
+	}{
+		{name: "disk", v: c.Disk},
+		{name: "cpu", v: c.CPU},
+		{name: "gpu", v: c.GPU},
+		{name: "mem", v: c.MEM},
+		{name: "net", v: c.NET},
+	} {
+		if dim.v != 0 {
+			_, _ = fmt.Fprintf(&b, " %s=%d", dim.name, dim.v)
+		}
+	}
+	return b.String()
+}
+
+func formatCardinality(n int64) string {
+	if n < 0 {
+		return "?"
+	}
+	return strconv.FormatInt(n, 10)
+}
@@ -82,3 +82,41 @@ func TestFormatted(t *testing.T) {
 		})
 	}
 }
+
+func TestFormatted_WithEstimates(t *testing.T) {
+	ps := plantest.CreatePlanSpec(&plantest.PlanSpec{
+		Nodes: []plan.Node{
+			plan.CreatePhysicalNode("source", statisticsSpec{
+				stats: plan.Statistics{Cardinality: 100, GroupCardinality: 4},
+			}),
+			plan.CreatePhysicalNode("aggregate", aggregateSpec{}),
+			plantest.CreatePhysicalMockNode("unknown"),
+			plantest.CreatePhysicalMockNode("union"),
+		},
+		Edges: [][2]int{
+			{0, 1},
+			{1, 3},
+			{2, 3},
+		},
+	})
+
+	want := `digraph {
+  source
+  // estimate: rows=100 tables=4 net=100
+  aggregate
+  // estimate: rows=4 tables=4 cpu=100
+  unknown
+  // estimate: rows=? tables=? cpu=1048576
+  union
+  // estimate: rows=? tables=? cpu=1048576
+
+  source -> aggregate
+  aggregate -> union
+  unknown -> union
+}
+`
+	got := fmt.Sprintf("%v", plan.Formatted(ps, plan.WithEstimates()))
+	if want != got {
+		t.Fatalf("unexpected output: -want/+got:\n%v", diff.LineDiff(want, got))
+	}
+}
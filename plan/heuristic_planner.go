@@ -7,6 +7,9 @@ import "sort"
 type heuristicPlanner struct {
 	rules         map[ProcedureKind][]Rule
 	disabledRules map[string]bool
+
+	// onRewrite is called with each rule that rewrites a node.
+	onRewrite func(rule Rule, node Node)
 }
 
 func newHeuristicPlanner() *heuristicPlanner {
@@ -47,6 +50,9 @@ func (p *heuristicPlanner) matchRules(node Node) (Node, bool, error) {
 			if err != nil {
 				return nil, false, err
 			}
+			if changed && p.onRewrite != nil {
+				p.onRewrite(rule, node)
+			}
 			anyChanged = anyChanged || changed
 			node = newNode
 		}
@@ -61,6 +67,9 @@ func (p *heuristicPlanner) matchRules(node Node) (Node, bool, error) {
 			if err != nil {
 				return nil, false, err
 			}
+			if changed && p.onRewrite != nil {
+				p.onRewrite(rule, node)
+			}
 			anyChanged = anyChanged || changed
 			node = newNode
 		}
@@ -81,6 +81,14 @@ func RemoveLogicalRules(rules ...string) LogicalOption {
 	})
 }
 
+// OnLogicalRuleApplied produces a logical plan option that calls fn
+// with each rule that rewrites a node and the node before it was rewritten.
+func OnLogicalRuleApplied(fn func(rule Rule, node Node)) LogicalOption {
+	return logicalOption(func(lp *logicalPlanner) {
+		lp.onRewrite = fn
+	})
+}
+
 // Disables integrity checks in the logical planner
 func DisableIntegrityChecks() LogicalOption {
 	return logicalOption(func(lp *logicalPlanner) {
@@ -1,6 +1,7 @@
 package plan
 
 import (
+	"context"
 	"fmt"
 	"math"
 )
@@ -40,6 +41,17 @@ func NewPhysicalPlanner(options ...PhysicalOption) PhysicalPlanner {
 }
 
 func (pp *physicalPlanner) Plan(spec *Spec) (*Spec, error) {
+	// Allow sources that need to perform I/O to estimate their statistics
+	// if a cost-based rule asks for them.
+	if pp.estimationCtx != nil {
+		_ = spec.BottomUpWalk(func(pn Node) error {
+			if se, ok := pn.ProcedureSpec().(StatisticsEstimator); ok {
+				se.SetEstimationContext(pp.estimationCtx)
+			}
+			return nil
+		})
+	}
+
 	transformedSpec, err := pp.heuristicPlanner.Plan(spec)
 	if err != nil {
 		return nil, err
@@ -73,6 +85,11 @@ func (pp *physicalPlanner) Plan(spec *Spec) (*Spec, error) {
 		transformedSpec.Resources.MemoryBytesQuota = pp.defaultMemoryLimit
 	}
 
+	// Update spill directory
+	if transformedSpec.Resources.SpillDirectory == "" {
+		transformedSpec.Resources.SpillDirectory = pp.defaultSpillDirectory
+	}
+
 	// Update concurrency quota
 	if transformedSpec.Resources.ConcurrencyQuota == 0 {
 		transformedSpec.Resources.ConcurrencyQuota = len(transformedSpec.Roots)
@@ -100,8 +117,10 @@ func validatePhysicalPlan(plan *Spec) error {
 
 type physicalPlanner struct {
 	*heuristicPlanner
-	defaultMemoryLimit int64
-	disableValidation  bool
+	estimationCtx         context.Context
+	defaultMemoryLimit    int64
+	defaultSpillDirectory string
+	disableValidation     bool
 }
 
 // PhysicalOption is an option to configure the behavior of the physical plan.
@@ -123,6 +142,25 @@ func WithDefaultMemoryLimit(memBytes int64) PhysicalOption {
 	})
 }
 
+// WithDefaultSpillDirectory sets the default directory where transformations may spill
+// data to disk when a plan generated by the planner is close to its memory limit.
+// If the query spec explicitly sets a spill directory, that directory is used instead of the default.
+func WithDefaultSpillDirectory(dir string) PhysicalOption {
+	return physicalOption(func(p *physicalPlanner) {
+		p.defaultSpillDirectory = dir
+	})
+}
+
+// WithStatisticsEstimation allows procedure specs that implement
+// StatisticsEstimator to perform I/O with the context to estimate
+// their statistics when a cost-based rule asks for them.
+// Statistics that require I/O are not estimated without this option.
+func WithStatisticsEstimation(ctx context.Context) PhysicalOption {
+	return physicalOption(func(p *physicalPlanner) {
+		p.estimationCtx = ctx
+	})
+}
+
 // OnlyPhysicalRules produces a physical plan option that forces only a particular set of rules to be applied.
 func OnlyPhysicalRules(rules ...Rule) PhysicalOption {
 	return physicalOption(func(pp *physicalPlanner) {
@@ -134,6 +172,28 @@ func OnlyPhysicalRules(rules ...Rule) PhysicalOption {
 	})
 }
 
+// OnPhysicalRuleApplied produces a physical plan option that calls fn
+// with each rule that rewrites a node and the node before it was rewritten.
+// The conversion of logical nodes into physical nodes is not reported.
+func OnPhysicalRuleApplied(fn func(rule Rule, node Node)) PhysicalOption {
+	return physicalOption(func(pp *physicalPlanner) {
+		pp.onRewrite = func(rule Rule, node Node) {
+			if _, ok := rule.(physicalConverterRule); ok {
+				return
+			}
+			fn(rule, node)
+		}
+	})
+}
+
+// AddPhysicalRules produces a physical plan option that applies the rules
+// in addition to the registered rules.
+func AddPhysicalRules(rules ...Rule) PhysicalOption {
+	return physicalOption(func(pp *physicalPlanner) {
+		pp.addRules(rules...)
+	})
+}
+
 func RemovePhysicalRules(rules ...string) PhysicalOption {
 	return physicalOption(func(pp *physicalPlanner) {
 		pp.removeRules(rules...)
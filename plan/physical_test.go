@@ -4,13 +4,18 @@ import (
 	"math"
 	"testing"
 
+	"github.com/google/go-cmp/cmp"
+
 	"github.com/influxdata/flux/plan"
 	"github.com/influxdata/flux/plan/plantest"
 )
 
 func TestPhysicalOptions(t *testing.T) {
 	configs := [][]plan.PhysicalOption{
-		{plan.WithDefaultMemoryLimit(16384)},
+		{
+			plan.WithDefaultMemoryLimit(16384),
+			plan.WithDefaultSpillDirectory("/tmp/flux"),
+		},
 		{},
 	}
 
@@ -38,10 +43,16 @@ func TestPhysicalOptions(t *testing.T) {
 			if outputPlan.Resources.MemoryBytesQuota != 16384 {
 				t.Errorf("Expected memory quota of 16384 with option specified")
 			}
+			if outputPlan.Resources.SpillDirectory != "/tmp/flux" {
+				t.Errorf("Expected spill directory of /tmp/flux with option specified")
+			}
 		} else {
 			if outputPlan.Resources.MemoryBytesQuota != math.MaxInt64 {
 				t.Errorf("Expected memory quota of math.MaxInt64 with no options specified")
 			}
+			if outputPlan.Resources.SpillDirectory != "" {
+				t.Errorf("Expected no spill directory with no options specified")
+			}
 		}
 	}
 }
@@ -91,3 +102,51 @@ func TestPhysicalIntegrityCheckOption(t *testing.T) {
 		t.Fatal("unexpected pass")
 	}
 }
+
+// mergeMocksRule merges a mock node into the mock node that precedes it.
+type mergeMocksRule struct{}
+
+func (mergeMocksRule) Name() string {
+	return "mergeMocks"
+}
+
+func (mergeMocksRule) Pattern() plan.Pattern {
+	return plan.Pat(plantest.MockKind, plan.Pat(plantest.MockKind))
+}
+
+func (mergeMocksRule) Rewrite(node plan.Node) (plan.Node, bool, error) {
+	pred := node.Predecessors()[0]
+	merged, err := plan.MergeToPhysicalNode(node, pred, plantest.MockProcedureSpec{})
+	if err != nil {
+		return nil, false, err
+	}
+	return merged, true, nil
+}
+
+func TestPhysicalRuleAppliedOption(t *testing.T) {
+	spec := &plantest.PlanSpec{
+		Nodes: []plan.Node{
+			plantest.CreateLogicalMockNode("0"),
+			plantest.CreateLogicalMockNode("1"),
+		},
+		Edges: [][2]int{
+			{0, 1},
+		},
+	}
+
+	var applied []string
+	planner := plan.NewPhysicalPlanner(
+		plan.OnlyPhysicalRules(mergeMocksRule{}),
+		plan.OnPhysicalRuleApplied(func(rule plan.Rule, node plan.Node) {
+			applied = append(applied, rule.Name()+" "+string(node.ID()))
+		}),
+	)
+	if _, err := planner.Plan(plantest.CreatePlanSpec(spec)); err != nil {
+		t.Fatalf("Physical planning failed: %v", err)
+	}
+
+	// The conversion of the logical nodes is not reported.
+	if want, got := []string{"mergeMocks 1"}, applied; !cmp.Equal(want, got) {
+		t.Errorf("unexpected rules -want/+got:\n%s", cmp.Diff(want, got))
+	}
+}
@@ -251,8 +251,8 @@ func mergePlanNodes(top, bottom, merged Node) (Node, error) {
 	}
 
 	merged.AddPredecessors(bottom.Predecessors()...)
-	for i, pred := range merged.Predecessors() {
-		for _, succ := range pred.Successors() {
+	for _, pred := range merged.Predecessors() {
+		for i, succ := range pred.Successors() {
 			if succ == bottom {
 				pred.Successors()[i] = merged
 			}
@@ -329,7 +329,8 @@ func ReplaceNode(oldNode, newNode Node) {
 }
 
 type WindowSpec struct {
-	Every  flux.Duration
-	Period flux.Duration
-	Offset flux.Duration
+	Every    flux.Duration
+	Period   flux.Duration
+	Offset   flux.Duration
+	Location flux.Location
 }
@@ -21,6 +21,11 @@ type ResourceManagement struct {
 	// There is a small amount of overhead memory being consumed by a query that will not be counted towards this limit.
 	// A zero value indicates unlimited.
 	MemoryBytesQuota int64 `json:"memory_bytes_quota"`
+	// SpillDirectory is the directory where transformations may write temporary files
+	// when the memory quota is nearly exhausted instead of failing the query.
+	// The files are created with the filesystem service from the query dependencies.
+	// An empty value disables spilling to disk.
+	SpillDirectory string `json:"spill_directory"`
 }
 
 // Priority is an integer that represents the query priority.
@@ -275,6 +275,8 @@ func analyzeExpression(expr ast.Expression) (Expression, error) {
 		return analyzeObjectExpression(expr)
 	case *ast.ArrayExpression:
 		return analyzeArrayExpression(expr)
+	case *ast.DictExpression:
+		return analyzeDictExpression(expr)
 	case *ast.Identifier:
 		return analyzeIdentifierExpression(expr)
 	case *ast.StringExpression:
@@ -627,6 +629,27 @@ func analyzeArrayExpression(array *ast.ArrayExpression) (*ArrayExpression, error
 	}
 	return a, nil
 }
+func analyzeDictExpression(dict *ast.DictExpression) (*DictExpression, error) {
+	d := &DictExpression{
+		loc:      loc(dict.Location()),
+		Elements: make([]*DictItem, len(dict.Elements)),
+	}
+	for i, item := range dict.Elements {
+		key, err := analyzeExpression(item.Key)
+		if err != nil {
+			return nil, err
+		}
+		val, err := analyzeExpression(item.Val)
+		if err != nil {
+			return nil, err
+		}
+		d.Elements[i] = &DictItem{
+			Key: key,
+			Val: val,
+		}
+	}
+	return d, nil
+}
 
 func analyzeIdentifier(ident *ast.Identifier) (*Identifier, error) {
 	return &Identifier{
@@ -426,6 +426,23 @@ func (v ConstraintGenerator) typeof(n Node) (PolyType, error) {
 		}
 		v.cs.AddTypeConst(nodeVar, at, n.Location())
 		return nodeVar, nil
+	case *DictExpression:
+		key := v.cs.f.Fresh()
+		val := v.cs.f.Fresh()
+		for _, item := range n.Elements {
+			kt, err := v.lookup(item.Key)
+			if err != nil {
+				return nil, err
+			}
+			v.cs.AddTypeConst(kt, key, item.Key.Location())
+			vt, err := v.lookup(item.Val)
+			if err != nil {
+				return nil, err
+			}
+			v.cs.AddTypeConst(vt, val, item.Val.Location())
+		}
+		v.cs.AddTypeConst(nodeVar, dict{key: key, value: val}, n.Location())
+		return nodeVar, nil
 	case *StringExpression:
 		for _, part := range n.Parts {
 			if p, ok := part.(*InterpolatedPart); ok {
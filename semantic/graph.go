@@ -44,6 +44,7 @@ func (*ExternalVariableAssignment) node() {}
 
 func (*StringExpression) node()      {}
 func (*ArrayExpression) node()       {}
+func (*DictExpression) node()        {}
 func (*FunctionExpression) node()    {}
 func (*BinaryExpression) node()      {}
 func (*CallExpression) node()        {}
@@ -103,6 +104,7 @@ type Expression interface {
 
 func (*StringExpression) expression()       {}
 func (*ArrayExpression) expression()        {}
+func (*DictExpression) expression()         {}
 func (*BinaryExpression) expression()       {}
 func (*BooleanLiteral) expression()         {}
 func (*CallExpression) expression()         {}
@@ -605,6 +607,46 @@ func (e *ArrayExpression) TypeOf() *types.MonoType {
 	return e.typ
 }
 
+// DictExpression represents a literal dictionary.
+type DictExpression struct {
+	loc `json:"-"`
+
+	Elements []*DictItem `json:"elements"`
+
+	typ *types.MonoType
+}
+
+// DictItem is a single key/value pair within a DictExpression.
+type DictItem struct {
+	Key Expression `json:"key"`
+	Val Expression `json:"val"`
+}
+
+func (*DictExpression) NodeType() string { return "DictExpression" }
+
+func (e *DictExpression) Copy() Node {
+	if e == nil {
+		return e
+	}
+	ne := new(DictExpression)
+	*ne = *e
+
+	if len(e.Elements) > 0 {
+		ne.Elements = make([]*DictItem, len(e.Elements))
+		for i, item := range e.Elements {
+			ne.Elements[i] = &DictItem{
+				Key: item.Key.Copy().(Expression),
+				Val: item.Val.Copy().(Expression),
+			}
+		}
+	}
+
+	return ne
+}
+func (e *DictExpression) TypeOf() *types.MonoType {
+	return e.typ
+}
+
 // FunctionExpression represents the definition of a function
 type FunctionExpression struct {
 	loc `json:"-"`
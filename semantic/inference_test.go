@@ -79,6 +79,37 @@ func TestInferTypes(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "dict expression",
+			node: &semantic.DictExpression{
+				Elements: []*semantic.DictItem{
+					{
+						Key: &semantic.StringLiteral{Value: "a"},
+						Val: &semantic.IntegerLiteral{Value: 0},
+					},
+					{
+						Key: &semantic.StringLiteral{Value: "b"},
+						Val: &semantic.IntegerLiteral{Value: 1},
+					},
+				},
+			},
+			solution: &solutionVisitor{
+				f: func(node semantic.Node) semantic.PolyType {
+					switch node.(type) {
+					case *semantic.DictExpression:
+						return semantic.NewDictPolyType(semantic.String, semantic.Int)
+					}
+					return nil
+				},
+			},
+		},
+		{
+			name: "dict value type error",
+			script: `
+["a": 1, "b": 2.0]
+`,
+			wantErr: errors.New(`type error 2:15-2:18: float != int`),
+		},
 		{
 			name: "var assignment with binary expression",
 			script: `
@@ -733,6 +733,39 @@ func (e *ArrayExpression) UnmarshalJSON(data []byte) error {
 	}
 	return nil
 }
+func (e *DictExpression) MarshalJSON() ([]byte, error) {
+	type Alias DictExpression
+	raw := struct {
+		Type string `json:"type"`
+		*Alias
+	}{
+		Type:  e.NodeType(),
+		Alias: (*Alias)(e),
+	}
+	return json.Marshal(raw)
+}
+func (i *DictItem) UnmarshalJSON(data []byte) error {
+	raw := struct {
+		Key json.RawMessage `json:"key"`
+		Val json.RawMessage `json:"val"`
+	}{}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	key, err := unmarshalExpression(raw.Key)
+	if err != nil {
+		return err
+	}
+	i.Key = key
+
+	val, err := unmarshalExpression(raw.Val)
+	if err != nil {
+		return err
+	}
+	i.Val = val
+	return nil
+}
 func (e *ObjectExpression) MarshalJSON() ([]byte, error) {
 	type Alias ObjectExpression
 	raw := struct {
@@ -1148,6 +1181,8 @@ func unmarshalNode(msg json.RawMessage) (Node, error) {
 		node = new(ConditionalExpression)
 	case "ArrayExpression":
 		node = new(ArrayExpression)
+	case "DictExpression":
+		node = new(DictExpression)
 	case "Identifier":
 		node = new(Identifier)
 	case "IdentifierExpression":
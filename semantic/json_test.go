@@ -263,6 +263,16 @@ func TestJSONMarshal(t *testing.T) {
 			},
 			want: `{"type":"ArrayExpression","elements":[{"type":"StringLiteral","value":"hello"}]}`,
 		},
+		{
+			name: "dict expression",
+			node: &semantic.DictExpression{
+				Elements: []*semantic.DictItem{{
+					Key: &semantic.StringLiteral{Value: "a"},
+					Val: &semantic.IntegerLiteral{Value: 1},
+				}},
+			},
+			want: `{"type":"DictExpression","elements":[{"key":{"type":"StringLiteral","value":"a"},"val":{"type":"IntegerLiteral","value":"1"}}]}`,
+		},
 		{
 			name: "object expression",
 			node: &semantic.ObjectExpression{
@@ -254,6 +254,98 @@ func (a array) Equal(t PolyType) bool {
 	return false
 }
 
+type dict struct {
+	key   PolyType
+	value PolyType
+}
+
+// NewDictPolyType creates a PolyType representing a dictionary
+// with the given key and value types.
+func NewDictPolyType(keyType, valueType PolyType) PolyType {
+	return dict{key: keyType, value: valueType}
+}
+
+func (d dict) Nature() Nature {
+	return Dictionary
+}
+func (d dict) String() string {
+	return fmt.Sprintf("[%v: %v]", d.key, d.value)
+}
+
+func (d dict) occurs(tv Tvar) bool {
+	return d.key.occurs(tv) || d.value.occurs(tv)
+}
+func (d dict) substituteType(tv Tvar, t PolyType) PolyType {
+	return dict{
+		key:   d.key.substituteType(tv, t),
+		value: d.value.substituteType(tv, t),
+	}
+}
+func (d dict) freeVars(c *Constraints) TvarSet {
+	return d.key.freeVars(c).union(d.value.freeVars(c))
+}
+func (l dict) unifyType(kinds map[Tvar]Kind, r PolyType) (Substitution, error) {
+	switch r := r.(type) {
+	case dict:
+		subst, err := unifyTypes(kinds, l.key, r.key)
+		if err != nil {
+			return nil, err
+		}
+		if subst == nil {
+			subst = make(Substitution)
+		}
+		s, err := unifyTypes(kinds, subst.ApplyType(l.value), subst.ApplyType(r.value))
+		if err != nil {
+			return nil, err
+		}
+		subst.Merge(s)
+		return subst, nil
+	case Tvar:
+		return r.unifyType(kinds, l)
+	default:
+		return nil, errors.Newf(codes.Invalid, "cannot unify dictionary with %T", r)
+	}
+}
+func (d dict) resolveType(kinds map[Tvar]Kind) (Type, error) {
+	k, err := d.key.resolveType(kinds)
+	if err != nil {
+		return nil, err
+	}
+	v, err := d.value.resolveType(kinds)
+	if err != nil {
+		return nil, err
+	}
+	return NewDictType(k, v), nil
+}
+func (d dict) MonoType() (Type, bool) {
+	k, ok := d.key.MonoType()
+	if !ok {
+		return nil, false
+	}
+	v, ok := d.value.MonoType()
+	if !ok {
+		return nil, false
+	}
+	return NewDictType(k, v), true
+}
+func (d dict) resolvePolyType(kinds map[Tvar]Kind) (PolyType, error) {
+	k, err := d.key.resolvePolyType(kinds)
+	if err != nil {
+		return nil, err
+	}
+	v, err := d.value.resolvePolyType(kinds)
+	if err != nil {
+		return nil, err
+	}
+	return dict{key: k, value: v}, nil
+}
+func (d dict) Equal(t PolyType) bool {
+	if o, ok := t.(dict); ok {
+		return d.key.Equal(o.key) && d.value.Equal(o.value)
+	}
+	return false
+}
+
 // pipeLabel is a hidden label on which all pipe arguments are passed according to type inference.
 const pipeLabel = "|pipe|"
 
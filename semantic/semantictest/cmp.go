@@ -30,6 +30,7 @@ var CmpOptions = []cmp.Option{
 	cmpopts.IgnoreUnexported(semantic.Extern{}),
 	cmpopts.IgnoreUnexported(semantic.ExternalVariableAssignment{}),
 	cmpopts.IgnoreUnexported(semantic.ArrayExpression{}),
+	cmpopts.IgnoreUnexported(semantic.DictExpression{}),
 	cmpopts.IgnoreUnexported(semantic.FunctionExpression{}),
 	cmpopts.IgnoreUnexported(semantic.FunctionBlock{}),
 	cmpopts.IgnoreUnexported(semantic.FunctionParameters{}),
@@ -125,6 +126,18 @@ func TransformValue(v values.Value) map[string]interface{} {
 			"type":     semantic.Object.String(),
 			"elements": elements,
 		}
+	case semantic.Dictionary:
+		var elements []map[string]interface{}
+		v.Dict().Range(func(key, value values.Value) {
+			elements = append(elements, map[string]interface{}{
+				"key":   TransformValue(key),
+				"value": TransformValue(value),
+			})
+		})
+		return map[string]interface{}{
+			"type":     semantic.Dictionary.String(),
+			"elements": elements,
+		}
 	default:
 		panic(fmt.Errorf("unexpected value type %v", v.Type()))
 	}
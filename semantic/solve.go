@@ -231,7 +231,26 @@ func unifyVarAndType(kinds map[Tvar]Kind, tv Tvar, t PolyType) (Substitution, er
 	if t.occurs(tv) {
 		return nil, errors.Newf(codes.Internal, "type var %v occurs in %v creating a cycle", tv, t)
 	}
-	return Substitution{tv: t}, nil
+	subst := Substitution{tv: t}
+	// An object type carries its own kind. If the type variable
+	// is also constrained by an object kind, the types of the
+	// properties they have in common must be unified.
+	if o, ok := t.(object); ok {
+		if k, ok := kinds[tv].(ObjectKind); ok {
+			for f, typL := range o.krecord.properties {
+				typR, ok := k.properties[f]
+				if !ok {
+					continue
+				}
+				s, err := unifyTypes(kinds, subst.ApplyType(typL), subst.ApplyType(typR))
+				if err != nil {
+					return nil, err
+				}
+				subst.Merge(s)
+			}
+		}
+	}
+	return subst, nil
 }
 
 func unifyKindsByVar(kinds map[Tvar]Kind, l, r Tvar) (Substitution, error) {
@@ -38,6 +38,14 @@ type Type interface {
 	// It panics if the type's Kind is not Array.
 	ElementType() Type
 
+	// KeyType returns the type of the keys in the dictionary.
+	// It panics if the type's Kind is not Dictionary.
+	KeyType() Type
+
+	// ValueType returns the type of the values in the dictionary.
+	// It panics if the type's Kind is not Dictionary.
+	ValueType() Type
+
 	// FunctionSignature returns the function signature of this type.
 	// It panics if the type's Kind is not Function.
 	FunctionSignature() FunctionSignature
@@ -67,23 +75,25 @@ const (
 	Array
 	Object
 	Function
+	Dictionary
 )
 
 var natureNames = []string{
-	Invalid:  "invalid",
-	Nil:      "nil",
-	String:   "string",
-	Bytes:    "bytes",
-	Int:      "int",
-	UInt:     "uint",
-	Float:    "float",
-	Bool:     "bool",
-	Time:     "time",
-	Duration: "duration",
-	Regexp:   "regexp",
-	Array:    "array",
-	Object:   "object",
-	Function: "function",
+	Invalid:    "invalid",
+	Nil:        "nil",
+	String:     "string",
+	Bytes:      "bytes",
+	Int:        "int",
+	UInt:       "uint",
+	Float:      "float",
+	Bool:       "bool",
+	Time:       "time",
+	Duration:   "duration",
+	Regexp:     "regexp",
+	Array:      "array",
+	Object:     "object",
+	Function:   "function",
+	Dictionary: "dictionary",
 }
 
 func (n Nature) String() string {
@@ -105,6 +115,12 @@ func (n Nature) Properties() map[string]Type {
 func (n Nature) ElementType() Type {
 	panic(errors.Newf(codes.Internal, "cannot get element type from kind %s", n))
 }
+func (n Nature) KeyType() Type {
+	panic(errors.Newf(codes.Internal, "cannot get key type from kind %s", n))
+}
+func (n Nature) ValueType() Type {
+	panic(errors.Newf(codes.Internal, "cannot get value type from kind %s", n))
+}
 func (n Nature) FunctionSignature() FunctionSignature {
 	panic(errors.Newf(codes.Internal, "cannot get function signature from kind %s", n))
 }
@@ -130,6 +146,12 @@ func (t *arrayType) Properties() map[string]Type {
 func (t *arrayType) ElementType() Type {
 	return t.elementType
 }
+func (t *arrayType) KeyType() Type {
+	panic(errors.Newf(codes.Internal, "cannot get key type of kind %s", t.Nature()))
+}
+func (t *arrayType) ValueType() Type {
+	panic(errors.Newf(codes.Internal, "cannot get value type of kind %s", t.Nature()))
+}
 func (t *arrayType) FunctionSignature() FunctionSignature {
 	panic(errors.Newf(codes.Internal, "cannot get function signature of kind %s", t.Nature()))
 }
@@ -179,6 +201,86 @@ func NewArrayType(elementType Type) Type {
 	return at
 }
 
+type dictType struct {
+	keyType   Type
+	valueType Type
+}
+
+func (t *dictType) String() string {
+	return fmt.Sprintf("[%v: %v]", t.keyType, t.valueType)
+}
+
+func (t *dictType) Nature() Nature {
+	return Dictionary
+}
+func (t *dictType) PropertyType(name string) Type {
+	panic(errors.Newf(codes.Internal, "cannot get property type of kind %s", t.Nature()))
+}
+func (t *dictType) Properties() map[string]Type {
+	panic(errors.Newf(codes.Internal, "cannot get properties type of kind %s", t.Nature()))
+}
+func (t *dictType) ElementType() Type {
+	panic(errors.Newf(codes.Internal, "cannot get element type of kind %s", t.Nature()))
+}
+func (t *dictType) KeyType() Type {
+	return t.keyType
+}
+func (t *dictType) ValueType() Type {
+	return t.valueType
+}
+func (t *dictType) FunctionSignature() FunctionSignature {
+	panic(errors.Newf(codes.Internal, "cannot get function signature of kind %s", t.Nature()))
+}
+func (t *dictType) PolyType() PolyType {
+	if t.keyType == nil || t.valueType == nil {
+		return Invalid
+	}
+	return NewDictPolyType(t.keyType.PolyType(), t.valueType.PolyType())
+}
+
+func (t *dictType) typ() {}
+
+// dictTypeKey identifies a dictType in the dictTypeCache.
+type dictTypeKey struct {
+	keyType   Type
+	valueType Type
+}
+
+// dictTypeCache caches *dictType values.
+//
+// Since dictTypes are identified by their key and value types
+// we can key all dictTypes by that pair.
+var dictTypeCache struct {
+	sync.Mutex // Guards stores (but not loads) on m.
+
+	// m is a map[dictTypeKey]*dictType.
+	// Elements in m are append-only and thus safe for concurrent reading.
+	m sync.Map
+}
+
+func NewDictType(keyType, valueType Type) Type {
+	key := dictTypeKey{keyType: keyType, valueType: valueType}
+	// Lookup dictType in cache by the key and value types.
+	if t, ok := dictTypeCache.m.Load(key); ok {
+		return t.(*dictType)
+	}
+
+	// Type not found in cache, lock and retry.
+	dictTypeCache.Lock()
+	defer dictTypeCache.Unlock()
+
+	// First read again while holding the lock.
+	if t, ok := dictTypeCache.m.Load(key); ok {
+		return t.(*dictType)
+	}
+
+	// Still no cache entry, add it.
+	dt := &dictType{keyType: keyType, valueType: valueType}
+	dictTypeCache.m.Store(key, dt)
+
+	return dt
+}
+
 type objectType struct {
 	properties map[string]Type
 }
@@ -210,6 +312,12 @@ func (t *objectType) Properties() map[string]Type {
 func (t *objectType) ElementType() Type {
 	panic(errors.Newf(codes.Internal, "cannot get element type of kind %s", t.Nature()))
 }
+func (t *objectType) KeyType() Type {
+	panic(errors.Newf(codes.Internal, "cannot get key type of kind %s", t.Nature()))
+}
+func (t *objectType) ValueType() Type {
+	panic(errors.Newf(codes.Internal, "cannot get value type of kind %s", t.Nature()))
+}
 func (t *objectType) FunctionSignature() FunctionSignature {
 	panic(errors.Newf(codes.Internal, "cannot get function signature of kind %s", t.Nature()))
 }
@@ -363,6 +471,12 @@ func (t *functionType) Properties() map[string]Type {
 func (t *functionType) ElementType() Type {
 	panic(errors.Newf(codes.Internal, "cannot get element type of kind %s", t.Nature()))
 }
+func (t *functionType) KeyType() Type {
+	panic(errors.Newf(codes.Internal, "cannot get key type of kind %s", t.Nature()))
+}
+func (t *functionType) ValueType() Type {
+	panic(errors.Newf(codes.Internal, "cannot get value type of kind %s", t.Nature()))
+}
 func (t *functionType) FunctionSignature() FunctionSignature {
 	return FunctionSignature{
 		Parameters:   t.parameters,
@@ -0,0 +1,265 @@
+package arrow_test
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	arrowlib "github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/ipc"
+	"github.com/google/go-cmp/cmp"
+	"github.com/influxdata/flux"
+	_ "github.com/influxdata/flux/builtin"
+	"github.com/influxdata/flux/dependencies/dependenciestest"
+	"github.com/influxdata/flux/execute/executetest"
+	"github.com/influxdata/flux/lang/langtest"
+	"github.com/influxdata/flux/memory"
+	"github.com/influxdata/flux/values"
+)
+
+const data = `
+#datatype,string,long,dateTime:RFC3339,string,string,double,long
+#group,false,false,false,true,true,false,false
+#default,_result,,,,,,
+,result,table,_time,_measurement,host,_value,count
+,,0,2019-11-01T00:00:00Z,cpu,a,1.5,1
+,,0,2019-11-01T00:00:10Z,cpu,a,2.5,
+,,1,2019-11-01T00:00:00Z,cpu,b,3.5,3
+`
+
+// runQuery executes the script and returns the tables for every result.
+func runQuery(t *testing.T, script string) ([]*executetest.Table, error) {
+	t.Helper()
+	ctx := dependenciestest.Default().Inject(context.Background())
+	tables, _, err := langtest.RunScript(ctx, script, time.Now())
+	return tables, err
+}
+
+func TestArrow_RoundTrip(t *testing.T) {
+	dir, err := ioutil.TempDir("", "flux-arrow")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = os.RemoveAll(dir) }()
+	file := strconv.Quote(filepath.Join(dir, "data.arrow"))
+
+	written, err := runQuery(t, `
+import "csv"
+import "arrow"
+
+csv.from(csv: `+strconv.Quote(data)+`) |> arrow.to(file: `+file+`)`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(written) != 2 {
+		t.Fatalf("arrow.to should pass its tables through, got %d tables", len(written))
+	}
+
+	cols := []flux.ColMeta{
+		{Label: "_time", Type: flux.TTime},
+		{Label: "_measurement", Type: flux.TString},
+		{Label: "host", Type: flux.TString},
+		{Label: "_value", Type: flux.TFloat},
+		{Label: "count", Type: flux.TInt},
+	}
+	ts := func(sec int64) values.Time {
+		return values.Time(time.Date(2019, 11, 1, 0, 0, 0, 0, time.UTC).UnixNano() + sec*1e9)
+	}
+	for _, tc := range []struct {
+		name    string
+		script  string
+		want    []*executetest.Table
+		wantErr string
+	}{
+		{
+			name:   "stored group key",
+			script: `arrow.from(file: ` + file + `)`,
+			want: []*executetest.Table{
+				{
+					KeyCols: []string{"_measurement", "host"},
+					ColMeta: cols,
+					Data: [][]interface{}{
+						{ts(0), "cpu", "a", 1.5, int64(1)},
+						{ts(10), "cpu", "a", 2.5, nil},
+					},
+				},
+				{
+					KeyCols: []string{"_measurement", "host"},
+					ColMeta: cols,
+					Data: [][]interface{}{
+						{ts(0), "cpu", "b", 3.5, int64(3)},
+					},
+				},
+			},
+		},
+		{
+			name:   "columns and group key",
+			script: `arrow.from(file: ` + file + `, columns: ["_measurement", "_value"], groupKey: ["_measurement"])`,
+			want: []*executetest.Table{
+				{
+					KeyCols: []string{"_measurement"},
+					ColMeta: []flux.ColMeta{
+						{Label: "_measurement", Type: flux.TString},
+						{Label: "_value", Type: flux.TFloat},
+					},
+					Data: [][]interface{}{
+						{"cpu", 1.5},
+						{"cpu", 2.5},
+						{"cpu", 3.5},
+					},
+				},
+			},
+		},
+		{
+			name:   "keep is pushed into the source",
+			script: `arrow.from(file: ` + file + `) |> keep(columns: ["host", "_value"])`,
+			want: []*executetest.Table{
+				{
+					KeyCols: []string{"host"},
+					ColMeta: []flux.ColMeta{
+						{Label: "host", Type: flux.TString},
+						{Label: "_value", Type: flux.TFloat},
+					},
+					Data: [][]interface{}{
+						{"a", 1.5},
+						{"a", 2.5},
+					},
+				},
+				{
+					KeyCols: []string{"host"},
+					ColMeta: []flux.ColMeta{
+						{Label: "host", Type: flux.TString},
+						{Label: "_value", Type: flux.TFloat},
+					},
+					Data: [][]interface{}{
+						{"b", 3.5},
+					},
+				},
+			},
+		},
+		{
+			name:    "missing file",
+			script:  `arrow.from(file: ` + strconv.Quote(filepath.Join(dir, "missing.arrow")) + `)`,
+			wantErr: "error in arrow.from(): failed to open",
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := runQuery(t, "import \"arrow\"\n"+tc.script)
+			if tc.wantErr != "" {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				if got := err.Error(); len(got) < len(tc.wantErr) || got[:len(tc.wantErr)] != tc.wantErr {
+					t.Fatalf("unexpected error -want/+got:\n\t- %s\n\t+ %s", tc.wantErr, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			executetest.NormalizeTables(tc.want)
+			if !cmp.Equal(tc.want, got) {
+				t.Errorf("unexpected tables -want/+got:\n%s", cmp.Diff(tc.want, got))
+			}
+		})
+	}
+}
+
+// TestFrom_Stream reads an IPC stream that was not written by flux
+// to check the arrow types that are converted to flux types.
+func TestFrom_Stream(t *testing.T) {
+	dir, err := ioutil.TempDir("", "flux-arrow")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = os.RemoveAll(dir) }()
+	fpath := filepath.Join(dir, "stream.arrow")
+
+	mem := &memory.Allocator{}
+	schema := arrowlib.NewSchema([]arrowlib.Field{
+		{Name: "ts", Type: arrowlib.FixedWidthTypes.Timestamp_ms, Nullable: true},
+		{Name: "day", Type: arrowlib.FixedWidthTypes.Date32, Nullable: true},
+		{Name: "i32", Type: arrowlib.PrimitiveTypes.Int32, Nullable: true},
+		{Name: "u8", Type: arrowlib.PrimitiveTypes.Uint8, Nullable: true},
+		{Name: "f32", Type: arrowlib.PrimitiveTypes.Float32, Nullable: true},
+		{Name: "bin", Type: arrowlib.BinaryTypes.Binary, Nullable: true},
+		{Name: "list", Type: arrowlib.ListOf(arrowlib.PrimitiveTypes.Int32), Nullable: true},
+	}, nil)
+	tsb := array.NewTimestampBuilder(mem, arrowlib.FixedWidthTypes.Timestamp_ms.(*arrowlib.TimestampType))
+	tsb.AppendValues([]arrowlib.Timestamp{1000, 2000}, nil)
+	db := array.NewDate32Builder(mem)
+	db.AppendValues([]arrowlib.Date32{1, 2}, nil)
+	ib := array.NewInt32Builder(mem)
+	ib.AppendValues([]int32{-1, 0}, []bool{true, false})
+	ub := array.NewUint8Builder(mem)
+	ub.AppendValues([]uint8{7, 8}, nil)
+	fb := array.NewFloat32Builder(mem)
+	fb.AppendValues([]float32{0.5, 1.5}, nil)
+	bb := array.NewBinaryBuilder(mem, arrowlib.BinaryTypes.Binary)
+	bb.AppendValues([][]byte{[]byte("a"), nil}, []bool{true, false})
+	lb := array.NewListBuilder(mem, arrowlib.PrimitiveTypes.Int32)
+	lb.Append(true)
+	lb.ValueBuilder().(*array.Int32Builder).Append(1)
+	lb.AppendNull()
+
+	var cols []array.Interface
+	for _, b := range []array.Builder{tsb, db, ib, ub, fb, bb, lb} {
+		cols = append(cols, b.NewArray())
+		b.Release()
+	}
+	rec := array.NewRecord(schema, cols, 2)
+	for _, col := range cols {
+		col.Release()
+	}
+	defer rec.Release()
+
+	f, err := os.Create(fpath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	w := ipc.NewWriter(f, ipc.WithSchema(schema), ipc.WithAllocator(mem))
+	if err := w.Write(rec); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := f.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	file := strconv.Quote(fpath)
+	got, err := runQuery(t, `import "arrow" arrow.from(file: `+file+`, columns: ["ts", "day", "i32", "u8", "f32", "bin"])`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	day := int64(24 * time.Hour)
+	want := []*executetest.Table{{
+		ColMeta: []flux.ColMeta{
+			{Label: "ts", Type: flux.TTime},
+			{Label: "day", Type: flux.TTime},
+			{Label: "i32", Type: flux.TInt},
+			{Label: "u8", Type: flux.TUInt},
+			{Label: "f32", Type: flux.TFloat},
+			{Label: "bin", Type: flux.TString},
+		},
+		Data: [][]interface{}{
+			{values.Time(1e9), values.Time(day), int64(-1), uint64(7), 0.5, "a"},
+			{values.Time(2e9), values.Time(2 * day), nil, uint64(8), 1.5, nil},
+		},
+	}}
+	executetest.NormalizeTables(want)
+	if !cmp.Equal(want, got) {
+		t.Errorf("unexpected tables -want/+got:\n%s", cmp.Diff(want, got))
+	}
+
+	if _, err := runQuery(t, `import "arrow" arrow.from(file: `+file+`)`); err == nil {
+		t.Error("expected an error for the list column")
+	}
+}
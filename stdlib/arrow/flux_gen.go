@@ -0,0 +1,162 @@
+// DO NOT EDIT: This file is autogenerated via the builtin command.
+
+package arrow
+
+import (
+	flux "github.com/influxdata/flux"
+	ast "github.com/influxdata/flux/ast"
+)
+
+func init() {
+	flux.RegisterPackage(pkgAST)
+}
+
+var pkgAST = &ast.Package{
+	BaseNode: ast.BaseNode{
+		Comments:        nil,
+		Errors:          nil,
+		Loc:             nil,
+		TrailingComment: nil,
+	},
+	Files: []*ast.File{&ast.File{
+		BaseNode: ast.BaseNode{
+			Comments: nil,
+			Errors:   nil,
+			Loc: &ast.SourceLocation{
+				End: ast.Position{
+					Column: 11,
+					Line:   4,
+				},
+				File:   "arrow.flux",
+				Source: "package arrow\n\nbuiltin from\nbuiltin to",
+				Start: ast.Position{
+					Column: 1,
+					Line:   1,
+				},
+			},
+			TrailingComment: nil,
+		},
+		Body: []ast.Statement{&ast.BuiltinStatement{
+			BaseNode: ast.BaseNode{
+				Comments: nil,
+				Errors:   nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 13,
+						Line:   3,
+					},
+					File:   "arrow.flux",
+					Source: "builtin from",
+					Start: ast.Position{
+						Column: 1,
+						Line:   3,
+					},
+				},
+				TrailingComment: nil,
+			},
+			ID: &ast.Identifier{
+				BaseNode: ast.BaseNode{
+					Comments: nil,
+					Errors:   nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 13,
+							Line:   3,
+						},
+						File:   "arrow.flux",
+						Source: "from",
+						Start: ast.Position{
+							Column: 9,
+							Line:   3,
+						},
+					},
+					TrailingComment: nil,
+				},
+				Name: "from",
+			},
+		}, &ast.BuiltinStatement{
+			BaseNode: ast.BaseNode{
+				Comments: nil,
+				Errors:   nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 11,
+						Line:   4,
+					},
+					File:   "arrow.flux",
+					Source: "builtin to",
+					Start: ast.Position{
+						Column: 1,
+						Line:   4,
+					},
+				},
+				TrailingComment: nil,
+			},
+			ID: &ast.Identifier{
+				BaseNode: ast.BaseNode{
+					Comments: nil,
+					Errors:   nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 11,
+							Line:   4,
+						},
+						File:   "arrow.flux",
+						Source: "to",
+						Start: ast.Position{
+							Column: 9,
+							Line:   4,
+						},
+					},
+					TrailingComment: nil,
+				},
+				Name: "to",
+			},
+		}},
+		Eof:      nil,
+		Imports:  nil,
+		Metadata: "parser-type=go",
+		Name:     "arrow.flux",
+		Package: &ast.PackageClause{
+			BaseNode: ast.BaseNode{
+				Comments: nil,
+				Errors:   nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 14,
+						Line:   1,
+					},
+					File:   "arrow.flux",
+					Source: "package arrow",
+					Start: ast.Position{
+						Column: 1,
+						Line:   1,
+					},
+				},
+				TrailingComment: nil,
+			},
+			Name: &ast.Identifier{
+				BaseNode: ast.BaseNode{
+					Comments: nil,
+					Errors:   nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 14,
+							Line:   1,
+						},
+						File:   "arrow.flux",
+						Source: "arrow",
+						Start: ast.Position{
+							Column: 9,
+							Line:   1,
+						},
+					},
+					TrailingComment: nil,
+				},
+				Name: "arrow",
+			},
+		},
+	}},
+	Package: "arrow",
+	Path:    "arrow",
+}
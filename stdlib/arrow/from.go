@@ -0,0 +1,437 @@
+package arrow
+
+import (
+	"bytes"
+	"context"
+	"io"
+
+	arrowlib "github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/ipc"
+	"github.com/influxdata/flux"
+	fluxarrow "github.com/influxdata/flux/arrow"
+	"github.com/influxdata/flux/codes"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/internal/columnar"
+	"github.com/influxdata/flux/internal/errors"
+	"github.com/influxdata/flux/interpreter"
+	"github.com/influxdata/flux/memory"
+	"github.com/influxdata/flux/plan"
+	"github.com/influxdata/flux/semantic"
+)
+
+const FromArrowKind = "fromArrow"
+
+type FromArrowOpSpec struct {
+	File    string   `json:"file"`
+	Columns []string `json:"columns,omitempty"`
+	// GroupKey is the list of columns the tables are grouped by.
+	// When it is nil, the group key stored in the file is used.
+	GroupKey []string `json:"groupKey"`
+}
+
+func init() {
+	fromArrowSignature := semantic.FunctionPolySignature{
+		Parameters: map[string]semantic.PolyType{
+			"file":     semantic.String,
+			"columns":  semantic.NewArrayPolyType(semantic.String),
+			"groupKey": semantic.NewArrayPolyType(semantic.String),
+		},
+		Required: semantic.LabelSet{"file"},
+		Return:   flux.TableObjectType,
+	}
+	flux.RegisterPackageValue("arrow", "from", flux.FunctionValue(FromArrowKind, createFromArrowOpSpec, fromArrowSignature))
+	flux.RegisterOpSpec(FromArrowKind, newFromArrowOp)
+	plan.RegisterProcedureSpec(FromArrowKind, newFromArrowProcedure, FromArrowKind)
+	plan.RegisterPhysicalRules(columnar.KeepRule{RuleName: "MergeArrowKeepRule", Source: FromArrowKind})
+	execute.RegisterSource(FromArrowKind, createFromArrowSource)
+}
+
+func createFromArrowOpSpec(args flux.Arguments, a *flux.Administration) (flux.OperationSpec, error) {
+	spec := new(FromArrowOpSpec)
+
+	file, err := args.GetRequiredString("file")
+	if err != nil {
+		return nil, err
+	}
+	if file == "" {
+		return nil, errors.New(codes.Invalid, "must provide a file name")
+	}
+	spec.File = file
+
+	if cols, ok, err := args.GetArray("columns", semantic.String); err != nil {
+		return nil, err
+	} else if ok {
+		if spec.Columns, err = interpreter.ToStringArray(cols); err != nil {
+			return nil, err
+		}
+	}
+
+	if key, ok, err := args.GetArray("groupKey", semantic.String); err != nil {
+		return nil, err
+	} else if ok {
+		if spec.GroupKey, err = interpreter.ToStringArray(key); err != nil {
+			return nil, err
+		}
+		if spec.GroupKey == nil {
+			spec.GroupKey = []string{}
+		}
+	}
+	return spec, nil
+}
+
+func newFromArrowOp() flux.OperationSpec {
+	return new(FromArrowOpSpec)
+}
+
+func (s *FromArrowOpSpec) Kind() flux.OperationKind {
+	return FromArrowKind
+}
+
+type FromArrowProcedureSpec struct {
+	plan.DefaultCost
+	File     string
+	Columns  []string
+	GroupKey []string
+}
+
+func newFromArrowProcedure(qs flux.OperationSpec, pa plan.Administration) (plan.ProcedureSpec, error) {
+	spec, ok := qs.(*FromArrowOpSpec)
+	if !ok {
+		return nil, errors.Newf(codes.Internal, "invalid spec type %T", qs)
+	}
+	return &FromArrowProcedureSpec{
+		File:     spec.File,
+		Columns:  spec.Columns,
+		GroupKey: spec.GroupKey,
+	}, nil
+}
+
+func (s *FromArrowProcedureSpec) Kind() plan.ProcedureKind {
+	return FromArrowKind
+}
+
+func (s *FromArrowProcedureSpec) Copy() plan.ProcedureSpec {
+	ns := *s
+	ns.Columns = append([]string(nil), s.Columns...)
+	if s.GroupKey != nil {
+		ns.GroupKey = append([]string{}, s.GroupKey...)
+	}
+	return &ns
+}
+
+// Project implements columnar.Projector.
+func (s *FromArrowProcedureSpec) Project(columns []string) (plan.PhysicalProcedureSpec, bool) {
+	cols, ok := columnar.ProjectColumns(s.Columns, s.GroupKey, columns)
+	if !ok {
+		return nil, false
+	}
+	ns := s.Copy().(*FromArrowProcedureSpec)
+	ns.Columns = cols
+	return ns, true
+}
+
+func createFromArrowSource(prSpec plan.ProcedureSpec, dsid execute.DatasetID, a execute.Administration) (execute.Source, error) {
+	spec, ok := prSpec.(*FromArrowProcedureSpec)
+	if !ok {
+		return nil, errors.Newf(codes.Internal, "invalid spec type %T", prSpec)
+	}
+	iterator := &arrowIterator{
+		spec:  spec,
+		deps:  flux.GetDependencies(a.Context()),
+		alloc: a.Allocator(),
+	}
+	return execute.CreateSourceFromIterator(iterator, dsid)
+}
+
+var _ execute.SourceIterator = (*arrowIterator)(nil)
+
+// arrowIterator reads the tables of an arrow file
+// through the filesystem service.
+type arrowIterator struct {
+	spec  *FromArrowProcedureSpec
+	deps  flux.Dependencies
+	alloc *memory.Allocator
+}
+
+func (s *arrowIterator) Do(ctx context.Context, f func(flux.Table) error) error {
+	tables, err := s.readTables()
+	if err != nil {
+		return errors.Wrap(err, codes.Inherit, "error in arrow.from()")
+	}
+	for i, tbl := range tables {
+		if err := f(tbl); err != nil {
+			// The tables that were not processed are still owned by the source.
+			for _, tbl := range tables[i+1:] {
+				tbl.Done()
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+// fileMagic begins the arrow IPC file format.
+// Files without it are read with the IPC stream format.
+const fileMagic = "ARROW1"
+
+// recordReader iterates over the records of a file or a stream.
+type recordReader interface {
+	Schema() *arrowlib.Schema
+	Next() bool
+	Record() array.Record
+	Err() error
+}
+
+// fileRecords adapts an ipc.FileReader to a recordReader.
+type fileRecords struct {
+	r   *ipc.FileReader
+	i   int
+	rec array.Record
+	err error
+}
+
+func (r *fileRecords) Schema() *arrowlib.Schema {
+	return r.r.Schema()
+}
+
+func (r *fileRecords) Next() bool {
+	if r.rec != nil {
+		r.rec.Release()
+		r.rec = nil
+	}
+	if r.err != nil || r.i >= r.r.NumRecords() {
+		return false
+	}
+	r.rec, r.err = r.r.Record(r.i)
+	r.i++
+	return r.err == nil
+}
+
+func (r *fileRecords) Record() array.Record {
+	return r.rec
+}
+
+func (r *fileRecords) Err() error {
+	return r.err
+}
+
+func (s *arrowIterator) readTables() ([]flux.Table, error) {
+	fs, err := s.deps.FilesystemService()
+	if err != nil {
+		return nil, err
+	}
+	f, _, err := columnar.OpenFile(fs, s.spec.File)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = f.Close() }()
+
+	magic := make([]byte, len(fileMagic))
+	n, err := io.ReadFull(f, magic)
+	if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+		return nil, err
+	}
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+
+	var rr recordReader
+	if bytes.Equal(magic[:n], []byte(fileMagic)) {
+		fr, err := ipc.NewFileReader(columnar.NewReaderAt(f), ipc.WithAllocator(s.alloc))
+		if err != nil {
+			return nil, errors.Wrapf(err, codes.Invalid, "failed to read %q", s.spec.File)
+		}
+		defer func() { _ = fr.Close() }()
+		rr = &fileRecords{r: fr}
+	} else {
+		sr, err := ipc.NewReader(f, ipc.WithAllocator(s.alloc))
+		if err != nil {
+			return nil, errors.Wrapf(err, codes.Invalid, "failed to read %q", s.spec.File)
+		}
+		defer sr.Release()
+		rr = sr
+	}
+
+	schema := rr.Schema()
+	names := make([]string, len(schema.Fields()))
+	for i, field := range schema.Fields() {
+		names[i] = field.Name
+	}
+	idx := columnar.Project(names, s.spec.Columns)
+	cols := make([]flux.ColMeta, len(idx))
+	scales := make([]int64, len(idx))
+	for i, j := range idx {
+		field := schema.Field(j)
+		typ, scale := columnType(field.Type)
+		if typ == flux.TInvalid {
+			return nil, errors.Newf(codes.Unimplemented, "column %q has arrow type %s that is not supported; use columns to skip it", field.Name, field.Type)
+		}
+		cols[i] = flux.ColMeta{Label: field.Name, Type: typ}
+		scales[i] = scale
+	}
+	md := schema.Metadata()
+	var (
+		stored string
+		hasMD  bool
+	)
+	if k := md.FindKey(columnar.GroupKeyMetadataKey); k >= 0 {
+		stored, hasMD = md.Values()[k], true
+	}
+	key, err := columnar.GroupKeyColumns(cols, s.spec.GroupKey, s.spec.GroupKey != nil, stored, hasMD)
+	if err != nil {
+		return nil, err
+	}
+
+	var buffers []*fluxarrow.TableBuffer
+	for rr.Next() {
+		rec := rr.Record()
+		buf := &fluxarrow.TableBuffer{
+			Columns: cols,
+			Values:  make([]array.Interface, len(cols)),
+		}
+		for i, j := range idx {
+			buf.Values[i] = convert(rec.Column(j), cols[i].Type, scales[i], s.alloc)
+		}
+		buffers = append(buffers, buf)
+	}
+	if err := rr.Err(); err != nil {
+		for _, buf := range buffers {
+			buf.Release()
+		}
+		return nil, errors.Wrapf(err, codes.Invalid, "failed to read %q", s.spec.File)
+	}
+	return columnar.Tables(buffers, cols, key, s.alloc)
+}
+
+const nanosPerDay = int64(24 * 60 * 60 * 1e9)
+
+// columnType returns the flux type of an arrow data type. For times
+// it also returns the factor that converts the values to nanoseconds.
+// It returns flux.TInvalid if the data type cannot be read.
+func columnType(dt arrowlib.DataType) (flux.ColType, int64) {
+	switch dt.ID() {
+	case arrowlib.INT8, arrowlib.INT16, arrowlib.INT32, arrowlib.INT64:
+		return flux.TInt, 1
+	case arrowlib.UINT8, arrowlib.UINT16, arrowlib.UINT32, arrowlib.UINT64:
+		return flux.TUInt, 1
+	case arrowlib.FLOAT32, arrowlib.FLOAT64:
+		return flux.TFloat, 1
+	case arrowlib.STRING, arrowlib.BINARY:
+		return flux.TString, 1
+	case arrowlib.BOOL:
+		return flux.TBool, 1
+	case arrowlib.TIMESTAMP:
+		switch dt.(*arrowlib.TimestampType).Unit {
+		case arrowlib.Second:
+			return flux.TTime, 1e9
+		case arrowlib.Millisecond:
+			return flux.TTime, 1e6
+		case arrowlib.Microsecond:
+			return flux.TTime, 1e3
+		default:
+			return flux.TTime, 1
+		}
+	case arrowlib.DATE32:
+		return flux.TTime, nanosPerDay
+	case arrowlib.DATE64:
+		return flux.TTime, 1e6
+	default:
+		return flux.TInvalid, 0
+	}
+}
+
+// convert converts an arrow array into the array flux uses for the type.
+// Arrays that already have the representation used by flux are retained
+// instead of copied.
+func convert(arr array.Interface, typ flux.ColType, scale int64, mem *memory.Allocator) array.Interface {
+	switch arr := arr.(type) {
+	case *array.Int64:
+		if typ == flux.TInt {
+			arr.Retain()
+			return arr
+		}
+	case *array.Uint64, *array.Float64, *array.Boolean:
+		arr.Retain()
+		return arr
+	case *array.String:
+		return array.NewBinaryData(arr.Data())
+	}
+
+	b := fluxarrow.NewBuilder(typ, mem)
+	defer b.Release()
+	b.Reserve(arr.Len())
+	switch b := b.(type) {
+	case *array.Int64Builder:
+		value := intValue(arr)
+		for i := 0; i < arr.Len(); i++ {
+			if arr.IsNull(i) {
+				b.AppendNull()
+				continue
+			}
+			b.Append(value(i) * scale)
+		}
+	case *array.Uint64Builder:
+		value := uintValue(arr)
+		for i := 0; i < arr.Len(); i++ {
+			if arr.IsNull(i) {
+				b.AppendNull()
+				continue
+			}
+			b.Append(value(i))
+		}
+	case *array.Float64Builder:
+		vs := arr.(*array.Float32)
+		for i := 0; i < arr.Len(); i++ {
+			if arr.IsNull(i) {
+				b.AppendNull()
+				continue
+			}
+			b.Append(float64(vs.Value(i)))
+		}
+	case *array.BinaryBuilder:
+		vs := arr.(*array.Binary)
+		for i := 0; i < arr.Len(); i++ {
+			if arr.IsNull(i) {
+				b.AppendNull()
+				continue
+			}
+			b.Append(vs.Value(i))
+		}
+	}
+	return b.NewArray()
+}
+
+func intValue(arr array.Interface) func(i int) int64 {
+	switch arr := arr.(type) {
+	case *array.Int8:
+		return func(i int) int64 { return int64(arr.Value(i)) }
+	case *array.Int16:
+		return func(i int) int64 { return int64(arr.Value(i)) }
+	case *array.Int32:
+		return func(i int) int64 { return int64(arr.Value(i)) }
+	case *array.Int64:
+		return arr.Value
+	case *array.Timestamp:
+		return func(i int) int64 { return int64(arr.Value(i)) }
+	case *array.Date32:
+		return func(i int) int64 { return int64(arr.Value(i)) }
+	case *array.Date64:
+		return func(i int) int64 { return int64(arr.Value(i)) }
+	default:
+		panic(errors.Newf(codes.Internal, "unexpected array type %T", arr))
+	}
+}
+
+func uintValue(arr array.Interface) func(i int) uint64 {
+	switch arr := arr.(type) {
+	case *array.Uint8:
+		return func(i int) uint64 { return uint64(arr.Value(i)) }
+	case *array.Uint16:
+		return func(i int) uint64 { return uint64(arr.Value(i)) }
+	case *array.Uint32:
+		return func(i int) uint64 { return uint64(arr.Value(i)) }
+	default:
+		panic(errors.Newf(codes.Internal, "unexpected array type %T", arr))
+	}
+}
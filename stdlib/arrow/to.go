@@ -0,0 +1,170 @@
+package arrow
+
+import (
+	"io"
+
+	arrowlib "github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/ipc"
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/codes"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/internal/columnar"
+	"github.com/influxdata/flux/internal/errors"
+	"github.com/influxdata/flux/internal/execute/table"
+	"github.com/influxdata/flux/memory"
+	"github.com/influxdata/flux/plan"
+	"github.com/influxdata/flux/semantic"
+)
+
+const ToArrowKind = "toArrow"
+
+type ToArrowOpSpec struct {
+	File string `json:"file"`
+}
+
+func init() {
+	toArrowSignature := flux.FunctionSignature(
+		map[string]semantic.PolyType{
+			"file": semantic.String,
+		},
+		[]string{"file"},
+	)
+	flux.RegisterPackageValue("arrow", "to", flux.FunctionValueWithSideEffect(ToArrowKind, createToArrowOpSpec, toArrowSignature))
+	flux.RegisterOpSpec(ToArrowKind, func() flux.OperationSpec { return &ToArrowOpSpec{} })
+	plan.RegisterProcedureSpecWithSideEffect(ToArrowKind, newToArrowProcedure, ToArrowKind)
+	execute.RegisterTransformation(ToArrowKind, createToArrowTransformation)
+}
+
+func createToArrowOpSpec(args flux.Arguments, a *flux.Administration) (flux.OperationSpec, error) {
+	if err := a.AddParentFromArgs(args); err != nil {
+		return nil, err
+	}
+	file, err := args.GetRequiredString("file")
+	if err != nil {
+		return nil, err
+	}
+	if file == "" {
+		return nil, errors.New(codes.Invalid, "must provide a file name")
+	}
+	return &ToArrowOpSpec{File: file}, nil
+}
+
+func (ToArrowOpSpec) Kind() flux.OperationKind {
+	return ToArrowKind
+}
+
+type ToArrowProcedureSpec struct {
+	plan.DefaultCost
+	File string
+}
+
+func newToArrowProcedure(qs flux.OperationSpec, a plan.Administration) (plan.ProcedureSpec, error) {
+	spec, ok := qs.(*ToArrowOpSpec)
+	if !ok {
+		return nil, errors.Newf(codes.Internal, "invalid spec type %T", qs)
+	}
+	return &ToArrowProcedureSpec{File: spec.File}, nil
+}
+
+func (s *ToArrowProcedureSpec) Kind() plan.ProcedureKind {
+	return ToArrowKind
+}
+
+func (s *ToArrowProcedureSpec) Copy() plan.ProcedureSpec {
+	ns := *s
+	return &ns
+}
+
+func createToArrowTransformation(id execute.DatasetID, mode execute.AccumulationMode, spec plan.ProcedureSpec, a execute.Administration) (execute.Transformation, execute.Dataset, error) {
+	s, ok := spec.(*ToArrowProcedureSpec)
+	if !ok {
+		return nil, nil, errors.Newf(codes.Internal, "invalid spec type %T", spec)
+	}
+	deps := flux.GetDependencies(a.Context())
+	t, d := columnar.NewWriteTransformation(id, deps, a.Allocator(), s.File, writeArrow)
+	return t, d, nil
+}
+
+// timestampType is the arrow type of the flux time columns.
+var timestampType = &arrowlib.TimestampType{Unit: arrowlib.Nanosecond, TimeZone: "UTC"}
+
+// dataType returns the arrow type that a flux column is written with.
+func dataType(typ flux.ColType) (arrowlib.DataType, error) {
+	switch typ {
+	case flux.TInt:
+		return arrowlib.PrimitiveTypes.Int64, nil
+	case flux.TUInt:
+		return arrowlib.PrimitiveTypes.Uint64, nil
+	case flux.TFloat:
+		return arrowlib.PrimitiveTypes.Float64, nil
+	case flux.TString:
+		return arrowlib.BinaryTypes.String, nil
+	case flux.TBool:
+		return arrowlib.FixedWidthTypes.Boolean, nil
+	case flux.TTime:
+		return timestampType, nil
+	default:
+		return nil, errors.Newf(codes.Internal, "unsupported column type %s", typ)
+	}
+}
+
+// writeArrow writes every buffer of the tables as a record of an arrow IPC file.
+func writeArrow(w io.WriteSeeker, c *columnar.Collector, mem *memory.Allocator) error {
+	fields := make([]arrowlib.Field, len(c.Columns()))
+	for i, col := range c.Columns() {
+		typ, err := dataType(col.Type)
+		if err != nil {
+			return err
+		}
+		fields[i] = arrowlib.Field{Name: col.Label, Type: typ, Nullable: true}
+	}
+	md := arrowlib.NewMetadata(
+		[]string{columnar.GroupKeyMetadataKey},
+		[]string{columnar.EncodeGroupKey(c.GroupKey())},
+	)
+	schema := arrowlib.NewSchema(fields, &md)
+
+	fw, err := ipc.NewFileWriter(w, ipc.WithSchema(schema), ipc.WithAllocator(mem))
+	if err != nil {
+		return err
+	}
+	if err := c.Tables(mem, func(buffers []flux.ColReader) error {
+		for _, cr := range buffers {
+			if err := writeRecord(fw, schema, cr); err != nil {
+				return err
+			}
+		}
+		return nil
+	}); err != nil {
+		_ = fw.Close()
+		return err
+	}
+	return fw.Close()
+}
+
+func writeRecord(fw *ipc.FileWriter, schema *arrowlib.Schema, cr flux.ColReader) error {
+	cols := make([]array.Interface, len(cr.Cols()))
+	for j := range cols {
+		vs := table.Values(cr, j)
+		switch cr.Cols()[j].Type {
+		case flux.TString:
+			// The IPC writer expects string columns to be an *array.String.
+			cols[j] = array.NewStringData(vs.Data())
+		case flux.TTime:
+			data := vs.Data()
+			ts := array.NewData(timestampType, data.Len(), data.Buffers(), nil, data.NullN(), data.Offset())
+			cols[j] = array.NewTimestampData(ts)
+			ts.Release()
+		default:
+			vs.Retain()
+			cols[j] = vs
+		}
+	}
+	rec := array.NewRecord(schema, cols, int64(cr.Len()))
+	for _, vs := range cols {
+		vs.Release()
+	}
+	defer rec.Release()
+	return fw.Write(rec)
+}
@@ -13,12 +13,15 @@ func init() {
 
 var pkgAST = &ast.Package{
 	BaseNode: ast.BaseNode{
-		Errors: nil,
-		Loc:    nil,
+		Comments:        nil,
+		Errors:          nil,
+		Loc:             nil,
+		TrailingComment: nil,
 	},
 	Files: []*ast.File{&ast.File{
 		BaseNode: ast.BaseNode{
-			Errors: nil,
+			Comments: nil,
+			Errors:   nil,
 			Loc: &ast.SourceLocation{
 				End: ast.Position{
 					Column: 13,
@@ -31,10 +34,12 @@ var pkgAST = &ast.Package{
 					Line:   1,
 				},
 			},
+			TrailingComment: nil,
 		},
 		Body: []ast.Statement{&ast.BuiltinStatement{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 13,
@@ -47,10 +52,12 @@ var pkgAST = &ast.Package{
 						Line:   3,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 13,
@@ -63,16 +70,19 @@ var pkgAST = &ast.Package{
 							Line:   3,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "from",
 			},
 		}},
+		Eof:      nil,
 		Imports:  nil,
 		Metadata: "parser-type=go",
 		Name:     "csv.flux",
 		Package: &ast.PackageClause{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 12,
@@ -85,10 +95,12 @@ var pkgAST = &ast.Package{
 						Line:   1,
 					},
 				},
+				TrailingComment: nil,
 			},
 			Name: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 12,
@@ -101,6 +113,7 @@ var pkgAST = &ast.Package{
 							Line:   1,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "csv",
 			},
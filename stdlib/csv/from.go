@@ -2,6 +2,8 @@ package csv
 
 import (
 	"context"
+	stdcsv "encoding/csv"
+	"io"
 	"strings"
 
 	"github.com/influxdata/flux"
@@ -72,9 +74,12 @@ func (s *FromCSVOpSpec) Kind() flux.OperationKind {
 }
 
 type FromCSVProcedureSpec struct {
-	plan.DefaultCost
 	CSV  string
 	File string
+
+	// Statistics are estimated from the raw csv text when
+	// the procedure is created. They are unknown for files.
+	Statistics plan.Statistics
 }
 
 func newFromCSVProcedure(qs flux.OperationSpec, pa plan.Administration) (plan.ProcedureSpec, error) {
@@ -83,9 +88,14 @@ func newFromCSVProcedure(qs flux.OperationSpec, pa plan.Administration) (plan.Pr
 		return nil, errors.Newf(codes.Internal, "invalid spec type %T", qs)
 	}
 
+	stats := plan.UnknownStatistics()
+	if spec.File == "" {
+		stats = estimateCSVStatistics(spec.CSV)
+	}
 	return &FromCSVProcedureSpec{
-		CSV:  spec.CSV,
-		File: spec.File,
+		CSV:        spec.CSV,
+		File:       spec.File,
+		Statistics: stats,
 	}, nil
 }
 
@@ -97,9 +107,73 @@ func (s *FromCSVProcedureSpec) Copy() plan.ProcedureSpec {
 	ns := new(FromCSVProcedureSpec)
 	ns.CSV = s.CSV
 	ns.File = s.File
+	ns.Statistics = s.Statistics
 	return ns
 }
 
+// Cost reports the statistics estimated from the csv text.
+// Decoding the text visits each row once.
+func (s *FromCSVProcedureSpec) Cost(inStats []plan.Statistics) (plan.Cost, plan.Statistics) {
+	return plan.Cost{CPU: s.Statistics.Rows()}, s.Statistics
+}
+
+// estimateCSVStatistics counts the data rows and the distinct tables
+// in annotated csv text without decoding the values.
+func estimateCSVStatistics(text string) plan.Statistics {
+	r := stdcsv.NewReader(strings.NewReader(text))
+	r.FieldsPerRecord = -1
+	r.ReuseRecord = true
+
+	var (
+		rows, blocks int64
+		tableIdx     = -1
+		tables       = make(map[string]struct{})
+	)
+	// The first row that is not an annotation is the header.
+	header := true
+	for {
+		record, err := r.Read()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return plan.UnknownStatistics()
+		}
+
+		// Annotations start a new block of tables
+		// and are followed by a header row.
+		if len(record) > 0 && strings.HasPrefix(record[0], "#") {
+			header = true
+			continue
+		}
+		if header {
+			header = false
+			blocks++
+			tableIdx = -1
+			for i, label := range record {
+				if label == "table" {
+					tableIdx = i
+					break
+				}
+			}
+			continue
+		}
+
+		rows++
+		if tableIdx >= 0 && tableIdx < len(record) {
+			tables[record[tableIdx]] = struct{}{}
+		}
+	}
+
+	groups := int64(len(tables))
+	if groups == 0 {
+		groups = blocks
+	}
+	return plan.Statistics{
+		Cardinality:      rows,
+		GroupCardinality: groups,
+	}
+}
+
 func createFromCSVSource(prSpec plan.ProcedureSpec, dsid execute.DatasetID, a execute.Administration) (execute.Source, error) {
 	spec, ok := prSpec.(*FromCSVProcedureSpec)
 	if !ok {
@@ -1,12 +1,17 @@
 package csv_test
 
 import (
+	"context"
 	"testing"
 	"time"
 
+	"github.com/google/go-cmp/cmp"
 	"github.com/influxdata/flux"
 	_ "github.com/influxdata/flux/builtin" // We need to import the builtins for the tests to work.
+	"github.com/influxdata/flux/dependencies/dependenciestest"
 	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/internal/spec"
+	"github.com/influxdata/flux/plan"
 	"github.com/influxdata/flux/querytest"
 	"github.com/influxdata/flux/stdlib/csv"
 	"github.com/influxdata/flux/stdlib/universe"
@@ -94,3 +99,55 @@ func TestFromCSVOperation_Marshaling(t *testing.T) {
 	}
 	querytest.OperationMarshalingTestHelper(t, data, op)
 }
+
+func TestFromCSV_Statistics(t *testing.T) {
+	for _, tt := range []struct {
+		name string
+		csv  string
+		want plan.Statistics
+	}{
+		{
+			name: "annotated",
+			csv: `#datatype,string,long,dateTime:RFC3339,double,string
+#group,false,false,false,false,true
+#default,_result,,,,
+,result,table,_time,_value,host
+,,0,2018-05-22T19:53:26Z,1.0,a
+,,0,2018-05-22T19:53:36Z,2.0,a
+,,1,2018-05-22T19:53:26Z,3.0,b
+
+#datatype,string,long,dateTime:RFC3339,double,string,string
+#group,false,false,false,false,true,true
+#default,_result,,,,,
+,result,table,_time,_value,host,region
+,,2,2018-05-22T19:53:26Z,4.0,c,west
+`,
+			want: plan.Statistics{Cardinality: 4, GroupCardinality: 3},
+		},
+		{
+			name: "no table column",
+			csv: `_time,_value
+2018-05-22T19:53:26Z,1.0
+2018-05-22T19:53:36Z,2.0
+`,
+			want: plan.Statistics{Cardinality: 2, GroupCardinality: 1},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := dependenciestest.Default().Inject(context.Background())
+			fluxSpec, err := spec.FromScript(ctx, time.Now(), `import "csv" csv.from(csv: "`+tt.csv+`")`)
+			if err != nil {
+				t.Fatal(err)
+			}
+			ps, err := plan.PlannerBuilder{}.Build().Plan(fluxSpec)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for root := range ps.Roots {
+				if _, got := plan.Estimate(root); !cmp.Equal(tt.want, got) {
+					t.Errorf("unexpected statistics -want/+got:\n%s", cmp.Diff(tt.want, got))
+				}
+			}
+		})
+	}
+}
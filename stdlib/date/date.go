@@ -21,7 +21,7 @@ func init() {
 		"second": values.NewFunction(
 			"second",
 			semantic.NewFunctionPolyType(semantic.FunctionPolySignature{
-				Parameters: map[string]semantic.PolyType{"t": semantic.Time},
+				Parameters: map[string]semantic.PolyType{"t": semantic.Time, "location": flux.LocationPolyType},
 				Required:   semantic.LabelSet{"t"},
 				Return:     semantic.Int,
 			}),
@@ -30,9 +30,13 @@ func init() {
 				if !ok {
 					return nil, errors.New(codes.Invalid, "missing argument t")
 				}
+				location, err := getLocation(args)
+				if err != nil {
+					return nil, err
+				}
 
 				if v1.Type().Nature() == semantic.Time {
-					return values.NewInt(int64(v1.Time().Time().Second())), nil
+					return values.NewInt(int64(location.ToCivil(v1.Time()).Time().Second())), nil
 				}
 				return nil, fmt.Errorf("cannot convert argument t of type %v to time", v1.Type().Nature())
 			}, false,
@@ -40,7 +44,7 @@ func init() {
 		"minute": values.NewFunction(
 			"minute",
 			semantic.NewFunctionPolyType(semantic.FunctionPolySignature{
-				Parameters: map[string]semantic.PolyType{"t": semantic.Time},
+				Parameters: map[string]semantic.PolyType{"t": semantic.Time, "location": flux.LocationPolyType},
 				Required:   semantic.LabelSet{"t"},
 				Return:     semantic.Int,
 			}),
@@ -49,9 +53,13 @@ func init() {
 				if !ok {
 					return nil, errors.New(codes.Invalid, "missing argument t")
 				}
+				location, err := getLocation(args)
+				if err != nil {
+					return nil, err
+				}
 
 				if v1.Type().Nature() == semantic.Time {
-					return values.NewInt(int64(v1.Time().Time().Minute())), nil
+					return values.NewInt(int64(location.ToCivil(v1.Time()).Time().Minute())), nil
 				}
 				return nil, fmt.Errorf("cannot convert argument t of type %v to time", v1.Type().Nature())
 			}, false,
@@ -59,7 +67,7 @@ func init() {
 		"hour": values.NewFunction(
 			"hour",
 			semantic.NewFunctionPolyType(semantic.FunctionPolySignature{
-				Parameters: map[string]semantic.PolyType{"t": semantic.Time},
+				Parameters: map[string]semantic.PolyType{"t": semantic.Time, "location": flux.LocationPolyType},
 				Required:   semantic.LabelSet{"t"},
 				Return:     semantic.Int,
 			}),
@@ -68,9 +76,13 @@ func init() {
 				if !ok {
 					return nil, errors.New(codes.Invalid, "missing argument t")
 				}
+				location, err := getLocation(args)
+				if err != nil {
+					return nil, err
+				}
 
 				if v1.Type().Nature() == semantic.Time {
-					return values.NewInt(int64(v1.Time().Time().Hour())), nil
+					return values.NewInt(int64(location.ToCivil(v1.Time()).Time().Hour())), nil
 				}
 				return nil, fmt.Errorf("cannot convert argument t of type %v to time", v1.Type().Nature())
 			}, false,
@@ -78,7 +90,7 @@ func init() {
 		"weekDay": values.NewFunction(
 			"weekDay",
 			semantic.NewFunctionPolyType(semantic.FunctionPolySignature{
-				Parameters: map[string]semantic.PolyType{"t": semantic.Time},
+				Parameters: map[string]semantic.PolyType{"t": semantic.Time, "location": flux.LocationPolyType},
 				Required:   semantic.LabelSet{"t"},
 				Return:     semantic.Int,
 			}),
@@ -87,9 +99,13 @@ func init() {
 				if !ok {
 					return nil, errors.New(codes.Invalid, "missing argument t")
 				}
+				location, err := getLocation(args)
+				if err != nil {
+					return nil, err
+				}
 
 				if v1.Type().Nature() == semantic.Time {
-					return values.NewInt(int64(v1.Time().Time().Weekday())), nil
+					return values.NewInt(int64(location.ToCivil(v1.Time()).Time().Weekday())), nil
 				}
 				return nil, fmt.Errorf("cannot convert argument t of type %v to time", v1.Type().Nature())
 			}, false,
@@ -97,7 +113,7 @@ func init() {
 		"monthDay": values.NewFunction(
 			"monthDay",
 			semantic.NewFunctionPolyType(semantic.FunctionPolySignature{
-				Parameters: map[string]semantic.PolyType{"t": semantic.Time},
+				Parameters: map[string]semantic.PolyType{"t": semantic.Time, "location": flux.LocationPolyType},
 				Required:   semantic.LabelSet{"t"},
 				Return:     semantic.Int,
 			}),
@@ -106,9 +122,13 @@ func init() {
 				if !ok {
 					return nil, errors.New(codes.Invalid, "missing argument t")
 				}
+				location, err := getLocation(args)
+				if err != nil {
+					return nil, err
+				}
 
 				if v1.Type().Nature() == semantic.Time {
-					return values.NewInt(int64(v1.Time().Time().Day())), nil
+					return values.NewInt(int64(location.ToCivil(v1.Time()).Time().Day())), nil
 				}
 				return nil, fmt.Errorf("cannot convert argument t of type %v to time", v1.Type().Nature())
 			}, false,
@@ -116,7 +136,7 @@ func init() {
 		"yearDay": values.NewFunction(
 			"yearDay",
 			semantic.NewFunctionPolyType(semantic.FunctionPolySignature{
-				Parameters: map[string]semantic.PolyType{"t": semantic.Time},
+				Parameters: map[string]semantic.PolyType{"t": semantic.Time, "location": flux.LocationPolyType},
 				Required:   semantic.LabelSet{"t"},
 				Return:     semantic.Int,
 			}),
@@ -125,9 +145,13 @@ func init() {
 				if !ok {
 					return nil, errors.New(codes.Invalid, "missing argument t")
 				}
+				location, err := getLocation(args)
+				if err != nil {
+					return nil, err
+				}
 
 				if v1.Type().Nature() == semantic.Time {
-					return values.NewInt(int64(v1.Time().Time().YearDay())), nil
+					return values.NewInt(int64(location.ToCivil(v1.Time()).Time().YearDay())), nil
 				}
 				return nil, fmt.Errorf("cannot convert argument t of type %v to time", v1.Type().Nature())
 			}, false,
@@ -135,7 +159,7 @@ func init() {
 		"month": values.NewFunction(
 			"month",
 			semantic.NewFunctionPolyType(semantic.FunctionPolySignature{
-				Parameters: map[string]semantic.PolyType{"t": semantic.Time},
+				Parameters: map[string]semantic.PolyType{"t": semantic.Time, "location": flux.LocationPolyType},
 				Required:   semantic.LabelSet{"t"},
 				Return:     semantic.Int,
 			}),
@@ -144,9 +168,13 @@ func init() {
 				if !ok {
 					return nil, errors.New(codes.Invalid, "missing argument t")
 				}
+				location, err := getLocation(args)
+				if err != nil {
+					return nil, err
+				}
 
 				if v1.Type().Nature() == semantic.Time {
-					return values.NewInt(int64(v1.Time().Time().Month())), nil
+					return values.NewInt(int64(location.ToCivil(v1.Time()).Time().Month())), nil
 				}
 				return nil, fmt.Errorf("cannot convert argument t of type %v to time", v1.Type().Nature())
 			}, false,
@@ -154,7 +182,7 @@ func init() {
 		"year": values.NewFunction(
 			"year",
 			semantic.NewFunctionPolyType(semantic.FunctionPolySignature{
-				Parameters: map[string]semantic.PolyType{"t": semantic.Time},
+				Parameters: map[string]semantic.PolyType{"t": semantic.Time, "location": flux.LocationPolyType},
 				Required:   semantic.LabelSet{"t"},
 				Return:     semantic.Int,
 			}),
@@ -163,9 +191,13 @@ func init() {
 				if !ok {
 					return nil, errors.New(codes.Invalid, "missing argument t")
 				}
+				location, err := getLocation(args)
+				if err != nil {
+					return nil, err
+				}
 
 				if v1.Type().Nature() == semantic.Time {
-					return values.NewInt(int64(v1.Time().Time().Year())), nil
+					return values.NewInt(int64(location.ToCivil(v1.Time()).Time().Year())), nil
 				}
 				return nil, fmt.Errorf("cannot convert argument t of type %v to time", v1.Type().Nature())
 			}, false,
@@ -173,7 +205,7 @@ func init() {
 		"week": values.NewFunction(
 			"week",
 			semantic.NewFunctionPolyType(semantic.FunctionPolySignature{
-				Parameters: map[string]semantic.PolyType{"t": semantic.Time},
+				Parameters: map[string]semantic.PolyType{"t": semantic.Time, "location": flux.LocationPolyType},
 				Required:   semantic.LabelSet{"t"},
 				Return:     semantic.Int,
 			}),
@@ -182,9 +214,13 @@ func init() {
 				if !ok {
 					return nil, errors.New(codes.Invalid, "missing argument t")
 				}
+				location, err := getLocation(args)
+				if err != nil {
+					return nil, err
+				}
 
 				if v1.Type().Nature() == semantic.Time {
-					_, week := v1.Time().Time().ISOWeek()
+					_, week := location.ToCivil(v1.Time()).Time().ISOWeek()
 					return values.NewInt(int64(week)), nil
 				}
 				return nil, fmt.Errorf("cannot convert argument t of type %v to time", v1.Type().Nature())
@@ -193,7 +229,7 @@ func init() {
 		"quarter": values.NewFunction(
 			"quarter",
 			semantic.NewFunctionPolyType(semantic.FunctionPolySignature{
-				Parameters: map[string]semantic.PolyType{"t": semantic.Time},
+				Parameters: map[string]semantic.PolyType{"t": semantic.Time, "location": flux.LocationPolyType},
 				Required:   semantic.LabelSet{"t"},
 				Return:     semantic.Int,
 			}),
@@ -202,9 +238,13 @@ func init() {
 				if !ok {
 					return nil, errors.New(codes.Invalid, "missing argument t")
 				}
+				location, err := getLocation(args)
+				if err != nil {
+					return nil, err
+				}
 
 				if v1.Type().Nature() == semantic.Time {
-					month := v1.Time().Time().Month()
+					month := location.ToCivil(v1.Time()).Time().Month()
 					return values.NewInt(int64(math.Ceil(float64(month) / 3.0))), nil
 				}
 				return nil, fmt.Errorf("cannot convert argument t of type %v to time", v1.Type().Nature())
@@ -213,7 +253,7 @@ func init() {
 		"millisecond": values.NewFunction(
 			"millisecond",
 			semantic.NewFunctionPolyType(semantic.FunctionPolySignature{
-				Parameters: map[string]semantic.PolyType{"t": semantic.Time},
+				Parameters: map[string]semantic.PolyType{"t": semantic.Time, "location": flux.LocationPolyType},
 				Required:   semantic.LabelSet{"t"},
 				Return:     semantic.Int,
 			}),
@@ -222,9 +262,13 @@ func init() {
 				if !ok {
 					return nil, errors.New(codes.Invalid, "missing argument t")
 				}
+				location, err := getLocation(args)
+				if err != nil {
+					return nil, err
+				}
 
 				if v1.Type().Nature() == semantic.Time {
-					millisecond := int64(time.Nanosecond) * int64(v1.Time().Time().Nanosecond()) / int64(time.Millisecond)
+					millisecond := int64(time.Nanosecond) * int64(location.ToCivil(v1.Time()).Time().Nanosecond()) / int64(time.Millisecond)
 					return values.NewInt(millisecond), nil
 				}
 				return nil, fmt.Errorf("cannot convert argument t of type %v to time", v1.Type().Nature())
@@ -233,7 +277,7 @@ func init() {
 		"microsecond": values.NewFunction(
 			"microsecond",
 			semantic.NewFunctionPolyType(semantic.FunctionPolySignature{
-				Parameters: map[string]semantic.PolyType{"t": semantic.Time},
+				Parameters: map[string]semantic.PolyType{"t": semantic.Time, "location": flux.LocationPolyType},
 				Required:   semantic.LabelSet{"t"},
 				Return:     semantic.Int,
 			}),
@@ -242,9 +286,13 @@ func init() {
 				if !ok {
 					return nil, errors.New(codes.Invalid, "missing argument t")
 				}
+				location, err := getLocation(args)
+				if err != nil {
+					return nil, err
+				}
 
 				if v1.Type().Nature() == semantic.Time {
-					microsecond := int64(time.Nanosecond) * int64(v1.Time().Time().Nanosecond()) / int64(time.Microsecond)
+					microsecond := int64(time.Nanosecond) * int64(location.ToCivil(v1.Time()).Time().Nanosecond()) / int64(time.Microsecond)
 					return values.NewInt(microsecond), nil
 				}
 				return nil, fmt.Errorf("cannot convert argument t of type %v to time", v1.Type().Nature())
@@ -253,7 +301,7 @@ func init() {
 		"nanosecond": values.NewFunction(
 			"nanosecond",
 			semantic.NewFunctionPolyType(semantic.FunctionPolySignature{
-				Parameters: map[string]semantic.PolyType{"t": semantic.Time},
+				Parameters: map[string]semantic.PolyType{"t": semantic.Time, "location": flux.LocationPolyType},
 				Required:   semantic.LabelSet{"t"},
 				Return:     semantic.Int,
 			}),
@@ -262,9 +310,13 @@ func init() {
 				if !ok {
 					return nil, errors.New(codes.Invalid, "missing argument t")
 				}
+				location, err := getLocation(args)
+				if err != nil {
+					return nil, err
+				}
 
 				if v1.Type().Nature() == semantic.Time {
-					return values.NewInt(int64(v1.Time().Time().Nanosecond())), nil
+					return values.NewInt(int64(location.ToCivil(v1.Time()).Time().Nanosecond())), nil
 				}
 				return nil, fmt.Errorf("cannot convert argument t of type %v to time", v1.Type().Nature())
 			}, false,
@@ -272,7 +324,7 @@ func init() {
 		"truncate": values.NewFunction(
 			"truncate",
 			semantic.NewFunctionPolyType(semantic.FunctionPolySignature{
-				Parameters: map[string]semantic.PolyType{"t": semantic.Time, "unit": semantic.Duration},
+				Parameters: map[string]semantic.PolyType{"t": semantic.Time, "unit": semantic.Duration, "location": flux.LocationPolyType},
 				Required:   semantic.LabelSet{"t", "unit"},
 				Return:     semantic.Time,
 			}),
@@ -287,8 +339,13 @@ func init() {
 					return nil, errors.New(codes.Invalid, "missing argument unit")
 				}
 
+				location, err := getLocation(args)
+				if err != nil {
+					return nil, err
+				}
+
 				if v.Type().Nature() == semantic.Time && u.Type().Nature() == semantic.Duration {
-					w, err := execute.NewWindow(u.Duration(), u.Duration(), execute.Duration{})
+					w, err := execute.NewWindowInLocation(u.Duration(), u.Duration(), execute.Duration{}, location)
 					if err != nil {
 						return nil, err
 					}
@@ -298,20 +355,251 @@ func init() {
 				return nil, fmt.Errorf("cannot truncate argument t of type %v to unit %v", v.Type().Nature(), u)
 			}, false,
 		),
+		"add": values.NewFunction(
+			"add",
+			semantic.NewFunctionPolyType(semantic.FunctionPolySignature{
+				Parameters: map[string]semantic.PolyType{"d": semantic.Duration, "to": semantic.Time, "location": flux.LocationPolyType},
+				Required:   semantic.LabelSet{"d", "to"},
+				Return:     semantic.Time,
+			}),
+			func(ctx context.Context, args values.Object) (values.Value, error) {
+				d, ok := args.Get("d")
+				if !ok {
+					return nil, errors.New(codes.Invalid, "missing argument d")
+				}
+				to, ok := args.Get("to")
+				if !ok {
+					return nil, errors.New(codes.Invalid, "missing argument to")
+				}
+				location, err := getLocation(args)
+				if err != nil {
+					return nil, err
+				}
+
+				if d.Type().Nature() == semantic.Duration && to.Type().Nature() == semantic.Time {
+					return values.NewTime(add(to.Time(), d.Duration(), location)), nil
+				}
+				return nil, fmt.Errorf("cannot add argument d of type %v to argument to of type %v", d.Type().Nature(), to.Type().Nature())
+			}, false,
+		),
+		"sub": values.NewFunction(
+			"sub",
+			semantic.NewFunctionPolyType(semantic.FunctionPolySignature{
+				Parameters: map[string]semantic.PolyType{"d": semantic.Duration, "from": semantic.Time, "location": flux.LocationPolyType},
+				Required:   semantic.LabelSet{"d", "from"},
+				Return:     semantic.Time,
+			}),
+			func(ctx context.Context, args values.Object) (values.Value, error) {
+				d, ok := args.Get("d")
+				if !ok {
+					return nil, errors.New(codes.Invalid, "missing argument d")
+				}
+				from, ok := args.Get("from")
+				if !ok {
+					return nil, errors.New(codes.Invalid, "missing argument from")
+				}
+				location, err := getLocation(args)
+				if err != nil {
+					return nil, err
+				}
+
+				if d.Type().Nature() == semantic.Duration && from.Type().Nature() == semantic.Time {
+					return values.NewTime(add(from.Time(), d.Duration().Mul(-1), location)), nil
+				}
+				return nil, fmt.Errorf("cannot subtract argument d of type %v from argument from of type %v", d.Type().Nature(), from.Type().Nature())
+			}, false,
+		),
+		"diff": values.NewFunction(
+			"diff",
+			semantic.NewFunctionPolyType(semantic.FunctionPolySignature{
+				Parameters: map[string]semantic.PolyType{"start": semantic.Time, "stop": semantic.Time, "unit": semantic.Duration, "location": flux.LocationPolyType},
+				Required:   semantic.LabelSet{"start", "stop", "unit"},
+				Return:     semantic.Int,
+			}),
+			func(ctx context.Context, args values.Object) (values.Value, error) {
+				start, ok := args.Get("start")
+				if !ok {
+					return nil, errors.New(codes.Invalid, "missing argument start")
+				}
+				stop, ok := args.Get("stop")
+				if !ok {
+					return nil, errors.New(codes.Invalid, "missing argument stop")
+				}
+				unit, ok := args.Get("unit")
+				if !ok {
+					return nil, errors.New(codes.Invalid, "missing argument unit")
+				}
+				location, err := getLocation(args)
+				if err != nil {
+					return nil, err
+				}
+
+				if start.Type().Nature() == semantic.Time && stop.Type().Nature() == semantic.Time && unit.Type().Nature() == semantic.Duration {
+					n, err := diff(start.Time(), stop.Time(), unit.Duration(), location)
+					if err != nil {
+						return nil, err
+					}
+					return values.NewInt(n), nil
+				}
+				return nil, fmt.Errorf("cannot compute the difference between arguments start of type %v and stop of type %v in unit of type %v", start.Type().Nature(), stop.Type().Nature(), unit.Type().Nature())
+			}, false,
+		),
+		"format": values.NewFunction(
+			"format",
+			semantic.NewFunctionPolyType(semantic.FunctionPolySignature{
+				Parameters: map[string]semantic.PolyType{"t": semantic.Time, "layout": semantic.String, "location": flux.LocationPolyType},
+				Required:   semantic.LabelSet{"t", "layout"},
+				Return:     semantic.String,
+			}),
+			func(ctx context.Context, args values.Object) (values.Value, error) {
+				v, ok := args.Get("t")
+				if !ok {
+					return nil, errors.New(codes.Invalid, "missing argument t")
+				}
+				layout, ok := args.Get("layout")
+				if !ok {
+					return nil, errors.New(codes.Invalid, "missing argument layout")
+				}
+				location, err := getLocation(args)
+				if err != nil {
+					return nil, err
+				}
+
+				if v.Type().Nature() == semantic.Time && layout.Type().Nature() == semantic.String {
+					return values.NewString(location.Time(v.Time()).Format(layout.Str())), nil
+				}
+				return nil, fmt.Errorf("cannot format argument t of type %v with layout of type %v", v.Type().Nature(), layout.Type().Nature())
+			}, false,
+		),
+		"parse": values.NewFunction(
+			"parse",
+			semantic.NewFunctionPolyType(semantic.FunctionPolySignature{
+				Parameters: map[string]semantic.PolyType{"s": semantic.String, "layout": semantic.String, "location": flux.LocationPolyType},
+				Required:   semantic.LabelSet{"s", "layout"},
+				Return:     semantic.Time,
+			}),
+			func(ctx context.Context, args values.Object) (values.Value, error) {
+				v, ok := args.Get("s")
+				if !ok {
+					return nil, errors.New(codes.Invalid, "missing argument s")
+				}
+				layout, ok := args.Get("layout")
+				if !ok {
+					return nil, errors.New(codes.Invalid, "missing argument layout")
+				}
+				location, err := getLocation(args)
+				if err != nil {
+					return nil, err
+				}
+
+				if v.Type().Nature() == semantic.String && layout.Type().Nature() == semantic.String {
+					t, err := parse(v.Str(), layout.Str(), location)
+					if err != nil {
+						return nil, err
+					}
+					return values.NewTime(t), nil
+				}
+				return nil, fmt.Errorf("cannot parse argument s of type %v with layout of type %v", v.Type().Nature(), layout.Type().Nature())
+			}, false,
+		),
 	}
 
-	flux.RegisterPackageValue("date", "second", SpecialFns["second"])
-	flux.RegisterPackageValue("date", "minute", SpecialFns["minute"])
-	flux.RegisterPackageValue("date", "hour", SpecialFns["hour"])
-	flux.RegisterPackageValue("date", "weekDay", SpecialFns["weekDay"])
-	flux.RegisterPackageValue("date", "monthDay", SpecialFns["monthDay"])
-	flux.RegisterPackageValue("date", "yearDay", SpecialFns["yearDay"])
-	flux.RegisterPackageValue("date", "month", SpecialFns["month"])
-	flux.RegisterPackageValue("date", "year", SpecialFns["year"])
-	flux.RegisterPackageValue("date", "week", SpecialFns["week"])
-	flux.RegisterPackageValue("date", "quarter", SpecialFns["quarter"])
-	flux.RegisterPackageValue("date", "millisecond", SpecialFns["millisecond"])
-	flux.RegisterPackageValue("date", "microsecond", SpecialFns["microsecond"])
-	flux.RegisterPackageValue("date", "nanosecond", SpecialFns["nanosecond"])
-	flux.RegisterPackageValue("date", "truncate", SpecialFns["truncate"])
+	// Each function is wrapped in Flux so that the location
+	// defaults to the location option.
+	flux.RegisterPackageValue("date", "_second", SpecialFns["second"])
+	flux.RegisterPackageValue("date", "_minute", SpecialFns["minute"])
+	flux.RegisterPackageValue("date", "_hour", SpecialFns["hour"])
+	flux.RegisterPackageValue("date", "_weekDay", SpecialFns["weekDay"])
+	flux.RegisterPackageValue("date", "_monthDay", SpecialFns["monthDay"])
+	flux.RegisterPackageValue("date", "_yearDay", SpecialFns["yearDay"])
+	flux.RegisterPackageValue("date", "_month", SpecialFns["month"])
+	flux.RegisterPackageValue("date", "_year", SpecialFns["year"])
+	flux.RegisterPackageValue("date", "_week", SpecialFns["week"])
+	flux.RegisterPackageValue("date", "_quarter", SpecialFns["quarter"])
+	flux.RegisterPackageValue("date", "_millisecond", SpecialFns["millisecond"])
+	flux.RegisterPackageValue("date", "_microsecond", SpecialFns["microsecond"])
+	flux.RegisterPackageValue("date", "_nanosecond", SpecialFns["nanosecond"])
+	flux.RegisterPackageValue("date", "_truncate", SpecialFns["truncate"])
+	flux.RegisterPackageValue("date", "_add", SpecialFns["add"])
+	flux.RegisterPackageValue("date", "_sub", SpecialFns["sub"])
+	flux.RegisterPackageValue("date", "_diff", SpecialFns["diff"])
+	flux.RegisterPackageValue("date", "_format", SpecialFns["format"])
+	flux.RegisterPackageValue("date", "_parse", SpecialFns["parse"])
+}
+
+// getLocation returns the location argument, or UTC if there is none.
+func getLocation(args values.Object) (values.Location, error) {
+	v, ok := args.Get("location")
+	if !ok {
+		return values.UTC, nil
+	}
+	return flux.ToLocation(v)
+}
+
+// add adds the duration to the time. Months, years and whole days are
+// added to the civil time of the location, so that, for example, adding
+// a month to the first of a month returns the first of the next month.
+// The rest of the duration is added in absolute time so that an hour
+// is not skipped or repeated across a daylight saving time transition.
+func add(t values.Time, d values.Duration, location values.Location) values.Time {
+	clock := values.Time(d.Nanoseconds() % int64(24*time.Hour))
+	if d.IsNegative() {
+		clock = -clock
+	}
+	if d.Months() == 0 && d.Nanoseconds() < int64(24*time.Hour) {
+		return t + clock
+	}
+	// Time.Add adds the nanoseconds after the months, so removing
+	// the clock part leaves the civil time with only whole days added.
+	civil := location.ToCivil(t).Add(d) - clock
+	return location.FromCivil(civil) + clock
+}
+
+// diff returns the number of whole units from start to stop.
+// Days, months and years are counted in the civil time of the location
+// and shorter units are counted in absolute time. The result is negative
+// when stop is before start.
+func diff(start, stop values.Time, unit values.Duration, location values.Location) (int64, error) {
+	if !unit.IsPositive() {
+		return 0, errors.Newf(codes.Invalid, "unit %v must be positive", unit)
+	}
+	months, nsecs := unit.Months(), unit.Nanoseconds()
+	if months != 0 && nsecs != 0 {
+		return 0, errors.Newf(codes.Invalid, "unit %v cannot mix month and nanosecond units", unit)
+	}
+
+	if nsecs%int64(24*time.Hour) != 0 {
+		return int64(stop-start) / nsecs, nil
+	}
+	from, to := location.ToCivil(start), location.ToCivil(stop)
+	if nsecs != 0 {
+		return int64(to-from) / nsecs, nil
+	}
+
+	fromYear, fromMonth, _ := from.Time().Date()
+	toYear, toMonth, _ := to.Time().Date()
+	n := (int64(toYear-fromYear)*12 + int64(toMonth-fromMonth)) / months
+	// A unit is only counted once it is complete,
+	// which depends on the day and the time of day.
+	if n > 0 && from.Add(unit.Mul(int(n))) > to {
+		n--
+	} else if n < 0 && from.Add(unit.Mul(int(n))) < to {
+		n++
+	}
+	return n, nil
+}
+
+// parse parses the string with the layout. A time without
+// a zone or an offset is a civil time in the location.
+func parse(s, layout string, location values.Location) (values.Time, error) {
+	t, err := time.Parse(layout, s)
+	if err != nil {
+		return 0, errors.Wrapf(err, codes.Invalid, "cannot parse %q with layout %q", s, layout)
+	}
+	// The string has a zone when it is parsed to the same time
+	// regardless of the location that is used when it has none.
+	if other, err := time.ParseInLocation(layout, s, time.FixedZone("", 3600)); err == nil && other.Equal(t) {
+		return values.ConvertTime(t), nil
+	}
+	return location.FromCivil(values.ConvertTime(t)), nil
 }
@@ -3,6 +3,7 @@ package date
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/influxdata/flux/dependencies/dependenciestest"
 	"github.com/influxdata/flux/values"
@@ -154,3 +155,174 @@ func TestTruncate(t *testing.T) {
 		})
 	}
 }
+
+func TestCalendarFns(t *testing.T) {
+	newYork := values.NewObjectWithValues(map[string]values.Value{
+		"zone":   values.NewString("America/New_York"),
+		"offset": values.NewDuration(values.ConvertDuration(0)),
+	})
+	testCases := []struct {
+		name string
+		args map[string]interface{}
+		want interface{}
+	}{
+		{
+			name: "add",
+			args: map[string]interface{}{"d": "1mo", "to": "2019-01-31T12:00:00Z"},
+			want: "2019-02-28T12:00:00Z",
+		},
+		{
+			name: "add",
+			args: map[string]interface{}{"d": "1y2mo", "to": "2019-12-15T00:00:00Z"},
+			want: "2021-02-15T00:00:00Z",
+		},
+		{
+			name: "add",
+			args: map[string]interface{}{"d": "1d", "to": "2019-03-09T12:00:00Z", "location": newYork},
+			// A civil day is 23 hours at the start of daylight saving time.
+			want: "2019-03-10T11:00:00Z",
+		},
+		{
+			name: "add",
+			args: map[string]interface{}{"d": "1h", "to": "2019-03-10T06:30:00Z", "location": newYork},
+			// 01:30 EST plus an hour is 03:30 EDT.
+			want: "2019-03-10T07:30:00Z",
+		},
+		{
+			name: "add",
+			args: map[string]interface{}{"d": "1d1h", "to": "2019-03-09T12:00:00Z", "location": newYork},
+			want: "2019-03-10T12:00:00Z",
+		},
+		{
+			name: "add",
+			args: map[string]interface{}{"d": "1h", "to": "2019-11-03T05:30:00Z", "location": newYork},
+			// 01:30 EDT plus an hour is the repeated 01:30 EST.
+			want: "2019-11-03T06:30:00Z",
+		},
+		{
+			name: "add",
+			args: map[string]interface{}{"d": "1h", "to": "2019-11-03T06:30:00Z", "location": newYork},
+			want: "2019-11-03T07:30:00Z",
+		},
+		{
+			name: "sub",
+			args: map[string]interface{}{"d": "1h", "from": "2019-11-03T06:30:00Z", "location": newYork},
+			want: "2019-11-03T05:30:00Z",
+		},
+		{
+			name: "sub",
+			args: map[string]interface{}{"d": "1mo", "from": "2019-03-31T00:00:00Z"},
+			want: "2019-02-28T00:00:00Z",
+		},
+		{
+			name: "sub",
+			args: map[string]interface{}{"d": "-1y", "from": "2019-03-31T00:00:00Z"},
+			want: "2020-03-31T00:00:00Z",
+		},
+		{
+			name: "diff",
+			args: map[string]interface{}{"start": "2019-01-15T00:00:00Z", "stop": "2019-04-14T00:00:00Z", "unit": "1mo"},
+			want: int64(2),
+		},
+		{
+			name: "diff",
+			args: map[string]interface{}{"start": "2019-01-15T00:00:00Z", "stop": "2019-04-15T00:00:00Z", "unit": "1mo"},
+			want: int64(3),
+		},
+		{
+			name: "diff",
+			args: map[string]interface{}{"start": "2019-04-15T00:00:00Z", "stop": "2018-01-16T00:00:00Z", "unit": "1y"},
+			want: int64(-1),
+		},
+		{
+			name: "diff",
+			args: map[string]interface{}{"start": "2019-03-09T12:00:00Z", "stop": "2019-03-10T11:00:00Z", "unit": "1d", "location": newYork},
+			want: int64(1),
+		},
+		{
+			name: "diff",
+			args: map[string]interface{}{"start": "2019-03-10T06:00:00Z", "stop": "2019-03-10T08:00:00Z", "unit": "1h", "location": newYork},
+			// 01:00 EST to 04:00 EDT is two hours.
+			want: int64(2),
+		},
+		{
+			name: "diff",
+			args: map[string]interface{}{"start": "2019-11-03T04:00:00Z", "stop": "2019-11-03T07:00:00Z", "unit": "1h", "location": newYork},
+			// 00:00 EDT to 02:00 EST is three hours.
+			want: int64(3),
+		},
+		{
+			name: "format",
+			args: map[string]interface{}{"t": "2019-06-03T13:59:01Z", "layout": "2006-01-02 15:04:05 MST"},
+			want: "2019-06-03 13:59:01 UTC",
+		},
+		{
+			name: "format",
+			args: map[string]interface{}{"t": "2019-06-03T13:59:01Z", "layout": "Jan 2, 2006 at 3:04pm (MST)", "location": newYork},
+			want: "Jun 3, 2019 at 9:59am (EDT)",
+		},
+		{
+			name: "parse",
+			args: map[string]interface{}{"s": "2019-06-03 09:59:01", "layout": "2006-01-02 15:04:05", "location": newYork},
+			want: "2019-06-03T13:59:01Z",
+		},
+		{
+			name: "parse",
+			args: map[string]interface{}{"s": "2019-06-03 09:59:01 +02:00", "layout": "2006-01-02 15:04:05 -07:00", "location": newYork},
+			want: "2019-06-03T07:59:01Z",
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			args := make(map[string]values.Value, len(tc.args))
+			for k, v := range tc.args {
+				args[k] = calendarValue(t, k, v)
+			}
+			got, err := SpecialFns[tc.name].Call(dependenciestest.Default().Inject(context.Background()), values.NewObjectWithValues(args))
+			if err != nil {
+				t.Fatal(err)
+			}
+			want := calendarValue(t, tc.name, tc.want)
+			if !want.Equal(got) {
+				t.Errorf("unexpected result: want %v, got %v", want, got)
+			}
+		})
+	}
+}
+
+// calendarValue converts a test argument to a value.
+// Strings are parsed as times or durations when they are valid.
+func calendarValue(t *testing.T, name string, v interface{}) values.Value {
+	t.Helper()
+	switch v := v.(type) {
+	case values.Value:
+		return v
+	case int64:
+		return values.NewInt(v)
+	case string:
+		if name == "layout" || name == "s" || name == "format" {
+			return values.NewString(v)
+		}
+		if d, err := values.ParseDuration(v); err == nil {
+			return values.NewDuration(d)
+		}
+		ts, err := time.Parse(time.RFC3339Nano, v)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return values.NewTime(values.ConvertTime(ts))
+	}
+	t.Fatalf("unexpected test value %v", v)
+	return nil
+}
+
+func TestParse_Error(t *testing.T) {
+	args := values.NewObjectWithValues(map[string]values.Value{
+		"s":      values.NewString("June 3rd"),
+		"layout": values.NewString("2006-01-02"),
+	})
+	if _, err := SpecialFns["parse"].Call(dependenciestest.Default().Inject(context.Background()), args); err == nil {
+		t.Fatal("expected an error")
+	}
+}
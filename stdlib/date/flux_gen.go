@@ -13,1496 +13,9027 @@ func init() {
 
 var pkgAST = &ast.Package{
 	BaseNode: ast.BaseNode{
-		Errors: nil,
-		Loc:    nil,
+		Comments:        nil,
+		Errors:          nil,
+		Loc:             nil,
+		TrailingComment: nil,
 	},
 	Files: []*ast.File{&ast.File{
 		BaseNode: ast.BaseNode{
-			Errors: nil,
+			Comments: nil,
+			Errors:   nil,
 			Loc: &ast.SourceLocation{
 				End: ast.Position{
 					Column: 15,
-					Line:   37,
+					Line:   80,
 				},
 				File:   "date.flux",
-				Source: "package date\n\nbuiltin second\nbuiltin minute\nbuiltin hour\nbuiltin weekDay\nbuiltin monthDay\nbuiltin yearDay\nbuiltin month\nbuiltin year\nbuiltin week\nbuiltin quarter\nbuiltin millisecond\nbuiltin microsecond\nbuiltin nanosecond\nbuiltin truncate\n\nSunday    = 0\nMonday    = 1\nTuesday   = 2\nWednesday = 3\nThursday  = 4\nFriday    = 5\nSaturday  = 6\n\nJanuary   = 1\nFebruary  = 2\nMarch     = 3\nApril     = 4\nMay       = 5\nJune      = 6\nJuly      = 7\nAugust    = 8\nSeptember = 9\nOctober   = 10\nNovember  = 11\nDecember  = 12",
+				Source: "package date\n\nbuiltin _second\nbuiltin _minute\nbuiltin _hour\nbuiltin _weekDay\nbuiltin _monthDay\nbuiltin _yearDay\nbuiltin _month\nbuiltin _year\nbuiltin _week\nbuiltin _quarter\nbuiltin _millisecond\nbuiltin _microsecond\nbuiltin _nanosecond\nbuiltin _truncate\nbuiltin _add\nbuiltin _sub\nbuiltin _diff\nbuiltin _format\nbuiltin _parse\n\n// location is the default location used to align windows and date\n// computations with civil time. The zone is an IANA time zone name\n// and the offset is a fixed duration added to the offset of the zone.\noption location = {zone: \"UTC\", offset: 0h}\n\n// The date functions compute their results in the civil time of location,\n// which defaults to the location option.\nsecond = (t, location=location) => _second(t: t, location: location)\nminute = (t, location=location) => _minute(t: t, location: location)\nhour = (t, location=location) => _hour(t: t, location: location)\nweekDay = (t, location=location) => _weekDay(t: t, location: location)\nmonthDay = (t, location=location) => _monthDay(t: t, location: location)\nyearDay = (t, location=location) => _yearDay(t: t, location: location)\nmonth = (t, location=location) => _month(t: t, location: location)\nyear = (t, location=location) => _year(t: t, location: location)\nweek = (t, location=location) => _week(t: t, location: location)\nquarter = (t, location=location) => _quarter(t: t, location: location)\nmillisecond = (t, location=location) => _millisecond(t: t, location: location)\nmicrosecond = (t, location=location) => _microsecond(t: t, location: location)\nnanosecond = (t, location=location) => _nanosecond(t: t, location: location)\ntruncate = (t, unit, location=location) => _truncate(t: t, unit: unit, location: location)\n\n// add and sub add or subtract a duration in the civil time of location.\n// Months and years are calendar months and years, so adding 1mo to\n// January 31st returns the last day of February. The part of the duration\n// that is shorter than a day keeps its length across daylight saving time.\nadd = (d, to, location=location) => _add(d: d, to: to, location: location)\nsub = (d, from, location=location) => _sub(d: d, from: from, location: location)\n\n// diff returns the number of whole units, such as 1d or 1mo, from start to stop.\n// Units shorter than a day are counted in absolute time.\ndiff = (start, stop, unit, location=location) => _diff(start: start, stop: stop, unit: unit, location: location)\n\n// format and parse convert between times and strings using a Go time layout,\n// for example \"2006-01-02 15:04:05\". Times without a zone are parsed in location.\nformat = (t, layout, location=location) => _format(t: t, layout: layout, location: location)\nparse = (s, layout, location=location) => _parse(s: s, layout: layout, location: location)\n\nSunday    = 0\nMonday    = 1\nTuesday   = 2\nWednesday = 3\nThursday  = 4\nFriday    = 5\nSaturday  = 6\n\nJanuary   = 1\nFebruary  = 2\nMarch     = 3\nApril     = 4\nMay       = 5\nJune      = 6\nJuly      = 7\nAugust    = 8\nSeptember = 9\nOctober   = 10\nNovember  = 11\nDecember  = 12",
 				Start: ast.Position{
 					Column: 1,
 					Line:   1,
 				},
 			},
+			TrailingComment: nil,
 		},
 		Body: []ast.Statement{&ast.BuiltinStatement{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
-						Column: 15,
+						Column: 16,
 						Line:   3,
 					},
 					File:   "date.flux",
-					Source: "builtin second",
+					Source: "builtin _second",
 					Start: ast.Position{
 						Column: 1,
 						Line:   3,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
-							Column: 15,
+							Column: 16,
 							Line:   3,
 						},
 						File:   "date.flux",
-						Source: "second",
+						Source: "_second",
 						Start: ast.Position{
 							Column: 9,
 							Line:   3,
 						},
 					},
+					TrailingComment: nil,
 				},
-				Name: "second",
+				Name: "_second",
 			},
 		}, &ast.BuiltinStatement{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
-						Column: 15,
+						Column: 16,
 						Line:   4,
 					},
 					File:   "date.flux",
-					Source: "builtin minute",
+					Source: "builtin _minute",
 					Start: ast.Position{
 						Column: 1,
 						Line:   4,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
-							Column: 15,
+							Column: 16,
 							Line:   4,
 						},
 						File:   "date.flux",
-						Source: "minute",
+						Source: "_minute",
 						Start: ast.Position{
 							Column: 9,
 							Line:   4,
 						},
 					},
+					TrailingComment: nil,
 				},
-				Name: "minute",
+				Name: "_minute",
 			},
 		}, &ast.BuiltinStatement{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
-						Column: 13,
+						Column: 14,
 						Line:   5,
 					},
 					File:   "date.flux",
-					Source: "builtin hour",
+					Source: "builtin _hour",
 					Start: ast.Position{
 						Column: 1,
 						Line:   5,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
-							Column: 13,
+							Column: 14,
 							Line:   5,
 						},
 						File:   "date.flux",
-						Source: "hour",
+						Source: "_hour",
 						Start: ast.Position{
 							Column: 9,
 							Line:   5,
 						},
 					},
+					TrailingComment: nil,
 				},
-				Name: "hour",
+				Name: "_hour",
 			},
 		}, &ast.BuiltinStatement{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
-						Column: 16,
+						Column: 17,
 						Line:   6,
 					},
 					File:   "date.flux",
-					Source: "builtin weekDay",
+					Source: "builtin _weekDay",
 					Start: ast.Position{
 						Column: 1,
 						Line:   6,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
-							Column: 16,
+							Column: 17,
 							Line:   6,
 						},
 						File:   "date.flux",
-						Source: "weekDay",
+						Source: "_weekDay",
 						Start: ast.Position{
 							Column: 9,
 							Line:   6,
 						},
 					},
+					TrailingComment: nil,
 				},
-				Name: "weekDay",
+				Name: "_weekDay",
 			},
 		}, &ast.BuiltinStatement{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
-						Column: 17,
+						Column: 18,
 						Line:   7,
 					},
 					File:   "date.flux",
-					Source: "builtin monthDay",
+					Source: "builtin _monthDay",
 					Start: ast.Position{
 						Column: 1,
 						Line:   7,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
-							Column: 17,
+							Column: 18,
 							Line:   7,
 						},
 						File:   "date.flux",
-						Source: "monthDay",
+						Source: "_monthDay",
 						Start: ast.Position{
 							Column: 9,
 							Line:   7,
 						},
 					},
+					TrailingComment: nil,
 				},
-				Name: "monthDay",
+				Name: "_monthDay",
 			},
 		}, &ast.BuiltinStatement{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
-						Column: 16,
+						Column: 17,
 						Line:   8,
 					},
 					File:   "date.flux",
-					Source: "builtin yearDay",
+					Source: "builtin _yearDay",
 					Start: ast.Position{
 						Column: 1,
 						Line:   8,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
-							Column: 16,
+							Column: 17,
 							Line:   8,
 						},
 						File:   "date.flux",
-						Source: "yearDay",
+						Source: "_yearDay",
 						Start: ast.Position{
 							Column: 9,
 							Line:   8,
 						},
 					},
+					TrailingComment: nil,
 				},
-				Name: "yearDay",
+				Name: "_yearDay",
 			},
 		}, &ast.BuiltinStatement{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
-						Column: 14,
+						Column: 15,
 						Line:   9,
 					},
 					File:   "date.flux",
-					Source: "builtin month",
+					Source: "builtin _month",
 					Start: ast.Position{
 						Column: 1,
 						Line:   9,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
-							Column: 14,
+							Column: 15,
 							Line:   9,
 						},
 						File:   "date.flux",
-						Source: "month",
+						Source: "_month",
 						Start: ast.Position{
 							Column: 9,
 							Line:   9,
 						},
 					},
+					TrailingComment: nil,
 				},
-				Name: "month",
+				Name: "_month",
 			},
 		}, &ast.BuiltinStatement{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
-						Column: 13,
+						Column: 14,
 						Line:   10,
 					},
 					File:   "date.flux",
-					Source: "builtin year",
+					Source: "builtin _year",
 					Start: ast.Position{
 						Column: 1,
 						Line:   10,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
-							Column: 13,
+							Column: 14,
+							Line:   10,
+						},
+						File:   "date.flux",
+						Source: "_year",
+						Start: ast.Position{
+							Column: 9,
 							Line:   10,
 						},
-						File:   "date.flux",
-						Source: "year",
-						Start: ast.Position{
-							Column: 9,
-							Line:   10,
+					},
+					TrailingComment: nil,
+				},
+				Name: "_year",
+			},
+		}, &ast.BuiltinStatement{
+			BaseNode: ast.BaseNode{
+				Comments: nil,
+				Errors:   nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 14,
+						Line:   11,
+					},
+					File:   "date.flux",
+					Source: "builtin _week",
+					Start: ast.Position{
+						Column: 1,
+						Line:   11,
+					},
+				},
+				TrailingComment: nil,
+			},
+			ID: &ast.Identifier{
+				BaseNode: ast.BaseNode{
+					Comments: nil,
+					Errors:   nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 14,
+							Line:   11,
+						},
+						File:   "date.flux",
+						Source: "_week",
+						Start: ast.Position{
+							Column: 9,
+							Line:   11,
+						},
+					},
+					TrailingComment: nil,
+				},
+				Name: "_week",
+			},
+		}, &ast.BuiltinStatement{
+			BaseNode: ast.BaseNode{
+				Comments: nil,
+				Errors:   nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 17,
+						Line:   12,
+					},
+					File:   "date.flux",
+					Source: "builtin _quarter",
+					Start: ast.Position{
+						Column: 1,
+						Line:   12,
+					},
+				},
+				TrailingComment: nil,
+			},
+			ID: &ast.Identifier{
+				BaseNode: ast.BaseNode{
+					Comments: nil,
+					Errors:   nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 17,
+							Line:   12,
+						},
+						File:   "date.flux",
+						Source: "_quarter",
+						Start: ast.Position{
+							Column: 9,
+							Line:   12,
+						},
+					},
+					TrailingComment: nil,
+				},
+				Name: "_quarter",
+			},
+		}, &ast.BuiltinStatement{
+			BaseNode: ast.BaseNode{
+				Comments: nil,
+				Errors:   nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 21,
+						Line:   13,
+					},
+					File:   "date.flux",
+					Source: "builtin _millisecond",
+					Start: ast.Position{
+						Column: 1,
+						Line:   13,
+					},
+				},
+				TrailingComment: nil,
+			},
+			ID: &ast.Identifier{
+				BaseNode: ast.BaseNode{
+					Comments: nil,
+					Errors:   nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 21,
+							Line:   13,
+						},
+						File:   "date.flux",
+						Source: "_millisecond",
+						Start: ast.Position{
+							Column: 9,
+							Line:   13,
+						},
+					},
+					TrailingComment: nil,
+				},
+				Name: "_millisecond",
+			},
+		}, &ast.BuiltinStatement{
+			BaseNode: ast.BaseNode{
+				Comments: nil,
+				Errors:   nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 21,
+						Line:   14,
+					},
+					File:   "date.flux",
+					Source: "builtin _microsecond",
+					Start: ast.Position{
+						Column: 1,
+						Line:   14,
+					},
+				},
+				TrailingComment: nil,
+			},
+			ID: &ast.Identifier{
+				BaseNode: ast.BaseNode{
+					Comments: nil,
+					Errors:   nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 21,
+							Line:   14,
+						},
+						File:   "date.flux",
+						Source: "_microsecond",
+						Start: ast.Position{
+							Column: 9,
+							Line:   14,
+						},
+					},
+					TrailingComment: nil,
+				},
+				Name: "_microsecond",
+			},
+		}, &ast.BuiltinStatement{
+			BaseNode: ast.BaseNode{
+				Comments: nil,
+				Errors:   nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 20,
+						Line:   15,
+					},
+					File:   "date.flux",
+					Source: "builtin _nanosecond",
+					Start: ast.Position{
+						Column: 1,
+						Line:   15,
+					},
+				},
+				TrailingComment: nil,
+			},
+			ID: &ast.Identifier{
+				BaseNode: ast.BaseNode{
+					Comments: nil,
+					Errors:   nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 20,
+							Line:   15,
+						},
+						File:   "date.flux",
+						Source: "_nanosecond",
+						Start: ast.Position{
+							Column: 9,
+							Line:   15,
+						},
+					},
+					TrailingComment: nil,
+				},
+				Name: "_nanosecond",
+			},
+		}, &ast.BuiltinStatement{
+			BaseNode: ast.BaseNode{
+				Comments: nil,
+				Errors:   nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 18,
+						Line:   16,
+					},
+					File:   "date.flux",
+					Source: "builtin _truncate",
+					Start: ast.Position{
+						Column: 1,
+						Line:   16,
+					},
+				},
+				TrailingComment: nil,
+			},
+			ID: &ast.Identifier{
+				BaseNode: ast.BaseNode{
+					Comments: nil,
+					Errors:   nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 18,
+							Line:   16,
+						},
+						File:   "date.flux",
+						Source: "_truncate",
+						Start: ast.Position{
+							Column: 9,
+							Line:   16,
+						},
+					},
+					TrailingComment: nil,
+				},
+				Name: "_truncate",
+			},
+		}, &ast.BuiltinStatement{
+			BaseNode: ast.BaseNode{
+				Comments: nil,
+				Errors:   nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 13,
+						Line:   17,
+					},
+					File:   "date.flux",
+					Source: "builtin _add",
+					Start: ast.Position{
+						Column: 1,
+						Line:   17,
+					},
+				},
+				TrailingComment: nil,
+			},
+			ID: &ast.Identifier{
+				BaseNode: ast.BaseNode{
+					Comments: nil,
+					Errors:   nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 13,
+							Line:   17,
+						},
+						File:   "date.flux",
+						Source: "_add",
+						Start: ast.Position{
+							Column: 9,
+							Line:   17,
+						},
+					},
+					TrailingComment: nil,
+				},
+				Name: "_add",
+			},
+		}, &ast.BuiltinStatement{
+			BaseNode: ast.BaseNode{
+				Comments: nil,
+				Errors:   nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 13,
+						Line:   18,
+					},
+					File:   "date.flux",
+					Source: "builtin _sub",
+					Start: ast.Position{
+						Column: 1,
+						Line:   18,
+					},
+				},
+				TrailingComment: nil,
+			},
+			ID: &ast.Identifier{
+				BaseNode: ast.BaseNode{
+					Comments: nil,
+					Errors:   nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 13,
+							Line:   18,
+						},
+						File:   "date.flux",
+						Source: "_sub",
+						Start: ast.Position{
+							Column: 9,
+							Line:   18,
+						},
+					},
+					TrailingComment: nil,
+				},
+				Name: "_sub",
+			},
+		}, &ast.BuiltinStatement{
+			BaseNode: ast.BaseNode{
+				Comments: nil,
+				Errors:   nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 14,
+						Line:   19,
+					},
+					File:   "date.flux",
+					Source: "builtin _diff",
+					Start: ast.Position{
+						Column: 1,
+						Line:   19,
+					},
+				},
+				TrailingComment: nil,
+			},
+			ID: &ast.Identifier{
+				BaseNode: ast.BaseNode{
+					Comments: nil,
+					Errors:   nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 14,
+							Line:   19,
+						},
+						File:   "date.flux",
+						Source: "_diff",
+						Start: ast.Position{
+							Column: 9,
+							Line:   19,
+						},
+					},
+					TrailingComment: nil,
+				},
+				Name: "_diff",
+			},
+		}, &ast.BuiltinStatement{
+			BaseNode: ast.BaseNode{
+				Comments: nil,
+				Errors:   nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 16,
+						Line:   20,
+					},
+					File:   "date.flux",
+					Source: "builtin _format",
+					Start: ast.Position{
+						Column: 1,
+						Line:   20,
+					},
+				},
+				TrailingComment: nil,
+			},
+			ID: &ast.Identifier{
+				BaseNode: ast.BaseNode{
+					Comments: nil,
+					Errors:   nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 16,
+							Line:   20,
+						},
+						File:   "date.flux",
+						Source: "_format",
+						Start: ast.Position{
+							Column: 9,
+							Line:   20,
+						},
+					},
+					TrailingComment: nil,
+				},
+				Name: "_format",
+			},
+		}, &ast.BuiltinStatement{
+			BaseNode: ast.BaseNode{
+				Comments: nil,
+				Errors:   nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 15,
+						Line:   21,
+					},
+					File:   "date.flux",
+					Source: "builtin _parse",
+					Start: ast.Position{
+						Column: 1,
+						Line:   21,
+					},
+				},
+				TrailingComment: nil,
+			},
+			ID: &ast.Identifier{
+				BaseNode: ast.BaseNode{
+					Comments: nil,
+					Errors:   nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 15,
+							Line:   21,
+						},
+						File:   "date.flux",
+						Source: "_parse",
+						Start: ast.Position{
+							Column: 9,
+							Line:   21,
+						},
+					},
+					TrailingComment: nil,
+				},
+				Name: "_parse",
+			},
+		}, &ast.OptionStatement{
+			Assignment: &ast.VariableAssignment{
+				BaseNode: ast.BaseNode{
+					Comments: nil,
+					Errors:   nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 44,
+							Line:   26,
+						},
+						File:   "date.flux",
+						Source: "location = {zone: \"UTC\", offset: 0h}",
+						Start: ast.Position{
+							Column: 8,
+							Line:   26,
+						},
+					},
+					TrailingComment: nil,
+				},
+				ID: &ast.Identifier{
+					BaseNode: ast.BaseNode{
+						Comments: nil,
+						Errors:   nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 16,
+								Line:   26,
+							},
+							File:   "date.flux",
+							Source: "location",
+							Start: ast.Position{
+								Column: 8,
+								Line:   26,
+							},
+						},
+						TrailingComment: nil,
+					},
+					Name: "location",
+				},
+				Init: &ast.ObjectExpression{
+					BaseNode: ast.BaseNode{
+						Comments: nil,
+						Errors:   nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 44,
+								Line:   26,
+							},
+							File:   "date.flux",
+							Source: "{zone: \"UTC\", offset: 0h}",
+							Start: ast.Position{
+								Column: 19,
+								Line:   26,
+							},
+						},
+						TrailingComment: nil,
+					},
+					Properties: []*ast.Property{&ast.Property{
+						BaseNode: ast.BaseNode{
+							Comments: nil,
+							Errors:   nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 31,
+									Line:   26,
+								},
+								File:   "date.flux",
+								Source: "zone: \"UTC\"",
+								Start: ast.Position{
+									Column: 20,
+									Line:   26,
+								},
+							},
+							TrailingComment: nil,
+						},
+						Key: &ast.Identifier{
+							BaseNode: ast.BaseNode{
+								Comments: nil,
+								Errors:   nil,
+								Loc: &ast.SourceLocation{
+									End: ast.Position{
+										Column: 24,
+										Line:   26,
+									},
+									File:   "date.flux",
+									Source: "zone",
+									Start: ast.Position{
+										Column: 20,
+										Line:   26,
+									},
+								},
+								TrailingComment: nil,
+							},
+							Name: "zone",
+						},
+						Value: &ast.StringLiteral{
+							BaseNode: ast.BaseNode{
+								Comments: nil,
+								Errors:   nil,
+								Loc: &ast.SourceLocation{
+									End: ast.Position{
+										Column: 31,
+										Line:   26,
+									},
+									File:   "date.flux",
+									Source: "\"UTC\"",
+									Start: ast.Position{
+										Column: 26,
+										Line:   26,
+									},
+								},
+								TrailingComment: nil,
+							},
+							Value: "UTC",
+						},
+					}, &ast.Property{
+						BaseNode: ast.BaseNode{
+							Comments: nil,
+							Errors:   nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 43,
+									Line:   26,
+								},
+								File:   "date.flux",
+								Source: "offset: 0h",
+								Start: ast.Position{
+									Column: 33,
+									Line:   26,
+								},
+							},
+							TrailingComment: nil,
+						},
+						Key: &ast.Identifier{
+							BaseNode: ast.BaseNode{
+								Comments: nil,
+								Errors:   nil,
+								Loc: &ast.SourceLocation{
+									End: ast.Position{
+										Column: 39,
+										Line:   26,
+									},
+									File:   "date.flux",
+									Source: "offset",
+									Start: ast.Position{
+										Column: 33,
+										Line:   26,
+									},
+								},
+								TrailingComment: nil,
+							},
+							Name: "offset",
+						},
+						Value: &ast.DurationLiteral{
+							BaseNode: ast.BaseNode{
+								Comments: nil,
+								Errors:   nil,
+								Loc: &ast.SourceLocation{
+									End: ast.Position{
+										Column: 43,
+										Line:   26,
+									},
+									File:   "date.flux",
+									Source: "0h",
+									Start: ast.Position{
+										Column: 41,
+										Line:   26,
+									},
+								},
+								TrailingComment: nil,
+							},
+							Values: []ast.Duration{ast.Duration{
+								Magnitude: int64(0),
+								Unit:      "h",
+							}},
+						},
+					}},
+					With: nil,
+				},
+			},
+			BaseNode: ast.BaseNode{
+				Comments: []ast.Comment{ast.Comment{Text: "// location is the default location used to align windows and date"}, ast.Comment{Text: "// computations with civil time. The zone is an IANA time zone name"}, ast.Comment{Text: "// and the offset is a fixed duration added to the offset of the zone."}},
+				Errors:   nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 44,
+						Line:   26,
+					},
+					File:   "date.flux",
+					Source: "option location = {zone: \"UTC\", offset: 0h}",
+					Start: ast.Position{
+						Column: 1,
+						Line:   26,
+					},
+				},
+				TrailingComment: nil,
+			},
+		}, &ast.VariableAssignment{
+			BaseNode: ast.BaseNode{
+				Comments: []ast.Comment{ast.Comment{Text: "// The date functions compute their results in the civil time of location,"}, ast.Comment{Text: "// which defaults to the location option."}},
+				Errors:   nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 69,
+						Line:   30,
+					},
+					File:   "date.flux",
+					Source: "second = (t, location=location) => _second(t: t, location: location)",
+					Start: ast.Position{
+						Column: 1,
+						Line:   30,
+					},
+				},
+				TrailingComment: nil,
+			},
+			ID: &ast.Identifier{
+				BaseNode: ast.BaseNode{
+					Comments: nil,
+					Errors:   nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 7,
+							Line:   30,
+						},
+						File:   "date.flux",
+						Source: "second",
+						Start: ast.Position{
+							Column: 1,
+							Line:   30,
+						},
+					},
+					TrailingComment: nil,
+				},
+				Name: "second",
+			},
+			Init: &ast.FunctionExpression{
+				BaseNode: ast.BaseNode{
+					Comments: nil,
+					Errors:   nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 69,
+							Line:   30,
+						},
+						File:   "date.flux",
+						Source: "(t, location=location) => _second(t: t, location: location)",
+						Start: ast.Position{
+							Column: 10,
+							Line:   30,
+						},
+					},
+					TrailingComment: nil,
+				},
+				Body: &ast.CallExpression{
+					Arguments: []ast.Expression{&ast.ObjectExpression{
+						BaseNode: ast.BaseNode{
+							Comments: nil,
+							Errors:   nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 68,
+									Line:   30,
+								},
+								File:   "date.flux",
+								Source: "t: t, location: location",
+								Start: ast.Position{
+									Column: 44,
+									Line:   30,
+								},
+							},
+							TrailingComment: nil,
+						},
+						Properties: []*ast.Property{&ast.Property{
+							BaseNode: ast.BaseNode{
+								Comments: nil,
+								Errors:   nil,
+								Loc: &ast.SourceLocation{
+									End: ast.Position{
+										Column: 48,
+										Line:   30,
+									},
+									File:   "date.flux",
+									Source: "t: t",
+									Start: ast.Position{
+										Column: 44,
+										Line:   30,
+									},
+								},
+								TrailingComment: nil,
+							},
+							Key: &ast.Identifier{
+								BaseNode: ast.BaseNode{
+									Comments: nil,
+									Errors:   nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 45,
+											Line:   30,
+										},
+										File:   "date.flux",
+										Source: "t",
+										Start: ast.Position{
+											Column: 44,
+											Line:   30,
+										},
+									},
+									TrailingComment: nil,
+								},
+								Name: "t",
+							},
+							Value: &ast.Identifier{
+								BaseNode: ast.BaseNode{
+									Comments: nil,
+									Errors:   nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 48,
+											Line:   30,
+										},
+										File:   "date.flux",
+										Source: "t",
+										Start: ast.Position{
+											Column: 47,
+											Line:   30,
+										},
+									},
+									TrailingComment: nil,
+								},
+								Name: "t",
+							},
+						}, &ast.Property{
+							BaseNode: ast.BaseNode{
+								Comments: nil,
+								Errors:   nil,
+								Loc: &ast.SourceLocation{
+									End: ast.Position{
+										Column: 68,
+										Line:   30,
+									},
+									File:   "date.flux",
+									Source: "location: location",
+									Start: ast.Position{
+										Column: 50,
+										Line:   30,
+									},
+								},
+								TrailingComment: nil,
+							},
+							Key: &ast.Identifier{
+								BaseNode: ast.BaseNode{
+									Comments: nil,
+									Errors:   nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 58,
+											Line:   30,
+										},
+										File:   "date.flux",
+										Source: "location",
+										Start: ast.Position{
+											Column: 50,
+											Line:   30,
+										},
+									},
+									TrailingComment: nil,
+								},
+								Name: "location",
+							},
+							Value: &ast.Identifier{
+								BaseNode: ast.BaseNode{
+									Comments: nil,
+									Errors:   nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 68,
+											Line:   30,
+										},
+										File:   "date.flux",
+										Source: "location",
+										Start: ast.Position{
+											Column: 60,
+											Line:   30,
+										},
+									},
+									TrailingComment: nil,
+								},
+								Name: "location",
+							},
+						}},
+						With: nil,
+					}},
+					BaseNode: ast.BaseNode{
+						Comments: nil,
+						Errors:   nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 69,
+								Line:   30,
+							},
+							File:   "date.flux",
+							Source: "_second(t: t, location: location)",
+							Start: ast.Position{
+								Column: 36,
+								Line:   30,
+							},
+						},
+						TrailingComment: nil,
+					},
+					Callee: &ast.Identifier{
+						BaseNode: ast.BaseNode{
+							Comments: nil,
+							Errors:   nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 43,
+									Line:   30,
+								},
+								File:   "date.flux",
+								Source: "_second",
+								Start: ast.Position{
+									Column: 36,
+									Line:   30,
+								},
+							},
+							TrailingComment: nil,
+						},
+						Name: "_second",
+					},
+				},
+				Params: []*ast.Property{&ast.Property{
+					BaseNode: ast.BaseNode{
+						Comments: nil,
+						Errors:   nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 12,
+								Line:   30,
+							},
+							File:   "date.flux",
+							Source: "t",
+							Start: ast.Position{
+								Column: 11,
+								Line:   30,
+							},
+						},
+						TrailingComment: nil,
+					},
+					Key: &ast.Identifier{
+						BaseNode: ast.BaseNode{
+							Comments: nil,
+							Errors:   nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 12,
+									Line:   30,
+								},
+								File:   "date.flux",
+								Source: "t",
+								Start: ast.Position{
+									Column: 11,
+									Line:   30,
+								},
+							},
+							TrailingComment: nil,
+						},
+						Name: "t",
+					},
+					Value: nil,
+				}, &ast.Property{
+					BaseNode: ast.BaseNode{
+						Comments: nil,
+						Errors:   nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 31,
+								Line:   30,
+							},
+							File:   "date.flux",
+							Source: "location=location",
+							Start: ast.Position{
+								Column: 14,
+								Line:   30,
+							},
+						},
+						TrailingComment: nil,
+					},
+					Key: &ast.Identifier{
+						BaseNode: ast.BaseNode{
+							Comments: nil,
+							Errors:   nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 22,
+									Line:   30,
+								},
+								File:   "date.flux",
+								Source: "location",
+								Start: ast.Position{
+									Column: 14,
+									Line:   30,
+								},
+							},
+							TrailingComment: nil,
+						},
+						Name: "location",
+					},
+					Value: &ast.Identifier{
+						BaseNode: ast.BaseNode{
+							Comments: nil,
+							Errors:   nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 31,
+									Line:   30,
+								},
+								File:   "date.flux",
+								Source: "location",
+								Start: ast.Position{
+									Column: 23,
+									Line:   30,
+								},
+							},
+							TrailingComment: nil,
+						},
+						Name: "location",
+					},
+				}},
+			},
+		}, &ast.VariableAssignment{
+			BaseNode: ast.BaseNode{
+				Comments: nil,
+				Errors:   nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 69,
+						Line:   31,
+					},
+					File:   "date.flux",
+					Source: "minute = (t, location=location) => _minute(t: t, location: location)",
+					Start: ast.Position{
+						Column: 1,
+						Line:   31,
+					},
+				},
+				TrailingComment: nil,
+			},
+			ID: &ast.Identifier{
+				BaseNode: ast.BaseNode{
+					Comments: nil,
+					Errors:   nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 7,
+							Line:   31,
+						},
+						File:   "date.flux",
+						Source: "minute",
+						Start: ast.Position{
+							Column: 1,
+							Line:   31,
+						},
+					},
+					TrailingComment: nil,
+				},
+				Name: "minute",
+			},
+			Init: &ast.FunctionExpression{
+				BaseNode: ast.BaseNode{
+					Comments: nil,
+					Errors:   nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 69,
+							Line:   31,
+						},
+						File:   "date.flux",
+						Source: "(t, location=location) => _minute(t: t, location: location)",
+						Start: ast.Position{
+							Column: 10,
+							Line:   31,
+						},
+					},
+					TrailingComment: nil,
+				},
+				Body: &ast.CallExpression{
+					Arguments: []ast.Expression{&ast.ObjectExpression{
+						BaseNode: ast.BaseNode{
+							Comments: nil,
+							Errors:   nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 68,
+									Line:   31,
+								},
+								File:   "date.flux",
+								Source: "t: t, location: location",
+								Start: ast.Position{
+									Column: 44,
+									Line:   31,
+								},
+							},
+							TrailingComment: nil,
+						},
+						Properties: []*ast.Property{&ast.Property{
+							BaseNode: ast.BaseNode{
+								Comments: nil,
+								Errors:   nil,
+								Loc: &ast.SourceLocation{
+									End: ast.Position{
+										Column: 48,
+										Line:   31,
+									},
+									File:   "date.flux",
+									Source: "t: t",
+									Start: ast.Position{
+										Column: 44,
+										Line:   31,
+									},
+								},
+								TrailingComment: nil,
+							},
+							Key: &ast.Identifier{
+								BaseNode: ast.BaseNode{
+									Comments: nil,
+									Errors:   nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 45,
+											Line:   31,
+										},
+										File:   "date.flux",
+										Source: "t",
+										Start: ast.Position{
+											Column: 44,
+											Line:   31,
+										},
+									},
+									TrailingComment: nil,
+								},
+								Name: "t",
+							},
+							Value: &ast.Identifier{
+								BaseNode: ast.BaseNode{
+									Comments: nil,
+									Errors:   nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 48,
+											Line:   31,
+										},
+										File:   "date.flux",
+										Source: "t",
+										Start: ast.Position{
+											Column: 47,
+											Line:   31,
+										},
+									},
+									TrailingComment: nil,
+								},
+								Name: "t",
+							},
+						}, &ast.Property{
+							BaseNode: ast.BaseNode{
+								Comments: nil,
+								Errors:   nil,
+								Loc: &ast.SourceLocation{
+									End: ast.Position{
+										Column: 68,
+										Line:   31,
+									},
+									File:   "date.flux",
+									Source: "location: location",
+									Start: ast.Position{
+										Column: 50,
+										Line:   31,
+									},
+								},
+								TrailingComment: nil,
+							},
+							Key: &ast.Identifier{
+								BaseNode: ast.BaseNode{
+									Comments: nil,
+									Errors:   nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 58,
+											Line:   31,
+										},
+										File:   "date.flux",
+										Source: "location",
+										Start: ast.Position{
+											Column: 50,
+											Line:   31,
+										},
+									},
+									TrailingComment: nil,
+								},
+								Name: "location",
+							},
+							Value: &ast.Identifier{
+								BaseNode: ast.BaseNode{
+									Comments: nil,
+									Errors:   nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 68,
+											Line:   31,
+										},
+										File:   "date.flux",
+										Source: "location",
+										Start: ast.Position{
+											Column: 60,
+											Line:   31,
+										},
+									},
+									TrailingComment: nil,
+								},
+								Name: "location",
+							},
+						}},
+						With: nil,
+					}},
+					BaseNode: ast.BaseNode{
+						Comments: nil,
+						Errors:   nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 69,
+								Line:   31,
+							},
+							File:   "date.flux",
+							Source: "_minute(t: t, location: location)",
+							Start: ast.Position{
+								Column: 36,
+								Line:   31,
+							},
+						},
+						TrailingComment: nil,
+					},
+					Callee: &ast.Identifier{
+						BaseNode: ast.BaseNode{
+							Comments: nil,
+							Errors:   nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 43,
+									Line:   31,
+								},
+								File:   "date.flux",
+								Source: "_minute",
+								Start: ast.Position{
+									Column: 36,
+									Line:   31,
+								},
+							},
+							TrailingComment: nil,
+						},
+						Name: "_minute",
+					},
+				},
+				Params: []*ast.Property{&ast.Property{
+					BaseNode: ast.BaseNode{
+						Comments: nil,
+						Errors:   nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 12,
+								Line:   31,
+							},
+							File:   "date.flux",
+							Source: "t",
+							Start: ast.Position{
+								Column: 11,
+								Line:   31,
+							},
+						},
+						TrailingComment: nil,
+					},
+					Key: &ast.Identifier{
+						BaseNode: ast.BaseNode{
+							Comments: nil,
+							Errors:   nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 12,
+									Line:   31,
+								},
+								File:   "date.flux",
+								Source: "t",
+								Start: ast.Position{
+									Column: 11,
+									Line:   31,
+								},
+							},
+							TrailingComment: nil,
+						},
+						Name: "t",
+					},
+					Value: nil,
+				}, &ast.Property{
+					BaseNode: ast.BaseNode{
+						Comments: nil,
+						Errors:   nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 31,
+								Line:   31,
+							},
+							File:   "date.flux",
+							Source: "location=location",
+							Start: ast.Position{
+								Column: 14,
+								Line:   31,
+							},
+						},
+						TrailingComment: nil,
+					},
+					Key: &ast.Identifier{
+						BaseNode: ast.BaseNode{
+							Comments: nil,
+							Errors:   nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 22,
+									Line:   31,
+								},
+								File:   "date.flux",
+								Source: "location",
+								Start: ast.Position{
+									Column: 14,
+									Line:   31,
+								},
+							},
+							TrailingComment: nil,
+						},
+						Name: "location",
+					},
+					Value: &ast.Identifier{
+						BaseNode: ast.BaseNode{
+							Comments: nil,
+							Errors:   nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 31,
+									Line:   31,
+								},
+								File:   "date.flux",
+								Source: "location",
+								Start: ast.Position{
+									Column: 23,
+									Line:   31,
+								},
+							},
+							TrailingComment: nil,
+						},
+						Name: "location",
+					},
+				}},
+			},
+		}, &ast.VariableAssignment{
+			BaseNode: ast.BaseNode{
+				Comments: nil,
+				Errors:   nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 65,
+						Line:   32,
+					},
+					File:   "date.flux",
+					Source: "hour = (t, location=location) => _hour(t: t, location: location)",
+					Start: ast.Position{
+						Column: 1,
+						Line:   32,
+					},
+				},
+				TrailingComment: nil,
+			},
+			ID: &ast.Identifier{
+				BaseNode: ast.BaseNode{
+					Comments: nil,
+					Errors:   nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 5,
+							Line:   32,
+						},
+						File:   "date.flux",
+						Source: "hour",
+						Start: ast.Position{
+							Column: 1,
+							Line:   32,
+						},
+					},
+					TrailingComment: nil,
+				},
+				Name: "hour",
+			},
+			Init: &ast.FunctionExpression{
+				BaseNode: ast.BaseNode{
+					Comments: nil,
+					Errors:   nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 65,
+							Line:   32,
+						},
+						File:   "date.flux",
+						Source: "(t, location=location) => _hour(t: t, location: location)",
+						Start: ast.Position{
+							Column: 8,
+							Line:   32,
+						},
+					},
+					TrailingComment: nil,
+				},
+				Body: &ast.CallExpression{
+					Arguments: []ast.Expression{&ast.ObjectExpression{
+						BaseNode: ast.BaseNode{
+							Comments: nil,
+							Errors:   nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 64,
+									Line:   32,
+								},
+								File:   "date.flux",
+								Source: "t: t, location: location",
+								Start: ast.Position{
+									Column: 40,
+									Line:   32,
+								},
+							},
+							TrailingComment: nil,
+						},
+						Properties: []*ast.Property{&ast.Property{
+							BaseNode: ast.BaseNode{
+								Comments: nil,
+								Errors:   nil,
+								Loc: &ast.SourceLocation{
+									End: ast.Position{
+										Column: 44,
+										Line:   32,
+									},
+									File:   "date.flux",
+									Source: "t: t",
+									Start: ast.Position{
+										Column: 40,
+										Line:   32,
+									},
+								},
+								TrailingComment: nil,
+							},
+							Key: &ast.Identifier{
+								BaseNode: ast.BaseNode{
+									Comments: nil,
+									Errors:   nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 41,
+											Line:   32,
+										},
+										File:   "date.flux",
+										Source: "t",
+										Start: ast.Position{
+											Column: 40,
+											Line:   32,
+										},
+									},
+									TrailingComment: nil,
+								},
+								Name: "t",
+							},
+							Value: &ast.Identifier{
+								BaseNode: ast.BaseNode{
+									Comments: nil,
+									Errors:   nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 44,
+											Line:   32,
+										},
+										File:   "date.flux",
+										Source: "t",
+										Start: ast.Position{
+											Column: 43,
+											Line:   32,
+										},
+									},
+									TrailingComment: nil,
+								},
+								Name: "t",
+							},
+						}, &ast.Property{
+							BaseNode: ast.BaseNode{
+								Comments: nil,
+								Errors:   nil,
+								Loc: &ast.SourceLocation{
+									End: ast.Position{
+										Column: 64,
+										Line:   32,
+									},
+									File:   "date.flux",
+									Source: "location: location",
+									Start: ast.Position{
+										Column: 46,
+										Line:   32,
+									},
+								},
+								TrailingComment: nil,
+							},
+							Key: &ast.Identifier{
+								BaseNode: ast.BaseNode{
+									Comments: nil,
+									Errors:   nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 54,
+											Line:   32,
+										},
+										File:   "date.flux",
+										Source: "location",
+										Start: ast.Position{
+											Column: 46,
+											Line:   32,
+										},
+									},
+									TrailingComment: nil,
+								},
+								Name: "location",
+							},
+							Value: &ast.Identifier{
+								BaseNode: ast.BaseNode{
+									Comments: nil,
+									Errors:   nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 64,
+											Line:   32,
+										},
+										File:   "date.flux",
+										Source: "location",
+										Start: ast.Position{
+											Column: 56,
+											Line:   32,
+										},
+									},
+									TrailingComment: nil,
+								},
+								Name: "location",
+							},
+						}},
+						With: nil,
+					}},
+					BaseNode: ast.BaseNode{
+						Comments: nil,
+						Errors:   nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 65,
+								Line:   32,
+							},
+							File:   "date.flux",
+							Source: "_hour(t: t, location: location)",
+							Start: ast.Position{
+								Column: 34,
+								Line:   32,
+							},
+						},
+						TrailingComment: nil,
+					},
+					Callee: &ast.Identifier{
+						BaseNode: ast.BaseNode{
+							Comments: nil,
+							Errors:   nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 39,
+									Line:   32,
+								},
+								File:   "date.flux",
+								Source: "_hour",
+								Start: ast.Position{
+									Column: 34,
+									Line:   32,
+								},
+							},
+							TrailingComment: nil,
+						},
+						Name: "_hour",
+					},
+				},
+				Params: []*ast.Property{&ast.Property{
+					BaseNode: ast.BaseNode{
+						Comments: nil,
+						Errors:   nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 10,
+								Line:   32,
+							},
+							File:   "date.flux",
+							Source: "t",
+							Start: ast.Position{
+								Column: 9,
+								Line:   32,
+							},
+						},
+						TrailingComment: nil,
+					},
+					Key: &ast.Identifier{
+						BaseNode: ast.BaseNode{
+							Comments: nil,
+							Errors:   nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 10,
+									Line:   32,
+								},
+								File:   "date.flux",
+								Source: "t",
+								Start: ast.Position{
+									Column: 9,
+									Line:   32,
+								},
+							},
+							TrailingComment: nil,
+						},
+						Name: "t",
+					},
+					Value: nil,
+				}, &ast.Property{
+					BaseNode: ast.BaseNode{
+						Comments: nil,
+						Errors:   nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 29,
+								Line:   32,
+							},
+							File:   "date.flux",
+							Source: "location=location",
+							Start: ast.Position{
+								Column: 12,
+								Line:   32,
+							},
+						},
+						TrailingComment: nil,
+					},
+					Key: &ast.Identifier{
+						BaseNode: ast.BaseNode{
+							Comments: nil,
+							Errors:   nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 20,
+									Line:   32,
+								},
+								File:   "date.flux",
+								Source: "location",
+								Start: ast.Position{
+									Column: 12,
+									Line:   32,
+								},
+							},
+							TrailingComment: nil,
+						},
+						Name: "location",
+					},
+					Value: &ast.Identifier{
+						BaseNode: ast.BaseNode{
+							Comments: nil,
+							Errors:   nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 29,
+									Line:   32,
+								},
+								File:   "date.flux",
+								Source: "location",
+								Start: ast.Position{
+									Column: 21,
+									Line:   32,
+								},
+							},
+							TrailingComment: nil,
+						},
+						Name: "location",
+					},
+				}},
+			},
+		}, &ast.VariableAssignment{
+			BaseNode: ast.BaseNode{
+				Comments: nil,
+				Errors:   nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 71,
+						Line:   33,
+					},
+					File:   "date.flux",
+					Source: "weekDay = (t, location=location) => _weekDay(t: t, location: location)",
+					Start: ast.Position{
+						Column: 1,
+						Line:   33,
+					},
+				},
+				TrailingComment: nil,
+			},
+			ID: &ast.Identifier{
+				BaseNode: ast.BaseNode{
+					Comments: nil,
+					Errors:   nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 8,
+							Line:   33,
+						},
+						File:   "date.flux",
+						Source: "weekDay",
+						Start: ast.Position{
+							Column: 1,
+							Line:   33,
+						},
+					},
+					TrailingComment: nil,
+				},
+				Name: "weekDay",
+			},
+			Init: &ast.FunctionExpression{
+				BaseNode: ast.BaseNode{
+					Comments: nil,
+					Errors:   nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 71,
+							Line:   33,
+						},
+						File:   "date.flux",
+						Source: "(t, location=location) => _weekDay(t: t, location: location)",
+						Start: ast.Position{
+							Column: 11,
+							Line:   33,
+						},
+					},
+					TrailingComment: nil,
+				},
+				Body: &ast.CallExpression{
+					Arguments: []ast.Expression{&ast.ObjectExpression{
+						BaseNode: ast.BaseNode{
+							Comments: nil,
+							Errors:   nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 70,
+									Line:   33,
+								},
+								File:   "date.flux",
+								Source: "t: t, location: location",
+								Start: ast.Position{
+									Column: 46,
+									Line:   33,
+								},
+							},
+							TrailingComment: nil,
+						},
+						Properties: []*ast.Property{&ast.Property{
+							BaseNode: ast.BaseNode{
+								Comments: nil,
+								Errors:   nil,
+								Loc: &ast.SourceLocation{
+									End: ast.Position{
+										Column: 50,
+										Line:   33,
+									},
+									File:   "date.flux",
+									Source: "t: t",
+									Start: ast.Position{
+										Column: 46,
+										Line:   33,
+									},
+								},
+								TrailingComment: nil,
+							},
+							Key: &ast.Identifier{
+								BaseNode: ast.BaseNode{
+									Comments: nil,
+									Errors:   nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 47,
+											Line:   33,
+										},
+										File:   "date.flux",
+										Source: "t",
+										Start: ast.Position{
+											Column: 46,
+											Line:   33,
+										},
+									},
+									TrailingComment: nil,
+								},
+								Name: "t",
+							},
+							Value: &ast.Identifier{
+								BaseNode: ast.BaseNode{
+									Comments: nil,
+									Errors:   nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 50,
+											Line:   33,
+										},
+										File:   "date.flux",
+										Source: "t",
+										Start: ast.Position{
+											Column: 49,
+											Line:   33,
+										},
+									},
+									TrailingComment: nil,
+								},
+								Name: "t",
+							},
+						}, &ast.Property{
+							BaseNode: ast.BaseNode{
+								Comments: nil,
+								Errors:   nil,
+								Loc: &ast.SourceLocation{
+									End: ast.Position{
+										Column: 70,
+										Line:   33,
+									},
+									File:   "date.flux",
+									Source: "location: location",
+									Start: ast.Position{
+										Column: 52,
+										Line:   33,
+									},
+								},
+								TrailingComment: nil,
+							},
+							Key: &ast.Identifier{
+								BaseNode: ast.BaseNode{
+									Comments: nil,
+									Errors:   nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 60,
+											Line:   33,
+										},
+										File:   "date.flux",
+										Source: "location",
+										Start: ast.Position{
+											Column: 52,
+											Line:   33,
+										},
+									},
+									TrailingComment: nil,
+								},
+								Name: "location",
+							},
+							Value: &ast.Identifier{
+								BaseNode: ast.BaseNode{
+									Comments: nil,
+									Errors:   nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 70,
+											Line:   33,
+										},
+										File:   "date.flux",
+										Source: "location",
+										Start: ast.Position{
+											Column: 62,
+											Line:   33,
+										},
+									},
+									TrailingComment: nil,
+								},
+								Name: "location",
+							},
+						}},
+						With: nil,
+					}},
+					BaseNode: ast.BaseNode{
+						Comments: nil,
+						Errors:   nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 71,
+								Line:   33,
+							},
+							File:   "date.flux",
+							Source: "_weekDay(t: t, location: location)",
+							Start: ast.Position{
+								Column: 37,
+								Line:   33,
+							},
+						},
+						TrailingComment: nil,
+					},
+					Callee: &ast.Identifier{
+						BaseNode: ast.BaseNode{
+							Comments: nil,
+							Errors:   nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 45,
+									Line:   33,
+								},
+								File:   "date.flux",
+								Source: "_weekDay",
+								Start: ast.Position{
+									Column: 37,
+									Line:   33,
+								},
+							},
+							TrailingComment: nil,
+						},
+						Name: "_weekDay",
+					},
+				},
+				Params: []*ast.Property{&ast.Property{
+					BaseNode: ast.BaseNode{
+						Comments: nil,
+						Errors:   nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 13,
+								Line:   33,
+							},
+							File:   "date.flux",
+							Source: "t",
+							Start: ast.Position{
+								Column: 12,
+								Line:   33,
+							},
+						},
+						TrailingComment: nil,
+					},
+					Key: &ast.Identifier{
+						BaseNode: ast.BaseNode{
+							Comments: nil,
+							Errors:   nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 13,
+									Line:   33,
+								},
+								File:   "date.flux",
+								Source: "t",
+								Start: ast.Position{
+									Column: 12,
+									Line:   33,
+								},
+							},
+							TrailingComment: nil,
+						},
+						Name: "t",
+					},
+					Value: nil,
+				}, &ast.Property{
+					BaseNode: ast.BaseNode{
+						Comments: nil,
+						Errors:   nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 32,
+								Line:   33,
+							},
+							File:   "date.flux",
+							Source: "location=location",
+							Start: ast.Position{
+								Column: 15,
+								Line:   33,
+							},
+						},
+						TrailingComment: nil,
+					},
+					Key: &ast.Identifier{
+						BaseNode: ast.BaseNode{
+							Comments: nil,
+							Errors:   nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 23,
+									Line:   33,
+								},
+								File:   "date.flux",
+								Source: "location",
+								Start: ast.Position{
+									Column: 15,
+									Line:   33,
+								},
+							},
+							TrailingComment: nil,
+						},
+						Name: "location",
+					},
+					Value: &ast.Identifier{
+						BaseNode: ast.BaseNode{
+							Comments: nil,
+							Errors:   nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 32,
+									Line:   33,
+								},
+								File:   "date.flux",
+								Source: "location",
+								Start: ast.Position{
+									Column: 24,
+									Line:   33,
+								},
+							},
+							TrailingComment: nil,
+						},
+						Name: "location",
+					},
+				}},
+			},
+		}, &ast.VariableAssignment{
+			BaseNode: ast.BaseNode{
+				Comments: nil,
+				Errors:   nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 73,
+						Line:   34,
+					},
+					File:   "date.flux",
+					Source: "monthDay = (t, location=location) => _monthDay(t: t, location: location)",
+					Start: ast.Position{
+						Column: 1,
+						Line:   34,
+					},
+				},
+				TrailingComment: nil,
+			},
+			ID: &ast.Identifier{
+				BaseNode: ast.BaseNode{
+					Comments: nil,
+					Errors:   nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 9,
+							Line:   34,
+						},
+						File:   "date.flux",
+						Source: "monthDay",
+						Start: ast.Position{
+							Column: 1,
+							Line:   34,
+						},
+					},
+					TrailingComment: nil,
+				},
+				Name: "monthDay",
+			},
+			Init: &ast.FunctionExpression{
+				BaseNode: ast.BaseNode{
+					Comments: nil,
+					Errors:   nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 73,
+							Line:   34,
+						},
+						File:   "date.flux",
+						Source: "(t, location=location) => _monthDay(t: t, location: location)",
+						Start: ast.Position{
+							Column: 12,
+							Line:   34,
+						},
+					},
+					TrailingComment: nil,
+				},
+				Body: &ast.CallExpression{
+					Arguments: []ast.Expression{&ast.ObjectExpression{
+						BaseNode: ast.BaseNode{
+							Comments: nil,
+							Errors:   nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 72,
+									Line:   34,
+								},
+								File:   "date.flux",
+								Source: "t: t, location: location",
+								Start: ast.Position{
+									Column: 48,
+									Line:   34,
+								},
+							},
+							TrailingComment: nil,
+						},
+						Properties: []*ast.Property{&ast.Property{
+							BaseNode: ast.BaseNode{
+								Comments: nil,
+								Errors:   nil,
+								Loc: &ast.SourceLocation{
+									End: ast.Position{
+										Column: 52,
+										Line:   34,
+									},
+									File:   "date.flux",
+									Source: "t: t",
+									Start: ast.Position{
+										Column: 48,
+										Line:   34,
+									},
+								},
+								TrailingComment: nil,
+							},
+							Key: &ast.Identifier{
+								BaseNode: ast.BaseNode{
+									Comments: nil,
+									Errors:   nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 49,
+											Line:   34,
+										},
+										File:   "date.flux",
+										Source: "t",
+										Start: ast.Position{
+											Column: 48,
+											Line:   34,
+										},
+									},
+									TrailingComment: nil,
+								},
+								Name: "t",
+							},
+							Value: &ast.Identifier{
+								BaseNode: ast.BaseNode{
+									Comments: nil,
+									Errors:   nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 52,
+											Line:   34,
+										},
+										File:   "date.flux",
+										Source: "t",
+										Start: ast.Position{
+											Column: 51,
+											Line:   34,
+										},
+									},
+									TrailingComment: nil,
+								},
+								Name: "t",
+							},
+						}, &ast.Property{
+							BaseNode: ast.BaseNode{
+								Comments: nil,
+								Errors:   nil,
+								Loc: &ast.SourceLocation{
+									End: ast.Position{
+										Column: 72,
+										Line:   34,
+									},
+									File:   "date.flux",
+									Source: "location: location",
+									Start: ast.Position{
+										Column: 54,
+										Line:   34,
+									},
+								},
+								TrailingComment: nil,
+							},
+							Key: &ast.Identifier{
+								BaseNode: ast.BaseNode{
+									Comments: nil,
+									Errors:   nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 62,
+											Line:   34,
+										},
+										File:   "date.flux",
+										Source: "location",
+										Start: ast.Position{
+											Column: 54,
+											Line:   34,
+										},
+									},
+									TrailingComment: nil,
+								},
+								Name: "location",
+							},
+							Value: &ast.Identifier{
+								BaseNode: ast.BaseNode{
+									Comments: nil,
+									Errors:   nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 72,
+											Line:   34,
+										},
+										File:   "date.flux",
+										Source: "location",
+										Start: ast.Position{
+											Column: 64,
+											Line:   34,
+										},
+									},
+									TrailingComment: nil,
+								},
+								Name: "location",
+							},
+						}},
+						With: nil,
+					}},
+					BaseNode: ast.BaseNode{
+						Comments: nil,
+						Errors:   nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 73,
+								Line:   34,
+							},
+							File:   "date.flux",
+							Source: "_monthDay(t: t, location: location)",
+							Start: ast.Position{
+								Column: 38,
+								Line:   34,
+							},
+						},
+						TrailingComment: nil,
+					},
+					Callee: &ast.Identifier{
+						BaseNode: ast.BaseNode{
+							Comments: nil,
+							Errors:   nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 47,
+									Line:   34,
+								},
+								File:   "date.flux",
+								Source: "_monthDay",
+								Start: ast.Position{
+									Column: 38,
+									Line:   34,
+								},
+							},
+							TrailingComment: nil,
+						},
+						Name: "_monthDay",
+					},
+				},
+				Params: []*ast.Property{&ast.Property{
+					BaseNode: ast.BaseNode{
+						Comments: nil,
+						Errors:   nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 14,
+								Line:   34,
+							},
+							File:   "date.flux",
+							Source: "t",
+							Start: ast.Position{
+								Column: 13,
+								Line:   34,
+							},
+						},
+						TrailingComment: nil,
+					},
+					Key: &ast.Identifier{
+						BaseNode: ast.BaseNode{
+							Comments: nil,
+							Errors:   nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 14,
+									Line:   34,
+								},
+								File:   "date.flux",
+								Source: "t",
+								Start: ast.Position{
+									Column: 13,
+									Line:   34,
+								},
+							},
+							TrailingComment: nil,
+						},
+						Name: "t",
+					},
+					Value: nil,
+				}, &ast.Property{
+					BaseNode: ast.BaseNode{
+						Comments: nil,
+						Errors:   nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 33,
+								Line:   34,
+							},
+							File:   "date.flux",
+							Source: "location=location",
+							Start: ast.Position{
+								Column: 16,
+								Line:   34,
+							},
+						},
+						TrailingComment: nil,
+					},
+					Key: &ast.Identifier{
+						BaseNode: ast.BaseNode{
+							Comments: nil,
+							Errors:   nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 24,
+									Line:   34,
+								},
+								File:   "date.flux",
+								Source: "location",
+								Start: ast.Position{
+									Column: 16,
+									Line:   34,
+								},
+							},
+							TrailingComment: nil,
+						},
+						Name: "location",
+					},
+					Value: &ast.Identifier{
+						BaseNode: ast.BaseNode{
+							Comments: nil,
+							Errors:   nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 33,
+									Line:   34,
+								},
+								File:   "date.flux",
+								Source: "location",
+								Start: ast.Position{
+									Column: 25,
+									Line:   34,
+								},
+							},
+							TrailingComment: nil,
+						},
+						Name: "location",
+					},
+				}},
+			},
+		}, &ast.VariableAssignment{
+			BaseNode: ast.BaseNode{
+				Comments: nil,
+				Errors:   nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 71,
+						Line:   35,
+					},
+					File:   "date.flux",
+					Source: "yearDay = (t, location=location) => _yearDay(t: t, location: location)",
+					Start: ast.Position{
+						Column: 1,
+						Line:   35,
+					},
+				},
+				TrailingComment: nil,
+			},
+			ID: &ast.Identifier{
+				BaseNode: ast.BaseNode{
+					Comments: nil,
+					Errors:   nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 8,
+							Line:   35,
+						},
+						File:   "date.flux",
+						Source: "yearDay",
+						Start: ast.Position{
+							Column: 1,
+							Line:   35,
+						},
+					},
+					TrailingComment: nil,
+				},
+				Name: "yearDay",
+			},
+			Init: &ast.FunctionExpression{
+				BaseNode: ast.BaseNode{
+					Comments: nil,
+					Errors:   nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 71,
+							Line:   35,
+						},
+						File:   "date.flux",
+						Source: "(t, location=location) => _yearDay(t: t, location: location)",
+						Start: ast.Position{
+							Column: 11,
+							Line:   35,
+						},
+					},
+					TrailingComment: nil,
+				},
+				Body: &ast.CallExpression{
+					Arguments: []ast.Expression{&ast.ObjectExpression{
+						BaseNode: ast.BaseNode{
+							Comments: nil,
+							Errors:   nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 70,
+									Line:   35,
+								},
+								File:   "date.flux",
+								Source: "t: t, location: location",
+								Start: ast.Position{
+									Column: 46,
+									Line:   35,
+								},
+							},
+							TrailingComment: nil,
+						},
+						Properties: []*ast.Property{&ast.Property{
+							BaseNode: ast.BaseNode{
+								Comments: nil,
+								Errors:   nil,
+								Loc: &ast.SourceLocation{
+									End: ast.Position{
+										Column: 50,
+										Line:   35,
+									},
+									File:   "date.flux",
+									Source: "t: t",
+									Start: ast.Position{
+										Column: 46,
+										Line:   35,
+									},
+								},
+								TrailingComment: nil,
+							},
+							Key: &ast.Identifier{
+								BaseNode: ast.BaseNode{
+									Comments: nil,
+									Errors:   nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 47,
+											Line:   35,
+										},
+										File:   "date.flux",
+										Source: "t",
+										Start: ast.Position{
+											Column: 46,
+											Line:   35,
+										},
+									},
+									TrailingComment: nil,
+								},
+								Name: "t",
+							},
+							Value: &ast.Identifier{
+								BaseNode: ast.BaseNode{
+									Comments: nil,
+									Errors:   nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 50,
+											Line:   35,
+										},
+										File:   "date.flux",
+										Source: "t",
+										Start: ast.Position{
+											Column: 49,
+											Line:   35,
+										},
+									},
+									TrailingComment: nil,
+								},
+								Name: "t",
+							},
+						}, &ast.Property{
+							BaseNode: ast.BaseNode{
+								Comments: nil,
+								Errors:   nil,
+								Loc: &ast.SourceLocation{
+									End: ast.Position{
+										Column: 70,
+										Line:   35,
+									},
+									File:   "date.flux",
+									Source: "location: location",
+									Start: ast.Position{
+										Column: 52,
+										Line:   35,
+									},
+								},
+								TrailingComment: nil,
+							},
+							Key: &ast.Identifier{
+								BaseNode: ast.BaseNode{
+									Comments: nil,
+									Errors:   nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 60,
+											Line:   35,
+										},
+										File:   "date.flux",
+										Source: "location",
+										Start: ast.Position{
+											Column: 52,
+											Line:   35,
+										},
+									},
+									TrailingComment: nil,
+								},
+								Name: "location",
+							},
+							Value: &ast.Identifier{
+								BaseNode: ast.BaseNode{
+									Comments: nil,
+									Errors:   nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 70,
+											Line:   35,
+										},
+										File:   "date.flux",
+										Source: "location",
+										Start: ast.Position{
+											Column: 62,
+											Line:   35,
+										},
+									},
+									TrailingComment: nil,
+								},
+								Name: "location",
+							},
+						}},
+						With: nil,
+					}},
+					BaseNode: ast.BaseNode{
+						Comments: nil,
+						Errors:   nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 71,
+								Line:   35,
+							},
+							File:   "date.flux",
+							Source: "_yearDay(t: t, location: location)",
+							Start: ast.Position{
+								Column: 37,
+								Line:   35,
+							},
+						},
+						TrailingComment: nil,
+					},
+					Callee: &ast.Identifier{
+						BaseNode: ast.BaseNode{
+							Comments: nil,
+							Errors:   nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 45,
+									Line:   35,
+								},
+								File:   "date.flux",
+								Source: "_yearDay",
+								Start: ast.Position{
+									Column: 37,
+									Line:   35,
+								},
+							},
+							TrailingComment: nil,
+						},
+						Name: "_yearDay",
+					},
+				},
+				Params: []*ast.Property{&ast.Property{
+					BaseNode: ast.BaseNode{
+						Comments: nil,
+						Errors:   nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 13,
+								Line:   35,
+							},
+							File:   "date.flux",
+							Source: "t",
+							Start: ast.Position{
+								Column: 12,
+								Line:   35,
+							},
+						},
+						TrailingComment: nil,
+					},
+					Key: &ast.Identifier{
+						BaseNode: ast.BaseNode{
+							Comments: nil,
+							Errors:   nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 13,
+									Line:   35,
+								},
+								File:   "date.flux",
+								Source: "t",
+								Start: ast.Position{
+									Column: 12,
+									Line:   35,
+								},
+							},
+							TrailingComment: nil,
+						},
+						Name: "t",
+					},
+					Value: nil,
+				}, &ast.Property{
+					BaseNode: ast.BaseNode{
+						Comments: nil,
+						Errors:   nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 32,
+								Line:   35,
+							},
+							File:   "date.flux",
+							Source: "location=location",
+							Start: ast.Position{
+								Column: 15,
+								Line:   35,
+							},
+						},
+						TrailingComment: nil,
+					},
+					Key: &ast.Identifier{
+						BaseNode: ast.BaseNode{
+							Comments: nil,
+							Errors:   nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 23,
+									Line:   35,
+								},
+								File:   "date.flux",
+								Source: "location",
+								Start: ast.Position{
+									Column: 15,
+									Line:   35,
+								},
+							},
+							TrailingComment: nil,
+						},
+						Name: "location",
+					},
+					Value: &ast.Identifier{
+						BaseNode: ast.BaseNode{
+							Comments: nil,
+							Errors:   nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 32,
+									Line:   35,
+								},
+								File:   "date.flux",
+								Source: "location",
+								Start: ast.Position{
+									Column: 24,
+									Line:   35,
+								},
+							},
+							TrailingComment: nil,
+						},
+						Name: "location",
+					},
+				}},
+			},
+		}, &ast.VariableAssignment{
+			BaseNode: ast.BaseNode{
+				Comments: nil,
+				Errors:   nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 67,
+						Line:   36,
+					},
+					File:   "date.flux",
+					Source: "month = (t, location=location) => _month(t: t, location: location)",
+					Start: ast.Position{
+						Column: 1,
+						Line:   36,
+					},
+				},
+				TrailingComment: nil,
+			},
+			ID: &ast.Identifier{
+				BaseNode: ast.BaseNode{
+					Comments: nil,
+					Errors:   nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 6,
+							Line:   36,
+						},
+						File:   "date.flux",
+						Source: "month",
+						Start: ast.Position{
+							Column: 1,
+							Line:   36,
+						},
+					},
+					TrailingComment: nil,
+				},
+				Name: "month",
+			},
+			Init: &ast.FunctionExpression{
+				BaseNode: ast.BaseNode{
+					Comments: nil,
+					Errors:   nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 67,
+							Line:   36,
+						},
+						File:   "date.flux",
+						Source: "(t, location=location) => _month(t: t, location: location)",
+						Start: ast.Position{
+							Column: 9,
+							Line:   36,
+						},
+					},
+					TrailingComment: nil,
+				},
+				Body: &ast.CallExpression{
+					Arguments: []ast.Expression{&ast.ObjectExpression{
+						BaseNode: ast.BaseNode{
+							Comments: nil,
+							Errors:   nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 66,
+									Line:   36,
+								},
+								File:   "date.flux",
+								Source: "t: t, location: location",
+								Start: ast.Position{
+									Column: 42,
+									Line:   36,
+								},
+							},
+							TrailingComment: nil,
+						},
+						Properties: []*ast.Property{&ast.Property{
+							BaseNode: ast.BaseNode{
+								Comments: nil,
+								Errors:   nil,
+								Loc: &ast.SourceLocation{
+									End: ast.Position{
+										Column: 46,
+										Line:   36,
+									},
+									File:   "date.flux",
+									Source: "t: t",
+									Start: ast.Position{
+										Column: 42,
+										Line:   36,
+									},
+								},
+								TrailingComment: nil,
+							},
+							Key: &ast.Identifier{
+								BaseNode: ast.BaseNode{
+									Comments: nil,
+									Errors:   nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 43,
+											Line:   36,
+										},
+										File:   "date.flux",
+										Source: "t",
+										Start: ast.Position{
+											Column: 42,
+											Line:   36,
+										},
+									},
+									TrailingComment: nil,
+								},
+								Name: "t",
+							},
+							Value: &ast.Identifier{
+								BaseNode: ast.BaseNode{
+									Comments: nil,
+									Errors:   nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 46,
+											Line:   36,
+										},
+										File:   "date.flux",
+										Source: "t",
+										Start: ast.Position{
+											Column: 45,
+											Line:   36,
+										},
+									},
+									TrailingComment: nil,
+								},
+								Name: "t",
+							},
+						}, &ast.Property{
+							BaseNode: ast.BaseNode{
+								Comments: nil,
+								Errors:   nil,
+								Loc: &ast.SourceLocation{
+									End: ast.Position{
+										Column: 66,
+										Line:   36,
+									},
+									File:   "date.flux",
+									Source: "location: location",
+									Start: ast.Position{
+										Column: 48,
+										Line:   36,
+									},
+								},
+								TrailingComment: nil,
+							},
+							Key: &ast.Identifier{
+								BaseNode: ast.BaseNode{
+									Comments: nil,
+									Errors:   nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 56,
+											Line:   36,
+										},
+										File:   "date.flux",
+										Source: "location",
+										Start: ast.Position{
+											Column: 48,
+											Line:   36,
+										},
+									},
+									TrailingComment: nil,
+								},
+								Name: "location",
+							},
+							Value: &ast.Identifier{
+								BaseNode: ast.BaseNode{
+									Comments: nil,
+									Errors:   nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 66,
+											Line:   36,
+										},
+										File:   "date.flux",
+										Source: "location",
+										Start: ast.Position{
+											Column: 58,
+											Line:   36,
+										},
+									},
+									TrailingComment: nil,
+								},
+								Name: "location",
+							},
+						}},
+						With: nil,
+					}},
+					BaseNode: ast.BaseNode{
+						Comments: nil,
+						Errors:   nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 67,
+								Line:   36,
+							},
+							File:   "date.flux",
+							Source: "_month(t: t, location: location)",
+							Start: ast.Position{
+								Column: 35,
+								Line:   36,
+							},
+						},
+						TrailingComment: nil,
+					},
+					Callee: &ast.Identifier{
+						BaseNode: ast.BaseNode{
+							Comments: nil,
+							Errors:   nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 41,
+									Line:   36,
+								},
+								File:   "date.flux",
+								Source: "_month",
+								Start: ast.Position{
+									Column: 35,
+									Line:   36,
+								},
+							},
+							TrailingComment: nil,
+						},
+						Name: "_month",
+					},
+				},
+				Params: []*ast.Property{&ast.Property{
+					BaseNode: ast.BaseNode{
+						Comments: nil,
+						Errors:   nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 11,
+								Line:   36,
+							},
+							File:   "date.flux",
+							Source: "t",
+							Start: ast.Position{
+								Column: 10,
+								Line:   36,
+							},
+						},
+						TrailingComment: nil,
+					},
+					Key: &ast.Identifier{
+						BaseNode: ast.BaseNode{
+							Comments: nil,
+							Errors:   nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 11,
+									Line:   36,
+								},
+								File:   "date.flux",
+								Source: "t",
+								Start: ast.Position{
+									Column: 10,
+									Line:   36,
+								},
+							},
+							TrailingComment: nil,
+						},
+						Name: "t",
+					},
+					Value: nil,
+				}, &ast.Property{
+					BaseNode: ast.BaseNode{
+						Comments: nil,
+						Errors:   nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 30,
+								Line:   36,
+							},
+							File:   "date.flux",
+							Source: "location=location",
+							Start: ast.Position{
+								Column: 13,
+								Line:   36,
+							},
+						},
+						TrailingComment: nil,
+					},
+					Key: &ast.Identifier{
+						BaseNode: ast.BaseNode{
+							Comments: nil,
+							Errors:   nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 21,
+									Line:   36,
+								},
+								File:   "date.flux",
+								Source: "location",
+								Start: ast.Position{
+									Column: 13,
+									Line:   36,
+								},
+							},
+							TrailingComment: nil,
+						},
+						Name: "location",
+					},
+					Value: &ast.Identifier{
+						BaseNode: ast.BaseNode{
+							Comments: nil,
+							Errors:   nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 30,
+									Line:   36,
+								},
+								File:   "date.flux",
+								Source: "location",
+								Start: ast.Position{
+									Column: 22,
+									Line:   36,
+								},
+							},
+							TrailingComment: nil,
+						},
+						Name: "location",
+					},
+				}},
+			},
+		}, &ast.VariableAssignment{
+			BaseNode: ast.BaseNode{
+				Comments: nil,
+				Errors:   nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 65,
+						Line:   37,
+					},
+					File:   "date.flux",
+					Source: "year = (t, location=location) => _year(t: t, location: location)",
+					Start: ast.Position{
+						Column: 1,
+						Line:   37,
+					},
+				},
+				TrailingComment: nil,
+			},
+			ID: &ast.Identifier{
+				BaseNode: ast.BaseNode{
+					Comments: nil,
+					Errors:   nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 5,
+							Line:   37,
+						},
+						File:   "date.flux",
+						Source: "year",
+						Start: ast.Position{
+							Column: 1,
+							Line:   37,
+						},
+					},
+					TrailingComment: nil,
+				},
+				Name: "year",
+			},
+			Init: &ast.FunctionExpression{
+				BaseNode: ast.BaseNode{
+					Comments: nil,
+					Errors:   nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 65,
+							Line:   37,
+						},
+						File:   "date.flux",
+						Source: "(t, location=location) => _year(t: t, location: location)",
+						Start: ast.Position{
+							Column: 8,
+							Line:   37,
+						},
+					},
+					TrailingComment: nil,
+				},
+				Body: &ast.CallExpression{
+					Arguments: []ast.Expression{&ast.ObjectExpression{
+						BaseNode: ast.BaseNode{
+							Comments: nil,
+							Errors:   nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 64,
+									Line:   37,
+								},
+								File:   "date.flux",
+								Source: "t: t, location: location",
+								Start: ast.Position{
+									Column: 40,
+									Line:   37,
+								},
+							},
+							TrailingComment: nil,
+						},
+						Properties: []*ast.Property{&ast.Property{
+							BaseNode: ast.BaseNode{
+								Comments: nil,
+								Errors:   nil,
+								Loc: &ast.SourceLocation{
+									End: ast.Position{
+										Column: 44,
+										Line:   37,
+									},
+									File:   "date.flux",
+									Source: "t: t",
+									Start: ast.Position{
+										Column: 40,
+										Line:   37,
+									},
+								},
+								TrailingComment: nil,
+							},
+							Key: &ast.Identifier{
+								BaseNode: ast.BaseNode{
+									Comments: nil,
+									Errors:   nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 41,
+											Line:   37,
+										},
+										File:   "date.flux",
+										Source: "t",
+										Start: ast.Position{
+											Column: 40,
+											Line:   37,
+										},
+									},
+									TrailingComment: nil,
+								},
+								Name: "t",
+							},
+							Value: &ast.Identifier{
+								BaseNode: ast.BaseNode{
+									Comments: nil,
+									Errors:   nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 44,
+											Line:   37,
+										},
+										File:   "date.flux",
+										Source: "t",
+										Start: ast.Position{
+											Column: 43,
+											Line:   37,
+										},
+									},
+									TrailingComment: nil,
+								},
+								Name: "t",
+							},
+						}, &ast.Property{
+							BaseNode: ast.BaseNode{
+								Comments: nil,
+								Errors:   nil,
+								Loc: &ast.SourceLocation{
+									End: ast.Position{
+										Column: 64,
+										Line:   37,
+									},
+									File:   "date.flux",
+									Source: "location: location",
+									Start: ast.Position{
+										Column: 46,
+										Line:   37,
+									},
+								},
+								TrailingComment: nil,
+							},
+							Key: &ast.Identifier{
+								BaseNode: ast.BaseNode{
+									Comments: nil,
+									Errors:   nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 54,
+											Line:   37,
+										},
+										File:   "date.flux",
+										Source: "location",
+										Start: ast.Position{
+											Column: 46,
+											Line:   37,
+										},
+									},
+									TrailingComment: nil,
+								},
+								Name: "location",
+							},
+							Value: &ast.Identifier{
+								BaseNode: ast.BaseNode{
+									Comments: nil,
+									Errors:   nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 64,
+											Line:   37,
+										},
+										File:   "date.flux",
+										Source: "location",
+										Start: ast.Position{
+											Column: 56,
+											Line:   37,
+										},
+									},
+									TrailingComment: nil,
+								},
+								Name: "location",
+							},
+						}},
+						With: nil,
+					}},
+					BaseNode: ast.BaseNode{
+						Comments: nil,
+						Errors:   nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 65,
+								Line:   37,
+							},
+							File:   "date.flux",
+							Source: "_year(t: t, location: location)",
+							Start: ast.Position{
+								Column: 34,
+								Line:   37,
+							},
+						},
+						TrailingComment: nil,
+					},
+					Callee: &ast.Identifier{
+						BaseNode: ast.BaseNode{
+							Comments: nil,
+							Errors:   nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 39,
+									Line:   37,
+								},
+								File:   "date.flux",
+								Source: "_year",
+								Start: ast.Position{
+									Column: 34,
+									Line:   37,
+								},
+							},
+							TrailingComment: nil,
+						},
+						Name: "_year",
+					},
+				},
+				Params: []*ast.Property{&ast.Property{
+					BaseNode: ast.BaseNode{
+						Comments: nil,
+						Errors:   nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 10,
+								Line:   37,
+							},
+							File:   "date.flux",
+							Source: "t",
+							Start: ast.Position{
+								Column: 9,
+								Line:   37,
+							},
+						},
+						TrailingComment: nil,
+					},
+					Key: &ast.Identifier{
+						BaseNode: ast.BaseNode{
+							Comments: nil,
+							Errors:   nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 10,
+									Line:   37,
+								},
+								File:   "date.flux",
+								Source: "t",
+								Start: ast.Position{
+									Column: 9,
+									Line:   37,
+								},
+							},
+							TrailingComment: nil,
+						},
+						Name: "t",
+					},
+					Value: nil,
+				}, &ast.Property{
+					BaseNode: ast.BaseNode{
+						Comments: nil,
+						Errors:   nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 29,
+								Line:   37,
+							},
+							File:   "date.flux",
+							Source: "location=location",
+							Start: ast.Position{
+								Column: 12,
+								Line:   37,
+							},
+						},
+						TrailingComment: nil,
+					},
+					Key: &ast.Identifier{
+						BaseNode: ast.BaseNode{
+							Comments: nil,
+							Errors:   nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 20,
+									Line:   37,
+								},
+								File:   "date.flux",
+								Source: "location",
+								Start: ast.Position{
+									Column: 12,
+									Line:   37,
+								},
+							},
+							TrailingComment: nil,
+						},
+						Name: "location",
+					},
+					Value: &ast.Identifier{
+						BaseNode: ast.BaseNode{
+							Comments: nil,
+							Errors:   nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 29,
+									Line:   37,
+								},
+								File:   "date.flux",
+								Source: "location",
+								Start: ast.Position{
+									Column: 21,
+									Line:   37,
+								},
+							},
+							TrailingComment: nil,
+						},
+						Name: "location",
+					},
+				}},
+			},
+		}, &ast.VariableAssignment{
+			BaseNode: ast.BaseNode{
+				Comments: nil,
+				Errors:   nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 65,
+						Line:   38,
+					},
+					File:   "date.flux",
+					Source: "week = (t, location=location) => _week(t: t, location: location)",
+					Start: ast.Position{
+						Column: 1,
+						Line:   38,
+					},
+				},
+				TrailingComment: nil,
+			},
+			ID: &ast.Identifier{
+				BaseNode: ast.BaseNode{
+					Comments: nil,
+					Errors:   nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 5,
+							Line:   38,
+						},
+						File:   "date.flux",
+						Source: "week",
+						Start: ast.Position{
+							Column: 1,
+							Line:   38,
+						},
+					},
+					TrailingComment: nil,
+				},
+				Name: "week",
+			},
+			Init: &ast.FunctionExpression{
+				BaseNode: ast.BaseNode{
+					Comments: nil,
+					Errors:   nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 65,
+							Line:   38,
+						},
+						File:   "date.flux",
+						Source: "(t, location=location) => _week(t: t, location: location)",
+						Start: ast.Position{
+							Column: 8,
+							Line:   38,
+						},
+					},
+					TrailingComment: nil,
+				},
+				Body: &ast.CallExpression{
+					Arguments: []ast.Expression{&ast.ObjectExpression{
+						BaseNode: ast.BaseNode{
+							Comments: nil,
+							Errors:   nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 64,
+									Line:   38,
+								},
+								File:   "date.flux",
+								Source: "t: t, location: location",
+								Start: ast.Position{
+									Column: 40,
+									Line:   38,
+								},
+							},
+							TrailingComment: nil,
+						},
+						Properties: []*ast.Property{&ast.Property{
+							BaseNode: ast.BaseNode{
+								Comments: nil,
+								Errors:   nil,
+								Loc: &ast.SourceLocation{
+									End: ast.Position{
+										Column: 44,
+										Line:   38,
+									},
+									File:   "date.flux",
+									Source: "t: t",
+									Start: ast.Position{
+										Column: 40,
+										Line:   38,
+									},
+								},
+								TrailingComment: nil,
+							},
+							Key: &ast.Identifier{
+								BaseNode: ast.BaseNode{
+									Comments: nil,
+									Errors:   nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 41,
+											Line:   38,
+										},
+										File:   "date.flux",
+										Source: "t",
+										Start: ast.Position{
+											Column: 40,
+											Line:   38,
+										},
+									},
+									TrailingComment: nil,
+								},
+								Name: "t",
+							},
+							Value: &ast.Identifier{
+								BaseNode: ast.BaseNode{
+									Comments: nil,
+									Errors:   nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 44,
+											Line:   38,
+										},
+										File:   "date.flux",
+										Source: "t",
+										Start: ast.Position{
+											Column: 43,
+											Line:   38,
+										},
+									},
+									TrailingComment: nil,
+								},
+								Name: "t",
+							},
+						}, &ast.Property{
+							BaseNode: ast.BaseNode{
+								Comments: nil,
+								Errors:   nil,
+								Loc: &ast.SourceLocation{
+									End: ast.Position{
+										Column: 64,
+										Line:   38,
+									},
+									File:   "date.flux",
+									Source: "location: location",
+									Start: ast.Position{
+										Column: 46,
+										Line:   38,
+									},
+								},
+								TrailingComment: nil,
+							},
+							Key: &ast.Identifier{
+								BaseNode: ast.BaseNode{
+									Comments: nil,
+									Errors:   nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 54,
+											Line:   38,
+										},
+										File:   "date.flux",
+										Source: "location",
+										Start: ast.Position{
+											Column: 46,
+											Line:   38,
+										},
+									},
+									TrailingComment: nil,
+								},
+								Name: "location",
+							},
+							Value: &ast.Identifier{
+								BaseNode: ast.BaseNode{
+									Comments: nil,
+									Errors:   nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 64,
+											Line:   38,
+										},
+										File:   "date.flux",
+										Source: "location",
+										Start: ast.Position{
+											Column: 56,
+											Line:   38,
+										},
+									},
+									TrailingComment: nil,
+								},
+								Name: "location",
+							},
+						}},
+						With: nil,
+					}},
+					BaseNode: ast.BaseNode{
+						Comments: nil,
+						Errors:   nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 65,
+								Line:   38,
+							},
+							File:   "date.flux",
+							Source: "_week(t: t, location: location)",
+							Start: ast.Position{
+								Column: 34,
+								Line:   38,
+							},
+						},
+						TrailingComment: nil,
+					},
+					Callee: &ast.Identifier{
+						BaseNode: ast.BaseNode{
+							Comments: nil,
+							Errors:   nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 39,
+									Line:   38,
+								},
+								File:   "date.flux",
+								Source: "_week",
+								Start: ast.Position{
+									Column: 34,
+									Line:   38,
+								},
+							},
+							TrailingComment: nil,
+						},
+						Name: "_week",
+					},
+				},
+				Params: []*ast.Property{&ast.Property{
+					BaseNode: ast.BaseNode{
+						Comments: nil,
+						Errors:   nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 10,
+								Line:   38,
+							},
+							File:   "date.flux",
+							Source: "t",
+							Start: ast.Position{
+								Column: 9,
+								Line:   38,
+							},
+						},
+						TrailingComment: nil,
+					},
+					Key: &ast.Identifier{
+						BaseNode: ast.BaseNode{
+							Comments: nil,
+							Errors:   nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 10,
+									Line:   38,
+								},
+								File:   "date.flux",
+								Source: "t",
+								Start: ast.Position{
+									Column: 9,
+									Line:   38,
+								},
+							},
+							TrailingComment: nil,
+						},
+						Name: "t",
+					},
+					Value: nil,
+				}, &ast.Property{
+					BaseNode: ast.BaseNode{
+						Comments: nil,
+						Errors:   nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 29,
+								Line:   38,
+							},
+							File:   "date.flux",
+							Source: "location=location",
+							Start: ast.Position{
+								Column: 12,
+								Line:   38,
+							},
+						},
+						TrailingComment: nil,
+					},
+					Key: &ast.Identifier{
+						BaseNode: ast.BaseNode{
+							Comments: nil,
+							Errors:   nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 20,
+									Line:   38,
+								},
+								File:   "date.flux",
+								Source: "location",
+								Start: ast.Position{
+									Column: 12,
+									Line:   38,
+								},
+							},
+							TrailingComment: nil,
+						},
+						Name: "location",
+					},
+					Value: &ast.Identifier{
+						BaseNode: ast.BaseNode{
+							Comments: nil,
+							Errors:   nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 29,
+									Line:   38,
+								},
+								File:   "date.flux",
+								Source: "location",
+								Start: ast.Position{
+									Column: 21,
+									Line:   38,
+								},
+							},
+							TrailingComment: nil,
+						},
+						Name: "location",
+					},
+				}},
+			},
+		}, &ast.VariableAssignment{
+			BaseNode: ast.BaseNode{
+				Comments: nil,
+				Errors:   nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 71,
+						Line:   39,
+					},
+					File:   "date.flux",
+					Source: "quarter = (t, location=location) => _quarter(t: t, location: location)",
+					Start: ast.Position{
+						Column: 1,
+						Line:   39,
+					},
+				},
+				TrailingComment: nil,
+			},
+			ID: &ast.Identifier{
+				BaseNode: ast.BaseNode{
+					Comments: nil,
+					Errors:   nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 8,
+							Line:   39,
+						},
+						File:   "date.flux",
+						Source: "quarter",
+						Start: ast.Position{
+							Column: 1,
+							Line:   39,
+						},
+					},
+					TrailingComment: nil,
+				},
+				Name: "quarter",
+			},
+			Init: &ast.FunctionExpression{
+				BaseNode: ast.BaseNode{
+					Comments: nil,
+					Errors:   nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 71,
+							Line:   39,
+						},
+						File:   "date.flux",
+						Source: "(t, location=location) => _quarter(t: t, location: location)",
+						Start: ast.Position{
+							Column: 11,
+							Line:   39,
+						},
+					},
+					TrailingComment: nil,
+				},
+				Body: &ast.CallExpression{
+					Arguments: []ast.Expression{&ast.ObjectExpression{
+						BaseNode: ast.BaseNode{
+							Comments: nil,
+							Errors:   nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 70,
+									Line:   39,
+								},
+								File:   "date.flux",
+								Source: "t: t, location: location",
+								Start: ast.Position{
+									Column: 46,
+									Line:   39,
+								},
+							},
+							TrailingComment: nil,
+						},
+						Properties: []*ast.Property{&ast.Property{
+							BaseNode: ast.BaseNode{
+								Comments: nil,
+								Errors:   nil,
+								Loc: &ast.SourceLocation{
+									End: ast.Position{
+										Column: 50,
+										Line:   39,
+									},
+									File:   "date.flux",
+									Source: "t: t",
+									Start: ast.Position{
+										Column: 46,
+										Line:   39,
+									},
+								},
+								TrailingComment: nil,
+							},
+							Key: &ast.Identifier{
+								BaseNode: ast.BaseNode{
+									Comments: nil,
+									Errors:   nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 47,
+											Line:   39,
+										},
+										File:   "date.flux",
+										Source: "t",
+										Start: ast.Position{
+											Column: 46,
+											Line:   39,
+										},
+									},
+									TrailingComment: nil,
+								},
+								Name: "t",
+							},
+							Value: &ast.Identifier{
+								BaseNode: ast.BaseNode{
+									Comments: nil,
+									Errors:   nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 50,
+											Line:   39,
+										},
+										File:   "date.flux",
+										Source: "t",
+										Start: ast.Position{
+											Column: 49,
+											Line:   39,
+										},
+									},
+									TrailingComment: nil,
+								},
+								Name: "t",
+							},
+						}, &ast.Property{
+							BaseNode: ast.BaseNode{
+								Comments: nil,
+								Errors:   nil,
+								Loc: &ast.SourceLocation{
+									End: ast.Position{
+										Column: 70,
+										Line:   39,
+									},
+									File:   "date.flux",
+									Source: "location: location",
+									Start: ast.Position{
+										Column: 52,
+										Line:   39,
+									},
+								},
+								TrailingComment: nil,
+							},
+							Key: &ast.Identifier{
+								BaseNode: ast.BaseNode{
+									Comments: nil,
+									Errors:   nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 60,
+											Line:   39,
+										},
+										File:   "date.flux",
+										Source: "location",
+										Start: ast.Position{
+											Column: 52,
+											Line:   39,
+										},
+									},
+									TrailingComment: nil,
+								},
+								Name: "location",
+							},
+							Value: &ast.Identifier{
+								BaseNode: ast.BaseNode{
+									Comments: nil,
+									Errors:   nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 70,
+											Line:   39,
+										},
+										File:   "date.flux",
+										Source: "location",
+										Start: ast.Position{
+											Column: 62,
+											Line:   39,
+										},
+									},
+									TrailingComment: nil,
+								},
+								Name: "location",
+							},
+						}},
+						With: nil,
+					}},
+					BaseNode: ast.BaseNode{
+						Comments: nil,
+						Errors:   nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 71,
+								Line:   39,
+							},
+							File:   "date.flux",
+							Source: "_quarter(t: t, location: location)",
+							Start: ast.Position{
+								Column: 37,
+								Line:   39,
+							},
+						},
+						TrailingComment: nil,
+					},
+					Callee: &ast.Identifier{
+						BaseNode: ast.BaseNode{
+							Comments: nil,
+							Errors:   nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 45,
+									Line:   39,
+								},
+								File:   "date.flux",
+								Source: "_quarter",
+								Start: ast.Position{
+									Column: 37,
+									Line:   39,
+								},
+							},
+							TrailingComment: nil,
+						},
+						Name: "_quarter",
+					},
+				},
+				Params: []*ast.Property{&ast.Property{
+					BaseNode: ast.BaseNode{
+						Comments: nil,
+						Errors:   nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 13,
+								Line:   39,
+							},
+							File:   "date.flux",
+							Source: "t",
+							Start: ast.Position{
+								Column: 12,
+								Line:   39,
+							},
+						},
+						TrailingComment: nil,
+					},
+					Key: &ast.Identifier{
+						BaseNode: ast.BaseNode{
+							Comments: nil,
+							Errors:   nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 13,
+									Line:   39,
+								},
+								File:   "date.flux",
+								Source: "t",
+								Start: ast.Position{
+									Column: 12,
+									Line:   39,
+								},
+							},
+							TrailingComment: nil,
+						},
+						Name: "t",
+					},
+					Value: nil,
+				}, &ast.Property{
+					BaseNode: ast.BaseNode{
+						Comments: nil,
+						Errors:   nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 32,
+								Line:   39,
+							},
+							File:   "date.flux",
+							Source: "location=location",
+							Start: ast.Position{
+								Column: 15,
+								Line:   39,
+							},
+						},
+						TrailingComment: nil,
+					},
+					Key: &ast.Identifier{
+						BaseNode: ast.BaseNode{
+							Comments: nil,
+							Errors:   nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 23,
+									Line:   39,
+								},
+								File:   "date.flux",
+								Source: "location",
+								Start: ast.Position{
+									Column: 15,
+									Line:   39,
+								},
+							},
+							TrailingComment: nil,
+						},
+						Name: "location",
+					},
+					Value: &ast.Identifier{
+						BaseNode: ast.BaseNode{
+							Comments: nil,
+							Errors:   nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 32,
+									Line:   39,
+								},
+								File:   "date.flux",
+								Source: "location",
+								Start: ast.Position{
+									Column: 24,
+									Line:   39,
+								},
+							},
+							TrailingComment: nil,
+						},
+						Name: "location",
+					},
+				}},
+			},
+		}, &ast.VariableAssignment{
+			BaseNode: ast.BaseNode{
+				Comments: nil,
+				Errors:   nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 79,
+						Line:   40,
+					},
+					File:   "date.flux",
+					Source: "millisecond = (t, location=location) => _millisecond(t: t, location: location)",
+					Start: ast.Position{
+						Column: 1,
+						Line:   40,
+					},
+				},
+				TrailingComment: nil,
+			},
+			ID: &ast.Identifier{
+				BaseNode: ast.BaseNode{
+					Comments: nil,
+					Errors:   nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 12,
+							Line:   40,
+						},
+						File:   "date.flux",
+						Source: "millisecond",
+						Start: ast.Position{
+							Column: 1,
+							Line:   40,
+						},
+					},
+					TrailingComment: nil,
+				},
+				Name: "millisecond",
+			},
+			Init: &ast.FunctionExpression{
+				BaseNode: ast.BaseNode{
+					Comments: nil,
+					Errors:   nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 79,
+							Line:   40,
+						},
+						File:   "date.flux",
+						Source: "(t, location=location) => _millisecond(t: t, location: location)",
+						Start: ast.Position{
+							Column: 15,
+							Line:   40,
+						},
+					},
+					TrailingComment: nil,
+				},
+				Body: &ast.CallExpression{
+					Arguments: []ast.Expression{&ast.ObjectExpression{
+						BaseNode: ast.BaseNode{
+							Comments: nil,
+							Errors:   nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 78,
+									Line:   40,
+								},
+								File:   "date.flux",
+								Source: "t: t, location: location",
+								Start: ast.Position{
+									Column: 54,
+									Line:   40,
+								},
+							},
+							TrailingComment: nil,
+						},
+						Properties: []*ast.Property{&ast.Property{
+							BaseNode: ast.BaseNode{
+								Comments: nil,
+								Errors:   nil,
+								Loc: &ast.SourceLocation{
+									End: ast.Position{
+										Column: 58,
+										Line:   40,
+									},
+									File:   "date.flux",
+									Source: "t: t",
+									Start: ast.Position{
+										Column: 54,
+										Line:   40,
+									},
+								},
+								TrailingComment: nil,
+							},
+							Key: &ast.Identifier{
+								BaseNode: ast.BaseNode{
+									Comments: nil,
+									Errors:   nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 55,
+											Line:   40,
+										},
+										File:   "date.flux",
+										Source: "t",
+										Start: ast.Position{
+											Column: 54,
+											Line:   40,
+										},
+									},
+									TrailingComment: nil,
+								},
+								Name: "t",
+							},
+							Value: &ast.Identifier{
+								BaseNode: ast.BaseNode{
+									Comments: nil,
+									Errors:   nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 58,
+											Line:   40,
+										},
+										File:   "date.flux",
+										Source: "t",
+										Start: ast.Position{
+											Column: 57,
+											Line:   40,
+										},
+									},
+									TrailingComment: nil,
+								},
+								Name: "t",
+							},
+						}, &ast.Property{
+							BaseNode: ast.BaseNode{
+								Comments: nil,
+								Errors:   nil,
+								Loc: &ast.SourceLocation{
+									End: ast.Position{
+										Column: 78,
+										Line:   40,
+									},
+									File:   "date.flux",
+									Source: "location: location",
+									Start: ast.Position{
+										Column: 60,
+										Line:   40,
+									},
+								},
+								TrailingComment: nil,
+							},
+							Key: &ast.Identifier{
+								BaseNode: ast.BaseNode{
+									Comments: nil,
+									Errors:   nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 68,
+											Line:   40,
+										},
+										File:   "date.flux",
+										Source: "location",
+										Start: ast.Position{
+											Column: 60,
+											Line:   40,
+										},
+									},
+									TrailingComment: nil,
+								},
+								Name: "location",
+							},
+							Value: &ast.Identifier{
+								BaseNode: ast.BaseNode{
+									Comments: nil,
+									Errors:   nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 78,
+											Line:   40,
+										},
+										File:   "date.flux",
+										Source: "location",
+										Start: ast.Position{
+											Column: 70,
+											Line:   40,
+										},
+									},
+									TrailingComment: nil,
+								},
+								Name: "location",
+							},
+						}},
+						With: nil,
+					}},
+					BaseNode: ast.BaseNode{
+						Comments: nil,
+						Errors:   nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 79,
+								Line:   40,
+							},
+							File:   "date.flux",
+							Source: "_millisecond(t: t, location: location)",
+							Start: ast.Position{
+								Column: 41,
+								Line:   40,
+							},
+						},
+						TrailingComment: nil,
+					},
+					Callee: &ast.Identifier{
+						BaseNode: ast.BaseNode{
+							Comments: nil,
+							Errors:   nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 53,
+									Line:   40,
+								},
+								File:   "date.flux",
+								Source: "_millisecond",
+								Start: ast.Position{
+									Column: 41,
+									Line:   40,
+								},
+							},
+							TrailingComment: nil,
+						},
+						Name: "_millisecond",
+					},
+				},
+				Params: []*ast.Property{&ast.Property{
+					BaseNode: ast.BaseNode{
+						Comments: nil,
+						Errors:   nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 17,
+								Line:   40,
+							},
+							File:   "date.flux",
+							Source: "t",
+							Start: ast.Position{
+								Column: 16,
+								Line:   40,
+							},
+						},
+						TrailingComment: nil,
+					},
+					Key: &ast.Identifier{
+						BaseNode: ast.BaseNode{
+							Comments: nil,
+							Errors:   nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 17,
+									Line:   40,
+								},
+								File:   "date.flux",
+								Source: "t",
+								Start: ast.Position{
+									Column: 16,
+									Line:   40,
+								},
+							},
+							TrailingComment: nil,
+						},
+						Name: "t",
+					},
+					Value: nil,
+				}, &ast.Property{
+					BaseNode: ast.BaseNode{
+						Comments: nil,
+						Errors:   nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 36,
+								Line:   40,
+							},
+							File:   "date.flux",
+							Source: "location=location",
+							Start: ast.Position{
+								Column: 19,
+								Line:   40,
+							},
+						},
+						TrailingComment: nil,
+					},
+					Key: &ast.Identifier{
+						BaseNode: ast.BaseNode{
+							Comments: nil,
+							Errors:   nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 27,
+									Line:   40,
+								},
+								File:   "date.flux",
+								Source: "location",
+								Start: ast.Position{
+									Column: 19,
+									Line:   40,
+								},
+							},
+							TrailingComment: nil,
+						},
+						Name: "location",
+					},
+					Value: &ast.Identifier{
+						BaseNode: ast.BaseNode{
+							Comments: nil,
+							Errors:   nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 36,
+									Line:   40,
+								},
+								File:   "date.flux",
+								Source: "location",
+								Start: ast.Position{
+									Column: 28,
+									Line:   40,
+								},
+							},
+							TrailingComment: nil,
+						},
+						Name: "location",
+					},
+				}},
+			},
+		}, &ast.VariableAssignment{
+			BaseNode: ast.BaseNode{
+				Comments: nil,
+				Errors:   nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 79,
+						Line:   41,
+					},
+					File:   "date.flux",
+					Source: "microsecond = (t, location=location) => _microsecond(t: t, location: location)",
+					Start: ast.Position{
+						Column: 1,
+						Line:   41,
+					},
+				},
+				TrailingComment: nil,
+			},
+			ID: &ast.Identifier{
+				BaseNode: ast.BaseNode{
+					Comments: nil,
+					Errors:   nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 12,
+							Line:   41,
+						},
+						File:   "date.flux",
+						Source: "microsecond",
+						Start: ast.Position{
+							Column: 1,
+							Line:   41,
+						},
+					},
+					TrailingComment: nil,
+				},
+				Name: "microsecond",
+			},
+			Init: &ast.FunctionExpression{
+				BaseNode: ast.BaseNode{
+					Comments: nil,
+					Errors:   nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 79,
+							Line:   41,
+						},
+						File:   "date.flux",
+						Source: "(t, location=location) => _microsecond(t: t, location: location)",
+						Start: ast.Position{
+							Column: 15,
+							Line:   41,
+						},
+					},
+					TrailingComment: nil,
+				},
+				Body: &ast.CallExpression{
+					Arguments: []ast.Expression{&ast.ObjectExpression{
+						BaseNode: ast.BaseNode{
+							Comments: nil,
+							Errors:   nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 78,
+									Line:   41,
+								},
+								File:   "date.flux",
+								Source: "t: t, location: location",
+								Start: ast.Position{
+									Column: 54,
+									Line:   41,
+								},
+							},
+							TrailingComment: nil,
+						},
+						Properties: []*ast.Property{&ast.Property{
+							BaseNode: ast.BaseNode{
+								Comments: nil,
+								Errors:   nil,
+								Loc: &ast.SourceLocation{
+									End: ast.Position{
+										Column: 58,
+										Line:   41,
+									},
+									File:   "date.flux",
+									Source: "t: t",
+									Start: ast.Position{
+										Column: 54,
+										Line:   41,
+									},
+								},
+								TrailingComment: nil,
+							},
+							Key: &ast.Identifier{
+								BaseNode: ast.BaseNode{
+									Comments: nil,
+									Errors:   nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 55,
+											Line:   41,
+										},
+										File:   "date.flux",
+										Source: "t",
+										Start: ast.Position{
+											Column: 54,
+											Line:   41,
+										},
+									},
+									TrailingComment: nil,
+								},
+								Name: "t",
+							},
+							Value: &ast.Identifier{
+								BaseNode: ast.BaseNode{
+									Comments: nil,
+									Errors:   nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 58,
+											Line:   41,
+										},
+										File:   "date.flux",
+										Source: "t",
+										Start: ast.Position{
+											Column: 57,
+											Line:   41,
+										},
+									},
+									TrailingComment: nil,
+								},
+								Name: "t",
+							},
+						}, &ast.Property{
+							BaseNode: ast.BaseNode{
+								Comments: nil,
+								Errors:   nil,
+								Loc: &ast.SourceLocation{
+									End: ast.Position{
+										Column: 78,
+										Line:   41,
+									},
+									File:   "date.flux",
+									Source: "location: location",
+									Start: ast.Position{
+										Column: 60,
+										Line:   41,
+									},
+								},
+								TrailingComment: nil,
+							},
+							Key: &ast.Identifier{
+								BaseNode: ast.BaseNode{
+									Comments: nil,
+									Errors:   nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 68,
+											Line:   41,
+										},
+										File:   "date.flux",
+										Source: "location",
+										Start: ast.Position{
+											Column: 60,
+											Line:   41,
+										},
+									},
+									TrailingComment: nil,
+								},
+								Name: "location",
+							},
+							Value: &ast.Identifier{
+								BaseNode: ast.BaseNode{
+									Comments: nil,
+									Errors:   nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 78,
+											Line:   41,
+										},
+										File:   "date.flux",
+										Source: "location",
+										Start: ast.Position{
+											Column: 70,
+											Line:   41,
+										},
+									},
+									TrailingComment: nil,
+								},
+								Name: "location",
+							},
+						}},
+						With: nil,
+					}},
+					BaseNode: ast.BaseNode{
+						Comments: nil,
+						Errors:   nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 79,
+								Line:   41,
+							},
+							File:   "date.flux",
+							Source: "_microsecond(t: t, location: location)",
+							Start: ast.Position{
+								Column: 41,
+								Line:   41,
+							},
+						},
+						TrailingComment: nil,
+					},
+					Callee: &ast.Identifier{
+						BaseNode: ast.BaseNode{
+							Comments: nil,
+							Errors:   nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 53,
+									Line:   41,
+								},
+								File:   "date.flux",
+								Source: "_microsecond",
+								Start: ast.Position{
+									Column: 41,
+									Line:   41,
+								},
+							},
+							TrailingComment: nil,
+						},
+						Name: "_microsecond",
+					},
+				},
+				Params: []*ast.Property{&ast.Property{
+					BaseNode: ast.BaseNode{
+						Comments: nil,
+						Errors:   nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 17,
+								Line:   41,
+							},
+							File:   "date.flux",
+							Source: "t",
+							Start: ast.Position{
+								Column: 16,
+								Line:   41,
+							},
+						},
+						TrailingComment: nil,
+					},
+					Key: &ast.Identifier{
+						BaseNode: ast.BaseNode{
+							Comments: nil,
+							Errors:   nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 17,
+									Line:   41,
+								},
+								File:   "date.flux",
+								Source: "t",
+								Start: ast.Position{
+									Column: 16,
+									Line:   41,
+								},
+							},
+							TrailingComment: nil,
+						},
+						Name: "t",
+					},
+					Value: nil,
+				}, &ast.Property{
+					BaseNode: ast.BaseNode{
+						Comments: nil,
+						Errors:   nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 36,
+								Line:   41,
+							},
+							File:   "date.flux",
+							Source: "location=location",
+							Start: ast.Position{
+								Column: 19,
+								Line:   41,
+							},
+						},
+						TrailingComment: nil,
+					},
+					Key: &ast.Identifier{
+						BaseNode: ast.BaseNode{
+							Comments: nil,
+							Errors:   nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 27,
+									Line:   41,
+								},
+								File:   "date.flux",
+								Source: "location",
+								Start: ast.Position{
+									Column: 19,
+									Line:   41,
+								},
+							},
+							TrailingComment: nil,
+						},
+						Name: "location",
+					},
+					Value: &ast.Identifier{
+						BaseNode: ast.BaseNode{
+							Comments: nil,
+							Errors:   nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 36,
+									Line:   41,
+								},
+								File:   "date.flux",
+								Source: "location",
+								Start: ast.Position{
+									Column: 28,
+									Line:   41,
+								},
+							},
+							TrailingComment: nil,
+						},
+						Name: "location",
+					},
+				}},
+			},
+		}, &ast.VariableAssignment{
+			BaseNode: ast.BaseNode{
+				Comments: nil,
+				Errors:   nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 77,
+						Line:   42,
+					},
+					File:   "date.flux",
+					Source: "nanosecond = (t, location=location) => _nanosecond(t: t, location: location)",
+					Start: ast.Position{
+						Column: 1,
+						Line:   42,
+					},
+				},
+				TrailingComment: nil,
+			},
+			ID: &ast.Identifier{
+				BaseNode: ast.BaseNode{
+					Comments: nil,
+					Errors:   nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 11,
+							Line:   42,
+						},
+						File:   "date.flux",
+						Source: "nanosecond",
+						Start: ast.Position{
+							Column: 1,
+							Line:   42,
+						},
+					},
+					TrailingComment: nil,
+				},
+				Name: "nanosecond",
+			},
+			Init: &ast.FunctionExpression{
+				BaseNode: ast.BaseNode{
+					Comments: nil,
+					Errors:   nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 77,
+							Line:   42,
+						},
+						File:   "date.flux",
+						Source: "(t, location=location) => _nanosecond(t: t, location: location)",
+						Start: ast.Position{
+							Column: 14,
+							Line:   42,
+						},
+					},
+					TrailingComment: nil,
+				},
+				Body: &ast.CallExpression{
+					Arguments: []ast.Expression{&ast.ObjectExpression{
+						BaseNode: ast.BaseNode{
+							Comments: nil,
+							Errors:   nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 76,
+									Line:   42,
+								},
+								File:   "date.flux",
+								Source: "t: t, location: location",
+								Start: ast.Position{
+									Column: 52,
+									Line:   42,
+								},
+							},
+							TrailingComment: nil,
+						},
+						Properties: []*ast.Property{&ast.Property{
+							BaseNode: ast.BaseNode{
+								Comments: nil,
+								Errors:   nil,
+								Loc: &ast.SourceLocation{
+									End: ast.Position{
+										Column: 56,
+										Line:   42,
+									},
+									File:   "date.flux",
+									Source: "t: t",
+									Start: ast.Position{
+										Column: 52,
+										Line:   42,
+									},
+								},
+								TrailingComment: nil,
+							},
+							Key: &ast.Identifier{
+								BaseNode: ast.BaseNode{
+									Comments: nil,
+									Errors:   nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 53,
+											Line:   42,
+										},
+										File:   "date.flux",
+										Source: "t",
+										Start: ast.Position{
+											Column: 52,
+											Line:   42,
+										},
+									},
+									TrailingComment: nil,
+								},
+								Name: "t",
+							},
+							Value: &ast.Identifier{
+								BaseNode: ast.BaseNode{
+									Comments: nil,
+									Errors:   nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 56,
+											Line:   42,
+										},
+										File:   "date.flux",
+										Source: "t",
+										Start: ast.Position{
+											Column: 55,
+											Line:   42,
+										},
+									},
+									TrailingComment: nil,
+								},
+								Name: "t",
+							},
+						}, &ast.Property{
+							BaseNode: ast.BaseNode{
+								Comments: nil,
+								Errors:   nil,
+								Loc: &ast.SourceLocation{
+									End: ast.Position{
+										Column: 76,
+										Line:   42,
+									},
+									File:   "date.flux",
+									Source: "location: location",
+									Start: ast.Position{
+										Column: 58,
+										Line:   42,
+									},
+								},
+								TrailingComment: nil,
+							},
+							Key: &ast.Identifier{
+								BaseNode: ast.BaseNode{
+									Comments: nil,
+									Errors:   nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 66,
+											Line:   42,
+										},
+										File:   "date.flux",
+										Source: "location",
+										Start: ast.Position{
+											Column: 58,
+											Line:   42,
+										},
+									},
+									TrailingComment: nil,
+								},
+								Name: "location",
+							},
+							Value: &ast.Identifier{
+								BaseNode: ast.BaseNode{
+									Comments: nil,
+									Errors:   nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 76,
+											Line:   42,
+										},
+										File:   "date.flux",
+										Source: "location",
+										Start: ast.Position{
+											Column: 68,
+											Line:   42,
+										},
+									},
+									TrailingComment: nil,
+								},
+								Name: "location",
+							},
+						}},
+						With: nil,
+					}},
+					BaseNode: ast.BaseNode{
+						Comments: nil,
+						Errors:   nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 77,
+								Line:   42,
+							},
+							File:   "date.flux",
+							Source: "_nanosecond(t: t, location: location)",
+							Start: ast.Position{
+								Column: 40,
+								Line:   42,
+							},
+						},
+						TrailingComment: nil,
+					},
+					Callee: &ast.Identifier{
+						BaseNode: ast.BaseNode{
+							Comments: nil,
+							Errors:   nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 51,
+									Line:   42,
+								},
+								File:   "date.flux",
+								Source: "_nanosecond",
+								Start: ast.Position{
+									Column: 40,
+									Line:   42,
+								},
+							},
+							TrailingComment: nil,
+						},
+						Name: "_nanosecond",
+					},
+				},
+				Params: []*ast.Property{&ast.Property{
+					BaseNode: ast.BaseNode{
+						Comments: nil,
+						Errors:   nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 16,
+								Line:   42,
+							},
+							File:   "date.flux",
+							Source: "t",
+							Start: ast.Position{
+								Column: 15,
+								Line:   42,
+							},
+						},
+						TrailingComment: nil,
+					},
+					Key: &ast.Identifier{
+						BaseNode: ast.BaseNode{
+							Comments: nil,
+							Errors:   nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 16,
+									Line:   42,
+								},
+								File:   "date.flux",
+								Source: "t",
+								Start: ast.Position{
+									Column: 15,
+									Line:   42,
+								},
+							},
+							TrailingComment: nil,
+						},
+						Name: "t",
+					},
+					Value: nil,
+				}, &ast.Property{
+					BaseNode: ast.BaseNode{
+						Comments: nil,
+						Errors:   nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 35,
+								Line:   42,
+							},
+							File:   "date.flux",
+							Source: "location=location",
+							Start: ast.Position{
+								Column: 18,
+								Line:   42,
+							},
+						},
+						TrailingComment: nil,
+					},
+					Key: &ast.Identifier{
+						BaseNode: ast.BaseNode{
+							Comments: nil,
+							Errors:   nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 26,
+									Line:   42,
+								},
+								File:   "date.flux",
+								Source: "location",
+								Start: ast.Position{
+									Column: 18,
+									Line:   42,
+								},
+							},
+							TrailingComment: nil,
+						},
+						Name: "location",
+					},
+					Value: &ast.Identifier{
+						BaseNode: ast.BaseNode{
+							Comments: nil,
+							Errors:   nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 35,
+									Line:   42,
+								},
+								File:   "date.flux",
+								Source: "location",
+								Start: ast.Position{
+									Column: 27,
+									Line:   42,
+								},
+							},
+							TrailingComment: nil,
+						},
+						Name: "location",
+					},
+				}},
+			},
+		}, &ast.VariableAssignment{
+			BaseNode: ast.BaseNode{
+				Comments: nil,
+				Errors:   nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 91,
+						Line:   43,
+					},
+					File:   "date.flux",
+					Source: "truncate = (t, unit, location=location) => _truncate(t: t, unit: unit, location: location)",
+					Start: ast.Position{
+						Column: 1,
+						Line:   43,
+					},
+				},
+				TrailingComment: nil,
+			},
+			ID: &ast.Identifier{
+				BaseNode: ast.BaseNode{
+					Comments: nil,
+					Errors:   nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 9,
+							Line:   43,
+						},
+						File:   "date.flux",
+						Source: "truncate",
+						Start: ast.Position{
+							Column: 1,
+							Line:   43,
+						},
+					},
+					TrailingComment: nil,
+				},
+				Name: "truncate",
+			},
+			Init: &ast.FunctionExpression{
+				BaseNode: ast.BaseNode{
+					Comments: nil,
+					Errors:   nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 91,
+							Line:   43,
+						},
+						File:   "date.flux",
+						Source: "(t, unit, location=location) => _truncate(t: t, unit: unit, location: location)",
+						Start: ast.Position{
+							Column: 12,
+							Line:   43,
+						},
+					},
+					TrailingComment: nil,
+				},
+				Body: &ast.CallExpression{
+					Arguments: []ast.Expression{&ast.ObjectExpression{
+						BaseNode: ast.BaseNode{
+							Comments: nil,
+							Errors:   nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 90,
+									Line:   43,
+								},
+								File:   "date.flux",
+								Source: "t: t, unit: unit, location: location",
+								Start: ast.Position{
+									Column: 54,
+									Line:   43,
+								},
+							},
+							TrailingComment: nil,
+						},
+						Properties: []*ast.Property{&ast.Property{
+							BaseNode: ast.BaseNode{
+								Comments: nil,
+								Errors:   nil,
+								Loc: &ast.SourceLocation{
+									End: ast.Position{
+										Column: 58,
+										Line:   43,
+									},
+									File:   "date.flux",
+									Source: "t: t",
+									Start: ast.Position{
+										Column: 54,
+										Line:   43,
+									},
+								},
+								TrailingComment: nil,
+							},
+							Key: &ast.Identifier{
+								BaseNode: ast.BaseNode{
+									Comments: nil,
+									Errors:   nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 55,
+											Line:   43,
+										},
+										File:   "date.flux",
+										Source: "t",
+										Start: ast.Position{
+											Column: 54,
+											Line:   43,
+										},
+									},
+									TrailingComment: nil,
+								},
+								Name: "t",
+							},
+							Value: &ast.Identifier{
+								BaseNode: ast.BaseNode{
+									Comments: nil,
+									Errors:   nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 58,
+											Line:   43,
+										},
+										File:   "date.flux",
+										Source: "t",
+										Start: ast.Position{
+											Column: 57,
+											Line:   43,
+										},
+									},
+									TrailingComment: nil,
+								},
+								Name: "t",
+							},
+						}, &ast.Property{
+							BaseNode: ast.BaseNode{
+								Comments: nil,
+								Errors:   nil,
+								Loc: &ast.SourceLocation{
+									End: ast.Position{
+										Column: 70,
+										Line:   43,
+									},
+									File:   "date.flux",
+									Source: "unit: unit",
+									Start: ast.Position{
+										Column: 60,
+										Line:   43,
+									},
+								},
+								TrailingComment: nil,
+							},
+							Key: &ast.Identifier{
+								BaseNode: ast.BaseNode{
+									Comments: nil,
+									Errors:   nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 64,
+											Line:   43,
+										},
+										File:   "date.flux",
+										Source: "unit",
+										Start: ast.Position{
+											Column: 60,
+											Line:   43,
+										},
+									},
+									TrailingComment: nil,
+								},
+								Name: "unit",
+							},
+							Value: &ast.Identifier{
+								BaseNode: ast.BaseNode{
+									Comments: nil,
+									Errors:   nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 70,
+											Line:   43,
+										},
+										File:   "date.flux",
+										Source: "unit",
+										Start: ast.Position{
+											Column: 66,
+											Line:   43,
+										},
+									},
+									TrailingComment: nil,
+								},
+								Name: "unit",
+							},
+						}, &ast.Property{
+							BaseNode: ast.BaseNode{
+								Comments: nil,
+								Errors:   nil,
+								Loc: &ast.SourceLocation{
+									End: ast.Position{
+										Column: 90,
+										Line:   43,
+									},
+									File:   "date.flux",
+									Source: "location: location",
+									Start: ast.Position{
+										Column: 72,
+										Line:   43,
+									},
+								},
+								TrailingComment: nil,
+							},
+							Key: &ast.Identifier{
+								BaseNode: ast.BaseNode{
+									Comments: nil,
+									Errors:   nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 80,
+											Line:   43,
+										},
+										File:   "date.flux",
+										Source: "location",
+										Start: ast.Position{
+											Column: 72,
+											Line:   43,
+										},
+									},
+									TrailingComment: nil,
+								},
+								Name: "location",
+							},
+							Value: &ast.Identifier{
+								BaseNode: ast.BaseNode{
+									Comments: nil,
+									Errors:   nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 90,
+											Line:   43,
+										},
+										File:   "date.flux",
+										Source: "location",
+										Start: ast.Position{
+											Column: 82,
+											Line:   43,
+										},
+									},
+									TrailingComment: nil,
+								},
+								Name: "location",
+							},
+						}},
+						With: nil,
+					}},
+					BaseNode: ast.BaseNode{
+						Comments: nil,
+						Errors:   nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 91,
+								Line:   43,
+							},
+							File:   "date.flux",
+							Source: "_truncate(t: t, unit: unit, location: location)",
+							Start: ast.Position{
+								Column: 44,
+								Line:   43,
+							},
+						},
+						TrailingComment: nil,
+					},
+					Callee: &ast.Identifier{
+						BaseNode: ast.BaseNode{
+							Comments: nil,
+							Errors:   nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 53,
+									Line:   43,
+								},
+								File:   "date.flux",
+								Source: "_truncate",
+								Start: ast.Position{
+									Column: 44,
+									Line:   43,
+								},
+							},
+							TrailingComment: nil,
+						},
+						Name: "_truncate",
+					},
+				},
+				Params: []*ast.Property{&ast.Property{
+					BaseNode: ast.BaseNode{
+						Comments: nil,
+						Errors:   nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 14,
+								Line:   43,
+							},
+							File:   "date.flux",
+							Source: "t",
+							Start: ast.Position{
+								Column: 13,
+								Line:   43,
+							},
+						},
+						TrailingComment: nil,
+					},
+					Key: &ast.Identifier{
+						BaseNode: ast.BaseNode{
+							Comments: nil,
+							Errors:   nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 14,
+									Line:   43,
+								},
+								File:   "date.flux",
+								Source: "t",
+								Start: ast.Position{
+									Column: 13,
+									Line:   43,
+								},
+							},
+							TrailingComment: nil,
+						},
+						Name: "t",
+					},
+					Value: nil,
+				}, &ast.Property{
+					BaseNode: ast.BaseNode{
+						Comments: nil,
+						Errors:   nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 20,
+								Line:   43,
+							},
+							File:   "date.flux",
+							Source: "unit",
+							Start: ast.Position{
+								Column: 16,
+								Line:   43,
+							},
+						},
+						TrailingComment: nil,
+					},
+					Key: &ast.Identifier{
+						BaseNode: ast.BaseNode{
+							Comments: nil,
+							Errors:   nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 20,
+									Line:   43,
+								},
+								File:   "date.flux",
+								Source: "unit",
+								Start: ast.Position{
+									Column: 16,
+									Line:   43,
+								},
+							},
+							TrailingComment: nil,
+						},
+						Name: "unit",
+					},
+					Value: nil,
+				}, &ast.Property{
+					BaseNode: ast.BaseNode{
+						Comments: nil,
+						Errors:   nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 39,
+								Line:   43,
+							},
+							File:   "date.flux",
+							Source: "location=location",
+							Start: ast.Position{
+								Column: 22,
+								Line:   43,
+							},
+						},
+						TrailingComment: nil,
+					},
+					Key: &ast.Identifier{
+						BaseNode: ast.BaseNode{
+							Comments: nil,
+							Errors:   nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 30,
+									Line:   43,
+								},
+								File:   "date.flux",
+								Source: "location",
+								Start: ast.Position{
+									Column: 22,
+									Line:   43,
+								},
+							},
+							TrailingComment: nil,
+						},
+						Name: "location",
+					},
+					Value: &ast.Identifier{
+						BaseNode: ast.BaseNode{
+							Comments: nil,
+							Errors:   nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 39,
+									Line:   43,
+								},
+								File:   "date.flux",
+								Source: "location",
+								Start: ast.Position{
+									Column: 31,
+									Line:   43,
+								},
+							},
+							TrailingComment: nil,
+						},
+						Name: "location",
+					},
+				}},
+			},
+		}, &ast.VariableAssignment{
+			BaseNode: ast.BaseNode{
+				Comments: []ast.Comment{ast.Comment{Text: "// add and sub add or subtract a duration in the civil time of location."}, ast.Comment{Text: "// Months and years are calendar months and years, so adding 1mo to"}, ast.Comment{Text: "// January 31st returns the last day of February. The part of the duration"}, ast.Comment{Text: "// that is shorter than a day keeps its length across daylight saving time."}},
+				Errors:   nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 75,
+						Line:   49,
+					},
+					File:   "date.flux",
+					Source: "add = (d, to, location=location) => _add(d: d, to: to, location: location)",
+					Start: ast.Position{
+						Column: 1,
+						Line:   49,
+					},
+				},
+				TrailingComment: nil,
+			},
+			ID: &ast.Identifier{
+				BaseNode: ast.BaseNode{
+					Comments: nil,
+					Errors:   nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 4,
+							Line:   49,
+						},
+						File:   "date.flux",
+						Source: "add",
+						Start: ast.Position{
+							Column: 1,
+							Line:   49,
+						},
+					},
+					TrailingComment: nil,
+				},
+				Name: "add",
+			},
+			Init: &ast.FunctionExpression{
+				BaseNode: ast.BaseNode{
+					Comments: nil,
+					Errors:   nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 75,
+							Line:   49,
+						},
+						File:   "date.flux",
+						Source: "(d, to, location=location) => _add(d: d, to: to, location: location)",
+						Start: ast.Position{
+							Column: 7,
+							Line:   49,
+						},
+					},
+					TrailingComment: nil,
+				},
+				Body: &ast.CallExpression{
+					Arguments: []ast.Expression{&ast.ObjectExpression{
+						BaseNode: ast.BaseNode{
+							Comments: nil,
+							Errors:   nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 74,
+									Line:   49,
+								},
+								File:   "date.flux",
+								Source: "d: d, to: to, location: location",
+								Start: ast.Position{
+									Column: 42,
+									Line:   49,
+								},
+							},
+							TrailingComment: nil,
+						},
+						Properties: []*ast.Property{&ast.Property{
+							BaseNode: ast.BaseNode{
+								Comments: nil,
+								Errors:   nil,
+								Loc: &ast.SourceLocation{
+									End: ast.Position{
+										Column: 46,
+										Line:   49,
+									},
+									File:   "date.flux",
+									Source: "d: d",
+									Start: ast.Position{
+										Column: 42,
+										Line:   49,
+									},
+								},
+								TrailingComment: nil,
+							},
+							Key: &ast.Identifier{
+								BaseNode: ast.BaseNode{
+									Comments: nil,
+									Errors:   nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 43,
+											Line:   49,
+										},
+										File:   "date.flux",
+										Source: "d",
+										Start: ast.Position{
+											Column: 42,
+											Line:   49,
+										},
+									},
+									TrailingComment: nil,
+								},
+								Name: "d",
+							},
+							Value: &ast.Identifier{
+								BaseNode: ast.BaseNode{
+									Comments: nil,
+									Errors:   nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 46,
+											Line:   49,
+										},
+										File:   "date.flux",
+										Source: "d",
+										Start: ast.Position{
+											Column: 45,
+											Line:   49,
+										},
+									},
+									TrailingComment: nil,
+								},
+								Name: "d",
+							},
+						}, &ast.Property{
+							BaseNode: ast.BaseNode{
+								Comments: nil,
+								Errors:   nil,
+								Loc: &ast.SourceLocation{
+									End: ast.Position{
+										Column: 54,
+										Line:   49,
+									},
+									File:   "date.flux",
+									Source: "to: to",
+									Start: ast.Position{
+										Column: 48,
+										Line:   49,
+									},
+								},
+								TrailingComment: nil,
+							},
+							Key: &ast.Identifier{
+								BaseNode: ast.BaseNode{
+									Comments: nil,
+									Errors:   nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 50,
+											Line:   49,
+										},
+										File:   "date.flux",
+										Source: "to",
+										Start: ast.Position{
+											Column: 48,
+											Line:   49,
+										},
+									},
+									TrailingComment: nil,
+								},
+								Name: "to",
+							},
+							Value: &ast.Identifier{
+								BaseNode: ast.BaseNode{
+									Comments: nil,
+									Errors:   nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 54,
+											Line:   49,
+										},
+										File:   "date.flux",
+										Source: "to",
+										Start: ast.Position{
+											Column: 52,
+											Line:   49,
+										},
+									},
+									TrailingComment: nil,
+								},
+								Name: "to",
+							},
+						}, &ast.Property{
+							BaseNode: ast.BaseNode{
+								Comments: nil,
+								Errors:   nil,
+								Loc: &ast.SourceLocation{
+									End: ast.Position{
+										Column: 74,
+										Line:   49,
+									},
+									File:   "date.flux",
+									Source: "location: location",
+									Start: ast.Position{
+										Column: 56,
+										Line:   49,
+									},
+								},
+								TrailingComment: nil,
+							},
+							Key: &ast.Identifier{
+								BaseNode: ast.BaseNode{
+									Comments: nil,
+									Errors:   nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 64,
+											Line:   49,
+										},
+										File:   "date.flux",
+										Source: "location",
+										Start: ast.Position{
+											Column: 56,
+											Line:   49,
+										},
+									},
+									TrailingComment: nil,
+								},
+								Name: "location",
+							},
+							Value: &ast.Identifier{
+								BaseNode: ast.BaseNode{
+									Comments: nil,
+									Errors:   nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 74,
+											Line:   49,
+										},
+										File:   "date.flux",
+										Source: "location",
+										Start: ast.Position{
+											Column: 66,
+											Line:   49,
+										},
+									},
+									TrailingComment: nil,
+								},
+								Name: "location",
+							},
+						}},
+						With: nil,
+					}},
+					BaseNode: ast.BaseNode{
+						Comments: nil,
+						Errors:   nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 75,
+								Line:   49,
+							},
+							File:   "date.flux",
+							Source: "_add(d: d, to: to, location: location)",
+							Start: ast.Position{
+								Column: 37,
+								Line:   49,
+							},
+						},
+						TrailingComment: nil,
+					},
+					Callee: &ast.Identifier{
+						BaseNode: ast.BaseNode{
+							Comments: nil,
+							Errors:   nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 41,
+									Line:   49,
+								},
+								File:   "date.flux",
+								Source: "_add",
+								Start: ast.Position{
+									Column: 37,
+									Line:   49,
+								},
+							},
+							TrailingComment: nil,
+						},
+						Name: "_add",
+					},
+				},
+				Params: []*ast.Property{&ast.Property{
+					BaseNode: ast.BaseNode{
+						Comments: nil,
+						Errors:   nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 9,
+								Line:   49,
+							},
+							File:   "date.flux",
+							Source: "d",
+							Start: ast.Position{
+								Column: 8,
+								Line:   49,
+							},
+						},
+						TrailingComment: nil,
+					},
+					Key: &ast.Identifier{
+						BaseNode: ast.BaseNode{
+							Comments: nil,
+							Errors:   nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 9,
+									Line:   49,
+								},
+								File:   "date.flux",
+								Source: "d",
+								Start: ast.Position{
+									Column: 8,
+									Line:   49,
+								},
+							},
+							TrailingComment: nil,
+						},
+						Name: "d",
+					},
+					Value: nil,
+				}, &ast.Property{
+					BaseNode: ast.BaseNode{
+						Comments: nil,
+						Errors:   nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 13,
+								Line:   49,
+							},
+							File:   "date.flux",
+							Source: "to",
+							Start: ast.Position{
+								Column: 11,
+								Line:   49,
+							},
+						},
+						TrailingComment: nil,
+					},
+					Key: &ast.Identifier{
+						BaseNode: ast.BaseNode{
+							Comments: nil,
+							Errors:   nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 13,
+									Line:   49,
+								},
+								File:   "date.flux",
+								Source: "to",
+								Start: ast.Position{
+									Column: 11,
+									Line:   49,
+								},
+							},
+							TrailingComment: nil,
+						},
+						Name: "to",
+					},
+					Value: nil,
+				}, &ast.Property{
+					BaseNode: ast.BaseNode{
+						Comments: nil,
+						Errors:   nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 32,
+								Line:   49,
+							},
+							File:   "date.flux",
+							Source: "location=location",
+							Start: ast.Position{
+								Column: 15,
+								Line:   49,
+							},
+						},
+						TrailingComment: nil,
+					},
+					Key: &ast.Identifier{
+						BaseNode: ast.BaseNode{
+							Comments: nil,
+							Errors:   nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 23,
+									Line:   49,
+								},
+								File:   "date.flux",
+								Source: "location",
+								Start: ast.Position{
+									Column: 15,
+									Line:   49,
+								},
+							},
+							TrailingComment: nil,
+						},
+						Name: "location",
+					},
+					Value: &ast.Identifier{
+						BaseNode: ast.BaseNode{
+							Comments: nil,
+							Errors:   nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 32,
+									Line:   49,
+								},
+								File:   "date.flux",
+								Source: "location",
+								Start: ast.Position{
+									Column: 24,
+									Line:   49,
+								},
+							},
+							TrailingComment: nil,
+						},
+						Name: "location",
+					},
+				}},
+			},
+		}, &ast.VariableAssignment{
+			BaseNode: ast.BaseNode{
+				Comments: nil,
+				Errors:   nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 81,
+						Line:   50,
+					},
+					File:   "date.flux",
+					Source: "sub = (d, from, location=location) => _sub(d: d, from: from, location: location)",
+					Start: ast.Position{
+						Column: 1,
+						Line:   50,
+					},
+				},
+				TrailingComment: nil,
+			},
+			ID: &ast.Identifier{
+				BaseNode: ast.BaseNode{
+					Comments: nil,
+					Errors:   nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 4,
+							Line:   50,
+						},
+						File:   "date.flux",
+						Source: "sub",
+						Start: ast.Position{
+							Column: 1,
+							Line:   50,
+						},
+					},
+					TrailingComment: nil,
+				},
+				Name: "sub",
+			},
+			Init: &ast.FunctionExpression{
+				BaseNode: ast.BaseNode{
+					Comments: nil,
+					Errors:   nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 81,
+							Line:   50,
+						},
+						File:   "date.flux",
+						Source: "(d, from, location=location) => _sub(d: d, from: from, location: location)",
+						Start: ast.Position{
+							Column: 7,
+							Line:   50,
+						},
+					},
+					TrailingComment: nil,
+				},
+				Body: &ast.CallExpression{
+					Arguments: []ast.Expression{&ast.ObjectExpression{
+						BaseNode: ast.BaseNode{
+							Comments: nil,
+							Errors:   nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 80,
+									Line:   50,
+								},
+								File:   "date.flux",
+								Source: "d: d, from: from, location: location",
+								Start: ast.Position{
+									Column: 44,
+									Line:   50,
+								},
+							},
+							TrailingComment: nil,
+						},
+						Properties: []*ast.Property{&ast.Property{
+							BaseNode: ast.BaseNode{
+								Comments: nil,
+								Errors:   nil,
+								Loc: &ast.SourceLocation{
+									End: ast.Position{
+										Column: 48,
+										Line:   50,
+									},
+									File:   "date.flux",
+									Source: "d: d",
+									Start: ast.Position{
+										Column: 44,
+										Line:   50,
+									},
+								},
+								TrailingComment: nil,
+							},
+							Key: &ast.Identifier{
+								BaseNode: ast.BaseNode{
+									Comments: nil,
+									Errors:   nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 45,
+											Line:   50,
+										},
+										File:   "date.flux",
+										Source: "d",
+										Start: ast.Position{
+											Column: 44,
+											Line:   50,
+										},
+									},
+									TrailingComment: nil,
+								},
+								Name: "d",
+							},
+							Value: &ast.Identifier{
+								BaseNode: ast.BaseNode{
+									Comments: nil,
+									Errors:   nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 48,
+											Line:   50,
+										},
+										File:   "date.flux",
+										Source: "d",
+										Start: ast.Position{
+											Column: 47,
+											Line:   50,
+										},
+									},
+									TrailingComment: nil,
+								},
+								Name: "d",
+							},
+						}, &ast.Property{
+							BaseNode: ast.BaseNode{
+								Comments: nil,
+								Errors:   nil,
+								Loc: &ast.SourceLocation{
+									End: ast.Position{
+										Column: 60,
+										Line:   50,
+									},
+									File:   "date.flux",
+									Source: "from: from",
+									Start: ast.Position{
+										Column: 50,
+										Line:   50,
+									},
+								},
+								TrailingComment: nil,
+							},
+							Key: &ast.Identifier{
+								BaseNode: ast.BaseNode{
+									Comments: nil,
+									Errors:   nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 54,
+											Line:   50,
+										},
+										File:   "date.flux",
+										Source: "from",
+										Start: ast.Position{
+											Column: 50,
+											Line:   50,
+										},
+									},
+									TrailingComment: nil,
+								},
+								Name: "from",
+							},
+							Value: &ast.Identifier{
+								BaseNode: ast.BaseNode{
+									Comments: nil,
+									Errors:   nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 60,
+											Line:   50,
+										},
+										File:   "date.flux",
+										Source: "from",
+										Start: ast.Position{
+											Column: 56,
+											Line:   50,
+										},
+									},
+									TrailingComment: nil,
+								},
+								Name: "from",
+							},
+						}, &ast.Property{
+							BaseNode: ast.BaseNode{
+								Comments: nil,
+								Errors:   nil,
+								Loc: &ast.SourceLocation{
+									End: ast.Position{
+										Column: 80,
+										Line:   50,
+									},
+									File:   "date.flux",
+									Source: "location: location",
+									Start: ast.Position{
+										Column: 62,
+										Line:   50,
+									},
+								},
+								TrailingComment: nil,
+							},
+							Key: &ast.Identifier{
+								BaseNode: ast.BaseNode{
+									Comments: nil,
+									Errors:   nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 70,
+											Line:   50,
+										},
+										File:   "date.flux",
+										Source: "location",
+										Start: ast.Position{
+											Column: 62,
+											Line:   50,
+										},
+									},
+									TrailingComment: nil,
+								},
+								Name: "location",
+							},
+							Value: &ast.Identifier{
+								BaseNode: ast.BaseNode{
+									Comments: nil,
+									Errors:   nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 80,
+											Line:   50,
+										},
+										File:   "date.flux",
+										Source: "location",
+										Start: ast.Position{
+											Column: 72,
+											Line:   50,
+										},
+									},
+									TrailingComment: nil,
+								},
+								Name: "location",
+							},
+						}},
+						With: nil,
+					}},
+					BaseNode: ast.BaseNode{
+						Comments: nil,
+						Errors:   nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 81,
+								Line:   50,
+							},
+							File:   "date.flux",
+							Source: "_sub(d: d, from: from, location: location)",
+							Start: ast.Position{
+								Column: 39,
+								Line:   50,
+							},
+						},
+						TrailingComment: nil,
+					},
+					Callee: &ast.Identifier{
+						BaseNode: ast.BaseNode{
+							Comments: nil,
+							Errors:   nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 43,
+									Line:   50,
+								},
+								File:   "date.flux",
+								Source: "_sub",
+								Start: ast.Position{
+									Column: 39,
+									Line:   50,
+								},
+							},
+							TrailingComment: nil,
+						},
+						Name: "_sub",
+					},
+				},
+				Params: []*ast.Property{&ast.Property{
+					BaseNode: ast.BaseNode{
+						Comments: nil,
+						Errors:   nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 9,
+								Line:   50,
+							},
+							File:   "date.flux",
+							Source: "d",
+							Start: ast.Position{
+								Column: 8,
+								Line:   50,
+							},
+						},
+						TrailingComment: nil,
+					},
+					Key: &ast.Identifier{
+						BaseNode: ast.BaseNode{
+							Comments: nil,
+							Errors:   nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 9,
+									Line:   50,
+								},
+								File:   "date.flux",
+								Source: "d",
+								Start: ast.Position{
+									Column: 8,
+									Line:   50,
+								},
+							},
+							TrailingComment: nil,
+						},
+						Name: "d",
+					},
+					Value: nil,
+				}, &ast.Property{
+					BaseNode: ast.BaseNode{
+						Comments: nil,
+						Errors:   nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 15,
+								Line:   50,
+							},
+							File:   "date.flux",
+							Source: "from",
+							Start: ast.Position{
+								Column: 11,
+								Line:   50,
+							},
+						},
+						TrailingComment: nil,
+					},
+					Key: &ast.Identifier{
+						BaseNode: ast.BaseNode{
+							Comments: nil,
+							Errors:   nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 15,
+									Line:   50,
+								},
+								File:   "date.flux",
+								Source: "from",
+								Start: ast.Position{
+									Column: 11,
+									Line:   50,
+								},
+							},
+							TrailingComment: nil,
+						},
+						Name: "from",
+					},
+					Value: nil,
+				}, &ast.Property{
+					BaseNode: ast.BaseNode{
+						Comments: nil,
+						Errors:   nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 34,
+								Line:   50,
+							},
+							File:   "date.flux",
+							Source: "location=location",
+							Start: ast.Position{
+								Column: 17,
+								Line:   50,
+							},
 						},
+						TrailingComment: nil,
 					},
-				},
-				Name: "year",
+					Key: &ast.Identifier{
+						BaseNode: ast.BaseNode{
+							Comments: nil,
+							Errors:   nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 25,
+									Line:   50,
+								},
+								File:   "date.flux",
+								Source: "location",
+								Start: ast.Position{
+									Column: 17,
+									Line:   50,
+								},
+							},
+							TrailingComment: nil,
+						},
+						Name: "location",
+					},
+					Value: &ast.Identifier{
+						BaseNode: ast.BaseNode{
+							Comments: nil,
+							Errors:   nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 34,
+									Line:   50,
+								},
+								File:   "date.flux",
+								Source: "location",
+								Start: ast.Position{
+									Column: 26,
+									Line:   50,
+								},
+							},
+							TrailingComment: nil,
+						},
+						Name: "location",
+					},
+				}},
 			},
-		}, &ast.BuiltinStatement{
+		}, &ast.VariableAssignment{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: []ast.Comment{ast.Comment{Text: "// diff returns the number of whole units, such as 1d or 1mo, from start to stop."}, ast.Comment{Text: "// Units shorter than a day are counted in absolute time."}},
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
-						Column: 13,
-						Line:   11,
+						Column: 113,
+						Line:   54,
 					},
 					File:   "date.flux",
-					Source: "builtin week",
+					Source: "diff = (start, stop, unit, location=location) => _diff(start: start, stop: stop, unit: unit, location: location)",
 					Start: ast.Position{
 						Column: 1,
-						Line:   11,
+						Line:   54,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
-							Column: 13,
-							Line:   11,
+							Column: 5,
+							Line:   54,
 						},
 						File:   "date.flux",
-						Source: "week",
+						Source: "diff",
 						Start: ast.Position{
-							Column: 9,
-							Line:   11,
+							Column: 1,
+							Line:   54,
 						},
 					},
+					TrailingComment: nil,
 				},
-				Name: "week",
-			},
-		}, &ast.BuiltinStatement{
-			BaseNode: ast.BaseNode{
-				Errors: nil,
-				Loc: &ast.SourceLocation{
-					End: ast.Position{
-						Column: 16,
-						Line:   12,
-					},
-					File:   "date.flux",
-					Source: "builtin quarter",
-					Start: ast.Position{
-						Column: 1,
-						Line:   12,
-					},
-				},
+				Name: "diff",
 			},
-			ID: &ast.Identifier{
+			Init: &ast.FunctionExpression{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
-							Column: 16,
-							Line:   12,
+							Column: 113,
+							Line:   54,
 						},
 						File:   "date.flux",
-						Source: "quarter",
+						Source: "(start, stop, unit, location=location) => _diff(start: start, stop: stop, unit: unit, location: location)",
 						Start: ast.Position{
-							Column: 9,
-							Line:   12,
+							Column: 8,
+							Line:   54,
 						},
 					},
+					TrailingComment: nil,
 				},
-				Name: "quarter",
+				Body: &ast.CallExpression{
+					Arguments: []ast.Expression{&ast.ObjectExpression{
+						BaseNode: ast.BaseNode{
+							Comments: nil,
+							Errors:   nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 112,
+									Line:   54,
+								},
+								File:   "date.flux",
+								Source: "start: start, stop: stop, unit: unit, location: location",
+								Start: ast.Position{
+									Column: 56,
+									Line:   54,
+								},
+							},
+							TrailingComment: nil,
+						},
+						Properties: []*ast.Property{&ast.Property{
+							BaseNode: ast.BaseNode{
+								Comments: nil,
+								Errors:   nil,
+								Loc: &ast.SourceLocation{
+									End: ast.Position{
+										Column: 68,
+										Line:   54,
+									},
+									File:   "date.flux",
+									Source: "start: start",
+									Start: ast.Position{
+										Column: 56,
+										Line:   54,
+									},
+								},
+								TrailingComment: nil,
+							},
+							Key: &ast.Identifier{
+								BaseNode: ast.BaseNode{
+									Comments: nil,
+									Errors:   nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 61,
+											Line:   54,
+										},
+										File:   "date.flux",
+										Source: "start",
+										Start: ast.Position{
+											Column: 56,
+											Line:   54,
+										},
+									},
+									TrailingComment: nil,
+								},
+								Name: "start",
+							},
+							Value: &ast.Identifier{
+								BaseNode: ast.BaseNode{
+									Comments: nil,
+									Errors:   nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 68,
+											Line:   54,
+										},
+										File:   "date.flux",
+										Source: "start",
+										Start: ast.Position{
+											Column: 63,
+											Line:   54,
+										},
+									},
+									TrailingComment: nil,
+								},
+								Name: "start",
+							},
+						}, &ast.Property{
+							BaseNode: ast.BaseNode{
+								Comments: nil,
+								Errors:   nil,
+								Loc: &ast.SourceLocation{
+									End: ast.Position{
+										Column: 80,
+										Line:   54,
+									},
+									File:   "date.flux",
+									Source: "stop: stop",
+									Start: ast.Position{
+										Column: 70,
+										Line:   54,
+									},
+								},
+								TrailingComment: nil,
+							},
+							Key: &ast.Identifier{
+								BaseNode: ast.BaseNode{
+									Comments: nil,
+									Errors:   nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 74,
+											Line:   54,
+										},
+										File:   "date.flux",
+										Source: "stop",
+										Start: ast.Position{
+											Column: 70,
+											Line:   54,
+										},
+									},
+									TrailingComment: nil,
+								},
+								Name: "stop",
+							},
+							Value: &ast.Identifier{
+								BaseNode: ast.BaseNode{
+									Comments: nil,
+									Errors:   nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 80,
+											Line:   54,
+										},
+										File:   "date.flux",
+										Source: "stop",
+										Start: ast.Position{
+											Column: 76,
+											Line:   54,
+										},
+									},
+									TrailingComment: nil,
+								},
+								Name: "stop",
+							},
+						}, &ast.Property{
+							BaseNode: ast.BaseNode{
+								Comments: nil,
+								Errors:   nil,
+								Loc: &ast.SourceLocation{
+									End: ast.Position{
+										Column: 92,
+										Line:   54,
+									},
+									File:   "date.flux",
+									Source: "unit: unit",
+									Start: ast.Position{
+										Column: 82,
+										Line:   54,
+									},
+								},
+								TrailingComment: nil,
+							},
+							Key: &ast.Identifier{
+								BaseNode: ast.BaseNode{
+									Comments: nil,
+									Errors:   nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 86,
+											Line:   54,
+										},
+										File:   "date.flux",
+										Source: "unit",
+										Start: ast.Position{
+											Column: 82,
+											Line:   54,
+										},
+									},
+									TrailingComment: nil,
+								},
+								Name: "unit",
+							},
+							Value: &ast.Identifier{
+								BaseNode: ast.BaseNode{
+									Comments: nil,
+									Errors:   nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 92,
+											Line:   54,
+										},
+										File:   "date.flux",
+										Source: "unit",
+										Start: ast.Position{
+											Column: 88,
+											Line:   54,
+										},
+									},
+									TrailingComment: nil,
+								},
+								Name: "unit",
+							},
+						}, &ast.Property{
+							BaseNode: ast.BaseNode{
+								Comments: nil,
+								Errors:   nil,
+								Loc: &ast.SourceLocation{
+									End: ast.Position{
+										Column: 112,
+										Line:   54,
+									},
+									File:   "date.flux",
+									Source: "location: location",
+									Start: ast.Position{
+										Column: 94,
+										Line:   54,
+									},
+								},
+								TrailingComment: nil,
+							},
+							Key: &ast.Identifier{
+								BaseNode: ast.BaseNode{
+									Comments: nil,
+									Errors:   nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 102,
+											Line:   54,
+										},
+										File:   "date.flux",
+										Source: "location",
+										Start: ast.Position{
+											Column: 94,
+											Line:   54,
+										},
+									},
+									TrailingComment: nil,
+								},
+								Name: "location",
+							},
+							Value: &ast.Identifier{
+								BaseNode: ast.BaseNode{
+									Comments: nil,
+									Errors:   nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 112,
+											Line:   54,
+										},
+										File:   "date.flux",
+										Source: "location",
+										Start: ast.Position{
+											Column: 104,
+											Line:   54,
+										},
+									},
+									TrailingComment: nil,
+								},
+								Name: "location",
+							},
+						}},
+						With: nil,
+					}},
+					BaseNode: ast.BaseNode{
+						Comments: nil,
+						Errors:   nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 113,
+								Line:   54,
+							},
+							File:   "date.flux",
+							Source: "_diff(start: start, stop: stop, unit: unit, location: location)",
+							Start: ast.Position{
+								Column: 50,
+								Line:   54,
+							},
+						},
+						TrailingComment: nil,
+					},
+					Callee: &ast.Identifier{
+						BaseNode: ast.BaseNode{
+							Comments: nil,
+							Errors:   nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 55,
+									Line:   54,
+								},
+								File:   "date.flux",
+								Source: "_diff",
+								Start: ast.Position{
+									Column: 50,
+									Line:   54,
+								},
+							},
+							TrailingComment: nil,
+						},
+						Name: "_diff",
+					},
+				},
+				Params: []*ast.Property{&ast.Property{
+					BaseNode: ast.BaseNode{
+						Comments: nil,
+						Errors:   nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 14,
+								Line:   54,
+							},
+							File:   "date.flux",
+							Source: "start",
+							Start: ast.Position{
+								Column: 9,
+								Line:   54,
+							},
+						},
+						TrailingComment: nil,
+					},
+					Key: &ast.Identifier{
+						BaseNode: ast.BaseNode{
+							Comments: nil,
+							Errors:   nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 14,
+									Line:   54,
+								},
+								File:   "date.flux",
+								Source: "start",
+								Start: ast.Position{
+									Column: 9,
+									Line:   54,
+								},
+							},
+							TrailingComment: nil,
+						},
+						Name: "start",
+					},
+					Value: nil,
+				}, &ast.Property{
+					BaseNode: ast.BaseNode{
+						Comments: nil,
+						Errors:   nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 20,
+								Line:   54,
+							},
+							File:   "date.flux",
+							Source: "stop",
+							Start: ast.Position{
+								Column: 16,
+								Line:   54,
+							},
+						},
+						TrailingComment: nil,
+					},
+					Key: &ast.Identifier{
+						BaseNode: ast.BaseNode{
+							Comments: nil,
+							Errors:   nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 20,
+									Line:   54,
+								},
+								File:   "date.flux",
+								Source: "stop",
+								Start: ast.Position{
+									Column: 16,
+									Line:   54,
+								},
+							},
+							TrailingComment: nil,
+						},
+						Name: "stop",
+					},
+					Value: nil,
+				}, &ast.Property{
+					BaseNode: ast.BaseNode{
+						Comments: nil,
+						Errors:   nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 26,
+								Line:   54,
+							},
+							File:   "date.flux",
+							Source: "unit",
+							Start: ast.Position{
+								Column: 22,
+								Line:   54,
+							},
+						},
+						TrailingComment: nil,
+					},
+					Key: &ast.Identifier{
+						BaseNode: ast.BaseNode{
+							Comments: nil,
+							Errors:   nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 26,
+									Line:   54,
+								},
+								File:   "date.flux",
+								Source: "unit",
+								Start: ast.Position{
+									Column: 22,
+									Line:   54,
+								},
+							},
+							TrailingComment: nil,
+						},
+						Name: "unit",
+					},
+					Value: nil,
+				}, &ast.Property{
+					BaseNode: ast.BaseNode{
+						Comments: nil,
+						Errors:   nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 45,
+								Line:   54,
+							},
+							File:   "date.flux",
+							Source: "location=location",
+							Start: ast.Position{
+								Column: 28,
+								Line:   54,
+							},
+						},
+						TrailingComment: nil,
+					},
+					Key: &ast.Identifier{
+						BaseNode: ast.BaseNode{
+							Comments: nil,
+							Errors:   nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 36,
+									Line:   54,
+								},
+								File:   "date.flux",
+								Source: "location",
+								Start: ast.Position{
+									Column: 28,
+									Line:   54,
+								},
+							},
+							TrailingComment: nil,
+						},
+						Name: "location",
+					},
+					Value: &ast.Identifier{
+						BaseNode: ast.BaseNode{
+							Comments: nil,
+							Errors:   nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 45,
+									Line:   54,
+								},
+								File:   "date.flux",
+								Source: "location",
+								Start: ast.Position{
+									Column: 37,
+									Line:   54,
+								},
+							},
+							TrailingComment: nil,
+						},
+						Name: "location",
+					},
+				}},
 			},
-		}, &ast.BuiltinStatement{
+		}, &ast.VariableAssignment{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: []ast.Comment{ast.Comment{Text: "// format and parse convert between times and strings using a Go time layout,"}, ast.Comment{Text: "// for example \"2006-01-02 15:04:05\". Times without a zone are parsed in location."}},
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
-						Column: 20,
-						Line:   13,
+						Column: 93,
+						Line:   58,
 					},
 					File:   "date.flux",
-					Source: "builtin millisecond",
+					Source: "format = (t, layout, location=location) => _format(t: t, layout: layout, location: location)",
 					Start: ast.Position{
 						Column: 1,
-						Line:   13,
+						Line:   58,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
-							Column: 20,
-							Line:   13,
+							Column: 7,
+							Line:   58,
 						},
 						File:   "date.flux",
-						Source: "millisecond",
+						Source: "format",
 						Start: ast.Position{
-							Column: 9,
-							Line:   13,
+							Column: 1,
+							Line:   58,
 						},
 					},
+					TrailingComment: nil,
 				},
-				Name: "millisecond",
-			},
-		}, &ast.BuiltinStatement{
-			BaseNode: ast.BaseNode{
-				Errors: nil,
-				Loc: &ast.SourceLocation{
-					End: ast.Position{
-						Column: 20,
-						Line:   14,
-					},
-					File:   "date.flux",
-					Source: "builtin microsecond",
-					Start: ast.Position{
-						Column: 1,
-						Line:   14,
-					},
-				},
+				Name: "format",
 			},
-			ID: &ast.Identifier{
+			Init: &ast.FunctionExpression{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
-							Column: 20,
-							Line:   14,
+							Column: 93,
+							Line:   58,
 						},
 						File:   "date.flux",
-						Source: "microsecond",
+						Source: "(t, layout, location=location) => _format(t: t, layout: layout, location: location)",
 						Start: ast.Position{
-							Column: 9,
-							Line:   14,
+							Column: 10,
+							Line:   58,
 						},
 					},
+					TrailingComment: nil,
 				},
-				Name: "microsecond",
+				Body: &ast.CallExpression{
+					Arguments: []ast.Expression{&ast.ObjectExpression{
+						BaseNode: ast.BaseNode{
+							Comments: nil,
+							Errors:   nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 92,
+									Line:   58,
+								},
+								File:   "date.flux",
+								Source: "t: t, layout: layout, location: location",
+								Start: ast.Position{
+									Column: 52,
+									Line:   58,
+								},
+							},
+							TrailingComment: nil,
+						},
+						Properties: []*ast.Property{&ast.Property{
+							BaseNode: ast.BaseNode{
+								Comments: nil,
+								Errors:   nil,
+								Loc: &ast.SourceLocation{
+									End: ast.Position{
+										Column: 56,
+										Line:   58,
+									},
+									File:   "date.flux",
+									Source: "t: t",
+									Start: ast.Position{
+										Column: 52,
+										Line:   58,
+									},
+								},
+								TrailingComment: nil,
+							},
+							Key: &ast.Identifier{
+								BaseNode: ast.BaseNode{
+									Comments: nil,
+									Errors:   nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 53,
+											Line:   58,
+										},
+										File:   "date.flux",
+										Source: "t",
+										Start: ast.Position{
+											Column: 52,
+											Line:   58,
+										},
+									},
+									TrailingComment: nil,
+								},
+								Name: "t",
+							},
+							Value: &ast.Identifier{
+								BaseNode: ast.BaseNode{
+									Comments: nil,
+									Errors:   nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 56,
+											Line:   58,
+										},
+										File:   "date.flux",
+										Source: "t",
+										Start: ast.Position{
+											Column: 55,
+											Line:   58,
+										},
+									},
+									TrailingComment: nil,
+								},
+								Name: "t",
+							},
+						}, &ast.Property{
+							BaseNode: ast.BaseNode{
+								Comments: nil,
+								Errors:   nil,
+								Loc: &ast.SourceLocation{
+									End: ast.Position{
+										Column: 72,
+										Line:   58,
+									},
+									File:   "date.flux",
+									Source: "layout: layout",
+									Start: ast.Position{
+										Column: 58,
+										Line:   58,
+									},
+								},
+								TrailingComment: nil,
+							},
+							Key: &ast.Identifier{
+								BaseNode: ast.BaseNode{
+									Comments: nil,
+									Errors:   nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 64,
+											Line:   58,
+										},
+										File:   "date.flux",
+										Source: "layout",
+										Start: ast.Position{
+											Column: 58,
+											Line:   58,
+										},
+									},
+									TrailingComment: nil,
+								},
+								Name: "layout",
+							},
+							Value: &ast.Identifier{
+								BaseNode: ast.BaseNode{
+									Comments: nil,
+									Errors:   nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 72,
+											Line:   58,
+										},
+										File:   "date.flux",
+										Source: "layout",
+										Start: ast.Position{
+											Column: 66,
+											Line:   58,
+										},
+									},
+									TrailingComment: nil,
+								},
+								Name: "layout",
+							},
+						}, &ast.Property{
+							BaseNode: ast.BaseNode{
+								Comments: nil,
+								Errors:   nil,
+								Loc: &ast.SourceLocation{
+									End: ast.Position{
+										Column: 92,
+										Line:   58,
+									},
+									File:   "date.flux",
+									Source: "location: location",
+									Start: ast.Position{
+										Column: 74,
+										Line:   58,
+									},
+								},
+								TrailingComment: nil,
+							},
+							Key: &ast.Identifier{
+								BaseNode: ast.BaseNode{
+									Comments: nil,
+									Errors:   nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 82,
+											Line:   58,
+										},
+										File:   "date.flux",
+										Source: "location",
+										Start: ast.Position{
+											Column: 74,
+											Line:   58,
+										},
+									},
+									TrailingComment: nil,
+								},
+								Name: "location",
+							},
+							Value: &ast.Identifier{
+								BaseNode: ast.BaseNode{
+									Comments: nil,
+									Errors:   nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 92,
+											Line:   58,
+										},
+										File:   "date.flux",
+										Source: "location",
+										Start: ast.Position{
+											Column: 84,
+											Line:   58,
+										},
+									},
+									TrailingComment: nil,
+								},
+								Name: "location",
+							},
+						}},
+						With: nil,
+					}},
+					BaseNode: ast.BaseNode{
+						Comments: nil,
+						Errors:   nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 93,
+								Line:   58,
+							},
+							File:   "date.flux",
+							Source: "_format(t: t, layout: layout, location: location)",
+							Start: ast.Position{
+								Column: 44,
+								Line:   58,
+							},
+						},
+						TrailingComment: nil,
+					},
+					Callee: &ast.Identifier{
+						BaseNode: ast.BaseNode{
+							Comments: nil,
+							Errors:   nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 51,
+									Line:   58,
+								},
+								File:   "date.flux",
+								Source: "_format",
+								Start: ast.Position{
+									Column: 44,
+									Line:   58,
+								},
+							},
+							TrailingComment: nil,
+						},
+						Name: "_format",
+					},
+				},
+				Params: []*ast.Property{&ast.Property{
+					BaseNode: ast.BaseNode{
+						Comments: nil,
+						Errors:   nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 12,
+								Line:   58,
+							},
+							File:   "date.flux",
+							Source: "t",
+							Start: ast.Position{
+								Column: 11,
+								Line:   58,
+							},
+						},
+						TrailingComment: nil,
+					},
+					Key: &ast.Identifier{
+						BaseNode: ast.BaseNode{
+							Comments: nil,
+							Errors:   nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 12,
+									Line:   58,
+								},
+								File:   "date.flux",
+								Source: "t",
+								Start: ast.Position{
+									Column: 11,
+									Line:   58,
+								},
+							},
+							TrailingComment: nil,
+						},
+						Name: "t",
+					},
+					Value: nil,
+				}, &ast.Property{
+					BaseNode: ast.BaseNode{
+						Comments: nil,
+						Errors:   nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 20,
+								Line:   58,
+							},
+							File:   "date.flux",
+							Source: "layout",
+							Start: ast.Position{
+								Column: 14,
+								Line:   58,
+							},
+						},
+						TrailingComment: nil,
+					},
+					Key: &ast.Identifier{
+						BaseNode: ast.BaseNode{
+							Comments: nil,
+							Errors:   nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 20,
+									Line:   58,
+								},
+								File:   "date.flux",
+								Source: "layout",
+								Start: ast.Position{
+									Column: 14,
+									Line:   58,
+								},
+							},
+							TrailingComment: nil,
+						},
+						Name: "layout",
+					},
+					Value: nil,
+				}, &ast.Property{
+					BaseNode: ast.BaseNode{
+						Comments: nil,
+						Errors:   nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 39,
+								Line:   58,
+							},
+							File:   "date.flux",
+							Source: "location=location",
+							Start: ast.Position{
+								Column: 22,
+								Line:   58,
+							},
+						},
+						TrailingComment: nil,
+					},
+					Key: &ast.Identifier{
+						BaseNode: ast.BaseNode{
+							Comments: nil,
+							Errors:   nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 30,
+									Line:   58,
+								},
+								File:   "date.flux",
+								Source: "location",
+								Start: ast.Position{
+									Column: 22,
+									Line:   58,
+								},
+							},
+							TrailingComment: nil,
+						},
+						Name: "location",
+					},
+					Value: &ast.Identifier{
+						BaseNode: ast.BaseNode{
+							Comments: nil,
+							Errors:   nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 39,
+									Line:   58,
+								},
+								File:   "date.flux",
+								Source: "location",
+								Start: ast.Position{
+									Column: 31,
+									Line:   58,
+								},
+							},
+							TrailingComment: nil,
+						},
+						Name: "location",
+					},
+				}},
 			},
-		}, &ast.BuiltinStatement{
+		}, &ast.VariableAssignment{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
-						Column: 19,
-						Line:   15,
+						Column: 91,
+						Line:   59,
 					},
 					File:   "date.flux",
-					Source: "builtin nanosecond",
+					Source: "parse = (s, layout, location=location) => _parse(s: s, layout: layout, location: location)",
 					Start: ast.Position{
 						Column: 1,
-						Line:   15,
+						Line:   59,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
-							Column: 19,
-							Line:   15,
+							Column: 6,
+							Line:   59,
 						},
 						File:   "date.flux",
-						Source: "nanosecond",
+						Source: "parse",
 						Start: ast.Position{
-							Column: 9,
-							Line:   15,
+							Column: 1,
+							Line:   59,
 						},
 					},
+					TrailingComment: nil,
 				},
-				Name: "nanosecond",
-			},
-		}, &ast.BuiltinStatement{
-			BaseNode: ast.BaseNode{
-				Errors: nil,
-				Loc: &ast.SourceLocation{
-					End: ast.Position{
-						Column: 17,
-						Line:   16,
-					},
-					File:   "date.flux",
-					Source: "builtin truncate",
-					Start: ast.Position{
-						Column: 1,
-						Line:   16,
-					},
-				},
+				Name: "parse",
 			},
-			ID: &ast.Identifier{
+			Init: &ast.FunctionExpression{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
-							Column: 17,
-							Line:   16,
+							Column: 91,
+							Line:   59,
 						},
 						File:   "date.flux",
-						Source: "truncate",
+						Source: "(s, layout, location=location) => _parse(s: s, layout: layout, location: location)",
 						Start: ast.Position{
 							Column: 9,
-							Line:   16,
+							Line:   59,
 						},
 					},
+					TrailingComment: nil,
 				},
-				Name: "truncate",
+				Body: &ast.CallExpression{
+					Arguments: []ast.Expression{&ast.ObjectExpression{
+						BaseNode: ast.BaseNode{
+							Comments: nil,
+							Errors:   nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 90,
+									Line:   59,
+								},
+								File:   "date.flux",
+								Source: "s: s, layout: layout, location: location",
+								Start: ast.Position{
+									Column: 50,
+									Line:   59,
+								},
+							},
+							TrailingComment: nil,
+						},
+						Properties: []*ast.Property{&ast.Property{
+							BaseNode: ast.BaseNode{
+								Comments: nil,
+								Errors:   nil,
+								Loc: &ast.SourceLocation{
+									End: ast.Position{
+										Column: 54,
+										Line:   59,
+									},
+									File:   "date.flux",
+									Source: "s: s",
+									Start: ast.Position{
+										Column: 50,
+										Line:   59,
+									},
+								},
+								TrailingComment: nil,
+							},
+							Key: &ast.Identifier{
+								BaseNode: ast.BaseNode{
+									Comments: nil,
+									Errors:   nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 51,
+											Line:   59,
+										},
+										File:   "date.flux",
+										Source: "s",
+										Start: ast.Position{
+											Column: 50,
+											Line:   59,
+										},
+									},
+									TrailingComment: nil,
+								},
+								Name: "s",
+							},
+							Value: &ast.Identifier{
+								BaseNode: ast.BaseNode{
+									Comments: nil,
+									Errors:   nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 54,
+											Line:   59,
+										},
+										File:   "date.flux",
+										Source: "s",
+										Start: ast.Position{
+											Column: 53,
+											Line:   59,
+										},
+									},
+									TrailingComment: nil,
+								},
+								Name: "s",
+							},
+						}, &ast.Property{
+							BaseNode: ast.BaseNode{
+								Comments: nil,
+								Errors:   nil,
+								Loc: &ast.SourceLocation{
+									End: ast.Position{
+										Column: 70,
+										Line:   59,
+									},
+									File:   "date.flux",
+									Source: "layout: layout",
+									Start: ast.Position{
+										Column: 56,
+										Line:   59,
+									},
+								},
+								TrailingComment: nil,
+							},
+							Key: &ast.Identifier{
+								BaseNode: ast.BaseNode{
+									Comments: nil,
+									Errors:   nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 62,
+											Line:   59,
+										},
+										File:   "date.flux",
+										Source: "layout",
+										Start: ast.Position{
+											Column: 56,
+											Line:   59,
+										},
+									},
+									TrailingComment: nil,
+								},
+								Name: "layout",
+							},
+							Value: &ast.Identifier{
+								BaseNode: ast.BaseNode{
+									Comments: nil,
+									Errors:   nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 70,
+											Line:   59,
+										},
+										File:   "date.flux",
+										Source: "layout",
+										Start: ast.Position{
+											Column: 64,
+											Line:   59,
+										},
+									},
+									TrailingComment: nil,
+								},
+								Name: "layout",
+							},
+						}, &ast.Property{
+							BaseNode: ast.BaseNode{
+								Comments: nil,
+								Errors:   nil,
+								Loc: &ast.SourceLocation{
+									End: ast.Position{
+										Column: 90,
+										Line:   59,
+									},
+									File:   "date.flux",
+									Source: "location: location",
+									Start: ast.Position{
+										Column: 72,
+										Line:   59,
+									},
+								},
+								TrailingComment: nil,
+							},
+							Key: &ast.Identifier{
+								BaseNode: ast.BaseNode{
+									Comments: nil,
+									Errors:   nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 80,
+											Line:   59,
+										},
+										File:   "date.flux",
+										Source: "location",
+										Start: ast.Position{
+											Column: 72,
+											Line:   59,
+										},
+									},
+									TrailingComment: nil,
+								},
+								Name: "location",
+							},
+							Value: &ast.Identifier{
+								BaseNode: ast.BaseNode{
+									Comments: nil,
+									Errors:   nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 90,
+											Line:   59,
+										},
+										File:   "date.flux",
+										Source: "location",
+										Start: ast.Position{
+											Column: 82,
+											Line:   59,
+										},
+									},
+									TrailingComment: nil,
+								},
+								Name: "location",
+							},
+						}},
+						With: nil,
+					}},
+					BaseNode: ast.BaseNode{
+						Comments: nil,
+						Errors:   nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 91,
+								Line:   59,
+							},
+							File:   "date.flux",
+							Source: "_parse(s: s, layout: layout, location: location)",
+							Start: ast.Position{
+								Column: 43,
+								Line:   59,
+							},
+						},
+						TrailingComment: nil,
+					},
+					Callee: &ast.Identifier{
+						BaseNode: ast.BaseNode{
+							Comments: nil,
+							Errors:   nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 49,
+									Line:   59,
+								},
+								File:   "date.flux",
+								Source: "_parse",
+								Start: ast.Position{
+									Column: 43,
+									Line:   59,
+								},
+							},
+							TrailingComment: nil,
+						},
+						Name: "_parse",
+					},
+				},
+				Params: []*ast.Property{&ast.Property{
+					BaseNode: ast.BaseNode{
+						Comments: nil,
+						Errors:   nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 11,
+								Line:   59,
+							},
+							File:   "date.flux",
+							Source: "s",
+							Start: ast.Position{
+								Column: 10,
+								Line:   59,
+							},
+						},
+						TrailingComment: nil,
+					},
+					Key: &ast.Identifier{
+						BaseNode: ast.BaseNode{
+							Comments: nil,
+							Errors:   nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 11,
+									Line:   59,
+								},
+								File:   "date.flux",
+								Source: "s",
+								Start: ast.Position{
+									Column: 10,
+									Line:   59,
+								},
+							},
+							TrailingComment: nil,
+						},
+						Name: "s",
+					},
+					Value: nil,
+				}, &ast.Property{
+					BaseNode: ast.BaseNode{
+						Comments: nil,
+						Errors:   nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 19,
+								Line:   59,
+							},
+							File:   "date.flux",
+							Source: "layout",
+							Start: ast.Position{
+								Column: 13,
+								Line:   59,
+							},
+						},
+						TrailingComment: nil,
+					},
+					Key: &ast.Identifier{
+						BaseNode: ast.BaseNode{
+							Comments: nil,
+							Errors:   nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 19,
+									Line:   59,
+								},
+								File:   "date.flux",
+								Source: "layout",
+								Start: ast.Position{
+									Column: 13,
+									Line:   59,
+								},
+							},
+							TrailingComment: nil,
+						},
+						Name: "layout",
+					},
+					Value: nil,
+				}, &ast.Property{
+					BaseNode: ast.BaseNode{
+						Comments: nil,
+						Errors:   nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 38,
+								Line:   59,
+							},
+							File:   "date.flux",
+							Source: "location=location",
+							Start: ast.Position{
+								Column: 21,
+								Line:   59,
+							},
+						},
+						TrailingComment: nil,
+					},
+					Key: &ast.Identifier{
+						BaseNode: ast.BaseNode{
+							Comments: nil,
+							Errors:   nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 29,
+									Line:   59,
+								},
+								File:   "date.flux",
+								Source: "location",
+								Start: ast.Position{
+									Column: 21,
+									Line:   59,
+								},
+							},
+							TrailingComment: nil,
+						},
+						Name: "location",
+					},
+					Value: &ast.Identifier{
+						BaseNode: ast.BaseNode{
+							Comments: nil,
+							Errors:   nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 38,
+									Line:   59,
+								},
+								File:   "date.flux",
+								Source: "location",
+								Start: ast.Position{
+									Column: 30,
+									Line:   59,
+								},
+							},
+							TrailingComment: nil,
+						},
+						Name: "location",
+					},
+				}},
 			},
 		}, &ast.VariableAssignment{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 14,
-						Line:   18,
+						Line:   61,
 					},
 					File:   "date.flux",
 					Source: "Sunday    = 0",
 					Start: ast.Position{
 						Column: 1,
-						Line:   18,
+						Line:   61,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 7,
-							Line:   18,
+							Line:   61,
 						},
 						File:   "date.flux",
 						Source: "Sunday",
 						Start: ast.Position{
 							Column: 1,
-							Line:   18,
+							Line:   61,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "Sunday",
 			},
 			Init: &ast.IntegerLiteral{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 14,
-							Line:   18,
+							Line:   61,
 						},
 						File:   "date.flux",
 						Source: "0",
 						Start: ast.Position{
 							Column: 13,
-							Line:   18,
+							Line:   61,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Value: int64(0),
 			},
 		}, &ast.VariableAssignment{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 14,
-						Line:   19,
+						Line:   62,
 					},
 					File:   "date.flux",
 					Source: "Monday    = 1",
 					Start: ast.Position{
 						Column: 1,
-						Line:   19,
+						Line:   62,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 7,
-							Line:   19,
+							Line:   62,
 						},
 						File:   "date.flux",
 						Source: "Monday",
 						Start: ast.Position{
 							Column: 1,
-							Line:   19,
+							Line:   62,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "Monday",
 			},
 			Init: &ast.IntegerLiteral{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 14,
-							Line:   19,
+							Line:   62,
 						},
 						File:   "date.flux",
 						Source: "1",
 						Start: ast.Position{
 							Column: 13,
-							Line:   19,
+							Line:   62,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Value: int64(1),
 			},
 		}, &ast.VariableAssignment{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 14,
-						Line:   20,
+						Line:   63,
 					},
 					File:   "date.flux",
 					Source: "Tuesday   = 2",
 					Start: ast.Position{
 						Column: 1,
-						Line:   20,
+						Line:   63,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 8,
-							Line:   20,
+							Line:   63,
 						},
 						File:   "date.flux",
 						Source: "Tuesday",
 						Start: ast.Position{
 							Column: 1,
-							Line:   20,
+							Line:   63,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "Tuesday",
 			},
 			Init: &ast.IntegerLiteral{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 14,
-							Line:   20,
+							Line:   63,
 						},
 						File:   "date.flux",
 						Source: "2",
 						Start: ast.Position{
 							Column: 13,
-							Line:   20,
+							Line:   63,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Value: int64(2),
 			},
 		}, &ast.VariableAssignment{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 14,
-						Line:   21,
+						Line:   64,
 					},
 					File:   "date.flux",
 					Source: "Wednesday = 3",
 					Start: ast.Position{
 						Column: 1,
-						Line:   21,
+						Line:   64,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 10,
-							Line:   21,
+							Line:   64,
 						},
 						File:   "date.flux",
 						Source: "Wednesday",
 						Start: ast.Position{
 							Column: 1,
-							Line:   21,
+							Line:   64,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "Wednesday",
 			},
 			Init: &ast.IntegerLiteral{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 14,
-							Line:   21,
+							Line:   64,
 						},
 						File:   "date.flux",
 						Source: "3",
 						Start: ast.Position{
 							Column: 13,
-							Line:   21,
+							Line:   64,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Value: int64(3),
 			},
 		}, &ast.VariableAssignment{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 14,
-						Line:   22,
+						Line:   65,
 					},
 					File:   "date.flux",
 					Source: "Thursday  = 4",
 					Start: ast.Position{
 						Column: 1,
-						Line:   22,
+						Line:   65,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 9,
-							Line:   22,
+							Line:   65,
 						},
 						File:   "date.flux",
 						Source: "Thursday",
 						Start: ast.Position{
 							Column: 1,
-							Line:   22,
+							Line:   65,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "Thursday",
 			},
 			Init: &ast.IntegerLiteral{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 14,
-							Line:   22,
+							Line:   65,
 						},
 						File:   "date.flux",
 						Source: "4",
 						Start: ast.Position{
 							Column: 13,
-							Line:   22,
+							Line:   65,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Value: int64(4),
 			},
 		}, &ast.VariableAssignment{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 14,
-						Line:   23,
+						Line:   66,
 					},
 					File:   "date.flux",
 					Source: "Friday    = 5",
 					Start: ast.Position{
 						Column: 1,
-						Line:   23,
+						Line:   66,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 7,
-							Line:   23,
+							Line:   66,
 						},
 						File:   "date.flux",
 						Source: "Friday",
 						Start: ast.Position{
 							Column: 1,
-							Line:   23,
+							Line:   66,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "Friday",
 			},
 			Init: &ast.IntegerLiteral{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 14,
-							Line:   23,
+							Line:   66,
 						},
 						File:   "date.flux",
 						Source: "5",
 						Start: ast.Position{
 							Column: 13,
-							Line:   23,
+							Line:   66,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Value: int64(5),
 			},
 		}, &ast.VariableAssignment{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 14,
-						Line:   24,
+						Line:   67,
 					},
 					File:   "date.flux",
 					Source: "Saturday  = 6",
 					Start: ast.Position{
 						Column: 1,
-						Line:   24,
+						Line:   67,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 9,
-							Line:   24,
+							Line:   67,
 						},
 						File:   "date.flux",
 						Source: "Saturday",
 						Start: ast.Position{
 							Column: 1,
-							Line:   24,
+							Line:   67,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "Saturday",
 			},
 			Init: &ast.IntegerLiteral{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 14,
-							Line:   24,
+							Line:   67,
 						},
 						File:   "date.flux",
 						Source: "6",
 						Start: ast.Position{
 							Column: 13,
-							Line:   24,
+							Line:   67,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Value: int64(6),
 			},
 		}, &ast.VariableAssignment{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 14,
-						Line:   26,
+						Line:   69,
 					},
 					File:   "date.flux",
 					Source: "January   = 1",
 					Start: ast.Position{
 						Column: 1,
-						Line:   26,
+						Line:   69,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 8,
-							Line:   26,
+							Line:   69,
 						},
 						File:   "date.flux",
 						Source: "January",
 						Start: ast.Position{
 							Column: 1,
-							Line:   26,
+							Line:   69,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "January",
 			},
 			Init: &ast.IntegerLiteral{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 14,
-							Line:   26,
+							Line:   69,
 						},
 						File:   "date.flux",
 						Source: "1",
 						Start: ast.Position{
 							Column: 13,
-							Line:   26,
+							Line:   69,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Value: int64(1),
 			},
 		}, &ast.VariableAssignment{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 14,
-						Line:   27,
+						Line:   70,
 					},
 					File:   "date.flux",
 					Source: "February  = 2",
 					Start: ast.Position{
 						Column: 1,
-						Line:   27,
+						Line:   70,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 9,
-							Line:   27,
+							Line:   70,
 						},
 						File:   "date.flux",
 						Source: "February",
 						Start: ast.Position{
 							Column: 1,
-							Line:   27,
+							Line:   70,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "February",
 			},
 			Init: &ast.IntegerLiteral{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 14,
-							Line:   27,
+							Line:   70,
 						},
 						File:   "date.flux",
 						Source: "2",
 						Start: ast.Position{
 							Column: 13,
-							Line:   27,
+							Line:   70,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Value: int64(2),
 			},
 		}, &ast.VariableAssignment{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 14,
-						Line:   28,
+						Line:   71,
 					},
 					File:   "date.flux",
 					Source: "March     = 3",
 					Start: ast.Position{
 						Column: 1,
-						Line:   28,
+						Line:   71,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 6,
-							Line:   28,
+							Line:   71,
 						},
 						File:   "date.flux",
 						Source: "March",
 						Start: ast.Position{
 							Column: 1,
-							Line:   28,
+							Line:   71,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "March",
 			},
 			Init: &ast.IntegerLiteral{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 14,
-							Line:   28,
+							Line:   71,
 						},
 						File:   "date.flux",
 						Source: "3",
 						Start: ast.Position{
 							Column: 13,
-							Line:   28,
+							Line:   71,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Value: int64(3),
 			},
 		}, &ast.VariableAssignment{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 14,
-						Line:   29,
+						Line:   72,
 					},
 					File:   "date.flux",
 					Source: "April     = 4",
 					Start: ast.Position{
 						Column: 1,
-						Line:   29,
+						Line:   72,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 6,
-							Line:   29,
+							Line:   72,
 						},
 						File:   "date.flux",
 						Source: "April",
 						Start: ast.Position{
 							Column: 1,
-							Line:   29,
+							Line:   72,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "April",
 			},
 			Init: &ast.IntegerLiteral{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 14,
-							Line:   29,
+							Line:   72,
 						},
 						File:   "date.flux",
 						Source: "4",
 						Start: ast.Position{
 							Column: 13,
-							Line:   29,
+							Line:   72,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Value: int64(4),
 			},
 		}, &ast.VariableAssignment{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 14,
-						Line:   30,
+						Line:   73,
 					},
 					File:   "date.flux",
 					Source: "May       = 5",
 					Start: ast.Position{
 						Column: 1,
-						Line:   30,
+						Line:   73,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 4,
-							Line:   30,
+							Line:   73,
 						},
 						File:   "date.flux",
 						Source: "May",
 						Start: ast.Position{
 							Column: 1,
-							Line:   30,
+							Line:   73,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "May",
 			},
 			Init: &ast.IntegerLiteral{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 14,
-							Line:   30,
+							Line:   73,
 						},
 						File:   "date.flux",
 						Source: "5",
 						Start: ast.Position{
 							Column: 13,
-							Line:   30,
+							Line:   73,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Value: int64(5),
 			},
 		}, &ast.VariableAssignment{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 14,
-						Line:   31,
+						Line:   74,
 					},
 					File:   "date.flux",
 					Source: "June      = 6",
 					Start: ast.Position{
 						Column: 1,
-						Line:   31,
+						Line:   74,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 5,
-							Line:   31,
+							Line:   74,
 						},
 						File:   "date.flux",
 						Source: "June",
 						Start: ast.Position{
 							Column: 1,
-							Line:   31,
+							Line:   74,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "June",
 			},
 			Init: &ast.IntegerLiteral{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 14,
-							Line:   31,
+							Line:   74,
 						},
 						File:   "date.flux",
 						Source: "6",
 						Start: ast.Position{
 							Column: 13,
-							Line:   31,
+							Line:   74,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Value: int64(6),
 			},
 		}, &ast.VariableAssignment{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 14,
-						Line:   32,
+						Line:   75,
 					},
 					File:   "date.flux",
 					Source: "July      = 7",
 					Start: ast.Position{
 						Column: 1,
-						Line:   32,
+						Line:   75,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 5,
-							Line:   32,
+							Line:   75,
 						},
 						File:   "date.flux",
 						Source: "July",
 						Start: ast.Position{
 							Column: 1,
-							Line:   32,
+							Line:   75,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "July",
 			},
 			Init: &ast.IntegerLiteral{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 14,
-							Line:   32,
+							Line:   75,
 						},
 						File:   "date.flux",
 						Source: "7",
 						Start: ast.Position{
 							Column: 13,
-							Line:   32,
+							Line:   75,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Value: int64(7),
 			},
 		}, &ast.VariableAssignment{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 14,
-						Line:   33,
+						Line:   76,
 					},
 					File:   "date.flux",
 					Source: "August    = 8",
 					Start: ast.Position{
 						Column: 1,
-						Line:   33,
+						Line:   76,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 7,
-							Line:   33,
+							Line:   76,
 						},
 						File:   "date.flux",
 						Source: "August",
 						Start: ast.Position{
 							Column: 1,
-							Line:   33,
+							Line:   76,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "August",
 			},
 			Init: &ast.IntegerLiteral{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 14,
-							Line:   33,
+							Line:   76,
 						},
 						File:   "date.flux",
 						Source: "8",
 						Start: ast.Position{
 							Column: 13,
-							Line:   33,
+							Line:   76,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Value: int64(8),
 			},
 		}, &ast.VariableAssignment{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 14,
-						Line:   34,
+						Line:   77,
 					},
 					File:   "date.flux",
 					Source: "September = 9",
 					Start: ast.Position{
 						Column: 1,
-						Line:   34,
+						Line:   77,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 10,
-							Line:   34,
+							Line:   77,
 						},
 						File:   "date.flux",
 						Source: "September",
 						Start: ast.Position{
 							Column: 1,
-							Line:   34,
+							Line:   77,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "September",
 			},
 			Init: &ast.IntegerLiteral{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 14,
-							Line:   34,
+							Line:   77,
 						},
 						File:   "date.flux",
 						Source: "9",
 						Start: ast.Position{
 							Column: 13,
-							Line:   34,
+							Line:   77,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Value: int64(9),
 			},
 		}, &ast.VariableAssignment{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 15,
-						Line:   35,
+						Line:   78,
 					},
 					File:   "date.flux",
 					Source: "October   = 10",
 					Start: ast.Position{
 						Column: 1,
-						Line:   35,
+						Line:   78,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 8,
-							Line:   35,
+							Line:   78,
 						},
 						File:   "date.flux",
 						Source: "October",
 						Start: ast.Position{
 							Column: 1,
-							Line:   35,
+							Line:   78,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "October",
 			},
 			Init: &ast.IntegerLiteral{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 15,
-							Line:   35,
+							Line:   78,
 						},
 						File:   "date.flux",
 						Source: "10",
 						Start: ast.Position{
 							Column: 13,
-							Line:   35,
+							Line:   78,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Value: int64(10),
 			},
 		}, &ast.VariableAssignment{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 15,
-						Line:   36,
+						Line:   79,
 					},
 					File:   "date.flux",
 					Source: "November  = 11",
 					Start: ast.Position{
 						Column: 1,
-						Line:   36,
+						Line:   79,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 9,
-							Line:   36,
+							Line:   79,
 						},
 						File:   "date.flux",
 						Source: "November",
 						Start: ast.Position{
 							Column: 1,
-							Line:   36,
+							Line:   79,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "November",
 			},
 			Init: &ast.IntegerLiteral{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 15,
-							Line:   36,
+							Line:   79,
 						},
 						File:   "date.flux",
 						Source: "11",
 						Start: ast.Position{
 							Column: 13,
-							Line:   36,
+							Line:   79,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Value: int64(11),
 			},
 		}, &ast.VariableAssignment{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 15,
-						Line:   37,
+						Line:   80,
 					},
 					File:   "date.flux",
 					Source: "December  = 12",
 					Start: ast.Position{
 						Column: 1,
-						Line:   37,
+						Line:   80,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 9,
-							Line:   37,
+							Line:   80,
 						},
 						File:   "date.flux",
 						Source: "December",
 						Start: ast.Position{
 							Column: 1,
-							Line:   37,
+							Line:   80,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "December",
 			},
 			Init: &ast.IntegerLiteral{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 15,
-							Line:   37,
+							Line:   80,
 						},
 						File:   "date.flux",
 						Source: "12",
 						Start: ast.Position{
 							Column: 13,
-							Line:   37,
+							Line:   80,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Value: int64(12),
 			},
 		}},
+		Eof:      nil,
 		Imports:  nil,
 		Metadata: "parser-type=go",
 		Name:     "date.flux",
 		Package: &ast.PackageClause{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 13,
@@ -1515,10 +9046,12 @@ var pkgAST = &ast.Package{
 						Line:   1,
 					},
 				},
+				TrailingComment: nil,
 			},
 			Name: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 13,
@@ -1531,6 +9064,7 @@ var pkgAST = &ast.Package{
 							Line:   1,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "date",
 			},
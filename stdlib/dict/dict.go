@@ -0,0 +1,158 @@
+package dict
+
+import (
+	"context"
+
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/codes"
+	"github.com/influxdata/flux/internal/errors"
+	"github.com/influxdata/flux/interpreter"
+	"github.com/influxdata/flux/semantic"
+	"github.com/influxdata/flux/values"
+)
+
+const pkgpath = "dict"
+
+func init() {
+	flux.RegisterPackageValue(pkgpath, "fromList", values.NewFunction(
+		"fromList",
+		semantic.NewFunctionPolyType(semantic.FunctionPolySignature{
+			Parameters: map[string]semantic.PolyType{
+				"pairs": semantic.NewArrayPolyType(semantic.NewObjectPolyType(
+					map[string]semantic.PolyType{
+						"key":   semantic.Tvar(1),
+						"value": semantic.Tvar(2),
+					},
+					semantic.LabelSet{"key", "value"},
+					semantic.AllLabels(),
+				)),
+			},
+			Required: semantic.LabelSet{"pairs"},
+			Return:   semantic.NewDictPolyType(semantic.Tvar(1), semantic.Tvar(2)),
+		}),
+		fromList,
+		false,
+	))
+	flux.RegisterPackageValue(pkgpath, "get", values.NewFunction(
+		"get",
+		semantic.NewFunctionPolyType(semantic.FunctionPolySignature{
+			Parameters: map[string]semantic.PolyType{
+				"dict":    semantic.NewDictPolyType(semantic.Tvar(1), semantic.Tvar(2)),
+				"key":     semantic.Tvar(1),
+				"default": semantic.Tvar(2),
+			},
+			Required: semantic.LabelSet{"dict", "key", "default"},
+			Return:   semantic.Tvar(2),
+		}),
+		get,
+		false,
+	))
+	flux.RegisterPackageValue(pkgpath, "insert", values.NewFunction(
+		"insert",
+		semantic.NewFunctionPolyType(semantic.FunctionPolySignature{
+			Parameters: map[string]semantic.PolyType{
+				"dict":  semantic.NewDictPolyType(semantic.Tvar(1), semantic.Tvar(2)),
+				"key":   semantic.Tvar(1),
+				"value": semantic.Tvar(2),
+			},
+			Required: semantic.LabelSet{"dict", "key", "value"},
+			Return:   semantic.NewDictPolyType(semantic.Tvar(1), semantic.Tvar(2)),
+		}),
+		insert,
+		false,
+	))
+	flux.RegisterPackageValue(pkgpath, "remove", values.NewFunction(
+		"remove",
+		semantic.NewFunctionPolyType(semantic.FunctionPolySignature{
+			Parameters: map[string]semantic.PolyType{
+				"dict": semantic.NewDictPolyType(semantic.Tvar(1), semantic.Tvar(2)),
+				"key":  semantic.Tvar(1),
+			},
+			Required: semantic.LabelSet{"dict", "key"},
+			Return:   semantic.NewDictPolyType(semantic.Tvar(1), semantic.Tvar(2)),
+		}),
+		remove,
+		false,
+	))
+}
+
+func fromList(ctx context.Context, args values.Object) (values.Value, error) {
+	a := interpreter.NewArguments(args)
+	pairs, err := a.GetRequiredArray("pairs", semantic.Object)
+	if err != nil {
+		return nil, err
+	}
+
+	elemType := pairs.Type().ElementType()
+	dictType := semantic.NewDictType(elemType.PropertyType("key"), elemType.PropertyType("value"))
+	builder := values.NewDictBuilder(dictType)
+	pairs.Range(func(i int, pair values.Value) {
+		if err != nil {
+			return
+		}
+		key, _ := pair.Object().Get("key")
+		value, _ := pair.Object().Get("value")
+		err = builder.Insert(key, value)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return builder.Dict(), nil
+}
+
+func get(ctx context.Context, args values.Object) (values.Value, error) {
+	a := interpreter.NewArguments(args)
+	d, err := getDict(a)
+	if err != nil {
+		return nil, err
+	}
+	key, err := a.GetRequired("key")
+	if err != nil {
+		return nil, err
+	}
+	def, err := a.GetRequired("default")
+	if err != nil {
+		return nil, err
+	}
+	return d.Get(key, def), nil
+}
+
+func insert(ctx context.Context, args values.Object) (values.Value, error) {
+	a := interpreter.NewArguments(args)
+	d, err := getDict(a)
+	if err != nil {
+		return nil, err
+	}
+	key, err := a.GetRequired("key")
+	if err != nil {
+		return nil, err
+	}
+	value, err := a.GetRequired("value")
+	if err != nil {
+		return nil, err
+	}
+	return d.Insert(key, value)
+}
+
+func remove(ctx context.Context, args values.Object) (values.Value, error) {
+	a := interpreter.NewArguments(args)
+	d, err := getDict(a)
+	if err != nil {
+		return nil, err
+	}
+	key, err := a.GetRequired("key")
+	if err != nil {
+		return nil, err
+	}
+	return d.Remove(key)
+}
+
+func getDict(a interpreter.Arguments) (values.Dictionary, error) {
+	v, err := a.GetRequired("dict")
+	if err != nil {
+		return nil, err
+	} else if got := v.Type().Nature(); got != semantic.Dictionary {
+		return nil, errors.Newf(codes.Invalid, "dict must be a dictionary, got %s", got)
+	}
+	return v.Dict(), nil
+}
@@ -0,0 +1,238 @@
+// DO NOT EDIT: This file is autogenerated via the builtin command.
+
+package dict
+
+import (
+	flux "github.com/influxdata/flux"
+	ast "github.com/influxdata/flux/ast"
+)
+
+func init() {
+	flux.RegisterPackage(pkgAST)
+}
+
+var pkgAST = &ast.Package{
+	BaseNode: ast.BaseNode{
+		Comments:        nil,
+		Errors:          nil,
+		Loc:             nil,
+		TrailingComment: nil,
+	},
+	Files: []*ast.File{&ast.File{
+		BaseNode: ast.BaseNode{
+			Comments: nil,
+			Errors:   nil,
+			Loc: &ast.SourceLocation{
+				End: ast.Position{
+					Column: 15,
+					Line:   15,
+				},
+				File:   "dict.flux",
+				Source: "package dict\n\n// fromList creates a dictionary from an array of objects\n// with a key and a value property.\nbuiltin fromList\n\n// get returns the value for the key in the dictionary\n// or the default if the key is not present.\nbuiltin get\n\n// insert returns a new dictionary with the key set to the value.\nbuiltin insert\n\n// remove returns a new dictionary without the key.\nbuiltin remove",
+				Start: ast.Position{
+					Column: 1,
+					Line:   1,
+				},
+			},
+			TrailingComment: nil,
+		},
+		Body: []ast.Statement{&ast.BuiltinStatement{
+			BaseNode: ast.BaseNode{
+				Comments: []ast.Comment{ast.Comment{Text: "// fromList creates a dictionary from an array of objects"}, ast.Comment{Text: "// with a key and a value property."}},
+				Errors:   nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 17,
+						Line:   5,
+					},
+					File:   "dict.flux",
+					Source: "builtin fromList",
+					Start: ast.Position{
+						Column: 1,
+						Line:   5,
+					},
+				},
+				TrailingComment: nil,
+			},
+			ID: &ast.Identifier{
+				BaseNode: ast.BaseNode{
+					Comments: nil,
+					Errors:   nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 17,
+							Line:   5,
+						},
+						File:   "dict.flux",
+						Source: "fromList",
+						Start: ast.Position{
+							Column: 9,
+							Line:   5,
+						},
+					},
+					TrailingComment: nil,
+				},
+				Name: "fromList",
+			},
+		}, &ast.BuiltinStatement{
+			BaseNode: ast.BaseNode{
+				Comments: []ast.Comment{ast.Comment{Text: "// get returns the value for the key in the dictionary"}, ast.Comment{Text: "// or the default if the key is not present."}},
+				Errors:   nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 12,
+						Line:   9,
+					},
+					File:   "dict.flux",
+					Source: "builtin get",
+					Start: ast.Position{
+						Column: 1,
+						Line:   9,
+					},
+				},
+				TrailingComment: nil,
+			},
+			ID: &ast.Identifier{
+				BaseNode: ast.BaseNode{
+					Comments: nil,
+					Errors:   nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 12,
+							Line:   9,
+						},
+						File:   "dict.flux",
+						Source: "get",
+						Start: ast.Position{
+							Column: 9,
+							Line:   9,
+						},
+					},
+					TrailingComment: nil,
+				},
+				Name: "get",
+			},
+		}, &ast.BuiltinStatement{
+			BaseNode: ast.BaseNode{
+				Comments: []ast.Comment{ast.Comment{Text: "// insert returns a new dictionary with the key set to the value."}},
+				Errors:   nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 15,
+						Line:   12,
+					},
+					File:   "dict.flux",
+					Source: "builtin insert",
+					Start: ast.Position{
+						Column: 1,
+						Line:   12,
+					},
+				},
+				TrailingComment: nil,
+			},
+			ID: &ast.Identifier{
+				BaseNode: ast.BaseNode{
+					Comments: nil,
+					Errors:   nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 15,
+							Line:   12,
+						},
+						File:   "dict.flux",
+						Source: "insert",
+						Start: ast.Position{
+							Column: 9,
+							Line:   12,
+						},
+					},
+					TrailingComment: nil,
+				},
+				Name: "insert",
+			},
+		}, &ast.BuiltinStatement{
+			BaseNode: ast.BaseNode{
+				Comments: []ast.Comment{ast.Comment{Text: "// remove returns a new dictionary without the key."}},
+				Errors:   nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 15,
+						Line:   15,
+					},
+					File:   "dict.flux",
+					Source: "builtin remove",
+					Start: ast.Position{
+						Column: 1,
+						Line:   15,
+					},
+				},
+				TrailingComment: nil,
+			},
+			ID: &ast.Identifier{
+				BaseNode: ast.BaseNode{
+					Comments: nil,
+					Errors:   nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 15,
+							Line:   15,
+						},
+						File:   "dict.flux",
+						Source: "remove",
+						Start: ast.Position{
+							Column: 9,
+							Line:   15,
+						},
+					},
+					TrailingComment: nil,
+				},
+				Name: "remove",
+			},
+		}},
+		Eof:      nil,
+		Imports:  nil,
+		Metadata: "parser-type=go",
+		Name:     "dict.flux",
+		Package: &ast.PackageClause{
+			BaseNode: ast.BaseNode{
+				Comments: nil,
+				Errors:   nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 13,
+						Line:   1,
+					},
+					File:   "dict.flux",
+					Source: "package dict",
+					Start: ast.Position{
+						Column: 1,
+						Line:   1,
+					},
+				},
+				TrailingComment: nil,
+			},
+			Name: &ast.Identifier{
+				BaseNode: ast.BaseNode{
+					Comments: nil,
+					Errors:   nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 13,
+							Line:   1,
+						},
+						File:   "dict.flux",
+						Source: "dict",
+						Start: ast.Position{
+							Column: 9,
+							Line:   1,
+						},
+					},
+					TrailingComment: nil,
+				},
+				Name: "dict",
+			},
+		},
+	}},
+	Package: "dict",
+	Path:    "dict",
+}
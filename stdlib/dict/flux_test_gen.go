@@ -0,0 +1,4829 @@
+// DO NOT EDIT: This file is autogenerated via the builtin command.
+
+package dict
+
+import (
+	ast "github.com/influxdata/flux/ast"
+	parser "github.com/influxdata/flux/internal/parser"
+)
+
+var FluxTestPackages = []*ast.Package{&ast.Package{
+	BaseNode: ast.BaseNode{
+		Comments:        nil,
+		Errors:          nil,
+		Loc:             nil,
+		TrailingComment: nil,
+	},
+	Files: []*ast.File{&ast.File{
+		BaseNode: ast.BaseNode{
+			Comments: nil,
+			Errors:   nil,
+			Loc: &ast.SourceLocation{
+				End: ast.Position{
+					Column: 98,
+					Line:   42,
+				},
+				File:   "fromList_test.flux",
+				Source: "package dict_test\n\nimport \"testing\"\nimport \"dict\"\n\noption now = () => (2030-01-01T00:00:00Z)\n\ninData = \"\n#datatype,string,long,dateTime:RFC3339,long,string,string,string\n#group,false,false,false,false,true,true,true\n#default,_result,,,,,,\n,result,table,_time,_value,_field,_measurement,code\n,,0,2018-05-22T19:53:26Z,3,requests,http,200\n,,0,2018-05-22T19:53:36Z,4,requests,http,200\n,,1,2018-05-22T19:53:26Z,1,requests,http,404\n,,2,2018-05-22T19:53:26Z,2,requests,http,503\n\"\n\noutData = \"\n#datatype,string,long,dateTime:RFC3339,dateTime:RFC3339,string,string,string,dateTime:RFC3339,long,string\n#group,false,false,true,true,true,true,true,false,false,false\n#default,_result,,,,,,,,,\n,result,table,_start,_stop,_measurement,_field,code,_time,_value,status\n,,0,2018-05-20T19:53:26Z,2030-01-01T00:00:00Z,http,requests,200,2018-05-22T19:53:26Z,3,OK\n,,0,2018-05-20T19:53:26Z,2030-01-01T00:00:00Z,http,requests,200,2018-05-22T19:53:36Z,4,OK\n,,1,2018-05-20T19:53:26Z,2030-01-01T00:00:00Z,http,requests,404,2018-05-22T19:53:26Z,1,Not Found\n,,2,2018-05-20T19:53:26Z,2030-01-01T00:00:00Z,http,requests,503,2018-05-22T19:53:26Z,2,Service Unavailable\n\"\n\ncodes = dict.fromList(pairs: [\n\t{key: \"200\", value: \"OK\"},\n\t{key: \"404\", value: \"Not Found\"},\n\t{key: \"503\", value: \"Service Unavailable\"},\n])\n\nt_fromList = (table=<-) =>\n\ttable\n\t\t|> range(start: 2018-05-20T19:53:26Z)\n\t\t|> map(fn: (r) => ({r with status: dict.get(dict: codes, key: r.code, default: \"\")}))\n\ntest _fromList = () =>\n\t({input: testing.loadStorage(csv: inData), want: testing.loadMem(csv: outData), fn: t_fromList})",
+				Start: ast.Position{
+					Column: 1,
+					Line:   1,
+				},
+			},
+			TrailingComment: nil,
+		},
+		Body: []ast.Statement{&ast.OptionStatement{
+			Assignment: &ast.VariableAssignment{
+				BaseNode: ast.BaseNode{
+					Comments: nil,
+					Errors:   nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 42,
+							Line:   6,
+						},
+						File:   "fromList_test.flux",
+						Source: "now = () => (2030-01-01T00:00:00Z)",
+						Start: ast.Position{
+							Column: 8,
+							Line:   6,
+						},
+					},
+					TrailingComment: nil,
+				},
+				ID: &ast.Identifier{
+					BaseNode: ast.BaseNode{
+						Comments: nil,
+						Errors:   nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 11,
+								Line:   6,
+							},
+							File:   "fromList_test.flux",
+							Source: "now",
+							Start: ast.Position{
+								Column: 8,
+								Line:   6,
+							},
+						},
+						TrailingComment: nil,
+					},
+					Name: "now",
+				},
+				Init: &ast.FunctionExpression{
+					BaseNode: ast.BaseNode{
+						Comments: nil,
+						Errors:   nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 42,
+								Line:   6,
+							},
+							File:   "fromList_test.flux",
+							Source: "() => (2030-01-01T00:00:00Z)",
+							Start: ast.Position{
+								Column: 14,
+								Line:   6,
+							},
+						},
+						TrailingComment: nil,
+					},
+					Body: &ast.ParenExpression{
+						BaseNode: ast.BaseNode{
+							Comments: nil,
+							Errors:   nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 42,
+									Line:   6,
+								},
+								File:   "fromList_test.flux",
+								Source: "(2030-01-01T00:00:00Z)",
+								Start: ast.Position{
+									Column: 20,
+									Line:   6,
+								},
+							},
+							TrailingComment: nil,
+						},
+						Expression: &ast.DateTimeLiteral{
+							BaseNode: ast.BaseNode{
+								Comments: nil,
+								Errors:   nil,
+								Loc: &ast.SourceLocation{
+									End: ast.Position{
+										Column: 41,
+										Line:   6,
+									},
+									File:   "fromList_test.flux",
+									Source: "2030-01-01T00:00:00Z",
+									Start: ast.Position{
+										Column: 21,
+										Line:   6,
+									},
+								},
+								TrailingComment: nil,
+							},
+							Value: parser.MustParseTime("2030-01-01T00:00:00Z"),
+						},
+					},
+					Params: nil,
+				},
+			},
+			BaseNode: ast.BaseNode{
+				Comments: nil,
+				Errors:   nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 42,
+						Line:   6,
+					},
+					File:   "fromList_test.flux",
+					Source: "option now = () => (2030-01-01T00:00:00Z)",
+					Start: ast.Position{
+						Column: 1,
+						Line:   6,
+					},
+				},
+				TrailingComment: nil,
+			},
+		}, &ast.VariableAssignment{
+			BaseNode: ast.BaseNode{
+				Comments: nil,
+				Errors:   nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 2,
+						Line:   17,
+					},
+					File:   "fromList_test.flux",
+					Source: "inData = \"\n#datatype,string,long,dateTime:RFC3339,long,string,string,string\n#group,false,false,false,false,true,true,true\n#default,_result,,,,,,\n,result,table,_time,_value,_field,_measurement,code\n,,0,2018-05-22T19:53:26Z,3,requests,http,200\n,,0,2018-05-22T19:53:36Z,4,requests,http,200\n,,1,2018-05-22T19:53:26Z,1,requests,http,404\n,,2,2018-05-22T19:53:26Z,2,requests,http,503\n\"",
+					Start: ast.Position{
+						Column: 1,
+						Line:   8,
+					},
+				},
+				TrailingComment: nil,
+			},
+			ID: &ast.Identifier{
+				BaseNode: ast.BaseNode{
+					Comments: nil,
+					Errors:   nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 7,
+							Line:   8,
+						},
+						File:   "fromList_test.flux",
+						Source: "inData",
+						Start: ast.Position{
+							Column: 1,
+							Line:   8,
+						},
+					},
+					TrailingComment: nil,
+				},
+				Name: "inData",
+			},
+			Init: &ast.StringLiteral{
+				BaseNode: ast.BaseNode{
+					Comments: nil,
+					Errors:   nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 2,
+							Line:   17,
+						},
+						File:   "fromList_test.flux",
+						Source: "\"\n#datatype,string,long,dateTime:RFC3339,long,string,string,string\n#group,false,false,false,false,true,true,true\n#default,_result,,,,,,\n,result,table,_time,_value,_field,_measurement,code\n,,0,2018-05-22T19:53:26Z,3,requests,http,200\n,,0,2018-05-22T19:53:36Z,4,requests,http,200\n,,1,2018-05-22T19:53:26Z,1,requests,http,404\n,,2,2018-05-22T19:53:26Z,2,requests,http,503\n\"",
+						Start: ast.Position{
+							Column: 10,
+							Line:   8,
+						},
+					},
+					TrailingComment: nil,
+				},
+				Value: "\n#datatype,string,long,dateTime:RFC3339,long,string,string,string\n#group,false,false,false,false,true,true,true\n#default,_result,,,,,,\n,result,table,_time,_value,_field,_measurement,code\n,,0,2018-05-22T19:53:26Z,3,requests,http,200\n,,0,2018-05-22T19:53:36Z,4,requests,http,200\n,,1,2018-05-22T19:53:26Z,1,requests,http,404\n,,2,2018-05-22T19:53:26Z,2,requests,http,503\n",
+			},
+		}, &ast.VariableAssignment{
+			BaseNode: ast.BaseNode{
+				Comments: nil,
+				Errors:   nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 2,
+						Line:   28,
+					},
+					File:   "fromList_test.flux",
+					Source: "outData = \"\n#datatype,string,long,dateTime:RFC3339,dateTime:RFC3339,string,string,string,dateTime:RFC3339,long,string\n#group,false,false,true,true,true,true,true,false,false,false\n#default,_result,,,,,,,,,\n,result,table,_start,_stop,_measurement,_field,code,_time,_value,status\n,,0,2018-05-20T19:53:26Z,2030-01-01T00:00:00Z,http,requests,200,2018-05-22T19:53:26Z,3,OK\n,,0,2018-05-20T19:53:26Z,2030-01-01T00:00:00Z,http,requests,200,2018-05-22T19:53:36Z,4,OK\n,,1,2018-05-20T19:53:26Z,2030-01-01T00:00:00Z,http,requests,404,2018-05-22T19:53:26Z,1,Not Found\n,,2,2018-05-20T19:53:26Z,2030-01-01T00:00:00Z,http,requests,503,2018-05-22T19:53:26Z,2,Service Unavailable\n\"",
+					Start: ast.Position{
+						Column: 1,
+						Line:   19,
+					},
+				},
+				TrailingComment: nil,
+			},
+			ID: &ast.Identifier{
+				BaseNode: ast.BaseNode{
+					Comments: nil,
+					Errors:   nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 8,
+							Line:   19,
+						},
+						File:   "fromList_test.flux",
+						Source: "outData",
+						Start: ast.Position{
+							Column: 1,
+							Line:   19,
+						},
+					},
+					TrailingComment: nil,
+				},
+				Name: "outData",
+			},
+			Init: &ast.StringLiteral{
+				BaseNode: ast.BaseNode{
+					Comments: nil,
+					Errors:   nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 2,
+							Line:   28,
+						},
+						File:   "fromList_test.flux",
+						Source: "\"\n#datatype,string,long,dateTime:RFC3339,dateTime:RFC3339,string,string,string,dateTime:RFC3339,long,string\n#group,false,false,true,true,true,true,true,false,false,false\n#default,_result,,,,,,,,,\n,result,table,_start,_stop,_measurement,_field,code,_time,_value,status\n,,0,2018-05-20T19:53:26Z,2030-01-01T00:00:00Z,http,requests,200,2018-05-22T19:53:26Z,3,OK\n,,0,2018-05-20T19:53:26Z,2030-01-01T00:00:00Z,http,requests,200,2018-05-22T19:53:36Z,4,OK\n,,1,2018-05-20T19:53:26Z,2030-01-01T00:00:00Z,http,requests,404,2018-05-22T19:53:26Z,1,Not Found\n,,2,2018-05-20T19:53:26Z,2030-01-01T00:00:00Z,http,requests,503,2018-05-22T19:53:26Z,2,Service Unavailable\n\"",
+						Start: ast.Position{
+							Column: 11,
+							Line:   19,
+						},
+					},
+					TrailingComment: nil,
+				},
+				Value: "\n#datatype,string,long,dateTime:RFC3339,dateTime:RFC3339,string,string,string,dateTime:RFC3339,long,string\n#group,false,false,true,true,true,true,true,false,false,false\n#default,_result,,,,,,,,,\n,result,table,_start,_stop,_measurement,_field,code,_time,_value,status\n,,0,2018-05-20T19:53:26Z,2030-01-01T00:00:00Z,http,requests,200,2018-05-22T19:53:26Z,3,OK\n,,0,2018-05-20T19:53:26Z,2030-01-01T00:00:00Z,http,requests,200,2018-05-22T19:53:36Z,4,OK\n,,1,2018-05-20T19:53:26Z,2030-01-01T00:00:00Z,http,requests,404,2018-05-22T19:53:26Z,1,Not Found\n,,2,2018-05-20T19:53:26Z,2030-01-01T00:00:00Z,http,requests,503,2018-05-22T19:53:26Z,2,Service Unavailable\n",
+			},
+		}, &ast.VariableAssignment{
+			BaseNode: ast.BaseNode{
+				Comments: nil,
+				Errors:   nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 3,
+						Line:   34,
+					},
+					File:   "fromList_test.flux",
+					Source: "codes = dict.fromList(pairs: [\n\t{key: \"200\", value: \"OK\"},\n\t{key: \"404\", value: \"Not Found\"},\n\t{key: \"503\", value: \"Service Unavailable\"},\n])",
+					Start: ast.Position{
+						Column: 1,
+						Line:   30,
+					},
+				},
+				TrailingComment: nil,
+			},
+			ID: &ast.Identifier{
+				BaseNode: ast.BaseNode{
+					Comments: nil,
+					Errors:   nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 6,
+							Line:   30,
+						},
+						File:   "fromList_test.flux",
+						Source: "codes",
+						Start: ast.Position{
+							Column: 1,
+							Line:   30,
+						},
+					},
+					TrailingComment: nil,
+				},
+				Name: "codes",
+			},
+			Init: &ast.CallExpression{
+				Arguments: []ast.Expression{&ast.ObjectExpression{
+					BaseNode: ast.BaseNode{
+						Comments: nil,
+						Errors:   nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 2,
+								Line:   34,
+							},
+							File:   "fromList_test.flux",
+							Source: "pairs: [\n\t{key: \"200\", value: \"OK\"},\n\t{key: \"404\", value: \"Not Found\"},\n\t{key: \"503\", value: \"Service Unavailable\"},\n]",
+							Start: ast.Position{
+								Column: 23,
+								Line:   30,
+							},
+						},
+						TrailingComment: nil,
+					},
+					Properties: []*ast.Property{&ast.Property{
+						BaseNode: ast.BaseNode{
+							Comments: nil,
+							Errors:   nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 2,
+									Line:   34,
+								},
+								File:   "fromList_test.flux",
+								Source: "pairs: [\n\t{key: \"200\", value: \"OK\"},\n\t{key: \"404\", value: \"Not Found\"},\n\t{key: \"503\", value: \"Service Unavailable\"},\n]",
+								Start: ast.Position{
+									Column: 23,
+									Line:   30,
+								},
+							},
+							TrailingComment: nil,
+						},
+						Key: &ast.Identifier{
+							BaseNode: ast.BaseNode{
+								Comments: nil,
+								Errors:   nil,
+								Loc: &ast.SourceLocation{
+									End: ast.Position{
+										Column: 28,
+										Line:   30,
+									},
+									File:   "fromList_test.flux",
+									Source: "pairs",
+									Start: ast.Position{
+										Column: 23,
+										Line:   30,
+									},
+								},
+								TrailingComment: nil,
+							},
+							Name: "pairs",
+						},
+						Value: &ast.ArrayExpression{
+							BaseNode: ast.BaseNode{
+								Comments: nil,
+								Errors:   nil,
+								Loc: &ast.SourceLocation{
+									End: ast.Position{
+										Column: 2,
+										Line:   34,
+									},
+									File:   "fromList_test.flux",
+									Source: "[\n\t{key: \"200\", value: \"OK\"},\n\t{key: \"404\", value: \"Not Found\"},\n\t{key: \"503\", value: \"Service Unavailable\"},\n]",
+									Start: ast.Position{
+										Column: 30,
+										Line:   30,
+									},
+								},
+								TrailingComment: nil,
+							},
+							Elements: []ast.Expression{&ast.ObjectExpression{
+								BaseNode: ast.BaseNode{
+									Comments: nil,
+									Errors:   nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 27,
+											Line:   31,
+										},
+										File:   "fromList_test.flux",
+										Source: "{key: \"200\", value: \"OK\"}",
+										Start: ast.Position{
+											Column: 2,
+											Line:   31,
+										},
+									},
+									TrailingComment: nil,
+								},
+								Properties: []*ast.Property{&ast.Property{
+									BaseNode: ast.BaseNode{
+										Comments: nil,
+										Errors:   nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 13,
+												Line:   31,
+											},
+											File:   "fromList_test.flux",
+											Source: "key: \"200\"",
+											Start: ast.Position{
+												Column: 3,
+												Line:   31,
+											},
+										},
+										TrailingComment: nil,
+									},
+									Key: &ast.Identifier{
+										BaseNode: ast.BaseNode{
+											Comments: nil,
+											Errors:   nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 6,
+													Line:   31,
+												},
+												File:   "fromList_test.flux",
+												Source: "key",
+												Start: ast.Position{
+													Column: 3,
+													Line:   31,
+												},
+											},
+											TrailingComment: nil,
+										},
+										Name: "key",
+									},
+									Value: &ast.StringLiteral{
+										BaseNode: ast.BaseNode{
+											Comments: nil,
+											Errors:   nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 13,
+													Line:   31,
+												},
+												File:   "fromList_test.flux",
+												Source: "\"200\"",
+												Start: ast.Position{
+													Column: 8,
+													Line:   31,
+												},
+											},
+											TrailingComment: nil,
+										},
+										Value: "200",
+									},
+								}, &ast.Property{
+									BaseNode: ast.BaseNode{
+										Comments: nil,
+										Errors:   nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 26,
+												Line:   31,
+											},
+											File:   "fromList_test.flux",
+											Source: "value: \"OK\"",
+											Start: ast.Position{
+												Column: 15,
+												Line:   31,
+											},
+										},
+										TrailingComment: nil,
+									},
+									Key: &ast.Identifier{
+										BaseNode: ast.BaseNode{
+											Comments: nil,
+											Errors:   nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 20,
+													Line:   31,
+												},
+												File:   "fromList_test.flux",
+												Source: "value",
+												Start: ast.Position{
+													Column: 15,
+													Line:   31,
+												},
+											},
+											TrailingComment: nil,
+										},
+										Name: "value",
+									},
+									Value: &ast.StringLiteral{
+										BaseNode: ast.BaseNode{
+											Comments: nil,
+											Errors:   nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 26,
+													Line:   31,
+												},
+												File:   "fromList_test.flux",
+												Source: "\"OK\"",
+												Start: ast.Position{
+													Column: 22,
+													Line:   31,
+												},
+											},
+											TrailingComment: nil,
+										},
+										Value: "OK",
+									},
+								}},
+								With: nil,
+							}, &ast.ObjectExpression{
+								BaseNode: ast.BaseNode{
+									Comments: nil,
+									Errors:   nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 34,
+											Line:   32,
+										},
+										File:   "fromList_test.flux",
+										Source: "{key: \"404\", value: \"Not Found\"}",
+										Start: ast.Position{
+											Column: 2,
+											Line:   32,
+										},
+									},
+									TrailingComment: nil,
+								},
+								Properties: []*ast.Property{&ast.Property{
+									BaseNode: ast.BaseNode{
+										Comments: nil,
+										Errors:   nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 13,
+												Line:   32,
+											},
+											File:   "fromList_test.flux",
+											Source: "key: \"404\"",
+											Start: ast.Position{
+												Column: 3,
+												Line:   32,
+											},
+										},
+										TrailingComment: nil,
+									},
+									Key: &ast.Identifier{
+										BaseNode: ast.BaseNode{
+											Comments: nil,
+											Errors:   nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 6,
+													Line:   32,
+												},
+												File:   "fromList_test.flux",
+												Source: "key",
+												Start: ast.Position{
+													Column: 3,
+													Line:   32,
+												},
+											},
+											TrailingComment: nil,
+										},
+										Name: "key",
+									},
+									Value: &ast.StringLiteral{
+										BaseNode: ast.BaseNode{
+											Comments: nil,
+											Errors:   nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 13,
+													Line:   32,
+												},
+												File:   "fromList_test.flux",
+												Source: "\"404\"",
+												Start: ast.Position{
+													Column: 8,
+													Line:   32,
+												},
+											},
+											TrailingComment: nil,
+										},
+										Value: "404",
+									},
+								}, &ast.Property{
+									BaseNode: ast.BaseNode{
+										Comments: nil,
+										Errors:   nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 33,
+												Line:   32,
+											},
+											File:   "fromList_test.flux",
+											Source: "value: \"Not Found\"",
+											Start: ast.Position{
+												Column: 15,
+												Line:   32,
+											},
+										},
+										TrailingComment: nil,
+									},
+									Key: &ast.Identifier{
+										BaseNode: ast.BaseNode{
+											Comments: nil,
+											Errors:   nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 20,
+													Line:   32,
+												},
+												File:   "fromList_test.flux",
+												Source: "value",
+												Start: ast.Position{
+													Column: 15,
+													Line:   32,
+												},
+											},
+											TrailingComment: nil,
+										},
+										Name: "value",
+									},
+									Value: &ast.StringLiteral{
+										BaseNode: ast.BaseNode{
+											Comments: nil,
+											Errors:   nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 33,
+													Line:   32,
+												},
+												File:   "fromList_test.flux",
+												Source: "\"Not Found\"",
+												Start: ast.Position{
+													Column: 22,
+													Line:   32,
+												},
+											},
+											TrailingComment: nil,
+										},
+										Value: "Not Found",
+									},
+								}},
+								With: nil,
+							}, &ast.ObjectExpression{
+								BaseNode: ast.BaseNode{
+									Comments: nil,
+									Errors:   nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 44,
+											Line:   33,
+										},
+										File:   "fromList_test.flux",
+										Source: "{key: \"503\", value: \"Service Unavailable\"}",
+										Start: ast.Position{
+											Column: 2,
+											Line:   33,
+										},
+									},
+									TrailingComment: nil,
+								},
+								Properties: []*ast.Property{&ast.Property{
+									BaseNode: ast.BaseNode{
+										Comments: nil,
+										Errors:   nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 13,
+												Line:   33,
+											},
+											File:   "fromList_test.flux",
+											Source: "key: \"503\"",
+											Start: ast.Position{
+												Column: 3,
+												Line:   33,
+											},
+										},
+										TrailingComment: nil,
+									},
+									Key: &ast.Identifier{
+										BaseNode: ast.BaseNode{
+											Comments: nil,
+											Errors:   nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 6,
+													Line:   33,
+												},
+												File:   "fromList_test.flux",
+												Source: "key",
+												Start: ast.Position{
+													Column: 3,
+													Line:   33,
+												},
+											},
+											TrailingComment: nil,
+										},
+										Name: "key",
+									},
+									Value: &ast.StringLiteral{
+										BaseNode: ast.BaseNode{
+											Comments: nil,
+											Errors:   nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 13,
+													Line:   33,
+												},
+												File:   "fromList_test.flux",
+												Source: "\"503\"",
+												Start: ast.Position{
+													Column: 8,
+													Line:   33,
+												},
+											},
+											TrailingComment: nil,
+										},
+										Value: "503",
+									},
+								}, &ast.Property{
+									BaseNode: ast.BaseNode{
+										Comments: nil,
+										Errors:   nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 43,
+												Line:   33,
+											},
+											File:   "fromList_test.flux",
+											Source: "value: \"Service Unavailable\"",
+											Start: ast.Position{
+												Column: 15,
+												Line:   33,
+											},
+										},
+										TrailingComment: nil,
+									},
+									Key: &ast.Identifier{
+										BaseNode: ast.BaseNode{
+											Comments: nil,
+											Errors:   nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 20,
+													Line:   33,
+												},
+												File:   "fromList_test.flux",
+												Source: "value",
+												Start: ast.Position{
+													Column: 15,
+													Line:   33,
+												},
+											},
+											TrailingComment: nil,
+										},
+										Name: "value",
+									},
+									Value: &ast.StringLiteral{
+										BaseNode: ast.BaseNode{
+											Comments: nil,
+											Errors:   nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 43,
+													Line:   33,
+												},
+												File:   "fromList_test.flux",
+												Source: "\"Service Unavailable\"",
+												Start: ast.Position{
+													Column: 22,
+													Line:   33,
+												},
+											},
+											TrailingComment: nil,
+										},
+										Value: "Service Unavailable",
+									},
+								}},
+								With: nil,
+							}},
+						},
+					}},
+					With: nil,
+				}},
+				BaseNode: ast.BaseNode{
+					Comments: nil,
+					Errors:   nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 3,
+							Line:   34,
+						},
+						File:   "fromList_test.flux",
+						Source: "dict.fromList(pairs: [\n\t{key: \"200\", value: \"OK\"},\n\t{key: \"404\", value: \"Not Found\"},\n\t{key: \"503\", value: \"Service Unavailable\"},\n])",
+						Start: ast.Position{
+							Column: 9,
+							Line:   30,
+						},
+					},
+					TrailingComment: nil,
+				},
+				Callee: &ast.MemberExpression{
+					BaseNode: ast.BaseNode{
+						Comments: nil,
+						Errors:   nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 22,
+								Line:   30,
+							},
+							File:   "fromList_test.flux",
+							Source: "dict.fromList",
+							Start: ast.Position{
+								Column: 9,
+								Line:   30,
+							},
+						},
+						TrailingComment: nil,
+					},
+					Object: &ast.Identifier{
+						BaseNode: ast.BaseNode{
+							Comments: nil,
+							Errors:   nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 13,
+									Line:   30,
+								},
+								File:   "fromList_test.flux",
+								Source: "dict",
+								Start: ast.Position{
+									Column: 9,
+									Line:   30,
+								},
+							},
+							TrailingComment: nil,
+						},
+						Name: "dict",
+					},
+					Property: &ast.Identifier{
+						BaseNode: ast.BaseNode{
+							Comments: nil,
+							Errors:   nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 22,
+									Line:   30,
+								},
+								File:   "fromList_test.flux",
+								Source: "fromList",
+								Start: ast.Position{
+									Column: 14,
+									Line:   30,
+								},
+							},
+							TrailingComment: nil,
+						},
+						Name: "fromList",
+					},
+				},
+			},
+		}, &ast.VariableAssignment{
+			BaseNode: ast.BaseNode{
+				Comments: nil,
+				Errors:   nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 88,
+						Line:   39,
+					},
+					File:   "fromList_test.flux",
+					Source: "t_fromList = (table=<-) =>\n\ttable\n\t\t|> range(start: 2018-05-20T19:53:26Z)\n\t\t|> map(fn: (r) => ({r with status: dict.get(dict: codes, key: r.code, default: \"\")}))",
+					Start: ast.Position{
+						Column: 1,
+						Line:   36,
+					},
+				},
+				TrailingComment: nil,
+			},
+			ID: &ast.Identifier{
+				BaseNode: ast.BaseNode{
+					Comments: nil,
+					Errors:   nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 11,
+							Line:   36,
+						},
+						File:   "fromList_test.flux",
+						Source: "t_fromList",
+						Start: ast.Position{
+							Column: 1,
+							Line:   36,
+						},
+					},
+					TrailingComment: nil,
+				},
+				Name: "t_fromList",
+			},
+			Init: &ast.FunctionExpression{
+				BaseNode: ast.BaseNode{
+					Comments: nil,
+					Errors:   nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 88,
+							Line:   39,
+						},
+						File:   "fromList_test.flux",
+						Source: "(table=<-) =>\n\ttable\n\t\t|> range(start: 2018-05-20T19:53:26Z)\n\t\t|> map(fn: (r) => ({r with status: dict.get(dict: codes, key: r.code, default: \"\")}))",
+						Start: ast.Position{
+							Column: 14,
+							Line:   36,
+						},
+					},
+					TrailingComment: nil,
+				},
+				Body: &ast.PipeExpression{
+					Argument: &ast.PipeExpression{
+						Argument: &ast.Identifier{
+							BaseNode: ast.BaseNode{
+								Comments: nil,
+								Errors:   nil,
+								Loc: &ast.SourceLocation{
+									End: ast.Position{
+										Column: 7,
+										Line:   37,
+									},
+									File:   "fromList_test.flux",
+									Source: "table",
+									Start: ast.Position{
+										Column: 2,
+										Line:   37,
+									},
+								},
+								TrailingComment: nil,
+							},
+							Name: "table",
+						},
+						BaseNode: ast.BaseNode{
+							Comments: nil,
+							Errors:   nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 40,
+									Line:   38,
+								},
+								File:   "fromList_test.flux",
+								Source: "table\n\t\t|> range(start: 2018-05-20T19:53:26Z)",
+								Start: ast.Position{
+									Column: 2,
+									Line:   37,
+								},
+							},
+							TrailingComment: nil,
+						},
+						Call: &ast.CallExpression{
+							Arguments: []ast.Expression{&ast.ObjectExpression{
+								BaseNode: ast.BaseNode{
+									Comments: nil,
+									Errors:   nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 39,
+											Line:   38,
+										},
+										File:   "fromList_test.flux",
+										Source: "start: 2018-05-20T19:53:26Z",
+										Start: ast.Position{
+											Column: 12,
+											Line:   38,
+										},
+									},
+									TrailingComment: nil,
+								},
+								Properties: []*ast.Property{&ast.Property{
+									BaseNode: ast.BaseNode{
+										Comments: nil,
+										Errors:   nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 39,
+												Line:   38,
+											},
+											File:   "fromList_test.flux",
+											Source: "start: 2018-05-20T19:53:26Z",
+											Start: ast.Position{
+												Column: 12,
+												Line:   38,
+											},
+										},
+										TrailingComment: nil,
+									},
+									Key: &ast.Identifier{
+										BaseNode: ast.BaseNode{
+											Comments: nil,
+											Errors:   nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 17,
+													Line:   38,
+												},
+												File:   "fromList_test.flux",
+												Source: "start",
+												Start: ast.Position{
+													Column: 12,
+													Line:   38,
+												},
+											},
+											TrailingComment: nil,
+										},
+										Name: "start",
+									},
+									Value: &ast.DateTimeLiteral{
+										BaseNode: ast.BaseNode{
+											Comments: nil,
+											Errors:   nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 39,
+													Line:   38,
+												},
+												File:   "fromList_test.flux",
+												Source: "2018-05-20T19:53:26Z",
+												Start: ast.Position{
+													Column: 19,
+													Line:   38,
+												},
+											},
+											TrailingComment: nil,
+										},
+										Value: parser.MustParseTime("2018-05-20T19:53:26Z"),
+									},
+								}},
+								With: nil,
+							}},
+							BaseNode: ast.BaseNode{
+								Comments: nil,
+								Errors:   nil,
+								Loc: &ast.SourceLocation{
+									End: ast.Position{
+										Column: 40,
+										Line:   38,
+									},
+									File:   "fromList_test.flux",
+									Source: "range(start: 2018-05-20T19:53:26Z)",
+									Start: ast.Position{
+										Column: 6,
+										Line:   38,
+									},
+								},
+								TrailingComment: nil,
+							},
+							Callee: &ast.Identifier{
+								BaseNode: ast.BaseNode{
+									Comments: nil,
+									Errors:   nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 11,
+											Line:   38,
+										},
+										File:   "fromList_test.flux",
+										Source: "range",
+										Start: ast.Position{
+											Column: 6,
+											Line:   38,
+										},
+									},
+									TrailingComment: nil,
+								},
+								Name: "range",
+							},
+						},
+					},
+					BaseNode: ast.BaseNode{
+						Comments: nil,
+						Errors:   nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 88,
+								Line:   39,
+							},
+							File:   "fromList_test.flux",
+							Source: "table\n\t\t|> range(start: 2018-05-20T19:53:26Z)\n\t\t|> map(fn: (r) => ({r with status: dict.get(dict: codes, key: r.code, default: \"\")}))",
+							Start: ast.Position{
+								Column: 2,
+								Line:   37,
+							},
+						},
+						TrailingComment: nil,
+					},
+					Call: &ast.CallExpression{
+						Arguments: []ast.Expression{&ast.ObjectExpression{
+							BaseNode: ast.BaseNode{
+								Comments: nil,
+								Errors:   nil,
+								Loc: &ast.SourceLocation{
+									End: ast.Position{
+										Column: 87,
+										Line:   39,
+									},
+									File:   "fromList_test.flux",
+									Source: "fn: (r) => ({r with status: dict.get(dict: codes, key: r.code, default: \"\")})",
+									Start: ast.Position{
+										Column: 10,
+										Line:   39,
+									},
+								},
+								TrailingComment: nil,
+							},
+							Properties: []*ast.Property{&ast.Property{
+								BaseNode: ast.BaseNode{
+									Comments: nil,
+									Errors:   nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 87,
+											Line:   39,
+										},
+										File:   "fromList_test.flux",
+										Source: "fn: (r) => ({r with status: dict.get(dict: codes, key: r.code, default: \"\")})",
+										Start: ast.Position{
+											Column: 10,
+											Line:   39,
+										},
+									},
+									TrailingComment: nil,
+								},
+								Key: &ast.Identifier{
+									BaseNode: ast.BaseNode{
+										Comments: nil,
+										Errors:   nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 12,
+												Line:   39,
+											},
+											File:   "fromList_test.flux",
+											Source: "fn",
+											Start: ast.Position{
+												Column: 10,
+												Line:   39,
+											},
+										},
+										TrailingComment: nil,
+									},
+									Name: "fn",
+								},
+								Value: &ast.FunctionExpression{
+									BaseNode: ast.BaseNode{
+										Comments: nil,
+										Errors:   nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 87,
+												Line:   39,
+											},
+											File:   "fromList_test.flux",
+											Source: "(r) => ({r with status: dict.get(dict: codes, key: r.code, default: \"\")})",
+											Start: ast.Position{
+												Column: 14,
+												Line:   39,
+											},
+										},
+										TrailingComment: nil,
+									},
+									Body: &ast.ParenExpression{
+										BaseNode: ast.BaseNode{
+											Comments: nil,
+											Errors:   nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 87,
+													Line:   39,
+												},
+												File:   "fromList_test.flux",
+												Source: "({r with status: dict.get(dict: codes, key: r.code, default: \"\")})",
+												Start: ast.Position{
+													Column: 21,
+													Line:   39,
+												},
+											},
+											TrailingComment: nil,
+										},
+										Expression: &ast.ObjectExpression{
+											BaseNode: ast.BaseNode{
+												Comments: nil,
+												Errors:   nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 86,
+														Line:   39,
+													},
+													File:   "fromList_test.flux",
+													Source: "{r with status: dict.get(dict: codes, key: r.code, default: \"\")}",
+													Start: ast.Position{
+														Column: 22,
+														Line:   39,
+													},
+												},
+												TrailingComment: nil,
+											},
+											Properties: []*ast.Property{&ast.Property{
+												BaseNode: ast.BaseNode{
+													Comments: nil,
+													Errors:   nil,
+													Loc: &ast.SourceLocation{
+														End: ast.Position{
+															Column: 85,
+															Line:   39,
+														},
+														File:   "fromList_test.flux",
+														Source: "status: dict.get(dict: codes, key: r.code, default: \"\")",
+														Start: ast.Position{
+															Column: 30,
+															Line:   39,
+														},
+													},
+													TrailingComment: nil,
+												},
+												Key: &ast.Identifier{
+													BaseNode: ast.BaseNode{
+														Comments: nil,
+														Errors:   nil,
+														Loc: &ast.SourceLocation{
+															End: ast.Position{
+																Column: 36,
+																Line:   39,
+															},
+															File:   "fromList_test.flux",
+															Source: "status",
+															Start: ast.Position{
+																Column: 30,
+																Line:   39,
+															},
+														},
+														TrailingComment: nil,
+													},
+													Name: "status",
+												},
+												Value: &ast.CallExpression{
+													Arguments: []ast.Expression{&ast.ObjectExpression{
+														BaseNode: ast.BaseNode{
+															Comments: nil,
+															Errors:   nil,
+															Loc: &ast.SourceLocation{
+																End: ast.Position{
+																	Column: 84,
+																	Line:   39,
+																},
+																File:   "fromList_test.flux",
+																Source: "dict: codes, key: r.code, default: \"\"",
+																Start: ast.Position{
+																	Column: 47,
+																	Line:   39,
+																},
+															},
+															TrailingComment: nil,
+														},
+														Properties: []*ast.Property{&ast.Property{
+															BaseNode: ast.BaseNode{
+																Comments: nil,
+																Errors:   nil,
+																Loc: &ast.SourceLocation{
+																	End: ast.Position{
+																		Column: 58,
+																		Line:   39,
+																	},
+																	File:   "fromList_test.flux",
+																	Source: "dict: codes",
+																	Start: ast.Position{
+																		Column: 47,
+																		Line:   39,
+																	},
+																},
+																TrailingComment: nil,
+															},
+															Key: &ast.Identifier{
+																BaseNode: ast.BaseNode{
+																	Comments: nil,
+																	Errors:   nil,
+																	Loc: &ast.SourceLocation{
+																		End: ast.Position{
+																			Column: 51,
+																			Line:   39,
+																		},
+																		File:   "fromList_test.flux",
+																		Source: "dict",
+																		Start: ast.Position{
+																			Column: 47,
+																			Line:   39,
+																		},
+																	},
+																	TrailingComment: nil,
+																},
+																Name: "dict",
+															},
+															Value: &ast.Identifier{
+																BaseNode: ast.BaseNode{
+																	Comments: nil,
+																	Errors:   nil,
+																	Loc: &ast.SourceLocation{
+																		End: ast.Position{
+																			Column: 58,
+																			Line:   39,
+																		},
+																		File:   "fromList_test.flux",
+																		Source: "codes",
+																		Start: ast.Position{
+																			Column: 53,
+																			Line:   39,
+																		},
+																	},
+																	TrailingComment: nil,
+																},
+																Name: "codes",
+															},
+														}, &ast.Property{
+															BaseNode: ast.BaseNode{
+																Comments: nil,
+																Errors:   nil,
+																Loc: &ast.SourceLocation{
+																	End: ast.Position{
+																		Column: 71,
+																		Line:   39,
+																	},
+																	File:   "fromList_test.flux",
+																	Source: "key: r.code",
+																	Start: ast.Position{
+																		Column: 60,
+																		Line:   39,
+																	},
+																},
+																TrailingComment: nil,
+															},
+															Key: &ast.Identifier{
+																BaseNode: ast.BaseNode{
+																	Comments: nil,
+																	Errors:   nil,
+																	Loc: &ast.SourceLocation{
+																		End: ast.Position{
+																			Column: 63,
+																			Line:   39,
+																		},
+																		File:   "fromList_test.flux",
+																		Source: "key",
+																		Start: ast.Position{
+																			Column: 60,
+																			Line:   39,
+																		},
+																	},
+																	TrailingComment: nil,
+																},
+																Name: "key",
+															},
+															Value: &ast.MemberExpression{
+																BaseNode: ast.BaseNode{
+																	Comments: nil,
+																	Errors:   nil,
+																	Loc: &ast.SourceLocation{
+																		End: ast.Position{
+																			Column: 71,
+																			Line:   39,
+																		},
+																		File:   "fromList_test.flux",
+																		Source: "r.code",
+																		Start: ast.Position{
+																			Column: 65,
+																			Line:   39,
+																		},
+																	},
+																	TrailingComment: nil,
+																},
+																Object: &ast.Identifier{
+																	BaseNode: ast.BaseNode{
+																		Comments: nil,
+																		Errors:   nil,
+																		Loc: &ast.SourceLocation{
+																			End: ast.Position{
+																				Column: 66,
+																				Line:   39,
+																			},
+																			File:   "fromList_test.flux",
+																			Source: "r",
+																			Start: ast.Position{
+																				Column: 65,
+																				Line:   39,
+																			},
+																		},
+																		TrailingComment: nil,
+																	},
+																	Name: "r",
+																},
+																Property: &ast.Identifier{
+																	BaseNode: ast.BaseNode{
+																		Comments: nil,
+																		Errors:   nil,
+																		Loc: &ast.SourceLocation{
+																			End: ast.Position{
+																				Column: 71,
+																				Line:   39,
+																			},
+																			File:   "fromList_test.flux",
+																			Source: "code",
+																			Start: ast.Position{
+																				Column: 67,
+																				Line:   39,
+																			},
+																		},
+																		TrailingComment: nil,
+																	},
+																	Name: "code",
+																},
+															},
+														}, &ast.Property{
+															BaseNode: ast.BaseNode{
+																Comments: nil,
+																Errors:   nil,
+																Loc: &ast.SourceLocation{
+																	End: ast.Position{
+																		Column: 84,
+																		Line:   39,
+																	},
+																	File:   "fromList_test.flux",
+																	Source: "default: \"\"",
+																	Start: ast.Position{
+																		Column: 73,
+																		Line:   39,
+																	},
+																},
+																TrailingComment: nil,
+															},
+															Key: &ast.Identifier{
+																BaseNode: ast.BaseNode{
+																	Comments: nil,
+																	Errors:   nil,
+																	Loc: &ast.SourceLocation{
+																		End: ast.Position{
+																			Column: 80,
+																			Line:   39,
+																		},
+																		File:   "fromList_test.flux",
+																		Source: "default",
+																		Start: ast.Position{
+																			Column: 73,
+																			Line:   39,
+																		},
+																	},
+																	TrailingComment: nil,
+																},
+																Name: "default",
+															},
+															Value: &ast.StringLiteral{
+																BaseNode: ast.BaseNode{
+																	Comments: nil,
+																	Errors:   nil,
+																	Loc: &ast.SourceLocation{
+																		End: ast.Position{
+																			Column: 84,
+																			Line:   39,
+																		},
+																		File:   "fromList_test.flux",
+																		Source: "\"\"",
+																		Start: ast.Position{
+																			Column: 82,
+																			Line:   39,
+																		},
+																	},
+																	TrailingComment: nil,
+																},
+																Value: "",
+															},
+														}},
+														With: nil,
+													}},
+													BaseNode: ast.BaseNode{
+														Comments: nil,
+														Errors:   nil,
+														Loc: &ast.SourceLocation{
+															End: ast.Position{
+																Column: 85,
+																Line:   39,
+															},
+															File:   "fromList_test.flux",
+															Source: "dict.get(dict: codes, key: r.code, default: \"\")",
+															Start: ast.Position{
+																Column: 38,
+																Line:   39,
+															},
+														},
+														TrailingComment: nil,
+													},
+													Callee: &ast.MemberExpression{
+														BaseNode: ast.BaseNode{
+															Comments: nil,
+															Errors:   nil,
+															Loc: &ast.SourceLocation{
+																End: ast.Position{
+																	Column: 46,
+																	Line:   39,
+																},
+																File:   "fromList_test.flux",
+																Source: "dict.get",
+																Start: ast.Position{
+																	Column: 38,
+																	Line:   39,
+																},
+															},
+															TrailingComment: nil,
+														},
+														Object: &ast.Identifier{
+															BaseNode: ast.BaseNode{
+																Comments: nil,
+																Errors:   nil,
+																Loc: &ast.SourceLocation{
+																	End: ast.Position{
+																		Column: 42,
+																		Line:   39,
+																	},
+																	File:   "fromList_test.flux",
+																	Source: "dict",
+																	Start: ast.Position{
+																		Column: 38,
+																		Line:   39,
+																	},
+																},
+																TrailingComment: nil,
+															},
+															Name: "dict",
+														},
+														Property: &ast.Identifier{
+															BaseNode: ast.BaseNode{
+																Comments: nil,
+																Errors:   nil,
+																Loc: &ast.SourceLocation{
+																	End: ast.Position{
+																		Column: 46,
+																		Line:   39,
+																	},
+																	File:   "fromList_test.flux",
+																	Source: "get",
+																	Start: ast.Position{
+																		Column: 43,
+																		Line:   39,
+																	},
+																},
+																TrailingComment: nil,
+															},
+															Name: "get",
+														},
+													},
+												},
+											}},
+											With: &ast.Identifier{
+												BaseNode: ast.BaseNode{
+													Comments: nil,
+													Errors:   nil,
+													Loc: &ast.SourceLocation{
+														End: ast.Position{
+															Column: 24,
+															Line:   39,
+														},
+														File:   "fromList_test.flux",
+														Source: "r",
+														Start: ast.Position{
+															Column: 23,
+															Line:   39,
+														},
+													},
+													TrailingComment: nil,
+												},
+												Name: "r",
+											},
+										},
+									},
+									Params: []*ast.Property{&ast.Property{
+										BaseNode: ast.BaseNode{
+											Comments: nil,
+											Errors:   nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 16,
+													Line:   39,
+												},
+												File:   "fromList_test.flux",
+												Source: "r",
+												Start: ast.Position{
+													Column: 15,
+													Line:   39,
+												},
+											},
+											TrailingComment: nil,
+										},
+										Key: &ast.Identifier{
+											BaseNode: ast.BaseNode{
+												Comments: nil,
+												Errors:   nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 16,
+														Line:   39,
+													},
+													File:   "fromList_test.flux",
+													Source: "r",
+													Start: ast.Position{
+														Column: 15,
+														Line:   39,
+													},
+												},
+												TrailingComment: nil,
+											},
+											Name: "r",
+										},
+										Value: nil,
+									}},
+								},
+							}},
+							With: nil,
+						}},
+						BaseNode: ast.BaseNode{
+							Comments: nil,
+							Errors:   nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 88,
+									Line:   39,
+								},
+								File:   "fromList_test.flux",
+								Source: "map(fn: (r) => ({r with status: dict.get(dict: codes, key: r.code, default: \"\")}))",
+								Start: ast.Position{
+									Column: 6,
+									Line:   39,
+								},
+							},
+							TrailingComment: nil,
+						},
+						Callee: &ast.Identifier{
+							BaseNode: ast.BaseNode{
+								Comments: nil,
+								Errors:   nil,
+								Loc: &ast.SourceLocation{
+									End: ast.Position{
+										Column: 9,
+										Line:   39,
+									},
+									File:   "fromList_test.flux",
+									Source: "map",
+									Start: ast.Position{
+										Column: 6,
+										Line:   39,
+									},
+								},
+								TrailingComment: nil,
+							},
+							Name: "map",
+						},
+					},
+				},
+				Params: []*ast.Property{&ast.Property{
+					BaseNode: ast.BaseNode{
+						Comments: nil,
+						Errors:   nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 23,
+								Line:   36,
+							},
+							File:   "fromList_test.flux",
+							Source: "table=<-",
+							Start: ast.Position{
+								Column: 15,
+								Line:   36,
+							},
+						},
+						TrailingComment: nil,
+					},
+					Key: &ast.Identifier{
+						BaseNode: ast.BaseNode{
+							Comments: nil,
+							Errors:   nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 20,
+									Line:   36,
+								},
+								File:   "fromList_test.flux",
+								Source: "table",
+								Start: ast.Position{
+									Column: 15,
+									Line:   36,
+								},
+							},
+							TrailingComment: nil,
+						},
+						Name: "table",
+					},
+					Value: &ast.PipeLiteral{BaseNode: ast.BaseNode{
+						Comments: nil,
+						Errors:   nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 23,
+								Line:   36,
+							},
+							File:   "fromList_test.flux",
+							Source: "<-",
+							Start: ast.Position{
+								Column: 21,
+								Line:   36,
+							},
+						},
+						TrailingComment: nil,
+					}},
+				}},
+			},
+		}, &ast.TestStatement{
+			Assignment: &ast.VariableAssignment{
+				BaseNode: ast.BaseNode{
+					Comments: nil,
+					Errors:   nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 98,
+							Line:   42,
+						},
+						File:   "fromList_test.flux",
+						Source: "_fromList = () =>\n\t({input: testing.loadStorage(csv: inData), want: testing.loadMem(csv: outData), fn: t_fromList})",
+						Start: ast.Position{
+							Column: 6,
+							Line:   41,
+						},
+					},
+					TrailingComment: nil,
+				},
+				ID: &ast.Identifier{
+					BaseNode: ast.BaseNode{
+						Comments: nil,
+						Errors:   nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 15,
+								Line:   41,
+							},
+							File:   "fromList_test.flux",
+							Source: "_fromList",
+							Start: ast.Position{
+								Column: 6,
+								Line:   41,
+							},
+						},
+						TrailingComment: nil,
+					},
+					Name: "_fromList",
+				},
+				Init: &ast.FunctionExpression{
+					BaseNode: ast.BaseNode{
+						Comments: nil,
+						Errors:   nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 98,
+								Line:   42,
+							},
+							File:   "fromList_test.flux",
+							Source: "() =>\n\t({input: testing.loadStorage(csv: inData), want: testing.loadMem(csv: outData), fn: t_fromList})",
+							Start: ast.Position{
+								Column: 18,
+								Line:   41,
+							},
+						},
+						TrailingComment: nil,
+					},
+					Body: &ast.ParenExpression{
+						BaseNode: ast.BaseNode{
+							Comments: nil,
+							Errors:   nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 98,
+									Line:   42,
+								},
+								File:   "fromList_test.flux",
+								Source: "({input: testing.loadStorage(csv: inData), want: testing.loadMem(csv: outData), fn: t_fromList})",
+								Start: ast.Position{
+									Column: 2,
+									Line:   42,
+								},
+							},
+							TrailingComment: nil,
+						},
+						Expression: &ast.ObjectExpression{
+							BaseNode: ast.BaseNode{
+								Comments: nil,
+								Errors:   nil,
+								Loc: &ast.SourceLocation{
+									End: ast.Position{
+										Column: 97,
+										Line:   42,
+									},
+									File:   "fromList_test.flux",
+									Source: "{input: testing.loadStorage(csv: inData), want: testing.loadMem(csv: outData), fn: t_fromList}",
+									Start: ast.Position{
+										Column: 3,
+										Line:   42,
+									},
+								},
+								TrailingComment: nil,
+							},
+							Properties: []*ast.Property{&ast.Property{
+								BaseNode: ast.BaseNode{
+									Comments: nil,
+									Errors:   nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 43,
+											Line:   42,
+										},
+										File:   "fromList_test.flux",
+										Source: "input: testing.loadStorage(csv: inData)",
+										Start: ast.Position{
+											Column: 4,
+											Line:   42,
+										},
+									},
+									TrailingComment: nil,
+								},
+								Key: &ast.Identifier{
+									BaseNode: ast.BaseNode{
+										Comments: nil,
+										Errors:   nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 9,
+												Line:   42,
+											},
+											File:   "fromList_test.flux",
+											Source: "input",
+											Start: ast.Position{
+												Column: 4,
+												Line:   42,
+											},
+										},
+										TrailingComment: nil,
+									},
+									Name: "input",
+								},
+								Value: &ast.CallExpression{
+									Arguments: []ast.Expression{&ast.ObjectExpression{
+										BaseNode: ast.BaseNode{
+											Comments: nil,
+											Errors:   nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 42,
+													Line:   42,
+												},
+												File:   "fromList_test.flux",
+												Source: "csv: inData",
+												Start: ast.Position{
+													Column: 31,
+													Line:   42,
+												},
+											},
+											TrailingComment: nil,
+										},
+										Properties: []*ast.Property{&ast.Property{
+											BaseNode: ast.BaseNode{
+												Comments: nil,
+												Errors:   nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 42,
+														Line:   42,
+													},
+													File:   "fromList_test.flux",
+													Source: "csv: inData",
+													Start: ast.Position{
+														Column: 31,
+														Line:   42,
+													},
+												},
+												TrailingComment: nil,
+											},
+											Key: &ast.Identifier{
+												BaseNode: ast.BaseNode{
+													Comments: nil,
+													Errors:   nil,
+													Loc: &ast.SourceLocation{
+														End: ast.Position{
+															Column: 34,
+															Line:   42,
+														},
+														File:   "fromList_test.flux",
+														Source: "csv",
+														Start: ast.Position{
+															Column: 31,
+															Line:   42,
+														},
+													},
+													TrailingComment: nil,
+												},
+												Name: "csv",
+											},
+											Value: &ast.Identifier{
+												BaseNode: ast.BaseNode{
+													Comments: nil,
+													Errors:   nil,
+													Loc: &ast.SourceLocation{
+														End: ast.Position{
+															Column: 42,
+															Line:   42,
+														},
+														File:   "fromList_test.flux",
+														Source: "inData",
+														Start: ast.Position{
+															Column: 36,
+															Line:   42,
+														},
+													},
+													TrailingComment: nil,
+												},
+												Name: "inData",
+											},
+										}},
+										With: nil,
+									}},
+									BaseNode: ast.BaseNode{
+										Comments: nil,
+										Errors:   nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 43,
+												Line:   42,
+											},
+											File:   "fromList_test.flux",
+											Source: "testing.loadStorage(csv: inData)",
+											Start: ast.Position{
+												Column: 11,
+												Line:   42,
+											},
+										},
+										TrailingComment: nil,
+									},
+									Callee: &ast.MemberExpression{
+										BaseNode: ast.BaseNode{
+											Comments: nil,
+											Errors:   nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 30,
+													Line:   42,
+												},
+												File:   "fromList_test.flux",
+												Source: "testing.loadStorage",
+												Start: ast.Position{
+													Column: 11,
+													Line:   42,
+												},
+											},
+											TrailingComment: nil,
+										},
+										Object: &ast.Identifier{
+											BaseNode: ast.BaseNode{
+												Comments: nil,
+												Errors:   nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 18,
+														Line:   42,
+													},
+													File:   "fromList_test.flux",
+													Source: "testing",
+													Start: ast.Position{
+														Column: 11,
+														Line:   42,
+													},
+												},
+												TrailingComment: nil,
+											},
+											Name: "testing",
+										},
+										Property: &ast.Identifier{
+											BaseNode: ast.BaseNode{
+												Comments: nil,
+												Errors:   nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 30,
+														Line:   42,
+													},
+													File:   "fromList_test.flux",
+													Source: "loadStorage",
+													Start: ast.Position{
+														Column: 19,
+														Line:   42,
+													},
+												},
+												TrailingComment: nil,
+											},
+											Name: "loadStorage",
+										},
+									},
+								},
+							}, &ast.Property{
+								BaseNode: ast.BaseNode{
+									Comments: nil,
+									Errors:   nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 80,
+											Line:   42,
+										},
+										File:   "fromList_test.flux",
+										Source: "want: testing.loadMem(csv: outData)",
+										Start: ast.Position{
+											Column: 45,
+											Line:   42,
+										},
+									},
+									TrailingComment: nil,
+								},
+								Key: &ast.Identifier{
+									BaseNode: ast.BaseNode{
+										Comments: nil,
+										Errors:   nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 49,
+												Line:   42,
+											},
+											File:   "fromList_test.flux",
+											Source: "want",
+											Start: ast.Position{
+												Column: 45,
+												Line:   42,
+											},
+										},
+										TrailingComment: nil,
+									},
+									Name: "want",
+								},
+								Value: &ast.CallExpression{
+									Arguments: []ast.Expression{&ast.ObjectExpression{
+										BaseNode: ast.BaseNode{
+											Comments: nil,
+											Errors:   nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 79,
+													Line:   42,
+												},
+												File:   "fromList_test.flux",
+												Source: "csv: outData",
+												Start: ast.Position{
+													Column: 67,
+													Line:   42,
+												},
+											},
+											TrailingComment: nil,
+										},
+										Properties: []*ast.Property{&ast.Property{
+											BaseNode: ast.BaseNode{
+												Comments: nil,
+												Errors:   nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 79,
+														Line:   42,
+													},
+													File:   "fromList_test.flux",
+													Source: "csv: outData",
+													Start: ast.Position{
+														Column: 67,
+														Line:   42,
+													},
+												},
+												TrailingComment: nil,
+											},
+											Key: &ast.Identifier{
+												BaseNode: ast.BaseNode{
+													Comments: nil,
+													Errors:   nil,
+													Loc: &ast.SourceLocation{
+														End: ast.Position{
+															Column: 70,
+															Line:   42,
+														},
+														File:   "fromList_test.flux",
+														Source: "csv",
+														Start: ast.Position{
+															Column: 67,
+															Line:   42,
+														},
+													},
+													TrailingComment: nil,
+												},
+												Name: "csv",
+											},
+											Value: &ast.Identifier{
+												BaseNode: ast.BaseNode{
+													Comments: nil,
+													Errors:   nil,
+													Loc: &ast.SourceLocation{
+														End: ast.Position{
+															Column: 79,
+															Line:   42,
+														},
+														File:   "fromList_test.flux",
+														Source: "outData",
+														Start: ast.Position{
+															Column: 72,
+															Line:   42,
+														},
+													},
+													TrailingComment: nil,
+												},
+												Name: "outData",
+											},
+										}},
+										With: nil,
+									}},
+									BaseNode: ast.BaseNode{
+										Comments: nil,
+										Errors:   nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 80,
+												Line:   42,
+											},
+											File:   "fromList_test.flux",
+											Source: "testing.loadMem(csv: outData)",
+											Start: ast.Position{
+												Column: 51,
+												Line:   42,
+											},
+										},
+										TrailingComment: nil,
+									},
+									Callee: &ast.MemberExpression{
+										BaseNode: ast.BaseNode{
+											Comments: nil,
+											Errors:   nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 66,
+													Line:   42,
+												},
+												File:   "fromList_test.flux",
+												Source: "testing.loadMem",
+												Start: ast.Position{
+													Column: 51,
+													Line:   42,
+												},
+											},
+											TrailingComment: nil,
+										},
+										Object: &ast.Identifier{
+											BaseNode: ast.BaseNode{
+												Comments: nil,
+												Errors:   nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 58,
+														Line:   42,
+													},
+													File:   "fromList_test.flux",
+													Source: "testing",
+													Start: ast.Position{
+														Column: 51,
+														Line:   42,
+													},
+												},
+												TrailingComment: nil,
+											},
+											Name: "testing",
+										},
+										Property: &ast.Identifier{
+											BaseNode: ast.BaseNode{
+												Comments: nil,
+												Errors:   nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 66,
+														Line:   42,
+													},
+													File:   "fromList_test.flux",
+													Source: "loadMem",
+													Start: ast.Position{
+														Column: 59,
+														Line:   42,
+													},
+												},
+												TrailingComment: nil,
+											},
+											Name: "loadMem",
+										},
+									},
+								},
+							}, &ast.Property{
+								BaseNode: ast.BaseNode{
+									Comments: nil,
+									Errors:   nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 96,
+											Line:   42,
+										},
+										File:   "fromList_test.flux",
+										Source: "fn: t_fromList",
+										Start: ast.Position{
+											Column: 82,
+											Line:   42,
+										},
+									},
+									TrailingComment: nil,
+								},
+								Key: &ast.Identifier{
+									BaseNode: ast.BaseNode{
+										Comments: nil,
+										Errors:   nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 84,
+												Line:   42,
+											},
+											File:   "fromList_test.flux",
+											Source: "fn",
+											Start: ast.Position{
+												Column: 82,
+												Line:   42,
+											},
+										},
+										TrailingComment: nil,
+									},
+									Name: "fn",
+								},
+								Value: &ast.Identifier{
+									BaseNode: ast.BaseNode{
+										Comments: nil,
+										Errors:   nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 96,
+												Line:   42,
+											},
+											File:   "fromList_test.flux",
+											Source: "t_fromList",
+											Start: ast.Position{
+												Column: 86,
+												Line:   42,
+											},
+										},
+										TrailingComment: nil,
+									},
+									Name: "t_fromList",
+								},
+							}},
+							With: nil,
+						},
+					},
+					Params: nil,
+				},
+			},
+			BaseNode: ast.BaseNode{
+				Comments: nil,
+				Errors:   nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 98,
+						Line:   42,
+					},
+					File:   "fromList_test.flux",
+					Source: "test _fromList = () =>\n\t({input: testing.loadStorage(csv: inData), want: testing.loadMem(csv: outData), fn: t_fromList})",
+					Start: ast.Position{
+						Column: 1,
+						Line:   41,
+					},
+				},
+				TrailingComment: nil,
+			},
+		}},
+		Eof: nil,
+		Imports: []*ast.ImportDeclaration{&ast.ImportDeclaration{
+			As: nil,
+			BaseNode: ast.BaseNode{
+				Comments: nil,
+				Errors:   nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 17,
+						Line:   3,
+					},
+					File:   "fromList_test.flux",
+					Source: "import \"testing\"",
+					Start: ast.Position{
+						Column: 1,
+						Line:   3,
+					},
+				},
+				TrailingComment: nil,
+			},
+			Path: &ast.StringLiteral{
+				BaseNode: ast.BaseNode{
+					Comments: nil,
+					Errors:   nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 17,
+							Line:   3,
+						},
+						File:   "fromList_test.flux",
+						Source: "\"testing\"",
+						Start: ast.Position{
+							Column: 8,
+							Line:   3,
+						},
+					},
+					TrailingComment: nil,
+				},
+				Value: "testing",
+			},
+		}, &ast.ImportDeclaration{
+			As: nil,
+			BaseNode: ast.BaseNode{
+				Comments: nil,
+				Errors:   nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 14,
+						Line:   4,
+					},
+					File:   "fromList_test.flux",
+					Source: "import \"dict\"",
+					Start: ast.Position{
+						Column: 1,
+						Line:   4,
+					},
+				},
+				TrailingComment: nil,
+			},
+			Path: &ast.StringLiteral{
+				BaseNode: ast.BaseNode{
+					Comments: nil,
+					Errors:   nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 14,
+							Line:   4,
+						},
+						File:   "fromList_test.flux",
+						Source: "\"dict\"",
+						Start: ast.Position{
+							Column: 8,
+							Line:   4,
+						},
+					},
+					TrailingComment: nil,
+				},
+				Value: "dict",
+			},
+		}},
+		Metadata: "parser-type=go",
+		Name:     "fromList_test.flux",
+		Package: &ast.PackageClause{
+			BaseNode: ast.BaseNode{
+				Comments: nil,
+				Errors:   nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 18,
+						Line:   1,
+					},
+					File:   "fromList_test.flux",
+					Source: "package dict_test",
+					Start: ast.Position{
+						Column: 1,
+						Line:   1,
+					},
+				},
+				TrailingComment: nil,
+			},
+			Name: &ast.Identifier{
+				BaseNode: ast.BaseNode{
+					Comments: nil,
+					Errors:   nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 18,
+							Line:   1,
+						},
+						File:   "fromList_test.flux",
+						Source: "dict_test",
+						Start: ast.Position{
+							Column: 9,
+							Line:   1,
+						},
+					},
+					TrailingComment: nil,
+				},
+				Name: "dict_test",
+			},
+		},
+	}, &ast.File{
+		BaseNode: ast.BaseNode{
+			Comments: nil,
+			Errors:   nil,
+			Loc: &ast.SourceLocation{
+				End: ast.Position{
+					Column: 93,
+					Line:   49,
+				},
+				File:   "get_test.flux",
+				Source: "package dict_test\n\nimport \"testing\"\nimport \"dict\"\n\noption now = () => (2030-01-01T00:00:00Z)\n\ninData = \"\n#datatype,string,long,dateTime:RFC3339,long,string,string,string\n#group,false,false,false,false,true,true,true\n#default,_result,,,,,,\n,result,table,_time,_value,_field,_measurement,host\n,,0,2018-05-22T19:53:26Z,15204688,io_time,diskio,host.a\n,,0,2018-05-22T19:53:36Z,15204894,io_time,diskio,host.a\n,,1,2018-05-22T19:53:26Z,648,io_time,diskio,host.b\n,,1,2018-05-22T19:53:36Z,648,io_time,diskio,host.b\n,,2,2018-05-22T19:53:26Z,17,io_time,diskio,host.c\n,,2,2018-05-22T19:53:36Z,18,io_time,diskio,host.c\n\"\n\noutData = \"\n#datatype,string,long,dateTime:RFC3339,dateTime:RFC3339,string,string,string,dateTime:RFC3339,long,string\n#group,false,false,true,true,true,true,true,false,false,false\n#default,_result,,,,,,,,,\n,result,table,_start,_stop,_measurement,_field,host,_time,_value,name\n,,0,2018-05-20T19:53:26Z,2030-01-01T00:00:00Z,diskio,io_time,host.a,2018-05-22T19:53:26Z,15204688,alpha\n,,0,2018-05-20T19:53:26Z,2030-01-01T00:00:00Z,diskio,io_time,host.a,2018-05-22T19:53:36Z,15204894,alpha\n,,1,2018-05-20T19:53:26Z,2030-01-01T00:00:00Z,diskio,io_time,host.b,2018-05-22T19:53:26Z,648,bravo\n,,1,2018-05-20T19:53:26Z,2030-01-01T00:00:00Z,diskio,io_time,host.b,2018-05-22T19:53:36Z,648,bravo\n,,2,2018-05-20T19:53:26Z,2030-01-01T00:00:00Z,diskio,io_time,host.c,2018-05-22T19:53:26Z,17,unknown\n,,2,2018-05-20T19:53:26Z,2030-01-01T00:00:00Z,diskio,io_time,host.c,2018-05-22T19:53:36Z,18,unknown\n\"\n\nnames = dict.remove(\n    dict: dict.insert(\n        dict: [\"host.a\": \"alpha\", \"host.c\": \"charlie\"],\n        key: \"host.b\",\n        value: \"bravo\",\n    ),\n    key: \"host.c\",\n)\n\nt_get = (table=<-) =>\n\ttable\n\t\t|> range(start: 2018-05-20T19:53:26Z)\n\t\t|> map(fn: (r) => ({r with name: dict.get(dict: names, key: r.host, default: \"unknown\")}))\n\ntest _get = () =>\n\t({input: testing.loadStorage(csv: inData), want: testing.loadMem(csv: outData), fn: t_get})",
+				Start: ast.Position{
+					Column: 1,
+					Line:   1,
+				},
+			},
+			TrailingComment: nil,
+		},
+		Body: []ast.Statement{&ast.OptionStatement{
+			Assignment: &ast.VariableAssignment{
+				BaseNode: ast.BaseNode{
+					Comments: nil,
+					Errors:   nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 42,
+							Line:   6,
+						},
+						File:   "get_test.flux",
+						Source: "now = () => (2030-01-01T00:00:00Z)",
+						Start: ast.Position{
+							Column: 8,
+							Line:   6,
+						},
+					},
+					TrailingComment: nil,
+				},
+				ID: &ast.Identifier{
+					BaseNode: ast.BaseNode{
+						Comments: nil,
+						Errors:   nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 11,
+								Line:   6,
+							},
+							File:   "get_test.flux",
+							Source: "now",
+							Start: ast.Position{
+								Column: 8,
+								Line:   6,
+							},
+						},
+						TrailingComment: nil,
+					},
+					Name: "now",
+				},
+				Init: &ast.FunctionExpression{
+					BaseNode: ast.BaseNode{
+						Comments: nil,
+						Errors:   nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 42,
+								Line:   6,
+							},
+							File:   "get_test.flux",
+							Source: "() => (2030-01-01T00:00:00Z)",
+							Start: ast.Position{
+								Column: 14,
+								Line:   6,
+							},
+						},
+						TrailingComment: nil,
+					},
+					Body: &ast.ParenExpression{
+						BaseNode: ast.BaseNode{
+							Comments: nil,
+							Errors:   nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 42,
+									Line:   6,
+								},
+								File:   "get_test.flux",
+								Source: "(2030-01-01T00:00:00Z)",
+								Start: ast.Position{
+									Column: 20,
+									Line:   6,
+								},
+							},
+							TrailingComment: nil,
+						},
+						Expression: &ast.DateTimeLiteral{
+							BaseNode: ast.BaseNode{
+								Comments: nil,
+								Errors:   nil,
+								Loc: &ast.SourceLocation{
+									End: ast.Position{
+										Column: 41,
+										Line:   6,
+									},
+									File:   "get_test.flux",
+									Source: "2030-01-01T00:00:00Z",
+									Start: ast.Position{
+										Column: 21,
+										Line:   6,
+									},
+								},
+								TrailingComment: nil,
+							},
+							Value: parser.MustParseTime("2030-01-01T00:00:00Z"),
+						},
+					},
+					Params: nil,
+				},
+			},
+			BaseNode: ast.BaseNode{
+				Comments: nil,
+				Errors:   nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 42,
+						Line:   6,
+					},
+					File:   "get_test.flux",
+					Source: "option now = () => (2030-01-01T00:00:00Z)",
+					Start: ast.Position{
+						Column: 1,
+						Line:   6,
+					},
+				},
+				TrailingComment: nil,
+			},
+		}, &ast.VariableAssignment{
+			BaseNode: ast.BaseNode{
+				Comments: nil,
+				Errors:   nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 2,
+						Line:   19,
+					},
+					File:   "get_test.flux",
+					Source: "inData = \"\n#datatype,string,long,dateTime:RFC3339,long,string,string,string\n#group,false,false,false,false,true,true,true\n#default,_result,,,,,,\n,result,table,_time,_value,_field,_measurement,host\n,,0,2018-05-22T19:53:26Z,15204688,io_time,diskio,host.a\n,,0,2018-05-22T19:53:36Z,15204894,io_time,diskio,host.a\n,,1,2018-05-22T19:53:26Z,648,io_time,diskio,host.b\n,,1,2018-05-22T19:53:36Z,648,io_time,diskio,host.b\n,,2,2018-05-22T19:53:26Z,17,io_time,diskio,host.c\n,,2,2018-05-22T19:53:36Z,18,io_time,diskio,host.c\n\"",
+					Start: ast.Position{
+						Column: 1,
+						Line:   8,
+					},
+				},
+				TrailingComment: nil,
+			},
+			ID: &ast.Identifier{
+				BaseNode: ast.BaseNode{
+					Comments: nil,
+					Errors:   nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 7,
+							Line:   8,
+						},
+						File:   "get_test.flux",
+						Source: "inData",
+						Start: ast.Position{
+							Column: 1,
+							Line:   8,
+						},
+					},
+					TrailingComment: nil,
+				},
+				Name: "inData",
+			},
+			Init: &ast.StringLiteral{
+				BaseNode: ast.BaseNode{
+					Comments: nil,
+					Errors:   nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 2,
+							Line:   19,
+						},
+						File:   "get_test.flux",
+						Source: "\"\n#datatype,string,long,dateTime:RFC3339,long,string,string,string\n#group,false,false,false,false,true,true,true\n#default,_result,,,,,,\n,result,table,_time,_value,_field,_measurement,host\n,,0,2018-05-22T19:53:26Z,15204688,io_time,diskio,host.a\n,,0,2018-05-22T19:53:36Z,15204894,io_time,diskio,host.a\n,,1,2018-05-22T19:53:26Z,648,io_time,diskio,host.b\n,,1,2018-05-22T19:53:36Z,648,io_time,diskio,host.b\n,,2,2018-05-22T19:53:26Z,17,io_time,diskio,host.c\n,,2,2018-05-22T19:53:36Z,18,io_time,diskio,host.c\n\"",
+						Start: ast.Position{
+							Column: 10,
+							Line:   8,
+						},
+					},
+					TrailingComment: nil,
+				},
+				Value: "\n#datatype,string,long,dateTime:RFC3339,long,string,string,string\n#group,false,false,false,false,true,true,true\n#default,_result,,,,,,\n,result,table,_time,_value,_field,_measurement,host\n,,0,2018-05-22T19:53:26Z,15204688,io_time,diskio,host.a\n,,0,2018-05-22T19:53:36Z,15204894,io_time,diskio,host.a\n,,1,2018-05-22T19:53:26Z,648,io_time,diskio,host.b\n,,1,2018-05-22T19:53:36Z,648,io_time,diskio,host.b\n,,2,2018-05-22T19:53:26Z,17,io_time,diskio,host.c\n,,2,2018-05-22T19:53:36Z,18,io_time,diskio,host.c\n",
+			},
+		}, &ast.VariableAssignment{
+			BaseNode: ast.BaseNode{
+				Comments: nil,
+				Errors:   nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 2,
+						Line:   32,
+					},
+					File:   "get_test.flux",
+					Source: "outData = \"\n#datatype,string,long,dateTime:RFC3339,dateTime:RFC3339,string,string,string,dateTime:RFC3339,long,string\n#group,false,false,true,true,true,true,true,false,false,false\n#default,_result,,,,,,,,,\n,result,table,_start,_stop,_measurement,_field,host,_time,_value,name\n,,0,2018-05-20T19:53:26Z,2030-01-01T00:00:00Z,diskio,io_time,host.a,2018-05-22T19:53:26Z,15204688,alpha\n,,0,2018-05-20T19:53:26Z,2030-01-01T00:00:00Z,diskio,io_time,host.a,2018-05-22T19:53:36Z,15204894,alpha\n,,1,2018-05-20T19:53:26Z,2030-01-01T00:00:00Z,diskio,io_time,host.b,2018-05-22T19:53:26Z,648,bravo\n,,1,2018-05-20T19:53:26Z,2030-01-01T00:00:00Z,diskio,io_time,host.b,2018-05-22T19:53:36Z,648,bravo\n,,2,2018-05-20T19:53:26Z,2030-01-01T00:00:00Z,diskio,io_time,host.c,2018-05-22T19:53:26Z,17,unknown\n,,2,2018-05-20T19:53:26Z,2030-01-01T00:00:00Z,diskio,io_time,host.c,2018-05-22T19:53:36Z,18,unknown\n\"",
+					Start: ast.Position{
+						Column: 1,
+						Line:   21,
+					},
+				},
+				TrailingComment: nil,
+			},
+			ID: &ast.Identifier{
+				BaseNode: ast.BaseNode{
+					Comments: nil,
+					Errors:   nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 8,
+							Line:   21,
+						},
+						File:   "get_test.flux",
+						Source: "outData",
+						Start: ast.Position{
+							Column: 1,
+							Line:   21,
+						},
+					},
+					TrailingComment: nil,
+				},
+				Name: "outData",
+			},
+			Init: &ast.StringLiteral{
+				BaseNode: ast.BaseNode{
+					Comments: nil,
+					Errors:   nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 2,
+							Line:   32,
+						},
+						File:   "get_test.flux",
+						Source: "\"\n#datatype,string,long,dateTime:RFC3339,dateTime:RFC3339,string,string,string,dateTime:RFC3339,long,string\n#group,false,false,true,true,true,true,true,false,false,false\n#default,_result,,,,,,,,,\n,result,table,_start,_stop,_measurement,_field,host,_time,_value,name\n,,0,2018-05-20T19:53:26Z,2030-01-01T00:00:00Z,diskio,io_time,host.a,2018-05-22T19:53:26Z,15204688,alpha\n,,0,2018-05-20T19:53:26Z,2030-01-01T00:00:00Z,diskio,io_time,host.a,2018-05-22T19:53:36Z,15204894,alpha\n,,1,2018-05-20T19:53:26Z,2030-01-01T00:00:00Z,diskio,io_time,host.b,2018-05-22T19:53:26Z,648,bravo\n,,1,2018-05-20T19:53:26Z,2030-01-01T00:00:00Z,diskio,io_time,host.b,2018-05-22T19:53:36Z,648,bravo\n,,2,2018-05-20T19:53:26Z,2030-01-01T00:00:00Z,diskio,io_time,host.c,2018-05-22T19:53:26Z,17,unknown\n,,2,2018-05-20T19:53:26Z,2030-01-01T00:00:00Z,diskio,io_time,host.c,2018-05-22T19:53:36Z,18,unknown\n\"",
+						Start: ast.Position{
+							Column: 11,
+							Line:   21,
+						},
+					},
+					TrailingComment: nil,
+				},
+				Value: "\n#datatype,string,long,dateTime:RFC3339,dateTime:RFC3339,string,string,string,dateTime:RFC3339,long,string\n#group,false,false,true,true,true,true,true,false,false,false\n#default,_result,,,,,,,,,\n,result,table,_start,_stop,_measurement,_field,host,_time,_value,name\n,,0,2018-05-20T19:53:26Z,2030-01-01T00:00:00Z,diskio,io_time,host.a,2018-05-22T19:53:26Z,15204688,alpha\n,,0,2018-05-20T19:53:26Z,2030-01-01T00:00:00Z,diskio,io_time,host.a,2018-05-22T19:53:36Z,15204894,alpha\n,,1,2018-05-20T19:53:26Z,2030-01-01T00:00:00Z,diskio,io_time,host.b,2018-05-22T19:53:26Z,648,bravo\n,,1,2018-05-20T19:53:26Z,2030-01-01T00:00:00Z,diskio,io_time,host.b,2018-05-22T19:53:36Z,648,bravo\n,,2,2018-05-20T19:53:26Z,2030-01-01T00:00:00Z,diskio,io_time,host.c,2018-05-22T19:53:26Z,17,unknown\n,,2,2018-05-20T19:53:26Z,2030-01-01T00:00:00Z,diskio,io_time,host.c,2018-05-22T19:53:36Z,18,unknown\n",
+			},
+		}, &ast.VariableAssignment{
+			BaseNode: ast.BaseNode{
+				Comments: nil,
+				Errors:   nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 2,
+						Line:   41,
+					},
+					File:   "get_test.flux",
+					Source: "names = dict.remove(\n    dict: dict.insert(\n        dict: [\"host.a\": \"alpha\", \"host.c\": \"charlie\"],\n        key: \"host.b\",\n        value: \"bravo\",\n    ),\n    key: \"host.c\",\n)",
+					Start: ast.Position{
+						Column: 1,
+						Line:   34,
+					},
+				},
+				TrailingComment: nil,
+			},
+			ID: &ast.Identifier{
+				BaseNode: ast.BaseNode{
+					Comments: nil,
+					Errors:   nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 6,
+							Line:   34,
+						},
+						File:   "get_test.flux",
+						Source: "names",
+						Start: ast.Position{
+							Column: 1,
+							Line:   34,
+						},
+					},
+					TrailingComment: nil,
+				},
+				Name: "names",
+			},
+			Init: &ast.CallExpression{
+				Arguments: []ast.Expression{&ast.ObjectExpression{
+					BaseNode: ast.BaseNode{
+						Comments: nil,
+						Errors:   nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 18,
+								Line:   40,
+							},
+							File:   "get_test.flux",
+							Source: "dict: dict.insert(\n        dict: [\"host.a\": \"alpha\", \"host.c\": \"charlie\"],\n        key: \"host.b\",\n        value: \"bravo\",\n    ),\n    key: \"host.c\"",
+							Start: ast.Position{
+								Column: 5,
+								Line:   35,
+							},
+						},
+						TrailingComment: nil,
+					},
+					Properties: []*ast.Property{&ast.Property{
+						BaseNode: ast.BaseNode{
+							Comments: nil,
+							Errors:   nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 6,
+									Line:   39,
+								},
+								File:   "get_test.flux",
+								Source: "dict: dict.insert(\n        dict: [\"host.a\": \"alpha\", \"host.c\": \"charlie\"],\n        key: \"host.b\",\n        value: \"bravo\",\n    )",
+								Start: ast.Position{
+									Column: 5,
+									Line:   35,
+								},
+							},
+							TrailingComment: nil,
+						},
+						Key: &ast.Identifier{
+							BaseNode: ast.BaseNode{
+								Comments: nil,
+								Errors:   nil,
+								Loc: &ast.SourceLocation{
+									End: ast.Position{
+										Column: 9,
+										Line:   35,
+									},
+									File:   "get_test.flux",
+									Source: "dict",
+									Start: ast.Position{
+										Column: 5,
+										Line:   35,
+									},
+								},
+								TrailingComment: nil,
+							},
+							Name: "dict",
+						},
+						Value: &ast.CallExpression{
+							Arguments: []ast.Expression{&ast.ObjectExpression{
+								BaseNode: ast.BaseNode{
+									Comments: nil,
+									Errors:   nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 23,
+											Line:   38,
+										},
+										File:   "get_test.flux",
+										Source: "dict: [\"host.a\": \"alpha\", \"host.c\": \"charlie\"],\n        key: \"host.b\",\n        value: \"bravo\"",
+										Start: ast.Position{
+											Column: 9,
+											Line:   36,
+										},
+									},
+									TrailingComment: nil,
+								},
+								Properties: []*ast.Property{&ast.Property{
+									BaseNode: ast.BaseNode{
+										Comments: nil,
+										Errors:   nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 55,
+												Line:   36,
+											},
+											File:   "get_test.flux",
+											Source: "dict: [\"host.a\": \"alpha\", \"host.c\": \"charlie\"]",
+											Start: ast.Position{
+												Column: 9,
+												Line:   36,
+											},
+										},
+										TrailingComment: nil,
+									},
+									Key: &ast.Identifier{
+										BaseNode: ast.BaseNode{
+											Comments: nil,
+											Errors:   nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 13,
+													Line:   36,
+												},
+												File:   "get_test.flux",
+												Source: "dict",
+												Start: ast.Position{
+													Column: 9,
+													Line:   36,
+												},
+											},
+											TrailingComment: nil,
+										},
+										Name: "dict",
+									},
+									Value: &ast.DictExpression{
+										BaseNode: ast.BaseNode{
+											Comments: nil,
+											Errors:   nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 55,
+													Line:   36,
+												},
+												File:   "get_test.flux",
+												Source: "[\"host.a\": \"alpha\", \"host.c\": \"charlie\"]",
+												Start: ast.Position{
+													Column: 15,
+													Line:   36,
+												},
+											},
+											TrailingComment: nil,
+										},
+										Elements: []*ast.DictItem{&ast.DictItem{
+											BaseNode: ast.BaseNode{
+												Comments: nil,
+												Errors:   nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 33,
+														Line:   36,
+													},
+													File:   "get_test.flux",
+													Source: "\"host.a\": \"alpha\"",
+													Start: ast.Position{
+														Column: 16,
+														Line:   36,
+													},
+												},
+												TrailingComment: nil,
+											},
+											Key: &ast.StringLiteral{
+												BaseNode: ast.BaseNode{
+													Comments: nil,
+													Errors:   nil,
+													Loc: &ast.SourceLocation{
+														End: ast.Position{
+															Column: 24,
+															Line:   36,
+														},
+														File:   "get_test.flux",
+														Source: "\"host.a\"",
+														Start: ast.Position{
+															Column: 16,
+															Line:   36,
+														},
+													},
+													TrailingComment: nil,
+												},
+												Value: "host.a",
+											},
+											Val: &ast.StringLiteral{
+												BaseNode: ast.BaseNode{
+													Comments: nil,
+													Errors:   nil,
+													Loc: &ast.SourceLocation{
+														End: ast.Position{
+															Column: 33,
+															Line:   36,
+														},
+														File:   "get_test.flux",
+														Source: "\"alpha\"",
+														Start: ast.Position{
+															Column: 26,
+															Line:   36,
+														},
+													},
+													TrailingComment: nil,
+												},
+												Value: "alpha",
+											},
+										}, &ast.DictItem{
+											BaseNode: ast.BaseNode{
+												Comments: nil,
+												Errors:   nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 54,
+														Line:   36,
+													},
+													File:   "get_test.flux",
+													Source: "\"host.c\": \"charlie\"",
+													Start: ast.Position{
+														Column: 35,
+														Line:   36,
+													},
+												},
+												TrailingComment: nil,
+											},
+											Key: &ast.StringLiteral{
+												BaseNode: ast.BaseNode{
+													Comments: nil,
+													Errors:   nil,
+													Loc: &ast.SourceLocation{
+														End: ast.Position{
+															Column: 43,
+															Line:   36,
+														},
+														File:   "get_test.flux",
+														Source: "\"host.c\"",
+														Start: ast.Position{
+															Column: 35,
+															Line:   36,
+														},
+													},
+													TrailingComment: nil,
+												},
+												Value: "host.c",
+											},
+											Val: &ast.StringLiteral{
+												BaseNode: ast.BaseNode{
+													Comments: nil,
+													Errors:   nil,
+													Loc: &ast.SourceLocation{
+														End: ast.Position{
+															Column: 54,
+															Line:   36,
+														},
+														File:   "get_test.flux",
+														Source: "\"charlie\"",
+														Start: ast.Position{
+															Column: 45,
+															Line:   36,
+														},
+													},
+													TrailingComment: nil,
+												},
+												Value: "charlie",
+											},
+										}},
+									},
+								}, &ast.Property{
+									BaseNode: ast.BaseNode{
+										Comments: nil,
+										Errors:   nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 22,
+												Line:   37,
+											},
+											File:   "get_test.flux",
+											Source: "key: \"host.b\"",
+											Start: ast.Position{
+												Column: 9,
+												Line:   37,
+											},
+										},
+										TrailingComment: nil,
+									},
+									Key: &ast.Identifier{
+										BaseNode: ast.BaseNode{
+											Comments: nil,
+											Errors:   nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 12,
+													Line:   37,
+												},
+												File:   "get_test.flux",
+												Source: "key",
+												Start: ast.Position{
+													Column: 9,
+													Line:   37,
+												},
+											},
+											TrailingComment: nil,
+										},
+										Name: "key",
+									},
+									Value: &ast.StringLiteral{
+										BaseNode: ast.BaseNode{
+											Comments: nil,
+											Errors:   nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 22,
+													Line:   37,
+												},
+												File:   "get_test.flux",
+												Source: "\"host.b\"",
+												Start: ast.Position{
+													Column: 14,
+													Line:   37,
+												},
+											},
+											TrailingComment: nil,
+										},
+										Value: "host.b",
+									},
+								}, &ast.Property{
+									BaseNode: ast.BaseNode{
+										Comments: nil,
+										Errors:   nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 23,
+												Line:   38,
+											},
+											File:   "get_test.flux",
+											Source: "value: \"bravo\"",
+											Start: ast.Position{
+												Column: 9,
+												Line:   38,
+											},
+										},
+										TrailingComment: nil,
+									},
+									Key: &ast.Identifier{
+										BaseNode: ast.BaseNode{
+											Comments: nil,
+											Errors:   nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 14,
+													Line:   38,
+												},
+												File:   "get_test.flux",
+												Source: "value",
+												Start: ast.Position{
+													Column: 9,
+													Line:   38,
+												},
+											},
+											TrailingComment: nil,
+										},
+										Name: "value",
+									},
+									Value: &ast.StringLiteral{
+										BaseNode: ast.BaseNode{
+											Comments: nil,
+											Errors:   nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 23,
+													Line:   38,
+												},
+												File:   "get_test.flux",
+												Source: "\"bravo\"",
+												Start: ast.Position{
+													Column: 16,
+													Line:   38,
+												},
+											},
+											TrailingComment: nil,
+										},
+										Value: "bravo",
+									},
+								}},
+								With: nil,
+							}},
+							BaseNode: ast.BaseNode{
+								Comments: nil,
+								Errors:   nil,
+								Loc: &ast.SourceLocation{
+									End: ast.Position{
+										Column: 6,
+										Line:   39,
+									},
+									File:   "get_test.flux",
+									Source: "dict.insert(\n        dict: [\"host.a\": \"alpha\", \"host.c\": \"charlie\"],\n        key: \"host.b\",\n        value: \"bravo\",\n    )",
+									Start: ast.Position{
+										Column: 11,
+										Line:   35,
+									},
+								},
+								TrailingComment: nil,
+							},
+							Callee: &ast.MemberExpression{
+								BaseNode: ast.BaseNode{
+									Comments: nil,
+									Errors:   nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 22,
+											Line:   35,
+										},
+										File:   "get_test.flux",
+										Source: "dict.insert",
+										Start: ast.Position{
+											Column: 11,
+											Line:   35,
+										},
+									},
+									TrailingComment: nil,
+								},
+								Object: &ast.Identifier{
+									BaseNode: ast.BaseNode{
+										Comments: nil,
+										Errors:   nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 15,
+												Line:   35,
+											},
+											File:   "get_test.flux",
+											Source: "dict",
+											Start: ast.Position{
+												Column: 11,
+												Line:   35,
+											},
+										},
+										TrailingComment: nil,
+									},
+									Name: "dict",
+								},
+								Property: &ast.Identifier{
+									BaseNode: ast.BaseNode{
+										Comments: nil,
+										Errors:   nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 22,
+												Line:   35,
+											},
+											File:   "get_test.flux",
+											Source: "insert",
+											Start: ast.Position{
+												Column: 16,
+												Line:   35,
+											},
+										},
+										TrailingComment: nil,
+									},
+									Name: "insert",
+								},
+							},
+						},
+					}, &ast.Property{
+						BaseNode: ast.BaseNode{
+							Comments: nil,
+							Errors:   nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 18,
+									Line:   40,
+								},
+								File:   "get_test.flux",
+								Source: "key: \"host.c\"",
+								Start: ast.Position{
+									Column: 5,
+									Line:   40,
+								},
+							},
+							TrailingComment: nil,
+						},
+						Key: &ast.Identifier{
+							BaseNode: ast.BaseNode{
+								Comments: nil,
+								Errors:   nil,
+								Loc: &ast.SourceLocation{
+									End: ast.Position{
+										Column: 8,
+										Line:   40,
+									},
+									File:   "get_test.flux",
+									Source: "key",
+									Start: ast.Position{
+										Column: 5,
+										Line:   40,
+									},
+								},
+								TrailingComment: nil,
+							},
+							Name: "key",
+						},
+						Value: &ast.StringLiteral{
+							BaseNode: ast.BaseNode{
+								Comments: nil,
+								Errors:   nil,
+								Loc: &ast.SourceLocation{
+									End: ast.Position{
+										Column: 18,
+										Line:   40,
+									},
+									File:   "get_test.flux",
+									Source: "\"host.c\"",
+									Start: ast.Position{
+										Column: 10,
+										Line:   40,
+									},
+								},
+								TrailingComment: nil,
+							},
+							Value: "host.c",
+						},
+					}},
+					With: nil,
+				}},
+				BaseNode: ast.BaseNode{
+					Comments: nil,
+					Errors:   nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 2,
+							Line:   41,
+						},
+						File:   "get_test.flux",
+						Source: "dict.remove(\n    dict: dict.insert(\n        dict: [\"host.a\": \"alpha\", \"host.c\": \"charlie\"],\n        key: \"host.b\",\n        value: \"bravo\",\n    ),\n    key: \"host.c\",\n)",
+						Start: ast.Position{
+							Column: 9,
+							Line:   34,
+						},
+					},
+					TrailingComment: nil,
+				},
+				Callee: &ast.MemberExpression{
+					BaseNode: ast.BaseNode{
+						Comments: nil,
+						Errors:   nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 20,
+								Line:   34,
+							},
+							File:   "get_test.flux",
+							Source: "dict.remove",
+							Start: ast.Position{
+								Column: 9,
+								Line:   34,
+							},
+						},
+						TrailingComment: nil,
+					},
+					Object: &ast.Identifier{
+						BaseNode: ast.BaseNode{
+							Comments: nil,
+							Errors:   nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 13,
+									Line:   34,
+								},
+								File:   "get_test.flux",
+								Source: "dict",
+								Start: ast.Position{
+									Column: 9,
+									Line:   34,
+								},
+							},
+							TrailingComment: nil,
+						},
+						Name: "dict",
+					},
+					Property: &ast.Identifier{
+						BaseNode: ast.BaseNode{
+							Comments: nil,
+							Errors:   nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 20,
+									Line:   34,
+								},
+								File:   "get_test.flux",
+								Source: "remove",
+								Start: ast.Position{
+									Column: 14,
+									Line:   34,
+								},
+							},
+							TrailingComment: nil,
+						},
+						Name: "remove",
+					},
+				},
+			},
+		}, &ast.VariableAssignment{
+			BaseNode: ast.BaseNode{
+				Comments: nil,
+				Errors:   nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 93,
+						Line:   46,
+					},
+					File:   "get_test.flux",
+					Source: "t_get = (table=<-) =>\n\ttable\n\t\t|> range(start: 2018-05-20T19:53:26Z)\n\t\t|> map(fn: (r) => ({r with name: dict.get(dict: names, key: r.host, default: \"unknown\")}))",
+					Start: ast.Position{
+						Column: 1,
+						Line:   43,
+					},
+				},
+				TrailingComment: nil,
+			},
+			ID: &ast.Identifier{
+				BaseNode: ast.BaseNode{
+					Comments: nil,
+					Errors:   nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 6,
+							Line:   43,
+						},
+						File:   "get_test.flux",
+						Source: "t_get",
+						Start: ast.Position{
+							Column: 1,
+							Line:   43,
+						},
+					},
+					TrailingComment: nil,
+				},
+				Name: "t_get",
+			},
+			Init: &ast.FunctionExpression{
+				BaseNode: ast.BaseNode{
+					Comments: nil,
+					Errors:   nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 93,
+							Line:   46,
+						},
+						File:   "get_test.flux",
+						Source: "(table=<-) =>\n\ttable\n\t\t|> range(start: 2018-05-20T19:53:26Z)\n\t\t|> map(fn: (r) => ({r with name: dict.get(dict: names, key: r.host, default: \"unknown\")}))",
+						Start: ast.Position{
+							Column: 9,
+							Line:   43,
+						},
+					},
+					TrailingComment: nil,
+				},
+				Body: &ast.PipeExpression{
+					Argument: &ast.PipeExpression{
+						Argument: &ast.Identifier{
+							BaseNode: ast.BaseNode{
+								Comments: nil,
+								Errors:   nil,
+								Loc: &ast.SourceLocation{
+									End: ast.Position{
+										Column: 7,
+										Line:   44,
+									},
+									File:   "get_test.flux",
+									Source: "table",
+									Start: ast.Position{
+										Column: 2,
+										Line:   44,
+									},
+								},
+								TrailingComment: nil,
+							},
+							Name: "table",
+						},
+						BaseNode: ast.BaseNode{
+							Comments: nil,
+							Errors:   nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 40,
+									Line:   45,
+								},
+								File:   "get_test.flux",
+								Source: "table\n\t\t|> range(start: 2018-05-20T19:53:26Z)",
+								Start: ast.Position{
+									Column: 2,
+									Line:   44,
+								},
+							},
+							TrailingComment: nil,
+						},
+						Call: &ast.CallExpression{
+							Arguments: []ast.Expression{&ast.ObjectExpression{
+								BaseNode: ast.BaseNode{
+									Comments: nil,
+									Errors:   nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 39,
+											Line:   45,
+										},
+										File:   "get_test.flux",
+										Source: "start: 2018-05-20T19:53:26Z",
+										Start: ast.Position{
+											Column: 12,
+											Line:   45,
+										},
+									},
+									TrailingComment: nil,
+								},
+								Properties: []*ast.Property{&ast.Property{
+									BaseNode: ast.BaseNode{
+										Comments: nil,
+										Errors:   nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 39,
+												Line:   45,
+											},
+											File:   "get_test.flux",
+											Source: "start: 2018-05-20T19:53:26Z",
+											Start: ast.Position{
+												Column: 12,
+												Line:   45,
+											},
+										},
+										TrailingComment: nil,
+									},
+									Key: &ast.Identifier{
+										BaseNode: ast.BaseNode{
+											Comments: nil,
+											Errors:   nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 17,
+													Line:   45,
+												},
+												File:   "get_test.flux",
+												Source: "start",
+												Start: ast.Position{
+													Column: 12,
+													Line:   45,
+												},
+											},
+											TrailingComment: nil,
+										},
+										Name: "start",
+									},
+									Value: &ast.DateTimeLiteral{
+										BaseNode: ast.BaseNode{
+											Comments: nil,
+											Errors:   nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 39,
+													Line:   45,
+												},
+												File:   "get_test.flux",
+												Source: "2018-05-20T19:53:26Z",
+												Start: ast.Position{
+													Column: 19,
+													Line:   45,
+												},
+											},
+											TrailingComment: nil,
+										},
+										Value: parser.MustParseTime("2018-05-20T19:53:26Z"),
+									},
+								}},
+								With: nil,
+							}},
+							BaseNode: ast.BaseNode{
+								Comments: nil,
+								Errors:   nil,
+								Loc: &ast.SourceLocation{
+									End: ast.Position{
+										Column: 40,
+										Line:   45,
+									},
+									File:   "get_test.flux",
+									Source: "range(start: 2018-05-20T19:53:26Z)",
+									Start: ast.Position{
+										Column: 6,
+										Line:   45,
+									},
+								},
+								TrailingComment: nil,
+							},
+							Callee: &ast.Identifier{
+								BaseNode: ast.BaseNode{
+									Comments: nil,
+									Errors:   nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 11,
+											Line:   45,
+										},
+										File:   "get_test.flux",
+										Source: "range",
+										Start: ast.Position{
+											Column: 6,
+											Line:   45,
+										},
+									},
+									TrailingComment: nil,
+								},
+								Name: "range",
+							},
+						},
+					},
+					BaseNode: ast.BaseNode{
+						Comments: nil,
+						Errors:   nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 93,
+								Line:   46,
+							},
+							File:   "get_test.flux",
+							Source: "table\n\t\t|> range(start: 2018-05-20T19:53:26Z)\n\t\t|> map(fn: (r) => ({r with name: dict.get(dict: names, key: r.host, default: \"unknown\")}))",
+							Start: ast.Position{
+								Column: 2,
+								Line:   44,
+							},
+						},
+						TrailingComment: nil,
+					},
+					Call: &ast.CallExpression{
+						Arguments: []ast.Expression{&ast.ObjectExpression{
+							BaseNode: ast.BaseNode{
+								Comments: nil,
+								Errors:   nil,
+								Loc: &ast.SourceLocation{
+									End: ast.Position{
+										Column: 92,
+										Line:   46,
+									},
+									File:   "get_test.flux",
+									Source: "fn: (r) => ({r with name: dict.get(dict: names, key: r.host, default: \"unknown\")})",
+									Start: ast.Position{
+										Column: 10,
+										Line:   46,
+									},
+								},
+								TrailingComment: nil,
+							},
+							Properties: []*ast.Property{&ast.Property{
+								BaseNode: ast.BaseNode{
+									Comments: nil,
+									Errors:   nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 92,
+											Line:   46,
+										},
+										File:   "get_test.flux",
+										Source: "fn: (r) => ({r with name: dict.get(dict: names, key: r.host, default: \"unknown\")})",
+										Start: ast.Position{
+											Column: 10,
+											Line:   46,
+										},
+									},
+									TrailingComment: nil,
+								},
+								Key: &ast.Identifier{
+									BaseNode: ast.BaseNode{
+										Comments: nil,
+										Errors:   nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 12,
+												Line:   46,
+											},
+											File:   "get_test.flux",
+											Source: "fn",
+											Start: ast.Position{
+												Column: 10,
+												Line:   46,
+											},
+										},
+										TrailingComment: nil,
+									},
+									Name: "fn",
+								},
+								Value: &ast.FunctionExpression{
+									BaseNode: ast.BaseNode{
+										Comments: nil,
+										Errors:   nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 92,
+												Line:   46,
+											},
+											File:   "get_test.flux",
+											Source: "(r) => ({r with name: dict.get(dict: names, key: r.host, default: \"unknown\")})",
+											Start: ast.Position{
+												Column: 14,
+												Line:   46,
+											},
+										},
+										TrailingComment: nil,
+									},
+									Body: &ast.ParenExpression{
+										BaseNode: ast.BaseNode{
+											Comments: nil,
+											Errors:   nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 92,
+													Line:   46,
+												},
+												File:   "get_test.flux",
+												Source: "({r with name: dict.get(dict: names, key: r.host, default: \"unknown\")})",
+												Start: ast.Position{
+													Column: 21,
+													Line:   46,
+												},
+											},
+											TrailingComment: nil,
+										},
+										Expression: &ast.ObjectExpression{
+											BaseNode: ast.BaseNode{
+												Comments: nil,
+												Errors:   nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 91,
+														Line:   46,
+													},
+													File:   "get_test.flux",
+													Source: "{r with name: dict.get(dict: names, key: r.host, default: \"unknown\")}",
+													Start: ast.Position{
+														Column: 22,
+														Line:   46,
+													},
+												},
+												TrailingComment: nil,
+											},
+											Properties: []*ast.Property{&ast.Property{
+												BaseNode: ast.BaseNode{
+													Comments: nil,
+													Errors:   nil,
+													Loc: &ast.SourceLocation{
+														End: ast.Position{
+															Column: 90,
+															Line:   46,
+														},
+														File:   "get_test.flux",
+														Source: "name: dict.get(dict: names, key: r.host, default: \"unknown\")",
+														Start: ast.Position{
+															Column: 30,
+															Line:   46,
+														},
+													},
+													TrailingComment: nil,
+												},
+												Key: &ast.Identifier{
+													BaseNode: ast.BaseNode{
+														Comments: nil,
+														Errors:   nil,
+														Loc: &ast.SourceLocation{
+															End: ast.Position{
+																Column: 34,
+																Line:   46,
+															},
+															File:   "get_test.flux",
+															Source: "name",
+															Start: ast.Position{
+																Column: 30,
+																Line:   46,
+															},
+														},
+														TrailingComment: nil,
+													},
+													Name: "name",
+												},
+												Value: &ast.CallExpression{
+													Arguments: []ast.Expression{&ast.ObjectExpression{
+														BaseNode: ast.BaseNode{
+															Comments: nil,
+															Errors:   nil,
+															Loc: &ast.SourceLocation{
+																End: ast.Position{
+																	Column: 89,
+																	Line:   46,
+																},
+																File:   "get_test.flux",
+																Source: "dict: names, key: r.host, default: \"unknown\"",
+																Start: ast.Position{
+																	Column: 45,
+																	Line:   46,
+																},
+															},
+															TrailingComment: nil,
+														},
+														Properties: []*ast.Property{&ast.Property{
+															BaseNode: ast.BaseNode{
+																Comments: nil,
+																Errors:   nil,
+																Loc: &ast.SourceLocation{
+																	End: ast.Position{
+																		Column: 56,
+																		Line:   46,
+																	},
+																	File:   "get_test.flux",
+																	Source: "dict: names",
+																	Start: ast.Position{
+																		Column: 45,
+																		Line:   46,
+																	},
+																},
+																TrailingComment: nil,
+															},
+															Key: &ast.Identifier{
+																BaseNode: ast.BaseNode{
+																	Comments: nil,
+																	Errors:   nil,
+																	Loc: &ast.SourceLocation{
+																		End: ast.Position{
+																			Column: 49,
+																			Line:   46,
+																		},
+																		File:   "get_test.flux",
+																		Source: "dict",
+																		Start: ast.Position{
+																			Column: 45,
+																			Line:   46,
+																		},
+																	},
+																	TrailingComment: nil,
+																},
+																Name: "dict",
+															},
+															Value: &ast.Identifier{
+																BaseNode: ast.BaseNode{
+																	Comments: nil,
+																	Errors:   nil,
+																	Loc: &ast.SourceLocation{
+																		End: ast.Position{
+																			Column: 56,
+																			Line:   46,
+																		},
+																		File:   "get_test.flux",
+																		Source: "names",
+																		Start: ast.Position{
+																			Column: 51,
+																			Line:   46,
+																		},
+																	},
+																	TrailingComment: nil,
+																},
+																Name: "names",
+															},
+														}, &ast.Property{
+															BaseNode: ast.BaseNode{
+																Comments: nil,
+																Errors:   nil,
+																Loc: &ast.SourceLocation{
+																	End: ast.Position{
+																		Column: 69,
+																		Line:   46,
+																	},
+																	File:   "get_test.flux",
+																	Source: "key: r.host",
+																	Start: ast.Position{
+																		Column: 58,
+																		Line:   46,
+																	},
+																},
+																TrailingComment: nil,
+															},
+															Key: &ast.Identifier{
+																BaseNode: ast.BaseNode{
+																	Comments: nil,
+																	Errors:   nil,
+																	Loc: &ast.SourceLocation{
+																		End: ast.Position{
+																			Column: 61,
+																			Line:   46,
+																		},
+																		File:   "get_test.flux",
+																		Source: "key",
+																		Start: ast.Position{
+																			Column: 58,
+																			Line:   46,
+																		},
+																	},
+																	TrailingComment: nil,
+																},
+																Name: "key",
+															},
+															Value: &ast.MemberExpression{
+																BaseNode: ast.BaseNode{
+																	Comments: nil,
+																	Errors:   nil,
+																	Loc: &ast.SourceLocation{
+																		End: ast.Position{
+																			Column: 69,
+																			Line:   46,
+																		},
+																		File:   "get_test.flux",
+																		Source: "r.host",
+																		Start: ast.Position{
+																			Column: 63,
+																			Line:   46,
+																		},
+																	},
+																	TrailingComment: nil,
+																},
+																Object: &ast.Identifier{
+																	BaseNode: ast.BaseNode{
+																		Comments: nil,
+																		Errors:   nil,
+																		Loc: &ast.SourceLocation{
+																			End: ast.Position{
+																				Column: 64,
+																				Line:   46,
+																			},
+																			File:   "get_test.flux",
+																			Source: "r",
+																			Start: ast.Position{
+																				Column: 63,
+																				Line:   46,
+																			},
+																		},
+																		TrailingComment: nil,
+																	},
+																	Name: "r",
+																},
+																Property: &ast.Identifier{
+																	BaseNode: ast.BaseNode{
+																		Comments: nil,
+																		Errors:   nil,
+																		Loc: &ast.SourceLocation{
+																			End: ast.Position{
+																				Column: 69,
+																				Line:   46,
+																			},
+																			File:   "get_test.flux",
+																			Source: "host",
+																			Start: ast.Position{
+																				Column: 65,
+																				Line:   46,
+																			},
+																		},
+																		TrailingComment: nil,
+																	},
+																	Name: "host",
+																},
+															},
+														}, &ast.Property{
+															BaseNode: ast.BaseNode{
+																Comments: nil,
+																Errors:   nil,
+																Loc: &ast.SourceLocation{
+																	End: ast.Position{
+																		Column: 89,
+																		Line:   46,
+																	},
+																	File:   "get_test.flux",
+																	Source: "default: \"unknown\"",
+																	Start: ast.Position{
+																		Column: 71,
+																		Line:   46,
+																	},
+																},
+																TrailingComment: nil,
+															},
+															Key: &ast.Identifier{
+																BaseNode: ast.BaseNode{
+																	Comments: nil,
+																	Errors:   nil,
+																	Loc: &ast.SourceLocation{
+																		End: ast.Position{
+																			Column: 78,
+																			Line:   46,
+																		},
+																		File:   "get_test.flux",
+																		Source: "default",
+																		Start: ast.Position{
+																			Column: 71,
+																			Line:   46,
+																		},
+																	},
+																	TrailingComment: nil,
+																},
+																Name: "default",
+															},
+															Value: &ast.StringLiteral{
+																BaseNode: ast.BaseNode{
+																	Comments: nil,
+																	Errors:   nil,
+																	Loc: &ast.SourceLocation{
+																		End: ast.Position{
+																			Column: 89,
+																			Line:   46,
+																		},
+																		File:   "get_test.flux",
+																		Source: "\"unknown\"",
+																		Start: ast.Position{
+																			Column: 80,
+																			Line:   46,
+																		},
+																	},
+																	TrailingComment: nil,
+																},
+																Value: "unknown",
+															},
+														}},
+														With: nil,
+													}},
+													BaseNode: ast.BaseNode{
+														Comments: nil,
+														Errors:   nil,
+														Loc: &ast.SourceLocation{
+															End: ast.Position{
+																Column: 90,
+																Line:   46,
+															},
+															File:   "get_test.flux",
+															Source: "dict.get(dict: names, key: r.host, default: \"unknown\")",
+															Start: ast.Position{
+																Column: 36,
+																Line:   46,
+															},
+														},
+														TrailingComment: nil,
+													},
+													Callee: &ast.MemberExpression{
+														BaseNode: ast.BaseNode{
+															Comments: nil,
+															Errors:   nil,
+															Loc: &ast.SourceLocation{
+																End: ast.Position{
+																	Column: 44,
+																	Line:   46,
+																},
+																File:   "get_test.flux",
+																Source: "dict.get",
+																Start: ast.Position{
+																	Column: 36,
+																	Line:   46,
+																},
+															},
+															TrailingComment: nil,
+														},
+														Object: &ast.Identifier{
+															BaseNode: ast.BaseNode{
+																Comments: nil,
+																Errors:   nil,
+																Loc: &ast.SourceLocation{
+																	End: ast.Position{
+																		Column: 40,
+																		Line:   46,
+																	},
+																	File:   "get_test.flux",
+																	Source: "dict",
+																	Start: ast.Position{
+																		Column: 36,
+																		Line:   46,
+																	},
+																},
+																TrailingComment: nil,
+															},
+															Name: "dict",
+														},
+														Property: &ast.Identifier{
+															BaseNode: ast.BaseNode{
+																Comments: nil,
+																Errors:   nil,
+																Loc: &ast.SourceLocation{
+																	End: ast.Position{
+																		Column: 44,
+																		Line:   46,
+																	},
+																	File:   "get_test.flux",
+																	Source: "get",
+																	Start: ast.Position{
+																		Column: 41,
+																		Line:   46,
+																	},
+																},
+																TrailingComment: nil,
+															},
+															Name: "get",
+														},
+													},
+												},
+											}},
+											With: &ast.Identifier{
+												BaseNode: ast.BaseNode{
+													Comments: nil,
+													Errors:   nil,
+													Loc: &ast.SourceLocation{
+														End: ast.Position{
+															Column: 24,
+															Line:   46,
+														},
+														File:   "get_test.flux",
+														Source: "r",
+														Start: ast.Position{
+															Column: 23,
+															Line:   46,
+														},
+													},
+													TrailingComment: nil,
+												},
+												Name: "r",
+											},
+										},
+									},
+									Params: []*ast.Property{&ast.Property{
+										BaseNode: ast.BaseNode{
+											Comments: nil,
+											Errors:   nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 16,
+													Line:   46,
+												},
+												File:   "get_test.flux",
+												Source: "r",
+												Start: ast.Position{
+													Column: 15,
+													Line:   46,
+												},
+											},
+											TrailingComment: nil,
+										},
+										Key: &ast.Identifier{
+											BaseNode: ast.BaseNode{
+												Comments: nil,
+												Errors:   nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 16,
+														Line:   46,
+													},
+													File:   "get_test.flux",
+													Source: "r",
+													Start: ast.Position{
+														Column: 15,
+														Line:   46,
+													},
+												},
+												TrailingComment: nil,
+											},
+											Name: "r",
+										},
+										Value: nil,
+									}},
+								},
+							}},
+							With: nil,
+						}},
+						BaseNode: ast.BaseNode{
+							Comments: nil,
+							Errors:   nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 93,
+									Line:   46,
+								},
+								File:   "get_test.flux",
+								Source: "map(fn: (r) => ({r with name: dict.get(dict: names, key: r.host, default: \"unknown\")}))",
+								Start: ast.Position{
+									Column: 6,
+									Line:   46,
+								},
+							},
+							TrailingComment: nil,
+						},
+						Callee: &ast.Identifier{
+							BaseNode: ast.BaseNode{
+								Comments: nil,
+								Errors:   nil,
+								Loc: &ast.SourceLocation{
+									End: ast.Position{
+										Column: 9,
+										Line:   46,
+									},
+									File:   "get_test.flux",
+									Source: "map",
+									Start: ast.Position{
+										Column: 6,
+										Line:   46,
+									},
+								},
+								TrailingComment: nil,
+							},
+							Name: "map",
+						},
+					},
+				},
+				Params: []*ast.Property{&ast.Property{
+					BaseNode: ast.BaseNode{
+						Comments: nil,
+						Errors:   nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 18,
+								Line:   43,
+							},
+							File:   "get_test.flux",
+							Source: "table=<-",
+							Start: ast.Position{
+								Column: 10,
+								Line:   43,
+							},
+						},
+						TrailingComment: nil,
+					},
+					Key: &ast.Identifier{
+						BaseNode: ast.BaseNode{
+							Comments: nil,
+							Errors:   nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 15,
+									Line:   43,
+								},
+								File:   "get_test.flux",
+								Source: "table",
+								Start: ast.Position{
+									Column: 10,
+									Line:   43,
+								},
+							},
+							TrailingComment: nil,
+						},
+						Name: "table",
+					},
+					Value: &ast.PipeLiteral{BaseNode: ast.BaseNode{
+						Comments: nil,
+						Errors:   nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 18,
+								Line:   43,
+							},
+							File:   "get_test.flux",
+							Source: "<-",
+							Start: ast.Position{
+								Column: 16,
+								Line:   43,
+							},
+						},
+						TrailingComment: nil,
+					}},
+				}},
+			},
+		}, &ast.TestStatement{
+			Assignment: &ast.VariableAssignment{
+				BaseNode: ast.BaseNode{
+					Comments: nil,
+					Errors:   nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 93,
+							Line:   49,
+						},
+						File:   "get_test.flux",
+						Source: "_get = () =>\n\t({input: testing.loadStorage(csv: inData), want: testing.loadMem(csv: outData), fn: t_get})",
+						Start: ast.Position{
+							Column: 6,
+							Line:   48,
+						},
+					},
+					TrailingComment: nil,
+				},
+				ID: &ast.Identifier{
+					BaseNode: ast.BaseNode{
+						Comments: nil,
+						Errors:   nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 10,
+								Line:   48,
+							},
+							File:   "get_test.flux",
+							Source: "_get",
+							Start: ast.Position{
+								Column: 6,
+								Line:   48,
+							},
+						},
+						TrailingComment: nil,
+					},
+					Name: "_get",
+				},
+				Init: &ast.FunctionExpression{
+					BaseNode: ast.BaseNode{
+						Comments: nil,
+						Errors:   nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 93,
+								Line:   49,
+							},
+							File:   "get_test.flux",
+							Source: "() =>\n\t({input: testing.loadStorage(csv: inData), want: testing.loadMem(csv: outData), fn: t_get})",
+							Start: ast.Position{
+								Column: 13,
+								Line:   48,
+							},
+						},
+						TrailingComment: nil,
+					},
+					Body: &ast.ParenExpression{
+						BaseNode: ast.BaseNode{
+							Comments: nil,
+							Errors:   nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 93,
+									Line:   49,
+								},
+								File:   "get_test.flux",
+								Source: "({input: testing.loadStorage(csv: inData), want: testing.loadMem(csv: outData), fn: t_get})",
+								Start: ast.Position{
+									Column: 2,
+									Line:   49,
+								},
+							},
+							TrailingComment: nil,
+						},
+						Expression: &ast.ObjectExpression{
+							BaseNode: ast.BaseNode{
+								Comments: nil,
+								Errors:   nil,
+								Loc: &ast.SourceLocation{
+									End: ast.Position{
+										Column: 92,
+										Line:   49,
+									},
+									File:   "get_test.flux",
+									Source: "{input: testing.loadStorage(csv: inData), want: testing.loadMem(csv: outData), fn: t_get}",
+									Start: ast.Position{
+										Column: 3,
+										Line:   49,
+									},
+								},
+								TrailingComment: nil,
+							},
+							Properties: []*ast.Property{&ast.Property{
+								BaseNode: ast.BaseNode{
+									Comments: nil,
+									Errors:   nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 43,
+											Line:   49,
+										},
+										File:   "get_test.flux",
+										Source: "input: testing.loadStorage(csv: inData)",
+										Start: ast.Position{
+											Column: 4,
+											Line:   49,
+										},
+									},
+									TrailingComment: nil,
+								},
+								Key: &ast.Identifier{
+									BaseNode: ast.BaseNode{
+										Comments: nil,
+										Errors:   nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 9,
+												Line:   49,
+											},
+											File:   "get_test.flux",
+											Source: "input",
+											Start: ast.Position{
+												Column: 4,
+												Line:   49,
+											},
+										},
+										TrailingComment: nil,
+									},
+									Name: "input",
+								},
+								Value: &ast.CallExpression{
+									Arguments: []ast.Expression{&ast.ObjectExpression{
+										BaseNode: ast.BaseNode{
+											Comments: nil,
+											Errors:   nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 42,
+													Line:   49,
+												},
+												File:   "get_test.flux",
+												Source: "csv: inData",
+												Start: ast.Position{
+													Column: 31,
+													Line:   49,
+												},
+											},
+											TrailingComment: nil,
+										},
+										Properties: []*ast.Property{&ast.Property{
+											BaseNode: ast.BaseNode{
+												Comments: nil,
+												Errors:   nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 42,
+														Line:   49,
+													},
+													File:   "get_test.flux",
+													Source: "csv: inData",
+													Start: ast.Position{
+														Column: 31,
+														Line:   49,
+													},
+												},
+												TrailingComment: nil,
+											},
+											Key: &ast.Identifier{
+												BaseNode: ast.BaseNode{
+													Comments: nil,
+													Errors:   nil,
+													Loc: &ast.SourceLocation{
+														End: ast.Position{
+															Column: 34,
+															Line:   49,
+														},
+														File:   "get_test.flux",
+														Source: "csv",
+														Start: ast.Position{
+															Column: 31,
+															Line:   49,
+														},
+													},
+													TrailingComment: nil,
+												},
+												Name: "csv",
+											},
+											Value: &ast.Identifier{
+												BaseNode: ast.BaseNode{
+													Comments: nil,
+													Errors:   nil,
+													Loc: &ast.SourceLocation{
+														End: ast.Position{
+															Column: 42,
+															Line:   49,
+														},
+														File:   "get_test.flux",
+														Source: "inData",
+														Start: ast.Position{
+															Column: 36,
+															Line:   49,
+														},
+													},
+													TrailingComment: nil,
+												},
+												Name: "inData",
+											},
+										}},
+										With: nil,
+									}},
+									BaseNode: ast.BaseNode{
+										Comments: nil,
+										Errors:   nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 43,
+												Line:   49,
+											},
+											File:   "get_test.flux",
+											Source: "testing.loadStorage(csv: inData)",
+											Start: ast.Position{
+												Column: 11,
+												Line:   49,
+											},
+										},
+										TrailingComment: nil,
+									},
+									Callee: &ast.MemberExpression{
+										BaseNode: ast.BaseNode{
+											Comments: nil,
+											Errors:   nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 30,
+													Line:   49,
+												},
+												File:   "get_test.flux",
+												Source: "testing.loadStorage",
+												Start: ast.Position{
+													Column: 11,
+													Line:   49,
+												},
+											},
+											TrailingComment: nil,
+										},
+										Object: &ast.Identifier{
+											BaseNode: ast.BaseNode{
+												Comments: nil,
+												Errors:   nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 18,
+														Line:   49,
+													},
+													File:   "get_test.flux",
+													Source: "testing",
+													Start: ast.Position{
+														Column: 11,
+														Line:   49,
+													},
+												},
+												TrailingComment: nil,
+											},
+											Name: "testing",
+										},
+										Property: &ast.Identifier{
+											BaseNode: ast.BaseNode{
+												Comments: nil,
+												Errors:   nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 30,
+														Line:   49,
+													},
+													File:   "get_test.flux",
+													Source: "loadStorage",
+													Start: ast.Position{
+														Column: 19,
+														Line:   49,
+													},
+												},
+												TrailingComment: nil,
+											},
+											Name: "loadStorage",
+										},
+									},
+								},
+							}, &ast.Property{
+								BaseNode: ast.BaseNode{
+									Comments: nil,
+									Errors:   nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 80,
+											Line:   49,
+										},
+										File:   "get_test.flux",
+										Source: "want: testing.loadMem(csv: outData)",
+										Start: ast.Position{
+											Column: 45,
+											Line:   49,
+										},
+									},
+									TrailingComment: nil,
+								},
+								Key: &ast.Identifier{
+									BaseNode: ast.BaseNode{
+										Comments: nil,
+										Errors:   nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 49,
+												Line:   49,
+											},
+											File:   "get_test.flux",
+											Source: "want",
+											Start: ast.Position{
+												Column: 45,
+												Line:   49,
+											},
+										},
+										TrailingComment: nil,
+									},
+									Name: "want",
+								},
+								Value: &ast.CallExpression{
+									Arguments: []ast.Expression{&ast.ObjectExpression{
+										BaseNode: ast.BaseNode{
+											Comments: nil,
+											Errors:   nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 79,
+													Line:   49,
+												},
+												File:   "get_test.flux",
+												Source: "csv: outData",
+												Start: ast.Position{
+													Column: 67,
+													Line:   49,
+												},
+											},
+											TrailingComment: nil,
+										},
+										Properties: []*ast.Property{&ast.Property{
+											BaseNode: ast.BaseNode{
+												Comments: nil,
+												Errors:   nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 79,
+														Line:   49,
+													},
+													File:   "get_test.flux",
+													Source: "csv: outData",
+													Start: ast.Position{
+														Column: 67,
+														Line:   49,
+													},
+												},
+												TrailingComment: nil,
+											},
+											Key: &ast.Identifier{
+												BaseNode: ast.BaseNode{
+													Comments: nil,
+													Errors:   nil,
+													Loc: &ast.SourceLocation{
+														End: ast.Position{
+															Column: 70,
+															Line:   49,
+														},
+														File:   "get_test.flux",
+														Source: "csv",
+														Start: ast.Position{
+															Column: 67,
+															Line:   49,
+														},
+													},
+													TrailingComment: nil,
+												},
+												Name: "csv",
+											},
+											Value: &ast.Identifier{
+												BaseNode: ast.BaseNode{
+													Comments: nil,
+													Errors:   nil,
+													Loc: &ast.SourceLocation{
+														End: ast.Position{
+															Column: 79,
+															Line:   49,
+														},
+														File:   "get_test.flux",
+														Source: "outData",
+														Start: ast.Position{
+															Column: 72,
+															Line:   49,
+														},
+													},
+													TrailingComment: nil,
+												},
+												Name: "outData",
+											},
+										}},
+										With: nil,
+									}},
+									BaseNode: ast.BaseNode{
+										Comments: nil,
+										Errors:   nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 80,
+												Line:   49,
+											},
+											File:   "get_test.flux",
+											Source: "testing.loadMem(csv: outData)",
+											Start: ast.Position{
+												Column: 51,
+												Line:   49,
+											},
+										},
+										TrailingComment: nil,
+									},
+									Callee: &ast.MemberExpression{
+										BaseNode: ast.BaseNode{
+											Comments: nil,
+											Errors:   nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 66,
+													Line:   49,
+												},
+												File:   "get_test.flux",
+												Source: "testing.loadMem",
+												Start: ast.Position{
+													Column: 51,
+													Line:   49,
+												},
+											},
+											TrailingComment: nil,
+										},
+										Object: &ast.Identifier{
+											BaseNode: ast.BaseNode{
+												Comments: nil,
+												Errors:   nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 58,
+														Line:   49,
+													},
+													File:   "get_test.flux",
+													Source: "testing",
+													Start: ast.Position{
+														Column: 51,
+														Line:   49,
+													},
+												},
+												TrailingComment: nil,
+											},
+											Name: "testing",
+										},
+										Property: &ast.Identifier{
+											BaseNode: ast.BaseNode{
+												Comments: nil,
+												Errors:   nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 66,
+														Line:   49,
+													},
+													File:   "get_test.flux",
+													Source: "loadMem",
+													Start: ast.Position{
+														Column: 59,
+														Line:   49,
+													},
+												},
+												TrailingComment: nil,
+											},
+											Name: "loadMem",
+										},
+									},
+								},
+							}, &ast.Property{
+								BaseNode: ast.BaseNode{
+									Comments: nil,
+									Errors:   nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 91,
+											Line:   49,
+										},
+										File:   "get_test.flux",
+										Source: "fn: t_get",
+										Start: ast.Position{
+											Column: 82,
+											Line:   49,
+										},
+									},
+									TrailingComment: nil,
+								},
+								Key: &ast.Identifier{
+									BaseNode: ast.BaseNode{
+										Comments: nil,
+										Errors:   nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 84,
+												Line:   49,
+											},
+											File:   "get_test.flux",
+											Source: "fn",
+											Start: ast.Position{
+												Column: 82,
+												Line:   49,
+											},
+										},
+										TrailingComment: nil,
+									},
+									Name: "fn",
+								},
+								Value: &ast.Identifier{
+									BaseNode: ast.BaseNode{
+										Comments: nil,
+										Errors:   nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 91,
+												Line:   49,
+											},
+											File:   "get_test.flux",
+											Source: "t_get",
+											Start: ast.Position{
+												Column: 86,
+												Line:   49,
+											},
+										},
+										TrailingComment: nil,
+									},
+									Name: "t_get",
+								},
+							}},
+							With: nil,
+						},
+					},
+					Params: nil,
+				},
+			},
+			BaseNode: ast.BaseNode{
+				Comments: nil,
+				Errors:   nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 93,
+						Line:   49,
+					},
+					File:   "get_test.flux",
+					Source: "test _get = () =>\n\t({input: testing.loadStorage(csv: inData), want: testing.loadMem(csv: outData), fn: t_get})",
+					Start: ast.Position{
+						Column: 1,
+						Line:   48,
+					},
+				},
+				TrailingComment: nil,
+			},
+		}},
+		Eof: nil,
+		Imports: []*ast.ImportDeclaration{&ast.ImportDeclaration{
+			As: nil,
+			BaseNode: ast.BaseNode{
+				Comments: nil,
+				Errors:   nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 17,
+						Line:   3,
+					},
+					File:   "get_test.flux",
+					Source: "import \"testing\"",
+					Start: ast.Position{
+						Column: 1,
+						Line:   3,
+					},
+				},
+				TrailingComment: nil,
+			},
+			Path: &ast.StringLiteral{
+				BaseNode: ast.BaseNode{
+					Comments: nil,
+					Errors:   nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 17,
+							Line:   3,
+						},
+						File:   "get_test.flux",
+						Source: "\"testing\"",
+						Start: ast.Position{
+							Column: 8,
+							Line:   3,
+						},
+					},
+					TrailingComment: nil,
+				},
+				Value: "testing",
+			},
+		}, &ast.ImportDeclaration{
+			As: nil,
+			BaseNode: ast.BaseNode{
+				Comments: nil,
+				Errors:   nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 14,
+						Line:   4,
+					},
+					File:   "get_test.flux",
+					Source: "import \"dict\"",
+					Start: ast.Position{
+						Column: 1,
+						Line:   4,
+					},
+				},
+				TrailingComment: nil,
+			},
+			Path: &ast.StringLiteral{
+				BaseNode: ast.BaseNode{
+					Comments: nil,
+					Errors:   nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 14,
+							Line:   4,
+						},
+						File:   "get_test.flux",
+						Source: "\"dict\"",
+						Start: ast.Position{
+							Column: 8,
+							Line:   4,
+						},
+					},
+					TrailingComment: nil,
+				},
+				Value: "dict",
+			},
+		}},
+		Metadata: "parser-type=go",
+		Name:     "get_test.flux",
+		Package: &ast.PackageClause{
+			BaseNode: ast.BaseNode{
+				Comments: nil,
+				Errors:   nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 18,
+						Line:   1,
+					},
+					File:   "get_test.flux",
+					Source: "package dict_test",
+					Start: ast.Position{
+						Column: 1,
+						Line:   1,
+					},
+				},
+				TrailingComment: nil,
+			},
+			Name: &ast.Identifier{
+				BaseNode: ast.BaseNode{
+					Comments: nil,
+					Errors:   nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 18,
+							Line:   1,
+						},
+						File:   "get_test.flux",
+						Source: "dict_test",
+						Start: ast.Position{
+							Column: 9,
+							Line:   1,
+						},
+					},
+					TrailingComment: nil,
+				},
+				Name: "dict_test",
+			},
+		},
+	}},
+	Package: "dict_test",
+	Path:    "dict",
+}}
@@ -13,12 +13,15 @@ func init() {
 
 var pkgAST = &ast.Package{
 	BaseNode: ast.BaseNode{
-		Errors: nil,
-		Loc:    nil,
+		Comments:        nil,
+		Errors:          nil,
+		Loc:             nil,
+		TrailingComment: nil,
 	},
 	Files: []*ast.File{&ast.File{
 		BaseNode: ast.BaseNode{
-			Errors: nil,
+			Comments: nil,
+			Errors:   nil,
 			Loc: &ast.SourceLocation{
 				End: ast.Position{
 					Column: 11,
@@ -31,10 +34,12 @@ var pkgAST = &ast.Package{
 					Line:   1,
 				},
 			},
+			TrailingComment: nil,
 		},
 		Body: []ast.Statement{&ast.BuiltinStatement{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 20,
@@ -47,10 +52,12 @@ var pkgAST = &ast.Package{
 						Line:   3,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 20,
@@ -63,12 +70,14 @@ var pkgAST = &ast.Package{
 							Line:   3,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "addDuration",
 			},
 		}, &ast.BuiltinStatement{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 20,
@@ -81,10 +90,12 @@ var pkgAST = &ast.Package{
 						Line:   4,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 20,
@@ -97,12 +108,14 @@ var pkgAST = &ast.Package{
 							Line:   4,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "subDuration",
 			},
 		}, &ast.BuiltinStatement{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: []ast.Comment{ast.Comment{Text: "// An experimental version of group that has mode: \"extend\""}},
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 14,
@@ -115,10 +128,12 @@ var pkgAST = &ast.Package{
 						Line:   7,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 14,
@@ -131,12 +146,14 @@ var pkgAST = &ast.Package{
 							Line:   7,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "group",
 			},
 		}, &ast.BuiltinStatement{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: []ast.Comment{ast.Comment{Text: "// objectKeys produces a list of the keys existing on the object"}},
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 19,
@@ -149,10 +166,12 @@ var pkgAST = &ast.Package{
 						Line:   10,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 19,
@@ -165,12 +184,14 @@ var pkgAST = &ast.Package{
 							Line:   10,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "objectKeys",
 			},
 		}, &ast.BuiltinStatement{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: []ast.Comment{ast.Comment{Text: "// set adds the values from the object onto each row of a table"}},
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 12,
@@ -183,10 +204,12 @@ var pkgAST = &ast.Package{
 						Line:   13,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 12,
@@ -199,12 +222,14 @@ var pkgAST = &ast.Package{
 							Line:   13,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "set",
 			},
 		}, &ast.BuiltinStatement{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: []ast.Comment{ast.Comment{Text: "// An experimental version of \"to\" that:"}, ast.Comment{Text: "// - Expects pivoted data"}, ast.Comment{Text: "// - Any column in the group key is made a tag in storage"}, ast.Comment{Text: "// - All other columns are fields"}, ast.Comment{Text: "// - An error will be thrown for incompatible data types"}},
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 11,
@@ -217,10 +242,12 @@ var pkgAST = &ast.Package{
 						Line:   20,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 11,
@@ -233,16 +260,19 @@ var pkgAST = &ast.Package{
 							Line:   20,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "to",
 			},
 		}},
+		Eof:      nil,
 		Imports:  nil,
 		Metadata: "parser-type=go",
 		Name:     "experimental.flux",
 		Package: &ast.PackageClause{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 21,
@@ -255,10 +285,12 @@ var pkgAST = &ast.Package{
 						Line:   1,
 					},
 				},
+				TrailingComment: nil,
 			},
 			Name: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 21,
@@ -271,6 +303,7 @@ var pkgAST = &ast.Package{
 							Line:   1,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "experimental",
 			},
@@ -9,12 +9,15 @@ import (
 
 var FluxTestPackages = []*ast.Package{&ast.Package{
 	BaseNode: ast.BaseNode{
-		Errors: nil,
-		Loc:    nil,
+		Comments:        nil,
+		Errors:          nil,
+		Loc:             nil,
+		TrailingComment: nil,
 	},
 	Files: []*ast.File{&ast.File{
 		BaseNode: ast.BaseNode{
-			Errors: nil,
+			Comments: nil,
+			Errors:   nil,
 			Loc: &ast.SourceLocation{
 				End: ast.Position{
 					Column: 95,
@@ -27,11 +30,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 					Line:   1,
 				},
 			},
+			TrailingComment: nil,
 		},
 		Body: []ast.Statement{&ast.OptionStatement{
 			Assignment: &ast.VariableAssignment{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 42,
@@ -44,10 +49,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   6,
 						},
 					},
+					TrailingComment: nil,
 				},
 				ID: &ast.Identifier{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 11,
@@ -60,12 +67,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   6,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Name: "now",
 				},
 				Init: &ast.FunctionExpression{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 42,
@@ -78,10 +87,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   6,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Body: &ast.ParenExpression{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 42,
@@ -94,10 +105,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Line:   6,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Expression: &ast.DateTimeLiteral{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 41,
@@ -110,6 +123,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										Line:   6,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Value: parser.MustParseTime("2030-01-01T00:00:00Z"),
 						},
@@ -118,7 +132,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 				},
 			},
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 42,
@@ -131,10 +146,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   6,
 					},
 				},
+				TrailingComment: nil,
 			},
 		}, &ast.VariableAssignment{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 2,
@@ -147,10 +164,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   8,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 7,
@@ -163,12 +182,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   8,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "inData",
 			},
 			Init: &ast.StringLiteral{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 2,
@@ -181,12 +202,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   8,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Value: "\n#datatype,string,long,dateTime:RFC3339,long,string,string,string,string\n#group,false,false,false,false,true,true,true,false\n#default,_result,,,,,,,\n,result,table,_time,_value,_field,_measurement,host,name\n,,0,2018-05-22T19:53:26Z,15204688,io_time,diskio,host.local,disk0\n,,0,2018-05-22T19:53:36Z,15204894,io_time,diskio,host.local,disk0\n,,0,2018-05-22T19:53:46Z,15205102,io_time,diskio,host.local,disk0\n,,0,2018-05-22T19:53:56Z,15205226,io_time,diskio,host.local,disk0\n,,0,2018-05-22T19:54:06Z,15205499,io_time,diskio,host.local,disk0\n,,0,2018-05-22T19:54:16Z,15205755,io_time,diskio,host.local,disk0\n,,0,2018-05-22T19:53:26Z,648,io_time,diskio,host.local,disk2\n,,0,2018-05-22T19:53:36Z,648,io_time,diskio,host.local,disk2\n,,0,2018-05-22T19:53:46Z,672,io_time,diskio,host.local,disk2\n,,0,2018-05-22T19:53:56Z,648,io_time,diskio,host.local,disk2\n,,0,2018-05-22T19:54:06Z,648,io_time,diskio,host.local,disk2\n,,0,2018-05-22T19:54:16Z,648,io_time,diskio,host.local,disk2\n",
 			},
 		}, &ast.VariableAssignment{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 2,
@@ -199,10 +222,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   27,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 8,
@@ -215,12 +240,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   27,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "outData",
 			},
 			Init: &ast.StringLiteral{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 2,
@@ -233,12 +260,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   27,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Value: "\n#datatype,string,long,dateTime:RFC3339,dateTime:RFC3339,string,string,string,string,dateTime:RFC3339,long\n#group,false,false,true,true,true,true,true,true,false,false\n#default,_result,,,,,,,,,\n,result,table,_start,_stop,_measurement,_field,host,name,_time,_value\n,,0,2018-05-22T19:53:26Z,2030-01-01T00:00:00Z,diskio,io_time,host.local,disk0,2018-05-22T19:54:16Z,15205755\n,,1,2018-05-22T19:53:26Z,2030-01-01T00:00:00Z,diskio,io_time,host.local,disk2,2018-05-22T19:53:46Z,672\n",
 			},
 		}, &ast.VariableAssignment{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 11,
@@ -251,10 +280,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   36,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 8,
@@ -267,12 +298,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   36,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "t_group",
 			},
 			Init: &ast.FunctionExpression{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 11,
@@ -285,6 +318,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   36,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Body: &ast.PipeExpression{
 					Argument: &ast.PipeExpression{
@@ -292,7 +326,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Argument: &ast.PipeExpression{
 								Argument: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 7,
@@ -305,11 +340,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   37,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "table",
 								},
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 40,
@@ -322,11 +359,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   37,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Call: &ast.CallExpression{
 									Arguments: []ast.Expression{&ast.ObjectExpression{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 39,
@@ -339,10 +378,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   38,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Properties: []*ast.Property{&ast.Property{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 39,
@@ -355,10 +396,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   38,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Key: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 17,
@@ -371,12 +414,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   38,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "start",
 											},
 											Value: &ast.DateTimeLiteral{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 39,
@@ -389,6 +434,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   38,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Value: parser.MustParseTime("2018-05-22T19:53:26Z"),
 											},
@@ -396,7 +442,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										With: nil,
 									}},
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 40,
@@ -409,10 +456,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   38,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Callee: &ast.Identifier{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 11,
@@ -425,13 +474,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   38,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Name: "range",
 									},
 								},
 							},
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 59,
@@ -444,11 +495,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										Line:   37,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Call: &ast.CallExpression{
 								Arguments: []ast.Expression{&ast.ObjectExpression{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 58,
@@ -461,10 +514,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   39,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Properties: []*ast.Property{&ast.Property{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 58,
@@ -477,10 +532,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   39,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Key: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 15,
@@ -493,12 +550,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   39,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "fn",
 										},
 										Value: &ast.FunctionExpression{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 58,
@@ -511,10 +570,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   39,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Body: &ast.ParenExpression{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 58,
@@ -527,10 +588,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   40,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Expression: &ast.LogicalExpression{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 57,
@@ -543,10 +606,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																Line:   40,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Left: &ast.BinaryExpression{
 														BaseNode: ast.BaseNode{
-															Errors: nil,
+															Comments: nil,
+															Errors:   nil,
 															Loc: &ast.SourceLocation{
 																End: ast.Position{
 																	Column: 31,
@@ -559,10 +624,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																	Line:   40,
 																},
 															},
+															TrailingComment: nil,
 														},
 														Left: &ast.MemberExpression{
 															BaseNode: ast.BaseNode{
-																Errors: nil,
+																Comments: nil,
+																Errors:   nil,
 																Loc: &ast.SourceLocation{
 																	End: ast.Position{
 																		Column: 19,
@@ -575,10 +642,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																		Line:   40,
 																	},
 																},
+																TrailingComment: nil,
 															},
 															Object: &ast.Identifier{
 																BaseNode: ast.BaseNode{
-																	Errors: nil,
+																	Comments: nil,
+																	Errors:   nil,
 																	Loc: &ast.SourceLocation{
 																		End: ast.Position{
 																			Column: 6,
@@ -591,12 +660,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																			Line:   40,
 																		},
 																	},
+																	TrailingComment: nil,
 																},
 																Name: "r",
 															},
 															Property: &ast.Identifier{
 																BaseNode: ast.BaseNode{
-																	Errors: nil,
+																	Comments: nil,
+																	Errors:   nil,
 																	Loc: &ast.SourceLocation{
 																		End: ast.Position{
 																			Column: 19,
@@ -609,6 +680,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																			Line:   40,
 																		},
 																	},
+																	TrailingComment: nil,
 																},
 																Name: "_measurement",
 															},
@@ -616,7 +688,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Operator: 17,
 														Right: &ast.StringLiteral{
 															BaseNode: ast.BaseNode{
-																Errors: nil,
+																Comments: nil,
+																Errors:   nil,
 																Loc: &ast.SourceLocation{
 																	End: ast.Position{
 																		Column: 31,
@@ -629,6 +702,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																		Line:   40,
 																	},
 																},
+																TrailingComment: nil,
 															},
 															Value: "diskio",
 														},
@@ -636,7 +710,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Operator: 1,
 													Right: &ast.BinaryExpression{
 														BaseNode: ast.BaseNode{
-															Errors: nil,
+															Comments: nil,
+															Errors:   nil,
 															Loc: &ast.SourceLocation{
 																End: ast.Position{
 																	Column: 57,
@@ -649,10 +724,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																	Line:   40,
 																},
 															},
+															TrailingComment: nil,
 														},
 														Left: &ast.MemberExpression{
 															BaseNode: ast.BaseNode{
-																Errors: nil,
+																Comments: nil,
+																Errors:   nil,
 																Loc: &ast.SourceLocation{
 																	End: ast.Position{
 																		Column: 44,
@@ -665,10 +742,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																		Line:   40,
 																	},
 																},
+																TrailingComment: nil,
 															},
 															Object: &ast.Identifier{
 																BaseNode: ast.BaseNode{
-																	Errors: nil,
+																	Comments: nil,
+																	Errors:   nil,
 																	Loc: &ast.SourceLocation{
 																		End: ast.Position{
 																			Column: 37,
@@ -681,12 +760,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																			Line:   40,
 																		},
 																	},
+																	TrailingComment: nil,
 																},
 																Name: "r",
 															},
 															Property: &ast.Identifier{
 																BaseNode: ast.BaseNode{
-																	Errors: nil,
+																	Comments: nil,
+																	Errors:   nil,
 																	Loc: &ast.SourceLocation{
 																		End: ast.Position{
 																			Column: 44,
@@ -699,6 +780,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																			Line:   40,
 																		},
 																	},
+																	TrailingComment: nil,
 																},
 																Name: "_field",
 															},
@@ -706,7 +788,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Operator: 17,
 														Right: &ast.StringLiteral{
 															BaseNode: ast.BaseNode{
-																Errors: nil,
+																Comments: nil,
+																Errors:   nil,
 																Loc: &ast.SourceLocation{
 																	End: ast.Position{
 																		Column: 57,
@@ -719,6 +802,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																		Line:   40,
 																	},
 																},
+																TrailingComment: nil,
 															},
 															Value: "io_time",
 														},
@@ -727,7 +811,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											},
 											Params: []*ast.Property{&ast.Property{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 19,
@@ -740,10 +825,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   39,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Key: &ast.Identifier{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 19,
@@ -756,6 +843,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																Line:   39,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Name: "r",
 												},
@@ -766,7 +854,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									With: nil,
 								}},
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 59,
@@ -779,10 +868,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   39,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Callee: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 12,
@@ -795,13 +886,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   39,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "filter",
 								},
 							},
 						},
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 59,
@@ -814,11 +907,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Line:   37,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Call: &ast.CallExpression{
 							Arguments: []ast.Expression{&ast.ObjectExpression{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 58,
@@ -831,10 +926,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   41,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Properties: []*ast.Property{&ast.Property{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 39,
@@ -847,10 +944,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   41,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Key: &ast.Identifier{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 29,
@@ -863,12 +962,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   41,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Name: "mode",
 									},
 									Value: &ast.StringLiteral{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 39,
@@ -881,12 +982,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   41,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Value: "extend",
 									},
 								}, &ast.Property{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 58,
@@ -899,10 +1002,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   41,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Key: &ast.Identifier{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 48,
@@ -915,12 +1020,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   41,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Name: "columns",
 									},
 									Value: &ast.ArrayExpression{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 58,
@@ -933,10 +1040,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   41,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Elements: []ast.Expression{&ast.StringLiteral{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 57,
@@ -949,6 +1058,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   41,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Value: "name",
 										}},
@@ -957,7 +1067,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								With: nil,
 							}},
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 59,
@@ -970,10 +1081,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										Line:   41,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Callee: &ast.MemberExpression{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 24,
@@ -986,10 +1099,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   41,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Object: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 18,
@@ -1002,12 +1117,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   41,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "experimental",
 								},
 								Property: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 24,
@@ -1020,6 +1137,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   41,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "group",
 								},
@@ -1027,7 +1145,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						},
 					},
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 11,
@@ -1040,11 +1159,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   37,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Call: &ast.CallExpression{
 						Arguments: nil,
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 11,
@@ -1057,10 +1178,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Line:   42,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Callee: &ast.Identifier{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 9,
@@ -1073,6 +1196,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										Line:   42,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Name: "max",
 						},
@@ -1080,7 +1204,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 				},
 				Params: []*ast.Property{&ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 20,
@@ -1093,10 +1218,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   36,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 17,
@@ -1109,11 +1236,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Line:   36,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Name: "table",
 					},
 					Value: &ast.PipeLiteral{BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 20,
@@ -1126,13 +1255,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   36,
 							},
 						},
+						TrailingComment: nil,
 					}},
 				}},
 			},
 		}, &ast.TestStatement{
 			Assignment: &ast.VariableAssignment{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 95,
@@ -1145,10 +1276,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   44,
 						},
 					},
+					TrailingComment: nil,
 				},
 				ID: &ast.Identifier{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 12,
@@ -1161,12 +1294,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   44,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Name: "_group",
 				},
 				Init: &ast.FunctionExpression{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 95,
@@ -1179,10 +1314,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   44,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Body: &ast.ParenExpression{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 95,
@@ -1195,10 +1332,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Line:   45,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Expression: &ast.ObjectExpression{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 94,
@@ -1211,10 +1350,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										Line:   45,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Properties: []*ast.Property{&ast.Property{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 43,
@@ -1227,10 +1368,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   45,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Key: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 9,
@@ -1243,13 +1386,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   45,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "input",
 								},
 								Value: &ast.CallExpression{
 									Arguments: []ast.Expression{&ast.ObjectExpression{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 42,
@@ -1262,10 +1407,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   45,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Properties: []*ast.Property{&ast.Property{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 42,
@@ -1278,10 +1425,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   45,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Key: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 34,
@@ -1294,12 +1443,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   45,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "csv",
 											},
 											Value: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 42,
@@ -1312,6 +1463,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   45,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "inData",
 											},
@@ -1319,7 +1471,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										With: nil,
 									}},
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 43,
@@ -1332,10 +1485,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   45,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Callee: &ast.MemberExpression{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 30,
@@ -1348,10 +1503,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   45,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Object: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 18,
@@ -1364,12 +1521,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   45,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "testing",
 										},
 										Property: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 30,
@@ -1382,6 +1541,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   45,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "loadStorage",
 										},
@@ -1389,7 +1549,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								},
 							}, &ast.Property{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 80,
@@ -1402,10 +1563,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   45,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Key: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 49,
@@ -1418,13 +1581,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   45,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "want",
 								},
 								Value: &ast.CallExpression{
 									Arguments: []ast.Expression{&ast.ObjectExpression{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 79,
@@ -1437,10 +1602,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   45,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Properties: []*ast.Property{&ast.Property{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 79,
@@ -1453,10 +1620,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   45,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Key: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 70,
@@ -1469,12 +1638,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   45,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "csv",
 											},
 											Value: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 79,
@@ -1487,6 +1658,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   45,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "outData",
 											},
@@ -1494,7 +1666,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										With: nil,
 									}},
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 80,
@@ -1507,10 +1680,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   45,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Callee: &ast.MemberExpression{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 66,
@@ -1523,10 +1698,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   45,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Object: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 58,
@@ -1539,12 +1716,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   45,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "testing",
 										},
 										Property: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 66,
@@ -1557,6 +1736,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   45,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "loadMem",
 										},
@@ -1564,7 +1744,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								},
 							}, &ast.Property{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 93,
@@ -1577,10 +1758,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   45,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Key: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 84,
@@ -1593,12 +1776,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   45,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "fn",
 								},
 								Value: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 93,
@@ -1611,6 +1796,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   45,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "t_group",
 								},
@@ -1622,7 +1808,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 				},
 			},
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 95,
@@ -1635,12 +1822,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   44,
 					},
 				},
+				TrailingComment: nil,
 			},
 		}},
+		Eof: nil,
 		Imports: []*ast.ImportDeclaration{&ast.ImportDeclaration{
 			As: nil,
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 22,
@@ -1653,10 +1843,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   3,
 					},
 				},
+				TrailingComment: nil,
 			},
 			Path: &ast.StringLiteral{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 22,
@@ -1669,13 +1861,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   3,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Value: "experimental",
 			},
 		}, &ast.ImportDeclaration{
 			As: nil,
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 17,
@@ -1688,10 +1882,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   4,
 					},
 				},
+				TrailingComment: nil,
 			},
 			Path: &ast.StringLiteral{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 17,
@@ -1704,6 +1900,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   4,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Value: "testing",
 			},
@@ -1712,7 +1909,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 		Name:     "group_test.flux",
 		Package: &ast.PackageClause{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 26,
@@ -1725,10 +1923,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   1,
 					},
 				},
+				TrailingComment: nil,
 			},
 			Name: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 26,
@@ -1741,13 +1941,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   1,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "experimental_test",
 			},
 		},
 	}, &ast.File{
 		BaseNode: ast.BaseNode{
-			Errors: nil,
+			Comments: nil,
+			Errors:   nil,
 			Loc: &ast.SourceLocation{
 				End: ast.Position{
 					Column: 93,
@@ -1760,11 +1962,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 					Line:   1,
 				},
 			},
+			TrailingComment: nil,
 		},
 		Body: []ast.Statement{&ast.OptionStatement{
 			Assignment: &ast.VariableAssignment{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 42,
@@ -1777,10 +1981,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   6,
 						},
 					},
+					TrailingComment: nil,
 				},
 				ID: &ast.Identifier{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 11,
@@ -1793,12 +1999,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   6,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Name: "now",
 				},
 				Init: &ast.FunctionExpression{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 42,
@@ -1811,10 +2019,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   6,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Body: &ast.ParenExpression{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 42,
@@ -1827,10 +2037,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Line:   6,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Expression: &ast.DateTimeLiteral{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 41,
@@ -1843,6 +2055,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										Line:   6,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Value: parser.MustParseTime("2030-01-01T00:00:00Z"),
 						},
@@ -1851,7 +2064,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 				},
 			},
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 42,
@@ -1864,10 +2078,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   6,
 					},
 				},
+				TrailingComment: nil,
 			},
 		}, &ast.VariableAssignment{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 2,
@@ -1880,10 +2096,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   8,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 7,
@@ -1896,12 +2114,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   8,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "inData",
 			},
 			Init: &ast.StringLiteral{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 2,
@@ -1914,12 +2134,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   8,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Value: "\n#datatype,string,long,string,string,string,dateTime:RFC3339,boolean\n#group,false,false,true,true,true,false,false\n#default,_result,,,,,,\n,result,table,_measurement,_field,t0,_time,_value\n,,0,thmWJ,GK1Ji,SbkiNS9,2018-12-19T22:13:30Z,false\n,,0,thmWJ,GK1Ji,SbkiNS9,2018-12-19T22:13:40Z,true\n,,0,thmWJ,GK1Ji,SbkiNS9,2018-12-19T22:13:50Z,false\n,,0,thmWJ,GK1Ji,SbkiNS9,2018-12-19T22:14:00Z,false\n,,0,thmWJ,GK1Ji,SbkiNS9,2018-12-19T22:14:10Z,true\n,,0,thmWJ,GK1Ji,SbkiNS9,2018-12-19T22:14:20Z,true\n,,1,thmWJ,GK1Ji,gpmhNEw,2018-12-19T22:13:30Z,false\n,,1,thmWJ,GK1Ji,gpmhNEw,2018-12-19T22:13:40Z,true\n,,1,thmWJ,GK1Ji,gpmhNEw,2018-12-19T22:13:50Z,false\n,,1,thmWJ,GK1Ji,gpmhNEw,2018-12-19T22:14:00Z,true\n,,1,thmWJ,GK1Ji,gpmhNEw,2018-12-19T22:14:10Z,true\n,,1,thmWJ,GK1Ji,gpmhNEw,2018-12-19T22:14:20Z,true\n\n#datatype,string,long,string,string,string,dateTime:RFC3339,double\n#group,false,false,true,true,true,false,false\n#default,_result,,,,,,\n,result,table,_measurement,_field,t0,_time,_value\n,,2,thmWJ,c9wjx7r,SbkiNS9,2018-12-19T22:13:30Z,-61.68790887989735\n,,2,thmWJ,c9wjx7r,SbkiNS9,2018-12-19T22:13:40Z,-6.3173755351186465\n,,2,thmWJ,c9wjx7r,SbkiNS9,2018-12-19T22:13:50Z,-26.049728557657513\n,,2,thmWJ,c9wjx7r,SbkiNS9,2018-12-19T22:14:00Z,114.285955884979\n,,2,thmWJ,c9wjx7r,SbkiNS9,2018-12-19T22:14:10Z,16.140262630578995\n,,2,thmWJ,c9wjx7r,SbkiNS9,2018-12-19T22:14:20Z,29.50336437998469\n,,3,thmWJ,c9wjx7r,gpmhNEw,2018-12-19T22:13:30Z,7.940387008821781\n,,3,thmWJ,c9wjx7r,gpmhNEw,2018-12-19T22:13:40Z,49.460104214779086\n,,3,thmWJ,c9wjx7r,gpmhNEw,2018-12-19T22:13:50Z,-36.564150808873954\n,,3,thmWJ,c9wjx7r,gpmhNEw,2018-12-19T22:14:00Z,34.319039251798635\n,,3,thmWJ,c9wjx7r,gpmhNEw,2018-12-19T22:14:10Z,79.27019811403116\n,,3,thmWJ,c9wjx7r,gpmhNEw,2018-12-19T22:14:20Z,41.91029522104053\n\n#datatype,string,long,string,string,string,dateTime:RFC3339,long\n#group,false,false,true,true,true,false,false\n#default,_result,,,,,,\n,result,table,_measurement,_field,t0,_time,_value\n,,4,thmWJ,iUcIq,SbkiNS9,2018-12-19T22:13:30Z,-44\n,,4,thmWJ,iUcIq,SbkiNS9,2018-12-19T22:13:40Z,-25\n,,4,thmWJ,iUcIq,SbkiNS9,2018-12-19T22:13:50Z,46\n,,4,thmWJ,iUcIq,SbkiNS9,2018-12-19T22:14:00Z,-2\n,,4,thmWJ,iUcIq,SbkiNS9,2018-12-19T22:14:10Z,-14\n,,4,thmWJ,iUcIq,SbkiNS9,2018-12-19T22:14:20Z,-53\n,,5,thmWJ,iUcIq,gpmhNEw,2018-12-19T22:13:30Z,17\n,,5,thmWJ,iUcIq,gpmhNEw,2018-12-19T22:13:40Z,-44\n,,5,thmWJ,iUcIq,gpmhNEw,2018-12-19T22:13:50Z,-99\n,,5,thmWJ,iUcIq,gpmhNEw,2018-12-19T22:14:00Z,-85\n,,5,thmWJ,iUcIq,gpmhNEw,2018-12-19T22:14:10Z,18\n,,5,thmWJ,iUcIq,gpmhNEw,2018-12-19T22:14:20Z,99\n\n#datatype,string,long,string,string,string,dateTime:RFC3339,string\n#group,false,false,true,true,true,false,false\n#default,_result,,,,,,\n,result,table,_measurement,_field,t0,_time,_value\n,,6,thmWJ,urO72,SbkiNS9,2018-12-19T22:13:30Z,xRbS\n,,6,thmWJ,urO72,SbkiNS9,2018-12-19T22:13:40Z,PtTh\n,,6,thmWJ,urO72,SbkiNS9,2018-12-19T22:13:50Z,ZjN2je\n,,6,thmWJ,urO72,SbkiNS9,2018-12-19T22:14:00Z,YZNBh\n,,6,thmWJ,urO72,SbkiNS9,2018-12-19T22:14:10Z,pu08\n,,6,thmWJ,urO72,SbkiNS9,2018-12-19T22:14:20Z,ixlOdT\n,,7,thmWJ,urO72,gpmhNEw,2018-12-19T22:13:30Z,YqV\n,,7,thmWJ,urO72,gpmhNEw,2018-12-19T22:13:40Z,GjbWF\n,,7,thmWJ,urO72,gpmhNEw,2018-12-19T22:13:50Z,GiX1Bb\n,,7,thmWJ,urO72,gpmhNEw,2018-12-19T22:14:00Z,DQCZXZ\n,,7,thmWJ,urO72,gpmhNEw,2018-12-19T22:14:10Z,atopRR2\n,,7,thmWJ,urO72,gpmhNEw,2018-12-19T22:14:20Z,TNKKB\n\n#datatype,string,long,string,string,string,dateTime:RFC3339,unsignedLong\n#group,false,false,true,true,true,false,false\n#default,_result,,,,,,\n,result,table,_measurement,_field,t0,_time,_value\n,,8,thmWJ,zmk1YWi,SbkiNS9,2018-12-19T22:13:30Z,84\n,,8,thmWJ,zmk1YWi,SbkiNS9,2018-12-19T22:13:40Z,52\n,,8,thmWJ,zmk1YWi,SbkiNS9,2018-12-19T22:13:50Z,23\n,,8,thmWJ,zmk1YWi,SbkiNS9,2018-12-19T22:14:00Z,62\n,,8,thmWJ,zmk1YWi,SbkiNS9,2018-12-19T22:14:10Z,22\n,,8,thmWJ,zmk1YWi,SbkiNS9,2018-12-19T22:14:20Z,78\n,,9,thmWJ,zmk1YWi,gpmhNEw,2018-12-19T22:13:30Z,79\n,,9,thmWJ,zmk1YWi,gpmhNEw,2018-12-19T22:13:40Z,33\n,,9,thmWJ,zmk1YWi,gpmhNEw,2018-12-19T22:13:50Z,97\n,,9,thmWJ,zmk1YWi,gpmhNEw,2018-12-19T22:14:00Z,90\n,,9,thmWJ,zmk1YWi,gpmhNEw,2018-12-19T22:14:10Z,96\n,,9,thmWJ,zmk1YWi,gpmhNEw,2018-12-19T22:14:20Z,10\n",
 			},
 		}, &ast.VariableAssignment{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 2,
@@ -1932,10 +2154,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   95,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 8,
@@ -1948,12 +2172,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   95,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "outData",
 			},
 			Init: &ast.StringLiteral{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 2,
@@ -1966,12 +2192,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   95,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Value: "\n#datatype,string,long,dateTime:RFC3339,dateTime:RFC3339,string,string,string,dateTime:RFC3339,boolean\n#group,false,false,true,true,true,true,true,false,false\n#default,_result,,,,,,,,\n,result,table,_start,_stop,_measurement,_field,t0,_time,_value\n,,0,2018-01-01T00:00:00Z,2030-01-01T00:00:00Z,thmWJ,GK1Ji,server01,2018-12-19T22:13:30Z,false\n,,0,2018-01-01T00:00:00Z,2030-01-01T00:00:00Z,thmWJ,GK1Ji,server01,2018-12-19T22:13:40Z,true\n,,0,2018-01-01T00:00:00Z,2030-01-01T00:00:00Z,thmWJ,GK1Ji,server01,2018-12-19T22:13:50Z,false\n,,0,2018-01-01T00:00:00Z,2030-01-01T00:00:00Z,thmWJ,GK1Ji,server01,2018-12-19T22:14:00Z,false\n,,0,2018-01-01T00:00:00Z,2030-01-01T00:00:00Z,thmWJ,GK1Ji,server01,2018-12-19T22:14:10Z,true\n,,0,2018-01-01T00:00:00Z,2030-01-01T00:00:00Z,thmWJ,GK1Ji,server01,2018-12-19T22:14:20Z,true\n,,0,2018-01-01T00:00:00Z,2030-01-01T00:00:00Z,thmWJ,GK1Ji,server01,2018-12-19T22:13:30Z,false\n,,0,2018-01-01T00:00:00Z,2030-01-01T00:00:00Z,thmWJ,GK1Ji,server01,2018-12-19T22:13:40Z,true\n,,0,2018-01-01T00:00:00Z,2030-01-01T00:00:00Z,thmWJ,GK1Ji,server01,2018-12-19T22:13:50Z,false\n,,0,2018-01-01T00:00:00Z,2030-01-01T00:00:00Z,thmWJ,GK1Ji,server01,2018-12-19T22:14:00Z,true\n,,0,2018-01-01T00:00:00Z,2030-01-01T00:00:00Z,thmWJ,GK1Ji,server01,2018-12-19T22:14:10Z,true\n,,0,2018-01-01T00:00:00Z,2030-01-01T00:00:00Z,thmWJ,GK1Ji,server01,2018-12-19T22:14:20Z,true\n#datatype,string,long,dateTime:RFC3339,dateTime:RFC3339,string,string,string,dateTime:RFC3339,double\n#group,false,false,true,true,true,true,true,false,false\n#default,_result,,,,,,,,\n,result,table,_start,_stop,_measurement,_field,t0,_time,_value\n,,1,2018-01-01T00:00:00Z,2030-01-01T00:00:00Z,thmWJ,c9wjx7r,server01,2018-12-19T22:13:30Z,-61.68790887989735\n,,1,2018-01-01T00:00:00Z,2030-01-01T00:00:00Z,thmWJ,c9wjx7r,server01,2018-12-19T22:13:40Z,-6.3173755351186465\n,,1,2018-01-01T00:00:00Z,2030-01-01T00:00:00Z,thmWJ,c9wjx7r,server01,2018-12-19T22:13:50Z,-26.049728557657513\n,,1,2018-01-01T00:00:00Z,2030-01-01T00:00:00Z,thmWJ,c9wjx7r,server01,2018-12-19T22:14:00Z,114.285955884979\n,,1,2018-01-01T00:00:00Z,2030-01-01T00:00:00Z,thmWJ,c9wjx7r,server01,2018-12-19T22:14:10Z,16.140262630578995\n,,1,2018-01-01T00:00:00Z,2030-01-01T00:00:00Z,thmWJ,c9wjx7r,server01,2018-12-19T22:14:20Z,29.50336437998469\n,,1,2018-01-01T00:00:00Z,2030-01-01T00:00:00Z,thmWJ,c9wjx7r,server01,2018-12-19T22:13:30Z,7.940387008821781\n,,1,2018-01-01T00:00:00Z,2030-01-01T00:00:00Z,thmWJ,c9wjx7r,server01,2018-12-19T22:13:40Z,49.460104214779086\n,,1,2018-01-01T00:00:00Z,2030-01-01T00:00:00Z,thmWJ,c9wjx7r,server01,2018-12-19T22:13:50Z,-36.564150808873954\n,,1,2018-01-01T00:00:00Z,2030-01-01T00:00:00Z,thmWJ,c9wjx7r,server01,2018-12-19T22:14:00Z,34.319039251798635\n,,1,2018-01-01T00:00:00Z,2030-01-01T00:00:00Z,thmWJ,c9wjx7r,server01,2018-12-19T22:14:10Z,79.27019811403116\n,,1,2018-01-01T00:00:00Z,2030-01-01T00:00:00Z,thmWJ,c9wjx7r,server01,2018-12-19T22:14:20Z,41.91029522104053\n#datatype,string,long,dateTime:RFC3339,dateTime:RFC3339,string,string,string,dateTime:RFC3339,long\n#group,false,false,true,true,true,true,true,false,false\n#default,_result,,,,,,,,\n,result,table,_start,_stop,_measurement,_field,t0,_time,_value\n,,2,2018-01-01T00:00:00Z,2030-01-01T00:00:00Z,thmWJ,iUcIq,server01,2018-12-19T22:13:30Z,-44\n,,2,2018-01-01T00:00:00Z,2030-01-01T00:00:00Z,thmWJ,iUcIq,server01,2018-12-19T22:13:40Z,-25\n,,2,2018-01-01T00:00:00Z,2030-01-01T00:00:00Z,thmWJ,iUcIq,server01,2018-12-19T22:13:50Z,46\n,,2,2018-01-01T00:00:00Z,2030-01-01T00:00:00Z,thmWJ,iUcIq,server01,2018-12-19T22:14:00Z,-2\n,,2,2018-01-01T00:00:00Z,2030-01-01T00:00:00Z,thmWJ,iUcIq,server01,2018-12-19T22:14:10Z,-14\n,,2,2018-01-01T00:00:00Z,2030-01-01T00:00:00Z,thmWJ,iUcIq,server01,2018-12-19T22:14:20Z,-53\n,,2,2018-01-01T00:00:00Z,2030-01-01T00:00:00Z,thmWJ,iUcIq,server01,2018-12-19T22:13:30Z,17\n,,2,2018-01-01T00:00:00Z,2030-01-01T00:00:00Z,thmWJ,iUcIq,server01,2018-12-19T22:13:40Z,-44\n,,2,2018-01-01T00:00:00Z,2030-01-01T00:00:00Z,thmWJ,iUcIq,server01,2018-12-19T22:13:50Z,-99\n,,2,2018-01-01T00:00:00Z,2030-01-01T00:00:00Z,thmWJ,iUcIq,server01,2018-12-19T22:14:00Z,-85\n,,2,2018-01-01T00:00:00Z,2030-01-01T00:00:00Z,thmWJ,iUcIq,server01,2018-12-19T22:14:10Z,18\n,,2,2018-01-01T00:00:00Z,2030-01-01T00:00:00Z,thmWJ,iUcIq,server01,2018-12-19T22:14:20Z,99\n#datatype,string,long,dateTime:RFC3339,dateTime:RFC3339,string,string,string,dateTime:RFC3339,string\n#group,false,false,true,true,true,true,true,false,false\n#default,_result,,,,,,,,\n,result,table,_start,_stop,_measurement,_field,t0,_time,_value\n,,3,2018-01-01T00:00:00Z,2030-01-01T00:00:00Z,thmWJ,urO72,server01,2018-12-19T22:13:30Z,xRbS\n,,3,2018-01-01T00:00:00Z,2030-01-01T00:00:00Z,thmWJ,urO72,server01,2018-12-19T22:13:40Z,PtTh\n,,3,2018-01-01T00:00:00Z,2030-01-01T00:00:00Z,thmWJ,urO72,server01,2018-12-19T22:13:50Z,ZjN2je\n,,3,2018-01-01T00:00:00Z,2030-01-01T00:00:00Z,thmWJ,urO72,server01,2018-12-19T22:14:00Z,YZNBh\n,,3,2018-01-01T00:00:00Z,2030-01-01T00:00:00Z,thmWJ,urO72,server01,2018-12-19T22:14:10Z,pu08\n,,3,2018-01-01T00:00:00Z,2030-01-01T00:00:00Z,thmWJ,urO72,server01,2018-12-19T22:14:20Z,ixlOdT\n,,3,2018-01-01T00:00:00Z,2030-01-01T00:00:00Z,thmWJ,urO72,server01,2018-12-19T22:13:30Z,YqV\n,,3,2018-01-01T00:00:00Z,2030-01-01T00:00:00Z,thmWJ,urO72,server01,2018-12-19T22:13:40Z,GjbWF\n,,3,2018-01-01T00:00:00Z,2030-01-01T00:00:00Z,thmWJ,urO72,server01,2018-12-19T22:13:50Z,GiX1Bb\n,,3,2018-01-01T00:00:00Z,2030-01-01T00:00:00Z,thmWJ,urO72,server01,2018-12-19T22:14:00Z,DQCZXZ\n,,3,2018-01-01T00:00:00Z,2030-01-01T00:00:00Z,thmWJ,urO72,server01,2018-12-19T22:14:10Z,atopRR2\n,,3,2018-01-01T00:00:00Z,2030-01-01T00:00:00Z,thmWJ,urO72,server01,2018-12-19T22:14:20Z,TNKKB\n#datatype,string,long,dateTime:RFC3339,dateTime:RFC3339,string,string,string,dateTime:RFC3339,unsignedLong\n#group,false,false,true,true,true,true,true,false,false\n#default,_result,,,,,,,,\n,result,table,_start,_stop,_measurement,_field,t0,_time,_value\n,,4,2018-01-01T00:00:00Z,2030-01-01T00:00:00Z,thmWJ,zmk1YWi,server01,2018-12-19T22:13:30Z,84\n,,4,2018-01-01T00:00:00Z,2030-01-01T00:00:00Z,thmWJ,zmk1YWi,server01,2018-12-19T22:13:40Z,52\n,,4,2018-01-01T00:00:00Z,2030-01-01T00:00:00Z,thmWJ,zmk1YWi,server01,2018-12-19T22:13:50Z,23\n,,4,2018-01-01T00:00:00Z,2030-01-01T00:00:00Z,thmWJ,zmk1YWi,server01,2018-12-19T22:14:00Z,62\n,,4,2018-01-01T00:00:00Z,2030-01-01T00:00:00Z,thmWJ,zmk1YWi,server01,2018-12-19T22:14:10Z,22\n,,4,2018-01-01T00:00:00Z,2030-01-01T00:00:00Z,thmWJ,zmk1YWi,server01,2018-12-19T22:14:20Z,78\n,,4,2018-01-01T00:00:00Z,2030-01-01T00:00:00Z,thmWJ,zmk1YWi,server01,2018-12-19T22:13:30Z,79\n,,4,2018-01-01T00:00:00Z,2030-01-01T00:00:00Z,thmWJ,zmk1YWi,server01,2018-12-19T22:13:40Z,33\n,,4,2018-01-01T00:00:00Z,2030-01-01T00:00:00Z,thmWJ,zmk1YWi,server01,2018-12-19T22:13:50Z,97\n,,4,2018-01-01T00:00:00Z,2030-01-01T00:00:00Z,thmWJ,zmk1YWi,server01,2018-12-19T22:14:00Z,90\n,,4,2018-01-01T00:00:00Z,2030-01-01T00:00:00Z,thmWJ,zmk1YWi,server01,2018-12-19T22:14:10Z,96\n,,4,2018-01-01T00:00:00Z,2030-01-01T00:00:00Z,thmWJ,zmk1YWi,server01,2018-12-19T22:14:20Z,10\n",
 			},
 		}, &ast.VariableAssignment{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 42,
@@ -1984,10 +2212,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   178,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 6,
@@ -2000,12 +2230,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   178,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "t_set",
 			},
 			Init: &ast.FunctionExpression{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 42,
@@ -2018,12 +2250,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   178,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Body: &ast.PipeExpression{
 					Argument: &ast.PipeExpression{
 						Argument: &ast.Identifier{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 7,
@@ -2036,11 +2270,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										Line:   179,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Name: "table",
 						},
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 40,
@@ -2053,11 +2289,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Line:   179,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Call: &ast.CallExpression{
 							Arguments: []ast.Expression{&ast.ObjectExpression{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 39,
@@ -2070,10 +2308,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   180,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Properties: []*ast.Property{&ast.Property{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 39,
@@ -2086,10 +2326,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   180,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Key: &ast.Identifier{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 17,
@@ -2102,12 +2344,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   180,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Name: "start",
 									},
 									Value: &ast.DateTimeLiteral{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 39,
@@ -2120,6 +2364,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   180,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Value: parser.MustParseTime("2018-01-01T00:00:00Z"),
 									},
@@ -2127,7 +2372,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								With: nil,
 							}},
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 40,
@@ -2140,10 +2386,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										Line:   180,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Callee: &ast.Identifier{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 11,
@@ -2156,13 +2404,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   180,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Name: "range",
 							},
 						},
 					},
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 42,
@@ -2175,11 +2425,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   179,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Call: &ast.CallExpression{
 						Arguments: []ast.Expression{&ast.ObjectExpression{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 41,
@@ -2192,10 +2444,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										Line:   181,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Properties: []*ast.Property{&ast.Property{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 41,
@@ -2208,10 +2462,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   181,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Key: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 24,
@@ -2224,12 +2480,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   181,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "o",
 								},
 								Value: &ast.ObjectExpression{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 41,
@@ -2242,10 +2500,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   181,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Properties: []*ast.Property{&ast.Property{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 40,
@@ -2258,10 +2518,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   181,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Key: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 28,
@@ -2274,12 +2536,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   181,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "t0",
 										},
 										Value: &ast.StringLiteral{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 40,
@@ -2292,6 +2556,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   181,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Value: "server01",
 										},
@@ -2302,7 +2567,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							With: nil,
 						}},
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 42,
@@ -2315,10 +2581,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Line:   181,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Callee: &ast.MemberExpression{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 22,
@@ -2331,10 +2599,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										Line:   181,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Object: &ast.Identifier{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 18,
@@ -2347,12 +2617,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   181,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Name: "experimental",
 							},
 							Property: &ast.Identifier{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 22,
@@ -2365,6 +2637,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   181,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Name: "set",
 							},
@@ -2373,7 +2646,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 				},
 				Params: []*ast.Property{&ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 18,
@@ -2386,10 +2660,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   178,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 15,
@@ -2402,11 +2678,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Line:   178,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Name: "table",
 					},
 					Value: &ast.PipeLiteral{BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 18,
@@ -2419,13 +2697,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   178,
 							},
 						},
+						TrailingComment: nil,
 					}},
 				}},
 			},
 		}, &ast.TestStatement{
 			Assignment: &ast.VariableAssignment{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 93,
@@ -2438,10 +2718,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   183,
 						},
 					},
+					TrailingComment: nil,
 				},
 				ID: &ast.Identifier{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 10,
@@ -2454,12 +2736,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   183,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Name: "_set",
 				},
 				Init: &ast.FunctionExpression{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 93,
@@ -2472,10 +2756,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   183,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Body: &ast.ParenExpression{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 93,
@@ -2488,10 +2774,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Line:   184,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Expression: &ast.ObjectExpression{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 92,
@@ -2504,10 +2792,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										Line:   184,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Properties: []*ast.Property{&ast.Property{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 43,
@@ -2520,10 +2810,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   184,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Key: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 9,
@@ -2536,13 +2828,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   184,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "input",
 								},
 								Value: &ast.CallExpression{
 									Arguments: []ast.Expression{&ast.ObjectExpression{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 42,
@@ -2555,10 +2849,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   184,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Properties: []*ast.Property{&ast.Property{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 42,
@@ -2571,10 +2867,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   184,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Key: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 34,
@@ -2587,12 +2885,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   184,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "csv",
 											},
 											Value: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 42,
@@ -2605,6 +2905,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   184,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "inData",
 											},
@@ -2612,7 +2913,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										With: nil,
 									}},
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 43,
@@ -2625,10 +2927,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   184,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Callee: &ast.MemberExpression{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 30,
@@ -2641,10 +2945,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   184,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Object: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 18,
@@ -2657,12 +2963,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   184,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "testing",
 										},
 										Property: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 30,
@@ -2675,6 +2983,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   184,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "loadStorage",
 										},
@@ -2682,7 +2991,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								},
 							}, &ast.Property{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 80,
@@ -2695,10 +3005,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   184,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Key: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 49,
@@ -2711,13 +3023,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   184,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "want",
 								},
 								Value: &ast.CallExpression{
 									Arguments: []ast.Expression{&ast.ObjectExpression{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 79,
@@ -2730,10 +3044,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   184,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Properties: []*ast.Property{&ast.Property{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 79,
@@ -2746,10 +3062,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   184,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Key: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 70,
@@ -2762,12 +3080,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   184,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "csv",
 											},
 											Value: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 79,
@@ -2780,6 +3100,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   184,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "outData",
 											},
@@ -2787,7 +3108,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										With: nil,
 									}},
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 80,
@@ -2800,10 +3122,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   184,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Callee: &ast.MemberExpression{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 66,
@@ -2816,10 +3140,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   184,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Object: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 58,
@@ -2832,12 +3158,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   184,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "testing",
 										},
 										Property: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 66,
@@ -2850,6 +3178,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   184,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "loadMem",
 										},
@@ -2857,7 +3186,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								},
 							}, &ast.Property{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 91,
@@ -2870,10 +3200,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   184,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Key: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 84,
@@ -2886,12 +3218,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   184,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "fn",
 								},
 								Value: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 91,
@@ -2904,6 +3238,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   184,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "t_set",
 								},
@@ -2915,7 +3250,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 				},
 			},
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 93,
@@ -2928,12 +3264,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   183,
 					},
 				},
+				TrailingComment: nil,
 			},
 		}},
+		Eof: nil,
 		Imports: []*ast.ImportDeclaration{&ast.ImportDeclaration{
 			As: nil,
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 17,
@@ -2946,10 +3285,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   3,
 					},
 				},
+				TrailingComment: nil,
 			},
 			Path: &ast.StringLiteral{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 17,
@@ -2962,13 +3303,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   3,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Value: "testing",
 			},
 		}, &ast.ImportDeclaration{
 			As: nil,
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 22,
@@ -2981,10 +3324,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   4,
 					},
 				},
+				TrailingComment: nil,
 			},
 			Path: &ast.StringLiteral{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 22,
@@ -2997,6 +3342,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   4,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Value: "experimental",
 			},
@@ -3005,7 +3351,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 		Name:     "set_test.flux",
 		Package: &ast.PackageClause{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 26,
@@ -3018,10 +3365,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   1,
 					},
 				},
+				TrailingComment: nil,
 			},
 			Name: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 26,
@@ -3034,6 +3383,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   1,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "experimental_test",
 			},
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"github.com/influxdata/flux"
 	"github.com/influxdata/flux/codes"
+	"github.com/influxdata/flux/dependencies/sideeffect"
 	"github.com/influxdata/flux/internal/errors"
 	"github.com/influxdata/flux/semantic"
 	"github.com/influxdata/flux/values"
@@ -91,6 +92,7 @@ var get = values.NewFunction(
 		if err != nil {
 			return nil, errors.Wrap(err, codes.Aborted, "missing client in http.get")
 		}
+		dc = sideeffect.HTTPClient(ctx, dc)
 
 		statusCode, body, headers, err := func(req *http.Request) (int, []byte, values.Object, error) {
 			s, cctx := opentracing.StartSpanFromContext(ctx, "http.get")
@@ -12,6 +12,7 @@ import (
 
 	MQTT "github.com/eclipse/paho.mqtt.golang"
 	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/dependencies/sideeffect"
 	"github.com/influxdata/flux/execute"
 	"github.com/influxdata/flux/internal/pkg/syncutil"
 	"github.com/influxdata/flux/plan"
@@ -265,13 +266,15 @@ func createToMQTTTransformation(id execute.DatasetID, mode execute.AccumulationM
 	cache := execute.NewTableBuilderCache(a.Allocator())
 	d := execute.NewDataset(id, mode, cache)
 	t := NewToMQTTTransformation(d, cache, s)
+	t.recorder = sideeffect.FromContext(a.Context())
 	return t, d, nil
 }
 
 type ToMQTTTransformation struct {
-	d     execute.Dataset
-	cache execute.TableBuilderCache
-	spec  *ToMQTTProcedureSpec
+	d        execute.Dataset
+	cache    execute.TableBuilderCache
+	spec     *ToMQTTProcedureSpec
+	recorder *sideeffect.Recorder
 }
 
 func (t *ToMQTTTransformation) RetractTable(id execute.DatasetID, key flux.GroupKey) error {
@@ -286,6 +289,55 @@ func NewToMQTTTransformation(d execute.Dataset, cache execute.TableBuilderCache,
 	}
 }
 
+// mqttClient publishes messages to a broker.
+type mqttClient interface {
+	Connect() error
+	Publish(topic, message string)
+	Disconnect()
+}
+
+type pahoClient struct {
+	client MQTT.Client
+}
+
+func (c pahoClient) Connect() error {
+	if token := c.client.Connect(); token.Wait() && token.Error() != nil {
+		return token.Error()
+	}
+	return nil
+}
+
+func (c pahoClient) Publish(topic, message string) {
+	token := c.client.Publish(topic, 0, false, message)
+	token.Wait()
+}
+
+func (c pahoClient) Disconnect() {
+	c.client.Disconnect(250)
+}
+
+// recordingClient records the messages with a side effect recorder instead of publishing them.
+type recordingClient struct {
+	r      *sideeffect.Recorder
+	broker string
+}
+
+func (c recordingClient) Connect() error {
+	return nil
+}
+
+func (c recordingClient) Publish(topic, message string) {
+	c.r.Record(sideeffect.Effect{
+		Kind:    "mqtt",
+		Method:  "publish",
+		Target:  topic,
+		Headers: map[string]string{"broker": c.broker},
+		Data:    message,
+	})
+}
+
+func (c recordingClient) Disconnect() {}
+
 type toMqttMetric struct {
 	tags   []*protocol.Tag
 	fields []*protocol.Field
@@ -337,15 +389,19 @@ func (t *ToMQTTTransformation) Process(id execute.DatasetID, tbl flux.Table) err
 	}
 	mqttTopic := t.spec.Spec.Topic
 
-	client := MQTT.NewClient(opts)
+	var client mqttClient
+	if t.recorder != nil {
+		client = recordingClient{r: t.recorder, broker: t.spec.Spec.Broker}
+	} else {
+		client = pahoClient{MQTT.NewClient(opts)}
+	}
 	if t.spec.Spec.Message != "" {
 		//create and start a client using the above ClientOptions
-		if token := client.Connect(); token.Wait() && token.Error() != nil {
-			return token.Error()
+		if err := client.Connect(); err != nil {
+			return err
 		}
-		token := client.Publish(t.spec.Spec.Topic, 0, false, t.spec.Spec.Message)
-		token.Wait()
-		client.Disconnect(250)
+		client.Publish(t.spec.Spec.Topic, t.spec.Spec.Message)
+		client.Disconnect()
 		return nil
 	}
 	pr, pw := io.Pipe() // TODO: replce the pipe with something faster
@@ -451,8 +507,8 @@ func (t *ToMQTTTransformation) Process(id execute.DatasetID, tbl flux.Table) err
 	})
 
 	//start a client using the above ClientOptions
-	if token := client.Connect(); token.Wait() && token.Error() != nil {
-		return token.Error()
+	if err := client.Connect(); err != nil {
+		return err
 	}
 	p := make([]byte, 2024)
 	var message strings.Builder
@@ -463,7 +519,7 @@ func (t *ToMQTTTransformation) Process(id execute.DatasetID, tbl flux.Table) err
 				message.WriteString(string(p[:n]))
 				break
 			}
-			client.Disconnect(250)
+			client.Disconnect()
 			return err
 		}
 		message.WriteString(string(p[:n]))
@@ -472,14 +528,13 @@ func (t *ToMQTTTransformation) Process(id execute.DatasetID, tbl flux.Table) err
 		if mqttTopic == "" {
 			mqttTopic = m.createTopic(message.String())
 		}
-		token := client.Publish(mqttTopic, 0, false, message.String())
-		token.Wait()
+		client.Publish(mqttTopic, message.String())
 	}
 	if err := wg.Wait(); err != nil {
-		client.Disconnect(250)
+		client.Disconnect()
 		return err
 	}
-	client.Disconnect(250)
+	client.Disconnect()
 	return nil
 
 }
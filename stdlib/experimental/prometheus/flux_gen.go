@@ -13,12 +13,15 @@ func init() {
 
 var pkgAST = &ast.Package{
 	BaseNode: ast.BaseNode{
-		Errors: nil,
-		Loc:    nil,
+		Comments:        nil,
+		Errors:          nil,
+		Loc:             nil,
+		TrailingComment: nil,
 	},
 	Files: []*ast.File{&ast.File{
 		BaseNode: ast.BaseNode{
-			Errors: nil,
+			Comments: nil,
+			Errors:   nil,
 			Loc: &ast.SourceLocation{
 				End: ast.Position{
 					Column: 58,
@@ -31,10 +34,12 @@ var pkgAST = &ast.Package{
 					Line:   1,
 				},
 			},
+			TrailingComment: nil,
 		},
 		Body: []ast.Statement{&ast.BuiltinStatement{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: []ast.Comment{ast.Comment{Text: "// scrape enables scraping of a prometheus metrics endpoint and converts "}, ast.Comment{Text: "// that input into flux tables. Each metric is put into an individual flux "}, ast.Comment{Text: "// table, including each histogram and summary value.  "}},
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 15,
@@ -47,10 +52,12 @@ var pkgAST = &ast.Package{
 						Line:   7,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 15,
@@ -63,12 +70,14 @@ var pkgAST = &ast.Package{
 							Line:   7,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "scrape",
 			},
 		}, &ast.VariableAssignment{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: []ast.Comment{ast.Comment{Text: "// histogramQuantile enables the user to calculate quantiles on a set of given values"}, ast.Comment{Text: "// This function assumes that the given histogram data is being scraped or read from a "}, ast.Comment{Text: "// Prometheus source. "}},
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 58,
@@ -81,10 +90,12 @@ var pkgAST = &ast.Package{
 						Line:   12,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 18,
@@ -97,12 +108,14 @@ var pkgAST = &ast.Package{
 							Line:   12,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "histogramQuantile",
 			},
 			Init: &ast.FunctionExpression{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 58,
@@ -115,6 +128,7 @@ var pkgAST = &ast.Package{
 							Line:   12,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Body: &ast.PipeExpression{
 					Argument: &ast.PipeExpression{
@@ -122,7 +136,8 @@ var pkgAST = &ast.Package{
 							Argument: &ast.PipeExpression{
 								Argument: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 11,
@@ -135,11 +150,13 @@ var pkgAST = &ast.Package{
 												Line:   13,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "tables",
 								},
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 61,
@@ -152,11 +169,13 @@ var pkgAST = &ast.Package{
 											Line:   13,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Call: &ast.CallExpression{
 									Arguments: []ast.Expression{&ast.ObjectExpression{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 60,
@@ -169,10 +188,12 @@ var pkgAST = &ast.Package{
 													Line:   14,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Properties: []*ast.Property{&ast.Property{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 60,
@@ -185,10 +206,12 @@ var pkgAST = &ast.Package{
 														Line:   14,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Key: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 21,
@@ -201,12 +224,14 @@ var pkgAST = &ast.Package{
 															Line:   14,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "fn",
 											},
 											Value: &ast.FunctionExpression{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 60,
@@ -219,10 +244,12 @@ var pkgAST = &ast.Package{
 															Line:   14,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Body: &ast.BinaryExpression{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 60,
@@ -235,10 +262,12 @@ var pkgAST = &ast.Package{
 																Line:   14,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Left: &ast.MemberExpression{
 														BaseNode: ast.BaseNode{
-															Errors: nil,
+															Comments: nil,
+															Errors:   nil,
 															Loc: &ast.SourceLocation{
 																End: ast.Position{
 																	Column: 44,
@@ -251,10 +280,12 @@ var pkgAST = &ast.Package{
 																	Line:   14,
 																},
 															},
+															TrailingComment: nil,
 														},
 														Object: &ast.Identifier{
 															BaseNode: ast.BaseNode{
-																Errors: nil,
+																Comments: nil,
+																Errors:   nil,
 																Loc: &ast.SourceLocation{
 																	End: ast.Position{
 																		Column: 31,
@@ -267,12 +298,14 @@ var pkgAST = &ast.Package{
 																		Line:   14,
 																	},
 																},
+																TrailingComment: nil,
 															},
 															Name: "r",
 														},
 														Property: &ast.Identifier{
 															BaseNode: ast.BaseNode{
-																Errors: nil,
+																Comments: nil,
+																Errors:   nil,
 																Loc: &ast.SourceLocation{
 																	End: ast.Position{
 																		Column: 44,
@@ -285,6 +318,7 @@ var pkgAST = &ast.Package{
 																		Line:   14,
 																	},
 																},
+																TrailingComment: nil,
 															},
 															Name: "_measurement",
 														},
@@ -292,7 +326,8 @@ var pkgAST = &ast.Package{
 													Operator: 17,
 													Right: &ast.StringLiteral{
 														BaseNode: ast.BaseNode{
-															Errors: nil,
+															Comments: nil,
+															Errors:   nil,
 															Loc: &ast.SourceLocation{
 																End: ast.Position{
 																	Column: 60,
@@ -305,13 +340,15 @@ var pkgAST = &ast.Package{
 																	Line:   14,
 																},
 															},
+															TrailingComment: nil,
 														},
 														Value: "prometheus",
 													},
 												},
 												Params: []*ast.Property{&ast.Property{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 25,
@@ -324,10 +361,12 @@ var pkgAST = &ast.Package{
 																Line:   14,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Key: &ast.Identifier{
 														BaseNode: ast.BaseNode{
-															Errors: nil,
+															Comments: nil,
+															Errors:   nil,
 															Loc: &ast.SourceLocation{
 																End: ast.Position{
 																	Column: 25,
@@ -340,6 +379,7 @@ var pkgAST = &ast.Package{
 																	Line:   14,
 																},
 															},
+															TrailingComment: nil,
 														},
 														Name: "r",
 													},
@@ -350,7 +390,8 @@ var pkgAST = &ast.Package{
 										With: nil,
 									}},
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 61,
@@ -363,10 +404,12 @@ var pkgAST = &ast.Package{
 												Line:   14,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Callee: &ast.Identifier{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 18,
@@ -379,13 +422,15 @@ var pkgAST = &ast.Package{
 													Line:   14,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Name: "filter",
 									},
 								},
 							},
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 69,
@@ -398,11 +443,13 @@ var pkgAST = &ast.Package{
 										Line:   13,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Call: &ast.CallExpression{
 								Arguments: []ast.Expression{&ast.ObjectExpression{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 68,
@@ -415,10 +462,12 @@ var pkgAST = &ast.Package{
 												Line:   15,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Properties: []*ast.Property{&ast.Property{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 32,
@@ -431,10 +480,12 @@ var pkgAST = &ast.Package{
 													Line:   15,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Key: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 22,
@@ -447,12 +498,14 @@ var pkgAST = &ast.Package{
 														Line:   15,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "mode",
 										},
 										Value: &ast.StringLiteral{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 32,
@@ -465,12 +518,14 @@ var pkgAST = &ast.Package{
 														Line:   15,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Value: "except",
 										},
 									}, &ast.Property{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 68,
@@ -483,10 +538,12 @@ var pkgAST = &ast.Package{
 													Line:   15,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Key: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 41,
@@ -499,12 +556,14 @@ var pkgAST = &ast.Package{
 														Line:   15,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "columns",
 										},
 										Value: &ast.ArrayExpression{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 68,
@@ -517,10 +576,12 @@ var pkgAST = &ast.Package{
 														Line:   15,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Elements: []ast.Expression{&ast.StringLiteral{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 48,
@@ -533,11 +594,13 @@ var pkgAST = &ast.Package{
 															Line:   15,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Value: "le",
 											}, &ast.StringLiteral{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 58,
@@ -550,11 +613,13 @@ var pkgAST = &ast.Package{
 															Line:   15,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Value: "_value",
 											}, &ast.StringLiteral{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 67,
@@ -567,6 +632,7 @@ var pkgAST = &ast.Package{
 															Line:   15,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Value: "_time",
 											}},
@@ -575,7 +641,8 @@ var pkgAST = &ast.Package{
 									With: nil,
 								}},
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 69,
@@ -588,10 +655,12 @@ var pkgAST = &ast.Package{
 											Line:   15,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Callee: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 17,
@@ -604,13 +673,15 @@ var pkgAST = &ast.Package{
 												Line:   15,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "group",
 								},
 							},
 						},
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 55,
@@ -623,11 +694,13 @@ var pkgAST = &ast.Package{
 									Line:   13,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Call: &ast.CallExpression{
 							Arguments: []ast.Expression{&ast.ObjectExpression{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 54,
@@ -640,10 +713,12 @@ var pkgAST = &ast.Package{
 											Line:   16,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Properties: []*ast.Property{&ast.Property{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 54,
@@ -656,10 +731,12 @@ var pkgAST = &ast.Package{
 												Line:   16,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Key: &ast.Identifier{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 18,
@@ -672,12 +749,14 @@ var pkgAST = &ast.Package{
 													Line:   16,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Name: "fn",
 									},
 									Value: &ast.FunctionExpression{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 54,
@@ -690,10 +769,12 @@ var pkgAST = &ast.Package{
 													Line:   16,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Body: &ast.ParenExpression{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 54,
@@ -706,10 +787,12 @@ var pkgAST = &ast.Package{
 														Line:   16,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Expression: &ast.ObjectExpression{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 53,
@@ -722,10 +805,12 @@ var pkgAST = &ast.Package{
 															Line:   16,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Properties: []*ast.Property{&ast.Property{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 52,
@@ -738,10 +823,12 @@ var pkgAST = &ast.Package{
 																Line:   16,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Key: &ast.Identifier{
 														BaseNode: ast.BaseNode{
-															Errors: nil,
+															Comments: nil,
+															Errors:   nil,
 															Loc: &ast.SourceLocation{
 																End: ast.Position{
 																	Column: 37,
@@ -754,13 +841,15 @@ var pkgAST = &ast.Package{
 																	Line:   16,
 																},
 															},
+															TrailingComment: nil,
 														},
 														Name: "le",
 													},
 													Value: &ast.CallExpression{
 														Arguments: []ast.Expression{&ast.ObjectExpression{
 															BaseNode: ast.BaseNode{
-																Errors: nil,
+																Comments: nil,
+																Errors:   nil,
 																Loc: &ast.SourceLocation{
 																	End: ast.Position{
 																		Column: 51,
@@ -773,10 +862,12 @@ var pkgAST = &ast.Package{
 																		Line:   16,
 																	},
 																},
+																TrailingComment: nil,
 															},
 															Properties: []*ast.Property{&ast.Property{
 																BaseNode: ast.BaseNode{
-																	Errors: nil,
+																	Comments: nil,
+																	Errors:   nil,
 																	Loc: &ast.SourceLocation{
 																		End: ast.Position{
 																			Column: 51,
@@ -789,10 +880,12 @@ var pkgAST = &ast.Package{
 																			Line:   16,
 																		},
 																	},
+																	TrailingComment: nil,
 																},
 																Key: &ast.Identifier{
 																	BaseNode: ast.BaseNode{
-																		Errors: nil,
+																		Comments: nil,
+																		Errors:   nil,
 																		Loc: &ast.SourceLocation{
 																			End: ast.Position{
 																				Column: 46,
@@ -805,12 +898,14 @@ var pkgAST = &ast.Package{
 																				Line:   16,
 																			},
 																		},
+																		TrailingComment: nil,
 																	},
 																	Name: "v",
 																},
 																Value: &ast.MemberExpression{
 																	BaseNode: ast.BaseNode{
-																		Errors: nil,
+																		Comments: nil,
+																		Errors:   nil,
 																		Loc: &ast.SourceLocation{
 																			End: ast.Position{
 																				Column: 51,
@@ -823,10 +918,12 @@ var pkgAST = &ast.Package{
 																				Line:   16,
 																			},
 																		},
+																		TrailingComment: nil,
 																	},
 																	Object: &ast.Identifier{
 																		BaseNode: ast.BaseNode{
-																			Errors: nil,
+																			Comments: nil,
+																			Errors:   nil,
 																			Loc: &ast.SourceLocation{
 																				End: ast.Position{
 																					Column: 48,
@@ -839,12 +936,14 @@ var pkgAST = &ast.Package{
 																					Line:   16,
 																				},
 																			},
+																			TrailingComment: nil,
 																		},
 																		Name: "r",
 																	},
 																	Property: &ast.Identifier{
 																		BaseNode: ast.BaseNode{
-																			Errors: nil,
+																			Comments: nil,
+																			Errors:   nil,
 																			Loc: &ast.SourceLocation{
 																				End: ast.Position{
 																					Column: 51,
@@ -857,6 +956,7 @@ var pkgAST = &ast.Package{
 																					Line:   16,
 																				},
 																			},
+																			TrailingComment: nil,
 																		},
 																		Name: "le",
 																	},
@@ -865,7 +965,8 @@ var pkgAST = &ast.Package{
 															With: nil,
 														}},
 														BaseNode: ast.BaseNode{
-															Errors: nil,
+															Comments: nil,
+															Errors:   nil,
 															Loc: &ast.SourceLocation{
 																End: ast.Position{
 																	Column: 52,
@@ -878,10 +979,12 @@ var pkgAST = &ast.Package{
 																	Line:   16,
 																},
 															},
+															TrailingComment: nil,
 														},
 														Callee: &ast.Identifier{
 															BaseNode: ast.BaseNode{
-																Errors: nil,
+																Comments: nil,
+																Errors:   nil,
 																Loc: &ast.SourceLocation{
 																	End: ast.Position{
 																		Column: 44,
@@ -894,6 +997,7 @@ var pkgAST = &ast.Package{
 																		Line:   16,
 																	},
 																},
+																TrailingComment: nil,
 															},
 															Name: "float",
 														},
@@ -901,7 +1005,8 @@ var pkgAST = &ast.Package{
 												}},
 												With: &ast.Identifier{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 29,
@@ -914,6 +1019,7 @@ var pkgAST = &ast.Package{
 																Line:   16,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Name: "r",
 												},
@@ -921,7 +1027,8 @@ var pkgAST = &ast.Package{
 										},
 										Params: []*ast.Property{&ast.Property{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 21,
@@ -934,10 +1041,12 @@ var pkgAST = &ast.Package{
 														Line:   16,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Key: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 21,
@@ -950,6 +1059,7 @@ var pkgAST = &ast.Package{
 															Line:   16,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "r",
 											},
@@ -960,7 +1070,8 @@ var pkgAST = &ast.Package{
 								With: nil,
 							}},
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 55,
@@ -973,10 +1084,12 @@ var pkgAST = &ast.Package{
 										Line:   16,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Callee: &ast.Identifier{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 15,
@@ -989,13 +1102,15 @@ var pkgAST = &ast.Package{
 											Line:   16,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Name: "map",
 							},
 						},
 					},
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 58,
@@ -1008,11 +1123,13 @@ var pkgAST = &ast.Package{
 								Line:   13,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Call: &ast.CallExpression{
 						Arguments: []ast.Expression{&ast.ObjectExpression{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 57,
@@ -1025,10 +1142,12 @@ var pkgAST = &ast.Package{
 										Line:   17,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Properties: []*ast.Property{&ast.Property{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 57,
@@ -1041,10 +1160,12 @@ var pkgAST = &ast.Package{
 											Line:   17,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Key: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 47,
@@ -1057,12 +1178,14 @@ var pkgAST = &ast.Package{
 												Line:   17,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "quantile",
 								},
 								Value: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 57,
@@ -1075,6 +1198,7 @@ var pkgAST = &ast.Package{
 												Line:   17,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "quantile",
 								},
@@ -1082,7 +1206,8 @@ var pkgAST = &ast.Package{
 							With: nil,
 						}},
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 58,
@@ -1095,10 +1220,12 @@ var pkgAST = &ast.Package{
 									Line:   17,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Callee: &ast.MemberExpression{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 38,
@@ -1111,10 +1238,12 @@ var pkgAST = &ast.Package{
 										Line:   17,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Object: &ast.Identifier{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 20,
@@ -1127,12 +1256,14 @@ var pkgAST = &ast.Package{
 											Line:   17,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Name: "universe",
 							},
 							Property: &ast.Identifier{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 38,
@@ -1145,6 +1276,7 @@ var pkgAST = &ast.Package{
 											Line:   17,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Name: "histogramQuantile",
 							},
@@ -1153,7 +1285,8 @@ var pkgAST = &ast.Package{
 				},
 				Params: []*ast.Property{&ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 31,
@@ -1166,10 +1299,12 @@ var pkgAST = &ast.Package{
 								Line:   12,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 28,
@@ -1182,11 +1317,13 @@ var pkgAST = &ast.Package{
 									Line:   12,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Name: "tables",
 					},
 					Value: &ast.PipeLiteral{BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 31,
@@ -1199,10 +1336,12 @@ var pkgAST = &ast.Package{
 								Line:   12,
 							},
 						},
+						TrailingComment: nil,
 					}},
 				}, &ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 41,
@@ -1215,10 +1354,12 @@ var pkgAST = &ast.Package{
 								Line:   12,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 41,
@@ -1231,6 +1372,7 @@ var pkgAST = &ast.Package{
 									Line:   12,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Name: "quantile",
 					},
@@ -1238,10 +1380,12 @@ var pkgAST = &ast.Package{
 				}},
 			},
 		}},
+		Eof: nil,
 		Imports: []*ast.ImportDeclaration{&ast.ImportDeclaration{
 			As: nil,
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 18,
@@ -1254,10 +1398,12 @@ var pkgAST = &ast.Package{
 						Line:   2,
 					},
 				},
+				TrailingComment: nil,
 			},
 			Path: &ast.StringLiteral{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 18,
@@ -1270,6 +1416,7 @@ var pkgAST = &ast.Package{
 							Line:   2,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Value: "universe",
 			},
@@ -1278,7 +1425,8 @@ var pkgAST = &ast.Package{
 		Name:     "prometheus.flux",
 		Package: &ast.PackageClause{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 19,
@@ -1291,10 +1439,12 @@ var pkgAST = &ast.Package{
 						Line:   1,
 					},
 				},
+				TrailingComment: nil,
 			},
 			Name: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 19,
@@ -1307,6 +1457,7 @@ var pkgAST = &ast.Package{
 							Line:   1,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "prometheus",
 			},
@@ -8,6 +8,7 @@ import (
 
 	"github.com/influxdata/flux"
 	"github.com/influxdata/flux/ast"
+	"github.com/influxdata/flux/dependencies/sideeffect"
 	"github.com/influxdata/flux/execute"
 	"github.com/influxdata/flux/execute/executetest"
 	"github.com/influxdata/flux/lang"
@@ -22,20 +23,14 @@ func init() {
 // list of end-to-end tests that are meant to be skipped and not run for various reasons
 var skip = map[string]map[string]string{
 	"universe": {
-		"string_max":                  "error: invalid use of function: *functions.MaxSelector has no implementation for type string (https://github.com/influxdata/platform/issues/224)",
-		"null_as_value":               "null not supported as value in influxql (https://github.com/influxdata/platform/issues/353)",
-		"string_interp":               "string interpolation not working as expected in flux (https://github.com/influxdata/platform/issues/404)",
-		"to":                          "to functions are not supported in the testing framework (https://github.com/influxdata/flux/issues/77)",
-		"covariance_missing_column_1": "need to support known errors in new test framework (https://github.com/influxdata/flux/issues/536)",
-		"covariance_missing_column_2": "need to support known errors in new test framework (https://github.com/influxdata/flux/issues/536)",
-		"drop_before_rename":          "need to support known errors in new test framework (https://github.com/influxdata/flux/issues/536)",
-		"drop_referenced":             "need to support known errors in new test framework (https://github.com/influxdata/flux/issues/536)",
-		"yield":                       "yield requires special test case (https://github.com/influxdata/flux/issues/535)",
-		"task_per_line":               "join produces inconsistent/racy results when table schemas do not match (https://github.com/influxdata/flux/issues/855)",
-		"integral_columns":            "aggregates changed to operate on just a single columnm.",
-	},
-	"http": {
-		"http_endpoint": "need ability to test side effects in e2e tests: https://github.com/influxdata/flux/issues/1723)",
+		"string_max":       "error: invalid use of function: *functions.MaxSelector has no implementation for type string (https://github.com/influxdata/platform/issues/224)",
+		"null_as_value":    "null not supported as value in influxql (https://github.com/influxdata/platform/issues/353)",
+		"string_interp":    "string interpolation not working as expected in flux (https://github.com/influxdata/platform/issues/404)",
+		"to":               "to functions are not supported in the testing framework (https://github.com/influxdata/flux/issues/77)",
+		"drop_referenced":  "filter no longer fails on a dropped column and the empty result cannot be written as a want stream",
+		"yield":            "yield requires special test case (https://github.com/influxdata/flux/issues/535)",
+		"task_per_line":    "join produces inconsistent/racy results when table schemas do not match (https://github.com/influxdata/flux/issues/855)",
+		"integral_columns": "aggregates changed to operate on just a single columnm.",
 	},
 	"testing/chronograf": {
 		"measurement_tag_keys":   "unskip chronograf flux tests once filter is refactored (https://github.com/influxdata/flux/issues/1289)",
@@ -138,6 +133,14 @@ func benchEndToEnd(b *testing.B, pkgs []*ast.Package) {
 
 func testFlux(t testing.TB, file *ast.File) flux.Statistics {
 	pkg := makeTestPackage(file)
+	// Type errors stop the package from being evaluated,
+	// so the test cases that expect them are checked first.
+	if failed, err := stdlib.TestingTypeError(pkg, flux.StdLib()); failed {
+		if err != nil {
+			t.Fatalf("unexpected error while compiling query: %v", err)
+		}
+		return flux.Statistics{}
+	}
 	pkg.Files = append(pkg.Files, stdlib.TestingRunCalls(pkg))
 	c := lang.ASTCompiler{AST: pkg}
 
@@ -161,6 +164,7 @@ func doTestRun(t testing.TB, c flux.Compiler) flux.Statistics {
 	}
 
 	ctx := executetest.NewTestExecuteDependencies().Inject(context.Background())
+	ctx = sideeffect.NewRecorder().Inject(ctx)
 	alloc := &memory.Allocator{}
 	r, err := program.Start(ctx, alloc)
 	if err != nil {
@@ -190,6 +194,7 @@ func doTestInspect(t testing.TB, c flux.Compiler) flux.Statistics {
 		t.Fatalf("unexpected error while compiling query: %v", err)
 	}
 	ctx := executetest.NewTestExecuteDependencies().Inject(context.Background())
+	ctx = sideeffect.NewRecorder().Inject(ctx)
 	alloc := &memory.Allocator{}
 	r, err := program.Start(ctx, alloc)
 	if err != nil {
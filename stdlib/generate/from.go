@@ -118,10 +118,19 @@ func (s *FromGeneratorProcedureSpec) Kind() plan.ProcedureKind {
 
 func (s *FromGeneratorProcedureSpec) Copy() plan.ProcedureSpec {
 	ns := new(FromGeneratorProcedureSpec)
-
+	*ns = *s
 	return ns
 }
 
+// Cost reports the number of rows that will be generated.
+// The generator always produces a single table.
+func (s *FromGeneratorProcedureSpec) Cost(inStats []plan.Statistics) (plan.Cost, plan.Statistics) {
+	return plan.Cost{CPU: s.Count}, plan.Statistics{
+		Cardinality:      s.Count,
+		GroupCardinality: 1,
+	}
+}
+
 func createFromGeneratorSource(prSpec plan.ProcedureSpec, dsid execute.DatasetID, a execute.Administration) (execute.Source, error) {
 	spec, ok := prSpec.(*FromGeneratorProcedureSpec)
 	if !ok {
@@ -13,12 +13,15 @@ func init() {
 
 var pkgAST = &ast.Package{
 	BaseNode: ast.BaseNode{
-		Errors: nil,
-		Loc:    nil,
+		Comments:        nil,
+		Errors:          nil,
+		Loc:             nil,
+		TrailingComment: nil,
 	},
 	Files: []*ast.File{&ast.File{
 		BaseNode: ast.BaseNode{
-			Errors: nil,
+			Comments: nil,
+			Errors:   nil,
 			Loc: &ast.SourceLocation{
 				End: ast.Position{
 					Column: 72,
@@ -31,10 +34,12 @@ var pkgAST = &ast.Package{
 					Line:   1,
 				},
 			},
+			TrailingComment: nil,
 		},
 		Body: []ast.Statement{&ast.BuiltinStatement{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: []ast.Comment{ast.Comment{Text: "// Post submits an HTTP post request to the specified URL with headers and data."}, ast.Comment{Text: "// The HTTP status code is returned."}},
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 13,
@@ -47,10 +52,12 @@ var pkgAST = &ast.Package{
 						Line:   7,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 13,
@@ -63,12 +70,14 @@ var pkgAST = &ast.Package{
 							Line:   7,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "post",
 			},
 		}, &ast.BuiltinStatement{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: []ast.Comment{ast.Comment{Text: "// basicAuth will take a username/password combination and return the authorization"}, ast.Comment{Text: "// header value."}},
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 18,
@@ -81,10 +90,12 @@ var pkgAST = &ast.Package{
 						Line:   11,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 18,
@@ -97,12 +108,14 @@ var pkgAST = &ast.Package{
 							Line:   11,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "basicAuth",
 			},
 		}, &ast.VariableAssignment{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 72,
@@ -115,10 +128,12 @@ var pkgAST = &ast.Package{
 						Line:   13,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 9,
@@ -131,12 +146,14 @@ var pkgAST = &ast.Package{
 							Line:   13,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "endpoint",
 			},
 			Init: &ast.FunctionExpression{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 72,
@@ -149,10 +166,12 @@ var pkgAST = &ast.Package{
 							Line:   13,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Body: &ast.FunctionExpression{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 72,
@@ -165,10 +184,12 @@ var pkgAST = &ast.Package{
 								Line:   14,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Body: &ast.FunctionExpression{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 72,
@@ -181,12 +202,14 @@ var pkgAST = &ast.Package{
 									Line:   15,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Body: &ast.PipeExpression{
 							Argument: &ast.PipeExpression{
 								Argument: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 19,
@@ -199,11 +222,13 @@ var pkgAST = &ast.Package{
 												Line:   16,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "tables",
 								},
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 19,
@@ -216,11 +241,13 @@ var pkgAST = &ast.Package{
 											Line:   16,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Call: &ast.CallExpression{
 									Arguments: []ast.Expression{&ast.ObjectExpression{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 18,
@@ -233,10 +260,12 @@ var pkgAST = &ast.Package{
 													Line:   17,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Properties: []*ast.Property{&ast.Property{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 18,
@@ -249,10 +278,12 @@ var pkgAST = &ast.Package{
 														Line:   17,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Key: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 26,
@@ -265,12 +296,14 @@ var pkgAST = &ast.Package{
 															Line:   17,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "fn",
 											},
 											Value: &ast.FunctionExpression{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 18,
@@ -283,10 +316,12 @@ var pkgAST = &ast.Package{
 															Line:   17,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Body: &ast.Block{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 18,
@@ -299,10 +334,12 @@ var pkgAST = &ast.Package{
 																Line:   17,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Body: []ast.Statement{&ast.VariableAssignment{
 														BaseNode: ast.BaseNode{
-															Errors: nil,
+															Comments: nil,
+															Errors:   nil,
 															Loc: &ast.SourceLocation{
 																End: ast.Position{
 																	Column: 38,
@@ -315,10 +352,12 @@ var pkgAST = &ast.Package{
 																	Line:   18,
 																},
 															},
+															TrailingComment: nil,
 														},
 														ID: &ast.Identifier{
 															BaseNode: ast.BaseNode{
-																Errors: nil,
+																Comments: nil,
+																Errors:   nil,
 																Loc: &ast.SourceLocation{
 																	End: ast.Position{
 																		Column: 24,
@@ -331,13 +370,15 @@ var pkgAST = &ast.Package{
 																		Line:   18,
 																	},
 																},
+																TrailingComment: nil,
 															},
 															Name: "obj",
 														},
 														Init: &ast.CallExpression{
 															Arguments: []ast.Expression{&ast.ObjectExpression{
 																BaseNode: ast.BaseNode{
-																	Errors: nil,
+																	Comments: nil,
+																	Errors:   nil,
 																	Loc: &ast.SourceLocation{
 																		End: ast.Position{
 																			Column: 37,
@@ -350,10 +391,12 @@ var pkgAST = &ast.Package{
 																			Line:   18,
 																		},
 																	},
+																	TrailingComment: nil,
 																},
 																Properties: []*ast.Property{&ast.Property{
 																	BaseNode: ast.BaseNode{
-																		Errors: nil,
+																		Comments: nil,
+																		Errors:   nil,
 																		Loc: &ast.SourceLocation{
 																			End: ast.Position{
 																				Column: 37,
@@ -366,10 +409,12 @@ var pkgAST = &ast.Package{
 																				Line:   18,
 																			},
 																		},
+																		TrailingComment: nil,
 																	},
 																	Key: &ast.Identifier{
 																		BaseNode: ast.BaseNode{
-																			Errors: nil,
+																			Comments: nil,
+																			Errors:   nil,
 																			Loc: &ast.SourceLocation{
 																				End: ast.Position{
 																					Column: 34,
@@ -382,12 +427,14 @@ var pkgAST = &ast.Package{
 																					Line:   18,
 																				},
 																			},
+																			TrailingComment: nil,
 																		},
 																		Name: "r",
 																	},
 																	Value: &ast.Identifier{
 																		BaseNode: ast.BaseNode{
-																			Errors: nil,
+																			Comments: nil,
+																			Errors:   nil,
 																			Loc: &ast.SourceLocation{
 																				End: ast.Position{
 																					Column: 37,
@@ -400,6 +447,7 @@ var pkgAST = &ast.Package{
 																					Line:   18,
 																				},
 																			},
+																			TrailingComment: nil,
 																		},
 																		Name: "r",
 																	},
@@ -407,7 +455,8 @@ var pkgAST = &ast.Package{
 																With: nil,
 															}},
 															BaseNode: ast.BaseNode{
-																Errors: nil,
+																Comments: nil,
+																Errors:   nil,
 																Loc: &ast.SourceLocation{
 																	End: ast.Position{
 																		Column: 38,
@@ -420,10 +469,12 @@ var pkgAST = &ast.Package{
 																		Line:   18,
 																	},
 																},
+																TrailingComment: nil,
 															},
 															Callee: &ast.Identifier{
 																BaseNode: ast.BaseNode{
-																	Errors: nil,
+																	Comments: nil,
+																	Errors:   nil,
 																	Loc: &ast.SourceLocation{
 																		End: ast.Position{
 																			Column: 32,
@@ -436,6 +487,7 @@ var pkgAST = &ast.Package{
 																			Line:   18,
 																		},
 																	},
+																	TrailingComment: nil,
 																},
 																Name: "mapFn",
 															},
@@ -443,7 +495,8 @@ var pkgAST = &ast.Package{
 													}, &ast.ReturnStatement{
 														Argument: &ast.ObjectExpression{
 															BaseNode: ast.BaseNode{
-																Errors: nil,
+																Comments: nil,
+																Errors:   nil,
 																Loc: &ast.SourceLocation{
 																	End: ast.Position{
 																		Column: 22,
@@ -456,10 +509,12 @@ var pkgAST = &ast.Package{
 																		Line:   19,
 																	},
 																},
+																TrailingComment: nil,
 															},
 															Properties: []*ast.Property{&ast.Property{
 																BaseNode: ast.BaseNode{
-																	Errors: nil,
+																	Comments: nil,
+																	Errors:   nil,
 																	Loc: &ast.SourceLocation{
 																		End: ast.Position{
 																			Column: 102,
@@ -472,10 +527,12 @@ var pkgAST = &ast.Package{
 																			Line:   20,
 																		},
 																	},
+																	TrailingComment: nil,
 																},
 																Key: &ast.Identifier{
 																	BaseNode: ast.BaseNode{
-																		Errors: nil,
+																		Comments: nil,
+																		Errors:   nil,
 																		Loc: &ast.SourceLocation{
 																			End: ast.Position{
 																				Column: 30,
@@ -488,13 +545,15 @@ var pkgAST = &ast.Package{
 																				Line:   20,
 																			},
 																		},
+																		TrailingComment: nil,
 																	},
 																	Name: "_sent",
 																},
 																Value: &ast.CallExpression{
 																	Arguments: []ast.Expression{&ast.ObjectExpression{
 																		BaseNode: ast.BaseNode{
-																			Errors: nil,
+																			Comments: nil,
+																			Errors:   nil,
 																			Loc: &ast.SourceLocation{
 																				End: ast.Position{
 																					Column: 101,
@@ -507,10 +566,12 @@ var pkgAST = &ast.Package{
 																					Line:   20,
 																				},
 																			},
+																			TrailingComment: nil,
 																		},
 																		Properties: []*ast.Property{&ast.Property{
 																			BaseNode: ast.BaseNode{
-																				Errors: nil,
+																				Comments: nil,
+																				Errors:   nil,
 																				Loc: &ast.SourceLocation{
 																					End: ast.Position{
 																						Column: 101,
@@ -523,10 +584,12 @@ var pkgAST = &ast.Package{
 																						Line:   20,
 																					},
 																				},
+																				TrailingComment: nil,
 																			},
 																			Key: &ast.Identifier{
 																				BaseNode: ast.BaseNode{
-																					Errors: nil,
+																					Comments: nil,
+																					Errors:   nil,
 																					Loc: &ast.SourceLocation{
 																						End: ast.Position{
 																							Column: 40,
@@ -539,12 +602,14 @@ var pkgAST = &ast.Package{
 																							Line:   20,
 																						},
 																					},
+																					TrailingComment: nil,
 																				},
 																				Name: "v",
 																			},
 																			Value: &ast.BinaryExpression{
 																				BaseNode: ast.BaseNode{
-																					Errors: nil,
+																					Comments: nil,
+																					Errors:   nil,
 																					Loc: &ast.SourceLocation{
 																						End: ast.Position{
 																							Column: 101,
@@ -557,10 +622,12 @@ var pkgAST = &ast.Package{
 																							Line:   20,
 																						},
 																					},
+																					TrailingComment: nil,
 																				},
 																				Left: &ast.IntegerLiteral{
 																					BaseNode: ast.BaseNode{
-																						Errors: nil,
+																						Comments: nil,
+																						Errors:   nil,
 																						Loc: &ast.SourceLocation{
 																							End: ast.Position{
 																								Column: 45,
@@ -573,6 +640,7 @@ var pkgAST = &ast.Package{
 																								Line:   20,
 																							},
 																						},
+																						TrailingComment: nil,
 																					},
 																					Value: int64(200),
 																				},
@@ -580,7 +648,8 @@ var pkgAST = &ast.Package{
 																				Right: &ast.CallExpression{
 																					Arguments: []ast.Expression{&ast.ObjectExpression{
 																						BaseNode: ast.BaseNode{
-																							Errors: nil,
+																							Comments: nil,
+																							Errors:   nil,
 																							Loc: &ast.SourceLocation{
 																								End: ast.Position{
 																									Column: 100,
@@ -593,10 +662,12 @@ var pkgAST = &ast.Package{
 																									Line:   20,
 																								},
 																							},
+																							TrailingComment: nil,
 																						},
 																						Properties: []*ast.Property{&ast.Property{
 																							BaseNode: ast.BaseNode{
-																								Errors: nil,
+																								Comments: nil,
+																								Errors:   nil,
 																								Loc: &ast.SourceLocation{
 																									End: ast.Position{
 																										Column: 62,
@@ -609,10 +680,12 @@ var pkgAST = &ast.Package{
 																										Line:   20,
 																									},
 																								},
+																								TrailingComment: nil,
 																							},
 																							Key: &ast.Identifier{
 																								BaseNode: ast.BaseNode{
-																									Errors: nil,
+																									Comments: nil,
+																									Errors:   nil,
 																									Loc: &ast.SourceLocation{
 																										End: ast.Position{
 																											Column: 57,
@@ -625,12 +698,14 @@ var pkgAST = &ast.Package{
 																											Line:   20,
 																										},
 																									},
+																									TrailingComment: nil,
 																								},
 																								Name: "url",
 																							},
 																							Value: &ast.Identifier{
 																								BaseNode: ast.BaseNode{
-																									Errors: nil,
+																									Comments: nil,
+																									Errors:   nil,
 																									Loc: &ast.SourceLocation{
 																										End: ast.Position{
 																											Column: 62,
@@ -643,12 +718,14 @@ var pkgAST = &ast.Package{
 																											Line:   20,
 																										},
 																									},
+																									TrailingComment: nil,
 																								},
 																								Name: "url",
 																							},
 																						}, &ast.Property{
 																							BaseNode: ast.BaseNode{
-																								Errors: nil,
+																								Comments: nil,
+																								Errors:   nil,
 																								Loc: &ast.SourceLocation{
 																									End: ast.Position{
 																										Column: 84,
@@ -661,10 +738,12 @@ var pkgAST = &ast.Package{
 																										Line:   20,
 																									},
 																								},
+																								TrailingComment: nil,
 																							},
 																							Key: &ast.Identifier{
 																								BaseNode: ast.BaseNode{
-																									Errors: nil,
+																									Comments: nil,
+																									Errors:   nil,
 																									Loc: &ast.SourceLocation{
 																										End: ast.Position{
 																											Column: 71,
@@ -677,12 +756,14 @@ var pkgAST = &ast.Package{
 																											Line:   20,
 																										},
 																									},
+																									TrailingComment: nil,
 																								},
 																								Name: "headers",
 																							},
 																							Value: &ast.MemberExpression{
 																								BaseNode: ast.BaseNode{
-																									Errors: nil,
+																									Comments: nil,
+																									Errors:   nil,
 																									Loc: &ast.SourceLocation{
 																										End: ast.Position{
 																											Column: 84,
@@ -695,10 +776,12 @@ var pkgAST = &ast.Package{
 																											Line:   20,
 																										},
 																									},
+																									TrailingComment: nil,
 																								},
 																								Object: &ast.Identifier{
 																									BaseNode: ast.BaseNode{
-																										Errors: nil,
+																										Comments: nil,
+																										Errors:   nil,
 																										Loc: &ast.SourceLocation{
 																											End: ast.Position{
 																												Column: 76,
@@ -711,12 +794,14 @@ var pkgAST = &ast.Package{
 																												Line:   20,
 																											},
 																										},
+																										TrailingComment: nil,
 																									},
 																									Name: "obj",
 																								},
 																								Property: &ast.Identifier{
 																									BaseNode: ast.BaseNode{
-																										Errors: nil,
+																										Comments: nil,
+																										Errors:   nil,
 																										Loc: &ast.SourceLocation{
 																											End: ast.Position{
 																												Column: 84,
@@ -729,13 +814,15 @@ var pkgAST = &ast.Package{
 																												Line:   20,
 																											},
 																										},
+																										TrailingComment: nil,
 																									},
 																									Name: "headers",
 																								},
 																							},
 																						}, &ast.Property{
 																							BaseNode: ast.BaseNode{
-																								Errors: nil,
+																								Comments: nil,
+																								Errors:   nil,
 																								Loc: &ast.SourceLocation{
 																									End: ast.Position{
 																										Column: 100,
@@ -748,10 +835,12 @@ var pkgAST = &ast.Package{
 																										Line:   20,
 																									},
 																								},
+																								TrailingComment: nil,
 																							},
 																							Key: &ast.Identifier{
 																								BaseNode: ast.BaseNode{
-																									Errors: nil,
+																									Comments: nil,
+																									Errors:   nil,
 																									Loc: &ast.SourceLocation{
 																										End: ast.Position{
 																											Column: 90,
@@ -764,12 +853,14 @@ var pkgAST = &ast.Package{
 																											Line:   20,
 																										},
 																									},
+																									TrailingComment: nil,
 																								},
 																								Name: "data",
 																							},
 																							Value: &ast.MemberExpression{
 																								BaseNode: ast.BaseNode{
-																									Errors: nil,
+																									Comments: nil,
+																									Errors:   nil,
 																									Loc: &ast.SourceLocation{
 																										End: ast.Position{
 																											Column: 100,
@@ -782,10 +873,12 @@ var pkgAST = &ast.Package{
 																											Line:   20,
 																										},
 																									},
+																									TrailingComment: nil,
 																								},
 																								Object: &ast.Identifier{
 																									BaseNode: ast.BaseNode{
-																										Errors: nil,
+																										Comments: nil,
+																										Errors:   nil,
 																										Loc: &ast.SourceLocation{
 																											End: ast.Position{
 																												Column: 95,
@@ -798,12 +891,14 @@ var pkgAST = &ast.Package{
 																												Line:   20,
 																											},
 																										},
+																										TrailingComment: nil,
 																									},
 																									Name: "obj",
 																								},
 																								Property: &ast.Identifier{
 																									BaseNode: ast.BaseNode{
-																										Errors: nil,
+																										Comments: nil,
+																										Errors:   nil,
 																										Loc: &ast.SourceLocation{
 																											End: ast.Position{
 																												Column: 100,
@@ -816,6 +911,7 @@ var pkgAST = &ast.Package{
 																												Line:   20,
 																											},
 																										},
+																										TrailingComment: nil,
 																									},
 																									Name: "data",
 																								},
@@ -824,7 +920,8 @@ var pkgAST = &ast.Package{
 																						With: nil,
 																					}},
 																					BaseNode: ast.BaseNode{
-																						Errors: nil,
+																						Comments: nil,
+																						Errors:   nil,
 																						Loc: &ast.SourceLocation{
 																							End: ast.Position{
 																								Column: 101,
@@ -837,10 +934,12 @@ var pkgAST = &ast.Package{
 																								Line:   20,
 																							},
 																						},
+																						TrailingComment: nil,
 																					},
 																					Callee: &ast.Identifier{
 																						BaseNode: ast.BaseNode{
-																							Errors: nil,
+																							Comments: nil,
+																							Errors:   nil,
 																							Loc: &ast.SourceLocation{
 																								End: ast.Position{
 																									Column: 53,
@@ -853,6 +952,7 @@ var pkgAST = &ast.Package{
 																									Line:   20,
 																								},
 																							},
+																							TrailingComment: nil,
 																						},
 																						Name: "post",
 																					},
@@ -862,7 +962,8 @@ var pkgAST = &ast.Package{
 																		With: nil,
 																	}},
 																	BaseNode: ast.BaseNode{
-																		Errors: nil,
+																		Comments: nil,
+																		Errors:   nil,
 																		Loc: &ast.SourceLocation{
 																			End: ast.Position{
 																				Column: 102,
@@ -875,10 +976,12 @@ var pkgAST = &ast.Package{
 																				Line:   20,
 																			},
 																		},
+																		TrailingComment: nil,
 																	},
 																	Callee: &ast.Identifier{
 																		BaseNode: ast.BaseNode{
-																			Errors: nil,
+																			Comments: nil,
+																			Errors:   nil,
 																			Loc: &ast.SourceLocation{
 																				End: ast.Position{
 																					Column: 38,
@@ -891,6 +994,7 @@ var pkgAST = &ast.Package{
 																					Line:   20,
 																				},
 																			},
+																			TrailingComment: nil,
 																		},
 																		Name: "string",
 																	},
@@ -898,7 +1002,8 @@ var pkgAST = &ast.Package{
 															}},
 															With: &ast.Identifier{
 																BaseNode: ast.BaseNode{
-																	Errors: nil,
+																	Comments: nil,
+																	Errors:   nil,
 																	Loc: &ast.SourceLocation{
 																		End: ast.Position{
 																			Column: 30,
@@ -911,12 +1016,14 @@ var pkgAST = &ast.Package{
 																			Line:   19,
 																		},
 																	},
+																	TrailingComment: nil,
 																},
 																Name: "r",
 															},
 														},
 														BaseNode: ast.BaseNode{
-															Errors: nil,
+															Comments: nil,
+															Errors:   nil,
 															Loc: &ast.SourceLocation{
 																End: ast.Position{
 																	Column: 22,
@@ -929,12 +1036,14 @@ var pkgAST = &ast.Package{
 																	Line:   19,
 																},
 															},
+															TrailingComment: nil,
 														},
 													}},
 												},
 												Params: []*ast.Property{&ast.Property{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 30,
@@ -947,10 +1056,12 @@ var pkgAST = &ast.Package{
 																Line:   17,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Key: &ast.Identifier{
 														BaseNode: ast.BaseNode{
-															Errors: nil,
+															Comments: nil,
+															Errors:   nil,
 															Loc: &ast.SourceLocation{
 																End: ast.Position{
 																	Column: 30,
@@ -963,6 +1074,7 @@ var pkgAST = &ast.Package{
 																	Line:   17,
 																},
 															},
+															TrailingComment: nil,
 														},
 														Name: "r",
 													},
@@ -973,7 +1085,8 @@ var pkgAST = &ast.Package{
 										With: nil,
 									}},
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 19,
@@ -986,10 +1099,12 @@ var pkgAST = &ast.Package{
 												Line:   17,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Callee: &ast.Identifier{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 23,
@@ -1002,13 +1117,15 @@ var pkgAST = &ast.Package{
 													Line:   17,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Name: "map",
 									},
 								},
 							},
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 72,
@@ -1021,11 +1138,13 @@ var pkgAST = &ast.Package{
 										Line:   16,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Call: &ast.CallExpression{
 								Arguments: []ast.Expression{&ast.ObjectExpression{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 71,
@@ -1038,10 +1157,12 @@ var pkgAST = &ast.Package{
 												Line:   23,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Properties: []*ast.Property{&ast.Property{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 52,
@@ -1054,10 +1175,12 @@ var pkgAST = &ast.Package{
 													Line:   23,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Key: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 43,
@@ -1070,12 +1193,14 @@ var pkgAST = &ast.Package{
 														Line:   23,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "mode",
 										},
 										Value: &ast.StringLiteral{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 52,
@@ -1088,12 +1213,14 @@ var pkgAST = &ast.Package{
 														Line:   23,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Value: "extend",
 										},
 									}, &ast.Property{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 71,
@@ -1106,10 +1233,12 @@ var pkgAST = &ast.Package{
 													Line:   23,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Key: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 61,
@@ -1122,12 +1251,14 @@ var pkgAST = &ast.Package{
 														Line:   23,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "columns",
 										},
 										Value: &ast.ArrayExpression{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 71,
@@ -1140,10 +1271,12 @@ var pkgAST = &ast.Package{
 														Line:   23,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Elements: []ast.Expression{&ast.StringLiteral{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 70,
@@ -1156,6 +1289,7 @@ var pkgAST = &ast.Package{
 															Line:   23,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Value: "_sent",
 											}},
@@ -1164,7 +1298,8 @@ var pkgAST = &ast.Package{
 									With: nil,
 								}},
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 72,
@@ -1177,10 +1312,12 @@ var pkgAST = &ast.Package{
 											Line:   23,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Callee: &ast.MemberExpression{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 38,
@@ -1193,10 +1330,12 @@ var pkgAST = &ast.Package{
 												Line:   23,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Object: &ast.Identifier{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 32,
@@ -1209,12 +1348,14 @@ var pkgAST = &ast.Package{
 													Line:   23,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Name: "experimental",
 									},
 									Property: &ast.Identifier{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 38,
@@ -1227,6 +1368,7 @@ var pkgAST = &ast.Package{
 													Line:   23,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Name: "group",
 									},
@@ -1235,7 +1377,8 @@ var pkgAST = &ast.Package{
 						},
 						Params: []*ast.Property{&ast.Property{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 19,
@@ -1248,10 +1391,12 @@ var pkgAST = &ast.Package{
 										Line:   15,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Key: &ast.Identifier{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 16,
@@ -1264,11 +1409,13 @@ var pkgAST = &ast.Package{
 											Line:   15,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Name: "tables",
 							},
 							Value: &ast.PipeLiteral{BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 19,
@@ -1281,12 +1428,14 @@ var pkgAST = &ast.Package{
 										Line:   15,
 									},
 								},
+								TrailingComment: nil,
 							}},
 						}},
 					},
 					Params: []*ast.Property{&ast.Property{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 11,
@@ -1299,10 +1448,12 @@ var pkgAST = &ast.Package{
 									Line:   14,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Key: &ast.Identifier{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 11,
@@ -1315,6 +1466,7 @@ var pkgAST = &ast.Package{
 										Line:   14,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Name: "mapFn",
 						},
@@ -1323,7 +1475,8 @@ var pkgAST = &ast.Package{
 				},
 				Params: []*ast.Property{&ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 17,
@@ -1336,10 +1489,12 @@ var pkgAST = &ast.Package{
 								Line:   13,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 17,
@@ -1352,6 +1507,7 @@ var pkgAST = &ast.Package{
 									Line:   13,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Name: "url",
 					},
@@ -1359,10 +1515,12 @@ var pkgAST = &ast.Package{
 				}},
 			},
 		}},
+		Eof: nil,
 		Imports: []*ast.ImportDeclaration{&ast.ImportDeclaration{
 			As: nil,
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 22,
@@ -1375,10 +1533,12 @@ var pkgAST = &ast.Package{
 						Line:   3,
 					},
 				},
+				TrailingComment: nil,
 			},
 			Path: &ast.StringLiteral{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 22,
@@ -1391,6 +1551,7 @@ var pkgAST = &ast.Package{
 							Line:   3,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Value: "experimental",
 			},
@@ -1399,7 +1560,8 @@ var pkgAST = &ast.Package{
 		Name:     "http.flux",
 		Package: &ast.PackageClause{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 13,
@@ -1412,10 +1574,12 @@ var pkgAST = &ast.Package{
 						Line:   1,
 					},
 				},
+				TrailingComment: nil,
 			},
 			Name: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 13,
@@ -1428,6 +1592,7 @@ var pkgAST = &ast.Package{
 							Line:   1,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "http",
 			},
@@ -9,12 +9,15 @@ import (
 
 var FluxTestPackages = []*ast.Package{&ast.Package{
 	BaseNode: ast.BaseNode{
-		Errors: nil,
-		Loc:    nil,
+		Comments:        nil,
+		Errors:          nil,
+		Loc:             nil,
+		TrailingComment: nil,
 	},
 	Files: []*ast.File{&ast.File{
 		BaseNode: ast.BaseNode{
-			Errors: nil,
+			Comments: nil,
+			Errors:   nil,
 			Loc: &ast.SourceLocation{
 				End: ast.Position{
 					Column: 95,
@@ -27,11 +30,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 					Line:   1,
 				},
 			},
+			TrailingComment: nil,
 		},
 		Body: []ast.Statement{&ast.OptionStatement{
 			Assignment: &ast.VariableAssignment{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 42,
@@ -44,10 +49,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   7,
 						},
 					},
+					TrailingComment: nil,
 				},
 				ID: &ast.Identifier{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 11,
@@ -60,12 +67,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   7,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Name: "now",
 				},
 				Init: &ast.FunctionExpression{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 42,
@@ -78,10 +87,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   7,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Body: &ast.ParenExpression{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 42,
@@ -94,10 +105,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Line:   7,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Expression: &ast.DateTimeLiteral{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 41,
@@ -110,6 +123,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										Line:   7,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Value: parser.MustParseTime("2030-01-01T00:00:00Z"),
 						},
@@ -118,7 +132,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 				},
 			},
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 42,
@@ -131,10 +146,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   7,
 					},
 				},
+				TrailingComment: nil,
 			},
 		}, &ast.VariableAssignment{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 2,
@@ -147,10 +164,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   9,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 7,
@@ -163,12 +182,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   9,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "inData",
 			},
 			Init: &ast.StringLiteral{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 2,
@@ -181,12 +202,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   9,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Value: "\n#datatype,string,long,dateTime:RFC3339,double,string,string,string,string,string,string\n#group,false,false,false,false,true,true,true,true,true,true\n#default,_result,,,,,,,,,\n,result,table,_time,_value,_field,_measurement,device,fstype,host,path\n,,0,2018-05-22T00:00:00Z,1,used_percent,disk,disk1s1,apfs,host.local,/\n,,0,2018-05-22T00:00:10Z,2,used_percent,disk,disk1s1,apfs,host.local,/\n,,0,2018-05-22T00:00:20Z,3,used_percent,disk,disk1s1,apfs,host.local,/\n",
 			},
 		}, &ast.VariableAssignment{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 2,
@@ -199,10 +222,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   19,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 8,
@@ -215,12 +240,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   19,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "outData",
 			},
 			Init: &ast.StringLiteral{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 2,
@@ -233,12 +260,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   19,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Value: "\n#datatype,string,long,dateTime:RFC3339,double,string,string,string,string,string,string,string\n#group,false,false,false,false,true,true,true,true,true,true,true\n#default,_result,,,,,,,,,,\n,result,table,_time,_value,_field,_measurement,device,fstype,host,path,_sent\n,,0,2018-05-22T00:00:00Z,1,used_percent,disk,disk1s1,apfs,host.local,/,true\n,,0,2018-05-22T00:00:10Z,2,used_percent,disk,disk1s1,apfs,host.local,/,true\n,,0,2018-05-22T00:00:20Z,3,used_percent,disk,disk1s1,apfs,host.local,/,true\n",
 			},
 		}, &ast.VariableAssignment{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 54,
@@ -251,10 +280,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   29,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 9,
@@ -267,13 +298,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   29,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "endpoint",
 			},
 			Init: &ast.CallExpression{
 				Arguments: []ast.Expression{&ast.ObjectExpression{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 53,
@@ -286,10 +319,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   29,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Properties: []*ast.Property{&ast.Property{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 53,
@@ -302,10 +337,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Line:   29,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Key: &ast.Identifier{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 29,
@@ -318,12 +355,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										Line:   29,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Name: "url",
 						},
 						Value: &ast.StringLiteral{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 53,
@@ -336,6 +375,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										Line:   29,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Value: "http://localhost:7777",
 						},
@@ -343,7 +383,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 					With: nil,
 				}},
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 54,
@@ -356,10 +397,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   29,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Callee: &ast.MemberExpression{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 25,
@@ -372,10 +415,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   29,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Object: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 16,
@@ -388,12 +433,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Line:   29,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Name: "http",
 					},
 					Property: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 25,
@@ -406,6 +453,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Line:   29,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Name: "endpoint",
 					},
@@ -413,7 +461,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 			},
 		}, &ast.VariableAssignment{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 64,
@@ -426,10 +475,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   31,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 5,
@@ -442,12 +493,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   31,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "post",
 			},
 			Init: &ast.FunctionExpression{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 64,
@@ -460,13 +513,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   31,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Body: &ast.PipeExpression{
 					Argument: &ast.PipeExpression{
 						Argument: &ast.PipeExpression{
 							Argument: &ast.Identifier{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 10,
@@ -479,11 +534,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   32,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Name: "table",
 							},
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 45,
@@ -496,11 +553,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										Line:   32,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Call: &ast.CallExpression{
 								Arguments: []ast.Expression{&ast.ObjectExpression{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 44,
@@ -513,10 +572,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   33,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Properties: []*ast.Property{&ast.Property{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 44,
@@ -529,10 +590,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   33,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Key: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 23,
@@ -545,12 +608,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   33,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "start",
 										},
 										Value: &ast.DateTimeLiteral{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 44,
@@ -563,6 +628,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   33,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Value: parser.MustParseTime("2018-05-22T00:00:00Z"),
 										},
@@ -570,7 +636,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									With: nil,
 								}},
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 45,
@@ -583,10 +650,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   33,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Callee: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 17,
@@ -599,13 +668,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   33,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "range",
 								},
 							},
 						},
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 46,
@@ -618,11 +689,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Line:   32,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Call: &ast.CallExpression{
 							Arguments: []ast.Expression{&ast.ObjectExpression{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 45,
@@ -635,10 +708,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   34,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Properties: []*ast.Property{&ast.Property{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 45,
@@ -651,10 +726,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   34,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Key: &ast.Identifier{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 24,
@@ -667,12 +744,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   34,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Name: "columns",
 									},
 									Value: &ast.ArrayExpression{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 45,
@@ -685,10 +764,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   34,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Elements: []ast.Expression{&ast.StringLiteral{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 35,
@@ -701,11 +782,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   34,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Value: "_start",
 										}, &ast.StringLiteral{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 44,
@@ -718,6 +801,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   34,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Value: "_stop",
 										}},
@@ -726,7 +810,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								With: nil,
 							}},
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 46,
@@ -739,10 +824,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										Line:   34,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Callee: &ast.Identifier{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 16,
@@ -755,13 +842,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   34,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Name: "drop",
 							},
 						},
 					},
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 64,
@@ -774,11 +863,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   32,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Call: &ast.CallExpression{
 						Arguments: nil,
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 64,
@@ -791,11 +882,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Line:   35,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Callee: &ast.CallExpression{
 							Arguments: []ast.Expression{&ast.ObjectExpression{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 61,
@@ -808,10 +901,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   35,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Properties: []*ast.Property{&ast.Property{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 61,
@@ -824,10 +919,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   35,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Key: &ast.Identifier{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 26,
@@ -840,12 +937,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   35,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Name: "mapFn",
 									},
 									Value: &ast.FunctionExpression{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 61,
@@ -858,10 +957,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   35,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Body: &ast.ParenExpression{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 61,
@@ -874,10 +975,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   35,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Expression: &ast.ObjectExpression{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 60,
@@ -890,10 +993,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   35,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Properties: []*ast.Property{&ast.Property{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 59,
@@ -906,10 +1011,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																Line:   35,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Key: &ast.Identifier{
 														BaseNode: ast.BaseNode{
-															Errors: nil,
+															Comments: nil,
+															Errors:   nil,
 															Loc: &ast.SourceLocation{
 																End: ast.Position{
 																	Column: 40,
@@ -922,13 +1029,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																	Line:   35,
 																},
 															},
+															TrailingComment: nil,
 														},
 														Name: "data",
 													},
 													Value: &ast.CallExpression{
 														Arguments: []ast.Expression{&ast.ObjectExpression{
 															BaseNode: ast.BaseNode{
-																Errors: nil,
+																Comments: nil,
+																Errors:   nil,
 																Loc: &ast.SourceLocation{
 																	End: ast.Position{
 																		Column: 58,
@@ -941,10 +1050,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																		Line:   35,
 																	},
 																},
+																TrailingComment: nil,
 															},
 															Properties: []*ast.Property{&ast.Property{
 																BaseNode: ast.BaseNode{
-																	Errors: nil,
+																	Comments: nil,
+																	Errors:   nil,
 																	Loc: &ast.SourceLocation{
 																		End: ast.Position{
 																			Column: 58,
@@ -957,10 +1068,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																			Line:   35,
 																		},
 																	},
+																	TrailingComment: nil,
 																},
 																Key: &ast.Identifier{
 																	BaseNode: ast.BaseNode{
-																		Errors: nil,
+																		Comments: nil,
+																		Errors:   nil,
 																		Loc: &ast.SourceLocation{
 																			End: ast.Position{
 																				Column: 55,
@@ -973,12 +1086,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																				Line:   35,
 																			},
 																		},
+																		TrailingComment: nil,
 																	},
 																	Name: "v",
 																},
 																Value: &ast.Identifier{
 																	BaseNode: ast.BaseNode{
-																		Errors: nil,
+																		Comments: nil,
+																		Errors:   nil,
 																		Loc: &ast.SourceLocation{
 																			End: ast.Position{
 																				Column: 58,
@@ -991,6 +1106,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																				Line:   35,
 																			},
 																		},
+																		TrailingComment: nil,
 																	},
 																	Name: "r",
 																},
@@ -998,7 +1114,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															With: nil,
 														}},
 														BaseNode: ast.BaseNode{
-															Errors: nil,
+															Comments: nil,
+															Errors:   nil,
 															Loc: &ast.SourceLocation{
 																End: ast.Position{
 																	Column: 59,
@@ -1011,10 +1128,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																	Line:   35,
 																},
 															},
+															TrailingComment: nil,
 														},
 														Callee: &ast.MemberExpression{
 															BaseNode: ast.BaseNode{
-																Errors: nil,
+																Comments: nil,
+																Errors:   nil,
 																Loc: &ast.SourceLocation{
 																	End: ast.Position{
 																		Column: 53,
@@ -1027,10 +1146,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																		Line:   35,
 																	},
 																},
+																TrailingComment: nil,
 															},
 															Object: &ast.Identifier{
 																BaseNode: ast.BaseNode{
-																	Errors: nil,
+																	Comments: nil,
+																	Errors:   nil,
 																	Loc: &ast.SourceLocation{
 																		End: ast.Position{
 																			Column: 46,
@@ -1043,12 +1164,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																			Line:   35,
 																		},
 																	},
+																	TrailingComment: nil,
 																},
 																Name: "json",
 															},
 															Property: &ast.Identifier{
 																BaseNode: ast.BaseNode{
-																	Errors: nil,
+																	Comments: nil,
+																	Errors:   nil,
 																	Loc: &ast.SourceLocation{
 																		End: ast.Position{
 																			Column: 53,
@@ -1061,6 +1184,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																			Line:   35,
 																		},
 																	},
+																	TrailingComment: nil,
 																},
 																Name: "encode",
 															},
@@ -1072,7 +1196,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										},
 										Params: []*ast.Property{&ast.Property{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 29,
@@ -1085,10 +1210,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   35,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Key: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 29,
@@ -1101,6 +1228,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   35,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "r",
 											},
@@ -1111,7 +1239,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								With: nil,
 							}},
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 62,
@@ -1124,10 +1253,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										Line:   35,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Callee: &ast.Identifier{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 20,
@@ -1140,6 +1271,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   35,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Name: "endpoint",
 							},
@@ -1148,7 +1280,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 				},
 				Params: []*ast.Property{&ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 17,
@@ -1161,10 +1294,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   31,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 14,
@@ -1177,11 +1312,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Line:   31,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Name: "table",
 					},
 					Value: &ast.PipeLiteral{BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 17,
@@ -1194,13 +1331,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   31,
 							},
 						},
+						TrailingComment: nil,
 					}},
 				}},
 			},
 		}, &ast.TestStatement{
 			Assignment: &ast.VariableAssignment{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 95,
@@ -1213,10 +1352,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   37,
 						},
 					},
+					TrailingComment: nil,
 				},
 				ID: &ast.Identifier{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 11,
@@ -1229,12 +1370,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   37,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Name: "_post",
 				},
 				Init: &ast.FunctionExpression{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 95,
@@ -1247,10 +1390,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   37,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Body: &ast.ParenExpression{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 95,
@@ -1263,10 +1408,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Line:   38,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Expression: &ast.ObjectExpression{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 94,
@@ -1279,10 +1426,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										Line:   38,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Properties: []*ast.Property{&ast.Property{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 46,
@@ -1295,10 +1444,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   38,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Key: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 12,
@@ -1311,13 +1462,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   38,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "input",
 								},
 								Value: &ast.CallExpression{
 									Arguments: []ast.Expression{&ast.ObjectExpression{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 45,
@@ -1330,10 +1483,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   38,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Properties: []*ast.Property{&ast.Property{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 45,
@@ -1346,10 +1501,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   38,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Key: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 37,
@@ -1362,12 +1519,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   38,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "csv",
 											},
 											Value: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 45,
@@ -1380,6 +1539,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   38,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "inData",
 											},
@@ -1387,7 +1547,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										With: nil,
 									}},
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 46,
@@ -1400,10 +1561,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   38,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Callee: &ast.MemberExpression{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 33,
@@ -1416,10 +1579,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   38,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Object: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 21,
@@ -1432,12 +1597,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   38,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "testing",
 										},
 										Property: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 33,
@@ -1450,6 +1617,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   38,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "loadStorage",
 										},
@@ -1457,7 +1625,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								},
 							}, &ast.Property{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 83,
@@ -1470,10 +1639,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   38,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Key: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 52,
@@ -1486,13 +1657,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   38,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "want",
 								},
 								Value: &ast.CallExpression{
 									Arguments: []ast.Expression{&ast.ObjectExpression{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 82,
@@ -1505,10 +1678,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   38,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Properties: []*ast.Property{&ast.Property{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 82,
@@ -1521,10 +1696,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   38,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Key: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 73,
@@ -1537,12 +1714,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   38,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "csv",
 											},
 											Value: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 82,
@@ -1555,6 +1734,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   38,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "outData",
 											},
@@ -1562,7 +1742,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										With: nil,
 									}},
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 83,
@@ -1575,10 +1756,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   38,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Callee: &ast.MemberExpression{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 69,
@@ -1591,10 +1774,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   38,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Object: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 61,
@@ -1607,12 +1792,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   38,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "testing",
 										},
 										Property: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 69,
@@ -1625,6 +1812,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   38,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "loadMem",
 										},
@@ -1632,7 +1820,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								},
 							}, &ast.Property{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 93,
@@ -1645,10 +1834,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   38,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Key: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 87,
@@ -1661,12 +1852,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   38,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "fn",
 								},
 								Value: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 93,
@@ -1679,6 +1872,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   38,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "post",
 								},
@@ -1690,7 +1884,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 				},
 			},
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 95,
@@ -1703,12 +1898,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   37,
 					},
 				},
+				TrailingComment: nil,
 			},
 		}},
+		Eof: nil,
 		Imports: []*ast.ImportDeclaration{&ast.ImportDeclaration{
 			As: nil,
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 17,
@@ -1721,10 +1919,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   3,
 					},
 				},
+				TrailingComment: nil,
 			},
 			Path: &ast.StringLiteral{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 17,
@@ -1737,13 +1937,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   3,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Value: "testing",
 			},
 		}, &ast.ImportDeclaration{
 			As: nil,
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 14,
@@ -1756,10 +1958,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   4,
 					},
 				},
+				TrailingComment: nil,
 			},
 			Path: &ast.StringLiteral{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 14,
@@ -1772,13 +1976,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   4,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Value: "http",
 			},
 		}, &ast.ImportDeclaration{
 			As: nil,
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 14,
@@ -1791,10 +1997,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   5,
 					},
 				},
+				TrailingComment: nil,
 			},
 			Path: &ast.StringLiteral{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 14,
@@ -1807,6 +2015,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   5,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Value: "json",
 			},
@@ -1815,7 +2024,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 		Name:     "http_endpoint_test.flux",
 		Package: &ast.PackageClause{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 18,
@@ -1828,10 +2038,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   1,
 					},
 				},
+				TrailingComment: nil,
 			},
 			Name: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 18,
@@ -1844,6 +2056,2347 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   1,
 						},
 					},
+					TrailingComment: nil,
+				},
+				Name: "http_test",
+			},
+		},
+	}, &ast.File{
+		BaseNode: ast.BaseNode{
+			Comments: nil,
+			Errors:   nil,
+			Loc: &ast.SourceLocation{
+				End: ast.Position{
+					Column: 108,
+					Line:   37,
+				},
+				File:   "http_post_side_effects_test.flux",
+				Source: "package http_test\n\nimport \"testing\"\nimport \"http\"\nimport \"json\"\n\noption now = () => (2030-01-01T00:00:00Z)\n\ninData = \"\n#datatype,string,long,dateTime:RFC3339,double,string,string\n#group,false,false,false,false,true,true\n#default,_result,,,,,\n,result,table,_time,_value,_field,_measurement\n,,0,2018-05-22T00:00:00Z,1,used_percent,disk\n,,0,2018-05-22T00:00:10Z,2,used_percent,disk\n\"\n\noutData = \"\n#datatype,string,long,string,string,string,string,string,string\n#group,false,false,false,false,false,false,false,false\n#default,_result,,,,,,,\n,result,table,kind,method,target,headers,data,args\n,,0,http,POST,http://localhost:7777,{},\\\"{\\\"\\\"time\\\"\\\":\\\"\\\"2018-05-22T00:00:00Z\\\"\\\",\\\"\\\"value\\\"\\\":1}\\\",[]\n,,0,http,POST,http://localhost:7777,{},\\\"{\\\"\\\"time\\\"\\\":\\\"\\\"2018-05-22T00:00:10Z\\\"\\\",\\\"\\\"value\\\"\\\":2}\\\",[]\n\"\n\nendpoint = http.endpoint(url: \"http://localhost:7777\")\n\npost_side_effects = (table=<-) =>\n    table\n        |> range(start: 2018-05-22T00:00:00Z)\n        |> drop(columns: [\"_start\", \"_stop\"])\n        |> endpoint(mapFn: (r) => ({data: json.encode(v: {time: r._time, value: r._value})}))()\n        |> testing.sideEffects()\n\ntest _post_side_effects = () =>\n    ({input: testing.loadStorage(csv: inData), want: testing.loadMem(csv: outData), fn: post_side_effects})",
+				Start: ast.Position{
+					Column: 1,
+					Line:   1,
+				},
+			},
+			TrailingComment: nil,
+		},
+		Body: []ast.Statement{&ast.OptionStatement{
+			Assignment: &ast.VariableAssignment{
+				BaseNode: ast.BaseNode{
+					Comments: nil,
+					Errors:   nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 42,
+							Line:   7,
+						},
+						File:   "http_post_side_effects_test.flux",
+						Source: "now = () => (2030-01-01T00:00:00Z)",
+						Start: ast.Position{
+							Column: 8,
+							Line:   7,
+						},
+					},
+					TrailingComment: nil,
+				},
+				ID: &ast.Identifier{
+					BaseNode: ast.BaseNode{
+						Comments: nil,
+						Errors:   nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 11,
+								Line:   7,
+							},
+							File:   "http_post_side_effects_test.flux",
+							Source: "now",
+							Start: ast.Position{
+								Column: 8,
+								Line:   7,
+							},
+						},
+						TrailingComment: nil,
+					},
+					Name: "now",
+				},
+				Init: &ast.FunctionExpression{
+					BaseNode: ast.BaseNode{
+						Comments: nil,
+						Errors:   nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 42,
+								Line:   7,
+							},
+							File:   "http_post_side_effects_test.flux",
+							Source: "() => (2030-01-01T00:00:00Z)",
+							Start: ast.Position{
+								Column: 14,
+								Line:   7,
+							},
+						},
+						TrailingComment: nil,
+					},
+					Body: &ast.ParenExpression{
+						BaseNode: ast.BaseNode{
+							Comments: nil,
+							Errors:   nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 42,
+									Line:   7,
+								},
+								File:   "http_post_side_effects_test.flux",
+								Source: "(2030-01-01T00:00:00Z)",
+								Start: ast.Position{
+									Column: 20,
+									Line:   7,
+								},
+							},
+							TrailingComment: nil,
+						},
+						Expression: &ast.DateTimeLiteral{
+							BaseNode: ast.BaseNode{
+								Comments: nil,
+								Errors:   nil,
+								Loc: &ast.SourceLocation{
+									End: ast.Position{
+										Column: 41,
+										Line:   7,
+									},
+									File:   "http_post_side_effects_test.flux",
+									Source: "2030-01-01T00:00:00Z",
+									Start: ast.Position{
+										Column: 21,
+										Line:   7,
+									},
+								},
+								TrailingComment: nil,
+							},
+							Value: parser.MustParseTime("2030-01-01T00:00:00Z"),
+						},
+					},
+					Params: nil,
+				},
+			},
+			BaseNode: ast.BaseNode{
+				Comments: nil,
+				Errors:   nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 42,
+						Line:   7,
+					},
+					File:   "http_post_side_effects_test.flux",
+					Source: "option now = () => (2030-01-01T00:00:00Z)",
+					Start: ast.Position{
+						Column: 1,
+						Line:   7,
+					},
+				},
+				TrailingComment: nil,
+			},
+		}, &ast.VariableAssignment{
+			BaseNode: ast.BaseNode{
+				Comments: nil,
+				Errors:   nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 2,
+						Line:   16,
+					},
+					File:   "http_post_side_effects_test.flux",
+					Source: "inData = \"\n#datatype,string,long,dateTime:RFC3339,double,string,string\n#group,false,false,false,false,true,true\n#default,_result,,,,,\n,result,table,_time,_value,_field,_measurement\n,,0,2018-05-22T00:00:00Z,1,used_percent,disk\n,,0,2018-05-22T00:00:10Z,2,used_percent,disk\n\"",
+					Start: ast.Position{
+						Column: 1,
+						Line:   9,
+					},
+				},
+				TrailingComment: nil,
+			},
+			ID: &ast.Identifier{
+				BaseNode: ast.BaseNode{
+					Comments: nil,
+					Errors:   nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 7,
+							Line:   9,
+						},
+						File:   "http_post_side_effects_test.flux",
+						Source: "inData",
+						Start: ast.Position{
+							Column: 1,
+							Line:   9,
+						},
+					},
+					TrailingComment: nil,
+				},
+				Name: "inData",
+			},
+			Init: &ast.StringLiteral{
+				BaseNode: ast.BaseNode{
+					Comments: nil,
+					Errors:   nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 2,
+							Line:   16,
+						},
+						File:   "http_post_side_effects_test.flux",
+						Source: "\"\n#datatype,string,long,dateTime:RFC3339,double,string,string\n#group,false,false,false,false,true,true\n#default,_result,,,,,\n,result,table,_time,_value,_field,_measurement\n,,0,2018-05-22T00:00:00Z,1,used_percent,disk\n,,0,2018-05-22T00:00:10Z,2,used_percent,disk\n\"",
+						Start: ast.Position{
+							Column: 10,
+							Line:   9,
+						},
+					},
+					TrailingComment: nil,
+				},
+				Value: "\n#datatype,string,long,dateTime:RFC3339,double,string,string\n#group,false,false,false,false,true,true\n#default,_result,,,,,\n,result,table,_time,_value,_field,_measurement\n,,0,2018-05-22T00:00:00Z,1,used_percent,disk\n,,0,2018-05-22T00:00:10Z,2,used_percent,disk\n",
+			},
+		}, &ast.VariableAssignment{
+			BaseNode: ast.BaseNode{
+				Comments: nil,
+				Errors:   nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 2,
+						Line:   25,
+					},
+					File:   "http_post_side_effects_test.flux",
+					Source: "outData = \"\n#datatype,string,long,string,string,string,string,string,string\n#group,false,false,false,false,false,false,false,false\n#default,_result,,,,,,,\n,result,table,kind,method,target,headers,data,args\n,,0,http,POST,http://localhost:7777,{},\\\"{\\\"\\\"time\\\"\\\":\\\"\\\"2018-05-22T00:00:00Z\\\"\\\",\\\"\\\"value\\\"\\\":1}\\\",[]\n,,0,http,POST,http://localhost:7777,{},\\\"{\\\"\\\"time\\\"\\\":\\\"\\\"2018-05-22T00:00:10Z\\\"\\\",\\\"\\\"value\\\"\\\":2}\\\",[]\n\"",
+					Start: ast.Position{
+						Column: 1,
+						Line:   18,
+					},
+				},
+				TrailingComment: nil,
+			},
+			ID: &ast.Identifier{
+				BaseNode: ast.BaseNode{
+					Comments: nil,
+					Errors:   nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 8,
+							Line:   18,
+						},
+						File:   "http_post_side_effects_test.flux",
+						Source: "outData",
+						Start: ast.Position{
+							Column: 1,
+							Line:   18,
+						},
+					},
+					TrailingComment: nil,
+				},
+				Name: "outData",
+			},
+			Init: &ast.StringLiteral{
+				BaseNode: ast.BaseNode{
+					Comments: nil,
+					Errors:   nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 2,
+							Line:   25,
+						},
+						File:   "http_post_side_effects_test.flux",
+						Source: "\"\n#datatype,string,long,string,string,string,string,string,string\n#group,false,false,false,false,false,false,false,false\n#default,_result,,,,,,,\n,result,table,kind,method,target,headers,data,args\n,,0,http,POST,http://localhost:7777,{},\\\"{\\\"\\\"time\\\"\\\":\\\"\\\"2018-05-22T00:00:00Z\\\"\\\",\\\"\\\"value\\\"\\\":1}\\\",[]\n,,0,http,POST,http://localhost:7777,{},\\\"{\\\"\\\"time\\\"\\\":\\\"\\\"2018-05-22T00:00:10Z\\\"\\\",\\\"\\\"value\\\"\\\":2}\\\",[]\n\"",
+						Start: ast.Position{
+							Column: 11,
+							Line:   18,
+						},
+					},
+					TrailingComment: nil,
+				},
+				Value: "\n#datatype,string,long,string,string,string,string,string,string\n#group,false,false,false,false,false,false,false,false\n#default,_result,,,,,,,\n,result,table,kind,method,target,headers,data,args\n,,0,http,POST,http://localhost:7777,{},\"{\"\"time\"\":\"\"2018-05-22T00:00:00Z\"\",\"\"value\"\":1}\",[]\n,,0,http,POST,http://localhost:7777,{},\"{\"\"time\"\":\"\"2018-05-22T00:00:10Z\"\",\"\"value\"\":2}\",[]\n",
+			},
+		}, &ast.VariableAssignment{
+			BaseNode: ast.BaseNode{
+				Comments: nil,
+				Errors:   nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 55,
+						Line:   27,
+					},
+					File:   "http_post_side_effects_test.flux",
+					Source: "endpoint = http.endpoint(url: \"http://localhost:7777\")",
+					Start: ast.Position{
+						Column: 1,
+						Line:   27,
+					},
+				},
+				TrailingComment: nil,
+			},
+			ID: &ast.Identifier{
+				BaseNode: ast.BaseNode{
+					Comments: nil,
+					Errors:   nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 9,
+							Line:   27,
+						},
+						File:   "http_post_side_effects_test.flux",
+						Source: "endpoint",
+						Start: ast.Position{
+							Column: 1,
+							Line:   27,
+						},
+					},
+					TrailingComment: nil,
+				},
+				Name: "endpoint",
+			},
+			Init: &ast.CallExpression{
+				Arguments: []ast.Expression{&ast.ObjectExpression{
+					BaseNode: ast.BaseNode{
+						Comments: nil,
+						Errors:   nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 54,
+								Line:   27,
+							},
+							File:   "http_post_side_effects_test.flux",
+							Source: "url: \"http://localhost:7777\"",
+							Start: ast.Position{
+								Column: 26,
+								Line:   27,
+							},
+						},
+						TrailingComment: nil,
+					},
+					Properties: []*ast.Property{&ast.Property{
+						BaseNode: ast.BaseNode{
+							Comments: nil,
+							Errors:   nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 54,
+									Line:   27,
+								},
+								File:   "http_post_side_effects_test.flux",
+								Source: "url: \"http://localhost:7777\"",
+								Start: ast.Position{
+									Column: 26,
+									Line:   27,
+								},
+							},
+							TrailingComment: nil,
+						},
+						Key: &ast.Identifier{
+							BaseNode: ast.BaseNode{
+								Comments: nil,
+								Errors:   nil,
+								Loc: &ast.SourceLocation{
+									End: ast.Position{
+										Column: 29,
+										Line:   27,
+									},
+									File:   "http_post_side_effects_test.flux",
+									Source: "url",
+									Start: ast.Position{
+										Column: 26,
+										Line:   27,
+									},
+								},
+								TrailingComment: nil,
+							},
+							Name: "url",
+						},
+						Value: &ast.StringLiteral{
+							BaseNode: ast.BaseNode{
+								Comments: nil,
+								Errors:   nil,
+								Loc: &ast.SourceLocation{
+									End: ast.Position{
+										Column: 54,
+										Line:   27,
+									},
+									File:   "http_post_side_effects_test.flux",
+									Source: "\"http://localhost:7777\"",
+									Start: ast.Position{
+										Column: 31,
+										Line:   27,
+									},
+								},
+								TrailingComment: nil,
+							},
+							Value: "http://localhost:7777",
+						},
+					}},
+					With: nil,
+				}},
+				BaseNode: ast.BaseNode{
+					Comments: nil,
+					Errors:   nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 55,
+							Line:   27,
+						},
+						File:   "http_post_side_effects_test.flux",
+						Source: "http.endpoint(url: \"http://localhost:7777\")",
+						Start: ast.Position{
+							Column: 12,
+							Line:   27,
+						},
+					},
+					TrailingComment: nil,
+				},
+				Callee: &ast.MemberExpression{
+					BaseNode: ast.BaseNode{
+						Comments: nil,
+						Errors:   nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 25,
+								Line:   27,
+							},
+							File:   "http_post_side_effects_test.flux",
+							Source: "http.endpoint",
+							Start: ast.Position{
+								Column: 12,
+								Line:   27,
+							},
+						},
+						TrailingComment: nil,
+					},
+					Object: &ast.Identifier{
+						BaseNode: ast.BaseNode{
+							Comments: nil,
+							Errors:   nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 16,
+									Line:   27,
+								},
+								File:   "http_post_side_effects_test.flux",
+								Source: "http",
+								Start: ast.Position{
+									Column: 12,
+									Line:   27,
+								},
+							},
+							TrailingComment: nil,
+						},
+						Name: "http",
+					},
+					Property: &ast.Identifier{
+						BaseNode: ast.BaseNode{
+							Comments: nil,
+							Errors:   nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 25,
+									Line:   27,
+								},
+								File:   "http_post_side_effects_test.flux",
+								Source: "endpoint",
+								Start: ast.Position{
+									Column: 17,
+									Line:   27,
+								},
+							},
+							TrailingComment: nil,
+						},
+						Name: "endpoint",
+					},
+				},
+			},
+		}, &ast.VariableAssignment{
+			BaseNode: ast.BaseNode{
+				Comments: nil,
+				Errors:   nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 33,
+						Line:   34,
+					},
+					File:   "http_post_side_effects_test.flux",
+					Source: "post_side_effects = (table=<-) =>\n    table\n        |> range(start: 2018-05-22T00:00:00Z)\n        |> drop(columns: [\"_start\", \"_stop\"])\n        |> endpoint(mapFn: (r) => ({data: json.encode(v: {time: r._time, value: r._value})}))()\n        |> testing.sideEffects()",
+					Start: ast.Position{
+						Column: 1,
+						Line:   29,
+					},
+				},
+				TrailingComment: nil,
+			},
+			ID: &ast.Identifier{
+				BaseNode: ast.BaseNode{
+					Comments: nil,
+					Errors:   nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 18,
+							Line:   29,
+						},
+						File:   "http_post_side_effects_test.flux",
+						Source: "post_side_effects",
+						Start: ast.Position{
+							Column: 1,
+							Line:   29,
+						},
+					},
+					TrailingComment: nil,
+				},
+				Name: "post_side_effects",
+			},
+			Init: &ast.FunctionExpression{
+				BaseNode: ast.BaseNode{
+					Comments: nil,
+					Errors:   nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 33,
+							Line:   34,
+						},
+						File:   "http_post_side_effects_test.flux",
+						Source: "(table=<-) =>\n    table\n        |> range(start: 2018-05-22T00:00:00Z)\n        |> drop(columns: [\"_start\", \"_stop\"])\n        |> endpoint(mapFn: (r) => ({data: json.encode(v: {time: r._time, value: r._value})}))()\n        |> testing.sideEffects()",
+						Start: ast.Position{
+							Column: 21,
+							Line:   29,
+						},
+					},
+					TrailingComment: nil,
+				},
+				Body: &ast.PipeExpression{
+					Argument: &ast.PipeExpression{
+						Argument: &ast.PipeExpression{
+							Argument: &ast.PipeExpression{
+								Argument: &ast.Identifier{
+									BaseNode: ast.BaseNode{
+										Comments: nil,
+										Errors:   nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 10,
+												Line:   30,
+											},
+											File:   "http_post_side_effects_test.flux",
+											Source: "table",
+											Start: ast.Position{
+												Column: 5,
+												Line:   30,
+											},
+										},
+										TrailingComment: nil,
+									},
+									Name: "table",
+								},
+								BaseNode: ast.BaseNode{
+									Comments: nil,
+									Errors:   nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 46,
+											Line:   31,
+										},
+										File:   "http_post_side_effects_test.flux",
+										Source: "table\n        |> range(start: 2018-05-22T00:00:00Z)",
+										Start: ast.Position{
+											Column: 5,
+											Line:   30,
+										},
+									},
+									TrailingComment: nil,
+								},
+								Call: &ast.CallExpression{
+									Arguments: []ast.Expression{&ast.ObjectExpression{
+										BaseNode: ast.BaseNode{
+											Comments: nil,
+											Errors:   nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 45,
+													Line:   31,
+												},
+												File:   "http_post_side_effects_test.flux",
+												Source: "start: 2018-05-22T00:00:00Z",
+												Start: ast.Position{
+													Column: 18,
+													Line:   31,
+												},
+											},
+											TrailingComment: nil,
+										},
+										Properties: []*ast.Property{&ast.Property{
+											BaseNode: ast.BaseNode{
+												Comments: nil,
+												Errors:   nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 45,
+														Line:   31,
+													},
+													File:   "http_post_side_effects_test.flux",
+													Source: "start: 2018-05-22T00:00:00Z",
+													Start: ast.Position{
+														Column: 18,
+														Line:   31,
+													},
+												},
+												TrailingComment: nil,
+											},
+											Key: &ast.Identifier{
+												BaseNode: ast.BaseNode{
+													Comments: nil,
+													Errors:   nil,
+													Loc: &ast.SourceLocation{
+														End: ast.Position{
+															Column: 23,
+															Line:   31,
+														},
+														File:   "http_post_side_effects_test.flux",
+														Source: "start",
+														Start: ast.Position{
+															Column: 18,
+															Line:   31,
+														},
+													},
+													TrailingComment: nil,
+												},
+												Name: "start",
+											},
+											Value: &ast.DateTimeLiteral{
+												BaseNode: ast.BaseNode{
+													Comments: nil,
+													Errors:   nil,
+													Loc: &ast.SourceLocation{
+														End: ast.Position{
+															Column: 45,
+															Line:   31,
+														},
+														File:   "http_post_side_effects_test.flux",
+														Source: "2018-05-22T00:00:00Z",
+														Start: ast.Position{
+															Column: 25,
+															Line:   31,
+														},
+													},
+													TrailingComment: nil,
+												},
+												Value: parser.MustParseTime("2018-05-22T00:00:00Z"),
+											},
+										}},
+										With: nil,
+									}},
+									BaseNode: ast.BaseNode{
+										Comments: nil,
+										Errors:   nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 46,
+												Line:   31,
+											},
+											File:   "http_post_side_effects_test.flux",
+											Source: "range(start: 2018-05-22T00:00:00Z)",
+											Start: ast.Position{
+												Column: 12,
+												Line:   31,
+											},
+										},
+										TrailingComment: nil,
+									},
+									Callee: &ast.Identifier{
+										BaseNode: ast.BaseNode{
+											Comments: nil,
+											Errors:   nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 17,
+													Line:   31,
+												},
+												File:   "http_post_side_effects_test.flux",
+												Source: "range",
+												Start: ast.Position{
+													Column: 12,
+													Line:   31,
+												},
+											},
+											TrailingComment: nil,
+										},
+										Name: "range",
+									},
+								},
+							},
+							BaseNode: ast.BaseNode{
+								Comments: nil,
+								Errors:   nil,
+								Loc: &ast.SourceLocation{
+									End: ast.Position{
+										Column: 46,
+										Line:   32,
+									},
+									File:   "http_post_side_effects_test.flux",
+									Source: "table\n        |> range(start: 2018-05-22T00:00:00Z)\n        |> drop(columns: [\"_start\", \"_stop\"])",
+									Start: ast.Position{
+										Column: 5,
+										Line:   30,
+									},
+								},
+								TrailingComment: nil,
+							},
+							Call: &ast.CallExpression{
+								Arguments: []ast.Expression{&ast.ObjectExpression{
+									BaseNode: ast.BaseNode{
+										Comments: nil,
+										Errors:   nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 45,
+												Line:   32,
+											},
+											File:   "http_post_side_effects_test.flux",
+											Source: "columns: [\"_start\", \"_stop\"]",
+											Start: ast.Position{
+												Column: 17,
+												Line:   32,
+											},
+										},
+										TrailingComment: nil,
+									},
+									Properties: []*ast.Property{&ast.Property{
+										BaseNode: ast.BaseNode{
+											Comments: nil,
+											Errors:   nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 45,
+													Line:   32,
+												},
+												File:   "http_post_side_effects_test.flux",
+												Source: "columns: [\"_start\", \"_stop\"]",
+												Start: ast.Position{
+													Column: 17,
+													Line:   32,
+												},
+											},
+											TrailingComment: nil,
+										},
+										Key: &ast.Identifier{
+											BaseNode: ast.BaseNode{
+												Comments: nil,
+												Errors:   nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 24,
+														Line:   32,
+													},
+													File:   "http_post_side_effects_test.flux",
+													Source: "columns",
+													Start: ast.Position{
+														Column: 17,
+														Line:   32,
+													},
+												},
+												TrailingComment: nil,
+											},
+											Name: "columns",
+										},
+										Value: &ast.ArrayExpression{
+											BaseNode: ast.BaseNode{
+												Comments: nil,
+												Errors:   nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 45,
+														Line:   32,
+													},
+													File:   "http_post_side_effects_test.flux",
+													Source: "[\"_start\", \"_stop\"]",
+													Start: ast.Position{
+														Column: 26,
+														Line:   32,
+													},
+												},
+												TrailingComment: nil,
+											},
+											Elements: []ast.Expression{&ast.StringLiteral{
+												BaseNode: ast.BaseNode{
+													Comments: nil,
+													Errors:   nil,
+													Loc: &ast.SourceLocation{
+														End: ast.Position{
+															Column: 35,
+															Line:   32,
+														},
+														File:   "http_post_side_effects_test.flux",
+														Source: "\"_start\"",
+														Start: ast.Position{
+															Column: 27,
+															Line:   32,
+														},
+													},
+													TrailingComment: nil,
+												},
+												Value: "_start",
+											}, &ast.StringLiteral{
+												BaseNode: ast.BaseNode{
+													Comments: nil,
+													Errors:   nil,
+													Loc: &ast.SourceLocation{
+														End: ast.Position{
+															Column: 44,
+															Line:   32,
+														},
+														File:   "http_post_side_effects_test.flux",
+														Source: "\"_stop\"",
+														Start: ast.Position{
+															Column: 37,
+															Line:   32,
+														},
+													},
+													TrailingComment: nil,
+												},
+												Value: "_stop",
+											}},
+										},
+									}},
+									With: nil,
+								}},
+								BaseNode: ast.BaseNode{
+									Comments: nil,
+									Errors:   nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 46,
+											Line:   32,
+										},
+										File:   "http_post_side_effects_test.flux",
+										Source: "drop(columns: [\"_start\", \"_stop\"])",
+										Start: ast.Position{
+											Column: 12,
+											Line:   32,
+										},
+									},
+									TrailingComment: nil,
+								},
+								Callee: &ast.Identifier{
+									BaseNode: ast.BaseNode{
+										Comments: nil,
+										Errors:   nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 16,
+												Line:   32,
+											},
+											File:   "http_post_side_effects_test.flux",
+											Source: "drop",
+											Start: ast.Position{
+												Column: 12,
+												Line:   32,
+											},
+										},
+										TrailingComment: nil,
+									},
+									Name: "drop",
+								},
+							},
+						},
+						BaseNode: ast.BaseNode{
+							Comments: nil,
+							Errors:   nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 96,
+									Line:   33,
+								},
+								File:   "http_post_side_effects_test.flux",
+								Source: "table\n        |> range(start: 2018-05-22T00:00:00Z)\n        |> drop(columns: [\"_start\", \"_stop\"])\n        |> endpoint(mapFn: (r) => ({data: json.encode(v: {time: r._time, value: r._value})}))()",
+								Start: ast.Position{
+									Column: 5,
+									Line:   30,
+								},
+							},
+							TrailingComment: nil,
+						},
+						Call: &ast.CallExpression{
+							Arguments: nil,
+							BaseNode: ast.BaseNode{
+								Comments: nil,
+								Errors:   nil,
+								Loc: &ast.SourceLocation{
+									End: ast.Position{
+										Column: 96,
+										Line:   33,
+									},
+									File:   "http_post_side_effects_test.flux",
+									Source: "endpoint(mapFn: (r) => ({data: json.encode(v: {time: r._time, value: r._value})}))()",
+									Start: ast.Position{
+										Column: 12,
+										Line:   33,
+									},
+								},
+								TrailingComment: nil,
+							},
+							Callee: &ast.CallExpression{
+								Arguments: []ast.Expression{&ast.ObjectExpression{
+									BaseNode: ast.BaseNode{
+										Comments: nil,
+										Errors:   nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 93,
+												Line:   33,
+											},
+											File:   "http_post_side_effects_test.flux",
+											Source: "mapFn: (r) => ({data: json.encode(v: {time: r._time, value: r._value})})",
+											Start: ast.Position{
+												Column: 21,
+												Line:   33,
+											},
+										},
+										TrailingComment: nil,
+									},
+									Properties: []*ast.Property{&ast.Property{
+										BaseNode: ast.BaseNode{
+											Comments: nil,
+											Errors:   nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 93,
+													Line:   33,
+												},
+												File:   "http_post_side_effects_test.flux",
+												Source: "mapFn: (r) => ({data: json.encode(v: {time: r._time, value: r._value})})",
+												Start: ast.Position{
+													Column: 21,
+													Line:   33,
+												},
+											},
+											TrailingComment: nil,
+										},
+										Key: &ast.Identifier{
+											BaseNode: ast.BaseNode{
+												Comments: nil,
+												Errors:   nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 26,
+														Line:   33,
+													},
+													File:   "http_post_side_effects_test.flux",
+													Source: "mapFn",
+													Start: ast.Position{
+														Column: 21,
+														Line:   33,
+													},
+												},
+												TrailingComment: nil,
+											},
+											Name: "mapFn",
+										},
+										Value: &ast.FunctionExpression{
+											BaseNode: ast.BaseNode{
+												Comments: nil,
+												Errors:   nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 93,
+														Line:   33,
+													},
+													File:   "http_post_side_effects_test.flux",
+													Source: "(r) => ({data: json.encode(v: {time: r._time, value: r._value})})",
+													Start: ast.Position{
+														Column: 28,
+														Line:   33,
+													},
+												},
+												TrailingComment: nil,
+											},
+											Body: &ast.ParenExpression{
+												BaseNode: ast.BaseNode{
+													Comments: nil,
+													Errors:   nil,
+													Loc: &ast.SourceLocation{
+														End: ast.Position{
+															Column: 93,
+															Line:   33,
+														},
+														File:   "http_post_side_effects_test.flux",
+														Source: "({data: json.encode(v: {time: r._time, value: r._value})})",
+														Start: ast.Position{
+															Column: 35,
+															Line:   33,
+														},
+													},
+													TrailingComment: nil,
+												},
+												Expression: &ast.ObjectExpression{
+													BaseNode: ast.BaseNode{
+														Comments: nil,
+														Errors:   nil,
+														Loc: &ast.SourceLocation{
+															End: ast.Position{
+																Column: 92,
+																Line:   33,
+															},
+															File:   "http_post_side_effects_test.flux",
+															Source: "{data: json.encode(v: {time: r._time, value: r._value})}",
+															Start: ast.Position{
+																Column: 36,
+																Line:   33,
+															},
+														},
+														TrailingComment: nil,
+													},
+													Properties: []*ast.Property{&ast.Property{
+														BaseNode: ast.BaseNode{
+															Comments: nil,
+															Errors:   nil,
+															Loc: &ast.SourceLocation{
+																End: ast.Position{
+																	Column: 91,
+																	Line:   33,
+																},
+																File:   "http_post_side_effects_test.flux",
+																Source: "data: json.encode(v: {time: r._time, value: r._value})",
+																Start: ast.Position{
+																	Column: 37,
+																	Line:   33,
+																},
+															},
+															TrailingComment: nil,
+														},
+														Key: &ast.Identifier{
+															BaseNode: ast.BaseNode{
+																Comments: nil,
+																Errors:   nil,
+																Loc: &ast.SourceLocation{
+																	End: ast.Position{
+																		Column: 41,
+																		Line:   33,
+																	},
+																	File:   "http_post_side_effects_test.flux",
+																	Source: "data",
+																	Start: ast.Position{
+																		Column: 37,
+																		Line:   33,
+																	},
+																},
+																TrailingComment: nil,
+															},
+															Name: "data",
+														},
+														Value: &ast.CallExpression{
+															Arguments: []ast.Expression{&ast.ObjectExpression{
+																BaseNode: ast.BaseNode{
+																	Comments: nil,
+																	Errors:   nil,
+																	Loc: &ast.SourceLocation{
+																		End: ast.Position{
+																			Column: 90,
+																			Line:   33,
+																		},
+																		File:   "http_post_side_effects_test.flux",
+																		Source: "v: {time: r._time, value: r._value}",
+																		Start: ast.Position{
+																			Column: 55,
+																			Line:   33,
+																		},
+																	},
+																	TrailingComment: nil,
+																},
+																Properties: []*ast.Property{&ast.Property{
+																	BaseNode: ast.BaseNode{
+																		Comments: nil,
+																		Errors:   nil,
+																		Loc: &ast.SourceLocation{
+																			End: ast.Position{
+																				Column: 90,
+																				Line:   33,
+																			},
+																			File:   "http_post_side_effects_test.flux",
+																			Source: "v: {time: r._time, value: r._value}",
+																			Start: ast.Position{
+																				Column: 55,
+																				Line:   33,
+																			},
+																		},
+																		TrailingComment: nil,
+																	},
+																	Key: &ast.Identifier{
+																		BaseNode: ast.BaseNode{
+																			Comments: nil,
+																			Errors:   nil,
+																			Loc: &ast.SourceLocation{
+																				End: ast.Position{
+																					Column: 56,
+																					Line:   33,
+																				},
+																				File:   "http_post_side_effects_test.flux",
+																				Source: "v",
+																				Start: ast.Position{
+																					Column: 55,
+																					Line:   33,
+																				},
+																			},
+																			TrailingComment: nil,
+																		},
+																		Name: "v",
+																	},
+																	Value: &ast.ObjectExpression{
+																		BaseNode: ast.BaseNode{
+																			Comments: nil,
+																			Errors:   nil,
+																			Loc: &ast.SourceLocation{
+																				End: ast.Position{
+																					Column: 90,
+																					Line:   33,
+																				},
+																				File:   "http_post_side_effects_test.flux",
+																				Source: "{time: r._time, value: r._value}",
+																				Start: ast.Position{
+																					Column: 58,
+																					Line:   33,
+																				},
+																			},
+																			TrailingComment: nil,
+																		},
+																		Properties: []*ast.Property{&ast.Property{
+																			BaseNode: ast.BaseNode{
+																				Comments: nil,
+																				Errors:   nil,
+																				Loc: &ast.SourceLocation{
+																					End: ast.Position{
+																						Column: 72,
+																						Line:   33,
+																					},
+																					File:   "http_post_side_effects_test.flux",
+																					Source: "time: r._time",
+																					Start: ast.Position{
+																						Column: 59,
+																						Line:   33,
+																					},
+																				},
+																				TrailingComment: nil,
+																			},
+																			Key: &ast.Identifier{
+																				BaseNode: ast.BaseNode{
+																					Comments: nil,
+																					Errors:   nil,
+																					Loc: &ast.SourceLocation{
+																						End: ast.Position{
+																							Column: 63,
+																							Line:   33,
+																						},
+																						File:   "http_post_side_effects_test.flux",
+																						Source: "time",
+																						Start: ast.Position{
+																							Column: 59,
+																							Line:   33,
+																						},
+																					},
+																					TrailingComment: nil,
+																				},
+																				Name: "time",
+																			},
+																			Value: &ast.MemberExpression{
+																				BaseNode: ast.BaseNode{
+																					Comments: nil,
+																					Errors:   nil,
+																					Loc: &ast.SourceLocation{
+																						End: ast.Position{
+																							Column: 72,
+																							Line:   33,
+																						},
+																						File:   "http_post_side_effects_test.flux",
+																						Source: "r._time",
+																						Start: ast.Position{
+																							Column: 65,
+																							Line:   33,
+																						},
+																					},
+																					TrailingComment: nil,
+																				},
+																				Object: &ast.Identifier{
+																					BaseNode: ast.BaseNode{
+																						Comments: nil,
+																						Errors:   nil,
+																						Loc: &ast.SourceLocation{
+																							End: ast.Position{
+																								Column: 66,
+																								Line:   33,
+																							},
+																							File:   "http_post_side_effects_test.flux",
+																							Source: "r",
+																							Start: ast.Position{
+																								Column: 65,
+																								Line:   33,
+																							},
+																						},
+																						TrailingComment: nil,
+																					},
+																					Name: "r",
+																				},
+																				Property: &ast.Identifier{
+																					BaseNode: ast.BaseNode{
+																						Comments: nil,
+																						Errors:   nil,
+																						Loc: &ast.SourceLocation{
+																							End: ast.Position{
+																								Column: 72,
+																								Line:   33,
+																							},
+																							File:   "http_post_side_effects_test.flux",
+																							Source: "_time",
+																							Start: ast.Position{
+																								Column: 67,
+																								Line:   33,
+																							},
+																						},
+																						TrailingComment: nil,
+																					},
+																					Name: "_time",
+																				},
+																			},
+																		}, &ast.Property{
+																			BaseNode: ast.BaseNode{
+																				Comments: nil,
+																				Errors:   nil,
+																				Loc: &ast.SourceLocation{
+																					End: ast.Position{
+																						Column: 89,
+																						Line:   33,
+																					},
+																					File:   "http_post_side_effects_test.flux",
+																					Source: "value: r._value",
+																					Start: ast.Position{
+																						Column: 74,
+																						Line:   33,
+																					},
+																				},
+																				TrailingComment: nil,
+																			},
+																			Key: &ast.Identifier{
+																				BaseNode: ast.BaseNode{
+																					Comments: nil,
+																					Errors:   nil,
+																					Loc: &ast.SourceLocation{
+																						End: ast.Position{
+																							Column: 79,
+																							Line:   33,
+																						},
+																						File:   "http_post_side_effects_test.flux",
+																						Source: "value",
+																						Start: ast.Position{
+																							Column: 74,
+																							Line:   33,
+																						},
+																					},
+																					TrailingComment: nil,
+																				},
+																				Name: "value",
+																			},
+																			Value: &ast.MemberExpression{
+																				BaseNode: ast.BaseNode{
+																					Comments: nil,
+																					Errors:   nil,
+																					Loc: &ast.SourceLocation{
+																						End: ast.Position{
+																							Column: 89,
+																							Line:   33,
+																						},
+																						File:   "http_post_side_effects_test.flux",
+																						Source: "r._value",
+																						Start: ast.Position{
+																							Column: 81,
+																							Line:   33,
+																						},
+																					},
+																					TrailingComment: nil,
+																				},
+																				Object: &ast.Identifier{
+																					BaseNode: ast.BaseNode{
+																						Comments: nil,
+																						Errors:   nil,
+																						Loc: &ast.SourceLocation{
+																							End: ast.Position{
+																								Column: 82,
+																								Line:   33,
+																							},
+																							File:   "http_post_side_effects_test.flux",
+																							Source: "r",
+																							Start: ast.Position{
+																								Column: 81,
+																								Line:   33,
+																							},
+																						},
+																						TrailingComment: nil,
+																					},
+																					Name: "r",
+																				},
+																				Property: &ast.Identifier{
+																					BaseNode: ast.BaseNode{
+																						Comments: nil,
+																						Errors:   nil,
+																						Loc: &ast.SourceLocation{
+																							End: ast.Position{
+																								Column: 89,
+																								Line:   33,
+																							},
+																							File:   "http_post_side_effects_test.flux",
+																							Source: "_value",
+																							Start: ast.Position{
+																								Column: 83,
+																								Line:   33,
+																							},
+																						},
+																						TrailingComment: nil,
+																					},
+																					Name: "_value",
+																				},
+																			},
+																		}},
+																		With: nil,
+																	},
+																}},
+																With: nil,
+															}},
+															BaseNode: ast.BaseNode{
+																Comments: nil,
+																Errors:   nil,
+																Loc: &ast.SourceLocation{
+																	End: ast.Position{
+																		Column: 91,
+																		Line:   33,
+																	},
+																	File:   "http_post_side_effects_test.flux",
+																	Source: "json.encode(v: {time: r._time, value: r._value})",
+																	Start: ast.Position{
+																		Column: 43,
+																		Line:   33,
+																	},
+																},
+																TrailingComment: nil,
+															},
+															Callee: &ast.MemberExpression{
+																BaseNode: ast.BaseNode{
+																	Comments: nil,
+																	Errors:   nil,
+																	Loc: &ast.SourceLocation{
+																		End: ast.Position{
+																			Column: 54,
+																			Line:   33,
+																		},
+																		File:   "http_post_side_effects_test.flux",
+																		Source: "json.encode",
+																		Start: ast.Position{
+																			Column: 43,
+																			Line:   33,
+																		},
+																	},
+																	TrailingComment: nil,
+																},
+																Object: &ast.Identifier{
+																	BaseNode: ast.BaseNode{
+																		Comments: nil,
+																		Errors:   nil,
+																		Loc: &ast.SourceLocation{
+																			End: ast.Position{
+																				Column: 47,
+																				Line:   33,
+																			},
+																			File:   "http_post_side_effects_test.flux",
+																			Source: "json",
+																			Start: ast.Position{
+																				Column: 43,
+																				Line:   33,
+																			},
+																		},
+																		TrailingComment: nil,
+																	},
+																	Name: "json",
+																},
+																Property: &ast.Identifier{
+																	BaseNode: ast.BaseNode{
+																		Comments: nil,
+																		Errors:   nil,
+																		Loc: &ast.SourceLocation{
+																			End: ast.Position{
+																				Column: 54,
+																				Line:   33,
+																			},
+																			File:   "http_post_side_effects_test.flux",
+																			Source: "encode",
+																			Start: ast.Position{
+																				Column: 48,
+																				Line:   33,
+																			},
+																		},
+																		TrailingComment: nil,
+																	},
+																	Name: "encode",
+																},
+															},
+														},
+													}},
+													With: nil,
+												},
+											},
+											Params: []*ast.Property{&ast.Property{
+												BaseNode: ast.BaseNode{
+													Comments: nil,
+													Errors:   nil,
+													Loc: &ast.SourceLocation{
+														End: ast.Position{
+															Column: 30,
+															Line:   33,
+														},
+														File:   "http_post_side_effects_test.flux",
+														Source: "r",
+														Start: ast.Position{
+															Column: 29,
+															Line:   33,
+														},
+													},
+													TrailingComment: nil,
+												},
+												Key: &ast.Identifier{
+													BaseNode: ast.BaseNode{
+														Comments: nil,
+														Errors:   nil,
+														Loc: &ast.SourceLocation{
+															End: ast.Position{
+																Column: 30,
+																Line:   33,
+															},
+															File:   "http_post_side_effects_test.flux",
+															Source: "r",
+															Start: ast.Position{
+																Column: 29,
+																Line:   33,
+															},
+														},
+														TrailingComment: nil,
+													},
+													Name: "r",
+												},
+												Value: nil,
+											}},
+										},
+									}},
+									With: nil,
+								}},
+								BaseNode: ast.BaseNode{
+									Comments: nil,
+									Errors:   nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 94,
+											Line:   33,
+										},
+										File:   "http_post_side_effects_test.flux",
+										Source: "endpoint(mapFn: (r) => ({data: json.encode(v: {time: r._time, value: r._value})}))",
+										Start: ast.Position{
+											Column: 12,
+											Line:   33,
+										},
+									},
+									TrailingComment: nil,
+								},
+								Callee: &ast.Identifier{
+									BaseNode: ast.BaseNode{
+										Comments: nil,
+										Errors:   nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 20,
+												Line:   33,
+											},
+											File:   "http_post_side_effects_test.flux",
+											Source: "endpoint",
+											Start: ast.Position{
+												Column: 12,
+												Line:   33,
+											},
+										},
+										TrailingComment: nil,
+									},
+									Name: "endpoint",
+								},
+							},
+						},
+					},
+					BaseNode: ast.BaseNode{
+						Comments: nil,
+						Errors:   nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 33,
+								Line:   34,
+							},
+							File:   "http_post_side_effects_test.flux",
+							Source: "table\n        |> range(start: 2018-05-22T00:00:00Z)\n        |> drop(columns: [\"_start\", \"_stop\"])\n        |> endpoint(mapFn: (r) => ({data: json.encode(v: {time: r._time, value: r._value})}))()\n        |> testing.sideEffects()",
+							Start: ast.Position{
+								Column: 5,
+								Line:   30,
+							},
+						},
+						TrailingComment: nil,
+					},
+					Call: &ast.CallExpression{
+						Arguments: nil,
+						BaseNode: ast.BaseNode{
+							Comments: nil,
+							Errors:   nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 33,
+									Line:   34,
+								},
+								File:   "http_post_side_effects_test.flux",
+								Source: "testing.sideEffects()",
+								Start: ast.Position{
+									Column: 12,
+									Line:   34,
+								},
+							},
+							TrailingComment: nil,
+						},
+						Callee: &ast.MemberExpression{
+							BaseNode: ast.BaseNode{
+								Comments: nil,
+								Errors:   nil,
+								Loc: &ast.SourceLocation{
+									End: ast.Position{
+										Column: 31,
+										Line:   34,
+									},
+									File:   "http_post_side_effects_test.flux",
+									Source: "testing.sideEffects",
+									Start: ast.Position{
+										Column: 12,
+										Line:   34,
+									},
+								},
+								TrailingComment: nil,
+							},
+							Object: &ast.Identifier{
+								BaseNode: ast.BaseNode{
+									Comments: nil,
+									Errors:   nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 19,
+											Line:   34,
+										},
+										File:   "http_post_side_effects_test.flux",
+										Source: "testing",
+										Start: ast.Position{
+											Column: 12,
+											Line:   34,
+										},
+									},
+									TrailingComment: nil,
+								},
+								Name: "testing",
+							},
+							Property: &ast.Identifier{
+								BaseNode: ast.BaseNode{
+									Comments: nil,
+									Errors:   nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 31,
+											Line:   34,
+										},
+										File:   "http_post_side_effects_test.flux",
+										Source: "sideEffects",
+										Start: ast.Position{
+											Column: 20,
+											Line:   34,
+										},
+									},
+									TrailingComment: nil,
+								},
+								Name: "sideEffects",
+							},
+						},
+					},
+				},
+				Params: []*ast.Property{&ast.Property{
+					BaseNode: ast.BaseNode{
+						Comments: nil,
+						Errors:   nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 30,
+								Line:   29,
+							},
+							File:   "http_post_side_effects_test.flux",
+							Source: "table=<-",
+							Start: ast.Position{
+								Column: 22,
+								Line:   29,
+							},
+						},
+						TrailingComment: nil,
+					},
+					Key: &ast.Identifier{
+						BaseNode: ast.BaseNode{
+							Comments: nil,
+							Errors:   nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 27,
+									Line:   29,
+								},
+								File:   "http_post_side_effects_test.flux",
+								Source: "table",
+								Start: ast.Position{
+									Column: 22,
+									Line:   29,
+								},
+							},
+							TrailingComment: nil,
+						},
+						Name: "table",
+					},
+					Value: &ast.PipeLiteral{BaseNode: ast.BaseNode{
+						Comments: nil,
+						Errors:   nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 30,
+								Line:   29,
+							},
+							File:   "http_post_side_effects_test.flux",
+							Source: "<-",
+							Start: ast.Position{
+								Column: 28,
+								Line:   29,
+							},
+						},
+						TrailingComment: nil,
+					}},
+				}},
+			},
+		}, &ast.TestStatement{
+			Assignment: &ast.VariableAssignment{
+				BaseNode: ast.BaseNode{
+					Comments: nil,
+					Errors:   nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 108,
+							Line:   37,
+						},
+						File:   "http_post_side_effects_test.flux",
+						Source: "_post_side_effects = () =>\n    ({input: testing.loadStorage(csv: inData), want: testing.loadMem(csv: outData), fn: post_side_effects})",
+						Start: ast.Position{
+							Column: 6,
+							Line:   36,
+						},
+					},
+					TrailingComment: nil,
+				},
+				ID: &ast.Identifier{
+					BaseNode: ast.BaseNode{
+						Comments: nil,
+						Errors:   nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 24,
+								Line:   36,
+							},
+							File:   "http_post_side_effects_test.flux",
+							Source: "_post_side_effects",
+							Start: ast.Position{
+								Column: 6,
+								Line:   36,
+							},
+						},
+						TrailingComment: nil,
+					},
+					Name: "_post_side_effects",
+				},
+				Init: &ast.FunctionExpression{
+					BaseNode: ast.BaseNode{
+						Comments: nil,
+						Errors:   nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 108,
+								Line:   37,
+							},
+							File:   "http_post_side_effects_test.flux",
+							Source: "() =>\n    ({input: testing.loadStorage(csv: inData), want: testing.loadMem(csv: outData), fn: post_side_effects})",
+							Start: ast.Position{
+								Column: 27,
+								Line:   36,
+							},
+						},
+						TrailingComment: nil,
+					},
+					Body: &ast.ParenExpression{
+						BaseNode: ast.BaseNode{
+							Comments: nil,
+							Errors:   nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 108,
+									Line:   37,
+								},
+								File:   "http_post_side_effects_test.flux",
+								Source: "({input: testing.loadStorage(csv: inData), want: testing.loadMem(csv: outData), fn: post_side_effects})",
+								Start: ast.Position{
+									Column: 5,
+									Line:   37,
+								},
+							},
+							TrailingComment: nil,
+						},
+						Expression: &ast.ObjectExpression{
+							BaseNode: ast.BaseNode{
+								Comments: nil,
+								Errors:   nil,
+								Loc: &ast.SourceLocation{
+									End: ast.Position{
+										Column: 107,
+										Line:   37,
+									},
+									File:   "http_post_side_effects_test.flux",
+									Source: "{input: testing.loadStorage(csv: inData), want: testing.loadMem(csv: outData), fn: post_side_effects}",
+									Start: ast.Position{
+										Column: 6,
+										Line:   37,
+									},
+								},
+								TrailingComment: nil,
+							},
+							Properties: []*ast.Property{&ast.Property{
+								BaseNode: ast.BaseNode{
+									Comments: nil,
+									Errors:   nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 46,
+											Line:   37,
+										},
+										File:   "http_post_side_effects_test.flux",
+										Source: "input: testing.loadStorage(csv: inData)",
+										Start: ast.Position{
+											Column: 7,
+											Line:   37,
+										},
+									},
+									TrailingComment: nil,
+								},
+								Key: &ast.Identifier{
+									BaseNode: ast.BaseNode{
+										Comments: nil,
+										Errors:   nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 12,
+												Line:   37,
+											},
+											File:   "http_post_side_effects_test.flux",
+											Source: "input",
+											Start: ast.Position{
+												Column: 7,
+												Line:   37,
+											},
+										},
+										TrailingComment: nil,
+									},
+									Name: "input",
+								},
+								Value: &ast.CallExpression{
+									Arguments: []ast.Expression{&ast.ObjectExpression{
+										BaseNode: ast.BaseNode{
+											Comments: nil,
+											Errors:   nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 45,
+													Line:   37,
+												},
+												File:   "http_post_side_effects_test.flux",
+												Source: "csv: inData",
+												Start: ast.Position{
+													Column: 34,
+													Line:   37,
+												},
+											},
+											TrailingComment: nil,
+										},
+										Properties: []*ast.Property{&ast.Property{
+											BaseNode: ast.BaseNode{
+												Comments: nil,
+												Errors:   nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 45,
+														Line:   37,
+													},
+													File:   "http_post_side_effects_test.flux",
+													Source: "csv: inData",
+													Start: ast.Position{
+														Column: 34,
+														Line:   37,
+													},
+												},
+												TrailingComment: nil,
+											},
+											Key: &ast.Identifier{
+												BaseNode: ast.BaseNode{
+													Comments: nil,
+													Errors:   nil,
+													Loc: &ast.SourceLocation{
+														End: ast.Position{
+															Column: 37,
+															Line:   37,
+														},
+														File:   "http_post_side_effects_test.flux",
+														Source: "csv",
+														Start: ast.Position{
+															Column: 34,
+															Line:   37,
+														},
+													},
+													TrailingComment: nil,
+												},
+												Name: "csv",
+											},
+											Value: &ast.Identifier{
+												BaseNode: ast.BaseNode{
+													Comments: nil,
+													Errors:   nil,
+													Loc: &ast.SourceLocation{
+														End: ast.Position{
+															Column: 45,
+															Line:   37,
+														},
+														File:   "http_post_side_effects_test.flux",
+														Source: "inData",
+														Start: ast.Position{
+															Column: 39,
+															Line:   37,
+														},
+													},
+													TrailingComment: nil,
+												},
+												Name: "inData",
+											},
+										}},
+										With: nil,
+									}},
+									BaseNode: ast.BaseNode{
+										Comments: nil,
+										Errors:   nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 46,
+												Line:   37,
+											},
+											File:   "http_post_side_effects_test.flux",
+											Source: "testing.loadStorage(csv: inData)",
+											Start: ast.Position{
+												Column: 14,
+												Line:   37,
+											},
+										},
+										TrailingComment: nil,
+									},
+									Callee: &ast.MemberExpression{
+										BaseNode: ast.BaseNode{
+											Comments: nil,
+											Errors:   nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 33,
+													Line:   37,
+												},
+												File:   "http_post_side_effects_test.flux",
+												Source: "testing.loadStorage",
+												Start: ast.Position{
+													Column: 14,
+													Line:   37,
+												},
+											},
+											TrailingComment: nil,
+										},
+										Object: &ast.Identifier{
+											BaseNode: ast.BaseNode{
+												Comments: nil,
+												Errors:   nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 21,
+														Line:   37,
+													},
+													File:   "http_post_side_effects_test.flux",
+													Source: "testing",
+													Start: ast.Position{
+														Column: 14,
+														Line:   37,
+													},
+												},
+												TrailingComment: nil,
+											},
+											Name: "testing",
+										},
+										Property: &ast.Identifier{
+											BaseNode: ast.BaseNode{
+												Comments: nil,
+												Errors:   nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 33,
+														Line:   37,
+													},
+													File:   "http_post_side_effects_test.flux",
+													Source: "loadStorage",
+													Start: ast.Position{
+														Column: 22,
+														Line:   37,
+													},
+												},
+												TrailingComment: nil,
+											},
+											Name: "loadStorage",
+										},
+									},
+								},
+							}, &ast.Property{
+								BaseNode: ast.BaseNode{
+									Comments: nil,
+									Errors:   nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 83,
+											Line:   37,
+										},
+										File:   "http_post_side_effects_test.flux",
+										Source: "want: testing.loadMem(csv: outData)",
+										Start: ast.Position{
+											Column: 48,
+											Line:   37,
+										},
+									},
+									TrailingComment: nil,
+								},
+								Key: &ast.Identifier{
+									BaseNode: ast.BaseNode{
+										Comments: nil,
+										Errors:   nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 52,
+												Line:   37,
+											},
+											File:   "http_post_side_effects_test.flux",
+											Source: "want",
+											Start: ast.Position{
+												Column: 48,
+												Line:   37,
+											},
+										},
+										TrailingComment: nil,
+									},
+									Name: "want",
+								},
+								Value: &ast.CallExpression{
+									Arguments: []ast.Expression{&ast.ObjectExpression{
+										BaseNode: ast.BaseNode{
+											Comments: nil,
+											Errors:   nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 82,
+													Line:   37,
+												},
+												File:   "http_post_side_effects_test.flux",
+												Source: "csv: outData",
+												Start: ast.Position{
+													Column: 70,
+													Line:   37,
+												},
+											},
+											TrailingComment: nil,
+										},
+										Properties: []*ast.Property{&ast.Property{
+											BaseNode: ast.BaseNode{
+												Comments: nil,
+												Errors:   nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 82,
+														Line:   37,
+													},
+													File:   "http_post_side_effects_test.flux",
+													Source: "csv: outData",
+													Start: ast.Position{
+														Column: 70,
+														Line:   37,
+													},
+												},
+												TrailingComment: nil,
+											},
+											Key: &ast.Identifier{
+												BaseNode: ast.BaseNode{
+													Comments: nil,
+													Errors:   nil,
+													Loc: &ast.SourceLocation{
+														End: ast.Position{
+															Column: 73,
+															Line:   37,
+														},
+														File:   "http_post_side_effects_test.flux",
+														Source: "csv",
+														Start: ast.Position{
+															Column: 70,
+															Line:   37,
+														},
+													},
+													TrailingComment: nil,
+												},
+												Name: "csv",
+											},
+											Value: &ast.Identifier{
+												BaseNode: ast.BaseNode{
+													Comments: nil,
+													Errors:   nil,
+													Loc: &ast.SourceLocation{
+														End: ast.Position{
+															Column: 82,
+															Line:   37,
+														},
+														File:   "http_post_side_effects_test.flux",
+														Source: "outData",
+														Start: ast.Position{
+															Column: 75,
+															Line:   37,
+														},
+													},
+													TrailingComment: nil,
+												},
+												Name: "outData",
+											},
+										}},
+										With: nil,
+									}},
+									BaseNode: ast.BaseNode{
+										Comments: nil,
+										Errors:   nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 83,
+												Line:   37,
+											},
+											File:   "http_post_side_effects_test.flux",
+											Source: "testing.loadMem(csv: outData)",
+											Start: ast.Position{
+												Column: 54,
+												Line:   37,
+											},
+										},
+										TrailingComment: nil,
+									},
+									Callee: &ast.MemberExpression{
+										BaseNode: ast.BaseNode{
+											Comments: nil,
+											Errors:   nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 69,
+													Line:   37,
+												},
+												File:   "http_post_side_effects_test.flux",
+												Source: "testing.loadMem",
+												Start: ast.Position{
+													Column: 54,
+													Line:   37,
+												},
+											},
+											TrailingComment: nil,
+										},
+										Object: &ast.Identifier{
+											BaseNode: ast.BaseNode{
+												Comments: nil,
+												Errors:   nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 61,
+														Line:   37,
+													},
+													File:   "http_post_side_effects_test.flux",
+													Source: "testing",
+													Start: ast.Position{
+														Column: 54,
+														Line:   37,
+													},
+												},
+												TrailingComment: nil,
+											},
+											Name: "testing",
+										},
+										Property: &ast.Identifier{
+											BaseNode: ast.BaseNode{
+												Comments: nil,
+												Errors:   nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 69,
+														Line:   37,
+													},
+													File:   "http_post_side_effects_test.flux",
+													Source: "loadMem",
+													Start: ast.Position{
+														Column: 62,
+														Line:   37,
+													},
+												},
+												TrailingComment: nil,
+											},
+											Name: "loadMem",
+										},
+									},
+								},
+							}, &ast.Property{
+								BaseNode: ast.BaseNode{
+									Comments: nil,
+									Errors:   nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 106,
+											Line:   37,
+										},
+										File:   "http_post_side_effects_test.flux",
+										Source: "fn: post_side_effects",
+										Start: ast.Position{
+											Column: 85,
+											Line:   37,
+										},
+									},
+									TrailingComment: nil,
+								},
+								Key: &ast.Identifier{
+									BaseNode: ast.BaseNode{
+										Comments: nil,
+										Errors:   nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 87,
+												Line:   37,
+											},
+											File:   "http_post_side_effects_test.flux",
+											Source: "fn",
+											Start: ast.Position{
+												Column: 85,
+												Line:   37,
+											},
+										},
+										TrailingComment: nil,
+									},
+									Name: "fn",
+								},
+								Value: &ast.Identifier{
+									BaseNode: ast.BaseNode{
+										Comments: nil,
+										Errors:   nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 106,
+												Line:   37,
+											},
+											File:   "http_post_side_effects_test.flux",
+											Source: "post_side_effects",
+											Start: ast.Position{
+												Column: 89,
+												Line:   37,
+											},
+										},
+										TrailingComment: nil,
+									},
+									Name: "post_side_effects",
+								},
+							}},
+							With: nil,
+						},
+					},
+					Params: nil,
+				},
+			},
+			BaseNode: ast.BaseNode{
+				Comments: nil,
+				Errors:   nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 108,
+						Line:   37,
+					},
+					File:   "http_post_side_effects_test.flux",
+					Source: "test _post_side_effects = () =>\n    ({input: testing.loadStorage(csv: inData), want: testing.loadMem(csv: outData), fn: post_side_effects})",
+					Start: ast.Position{
+						Column: 1,
+						Line:   36,
+					},
+				},
+				TrailingComment: nil,
+			},
+		}},
+		Eof: nil,
+		Imports: []*ast.ImportDeclaration{&ast.ImportDeclaration{
+			As: nil,
+			BaseNode: ast.BaseNode{
+				Comments: nil,
+				Errors:   nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 17,
+						Line:   3,
+					},
+					File:   "http_post_side_effects_test.flux",
+					Source: "import \"testing\"",
+					Start: ast.Position{
+						Column: 1,
+						Line:   3,
+					},
+				},
+				TrailingComment: nil,
+			},
+			Path: &ast.StringLiteral{
+				BaseNode: ast.BaseNode{
+					Comments: nil,
+					Errors:   nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 17,
+							Line:   3,
+						},
+						File:   "http_post_side_effects_test.flux",
+						Source: "\"testing\"",
+						Start: ast.Position{
+							Column: 8,
+							Line:   3,
+						},
+					},
+					TrailingComment: nil,
+				},
+				Value: "testing",
+			},
+		}, &ast.ImportDeclaration{
+			As: nil,
+			BaseNode: ast.BaseNode{
+				Comments: nil,
+				Errors:   nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 14,
+						Line:   4,
+					},
+					File:   "http_post_side_effects_test.flux",
+					Source: "import \"http\"",
+					Start: ast.Position{
+						Column: 1,
+						Line:   4,
+					},
+				},
+				TrailingComment: nil,
+			},
+			Path: &ast.StringLiteral{
+				BaseNode: ast.BaseNode{
+					Comments: nil,
+					Errors:   nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 14,
+							Line:   4,
+						},
+						File:   "http_post_side_effects_test.flux",
+						Source: "\"http\"",
+						Start: ast.Position{
+							Column: 8,
+							Line:   4,
+						},
+					},
+					TrailingComment: nil,
+				},
+				Value: "http",
+			},
+		}, &ast.ImportDeclaration{
+			As: nil,
+			BaseNode: ast.BaseNode{
+				Comments: nil,
+				Errors:   nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 14,
+						Line:   5,
+					},
+					File:   "http_post_side_effects_test.flux",
+					Source: "import \"json\"",
+					Start: ast.Position{
+						Column: 1,
+						Line:   5,
+					},
+				},
+				TrailingComment: nil,
+			},
+			Path: &ast.StringLiteral{
+				BaseNode: ast.BaseNode{
+					Comments: nil,
+					Errors:   nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 14,
+							Line:   5,
+						},
+						File:   "http_post_side_effects_test.flux",
+						Source: "\"json\"",
+						Start: ast.Position{
+							Column: 8,
+							Line:   5,
+						},
+					},
+					TrailingComment: nil,
+				},
+				Value: "json",
+			},
+		}},
+		Metadata: "parser-type=go",
+		Name:     "http_post_side_effects_test.flux",
+		Package: &ast.PackageClause{
+			BaseNode: ast.BaseNode{
+				Comments: nil,
+				Errors:   nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 18,
+						Line:   1,
+					},
+					File:   "http_post_side_effects_test.flux",
+					Source: "package http_test",
+					Start: ast.Position{
+						Column: 1,
+						Line:   1,
+					},
+				},
+				TrailingComment: nil,
+			},
+			Name: &ast.Identifier{
+				BaseNode: ast.BaseNode{
+					Comments: nil,
+					Errors:   nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 18,
+							Line:   1,
+						},
+						File:   "http_post_side_effects_test.flux",
+						Source: "http_test",
+						Start: ast.Position{
+							Column: 9,
+							Line:   1,
+						},
+					},
+					TrailingComment: nil,
 				},
 				Name: "http_test",
 			},
@@ -10,6 +10,7 @@ import (
 
 	"github.com/influxdata/flux"
 	"github.com/influxdata/flux/codes"
+	"github.com/influxdata/flux/dependencies/sideeffect"
 	"github.com/influxdata/flux/internal/errors"
 	"github.com/influxdata/flux/iocounter"
 	"github.com/influxdata/flux/semantic"
@@ -87,6 +88,7 @@ func init() {
 			if err != nil {
 				return nil, errors.Wrap(err, codes.Aborted, "missing client in http.post")
 			}
+			dc = sideeffect.HTTPClient(ctx, dc)
 
 			statusCode, err := func(req *http.Request) (int, error) {
 				s, cctx := opentracing.StartSpanFromContext(ctx, "http.post")
@@ -1,11 +1,14 @@
-// From is an operation that mocks the real implementation of InfluxDB's from.
-// It is used in Flux to compile queries that resemble real queries issued against InfluxDB.
-// Implementors of the real from are expected to replace its implementation via flux.ReplacePackageValue.
+// From is an operation that reads data from InfluxDB.
+// When a host is specified, the data is queried from a remote InfluxDB
+// using its HTTP API. Otherwise it is used in Flux to compile queries that
+// resemble real queries issued against InfluxDB and implementors of the real
+// from are expected to replace its implementation via flux.ReplacePackageValue.
 package influxdb
 
 import (
 	"github.com/influxdata/flux"
 	"github.com/influxdata/flux/codes"
+	"github.com/influxdata/flux/execute"
 	"github.com/influxdata/flux/internal/errors"
 	"github.com/influxdata/flux/plan"
 	"github.com/influxdata/flux/semantic"
@@ -14,13 +17,23 @@ import (
 const FromKind = "from"
 
 type FromOpSpec struct {
-	Bucket string
+	Bucket   string `json:"bucket,omitempty"`
+	BucketID string `json:"bucketID,omitempty"`
+	Org      string `json:"org,omitempty"`
+	OrgID    string `json:"orgID,omitempty"`
+	Host     string `json:"host,omitempty"`
+	Token    string `json:"token,omitempty"`
 }
 
 func init() {
 	fromSignature := semantic.FunctionPolySignature{
 		Parameters: map[string]semantic.PolyType{
-			"bucket": semantic.String,
+			"bucket":   semantic.String,
+			"bucketID": semantic.String,
+			"org":      semantic.String,
+			"orgID":    semantic.String,
+			"host":     semantic.String,
+			"token":    semantic.String,
 		},
 		Required: nil,
 		Return:   flux.TableObjectType,
@@ -29,14 +42,56 @@ func init() {
 	flux.RegisterPackageValue("influxdata/influxdb", FromKind, flux.FunctionValue(FromKind, createFromOpSpec, fromSignature))
 	flux.RegisterOpSpec(FromKind, newFromOp)
 	plan.RegisterProcedureSpec(FromKind, newFromProcedure, FromKind)
+	plan.RegisterPhysicalRules(FromRemoteRule{}, MergeRemoteRangeRule{})
+	execute.RegisterSource(RemoteFromKind, createRemoteFromSource)
 }
 
 func createFromOpSpec(args flux.Arguments, a *flux.Administration) (flux.OperationSpec, error) {
 	spec := new(FromOpSpec)
-	if b, _, e := args.GetString("bucket"); e != nil {
-		return nil, e
-	} else {
-		spec.Bucket = b
+	if v, ok, err := args.GetString("bucket"); err != nil {
+		return nil, err
+	} else if ok {
+		spec.Bucket = v
+	}
+	if v, ok, err := args.GetString("bucketID"); err != nil {
+		return nil, err
+	} else if ok {
+		spec.BucketID = v
+	}
+	if v, ok, err := args.GetString("org"); err != nil {
+		return nil, err
+	} else if ok {
+		spec.Org = v
+	}
+	if v, ok, err := args.GetString("orgID"); err != nil {
+		return nil, err
+	} else if ok {
+		spec.OrgID = v
+	}
+	if v, ok, err := args.GetString("host"); err != nil {
+		return nil, err
+	} else if ok {
+		spec.Host = v
+	}
+	if v, ok, err := args.GetString("token"); err != nil {
+		return nil, err
+	} else if ok {
+		spec.Token = v
+	}
+
+	if spec.Bucket != "" && spec.BucketID != "" {
+		return nil, errors.New(codes.Invalid, "cannot submit both bucket and bucketID")
+	}
+	if spec.Org != "" && spec.OrgID != "" {
+		return nil, errors.New(codes.Invalid, "cannot submit both org and orgID")
+	}
+	if spec.Host != "" {
+		if spec.Bucket == "" && spec.BucketID == "" {
+			return nil, errors.New(codes.Invalid, "must specify one of bucket or bucketID when reading from a host")
+		}
+		if spec.Org == "" && spec.OrgID == "" {
+			return nil, errors.New(codes.Invalid, "must specify one of org or orgID when reading from a host")
+		}
 	}
 	return spec, nil
 }
@@ -51,7 +106,12 @@ func (s *FromOpSpec) Kind() flux.OperationKind {
 
 type FromProcedureSpec struct {
 	plan.DefaultCost
-	Bucket string
+	Bucket   string
+	BucketID string
+	Org      string
+	OrgID    string
+	Host     string
+	Token    string
 }
 
 func newFromProcedure(qs flux.OperationSpec, pa plan.Administration) (plan.ProcedureSpec, error) {
@@ -61,7 +121,12 @@ func newFromProcedure(qs flux.OperationSpec, pa plan.Administration) (plan.Proce
 	}
 
 	return &FromProcedureSpec{
-		Bucket: spec.Bucket,
+		Bucket:   spec.Bucket,
+		BucketID: spec.BucketID,
+		Org:      spec.Org,
+		OrgID:    spec.OrgID,
+		Host:     spec.Host,
+		Token:    spec.Token,
 	}, nil
 }
 
@@ -74,3 +139,8 @@ func (s *FromProcedureSpec) Copy() plan.ProcedureSpec {
 	*ns = *s
 	return ns
 }
+
+// IsRemote reports whether the data is read from a remote host.
+func (s *FromProcedureSpec) IsRemote() bool {
+	return s.Host != ""
+}
@@ -32,6 +32,33 @@ func TestFrom_NewQuery(t *testing.T) {
 			Raw:     `from(bucket:"telegraf", chicken:"what is this?")`,
 			WantErr: true,
 		},
+		{
+			Name: "from remote host",
+			Raw:  `from(bucket:"mybucket", org:"myorg", host:"http://localhost:9999", token:"mytoken")`,
+			Want: &flux.Spec{
+				Operations: []*flux.Operation{
+					{
+						ID: "from0",
+						Spec: &influxdb.FromOpSpec{
+							Bucket: "mybucket",
+							Org:    "myorg",
+							Host:   "http://localhost:9999",
+							Token:  "mytoken",
+						},
+					},
+				},
+			},
+		},
+		{
+			Name:    "from bucket and bucketID",
+			Raw:     `from(bucket:"mybucket", bucketID:"1234")`,
+			WantErr: true,
+		},
+		{
+			Name:    "from remote host without org",
+			Raw:     `from(bucket:"mybucket", host:"http://localhost:9999")`,
+			WantErr: true,
+		},
 		{
 			Name: "from with database",
 			Raw:  `from(bucket:"mybucket") |> range(start:-4h, stop:-2h) |> sum()`,
@@ -0,0 +1,119 @@
+package influxdb
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/codes"
+	"github.com/influxdata/flux/internal/errors"
+)
+
+// maxErrorBody is the maximum amount of an error response body
+// that will be read when decoding the error from a remote host.
+const maxErrorBody = 64 * 1024 // 64 KB
+
+// newRemoteRequest constructs a request for the given path on a remote host.
+// The url is validated using the url validator from the dependencies
+// and the token, if any, is added to the authorization header.
+func newRemoteRequest(ctx context.Context, method, host, path string, params url.Values, token string, body io.Reader) (*http.Request, error) {
+	u, err := url.Parse(host)
+	if err != nil {
+		return nil, errors.Wrapf(err, codes.Invalid, "invalid host %q", host)
+	}
+	u.Path = strings.TrimSuffix(u.Path, "/") + path
+	u.RawQuery = params.Encode()
+
+	validator, err := flux.GetDependencies(ctx).URLValidator()
+	if err != nil {
+		return nil, err
+	}
+	if err := validator.Validate(u); err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(method, u.String(), body)
+	if err != nil {
+		return nil, err
+	}
+	if token != "" {
+		req.Header.Set("Authorization", "Token "+token)
+	}
+	return req.WithContext(ctx), nil
+}
+
+// doRemoteRequest performs the request with the http client from the dependencies.
+// If the remote host responds with an error, the response body is closed
+// and the error is returned. Otherwise the caller must close the body.
+func doRemoteRequest(ctx context.Context, req *http.Request) (*http.Response, error) {
+	client, err := flux.GetDependencies(ctx).HTTPClient()
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, errors.Wrap(err, codes.Unavailable, "failed to contact remote host")
+	}
+	if resp.StatusCode/100 != 2 {
+		defer func() { _ = resp.Body.Close() }()
+		return nil, decodeRemoteError(resp)
+	}
+	return resp, nil
+}
+
+// decodeRemoteError converts an error response from a remote host into an error.
+func decodeRemoteError(resp *http.Response) error {
+	body, err := ioutil.ReadAll(io.LimitReader(resp.Body, maxErrorBody))
+	if err != nil {
+		return errors.Wrap(err, codes.Internal, "failed to read error response")
+	}
+
+	var e struct {
+		Code    string `json:"code"`
+		Message string `json:"message"`
+	}
+	msg := strings.TrimSpace(string(body))
+	if err := json.Unmarshal(body, &e); err == nil && e.Message != "" {
+		msg = e.Message
+	}
+	if msg == "" {
+		msg = http.StatusText(resp.StatusCode)
+	}
+	return errors.Newf(statusCode(resp.StatusCode), "remote host returned %d: %s", resp.StatusCode, msg)
+}
+
+// statusCode maps an http status code to an error code.
+func statusCode(status int) codes.Code {
+	switch status {
+	case http.StatusBadRequest:
+		return codes.Invalid
+	case http.StatusUnauthorized:
+		return codes.Unauthenticated
+	case http.StatusForbidden:
+		return codes.PermissionDenied
+	case http.StatusNotFound:
+		return codes.NotFound
+	case http.StatusTooManyRequests, http.StatusRequestEntityTooLarge:
+		return codes.ResourceExhausted
+	case http.StatusServiceUnavailable:
+		return codes.Unavailable
+	default:
+		return codes.Internal
+	}
+}
+
+// orgParams returns the query parameters that identify the organization.
+func orgParams(org, orgID string) url.Values {
+	params := make(url.Values)
+	if orgID != "" {
+		params.Set("orgID", orgID)
+	} else {
+		params.Set("org", org)
+	}
+	return params
+}
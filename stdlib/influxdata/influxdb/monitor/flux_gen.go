@@ -13,12 +13,15 @@ func init() {
 
 var pkgAST = &ast.Package{
 	BaseNode: ast.BaseNode{
-		Errors: nil,
-		Loc:    nil,
+		Comments:        nil,
+		Errors:          nil,
+		Loc:             nil,
+		TrailingComment: nil,
 	},
 	Files: []*ast.File{&ast.File{
 		BaseNode: ast.BaseNode{
-			Errors: nil,
+			Comments: nil,
+			Errors:   nil,
 			Loc: &ast.SourceLocation{
 				End: ast.Position{
 					Column: 19,
@@ -31,10 +34,12 @@ var pkgAST = &ast.Package{
 					Line:   1,
 				},
 			},
+			TrailingComment: nil,
 		},
 		Body: []ast.Statement{&ast.VariableAssignment{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 23,
@@ -47,10 +52,12 @@ var pkgAST = &ast.Package{
 						Line:   7,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 7,
@@ -63,12 +70,14 @@ var pkgAST = &ast.Package{
 							Line:   7,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "bucket",
 			},
 			Init: &ast.StringLiteral{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 23,
@@ -81,13 +90,15 @@ var pkgAST = &ast.Package{
 							Line:   7,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Value: "_monitoring",
 			},
 		}, &ast.OptionStatement{
 			Assignment: &ast.VariableAssignment{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 72,
@@ -100,10 +111,12 @@ var pkgAST = &ast.Package{
 							Line:   10,
 						},
 					},
+					TrailingComment: nil,
 				},
 				ID: &ast.Identifier{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 13,
@@ -116,12 +129,14 @@ var pkgAST = &ast.Package{
 								Line:   10,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Name: "write",
 				},
 				Init: &ast.FunctionExpression{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 72,
@@ -134,11 +149,13 @@ var pkgAST = &ast.Package{
 								Line:   10,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Body: &ast.PipeExpression{
 						Argument: &ast.Identifier{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 37,
@@ -151,11 +168,13 @@ var pkgAST = &ast.Package{
 										Line:   10,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Name: "tables",
 						},
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 72,
@@ -168,11 +187,13 @@ var pkgAST = &ast.Package{
 									Line:   10,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Call: &ast.CallExpression{
 							Arguments: []ast.Expression{&ast.ObjectExpression{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 71,
@@ -185,10 +206,12 @@ var pkgAST = &ast.Package{
 											Line:   10,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Properties: []*ast.Property{&ast.Property{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 71,
@@ -201,10 +224,12 @@ var pkgAST = &ast.Package{
 												Line:   10,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Key: &ast.Identifier{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 63,
@@ -217,12 +242,14 @@ var pkgAST = &ast.Package{
 													Line:   10,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Name: "bucket",
 									},
 									Value: &ast.Identifier{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 71,
@@ -235,6 +262,7 @@ var pkgAST = &ast.Package{
 													Line:   10,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Name: "bucket",
 									},
@@ -242,7 +270,8 @@ var pkgAST = &ast.Package{
 								With: nil,
 							}},
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 72,
@@ -255,10 +284,12 @@ var pkgAST = &ast.Package{
 										Line:   10,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Callee: &ast.MemberExpression{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 56,
@@ -271,10 +302,12 @@ var pkgAST = &ast.Package{
 											Line:   10,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Object: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 53,
@@ -287,12 +320,14 @@ var pkgAST = &ast.Package{
 												Line:   10,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "experimental",
 								},
 								Property: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 56,
@@ -305,6 +340,7 @@ var pkgAST = &ast.Package{
 												Line:   10,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "to",
 								},
@@ -313,7 +349,8 @@ var pkgAST = &ast.Package{
 					},
 					Params: []*ast.Property{&ast.Property{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 26,
@@ -326,10 +363,12 @@ var pkgAST = &ast.Package{
 									Line:   10,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Key: &ast.Identifier{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 23,
@@ -342,11 +381,13 @@ var pkgAST = &ast.Package{
 										Line:   10,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Name: "tables",
 						},
 						Value: &ast.PipeLiteral{BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 26,
@@ -359,12 +400,14 @@ var pkgAST = &ast.Package{
 									Line:   10,
 								},
 							},
+							TrailingComment: nil,
 						}},
 					}},
 				},
 			},
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: []ast.Comment{ast.Comment{Text: "// Write persists the check statuses"}},
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 72,
@@ -377,11 +420,13 @@ var pkgAST = &ast.Package{
 						Line:   10,
 					},
 				},
+				TrailingComment: nil,
 			},
 		}, &ast.OptionStatement{
 			Assignment: &ast.VariableAssignment{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 70,
@@ -394,10 +439,12 @@ var pkgAST = &ast.Package{
 							Line:   13,
 						},
 					},
+					TrailingComment: nil,
 				},
 				ID: &ast.Identifier{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 11,
@@ -410,12 +457,14 @@ var pkgAST = &ast.Package{
 								Line:   13,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Name: "log",
 				},
 				Init: &ast.FunctionExpression{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 70,
@@ -428,11 +477,13 @@ var pkgAST = &ast.Package{
 								Line:   13,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Body: &ast.PipeExpression{
 						Argument: &ast.Identifier{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 35,
@@ -445,11 +496,13 @@ var pkgAST = &ast.Package{
 										Line:   13,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Name: "tables",
 						},
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 70,
@@ -462,11 +515,13 @@ var pkgAST = &ast.Package{
 									Line:   13,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Call: &ast.CallExpression{
 							Arguments: []ast.Expression{&ast.ObjectExpression{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 69,
@@ -479,10 +534,12 @@ var pkgAST = &ast.Package{
 											Line:   13,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Properties: []*ast.Property{&ast.Property{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 69,
@@ -495,10 +552,12 @@ var pkgAST = &ast.Package{
 												Line:   13,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Key: &ast.Identifier{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 61,
@@ -511,12 +570,14 @@ var pkgAST = &ast.Package{
 													Line:   13,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Name: "bucket",
 									},
 									Value: &ast.Identifier{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 69,
@@ -529,6 +590,7 @@ var pkgAST = &ast.Package{
 													Line:   13,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Name: "bucket",
 									},
@@ -536,7 +598,8 @@ var pkgAST = &ast.Package{
 								With: nil,
 							}},
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 70,
@@ -549,10 +612,12 @@ var pkgAST = &ast.Package{
 										Line:   13,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Callee: &ast.MemberExpression{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 54,
@@ -565,10 +630,12 @@ var pkgAST = &ast.Package{
 											Line:   13,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Object: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 51,
@@ -581,12 +648,14 @@ var pkgAST = &ast.Package{
 												Line:   13,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "experimental",
 								},
 								Property: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 54,
@@ -599,6 +668,7 @@ var pkgAST = &ast.Package{
 												Line:   13,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "to",
 								},
@@ -607,7 +677,8 @@ var pkgAST = &ast.Package{
 					},
 					Params: []*ast.Property{&ast.Property{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 24,
@@ -620,10 +691,12 @@ var pkgAST = &ast.Package{
 									Line:   13,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Key: &ast.Identifier{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 21,
@@ -636,11 +709,13 @@ var pkgAST = &ast.Package{
 										Line:   13,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Name: "tables",
 						},
 						Value: &ast.PipeLiteral{BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 24,
@@ -653,12 +728,14 @@ var pkgAST = &ast.Package{
 									Line:   13,
 								},
 							},
+							TrailingComment: nil,
 						}},
 					}},
 				},
 			},
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: []ast.Comment{ast.Comment{Text: "// Log records notification events"}},
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 70,
@@ -671,10 +748,12 @@ var pkgAST = &ast.Package{
 						Line:   13,
 					},
 				},
+				TrailingComment: nil,
 			},
 		}, &ast.VariableAssignment{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: []ast.Comment{ast.Comment{Text: "// From retrieves the check statuses that have been stored."}},
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 29,
@@ -687,10 +766,12 @@ var pkgAST = &ast.Package{
 						Line:   16,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 5,
@@ -703,12 +784,14 @@ var pkgAST = &ast.Package{
 							Line:   16,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "from",
 			},
 			Init: &ast.FunctionExpression{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 29,
@@ -721,6 +804,7 @@ var pkgAST = &ast.Package{
 							Line:   16,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Body: &ast.PipeExpression{
 					Argument: &ast.PipeExpression{
@@ -729,7 +813,8 @@ var pkgAST = &ast.Package{
 								Argument: &ast.CallExpression{
 									Arguments: []ast.Expression{&ast.ObjectExpression{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 33,
@@ -742,10 +827,12 @@ var pkgAST = &ast.Package{
 													Line:   17,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Properties: []*ast.Property{&ast.Property{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 33,
@@ -758,10 +845,12 @@ var pkgAST = &ast.Package{
 														Line:   17,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Key: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 25,
@@ -774,12 +863,14 @@ var pkgAST = &ast.Package{
 															Line:   17,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "bucket",
 											},
 											Value: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 33,
@@ -792,6 +883,7 @@ var pkgAST = &ast.Package{
 															Line:   17,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "bucket",
 											},
@@ -799,7 +891,8 @@ var pkgAST = &ast.Package{
 										With: nil,
 									}},
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 34,
@@ -812,10 +905,12 @@ var pkgAST = &ast.Package{
 												Line:   17,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Callee: &ast.MemberExpression{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 18,
@@ -828,10 +923,12 @@ var pkgAST = &ast.Package{
 													Line:   17,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Object: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 13,
@@ -844,12 +941,14 @@ var pkgAST = &ast.Package{
 														Line:   17,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "influxdb",
 										},
 										Property: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 18,
@@ -862,13 +961,15 @@ var pkgAST = &ast.Package{
 														Line:   17,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "from",
 										},
 									},
 								},
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 43,
@@ -881,11 +982,13 @@ var pkgAST = &ast.Package{
 											Line:   17,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Call: &ast.CallExpression{
 									Arguments: []ast.Expression{&ast.ObjectExpression{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 42,
@@ -898,10 +1001,12 @@ var pkgAST = &ast.Package{
 													Line:   18,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Properties: []*ast.Property{&ast.Property{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 30,
@@ -914,10 +1019,12 @@ var pkgAST = &ast.Package{
 														Line:   18,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Key: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 23,
@@ -930,12 +1037,14 @@ var pkgAST = &ast.Package{
 															Line:   18,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "start",
 											},
 											Value: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 30,
@@ -948,12 +1057,14 @@ var pkgAST = &ast.Package{
 															Line:   18,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "start",
 											},
 										}, &ast.Property{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 42,
@@ -966,10 +1077,12 @@ var pkgAST = &ast.Package{
 														Line:   18,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Key: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 36,
@@ -982,12 +1095,14 @@ var pkgAST = &ast.Package{
 															Line:   18,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "stop",
 											},
 											Value: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 42,
@@ -1000,6 +1115,7 @@ var pkgAST = &ast.Package{
 															Line:   18,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "stop",
 											},
@@ -1007,7 +1123,8 @@ var pkgAST = &ast.Package{
 										With: nil,
 									}},
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 43,
@@ -1020,10 +1137,12 @@ var pkgAST = &ast.Package{
 												Line:   18,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Callee: &ast.Identifier{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 17,
@@ -1036,13 +1155,15 @@ var pkgAST = &ast.Package{
 													Line:   18,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Name: "range",
 									},
 								},
 							},
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 59,
@@ -1055,11 +1176,13 @@ var pkgAST = &ast.Package{
 										Line:   17,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Call: &ast.CallExpression{
 								Arguments: []ast.Expression{&ast.ObjectExpression{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 58,
@@ -1072,10 +1195,12 @@ var pkgAST = &ast.Package{
 												Line:   19,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Properties: []*ast.Property{&ast.Property{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 58,
@@ -1088,10 +1213,12 @@ var pkgAST = &ast.Package{
 													Line:   19,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Key: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 21,
@@ -1104,12 +1231,14 @@ var pkgAST = &ast.Package{
 														Line:   19,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "fn",
 										},
 										Value: &ast.FunctionExpression{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 58,
@@ -1122,10 +1251,12 @@ var pkgAST = &ast.Package{
 														Line:   19,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Body: &ast.BinaryExpression{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 58,
@@ -1138,10 +1269,12 @@ var pkgAST = &ast.Package{
 															Line:   19,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Left: &ast.MemberExpression{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 44,
@@ -1154,10 +1287,12 @@ var pkgAST = &ast.Package{
 																Line:   19,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Object: &ast.Identifier{
 														BaseNode: ast.BaseNode{
-															Errors: nil,
+															Comments: nil,
+															Errors:   nil,
 															Loc: &ast.SourceLocation{
 																End: ast.Position{
 																	Column: 31,
@@ -1170,12 +1305,14 @@ var pkgAST = &ast.Package{
 																	Line:   19,
 																},
 															},
+															TrailingComment: nil,
 														},
 														Name: "r",
 													},
 													Property: &ast.Identifier{
 														BaseNode: ast.BaseNode{
-															Errors: nil,
+															Comments: nil,
+															Errors:   nil,
 															Loc: &ast.SourceLocation{
 																End: ast.Position{
 																	Column: 44,
@@ -1188,6 +1325,7 @@ var pkgAST = &ast.Package{
 																	Line:   19,
 																},
 															},
+															TrailingComment: nil,
 														},
 														Name: "_measurement",
 													},
@@ -1195,7 +1333,8 @@ var pkgAST = &ast.Package{
 												Operator: 17,
 												Right: &ast.StringLiteral{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 58,
@@ -1208,13 +1347,15 @@ var pkgAST = &ast.Package{
 																Line:   19,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Value: "statuses",
 												},
 											},
 											Params: []*ast.Property{&ast.Property{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 25,
@@ -1227,10 +1368,12 @@ var pkgAST = &ast.Package{
 															Line:   19,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Key: &ast.Identifier{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 25,
@@ -1243,6 +1386,7 @@ var pkgAST = &ast.Package{
 																Line:   19,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Name: "r",
 												},
@@ -1253,7 +1397,8 @@ var pkgAST = &ast.Package{
 									With: nil,
 								}},
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 59,
@@ -1266,10 +1411,12 @@ var pkgAST = &ast.Package{
 											Line:   19,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Callee: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 18,
@@ -1282,13 +1429,15 @@ var pkgAST = &ast.Package{
 												Line:   19,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "filter",
 								},
 							},
 						},
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 26,
@@ -1301,11 +1450,13 @@ var pkgAST = &ast.Package{
 									Line:   17,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Call: &ast.CallExpression{
 							Arguments: []ast.Expression{&ast.ObjectExpression{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 25,
@@ -1318,10 +1469,12 @@ var pkgAST = &ast.Package{
 											Line:   20,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Properties: []*ast.Property{&ast.Property{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 25,
@@ -1334,10 +1487,12 @@ var pkgAST = &ast.Package{
 												Line:   20,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Key: &ast.Identifier{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 21,
@@ -1350,12 +1505,14 @@ var pkgAST = &ast.Package{
 													Line:   20,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Name: "fn",
 									},
 									Value: &ast.Identifier{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 25,
@@ -1368,6 +1525,7 @@ var pkgAST = &ast.Package{
 													Line:   20,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Name: "fn",
 									},
@@ -1375,7 +1533,8 @@ var pkgAST = &ast.Package{
 								With: nil,
 							}},
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 26,
@@ -1388,10 +1547,12 @@ var pkgAST = &ast.Package{
 										Line:   20,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Callee: &ast.Identifier{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 18,
@@ -1404,13 +1565,15 @@ var pkgAST = &ast.Package{
 											Line:   20,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Name: "filter",
 							},
 						},
 					},
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 29,
@@ -1423,11 +1586,13 @@ var pkgAST = &ast.Package{
 								Line:   17,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Call: &ast.CallExpression{
 						Arguments: nil,
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 29,
@@ -1440,10 +1605,12 @@ var pkgAST = &ast.Package{
 									Line:   21,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Callee: &ast.MemberExpression{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 27,
@@ -1456,10 +1623,12 @@ var pkgAST = &ast.Package{
 										Line:   21,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Object: &ast.Identifier{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 14,
@@ -1472,12 +1641,14 @@ var pkgAST = &ast.Package{
 											Line:   21,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Name: "v1",
 							},
 							Property: &ast.Identifier{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 27,
@@ -1490,6 +1661,7 @@ var pkgAST = &ast.Package{
 											Line:   21,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Name: "fieldsAsCols",
 							},
@@ -1498,7 +1670,8 @@ var pkgAST = &ast.Package{
 				},
 				Params: []*ast.Property{&ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 14,
@@ -1511,10 +1684,12 @@ var pkgAST = &ast.Package{
 								Line:   16,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 14,
@@ -1527,13 +1702,15 @@ var pkgAST = &ast.Package{
 									Line:   16,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Name: "start",
 					},
 					Value: nil,
 				}, &ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 26,
@@ -1546,10 +1723,12 @@ var pkgAST = &ast.Package{
 								Line:   16,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 20,
@@ -1562,13 +1741,15 @@ var pkgAST = &ast.Package{
 									Line:   16,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Name: "stop",
 					},
 					Value: &ast.CallExpression{
 						Arguments: nil,
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 26,
@@ -1581,10 +1762,12 @@ var pkgAST = &ast.Package{
 									Line:   16,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Callee: &ast.Identifier{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 24,
@@ -1597,13 +1780,15 @@ var pkgAST = &ast.Package{
 										Line:   16,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Name: "now",
 						},
 					},
 				}, &ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 42,
@@ -1616,10 +1801,12 @@ var pkgAST = &ast.Package{
 								Line:   16,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 30,
@@ -1632,12 +1819,14 @@ var pkgAST = &ast.Package{
 									Line:   16,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Name: "fn",
 					},
 					Value: &ast.FunctionExpression{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 42,
@@ -1650,10 +1839,12 @@ var pkgAST = &ast.Package{
 									Line:   16,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Body: &ast.Identifier{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 42,
@@ -1666,12 +1857,14 @@ var pkgAST = &ast.Package{
 										Line:   16,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Name: "true",
 						},
 						Params: []*ast.Property{&ast.Property{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 33,
@@ -1684,10 +1877,12 @@ var pkgAST = &ast.Package{
 										Line:   16,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Key: &ast.Identifier{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 33,
@@ -1700,6 +1895,7 @@ var pkgAST = &ast.Package{
 											Line:   16,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Name: "r",
 							},
@@ -1710,7 +1906,8 @@ var pkgAST = &ast.Package{
 			},
 		}, &ast.VariableAssignment{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: []ast.Comment{ast.Comment{Text: "// StateChanges takes a stream of tables, fromLevel, and toLevel and returns"}, ast.Comment{Text: "// a stream of tables where status has gone from fromLevel to toLevel."}, ast.Comment{Text: "//"}, ast.Comment{Text: "// StateChanges only operates on data with data containing r._measurement == \"statuses\""}, ast.Comment{Text: "// and where r._level exists."}},
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 2,
@@ -1723,10 +1920,12 @@ var pkgAST = &ast.Package{
 						Line:   28,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 13,
@@ -1739,12 +1938,14 @@ var pkgAST = &ast.Package{
 							Line:   28,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "stateChanges",
 			},
 			Init: &ast.FunctionExpression{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 2,
@@ -1757,10 +1958,12 @@ var pkgAST = &ast.Package{
 							Line:   28,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Body: &ast.Block{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 2,
@@ -1773,10 +1976,12 @@ var pkgAST = &ast.Package{
 								Line:   28,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Body: []ast.Statement{&ast.VariableAssignment{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 45,
@@ -1789,10 +1994,12 @@ var pkgAST = &ast.Package{
 									Line:   29,
 								},
 							},
+							TrailingComment: nil,
 						},
 						ID: &ast.Identifier{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 15,
@@ -1805,6 +2012,7 @@ var pkgAST = &ast.Package{
 										Line:   29,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Name: "toStatuses",
 						},
@@ -1815,7 +2023,8 @@ var pkgAST = &ast.Package{
 										Argument: &ast.PipeExpression{
 											Argument: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 24,
@@ -1828,11 +2037,13 @@ var pkgAST = &ast.Package{
 															Line:   29,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "tables",
 											},
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 83,
@@ -1845,11 +2056,13 @@ var pkgAST = &ast.Package{
 														Line:   29,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Call: &ast.CallExpression{
 												Arguments: []ast.Expression{&ast.ObjectExpression{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 82,
@@ -1862,10 +2075,12 @@ var pkgAST = &ast.Package{
 																Line:   30,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Properties: []*ast.Property{&ast.Property{
 														BaseNode: ast.BaseNode{
-															Errors: nil,
+															Comments: nil,
+															Errors:   nil,
 															Loc: &ast.SourceLocation{
 																End: ast.Position{
 																	Column: 82,
@@ -1878,10 +2093,12 @@ var pkgAST = &ast.Package{
 																	Line:   30,
 																},
 															},
+															TrailingComment: nil,
 														},
 														Key: &ast.Identifier{
 															BaseNode: ast.BaseNode{
-																Errors: nil,
+																Comments: nil,
+																Errors:   nil,
 																Loc: &ast.SourceLocation{
 																	End: ast.Position{
 																		Column: 21,
@@ -1894,12 +2111,14 @@ var pkgAST = &ast.Package{
 																		Line:   30,
 																	},
 																},
+																TrailingComment: nil,
 															},
 															Name: "fn",
 														},
 														Value: &ast.FunctionExpression{
 															BaseNode: ast.BaseNode{
-																Errors: nil,
+																Comments: nil,
+																Errors:   nil,
 																Loc: &ast.SourceLocation{
 																	End: ast.Position{
 																		Column: 82,
@@ -1912,10 +2131,12 @@ var pkgAST = &ast.Package{
 																		Line:   30,
 																	},
 																},
+																TrailingComment: nil,
 															},
 															Body: &ast.LogicalExpression{
 																BaseNode: ast.BaseNode{
-																	Errors: nil,
+																	Comments: nil,
+																	Errors:   nil,
 																	Loc: &ast.SourceLocation{
 																		End: ast.Position{
 																			Column: 82,
@@ -1928,10 +2149,12 @@ var pkgAST = &ast.Package{
 																			Line:   30,
 																		},
 																	},
+																	TrailingComment: nil,
 																},
 																Left: &ast.BinaryExpression{
 																	BaseNode: ast.BaseNode{
-																		Errors: nil,
+																		Comments: nil,
+																		Errors:   nil,
 																		Loc: &ast.SourceLocation{
 																			End: ast.Position{
 																				Column: 49,
@@ -1944,10 +2167,12 @@ var pkgAST = &ast.Package{
 																				Line:   30,
 																			},
 																		},
+																		TrailingComment: nil,
 																	},
 																	Left: &ast.MemberExpression{
 																		BaseNode: ast.BaseNode{
-																			Errors: nil,
+																			Comments: nil,
+																			Errors:   nil,
 																			Loc: &ast.SourceLocation{
 																				End: ast.Position{
 																					Column: 38,
@@ -1960,10 +2185,12 @@ var pkgAST = &ast.Package{
 																					Line:   30,
 																				},
 																			},
+																			TrailingComment: nil,
 																		},
 																		Object: &ast.Identifier{
 																			BaseNode: ast.BaseNode{
-																				Errors: nil,
+																				Comments: nil,
+																				Errors:   nil,
 																				Loc: &ast.SourceLocation{
 																					End: ast.Position{
 																						Column: 31,
@@ -1976,12 +2203,14 @@ var pkgAST = &ast.Package{
 																						Line:   30,
 																					},
 																				},
+																				TrailingComment: nil,
 																			},
 																			Name: "r",
 																		},
 																		Property: &ast.Identifier{
 																			BaseNode: ast.BaseNode{
-																				Errors: nil,
+																				Comments: nil,
+																				Errors:   nil,
 																				Loc: &ast.SourceLocation{
 																					End: ast.Position{
 																						Column: 38,
@@ -1994,6 +2223,7 @@ var pkgAST = &ast.Package{
 																						Line:   30,
 																					},
 																				},
+																				TrailingComment: nil,
 																			},
 																			Name: "_level",
 																		},
@@ -2001,7 +2231,8 @@ var pkgAST = &ast.Package{
 																	Operator: 17,
 																	Right: &ast.Identifier{
 																		BaseNode: ast.BaseNode{
-																			Errors: nil,
+																			Comments: nil,
+																			Errors:   nil,
 																			Loc: &ast.SourceLocation{
 																				End: ast.Position{
 																					Column: 49,
@@ -2014,6 +2245,7 @@ var pkgAST = &ast.Package{
 																					Line:   30,
 																				},
 																			},
+																			TrailingComment: nil,
 																		},
 																		Name: "toLevel",
 																	},
@@ -2021,7 +2253,8 @@ var pkgAST = &ast.Package{
 																Operator: 1,
 																Right: &ast.BinaryExpression{
 																	BaseNode: ast.BaseNode{
-																		Errors: nil,
+																		Comments: nil,
+																		Errors:   nil,
 																		Loc: &ast.SourceLocation{
 																			End: ast.Position{
 																				Column: 82,
@@ -2034,10 +2267,12 @@ var pkgAST = &ast.Package{
 																				Line:   30,
 																			},
 																		},
+																		TrailingComment: nil,
 																	},
 																	Left: &ast.MemberExpression{
 																		BaseNode: ast.BaseNode{
-																			Errors: nil,
+																			Comments: nil,
+																			Errors:   nil,
 																			Loc: &ast.SourceLocation{
 																				End: ast.Position{
 																					Column: 68,
@@ -2050,10 +2285,12 @@ var pkgAST = &ast.Package{
 																					Line:   30,
 																				},
 																			},
+																			TrailingComment: nil,
 																		},
 																		Object: &ast.Identifier{
 																			BaseNode: ast.BaseNode{
-																				Errors: nil,
+																				Comments: nil,
+																				Errors:   nil,
 																				Loc: &ast.SourceLocation{
 																					End: ast.Position{
 																						Column: 55,
@@ -2066,12 +2303,14 @@ var pkgAST = &ast.Package{
 																						Line:   30,
 																					},
 																				},
+																				TrailingComment: nil,
 																			},
 																			Name: "r",
 																		},
 																		Property: &ast.Identifier{
 																			BaseNode: ast.BaseNode{
-																				Errors: nil,
+																				Comments: nil,
+																				Errors:   nil,
 																				Loc: &ast.SourceLocation{
 																					End: ast.Position{
 																						Column: 68,
@@ -2084,6 +2323,7 @@ var pkgAST = &ast.Package{
 																						Line:   30,
 																					},
 																				},
+																				TrailingComment: nil,
 																			},
 																			Name: "_measurement",
 																		},
@@ -2091,7 +2331,8 @@ var pkgAST = &ast.Package{
 																	Operator: 17,
 																	Right: &ast.StringLiteral{
 																		BaseNode: ast.BaseNode{
-																			Errors: nil,
+																			Comments: nil,
+																			Errors:   nil,
 																			Loc: &ast.SourceLocation{
 																				End: ast.Position{
 																					Column: 82,
@@ -2104,6 +2345,7 @@ var pkgAST = &ast.Package{
 																					Line:   30,
 																				},
 																			},
+																			TrailingComment: nil,
 																		},
 																		Value: "statuses",
 																	},
@@ -2111,7 +2353,8 @@ var pkgAST = &ast.Package{
 															},
 															Params: []*ast.Property{&ast.Property{
 																BaseNode: ast.BaseNode{
-																	Errors: nil,
+																	Comments: nil,
+																	Errors:   nil,
 																	Loc: &ast.SourceLocation{
 																		End: ast.Position{
 																			Column: 25,
@@ -2124,10 +2367,12 @@ var pkgAST = &ast.Package{
 																			Line:   30,
 																		},
 																	},
+																	TrailingComment: nil,
 																},
 																Key: &ast.Identifier{
 																	BaseNode: ast.BaseNode{
-																		Errors: nil,
+																		Comments: nil,
+																		Errors:   nil,
 																		Loc: &ast.SourceLocation{
 																			End: ast.Position{
 																				Column: 25,
@@ -2140,6 +2385,7 @@ var pkgAST = &ast.Package{
 																				Line:   30,
 																			},
 																		},
+																		TrailingComment: nil,
 																	},
 																	Name: "r",
 																},
@@ -2150,7 +2396,8 @@ var pkgAST = &ast.Package{
 													With: nil,
 												}},
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 83,
@@ -2163,10 +2410,12 @@ var pkgAST = &ast.Package{
 															Line:   30,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Callee: &ast.Identifier{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 18,
@@ -2179,13 +2428,15 @@ var pkgAST = &ast.Package{
 																Line:   30,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Name: "filter",
 												},
 											},
 										},
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 53,
@@ -2198,11 +2449,13 @@ var pkgAST = &ast.Package{
 													Line:   29,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Call: &ast.CallExpression{
 											Arguments: []ast.Expression{&ast.ObjectExpression{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 52,
@@ -2215,10 +2468,12 @@ var pkgAST = &ast.Package{
 															Line:   31,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Properties: []*ast.Property{&ast.Property{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 52,
@@ -2231,10 +2486,12 @@ var pkgAST = &ast.Package{
 																Line:   31,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Key: &ast.Identifier{
 														BaseNode: ast.BaseNode{
-															Errors: nil,
+															Comments: nil,
+															Errors:   nil,
 															Loc: &ast.SourceLocation{
 																End: ast.Position{
 																	Column: 18,
@@ -2247,12 +2504,14 @@ var pkgAST = &ast.Package{
 																	Line:   31,
 																},
 															},
+															TrailingComment: nil,
 														},
 														Name: "fn",
 													},
 													Value: &ast.FunctionExpression{
 														BaseNode: ast.BaseNode{
-															Errors: nil,
+															Comments: nil,
+															Errors:   nil,
 															Loc: &ast.SourceLocation{
 																End: ast.Position{
 																	Column: 52,
@@ -2265,10 +2524,12 @@ var pkgAST = &ast.Package{
 																	Line:   31,
 																},
 															},
+															TrailingComment: nil,
 														},
 														Body: &ast.ParenExpression{
 															BaseNode: ast.BaseNode{
-																Errors: nil,
+																Comments: nil,
+																Errors:   nil,
 																Loc: &ast.SourceLocation{
 																	End: ast.Position{
 																		Column: 52,
@@ -2281,10 +2542,12 @@ var pkgAST = &ast.Package{
 																		Line:   31,
 																	},
 																},
+																TrailingComment: nil,
 															},
 															Expression: &ast.ObjectExpression{
 																BaseNode: ast.BaseNode{
-																	Errors: nil,
+																	Comments: nil,
+																	Errors:   nil,
 																	Loc: &ast.SourceLocation{
 																		End: ast.Position{
 																			Column: 51,
@@ -2297,10 +2560,12 @@ var pkgAST = &ast.Package{
 																			Line:   31,
 																		},
 																	},
+																	TrailingComment: nil,
 																},
 																Properties: []*ast.Property{&ast.Property{
 																	BaseNode: ast.BaseNode{
-																		Errors: nil,
+																		Comments: nil,
+																		Errors:   nil,
 																		Loc: &ast.SourceLocation{
 																			End: ast.Position{
 																				Column: 50,
@@ -2313,10 +2578,12 @@ var pkgAST = &ast.Package{
 																				Line:   31,
 																			},
 																		},
+																		TrailingComment: nil,
 																	},
 																	Key: &ast.Identifier{
 																		BaseNode: ast.BaseNode{
-																			Errors: nil,
+																			Comments: nil,
+																			Errors:   nil,
 																			Loc: &ast.SourceLocation{
 																				End: ast.Position{
 																					Column: 47,
@@ -2329,12 +2596,14 @@ var pkgAST = &ast.Package{
 																					Line:   31,
 																				},
 																			},
+																			TrailingComment: nil,
 																		},
 																		Name: "level_value",
 																	},
 																	Value: &ast.IntegerLiteral{
 																		BaseNode: ast.BaseNode{
-																			Errors: nil,
+																			Comments: nil,
+																			Errors:   nil,
 																			Loc: &ast.SourceLocation{
 																				End: ast.Position{
 																					Column: 50,
@@ -2347,13 +2616,15 @@ var pkgAST = &ast.Package{
 																					Line:   31,
 																				},
 																			},
+																			TrailingComment: nil,
 																		},
 																		Value: int64(1),
 																	},
 																}},
 																With: &ast.Identifier{
 																	BaseNode: ast.BaseNode{
-																		Errors: nil,
+																		Comments: nil,
+																		Errors:   nil,
 																		Loc: &ast.SourceLocation{
 																			End: ast.Position{
 																				Column: 30,
@@ -2366,6 +2637,7 @@ var pkgAST = &ast.Package{
 																				Line:   31,
 																			},
 																		},
+																		TrailingComment: nil,
 																	},
 																	Name: "r",
 																},
@@ -2373,7 +2645,8 @@ var pkgAST = &ast.Package{
 														},
 														Params: []*ast.Property{&ast.Property{
 															BaseNode: ast.BaseNode{
-																Errors: nil,
+																Comments: nil,
+																Errors:   nil,
 																Loc: &ast.SourceLocation{
 																	End: ast.Position{
 																		Column: 22,
@@ -2386,10 +2659,12 @@ var pkgAST = &ast.Package{
 																		Line:   31,
 																	},
 																},
+																TrailingComment: nil,
 															},
 															Key: &ast.Identifier{
 																BaseNode: ast.BaseNode{
-																	Errors: nil,
+																	Comments: nil,
+																	Errors:   nil,
 																	Loc: &ast.SourceLocation{
 																		End: ast.Position{
 																			Column: 22,
@@ -2402,6 +2677,7 @@ var pkgAST = &ast.Package{
 																			Line:   31,
 																		},
 																	},
+																	TrailingComment: nil,
 																},
 																Name: "r",
 															},
@@ -2412,7 +2688,8 @@ var pkgAST = &ast.Package{
 												With: nil,
 											}},
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 53,
@@ -2425,10 +2702,12 @@ var pkgAST = &ast.Package{
 														Line:   31,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Callee: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 15,
@@ -2441,13 +2720,15 @@ var pkgAST = &ast.Package{
 															Line:   31,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "map",
 											},
 										},
 									},
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 49,
@@ -2460,11 +2741,13 @@ var pkgAST = &ast.Package{
 												Line:   29,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Call: &ast.CallExpression{
 										Arguments: []ast.Expression{&ast.ObjectExpression{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 48,
@@ -2477,10 +2760,12 @@ var pkgAST = &ast.Package{
 														Line:   32,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Properties: []*ast.Property{&ast.Property{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 38,
@@ -2493,10 +2778,12 @@ var pkgAST = &ast.Package{
 															Line:   32,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Key: &ast.Identifier{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 28,
@@ -2509,12 +2796,14 @@ var pkgAST = &ast.Package{
 																Line:   32,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Name: "column",
 												},
 												Value: &ast.StringLiteral{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 38,
@@ -2527,12 +2816,14 @@ var pkgAST = &ast.Package{
 																Line:   32,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Value: "_level",
 												},
 											}, &ast.Property{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 48,
@@ -2545,10 +2836,12 @@ var pkgAST = &ast.Package{
 															Line:   32,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Key: &ast.Identifier{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 42,
@@ -2561,12 +2854,14 @@ var pkgAST = &ast.Package{
 																Line:   32,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Name: "as",
 												},
 												Value: &ast.StringLiteral{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 48,
@@ -2579,6 +2874,7 @@ var pkgAST = &ast.Package{
 																Line:   32,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Value: "l2",
 												},
@@ -2586,7 +2882,8 @@ var pkgAST = &ast.Package{
 											With: nil,
 										}},
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 49,
@@ -2599,10 +2896,12 @@ var pkgAST = &ast.Package{
 													Line:   32,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Callee: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 21,
@@ -2615,13 +2914,15 @@ var pkgAST = &ast.Package{
 														Line:   32,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "duplicate",
 										},
 									},
 								},
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 37,
@@ -2634,11 +2935,13 @@ var pkgAST = &ast.Package{
 											Line:   29,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Call: &ast.CallExpression{
 									Arguments: []ast.Expression{&ast.ObjectExpression{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 36,
@@ -2651,10 +2954,12 @@ var pkgAST = &ast.Package{
 													Line:   33,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Properties: []*ast.Property{&ast.Property{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 36,
@@ -2667,10 +2972,12 @@ var pkgAST = &ast.Package{
 														Line:   33,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Key: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 24,
@@ -2683,12 +2990,14 @@ var pkgAST = &ast.Package{
 															Line:   33,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "columns",
 											},
 											Value: &ast.ArrayExpression{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 36,
@@ -2701,10 +3010,12 @@ var pkgAST = &ast.Package{
 															Line:   33,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Elements: []ast.Expression{&ast.StringLiteral{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 35,
@@ -2717,6 +3028,7 @@ var pkgAST = &ast.Package{
 																Line:   33,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Value: "_level",
 												}},
@@ -2725,7 +3037,8 @@ var pkgAST = &ast.Package{
 										With: nil,
 									}},
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 37,
@@ -2738,10 +3051,12 @@ var pkgAST = &ast.Package{
 												Line:   33,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Callee: &ast.Identifier{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 16,
@@ -2754,13 +3069,15 @@ var pkgAST = &ast.Package{
 													Line:   33,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Name: "drop",
 									},
 								},
 							},
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 45,
@@ -2773,11 +3090,13 @@ var pkgAST = &ast.Package{
 										Line:   29,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Call: &ast.CallExpression{
 								Arguments: []ast.Expression{&ast.ObjectExpression{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 44,
@@ -2790,10 +3109,12 @@ var pkgAST = &ast.Package{
 												Line:   34,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Properties: []*ast.Property{&ast.Property{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 44,
@@ -2806,10 +3127,12 @@ var pkgAST = &ast.Package{
 													Line:   34,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Key: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 26,
@@ -2822,12 +3145,14 @@ var pkgAST = &ast.Package{
 														Line:   34,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "columns",
 										},
 										Value: &ast.ObjectExpression{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 44,
@@ -2840,10 +3165,12 @@ var pkgAST = &ast.Package{
 														Line:   34,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Properties: []*ast.Property{&ast.Property{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 43,
@@ -2856,10 +3183,12 @@ var pkgAST = &ast.Package{
 															Line:   34,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Key: &ast.StringLiteral{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 33,
@@ -2872,12 +3201,14 @@ var pkgAST = &ast.Package{
 																Line:   34,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Value: "l2",
 												},
 												Value: &ast.StringLiteral{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 43,
@@ -2890,6 +3221,7 @@ var pkgAST = &ast.Package{
 																Line:   34,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Value: "_level",
 												},
@@ -2900,7 +3232,8 @@ var pkgAST = &ast.Package{
 									With: nil,
 								}},
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 45,
@@ -2913,10 +3246,12 @@ var pkgAST = &ast.Package{
 											Line:   34,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Callee: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 18,
@@ -2929,6 +3264,7 @@ var pkgAST = &ast.Package{
 												Line:   34,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "rename",
 								},
@@ -2936,7 +3272,8 @@ var pkgAST = &ast.Package{
 						},
 					}, &ast.VariableAssignment{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 53,
@@ -2949,10 +3286,12 @@ var pkgAST = &ast.Package{
 									Line:   36,
 								},
 							},
+							TrailingComment: nil,
 						},
 						ID: &ast.Identifier{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 16,
@@ -2965,13 +3304,15 @@ var pkgAST = &ast.Package{
 										Line:   36,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Name: "levelFilter",
 						},
 						Init: &ast.ConditionalExpression{
 							Alternate: &ast.FunctionExpression{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 53,
@@ -2984,10 +3325,12 @@ var pkgAST = &ast.Package{
 											Line:   37,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Body: &ast.BinaryExpression{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 53,
@@ -3000,10 +3343,12 @@ var pkgAST = &ast.Package{
 												Line:   37,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Left: &ast.MemberExpression{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 40,
@@ -3016,10 +3361,12 @@ var pkgAST = &ast.Package{
 													Line:   37,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Object: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 33,
@@ -3032,12 +3379,14 @@ var pkgAST = &ast.Package{
 														Line:   37,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "r",
 										},
 										Property: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 40,
@@ -3050,6 +3399,7 @@ var pkgAST = &ast.Package{
 														Line:   37,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "_level",
 										},
@@ -3057,7 +3407,8 @@ var pkgAST = &ast.Package{
 									Operator: 17,
 									Right: &ast.Identifier{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 53,
@@ -3070,13 +3421,15 @@ var pkgAST = &ast.Package{
 													Line:   37,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Name: "fromLevel",
 									},
 								},
 								Params: []*ast.Property{&ast.Property{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 27,
@@ -3089,10 +3442,12 @@ var pkgAST = &ast.Package{
 												Line:   37,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Key: &ast.Identifier{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 27,
@@ -3105,6 +3460,7 @@ var pkgAST = &ast.Package{
 													Line:   37,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Name: "r",
 									},
@@ -3112,7 +3468,8 @@ var pkgAST = &ast.Package{
 								}},
 							},
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 53,
@@ -3125,10 +3482,12 @@ var pkgAST = &ast.Package{
 										Line:   36,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Consequent: &ast.FunctionExpression{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 92,
@@ -3141,10 +3500,12 @@ var pkgAST = &ast.Package{
 											Line:   36,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Body: &ast.LogicalExpression{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 92,
@@ -3157,10 +3518,12 @@ var pkgAST = &ast.Package{
 												Line:   36,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Left: &ast.BinaryExpression{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 72,
@@ -3173,10 +3536,12 @@ var pkgAST = &ast.Package{
 													Line:   36,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Left: &ast.MemberExpression{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 61,
@@ -3189,10 +3554,12 @@ var pkgAST = &ast.Package{
 														Line:   36,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Object: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 54,
@@ -3205,12 +3572,14 @@ var pkgAST = &ast.Package{
 															Line:   36,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "r",
 											},
 											Property: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 61,
@@ -3223,6 +3592,7 @@ var pkgAST = &ast.Package{
 															Line:   36,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "_level",
 											},
@@ -3230,7 +3600,8 @@ var pkgAST = &ast.Package{
 										Operator: 18,
 										Right: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 72,
@@ -3243,6 +3614,7 @@ var pkgAST = &ast.Package{
 														Line:   36,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "toLevel",
 										},
@@ -3251,7 +3623,8 @@ var pkgAST = &ast.Package{
 									Right: &ast.UnaryExpression{
 										Argument: &ast.MemberExpression{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 92,
@@ -3264,10 +3637,12 @@ var pkgAST = &ast.Package{
 														Line:   36,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Object: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 85,
@@ -3280,12 +3655,14 @@ var pkgAST = &ast.Package{
 															Line:   36,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "r",
 											},
 											Property: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 92,
@@ -3298,12 +3675,14 @@ var pkgAST = &ast.Package{
 															Line:   36,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "_level",
 											},
 										},
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 92,
@@ -3316,13 +3695,15 @@ var pkgAST = &ast.Package{
 													Line:   36,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Operator: 14,
 									},
 								},
 								Params: []*ast.Property{&ast.Property{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 48,
@@ -3335,10 +3716,12 @@ var pkgAST = &ast.Package{
 												Line:   36,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Key: &ast.Identifier{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 48,
@@ -3351,6 +3734,7 @@ var pkgAST = &ast.Package{
 													Line:   36,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Name: "r",
 									},
@@ -3359,7 +3743,8 @@ var pkgAST = &ast.Package{
 							},
 							Test: &ast.BinaryExpression{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 40,
@@ -3372,10 +3757,12 @@ var pkgAST = &ast.Package{
 											Line:   36,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Left: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 31,
@@ -3388,13 +3775,15 @@ var pkgAST = &ast.Package{
 												Line:   36,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "fromLevel",
 								},
 								Operator: 17,
 								Right: &ast.StringLiteral{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 40,
@@ -3407,6 +3796,7 @@ var pkgAST = &ast.Package{
 												Line:   36,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Value: "any",
 								},
@@ -3414,7 +3804,8 @@ var pkgAST = &ast.Package{
 						},
 					}, &ast.VariableAssignment{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 45,
@@ -3427,10 +3818,12 @@ var pkgAST = &ast.Package{
 									Line:   39,
 								},
 							},
+							TrailingComment: nil,
 						},
 						ID: &ast.Identifier{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 17,
@@ -3443,6 +3836,7 @@ var pkgAST = &ast.Package{
 										Line:   39,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Name: "fromStatuses",
 						},
@@ -3453,7 +3847,8 @@ var pkgAST = &ast.Package{
 										Argument: &ast.PipeExpression{
 											Argument: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 26,
@@ -3466,11 +3861,13 @@ var pkgAST = &ast.Package{
 															Line:   39,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "tables",
 											},
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 35,
@@ -3483,11 +3880,13 @@ var pkgAST = &ast.Package{
 														Line:   39,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Call: &ast.CallExpression{
 												Arguments: []ast.Expression{&ast.ObjectExpression{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 34,
@@ -3500,10 +3899,12 @@ var pkgAST = &ast.Package{
 																Line:   40,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Properties: []*ast.Property{&ast.Property{
 														BaseNode: ast.BaseNode{
-															Errors: nil,
+															Comments: nil,
+															Errors:   nil,
 															Loc: &ast.SourceLocation{
 																End: ast.Position{
 																	Column: 34,
@@ -3516,10 +3917,12 @@ var pkgAST = &ast.Package{
 																	Line:   40,
 																},
 															},
+															TrailingComment: nil,
 														},
 														Key: &ast.Identifier{
 															BaseNode: ast.BaseNode{
-																Errors: nil,
+																Comments: nil,
+																Errors:   nil,
 																Loc: &ast.SourceLocation{
 																	End: ast.Position{
 																		Column: 21,
@@ -3532,12 +3935,14 @@ var pkgAST = &ast.Package{
 																		Line:   40,
 																	},
 																},
+																TrailingComment: nil,
 															},
 															Name: "fn",
 														},
 														Value: &ast.Identifier{
 															BaseNode: ast.BaseNode{
-																Errors: nil,
+																Comments: nil,
+																Errors:   nil,
 																Loc: &ast.SourceLocation{
 																	End: ast.Position{
 																		Column: 34,
@@ -3550,6 +3955,7 @@ var pkgAST = &ast.Package{
 																		Line:   40,
 																	},
 																},
+																TrailingComment: nil,
 															},
 															Name: "levelFilter",
 														},
@@ -3557,7 +3963,8 @@ var pkgAST = &ast.Package{
 													With: nil,
 												}},
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 35,
@@ -3570,10 +3977,12 @@ var pkgAST = &ast.Package{
 															Line:   40,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Callee: &ast.Identifier{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 18,
@@ -3586,13 +3995,15 @@ var pkgAST = &ast.Package{
 																Line:   40,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Name: "filter",
 												},
 											},
 										},
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 53,
@@ -3605,11 +4016,13 @@ var pkgAST = &ast.Package{
 													Line:   39,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Call: &ast.CallExpression{
 											Arguments: []ast.Expression{&ast.ObjectExpression{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 52,
@@ -3622,10 +4035,12 @@ var pkgAST = &ast.Package{
 															Line:   41,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Properties: []*ast.Property{&ast.Property{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 52,
@@ -3638,10 +4053,12 @@ var pkgAST = &ast.Package{
 																Line:   41,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Key: &ast.Identifier{
 														BaseNode: ast.BaseNode{
-															Errors: nil,
+															Comments: nil,
+															Errors:   nil,
 															Loc: &ast.SourceLocation{
 																End: ast.Position{
 																	Column: 18,
@@ -3654,12 +4071,14 @@ var pkgAST = &ast.Package{
 																	Line:   41,
 																},
 															},
+															TrailingComment: nil,
 														},
 														Name: "fn",
 													},
 													Value: &ast.FunctionExpression{
 														BaseNode: ast.BaseNode{
-															Errors: nil,
+															Comments: nil,
+															Errors:   nil,
 															Loc: &ast.SourceLocation{
 																End: ast.Position{
 																	Column: 52,
@@ -3672,10 +4091,12 @@ var pkgAST = &ast.Package{
 																	Line:   41,
 																},
 															},
+															TrailingComment: nil,
 														},
 														Body: &ast.ParenExpression{
 															BaseNode: ast.BaseNode{
-																Errors: nil,
+																Comments: nil,
+																Errors:   nil,
 																Loc: &ast.SourceLocation{
 																	End: ast.Position{
 																		Column: 52,
@@ -3688,10 +4109,12 @@ var pkgAST = &ast.Package{
 																		Line:   41,
 																	},
 																},
+																TrailingComment: nil,
 															},
 															Expression: &ast.ObjectExpression{
 																BaseNode: ast.BaseNode{
-																	Errors: nil,
+																	Comments: nil,
+																	Errors:   nil,
 																	Loc: &ast.SourceLocation{
 																		End: ast.Position{
 																			Column: 51,
@@ -3704,10 +4127,12 @@ var pkgAST = &ast.Package{
 																			Line:   41,
 																		},
 																	},
+																	TrailingComment: nil,
 																},
 																Properties: []*ast.Property{&ast.Property{
 																	BaseNode: ast.BaseNode{
-																		Errors: nil,
+																		Comments: nil,
+																		Errors:   nil,
 																		Loc: &ast.SourceLocation{
 																			End: ast.Position{
 																				Column: 50,
@@ -3720,10 +4145,12 @@ var pkgAST = &ast.Package{
 																				Line:   41,
 																			},
 																		},
+																		TrailingComment: nil,
 																	},
 																	Key: &ast.Identifier{
 																		BaseNode: ast.BaseNode{
-																			Errors: nil,
+																			Comments: nil,
+																			Errors:   nil,
 																			Loc: &ast.SourceLocation{
 																				End: ast.Position{
 																					Column: 47,
@@ -3736,12 +4163,14 @@ var pkgAST = &ast.Package{
 																					Line:   41,
 																				},
 																			},
+																			TrailingComment: nil,
 																		},
 																		Name: "level_value",
 																	},
 																	Value: &ast.IntegerLiteral{
 																		BaseNode: ast.BaseNode{
-																			Errors: nil,
+																			Comments: nil,
+																			Errors:   nil,
 																			Loc: &ast.SourceLocation{
 																				End: ast.Position{
 																					Column: 50,
@@ -3754,13 +4183,15 @@ var pkgAST = &ast.Package{
 																					Line:   41,
 																				},
 																			},
+																			TrailingComment: nil,
 																		},
 																		Value: int64(0),
 																	},
 																}},
 																With: &ast.Identifier{
 																	BaseNode: ast.BaseNode{
-																		Errors: nil,
+																		Comments: nil,
+																		Errors:   nil,
 																		Loc: &ast.SourceLocation{
 																			End: ast.Position{
 																				Column: 30,
@@ -3773,6 +4204,7 @@ var pkgAST = &ast.Package{
 																				Line:   41,
 																			},
 																		},
+																		TrailingComment: nil,
 																	},
 																	Name: "r",
 																},
@@ -3780,7 +4212,8 @@ var pkgAST = &ast.Package{
 														},
 														Params: []*ast.Property{&ast.Property{
 															BaseNode: ast.BaseNode{
-																Errors: nil,
+																Comments: nil,
+																Errors:   nil,
 																Loc: &ast.SourceLocation{
 																	End: ast.Position{
 																		Column: 22,
@@ -3793,10 +4226,12 @@ var pkgAST = &ast.Package{
 																		Line:   41,
 																	},
 																},
+																TrailingComment: nil,
 															},
 															Key: &ast.Identifier{
 																BaseNode: ast.BaseNode{
-																	Errors: nil,
+																	Comments: nil,
+																	Errors:   nil,
 																	Loc: &ast.SourceLocation{
 																		End: ast.Position{
 																			Column: 22,
@@ -3809,6 +4244,7 @@ var pkgAST = &ast.Package{
 																			Line:   41,
 																		},
 																	},
+																	TrailingComment: nil,
 																},
 																Name: "r",
 															},
@@ -3819,7 +4255,8 @@ var pkgAST = &ast.Package{
 												With: nil,
 											}},
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 53,
@@ -3832,10 +4269,12 @@ var pkgAST = &ast.Package{
 														Line:   41,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Callee: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 15,
@@ -3848,13 +4287,15 @@ var pkgAST = &ast.Package{
 															Line:   41,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "map",
 											},
 										},
 									},
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 49,
@@ -3867,11 +4308,13 @@ var pkgAST = &ast.Package{
 												Line:   39,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Call: &ast.CallExpression{
 										Arguments: []ast.Expression{&ast.ObjectExpression{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 48,
@@ -3884,10 +4327,12 @@ var pkgAST = &ast.Package{
 														Line:   42,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Properties: []*ast.Property{&ast.Property{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 38,
@@ -3900,10 +4345,12 @@ var pkgAST = &ast.Package{
 															Line:   42,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Key: &ast.Identifier{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 28,
@@ -3916,12 +4363,14 @@ var pkgAST = &ast.Package{
 																Line:   42,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Name: "column",
 												},
 												Value: &ast.StringLiteral{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 38,
@@ -3934,12 +4383,14 @@ var pkgAST = &ast.Package{
 																Line:   42,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Value: "_level",
 												},
 											}, &ast.Property{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 48,
@@ -3952,10 +4403,12 @@ var pkgAST = &ast.Package{
 															Line:   42,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Key: &ast.Identifier{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 42,
@@ -3968,12 +4421,14 @@ var pkgAST = &ast.Package{
 																Line:   42,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Name: "as",
 												},
 												Value: &ast.StringLiteral{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 48,
@@ -3986,6 +4441,7 @@ var pkgAST = &ast.Package{
 																Line:   42,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Value: "l2",
 												},
@@ -3993,7 +4449,8 @@ var pkgAST = &ast.Package{
 											With: nil,
 										}},
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 49,
@@ -4006,10 +4463,12 @@ var pkgAST = &ast.Package{
 													Line:   42,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Callee: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 21,
@@ -4022,13 +4481,15 @@ var pkgAST = &ast.Package{
 														Line:   42,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "duplicate",
 										},
 									},
 								},
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 37,
@@ -4041,11 +4502,13 @@ var pkgAST = &ast.Package{
 											Line:   39,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Call: &ast.CallExpression{
 									Arguments: []ast.Expression{&ast.ObjectExpression{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 36,
@@ -4058,10 +4521,12 @@ var pkgAST = &ast.Package{
 													Line:   43,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Properties: []*ast.Property{&ast.Property{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 36,
@@ -4074,10 +4539,12 @@ var pkgAST = &ast.Package{
 														Line:   43,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Key: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 24,
@@ -4090,12 +4557,14 @@ var pkgAST = &ast.Package{
 															Line:   43,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "columns",
 											},
 											Value: &ast.ArrayExpression{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 36,
@@ -4108,10 +4577,12 @@ var pkgAST = &ast.Package{
 															Line:   43,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Elements: []ast.Expression{&ast.StringLiteral{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 35,
@@ -4124,6 +4595,7 @@ var pkgAST = &ast.Package{
 																Line:   43,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Value: "_level",
 												}},
@@ -4132,7 +4604,8 @@ var pkgAST = &ast.Package{
 										With: nil,
 									}},
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 37,
@@ -4145,10 +4618,12 @@ var pkgAST = &ast.Package{
 												Line:   43,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Callee: &ast.Identifier{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 16,
@@ -4161,13 +4636,15 @@ var pkgAST = &ast.Package{
 													Line:   43,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Name: "drop",
 									},
 								},
 							},
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 45,
@@ -4180,11 +4657,13 @@ var pkgAST = &ast.Package{
 										Line:   39,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Call: &ast.CallExpression{
 								Arguments: []ast.Expression{&ast.ObjectExpression{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 44,
@@ -4197,10 +4676,12 @@ var pkgAST = &ast.Package{
 												Line:   44,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Properties: []*ast.Property{&ast.Property{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 44,
@@ -4213,10 +4694,12 @@ var pkgAST = &ast.Package{
 													Line:   44,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Key: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 26,
@@ -4229,12 +4712,14 @@ var pkgAST = &ast.Package{
 														Line:   44,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "columns",
 										},
 										Value: &ast.ObjectExpression{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 44,
@@ -4247,10 +4732,12 @@ var pkgAST = &ast.Package{
 														Line:   44,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Properties: []*ast.Property{&ast.Property{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 43,
@@ -4263,10 +4750,12 @@ var pkgAST = &ast.Package{
 															Line:   44,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Key: &ast.StringLiteral{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 33,
@@ -4279,12 +4768,14 @@ var pkgAST = &ast.Package{
 																Line:   44,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Value: "l2",
 												},
 												Value: &ast.StringLiteral{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 43,
@@ -4297,6 +4788,7 @@ var pkgAST = &ast.Package{
 																Line:   44,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Value: "_level",
 												},
@@ -4307,7 +4799,8 @@ var pkgAST = &ast.Package{
 									With: nil,
 								}},
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 45,
@@ -4320,10 +4813,12 @@ var pkgAST = &ast.Package{
 											Line:   44,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Callee: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 18,
@@ -4336,6 +4831,7 @@ var pkgAST = &ast.Package{
 												Line:   44,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "rename",
 								},
@@ -4343,7 +4839,8 @@ var pkgAST = &ast.Package{
 						},
 					}, &ast.VariableAssignment{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 36,
@@ -4356,10 +4853,12 @@ var pkgAST = &ast.Package{
 									Line:   46,
 								},
 							},
+							TrailingComment: nil,
 						},
 						ID: &ast.Identifier{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 17,
@@ -4372,6 +4871,7 @@ var pkgAST = &ast.Package{
 										Line:   46,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Name: "allStatuses",
 						},
@@ -4379,7 +4879,8 @@ var pkgAST = &ast.Package{
 							Argument: &ast.CallExpression{
 								Arguments: []ast.Expression{&ast.ObjectExpression{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 60,
@@ -4392,10 +4893,12 @@ var pkgAST = &ast.Package{
 												Line:   46,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Properties: []*ast.Property{&ast.Property{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 60,
@@ -4408,10 +4911,12 @@ var pkgAST = &ast.Package{
 													Line:   46,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Key: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 32,
@@ -4424,12 +4929,14 @@ var pkgAST = &ast.Package{
 														Line:   46,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "tables",
 										},
 										Value: &ast.ArrayExpression{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 60,
@@ -4442,10 +4949,12 @@ var pkgAST = &ast.Package{
 														Line:   46,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Elements: []ast.Expression{&ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 45,
@@ -4458,11 +4967,13 @@ var pkgAST = &ast.Package{
 															Line:   46,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "toStatuses",
 											}, &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 59,
@@ -4475,6 +4986,7 @@ var pkgAST = &ast.Package{
 															Line:   46,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "fromStatuses",
 											}},
@@ -4483,7 +4995,8 @@ var pkgAST = &ast.Package{
 									With: nil,
 								}},
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 61,
@@ -4496,10 +5009,12 @@ var pkgAST = &ast.Package{
 											Line:   46,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Callee: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 25,
@@ -4512,12 +5027,14 @@ var pkgAST = &ast.Package{
 												Line:   46,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "union",
 								},
 							},
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 36,
@@ -4530,11 +5047,13 @@ var pkgAST = &ast.Package{
 										Line:   46,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Call: &ast.CallExpression{
 								Arguments: []ast.Expression{&ast.ObjectExpression{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 35,
@@ -4547,10 +5066,12 @@ var pkgAST = &ast.Package{
 												Line:   47,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Properties: []*ast.Property{&ast.Property{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 35,
@@ -4563,10 +5084,12 @@ var pkgAST = &ast.Package{
 													Line:   47,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Key: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 24,
@@ -4579,12 +5102,14 @@ var pkgAST = &ast.Package{
 														Line:   47,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "columns",
 										},
 										Value: &ast.ArrayExpression{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 35,
@@ -4597,10 +5122,12 @@ var pkgAST = &ast.Package{
 														Line:   47,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Elements: []ast.Expression{&ast.StringLiteral{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 34,
@@ -4613,6 +5140,7 @@ var pkgAST = &ast.Package{
 															Line:   47,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Value: "_time",
 											}},
@@ -4621,7 +5149,8 @@ var pkgAST = &ast.Package{
 									With: nil,
 								}},
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 36,
@@ -4634,10 +5163,12 @@ var pkgAST = &ast.Package{
 											Line:   47,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Callee: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 16,
@@ -4650,6 +5181,7 @@ var pkgAST = &ast.Package{
 												Line:   47,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "sort",
 								},
@@ -4662,7 +5194,8 @@ var pkgAST = &ast.Package{
 									Argument: &ast.PipeExpression{
 										Argument: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 23,
@@ -4675,11 +5208,13 @@ var pkgAST = &ast.Package{
 														Line:   49,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "allStatuses",
 										},
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 48,
@@ -4692,11 +5227,13 @@ var pkgAST = &ast.Package{
 													Line:   49,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Call: &ast.CallExpression{
 											Arguments: []ast.Expression{&ast.ObjectExpression{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 47,
@@ -4709,10 +5246,12 @@ var pkgAST = &ast.Package{
 															Line:   50,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Properties: []*ast.Property{&ast.Property{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 47,
@@ -4725,10 +5264,12 @@ var pkgAST = &ast.Package{
 																Line:   50,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Key: &ast.Identifier{
 														BaseNode: ast.BaseNode{
-															Errors: nil,
+															Comments: nil,
+															Errors:   nil,
 															Loc: &ast.SourceLocation{
 																End: ast.Position{
 																	Column: 30,
@@ -4741,12 +5282,14 @@ var pkgAST = &ast.Package{
 																	Line:   50,
 																},
 															},
+															TrailingComment: nil,
 														},
 														Name: "columns",
 													},
 													Value: &ast.ArrayExpression{
 														BaseNode: ast.BaseNode{
-															Errors: nil,
+															Comments: nil,
+															Errors:   nil,
 															Loc: &ast.SourceLocation{
 																End: ast.Position{
 																	Column: 47,
@@ -4759,10 +5302,12 @@ var pkgAST = &ast.Package{
 																	Line:   50,
 																},
 															},
+															TrailingComment: nil,
 														},
 														Elements: []ast.Expression{&ast.StringLiteral{
 															BaseNode: ast.BaseNode{
-																Errors: nil,
+																Comments: nil,
+																Errors:   nil,
 																Loc: &ast.SourceLocation{
 																	End: ast.Position{
 																		Column: 46,
@@ -4775,6 +5320,7 @@ var pkgAST = &ast.Package{
 																		Line:   50,
 																	},
 																},
+																TrailingComment: nil,
 															},
 															Value: "level_value",
 														}},
@@ -4783,7 +5329,8 @@ var pkgAST = &ast.Package{
 												With: nil,
 											}},
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 48,
@@ -4796,10 +5343,12 @@ var pkgAST = &ast.Package{
 														Line:   50,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Callee: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 22,
@@ -4812,13 +5361,15 @@ var pkgAST = &ast.Package{
 															Line:   50,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "difference",
 											},
 										},
 									},
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 48,
@@ -4831,11 +5382,13 @@ var pkgAST = &ast.Package{
 												Line:   49,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Call: &ast.CallExpression{
 										Arguments: []ast.Expression{&ast.ObjectExpression{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 47,
@@ -4848,10 +5401,12 @@ var pkgAST = &ast.Package{
 														Line:   51,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Properties: []*ast.Property{&ast.Property{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 47,
@@ -4864,10 +5419,12 @@ var pkgAST = &ast.Package{
 															Line:   51,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Key: &ast.Identifier{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 21,
@@ -4880,12 +5437,14 @@ var pkgAST = &ast.Package{
 																Line:   51,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Name: "fn",
 												},
 												Value: &ast.FunctionExpression{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 47,
@@ -4898,10 +5457,12 @@ var pkgAST = &ast.Package{
 																Line:   51,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Body: &ast.BinaryExpression{
 														BaseNode: ast.BaseNode{
-															Errors: nil,
+															Comments: nil,
+															Errors:   nil,
 															Loc: &ast.SourceLocation{
 																End: ast.Position{
 																	Column: 47,
@@ -4914,10 +5475,12 @@ var pkgAST = &ast.Package{
 																	Line:   51,
 																},
 															},
+															TrailingComment: nil,
 														},
 														Left: &ast.MemberExpression{
 															BaseNode: ast.BaseNode{
-																Errors: nil,
+																Comments: nil,
+																Errors:   nil,
 																Loc: &ast.SourceLocation{
 																	End: ast.Position{
 																		Column: 43,
@@ -4930,10 +5493,12 @@ var pkgAST = &ast.Package{
 																		Line:   51,
 																	},
 																},
+																TrailingComment: nil,
 															},
 															Object: &ast.Identifier{
 																BaseNode: ast.BaseNode{
-																	Errors: nil,
+																	Comments: nil,
+																	Errors:   nil,
 																	Loc: &ast.SourceLocation{
 																		End: ast.Position{
 																			Column: 31,
@@ -4946,12 +5511,14 @@ var pkgAST = &ast.Package{
 																			Line:   51,
 																		},
 																	},
+																	TrailingComment: nil,
 																},
 																Name: "r",
 															},
 															Property: &ast.Identifier{
 																BaseNode: ast.BaseNode{
-																	Errors: nil,
+																	Comments: nil,
+																	Errors:   nil,
 																	Loc: &ast.SourceLocation{
 																		End: ast.Position{
 																			Column: 43,
@@ -4964,6 +5531,7 @@ var pkgAST = &ast.Package{
 																			Line:   51,
 																		},
 																	},
+																	TrailingComment: nil,
 																},
 																Name: "level_value",
 															},
@@ -4971,7 +5539,8 @@ var pkgAST = &ast.Package{
 														Operator: 10,
 														Right: &ast.IntegerLiteral{
 															BaseNode: ast.BaseNode{
-																Errors: nil,
+																Comments: nil,
+																Errors:   nil,
 																Loc: &ast.SourceLocation{
 																	End: ast.Position{
 																		Column: 47,
@@ -4984,13 +5553,15 @@ var pkgAST = &ast.Package{
 																		Line:   51,
 																	},
 																},
+																TrailingComment: nil,
 															},
 															Value: int64(0),
 														},
 													},
 													Params: []*ast.Property{&ast.Property{
 														BaseNode: ast.BaseNode{
-															Errors: nil,
+															Comments: nil,
+															Errors:   nil,
 															Loc: &ast.SourceLocation{
 																End: ast.Position{
 																	Column: 25,
@@ -5003,10 +5574,12 @@ var pkgAST = &ast.Package{
 																	Line:   51,
 																},
 															},
+															TrailingComment: nil,
 														},
 														Key: &ast.Identifier{
 															BaseNode: ast.BaseNode{
-																Errors: nil,
+																Comments: nil,
+																Errors:   nil,
 																Loc: &ast.SourceLocation{
 																	End: ast.Position{
 																		Column: 25,
@@ -5019,6 +5592,7 @@ var pkgAST = &ast.Package{
 																		Line:   51,
 																	},
 																},
+																TrailingComment: nil,
 															},
 															Name: "r",
 														},
@@ -5029,7 +5603,8 @@ var pkgAST = &ast.Package{
 											With: nil,
 										}},
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 48,
@@ -5042,10 +5617,12 @@ var pkgAST = &ast.Package{
 													Line:   51,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Callee: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 18,
@@ -5058,13 +5635,15 @@ var pkgAST = &ast.Package{
 														Line:   51,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "filter",
 										},
 									},
 								},
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 42,
@@ -5077,11 +5656,13 @@ var pkgAST = &ast.Package{
 											Line:   49,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Call: &ast.CallExpression{
 									Arguments: []ast.Expression{&ast.ObjectExpression{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 41,
@@ -5094,10 +5675,12 @@ var pkgAST = &ast.Package{
 													Line:   52,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Properties: []*ast.Property{&ast.Property{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 41,
@@ -5110,10 +5693,12 @@ var pkgAST = &ast.Package{
 														Line:   52,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Key: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 24,
@@ -5126,12 +5711,14 @@ var pkgAST = &ast.Package{
 															Line:   52,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "columns",
 											},
 											Value: &ast.ArrayExpression{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 41,
@@ -5144,10 +5731,12 @@ var pkgAST = &ast.Package{
 															Line:   52,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Elements: []ast.Expression{&ast.StringLiteral{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 40,
@@ -5160,6 +5749,7 @@ var pkgAST = &ast.Package{
 																Line:   52,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Value: "level_value",
 												}},
@@ -5168,7 +5758,8 @@ var pkgAST = &ast.Package{
 										With: nil,
 									}},
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 42,
@@ -5181,10 +5772,12 @@ var pkgAST = &ast.Package{
 												Line:   52,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Callee: &ast.Identifier{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 16,
@@ -5197,13 +5790,15 @@ var pkgAST = &ast.Package{
 													Line:   52,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Name: "drop",
 									},
 								},
 							},
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 67,
@@ -5216,11 +5811,13 @@ var pkgAST = &ast.Package{
 										Line:   49,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Call: &ast.CallExpression{
 								Arguments: []ast.Expression{&ast.ObjectExpression{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 66,
@@ -5233,10 +5830,12 @@ var pkgAST = &ast.Package{
 												Line:   53,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Properties: []*ast.Property{&ast.Property{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 45,
@@ -5249,10 +5848,12 @@ var pkgAST = &ast.Package{
 													Line:   53,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Key: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 35,
@@ -5265,12 +5866,14 @@ var pkgAST = &ast.Package{
 														Line:   53,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "mode",
 										},
 										Value: &ast.StringLiteral{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 45,
@@ -5283,12 +5886,14 @@ var pkgAST = &ast.Package{
 														Line:   53,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Value: "extend",
 										},
 									}, &ast.Property{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 66,
@@ -5301,10 +5906,12 @@ var pkgAST = &ast.Package{
 													Line:   53,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Key: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 54,
@@ -5317,12 +5924,14 @@ var pkgAST = &ast.Package{
 														Line:   53,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "columns",
 										},
 										Value: &ast.ArrayExpression{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 66,
@@ -5335,10 +5944,12 @@ var pkgAST = &ast.Package{
 														Line:   53,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Elements: []ast.Expression{&ast.StringLiteral{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 65,
@@ -5351,6 +5962,7 @@ var pkgAST = &ast.Package{
 															Line:   53,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Value: "_level",
 											}},
@@ -5359,7 +5971,8 @@ var pkgAST = &ast.Package{
 									With: nil,
 								}},
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 67,
@@ -5372,10 +5985,12 @@ var pkgAST = &ast.Package{
 											Line:   53,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Callee: &ast.MemberExpression{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 30,
@@ -5388,10 +6003,12 @@ var pkgAST = &ast.Package{
 												Line:   53,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Object: &ast.Identifier{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 24,
@@ -5404,12 +6021,14 @@ var pkgAST = &ast.Package{
 													Line:   53,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Name: "experimental",
 									},
 									Property: &ast.Identifier{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 30,
@@ -5422,6 +6041,7 @@ var pkgAST = &ast.Package{
 													Line:   53,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Name: "group",
 									},
@@ -5429,7 +6049,8 @@ var pkgAST = &ast.Package{
 							},
 						},
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 67,
@@ -5442,12 +6063,14 @@ var pkgAST = &ast.Package{
 									Line:   49,
 								},
 							},
+							TrailingComment: nil,
 						},
 					}},
 				},
 				Params: []*ast.Property{&ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 32,
@@ -5460,10 +6083,12 @@ var pkgAST = &ast.Package{
 								Line:   28,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 26,
@@ -5476,12 +6101,14 @@ var pkgAST = &ast.Package{
 									Line:   28,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Name: "fromLevel",
 					},
 					Value: &ast.StringLiteral{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 32,
@@ -5494,12 +6121,14 @@ var pkgAST = &ast.Package{
 									Line:   28,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Value: "any",
 					},
 				}, &ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 41,
@@ -5512,10 +6141,12 @@ var pkgAST = &ast.Package{
 								Line:   28,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 41,
@@ -5528,13 +6159,15 @@ var pkgAST = &ast.Package{
 									Line:   28,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Name: "toLevel",
 					},
 					Value: nil,
 				}, &ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 52,
@@ -5547,10 +6180,12 @@ var pkgAST = &ast.Package{
 								Line:   28,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 49,
@@ -5563,11 +6198,13 @@ var pkgAST = &ast.Package{
 									Line:   28,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Name: "tables",
 					},
 					Value: &ast.PipeLiteral{BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 52,
@@ -5580,12 +6217,14 @@ var pkgAST = &ast.Package{
 								Line:   28,
 							},
 						},
+						TrailingComment: nil,
 					}},
 				}},
 			},
 		}, &ast.VariableAssignment{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: []ast.Comment{ast.Comment{Text: "// Notify will call the endpoint and log the results."}},
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 17,
@@ -5598,10 +6237,12 @@ var pkgAST = &ast.Package{
 						Line:   57,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 7,
@@ -5614,12 +6255,14 @@ var pkgAST = &ast.Package{
 							Line:   57,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "notify",
 			},
 			Init: &ast.FunctionExpression{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 17,
@@ -5632,6 +6275,7 @@ var pkgAST = &ast.Package{
 							Line:   57,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Body: &ast.PipeExpression{
 					Argument: &ast.PipeExpression{
@@ -5641,7 +6285,8 @@ var pkgAST = &ast.Package{
 									Argument: &ast.PipeExpression{
 										Argument: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 11,
@@ -5654,11 +6299,13 @@ var pkgAST = &ast.Package{
 														Line:   58,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "tables",
 										},
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 37,
@@ -5671,11 +6318,13 @@ var pkgAST = &ast.Package{
 													Line:   58,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Call: &ast.CallExpression{
 											Arguments: []ast.Expression{&ast.ObjectExpression{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 36,
@@ -5688,10 +6337,12 @@ var pkgAST = &ast.Package{
 															Line:   59,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Properties: []*ast.Property{&ast.Property{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 36,
@@ -5704,10 +6355,12 @@ var pkgAST = &ast.Package{
 																Line:   59,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Key: &ast.Identifier{
 														BaseNode: ast.BaseNode{
-															Errors: nil,
+															Comments: nil,
+															Errors:   nil,
 															Loc: &ast.SourceLocation{
 																End: ast.Position{
 																	Column: 30,
@@ -5720,12 +6373,14 @@ var pkgAST = &ast.Package{
 																	Line:   59,
 																},
 															},
+															TrailingComment: nil,
 														},
 														Name: "o",
 													},
 													Value: &ast.Identifier{
 														BaseNode: ast.BaseNode{
-															Errors: nil,
+															Comments: nil,
+															Errors:   nil,
 															Loc: &ast.SourceLocation{
 																End: ast.Position{
 																	Column: 36,
@@ -5738,6 +6393,7 @@ var pkgAST = &ast.Package{
 																	Line:   59,
 																},
 															},
+															TrailingComment: nil,
 														},
 														Name: "data",
 													},
@@ -5745,7 +6401,8 @@ var pkgAST = &ast.Package{
 												With: nil,
 											}},
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 37,
@@ -5758,10 +6415,12 @@ var pkgAST = &ast.Package{
 														Line:   59,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Callee: &ast.MemberExpression{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 28,
@@ -5774,10 +6433,12 @@ var pkgAST = &ast.Package{
 															Line:   59,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Object: &ast.Identifier{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 24,
@@ -5790,12 +6451,14 @@ var pkgAST = &ast.Package{
 																Line:   59,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Name: "experimental",
 												},
 												Property: &ast.Identifier{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 28,
@@ -5808,6 +6471,7 @@ var pkgAST = &ast.Package{
 																Line:   59,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Name: "set",
 												},
@@ -5815,7 +6479,8 @@ var pkgAST = &ast.Package{
 										},
 									},
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 89,
@@ -5828,11 +6493,13 @@ var pkgAST = &ast.Package{
 												Line:   58,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Call: &ast.CallExpression{
 										Arguments: []ast.Expression{&ast.ObjectExpression{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 88,
@@ -5845,10 +6512,12 @@ var pkgAST = &ast.Package{
 														Line:   60,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Properties: []*ast.Property{&ast.Property{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 45,
@@ -5861,10 +6530,12 @@ var pkgAST = &ast.Package{
 															Line:   60,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Key: &ast.Identifier{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 35,
@@ -5877,12 +6548,14 @@ var pkgAST = &ast.Package{
 																Line:   60,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Name: "mode",
 												},
 												Value: &ast.StringLiteral{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 45,
@@ -5895,12 +6568,14 @@ var pkgAST = &ast.Package{
 																Line:   60,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Value: "extend",
 												},
 											}, &ast.Property{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 88,
@@ -5913,10 +6588,12 @@ var pkgAST = &ast.Package{
 															Line:   60,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Key: &ast.Identifier{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 54,
@@ -5929,13 +6606,15 @@ var pkgAST = &ast.Package{
 																Line:   60,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Name: "columns",
 												},
 												Value: &ast.CallExpression{
 													Arguments: []ast.Expression{&ast.ObjectExpression{
 														BaseNode: ast.BaseNode{
-															Errors: nil,
+															Comments: nil,
+															Errors:   nil,
 															Loc: &ast.SourceLocation{
 																End: ast.Position{
 																	Column: 87,
@@ -5948,10 +6627,12 @@ var pkgAST = &ast.Package{
 																	Line:   60,
 																},
 															},
+															TrailingComment: nil,
 														},
 														Properties: []*ast.Property{&ast.Property{
 															BaseNode: ast.BaseNode{
-																Errors: nil,
+																Comments: nil,
+																Errors:   nil,
 																Loc: &ast.SourceLocation{
 																	End: ast.Position{
 																		Column: 87,
@@ -5964,10 +6645,12 @@ var pkgAST = &ast.Package{
 																		Line:   60,
 																	},
 																},
+																TrailingComment: nil,
 															},
 															Key: &ast.Identifier{
 																BaseNode: ast.BaseNode{
-																	Errors: nil,
+																	Comments: nil,
+																	Errors:   nil,
 																	Loc: &ast.SourceLocation{
 																		End: ast.Position{
 																			Column: 81,
@@ -5980,12 +6663,14 @@ var pkgAST = &ast.Package{
 																			Line:   60,
 																		},
 																	},
+																	TrailingComment: nil,
 																},
 																Name: "o",
 															},
 															Value: &ast.Identifier{
 																BaseNode: ast.BaseNode{
-																	Errors: nil,
+																	Comments: nil,
+																	Errors:   nil,
 																	Loc: &ast.SourceLocation{
 																		End: ast.Position{
 																			Column: 87,
@@ -5998,6 +6683,7 @@ var pkgAST = &ast.Package{
 																			Line:   60,
 																		},
 																	},
+																	TrailingComment: nil,
 																},
 																Name: "data",
 															},
@@ -6005,7 +6691,8 @@ var pkgAST = &ast.Package{
 														With: nil,
 													}},
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 88,
@@ -6018,10 +6705,12 @@ var pkgAST = &ast.Package{
 																Line:   60,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Callee: &ast.MemberExpression{
 														BaseNode: ast.BaseNode{
-															Errors: nil,
+															Comments: nil,
+															Errors:   nil,
 															Loc: &ast.SourceLocation{
 																End: ast.Position{
 																	Column: 79,
@@ -6034,10 +6723,12 @@ var pkgAST = &ast.Package{
 																	Line:   60,
 																},
 															},
+															TrailingComment: nil,
 														},
 														Object: &ast.Identifier{
 															BaseNode: ast.BaseNode{
-																Errors: nil,
+																Comments: nil,
+																Errors:   nil,
 																Loc: &ast.SourceLocation{
 																	End: ast.Position{
 																		Column: 68,
@@ -6050,12 +6741,14 @@ var pkgAST = &ast.Package{
 																		Line:   60,
 																	},
 																},
+																TrailingComment: nil,
 															},
 															Name: "experimental",
 														},
 														Property: &ast.Identifier{
 															BaseNode: ast.BaseNode{
-																Errors: nil,
+																Comments: nil,
+																Errors:   nil,
 																Loc: &ast.SourceLocation{
 																	End: ast.Position{
 																		Column: 79,
@@ -6068,6 +6761,7 @@ var pkgAST = &ast.Package{
 																		Line:   60,
 																	},
 																},
+																TrailingComment: nil,
 															},
 															Name: "objectKeys",
 														},
@@ -6077,7 +6771,8 @@ var pkgAST = &ast.Package{
 											With: nil,
 										}},
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 89,
@@ -6090,10 +6785,12 @@ var pkgAST = &ast.Package{
 													Line:   60,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Callee: &ast.MemberExpression{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 30,
@@ -6106,10 +6803,12 @@ var pkgAST = &ast.Package{
 														Line:   60,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Object: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 24,
@@ -6122,12 +6821,14 @@ var pkgAST = &ast.Package{
 															Line:   60,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "experimental",
 											},
 											Property: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 30,
@@ -6140,6 +6841,7 @@ var pkgAST = &ast.Package{
 															Line:   60,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "group",
 											},
@@ -6147,7 +6849,8 @@ var pkgAST = &ast.Package{
 									},
 								},
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 12,
@@ -6160,11 +6863,13 @@ var pkgAST = &ast.Package{
 											Line:   58,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Call: &ast.CallExpression{
 									Arguments: []ast.Expression{&ast.ObjectExpression{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 11,
@@ -6177,10 +6882,12 @@ var pkgAST = &ast.Package{
 													Line:   61,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Properties: []*ast.Property{&ast.Property{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 11,
@@ -6193,10 +6900,12 @@ var pkgAST = &ast.Package{
 														Line:   61,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Key: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 18,
@@ -6209,12 +6918,14 @@ var pkgAST = &ast.Package{
 															Line:   61,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "fn",
 											},
 											Value: &ast.FunctionExpression{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 11,
@@ -6227,10 +6938,12 @@ var pkgAST = &ast.Package{
 															Line:   61,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Body: &ast.ParenExpression{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 11,
@@ -6243,10 +6956,12 @@ var pkgAST = &ast.Package{
 																Line:   61,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Expression: &ast.ObjectExpression{
 														BaseNode: ast.BaseNode{
-															Errors: nil,
+															Comments: nil,
+															Errors:   nil,
 															Loc: &ast.SourceLocation{
 																End: ast.Position{
 																	Column: 10,
@@ -6259,10 +6974,12 @@ var pkgAST = &ast.Package{
 																	Line:   61,
 																},
 															},
+															TrailingComment: nil,
 														},
 														Properties: []*ast.Property{&ast.Property{
 															BaseNode: ast.BaseNode{
-																Errors: nil,
+																Comments: nil,
+																Errors:   nil,
 																Loc: &ast.SourceLocation{
 																	End: ast.Position{
 																		Column: 42,
@@ -6275,10 +6992,12 @@ var pkgAST = &ast.Package{
 																		Line:   62,
 																	},
 																},
+																TrailingComment: nil,
 															},
 															Key: &ast.Identifier{
 																BaseNode: ast.BaseNode{
-																	Errors: nil,
+																	Comments: nil,
+																	Errors:   nil,
 																	Loc: &ast.SourceLocation{
 																		End: ast.Position{
 																			Column: 25,
@@ -6291,12 +7010,14 @@ var pkgAST = &ast.Package{
 																			Line:   62,
 																		},
 																	},
+																	TrailingComment: nil,
 																},
 																Name: "_measurement",
 															},
 															Value: &ast.StringLiteral{
 																BaseNode: ast.BaseNode{
-																	Errors: nil,
+																	Comments: nil,
+																	Errors:   nil,
 																	Loc: &ast.SourceLocation{
 																		End: ast.Position{
 																			Column: 42,
@@ -6309,12 +7030,14 @@ var pkgAST = &ast.Package{
 																			Line:   62,
 																		},
 																	},
+																	TrailingComment: nil,
 																},
 																Value: "notifications",
 															},
 														}, &ast.Property{
 															BaseNode: ast.BaseNode{
-																Errors: nil,
+																Comments: nil,
+																Errors:   nil,
 																Loc: &ast.SourceLocation{
 																	End: ast.Position{
 																		Column: 47,
@@ -6327,10 +7050,12 @@ var pkgAST = &ast.Package{
 																		Line:   63,
 																	},
 																},
+																TrailingComment: nil,
 															},
 															Key: &ast.Identifier{
 																BaseNode: ast.BaseNode{
-																	Errors: nil,
+																	Comments: nil,
+																	Errors:   nil,
 																	Loc: &ast.SourceLocation{
 																		End: ast.Position{
 																			Column: 30,
@@ -6343,13 +7068,15 @@ var pkgAST = &ast.Package{
 																			Line:   63,
 																		},
 																	},
+																	TrailingComment: nil,
 																},
 																Name: "_status_timestamp",
 															},
 															Value: &ast.CallExpression{
 																Arguments: []ast.Expression{&ast.ObjectExpression{
 																	BaseNode: ast.BaseNode{
-																		Errors: nil,
+																		Comments: nil,
+																		Errors:   nil,
 																		Loc: &ast.SourceLocation{
 																			End: ast.Position{
 																				Column: 46,
@@ -6362,10 +7089,12 @@ var pkgAST = &ast.Package{
 																				Line:   63,
 																			},
 																		},
+																		TrailingComment: nil,
 																	},
 																	Properties: []*ast.Property{&ast.Property{
 																		BaseNode: ast.BaseNode{
-																			Errors: nil,
+																			Comments: nil,
+																			Errors:   nil,
 																			Loc: &ast.SourceLocation{
 																				End: ast.Position{
 																					Column: 46,
@@ -6378,10 +7107,12 @@ var pkgAST = &ast.Package{
 																					Line:   63,
 																				},
 																			},
+																			TrailingComment: nil,
 																		},
 																		Key: &ast.Identifier{
 																			BaseNode: ast.BaseNode{
-																				Errors: nil,
+																				Comments: nil,
+																				Errors:   nil,
 																				Loc: &ast.SourceLocation{
 																					End: ast.Position{
 																						Column: 37,
@@ -6394,12 +7125,14 @@ var pkgAST = &ast.Package{
 																						Line:   63,
 																					},
 																				},
+																				TrailingComment: nil,
 																			},
 																			Name: "v",
 																		},
 																		Value: &ast.MemberExpression{
 																			BaseNode: ast.BaseNode{
-																				Errors: nil,
+																				Comments: nil,
+																				Errors:   nil,
 																				Loc: &ast.SourceLocation{
 																					End: ast.Position{
 																						Column: 46,
@@ -6412,10 +7145,12 @@ var pkgAST = &ast.Package{
 																						Line:   63,
 																					},
 																				},
+																				TrailingComment: nil,
 																			},
 																			Object: &ast.Identifier{
 																				BaseNode: ast.BaseNode{
-																					Errors: nil,
+																					Comments: nil,
+																					Errors:   nil,
 																					Loc: &ast.SourceLocation{
 																						End: ast.Position{
 																							Column: 40,
@@ -6428,12 +7163,14 @@ var pkgAST = &ast.Package{
 																							Line:   63,
 																						},
 																					},
+																					TrailingComment: nil,
 																				},
 																				Name: "r",
 																			},
 																			Property: &ast.Identifier{
 																				BaseNode: ast.BaseNode{
-																					Errors: nil,
+																					Comments: nil,
+																					Errors:   nil,
 																					Loc: &ast.SourceLocation{
 																						End: ast.Position{
 																							Column: 46,
@@ -6446,6 +7183,7 @@ var pkgAST = &ast.Package{
 																							Line:   63,
 																						},
 																					},
+																					TrailingComment: nil,
 																				},
 																				Name: "_time",
 																			},
@@ -6454,7 +7192,8 @@ var pkgAST = &ast.Package{
 																	With: nil,
 																}},
 																BaseNode: ast.BaseNode{
-																	Errors: nil,
+																	Comments: nil,
+																	Errors:   nil,
 																	Loc: &ast.SourceLocation{
 																		End: ast.Position{
 																			Column: 47,
@@ -6467,10 +7206,12 @@ var pkgAST = &ast.Package{
 																			Line:   63,
 																		},
 																	},
+																	TrailingComment: nil,
 																},
 																Callee: &ast.Identifier{
 																	BaseNode: ast.BaseNode{
-																		Errors: nil,
+																		Comments: nil,
+																		Errors:   nil,
 																		Loc: &ast.SourceLocation{
 																			End: ast.Position{
 																				Column: 35,
@@ -6483,13 +7224,15 @@ var pkgAST = &ast.Package{
 																				Line:   63,
 																			},
 																		},
+																		TrailingComment: nil,
 																	},
 																	Name: "int",
 																},
 															},
 														}, &ast.Property{
 															BaseNode: ast.BaseNode{
-																Errors: nil,
+																Comments: nil,
+																Errors:   nil,
 																Loc: &ast.SourceLocation{
 																	End: ast.Position{
 																		Column: 25,
@@ -6502,10 +7245,12 @@ var pkgAST = &ast.Package{
 																		Line:   64,
 																	},
 																},
+																TrailingComment: nil,
 															},
 															Key: &ast.Identifier{
 																BaseNode: ast.BaseNode{
-																	Errors: nil,
+																	Comments: nil,
+																	Errors:   nil,
 																	Loc: &ast.SourceLocation{
 																		End: ast.Position{
 																			Column: 18,
@@ -6518,13 +7263,15 @@ var pkgAST = &ast.Package{
 																			Line:   64,
 																		},
 																	},
+																	TrailingComment: nil,
 																},
 																Name: "_time",
 															},
 															Value: &ast.CallExpression{
 																Arguments: nil,
 																BaseNode: ast.BaseNode{
-																	Errors: nil,
+																	Comments: nil,
+																	Errors:   nil,
 																	Loc: &ast.SourceLocation{
 																		End: ast.Position{
 																			Column: 25,
@@ -6537,10 +7284,12 @@ var pkgAST = &ast.Package{
 																			Line:   64,
 																		},
 																	},
+																	TrailingComment: nil,
 																},
 																Callee: &ast.Identifier{
 																	BaseNode: ast.BaseNode{
-																		Errors: nil,
+																		Comments: nil,
+																		Errors:   nil,
 																		Loc: &ast.SourceLocation{
 																			End: ast.Position{
 																				Column: 23,
@@ -6553,6 +7302,7 @@ var pkgAST = &ast.Package{
 																				Line:   64,
 																			},
 																		},
+																		TrailingComment: nil,
 																	},
 																	Name: "now",
 																},
@@ -6560,7 +7310,8 @@ var pkgAST = &ast.Package{
 														}},
 														With: &ast.Identifier{
 															BaseNode: ast.BaseNode{
-																Errors: nil,
+																Comments: nil,
+																Errors:   nil,
 																Loc: &ast.SourceLocation{
 																	End: ast.Position{
 																		Column: 30,
@@ -6573,6 +7324,7 @@ var pkgAST = &ast.Package{
 																		Line:   61,
 																	},
 																},
+																TrailingComment: nil,
 															},
 															Name: "r",
 														},
@@ -6580,7 +7332,8 @@ var pkgAST = &ast.Package{
 												},
 												Params: []*ast.Property{&ast.Property{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 22,
@@ -6593,10 +7346,12 @@ var pkgAST = &ast.Package{
 																Line:   61,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Key: &ast.Identifier{
 														BaseNode: ast.BaseNode{
-															Errors: nil,
+															Comments: nil,
+															Errors:   nil,
 															Loc: &ast.SourceLocation{
 																End: ast.Position{
 																	Column: 22,
@@ -6609,6 +7364,7 @@ var pkgAST = &ast.Package{
 																	Line:   61,
 																},
 															},
+															TrailingComment: nil,
 														},
 														Name: "r",
 													},
@@ -6619,7 +7375,8 @@ var pkgAST = &ast.Package{
 										With: nil,
 									}},
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 12,
@@ -6632,10 +7389,12 @@ var pkgAST = &ast.Package{
 												Line:   61,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Callee: &ast.Identifier{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 15,
@@ -6648,13 +7407,15 @@ var pkgAST = &ast.Package{
 													Line:   61,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Name: "map",
 									},
 								},
 							},
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 22,
@@ -6667,11 +7428,13 @@ var pkgAST = &ast.Package{
 										Line:   58,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Call: &ast.CallExpression{
 								Arguments: nil,
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 22,
@@ -6684,10 +7447,12 @@ var pkgAST = &ast.Package{
 											Line:   66,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Callee: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 20,
@@ -6700,13 +7465,15 @@ var pkgAST = &ast.Package{
 												Line:   66,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "endpoint",
 								},
 							},
 						},
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 66,
@@ -6719,11 +7486,13 @@ var pkgAST = &ast.Package{
 									Line:   58,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Call: &ast.CallExpression{
 							Arguments: []ast.Expression{&ast.ObjectExpression{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 65,
@@ -6736,10 +7505,12 @@ var pkgAST = &ast.Package{
 											Line:   67,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Properties: []*ast.Property{&ast.Property{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 45,
@@ -6752,10 +7523,12 @@ var pkgAST = &ast.Package{
 												Line:   67,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Key: &ast.Identifier{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 35,
@@ -6768,12 +7541,14 @@ var pkgAST = &ast.Package{
 													Line:   67,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Name: "mode",
 									},
 									Value: &ast.StringLiteral{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 45,
@@ -6786,12 +7561,14 @@ var pkgAST = &ast.Package{
 													Line:   67,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Value: "extend",
 									},
 								}, &ast.Property{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 65,
@@ -6804,10 +7581,12 @@ var pkgAST = &ast.Package{
 												Line:   67,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Key: &ast.Identifier{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 54,
@@ -6820,12 +7599,14 @@ var pkgAST = &ast.Package{
 													Line:   67,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Name: "columns",
 									},
 									Value: &ast.ArrayExpression{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 65,
@@ -6838,10 +7619,12 @@ var pkgAST = &ast.Package{
 													Line:   67,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Elements: []ast.Expression{&ast.StringLiteral{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 64,
@@ -6854,6 +7637,7 @@ var pkgAST = &ast.Package{
 														Line:   67,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Value: "_sent",
 										}},
@@ -6862,7 +7646,8 @@ var pkgAST = &ast.Package{
 								With: nil,
 							}},
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 66,
@@ -6875,10 +7660,12 @@ var pkgAST = &ast.Package{
 										Line:   67,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Callee: &ast.MemberExpression{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 30,
@@ -6891,10 +7678,12 @@ var pkgAST = &ast.Package{
 											Line:   67,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Object: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 24,
@@ -6907,12 +7696,14 @@ var pkgAST = &ast.Package{
 												Line:   67,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "experimental",
 								},
 								Property: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 30,
@@ -6925,6 +7716,7 @@ var pkgAST = &ast.Package{
 												Line:   67,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "group",
 								},
@@ -6932,7 +7724,8 @@ var pkgAST = &ast.Package{
 						},
 					},
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 17,
@@ -6945,11 +7738,13 @@ var pkgAST = &ast.Package{
 								Line:   58,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Call: &ast.CallExpression{
 						Arguments: nil,
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 17,
@@ -6962,10 +7757,12 @@ var pkgAST = &ast.Package{
 									Line:   68,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Callee: &ast.Identifier{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 15,
@@ -6978,6 +7775,7 @@ var pkgAST = &ast.Package{
 										Line:   68,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Name: "log",
 						},
@@ -6985,7 +7783,8 @@ var pkgAST = &ast.Package{
 				},
 				Params: []*ast.Property{&ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 20,
@@ -6998,10 +7797,12 @@ var pkgAST = &ast.Package{
 								Line:   57,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 17,
@@ -7014,11 +7815,13 @@ var pkgAST = &ast.Package{
 									Line:   57,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Name: "tables",
 					},
 					Value: &ast.PipeLiteral{BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 20,
@@ -7031,10 +7834,12 @@ var pkgAST = &ast.Package{
 								Line:   57,
 							},
 						},
+						TrailingComment: nil,
 					}},
 				}, &ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 30,
@@ -7047,10 +7852,12 @@ var pkgAST = &ast.Package{
 								Line:   57,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 30,
@@ -7063,13 +7870,15 @@ var pkgAST = &ast.Package{
 									Line:   57,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Name: "endpoint",
 					},
 					Value: nil,
 				}, &ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 39,
@@ -7082,10 +7891,12 @@ var pkgAST = &ast.Package{
 								Line:   57,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 36,
@@ -7098,12 +7909,14 @@ var pkgAST = &ast.Package{
 									Line:   57,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Name: "data",
 					},
 					Value: &ast.ObjectExpression{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 39,
@@ -7116,6 +7929,7 @@ var pkgAST = &ast.Package{
 									Line:   57,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Properties: nil,
 						With:       nil,
@@ -7124,7 +7938,8 @@ var pkgAST = &ast.Package{
 			},
 		}, &ast.VariableAssignment{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: []ast.Comment{ast.Comment{Text: "// Logs retrieves notification events that have been logged."}},
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 29,
@@ -7137,10 +7952,12 @@ var pkgAST = &ast.Package{
 						Line:   71,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 5,
@@ -7153,12 +7970,14 @@ var pkgAST = &ast.Package{
 							Line:   71,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "logs",
 			},
 			Init: &ast.FunctionExpression{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 29,
@@ -7171,6 +7990,7 @@ var pkgAST = &ast.Package{
 							Line:   71,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Body: &ast.PipeExpression{
 					Argument: &ast.PipeExpression{
@@ -7179,7 +7999,8 @@ var pkgAST = &ast.Package{
 								Argument: &ast.CallExpression{
 									Arguments: []ast.Expression{&ast.ObjectExpression{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 33,
@@ -7192,10 +8013,12 @@ var pkgAST = &ast.Package{
 													Line:   72,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Properties: []*ast.Property{&ast.Property{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 33,
@@ -7208,10 +8031,12 @@ var pkgAST = &ast.Package{
 														Line:   72,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Key: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 25,
@@ -7224,12 +8049,14 @@ var pkgAST = &ast.Package{
 															Line:   72,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "bucket",
 											},
 											Value: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 33,
@@ -7242,6 +8069,7 @@ var pkgAST = &ast.Package{
 															Line:   72,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "bucket",
 											},
@@ -7249,7 +8077,8 @@ var pkgAST = &ast.Package{
 										With: nil,
 									}},
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 34,
@@ -7262,10 +8091,12 @@ var pkgAST = &ast.Package{
 												Line:   72,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Callee: &ast.MemberExpression{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 18,
@@ -7278,10 +8109,12 @@ var pkgAST = &ast.Package{
 													Line:   72,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Object: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 13,
@@ -7294,12 +8127,14 @@ var pkgAST = &ast.Package{
 														Line:   72,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "influxdb",
 										},
 										Property: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 18,
@@ -7312,13 +8147,15 @@ var pkgAST = &ast.Package{
 														Line:   72,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "from",
 										},
 									},
 								},
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 43,
@@ -7331,11 +8168,13 @@ var pkgAST = &ast.Package{
 											Line:   72,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Call: &ast.CallExpression{
 									Arguments: []ast.Expression{&ast.ObjectExpression{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 42,
@@ -7348,10 +8187,12 @@ var pkgAST = &ast.Package{
 													Line:   73,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Properties: []*ast.Property{&ast.Property{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 30,
@@ -7364,10 +8205,12 @@ var pkgAST = &ast.Package{
 														Line:   73,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Key: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 23,
@@ -7380,12 +8223,14 @@ var pkgAST = &ast.Package{
 															Line:   73,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "start",
 											},
 											Value: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 30,
@@ -7398,12 +8243,14 @@ var pkgAST = &ast.Package{
 															Line:   73,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "start",
 											},
 										}, &ast.Property{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 42,
@@ -7416,10 +8263,12 @@ var pkgAST = &ast.Package{
 														Line:   73,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Key: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 36,
@@ -7432,12 +8281,14 @@ var pkgAST = &ast.Package{
 															Line:   73,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "stop",
 											},
 											Value: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 42,
@@ -7450,6 +8301,7 @@ var pkgAST = &ast.Package{
 															Line:   73,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "stop",
 											},
@@ -7457,7 +8309,8 @@ var pkgAST = &ast.Package{
 										With: nil,
 									}},
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 43,
@@ -7470,10 +8323,12 @@ var pkgAST = &ast.Package{
 												Line:   73,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Callee: &ast.Identifier{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 17,
@@ -7486,13 +8341,15 @@ var pkgAST = &ast.Package{
 													Line:   73,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Name: "range",
 									},
 								},
 							},
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 64,
@@ -7505,11 +8362,13 @@ var pkgAST = &ast.Package{
 										Line:   72,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Call: &ast.CallExpression{
 								Arguments: []ast.Expression{&ast.ObjectExpression{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 63,
@@ -7522,10 +8381,12 @@ var pkgAST = &ast.Package{
 												Line:   74,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Properties: []*ast.Property{&ast.Property{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 63,
@@ -7538,10 +8399,12 @@ var pkgAST = &ast.Package{
 													Line:   74,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Key: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 21,
@@ -7554,12 +8417,14 @@ var pkgAST = &ast.Package{
 														Line:   74,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "fn",
 										},
 										Value: &ast.FunctionExpression{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 63,
@@ -7572,10 +8437,12 @@ var pkgAST = &ast.Package{
 														Line:   74,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Body: &ast.BinaryExpression{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 63,
@@ -7588,10 +8455,12 @@ var pkgAST = &ast.Package{
 															Line:   74,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Left: &ast.MemberExpression{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 44,
@@ -7604,10 +8473,12 @@ var pkgAST = &ast.Package{
 																Line:   74,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Object: &ast.Identifier{
 														BaseNode: ast.BaseNode{
-															Errors: nil,
+															Comments: nil,
+															Errors:   nil,
 															Loc: &ast.SourceLocation{
 																End: ast.Position{
 																	Column: 31,
@@ -7620,12 +8491,14 @@ var pkgAST = &ast.Package{
 																	Line:   74,
 																},
 															},
+															TrailingComment: nil,
 														},
 														Name: "r",
 													},
 													Property: &ast.Identifier{
 														BaseNode: ast.BaseNode{
-															Errors: nil,
+															Comments: nil,
+															Errors:   nil,
 															Loc: &ast.SourceLocation{
 																End: ast.Position{
 																	Column: 44,
@@ -7638,6 +8511,7 @@ var pkgAST = &ast.Package{
 																	Line:   74,
 																},
 															},
+															TrailingComment: nil,
 														},
 														Name: "_measurement",
 													},
@@ -7645,7 +8519,8 @@ var pkgAST = &ast.Package{
 												Operator: 17,
 												Right: &ast.StringLiteral{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 63,
@@ -7658,13 +8533,15 @@ var pkgAST = &ast.Package{
 																Line:   74,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Value: "notifications",
 												},
 											},
 											Params: []*ast.Property{&ast.Property{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 25,
@@ -7677,10 +8554,12 @@ var pkgAST = &ast.Package{
 															Line:   74,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Key: &ast.Identifier{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 25,
@@ -7693,6 +8572,7 @@ var pkgAST = &ast.Package{
 																Line:   74,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Name: "r",
 												},
@@ -7703,7 +8583,8 @@ var pkgAST = &ast.Package{
 									With: nil,
 								}},
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 64,
@@ -7716,10 +8597,12 @@ var pkgAST = &ast.Package{
 											Line:   74,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Callee: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 18,
@@ -7732,13 +8615,15 @@ var pkgAST = &ast.Package{
 												Line:   74,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "filter",
 								},
 							},
 						},
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 26,
@@ -7751,11 +8636,13 @@ var pkgAST = &ast.Package{
 									Line:   72,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Call: &ast.CallExpression{
 							Arguments: []ast.Expression{&ast.ObjectExpression{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 25,
@@ -7768,10 +8655,12 @@ var pkgAST = &ast.Package{
 											Line:   75,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Properties: []*ast.Property{&ast.Property{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 25,
@@ -7784,10 +8673,12 @@ var pkgAST = &ast.Package{
 												Line:   75,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Key: &ast.Identifier{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 21,
@@ -7800,12 +8691,14 @@ var pkgAST = &ast.Package{
 													Line:   75,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Name: "fn",
 									},
 									Value: &ast.Identifier{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 25,
@@ -7818,6 +8711,7 @@ var pkgAST = &ast.Package{
 													Line:   75,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Name: "fn",
 									},
@@ -7825,7 +8719,8 @@ var pkgAST = &ast.Package{
 								With: nil,
 							}},
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 26,
@@ -7838,10 +8733,12 @@ var pkgAST = &ast.Package{
 										Line:   75,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Callee: &ast.Identifier{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 18,
@@ -7854,13 +8751,15 @@ var pkgAST = &ast.Package{
 											Line:   75,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Name: "filter",
 							},
 						},
 					},
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 29,
@@ -7873,11 +8772,13 @@ var pkgAST = &ast.Package{
 								Line:   72,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Call: &ast.CallExpression{
 						Arguments: nil,
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 29,
@@ -7890,10 +8791,12 @@ var pkgAST = &ast.Package{
 									Line:   76,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Callee: &ast.MemberExpression{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 27,
@@ -7906,10 +8809,12 @@ var pkgAST = &ast.Package{
 										Line:   76,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Object: &ast.Identifier{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 14,
@@ -7922,12 +8827,14 @@ var pkgAST = &ast.Package{
 											Line:   76,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Name: "v1",
 							},
 							Property: &ast.Identifier{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 27,
@@ -7940,6 +8847,7 @@ var pkgAST = &ast.Package{
 											Line:   76,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Name: "fieldsAsCols",
 							},
@@ -7948,7 +8856,8 @@ var pkgAST = &ast.Package{
 				},
 				Params: []*ast.Property{&ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 14,
@@ -7961,10 +8870,12 @@ var pkgAST = &ast.Package{
 								Line:   71,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 14,
@@ -7977,13 +8888,15 @@ var pkgAST = &ast.Package{
 									Line:   71,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Name: "start",
 					},
 					Value: nil,
 				}, &ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 26,
@@ -7996,10 +8909,12 @@ var pkgAST = &ast.Package{
 								Line:   71,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 20,
@@ -8012,13 +8927,15 @@ var pkgAST = &ast.Package{
 									Line:   71,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Name: "stop",
 					},
 					Value: &ast.CallExpression{
 						Arguments: nil,
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 26,
@@ -8031,10 +8948,12 @@ var pkgAST = &ast.Package{
 									Line:   71,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Callee: &ast.Identifier{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 24,
@@ -8047,13 +8966,15 @@ var pkgAST = &ast.Package{
 										Line:   71,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Name: "now",
 						},
 					},
 				}, &ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 30,
@@ -8066,10 +8987,12 @@ var pkgAST = &ast.Package{
 								Line:   71,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 30,
@@ -8082,6 +9005,7 @@ var pkgAST = &ast.Package{
 									Line:   71,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Name: "fn",
 					},
@@ -8090,7 +9014,8 @@ var pkgAST = &ast.Package{
 			},
 		}, &ast.VariableAssignment{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: []ast.Comment{ast.Comment{Text: "// Deadman takes in a stream of tables and reports which tables"}, ast.Comment{Text: "// were observed strictly before t and which were observed after."}, ast.Comment{Text: "//"}},
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 54,
@@ -8103,10 +9028,12 @@ var pkgAST = &ast.Package{
 						Line:   81,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 8,
@@ -8119,12 +9046,14 @@ var pkgAST = &ast.Package{
 							Line:   81,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "deadman",
 			},
 			Init: &ast.FunctionExpression{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 54,
@@ -8137,12 +9066,14 @@ var pkgAST = &ast.Package{
 							Line:   81,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Body: &ast.PipeExpression{
 					Argument: &ast.PipeExpression{
 						Argument: &ast.Identifier{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 35,
@@ -8155,11 +9086,13 @@ var pkgAST = &ast.Package{
 										Line:   81,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Name: "tables",
 						},
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 28,
@@ -8172,11 +9105,13 @@ var pkgAST = &ast.Package{
 									Line:   81,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Call: &ast.CallExpression{
 							Arguments: []ast.Expression{&ast.ObjectExpression{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 27,
@@ -8189,10 +9124,12 @@ var pkgAST = &ast.Package{
 											Line:   82,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Properties: []*ast.Property{&ast.Property{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 27,
@@ -8205,10 +9142,12 @@ var pkgAST = &ast.Package{
 												Line:   82,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Key: &ast.Identifier{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 18,
@@ -8221,12 +9160,14 @@ var pkgAST = &ast.Package{
 													Line:   82,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Name: "column",
 									},
 									Value: &ast.StringLiteral{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 27,
@@ -8239,6 +9180,7 @@ var pkgAST = &ast.Package{
 													Line:   82,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Value: "_time",
 									},
@@ -8246,7 +9188,8 @@ var pkgAST = &ast.Package{
 								With: nil,
 							}},
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 28,
@@ -8259,10 +9202,12 @@ var pkgAST = &ast.Package{
 										Line:   82,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Callee: &ast.Identifier{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 11,
@@ -8275,13 +9220,15 @@ var pkgAST = &ast.Package{
 											Line:   82,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Name: "max",
 							},
 						},
 					},
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 54,
@@ -8294,11 +9241,13 @@ var pkgAST = &ast.Package{
 								Line:   81,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Call: &ast.CallExpression{
 						Arguments: []ast.Expression{&ast.ObjectExpression{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 53,
@@ -8311,10 +9260,12 @@ var pkgAST = &ast.Package{
 										Line:   83,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Properties: []*ast.Property{&ast.Property{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 53,
@@ -8327,10 +9278,12 @@ var pkgAST = &ast.Package{
 											Line:   83,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Key: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 14,
@@ -8343,12 +9296,14 @@ var pkgAST = &ast.Package{
 												Line:   83,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "fn",
 								},
 								Value: &ast.FunctionExpression{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 53,
@@ -8361,10 +9316,12 @@ var pkgAST = &ast.Package{
 												Line:   83,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Body: &ast.ParenExpression{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 53,
@@ -8377,10 +9334,12 @@ var pkgAST = &ast.Package{
 													Line:   83,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Expression: &ast.ObjectExpression{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 51,
@@ -8393,10 +9352,12 @@ var pkgAST = &ast.Package{
 														Line:   83,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Properties: []*ast.Property{&ast.Property{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 50,
@@ -8409,10 +9370,12 @@ var pkgAST = &ast.Package{
 															Line:   83,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Key: &ast.Identifier{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 37,
@@ -8425,12 +9388,14 @@ var pkgAST = &ast.Package{
 																Line:   83,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Name: "dead",
 												},
 												Value: &ast.BinaryExpression{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 50,
@@ -8443,10 +9408,12 @@ var pkgAST = &ast.Package{
 																Line:   83,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Left: &ast.MemberExpression{
 														BaseNode: ast.BaseNode{
-															Errors: nil,
+															Comments: nil,
+															Errors:   nil,
 															Loc: &ast.SourceLocation{
 																End: ast.Position{
 																	Column: 46,
@@ -8459,10 +9426,12 @@ var pkgAST = &ast.Package{
 																	Line:   83,
 																},
 															},
+															TrailingComment: nil,
 														},
 														Object: &ast.Identifier{
 															BaseNode: ast.BaseNode{
-																Errors: nil,
+																Comments: nil,
+																Errors:   nil,
 																Loc: &ast.SourceLocation{
 																	End: ast.Position{
 																		Column: 40,
@@ -8475,12 +9444,14 @@ var pkgAST = &ast.Package{
 																		Line:   83,
 																	},
 																},
+																TrailingComment: nil,
 															},
 															Name: "r",
 														},
 														Property: &ast.Identifier{
 															BaseNode: ast.BaseNode{
-																Errors: nil,
+																Comments: nil,
+																Errors:   nil,
 																Loc: &ast.SourceLocation{
 																	End: ast.Position{
 																		Column: 46,
@@ -8493,6 +9464,7 @@ var pkgAST = &ast.Package{
 																		Line:   83,
 																	},
 																},
+																TrailingComment: nil,
 															},
 															Name: "_time",
 														},
@@ -8500,7 +9472,8 @@ var pkgAST = &ast.Package{
 													Operator: 8,
 													Right: &ast.Identifier{
 														BaseNode: ast.BaseNode{
-															Errors: nil,
+															Comments: nil,
+															Errors:   nil,
 															Loc: &ast.SourceLocation{
 																End: ast.Position{
 																	Column: 50,
@@ -8513,6 +9486,7 @@ var pkgAST = &ast.Package{
 																	Line:   83,
 																},
 															},
+															TrailingComment: nil,
 														},
 														Name: "t",
 													},
@@ -8520,7 +9494,8 @@ var pkgAST = &ast.Package{
 											}},
 											With: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 27,
@@ -8533,6 +9508,7 @@ var pkgAST = &ast.Package{
 															Line:   83,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "r",
 											},
@@ -8540,7 +9516,8 @@ var pkgAST = &ast.Package{
 									},
 									Params: []*ast.Property{&ast.Property{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 18,
@@ -8553,10 +9530,12 @@ var pkgAST = &ast.Package{
 													Line:   83,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Key: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 18,
@@ -8569,6 +9548,7 @@ var pkgAST = &ast.Package{
 														Line:   83,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "r",
 										},
@@ -8579,7 +9559,8 @@ var pkgAST = &ast.Package{
 							With: nil,
 						}},
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 54,
@@ -8592,10 +9573,12 @@ var pkgAST = &ast.Package{
 									Line:   83,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Callee: &ast.Identifier{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 11,
@@ -8608,6 +9591,7 @@ var pkgAST = &ast.Package{
 										Line:   83,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Name: "map",
 						},
@@ -8615,7 +9599,8 @@ var pkgAST = &ast.Package{
 				},
 				Params: []*ast.Property{&ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 13,
@@ -8628,10 +9613,12 @@ var pkgAST = &ast.Package{
 								Line:   81,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 13,
@@ -8644,13 +9631,15 @@ var pkgAST = &ast.Package{
 									Line:   81,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Name: "t",
 					},
 					Value: nil,
 				}, &ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 24,
@@ -8663,10 +9652,12 @@ var pkgAST = &ast.Package{
 								Line:   81,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 21,
@@ -8679,11 +9670,13 @@ var pkgAST = &ast.Package{
 									Line:   81,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Name: "tables",
 					},
 					Value: &ast.PipeLiteral{BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 24,
@@ -8696,12 +9689,14 @@ var pkgAST = &ast.Package{
 								Line:   81,
 							},
 						},
+						TrailingComment: nil,
 					}},
 				}},
 			},
 		}, &ast.VariableAssignment{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: []ast.Comment{ast.Comment{Text: "// levels describing the result of a check"}},
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 15,
@@ -8714,10 +9709,12 @@ var pkgAST = &ast.Package{
 						Line:   86,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 8,
@@ -8730,12 +9727,14 @@ var pkgAST = &ast.Package{
 							Line:   86,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "levelOK",
 			},
 			Init: &ast.StringLiteral{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 15,
@@ -8748,12 +9747,14 @@ var pkgAST = &ast.Package{
 							Line:   86,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Value: "ok",
 			},
 		}, &ast.VariableAssignment{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 19,
@@ -8766,10 +9767,12 @@ var pkgAST = &ast.Package{
 						Line:   87,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 10,
@@ -8782,12 +9785,14 @@ var pkgAST = &ast.Package{
 							Line:   87,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "levelInfo",
 			},
 			Init: &ast.StringLiteral{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 19,
@@ -8800,12 +9805,14 @@ var pkgAST = &ast.Package{
 							Line:   87,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Value: "info",
 			},
 		}, &ast.VariableAssignment{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 19,
@@ -8818,10 +9825,12 @@ var pkgAST = &ast.Package{
 						Line:   88,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 10,
@@ -8834,12 +9843,14 @@ var pkgAST = &ast.Package{
 							Line:   88,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "levelWarn",
 			},
 			Init: &ast.StringLiteral{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 19,
@@ -8852,12 +9863,14 @@ var pkgAST = &ast.Package{
 							Line:   88,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Value: "warn",
 			},
 		}, &ast.VariableAssignment{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 19,
@@ -8870,10 +9883,12 @@ var pkgAST = &ast.Package{
 						Line:   89,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 10,
@@ -8886,12 +9901,14 @@ var pkgAST = &ast.Package{
 							Line:   89,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "levelCrit",
 			},
 			Init: &ast.StringLiteral{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 19,
@@ -8904,12 +9921,14 @@ var pkgAST = &ast.Package{
 							Line:   89,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Value: "crit",
 			},
 		}, &ast.VariableAssignment{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 25,
@@ -8922,10 +9941,12 @@ var pkgAST = &ast.Package{
 						Line:   90,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 13,
@@ -8938,12 +9959,14 @@ var pkgAST = &ast.Package{
 							Line:   90,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "levelUnknown",
 			},
 			Init: &ast.StringLiteral{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 25,
@@ -8956,12 +9979,14 @@ var pkgAST = &ast.Package{
 							Line:   90,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Value: "unknown",
 			},
 		}, &ast.VariableAssignment{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: []ast.Comment{ast.Comment{Text: "// Check performs a check against its input using the given ok, info, warn and crit functions"}, ast.Comment{Text: "// and writes the result to a system bucket."}},
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 19,
@@ -8974,10 +9999,12 @@ var pkgAST = &ast.Package{
 						Line:   94,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 6,
@@ -8990,12 +10017,14 @@ var pkgAST = &ast.Package{
 							Line:   94,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "check",
 			},
 			Init: &ast.FunctionExpression{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 19,
@@ -9008,6 +10037,7 @@ var pkgAST = &ast.Package{
 							Line:   94,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Body: &ast.PipeExpression{
 					Argument: &ast.PipeExpression{
@@ -9017,7 +10047,8 @@ var pkgAST = &ast.Package{
 									Argument: &ast.PipeExpression{
 										Argument: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 11,
@@ -9030,11 +10061,13 @@ var pkgAST = &ast.Package{
 														Line:   103,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "tables",
 										},
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 42,
@@ -9047,11 +10080,13 @@ var pkgAST = &ast.Package{
 													Line:   103,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Call: &ast.CallExpression{
 											Arguments: []ast.Expression{&ast.ObjectExpression{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 41,
@@ -9064,10 +10099,12 @@ var pkgAST = &ast.Package{
 															Line:   104,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Properties: []*ast.Property{&ast.Property{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 41,
@@ -9080,10 +10117,12 @@ var pkgAST = &ast.Package{
 																Line:   104,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Key: &ast.Identifier{
 														BaseNode: ast.BaseNode{
-															Errors: nil,
+															Comments: nil,
+															Errors:   nil,
 															Loc: &ast.SourceLocation{
 																End: ast.Position{
 																	Column: 30,
@@ -9096,12 +10135,14 @@ var pkgAST = &ast.Package{
 																	Line:   104,
 																},
 															},
+															TrailingComment: nil,
 														},
 														Name: "o",
 													},
 													Value: &ast.MemberExpression{
 														BaseNode: ast.BaseNode{
-															Errors: nil,
+															Comments: nil,
+															Errors:   nil,
 															Loc: &ast.SourceLocation{
 																End: ast.Position{
 																	Column: 41,
@@ -9114,10 +10155,12 @@ var pkgAST = &ast.Package{
 																	Line:   104,
 																},
 															},
+															TrailingComment: nil,
 														},
 														Object: &ast.Identifier{
 															BaseNode: ast.BaseNode{
-																Errors: nil,
+																Comments: nil,
+																Errors:   nil,
 																Loc: &ast.SourceLocation{
 																	End: ast.Position{
 																		Column: 36,
@@ -9130,12 +10173,14 @@ var pkgAST = &ast.Package{
 																		Line:   104,
 																	},
 																},
+																TrailingComment: nil,
 															},
 															Name: "data",
 														},
 														Property: &ast.Identifier{
 															BaseNode: ast.BaseNode{
-																Errors: nil,
+																Comments: nil,
+																Errors:   nil,
 																Loc: &ast.SourceLocation{
 																	End: ast.Position{
 																		Column: 41,
@@ -9148,6 +10193,7 @@ var pkgAST = &ast.Package{
 																		Line:   104,
 																	},
 																},
+																TrailingComment: nil,
 															},
 															Name: "tags",
 														},
@@ -9156,7 +10202,8 @@ var pkgAST = &ast.Package{
 												With: nil,
 											}},
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 42,
@@ -9169,10 +10216,12 @@ var pkgAST = &ast.Package{
 														Line:   104,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Callee: &ast.MemberExpression{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 28,
@@ -9185,10 +10234,12 @@ var pkgAST = &ast.Package{
 															Line:   104,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Object: &ast.Identifier{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 24,
@@ -9201,12 +10252,14 @@ var pkgAST = &ast.Package{
 																Line:   104,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Name: "experimental",
 												},
 												Property: &ast.Identifier{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 28,
@@ -9219,6 +10272,7 @@ var pkgAST = &ast.Package{
 																Line:   104,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Name: "set",
 												},
@@ -9226,7 +10280,8 @@ var pkgAST = &ast.Package{
 										},
 									},
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 94,
@@ -9239,11 +10294,13 @@ var pkgAST = &ast.Package{
 												Line:   103,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Call: &ast.CallExpression{
 										Arguments: []ast.Expression{&ast.ObjectExpression{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 93,
@@ -9256,10 +10313,12 @@ var pkgAST = &ast.Package{
 														Line:   105,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Properties: []*ast.Property{&ast.Property{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 45,
@@ -9272,10 +10331,12 @@ var pkgAST = &ast.Package{
 															Line:   105,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Key: &ast.Identifier{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 35,
@@ -9288,12 +10349,14 @@ var pkgAST = &ast.Package{
 																Line:   105,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Name: "mode",
 												},
 												Value: &ast.StringLiteral{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 45,
@@ -9306,12 +10369,14 @@ var pkgAST = &ast.Package{
 																Line:   105,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Value: "extend",
 												},
 											}, &ast.Property{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 93,
@@ -9324,10 +10389,12 @@ var pkgAST = &ast.Package{
 															Line:   105,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Key: &ast.Identifier{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 54,
@@ -9340,13 +10407,15 @@ var pkgAST = &ast.Package{
 																Line:   105,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Name: "columns",
 												},
 												Value: &ast.CallExpression{
 													Arguments: []ast.Expression{&ast.ObjectExpression{
 														BaseNode: ast.BaseNode{
-															Errors: nil,
+															Comments: nil,
+															Errors:   nil,
 															Loc: &ast.SourceLocation{
 																End: ast.Position{
 																	Column: 92,
@@ -9359,10 +10428,12 @@ var pkgAST = &ast.Package{
 																	Line:   105,
 																},
 															},
+															TrailingComment: nil,
 														},
 														Properties: []*ast.Property{&ast.Property{
 															BaseNode: ast.BaseNode{
-																Errors: nil,
+																Comments: nil,
+																Errors:   nil,
 																Loc: &ast.SourceLocation{
 																	End: ast.Position{
 																		Column: 92,
@@ -9375,10 +10446,12 @@ var pkgAST = &ast.Package{
 																		Line:   105,
 																	},
 																},
+																TrailingComment: nil,
 															},
 															Key: &ast.Identifier{
 																BaseNode: ast.BaseNode{
-																	Errors: nil,
+																	Comments: nil,
+																	Errors:   nil,
 																	Loc: &ast.SourceLocation{
 																		End: ast.Position{
 																			Column: 81,
@@ -9391,12 +10464,14 @@ var pkgAST = &ast.Package{
 																			Line:   105,
 																		},
 																	},
+																	TrailingComment: nil,
 																},
 																Name: "o",
 															},
 															Value: &ast.MemberExpression{
 																BaseNode: ast.BaseNode{
-																	Errors: nil,
+																	Comments: nil,
+																	Errors:   nil,
 																	Loc: &ast.SourceLocation{
 																		End: ast.Position{
 																			Column: 92,
@@ -9409,10 +10484,12 @@ var pkgAST = &ast.Package{
 																			Line:   105,
 																		},
 																	},
+																	TrailingComment: nil,
 																},
 																Object: &ast.Identifier{
 																	BaseNode: ast.BaseNode{
-																		Errors: nil,
+																		Comments: nil,
+																		Errors:   nil,
 																		Loc: &ast.SourceLocation{
 																			End: ast.Position{
 																				Column: 87,
@@ -9425,12 +10502,14 @@ var pkgAST = &ast.Package{
 																				Line:   105,
 																			},
 																		},
+																		TrailingComment: nil,
 																	},
 																	Name: "data",
 																},
 																Property: &ast.Identifier{
 																	BaseNode: ast.BaseNode{
-																		Errors: nil,
+																		Comments: nil,
+																		Errors:   nil,
 																		Loc: &ast.SourceLocation{
 																			End: ast.Position{
 																				Column: 92,
@@ -9443,6 +10522,7 @@ var pkgAST = &ast.Package{
 																				Line:   105,
 																			},
 																		},
+																		TrailingComment: nil,
 																	},
 																	Name: "tags",
 																},
@@ -9451,7 +10531,8 @@ var pkgAST = &ast.Package{
 														With: nil,
 													}},
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 93,
@@ -9464,10 +10545,12 @@ var pkgAST = &ast.Package{
 																Line:   105,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Callee: &ast.MemberExpression{
 														BaseNode: ast.BaseNode{
-															Errors: nil,
+															Comments: nil,
+															Errors:   nil,
 															Loc: &ast.SourceLocation{
 																End: ast.Position{
 																	Column: 79,
@@ -9480,10 +10563,12 @@ var pkgAST = &ast.Package{
 																	Line:   105,
 																},
 															},
+															TrailingComment: nil,
 														},
 														Object: &ast.Identifier{
 															BaseNode: ast.BaseNode{
-																Errors: nil,
+																Comments: nil,
+																Errors:   nil,
 																Loc: &ast.SourceLocation{
 																	End: ast.Position{
 																		Column: 68,
@@ -9496,12 +10581,14 @@ var pkgAST = &ast.Package{
 																		Line:   105,
 																	},
 																},
+																TrailingComment: nil,
 															},
 															Name: "experimental",
 														},
 														Property: &ast.Identifier{
 															BaseNode: ast.BaseNode{
-																Errors: nil,
+																Comments: nil,
+																Errors:   nil,
 																Loc: &ast.SourceLocation{
 																	End: ast.Position{
 																		Column: 79,
@@ -9514,6 +10601,7 @@ var pkgAST = &ast.Package{
 																		Line:   105,
 																	},
 																},
+																TrailingComment: nil,
 															},
 															Name: "objectKeys",
 														},
@@ -9523,7 +10611,8 @@ var pkgAST = &ast.Package{
 											With: nil,
 										}},
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 94,
@@ -9536,10 +10625,12 @@ var pkgAST = &ast.Package{
 													Line:   105,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Callee: &ast.MemberExpression{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 30,
@@ -9552,10 +10643,12 @@ var pkgAST = &ast.Package{
 														Line:   105,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Object: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 24,
@@ -9568,12 +10661,14 @@ var pkgAST = &ast.Package{
 															Line:   105,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "experimental",
 											},
 											Property: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 30,
@@ -9586,6 +10681,7 @@ var pkgAST = &ast.Package{
 															Line:   105,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "group",
 											},
@@ -9593,7 +10689,8 @@ var pkgAST = &ast.Package{
 									},
 								},
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 12,
@@ -9606,11 +10703,13 @@ var pkgAST = &ast.Package{
 											Line:   103,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Call: &ast.CallExpression{
 									Arguments: []ast.Expression{&ast.ObjectExpression{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 11,
@@ -9623,10 +10722,12 @@ var pkgAST = &ast.Package{
 													Line:   106,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Properties: []*ast.Property{&ast.Property{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 11,
@@ -9639,10 +10740,12 @@ var pkgAST = &ast.Package{
 														Line:   106,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Key: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 18,
@@ -9655,12 +10758,14 @@ var pkgAST = &ast.Package{
 															Line:   106,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "fn",
 											},
 											Value: &ast.FunctionExpression{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 11,
@@ -9673,10 +10778,12 @@ var pkgAST = &ast.Package{
 															Line:   106,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Body: &ast.ParenExpression{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 11,
@@ -9689,10 +10796,12 @@ var pkgAST = &ast.Package{
 																Line:   106,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Expression: &ast.ObjectExpression{
 														BaseNode: ast.BaseNode{
-															Errors: nil,
+															Comments: nil,
+															Errors:   nil,
 															Loc: &ast.SourceLocation{
 																End: ast.Position{
 																	Column: 10,
@@ -9705,10 +10814,12 @@ var pkgAST = &ast.Package{
 																	Line:   106,
 																},
 															},
+															TrailingComment: nil,
 														},
 														Properties: []*ast.Property{&ast.Property{
 															BaseNode: ast.BaseNode{
-																Errors: nil,
+																Comments: nil,
+																Errors:   nil,
 																Loc: &ast.SourceLocation{
 																	End: ast.Position{
 																		Column: 37,
@@ -9721,10 +10832,12 @@ var pkgAST = &ast.Package{
 																		Line:   107,
 																	},
 																},
+																TrailingComment: nil,
 															},
 															Key: &ast.Identifier{
 																BaseNode: ast.BaseNode{
-																	Errors: nil,
+																	Comments: nil,
+																	Errors:   nil,
 																	Loc: &ast.SourceLocation{
 																		End: ast.Position{
 																			Column: 25,
@@ -9737,12 +10850,14 @@ var pkgAST = &ast.Package{
 																			Line:   107,
 																		},
 																	},
+																	TrailingComment: nil,
 																},
 																Name: "_measurement",
 															},
 															Value: &ast.StringLiteral{
 																BaseNode: ast.BaseNode{
-																	Errors: nil,
+																	Comments: nil,
+																	Errors:   nil,
 																	Loc: &ast.SourceLocation{
 																		End: ast.Position{
 																			Column: 37,
@@ -9755,12 +10870,14 @@ var pkgAST = &ast.Package{
 																			Line:   107,
 																		},
 																	},
+																	TrailingComment: nil,
 																},
 																Value: "statuses",
 															},
 														}, &ast.Property{
 															BaseNode: ast.BaseNode{
-																Errors: nil,
+																Comments: nil,
+																Errors:   nil,
 																Loc: &ast.SourceLocation{
 																	End: ast.Position{
 																		Column: 48,
@@ -9773,10 +10890,12 @@ var pkgAST = &ast.Package{
 																		Line:   108,
 																	},
 																},
+																TrailingComment: nil,
 															},
 															Key: &ast.Identifier{
 																BaseNode: ast.BaseNode{
-																	Errors: nil,
+																	Comments: nil,
+																	Errors:   nil,
 																	Loc: &ast.SourceLocation{
 																		End: ast.Position{
 																			Column: 32,
@@ -9789,12 +10908,14 @@ var pkgAST = &ast.Package{
 																			Line:   108,
 																		},
 																	},
+																	TrailingComment: nil,
 																},
 																Name: "_source_measurement",
 															},
 															Value: &ast.MemberExpression{
 																BaseNode: ast.BaseNode{
-																	Errors: nil,
+																	Comments: nil,
+																	Errors:   nil,
 																	Loc: &ast.SourceLocation{
 																		End: ast.Position{
 																			Column: 48,
@@ -9807,10 +10928,12 @@ var pkgAST = &ast.Package{
 																			Line:   108,
 																		},
 																	},
+																	TrailingComment: nil,
 																},
 																Object: &ast.Identifier{
 																	BaseNode: ast.BaseNode{
-																		Errors: nil,
+																		Comments: nil,
+																		Errors:   nil,
 																		Loc: &ast.SourceLocation{
 																			End: ast.Position{
 																				Column: 35,
@@ -9823,12 +10946,14 @@ var pkgAST = &ast.Package{
 																				Line:   108,
 																			},
 																		},
+																		TrailingComment: nil,
 																	},
 																	Name: "r",
 																},
 																Property: &ast.Identifier{
 																	BaseNode: ast.BaseNode{
-																		Errors: nil,
+																		Comments: nil,
+																		Errors:   nil,
 																		Loc: &ast.SourceLocation{
 																			End: ast.Position{
 																				Column: 48,
@@ -9841,13 +10966,15 @@ var pkgAST = &ast.Package{
 																				Line:   108,
 																			},
 																		},
+																		TrailingComment: nil,
 																	},
 																	Name: "_measurement",
 																},
 															},
 														}, &ast.Property{
 															BaseNode: ast.BaseNode{
-																Errors: nil,
+																Comments: nil,
+																Errors:   nil,
 																Loc: &ast.SourceLocation{
 																	End: ast.Position{
 																		Column: 30,
@@ -9860,10 +10987,12 @@ var pkgAST = &ast.Package{
 																		Line:   109,
 																	},
 																},
+																TrailingComment: nil,
 															},
 															Key: &ast.Identifier{
 																BaseNode: ast.BaseNode{
-																	Errors: nil,
+																	Comments: nil,
+																	Errors:   nil,
 																	Loc: &ast.SourceLocation{
 																		End: ast.Position{
 																			Column: 18,
@@ -9876,12 +11005,14 @@ var pkgAST = &ast.Package{
 																			Line:   109,
 																		},
 																	},
+																	TrailingComment: nil,
 																},
 																Name: "_type",
 															},
 															Value: &ast.MemberExpression{
 																BaseNode: ast.BaseNode{
-																	Errors: nil,
+																	Comments: nil,
+																	Errors:   nil,
 																	Loc: &ast.SourceLocation{
 																		End: ast.Position{
 																			Column: 30,
@@ -9894,10 +11025,12 @@ var pkgAST = &ast.Package{
 																			Line:   109,
 																		},
 																	},
+																	TrailingComment: nil,
 																},
 																Object: &ast.Identifier{
 																	BaseNode: ast.BaseNode{
-																		Errors: nil,
+																		Comments: nil,
+																		Errors:   nil,
 																		Loc: &ast.SourceLocation{
 																			End: ast.Position{
 																				Column: 24,
@@ -9910,12 +11043,14 @@ var pkgAST = &ast.Package{
 																				Line:   109,
 																			},
 																		},
+																		TrailingComment: nil,
 																	},
 																	Name: "data",
 																},
 																Property: &ast.Identifier{
 																	BaseNode: ast.BaseNode{
-																		Errors: nil,
+																		Comments: nil,
+																		Errors:   nil,
 																		Loc: &ast.SourceLocation{
 																			End: ast.Position{
 																				Column: 30,
@@ -9928,13 +11063,15 @@ var pkgAST = &ast.Package{
 																				Line:   109,
 																			},
 																		},
+																		TrailingComment: nil,
 																	},
 																	Name: "_type",
 																},
 															},
 														}, &ast.Property{
 															BaseNode: ast.BaseNode{
-																Errors: nil,
+																Comments: nil,
+																Errors:   nil,
 																Loc: &ast.SourceLocation{
 																	End: ast.Position{
 																		Column: 39,
@@ -9947,10 +11084,12 @@ var pkgAST = &ast.Package{
 																		Line:   110,
 																	},
 																},
+																TrailingComment: nil,
 															},
 															Key: &ast.Identifier{
 																BaseNode: ast.BaseNode{
-																	Errors: nil,
+																	Comments: nil,
+																	Errors:   nil,
 																	Loc: &ast.SourceLocation{
 																		End: ast.Position{
 																			Column: 22,
@@ -9963,12 +11102,14 @@ var pkgAST = &ast.Package{
 																			Line:   110,
 																		},
 																	},
+																	TrailingComment: nil,
 																},
 																Name: "_check_id",
 															},
 															Value: &ast.MemberExpression{
 																BaseNode: ast.BaseNode{
-																	Errors: nil,
+																	Comments: nil,
+																	Errors:   nil,
 																	Loc: &ast.SourceLocation{
 																		End: ast.Position{
 																			Column: 39,
@@ -9981,10 +11122,12 @@ var pkgAST = &ast.Package{
 																			Line:   110,
 																		},
 																	},
+																	TrailingComment: nil,
 																},
 																Object: &ast.Identifier{
 																	BaseNode: ast.BaseNode{
-																		Errors: nil,
+																		Comments: nil,
+																		Errors:   nil,
 																		Loc: &ast.SourceLocation{
 																			End: ast.Position{
 																				Column: 29,
@@ -9997,12 +11140,14 @@ var pkgAST = &ast.Package{
 																				Line:   110,
 																			},
 																		},
+																		TrailingComment: nil,
 																	},
 																	Name: "data",
 																},
 																Property: &ast.Identifier{
 																	BaseNode: ast.BaseNode{
-																		Errors: nil,
+																		Comments: nil,
+																		Errors:   nil,
 																		Loc: &ast.SourceLocation{
 																			End: ast.Position{
 																				Column: 39,
@@ -10015,13 +11160,15 @@ var pkgAST = &ast.Package{
 																				Line:   110,
 																			},
 																		},
+																		TrailingComment: nil,
 																	},
 																	Name: "_check_id",
 																},
 															},
 														}, &ast.Property{
 															BaseNode: ast.BaseNode{
-																Errors: nil,
+																Comments: nil,
+																Errors:   nil,
 																Loc: &ast.SourceLocation{
 																	End: ast.Position{
 																		Column: 42,
@@ -10034,10 +11181,12 @@ var pkgAST = &ast.Package{
 																		Line:   111,
 																	},
 																},
+																TrailingComment: nil,
 															},
 															Key: &ast.Identifier{
 																BaseNode: ast.BaseNode{
-																	Errors: nil,
+																	Comments: nil,
+																	Errors:   nil,
 																	Loc: &ast.SourceLocation{
 																		End: ast.Position{
 																			Column: 24,
@@ -10050,12 +11199,14 @@ var pkgAST = &ast.Package{
 																			Line:   111,
 																		},
 																	},
+																	TrailingComment: nil,
 																},
 																Name: "_check_name",
 															},
 															Value: &ast.MemberExpression{
 																BaseNode: ast.BaseNode{
-																	Errors: nil,
+																	Comments: nil,
+																	Errors:   nil,
 																	Loc: &ast.SourceLocation{
 																		End: ast.Position{
 																			Column: 42,
@@ -10068,10 +11219,12 @@ var pkgAST = &ast.Package{
 																			Line:   111,
 																		},
 																	},
+																	TrailingComment: nil,
 																},
 																Object: &ast.Identifier{
 																	BaseNode: ast.BaseNode{
-																		Errors: nil,
+																		Comments: nil,
+																		Errors:   nil,
 																		Loc: &ast.SourceLocation{
 																			End: ast.Position{
 																				Column: 30,
@@ -10084,12 +11237,14 @@ var pkgAST = &ast.Package{
 																				Line:   111,
 																			},
 																		},
+																		TrailingComment: nil,
 																	},
 																	Name: "data",
 																},
 																Property: &ast.Identifier{
 																	BaseNode: ast.BaseNode{
-																		Errors: nil,
+																		Comments: nil,
+																		Errors:   nil,
 																		Loc: &ast.SourceLocation{
 																			End: ast.Position{
 																				Column: 42,
@@ -10102,13 +11257,15 @@ var pkgAST = &ast.Package{
 																				Line:   111,
 																			},
 																		},
+																		TrailingComment: nil,
 																	},
 																	Name: "_check_name",
 																},
 															},
 														}, &ast.Property{
 															BaseNode: ast.BaseNode{
-																Errors: nil,
+																Comments: nil,
+																Errors:   nil,
 																Loc: &ast.SourceLocation{
 																	End: ast.Position{
 																		Column: 34,
@@ -10121,10 +11278,12 @@ var pkgAST = &ast.Package{
 																		Line:   112,
 																	},
 																},
+																TrailingComment: nil,
 															},
 															Key: &ast.Identifier{
 																BaseNode: ast.BaseNode{
-																	Errors: nil,
+																	Comments: nil,
+																	Errors:   nil,
 																	Loc: &ast.SourceLocation{
 																		End: ast.Position{
 																			Column: 19,
@@ -10137,6 +11296,7 @@ var pkgAST = &ast.Package{
 																			Line:   112,
 																		},
 																	},
+																	TrailingComment: nil,
 																},
 																Name: "_level",
 															},
@@ -10146,7 +11306,8 @@ var pkgAST = &ast.Package{
 																		Alternate: &ast.ConditionalExpression{
 																			Alternate: &ast.Identifier{
 																				BaseNode: ast.BaseNode{
-																					Errors: nil,
+																					Comments: nil,
+																					Errors:   nil,
 																					Loc: &ast.SourceLocation{
 																						End: ast.Position{
 																							Column: 34,
@@ -10159,11 +11320,13 @@ var pkgAST = &ast.Package{
 																							Line:   117,
 																						},
 																					},
+																					TrailingComment: nil,
 																				},
 																				Name: "levelUnknown",
 																			},
 																			BaseNode: ast.BaseNode{
-																				Errors: nil,
+																				Comments: nil,
+																				Errors:   nil,
 																				Loc: &ast.SourceLocation{
 																					End: ast.Position{
 																						Column: 34,
@@ -10176,10 +11339,12 @@ var pkgAST = &ast.Package{
 																						Line:   116,
 																					},
 																				},
+																				TrailingComment: nil,
 																			},
 																			Consequent: &ast.Identifier{
 																				BaseNode: ast.BaseNode{
-																					Errors: nil,
+																					Comments: nil,
+																					Errors:   nil,
 																					Loc: &ast.SourceLocation{
 																						End: ast.Position{
 																							Column: 46,
@@ -10192,13 +11357,15 @@ var pkgAST = &ast.Package{
 																							Line:   116,
 																						},
 																					},
+																					TrailingComment: nil,
 																				},
 																				Name: "levelOK",
 																			},
 																			Test: &ast.CallExpression{
 																				Arguments: []ast.Expression{&ast.ObjectExpression{
 																					BaseNode: ast.BaseNode{
-																						Errors: nil,
+																						Comments: nil,
+																						Errors:   nil,
 																						Loc: &ast.SourceLocation{
 																							End: ast.Position{
 																								Column: 32,
@@ -10211,10 +11378,12 @@ var pkgAST = &ast.Package{
 																								Line:   116,
 																							},
 																						},
+																						TrailingComment: nil,
 																					},
 																					Properties: []*ast.Property{&ast.Property{
 																						BaseNode: ast.BaseNode{
-																							Errors: nil,
+																							Comments: nil,
+																							Errors:   nil,
 																							Loc: &ast.SourceLocation{
 																								End: ast.Position{
 																									Column: 32,
@@ -10227,10 +11396,12 @@ var pkgAST = &ast.Package{
 																									Line:   116,
 																								},
 																							},
+																							TrailingComment: nil,
 																						},
 																						Key: &ast.Identifier{
 																							BaseNode: ast.BaseNode{
-																								Errors: nil,
+																								Comments: nil,
+																								Errors:   nil,
 																								Loc: &ast.SourceLocation{
 																									End: ast.Position{
 																										Column: 29,
@@ -10243,12 +11414,14 @@ var pkgAST = &ast.Package{
 																										Line:   116,
 																									},
 																								},
+																								TrailingComment: nil,
 																							},
 																							Name: "r",
 																						},
 																						Value: &ast.Identifier{
 																							BaseNode: ast.BaseNode{
-																								Errors: nil,
+																								Comments: nil,
+																								Errors:   nil,
 																								Loc: &ast.SourceLocation{
 																									End: ast.Position{
 																										Column: 32,
@@ -10261,6 +11434,7 @@ var pkgAST = &ast.Package{
 																										Line:   116,
 																									},
 																								},
+																								TrailingComment: nil,
 																							},
 																							Name: "r",
 																						},
@@ -10268,7 +11442,8 @@ var pkgAST = &ast.Package{
 																					With: nil,
 																				}},
 																				BaseNode: ast.BaseNode{
-																					Errors: nil,
+																					Comments: nil,
+																					Errors:   nil,
 																					Loc: &ast.SourceLocation{
 																						End: ast.Position{
 																							Column: 33,
@@ -10281,10 +11456,12 @@ var pkgAST = &ast.Package{
 																							Line:   116,
 																						},
 																					},
+																					TrailingComment: nil,
 																				},
 																				Callee: &ast.Identifier{
 																					BaseNode: ast.BaseNode{
-																						Errors: nil,
+																						Comments: nil,
+																						Errors:   nil,
 																						Loc: &ast.SourceLocation{
 																							End: ast.Position{
 																								Column: 27,
@@ -10297,13 +11474,15 @@ var pkgAST = &ast.Package{
 																								Line:   116,
 																							},
 																						},
+																						TrailingComment: nil,
 																					},
 																					Name: "ok",
 																				},
 																			},
 																		},
 																		BaseNode: ast.BaseNode{
-																			Errors: nil,
+																			Comments: nil,
+																			Errors:   nil,
 																			Loc: &ast.SourceLocation{
 																				End: ast.Position{
 																					Column: 34,
@@ -10316,10 +11495,12 @@ var pkgAST = &ast.Package{
 																					Line:   115,
 																				},
 																			},
+																			TrailingComment: nil,
 																		},
 																		Consequent: &ast.Identifier{
 																			BaseNode: ast.BaseNode{
-																				Errors: nil,
+																				Comments: nil,
+																				Errors:   nil,
 																				Loc: &ast.SourceLocation{
 																					End: ast.Position{
 																						Column: 50,
@@ -10332,13 +11513,15 @@ var pkgAST = &ast.Package{
 																						Line:   115,
 																					},
 																				},
+																				TrailingComment: nil,
 																			},
 																			Name: "levelInfo",
 																		},
 																		Test: &ast.CallExpression{
 																			Arguments: []ast.Expression{&ast.ObjectExpression{
 																				BaseNode: ast.BaseNode{
-																					Errors: nil,
+																					Comments: nil,
+																					Errors:   nil,
 																					Loc: &ast.SourceLocation{
 																						End: ast.Position{
 																							Column: 34,
@@ -10351,10 +11534,12 @@ var pkgAST = &ast.Package{
 																							Line:   115,
 																						},
 																					},
+																					TrailingComment: nil,
 																				},
 																				Properties: []*ast.Property{&ast.Property{
 																					BaseNode: ast.BaseNode{
-																						Errors: nil,
+																						Comments: nil,
+																						Errors:   nil,
 																						Loc: &ast.SourceLocation{
 																							End: ast.Position{
 																								Column: 34,
@@ -10367,10 +11552,12 @@ var pkgAST = &ast.Package{
 																								Line:   115,
 																							},
 																						},
+																						TrailingComment: nil,
 																					},
 																					Key: &ast.Identifier{
 																						BaseNode: ast.BaseNode{
-																							Errors: nil,
+																							Comments: nil,
+																							Errors:   nil,
 																							Loc: &ast.SourceLocation{
 																								End: ast.Position{
 																									Column: 31,
@@ -10383,12 +11570,14 @@ var pkgAST = &ast.Package{
 																									Line:   115,
 																								},
 																							},
+																							TrailingComment: nil,
 																						},
 																						Name: "r",
 																					},
 																					Value: &ast.Identifier{
 																						BaseNode: ast.BaseNode{
-																							Errors: nil,
+																							Comments: nil,
+																							Errors:   nil,
 																							Loc: &ast.SourceLocation{
 																								End: ast.Position{
 																									Column: 34,
@@ -10401,6 +11590,7 @@ var pkgAST = &ast.Package{
 																									Line:   115,
 																								},
 																							},
+																							TrailingComment: nil,
 																						},
 																						Name: "r",
 																					},
@@ -10408,7 +11598,8 @@ var pkgAST = &ast.Package{
 																				With: nil,
 																			}},
 																			BaseNode: ast.BaseNode{
-																				Errors: nil,
+																				Comments: nil,
+																				Errors:   nil,
 																				Loc: &ast.SourceLocation{
 																					End: ast.Position{
 																						Column: 35,
@@ -10421,10 +11612,12 @@ var pkgAST = &ast.Package{
 																						Line:   115,
 																					},
 																				},
+																				TrailingComment: nil,
 																			},
 																			Callee: &ast.Identifier{
 																				BaseNode: ast.BaseNode{
-																					Errors: nil,
+																					Comments: nil,
+																					Errors:   nil,
 																					Loc: &ast.SourceLocation{
 																						End: ast.Position{
 																							Column: 29,
@@ -10437,13 +11630,15 @@ var pkgAST = &ast.Package{
 																							Line:   115,
 																						},
 																					},
+																					TrailingComment: nil,
 																				},
 																				Name: "info",
 																			},
 																		},
 																	},
 																	BaseNode: ast.BaseNode{
-																		Errors: nil,
+																		Comments: nil,
+																		Errors:   nil,
 																		Loc: &ast.SourceLocation{
 																			End: ast.Position{
 																				Column: 34,
@@ -10456,10 +11651,12 @@ var pkgAST = &ast.Package{
 																				Line:   114,
 																			},
 																		},
+																		TrailingComment: nil,
 																	},
 																	Consequent: &ast.Identifier{
 																		BaseNode: ast.BaseNode{
-																			Errors: nil,
+																			Comments: nil,
+																			Errors:   nil,
 																			Loc: &ast.SourceLocation{
 																				End: ast.Position{
 																					Column: 50,
@@ -10472,13 +11669,15 @@ var pkgAST = &ast.Package{
 																					Line:   114,
 																				},
 																			},
+																			TrailingComment: nil,
 																		},
 																		Name: "levelWarn",
 																	},
 																	Test: &ast.CallExpression{
 																		Arguments: []ast.Expression{&ast.ObjectExpression{
 																			BaseNode: ast.BaseNode{
-																				Errors: nil,
+																				Comments: nil,
+																				Errors:   nil,
 																				Loc: &ast.SourceLocation{
 																					End: ast.Position{
 																						Column: 34,
@@ -10491,10 +11690,12 @@ var pkgAST = &ast.Package{
 																						Line:   114,
 																					},
 																				},
+																				TrailingComment: nil,
 																			},
 																			Properties: []*ast.Property{&ast.Property{
 																				BaseNode: ast.BaseNode{
-																					Errors: nil,
+																					Comments: nil,
+																					Errors:   nil,
 																					Loc: &ast.SourceLocation{
 																						End: ast.Position{
 																							Column: 34,
@@ -10507,10 +11708,12 @@ var pkgAST = &ast.Package{
 																							Line:   114,
 																						},
 																					},
+																					TrailingComment: nil,
 																				},
 																				Key: &ast.Identifier{
 																					BaseNode: ast.BaseNode{
-																						Errors: nil,
+																						Comments: nil,
+																						Errors:   nil,
 																						Loc: &ast.SourceLocation{
 																							End: ast.Position{
 																								Column: 31,
@@ -10523,12 +11726,14 @@ var pkgAST = &ast.Package{
 																								Line:   114,
 																							},
 																						},
+																						TrailingComment: nil,
 																					},
 																					Name: "r",
 																				},
 																				Value: &ast.Identifier{
 																					BaseNode: ast.BaseNode{
-																						Errors: nil,
+																						Comments: nil,
+																						Errors:   nil,
 																						Loc: &ast.SourceLocation{
 																							End: ast.Position{
 																								Column: 34,
@@ -10541,6 +11746,7 @@ var pkgAST = &ast.Package{
 																								Line:   114,
 																							},
 																						},
+																						TrailingComment: nil,
 																					},
 																					Name: "r",
 																				},
@@ -10548,7 +11754,8 @@ var pkgAST = &ast.Package{
 																			With: nil,
 																		}},
 																		BaseNode: ast.BaseNode{
-																			Errors: nil,
+																			Comments: nil,
+																			Errors:   nil,
 																			Loc: &ast.SourceLocation{
 																				End: ast.Position{
 																					Column: 35,
@@ -10561,10 +11768,12 @@ var pkgAST = &ast.Package{
 																					Line:   114,
 																				},
 																			},
+																			TrailingComment: nil,
 																		},
 																		Callee: &ast.Identifier{
 																			BaseNode: ast.BaseNode{
-																				Errors: nil,
+																				Comments: nil,
+																				Errors:   nil,
 																				Loc: &ast.SourceLocation{
 																					End: ast.Position{
 																						Column: 29,
@@ -10577,13 +11786,15 @@ var pkgAST = &ast.Package{
 																						Line:   114,
 																					},
 																				},
+																				TrailingComment: nil,
 																			},
 																			Name: "warn",
 																		},
 																	},
 																},
 																BaseNode: ast.BaseNode{
-																	Errors: nil,
+																	Comments: nil,
+																	Errors:   nil,
 																	Loc: &ast.SourceLocation{
 																		End: ast.Position{
 																			Column: 34,
@@ -10596,10 +11807,12 @@ var pkgAST = &ast.Package{
 																			Line:   113,
 																		},
 																	},
+																	TrailingComment: nil,
 																},
 																Consequent: &ast.Identifier{
 																	BaseNode: ast.BaseNode{
-																		Errors: nil,
+																		Comments: nil,
+																		Errors:   nil,
 																		Loc: &ast.SourceLocation{
 																			End: ast.Position{
 																				Column: 45,
@@ -10612,13 +11825,15 @@ var pkgAST = &ast.Package{
 																				Line:   113,
 																			},
 																		},
+																		TrailingComment: nil,
 																	},
 																	Name: "levelCrit",
 																},
 																Test: &ast.CallExpression{
 																	Arguments: []ast.Expression{&ast.ObjectExpression{
 																		BaseNode: ast.BaseNode{
-																			Errors: nil,
+																			Comments: nil,
+																			Errors:   nil,
 																			Loc: &ast.SourceLocation{
 																				End: ast.Position{
 																					Column: 29,
@@ -10631,10 +11846,12 @@ var pkgAST = &ast.Package{
 																					Line:   113,
 																				},
 																			},
+																			TrailingComment: nil,
 																		},
 																		Properties: []*ast.Property{&ast.Property{
 																			BaseNode: ast.BaseNode{
-																				Errors: nil,
+																				Comments: nil,
+																				Errors:   nil,
 																				Loc: &ast.SourceLocation{
 																					End: ast.Position{
 																						Column: 29,
@@ -10647,10 +11864,12 @@ var pkgAST = &ast.Package{
 																						Line:   113,
 																					},
 																				},
+																				TrailingComment: nil,
 																			},
 																			Key: &ast.Identifier{
 																				BaseNode: ast.BaseNode{
-																					Errors: nil,
+																					Comments: nil,
+																					Errors:   nil,
 																					Loc: &ast.SourceLocation{
 																						End: ast.Position{
 																							Column: 26,
@@ -10663,12 +11882,14 @@ var pkgAST = &ast.Package{
 																							Line:   113,
 																						},
 																					},
+																					TrailingComment: nil,
 																				},
 																				Name: "r",
 																			},
 																			Value: &ast.Identifier{
 																				BaseNode: ast.BaseNode{
-																					Errors: nil,
+																					Comments: nil,
+																					Errors:   nil,
 																					Loc: &ast.SourceLocation{
 																						End: ast.Position{
 																							Column: 29,
@@ -10681,6 +11902,7 @@ var pkgAST = &ast.Package{
 																							Line:   113,
 																						},
 																					},
+																					TrailingComment: nil,
 																				},
 																				Name: "r",
 																			},
@@ -10688,7 +11910,8 @@ var pkgAST = &ast.Package{
 																		With: nil,
 																	}},
 																	BaseNode: ast.BaseNode{
-																		Errors: nil,
+																		Comments: nil,
+																		Errors:   nil,
 																		Loc: &ast.SourceLocation{
 																			End: ast.Position{
 																				Column: 30,
@@ -10701,10 +11924,12 @@ var pkgAST = &ast.Package{
 																				Line:   113,
 																			},
 																		},
+																		TrailingComment: nil,
 																	},
 																	Callee: &ast.Identifier{
 																		BaseNode: ast.BaseNode{
-																			Errors: nil,
+																			Comments: nil,
+																			Errors:   nil,
 																			Loc: &ast.SourceLocation{
 																				End: ast.Position{
 																					Column: 24,
@@ -10717,6 +11942,7 @@ var pkgAST = &ast.Package{
 																					Line:   113,
 																				},
 																			},
+																			TrailingComment: nil,
 																		},
 																		Name: "crit",
 																	},
@@ -10724,7 +11950,8 @@ var pkgAST = &ast.Package{
 															},
 														}, &ast.Property{
 															BaseNode: ast.BaseNode{
-																Errors: nil,
+																Comments: nil,
+																Errors:   nil,
 																Loc: &ast.SourceLocation{
 																	End: ast.Position{
 																		Column: 46,
@@ -10737,10 +11964,12 @@ var pkgAST = &ast.Package{
 																		Line:   118,
 																	},
 																},
+																TrailingComment: nil,
 															},
 															Key: &ast.Identifier{
 																BaseNode: ast.BaseNode{
-																	Errors: nil,
+																	Comments: nil,
+																	Errors:   nil,
 																	Loc: &ast.SourceLocation{
 																		End: ast.Position{
 																			Column: 30,
@@ -10753,13 +11982,15 @@ var pkgAST = &ast.Package{
 																			Line:   118,
 																		},
 																	},
+																	TrailingComment: nil,
 																},
 																Name: "_source_timestamp",
 															},
 															Value: &ast.CallExpression{
 																Arguments: []ast.Expression{&ast.ObjectExpression{
 																	BaseNode: ast.BaseNode{
-																		Errors: nil,
+																		Comments: nil,
+																		Errors:   nil,
 																		Loc: &ast.SourceLocation{
 																			End: ast.Position{
 																				Column: 45,
@@ -10772,10 +12003,12 @@ var pkgAST = &ast.Package{
 																				Line:   118,
 																			},
 																		},
+																		TrailingComment: nil,
 																	},
 																	Properties: []*ast.Property{&ast.Property{
 																		BaseNode: ast.BaseNode{
-																			Errors: nil,
+																			Comments: nil,
+																			Errors:   nil,
 																			Loc: &ast.SourceLocation{
 																				End: ast.Position{
 																					Column: 45,
@@ -10788,10 +12021,12 @@ var pkgAST = &ast.Package{
 																					Line:   118,
 																				},
 																			},
+																			TrailingComment: nil,
 																		},
 																		Key: &ast.Identifier{
 																			BaseNode: ast.BaseNode{
-																				Errors: nil,
+																				Comments: nil,
+																				Errors:   nil,
 																				Loc: &ast.SourceLocation{
 																					End: ast.Position{
 																						Column: 37,
@@ -10804,12 +12039,14 @@ var pkgAST = &ast.Package{
 																						Line:   118,
 																					},
 																				},
+																				TrailingComment: nil,
 																			},
 																			Name: "v",
 																		},
 																		Value: &ast.MemberExpression{
 																			BaseNode: ast.BaseNode{
-																				Errors: nil,
+																				Comments: nil,
+																				Errors:   nil,
 																				Loc: &ast.SourceLocation{
 																					End: ast.Position{
 																						Column: 45,
@@ -10822,10 +12059,12 @@ var pkgAST = &ast.Package{
 																						Line:   118,
 																					},
 																				},
+																				TrailingComment: nil,
 																			},
 																			Object: &ast.Identifier{
 																				BaseNode: ast.BaseNode{
-																					Errors: nil,
+																					Comments: nil,
+																					Errors:   nil,
 																					Loc: &ast.SourceLocation{
 																						End: ast.Position{
 																							Column: 39,
@@ -10838,12 +12077,14 @@ var pkgAST = &ast.Package{
 																							Line:   118,
 																						},
 																					},
+																					TrailingComment: nil,
 																				},
 																				Name: "r",
 																			},
 																			Property: &ast.Identifier{
 																				BaseNode: ast.BaseNode{
-																					Errors: nil,
+																					Comments: nil,
+																					Errors:   nil,
 																					Loc: &ast.SourceLocation{
 																						End: ast.Position{
 																							Column: 45,
@@ -10856,6 +12097,7 @@ var pkgAST = &ast.Package{
 																							Line:   118,
 																						},
 																					},
+																					TrailingComment: nil,
 																				},
 																				Name: "_time",
 																			},
@@ -10864,7 +12106,8 @@ var pkgAST = &ast.Package{
 																	With: nil,
 																}},
 																BaseNode: ast.BaseNode{
-																	Errors: nil,
+																	Comments: nil,
+																	Errors:   nil,
 																	Loc: &ast.SourceLocation{
 																		End: ast.Position{
 																			Column: 46,
@@ -10877,10 +12120,12 @@ var pkgAST = &ast.Package{
 																			Line:   118,
 																		},
 																	},
+																	TrailingComment: nil,
 																},
 																Callee: &ast.Identifier{
 																	BaseNode: ast.BaseNode{
-																		Errors: nil,
+																		Comments: nil,
+																		Errors:   nil,
 																		Loc: &ast.SourceLocation{
 																			End: ast.Position{
 																				Column: 35,
@@ -10893,13 +12138,15 @@ var pkgAST = &ast.Package{
 																				Line:   118,
 																			},
 																		},
+																		TrailingComment: nil,
 																	},
 																	Name: "int",
 																},
 															},
 														}, &ast.Property{
 															BaseNode: ast.BaseNode{
-																Errors: nil,
+																Comments: nil,
+																Errors:   nil,
 																Loc: &ast.SourceLocation{
 																	End: ast.Position{
 																		Column: 25,
@@ -10912,10 +12159,12 @@ var pkgAST = &ast.Package{
 																		Line:   119,
 																	},
 																},
+																TrailingComment: nil,
 															},
 															Key: &ast.Identifier{
 																BaseNode: ast.BaseNode{
-																	Errors: nil,
+																	Comments: nil,
+																	Errors:   nil,
 																	Loc: &ast.SourceLocation{
 																		End: ast.Position{
 																			Column: 18,
@@ -10928,13 +12177,15 @@ var pkgAST = &ast.Package{
 																			Line:   119,
 																		},
 																	},
+																	TrailingComment: nil,
 																},
 																Name: "_time",
 															},
 															Value: &ast.CallExpression{
 																Arguments: nil,
 																BaseNode: ast.BaseNode{
-																	Errors: nil,
+																	Comments: nil,
+																	Errors:   nil,
 																	Loc: &ast.SourceLocation{
 																		End: ast.Position{
 																			Column: 25,
@@ -10947,10 +12198,12 @@ var pkgAST = &ast.Package{
 																			Line:   119,
 																		},
 																	},
+																	TrailingComment: nil,
 																},
 																Callee: &ast.Identifier{
 																	BaseNode: ast.BaseNode{
-																		Errors: nil,
+																		Comments: nil,
+																		Errors:   nil,
 																		Loc: &ast.SourceLocation{
 																			End: ast.Position{
 																				Column: 23,
@@ -10963,6 +12216,7 @@ var pkgAST = &ast.Package{
 																				Line:   119,
 																			},
 																		},
+																		TrailingComment: nil,
 																	},
 																	Name: "now",
 																},
@@ -10970,7 +12224,8 @@ var pkgAST = &ast.Package{
 														}},
 														With: &ast.Identifier{
 															BaseNode: ast.BaseNode{
-																Errors: nil,
+																Comments: nil,
+																Errors:   nil,
 																Loc: &ast.SourceLocation{
 																	End: ast.Position{
 																		Column: 30,
@@ -10983,6 +12238,7 @@ var pkgAST = &ast.Package{
 																		Line:   106,
 																	},
 																},
+																TrailingComment: nil,
 															},
 															Name: "r",
 														},
@@ -10990,7 +12246,8 @@ var pkgAST = &ast.Package{
 												},
 												Params: []*ast.Property{&ast.Property{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 22,
@@ -11003,10 +12260,12 @@ var pkgAST = &ast.Package{
 																Line:   106,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Key: &ast.Identifier{
 														BaseNode: ast.BaseNode{
-															Errors: nil,
+															Comments: nil,
+															Errors:   nil,
 															Loc: &ast.SourceLocation{
 																End: ast.Position{
 																	Column: 22,
@@ -11019,6 +12278,7 @@ var pkgAST = &ast.Package{
 																	Line:   106,
 																},
 															},
+															TrailingComment: nil,
 														},
 														Name: "r",
 													},
@@ -11029,7 +12289,8 @@ var pkgAST = &ast.Package{
 										With: nil,
 									}},
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 12,
@@ -11042,10 +12303,12 @@ var pkgAST = &ast.Package{
 												Line:   106,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Callee: &ast.Identifier{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 15,
@@ -11058,13 +12321,15 @@ var pkgAST = &ast.Package{
 													Line:   106,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Name: "map",
 									},
 								},
 							},
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 12,
@@ -11077,11 +12342,13 @@ var pkgAST = &ast.Package{
 										Line:   103,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Call: &ast.CallExpression{
 								Arguments: []ast.Expression{&ast.ObjectExpression{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 11,
@@ -11094,10 +12361,12 @@ var pkgAST = &ast.Package{
 												Line:   121,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Properties: []*ast.Property{&ast.Property{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 11,
@@ -11110,10 +12379,12 @@ var pkgAST = &ast.Package{
 													Line:   121,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Key: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 18,
@@ -11126,12 +12397,14 @@ var pkgAST = &ast.Package{
 														Line:   121,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "fn",
 										},
 										Value: &ast.FunctionExpression{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 11,
@@ -11144,10 +12417,12 @@ var pkgAST = &ast.Package{
 														Line:   121,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Body: &ast.ParenExpression{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 11,
@@ -11160,10 +12435,12 @@ var pkgAST = &ast.Package{
 															Line:   121,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Expression: &ast.ObjectExpression{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 10,
@@ -11176,10 +12453,12 @@ var pkgAST = &ast.Package{
 																Line:   121,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Properties: []*ast.Property{&ast.Property{
 														BaseNode: ast.BaseNode{
-															Errors: nil,
+															Comments: nil,
+															Errors:   nil,
 															Loc: &ast.SourceLocation{
 																End: ast.Position{
 																	Column: 38,
@@ -11192,10 +12471,12 @@ var pkgAST = &ast.Package{
 																	Line:   122,
 																},
 															},
+															TrailingComment: nil,
 														},
 														Key: &ast.Identifier{
 															BaseNode: ast.BaseNode{
-																Errors: nil,
+																Comments: nil,
+																Errors:   nil,
 																Loc: &ast.SourceLocation{
 																	End: ast.Position{
 																		Column: 21,
@@ -11208,13 +12489,15 @@ var pkgAST = &ast.Package{
 																		Line:   122,
 																	},
 																},
+																TrailingComment: nil,
 															},
 															Name: "_message",
 														},
 														Value: &ast.CallExpression{
 															Arguments: []ast.Expression{&ast.ObjectExpression{
 																BaseNode: ast.BaseNode{
-																	Errors: nil,
+																	Comments: nil,
+																	Errors:   nil,
 																	Loc: &ast.SourceLocation{
 																		End: ast.Position{
 																			Column: 37,
@@ -11227,10 +12510,12 @@ var pkgAST = &ast.Package{
 																			Line:   122,
 																		},
 																	},
+																	TrailingComment: nil,
 																},
 																Properties: []*ast.Property{&ast.Property{
 																	BaseNode: ast.BaseNode{
-																		Errors: nil,
+																		Comments: nil,
+																		Errors:   nil,
 																		Loc: &ast.SourceLocation{
 																			End: ast.Position{
 																				Column: 37,
@@ -11243,10 +12528,12 @@ var pkgAST = &ast.Package{
 																				Line:   122,
 																			},
 																		},
+																		TrailingComment: nil,
 																	},
 																	Key: &ast.Identifier{
 																		BaseNode: ast.BaseNode{
-																			Errors: nil,
+																			Comments: nil,
+																			Errors:   nil,
 																			Loc: &ast.SourceLocation{
 																				End: ast.Position{
 																					Column: 34,
@@ -11259,12 +12546,14 @@ var pkgAST = &ast.Package{
 																					Line:   122,
 																				},
 																			},
+																			TrailingComment: nil,
 																		},
 																		Name: "r",
 																	},
 																	Value: &ast.Identifier{
 																		BaseNode: ast.BaseNode{
-																			Errors: nil,
+																			Comments: nil,
+																			Errors:   nil,
 																			Loc: &ast.SourceLocation{
 																				End: ast.Position{
 																					Column: 37,
@@ -11277,6 +12566,7 @@ var pkgAST = &ast.Package{
 																					Line:   122,
 																				},
 																			},
+																			TrailingComment: nil,
 																		},
 																		Name: "r",
 																	},
@@ -11284,7 +12574,8 @@ var pkgAST = &ast.Package{
 																With: nil,
 															}},
 															BaseNode: ast.BaseNode{
-																Errors: nil,
+																Comments: nil,
+																Errors:   nil,
 																Loc: &ast.SourceLocation{
 																	End: ast.Position{
 																		Column: 38,
@@ -11297,10 +12588,12 @@ var pkgAST = &ast.Package{
 																		Line:   122,
 																	},
 																},
+																TrailingComment: nil,
 															},
 															Callee: &ast.Identifier{
 																BaseNode: ast.BaseNode{
-																	Errors: nil,
+																	Comments: nil,
+																	Errors:   nil,
 																	Loc: &ast.SourceLocation{
 																		End: ast.Position{
 																			Column: 32,
@@ -11313,6 +12606,7 @@ var pkgAST = &ast.Package{
 																			Line:   122,
 																		},
 																	},
+																	TrailingComment: nil,
 																},
 																Name: "messageFn",
 															},
@@ -11320,7 +12614,8 @@ var pkgAST = &ast.Package{
 													}},
 													With: &ast.Identifier{
 														BaseNode: ast.BaseNode{
-															Errors: nil,
+															Comments: nil,
+															Errors:   nil,
 															Loc: &ast.SourceLocation{
 																End: ast.Position{
 																	Column: 30,
@@ -11333,6 +12628,7 @@ var pkgAST = &ast.Package{
 																	Line:   121,
 																},
 															},
+															TrailingComment: nil,
 														},
 														Name: "r",
 													},
@@ -11340,7 +12636,8 @@ var pkgAST = &ast.Package{
 											},
 											Params: []*ast.Property{&ast.Property{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 22,
@@ -11353,10 +12650,12 @@ var pkgAST = &ast.Package{
 															Line:   121,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Key: &ast.Identifier{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 22,
@@ -11369,6 +12668,7 @@ var pkgAST = &ast.Package{
 																Line:   121,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Name: "r",
 												},
@@ -11379,7 +12679,8 @@ var pkgAST = &ast.Package{
 									With: nil,
 								}},
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 12,
@@ -11392,10 +12693,12 @@ var pkgAST = &ast.Package{
 											Line:   121,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Callee: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 15,
@@ -11408,13 +12711,15 @@ var pkgAST = &ast.Package{
 												Line:   121,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "map",
 								},
 							},
 						},
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 127,
@@ -11427,11 +12732,13 @@ var pkgAST = &ast.Package{
 									Line:   103,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Call: &ast.CallExpression{
 							Arguments: []ast.Expression{&ast.ObjectExpression{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 126,
@@ -11444,10 +12751,12 @@ var pkgAST = &ast.Package{
 											Line:   124,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Properties: []*ast.Property{&ast.Property{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 45,
@@ -11460,10 +12769,12 @@ var pkgAST = &ast.Package{
 												Line:   124,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Key: &ast.Identifier{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 35,
@@ -11476,12 +12787,14 @@ var pkgAST = &ast.Package{
 													Line:   124,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Name: "mode",
 									},
 									Value: &ast.StringLiteral{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 45,
@@ -11494,12 +12807,14 @@ var pkgAST = &ast.Package{
 													Line:   124,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Value: "extend",
 									},
 								}, &ast.Property{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 126,
@@ -11512,10 +12827,12 @@ var pkgAST = &ast.Package{
 												Line:   124,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Key: &ast.Identifier{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 54,
@@ -11528,12 +12845,14 @@ var pkgAST = &ast.Package{
 													Line:   124,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Name: "columns",
 									},
 									Value: &ast.ArrayExpression{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 126,
@@ -11546,10 +12865,12 @@ var pkgAST = &ast.Package{
 													Line:   124,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Elements: []ast.Expression{&ast.StringLiteral{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 78,
@@ -11562,11 +12883,13 @@ var pkgAST = &ast.Package{
 														Line:   124,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Value: "_source_measurement",
 										}, &ast.StringLiteral{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 87,
@@ -11579,11 +12902,13 @@ var pkgAST = &ast.Package{
 														Line:   124,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Value: "_type",
 										}, &ast.StringLiteral{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 100,
@@ -11596,11 +12921,13 @@ var pkgAST = &ast.Package{
 														Line:   124,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Value: "_check_id",
 										}, &ast.StringLiteral{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 115,
@@ -11613,11 +12940,13 @@ var pkgAST = &ast.Package{
 														Line:   124,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Value: "_check_name",
 										}, &ast.StringLiteral{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 125,
@@ -11630,6 +12959,7 @@ var pkgAST = &ast.Package{
 														Line:   124,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Value: "_level",
 										}},
@@ -11638,7 +12968,8 @@ var pkgAST = &ast.Package{
 								With: nil,
 							}},
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 127,
@@ -11651,10 +12982,12 @@ var pkgAST = &ast.Package{
 										Line:   124,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Callee: &ast.MemberExpression{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 30,
@@ -11667,10 +13000,12 @@ var pkgAST = &ast.Package{
 											Line:   124,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Object: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 24,
@@ -11683,12 +13018,14 @@ var pkgAST = &ast.Package{
 												Line:   124,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "experimental",
 								},
 								Property: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 30,
@@ -11701,6 +13038,7 @@ var pkgAST = &ast.Package{
 												Line:   124,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "group",
 								},
@@ -11708,7 +13046,8 @@ var pkgAST = &ast.Package{
 						},
 					},
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 19,
@@ -11721,11 +13060,13 @@ var pkgAST = &ast.Package{
 								Line:   103,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Call: &ast.CallExpression{
 						Arguments: nil,
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 19,
@@ -11738,10 +13079,12 @@ var pkgAST = &ast.Package{
 									Line:   125,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Callee: &ast.Identifier{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 17,
@@ -11754,6 +13097,7 @@ var pkgAST = &ast.Package{
 										Line:   125,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Name: "write",
 						},
@@ -11761,7 +13105,8 @@ var pkgAST = &ast.Package{
 				},
 				Params: []*ast.Property{&ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 14,
@@ -11774,10 +13119,12 @@ var pkgAST = &ast.Package{
 								Line:   95,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 11,
@@ -11790,11 +13137,13 @@ var pkgAST = &ast.Package{
 									Line:   95,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Name: "tables",
 					},
 					Value: &ast.PipeLiteral{BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 14,
@@ -11807,10 +13156,12 @@ var pkgAST = &ast.Package{
 								Line:   95,
 							},
 						},
+						TrailingComment: nil,
 					}},
 				}, &ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 9,
@@ -11823,10 +13174,12 @@ var pkgAST = &ast.Package{
 								Line:   96,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 9,
@@ -11839,13 +13192,15 @@ var pkgAST = &ast.Package{
 									Line:   96,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Name: "data",
 					},
 					Value: nil,
 				}, &ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 14,
@@ -11858,10 +13213,12 @@ var pkgAST = &ast.Package{
 								Line:   97,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 14,
@@ -11874,13 +13231,15 @@ var pkgAST = &ast.Package{
 									Line:   97,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Name: "messageFn",
 					},
 					Value: nil,
 				}, &ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 22,
@@ -11893,10 +13252,12 @@ var pkgAST = &ast.Package{
 								Line:   98,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 9,
@@ -11909,12 +13270,14 @@ var pkgAST = &ast.Package{
 									Line:   98,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Name: "crit",
 					},
 					Value: &ast.FunctionExpression{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 22,
@@ -11927,10 +13290,12 @@ var pkgAST = &ast.Package{
 									Line:   98,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Body: &ast.Identifier{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 22,
@@ -11943,12 +13308,14 @@ var pkgAST = &ast.Package{
 										Line:   98,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Name: "false",
 						},
 						Params: []*ast.Property{&ast.Property{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 12,
@@ -11961,10 +13328,12 @@ var pkgAST = &ast.Package{
 										Line:   98,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Key: &ast.Identifier{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 12,
@@ -11977,6 +13346,7 @@ var pkgAST = &ast.Package{
 											Line:   98,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Name: "r",
 							},
@@ -11985,7 +13355,8 @@ var pkgAST = &ast.Package{
 					},
 				}, &ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 22,
@@ -11998,10 +13369,12 @@ var pkgAST = &ast.Package{
 								Line:   99,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 9,
@@ -12014,12 +13387,14 @@ var pkgAST = &ast.Package{
 									Line:   99,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Name: "warn",
 					},
 					Value: &ast.FunctionExpression{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 22,
@@ -12032,10 +13407,12 @@ var pkgAST = &ast.Package{
 									Line:   99,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Body: &ast.Identifier{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 22,
@@ -12048,12 +13425,14 @@ var pkgAST = &ast.Package{
 										Line:   99,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Name: "false",
 						},
 						Params: []*ast.Property{&ast.Property{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 12,
@@ -12066,10 +13445,12 @@ var pkgAST = &ast.Package{
 										Line:   99,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Key: &ast.Identifier{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 12,
@@ -12082,6 +13463,7 @@ var pkgAST = &ast.Package{
 											Line:   99,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Name: "r",
 							},
@@ -12090,7 +13472,8 @@ var pkgAST = &ast.Package{
 					},
 				}, &ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 22,
@@ -12103,10 +13486,12 @@ var pkgAST = &ast.Package{
 								Line:   100,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 9,
@@ -12119,12 +13504,14 @@ var pkgAST = &ast.Package{
 									Line:   100,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Name: "info",
 					},
 					Value: &ast.FunctionExpression{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 22,
@@ -12137,10 +13524,12 @@ var pkgAST = &ast.Package{
 									Line:   100,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Body: &ast.Identifier{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 22,
@@ -12153,12 +13542,14 @@ var pkgAST = &ast.Package{
 										Line:   100,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Name: "false",
 						},
 						Params: []*ast.Property{&ast.Property{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 12,
@@ -12171,10 +13562,12 @@ var pkgAST = &ast.Package{
 										Line:   100,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Key: &ast.Identifier{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 12,
@@ -12187,6 +13580,7 @@ var pkgAST = &ast.Package{
 											Line:   100,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Name: "r",
 							},
@@ -12195,7 +13589,8 @@ var pkgAST = &ast.Package{
 					},
 				}, &ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 19,
@@ -12208,10 +13603,12 @@ var pkgAST = &ast.Package{
 								Line:   101,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 7,
@@ -12224,12 +13621,14 @@ var pkgAST = &ast.Package{
 									Line:   101,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Name: "ok",
 					},
 					Value: &ast.FunctionExpression{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 19,
@@ -12242,10 +13641,12 @@ var pkgAST = &ast.Package{
 									Line:   101,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Body: &ast.Identifier{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 19,
@@ -12258,12 +13659,14 @@ var pkgAST = &ast.Package{
 										Line:   101,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Name: "true",
 						},
 						Params: []*ast.Property{&ast.Property{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 10,
@@ -12276,10 +13679,12 @@ var pkgAST = &ast.Package{
 										Line:   101,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Key: &ast.Identifier{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 10,
@@ -12292,6 +13697,7 @@ var pkgAST = &ast.Package{
 											Line:   101,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Name: "r",
 							},
@@ -12301,10 +13707,12 @@ var pkgAST = &ast.Package{
 				}},
 			},
 		}},
+		Eof: nil,
 		Imports: []*ast.ImportDeclaration{&ast.ImportDeclaration{
 			As: nil,
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 22,
@@ -12317,10 +13725,12 @@ var pkgAST = &ast.Package{
 						Line:   3,
 					},
 				},
+				TrailingComment: nil,
 			},
 			Path: &ast.StringLiteral{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 22,
@@ -12333,13 +13743,15 @@ var pkgAST = &ast.Package{
 							Line:   3,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Value: "experimental",
 			},
 		}, &ast.ImportDeclaration{
 			As: nil,
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 32,
@@ -12352,10 +13764,12 @@ var pkgAST = &ast.Package{
 						Line:   4,
 					},
 				},
+				TrailingComment: nil,
 			},
 			Path: &ast.StringLiteral{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 32,
@@ -12368,13 +13782,15 @@ var pkgAST = &ast.Package{
 							Line:   4,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Value: "influxdata/influxdb/v1",
 			},
 		}, &ast.ImportDeclaration{
 			As: nil,
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 29,
@@ -12387,10 +13803,12 @@ var pkgAST = &ast.Package{
 						Line:   5,
 					},
 				},
+				TrailingComment: nil,
 			},
 			Path: &ast.StringLiteral{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 29,
@@ -12403,6 +13821,7 @@ var pkgAST = &ast.Package{
 							Line:   5,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Value: "influxdata/influxdb",
 			},
@@ -12411,7 +13830,8 @@ var pkgAST = &ast.Package{
 		Name:     "monitor.flux",
 		Package: &ast.PackageClause{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 16,
@@ -12424,10 +13844,12 @@ var pkgAST = &ast.Package{
 						Line:   1,
 					},
 				},
+				TrailingComment: nil,
 			},
 			Name: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 16,
@@ -12440,6 +13862,7 @@ var pkgAST = &ast.Package{
 							Line:   1,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "monitor",
 			},
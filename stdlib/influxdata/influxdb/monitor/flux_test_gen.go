@@ -9,12 +9,15 @@ import (
 
 var FluxTestPackages = []*ast.Package{&ast.Package{
 	BaseNode: ast.BaseNode{
-		Errors: nil,
-		Loc:    nil,
+		Comments:        nil,
+		Errors:          nil,
+		Loc:             nil,
+		TrailingComment: nil,
 	},
 	Files: []*ast.File{&ast.File{
 		BaseNode: ast.BaseNode{
-			Errors: nil,
+			Comments: nil,
+			Errors:   nil,
 			Loc: &ast.SourceLocation{
 				End: ast.Position{
 					Column: 98,
@@ -27,11 +30,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 					Line:   1,
 				},
 			},
+			TrailingComment: nil,
 		},
 		Body: []ast.Statement{&ast.OptionStatement{
 			Assignment: &ast.VariableAssignment{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 40,
@@ -44,10 +49,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   7,
 						},
 					},
+					TrailingComment: nil,
 				},
 				ID: &ast.Identifier{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 11,
@@ -60,12 +67,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   7,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Name: "now",
 				},
 				Init: &ast.FunctionExpression{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 40,
@@ -78,10 +87,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   7,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Body: &ast.DateTimeLiteral{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 40,
@@ -94,6 +105,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Line:   7,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Value: parser.MustParseTime("2018-05-22T19:54:20Z"),
 					},
@@ -101,7 +113,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 				},
 			},
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 40,
@@ -114,11 +127,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   7,
 					},
 				},
+				TrailingComment: nil,
 			},
 		}, &ast.OptionStatement{
 			Assignment: &ast.MemberAssignment{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 82,
@@ -131,10 +146,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   9,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Init: &ast.FunctionExpression{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 82,
@@ -147,11 +164,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   9,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Body: &ast.PipeExpression{
 						Argument: &ast.Identifier{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 45,
@@ -164,11 +183,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										Line:   9,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Name: "tables",
 						},
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 82,
@@ -181,11 +202,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Line:   9,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Call: &ast.CallExpression{
 							Arguments: []ast.Expression{&ast.ObjectExpression{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 81,
@@ -198,10 +221,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   9,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Properties: []*ast.Property{&ast.Property{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 81,
@@ -214,10 +239,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   9,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Key: &ast.Identifier{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 61,
@@ -230,12 +257,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   9,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Name: "columns",
 									},
 									Value: &ast.ArrayExpression{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 81,
@@ -248,10 +277,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   9,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Elements: []ast.Expression{&ast.StringLiteral{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 71,
@@ -264,11 +295,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   9,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Value: "_start",
 										}, &ast.StringLiteral{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 80,
@@ -281,6 +314,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   9,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Value: "_stop",
 										}},
@@ -289,7 +323,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								With: nil,
 							}},
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 82,
@@ -302,10 +337,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										Line:   9,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Callee: &ast.Identifier{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 53,
@@ -318,6 +355,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   9,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Name: "drop",
 							},
@@ -325,7 +363,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 					},
 					Params: []*ast.Property{&ast.Property{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 34,
@@ -338,10 +377,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Line:   9,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Key: &ast.Identifier{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 31,
@@ -354,11 +395,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										Line:   9,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Name: "tables",
 						},
 						Value: &ast.PipeLiteral{BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 34,
@@ -371,12 +414,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Line:   9,
 								},
 							},
+							TrailingComment: nil,
 						}},
 					}},
 				},
 				Member: &ast.MemberExpression{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 21,
@@ -389,10 +434,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   9,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Object: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 15,
@@ -405,12 +452,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Line:   9,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Name: "monitor",
 					},
 					Property: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 21,
@@ -423,13 +472,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Line:   9,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Name: "write",
 					},
 				},
 			},
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 82,
@@ -442,10 +493,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   9,
 					},
 				},
+				TrailingComment: nil,
 			},
 		}, &ast.VariableAssignment{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 2,
@@ -458,10 +511,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   11,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 7,
@@ -474,12 +529,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   11,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "inData",
 			},
 			Init: &ast.StringLiteral{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 2,
@@ -492,12 +549,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   11,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Value: "\n#datatype,string,long,dateTime:RFC3339,double,string,string,string,string\n#group,false,false,false,false,true,true,true,true\n#default,_result,,,,,,,\n,result,table,_time,_value,_field,_measurement,cpu,host\n,,0,2018-05-22T19:53:26Z,91.7364670583823,usage_idle,cpu,cpu-total,host.local\n,,0,2018-05-22T19:53:36Z,89.51118889861233,usage_idle,cpu,cpu-total,host.local\n,,0,2018-05-22T19:53:46Z,4.9,usage_idle,cpu,cpu-total,host.local\n,,0,2018-05-22T19:53:56Z,4.7,usage_idle,cpu,cpu-total,host.local\n,,0,2018-05-22T19:54:06Z,7.0,usage_idle,cpu,cpu-total,host.local\n,,0,2018-05-22T19:54:16Z,7.1,usage_idle,cpu,cpu-total,host.local\n",
 			},
 		}, &ast.VariableAssignment{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 2,
@@ -510,10 +569,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   24,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 8,
@@ -526,12 +587,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   24,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "outData",
 			},
 			Init: &ast.StringLiteral{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 2,
@@ -544,12 +607,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   24,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Value: "\n#datatype,string,long,string,string,string,string,string,string,long,dateTime:RFC3339,string,string,string,string,string,double\n#group,false,false,true,true,true,true,false,true,false,false,true,true,true,true,true,false\n#default,got,,,,,,,,,,,,,,,\n,result,table,_check_id,_check_name,_level,_measurement,_message,_source_measurement,_source_timestamp,_time,_type,aaa,bbb,cpu,host,usage_idle\n,,0,000000000000000a,cpu threshold check,crit,statuses,whoa!,cpu,1527018840000000000,2018-05-22T19:54:20Z,threshold,vaaa,vbbb,cpu-total,host.local,4.800000000000001\n,,1,000000000000000a,cpu threshold check,ok,statuses,whoa!,cpu,1527018820000000000,2018-05-22T19:54:20Z,threshold,vaaa,vbbb,cpu-total,host.local,90.62382797849732\n,,2,000000000000000a,cpu threshold check,warn,statuses,whoa!,cpu,1527018860000000000,2018-05-22T19:54:20Z,threshold,vaaa,vbbb,cpu-total,host.local,7.05\n",
 			},
 		}, &ast.VariableAssignment{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 2,
@@ -562,10 +627,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   34,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 5,
@@ -578,12 +645,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   34,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "data",
 			},
 			Init: &ast.ObjectExpression{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 2,
@@ -596,10 +665,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   34,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Properties: []*ast.Property{&ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 34,
@@ -612,10 +683,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   35,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 14,
@@ -628,12 +701,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Line:   35,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Name: "_check_id",
 					},
 					Value: &ast.StringLiteral{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 34,
@@ -646,12 +721,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Line:   35,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Value: "000000000000000a",
 					},
 				}, &ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 39,
@@ -664,10 +741,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   36,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 16,
@@ -680,12 +759,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Line:   36,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Name: "_check_name",
 					},
 					Value: &ast.StringLiteral{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 39,
@@ -698,12 +779,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Line:   36,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Value: "cpu threshold check",
 					},
 				}, &ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 23,
@@ -716,10 +799,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   37,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 10,
@@ -732,12 +817,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Line:   37,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Name: "_type",
 					},
 					Value: &ast.StringLiteral{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 23,
@@ -750,12 +837,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Line:   37,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Value: "threshold",
 					},
 				}, &ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 37,
@@ -768,10 +857,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   38,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 9,
@@ -784,12 +875,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Line:   38,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Name: "tags",
 					},
 					Value: &ast.ObjectExpression{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 37,
@@ -802,10 +895,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Line:   38,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Properties: []*ast.Property{&ast.Property{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 23,
@@ -818,10 +913,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										Line:   38,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Key: &ast.Identifier{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 15,
@@ -834,12 +931,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   38,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Name: "aaa",
 							},
 							Value: &ast.StringLiteral{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 23,
@@ -852,12 +951,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   38,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Value: "vaaa",
 							},
 						}, &ast.Property{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 36,
@@ -870,10 +971,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										Line:   38,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Key: &ast.Identifier{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 28,
@@ -886,12 +989,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   38,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Name: "bbb",
 							},
 							Value: &ast.StringLiteral{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 36,
@@ -904,6 +1009,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   38,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Value: "vbbb",
 							},
@@ -915,7 +1021,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 			},
 		}, &ast.VariableAssignment{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 35,
@@ -928,10 +1035,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   41,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 5,
@@ -944,12 +1053,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   41,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "crit",
 			},
 			Init: &ast.FunctionExpression{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 35,
@@ -962,10 +1073,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   41,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Body: &ast.ParenExpression{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 35,
@@ -978,10 +1091,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   41,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Expression: &ast.BinaryExpression{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 34,
@@ -994,10 +1109,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Line:   41,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Left: &ast.MemberExpression{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 28,
@@ -1010,10 +1127,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										Line:   41,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Object: &ast.Identifier{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 17,
@@ -1026,12 +1145,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   41,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Name: "r",
 							},
 							Property: &ast.Identifier{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 28,
@@ -1044,6 +1165,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   41,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Name: "usage_idle",
 							},
@@ -1051,7 +1173,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Operator: 8,
 						Right: &ast.FloatLiteral{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 34,
@@ -1064,6 +1187,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										Line:   41,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Value: 5.0,
 						},
@@ -1071,7 +1195,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 				},
 				Params: []*ast.Property{&ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 10,
@@ -1084,10 +1209,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   41,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 10,
@@ -1100,6 +1227,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Line:   41,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Name: "r",
 					},
@@ -1108,7 +1236,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 			},
 		}, &ast.VariableAssignment{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 36,
@@ -1121,10 +1250,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   42,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 5,
@@ -1137,12 +1268,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   42,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "warn",
 			},
 			Init: &ast.FunctionExpression{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 36,
@@ -1155,10 +1288,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   42,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Body: &ast.ParenExpression{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 36,
@@ -1171,10 +1306,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   42,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Expression: &ast.BinaryExpression{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 35,
@@ -1187,10 +1324,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Line:   42,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Left: &ast.MemberExpression{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 28,
@@ -1203,10 +1342,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										Line:   42,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Object: &ast.Identifier{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 17,
@@ -1219,12 +1360,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   42,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Name: "r",
 							},
 							Property: &ast.Identifier{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 28,
@@ -1237,6 +1380,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   42,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Name: "usage_idle",
 							},
@@ -1244,7 +1388,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Operator: 8,
 						Right: &ast.FloatLiteral{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 35,
@@ -1257,6 +1402,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										Line:   42,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Value: 10.0,
 						},
@@ -1264,7 +1410,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 				},
 				Params: []*ast.Property{&ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 10,
@@ -1277,10 +1424,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   42,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 10,
@@ -1293,6 +1442,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Line:   42,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Name: "r",
 					},
@@ -1301,7 +1451,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 			},
 		}, &ast.VariableAssignment{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 36,
@@ -1314,10 +1465,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   43,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 5,
@@ -1330,12 +1483,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   43,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "info",
 			},
 			Init: &ast.FunctionExpression{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 36,
@@ -1348,10 +1503,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   43,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Body: &ast.ParenExpression{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 36,
@@ -1364,10 +1521,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   43,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Expression: &ast.BinaryExpression{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 35,
@@ -1380,10 +1539,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Line:   43,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Left: &ast.MemberExpression{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 28,
@@ -1396,10 +1557,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										Line:   43,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Object: &ast.Identifier{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 17,
@@ -1412,12 +1575,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   43,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Name: "r",
 							},
 							Property: &ast.Identifier{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 28,
@@ -1430,6 +1595,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   43,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Name: "usage_idle",
 							},
@@ -1437,7 +1603,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Operator: 8,
 						Right: &ast.FloatLiteral{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 35,
@@ -1450,6 +1617,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										Line:   43,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Value: 25.0,
 						},
@@ -1457,7 +1625,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 				},
 				Params: []*ast.Property{&ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 10,
@@ -1470,10 +1639,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   43,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 10,
@@ -1486,6 +1657,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Line:   43,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Name: "r",
 					},
@@ -1494,7 +1666,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 			},
 		}, &ast.VariableAssignment{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 27,
@@ -1507,10 +1680,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   45,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 10,
@@ -1523,12 +1698,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   45,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "messageFn",
 			},
 			Init: &ast.FunctionExpression{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 27,
@@ -1541,10 +1718,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   45,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Body: &ast.StringLiteral{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 27,
@@ -1557,12 +1736,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   45,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Value: "whoa!",
 				},
 				Params: []*ast.Property{&ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 15,
@@ -1575,10 +1756,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   45,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 15,
@@ -1591,6 +1774,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Line:   45,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Name: "r",
 					},
@@ -1599,7 +1783,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 			},
 		}, &ast.VariableAssignment{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 6,
@@ -1612,10 +1797,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   47,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 8,
@@ -1628,12 +1815,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   47,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "t_check",
 			},
 			Init: &ast.FunctionExpression{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 6,
@@ -1646,6 +1835,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   47,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Body: &ast.PipeExpression{
 					Argument: &ast.PipeExpression{
@@ -1656,7 +1846,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										Argument: &ast.PipeExpression{
 											Argument: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 30,
@@ -1669,11 +1860,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   47,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "table",
 											},
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 25,
@@ -1686,11 +1879,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   47,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Call: &ast.CallExpression{
 												Arguments: []ast.Expression{&ast.ObjectExpression{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 24,
@@ -1703,10 +1898,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																Line:   48,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Properties: []*ast.Property{&ast.Property{
 														BaseNode: ast.BaseNode{
-															Errors: nil,
+															Comments: nil,
+															Errors:   nil,
 															Loc: &ast.SourceLocation{
 																End: ast.Position{
 																	Column: 24,
@@ -1719,10 +1916,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																	Line:   48,
 																},
 															},
+															TrailingComment: nil,
 														},
 														Key: &ast.Identifier{
 															BaseNode: ast.BaseNode{
-																Errors: nil,
+																Comments: nil,
+																Errors:   nil,
 																Loc: &ast.SourceLocation{
 																	End: ast.Position{
 																		Column: 19,
@@ -1735,13 +1934,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																		Line:   48,
 																	},
 																},
+																TrailingComment: nil,
 															},
 															Name: "start",
 														},
 														Value: &ast.UnaryExpression{
 															Argument: &ast.DurationLiteral{
 																BaseNode: ast.BaseNode{
-																	Errors: nil,
+																	Comments: nil,
+																	Errors:   nil,
 																	Loc: &ast.SourceLocation{
 																		End: ast.Position{
 																			Column: 24,
@@ -1754,6 +1955,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																			Line:   48,
 																		},
 																	},
+																	TrailingComment: nil,
 																},
 																Values: []ast.Duration{ast.Duration{
 																	Magnitude: int64(1),
@@ -1761,7 +1963,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																}},
 															},
 															BaseNode: ast.BaseNode{
-																Errors: nil,
+																Comments: nil,
+																Errors:   nil,
 																Loc: &ast.SourceLocation{
 																	End: ast.Position{
 																		Column: 24,
@@ -1774,6 +1977,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																		Line:   48,
 																	},
 																},
+																TrailingComment: nil,
 															},
 															Operator: 6,
 														},
@@ -1781,7 +1985,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													With: nil,
 												}},
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 25,
@@ -1794,10 +1999,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   48,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Callee: &ast.Identifier{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 13,
@@ -1810,13 +2017,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																Line:   48,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Name: "range",
 												},
 											},
 										},
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 50,
@@ -1829,11 +2038,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   47,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Call: &ast.CallExpression{
 											Arguments: []ast.Expression{&ast.ObjectExpression{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 49,
@@ -1846,10 +2057,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   49,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Properties: []*ast.Property{&ast.Property{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 49,
@@ -1862,10 +2075,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																Line:   49,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Key: &ast.Identifier{
 														BaseNode: ast.BaseNode{
-															Errors: nil,
+															Comments: nil,
+															Errors:   nil,
 															Loc: &ast.SourceLocation{
 																End: ast.Position{
 																	Column: 17,
@@ -1878,12 +2093,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																	Line:   49,
 																},
 															},
+															TrailingComment: nil,
 														},
 														Name: "fn",
 													},
 													Value: &ast.FunctionExpression{
 														BaseNode: ast.BaseNode{
-															Errors: nil,
+															Comments: nil,
+															Errors:   nil,
 															Loc: &ast.SourceLocation{
 																End: ast.Position{
 																	Column: 49,
@@ -1896,10 +2113,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																	Line:   49,
 																},
 															},
+															TrailingComment: nil,
 														},
 														Body: &ast.BinaryExpression{
 															BaseNode: ast.BaseNode{
-																Errors: nil,
+																Comments: nil,
+																Errors:   nil,
 																Loc: &ast.SourceLocation{
 																	End: ast.Position{
 																		Column: 49,
@@ -1912,10 +2131,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																		Line:   49,
 																	},
 																},
+																TrailingComment: nil,
 															},
 															Left: &ast.MemberExpression{
 																BaseNode: ast.BaseNode{
-																	Errors: nil,
+																	Comments: nil,
+																	Errors:   nil,
 																	Loc: &ast.SourceLocation{
 																		End: ast.Position{
 																			Column: 40,
@@ -1928,10 +2149,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																			Line:   49,
 																		},
 																	},
+																	TrailingComment: nil,
 																},
 																Object: &ast.Identifier{
 																	BaseNode: ast.BaseNode{
-																		Errors: nil,
+																		Comments: nil,
+																		Errors:   nil,
 																		Loc: &ast.SourceLocation{
 																			End: ast.Position{
 																				Column: 27,
@@ -1944,12 +2167,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																				Line:   49,
 																			},
 																		},
+																		TrailingComment: nil,
 																	},
 																	Name: "r",
 																},
 																Property: &ast.Identifier{
 																	BaseNode: ast.BaseNode{
-																		Errors: nil,
+																		Comments: nil,
+																		Errors:   nil,
 																		Loc: &ast.SourceLocation{
 																			End: ast.Position{
 																				Column: 40,
@@ -1962,6 +2187,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																				Line:   49,
 																			},
 																		},
+																		TrailingComment: nil,
 																	},
 																	Name: "_measurement",
 																},
@@ -1969,7 +2195,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Operator: 17,
 															Right: &ast.StringLiteral{
 																BaseNode: ast.BaseNode{
-																	Errors: nil,
+																	Comments: nil,
+																	Errors:   nil,
 																	Loc: &ast.SourceLocation{
 																		End: ast.Position{
 																			Column: 49,
@@ -1982,13 +2209,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																			Line:   49,
 																		},
 																	},
+																	TrailingComment: nil,
 																},
 																Value: "cpu",
 															},
 														},
 														Params: []*ast.Property{&ast.Property{
 															BaseNode: ast.BaseNode{
-																Errors: nil,
+																Comments: nil,
+																Errors:   nil,
 																Loc: &ast.SourceLocation{
 																	End: ast.Position{
 																		Column: 21,
@@ -2001,10 +2230,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																		Line:   49,
 																	},
 																},
+																TrailingComment: nil,
 															},
 															Key: &ast.Identifier{
 																BaseNode: ast.BaseNode{
-																	Errors: nil,
+																	Comments: nil,
+																	Errors:   nil,
 																	Loc: &ast.SourceLocation{
 																		End: ast.Position{
 																			Column: 21,
@@ -2017,6 +2248,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																			Line:   49,
 																		},
 																	},
+																	TrailingComment: nil,
 																},
 																Name: "r",
 															},
@@ -2027,7 +2259,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												With: nil,
 											}},
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 50,
@@ -2040,10 +2273,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   49,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Callee: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 14,
@@ -2056,13 +2291,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   49,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "filter",
 											},
 										},
 									},
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 51,
@@ -2075,11 +2312,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   47,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Call: &ast.CallExpression{
 										Arguments: []ast.Expression{&ast.ObjectExpression{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 50,
@@ -2092,10 +2331,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   50,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Properties: []*ast.Property{&ast.Property{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 50,
@@ -2108,10 +2349,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   50,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Key: &ast.Identifier{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 17,
@@ -2124,12 +2367,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																Line:   50,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Name: "fn",
 												},
 												Value: &ast.FunctionExpression{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 50,
@@ -2142,10 +2387,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																Line:   50,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Body: &ast.BinaryExpression{
 														BaseNode: ast.BaseNode{
-															Errors: nil,
+															Comments: nil,
+															Errors:   nil,
 															Loc: &ast.SourceLocation{
 																End: ast.Position{
 																	Column: 50,
@@ -2158,10 +2405,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																	Line:   50,
 																},
 															},
+															TrailingComment: nil,
 														},
 														Left: &ast.MemberExpression{
 															BaseNode: ast.BaseNode{
-																Errors: nil,
+																Comments: nil,
+																Errors:   nil,
 																Loc: &ast.SourceLocation{
 																	End: ast.Position{
 																		Column: 34,
@@ -2174,10 +2423,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																		Line:   50,
 																	},
 																},
+																TrailingComment: nil,
 															},
 															Object: &ast.Identifier{
 																BaseNode: ast.BaseNode{
-																	Errors: nil,
+																	Comments: nil,
+																	Errors:   nil,
 																	Loc: &ast.SourceLocation{
 																		End: ast.Position{
 																			Column: 27,
@@ -2190,12 +2441,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																			Line:   50,
 																		},
 																	},
+																	TrailingComment: nil,
 																},
 																Name: "r",
 															},
 															Property: &ast.Identifier{
 																BaseNode: ast.BaseNode{
-																	Errors: nil,
+																	Comments: nil,
+																	Errors:   nil,
 																	Loc: &ast.SourceLocation{
 																		End: ast.Position{
 																			Column: 34,
@@ -2208,6 +2461,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																			Line:   50,
 																		},
 																	},
+																	TrailingComment: nil,
 																},
 																Name: "_field",
 															},
@@ -2215,7 +2469,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Operator: 17,
 														Right: &ast.StringLiteral{
 															BaseNode: ast.BaseNode{
-																Errors: nil,
+																Comments: nil,
+																Errors:   nil,
 																Loc: &ast.SourceLocation{
 																	End: ast.Position{
 																		Column: 50,
@@ -2228,13 +2483,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																		Line:   50,
 																	},
 																},
+																TrailingComment: nil,
 															},
 															Value: "usage_idle",
 														},
 													},
 													Params: []*ast.Property{&ast.Property{
 														BaseNode: ast.BaseNode{
-															Errors: nil,
+															Comments: nil,
+															Errors:   nil,
 															Loc: &ast.SourceLocation{
 																End: ast.Position{
 																	Column: 21,
@@ -2247,10 +2504,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																	Line:   50,
 																},
 															},
+															TrailingComment: nil,
 														},
 														Key: &ast.Identifier{
 															BaseNode: ast.BaseNode{
-																Errors: nil,
+																Comments: nil,
+																Errors:   nil,
 																Loc: &ast.SourceLocation{
 																	End: ast.Position{
 																		Column: 21,
@@ -2263,6 +2522,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																		Line:   50,
 																	},
 																},
+																TrailingComment: nil,
 															},
 															Name: "r",
 														},
@@ -2273,7 +2533,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											With: nil,
 										}},
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 51,
@@ -2286,10 +2547,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   50,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Callee: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 14,
@@ -2302,13 +2565,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   50,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "filter",
 										},
 									},
 								},
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 47,
@@ -2321,11 +2586,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   47,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Call: &ast.CallExpression{
 									Arguments: []ast.Expression{&ast.ObjectExpression{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 46,
@@ -2338,10 +2605,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   51,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Properties: []*ast.Property{&ast.Property{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 46,
@@ -2354,10 +2623,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   51,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Key: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 17,
@@ -2370,12 +2641,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   51,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "fn",
 											},
 											Value: &ast.FunctionExpression{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 46,
@@ -2388,10 +2661,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   51,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Body: &ast.BinaryExpression{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 46,
@@ -2404,10 +2679,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																Line:   51,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Left: &ast.MemberExpression{
 														BaseNode: ast.BaseNode{
-															Errors: nil,
+															Comments: nil,
+															Errors:   nil,
 															Loc: &ast.SourceLocation{
 																End: ast.Position{
 																	Column: 31,
@@ -2420,10 +2697,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																	Line:   51,
 																},
 															},
+															TrailingComment: nil,
 														},
 														Object: &ast.Identifier{
 															BaseNode: ast.BaseNode{
-																Errors: nil,
+																Comments: nil,
+																Errors:   nil,
 																Loc: &ast.SourceLocation{
 																	End: ast.Position{
 																		Column: 27,
@@ -2436,12 +2715,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																		Line:   51,
 																	},
 																},
+																TrailingComment: nil,
 															},
 															Name: "r",
 														},
 														Property: &ast.Identifier{
 															BaseNode: ast.BaseNode{
-																Errors: nil,
+																Comments: nil,
+																Errors:   nil,
 																Loc: &ast.SourceLocation{
 																	End: ast.Position{
 																		Column: 31,
@@ -2454,6 +2735,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																		Line:   51,
 																	},
 																},
+																TrailingComment: nil,
 															},
 															Name: "cpu",
 														},
@@ -2461,7 +2743,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Operator: 17,
 													Right: &ast.StringLiteral{
 														BaseNode: ast.BaseNode{
-															Errors: nil,
+															Comments: nil,
+															Errors:   nil,
 															Loc: &ast.SourceLocation{
 																End: ast.Position{
 																	Column: 46,
@@ -2474,13 +2757,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																	Line:   51,
 																},
 															},
+															TrailingComment: nil,
 														},
 														Value: "cpu-total",
 													},
 												},
 												Params: []*ast.Property{&ast.Property{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 21,
@@ -2493,10 +2778,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																Line:   51,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Key: &ast.Identifier{
 														BaseNode: ast.BaseNode{
-															Errors: nil,
+															Comments: nil,
+															Errors:   nil,
 															Loc: &ast.SourceLocation{
 																End: ast.Position{
 																	Column: 21,
@@ -2509,6 +2796,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																	Line:   51,
 																},
 															},
+															TrailingComment: nil,
 														},
 														Name: "r",
 													},
@@ -2519,7 +2807,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										With: nil,
 									}},
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 47,
@@ -2532,10 +2821,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   51,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Callee: &ast.Identifier{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 14,
@@ -2548,13 +2839,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   51,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Name: "filter",
 									},
 								},
 							},
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 25,
@@ -2567,11 +2860,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										Line:   47,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Call: &ast.CallExpression{
 								Arguments: nil,
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 25,
@@ -2584,10 +2879,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   52,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Callee: &ast.MemberExpression{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 23,
@@ -2600,10 +2897,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   52,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Object: &ast.Identifier{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 10,
@@ -2616,12 +2915,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   52,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Name: "v1",
 									},
 									Property: &ast.Identifier{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 23,
@@ -2634,6 +2935,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   52,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Name: "fieldsAsCols",
 									},
@@ -2641,7 +2943,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							},
 						},
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 67,
@@ -2654,11 +2957,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Line:   47,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Call: &ast.CallExpression{
 							Arguments: []ast.Expression{&ast.ObjectExpression{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 66,
@@ -2671,10 +2976,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   53,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Properties: []*ast.Property{&ast.Property{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 34,
@@ -2687,10 +2994,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   53,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Key: &ast.Identifier{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 29,
@@ -2703,12 +3012,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   53,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Name: "every",
 									},
 									Value: &ast.DurationLiteral{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 34,
@@ -2721,6 +3032,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   53,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Values: []ast.Duration{ast.Duration{
 											Magnitude: int64(20),
@@ -2729,7 +3041,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									},
 								}, &ast.Property{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 44,
@@ -2742,10 +3055,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   53,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Key: &ast.Identifier{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 38,
@@ -2758,12 +3073,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   53,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Name: "fn",
 									},
 									Value: &ast.Identifier{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 44,
@@ -2776,12 +3093,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   53,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Name: "mean",
 									},
 								}, &ast.Property{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 66,
@@ -2794,10 +3113,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   53,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Key: &ast.Identifier{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 52,
@@ -2810,12 +3131,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   53,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Name: "column",
 									},
 									Value: &ast.StringLiteral{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 66,
@@ -2828,6 +3151,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   53,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Value: "usage_idle",
 									},
@@ -2835,7 +3159,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								With: nil,
 							}},
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: []ast.Comment{ast.Comment{Text: "// pivot data so there is a \"usage_idle\" column"}},
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 67,
@@ -2848,10 +3173,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										Line:   53,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Callee: &ast.Identifier{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 23,
@@ -2864,13 +3191,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   53,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Name: "aggregateWindow",
 							},
 						},
 					},
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 6,
@@ -2883,11 +3212,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   47,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Call: &ast.CallExpression{
 						Arguments: []ast.Expression{&ast.ObjectExpression{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 19,
@@ -2900,10 +3231,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										Line:   55,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Properties: []*ast.Property{&ast.Property{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 19,
@@ -2916,10 +3249,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   55,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Key: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 13,
@@ -2932,12 +3267,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   55,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "data",
 								},
 								Value: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 19,
@@ -2950,12 +3287,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   55,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "data",
 								},
 							}, &ast.Property{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 29,
@@ -2968,10 +3307,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   56,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Key: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 18,
@@ -2984,12 +3325,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   56,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "messageFn",
 								},
 								Value: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 29,
@@ -3002,12 +3345,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   56,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "messageFn",
 								},
 							}, &ast.Property{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 19,
@@ -3020,10 +3365,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   57,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Key: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 13,
@@ -3036,12 +3383,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   57,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "info",
 								},
 								Value: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 19,
@@ -3054,12 +3403,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   57,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "info",
 								},
 							}, &ast.Property{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 19,
@@ -3072,10 +3423,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   58,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Key: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 13,
@@ -3088,12 +3441,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   58,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "warn",
 								},
 								Value: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 19,
@@ -3106,12 +3461,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   58,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "warn",
 								},
 							}, &ast.Property{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 19,
@@ -3124,10 +3481,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   59,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Key: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 13,
@@ -3140,12 +3499,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   59,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "crit",
 								},
 								Value: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 19,
@@ -3158,6 +3519,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   59,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "crit",
 								},
@@ -3165,7 +3527,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							With: nil,
 						}},
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 6,
@@ -3178,10 +3541,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Line:   54,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Callee: &ast.MemberExpression{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 21,
@@ -3194,10 +3559,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										Line:   54,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Object: &ast.Identifier{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 15,
@@ -3210,12 +3577,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   54,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Name: "monitor",
 							},
 							Property: &ast.Identifier{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 21,
@@ -3228,6 +3597,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   54,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Name: "check",
 							},
@@ -3236,7 +3606,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 				},
 				Params: []*ast.Property{&ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 20,
@@ -3249,10 +3620,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   47,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 17,
@@ -3265,11 +3638,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Line:   47,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Name: "table",
 					},
 					Value: &ast.PipeLiteral{BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 20,
@@ -3282,13 +3657,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   47,
 							},
 						},
+						TrailingComment: nil,
 					}},
 				}},
 			},
 		}, &ast.TestStatement{
 			Assignment: &ast.VariableAssignment{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 98,
@@ -3301,10 +3678,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   62,
 						},
 					},
+					TrailingComment: nil,
 				},
 				ID: &ast.Identifier{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 19,
@@ -3317,12 +3696,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   62,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Name: "monitor_check",
 				},
 				Init: &ast.FunctionExpression{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 98,
@@ -3335,10 +3716,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   62,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Body: &ast.ParenExpression{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 98,
@@ -3351,10 +3734,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Line:   63,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Expression: &ast.ObjectExpression{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 97,
@@ -3367,10 +3752,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										Line:   63,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Properties: []*ast.Property{&ast.Property{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 46,
@@ -3383,10 +3770,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   63,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Key: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 12,
@@ -3399,13 +3788,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   63,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "input",
 								},
 								Value: &ast.CallExpression{
 									Arguments: []ast.Expression{&ast.ObjectExpression{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 45,
@@ -3418,10 +3809,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   63,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Properties: []*ast.Property{&ast.Property{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 45,
@@ -3434,10 +3827,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   63,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Key: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 37,
@@ -3450,12 +3845,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   63,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "csv",
 											},
 											Value: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 45,
@@ -3468,6 +3865,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   63,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "inData",
 											},
@@ -3475,7 +3873,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										With: nil,
 									}},
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 46,
@@ -3488,10 +3887,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   63,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Callee: &ast.MemberExpression{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 33,
@@ -3504,10 +3905,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   63,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Object: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 21,
@@ -3520,12 +3923,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   63,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "testing",
 										},
 										Property: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 33,
@@ -3538,6 +3943,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   63,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "loadStorage",
 										},
@@ -3545,7 +3951,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								},
 							}, &ast.Property{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 83,
@@ -3558,10 +3965,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   63,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Key: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 52,
@@ -3574,13 +3983,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   63,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "want",
 								},
 								Value: &ast.CallExpression{
 									Arguments: []ast.Expression{&ast.ObjectExpression{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 82,
@@ -3593,10 +4004,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   63,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Properties: []*ast.Property{&ast.Property{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 82,
@@ -3609,10 +4022,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   63,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Key: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 73,
@@ -3625,12 +4040,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   63,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "csv",
 											},
 											Value: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 82,
@@ -3643,6 +4060,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   63,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "outData",
 											},
@@ -3650,7 +4068,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										With: nil,
 									}},
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 83,
@@ -3663,10 +4082,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   63,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Callee: &ast.MemberExpression{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 69,
@@ -3679,10 +4100,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   63,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Object: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 61,
@@ -3695,12 +4118,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   63,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "testing",
 										},
 										Property: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 69,
@@ -3713,6 +4138,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   63,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "loadMem",
 										},
@@ -3720,7 +4146,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								},
 							}, &ast.Property{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 96,
@@ -3733,10 +4160,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   63,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Key: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 87,
@@ -3749,12 +4178,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   63,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "fn",
 								},
 								Value: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 96,
@@ -3767,6 +4198,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   63,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "t_check",
 								},
@@ -3778,7 +4210,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 				},
 			},
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 98,
@@ -3791,12 +4224,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   62,
 					},
 				},
+				TrailingComment: nil,
 			},
 		}},
+		Eof: nil,
 		Imports: []*ast.ImportDeclaration{&ast.ImportDeclaration{
 			As: nil,
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 37,
@@ -3809,10 +4245,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   3,
 					},
 				},
+				TrailingComment: nil,
 			},
 			Path: &ast.StringLiteral{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 37,
@@ -3825,13 +4263,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   3,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Value: "influxdata/influxdb/monitor",
 			},
 		}, &ast.ImportDeclaration{
 			As: nil,
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 32,
@@ -3844,10 +4284,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   4,
 					},
 				},
+				TrailingComment: nil,
 			},
 			Path: &ast.StringLiteral{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 32,
@@ -3860,13 +4302,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   4,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Value: "influxdata/influxdb/v1",
 			},
 		}, &ast.ImportDeclaration{
 			As: nil,
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 17,
@@ -3879,10 +4323,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   5,
 					},
 				},
+				TrailingComment: nil,
 			},
 			Path: &ast.StringLiteral{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 17,
@@ -3895,6 +4341,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   5,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Value: "testing",
 			},
@@ -3903,7 +4350,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 		Name:     "check_test.flux",
 		Package: &ast.PackageClause{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 21,
@@ -3916,10 +4364,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   1,
 					},
 				},
+				TrailingComment: nil,
 			},
 			Name: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 21,
@@ -3932,13 +4382,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   1,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "monitor_test",
 			},
 		},
 	}, &ast.File{
 		BaseNode: ast.BaseNode{
-			Errors: nil,
+			Comments: nil,
+			Errors:   nil,
 			Loc: &ast.SourceLocation{
 				End: ast.Position{
 					Column: 101,
@@ -3951,11 +4403,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 					Line:   1,
 				},
 			},
+			TrailingComment: nil,
 		},
 		Body: []ast.Statement{&ast.OptionStatement{
 			Assignment: &ast.VariableAssignment{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 40,
@@ -3968,10 +4422,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   7,
 						},
 					},
+					TrailingComment: nil,
 				},
 				ID: &ast.Identifier{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 11,
@@ -3984,12 +4440,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   7,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Name: "now",
 				},
 				Init: &ast.FunctionExpression{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 40,
@@ -4002,10 +4460,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   7,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Body: &ast.DateTimeLiteral{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 40,
@@ -4018,6 +4478,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Line:   7,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Value: parser.MustParseTime("2018-05-22T20:00:00Z"),
 					},
@@ -4025,7 +4486,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 				},
 			},
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 40,
@@ -4038,10 +4500,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   7,
 					},
 				},
+				TrailingComment: nil,
 			},
 		}, &ast.VariableAssignment{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 2,
@@ -4054,10 +4518,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   9,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 7,
@@ -4070,12 +4536,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   9,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "inData",
 			},
 			Init: &ast.StringLiteral{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 2,
@@ -4088,12 +4556,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   9,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Value: "\n#datatype,string,long,dateTime:RFC3339,long,string,string\n#group,false,false,false,false,true,true\n#default,_result,,,,,\n,result,table,_time,_value,_field,_measurement\n,,0,2018-05-22T19:30:00Z,11,A,cpu\n,,0,2018-05-22T18:30:00Z,11,A,cpu\n,,0,2018-05-22T17:30:00Z,11,A,cpu\n,,0,2018-05-22T16:30:00Z,11,A,cpu\n,,0,2018-05-22T15:30:00Z,11,A,cpu\n,,1,2018-05-22T15:30:00Z,11,B,cpu\n,,1,2018-05-22T16:30:00Z,11,B,cpu\n,,1,2018-05-22T17:30:00Z,11,B,cpu\n,,1,2018-05-22T18:30:00Z,11,B,cpu\n,,1,2018-05-22T19:30:00Z,11,B,cpu\n,,2,2018-05-22T18:30:00Z,11,C,cpu\n,,2,2018-05-22T14:30:00Z,11,C,cpu\n,,2,2018-05-22T17:30:00Z,11,C,cpu\n,,2,2018-05-22T15:30:00Z,11,C,cpu\n,,2,2018-05-22T16:30:00Z,11,C,cpu\n,,3,2018-05-22T18:30:00Z,11,D,cpu\n,,3,2018-05-22T15:30:00Z,11,D,cpu\n,,3,2018-05-22T19:30:00Z,11,D,cpu\n,,3,2018-05-22T16:30:00Z,11,D,cpu\n,,3,2018-05-22T17:30:00Z,11,D,cpu\n",
 			},
 		}, &ast.VariableAssignment{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 2,
@@ -4106,10 +4576,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   36,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 8,
@@ -4122,12 +4594,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   36,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "outData",
 			},
 			Init: &ast.StringLiteral{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 2,
@@ -4140,12 +4614,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   36,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Value: "\n#datatype,string,long,dateTime:RFC3339,dateTime:RFC3339,dateTime:RFC3339,long,string,string,boolean\n#group,false,false,true,true,false,false,true,true,false\n#default,_result,,,,,,,,\n,result,table,_start,_stop,_time,_value,_field,_measurement,dead\n,,0,2018-05-22T15:00:00Z,2018-05-22T20:00:00Z,2018-05-22T19:30:00Z,11,A,cpu,false\n,,1,2018-05-22T15:00:00Z,2018-05-22T20:00:00Z,2018-05-22T19:30:00Z,11,B,cpu,false\n,,2,2018-05-22T15:00:00Z,2018-05-22T20:00:00Z,2018-05-22T18:30:00Z,11,C,cpu,true\n,,3,2018-05-22T15:00:00Z,2018-05-22T20:00:00Z,2018-05-22T19:30:00Z,11,D,cpu,false\n",
 			},
 		}, &ast.VariableAssignment{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 71,
@@ -4158,10 +4634,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   47,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 14,
@@ -4174,12 +4652,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   47,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "t_deadman_add",
 			},
 			Init: &ast.FunctionExpression{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 71,
@@ -4192,12 +4672,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   47,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Body: &ast.PipeExpression{
 					Argument: &ast.PipeExpression{
 						Argument: &ast.Identifier{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 36,
@@ -4210,11 +4692,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										Line:   47,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Name: "table",
 						},
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 25,
@@ -4227,11 +4711,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Line:   47,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Call: &ast.CallExpression{
 							Arguments: []ast.Expression{&ast.ObjectExpression{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 24,
@@ -4244,10 +4730,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   48,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Properties: []*ast.Property{&ast.Property{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 24,
@@ -4260,10 +4748,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   48,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Key: &ast.Identifier{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 19,
@@ -4276,13 +4766,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   48,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Name: "start",
 									},
 									Value: &ast.UnaryExpression{
 										Argument: &ast.DurationLiteral{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 24,
@@ -4295,6 +4787,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   48,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Values: []ast.Duration{ast.Duration{
 												Magnitude: int64(5),
@@ -4302,7 +4795,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											}},
 										},
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 24,
@@ -4315,6 +4809,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   48,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Operator: 6,
 									},
@@ -4322,7 +4817,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								With: nil,
 							}},
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 25,
@@ -4335,10 +4831,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										Line:   48,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Callee: &ast.Identifier{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 13,
@@ -4351,13 +4849,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   48,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Name: "range",
 							},
 						},
 					},
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 71,
@@ -4370,11 +4870,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   47,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Call: &ast.CallExpression{
 						Arguments: []ast.Expression{&ast.ObjectExpression{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 70,
@@ -4387,10 +4889,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										Line:   49,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Properties: []*ast.Property{&ast.Property{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 70,
@@ -4403,10 +4907,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   49,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Key: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 25,
@@ -4419,13 +4925,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   49,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "t",
 								},
 								Value: &ast.CallExpression{
 									Arguments: []ast.Expression{&ast.ObjectExpression{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 69,
@@ -4438,10 +4946,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   49,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Properties: []*ast.Property{&ast.Property{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 58,
@@ -4454,10 +4964,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   49,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Key: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 53,
@@ -4470,13 +4982,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   49,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "d",
 											},
 											Value: &ast.UnaryExpression{
 												Argument: &ast.DurationLiteral{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 58,
@@ -4489,6 +5003,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																Line:   49,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Values: []ast.Duration{ast.Duration{
 														Magnitude: int64(1),
@@ -4496,7 +5011,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													}},
 												},
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 58,
@@ -4509,12 +5025,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   49,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Operator: 6,
 											},
 										}, &ast.Property{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 69,
@@ -4527,10 +5045,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   49,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Key: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 62,
@@ -4543,13 +5063,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   49,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "to",
 											},
 											Value: &ast.CallExpression{
 												Arguments: nil,
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 69,
@@ -4562,10 +5084,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   49,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Callee: &ast.Identifier{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 67,
@@ -4578,6 +5102,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																Line:   49,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Name: "now",
 												},
@@ -4586,7 +5111,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										With: nil,
 									}},
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 70,
@@ -4599,10 +5125,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   49,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Callee: &ast.MemberExpression{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 51,
@@ -4615,10 +5143,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   49,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Object: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 39,
@@ -4631,12 +5161,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   49,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "experimental",
 										},
 										Property: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 51,
@@ -4649,6 +5181,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   49,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "addDuration",
 										},
@@ -4658,7 +5191,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							With: nil,
 						}},
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 71,
@@ -4671,10 +5205,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Line:   49,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Callee: &ast.MemberExpression{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 23,
@@ -4687,10 +5223,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										Line:   49,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Object: &ast.Identifier{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 15,
@@ -4703,12 +5241,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   49,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Name: "monitor",
 							},
 							Property: &ast.Identifier{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 23,
@@ -4721,6 +5261,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   49,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Name: "deadman",
 							},
@@ -4729,7 +5270,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 				},
 				Params: []*ast.Property{&ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 26,
@@ -4742,10 +5284,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   47,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 23,
@@ -4758,11 +5302,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Line:   47,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Name: "table",
 					},
 					Value: &ast.PipeLiteral{BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 26,
@@ -4775,13 +5321,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   47,
 							},
 						},
+						TrailingComment: nil,
 					}},
 				}},
 			},
 		}, &ast.TestStatement{
 			Assignment: &ast.VariableAssignment{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 101,
@@ -4794,10 +5342,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   51,
 						},
 					},
+					TrailingComment: nil,
 				},
 				ID: &ast.Identifier{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 17,
@@ -4810,12 +5360,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   51,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Name: "deadman_add",
 				},
 				Init: &ast.FunctionExpression{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 101,
@@ -4828,10 +5380,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   51,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Body: &ast.ParenExpression{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 101,
@@ -4844,10 +5398,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Line:   52,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Expression: &ast.ObjectExpression{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 100,
@@ -4860,10 +5416,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										Line:   52,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Properties: []*ast.Property{&ast.Property{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 43,
@@ -4876,10 +5434,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   52,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Key: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 9,
@@ -4892,13 +5452,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   52,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "input",
 								},
 								Value: &ast.CallExpression{
 									Arguments: []ast.Expression{&ast.ObjectExpression{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 42,
@@ -4911,10 +5473,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   52,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Properties: []*ast.Property{&ast.Property{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 42,
@@ -4927,10 +5491,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   52,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Key: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 34,
@@ -4943,12 +5509,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   52,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "csv",
 											},
 											Value: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 42,
@@ -4961,6 +5529,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   52,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "inData",
 											},
@@ -4968,7 +5537,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										With: nil,
 									}},
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 43,
@@ -4981,10 +5551,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   52,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Callee: &ast.MemberExpression{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 30,
@@ -4997,10 +5569,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   52,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Object: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 18,
@@ -5013,12 +5587,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   52,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "testing",
 										},
 										Property: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 30,
@@ -5031,6 +5607,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   52,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "loadStorage",
 										},
@@ -5038,7 +5615,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								},
 							}, &ast.Property{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 80,
@@ -5051,10 +5629,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   52,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Key: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 49,
@@ -5067,13 +5647,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   52,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "want",
 								},
 								Value: &ast.CallExpression{
 									Arguments: []ast.Expression{&ast.ObjectExpression{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 79,
@@ -5086,10 +5668,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   52,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Properties: []*ast.Property{&ast.Property{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 79,
@@ -5102,10 +5686,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   52,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Key: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 70,
@@ -5118,12 +5704,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   52,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "csv",
 											},
 											Value: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 79,
@@ -5136,6 +5724,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   52,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "outData",
 											},
@@ -5143,7 +5732,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										With: nil,
 									}},
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 80,
@@ -5156,10 +5746,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   52,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Callee: &ast.MemberExpression{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 66,
@@ -5172,10 +5764,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   52,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Object: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 58,
@@ -5188,12 +5782,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   52,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "testing",
 										},
 										Property: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 66,
@@ -5206,6 +5802,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   52,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "loadMem",
 										},
@@ -5213,7 +5810,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								},
 							}, &ast.Property{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 99,
@@ -5226,10 +5824,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   52,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Key: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 84,
@@ -5242,12 +5842,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   52,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "fn",
 								},
 								Value: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 99,
@@ -5260,6 +5862,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   52,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "t_deadman_add",
 								},
@@ -5271,7 +5874,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 				},
 			},
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 101,
@@ -5284,12 +5888,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   51,
 					},
 				},
+				TrailingComment: nil,
 			},
 		}},
+		Eof: nil,
 		Imports: []*ast.ImportDeclaration{&ast.ImportDeclaration{
 			As: nil,
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 37,
@@ -5302,10 +5909,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   3,
 					},
 				},
+				TrailingComment: nil,
 			},
 			Path: &ast.StringLiteral{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 37,
@@ -5318,13 +5927,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   3,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Value: "influxdata/influxdb/monitor",
 			},
 		}, &ast.ImportDeclaration{
 			As: nil,
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 17,
@@ -5337,10 +5948,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   4,
 					},
 				},
+				TrailingComment: nil,
 			},
 			Path: &ast.StringLiteral{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 17,
@@ -5353,13 +5966,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   4,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Value: "testing",
 			},
 		}, &ast.ImportDeclaration{
 			As: nil,
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 22,
@@ -5372,10 +5987,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   5,
 					},
 				},
+				TrailingComment: nil,
 			},
 			Path: &ast.StringLiteral{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 22,
@@ -5388,6 +6005,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   5,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Value: "experimental",
 			},
@@ -5396,7 +6014,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 		Name:     "deadman_add_test.flux",
 		Package: &ast.PackageClause{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 21,
@@ -5409,10 +6028,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   1,
 					},
 				},
+				TrailingComment: nil,
 			},
 			Name: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 21,
@@ -5425,13 +6046,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   1,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "monitor_test",
 			},
 		},
 	}, &ast.File{
 		BaseNode: ast.BaseNode{
-			Errors: nil,
+			Comments: nil,
+			Errors:   nil,
 			Loc: &ast.SourceLocation{
 				End: ast.Position{
 					Column: 101,
@@ -5444,11 +6067,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 					Line:   1,
 				},
 			},
+			TrailingComment: nil,
 		},
 		Body: []ast.Statement{&ast.OptionStatement{
 			Assignment: &ast.VariableAssignment{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 40,
@@ -5461,10 +6086,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   7,
 						},
 					},
+					TrailingComment: nil,
 				},
 				ID: &ast.Identifier{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 11,
@@ -5477,12 +6104,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   7,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Name: "now",
 				},
 				Init: &ast.FunctionExpression{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 40,
@@ -5495,10 +6124,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   7,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Body: &ast.DateTimeLiteral{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 40,
@@ -5511,6 +6142,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Line:   7,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Value: parser.MustParseTime("2018-05-22T20:00:00Z"),
 					},
@@ -5518,7 +6150,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 				},
 			},
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 40,
@@ -5531,10 +6164,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   7,
 					},
 				},
+				TrailingComment: nil,
 			},
 		}, &ast.VariableAssignment{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 2,
@@ -5547,10 +6182,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   9,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 7,
@@ -5563,12 +6200,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   9,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "inData",
 			},
 			Init: &ast.StringLiteral{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 2,
@@ -5581,12 +6220,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   9,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Value: "\n#datatype,string,long,dateTime:RFC3339,long,string,string\n#group,false,false,false,false,true,true\n#default,_result,,,,,\n,result,table,_time,_value,_field,_measurement\n,,0,2018-05-22T19:30:00Z,11,A,cpu\n,,0,2018-05-22T18:30:00Z,11,A,cpu\n,,0,2018-05-22T17:30:00Z,11,A,cpu\n,,0,2018-05-22T16:30:00Z,11,A,cpu\n,,0,2018-05-22T15:30:00Z,11,A,cpu\n,,1,2018-05-22T15:30:00Z,11,B,cpu\n,,1,2018-05-22T16:30:00Z,11,B,cpu\n,,1,2018-05-22T17:30:00Z,11,B,cpu\n,,1,2018-05-22T18:30:00Z,11,B,cpu\n,,1,2018-05-22T19:30:00Z,11,B,cpu\n,,2,2018-05-22T18:30:00Z,11,C,cpu\n,,2,2018-05-22T14:30:00Z,11,C,cpu\n,,2,2018-05-22T17:30:00Z,11,C,cpu\n,,2,2018-05-22T15:30:00Z,11,C,cpu\n,,2,2018-05-22T16:30:00Z,11,C,cpu\n,,3,2018-05-22T18:30:00Z,11,D,cpu\n,,3,2018-05-22T15:30:00Z,11,D,cpu\n,,3,2018-05-22T19:30:00Z,11,D,cpu\n,,3,2018-05-22T16:30:00Z,11,D,cpu\n,,3,2018-05-22T17:30:00Z,11,D,cpu\n",
 			},
 		}, &ast.VariableAssignment{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 2,
@@ -5599,10 +6240,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   36,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 8,
@@ -5615,12 +6258,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   36,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "outData",
 			},
 			Init: &ast.StringLiteral{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 2,
@@ -5633,12 +6278,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   36,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Value: "\n#datatype,string,long,dateTime:RFC3339,dateTime:RFC3339,dateTime:RFC3339,long,string,string,boolean\n#group,false,false,true,true,false,false,true,true,false\n#default,_result,,,,,,,,\n,result,table,_start,_stop,_time,_value,_field,_measurement,dead\n,,0,2018-05-22T15:00:00Z,2018-05-22T20:00:00Z,2018-05-22T19:30:00Z,11,A,cpu,false\n,,1,2018-05-22T15:00:00Z,2018-05-22T20:00:00Z,2018-05-22T19:30:00Z,11,B,cpu,false\n,,2,2018-05-22T15:00:00Z,2018-05-22T20:00:00Z,2018-05-22T18:30:00Z,11,C,cpu,true\n,,3,2018-05-22T15:00:00Z,2018-05-22T20:00:00Z,2018-05-22T19:30:00Z,11,D,cpu,false\n",
 			},
 		}, &ast.VariableAssignment{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 72,
@@ -5651,10 +6298,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   47,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 14,
@@ -5667,12 +6316,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   47,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "t_deadman_sub",
 			},
 			Init: &ast.FunctionExpression{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 72,
@@ -5685,12 +6336,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   47,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Body: &ast.PipeExpression{
 					Argument: &ast.PipeExpression{
 						Argument: &ast.Identifier{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 36,
@@ -5703,11 +6356,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										Line:   47,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Name: "table",
 						},
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 25,
@@ -5720,11 +6375,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Line:   47,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Call: &ast.CallExpression{
 							Arguments: []ast.Expression{&ast.ObjectExpression{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 24,
@@ -5737,10 +6394,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   48,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Properties: []*ast.Property{&ast.Property{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 24,
@@ -5753,10 +6412,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   48,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Key: &ast.Identifier{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 19,
@@ -5769,13 +6430,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   48,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Name: "start",
 									},
 									Value: &ast.UnaryExpression{
 										Argument: &ast.DurationLiteral{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 24,
@@ -5788,6 +6451,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   48,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Values: []ast.Duration{ast.Duration{
 												Magnitude: int64(5),
@@ -5795,7 +6459,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											}},
 										},
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 24,
@@ -5808,6 +6473,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   48,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Operator: 6,
 									},
@@ -5815,7 +6481,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								With: nil,
 							}},
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 25,
@@ -5828,10 +6495,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										Line:   48,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Callee: &ast.Identifier{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 13,
@@ -5844,13 +6513,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   48,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Name: "range",
 							},
 						},
 					},
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 72,
@@ -5863,11 +6534,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   47,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Call: &ast.CallExpression{
 						Arguments: []ast.Expression{&ast.ObjectExpression{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 71,
@@ -5880,10 +6553,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										Line:   49,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Properties: []*ast.Property{&ast.Property{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 71,
@@ -5896,10 +6571,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   49,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Key: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 25,
@@ -5912,13 +6589,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   49,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "t",
 								},
 								Value: &ast.CallExpression{
 									Arguments: []ast.Expression{&ast.ObjectExpression{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 70,
@@ -5931,10 +6610,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   49,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Properties: []*ast.Property{&ast.Property{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 57,
@@ -5947,10 +6628,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   49,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Key: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 53,
@@ -5963,12 +6646,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   49,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "d",
 											},
 											Value: &ast.DurationLiteral{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 57,
@@ -5981,6 +6666,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   49,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Values: []ast.Duration{ast.Duration{
 													Magnitude: int64(1),
@@ -5989,7 +6675,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											},
 										}, &ast.Property{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 70,
@@ -6002,10 +6689,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   49,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Key: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 63,
@@ -6018,13 +6707,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   49,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "from",
 											},
 											Value: &ast.CallExpression{
 												Arguments: nil,
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 70,
@@ -6037,10 +6728,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   49,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Callee: &ast.Identifier{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 68,
@@ -6053,6 +6746,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																Line:   49,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Name: "now",
 												},
@@ -6061,7 +6755,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										With: nil,
 									}},
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 71,
@@ -6074,10 +6769,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   49,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Callee: &ast.MemberExpression{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 51,
@@ -6090,10 +6787,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   49,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Object: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 39,
@@ -6106,12 +6805,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   49,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "experimental",
 										},
 										Property: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 51,
@@ -6124,6 +6825,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   49,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "subDuration",
 										},
@@ -6133,7 +6835,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							With: nil,
 						}},
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 72,
@@ -6146,10 +6849,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Line:   49,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Callee: &ast.MemberExpression{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 23,
@@ -6162,10 +6867,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										Line:   49,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Object: &ast.Identifier{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 15,
@@ -6178,12 +6885,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   49,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Name: "monitor",
 							},
 							Property: &ast.Identifier{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 23,
@@ -6196,6 +6905,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   49,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Name: "deadman",
 							},
@@ -6204,7 +6914,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 				},
 				Params: []*ast.Property{&ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 26,
@@ -6217,10 +6928,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   47,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 23,
@@ -6233,11 +6946,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Line:   47,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Name: "table",
 					},
 					Value: &ast.PipeLiteral{BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 26,
@@ -6250,13 +6965,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   47,
 							},
 						},
+						TrailingComment: nil,
 					}},
 				}},
 			},
 		}, &ast.TestStatement{
 			Assignment: &ast.VariableAssignment{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 101,
@@ -6269,10 +6986,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   51,
 						},
 					},
+					TrailingComment: nil,
 				},
 				ID: &ast.Identifier{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 17,
@@ -6285,12 +7004,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   51,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Name: "deadman_sub",
 				},
 				Init: &ast.FunctionExpression{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 101,
@@ -6303,10 +7024,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   51,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Body: &ast.ParenExpression{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 101,
@@ -6319,10 +7042,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Line:   52,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Expression: &ast.ObjectExpression{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 100,
@@ -6335,10 +7060,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										Line:   52,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Properties: []*ast.Property{&ast.Property{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 43,
@@ -6351,10 +7078,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   52,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Key: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 9,
@@ -6367,13 +7096,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   52,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "input",
 								},
 								Value: &ast.CallExpression{
 									Arguments: []ast.Expression{&ast.ObjectExpression{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 42,
@@ -6386,10 +7117,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   52,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Properties: []*ast.Property{&ast.Property{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 42,
@@ -6402,10 +7135,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   52,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Key: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 34,
@@ -6418,12 +7153,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   52,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "csv",
 											},
 											Value: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 42,
@@ -6436,6 +7173,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   52,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "inData",
 											},
@@ -6443,7 +7181,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										With: nil,
 									}},
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 43,
@@ -6456,10 +7195,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   52,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Callee: &ast.MemberExpression{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 30,
@@ -6472,10 +7213,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   52,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Object: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 18,
@@ -6488,12 +7231,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   52,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "testing",
 										},
 										Property: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 30,
@@ -6506,6 +7251,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   52,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "loadStorage",
 										},
@@ -6513,7 +7259,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								},
 							}, &ast.Property{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 80,
@@ -6526,10 +7273,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   52,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Key: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 49,
@@ -6542,13 +7291,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   52,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "want",
 								},
 								Value: &ast.CallExpression{
 									Arguments: []ast.Expression{&ast.ObjectExpression{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 79,
@@ -6561,10 +7312,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   52,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Properties: []*ast.Property{&ast.Property{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 79,
@@ -6577,10 +7330,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   52,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Key: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 70,
@@ -6593,12 +7348,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   52,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "csv",
 											},
 											Value: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 79,
@@ -6611,6 +7368,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   52,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "outData",
 											},
@@ -6618,7 +7376,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										With: nil,
 									}},
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 80,
@@ -6631,10 +7390,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   52,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Callee: &ast.MemberExpression{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 66,
@@ -6647,10 +7408,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   52,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Object: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 58,
@@ -6663,12 +7426,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   52,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "testing",
 										},
 										Property: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 66,
@@ -6681,6 +7446,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   52,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "loadMem",
 										},
@@ -6688,7 +7454,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								},
 							}, &ast.Property{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 99,
@@ -6701,10 +7468,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   52,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Key: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 84,
@@ -6717,12 +7486,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   52,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "fn",
 								},
 								Value: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 99,
@@ -6735,6 +7506,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   52,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "t_deadman_sub",
 								},
@@ -6746,7 +7518,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 				},
 			},
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 101,
@@ -6759,12 +7532,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   51,
 					},
 				},
+				TrailingComment: nil,
 			},
 		}},
+		Eof: nil,
 		Imports: []*ast.ImportDeclaration{&ast.ImportDeclaration{
 			As: nil,
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 37,
@@ -6777,10 +7553,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   3,
 					},
 				},
+				TrailingComment: nil,
 			},
 			Path: &ast.StringLiteral{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 37,
@@ -6793,13 +7571,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   3,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Value: "influxdata/influxdb/monitor",
 			},
 		}, &ast.ImportDeclaration{
 			As: nil,
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 17,
@@ -6812,10 +7592,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   4,
 					},
 				},
+				TrailingComment: nil,
 			},
 			Path: &ast.StringLiteral{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 17,
@@ -6828,13 +7610,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   4,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Value: "testing",
 			},
 		}, &ast.ImportDeclaration{
 			As: nil,
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 22,
@@ -6847,10 +7631,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   5,
 					},
 				},
+				TrailingComment: nil,
 			},
 			Path: &ast.StringLiteral{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 22,
@@ -6863,6 +7649,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   5,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Value: "experimental",
 			},
@@ -6871,7 +7658,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 		Name:     "deadman_sub_test.flux",
 		Package: &ast.PackageClause{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 21,
@@ -6884,10 +7672,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   1,
 					},
 				},
+				TrailingComment: nil,
 			},
 			Name: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 21,
@@ -6900,13 +7690,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   1,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "monitor_test",
 			},
 		},
 	}, &ast.File{
 		BaseNode: ast.BaseNode{
-			Errors: nil,
+			Comments: nil,
+			Errors:   nil,
 			Loc: &ast.SourceLocation{
 				End: ast.Position{
 					Column: 99,
@@ -6919,11 +7711,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 					Line:   1,
 				},
 			},
+			TrailingComment: nil,
 		},
 		Body: []ast.Statement{&ast.OptionStatement{
 			Assignment: &ast.VariableAssignment{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 40,
@@ -6936,10 +7730,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   8,
 						},
 					},
+					TrailingComment: nil,
 				},
 				ID: &ast.Identifier{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 11,
@@ -6952,12 +7748,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   8,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Name: "now",
 				},
 				Init: &ast.FunctionExpression{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 40,
@@ -6970,10 +7768,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   8,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Body: &ast.DateTimeLiteral{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 40,
@@ -6986,6 +7786,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Line:   8,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Value: parser.MustParseTime("2018-05-22T19:54:40Z"),
 					},
@@ -6993,7 +7794,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 				},
 			},
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 40,
@@ -7006,11 +7808,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   8,
 					},
 				},
+				TrailingComment: nil,
 			},
 		}, &ast.OptionStatement{
 			Assignment: &ast.MemberAssignment{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 80,
@@ -7023,10 +7827,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   10,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Init: &ast.FunctionExpression{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 80,
@@ -7039,11 +7845,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   10,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Body: &ast.PipeExpression{
 						Argument: &ast.Identifier{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 43,
@@ -7056,11 +7864,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										Line:   10,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Name: "tables",
 						},
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 80,
@@ -7073,11 +7883,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Line:   10,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Call: &ast.CallExpression{
 							Arguments: []ast.Expression{&ast.ObjectExpression{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 79,
@@ -7090,10 +7902,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   10,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Properties: []*ast.Property{&ast.Property{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 79,
@@ -7106,10 +7920,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   10,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Key: &ast.Identifier{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 59,
@@ -7122,12 +7938,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   10,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Name: "columns",
 									},
 									Value: &ast.ArrayExpression{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 79,
@@ -7140,10 +7958,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   10,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Elements: []ast.Expression{&ast.StringLiteral{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 69,
@@ -7156,11 +7976,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   10,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Value: "_start",
 										}, &ast.StringLiteral{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 78,
@@ -7173,6 +7995,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   10,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Value: "_stop",
 										}},
@@ -7181,7 +8004,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								With: nil,
 							}},
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 80,
@@ -7194,10 +8018,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										Line:   10,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Callee: &ast.Identifier{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 51,
@@ -7210,6 +8036,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   10,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Name: "drop",
 							},
@@ -7217,7 +8044,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 					},
 					Params: []*ast.Property{&ast.Property{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 32,
@@ -7230,10 +8058,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Line:   10,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Key: &ast.Identifier{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 29,
@@ -7246,11 +8076,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										Line:   10,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Name: "tables",
 						},
 						Value: &ast.PipeLiteral{BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 32,
@@ -7263,12 +8095,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Line:   10,
 								},
 							},
+							TrailingComment: nil,
 						}},
 					}},
 				},
 				Member: &ast.MemberExpression{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 19,
@@ -7281,10 +8115,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   10,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Object: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 15,
@@ -7297,12 +8133,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Line:   10,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Name: "monitor",
 					},
 					Property: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 19,
@@ -7315,13 +8153,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Line:   10,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Name: "log",
 					},
 				},
 			},
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 80,
@@ -7334,10 +8174,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   10,
 					},
 				},
+				TrailingComment: nil,
 			},
 		}, &ast.VariableAssignment{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: []ast.Comment{ast.Comment{Text: "// Note this input data is identical to the output data of the check test case, post pivot."}},
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 2,
@@ -7350,10 +8192,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   13,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 7,
@@ -7366,12 +8210,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   13,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "inData",
 			},
 			Init: &ast.StringLiteral{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 2,
@@ -7384,12 +8230,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   13,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Value: "\n#datatype,string,long,string,string,string,string,string,dateTime:RFC3339,string,string,string,string,string,string,double\n#group,false,false,true,true,true,true,true,false,true,true,true,true,true,true,false\n#default,got,,,,,,,,,,,,,,\n,result,table,_check_id,_check_name,_level,_measurement,_source_measurement,_time,_type,aaa,bbb,cpu,host,_field,_value\n,,0,000000000000000a,cpu threshold check,crit,statuses,cpu,2018-05-22T19:54:20Z,threshold,vaaa,vbbb,cpu-total,host.local,usage_idle,4.800000000000001\n,,1,000000000000000a,cpu threshold check,ok,statuses,cpu,2018-05-22T19:54:20Z,threshold,vaaa,vbbb,cpu-total,host.local,usage_idle,90.62382797849732\n,,2,000000000000000a,cpu threshold check,warn,statuses,cpu,2018-05-22T19:54:20Z,threshold,vaaa,vbbb,cpu-total,host.local,usage_idle,7.05\n\n#datatype,string,long,string,string,string,string,string,dateTime:RFC3339,string,string,string,string,string,string,string\n#group,false,false,true,true,true,true,true,false,true,true,true,true,true,true,false\n#default,got,,,,,,,,,,,,,,\n,result,table,_check_id,_check_name,_level,_measurement,_source_measurement,_time,_type,aaa,bbb,cpu,host,_field,_value\n,,1,000000000000000a,cpu threshold check,ok,statuses,cpu,2018-05-22T19:54:20Z,threshold,vaaa,vbbb,cpu-total,host.local,_message,whoa!\n,,2,000000000000000a,cpu threshold check,warn,statuses,cpu,2018-05-22T19:54:20Z,threshold,vaaa,vbbb,cpu-total,host.local,_message,whoa!\n,,0,000000000000000a,cpu threshold check,crit,statuses,cpu,2018-05-22T19:54:20Z,threshold,vaaa,vbbb,cpu-total,host.local,_message,whoa!\n\n#datatype,string,long,string,string,string,string,string,dateTime:RFC3339,string,string,string,string,string,string,long\n#group,false,false,true,true,true,true,true,false,true,true,true,true,true,true,false\n#default,got,,,,,,,,,,,,,,\n,result,table,_check_id,_check_name,_level,_measurement,_source_measurement,_time,_type,aaa,bbb,cpu,host,_field,_value\n,,0,000000000000000a,cpu threshold check,crit,statuses,cpu,2018-05-22T19:54:20Z,threshold,vaaa,vbbb,cpu-total,host.local,_source_timestamp,1527018840000000000\n,,1,000000000000000a,cpu threshold check,ok,statuses,cpu,2018-05-22T19:54:20Z,threshold,vaaa,vbbb,cpu-total,host.local,_source_timestamp,1527018820000000000\n,,2,000000000000000a,cpu threshold check,warn,statuses,cpu,2018-05-22T19:54:20Z,threshold,vaaa,vbbb,cpu-total,host.local,_source_timestamp,1527018860000000000\n",
 			},
 		}, &ast.VariableAssignment{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 2,
@@ -7402,10 +8250,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   39,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 8,
@@ -7418,12 +8268,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   39,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "outData",
 			},
 			Init: &ast.StringLiteral{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 2,
@@ -7436,12 +8288,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   39,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Value: "\n#datatype,string,long,string,string,string,string,string,string,string,string,string,string,long,long,dateTime:RFC3339,string,string,string,string,string,double,string\n#group,false,false,true,true,true,true,true,true,true,true,false,true,false,false,false,true,true,true,true,true,false,true\n#default,got,,,,,,,,,,,,,,,,,,,,,\n,result,table,_notification_rule_id,_notification_rule_name,_notification_endpoint_id,_notification_endpoint_name,_check_id,_check_name,_level,_measurement,_message,_source_measurement,_status_timestamp,_source_timestamp,_time,_type,aaa,bbb,cpu,host,usage_idle,_sent\n,,0,0000000000000001,http-rule,00000000000002,http-endpoint,000000000000000a,cpu threshold check,crit,notifications,whoa!,cpu,1527018860000000000,1527018840000000000,2018-05-22T19:54:40Z,threshold,vaaa,vbbb,cpu-total,host.local,4.800000000000001,true\n,,1,0000000000000001,http-rule,00000000000002,http-endpoint,000000000000000a,cpu threshold check,ok,notifications,whoa!,cpu,1527018860000000000,1527018820000000000,2018-05-22T19:54:40Z,threshold,vaaa,vbbb,cpu-total,host.local,90.62382797849732,true\n,,2,0000000000000001,http-rule,00000000000002,http-endpoint,000000000000000a,cpu threshold check,warn,notifications,whoa!,cpu,1527018860000000000,1527018860000000000,2018-05-22T19:54:40Z,threshold,vaaa,vbbb,cpu-total,host.local,7.05,true\n",
 			},
 		}, &ast.VariableAssignment{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 79,
@@ -7454,10 +8308,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   49,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 9,
@@ -7470,12 +8326,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   49,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "endpoint",
 			},
 			Init: &ast.FunctionExpression{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 79,
@@ -7488,10 +8346,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   49,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Body: &ast.FunctionExpression{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 79,
@@ -7504,11 +8364,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   49,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Body: &ast.PipeExpression{
 						Argument: &ast.Identifier{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 39,
@@ -7521,11 +8383,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										Line:   49,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Name: "tables",
 						},
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 79,
@@ -7538,11 +8402,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Line:   49,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Call: &ast.CallExpression{
 							Arguments: []ast.Expression{&ast.ObjectExpression{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 78,
@@ -7555,10 +8421,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   49,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Properties: []*ast.Property{&ast.Property{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 78,
@@ -7571,10 +8439,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   49,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Key: &ast.Identifier{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 61,
@@ -7587,12 +8457,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   49,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Name: "o",
 									},
 									Value: &ast.ObjectExpression{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 78,
@@ -7605,10 +8477,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   49,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Properties: []*ast.Property{&ast.Property{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 77,
@@ -7621,10 +8495,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   49,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Key: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 69,
@@ -7637,12 +8513,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   49,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "_sent",
 											},
 											Value: &ast.StringLiteral{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 77,
@@ -7655,6 +8533,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   49,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Value: "true",
 											},
@@ -7665,7 +8544,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								With: nil,
 							}},
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 79,
@@ -7678,10 +8558,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										Line:   49,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Callee: &ast.MemberExpression{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 59,
@@ -7694,10 +8576,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   49,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Object: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 55,
@@ -7710,12 +8594,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   49,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "experimental",
 								},
 								Property: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 59,
@@ -7728,6 +8614,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   49,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "set",
 								},
@@ -7736,7 +8623,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 					},
 					Params: []*ast.Property{&ast.Property{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 28,
@@ -7749,10 +8637,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Line:   49,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Key: &ast.Identifier{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 25,
@@ -7765,11 +8655,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										Line:   49,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Name: "tables",
 						},
 						Value: &ast.PipeLiteral{BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 28,
@@ -7782,6 +8674,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Line:   49,
 								},
 							},
+							TrailingComment: nil,
 						}},
 					}},
 				},
@@ -7789,7 +8682,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 			},
 		}, &ast.VariableAssignment{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 2,
@@ -7802,10 +8696,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   51,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 13,
@@ -7818,12 +8714,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   51,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "notification",
 			},
 			Init: &ast.ObjectExpression{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 2,
@@ -7836,10 +8734,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   51,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Properties: []*ast.Property{&ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 46,
@@ -7852,10 +8752,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   52,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 26,
@@ -7868,12 +8770,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Line:   52,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Name: "_notification_rule_id",
 					},
 					Value: &ast.StringLiteral{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 46,
@@ -7886,12 +8790,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Line:   52,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Value: "0000000000000001",
 					},
 				}, &ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 41,
@@ -7904,10 +8810,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   53,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 28,
@@ -7920,12 +8828,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Line:   53,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Name: "_notification_rule_name",
 					},
 					Value: &ast.StringLiteral{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 41,
@@ -7938,12 +8848,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Line:   53,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Value: "http-rule",
 					},
 				}, &ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 48,
@@ -7956,10 +8868,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   54,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 30,
@@ -7972,12 +8886,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Line:   54,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Name: "_notification_endpoint_id",
 					},
 					Value: &ast.StringLiteral{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 48,
@@ -7990,12 +8906,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Line:   54,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Value: "00000000000002",
 					},
 				}, &ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 49,
@@ -8008,10 +8926,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   55,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 32,
@@ -8024,12 +8944,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Line:   55,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Name: "_notification_endpoint_name",
 					},
 					Value: &ast.StringLiteral{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 49,
@@ -8042,6 +8964,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Line:   55,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Value: "http-endpoint",
 					},
@@ -8050,7 +8973,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 			},
 		}, &ast.VariableAssignment{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 6,
@@ -8063,10 +8987,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   59,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 9,
@@ -8079,12 +9005,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   59,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "t_notify",
 			},
 			Init: &ast.FunctionExpression{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 6,
@@ -8097,13 +9025,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   59,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Body: &ast.PipeExpression{
 					Argument: &ast.PipeExpression{
 						Argument: &ast.PipeExpression{
 							Argument: &ast.Identifier{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 31,
@@ -8116,11 +9046,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   59,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Name: "table",
 							},
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 25,
@@ -8133,11 +9065,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										Line:   59,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Call: &ast.CallExpression{
 								Arguments: []ast.Expression{&ast.ObjectExpression{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 24,
@@ -8150,10 +9084,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   60,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Properties: []*ast.Property{&ast.Property{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 24,
@@ -8166,10 +9102,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   60,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Key: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 19,
@@ -8182,13 +9120,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   60,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "start",
 										},
 										Value: &ast.UnaryExpression{
 											Argument: &ast.DurationLiteral{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 24,
@@ -8201,6 +9141,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   60,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Values: []ast.Duration{ast.Duration{
 													Magnitude: int64(1),
@@ -8208,7 +9149,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												}},
 											},
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 24,
@@ -8221,6 +9163,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   60,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Operator: 6,
 										},
@@ -8228,7 +9171,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									With: nil,
 								}},
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 25,
@@ -8241,10 +9185,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   60,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Callee: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 13,
@@ -8257,13 +9203,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   60,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "range",
 								},
 							},
 						},
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 25,
@@ -8276,11 +9224,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Line:   59,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Call: &ast.CallExpression{
 							Arguments: nil,
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 25,
@@ -8293,10 +9243,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										Line:   61,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Callee: &ast.MemberExpression{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 23,
@@ -8309,10 +9261,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   61,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Object: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 10,
@@ -8325,12 +9279,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   61,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "v1",
 								},
 								Property: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 23,
@@ -8343,6 +9299,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   61,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "fieldsAsCols",
 								},
@@ -8350,7 +9307,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						},
 					},
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 6,
@@ -8363,11 +9321,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   59,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Call: &ast.CallExpression{
 						Arguments: []ast.Expression{&ast.ObjectExpression{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 29,
@@ -8380,10 +9340,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										Line:   63,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Properties: []*ast.Property{&ast.Property{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 27,
@@ -8396,10 +9358,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   63,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Key: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 13,
@@ -8412,12 +9376,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   63,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "data",
 								},
 								Value: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 27,
@@ -8430,12 +9396,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   63,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "notification",
 								},
 							}, &ast.Property{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 29,
@@ -8448,10 +9416,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   64,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Key: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 17,
@@ -8464,13 +9434,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   64,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "endpoint",
 								},
 								Value: &ast.CallExpression{
 									Arguments: nil,
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 29,
@@ -8483,10 +9455,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   64,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Callee: &ast.Identifier{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 27,
@@ -8499,6 +9473,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   64,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Name: "endpoint",
 									},
@@ -8507,7 +9482,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							With: nil,
 						}},
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 6,
@@ -8520,10 +9496,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Line:   62,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Callee: &ast.MemberExpression{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 22,
@@ -8536,10 +9514,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										Line:   62,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Object: &ast.Identifier{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 15,
@@ -8552,12 +9532,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   62,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Name: "monitor",
 							},
 							Property: &ast.Identifier{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 22,
@@ -8570,6 +9552,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   62,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Name: "notify",
 							},
@@ -8578,7 +9561,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 				},
 				Params: []*ast.Property{&ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 21,
@@ -8591,10 +9575,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   59,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 18,
@@ -8607,11 +9593,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Line:   59,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Name: "table",
 					},
 					Value: &ast.PipeLiteral{BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 21,
@@ -8624,13 +9612,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   59,
 							},
 						},
+						TrailingComment: nil,
 					}},
 				}},
 			},
 		}, &ast.TestStatement{
 			Assignment: &ast.VariableAssignment{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 99,
@@ -8643,10 +9633,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   67,
 						},
 					},
+					TrailingComment: nil,
 				},
 				ID: &ast.Identifier{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 20,
@@ -8659,12 +9651,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   67,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Name: "monitor_notify",
 				},
 				Init: &ast.FunctionExpression{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 99,
@@ -8677,10 +9671,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   67,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Body: &ast.ParenExpression{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 99,
@@ -8693,10 +9689,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Line:   68,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Expression: &ast.ObjectExpression{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 98,
@@ -8709,10 +9707,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										Line:   68,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Properties: []*ast.Property{&ast.Property{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 46,
@@ -8725,10 +9725,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   68,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Key: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 12,
@@ -8741,13 +9743,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   68,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "input",
 								},
 								Value: &ast.CallExpression{
 									Arguments: []ast.Expression{&ast.ObjectExpression{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 45,
@@ -8760,10 +9764,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   68,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Properties: []*ast.Property{&ast.Property{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 45,
@@ -8776,10 +9782,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   68,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Key: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 37,
@@ -8792,12 +9800,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   68,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "csv",
 											},
 											Value: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 45,
@@ -8810,6 +9820,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   68,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "inData",
 											},
@@ -8817,7 +9828,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										With: nil,
 									}},
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 46,
@@ -8830,10 +9842,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   68,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Callee: &ast.MemberExpression{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 33,
@@ -8846,10 +9860,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   68,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Object: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 21,
@@ -8862,12 +9878,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   68,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "testing",
 										},
 										Property: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 33,
@@ -8880,6 +9898,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   68,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "loadStorage",
 										},
@@ -8887,7 +9906,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								},
 							}, &ast.Property{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 83,
@@ -8900,10 +9920,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   68,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Key: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 52,
@@ -8916,13 +9938,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   68,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "want",
 								},
 								Value: &ast.CallExpression{
 									Arguments: []ast.Expression{&ast.ObjectExpression{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 82,
@@ -8935,10 +9959,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   68,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Properties: []*ast.Property{&ast.Property{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 82,
@@ -8951,10 +9977,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   68,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Key: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 73,
@@ -8967,12 +9995,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   68,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "csv",
 											},
 											Value: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 82,
@@ -8985,6 +10015,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   68,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "outData",
 											},
@@ -8992,7 +10023,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										With: nil,
 									}},
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 83,
@@ -9005,10 +10037,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   68,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Callee: &ast.MemberExpression{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 69,
@@ -9021,10 +10055,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   68,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Object: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 61,
@@ -9037,12 +10073,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   68,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "testing",
 										},
 										Property: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 69,
@@ -9055,6 +10093,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   68,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "loadMem",
 										},
@@ -9062,7 +10101,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								},
 							}, &ast.Property{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 97,
@@ -9075,10 +10115,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   68,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Key: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 87,
@@ -9091,12 +10133,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   68,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "fn",
 								},
 								Value: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 97,
@@ -9109,6 +10153,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   68,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "t_notify",
 								},
@@ -9120,7 +10165,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 				},
 			},
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 99,
@@ -9133,12 +10179,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   67,
 					},
 				},
+				TrailingComment: nil,
 			},
 		}},
+		Eof: nil,
 		Imports: []*ast.ImportDeclaration{&ast.ImportDeclaration{
 			As: nil,
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 37,
@@ -9151,10 +10200,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   3,
 					},
 				},
+				TrailingComment: nil,
 			},
 			Path: &ast.StringLiteral{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 37,
@@ -9167,13 +10218,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   3,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Value: "influxdata/influxdb/monitor",
 			},
 		}, &ast.ImportDeclaration{
 			As: nil,
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 32,
@@ -9186,10 +10239,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   4,
 					},
 				},
+				TrailingComment: nil,
 			},
 			Path: &ast.StringLiteral{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 32,
@@ -9202,13 +10257,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   4,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Value: "influxdata/influxdb/v1",
 			},
 		}, &ast.ImportDeclaration{
 			As: nil,
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 17,
@@ -9221,10 +10278,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   5,
 					},
 				},
+				TrailingComment: nil,
 			},
 			Path: &ast.StringLiteral{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 17,
@@ -9237,13 +10296,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   5,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Value: "testing",
 			},
 		}, &ast.ImportDeclaration{
 			As: nil,
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 22,
@@ -9256,10 +10317,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   6,
 					},
 				},
+				TrailingComment: nil,
 			},
 			Path: &ast.StringLiteral{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 22,
@@ -9272,6 +10335,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   6,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Value: "experimental",
 			},
@@ -9280,7 +10344,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 		Name:     "notify_test.flux",
 		Package: &ast.PackageClause{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 21,
@@ -9293,10 +10358,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   1,
 					},
 				},
+				TrailingComment: nil,
 			},
 			Name: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 21,
@@ -9309,13 +10376,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   1,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "monitor_test",
 			},
 		},
 	}, &ast.File{
 		BaseNode: ast.BaseNode{
-			Errors: nil,
+			Comments: nil,
+			Errors:   nil,
 			Loc: &ast.SourceLocation{
 				End: ast.Position{
 					Column: 106,
@@ -9328,11 +10397,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 					Line:   1,
 				},
 			},
+			TrailingComment: nil,
 		},
 		Body: []ast.Statement{&ast.OptionStatement{
 			Assignment: &ast.VariableAssignment{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 40,
@@ -9345,10 +10416,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   8,
 						},
 					},
+					TrailingComment: nil,
 				},
 				ID: &ast.Identifier{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 11,
@@ -9361,12 +10434,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   8,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Name: "now",
 				},
 				Init: &ast.FunctionExpression{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 40,
@@ -9379,10 +10454,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   8,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Body: &ast.DateTimeLiteral{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 40,
@@ -9395,6 +10472,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Line:   8,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Value: parser.MustParseTime("2018-05-22T19:54:40Z"),
 					},
@@ -9402,7 +10480,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 				},
 			},
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 40,
@@ -9415,11 +10494,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   8,
 					},
 				},
+				TrailingComment: nil,
 			},
 		}, &ast.OptionStatement{
 			Assignment: &ast.MemberAssignment{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 80,
@@ -9432,10 +10513,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   10,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Init: &ast.FunctionExpression{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 80,
@@ -9448,11 +10531,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   10,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Body: &ast.PipeExpression{
 						Argument: &ast.Identifier{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 43,
@@ -9465,11 +10550,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										Line:   10,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Name: "tables",
 						},
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 80,
@@ -9482,11 +10569,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Line:   10,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Call: &ast.CallExpression{
 							Arguments: []ast.Expression{&ast.ObjectExpression{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 79,
@@ -9499,10 +10588,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   10,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Properties: []*ast.Property{&ast.Property{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 79,
@@ -9515,10 +10606,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   10,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Key: &ast.Identifier{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 59,
@@ -9531,12 +10624,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   10,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Name: "columns",
 									},
 									Value: &ast.ArrayExpression{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 79,
@@ -9549,10 +10644,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   10,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Elements: []ast.Expression{&ast.StringLiteral{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 69,
@@ -9565,11 +10662,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   10,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Value: "_start",
 										}, &ast.StringLiteral{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 78,
@@ -9582,6 +10681,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   10,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Value: "_stop",
 										}},
@@ -9590,7 +10690,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								With: nil,
 							}},
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 80,
@@ -9603,10 +10704,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										Line:   10,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Callee: &ast.Identifier{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 51,
@@ -9619,6 +10722,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   10,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Name: "drop",
 							},
@@ -9626,7 +10730,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 					},
 					Params: []*ast.Property{&ast.Property{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 32,
@@ -9639,10 +10744,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Line:   10,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Key: &ast.Identifier{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 29,
@@ -9655,11 +10762,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										Line:   10,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Name: "tables",
 						},
 						Value: &ast.PipeLiteral{BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 32,
@@ -9672,12 +10781,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Line:   10,
 								},
 							},
+							TrailingComment: nil,
 						}},
 					}},
 				},
 				Member: &ast.MemberExpression{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 19,
@@ -9690,10 +10801,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   10,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Object: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 15,
@@ -9706,12 +10819,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Line:   10,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Name: "monitor",
 					},
 					Property: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 19,
@@ -9724,13 +10839,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Line:   10,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Name: "log",
 					},
 				},
 			},
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 80,
@@ -9743,10 +10860,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   10,
 					},
 				},
+				TrailingComment: nil,
 			},
 		}, &ast.VariableAssignment{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: []ast.Comment{ast.Comment{Text: "// Note this input data is identical to the output data of the check test case, post pivot."}},
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 2,
@@ -9759,10 +10878,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   13,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 7,
@@ -9775,12 +10896,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   13,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "inData",
 			},
 			Init: &ast.StringLiteral{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 2,
@@ -9793,12 +10916,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   13,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Value: "\n#datatype,string,long,string,string,string,string,string,dateTime:RFC3339,string,string,string,string,string,string,double\n#group,false,false,true,true,true,true,true,false,true,true,true,true,true,true,false\n#default,got,,,,,,,,,,,,,,\n,result,table,_check_id,_check_name,_level,_measurement,_source_measurement,_time,_type,aaa,bbb,cpu,host,_field,_value\n,,0,000000000000000a,cpu threshold check,crit,statuses,cpu,2018-05-22T19:54:20Z,threshold,vaaa,vbbb,cpu-total,host.local,usage_idle,4.800000000000001\n,,1,000000000000000a,cpu threshold check,ok,statuses,cpu,2018-05-22T19:54:21Z,threshold,vaaa,vbbb,cpu-total,host.local,usage_idle,90.62382797849732\n,,2,000000000000000a,cpu threshold check,warn,statuses,cpu,2018-05-22T19:54:22Z,threshold,vaaa,vbbb,cpu-total,host.local,usage_idle,7.05\n\n#datatype,string,long,string,string,string,string,string,dateTime:RFC3339,string,string,string,string,string,string,string\n#group,false,false,true,true,true,true,true,false,true,true,true,true,true,true,false\n#default,got,,,,,,,,,,,,,,\n,result,table,_check_id,_check_name,_level,_measurement,_source_measurement,_time,_type,aaa,bbb,cpu,host,_field,_value\n,,0,000000000000000a,cpu threshold check,crit,statuses,cpu,2018-05-22T19:54:20Z,threshold,vaaa,vbbb,cpu-total,host.local,_message,whoa!\n,,1,000000000000000a,cpu threshold check,ok,statuses,cpu,2018-05-22T19:54:21Z,threshold,vaaa,vbbb,cpu-total,host.local,_message,whoa!\n,,2,000000000000000a,cpu threshold check,warn,statuses,cpu,2018-05-22T19:54:22Z,threshold,vaaa,vbbb,cpu-total,host.local,_message,whoa!\n\n#datatype,string,long,string,string,string,string,string,dateTime:RFC3339,string,string,string,string,string,string,long\n#group,false,false,true,true,true,true,true,false,true,true,true,true,true,true,false\n#default,got,,,,,,,,,,,,,,\n,result,table,_check_id,_check_name,_level,_measurement,_source_measurement,_time,_type,aaa,bbb,cpu,host,_field,_value\n,,0,000000000000000a,cpu threshold check,crit,statuses,cpu,2018-05-22T19:54:20Z,threshold,vaaa,vbbb,cpu-total,host.local,_source_timestamp,1527018840000000000\n,,1,000000000000000a,cpu threshold check,ok,statuses,cpu,2018-05-22T19:54:21Z,threshold,vaaa,vbbb,cpu-total,host.local,_source_timestamp,1527018820000000000\n,,2,000000000000000a,cpu threshold check,warn,statuses,cpu,2018-05-22T19:54:22Z,threshold,vaaa,vbbb,cpu-total,host.local,_source_timestamp,1527018860000000000\n",
 			},
 		}, &ast.VariableAssignment{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 2,
@@ -9811,10 +10936,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   39,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 8,
@@ -9827,12 +10954,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   39,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "outData",
 			},
 			Init: &ast.StringLiteral{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 2,
@@ -9845,12 +10974,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   39,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Value: "\n#datatype,string,long,string,string,string,string,string,string,long,dateTime:RFC3339,string,string,string,string,string,double\n#group,false,false,true,true,true,true,false,true,false,false,true,true,true,true,true,false\n#default,got,,,,,,,,,,,,,,,\n,result,table,_check_id,_check_name,_level,_measurement,_message,_source_measurement,_source_timestamp,_time,_type,aaa,bbb,cpu,host,usage_idle\n,,2,000000000000000a,cpu threshold check,warn,statuses,whoa!,cpu,1527018860000000000,2018-05-22T19:54:22Z,threshold,vaaa,vbbb,cpu-total,host.local,7.05\n",
 			},
 		}, &ast.VariableAssignment{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 41,
@@ -9863,10 +10994,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   47,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 16,
@@ -9879,12 +11012,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   47,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "t_state_changes",
 			},
 			Init: &ast.FunctionExpression{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 41,
@@ -9897,6 +11032,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   47,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Body: &ast.PipeExpression{
 					Argument: &ast.PipeExpression{
@@ -9904,7 +11040,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Argument: &ast.PipeExpression{
 								Argument: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 38,
@@ -9917,11 +11054,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   47,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "table",
 								},
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 25,
@@ -9934,11 +11073,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   47,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Call: &ast.CallExpression{
 									Arguments: []ast.Expression{&ast.ObjectExpression{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 24,
@@ -9951,10 +11092,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   48,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Properties: []*ast.Property{&ast.Property{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 24,
@@ -9967,10 +11110,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   48,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Key: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 19,
@@ -9983,13 +11128,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   48,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "start",
 											},
 											Value: &ast.UnaryExpression{
 												Argument: &ast.DurationLiteral{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 24,
@@ -10002,6 +11149,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																Line:   48,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Values: []ast.Duration{ast.Duration{
 														Magnitude: int64(1),
@@ -10009,7 +11157,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													}},
 												},
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 24,
@@ -10022,6 +11171,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   48,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Operator: 6,
 											},
@@ -10029,7 +11179,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										With: nil,
 									}},
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 25,
@@ -10042,10 +11193,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   48,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Callee: &ast.Identifier{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 13,
@@ -10058,13 +11211,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   48,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Name: "range",
 									},
 								},
 							},
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 25,
@@ -10077,11 +11232,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										Line:   47,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Call: &ast.CallExpression{
 								Arguments: nil,
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 25,
@@ -10094,10 +11251,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   49,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Callee: &ast.MemberExpression{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 23,
@@ -10110,10 +11269,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   49,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Object: &ast.Identifier{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 10,
@@ -10126,12 +11287,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   49,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Name: "v1",
 									},
 									Property: &ast.Identifier{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 23,
@@ -10144,6 +11307,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   49,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Name: "fieldsAsCols",
 									},
@@ -10151,7 +11315,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							},
 						},
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 6,
@@ -10164,11 +11329,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Line:   47,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Call: &ast.CallExpression{
 							Arguments: []ast.Expression{&ast.ObjectExpression{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 24,
@@ -10181,10 +11348,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   51,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Properties: []*ast.Property{&ast.Property{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 24,
@@ -10197,10 +11366,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   51,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Key: &ast.Identifier{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 16,
@@ -10213,12 +11384,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   51,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Name: "toLevel",
 									},
 									Value: &ast.StringLiteral{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 24,
@@ -10231,6 +11404,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   51,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Value: "warn",
 									},
@@ -10238,7 +11412,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								With: nil,
 							}},
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 6,
@@ -10251,10 +11426,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										Line:   50,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Callee: &ast.MemberExpression{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 28,
@@ -10267,10 +11444,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   50,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Object: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 15,
@@ -10283,12 +11462,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   50,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "monitor",
 								},
 								Property: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 28,
@@ -10301,6 +11482,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   50,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "stateChanges",
 								},
@@ -10308,7 +11490,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						},
 					},
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 41,
@@ -10321,11 +11504,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   47,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Call: &ast.CallExpression{
 						Arguments: []ast.Expression{&ast.ObjectExpression{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 40,
@@ -10338,10 +11523,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										Line:   53,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Properties: []*ast.Property{&ast.Property{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 40,
@@ -10354,10 +11541,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   53,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Key: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 20,
@@ -10370,12 +11559,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   53,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "columns",
 								},
 								Value: &ast.ArrayExpression{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 40,
@@ -10388,10 +11579,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   53,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Elements: []ast.Expression{&ast.StringLiteral{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 31,
@@ -10404,11 +11597,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   53,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Value: "_start",
 									}, &ast.StringLiteral{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 39,
@@ -10421,6 +11616,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   53,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Value: "_stop",
 									}},
@@ -10429,7 +11625,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							With: nil,
 						}},
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 41,
@@ -10442,10 +11639,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Line:   53,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Callee: &ast.Identifier{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 12,
@@ -10458,6 +11657,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										Line:   53,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Name: "drop",
 						},
@@ -10465,7 +11665,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 				},
 				Params: []*ast.Property{&ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 28,
@@ -10478,10 +11679,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   47,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 25,
@@ -10494,11 +11697,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Line:   47,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Name: "table",
 					},
 					Value: &ast.PipeLiteral{BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 28,
@@ -10511,13 +11716,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   47,
 							},
 						},
+						TrailingComment: nil,
 					}},
 				}},
 			},
 		}, &ast.TestStatement{
 			Assignment: &ast.VariableAssignment{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 106,
@@ -10530,10 +11737,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   55,
 						},
 					},
+					TrailingComment: nil,
 				},
 				ID: &ast.Identifier{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 27,
@@ -10546,12 +11755,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   55,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Name: "monitor_state_changes",
 				},
 				Init: &ast.FunctionExpression{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 106,
@@ -10564,10 +11775,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   55,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Body: &ast.ParenExpression{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 106,
@@ -10580,10 +11793,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Line:   56,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Expression: &ast.ObjectExpression{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 105,
@@ -10596,10 +11811,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										Line:   56,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Properties: []*ast.Property{&ast.Property{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 46,
@@ -10612,10 +11829,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   56,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Key: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 12,
@@ -10628,13 +11847,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   56,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "input",
 								},
 								Value: &ast.CallExpression{
 									Arguments: []ast.Expression{&ast.ObjectExpression{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 45,
@@ -10647,10 +11868,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   56,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Properties: []*ast.Property{&ast.Property{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 45,
@@ -10663,10 +11886,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   56,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Key: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 37,
@@ -10679,12 +11904,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   56,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "csv",
 											},
 											Value: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 45,
@@ -10697,6 +11924,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   56,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "inData",
 											},
@@ -10704,7 +11932,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										With: nil,
 									}},
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 46,
@@ -10717,10 +11946,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   56,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Callee: &ast.MemberExpression{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 33,
@@ -10733,10 +11964,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   56,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Object: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 21,
@@ -10749,12 +11982,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   56,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "testing",
 										},
 										Property: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 33,
@@ -10767,6 +12002,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   56,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "loadStorage",
 										},
@@ -10774,7 +12010,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								},
 							}, &ast.Property{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 83,
@@ -10787,10 +12024,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   56,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Key: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 52,
@@ -10803,13 +12042,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   56,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "want",
 								},
 								Value: &ast.CallExpression{
 									Arguments: []ast.Expression{&ast.ObjectExpression{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 82,
@@ -10822,10 +12063,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   56,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Properties: []*ast.Property{&ast.Property{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 82,
@@ -10838,10 +12081,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   56,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Key: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 73,
@@ -10854,12 +12099,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   56,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "csv",
 											},
 											Value: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 82,
@@ -10872,6 +12119,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   56,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "outData",
 											},
@@ -10879,7 +12127,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										With: nil,
 									}},
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 83,
@@ -10892,10 +12141,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   56,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Callee: &ast.MemberExpression{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 69,
@@ -10908,10 +12159,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   56,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Object: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 61,
@@ -10924,12 +12177,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   56,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "testing",
 										},
 										Property: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 69,
@@ -10942,6 +12197,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   56,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "loadMem",
 										},
@@ -10949,7 +12205,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								},
 							}, &ast.Property{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 104,
@@ -10962,10 +12219,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   56,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Key: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 87,
@@ -10978,12 +12237,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   56,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "fn",
 								},
 								Value: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 104,
@@ -10996,6 +12257,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   56,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "t_state_changes",
 								},
@@ -11007,7 +12269,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 				},
 			},
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 106,
@@ -11020,12 +12283,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   55,
 					},
 				},
+				TrailingComment: nil,
 			},
 		}},
+		Eof: nil,
 		Imports: []*ast.ImportDeclaration{&ast.ImportDeclaration{
 			As: nil,
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 37,
@@ -11038,10 +12304,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   3,
 					},
 				},
+				TrailingComment: nil,
 			},
 			Path: &ast.StringLiteral{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 37,
@@ -11054,13 +12322,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   3,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Value: "influxdata/influxdb/monitor",
 			},
 		}, &ast.ImportDeclaration{
 			As: nil,
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 32,
@@ -11073,10 +12343,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   4,
 					},
 				},
+				TrailingComment: nil,
 			},
 			Path: &ast.StringLiteral{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 32,
@@ -11089,13 +12361,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   4,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Value: "influxdata/influxdb/v1",
 			},
 		}, &ast.ImportDeclaration{
 			As: nil,
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 17,
@@ -11108,10 +12382,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   5,
 					},
 				},
+				TrailingComment: nil,
 			},
 			Path: &ast.StringLiteral{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 17,
@@ -11124,13 +12400,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   5,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Value: "testing",
 			},
 		}, &ast.ImportDeclaration{
 			As: nil,
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 22,
@@ -11143,10 +12421,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   6,
 					},
 				},
+				TrailingComment: nil,
 			},
 			Path: &ast.StringLiteral{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 22,
@@ -11159,6 +12439,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   6,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Value: "experimental",
 			},
@@ -11167,7 +12448,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 		Name:     "state_changes_test.flux",
 		Package: &ast.PackageClause{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 21,
@@ -11180,10 +12462,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   1,
 					},
 				},
+				TrailingComment: nil,
 			},
 			Name: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 21,
@@ -11196,6 +12480,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   1,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "monitor_test",
 			},
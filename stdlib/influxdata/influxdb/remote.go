@@ -0,0 +1,246 @@
+package influxdb
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/ast"
+	"github.com/influxdata/flux/codes"
+	"github.com/influxdata/flux/csv"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/internal/errors"
+	"github.com/influxdata/flux/memory"
+	"github.com/influxdata/flux/plan"
+	"github.com/influxdata/flux/stdlib/universe"
+)
+
+// RemoteFromKind is the kind for a from that reads
+// from a remote host using its HTTP API.
+const RemoteFromKind = "influxdata/influxdb.remoteFrom"
+
+// RemoteFromProcedureSpec is the physical procedure spec for a from
+// that has a host. It is kept separate from the from procedure spec
+// so implementors of the real from may still register a source for it.
+type RemoteFromProcedureSpec struct {
+	plan.DefaultCost
+	*FromProcedureSpec
+
+	// Range is the range that has been pushed down
+	// into the query sent to the remote host.
+	// It is set by the MergeRemoteRangeRule.
+	Range *universe.RangeProcedureSpec
+}
+
+func (s *RemoteFromProcedureSpec) Kind() plan.ProcedureKind {
+	return RemoteFromKind
+}
+
+func (s *RemoteFromProcedureSpec) Copy() plan.ProcedureSpec {
+	ns := new(RemoteFromProcedureSpec)
+	ns.FromProcedureSpec = s.FromProcedureSpec.Copy().(*FromProcedureSpec)
+	if s.Range != nil {
+		ns.Range = s.Range.Copy().(*universe.RangeProcedureSpec)
+	}
+	return ns
+}
+
+// TimeBounds implements plan.BoundsAwareProcedureSpec.
+func (s *RemoteFromProcedureSpec) TimeBounds(predecessorBounds *plan.Bounds) *plan.Bounds {
+	if s.Range == nil {
+		return predecessorBounds
+	}
+	return s.Range.TimeBounds(predecessorBounds)
+}
+
+// PostPhysicalValidate implements plan.PostPhysicalValidator.
+func (s *RemoteFromProcedureSpec) PostPhysicalValidate(id plan.NodeID) error {
+	if s.Range == nil {
+		return errors.Newf(codes.Invalid, "cannot submit unbounded read to %q; try bounding 'from' with a call to 'range'", s.Host)
+	}
+	return nil
+}
+
+// FromRemoteRule converts a from that has a host into a remote from.
+type FromRemoteRule struct{}
+
+func (FromRemoteRule) Name() string {
+	return "FromRemoteRule"
+}
+
+func (FromRemoteRule) Pattern() plan.Pattern {
+	return plan.Pat(FromKind)
+}
+
+func (FromRemoteRule) Rewrite(node plan.Node) (plan.Node, bool, error) {
+	spec := node.ProcedureSpec().(*FromProcedureSpec)
+	if !spec.IsRemote() {
+		return node, false, nil
+	}
+	if err := node.ReplaceSpec(&RemoteFromProcedureSpec{
+		FromProcedureSpec: spec.Copy().(*FromProcedureSpec),
+	}); err != nil {
+		return nil, false, err
+	}
+	return node, true, nil
+}
+
+// MergeRemoteRangeRule pushes a range into a remote from
+// so the range is part of the query sent to the remote host.
+type MergeRemoteRangeRule struct{}
+
+func (MergeRemoteRangeRule) Name() string {
+	return "MergeRemoteRangeRule"
+}
+
+func (MergeRemoteRangeRule) Pattern() plan.Pattern {
+	return plan.Pat(universe.RangeKind, plan.Pat(RemoteFromKind))
+}
+
+func (MergeRemoteRangeRule) Rewrite(node plan.Node) (plan.Node, bool, error) {
+	fromNode := node.Predecessors()[0]
+	fromSpec := fromNode.ProcedureSpec().(*RemoteFromProcedureSpec)
+	if fromSpec.Range != nil {
+		return node, false, nil
+	}
+
+	mergedSpec := fromSpec.Copy().(*RemoteFromProcedureSpec)
+	mergedSpec.Range = node.ProcedureSpec().Copy().(*universe.RangeProcedureSpec)
+	mergedNode, err := plan.MergeToPhysicalNode(node, fromNode, mergedSpec)
+	if err != nil {
+		return nil, false, err
+	}
+	return mergedNode, true, nil
+}
+
+func createRemoteFromSource(prSpec plan.ProcedureSpec, dsid execute.DatasetID, a execute.Administration) (execute.Source, error) {
+	spec, ok := prSpec.(*RemoteFromProcedureSpec)
+	if !ok {
+		return nil, errors.Newf(codes.Internal, "invalid spec type %T", prSpec)
+	}
+
+	iterator := &remoteIterator{spec: spec, alloc: a.Allocator()}
+	return execute.CreateSourceFromIterator(iterator, dsid)
+}
+
+var _ execute.SourceIterator = (*remoteIterator)(nil)
+
+// remoteIterator queries a remote InfluxDB with the query endpoint
+// and decodes the annotated csv response into tables.
+type remoteIterator struct {
+	spec  *RemoteFromProcedureSpec
+	alloc *memory.Allocator
+}
+
+func (s *remoteIterator) Do(ctx context.Context, f func(flux.Table) error) error {
+	body, err := json.Marshal(s.newQueryRequest())
+	if err != nil {
+		return err
+	}
+	req, err := newRemoteRequest(ctx, http.MethodPost, s.spec.Host, "/api/v2/query",
+		orgParams(s.spec.Org, s.spec.OrgID), s.spec.Token, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/csv")
+
+	resp, err := doRemoteRequest(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	decoder := csv.NewMultiResultDecoder(csv.ResultDecoderConfig{Allocator: s.alloc})
+	results, err := decoder.Decode(resp.Body)
+	if err != nil {
+		_ = resp.Body.Close()
+		return err
+	}
+	defer results.Release()
+
+	for results.More() {
+		if err := results.Next().Tables().Do(f); err != nil {
+			return err
+		}
+	}
+	return results.Err()
+}
+
+// queryRequest is the body of a request to the query endpoint.
+type queryRequest struct {
+	Query   string       `json:"query"`
+	Type    string       `json:"type"`
+	Dialect queryDialect `json:"dialect"`
+}
+
+type queryDialect struct {
+	Header      bool     `json:"header"`
+	Delimiter   string   `json:"delimiter"`
+	Annotations []string `json:"annotations"`
+}
+
+func (s *remoteIterator) newQueryRequest() queryRequest {
+	return queryRequest{
+		Query: ast.Format(s.remoteQuery()),
+		Type:  "flux",
+		Dialect: queryDialect{
+			Header:      true,
+			Delimiter:   ",",
+			Annotations: []string{"datatype", "group", "default"},
+		},
+	}
+}
+
+// remoteQuery constructs the query that is sent to the remote host.
+// The range is converted to absolute times so the remote host
+// reads the same time range regardless of its own clock.
+func (s *remoteIterator) remoteQuery() ast.Expression {
+	fromArgs := make([]*ast.Property, 0, 1)
+	if s.spec.BucketID != "" {
+		fromArgs = append(fromArgs, stringProperty("bucketID", s.spec.BucketID))
+	} else {
+		fromArgs = append(fromArgs, stringProperty("bucket", s.spec.Bucket))
+	}
+
+	r := s.spec.Range
+	rangeArgs := []*ast.Property{
+		{
+			Key:   &ast.Identifier{Name: "start"},
+			Value: &ast.DateTimeLiteral{Value: r.Bounds.Start.Time(r.Bounds.Now).UTC()},
+		},
+		{
+			Key:   &ast.Identifier{Name: "stop"},
+			Value: &ast.DateTimeLiteral{Value: r.Bounds.Stop.Time(r.Bounds.Now).UTC()},
+		},
+	}
+	if r.TimeColumn != "" && r.TimeColumn != execute.DefaultTimeColLabel {
+		rangeArgs = append(rangeArgs, stringProperty("timeColumn", r.TimeColumn))
+	}
+	if r.StartColumn != "" && r.StartColumn != execute.DefaultStartColLabel {
+		rangeArgs = append(rangeArgs, stringProperty("startColumn", r.StartColumn))
+	}
+	if r.StopColumn != "" && r.StopColumn != execute.DefaultStopColLabel {
+		rangeArgs = append(rangeArgs, stringProperty("stopColumn", r.StopColumn))
+	}
+
+	return &ast.PipeExpression{
+		Argument: callExpression("from", fromArgs),
+		Call:     callExpression("range", rangeArgs),
+	}
+}
+
+func callExpression(name string, properties []*ast.Property) *ast.CallExpression {
+	return &ast.CallExpression{
+		Callee:    &ast.Identifier{Name: name},
+		Arguments: []ast.Expression{&ast.ObjectExpression{Properties: properties}},
+	}
+}
+
+func stringProperty(key, value string) *ast.Property {
+	return &ast.Property{
+		Key:   &ast.Identifier{Name: key},
+		Value: &ast.StringLiteral{Value: value},
+	}
+}
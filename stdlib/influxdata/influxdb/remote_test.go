@@ -0,0 +1,231 @@
+package influxdb_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/codes"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/execute/executetest"
+	"github.com/influxdata/flux/internal/errors"
+	"github.com/influxdata/flux/lang/langtest"
+)
+
+// runQuery executes the script and returns the tables for every result.
+func runQuery(t *testing.T, script string) ([]*executetest.Table, error) {
+	t.Helper()
+	ctx := flux.NewDefaultDependencies().Inject(context.Background())
+	tables, _, err := langtest.RunScript(ctx, script, time.Date(2019, 11, 1, 0, 0, 0, 0, time.UTC))
+	return tables, err
+}
+
+func TestFrom_Remote(t *testing.T) {
+	var (
+		path, org, auth string
+		query           struct {
+			Query string `json:"query"`
+			Type  string `json:"type"`
+		}
+	)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path, org, auth = r.URL.Path, r.URL.Query().Get("org"), r.Header.Get("Authorization")
+		if err := json.NewDecoder(r.Body).Decode(&query); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.Header().Set("Content-Type", "text/csv")
+		_, _ = w.Write([]byte(`#datatype,string,long,dateTime:RFC3339,dateTime:RFC3339,dateTime:RFC3339,string,string,double
+#group,false,false,true,true,false,true,true,false
+#default,_result,,,,,,,
+,result,table,_start,_stop,_time,_measurement,_field,_value
+,,0,2019-10-31T23:00:00Z,2019-11-01T00:00:00Z,2019-10-31T23:10:00Z,cpu,usage_user,1.5
+,,0,2019-10-31T23:00:00Z,2019-11-01T00:00:00Z,2019-10-31T23:20:00Z,cpu,usage_user,2.5
+`))
+	}))
+	defer ts.Close()
+
+	script := fmt.Sprintf(`
+import "influxdata/influxdb"
+
+influxdb.from(bucket: "telegraf", org: "influxdata", host: %q, token: "mytoken")
+	|> range(start: -1h)
+`, ts.URL)
+	got, err := runQuery(t, script)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if want := "/api/v2/query"; path != want {
+		t.Errorf("unexpected path -want/+got:\n\t- %s\n\t+ %s", want, path)
+	}
+	if want := "influxdata"; org != want {
+		t.Errorf("unexpected org -want/+got:\n\t- %s\n\t+ %s", want, org)
+	}
+	if want := "Token mytoken"; auth != want {
+		t.Errorf("unexpected authorization -want/+got:\n\t- %s\n\t+ %s", want, auth)
+	}
+	if want := "from(bucket: \"telegraf\")\n\t|> range(start: 2019-10-31T23:00:00Z, stop: 2019-11-01T00:00:00Z)"; query.Query != want {
+		t.Errorf("unexpected query -want/+got:\n\t- %s\n\t+ %s", want, query.Query)
+	}
+
+	want := []*executetest.Table{{
+		KeyCols: []string{"_start", "_stop", "_measurement", "_field"},
+		ColMeta: []flux.ColMeta{
+			{Label: "_start", Type: flux.TTime},
+			{Label: "_stop", Type: flux.TTime},
+			{Label: "_time", Type: flux.TTime},
+			{Label: "_measurement", Type: flux.TString},
+			{Label: "_field", Type: flux.TString},
+			{Label: "_value", Type: flux.TFloat},
+		},
+		Data: [][]interface{}{
+			{mustParseTime("2019-10-31T23:00:00Z"), mustParseTime("2019-11-01T00:00:00Z"), mustParseTime("2019-10-31T23:10:00Z"), "cpu", "usage_user", 1.5},
+			{mustParseTime("2019-10-31T23:00:00Z"), mustParseTime("2019-11-01T00:00:00Z"), mustParseTime("2019-10-31T23:20:00Z"), "cpu", "usage_user", 2.5},
+		},
+	}}
+	executetest.NormalizeTables(want)
+	if !cmp.Equal(want, got) {
+		t.Errorf("unexpected tables -want/+got:\n%s", cmp.Diff(want, got))
+	}
+}
+
+func TestFrom_RemoteError(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		_, _ = w.Write([]byte(`{"code":"unauthorized","message":"unauthorized access"}`))
+	}))
+	defer ts.Close()
+
+	script := fmt.Sprintf(`
+import "influxdata/influxdb"
+
+influxdb.from(bucket: "telegraf", org: "influxdata", host: %q)
+	|> range(start: -1h)
+`, ts.URL)
+	_, err := runQuery(t, script)
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	if want, got := codes.Unauthenticated, errors.Code(err); want != got {
+		t.Errorf("unexpected error code -want/+got:\n\t- %s\n\t+ %s", want, got)
+	}
+	if !strings.Contains(err.Error(), "unauthorized access") {
+		t.Errorf("unexpected error: %s", err)
+	}
+}
+
+func TestFrom_RemoteRequiresRange(t *testing.T) {
+	script := `
+import "influxdata/influxdb"
+
+influxdb.from(bucket: "telegraf", org: "influxdata", host: "http://localhost:9999")
+`
+	_, err := runQuery(t, script)
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	if want, got := codes.Invalid, errors.Code(err); want != got {
+		t.Errorf("unexpected error code -want/+got:\n\t- %s\n\t+ %s", want, got)
+	}
+}
+
+func TestTo_Remote(t *testing.T) {
+	var (
+		path, bucket, org, auth string
+		body                    []byte
+	)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path, auth = r.URL.Path, r.Header.Get("Authorization")
+		bucket, org = r.URL.Query().Get("bucket"), r.URL.Query().Get("orgID")
+		var err error
+		if body, err = ioutil.ReadAll(r.Body); err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer ts.Close()
+
+	testCases := []struct {
+		name  string
+		args  string
+		want  string
+		input string
+	}{
+		{
+			name: "default columns",
+			input: `
+#datatype,string,long,dateTime:RFC3339,string,string,string,double
+#group,false,false,false,true,true,true,false
+#default,_result,,,,,,
+,result,table,_time,_measurement,_field,host,_value
+,,0,2019-10-31T23:10:00Z,cpu,usage_user,a,1.5
+,,0,2019-10-31T23:20:00Z,cpu,usage_user,a,2.5
+`,
+			want: "cpu,host=a usage_user=1.5 1572563400000000000\n" +
+				"cpu,host=a usage_user=2.5 1572564000000000000\n",
+		},
+		{
+			name: "field function",
+			args: `, tagColumns: ["host"], fieldFn: (r) => ({used: r.used, free: r.free})`,
+			input: `
+#datatype,string,long,dateTime:RFC3339,string,string,string,long,long
+#group,false,false,false,true,false,false,false,false
+#default,_result,,,,,,,
+,result,table,_time,_measurement,host,region,used,free
+,,0,2019-10-31T23:10:00Z,mem,a,west,10,20
+,,0,2019-10-31T23:20:00Z,mem,b,east,15,
+`,
+			want: "mem,host=a free=20i,used=10i 1572563400000000000\n" +
+				"mem,host=b used=15i 1572564000000000000\n",
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			script := fmt.Sprintf(`
+import "csv"
+import "influxdata/influxdb"
+
+csv.from(csv: %q)
+	|> influxdb.to(bucket: "telegraf", orgID: "0123456789abcdef", host: %q, token: "mytoken"%s)
+`, tc.input, ts.URL, tc.args)
+			if _, err := runQuery(t, script); err != nil {
+				t.Fatal(err)
+			}
+
+			if want := "/api/v2/write"; path != want {
+				t.Errorf("unexpected path -want/+got:\n\t- %s\n\t+ %s", want, path)
+			}
+			if want := "telegraf"; bucket != want {
+				t.Errorf("unexpected bucket -want/+got:\n\t- %s\n\t+ %s", want, bucket)
+			}
+			if want := "0123456789abcdef"; org != want {
+				t.Errorf("unexpected org -want/+got:\n\t- %s\n\t+ %s", want, org)
+			}
+			if want := "Token mytoken"; auth != want {
+				t.Errorf("unexpected authorization -want/+got:\n\t- %s\n\t+ %s", want, auth)
+			}
+			if got := string(body); got != tc.want {
+				t.Errorf("unexpected line protocol -want/+got:\n%s", cmp.Diff(tc.want, got))
+			}
+		})
+	}
+}
+
+func mustParseTime(s string) execute.Time {
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		panic(err)
+	}
+	return execute.Time(t.UnixNano())
+}
@@ -9,12 +9,15 @@ import (
 
 var FluxTestPackages = []*ast.Package{&ast.Package{
 	BaseNode: ast.BaseNode{
-		Errors: nil,
-		Loc:    nil,
+		Comments:        nil,
+		Errors:          nil,
+		Loc:             nil,
+		TrailingComment: nil,
 	},
 	Files: []*ast.File{&ast.File{
 		BaseNode: ast.BaseNode{
-			Errors: nil,
+			Comments: nil,
+			Errors:   nil,
 			Loc: &ast.SourceLocation{
 				End: ast.Position{
 					Column: 100,
@@ -27,11 +30,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 					Line:   1,
 				},
 			},
+			TrailingComment: nil,
 		},
 		Body: []ast.Statement{&ast.OptionStatement{
 			Assignment: &ast.VariableAssignment{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 42,
@@ -44,10 +49,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   6,
 						},
 					},
+					TrailingComment: nil,
 				},
 				ID: &ast.Identifier{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 11,
@@ -60,12 +67,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   6,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Name: "now",
 				},
 				Init: &ast.FunctionExpression{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 42,
@@ -78,10 +87,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   6,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Body: &ast.ParenExpression{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 42,
@@ -94,10 +105,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Line:   6,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Expression: &ast.DateTimeLiteral{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 41,
@@ -110,6 +123,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										Line:   6,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Value: parser.MustParseTime("2030-01-01T00:00:00Z"),
 						},
@@ -118,7 +132,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 				},
 			},
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 42,
@@ -131,10 +146,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   6,
 					},
 				},
+				TrailingComment: nil,
 			},
 		}, &ast.VariableAssignment{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 2,
@@ -147,10 +164,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   8,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 7,
@@ -163,12 +182,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   8,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "inData",
 			},
 			Init: &ast.StringLiteral{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 2,
@@ -181,12 +202,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   8,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Value: "\n#datatype,string,long,dateTime:RFC3339,double,string,string\n#group,false,false,false,false,true,true\n#default,_result,,,,,\n,result,table,_time,_value,_field,_measurement\n,,0,2018-05-22T19:53:26Z,1.83,load1,system\n",
 			},
 		}, &ast.VariableAssignment{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 2,
@@ -199,10 +222,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   16,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 8,
@@ -215,12 +240,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   16,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "outData",
 			},
 			Init: &ast.StringLiteral{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 2,
@@ -233,12 +260,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   16,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Value: "\n#datatype,string,long,dateTime:RFC3339,double,string,string,string\n#group,false,false,false,false,true,true,false\n#default,_result,,,,,,\n,result,table,_time,_value,_field,_measurement,token\n,,0,2018-05-22T19:53:26Z,1.83,load1,system,mysecrettoken\n",
 			},
 		}, &ast.VariableAssignment{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 34,
@@ -251,10 +280,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   24,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 6,
@@ -267,13 +298,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   24,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "token",
 			},
 			Init: &ast.CallExpression{
 				Arguments: []ast.Expression{&ast.ObjectExpression{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 33,
@@ -286,10 +319,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   24,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Properties: []*ast.Property{&ast.Property{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 33,
@@ -302,10 +337,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Line:   24,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Key: &ast.Identifier{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 24,
@@ -318,12 +355,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										Line:   24,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Name: "key",
 						},
 						Value: &ast.StringLiteral{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 33,
@@ -336,6 +375,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										Line:   24,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Value: "token",
 						},
@@ -343,7 +383,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 					With: nil,
 				}},
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 34,
@@ -356,10 +397,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   24,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Callee: &ast.MemberExpression{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 20,
@@ -372,10 +415,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   24,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Object: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 16,
@@ -388,12 +433,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Line:   24,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Name: "secrets",
 					},
 					Property: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 20,
@@ -406,6 +453,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Line:   24,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Name: "get",
 					},
@@ -413,7 +461,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 			},
 		}, &ast.VariableAssignment{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 39,
@@ -426,10 +475,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   25,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 13,
@@ -442,12 +493,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   25,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "t_get_secret",
 			},
 			Init: &ast.FunctionExpression{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 39,
@@ -460,11 +513,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   25,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Body: &ast.PipeExpression{
 					Argument: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 7,
@@ -477,11 +532,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Line:   26,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Name: "table",
 					},
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 39,
@@ -494,11 +551,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   26,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Call: &ast.CallExpression{
 						Arguments: []ast.Expression{&ast.ObjectExpression{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 38,
@@ -511,10 +570,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										Line:   27,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Properties: []*ast.Property{&ast.Property{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 24,
@@ -527,10 +588,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   27,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Key: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 15,
@@ -543,12 +606,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   27,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "key",
 								},
 								Value: &ast.StringLiteral{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 24,
@@ -561,12 +626,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   27,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Value: "token",
 								},
 							}, &ast.Property{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 38,
@@ -579,10 +646,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   27,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Key: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 31,
@@ -595,12 +664,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   27,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "value",
 								},
 								Value: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 38,
@@ -613,6 +684,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   27,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "token",
 								},
@@ -620,7 +692,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							With: nil,
 						}},
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 39,
@@ -633,10 +706,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Line:   27,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Callee: &ast.Identifier{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 11,
@@ -649,6 +724,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										Line:   27,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Name: "set",
 						},
@@ -656,7 +732,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 				},
 				Params: []*ast.Property{&ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 25,
@@ -669,10 +746,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   25,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 22,
@@ -685,11 +764,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Line:   25,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Name: "table",
 					},
 					Value: &ast.PipeLiteral{BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 25,
@@ -702,13 +783,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   25,
 							},
 						},
+						TrailingComment: nil,
 					}},
 				}},
 			},
 		}, &ast.TestStatement{
 			Assignment: &ast.VariableAssignment{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 100,
@@ -721,10 +804,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   29,
 						},
 					},
+					TrailingComment: nil,
 				},
 				ID: &ast.Identifier{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 17,
@@ -737,12 +822,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   29,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Name: "_get_secret",
 				},
 				Init: &ast.FunctionExpression{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 100,
@@ -755,10 +842,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   29,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Body: &ast.ParenExpression{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 100,
@@ -771,10 +860,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Line:   30,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Expression: &ast.ObjectExpression{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 99,
@@ -787,10 +878,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										Line:   30,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Properties: []*ast.Property{&ast.Property{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 43,
@@ -803,10 +896,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   30,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Key: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 9,
@@ -819,13 +914,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   30,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "input",
 								},
 								Value: &ast.CallExpression{
 									Arguments: []ast.Expression{&ast.ObjectExpression{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 42,
@@ -838,10 +935,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   30,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Properties: []*ast.Property{&ast.Property{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 42,
@@ -854,10 +953,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   30,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Key: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 34,
@@ -870,12 +971,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   30,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "csv",
 											},
 											Value: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 42,
@@ -888,6 +991,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   30,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "inData",
 											},
@@ -895,7 +999,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										With: nil,
 									}},
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 43,
@@ -908,10 +1013,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   30,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Callee: &ast.MemberExpression{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 30,
@@ -924,10 +1031,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   30,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Object: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 18,
@@ -940,12 +1049,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   30,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "testing",
 										},
 										Property: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 30,
@@ -958,6 +1069,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   30,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "loadStorage",
 										},
@@ -965,7 +1077,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								},
 							}, &ast.Property{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 80,
@@ -978,10 +1091,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   30,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Key: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 49,
@@ -994,13 +1109,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   30,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "want",
 								},
 								Value: &ast.CallExpression{
 									Arguments: []ast.Expression{&ast.ObjectExpression{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 79,
@@ -1013,10 +1130,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   30,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Properties: []*ast.Property{&ast.Property{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 79,
@@ -1029,10 +1148,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   30,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Key: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 70,
@@ -1045,12 +1166,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   30,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "csv",
 											},
 											Value: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 79,
@@ -1063,6 +1186,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   30,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "outData",
 											},
@@ -1070,7 +1194,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										With: nil,
 									}},
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 80,
@@ -1083,10 +1208,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   30,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Callee: &ast.MemberExpression{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 66,
@@ -1099,10 +1226,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   30,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Object: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 58,
@@ -1115,12 +1244,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   30,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "testing",
 										},
 										Property: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 66,
@@ -1133,6 +1264,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   30,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "loadMem",
 										},
@@ -1140,7 +1272,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								},
 							}, &ast.Property{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 98,
@@ -1153,10 +1286,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   30,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Key: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 84,
@@ -1169,12 +1304,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   30,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "fn",
 								},
 								Value: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 98,
@@ -1187,6 +1324,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   30,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "t_get_secret",
 								},
@@ -1198,7 +1336,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 				},
 			},
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 100,
@@ -1211,12 +1350,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   29,
 					},
 				},
+				TrailingComment: nil,
 			},
 		}},
+		Eof: nil,
 		Imports: []*ast.ImportDeclaration{&ast.ImportDeclaration{
 			As: nil,
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 17,
@@ -1229,10 +1371,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   3,
 					},
 				},
+				TrailingComment: nil,
 			},
 			Path: &ast.StringLiteral{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 17,
@@ -1245,13 +1389,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   3,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Value: "testing",
 			},
 		}, &ast.ImportDeclaration{
 			As: nil,
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 37,
@@ -1264,10 +1410,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   4,
 					},
 				},
+				TrailingComment: nil,
 			},
 			Path: &ast.StringLiteral{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 37,
@@ -1280,6 +1428,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   4,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Value: "influxdata/influxdb/secrets",
 			},
@@ -1288,7 +1437,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 		Name:     "secrets_test.flux",
 		Package: &ast.PackageClause{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 21,
@@ -1301,10 +1451,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   1,
 					},
 				},
+				TrailingComment: nil,
 			},
 			Name: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 21,
@@ -1317,6 +1469,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   1,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "secrets_test",
 			},
@@ -1,13 +1,31 @@
 package influxdb
 
 import (
+	"bytes"
+	"context"
+	"net/http"
+	"sort"
+	"time"
+
 	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/codes"
+	"github.com/influxdata/flux/compiler"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/internal/errors"
+	"github.com/influxdata/flux/interpreter"
+	"github.com/influxdata/flux/plan"
 	"github.com/influxdata/flux/semantic"
+	"github.com/influxdata/flux/values"
+	protocol "github.com/influxdata/line-protocol"
 )
 
 // ToKind is the kind for the `to` flux function
 const ToKind = "to"
 
+// writeBatchSize is the number of points written
+// to the remote host with a single request.
+const writeBatchSize = 5000
+
 var ToSignature = flux.FunctionSignature(
 	map[string]semantic.PolyType{
 		"bucket":            semantic.String,
@@ -18,7 +36,7 @@ var ToSignature = flux.FunctionSignature(
 		"token":             semantic.String,
 		"timeColumn":        semantic.String,
 		"measurementColumn": semantic.String,
-		"tagColumns":        semantic.Array,
+		"tagColumns":        semantic.NewArrayPolyType(semantic.String),
 		"fieldFn": semantic.NewFunctionPolyType(semantic.FunctionPolySignature{
 			Parameters: map[string]semantic.PolyType{
 				"r": semantic.Tvar(1),
@@ -31,5 +49,435 @@ var ToSignature = flux.FunctionSignature(
 )
 
 func init() {
-	flux.RegisterPackageValue("influxdata/influxdb", ToKind, flux.FunctionValueWithSideEffect(ToKind, nil, ToSignature))
+	flux.RegisterPackageValue("influxdata/influxdb", ToKind, flux.FunctionValueWithSideEffect(ToKind, createToOpSpec, ToSignature))
+	flux.RegisterOpSpec(ToKind, newToOp)
+	plan.RegisterProcedureSpecWithSideEffect(ToKind, newToProcedure, ToKind)
+	execute.RegisterTransformation(ToKind, createToTransformation)
+}
+
+type ToOpSpec struct {
+	Bucket            string                       `json:"bucket,omitempty"`
+	BucketID          string                       `json:"bucketID,omitempty"`
+	Org               string                       `json:"org,omitempty"`
+	OrgID             string                       `json:"orgID,omitempty"`
+	Host              string                       `json:"host,omitempty"`
+	Token             string                       `json:"token,omitempty"`
+	TimeColumn        string                       `json:"timeColumn"`
+	MeasurementColumn string                       `json:"measurementColumn"`
+	TagColumns        []string                     `json:"tagColumns,omitempty"`
+	FieldFn           interpreter.ResolvedFunction `json:"fieldFn"`
+}
+
+func createToOpSpec(args flux.Arguments, a *flux.Administration) (flux.OperationSpec, error) {
+	if err := a.AddParentFromArgs(args); err != nil {
+		return nil, err
+	}
+
+	spec := new(ToOpSpec)
+	if v, ok, err := args.GetString("bucket"); err != nil {
+		return nil, err
+	} else if ok {
+		spec.Bucket = v
+	}
+	if v, ok, err := args.GetString("bucketID"); err != nil {
+		return nil, err
+	} else if ok {
+		spec.BucketID = v
+	}
+	if v, ok, err := args.GetString("org"); err != nil {
+		return nil, err
+	} else if ok {
+		spec.Org = v
+	}
+	if v, ok, err := args.GetString("orgID"); err != nil {
+		return nil, err
+	} else if ok {
+		spec.OrgID = v
+	}
+	if v, ok, err := args.GetString("host"); err != nil {
+		return nil, err
+	} else if ok {
+		spec.Host = v
+	}
+	if v, ok, err := args.GetString("token"); err != nil {
+		return nil, err
+	} else if ok {
+		spec.Token = v
+	}
+
+	if v, ok, err := args.GetString("timeColumn"); err != nil {
+		return nil, err
+	} else if ok {
+		spec.TimeColumn = v
+	} else {
+		spec.TimeColumn = execute.DefaultTimeColLabel
+	}
+	if v, ok, err := args.GetString("measurementColumn"); err != nil {
+		return nil, err
+	} else if ok {
+		spec.MeasurementColumn = v
+	} else {
+		spec.MeasurementColumn = defaultMeasurementColLabel
+	}
+
+	if tagColumns, ok, err := args.GetArray("tagColumns", semantic.String); err != nil {
+		return nil, err
+	} else if ok {
+		spec.TagColumns = make([]string, tagColumns.Len())
+		tagColumns.Range(func(i int, v values.Value) {
+			spec.TagColumns[i] = v.Str()
+		})
+		sort.Strings(spec.TagColumns)
+	}
+
+	if f, ok, err := args.GetFunction("fieldFn"); err != nil {
+		return nil, err
+	} else if ok {
+		fn, err := interpreter.ResolveFunction(f)
+		if err != nil {
+			return nil, err
+		}
+		spec.FieldFn = fn
+	}
+
+	if spec.Bucket != "" && spec.BucketID != "" {
+		return nil, errors.New(codes.Invalid, "cannot submit both bucket and bucketID")
+	}
+	if spec.Org != "" && spec.OrgID != "" {
+		return nil, errors.New(codes.Invalid, "cannot submit both org and orgID")
+	}
+	if spec.Host != "" {
+		if spec.Bucket == "" && spec.BucketID == "" {
+			return nil, errors.New(codes.Invalid, "must specify one of bucket or bucketID when writing to a host")
+		}
+		if spec.Org == "" && spec.OrgID == "" {
+			return nil, errors.New(codes.Invalid, "must specify one of org or orgID when writing to a host")
+		}
+	}
+	return spec, nil
+}
+
+func newToOp() flux.OperationSpec {
+	return new(ToOpSpec)
+}
+
+func (s *ToOpSpec) Kind() flux.OperationKind {
+	return ToKind
+}
+
+type ToProcedureSpec struct {
+	plan.DefaultCost
+	Spec *ToOpSpec
+}
+
+func newToProcedure(qs flux.OperationSpec, pa plan.Administration) (plan.ProcedureSpec, error) {
+	spec, ok := qs.(*ToOpSpec)
+	if !ok {
+		return nil, errors.Newf(codes.Internal, "invalid spec type %T", qs)
+	}
+	return &ToProcedureSpec{Spec: spec}, nil
+}
+
+func (s *ToProcedureSpec) Kind() plan.ProcedureKind {
+	return ToKind
+}
+
+func (s *ToProcedureSpec) Copy() plan.ProcedureSpec {
+	ns := *s.Spec
+	ns.TagColumns = append([]string(nil), s.Spec.TagColumns...)
+	ns.FieldFn = s.Spec.FieldFn.Copy()
+	return &ToProcedureSpec{Spec: &ns}
+}
+
+func createToTransformation(id execute.DatasetID, mode execute.AccumulationMode, spec plan.ProcedureSpec, a execute.Administration) (execute.Transformation, execute.Dataset, error) {
+	s, ok := spec.(*ToProcedureSpec)
+	if !ok {
+		return nil, nil, errors.Newf(codes.Internal, "invalid spec type %T", spec)
+	}
+	if s.Spec.Host == "" {
+		return nil, nil, errors.New(codes.Invalid, "to requires a host when it is not provided by the runtime")
+	}
+	cache := execute.NewTableBuilderCache(a.Allocator())
+	d := execute.NewDataset(id, mode, cache)
+	t, err := NewToTransformation(a.Context(), d, cache, s)
+	if err != nil {
+		return nil, nil, err
+	}
+	return t, d, nil
+}
+
+// defaultMeasurementColLabel is the default column
+// that contains the measurement name.
+const defaultMeasurementColLabel = "_measurement"
+
+// defaultFieldColLabel is the column that contains the field key
+// when no field function is specified.
+const defaultFieldColLabel = "_field"
+
+type ToTransformation struct {
+	d     execute.Dataset
+	cache execute.TableBuilderCache
+	ctx   context.Context
+	spec  *ToOpSpec
+	fn    *execute.RowMapFn
+
+	buf     bytes.Buffer
+	encoder *protocol.Encoder
+	n       int
+}
+
+func NewToTransformation(ctx context.Context, d execute.Dataset, cache execute.TableBuilderCache, spec *ToProcedureSpec) (*ToTransformation, error) {
+	t := &ToTransformation{
+		d:     d,
+		cache: cache,
+		ctx:   ctx,
+		spec:  spec.Spec,
+	}
+	if spec.Spec.FieldFn.Fn != nil {
+		fn, err := execute.NewRowMapFn(spec.Spec.FieldFn.Fn, compiler.ToScope(spec.Spec.FieldFn.Scope))
+		if err != nil {
+			return nil, err
+		}
+		t.fn = fn
+	}
+	t.encoder = protocol.NewEncoder(&t.buf)
+	t.encoder.FailOnFieldErr(true)
+	t.encoder.SetFieldSortOrder(protocol.SortFields)
+	return t, nil
+}
+
+func (t *ToTransformation) RetractTable(id execute.DatasetID, key flux.GroupKey) error {
+	return t.d.RetractTable(key)
+}
+
+type toMetric struct {
+	tags   []*protocol.Tag
+	fields []*protocol.Field
+	name   string
+	t      time.Time
+}
+
+func (m *toMetric) TagList() []*protocol.Tag {
+	return m.tags
+}
+
+func (m *toMetric) FieldList() []*protocol.Field {
+	return m.fields
+}
+
+func (m *toMetric) Name() string {
+	return m.name
+}
+
+func (m *toMetric) Time() time.Time {
+	return m.t
+}
+
+func (m *toMetric) reset() {
+	m.tags = m.tags[:0]
+	m.fields = m.fields[:0]
+	m.name = ""
+}
+
+func (t *ToTransformation) Process(id execute.DatasetID, tbl flux.Table) error {
+	cols := tbl.Cols()
+	timeIdx := execute.ColIdx(t.spec.TimeColumn, cols)
+	if timeIdx < 0 {
+		return errors.Newf(codes.Invalid, "no time column %q", t.spec.TimeColumn)
+	} else if cols[timeIdx].Type != flux.TTime {
+		return errors.Newf(codes.Invalid, "column %q is not of type %s", t.spec.TimeColumn, flux.TTime)
+	}
+	measurementIdx := execute.ColIdx(t.spec.MeasurementColumn, cols)
+	if measurementIdx < 0 {
+		return errors.Newf(codes.Invalid, "no measurement column %q", t.spec.MeasurementColumn)
+	} else if cols[measurementIdx].Type != flux.TString {
+		return errors.Newf(codes.Invalid, "column %q is not of type %s", t.spec.MeasurementColumn, flux.TString)
+	}
+
+	isTag, err := t.tagColumns(tbl.Key(), cols)
+	if err != nil {
+		return err
+	}
+
+	var fieldIdx, valueIdx int
+	if t.fn != nil {
+		if err := t.fn.Prepare(cols); err != nil {
+			return err
+		}
+	} else {
+		fieldIdx = execute.ColIdx(defaultFieldColLabel, cols)
+		valueIdx = execute.ColIdx(execute.DefaultValueColLabel, cols)
+		if fieldIdx < 0 || valueIdx < 0 {
+			return errors.Newf(codes.Invalid, "table must have %q and %q columns when no fieldFn is specified", defaultFieldColLabel, execute.DefaultValueColLabel)
+		}
+	}
+
+	builder, created := t.cache.TableBuilder(tbl.Key())
+	if created {
+		if err := execute.AddTableCols(tbl, builder); err != nil {
+			return err
+		}
+	}
+
+	m := new(toMetric)
+	return tbl.Do(func(cr flux.ColReader) error {
+		for i, l := 0, cr.Len(); i < l; i++ {
+			m.reset()
+			if cr.Times(timeIdx).IsNull(i) {
+				return errors.Newf(codes.Invalid, "null value in time column %q", t.spec.TimeColumn)
+			}
+			m.t = values.Time(cr.Times(timeIdx).Value(i)).Time()
+			if cr.Strings(measurementIdx).IsNull(i) {
+				return errors.Newf(codes.Invalid, "null value in measurement column %q", t.spec.MeasurementColumn)
+			}
+			m.name = cr.Strings(measurementIdx).ValueString(i)
+
+			for j := range cols {
+				if isTag[j] && cr.Strings(j).IsValid(i) {
+					m.tags = append(m.tags, &protocol.Tag{Key: cols[j].Label, Value: cr.Strings(j).ValueString(i)})
+				}
+			}
+
+			if t.fn != nil {
+				obj, err := t.fn.Eval(t.ctx, i, cr)
+				if err != nil {
+					return err
+				}
+				var fieldErr error
+				obj.Range(func(k string, v values.Value) {
+					if fieldErr != nil || v.IsNull() {
+						return
+					}
+					fv, err := fieldValue(k, v)
+					if err != nil {
+						fieldErr = err
+						return
+					}
+					m.fields = append(m.fields, &protocol.Field{Key: k, Value: fv})
+				})
+				if fieldErr != nil {
+					return fieldErr
+				}
+			} else if cr.Strings(fieldIdx).IsValid(i) {
+				v := execute.ValueForRow(cr, i, valueIdx)
+				if !v.IsNull() {
+					key := cr.Strings(fieldIdx).ValueString(i)
+					fv, err := fieldValue(key, v)
+					if err != nil {
+						return err
+					}
+					m.fields = append(m.fields, &protocol.Field{Key: key, Value: fv})
+				}
+			}
+
+			if len(m.fields) > 0 {
+				if _, err := t.encoder.Encode(m); err != nil {
+					return err
+				}
+				t.n++
+				if t.n >= writeBatchSize {
+					if err := t.flush(); err != nil {
+						return err
+					}
+				}
+			}
+
+			if err := execute.AppendRecord(i, cr, builder); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// tagColumns reports which columns are written as tags.
+// When no tag columns are specified, every string column in the
+// group key except for the measurement, field and bounds columns is a tag.
+func (t *ToTransformation) tagColumns(key flux.GroupKey, cols []flux.ColMeta) ([]bool, error) {
+	isTag := make([]bool, len(cols))
+	if t.spec.TagColumns != nil {
+		for j, col := range cols {
+			idx := sort.SearchStrings(t.spec.TagColumns, col.Label)
+			if idx < len(t.spec.TagColumns) && t.spec.TagColumns[idx] == col.Label {
+				if col.Type != flux.TString {
+					return nil, errors.Newf(codes.Invalid, "tag column %q is not of type %s", col.Label, flux.TString)
+				}
+				isTag[j] = true
+			}
+		}
+		return isTag, nil
+	}
+
+	for j, col := range cols {
+		switch col.Label {
+		case execute.DefaultStartColLabel, execute.DefaultStopColLabel,
+			defaultFieldColLabel, t.spec.MeasurementColumn, t.spec.TimeColumn:
+			continue
+		}
+		isTag[j] = col.Type == flux.TString && key.HasCol(col.Label)
+	}
+	return isTag, nil
+}
+
+// fieldValue converts a flux value into a line protocol field value.
+func fieldValue(key string, v values.Value) (interface{}, error) {
+	switch v.Type().Nature() {
+	case semantic.Float:
+		return v.Float(), nil
+	case semantic.Int:
+		return v.Int(), nil
+	case semantic.UInt:
+		return v.UInt(), nil
+	case semantic.String:
+		return v.Str(), nil
+	case semantic.Bool:
+		return v.Bool(), nil
+	default:
+		return nil, errors.Newf(codes.Invalid, "unsupported type %s for field %q", v.Type(), key)
+	}
+}
+
+// flush writes the buffered points to the remote host.
+func (t *ToTransformation) flush() error {
+	if t.n == 0 {
+		return nil
+	}
+	params := orgParams(t.spec.Org, t.spec.OrgID)
+	if t.spec.BucketID != "" {
+		params.Set("bucketID", t.spec.BucketID)
+	} else {
+		params.Set("bucket", t.spec.Bucket)
+	}
+	params.Set("precision", "ns")
+
+	req, err := newRemoteRequest(t.ctx, http.MethodPost, t.spec.Host, "/api/v2/write", params, t.spec.Token, bytes.NewReader(t.buf.Bytes()))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "text/plain; charset=utf-8")
+
+	resp, err := doRemoteRequest(t.ctx, req)
+	if err != nil {
+		return err
+	}
+	_ = resp.Body.Close()
+
+	t.buf.Reset()
+	t.n = 0
+	return nil
+}
+
+func (t *ToTransformation) UpdateWatermark(id execute.DatasetID, pt execute.Time) error {
+	return t.d.UpdateWatermark(pt)
+}
+
+func (t *ToTransformation) UpdateProcessingTime(id execute.DatasetID, pt execute.Time) error {
+	return t.d.UpdateProcessingTime(pt)
+}
+
+func (t *ToTransformation) Finish(id execute.DatasetID, err error) {
+	if err == nil {
+		err = t.flush()
+	}
+	t.d.Finish(err)
 }
@@ -13,12 +13,15 @@ func init() {
 
 var pkgAST = &ast.Package{
 	BaseNode: ast.BaseNode{
-		Errors: nil,
-		Loc:    nil,
+		Comments:        nil,
+		Errors:          nil,
+		Loc:             nil,
+		TrailingComment: nil,
 	},
 	Files: []*ast.File{&ast.File{
 		BaseNode: ast.BaseNode{
-			Errors: nil,
+			Comments: nil,
+			Errors:   nil,
 			Loc: &ast.SourceLocation{
 				End: ast.Position{
 					Column: 51,
@@ -31,10 +34,12 @@ var pkgAST = &ast.Package{
 					Line:   1,
 				},
 			},
+			TrailingComment: nil,
 		},
 		Body: []ast.Statement{&ast.BuiltinStatement{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: []ast.Comment{ast.Comment{Text: "// Json parses an InfluxDB 1.x json result into a table stream."}},
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 13,
@@ -47,10 +52,12 @@ var pkgAST = &ast.Package{
 						Line:   4,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 13,
@@ -63,12 +70,14 @@ var pkgAST = &ast.Package{
 							Line:   4,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "json",
 			},
 		}, &ast.BuiltinStatement{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: []ast.Comment{ast.Comment{Text: "// Databases returns the list of available databases, it has no parameters."}},
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 18,
@@ -81,10 +90,12 @@ var pkgAST = &ast.Package{
 						Line:   7,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 18,
@@ -97,12 +108,14 @@ var pkgAST = &ast.Package{
 							Line:   7,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "databases",
 			},
 		}, &ast.VariableAssignment{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: []ast.Comment{ast.Comment{Text: "// fieldsAsCols is a special application of pivot that will automatically align fields within each measurement that have the same timestamp."}},
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 81,
@@ -115,10 +128,12 @@ var pkgAST = &ast.Package{
 						Line:   10,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 13,
@@ -131,12 +146,14 @@ var pkgAST = &ast.Package{
 							Line:   10,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "fieldsAsCols",
 			},
 			Init: &ast.FunctionExpression{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 81,
@@ -149,11 +166,13 @@ var pkgAST = &ast.Package{
 							Line:   10,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Body: &ast.PipeExpression{
 					Argument: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 11,
@@ -166,11 +185,13 @@ var pkgAST = &ast.Package{
 									Line:   11,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Name: "tables",
 					},
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 81,
@@ -183,11 +204,13 @@ var pkgAST = &ast.Package{
 								Line:   11,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Call: &ast.CallExpression{
 						Arguments: []ast.Expression{&ast.ObjectExpression{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 80,
@@ -200,10 +223,12 @@ var pkgAST = &ast.Package{
 										Line:   12,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Properties: []*ast.Property{&ast.Property{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 34,
@@ -216,10 +241,12 @@ var pkgAST = &ast.Package{
 											Line:   12,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Key: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 24,
@@ -232,12 +259,14 @@ var pkgAST = &ast.Package{
 												Line:   12,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "rowKey",
 								},
 								Value: &ast.ArrayExpression{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 34,
@@ -250,10 +279,12 @@ var pkgAST = &ast.Package{
 												Line:   12,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Elements: []ast.Expression{&ast.StringLiteral{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 33,
@@ -266,13 +297,15 @@ var pkgAST = &ast.Package{
 													Line:   12,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Value: "_time",
 									}},
 								},
 							}, &ast.Property{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 57,
@@ -285,10 +318,12 @@ var pkgAST = &ast.Package{
 											Line:   12,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Key: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 45,
@@ -301,12 +336,14 @@ var pkgAST = &ast.Package{
 												Line:   12,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "columnKey",
 								},
 								Value: &ast.ArrayExpression{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 57,
@@ -319,10 +356,12 @@ var pkgAST = &ast.Package{
 												Line:   12,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Elements: []ast.Expression{&ast.StringLiteral{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 56,
@@ -335,13 +374,15 @@ var pkgAST = &ast.Package{
 													Line:   12,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Value: "_field",
 									}},
 								},
 							}, &ast.Property{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 80,
@@ -354,10 +395,12 @@ var pkgAST = &ast.Package{
 											Line:   12,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Key: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 70,
@@ -370,12 +413,14 @@ var pkgAST = &ast.Package{
 												Line:   12,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "valueColumn",
 								},
 								Value: &ast.StringLiteral{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 80,
@@ -388,6 +433,7 @@ var pkgAST = &ast.Package{
 												Line:   12,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Value: "_value",
 								},
@@ -395,7 +441,8 @@ var pkgAST = &ast.Package{
 							With: nil,
 						}},
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 81,
@@ -408,10 +455,12 @@ var pkgAST = &ast.Package{
 									Line:   12,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Callee: &ast.Identifier{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 17,
@@ -424,6 +473,7 @@ var pkgAST = &ast.Package{
 										Line:   12,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Name: "pivot",
 						},
@@ -431,7 +481,8 @@ var pkgAST = &ast.Package{
 				},
 				Params: []*ast.Property{&ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 26,
@@ -444,10 +495,12 @@ var pkgAST = &ast.Package{
 								Line:   10,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 23,
@@ -460,11 +513,13 @@ var pkgAST = &ast.Package{
 									Line:   10,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Name: "tables",
 					},
 					Value: &ast.PipeLiteral{BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 26,
@@ -477,12 +532,14 @@ var pkgAST = &ast.Package{
 								Line:   10,
 							},
 						},
+						TrailingComment: nil,
 					}},
 				}},
 			},
 		}, &ast.VariableAssignment{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: []ast.Comment{ast.Comment{Text: "// TagValues returns the unique values for a given tag."}, ast.Comment{Text: "// The return value is always a single table with a single column \"_value\"."}},
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 31,
@@ -495,10 +552,12 @@ var pkgAST = &ast.Package{
 						Line:   16,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 10,
@@ -511,12 +570,14 @@ var pkgAST = &ast.Package{
 							Line:   16,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "tagValues",
 			},
 			Init: &ast.FunctionExpression{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 31,
@@ -529,6 +590,7 @@ var pkgAST = &ast.Package{
 							Line:   16,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Body: &ast.PipeExpression{
 					Argument: &ast.PipeExpression{
@@ -538,7 +600,8 @@ var pkgAST = &ast.Package{
 									Argument: &ast.CallExpression{
 										Arguments: []ast.Expression{&ast.ObjectExpression{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 24,
@@ -551,10 +614,12 @@ var pkgAST = &ast.Package{
 														Line:   17,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Properties: []*ast.Property{&ast.Property{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 24,
@@ -567,10 +632,12 @@ var pkgAST = &ast.Package{
 															Line:   17,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Key: &ast.Identifier{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 16,
@@ -583,12 +650,14 @@ var pkgAST = &ast.Package{
 																Line:   17,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Name: "bucket",
 												},
 												Value: &ast.Identifier{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 24,
@@ -601,6 +670,7 @@ var pkgAST = &ast.Package{
 																Line:   17,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Name: "bucket",
 												},
@@ -608,7 +678,8 @@ var pkgAST = &ast.Package{
 											With: nil,
 										}},
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 25,
@@ -621,10 +692,12 @@ var pkgAST = &ast.Package{
 													Line:   17,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Callee: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 9,
@@ -637,12 +710,14 @@ var pkgAST = &ast.Package{
 														Line:   17,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "from",
 										},
 									},
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 29,
@@ -655,11 +730,13 @@ var pkgAST = &ast.Package{
 												Line:   17,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Call: &ast.CallExpression{
 										Arguments: []ast.Expression{&ast.ObjectExpression{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 28,
@@ -672,10 +749,12 @@ var pkgAST = &ast.Package{
 														Line:   18,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Properties: []*ast.Property{&ast.Property{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 28,
@@ -688,10 +767,12 @@ var pkgAST = &ast.Package{
 															Line:   18,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Key: &ast.Identifier{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 21,
@@ -704,12 +785,14 @@ var pkgAST = &ast.Package{
 																Line:   18,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Name: "start",
 												},
 												Value: &ast.Identifier{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 28,
@@ -722,6 +805,7 @@ var pkgAST = &ast.Package{
 																Line:   18,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Name: "start",
 												},
@@ -729,7 +813,8 @@ var pkgAST = &ast.Package{
 											With: nil,
 										}},
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 29,
@@ -742,10 +827,12 @@ var pkgAST = &ast.Package{
 													Line:   18,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Callee: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 15,
@@ -758,13 +845,15 @@ var pkgAST = &ast.Package{
 														Line:   18,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "range",
 										},
 									},
 								},
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 31,
@@ -777,11 +866,13 @@ var pkgAST = &ast.Package{
 											Line:   17,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Call: &ast.CallExpression{
 									Arguments: []ast.Expression{&ast.ObjectExpression{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 30,
@@ -794,10 +885,12 @@ var pkgAST = &ast.Package{
 													Line:   19,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Properties: []*ast.Property{&ast.Property{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 30,
@@ -810,10 +903,12 @@ var pkgAST = &ast.Package{
 														Line:   19,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Key: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 19,
@@ -826,12 +921,14 @@ var pkgAST = &ast.Package{
 															Line:   19,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "fn",
 											},
 											Value: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 30,
@@ -844,6 +941,7 @@ var pkgAST = &ast.Package{
 															Line:   19,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "predicate",
 											},
@@ -851,7 +949,8 @@ var pkgAST = &ast.Package{
 										With: nil,
 									}},
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 31,
@@ -864,10 +963,12 @@ var pkgAST = &ast.Package{
 												Line:   19,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Callee: &ast.Identifier{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 16,
@@ -880,13 +981,15 @@ var pkgAST = &ast.Package{
 													Line:   19,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Name: "filter",
 									},
 								},
 							},
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 30,
@@ -899,11 +1002,13 @@ var pkgAST = &ast.Package{
 										Line:   17,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Call: &ast.CallExpression{
 								Arguments: []ast.Expression{&ast.ObjectExpression{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 29,
@@ -916,10 +1021,12 @@ var pkgAST = &ast.Package{
 												Line:   20,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Properties: []*ast.Property{&ast.Property{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 29,
@@ -932,10 +1039,12 @@ var pkgAST = &ast.Package{
 													Line:   20,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Key: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 22,
@@ -948,12 +1057,14 @@ var pkgAST = &ast.Package{
 														Line:   20,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "columns",
 										},
 										Value: &ast.ArrayExpression{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 29,
@@ -966,10 +1077,12 @@ var pkgAST = &ast.Package{
 														Line:   20,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Elements: []ast.Expression{&ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 28,
@@ -982,6 +1095,7 @@ var pkgAST = &ast.Package{
 															Line:   20,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "tag",
 											}},
@@ -990,7 +1104,8 @@ var pkgAST = &ast.Package{
 									With: nil,
 								}},
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 30,
@@ -1003,10 +1118,12 @@ var pkgAST = &ast.Package{
 											Line:   20,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Callee: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 14,
@@ -1019,13 +1136,15 @@ var pkgAST = &ast.Package{
 												Line:   20,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "keep",
 								},
 							},
 						},
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 17,
@@ -1038,11 +1157,13 @@ var pkgAST = &ast.Package{
 									Line:   17,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Call: &ast.CallExpression{
 							Arguments: nil,
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 17,
@@ -1055,10 +1176,12 @@ var pkgAST = &ast.Package{
 										Line:   21,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Callee: &ast.Identifier{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 15,
@@ -1071,13 +1194,15 @@ var pkgAST = &ast.Package{
 											Line:   21,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Name: "group",
 							},
 						},
 					},
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 31,
@@ -1090,11 +1215,13 @@ var pkgAST = &ast.Package{
 								Line:   17,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Call: &ast.CallExpression{
 						Arguments: []ast.Expression{&ast.ObjectExpression{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 30,
@@ -1107,10 +1234,12 @@ var pkgAST = &ast.Package{
 										Line:   22,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Properties: []*ast.Property{&ast.Property{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 30,
@@ -1123,10 +1252,12 @@ var pkgAST = &ast.Package{
 											Line:   22,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Key: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 25,
@@ -1139,12 +1270,14 @@ var pkgAST = &ast.Package{
 												Line:   22,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "column",
 								},
 								Value: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 30,
@@ -1157,6 +1290,7 @@ var pkgAST = &ast.Package{
 												Line:   22,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "tag",
 								},
@@ -1164,7 +1298,8 @@ var pkgAST = &ast.Package{
 							With: nil,
 						}},
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 31,
@@ -1177,10 +1312,12 @@ var pkgAST = &ast.Package{
 									Line:   22,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Callee: &ast.Identifier{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 18,
@@ -1193,6 +1330,7 @@ var pkgAST = &ast.Package{
 										Line:   22,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Name: "distinct",
 						},
@@ -1200,7 +1338,8 @@ var pkgAST = &ast.Package{
 				},
 				Params: []*ast.Property{&ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 20,
@@ -1213,10 +1352,12 @@ var pkgAST = &ast.Package{
 								Line:   16,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 20,
@@ -1229,13 +1370,15 @@ var pkgAST = &ast.Package{
 									Line:   16,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Name: "bucket",
 					},
 					Value: nil,
 				}, &ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 25,
@@ -1248,10 +1391,12 @@ var pkgAST = &ast.Package{
 								Line:   16,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 25,
@@ -1264,13 +1409,15 @@ var pkgAST = &ast.Package{
 									Line:   16,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Name: "tag",
 					},
 					Value: nil,
 				}, &ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 48,
@@ -1283,10 +1430,12 @@ var pkgAST = &ast.Package{
 								Line:   16,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 36,
@@ -1299,12 +1448,14 @@ var pkgAST = &ast.Package{
 									Line:   16,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Name: "predicate",
 					},
 					Value: &ast.FunctionExpression{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 48,
@@ -1317,10 +1468,12 @@ var pkgAST = &ast.Package{
 									Line:   16,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Body: &ast.Identifier{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 48,
@@ -1333,12 +1486,14 @@ var pkgAST = &ast.Package{
 										Line:   16,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Name: "true",
 						},
 						Params: []*ast.Property{&ast.Property{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 39,
@@ -1351,10 +1506,12 @@ var pkgAST = &ast.Package{
 										Line:   16,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Key: &ast.Identifier{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 39,
@@ -1367,6 +1524,7 @@ var pkgAST = &ast.Package{
 											Line:   16,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Name: "r",
 							},
@@ -1375,7 +1533,8 @@ var pkgAST = &ast.Package{
 					},
 				}, &ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 60,
@@ -1388,10 +1547,12 @@ var pkgAST = &ast.Package{
 								Line:   16,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 55,
@@ -1404,13 +1565,15 @@ var pkgAST = &ast.Package{
 									Line:   16,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Name: "start",
 					},
 					Value: &ast.UnaryExpression{
 						Argument: &ast.DurationLiteral{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 60,
@@ -1423,6 +1586,7 @@ var pkgAST = &ast.Package{
 										Line:   16,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Values: []ast.Duration{ast.Duration{
 								Magnitude: int64(30),
@@ -1430,7 +1594,8 @@ var pkgAST = &ast.Package{
 							}},
 						},
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 60,
@@ -1443,6 +1608,7 @@ var pkgAST = &ast.Package{
 									Line:   16,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Operator: 6,
 					},
@@ -1450,7 +1616,8 @@ var pkgAST = &ast.Package{
 			},
 		}, &ast.VariableAssignment{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: []ast.Comment{ast.Comment{Text: "// MeasurementTagValues returns a single table with a single column \"_value\" that contains the"}, ast.Comment{Text: "// The return value is always a single table with a single column \"_value\"."}},
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 89,
@@ -1463,10 +1630,12 @@ var pkgAST = &ast.Package{
 						Line:   26,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 21,
@@ -1479,12 +1648,14 @@ var pkgAST = &ast.Package{
 							Line:   26,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "measurementTagValues",
 			},
 			Init: &ast.FunctionExpression{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 89,
@@ -1497,11 +1668,13 @@ var pkgAST = &ast.Package{
 							Line:   26,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Body: &ast.CallExpression{
 					Arguments: []ast.Expression{&ast.ObjectExpression{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 88,
@@ -1514,10 +1687,12 @@ var pkgAST = &ast.Package{
 									Line:   27,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Properties: []*ast.Property{&ast.Property{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 29,
@@ -1530,10 +1705,12 @@ var pkgAST = &ast.Package{
 										Line:   27,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Key: &ast.Identifier{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 21,
@@ -1546,12 +1723,14 @@ var pkgAST = &ast.Package{
 											Line:   27,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Name: "bucket",
 							},
 							Value: &ast.Identifier{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 29,
@@ -1564,12 +1743,14 @@ var pkgAST = &ast.Package{
 											Line:   27,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Name: "bucket",
 							},
 						}, &ast.Property{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 39,
@@ -1582,10 +1763,12 @@ var pkgAST = &ast.Package{
 										Line:   27,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Key: &ast.Identifier{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 34,
@@ -1598,12 +1781,14 @@ var pkgAST = &ast.Package{
 											Line:   27,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Name: "tag",
 							},
 							Value: &ast.Identifier{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 39,
@@ -1616,12 +1801,14 @@ var pkgAST = &ast.Package{
 											Line:   27,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Name: "tag",
 							},
 						}, &ast.Property{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 88,
@@ -1634,10 +1821,12 @@ var pkgAST = &ast.Package{
 										Line:   27,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Key: &ast.Identifier{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 50,
@@ -1650,12 +1839,14 @@ var pkgAST = &ast.Package{
 											Line:   27,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Name: "predicate",
 							},
 							Value: &ast.FunctionExpression{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 88,
@@ -1668,10 +1859,12 @@ var pkgAST = &ast.Package{
 											Line:   27,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Body: &ast.BinaryExpression{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 88,
@@ -1684,10 +1877,12 @@ var pkgAST = &ast.Package{
 												Line:   27,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Left: &ast.MemberExpression{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 73,
@@ -1700,10 +1895,12 @@ var pkgAST = &ast.Package{
 													Line:   27,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Object: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 60,
@@ -1716,12 +1913,14 @@ var pkgAST = &ast.Package{
 														Line:   27,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "r",
 										},
 										Property: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 73,
@@ -1734,6 +1933,7 @@ var pkgAST = &ast.Package{
 														Line:   27,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "_measurement",
 										},
@@ -1741,7 +1941,8 @@ var pkgAST = &ast.Package{
 									Operator: 17,
 									Right: &ast.Identifier{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 88,
@@ -1754,13 +1955,15 @@ var pkgAST = &ast.Package{
 													Line:   27,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Name: "measurement",
 									},
 								},
 								Params: []*ast.Property{&ast.Property{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 54,
@@ -1773,10 +1976,12 @@ var pkgAST = &ast.Package{
 												Line:   27,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Key: &ast.Identifier{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 54,
@@ -1789,6 +1994,7 @@ var pkgAST = &ast.Package{
 													Line:   27,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Name: "r",
 									},
@@ -1799,7 +2005,8 @@ var pkgAST = &ast.Package{
 						With: nil,
 					}},
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 89,
@@ -1812,10 +2019,12 @@ var pkgAST = &ast.Package{
 								Line:   27,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Callee: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 14,
@@ -1828,13 +2037,15 @@ var pkgAST = &ast.Package{
 									Line:   27,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Name: "tagValues",
 					},
 				},
 				Params: []*ast.Property{&ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 31,
@@ -1847,10 +2058,12 @@ var pkgAST = &ast.Package{
 								Line:   26,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 31,
@@ -1863,13 +2076,15 @@ var pkgAST = &ast.Package{
 									Line:   26,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Name: "bucket",
 					},
 					Value: nil,
 				}, &ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 44,
@@ -1882,10 +2097,12 @@ var pkgAST = &ast.Package{
 								Line:   26,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 44,
@@ -1898,13 +2115,15 @@ var pkgAST = &ast.Package{
 									Line:   26,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Name: "measurement",
 					},
 					Value: nil,
 				}, &ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 49,
@@ -1917,10 +2136,12 @@ var pkgAST = &ast.Package{
 								Line:   26,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 49,
@@ -1933,6 +2154,7 @@ var pkgAST = &ast.Package{
 									Line:   26,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Name: "tag",
 					},
@@ -1941,7 +2163,8 @@ var pkgAST = &ast.Package{
 			},
 		}, &ast.VariableAssignment{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: []ast.Comment{ast.Comment{Text: "// TagKeys returns the list of tag keys for all series that match the predicate."}, ast.Comment{Text: "// The return value is always a single table with a single column \"_value\"."}},
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 22,
@@ -1954,10 +2177,12 @@ var pkgAST = &ast.Package{
 						Line:   31,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 8,
@@ -1970,12 +2195,14 @@ var pkgAST = &ast.Package{
 							Line:   31,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "tagKeys",
 			},
 			Init: &ast.FunctionExpression{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 22,
@@ -1988,6 +2215,7 @@ var pkgAST = &ast.Package{
 							Line:   31,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Body: &ast.PipeExpression{
 					Argument: &ast.PipeExpression{
@@ -1997,7 +2225,8 @@ var pkgAST = &ast.Package{
 									Argument: &ast.CallExpression{
 										Arguments: []ast.Expression{&ast.ObjectExpression{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 24,
@@ -2010,10 +2239,12 @@ var pkgAST = &ast.Package{
 														Line:   32,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Properties: []*ast.Property{&ast.Property{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 24,
@@ -2026,10 +2257,12 @@ var pkgAST = &ast.Package{
 															Line:   32,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Key: &ast.Identifier{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 16,
@@ -2042,12 +2275,14 @@ var pkgAST = &ast.Package{
 																Line:   32,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Name: "bucket",
 												},
 												Value: &ast.Identifier{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 24,
@@ -2060,6 +2295,7 @@ var pkgAST = &ast.Package{
 																Line:   32,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Name: "bucket",
 												},
@@ -2067,7 +2303,8 @@ var pkgAST = &ast.Package{
 											With: nil,
 										}},
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 25,
@@ -2080,10 +2317,12 @@ var pkgAST = &ast.Package{
 													Line:   32,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Callee: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 9,
@@ -2096,12 +2335,14 @@ var pkgAST = &ast.Package{
 														Line:   32,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "from",
 										},
 									},
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 31,
@@ -2114,11 +2355,13 @@ var pkgAST = &ast.Package{
 												Line:   32,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Call: &ast.CallExpression{
 										Arguments: []ast.Expression{&ast.ObjectExpression{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 30,
@@ -2131,10 +2374,12 @@ var pkgAST = &ast.Package{
 														Line:   33,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Properties: []*ast.Property{&ast.Property{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 30,
@@ -2147,10 +2392,12 @@ var pkgAST = &ast.Package{
 															Line:   33,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Key: &ast.Identifier{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 23,
@@ -2163,12 +2410,14 @@ var pkgAST = &ast.Package{
 																Line:   33,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Name: "start",
 												},
 												Value: &ast.Identifier{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 30,
@@ -2181,6 +2430,7 @@ var pkgAST = &ast.Package{
 																Line:   33,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Name: "start",
 												},
@@ -2188,7 +2438,8 @@ var pkgAST = &ast.Package{
 											With: nil,
 										}},
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 31,
@@ -2201,10 +2452,12 @@ var pkgAST = &ast.Package{
 													Line:   33,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Callee: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 17,
@@ -2217,13 +2470,15 @@ var pkgAST = &ast.Package{
 														Line:   33,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "range",
 										},
 									},
 								},
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 33,
@@ -2236,11 +2491,13 @@ var pkgAST = &ast.Package{
 											Line:   32,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Call: &ast.CallExpression{
 									Arguments: []ast.Expression{&ast.ObjectExpression{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 32,
@@ -2253,10 +2510,12 @@ var pkgAST = &ast.Package{
 													Line:   34,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Properties: []*ast.Property{&ast.Property{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 32,
@@ -2269,10 +2528,12 @@ var pkgAST = &ast.Package{
 														Line:   34,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Key: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 21,
@@ -2285,12 +2546,14 @@ var pkgAST = &ast.Package{
 															Line:   34,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "fn",
 											},
 											Value: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 32,
@@ -2303,6 +2566,7 @@ var pkgAST = &ast.Package{
 															Line:   34,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "predicate",
 											},
@@ -2310,7 +2574,8 @@ var pkgAST = &ast.Package{
 										With: nil,
 									}},
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 33,
@@ -2323,10 +2588,12 @@ var pkgAST = &ast.Package{
 												Line:   34,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Callee: &ast.Identifier{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 18,
@@ -2339,13 +2606,15 @@ var pkgAST = &ast.Package{
 													Line:   34,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Name: "filter",
 									},
 								},
 							},
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 18,
@@ -2358,11 +2627,13 @@ var pkgAST = &ast.Package{
 										Line:   32,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Call: &ast.CallExpression{
 								Arguments: nil,
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 18,
@@ -2375,10 +2646,12 @@ var pkgAST = &ast.Package{
 											Line:   35,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Callee: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 16,
@@ -2391,13 +2664,15 @@ var pkgAST = &ast.Package{
 												Line:   35,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "keys",
 								},
 							},
 						},
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 37,
@@ -2410,11 +2685,13 @@ var pkgAST = &ast.Package{
 									Line:   32,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Call: &ast.CallExpression{
 							Arguments: []ast.Expression{&ast.ObjectExpression{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 36,
@@ -2427,10 +2704,12 @@ var pkgAST = &ast.Package{
 											Line:   36,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Properties: []*ast.Property{&ast.Property{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 36,
@@ -2443,10 +2722,12 @@ var pkgAST = &ast.Package{
 												Line:   36,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Key: &ast.Identifier{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 24,
@@ -2459,12 +2740,14 @@ var pkgAST = &ast.Package{
 													Line:   36,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Name: "columns",
 									},
 									Value: &ast.ArrayExpression{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 36,
@@ -2477,10 +2760,12 @@ var pkgAST = &ast.Package{
 													Line:   36,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Elements: []ast.Expression{&ast.StringLiteral{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 35,
@@ -2493,6 +2778,7 @@ var pkgAST = &ast.Package{
 														Line:   36,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Value: "_value",
 										}},
@@ -2501,7 +2787,8 @@ var pkgAST = &ast.Package{
 								With: nil,
 							}},
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 37,
@@ -2514,10 +2801,12 @@ var pkgAST = &ast.Package{
 										Line:   36,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Callee: &ast.Identifier{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 16,
@@ -2530,13 +2819,15 @@ var pkgAST = &ast.Package{
 											Line:   36,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Name: "keep",
 							},
 						},
 					},
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 22,
@@ -2549,11 +2840,13 @@ var pkgAST = &ast.Package{
 								Line:   32,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Call: &ast.CallExpression{
 						Arguments: nil,
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 22,
@@ -2566,10 +2859,12 @@ var pkgAST = &ast.Package{
 									Line:   37,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Callee: &ast.Identifier{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 20,
@@ -2582,6 +2877,7 @@ var pkgAST = &ast.Package{
 										Line:   37,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Name: "distinct",
 						},
@@ -2589,7 +2885,8 @@ var pkgAST = &ast.Package{
 				},
 				Params: []*ast.Property{&ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 18,
@@ -2602,10 +2899,12 @@ var pkgAST = &ast.Package{
 								Line:   31,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 18,
@@ -2618,13 +2917,15 @@ var pkgAST = &ast.Package{
 									Line:   31,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Name: "bucket",
 					},
 					Value: nil,
 				}, &ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 41,
@@ -2637,10 +2938,12 @@ var pkgAST = &ast.Package{
 								Line:   31,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 29,
@@ -2653,12 +2956,14 @@ var pkgAST = &ast.Package{
 									Line:   31,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Name: "predicate",
 					},
 					Value: &ast.FunctionExpression{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 41,
@@ -2671,10 +2976,12 @@ var pkgAST = &ast.Package{
 									Line:   31,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Body: &ast.Identifier{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 41,
@@ -2687,12 +2994,14 @@ var pkgAST = &ast.Package{
 										Line:   31,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Name: "true",
 						},
 						Params: []*ast.Property{&ast.Property{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 32,
@@ -2705,10 +3014,12 @@ var pkgAST = &ast.Package{
 										Line:   31,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Key: &ast.Identifier{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 32,
@@ -2721,6 +3032,7 @@ var pkgAST = &ast.Package{
 											Line:   31,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Name: "r",
 							},
@@ -2729,7 +3041,8 @@ var pkgAST = &ast.Package{
 					},
 				}, &ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 53,
@@ -2742,10 +3055,12 @@ var pkgAST = &ast.Package{
 								Line:   31,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 48,
@@ -2758,13 +3073,15 @@ var pkgAST = &ast.Package{
 									Line:   31,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Name: "start",
 					},
 					Value: &ast.UnaryExpression{
 						Argument: &ast.DurationLiteral{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 53,
@@ -2777,6 +3094,7 @@ var pkgAST = &ast.Package{
 										Line:   31,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Values: []ast.Duration{ast.Duration{
 								Magnitude: int64(30),
@@ -2784,7 +3102,8 @@ var pkgAST = &ast.Package{
 							}},
 						},
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 53,
@@ -2797,6 +3116,7 @@ var pkgAST = &ast.Package{
 									Line:   31,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Operator: 6,
 					},
@@ -2804,7 +3124,8 @@ var pkgAST = &ast.Package{
 			},
 		}, &ast.VariableAssignment{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: []ast.Comment{ast.Comment{Text: "// MeasurementTagKeys returns the list of tag keys for a specific measurement."}},
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 77,
@@ -2817,10 +3138,12 @@ var pkgAST = &ast.Package{
 						Line:   40,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 19,
@@ -2833,12 +3156,14 @@ var pkgAST = &ast.Package{
 							Line:   40,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "measurementTagKeys",
 			},
 			Init: &ast.FunctionExpression{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 77,
@@ -2851,11 +3176,13 @@ var pkgAST = &ast.Package{
 							Line:   40,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Body: &ast.CallExpression{
 					Arguments: []ast.Expression{&ast.ObjectExpression{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 76,
@@ -2868,10 +3195,12 @@ var pkgAST = &ast.Package{
 									Line:   41,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Properties: []*ast.Property{&ast.Property{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 27,
@@ -2884,10 +3213,12 @@ var pkgAST = &ast.Package{
 										Line:   41,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Key: &ast.Identifier{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 19,
@@ -2900,12 +3231,14 @@ var pkgAST = &ast.Package{
 											Line:   41,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Name: "bucket",
 							},
 							Value: &ast.Identifier{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 27,
@@ -2918,12 +3251,14 @@ var pkgAST = &ast.Package{
 											Line:   41,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Name: "bucket",
 							},
 						}, &ast.Property{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 76,
@@ -2936,10 +3271,12 @@ var pkgAST = &ast.Package{
 										Line:   41,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Key: &ast.Identifier{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 38,
@@ -2952,12 +3289,14 @@ var pkgAST = &ast.Package{
 											Line:   41,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Name: "predicate",
 							},
 							Value: &ast.FunctionExpression{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 76,
@@ -2970,10 +3309,12 @@ var pkgAST = &ast.Package{
 											Line:   41,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Body: &ast.BinaryExpression{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 76,
@@ -2986,10 +3327,12 @@ var pkgAST = &ast.Package{
 												Line:   41,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Left: &ast.MemberExpression{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 61,
@@ -3002,10 +3345,12 @@ var pkgAST = &ast.Package{
 													Line:   41,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Object: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 48,
@@ -3018,12 +3363,14 @@ var pkgAST = &ast.Package{
 														Line:   41,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "r",
 										},
 										Property: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 61,
@@ -3036,6 +3383,7 @@ var pkgAST = &ast.Package{
 														Line:   41,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "_measurement",
 										},
@@ -3043,7 +3391,8 @@ var pkgAST = &ast.Package{
 									Operator: 17,
 									Right: &ast.Identifier{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 76,
@@ -3056,13 +3405,15 @@ var pkgAST = &ast.Package{
 													Line:   41,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Name: "measurement",
 									},
 								},
 								Params: []*ast.Property{&ast.Property{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 42,
@@ -3075,10 +3426,12 @@ var pkgAST = &ast.Package{
 												Line:   41,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Key: &ast.Identifier{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 42,
@@ -3091,6 +3444,7 @@ var pkgAST = &ast.Package{
 													Line:   41,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Name: "r",
 									},
@@ -3101,7 +3455,8 @@ var pkgAST = &ast.Package{
 						With: nil,
 					}},
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 77,
@@ -3114,10 +3469,12 @@ var pkgAST = &ast.Package{
 								Line:   41,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Callee: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 12,
@@ -3130,13 +3487,15 @@ var pkgAST = &ast.Package{
 									Line:   41,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Name: "tagKeys",
 					},
 				},
 				Params: []*ast.Property{&ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 29,
@@ -3149,10 +3508,12 @@ var pkgAST = &ast.Package{
 								Line:   40,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 29,
@@ -3165,13 +3526,15 @@ var pkgAST = &ast.Package{
 									Line:   40,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Name: "bucket",
 					},
 					Value: nil,
 				}, &ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 42,
@@ -3184,10 +3547,12 @@ var pkgAST = &ast.Package{
 								Line:   40,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 42,
@@ -3200,6 +3565,7 @@ var pkgAST = &ast.Package{
 									Line:   40,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Name: "measurement",
 					},
@@ -3208,7 +3574,8 @@ var pkgAST = &ast.Package{
 			},
 		}, &ast.VariableAssignment{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: []ast.Comment{ast.Comment{Text: "// Measurements returns the list of measurements in a specific bucket."}},
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 51,
@@ -3221,10 +3588,12 @@ var pkgAST = &ast.Package{
 						Line:   44,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 13,
@@ -3237,12 +3606,14 @@ var pkgAST = &ast.Package{
 							Line:   44,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "measurements",
 			},
 			Init: &ast.FunctionExpression{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 51,
@@ -3255,11 +3626,13 @@ var pkgAST = &ast.Package{
 							Line:   44,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Body: &ast.CallExpression{
 					Arguments: []ast.Expression{&ast.ObjectExpression{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 50,
@@ -3272,10 +3645,12 @@ var pkgAST = &ast.Package{
 									Line:   45,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Properties: []*ast.Property{&ast.Property{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 29,
@@ -3288,10 +3663,12 @@ var pkgAST = &ast.Package{
 										Line:   45,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Key: &ast.Identifier{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 21,
@@ -3304,12 +3681,14 @@ var pkgAST = &ast.Package{
 											Line:   45,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Name: "bucket",
 							},
 							Value: &ast.Identifier{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 29,
@@ -3322,12 +3701,14 @@ var pkgAST = &ast.Package{
 											Line:   45,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Name: "bucket",
 							},
 						}, &ast.Property{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 50,
@@ -3340,10 +3721,12 @@ var pkgAST = &ast.Package{
 										Line:   45,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Key: &ast.Identifier{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 34,
@@ -3356,12 +3739,14 @@ var pkgAST = &ast.Package{
 											Line:   45,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Name: "tag",
 							},
 							Value: &ast.StringLiteral{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 50,
@@ -3374,6 +3759,7 @@ var pkgAST = &ast.Package{
 											Line:   45,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Value: "_measurement",
 							},
@@ -3381,7 +3767,8 @@ var pkgAST = &ast.Package{
 						With: nil,
 					}},
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 51,
@@ -3394,10 +3781,12 @@ var pkgAST = &ast.Package{
 								Line:   45,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Callee: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 14,
@@ -3410,13 +3799,15 @@ var pkgAST = &ast.Package{
 									Line:   45,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Name: "tagValues",
 					},
 				},
 				Params: []*ast.Property{&ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 23,
@@ -3429,10 +3820,12 @@ var pkgAST = &ast.Package{
 								Line:   44,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 23,
@@ -3445,6 +3838,7 @@ var pkgAST = &ast.Package{
 									Line:   44,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Name: "bucket",
 					},
@@ -3452,12 +3846,14 @@ var pkgAST = &ast.Package{
 				}},
 			},
 		}},
+		Eof:      nil,
 		Imports:  nil,
 		Metadata: "parser-type=go",
 		Name:     "v1.flux",
 		Package: &ast.PackageClause{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 11,
@@ -3470,10 +3866,12 @@ var pkgAST = &ast.Package{
 						Line:   1,
 					},
 				},
+				TrailingComment: nil,
 			},
 			Name: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 11,
@@ -3486,6 +3884,7 @@ var pkgAST = &ast.Package{
 							Line:   1,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "v1",
 			},
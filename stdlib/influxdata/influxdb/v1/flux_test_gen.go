@@ -9,12 +9,15 @@ import (
 
 var FluxTestPackages = []*ast.Package{&ast.Package{
 	BaseNode: ast.BaseNode{
-		Errors: nil,
-		Loc:    nil,
+		Comments:        nil,
+		Errors:          nil,
+		Loc:             nil,
+		TrailingComment: nil,
 	},
 	Files: []*ast.File{&ast.File{
 		BaseNode: ast.BaseNode{
-			Errors: nil,
+			Comments: nil,
+			Errors:   nil,
 			Loc: &ast.SourceLocation{
 				End: ast.Position{
 					Column: 108,
@@ -27,11 +30,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 					Line:   1,
 				},
 			},
+			TrailingComment: nil,
 		},
 		Body: []ast.Statement{&ast.OptionStatement{
 			Assignment: &ast.VariableAssignment{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 42,
@@ -44,10 +49,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   6,
 						},
 					},
+					TrailingComment: nil,
 				},
 				ID: &ast.Identifier{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 11,
@@ -60,12 +67,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   6,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Name: "now",
 				},
 				Init: &ast.FunctionExpression{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 42,
@@ -78,10 +87,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   6,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Body: &ast.ParenExpression{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 42,
@@ -94,10 +105,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Line:   6,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Expression: &ast.DateTimeLiteral{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 41,
@@ -110,6 +123,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										Line:   6,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Value: parser.MustParseTime("2030-01-01T00:00:00Z"),
 						},
@@ -118,7 +132,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 				},
 			},
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 42,
@@ -131,10 +146,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   6,
 					},
 				},
+				TrailingComment: nil,
 			},
 		}, &ast.VariableAssignment{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 2,
@@ -147,10 +164,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   8,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 7,
@@ -163,12 +182,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   8,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "inData",
 			},
 			Init: &ast.StringLiteral{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 2,
@@ -181,12 +202,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   8,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Value: "\n#datatype,string,long,dateTime:RFC3339,double,string,string,string\n#group,false,false,false,false,true,true,true\n#default,_result,,,,,,\n,result,table,_time,_value,_field,_measurement,host\n,,0,2018-05-22T19:53:26Z,1.83,load1,system,host.local\n,,0,2018-05-22T19:53:36Z,1.7,load1,system,host.local\n,,0,2018-05-22T19:53:46Z,1.74,load1,system,host.local\n,,0,2018-05-22T19:53:56Z,1.63,load1,system,host.local\n,,0,2018-05-22T19:54:06Z,1.91,load1,system,host.local\n,,0,2018-05-22T19:54:16Z,1.84,load1,system,host.local\n,,1,2018-05-22T19:53:26Z,1.98,load15,system,host.local\n,,1,2018-05-22T19:53:36Z,1.97,load15,system,host.local\n,,1,2018-05-22T19:53:46Z,1.97,load15,system,host.local\n,,1,2018-05-22T19:53:56Z,1.96,load15,system,host.local\n,,1,2018-05-22T19:54:06Z,1.98,load15,system,host.local\n,,1,2018-05-22T19:54:16Z,1.97,load15,system,host.local\n,,2,2018-05-22T19:53:26Z,1.95,load5,system,host.local\n,,2,2018-05-22T19:53:36Z,1.92,load5,system,host.local\n,,2,2018-05-22T19:53:46Z,1.92,load5,system,host.local\n,,2,2018-05-22T19:53:56Z,1.89,load5,system,host.local\n,,2,2018-05-22T19:54:06Z,1.94,load5,system,host.local\n,,2,2018-05-22T19:54:16Z,1.93,load5,system,host.local\n,,3,2018-05-22T19:53:26Z,82.9833984375,used_percent,swap,host.local\n,,3,2018-05-22T19:53:36Z,82.598876953125,used_percent,swap,host.local\n,,3,2018-05-22T19:53:46Z,82.598876953125,used_percent,swap,host.local\n,,3,2018-05-22T19:53:56Z,82.598876953125,used_percent,swap,host.local\n,,3,2018-05-22T19:54:06Z,82.598876953125,used_percent,swap,host.local\n,,3,2018-05-22T19:54:16Z,82.6416015625,used_percent,swap,host.local\n",
 			},
 		}, &ast.VariableAssignment{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 2,
@@ -199,10 +222,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   39,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 8,
@@ -215,12 +240,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   39,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "outData",
 			},
 			Init: &ast.StringLiteral{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 2,
@@ -233,12 +260,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   39,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Value: "\n#datatype,string,long,dateTime:RFC3339,dateTime:RFC3339,dateTime:RFC3339,string,string,double\n#group,false,false,true,true,false,true,true,false\n#default,0,,,,,,,\n,result,table,_start,_stop,_time,_measurement,host,used_percent\n,,0,2018-05-22T19:53:26Z,2018-05-22T19:54:17Z,2018-05-22T19:53:26Z,swap,host.local,82.9833984375\n,,0,2018-05-22T19:53:26Z,2018-05-22T19:54:17Z,2018-05-22T19:53:36Z,swap,host.local,82.598876953125\n,,0,2018-05-22T19:53:26Z,2018-05-22T19:54:17Z,2018-05-22T19:53:46Z,swap,host.local,82.598876953125\n,,0,2018-05-22T19:53:26Z,2018-05-22T19:54:17Z,2018-05-22T19:53:56Z,swap,host.local,82.598876953125\n,,0,2018-05-22T19:53:26Z,2018-05-22T19:54:17Z,2018-05-22T19:54:06Z,swap,host.local,82.598876953125\n,,0,2018-05-22T19:53:26Z,2018-05-22T19:54:17Z,2018-05-22T19:54:16Z,swap,host.local,82.6416015625\n#datatype,string,long,dateTime:RFC3339,dateTime:RFC3339,dateTime:RFC3339,string,string,double,double,double\n#group,false,false,true,true,false,true,true,false,false,false\n#default,0,,,,,,,,,\n,result,table,_start,_stop,_time,_measurement,host,load1,load15,load5\n,,1,2018-05-22T19:53:26Z,2018-05-22T19:54:17Z,2018-05-22T19:53:26Z,system,host.local,1.83,1.98,1.95\n,,1,2018-05-22T19:53:26Z,2018-05-22T19:54:17Z,2018-05-22T19:53:36Z,system,host.local,1.7,1.97,1.92\n,,1,2018-05-22T19:53:26Z,2018-05-22T19:54:17Z,2018-05-22T19:53:46Z,system,host.local,1.74,1.97,1.92\n,,1,2018-05-22T19:53:26Z,2018-05-22T19:54:17Z,2018-05-22T19:53:56Z,system,host.local,1.63,1.96,1.89\n,,1,2018-05-22T19:53:26Z,2018-05-22T19:54:17Z,2018-05-22T19:54:06Z,system,host.local,1.91,1.98,1.94\n,,1,2018-05-22T19:53:26Z,2018-05-22T19:54:17Z,2018-05-22T19:54:16Z,system,host.local,1.84,1.97,1.93\n",
 			},
 		}, &ast.VariableAssignment{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 23,
@@ -251,10 +280,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   62,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 21,
@@ -267,12 +298,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   62,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "t_influxFieldsAsCols",
 			},
 			Init: &ast.FunctionExpression{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 23,
@@ -285,10 +318,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   62,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Body: &ast.ParenExpression{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 23,
@@ -301,13 +336,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   63,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Expression: &ast.PipeExpression{
 						Argument: &ast.PipeExpression{
 							Argument: &ast.PipeExpression{
 								Argument: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 8,
@@ -320,11 +357,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   63,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "table",
 								},
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 68,
@@ -337,11 +376,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   63,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Call: &ast.CallExpression{
 									Arguments: []ast.Expression{&ast.ObjectExpression{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 67,
@@ -354,10 +395,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   64,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Properties: []*ast.Property{&ast.Property{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 39,
@@ -370,10 +413,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   64,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Key: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 17,
@@ -386,12 +431,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   64,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "start",
 											},
 											Value: &ast.DateTimeLiteral{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 39,
@@ -404,12 +451,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   64,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Value: parser.MustParseTime("2018-05-22T19:53:26Z"),
 											},
 										}, &ast.Property{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 67,
@@ -422,10 +471,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   64,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Key: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 45,
@@ -438,12 +489,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   64,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "stop",
 											},
 											Value: &ast.DateTimeLiteral{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 67,
@@ -456,6 +509,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   64,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Value: parser.MustParseTime("2018-05-22T19:54:17Z"),
 											},
@@ -463,7 +517,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										With: nil,
 									}},
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 68,
@@ -476,10 +531,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   64,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Callee: &ast.Identifier{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 11,
@@ -492,13 +549,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   64,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Name: "range",
 									},
 								},
 							},
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 23,
@@ -511,11 +570,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										Line:   63,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Call: &ast.CallExpression{
 								Arguments: nil,
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 23,
@@ -528,10 +589,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   65,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Callee: &ast.MemberExpression{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 21,
@@ -544,10 +607,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   65,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Object: &ast.Identifier{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 8,
@@ -560,12 +625,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   65,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Name: "v1",
 									},
 									Property: &ast.Identifier{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 21,
@@ -578,6 +645,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   65,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Name: "fieldsAsCols",
 									},
@@ -585,7 +653,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							},
 						},
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 22,
@@ -598,11 +667,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Line:   63,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Call: &ast.CallExpression{
 							Arguments: []ast.Expression{&ast.ObjectExpression{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 21,
@@ -615,10 +686,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   66,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Properties: []*ast.Property{&ast.Property{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 21,
@@ -631,10 +704,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   66,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Key: &ast.Identifier{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 16,
@@ -647,12 +722,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   66,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Name: "name",
 									},
 									Value: &ast.StringLiteral{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 21,
@@ -665,6 +742,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   66,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Value: "0",
 									},
@@ -672,7 +750,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								With: nil,
 							}},
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 22,
@@ -685,10 +764,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										Line:   66,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Callee: &ast.Identifier{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 11,
@@ -701,6 +782,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   66,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Name: "yield",
 							},
@@ -709,7 +791,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 				},
 				Params: []*ast.Property{&ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 33,
@@ -722,10 +805,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   62,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 30,
@@ -738,11 +823,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Line:   62,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Name: "table",
 					},
 					Value: &ast.PipeLiteral{BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 33,
@@ -755,13 +842,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   62,
 							},
 						},
+						TrailingComment: nil,
 					}},
 				}},
 			},
 		}, &ast.TestStatement{
 			Assignment: &ast.VariableAssignment{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 108,
@@ -774,10 +863,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   68,
 						},
 					},
+					TrailingComment: nil,
 				},
 				ID: &ast.Identifier{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 25,
@@ -790,12 +881,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   68,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Name: "_influxFieldsAsCols",
 				},
 				Init: &ast.FunctionExpression{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 108,
@@ -808,10 +901,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   68,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Body: &ast.ParenExpression{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 108,
@@ -824,10 +919,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Line:   69,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Expression: &ast.ObjectExpression{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 107,
@@ -840,10 +937,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										Line:   69,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Properties: []*ast.Property{&ast.Property{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 43,
@@ -856,10 +955,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   69,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Key: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 9,
@@ -872,13 +973,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   69,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "input",
 								},
 								Value: &ast.CallExpression{
 									Arguments: []ast.Expression{&ast.ObjectExpression{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 42,
@@ -891,10 +994,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   69,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Properties: []*ast.Property{&ast.Property{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 42,
@@ -907,10 +1012,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   69,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Key: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 34,
@@ -923,12 +1030,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   69,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "csv",
 											},
 											Value: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 42,
@@ -941,6 +1050,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   69,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "inData",
 											},
@@ -948,7 +1058,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										With: nil,
 									}},
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 43,
@@ -961,10 +1072,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   69,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Callee: &ast.MemberExpression{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 30,
@@ -977,10 +1090,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   69,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Object: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 18,
@@ -993,12 +1108,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   69,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "testing",
 										},
 										Property: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 30,
@@ -1011,6 +1128,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   69,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "loadStorage",
 										},
@@ -1018,7 +1136,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								},
 							}, &ast.Property{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 80,
@@ -1031,10 +1150,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   69,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Key: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 49,
@@ -1047,13 +1168,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   69,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "want",
 								},
 								Value: &ast.CallExpression{
 									Arguments: []ast.Expression{&ast.ObjectExpression{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 79,
@@ -1066,10 +1189,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   69,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Properties: []*ast.Property{&ast.Property{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 79,
@@ -1082,10 +1207,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   69,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Key: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 70,
@@ -1098,12 +1225,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   69,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "csv",
 											},
 											Value: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 79,
@@ -1116,6 +1245,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   69,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "outData",
 											},
@@ -1123,7 +1253,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										With: nil,
 									}},
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 80,
@@ -1136,10 +1267,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   69,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Callee: &ast.MemberExpression{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 66,
@@ -1152,10 +1285,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   69,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Object: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 58,
@@ -1168,12 +1303,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   69,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "testing",
 										},
 										Property: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 66,
@@ -1186,6 +1323,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   69,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "loadMem",
 										},
@@ -1193,7 +1331,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								},
 							}, &ast.Property{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 106,
@@ -1206,10 +1345,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   69,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Key: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 84,
@@ -1222,12 +1363,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   69,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "fn",
 								},
 								Value: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 106,
@@ -1240,6 +1383,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   69,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "t_influxFieldsAsCols",
 								},
@@ -1251,7 +1395,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 				},
 			},
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 108,
@@ -1264,12 +1409,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   68,
 					},
 				},
+				TrailingComment: nil,
 			},
 		}},
+		Eof: nil,
 		Imports: []*ast.ImportDeclaration{&ast.ImportDeclaration{
 			As: nil,
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 17,
@@ -1282,10 +1430,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   3,
 					},
 				},
+				TrailingComment: nil,
 			},
 			Path: &ast.StringLiteral{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 17,
@@ -1298,13 +1448,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   3,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Value: "testing",
 			},
 		}, &ast.ImportDeclaration{
 			As: nil,
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 32,
@@ -1317,10 +1469,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   4,
 					},
 				},
+				TrailingComment: nil,
 			},
 			Path: &ast.StringLiteral{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 32,
@@ -1333,6 +1487,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   4,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Value: "influxdata/influxdb/v1",
 			},
@@ -1341,7 +1496,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 		Name:     "influxFieldsAsCols_test.flux",
 		Package: &ast.PackageClause{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 16,
@@ -1354,10 +1510,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   1,
 					},
 				},
+				TrailingComment: nil,
 			},
 			Name: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 16,
@@ -1370,13 +1528,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   1,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "v1_test",
 			},
 		},
 	}, &ast.File{
 		BaseNode: ast.BaseNode{
-			Errors: nil,
+			Comments: nil,
+			Errors:   nil,
 			Loc: &ast.SourceLocation{
 				End: ast.Position{
 					Column: 3,
@@ -1389,11 +1549,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 					Line:   1,
 				},
 			},
+			TrailingComment: nil,
 		},
 		Body: []ast.Statement{&ast.OptionStatement{
 			Assignment: &ast.VariableAssignment{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 40,
@@ -1406,10 +1568,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   6,
 						},
 					},
+					TrailingComment: nil,
 				},
 				ID: &ast.Identifier{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 11,
@@ -1422,12 +1586,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   6,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Name: "now",
 				},
 				Init: &ast.FunctionExpression{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 40,
@@ -1440,10 +1606,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   6,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Body: &ast.DateTimeLiteral{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 40,
@@ -1456,6 +1624,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Line:   6,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Value: parser.MustParseTime("2030-01-01T00:00:00Z"),
 					},
@@ -1463,7 +1632,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 				},
 			},
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 40,
@@ -1476,10 +1646,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   6,
 					},
 				},
+				TrailingComment: nil,
 			},
 		}, &ast.VariableAssignment{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 2,
@@ -1492,10 +1664,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   8,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 7,
@@ -1508,12 +1682,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   8,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "inData",
 			},
 			Init: &ast.StringLiteral{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 2,
@@ -1526,12 +1702,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   8,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Value: "\n#datatype,string,long,dateTime:RFC3339,double,string,string,string\n#group,false,false,false,false,true,true,true\n#default,_result,,,,,,\n,result,table,_time,_value,_field,_measurement,host\n,,0,2018-05-22T19:53:26Z,1.83,load1,system,host.local\n,,0,2018-05-22T19:53:36Z,1.7,load1,system,host.local\n,,0,2018-05-22T19:53:46Z,1.74,load1,system,host.local\n,,0,2018-05-22T19:53:56Z,1.63,load1,system,host.local\n,,0,2018-05-22T19:54:06Z,1.91,load1,system,host.local\n,,0,2018-05-22T19:54:16Z,1.84,load1,system,host.local\n,,1,2018-05-22T19:53:26Z,1.98,load15,system,host.local\n,,1,2018-05-22T19:53:36Z,1.97,load15,system,host.local\n,,1,2018-05-22T19:53:46Z,1.97,load15,system,host.local\n,,1,2018-05-22T19:53:56Z,1.96,load15,system,host.local\n,,1,2018-05-22T19:54:06Z,1.98,load15,system,host.local\n,,1,2018-05-22T19:54:16Z,1.97,load15,system,host.local\n,,2,2018-05-22T19:53:26Z,1.95,load5,system,host.local\n,,2,2018-05-22T19:53:36Z,1.92,load5,system,host.local\n,,2,2018-05-22T19:53:46Z,1.92,load5,system,host.local\n,,2,2018-05-22T19:53:56Z,1.89,load5,system,host.local\n,,2,2018-05-22T19:54:06Z,1.94,load5,system,host.local\n,,2,2018-05-22T19:54:16Z,1.93,load5,system,host.local\n",
 			},
 		}, &ast.VariableAssignment{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 2,
@@ -1544,10 +1722,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   32,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 8,
@@ -1560,12 +1740,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   32,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "outData",
 			},
 			Init: &ast.StringLiteral{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 2,
@@ -1578,12 +1760,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   32,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Value: "\n#datatype,string,long,dateTime:RFC3339,dateTime:RFC3339,dateTime:RFC3339,string,string,double,double,double\n#group,false,false,true,true,false,true,true,false,false,false\n#default,0,,,,,,,,,\n,result,table,_start,_stop,_time,_measurement,host,load1,load15,load5\n,,0,2018-05-22T19:53:26Z,2018-05-22T19:54:17Z,2018-05-22T19:53:26Z,system,host.local,1.83,1.98,1.95\n,,0,2018-05-22T19:53:26Z,2018-05-22T19:54:17Z,2018-05-22T19:53:36Z,system,host.local,1.7,1.97,1.92\n,,0,2018-05-22T19:53:26Z,2018-05-22T19:54:17Z,2018-05-22T19:53:46Z,system,host.local,1.74,1.97,1.92\n,,0,2018-05-22T19:53:26Z,2018-05-22T19:54:17Z,2018-05-22T19:53:56Z,system,host.local,1.63,1.96,1.89\n,,0,2018-05-22T19:53:26Z,2018-05-22T19:54:17Z,2018-05-22T19:54:06Z,system,host.local,1.91,1.98,1.94\n,,0,2018-05-22T19:53:26Z,2018-05-22T19:54:17Z,2018-05-22T19:54:16Z,system,host.local,1.84,1.97,1.93\n",
 			},
 		}, &ast.VariableAssignment{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: []ast.Comment{ast.Comment{Text: "// select load1, load15"}},
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 44,
@@ -1596,10 +1780,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   46,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 9,
@@ -1612,12 +1798,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   46,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "rawQuery",
 			},
 			Init: &ast.FunctionExpression{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 44,
@@ -1630,6 +1818,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   46,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Body: &ast.PipeExpression{
 					Argument: &ast.PipeExpression{
@@ -1638,7 +1827,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Argument: &ast.PipeExpression{
 									Argument: &ast.Identifier{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 9,
@@ -1651,11 +1841,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   47,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Name: "stream",
 									},
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 38,
@@ -1668,11 +1860,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   47,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Call: &ast.CallExpression{
 										Arguments: []ast.Expression{&ast.ObjectExpression{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 37,
@@ -1685,10 +1879,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   48,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Properties: []*ast.Property{&ast.Property{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 25,
@@ -1701,10 +1897,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   48,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Key: &ast.Identifier{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 19,
@@ -1717,12 +1915,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																Line:   48,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Name: "start",
 												},
 												Value: &ast.Identifier{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 25,
@@ -1735,12 +1935,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																Line:   48,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Name: "start",
 												},
 											}, &ast.Property{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 37,
@@ -1753,10 +1955,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   48,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Key: &ast.Identifier{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 31,
@@ -1769,12 +1973,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																Line:   48,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Name: "stop",
 												},
 												Value: &ast.Identifier{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 37,
@@ -1787,6 +1993,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																Line:   48,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Name: "stop",
 												},
@@ -1794,7 +2001,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											With: nil,
 										}},
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 38,
@@ -1807,10 +2015,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   48,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Callee: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 13,
@@ -1823,13 +2033,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   48,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "range",
 										},
 									},
 								},
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 99,
@@ -1842,11 +2054,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   47,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Call: &ast.CallExpression{
 									Arguments: []ast.Expression{&ast.ObjectExpression{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 98,
@@ -1859,10 +2073,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   49,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Properties: []*ast.Property{&ast.Property{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 98,
@@ -1875,10 +2091,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   49,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Key: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 17,
@@ -1891,12 +2109,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   49,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "fn",
 											},
 											Value: &ast.FunctionExpression{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 98,
@@ -1909,10 +2129,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   49,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Body: &ast.LogicalExpression{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 98,
@@ -1925,10 +2147,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																Line:   49,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Left: &ast.BinaryExpression{
 														BaseNode: ast.BaseNode{
-															Errors: nil,
+															Comments: nil,
+															Errors:   nil,
 															Loc: &ast.SourceLocation{
 																End: ast.Position{
 																	Column: 55,
@@ -1941,10 +2165,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																	Line:   49,
 																},
 															},
+															TrailingComment: nil,
 														},
 														Left: &ast.MemberExpression{
 															BaseNode: ast.BaseNode{
-																Errors: nil,
+																Comments: nil,
+																Errors:   nil,
 																Loc: &ast.SourceLocation{
 																	End: ast.Position{
 																		Column: 40,
@@ -1957,10 +2183,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																		Line:   49,
 																	},
 																},
+																TrailingComment: nil,
 															},
 															Object: &ast.Identifier{
 																BaseNode: ast.BaseNode{
-																	Errors: nil,
+																	Comments: nil,
+																	Errors:   nil,
 																	Loc: &ast.SourceLocation{
 																		End: ast.Position{
 																			Column: 27,
@@ -1973,12 +2201,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																			Line:   49,
 																		},
 																	},
+																	TrailingComment: nil,
 																},
 																Name: "r",
 															},
 															Property: &ast.Identifier{
 																BaseNode: ast.BaseNode{
-																	Errors: nil,
+																	Comments: nil,
+																	Errors:   nil,
 																	Loc: &ast.SourceLocation{
 																		End: ast.Position{
 																			Column: 40,
@@ -1991,6 +2221,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																			Line:   49,
 																		},
 																	},
+																	TrailingComment: nil,
 																},
 																Name: "_measurement",
 															},
@@ -1998,7 +2229,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Operator: 17,
 														Right: &ast.Identifier{
 															BaseNode: ast.BaseNode{
-																Errors: nil,
+																Comments: nil,
+																Errors:   nil,
 																Loc: &ast.SourceLocation{
 																	End: ast.Position{
 																		Column: 55,
@@ -2011,6 +2243,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																		Line:   49,
 																	},
 																},
+																TrailingComment: nil,
 															},
 															Name: "measurement",
 														},
@@ -2019,7 +2252,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Right: &ast.CallExpression{
 														Arguments: []ast.Expression{&ast.ObjectExpression{
 															BaseNode: ast.BaseNode{
-																Errors: nil,
+																Comments: nil,
+																Errors:   nil,
 																Loc: &ast.SourceLocation{
 																	End: ast.Position{
 																		Column: 97,
@@ -2032,10 +2266,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																		Line:   49,
 																	},
 																},
+																TrailingComment: nil,
 															},
 															Properties: []*ast.Property{&ast.Property{
 																BaseNode: ast.BaseNode{
-																	Errors: nil,
+																	Comments: nil,
+																	Errors:   nil,
 																	Loc: &ast.SourceLocation{
 																		End: ast.Position{
 																			Column: 84,
@@ -2048,10 +2284,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																			Line:   49,
 																		},
 																	},
+																	TrailingComment: nil,
 																},
 																Key: &ast.Identifier{
 																	BaseNode: ast.BaseNode{
-																		Errors: nil,
+																		Comments: nil,
+																		Errors:   nil,
 																		Loc: &ast.SourceLocation{
 																			End: ast.Position{
 																				Column: 74,
@@ -2064,12 +2302,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																				Line:   49,
 																			},
 																		},
+																		TrailingComment: nil,
 																	},
 																	Name: "value",
 																},
 																Value: &ast.MemberExpression{
 																	BaseNode: ast.BaseNode{
-																		Errors: nil,
+																		Comments: nil,
+																		Errors:   nil,
 																		Loc: &ast.SourceLocation{
 																			End: ast.Position{
 																				Column: 84,
@@ -2082,10 +2322,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																				Line:   49,
 																			},
 																		},
+																		TrailingComment: nil,
 																	},
 																	Object: &ast.Identifier{
 																		BaseNode: ast.BaseNode{
-																			Errors: nil,
+																			Comments: nil,
+																			Errors:   nil,
 																			Loc: &ast.SourceLocation{
 																				End: ast.Position{
 																					Column: 77,
@@ -2098,12 +2340,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																					Line:   49,
 																				},
 																			},
+																			TrailingComment: nil,
 																		},
 																		Name: "r",
 																	},
 																	Property: &ast.Identifier{
 																		BaseNode: ast.BaseNode{
-																			Errors: nil,
+																			Comments: nil,
+																			Errors:   nil,
 																			Loc: &ast.SourceLocation{
 																				End: ast.Position{
 																					Column: 84,
@@ -2116,13 +2360,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																					Line:   49,
 																				},
 																			},
+																			TrailingComment: nil,
 																		},
 																		Name: "_field",
 																	},
 																},
 															}, &ast.Property{
 																BaseNode: ast.BaseNode{
-																	Errors: nil,
+																	Comments: nil,
+																	Errors:   nil,
 																	Loc: &ast.SourceLocation{
 																		End: ast.Position{
 																			Column: 97,
@@ -2135,10 +2381,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																			Line:   49,
 																		},
 																	},
+																	TrailingComment: nil,
 																},
 																Key: &ast.Identifier{
 																	BaseNode: ast.BaseNode{
-																		Errors: nil,
+																		Comments: nil,
+																		Errors:   nil,
 																		Loc: &ast.SourceLocation{
 																			End: ast.Position{
 																				Column: 89,
@@ -2151,12 +2399,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																				Line:   49,
 																			},
 																		},
+																		TrailingComment: nil,
 																	},
 																	Name: "set",
 																},
 																Value: &ast.Identifier{
 																	BaseNode: ast.BaseNode{
-																		Errors: nil,
+																		Comments: nil,
+																		Errors:   nil,
 																		Loc: &ast.SourceLocation{
 																			End: ast.Position{
 																				Column: 97,
@@ -2169,6 +2419,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																				Line:   49,
 																			},
 																		},
+																		TrailingComment: nil,
 																	},
 																	Name: "fields",
 																},
@@ -2176,7 +2427,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															With: nil,
 														}},
 														BaseNode: ast.BaseNode{
-															Errors: nil,
+															Comments: nil,
+															Errors:   nil,
 															Loc: &ast.SourceLocation{
 																End: ast.Position{
 																	Column: 98,
@@ -2189,10 +2441,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																	Line:   49,
 																},
 															},
+															TrailingComment: nil,
 														},
 														Callee: &ast.Identifier{
 															BaseNode: ast.BaseNode{
-																Errors: nil,
+																Comments: nil,
+																Errors:   nil,
 																Loc: &ast.SourceLocation{
 																	End: ast.Position{
 																		Column: 68,
@@ -2205,6 +2459,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																		Line:   49,
 																	},
 																},
+																TrailingComment: nil,
 															},
 															Name: "contains",
 														},
@@ -2212,7 +2467,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												},
 												Params: []*ast.Property{&ast.Property{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 21,
@@ -2225,10 +2481,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																Line:   49,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Key: &ast.Identifier{
 														BaseNode: ast.BaseNode{
-															Errors: nil,
+															Comments: nil,
+															Errors:   nil,
 															Loc: &ast.SourceLocation{
 																End: ast.Position{
 																	Column: 21,
@@ -2241,6 +2499,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																	Line:   49,
 																},
 															},
+															TrailingComment: nil,
 														},
 														Name: "r",
 													},
@@ -2251,7 +2510,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										With: nil,
 									}},
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 99,
@@ -2264,10 +2524,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   49,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Callee: &ast.Identifier{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 14,
@@ -2280,13 +2542,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   49,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Name: "filter",
 									},
 								},
 							},
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 47,
@@ -2299,11 +2563,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										Line:   47,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Call: &ast.CallExpression{
 								Arguments: []ast.Expression{&ast.ObjectExpression{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 46,
@@ -2316,10 +2582,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   50,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Properties: []*ast.Property{&ast.Property{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 30,
@@ -2332,10 +2600,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   50,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Key: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 21,
@@ -2348,12 +2618,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   50,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "columns",
 										},
 										Value: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 30,
@@ -2366,12 +2638,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   50,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "groupBy",
 										},
 									}, &ast.Property{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 46,
@@ -2384,10 +2658,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   50,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Key: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 36,
@@ -2400,12 +2676,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   50,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "mode",
 										},
 										Value: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 46,
@@ -2418,6 +2696,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   50,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "groupMode",
 										},
@@ -2425,7 +2704,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									With: nil,
 								}},
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 47,
@@ -2438,10 +2718,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   50,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Callee: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 13,
@@ -2454,13 +2736,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   50,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "group",
 								},
 							},
 						},
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 25,
@@ -2473,11 +2757,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Line:   47,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Call: &ast.CallExpression{
 							Arguments: nil,
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 25,
@@ -2490,10 +2776,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										Line:   51,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Callee: &ast.MemberExpression{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 23,
@@ -2506,10 +2794,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   51,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Object: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 10,
@@ -2522,12 +2812,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   51,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "v1",
 								},
 								Property: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 23,
@@ -2540,6 +2832,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   51,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "fieldsAsCols",
 								},
@@ -2547,7 +2840,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						},
 					},
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 44,
@@ -2560,11 +2854,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   47,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Call: &ast.CallExpression{
 						Arguments: []ast.Expression{&ast.ObjectExpression{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 43,
@@ -2577,10 +2873,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										Line:   52,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Properties: []*ast.Property{&ast.Property{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 27,
@@ -2593,10 +2891,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   52,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Key: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 20,
@@ -2609,12 +2909,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   52,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "every",
 								},
 								Value: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 27,
@@ -2627,12 +2929,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   52,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "every",
 								},
 							}, &ast.Property{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 43,
@@ -2645,10 +2949,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   52,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Key: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 35,
@@ -2661,12 +2967,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   52,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "period",
 								},
 								Value: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 43,
@@ -2679,6 +2987,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   52,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "period",
 								},
@@ -2686,7 +2995,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							With: nil,
 						}},
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 44,
@@ -2699,10 +3009,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Line:   52,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Callee: &ast.Identifier{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 14,
@@ -2715,6 +3027,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										Line:   52,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Name: "window",
 						},
@@ -2722,7 +3035,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 				},
 				Params: []*ast.Property{&ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 22,
@@ -2735,10 +3049,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   46,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 19,
@@ -2751,11 +3067,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Line:   46,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Name: "stream",
 					},
 					Value: &ast.PipeLiteral{BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 22,
@@ -2768,10 +3086,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   46,
 							},
 						},
+						TrailingComment: nil,
 					}},
 				}, &ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 29,
@@ -2784,10 +3104,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   46,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 29,
@@ -2800,13 +3122,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Line:   46,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Name: "start",
 					},
 					Value: nil,
 				}, &ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 35,
@@ -2819,10 +3143,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   46,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 35,
@@ -2835,13 +3161,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Line:   46,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Name: "stop",
 					},
 					Value: nil,
 				}, &ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 48,
@@ -2854,10 +3182,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   46,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 48,
@@ -2870,13 +3200,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Line:   46,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Name: "measurement",
 					},
 					Value: nil,
 				}, &ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 59,
@@ -2889,10 +3221,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   46,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 56,
@@ -2905,12 +3239,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Line:   46,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Name: "fields",
 					},
 					Value: &ast.ArrayExpression{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 59,
@@ -2923,12 +3259,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Line:   46,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Elements: nil,
 					},
 				}, &ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 88,
@@ -2941,10 +3279,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   46,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 68,
@@ -2957,12 +3297,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Line:   46,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Name: "groupBy",
 					},
 					Value: &ast.ArrayExpression{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 88,
@@ -2975,10 +3317,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Line:   46,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Elements: []ast.Expression{&ast.StringLiteral{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 77,
@@ -2991,11 +3335,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										Line:   46,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Value: "_time",
 						}, &ast.StringLiteral{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 87,
@@ -3008,13 +3354,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										Line:   46,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Value: "_value",
 						}},
 					},
 				}, &ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 108,
@@ -3027,10 +3375,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   46,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 99,
@@ -3043,12 +3393,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Line:   46,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Name: "groupMode",
 					},
 					Value: &ast.StringLiteral{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 108,
@@ -3061,12 +3413,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Line:   46,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Value: "except",
 					},
 				}, &ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 119,
@@ -3079,10 +3433,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   46,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 115,
@@ -3095,12 +3451,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Line:   46,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Name: "every",
 					},
 					Value: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 119,
@@ -3113,12 +3471,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Line:   46,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Name: "inf",
 					},
 				}, &ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 130,
@@ -3131,10 +3491,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   46,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 127,
@@ -3147,12 +3509,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Line:   46,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Name: "period",
 					},
 					Value: &ast.DurationLiteral{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 130,
@@ -3165,6 +3529,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Line:   46,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Values: []ast.Duration{ast.Duration{
 							Magnitude: int64(0),
@@ -3176,7 +3541,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 		}, &ast.TestStatement{
 			Assignment: &ast.VariableAssignment{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 3,
@@ -3189,10 +3555,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   54,
 						},
 					},
+					TrailingComment: nil,
 				},
 				ID: &ast.Identifier{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 22,
@@ -3205,12 +3573,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   54,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Name: "influx_raw_query",
 				},
 				Init: &ast.FunctionExpression{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 3,
@@ -3223,10 +3593,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   54,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Body: &ast.ParenExpression{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 3,
@@ -3239,10 +3611,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Line:   54,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Expression: &ast.ObjectExpression{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 2,
@@ -3255,10 +3629,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										Line:   54,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Properties: []*ast.Property{&ast.Property{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 44,
@@ -3271,10 +3647,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   55,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Key: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 10,
@@ -3287,13 +3665,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   55,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "input",
 								},
 								Value: &ast.CallExpression{
 									Arguments: []ast.Expression{&ast.ObjectExpression{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 43,
@@ -3306,10 +3686,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   55,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Properties: []*ast.Property{&ast.Property{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 43,
@@ -3322,10 +3704,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   55,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Key: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 35,
@@ -3338,12 +3722,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   55,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "csv",
 											},
 											Value: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 43,
@@ -3356,6 +3742,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   55,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "inData",
 											},
@@ -3363,7 +3750,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										With: nil,
 									}},
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 44,
@@ -3376,10 +3764,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   55,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Callee: &ast.MemberExpression{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 31,
@@ -3392,10 +3782,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   55,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Object: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 19,
@@ -3408,12 +3800,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   55,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "testing",
 										},
 										Property: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 31,
@@ -3426,6 +3820,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   55,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "loadStorage",
 										},
@@ -3433,7 +3828,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								},
 							}, &ast.Property{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 40,
@@ -3446,10 +3842,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   56,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Key: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 9,
@@ -3462,13 +3860,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   56,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "want",
 								},
 								Value: &ast.CallExpression{
 									Arguments: []ast.Expression{&ast.ObjectExpression{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 39,
@@ -3481,10 +3881,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   56,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Properties: []*ast.Property{&ast.Property{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 39,
@@ -3497,10 +3899,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   56,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Key: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 30,
@@ -3513,12 +3917,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   56,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "csv",
 											},
 											Value: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 39,
@@ -3531,6 +3937,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   56,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "outData",
 											},
@@ -3538,7 +3945,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										With: nil,
 									}},
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 40,
@@ -3551,10 +3959,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   56,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Callee: &ast.MemberExpression{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 26,
@@ -3567,10 +3977,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   56,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Object: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 18,
@@ -3583,12 +3995,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   56,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "testing",
 										},
 										Property: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 26,
@@ -3601,6 +4015,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   56,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "loadMem",
 										},
@@ -3608,7 +4023,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								},
 							}, &ast.Property{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 157,
@@ -3621,10 +4037,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   57,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Key: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 7,
@@ -3637,12 +4055,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   57,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "fn",
 								},
 								Value: &ast.FunctionExpression{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 157,
@@ -3655,11 +4075,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   57,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Body: &ast.PipeExpression{
 										Argument: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 28,
@@ -3672,11 +4094,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   57,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "table",
 										},
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 157,
@@ -3689,11 +4113,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   57,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Call: &ast.CallExpression{
 											Arguments: []ast.Expression{&ast.ObjectExpression{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 156,
@@ -3706,10 +4132,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   57,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Properties: []*ast.Property{&ast.Property{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 62,
@@ -3722,10 +4150,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																Line:   57,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Key: &ast.Identifier{
 														BaseNode: ast.BaseNode{
-															Errors: nil,
+															Comments: nil,
+															Errors:   nil,
 															Loc: &ast.SourceLocation{
 																End: ast.Position{
 																	Column: 52,
@@ -3738,12 +4168,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																	Line:   57,
 																},
 															},
+															TrailingComment: nil,
 														},
 														Name: "measurement",
 													},
 													Value: &ast.StringLiteral{
 														BaseNode: ast.BaseNode{
-															Errors: nil,
+															Comments: nil,
+															Errors:   nil,
 															Loc: &ast.SourceLocation{
 																End: ast.Position{
 																	Column: 62,
@@ -3756,12 +4188,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																	Line:   57,
 																},
 															},
+															TrailingComment: nil,
 														},
 														Value: "system",
 													},
 												}, &ast.Property{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 99,
@@ -3774,10 +4208,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																Line:   57,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Key: &ast.Identifier{
 														BaseNode: ast.BaseNode{
-															Errors: nil,
+															Comments: nil,
+															Errors:   nil,
 															Loc: &ast.SourceLocation{
 																End: ast.Position{
 																	Column: 69,
@@ -3790,12 +4226,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																	Line:   57,
 																},
 															},
+															TrailingComment: nil,
 														},
 														Name: "fields",
 													},
 													Value: &ast.ArrayExpression{
 														BaseNode: ast.BaseNode{
-															Errors: nil,
+															Comments: nil,
+															Errors:   nil,
 															Loc: &ast.SourceLocation{
 																End: ast.Position{
 																	Column: 99,
@@ -3808,10 +4246,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																	Line:   57,
 																},
 															},
+															TrailingComment: nil,
 														},
 														Elements: []ast.Expression{&ast.StringLiteral{
 															BaseNode: ast.BaseNode{
-																Errors: nil,
+																Comments: nil,
+																Errors:   nil,
 																Loc: &ast.SourceLocation{
 																	End: ast.Position{
 																		Column: 79,
@@ -3824,11 +4264,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																		Line:   57,
 																	},
 																},
+																TrailingComment: nil,
 															},
 															Value: "load1",
 														}, &ast.StringLiteral{
 															BaseNode: ast.BaseNode{
-																Errors: nil,
+																Comments: nil,
+																Errors:   nil,
 																Loc: &ast.SourceLocation{
 																	End: ast.Position{
 																		Column: 89,
@@ -3841,11 +4283,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																		Line:   57,
 																	},
 																},
+																TrailingComment: nil,
 															},
 															Value: "load15",
 														}, &ast.StringLiteral{
 															BaseNode: ast.BaseNode{
-																Errors: nil,
+																Comments: nil,
+																Errors:   nil,
 																Loc: &ast.SourceLocation{
 																	End: ast.Position{
 																		Column: 98,
@@ -3858,13 +4302,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																		Line:   57,
 																	},
 																},
+																TrailingComment: nil,
 															},
 															Value: "load5",
 														}},
 													},
 												}, &ast.Property{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 128,
@@ -3877,10 +4323,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																Line:   57,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Key: &ast.Identifier{
 														BaseNode: ast.BaseNode{
-															Errors: nil,
+															Comments: nil,
+															Errors:   nil,
 															Loc: &ast.SourceLocation{
 																End: ast.Position{
 																	Column: 106,
@@ -3893,12 +4341,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																	Line:   57,
 																},
 															},
+															TrailingComment: nil,
 														},
 														Name: "start",
 													},
 													Value: &ast.DateTimeLiteral{
 														BaseNode: ast.BaseNode{
-															Errors: nil,
+															Comments: nil,
+															Errors:   nil,
 															Loc: &ast.SourceLocation{
 																End: ast.Position{
 																	Column: 128,
@@ -3911,12 +4361,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																	Line:   57,
 																},
 															},
+															TrailingComment: nil,
 														},
 														Value: parser.MustParseTime("2018-05-22T19:53:26Z"),
 													},
 												}, &ast.Property{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 156,
@@ -3929,10 +4381,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																Line:   57,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Key: &ast.Identifier{
 														BaseNode: ast.BaseNode{
-															Errors: nil,
+															Comments: nil,
+															Errors:   nil,
 															Loc: &ast.SourceLocation{
 																End: ast.Position{
 																	Column: 134,
@@ -3945,12 +4399,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																	Line:   57,
 																},
 															},
+															TrailingComment: nil,
 														},
 														Name: "stop",
 													},
 													Value: &ast.DateTimeLiteral{
 														BaseNode: ast.BaseNode{
-															Errors: nil,
+															Comments: nil,
+															Errors:   nil,
 															Loc: &ast.SourceLocation{
 																End: ast.Position{
 																	Column: 156,
@@ -3963,6 +4419,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																	Line:   57,
 																},
 															},
+															TrailingComment: nil,
 														},
 														Value: parser.MustParseTime("2018-05-22T19:54:17Z"),
 													},
@@ -3970,7 +4427,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												With: nil,
 											}},
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 157,
@@ -3983,10 +4441,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   57,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Callee: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 40,
@@ -3999,6 +4459,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   57,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "rawQuery",
 											},
@@ -4006,7 +4467,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									},
 									Params: []*ast.Property{&ast.Property{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 18,
@@ -4019,10 +4481,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   57,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Key: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 15,
@@ -4035,11 +4499,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   57,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "table",
 										},
 										Value: &ast.PipeLiteral{BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 18,
@@ -4052,6 +4518,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   57,
 												},
 											},
+											TrailingComment: nil,
 										}},
 									}},
 								},
@@ -4063,7 +4530,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 				},
 			},
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 3,
@@ -4076,12 +4544,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   54,
 					},
 				},
+				TrailingComment: nil,
 			},
 		}},
+		Eof: nil,
 		Imports: []*ast.ImportDeclaration{&ast.ImportDeclaration{
 			As: nil,
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 17,
@@ -4094,10 +4565,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   3,
 					},
 				},
+				TrailingComment: nil,
 			},
 			Path: &ast.StringLiteral{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 17,
@@ -4110,13 +4583,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   3,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Value: "testing",
 			},
 		}, &ast.ImportDeclaration{
 			As: nil,
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 32,
@@ -4129,10 +4604,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   4,
 					},
 				},
+				TrailingComment: nil,
 			},
 			Path: &ast.StringLiteral{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 32,
@@ -4145,6 +4622,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   4,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Value: "influxdata/influxdb/v1",
 			},
@@ -4153,7 +4631,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 		Name:     "influxRawQuery_test.flux",
 		Package: &ast.PackageClause{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 16,
@@ -4166,10 +4645,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   1,
 					},
 				},
+				TrailingComment: nil,
 			},
 			Name: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 16,
@@ -4182,13 +4663,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   1,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "v1_test",
 			},
 		},
 	}, &ast.File{
 		BaseNode: ast.BaseNode{
-			Errors: nil,
+			Comments: nil,
+			Errors:   nil,
 			Loc: &ast.SourceLocation{
 				End: ast.Position{
 					Column: 114,
@@ -4201,10 +4684,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 					Line:   1,
 				},
 			},
+			TrailingComment: nil,
 		},
 		Body: []ast.Statement{&ast.VariableAssignment{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 2,
@@ -4217,10 +4702,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   5,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 6,
@@ -4233,12 +4720,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   5,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "input",
 			},
 			Init: &ast.StringLiteral{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 2,
@@ -4251,12 +4740,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   5,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Value: "\n#datatype,string,long,dateTime:RFC3339,string,string,string,double\n#group,false,false,false,true,true,true,false\n#default,_result,,,,,,\n,result,table,_time,_measurement,host,_field,_value\n,,0,2018-05-22T19:53:26Z,system,host.local,load1,1.83\n,,0,2018-05-22T19:53:36Z,system,host.local,load1,1.72\n,,0,2018-05-22T19:53:46Z,system,host.local,load1,1.74\n,,0,2018-05-22T19:53:56Z,system,host.local,load1,1.63\n,,0,2018-05-22T19:54:06Z,system,host.local,load1,1.91\n,,0,2018-05-22T19:54:16Z,system,host.local,load1,1.84\n\n,,1,2018-05-22T19:53:26Z,sys,host.local,load3,1.98\n,,1,2018-05-22T19:53:36Z,sys,host.local,load3,1.97\n,,1,2018-05-22T19:53:46Z,sys,host.local,load3,1.97\n,,1,2018-05-22T19:53:56Z,sys,host.local,load3,1.96\n,,1,2018-05-22T19:54:06Z,sys,host.local,load3,1.98\n,,1,2018-05-22T19:54:16Z,sys,host.local,load3,1.97\n\n,,2,2018-05-22T19:53:26Z,system,host.local,load5,1.95\n,,2,2018-05-22T19:53:36Z,system,host.local,load5,1.92\n,,2,2018-05-22T19:53:46Z,system,host.local,load5,1.92\n,,2,2018-05-22T19:53:56Z,system,host.local,load5,1.89\n,,2,2018-05-22T19:54:06Z,system,host.local,load5,1.94\n,,2,2018-05-22T19:54:16Z,system,host.local,load5,1.93\n\n,,3,2018-05-22T19:53:26Z,swap,host.global,used_percent,82.98\n,,3,2018-05-22T19:53:36Z,swap,host.global,used_percent,82.59\n,,3,2018-05-22T19:53:46Z,swap,host.global,used_percent,82.59\n,,3,2018-05-22T19:53:56Z,swap,host.global,used_percent,82.59\n,,3,2018-05-22T19:54:06Z,swap,host.global,used_percent,82.59\n,,3,2018-05-22T19:54:16Z,swap,host.global,used_percent,82.64\n\n#datatype,string,long,dateTime:RFC3339,string,string,string,long\n#group,false,false,false,true,true,true,false\n#default,_result,,,,,,\n,result,table,_time,_measurement,host,_field,_value\n,,0,2018-05-22T19:53:26Z,sys,host.global,load7,183\n,,0,2018-05-22T19:53:36Z,sys,host.global,load7,172\n,,0,2018-05-22T19:53:46Z,sys,host.global,load7,174\n,,0,2018-05-22T19:53:56Z,sys,host.global,load7,163\n,,0,2018-05-22T19:54:06Z,sys,host.global,load7,191\n,,0,2018-05-22T19:54:16Z,sys,host.global,load7,184\n\n,,1,2018-05-22T19:53:26Z,sys,host.local,load8,198\n,,1,2018-05-22T19:53:36Z,sys,host.local,load8,197\n,,1,2018-05-22T19:53:46Z,sys,host.local,load8,197\n,,1,2018-05-22T19:53:56Z,sys,host.local,load8,196\n,,1,2018-05-22T19:54:06Z,sys,host.local,load8,198\n,,1,2018-05-22T19:54:16Z,sys,host.local,load8,197\n\n,,2,2018-05-22T19:53:26Z,sys,host.global,load9,195\n,,2,2018-05-22T19:53:36Z,sys,host.global,load9,192\n,,2,2018-05-22T19:53:46Z,sys,host.global,load9,192\n,,2,2018-05-22T19:53:56Z,sys,host.global,load9,189\n,,2,2018-05-22T19:54:06Z,sys,host.global,load9,194\n,,2,2018-05-22T19:54:16Z,sys,host.global,load9,193\n\n,,3,2018-05-22T19:53:26Z,swp,host.global,used_percent,8298\n,,3,2018-05-22T19:53:36Z,swp,host.global,used_percent,8259\n,,3,2018-05-22T19:53:46Z,swp,host.global,used_percent,8259\n,,3,2018-05-22T19:53:56Z,swp,host.global,used_percent,8259\n,,3,2018-05-22T19:54:06Z,swp,host.global,used_percent,8259\n,,3,2018-05-22T19:54:16Z,swp,host.global,used_percent,8264\n",
 			},
 		}, &ast.VariableAssignment{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 2,
@@ -4269,10 +4760,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   71,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 7,
@@ -4285,12 +4778,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   71,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "output",
 			},
 			Init: &ast.StringLiteral{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 2,
@@ -4303,12 +4798,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   71,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Value: "\n#datatype,string,long,string\n#group,false,false,false\n#default,0,,\n,result,table,_value\n,,0,load3\n,,0,load8\n",
 			},
 		}, &ast.VariableAssignment{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 14,
@@ -4321,10 +4818,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   80,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 26,
@@ -4337,12 +4836,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   80,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "measurement_tag_values_fn",
 			},
 			Init: &ast.FunctionExpression{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 14,
@@ -4355,6 +4856,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   80,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Body: &ast.PipeExpression{
 					Argument: &ast.PipeExpression{
@@ -4365,7 +4867,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										Argument: &ast.PipeExpression{
 											Argument: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 50,
@@ -4378,11 +4881,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   80,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "tables",
 											},
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 70,
@@ -4395,11 +4900,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   80,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Call: &ast.CallExpression{
 												Arguments: []ast.Expression{&ast.ObjectExpression{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 69,
@@ -4412,10 +4919,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																Line:   81,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Properties: []*ast.Property{&ast.Property{
 														BaseNode: ast.BaseNode{
-															Errors: nil,
+															Comments: nil,
+															Errors:   nil,
 															Loc: &ast.SourceLocation{
 																End: ast.Position{
 																	Column: 41,
@@ -4428,10 +4937,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																	Line:   81,
 																},
 															},
+															TrailingComment: nil,
 														},
 														Key: &ast.Identifier{
 															BaseNode: ast.BaseNode{
-																Errors: nil,
+																Comments: nil,
+																Errors:   nil,
 																Loc: &ast.SourceLocation{
 																	End: ast.Position{
 																		Column: 19,
@@ -4444,12 +4955,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																		Line:   81,
 																	},
 																},
+																TrailingComment: nil,
 															},
 															Name: "start",
 														},
 														Value: &ast.DateTimeLiteral{
 															BaseNode: ast.BaseNode{
-																Errors: nil,
+																Comments: nil,
+																Errors:   nil,
 																Loc: &ast.SourceLocation{
 																	End: ast.Position{
 																		Column: 41,
@@ -4462,12 +4975,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																		Line:   81,
 																	},
 																},
+																TrailingComment: nil,
 															},
 															Value: parser.MustParseTime("2018-01-01T00:00:00Z"),
 														},
 													}, &ast.Property{
 														BaseNode: ast.BaseNode{
-															Errors: nil,
+															Comments: nil,
+															Errors:   nil,
 															Loc: &ast.SourceLocation{
 																End: ast.Position{
 																	Column: 69,
@@ -4480,10 +4995,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																	Line:   81,
 																},
 															},
+															TrailingComment: nil,
 														},
 														Key: &ast.Identifier{
 															BaseNode: ast.BaseNode{
-																Errors: nil,
+																Comments: nil,
+																Errors:   nil,
 																Loc: &ast.SourceLocation{
 																	End: ast.Position{
 																		Column: 47,
@@ -4496,12 +5013,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																		Line:   81,
 																	},
 																},
+																TrailingComment: nil,
 															},
 															Name: "stop",
 														},
 														Value: &ast.DateTimeLiteral{
 															BaseNode: ast.BaseNode{
-																Errors: nil,
+																Comments: nil,
+																Errors:   nil,
 																Loc: &ast.SourceLocation{
 																	End: ast.Position{
 																		Column: 69,
@@ -4514,6 +5033,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																		Line:   81,
 																	},
 																},
+																TrailingComment: nil,
 															},
 															Value: parser.MustParseTime("2019-01-01T00:00:00Z"),
 														},
@@ -4521,7 +5041,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													With: nil,
 												}},
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 70,
@@ -4534,10 +5055,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   81,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Callee: &ast.Identifier{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 13,
@@ -4550,13 +5073,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																Line:   81,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Name: "range",
 												},
 											},
 										},
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 50,
@@ -4569,11 +5094,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   80,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Call: &ast.CallExpression{
 											Arguments: []ast.Expression{&ast.ObjectExpression{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 49,
@@ -4586,10 +5113,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   82,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Properties: []*ast.Property{&ast.Property{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 49,
@@ -4602,10 +5131,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																Line:   82,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Key: &ast.Identifier{
 														BaseNode: ast.BaseNode{
-															Errors: nil,
+															Comments: nil,
+															Errors:   nil,
 															Loc: &ast.SourceLocation{
 																End: ast.Position{
 																	Column: 17,
@@ -4618,12 +5149,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																	Line:   82,
 																},
 															},
+															TrailingComment: nil,
 														},
 														Name: "fn",
 													},
 													Value: &ast.FunctionExpression{
 														BaseNode: ast.BaseNode{
-															Errors: nil,
+															Comments: nil,
+															Errors:   nil,
 															Loc: &ast.SourceLocation{
 																End: ast.Position{
 																	Column: 49,
@@ -4636,10 +5169,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																	Line:   82,
 																},
 															},
+															TrailingComment: nil,
 														},
 														Body: &ast.BinaryExpression{
 															BaseNode: ast.BaseNode{
-																Errors: nil,
+																Comments: nil,
+																Errors:   nil,
 																Loc: &ast.SourceLocation{
 																	End: ast.Position{
 																		Column: 49,
@@ -4652,10 +5187,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																		Line:   82,
 																	},
 																},
+																TrailingComment: nil,
 															},
 															Left: &ast.MemberExpression{
 																BaseNode: ast.BaseNode{
-																	Errors: nil,
+																	Comments: nil,
+																	Errors:   nil,
 																	Loc: &ast.SourceLocation{
 																		End: ast.Position{
 																			Column: 40,
@@ -4668,10 +5205,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																			Line:   82,
 																		},
 																	},
+																	TrailingComment: nil,
 																},
 																Object: &ast.Identifier{
 																	BaseNode: ast.BaseNode{
-																		Errors: nil,
+																		Comments: nil,
+																		Errors:   nil,
 																		Loc: &ast.SourceLocation{
 																			End: ast.Position{
 																				Column: 27,
@@ -4684,12 +5223,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																				Line:   82,
 																			},
 																		},
+																		TrailingComment: nil,
 																	},
 																	Name: "r",
 																},
 																Property: &ast.Identifier{
 																	BaseNode: ast.BaseNode{
-																		Errors: nil,
+																		Comments: nil,
+																		Errors:   nil,
 																		Loc: &ast.SourceLocation{
 																			End: ast.Position{
 																				Column: 40,
@@ -4702,6 +5243,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																				Line:   82,
 																			},
 																		},
+																		TrailingComment: nil,
 																	},
 																	Name: "_measurement",
 																},
@@ -4709,7 +5251,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Operator: 17,
 															Right: &ast.StringLiteral{
 																BaseNode: ast.BaseNode{
-																	Errors: nil,
+																	Comments: nil,
+																	Errors:   nil,
 																	Loc: &ast.SourceLocation{
 																		End: ast.Position{
 																			Column: 49,
@@ -4722,13 +5265,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																			Line:   82,
 																		},
 																	},
+																	TrailingComment: nil,
 																},
 																Value: "sys",
 															},
 														},
 														Params: []*ast.Property{&ast.Property{
 															BaseNode: ast.BaseNode{
-																Errors: nil,
+																Comments: nil,
+																Errors:   nil,
 																Loc: &ast.SourceLocation{
 																	End: ast.Position{
 																		Column: 21,
@@ -4741,10 +5286,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																		Line:   82,
 																	},
 																},
+																TrailingComment: nil,
 															},
 															Key: &ast.Identifier{
 																BaseNode: ast.BaseNode{
-																	Errors: nil,
+																	Comments: nil,
+																	Errors:   nil,
 																	Loc: &ast.SourceLocation{
 																		End: ast.Position{
 																			Column: 21,
@@ -4757,6 +5304,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																			Line:   82,
 																		},
 																	},
+																	TrailingComment: nil,
 																},
 																Name: "r",
 															},
@@ -4767,7 +5315,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												With: nil,
 											}},
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 50,
@@ -4780,10 +5329,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   82,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Callee: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 14,
@@ -4796,13 +5347,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   82,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "filter",
 											},
 										},
 									},
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 49,
@@ -4815,11 +5368,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   80,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Call: &ast.CallExpression{
 										Arguments: []ast.Expression{&ast.ObjectExpression{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 48,
@@ -4832,10 +5387,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   83,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Properties: []*ast.Property{&ast.Property{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 48,
@@ -4848,10 +5405,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   83,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Key: &ast.Identifier{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 17,
@@ -4864,12 +5423,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																Line:   83,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Name: "fn",
 												},
 												Value: &ast.FunctionExpression{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 48,
@@ -4882,10 +5443,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																Line:   83,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Body: &ast.BinaryExpression{
 														BaseNode: ast.BaseNode{
-															Errors: nil,
+															Comments: nil,
+															Errors:   nil,
 															Loc: &ast.SourceLocation{
 																End: ast.Position{
 																	Column: 48,
@@ -4898,10 +5461,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																	Line:   83,
 																},
 															},
+															TrailingComment: nil,
 														},
 														Left: &ast.MemberExpression{
 															BaseNode: ast.BaseNode{
-																Errors: nil,
+																Comments: nil,
+																Errors:   nil,
 																Loc: &ast.SourceLocation{
 																	End: ast.Position{
 																		Column: 32,
@@ -4914,10 +5479,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																		Line:   83,
 																	},
 																},
+																TrailingComment: nil,
 															},
 															Object: &ast.Identifier{
 																BaseNode: ast.BaseNode{
-																	Errors: nil,
+																	Comments: nil,
+																	Errors:   nil,
 																	Loc: &ast.SourceLocation{
 																		End: ast.Position{
 																			Column: 27,
@@ -4930,12 +5497,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																			Line:   83,
 																		},
 																	},
+																	TrailingComment: nil,
 																},
 																Name: "r",
 															},
 															Property: &ast.Identifier{
 																BaseNode: ast.BaseNode{
-																	Errors: nil,
+																	Comments: nil,
+																	Errors:   nil,
 																	Loc: &ast.SourceLocation{
 																		End: ast.Position{
 																			Column: 32,
@@ -4948,6 +5517,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																			Line:   83,
 																		},
 																	},
+																	TrailingComment: nil,
 																},
 																Name: "host",
 															},
@@ -4955,7 +5525,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Operator: 17,
 														Right: &ast.StringLiteral{
 															BaseNode: ast.BaseNode{
-																Errors: nil,
+																Comments: nil,
+																Errors:   nil,
 																Loc: &ast.SourceLocation{
 																	End: ast.Position{
 																		Column: 48,
@@ -4968,13 +5539,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																		Line:   83,
 																	},
 																},
+																TrailingComment: nil,
 															},
 															Value: "host.local",
 														},
 													},
 													Params: []*ast.Property{&ast.Property{
 														BaseNode: ast.BaseNode{
-															Errors: nil,
+															Comments: nil,
+															Errors:   nil,
 															Loc: &ast.SourceLocation{
 																End: ast.Position{
 																	Column: 21,
@@ -4987,10 +5560,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																	Line:   83,
 																},
 															},
+															TrailingComment: nil,
 														},
 														Key: &ast.Identifier{
 															BaseNode: ast.BaseNode{
-																Errors: nil,
+																Comments: nil,
+																Errors:   nil,
 																Loc: &ast.SourceLocation{
 																	End: ast.Position{
 																		Column: 21,
@@ -5003,6 +5578,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																		Line:   83,
 																	},
 																},
+																TrailingComment: nil,
 															},
 															Name: "r",
 														},
@@ -5013,7 +5589,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											With: nil,
 										}},
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 49,
@@ -5026,10 +5603,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   83,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Callee: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 14,
@@ -5042,13 +5621,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   83,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "filter",
 										},
 									},
 								},
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 33,
@@ -5061,11 +5642,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   80,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Call: &ast.CallExpression{
 									Arguments: []ast.Expression{&ast.ObjectExpression{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 32,
@@ -5078,10 +5661,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   84,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Properties: []*ast.Property{&ast.Property{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 32,
@@ -5094,10 +5679,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   84,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Key: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 20,
@@ -5110,12 +5697,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   84,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "columns",
 											},
 											Value: &ast.ArrayExpression{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 32,
@@ -5128,10 +5717,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   84,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Elements: []ast.Expression{&ast.StringLiteral{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 31,
@@ -5144,6 +5735,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																Line:   84,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Value: "_field",
 												}},
@@ -5152,7 +5744,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										With: nil,
 									}},
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 33,
@@ -5165,10 +5758,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   84,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Callee: &ast.Identifier{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 12,
@@ -5181,13 +5776,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   84,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Name: "keep",
 									},
 								},
 							},
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 15,
@@ -5200,11 +5797,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										Line:   80,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Call: &ast.CallExpression{
 								Arguments: nil,
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 15,
@@ -5217,10 +5816,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   85,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Callee: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 13,
@@ -5233,13 +5834,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   85,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "group",
 								},
 							},
 						},
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 34,
@@ -5252,11 +5855,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Line:   80,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Call: &ast.CallExpression{
 							Arguments: []ast.Expression{&ast.ObjectExpression{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 33,
@@ -5269,10 +5874,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   86,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Properties: []*ast.Property{&ast.Property{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 33,
@@ -5285,10 +5892,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   86,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Key: &ast.Identifier{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 23,
@@ -5301,12 +5910,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   86,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Name: "column",
 									},
 									Value: &ast.StringLiteral{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 33,
@@ -5319,6 +5930,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   86,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Value: "_field",
 									},
@@ -5326,7 +5938,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								With: nil,
 							}},
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 34,
@@ -5339,10 +5952,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										Line:   86,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Callee: &ast.Identifier{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 16,
@@ -5355,13 +5970,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   86,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Name: "distinct",
 							},
 						},
 					},
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 14,
@@ -5374,11 +5991,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   80,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Call: &ast.CallExpression{
 						Arguments: nil,
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 14,
@@ -5391,10 +6010,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Line:   87,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Callee: &ast.Identifier{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 12,
@@ -5407,6 +6028,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										Line:   87,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Name: "sort",
 						},
@@ -5414,7 +6036,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 				},
 				Params: []*ast.Property{&ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 39,
@@ -5427,10 +6050,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   80,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 36,
@@ -5443,11 +6068,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Line:   80,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Name: "tables",
 					},
 					Value: &ast.PipeLiteral{BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 39,
@@ -5460,13 +6087,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   80,
 							},
 						},
+						TrailingComment: nil,
 					}},
 				}},
 			},
 		}, &ast.TestStatement{
 			Assignment: &ast.VariableAssignment{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 114,
@@ -5479,10 +6108,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   89,
 						},
 					},
+					TrailingComment: nil,
 				},
 				ID: &ast.Identifier{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 28,
@@ -5495,12 +6126,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   89,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Name: "measurement_tag_values",
 				},
 				Init: &ast.FunctionExpression{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 114,
@@ -5513,10 +6146,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   89,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Body: &ast.ParenExpression{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 114,
@@ -5529,10 +6164,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Line:   90,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Expression: &ast.ObjectExpression{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 113,
@@ -5545,10 +6182,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										Line:   90,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Properties: []*ast.Property{&ast.Property{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 45,
@@ -5561,10 +6200,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   90,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Key: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 12,
@@ -5577,13 +6218,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   90,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "input",
 								},
 								Value: &ast.CallExpression{
 									Arguments: []ast.Expression{&ast.ObjectExpression{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 44,
@@ -5596,10 +6239,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   90,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Properties: []*ast.Property{&ast.Property{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 44,
@@ -5612,10 +6257,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   90,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Key: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 37,
@@ -5628,12 +6275,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   90,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "csv",
 											},
 											Value: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 44,
@@ -5646,6 +6295,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   90,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "input",
 											},
@@ -5653,7 +6303,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										With: nil,
 									}},
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 45,
@@ -5666,10 +6317,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   90,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Callee: &ast.MemberExpression{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 33,
@@ -5682,10 +6335,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   90,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Object: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 21,
@@ -5698,12 +6353,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   90,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "testing",
 										},
 										Property: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 33,
@@ -5716,6 +6373,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   90,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "loadStorage",
 										},
@@ -5723,7 +6381,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								},
 							}, &ast.Property{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 81,
@@ -5736,10 +6395,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   90,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Key: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 51,
@@ -5752,13 +6413,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   90,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "want",
 								},
 								Value: &ast.CallExpression{
 									Arguments: []ast.Expression{&ast.ObjectExpression{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 80,
@@ -5771,10 +6434,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   90,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Properties: []*ast.Property{&ast.Property{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 80,
@@ -5787,10 +6452,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   90,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Key: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 72,
@@ -5803,12 +6470,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   90,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "csv",
 											},
 											Value: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 80,
@@ -5821,6 +6490,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   90,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "output",
 											},
@@ -5828,7 +6498,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										With: nil,
 									}},
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 81,
@@ -5841,10 +6512,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   90,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Callee: &ast.MemberExpression{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 68,
@@ -5857,10 +6530,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   90,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Object: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 60,
@@ -5873,12 +6548,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   90,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "testing",
 										},
 										Property: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 68,
@@ -5891,6 +6568,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   90,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "loadMem",
 										},
@@ -5898,7 +6576,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								},
 							}, &ast.Property{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 112,
@@ -5911,10 +6590,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   90,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Key: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 85,
@@ -5927,12 +6608,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   90,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "fn",
 								},
 								Value: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 112,
@@ -5945,6 +6628,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   90,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "measurement_tag_values_fn",
 								},
@@ -5956,7 +6640,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 				},
 			},
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 114,
@@ -5969,12 +6654,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   89,
 					},
 				},
+				TrailingComment: nil,
 			},
 		}},
+		Eof: nil,
 		Imports: []*ast.ImportDeclaration{&ast.ImportDeclaration{
 			As: nil,
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 17,
@@ -5987,10 +6675,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   3,
 					},
 				},
+				TrailingComment: nil,
 			},
 			Path: &ast.StringLiteral{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 17,
@@ -6003,6 +6693,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   3,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Value: "testing",
 			},
@@ -6011,7 +6702,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 		Name:     "measurement_tag_values_test.flux",
 		Package: &ast.PackageClause{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 16,
@@ -6024,10 +6716,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   1,
 					},
 				},
+				TrailingComment: nil,
 			},
 			Name: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 16,
@@ -6040,13 +6734,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   1,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "v1_test",
 			},
 		},
 	}, &ast.File{
 		BaseNode: ast.BaseNode{
-			Errors: nil,
+			Comments: nil,
+			Errors:   nil,
 			Loc: &ast.SourceLocation{
 				End: ast.Position{
 					Column: 109,
@@ -6059,10 +6755,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 					Line:   1,
 				},
 			},
+			TrailingComment: nil,
 		},
 		Body: []ast.Statement{&ast.VariableAssignment{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 2,
@@ -6075,10 +6773,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   5,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 6,
@@ -6091,12 +6791,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   5,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "input",
 			},
 			Init: &ast.StringLiteral{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 2,
@@ -6109,12 +6811,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   5,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Value: "\n#datatype,string,long,dateTime:RFC3339,string,string,string,double\n#group,false,false,false,true,true,true,false\n#default,_result,,,,,,\n,result,table,_time,_measurement,host,_field,_value\n,,0,2018-05-22T19:53:26Z,system,host.local,load1,1.83\n,,0,2018-05-22T19:53:36Z,system,host.local,load1,1.72\n,,0,2018-05-22T19:53:46Z,system,host.local,load1,1.74\n,,0,2018-05-22T19:53:56Z,system,host.local,load1,1.63\n,,0,2018-05-22T19:54:06Z,system,host.local,load1,1.91\n,,0,2018-05-22T19:54:16Z,system,host.local,load1,1.84\n\n,,1,2018-05-22T19:53:26Z,system,host.local,load3,1.98\n,,1,2018-05-22T19:53:36Z,system,host.local,load3,1.97\n,,1,2018-05-22T19:53:46Z,system,host.local,load3,1.97\n,,1,2018-05-22T19:53:56Z,system,host.local,load3,1.96\n,,1,2018-05-22T19:54:06Z,system,host.local,load3,1.98\n,,1,2018-05-22T19:54:16Z,system,host.local,load3,1.97\n\n,,2,2018-05-22T19:53:26Z,system,host.local,load5,1.95\n,,2,2018-05-22T19:53:36Z,system,host.local,load5,1.92\n,,2,2018-05-22T19:53:46Z,system,host.local,load5,1.92\n,,2,2018-05-22T19:53:56Z,system,host.local,load5,1.89\n,,2,2018-05-22T19:54:06Z,system,host.local,load5,1.94\n,,2,2018-05-22T19:54:16Z,system,host.local,load5,1.93\n\n,,3,2018-05-22T19:53:26Z,swap,host.local,used_percent,82.98\n,,3,2018-05-22T19:53:36Z,swap,host.local,used_percent,82.59\n,,3,2018-05-22T19:53:46Z,swap,host.local,used_percent,82.59\n,,3,2018-05-22T19:53:56Z,swap,host.local,used_percent,82.59\n,,3,2018-05-22T19:54:06Z,swap,host.local,used_percent,82.59\n,,3,2018-05-22T19:54:16Z,swap,host.local,used_percent,82.64\n",
 			},
 		}, &ast.VariableAssignment{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 2,
@@ -6127,10 +6831,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   39,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 7,
@@ -6143,12 +6849,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   39,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "output",
 			},
 			Init: &ast.StringLiteral{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 2,
@@ -6161,12 +6869,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   39,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Value: "\n#datatype,string,long,string\n#group,false,false,false\n#default,0,,\n,result,table,_value\n,,0,swap\n,,0,system\n",
 			},
 		}, &ast.VariableAssignment{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 14,
@@ -6179,10 +6889,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   48,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 21,
@@ -6195,12 +6907,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   48,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "show_measurements_fn",
 			},
 			Init: &ast.FunctionExpression{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 14,
@@ -6213,6 +6927,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   48,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Body: &ast.PipeExpression{
 					Argument: &ast.PipeExpression{
@@ -6222,7 +6937,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Argument: &ast.PipeExpression{
 										Argument: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 45,
@@ -6235,11 +6951,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   48,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "tables",
 										},
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 70,
@@ -6252,11 +6970,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   48,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Call: &ast.CallExpression{
 											Arguments: []ast.Expression{&ast.ObjectExpression{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 69,
@@ -6269,10 +6989,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   49,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Properties: []*ast.Property{&ast.Property{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 41,
@@ -6285,10 +7007,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																Line:   49,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Key: &ast.Identifier{
 														BaseNode: ast.BaseNode{
-															Errors: nil,
+															Comments: nil,
+															Errors:   nil,
 															Loc: &ast.SourceLocation{
 																End: ast.Position{
 																	Column: 19,
@@ -6301,12 +7025,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																	Line:   49,
 																},
 															},
+															TrailingComment: nil,
 														},
 														Name: "start",
 													},
 													Value: &ast.DateTimeLiteral{
 														BaseNode: ast.BaseNode{
-															Errors: nil,
+															Comments: nil,
+															Errors:   nil,
 															Loc: &ast.SourceLocation{
 																End: ast.Position{
 																	Column: 41,
@@ -6319,12 +7045,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																	Line:   49,
 																},
 															},
+															TrailingComment: nil,
 														},
 														Value: parser.MustParseTime("2018-01-01T00:00:00Z"),
 													},
 												}, &ast.Property{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 69,
@@ -6337,10 +7065,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																Line:   49,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Key: &ast.Identifier{
 														BaseNode: ast.BaseNode{
-															Errors: nil,
+															Comments: nil,
+															Errors:   nil,
 															Loc: &ast.SourceLocation{
 																End: ast.Position{
 																	Column: 47,
@@ -6353,12 +7083,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																	Line:   49,
 																},
 															},
+															TrailingComment: nil,
 														},
 														Name: "stop",
 													},
 													Value: &ast.DateTimeLiteral{
 														BaseNode: ast.BaseNode{
-															Errors: nil,
+															Comments: nil,
+															Errors:   nil,
 															Loc: &ast.SourceLocation{
 																End: ast.Position{
 																	Column: 69,
@@ -6371,6 +7103,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																	Line:   49,
 																},
 															},
+															TrailingComment: nil,
 														},
 														Value: parser.MustParseTime("2019-01-01T00:00:00Z"),
 													},
@@ -6378,7 +7111,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												With: nil,
 											}},
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 70,
@@ -6391,10 +7125,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   49,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Callee: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 13,
@@ -6407,13 +7143,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   49,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "range",
 											},
 										},
 									},
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 31,
@@ -6426,11 +7164,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   48,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Call: &ast.CallExpression{
 										Arguments: []ast.Expression{&ast.ObjectExpression{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 30,
@@ -6443,10 +7183,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   50,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Properties: []*ast.Property{&ast.Property{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 30,
@@ -6459,10 +7201,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   50,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Key: &ast.Identifier{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 17,
@@ -6475,12 +7219,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																Line:   50,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Name: "fn",
 												},
 												Value: &ast.FunctionExpression{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 30,
@@ -6493,10 +7239,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																Line:   50,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Body: &ast.Identifier{
 														BaseNode: ast.BaseNode{
-															Errors: nil,
+															Comments: nil,
+															Errors:   nil,
 															Loc: &ast.SourceLocation{
 																End: ast.Position{
 																	Column: 30,
@@ -6509,12 +7257,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																	Line:   50,
 																},
 															},
+															TrailingComment: nil,
 														},
 														Name: "true",
 													},
 													Params: []*ast.Property{&ast.Property{
 														BaseNode: ast.BaseNode{
-															Errors: nil,
+															Comments: nil,
+															Errors:   nil,
 															Loc: &ast.SourceLocation{
 																End: ast.Position{
 																	Column: 21,
@@ -6527,10 +7277,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																	Line:   50,
 																},
 															},
+															TrailingComment: nil,
 														},
 														Key: &ast.Identifier{
 															BaseNode: ast.BaseNode{
-																Errors: nil,
+																Comments: nil,
+																Errors:   nil,
 																Loc: &ast.SourceLocation{
 																	End: ast.Position{
 																		Column: 21,
@@ -6543,6 +7295,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																		Line:   50,
 																	},
 																},
+																TrailingComment: nil,
 															},
 															Name: "r",
 														},
@@ -6553,7 +7306,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											With: nil,
 										}},
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 31,
@@ -6566,10 +7320,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   50,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Callee: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 14,
@@ -6582,13 +7338,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   50,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "filter",
 										},
 									},
 								},
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 39,
@@ -6601,11 +7359,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   48,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Call: &ast.CallExpression{
 									Arguments: []ast.Expression{&ast.ObjectExpression{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 38,
@@ -6618,10 +7378,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   51,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Properties: []*ast.Property{&ast.Property{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 38,
@@ -6634,10 +7396,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   51,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Key: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 20,
@@ -6650,12 +7414,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   51,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "columns",
 											},
 											Value: &ast.ArrayExpression{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 38,
@@ -6668,10 +7434,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   51,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Elements: []ast.Expression{&ast.StringLiteral{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 37,
@@ -6684,6 +7452,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																Line:   51,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Value: "_measurement",
 												}},
@@ -6692,7 +7461,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										With: nil,
 									}},
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 39,
@@ -6705,10 +7475,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   51,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Callee: &ast.Identifier{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 12,
@@ -6721,13 +7493,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   51,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Name: "keep",
 									},
 								},
 							},
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 15,
@@ -6740,11 +7514,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										Line:   48,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Call: &ast.CallExpression{
 								Arguments: nil,
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 15,
@@ -6757,10 +7533,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   52,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Callee: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 13,
@@ -6773,13 +7551,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   52,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "group",
 								},
 							},
 						},
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 40,
@@ -6792,11 +7572,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Line:   48,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Call: &ast.CallExpression{
 							Arguments: []ast.Expression{&ast.ObjectExpression{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 39,
@@ -6809,10 +7591,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   53,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Properties: []*ast.Property{&ast.Property{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 39,
@@ -6825,10 +7609,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   53,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Key: &ast.Identifier{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 23,
@@ -6841,12 +7627,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   53,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Name: "column",
 									},
 									Value: &ast.StringLiteral{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 39,
@@ -6859,6 +7647,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   53,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Value: "_measurement",
 									},
@@ -6866,7 +7655,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								With: nil,
 							}},
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 40,
@@ -6879,10 +7669,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										Line:   53,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Callee: &ast.Identifier{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 16,
@@ -6895,13 +7687,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   53,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Name: "distinct",
 							},
 						},
 					},
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 14,
@@ -6914,11 +7708,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   48,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Call: &ast.CallExpression{
 						Arguments: nil,
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 14,
@@ -6931,10 +7727,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Line:   54,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Callee: &ast.Identifier{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 12,
@@ -6947,6 +7745,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										Line:   54,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Name: "sort",
 						},
@@ -6954,7 +7753,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 				},
 				Params: []*ast.Property{&ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 34,
@@ -6967,10 +7767,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   48,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 31,
@@ -6983,11 +7785,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Line:   48,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Name: "tables",
 					},
 					Value: &ast.PipeLiteral{BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 34,
@@ -7000,13 +7804,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   48,
 							},
 						},
+						TrailingComment: nil,
 					}},
 				}},
 			},
 		}, &ast.TestStatement{
 			Assignment: &ast.VariableAssignment{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 109,
@@ -7019,10 +7825,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   56,
 						},
 					},
+					TrailingComment: nil,
 				},
 				ID: &ast.Identifier{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 23,
@@ -7035,12 +7843,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   56,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Name: "show_measurements",
 				},
 				Init: &ast.FunctionExpression{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 109,
@@ -7053,10 +7863,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   56,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Body: &ast.ParenExpression{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 109,
@@ -7069,10 +7881,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Line:   57,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Expression: &ast.ObjectExpression{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 108,
@@ -7085,10 +7899,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										Line:   57,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Properties: []*ast.Property{&ast.Property{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 45,
@@ -7101,10 +7917,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   57,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Key: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 12,
@@ -7117,13 +7935,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   57,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "input",
 								},
 								Value: &ast.CallExpression{
 									Arguments: []ast.Expression{&ast.ObjectExpression{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 44,
@@ -7136,10 +7956,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   57,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Properties: []*ast.Property{&ast.Property{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 44,
@@ -7152,10 +7974,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   57,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Key: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 37,
@@ -7168,12 +7992,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   57,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "csv",
 											},
 											Value: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 44,
@@ -7186,6 +8012,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   57,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "input",
 											},
@@ -7193,7 +8020,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										With: nil,
 									}},
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 45,
@@ -7206,10 +8034,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   57,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Callee: &ast.MemberExpression{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 33,
@@ -7222,10 +8052,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   57,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Object: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 21,
@@ -7238,12 +8070,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   57,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "testing",
 										},
 										Property: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 33,
@@ -7256,6 +8090,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   57,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "loadStorage",
 										},
@@ -7263,7 +8098,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								},
 							}, &ast.Property{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 81,
@@ -7276,10 +8112,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   57,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Key: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 51,
@@ -7292,13 +8130,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   57,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "want",
 								},
 								Value: &ast.CallExpression{
 									Arguments: []ast.Expression{&ast.ObjectExpression{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 80,
@@ -7311,10 +8151,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   57,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Properties: []*ast.Property{&ast.Property{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 80,
@@ -7327,10 +8169,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   57,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Key: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 72,
@@ -7343,12 +8187,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   57,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "csv",
 											},
 											Value: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 80,
@@ -7361,6 +8207,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   57,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "output",
 											},
@@ -7368,7 +8215,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										With: nil,
 									}},
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 81,
@@ -7381,10 +8229,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   57,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Callee: &ast.MemberExpression{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 68,
@@ -7397,10 +8247,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   57,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Object: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 60,
@@ -7413,12 +8265,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   57,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "testing",
 										},
 										Property: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 68,
@@ -7431,6 +8285,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   57,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "loadMem",
 										},
@@ -7438,7 +8293,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								},
 							}, &ast.Property{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 107,
@@ -7451,10 +8307,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   57,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Key: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 85,
@@ -7467,12 +8325,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   57,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "fn",
 								},
 								Value: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 107,
@@ -7485,6 +8345,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   57,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "show_measurements_fn",
 								},
@@ -7496,7 +8357,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 				},
 			},
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 109,
@@ -7509,12 +8371,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   56,
 					},
 				},
+				TrailingComment: nil,
 			},
 		}},
+		Eof: nil,
 		Imports: []*ast.ImportDeclaration{&ast.ImportDeclaration{
 			As: nil,
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 17,
@@ -7527,10 +8392,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   3,
 					},
 				},
+				TrailingComment: nil,
 			},
 			Path: &ast.StringLiteral{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 17,
@@ -7543,6 +8410,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   3,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Value: "testing",
 			},
@@ -7551,7 +8419,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 		Name:     "show_measurements_test.flux",
 		Package: &ast.PackageClause{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 16,
@@ -7564,10 +8433,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   1,
 					},
 				},
+				TrailingComment: nil,
 			},
 			Name: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 16,
@@ -7580,13 +8451,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   1,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "v1_test",
 			},
 		},
 	}, &ast.File{
 		BaseNode: ast.BaseNode{
-			Errors: nil,
+			Comments: nil,
+			Errors:   nil,
 			Loc: &ast.SourceLocation{
 				End: ast.Position{
 					Column: 105,
@@ -7599,10 +8472,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 					Line:   1,
 				},
 			},
+			TrailingComment: nil,
 		},
 		Body: []ast.Statement{&ast.VariableAssignment{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 2,
@@ -7615,10 +8490,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   5,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 6,
@@ -7631,12 +8508,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   5,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "input",
 			},
 			Init: &ast.StringLiteral{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 2,
@@ -7649,12 +8528,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   5,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Value: "\n#datatype,string,long,dateTime:RFC3339,string,string,string,double\n#group,false,false,false,true,true,true,false\n#default,_result,,,,,,\n,result,table,_time,_measurement,host,_field,_value\n,,0,2018-05-22T19:53:26Z,system,host.local,load1,1.83\n,,0,2018-05-22T19:53:36Z,system,host.local,load1,1.72\n,,0,2018-05-22T19:53:46Z,system,host.local,load1,1.74\n,,0,2018-05-22T19:53:56Z,system,host.local,load1,1.63\n,,0,2018-05-22T19:54:06Z,system,host.local,load1,1.91\n,,0,2018-05-22T19:54:16Z,system,host.local,load1,1.84\n\n,,1,2018-05-22T19:53:26Z,system,host.local,load3,1.98\n,,1,2018-05-22T19:53:36Z,system,host.local,load3,1.97\n,,1,2018-05-22T19:53:46Z,system,host.local,load3,1.97\n,,1,2018-05-22T19:53:56Z,system,host.local,load3,1.96\n,,1,2018-05-22T19:54:06Z,system,host.local,load3,1.98\n,,1,2018-05-22T19:54:16Z,system,host.local,load3,1.97\n\n,,2,2018-05-22T19:53:26Z,system,host.local,load5,1.95\n,,2,2018-05-22T19:53:36Z,system,host.local,load5,1.92\n,,2,2018-05-22T19:53:46Z,system,host.local,load5,1.92\n,,2,2018-05-22T19:53:56Z,system,host.local,load5,1.89\n,,2,2018-05-22T19:54:06Z,system,host.local,load5,1.94\n,,2,2018-05-22T19:54:16Z,system,host.local,load5,1.93\n\n#datatype,string,long,dateTime:RFC3339,string,string,string,string,long\n#group,false,false,false,true,true,true,true,false\n#default,_result,,,,,,,\n,result,table,_time,_measurement,host,region,_field,_value\n,,0,2018-05-22T19:53:26Z,system,us-east,host.local,load1,10\n,,0,2018-05-22T19:53:36Z,system,us-east,host.local,load1,11\n,,0,2018-05-22T19:53:46Z,system,us-east,host.local,load1,18\n,,0,2018-05-22T19:53:56Z,system,us-east,host.local,load1,19\n,,0,2018-05-22T19:54:06Z,system,us-east,host.local,load1,17\n,,0,2018-05-22T19:54:16Z,system,us-east,host.local,load1,17\n\n,,1,2018-05-22T19:53:26Z,system,us-east,host.local,load3,16\n,,1,2018-05-22T19:53:36Z,system,us-east,host.local,load3,16\n,,1,2018-05-22T19:53:46Z,system,us-east,host.local,load3,15\n,,1,2018-05-22T19:53:56Z,system,us-east,host.local,load3,19\n,,1,2018-05-22T19:54:06Z,system,us-east,host.local,load3,19\n,,1,2018-05-22T19:54:16Z,system,us-east,host.local,load3,19\n\n,,2,2018-05-22T19:53:26Z,system,us-west,host.local,load5,19\n,,2,2018-05-22T19:53:36Z,system,us-west,host.local,load5,22\n,,2,2018-05-22T19:53:46Z,system,us-west,host.local,load5,11\n,,2,2018-05-22T19:53:56Z,system,us-west,host.local,load5,12\n,,2,2018-05-22T19:54:06Z,system,us-west,host.local,load5,13\n,,2,2018-05-22T19:54:16Z,system,us-west,host.local,load5,13\n",
 			},
 		}, &ast.VariableAssignment{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 2,
@@ -7667,10 +8548,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   57,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 7,
@@ -7683,12 +8566,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   57,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "output",
 			},
 			Init: &ast.StringLiteral{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 2,
@@ -7701,12 +8586,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   57,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Value: "\n#datatype,string,long,string\n#group,false,false,false\n#default,0,,\n,result,table,_value\n,,0,_field\n,,0,_measurement\n,,0,_start\n,,0,_stop\n,,0,host\n,,0,region\n",
 			},
 		}, &ast.VariableAssignment{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 14,
@@ -7719,10 +8606,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   70,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 17,
@@ -7735,12 +8624,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   70,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "show_tag_keys_fn",
 			},
 			Init: &ast.FunctionExpression{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 14,
@@ -7753,6 +8644,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   70,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Body: &ast.PipeExpression{
 					Argument: &ast.PipeExpression{
@@ -7762,7 +8654,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Argument: &ast.PipeExpression{
 										Argument: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 41,
@@ -7775,11 +8668,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   70,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "tables",
 										},
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 70,
@@ -7792,11 +8687,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   70,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Call: &ast.CallExpression{
 											Arguments: []ast.Expression{&ast.ObjectExpression{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 69,
@@ -7809,10 +8706,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   71,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Properties: []*ast.Property{&ast.Property{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 41,
@@ -7825,10 +8724,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																Line:   71,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Key: &ast.Identifier{
 														BaseNode: ast.BaseNode{
-															Errors: nil,
+															Comments: nil,
+															Errors:   nil,
 															Loc: &ast.SourceLocation{
 																End: ast.Position{
 																	Column: 19,
@@ -7841,12 +8742,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																	Line:   71,
 																},
 															},
+															TrailingComment: nil,
 														},
 														Name: "start",
 													},
 													Value: &ast.DateTimeLiteral{
 														BaseNode: ast.BaseNode{
-															Errors: nil,
+															Comments: nil,
+															Errors:   nil,
 															Loc: &ast.SourceLocation{
 																End: ast.Position{
 																	Column: 41,
@@ -7859,12 +8762,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																	Line:   71,
 																},
 															},
+															TrailingComment: nil,
 														},
 														Value: parser.MustParseTime("2018-01-01T00:00:00Z"),
 													},
 												}, &ast.Property{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 69,
@@ -7877,10 +8782,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																Line:   71,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Key: &ast.Identifier{
 														BaseNode: ast.BaseNode{
-															Errors: nil,
+															Comments: nil,
+															Errors:   nil,
 															Loc: &ast.SourceLocation{
 																End: ast.Position{
 																	Column: 47,
@@ -7893,12 +8800,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																	Line:   71,
 																},
 															},
+															TrailingComment: nil,
 														},
 														Name: "stop",
 													},
 													Value: &ast.DateTimeLiteral{
 														BaseNode: ast.BaseNode{
-															Errors: nil,
+															Comments: nil,
+															Errors:   nil,
 															Loc: &ast.SourceLocation{
 																End: ast.Position{
 																	Column: 69,
@@ -7911,6 +8820,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																	Line:   71,
 																},
 															},
+															TrailingComment: nil,
 														},
 														Value: parser.MustParseTime("2019-01-01T00:00:00Z"),
 													},
@@ -7918,7 +8828,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												With: nil,
 											}},
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 70,
@@ -7931,10 +8842,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   71,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Callee: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 13,
@@ -7947,13 +8860,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   71,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "range",
 											},
 										},
 									},
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 31,
@@ -7966,11 +8881,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   70,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Call: &ast.CallExpression{
 										Arguments: []ast.Expression{&ast.ObjectExpression{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 30,
@@ -7983,10 +8900,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   72,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Properties: []*ast.Property{&ast.Property{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 30,
@@ -7999,10 +8918,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   72,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Key: &ast.Identifier{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 17,
@@ -8015,12 +8936,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																Line:   72,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Name: "fn",
 												},
 												Value: &ast.FunctionExpression{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 30,
@@ -8033,10 +8956,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																Line:   72,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Body: &ast.Identifier{
 														BaseNode: ast.BaseNode{
-															Errors: nil,
+															Comments: nil,
+															Errors:   nil,
 															Loc: &ast.SourceLocation{
 																End: ast.Position{
 																	Column: 30,
@@ -8049,12 +8974,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																	Line:   72,
 																},
 															},
+															TrailingComment: nil,
 														},
 														Name: "true",
 													},
 													Params: []*ast.Property{&ast.Property{
 														BaseNode: ast.BaseNode{
-															Errors: nil,
+															Comments: nil,
+															Errors:   nil,
 															Loc: &ast.SourceLocation{
 																End: ast.Position{
 																	Column: 21,
@@ -8067,10 +8994,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																	Line:   72,
 																},
 															},
+															TrailingComment: nil,
 														},
 														Key: &ast.Identifier{
 															BaseNode: ast.BaseNode{
-																Errors: nil,
+																Comments: nil,
+																Errors:   nil,
 																Loc: &ast.SourceLocation{
 																	End: ast.Position{
 																		Column: 21,
@@ -8083,6 +9012,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																		Line:   72,
 																	},
 																},
+																TrailingComment: nil,
 															},
 															Name: "r",
 														},
@@ -8093,7 +9023,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											With: nil,
 										}},
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 31,
@@ -8106,10 +9037,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   72,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Callee: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 14,
@@ -8122,13 +9055,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   72,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "filter",
 										},
 									},
 								},
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 14,
@@ -8141,11 +9076,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   70,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Call: &ast.CallExpression{
 									Arguments: nil,
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 14,
@@ -8158,10 +9095,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   73,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Callee: &ast.Identifier{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 12,
@@ -8174,13 +9113,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   73,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Name: "keys",
 									},
 								},
 							},
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 33,
@@ -8193,11 +9134,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										Line:   70,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Call: &ast.CallExpression{
 								Arguments: []ast.Expression{&ast.ObjectExpression{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 32,
@@ -8210,10 +9153,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   74,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Properties: []*ast.Property{&ast.Property{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 32,
@@ -8226,10 +9171,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   74,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Key: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 20,
@@ -8242,12 +9189,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   74,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "columns",
 										},
 										Value: &ast.ArrayExpression{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 32,
@@ -8260,10 +9209,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   74,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Elements: []ast.Expression{&ast.StringLiteral{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 31,
@@ -8276,6 +9227,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   74,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Value: "_value",
 											}},
@@ -8284,7 +9236,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									With: nil,
 								}},
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 33,
@@ -8297,10 +9250,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   74,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Callee: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 12,
@@ -8313,13 +9268,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   74,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "keep",
 								},
 							},
 						},
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 18,
@@ -8332,11 +9289,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Line:   70,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Call: &ast.CallExpression{
 							Arguments: nil,
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 18,
@@ -8349,10 +9308,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										Line:   75,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Callee: &ast.Identifier{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 16,
@@ -8365,13 +9326,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   75,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Name: "distinct",
 							},
 						},
 					},
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 14,
@@ -8384,11 +9347,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   70,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Call: &ast.CallExpression{
 						Arguments: nil,
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 14,
@@ -8401,10 +9366,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Line:   76,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Callee: &ast.Identifier{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 12,
@@ -8417,6 +9384,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										Line:   76,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Name: "sort",
 						},
@@ -8424,7 +9392,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 				},
 				Params: []*ast.Property{&ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 30,
@@ -8437,10 +9406,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   70,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 27,
@@ -8453,11 +9424,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Line:   70,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Name: "tables",
 					},
 					Value: &ast.PipeLiteral{BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 30,
@@ -8470,13 +9443,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   70,
 							},
 						},
+						TrailingComment: nil,
 					}},
 				}},
 			},
 		}, &ast.TestStatement{
 			Assignment: &ast.VariableAssignment{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 105,
@@ -8489,10 +9464,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   78,
 						},
 					},
+					TrailingComment: nil,
 				},
 				ID: &ast.Identifier{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 19,
@@ -8505,12 +9482,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   78,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Name: "show_tag_keys",
 				},
 				Init: &ast.FunctionExpression{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 105,
@@ -8523,10 +9502,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   78,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Body: &ast.ParenExpression{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 105,
@@ -8539,10 +9520,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Line:   79,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Expression: &ast.ObjectExpression{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 104,
@@ -8555,10 +9538,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										Line:   79,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Properties: []*ast.Property{&ast.Property{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 45,
@@ -8571,10 +9556,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   79,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Key: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 12,
@@ -8587,13 +9574,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   79,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "input",
 								},
 								Value: &ast.CallExpression{
 									Arguments: []ast.Expression{&ast.ObjectExpression{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 44,
@@ -8606,10 +9595,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   79,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Properties: []*ast.Property{&ast.Property{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 44,
@@ -8622,10 +9613,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   79,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Key: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 37,
@@ -8638,12 +9631,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   79,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "csv",
 											},
 											Value: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 44,
@@ -8656,6 +9651,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   79,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "input",
 											},
@@ -8663,7 +9659,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										With: nil,
 									}},
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 45,
@@ -8676,10 +9673,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   79,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Callee: &ast.MemberExpression{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 33,
@@ -8692,10 +9691,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   79,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Object: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 21,
@@ -8708,12 +9709,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   79,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "testing",
 										},
 										Property: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 33,
@@ -8726,6 +9729,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   79,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "loadStorage",
 										},
@@ -8733,7 +9737,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								},
 							}, &ast.Property{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 81,
@@ -8746,10 +9751,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   79,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Key: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 51,
@@ -8762,13 +9769,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   79,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "want",
 								},
 								Value: &ast.CallExpression{
 									Arguments: []ast.Expression{&ast.ObjectExpression{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 80,
@@ -8781,10 +9790,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   79,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Properties: []*ast.Property{&ast.Property{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 80,
@@ -8797,10 +9808,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   79,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Key: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 72,
@@ -8813,12 +9826,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   79,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "csv",
 											},
 											Value: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 80,
@@ -8831,6 +9846,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   79,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "output",
 											},
@@ -8838,7 +9854,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										With: nil,
 									}},
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 81,
@@ -8851,10 +9868,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   79,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Callee: &ast.MemberExpression{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 68,
@@ -8867,10 +9886,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   79,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Object: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 60,
@@ -8883,12 +9904,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   79,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "testing",
 										},
 										Property: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 68,
@@ -8901,6 +9924,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   79,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "loadMem",
 										},
@@ -8908,7 +9932,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								},
 							}, &ast.Property{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 103,
@@ -8921,10 +9946,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   79,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Key: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 85,
@@ -8937,12 +9964,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   79,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "fn",
 								},
 								Value: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 103,
@@ -8955,6 +9984,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   79,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "show_tag_keys_fn",
 								},
@@ -8966,7 +9996,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 				},
 			},
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 105,
@@ -8979,12 +10010,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   78,
 					},
 				},
+				TrailingComment: nil,
 			},
 		}},
+		Eof: nil,
 		Imports: []*ast.ImportDeclaration{&ast.ImportDeclaration{
 			As: nil,
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 17,
@@ -8997,10 +10031,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   3,
 					},
 				},
+				TrailingComment: nil,
 			},
 			Path: &ast.StringLiteral{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 17,
@@ -9013,6 +10049,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   3,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Value: "testing",
 			},
@@ -9021,7 +10058,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 		Name:     "show_tag_keys_test.flux",
 		Package: &ast.PackageClause{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 16,
@@ -9034,10 +10072,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   1,
 					},
 				},
+				TrailingComment: nil,
 			},
 			Name: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 16,
@@ -9050,13 +10090,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   1,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "v1_test",
 			},
 		},
 	}, &ast.File{
 		BaseNode: ast.BaseNode{
-			Errors: nil,
+			Comments: nil,
+			Errors:   nil,
 			Loc: &ast.SourceLocation{
 				End: ast.Position{
 					Column: 107,
@@ -9069,10 +10111,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 					Line:   1,
 				},
 			},
+			TrailingComment: nil,
 		},
 		Body: []ast.Statement{&ast.VariableAssignment{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 2,
@@ -9085,10 +10129,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   5,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 6,
@@ -9101,12 +10147,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   5,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "input",
 			},
 			Init: &ast.StringLiteral{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 2,
@@ -9119,12 +10167,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   5,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Value: "\n#datatype,string,long,dateTime:RFC3339,string,string,string,double\n#group,false,false,false,true,true,true,false\n#default,_result,,,,,,\n,result,table,_time,_measurement,host,_field,_value\n,,0,2018-05-22T19:53:26Z,system,host.local,load1,1.83\n,,0,2018-05-22T19:53:36Z,system,host.local,load1,1.72\n,,0,2018-05-22T19:53:46Z,system,host.local,load1,1.74\n,,0,2018-05-22T19:53:56Z,system,host.local,load1,1.63\n,,0,2018-05-22T19:54:06Z,system,host.local,load1,1.91\n,,0,2018-05-22T19:54:16Z,system,host.local,load1,1.84\n\n,,1,2018-05-22T19:53:26Z,system,host.local,load3,1.98\n,,1,2018-05-22T19:53:36Z,system,host.local,load3,1.97\n,,1,2018-05-22T19:53:46Z,system,host.local,load3,1.97\n,,1,2018-05-22T19:53:56Z,system,host.local,load3,1.96\n,,1,2018-05-22T19:54:06Z,system,host.local,load3,1.98\n,,1,2018-05-22T19:54:16Z,system,host.local,load3,1.97\n\n,,2,2018-05-22T19:53:26Z,system,host.local,load5,1.95\n,,2,2018-05-22T19:53:36Z,system,host.local,load5,1.92\n,,2,2018-05-22T19:53:46Z,system,host.local,load5,1.92\n,,2,2018-05-22T19:53:56Z,system,host.local,load5,1.89\n,,2,2018-05-22T19:54:06Z,system,host.local,load5,1.94\n,,2,2018-05-22T19:54:16Z,system,host.local,load5,1.93\n\n,,3,2018-05-22T19:53:26Z,swap,host.local,used_percent,82.98\n,,3,2018-05-22T19:53:36Z,swap,host.local,used_percent,82.59\n,,3,2018-05-22T19:53:46Z,swap,host.local,used_percent,82.59\n,,3,2018-05-22T19:53:56Z,swap,host.local,used_percent,82.59\n,,3,2018-05-22T19:54:06Z,swap,host.local,used_percent,82.59\n,,3,2018-05-22T19:54:16Z,swap,host.local,used_percent,82.64\n",
 			},
 		}, &ast.VariableAssignment{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 2,
@@ -9137,10 +10187,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   39,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 7,
@@ -9153,12 +10205,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   39,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "output",
 			},
 			Init: &ast.StringLiteral{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 2,
@@ -9171,12 +10225,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   39,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Value: "\n#datatype,string,long,string\n#group,false,false,false\n#default,0,,\n,result,table,_value\n,,0,load1\n,,0,load3\n,,0,load5\n,,0,used_percent\n",
 			},
 		}, &ast.VariableAssignment{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 14,
@@ -9189,10 +10245,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   50,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 19,
@@ -9205,12 +10263,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   50,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "show_tag_values_fn",
 			},
 			Init: &ast.FunctionExpression{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 14,
@@ -9223,6 +10283,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   50,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Body: &ast.PipeExpression{
 					Argument: &ast.PipeExpression{
@@ -9232,7 +10293,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Argument: &ast.PipeExpression{
 										Argument: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 43,
@@ -9245,11 +10307,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   50,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "tables",
 										},
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 70,
@@ -9262,11 +10326,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   50,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Call: &ast.CallExpression{
 											Arguments: []ast.Expression{&ast.ObjectExpression{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 69,
@@ -9279,10 +10345,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   51,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Properties: []*ast.Property{&ast.Property{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 41,
@@ -9295,10 +10363,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																Line:   51,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Key: &ast.Identifier{
 														BaseNode: ast.BaseNode{
-															Errors: nil,
+															Comments: nil,
+															Errors:   nil,
 															Loc: &ast.SourceLocation{
 																End: ast.Position{
 																	Column: 19,
@@ -9311,12 +10381,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																	Line:   51,
 																},
 															},
+															TrailingComment: nil,
 														},
 														Name: "start",
 													},
 													Value: &ast.DateTimeLiteral{
 														BaseNode: ast.BaseNode{
-															Errors: nil,
+															Comments: nil,
+															Errors:   nil,
 															Loc: &ast.SourceLocation{
 																End: ast.Position{
 																	Column: 41,
@@ -9329,12 +10401,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																	Line:   51,
 																},
 															},
+															TrailingComment: nil,
 														},
 														Value: parser.MustParseTime("2018-01-01T00:00:00Z"),
 													},
 												}, &ast.Property{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 69,
@@ -9347,10 +10421,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																Line:   51,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Key: &ast.Identifier{
 														BaseNode: ast.BaseNode{
-															Errors: nil,
+															Comments: nil,
+															Errors:   nil,
 															Loc: &ast.SourceLocation{
 																End: ast.Position{
 																	Column: 47,
@@ -9363,12 +10439,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																	Line:   51,
 																},
 															},
+															TrailingComment: nil,
 														},
 														Name: "stop",
 													},
 													Value: &ast.DateTimeLiteral{
 														BaseNode: ast.BaseNode{
-															Errors: nil,
+															Comments: nil,
+															Errors:   nil,
 															Loc: &ast.SourceLocation{
 																End: ast.Position{
 																	Column: 69,
@@ -9381,6 +10459,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																	Line:   51,
 																},
 															},
+															TrailingComment: nil,
 														},
 														Value: parser.MustParseTime("2019-01-01T00:00:00Z"),
 													},
@@ -9388,7 +10467,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												With: nil,
 											}},
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 70,
@@ -9401,10 +10481,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   51,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Callee: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 13,
@@ -9417,13 +10499,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   51,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "range",
 											},
 										},
 									},
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 31,
@@ -9436,11 +10520,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   50,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Call: &ast.CallExpression{
 										Arguments: []ast.Expression{&ast.ObjectExpression{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 30,
@@ -9453,10 +10539,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   52,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Properties: []*ast.Property{&ast.Property{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 30,
@@ -9469,10 +10557,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   52,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Key: &ast.Identifier{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 17,
@@ -9485,12 +10575,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																Line:   52,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Name: "fn",
 												},
 												Value: &ast.FunctionExpression{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 30,
@@ -9503,10 +10595,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																Line:   52,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Body: &ast.Identifier{
 														BaseNode: ast.BaseNode{
-															Errors: nil,
+															Comments: nil,
+															Errors:   nil,
 															Loc: &ast.SourceLocation{
 																End: ast.Position{
 																	Column: 30,
@@ -9519,12 +10613,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																	Line:   52,
 																},
 															},
+															TrailingComment: nil,
 														},
 														Name: "true",
 													},
 													Params: []*ast.Property{&ast.Property{
 														BaseNode: ast.BaseNode{
-															Errors: nil,
+															Comments: nil,
+															Errors:   nil,
 															Loc: &ast.SourceLocation{
 																End: ast.Position{
 																	Column: 21,
@@ -9537,10 +10633,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																	Line:   52,
 																},
 															},
+															TrailingComment: nil,
 														},
 														Key: &ast.Identifier{
 															BaseNode: ast.BaseNode{
-																Errors: nil,
+																Comments: nil,
+																Errors:   nil,
 																Loc: &ast.SourceLocation{
 																	End: ast.Position{
 																		Column: 21,
@@ -9553,6 +10651,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																		Line:   52,
 																	},
 																},
+																TrailingComment: nil,
 															},
 															Name: "r",
 														},
@@ -9563,7 +10662,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											With: nil,
 										}},
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 31,
@@ -9576,10 +10676,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   52,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Callee: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 14,
@@ -9592,13 +10694,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   52,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "filter",
 										},
 									},
 								},
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 33,
@@ -9611,11 +10715,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   50,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Call: &ast.CallExpression{
 									Arguments: []ast.Expression{&ast.ObjectExpression{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 32,
@@ -9628,10 +10734,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   53,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Properties: []*ast.Property{&ast.Property{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 32,
@@ -9644,10 +10752,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   53,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Key: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 20,
@@ -9660,12 +10770,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   53,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "columns",
 											},
 											Value: &ast.ArrayExpression{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 32,
@@ -9678,10 +10790,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   53,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Elements: []ast.Expression{&ast.StringLiteral{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 31,
@@ -9694,6 +10808,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																Line:   53,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Value: "_field",
 												}},
@@ -9702,7 +10817,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										With: nil,
 									}},
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 33,
@@ -9715,10 +10831,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   53,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Callee: &ast.Identifier{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 12,
@@ -9731,13 +10849,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   53,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Name: "keep",
 									},
 								},
 							},
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 15,
@@ -9750,11 +10870,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										Line:   50,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Call: &ast.CallExpression{
 								Arguments: nil,
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 15,
@@ -9767,10 +10889,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   54,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Callee: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 13,
@@ -9783,13 +10907,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   54,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "group",
 								},
 							},
 						},
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 34,
@@ -9802,11 +10928,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Line:   50,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Call: &ast.CallExpression{
 							Arguments: []ast.Expression{&ast.ObjectExpression{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 33,
@@ -9819,10 +10947,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   55,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Properties: []*ast.Property{&ast.Property{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 33,
@@ -9835,10 +10965,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   55,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Key: &ast.Identifier{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 23,
@@ -9851,12 +10983,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   55,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Name: "column",
 									},
 									Value: &ast.StringLiteral{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 33,
@@ -9869,6 +11003,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   55,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Value: "_field",
 									},
@@ -9876,7 +11011,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								With: nil,
 							}},
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 34,
@@ -9889,10 +11025,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										Line:   55,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Callee: &ast.Identifier{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 16,
@@ -9905,13 +11043,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   55,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Name: "distinct",
 							},
 						},
 					},
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 14,
@@ -9924,11 +11064,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   50,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Call: &ast.CallExpression{
 						Arguments: nil,
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 14,
@@ -9941,10 +11083,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Line:   56,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Callee: &ast.Identifier{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 12,
@@ -9957,6 +11101,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										Line:   56,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Name: "sort",
 						},
@@ -9964,7 +11109,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 				},
 				Params: []*ast.Property{&ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 32,
@@ -9977,10 +11123,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   50,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 29,
@@ -9993,11 +11141,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Line:   50,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Name: "tables",
 					},
 					Value: &ast.PipeLiteral{BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 32,
@@ -10010,13 +11160,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   50,
 							},
 						},
+						TrailingComment: nil,
 					}},
 				}},
 			},
 		}, &ast.TestStatement{
 			Assignment: &ast.VariableAssignment{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 107,
@@ -10029,10 +11181,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   58,
 						},
 					},
+					TrailingComment: nil,
 				},
 				ID: &ast.Identifier{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 21,
@@ -10045,12 +11199,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   58,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Name: "show_tag_values",
 				},
 				Init: &ast.FunctionExpression{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 107,
@@ -10063,10 +11219,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   58,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Body: &ast.ParenExpression{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 107,
@@ -10079,10 +11237,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Line:   59,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Expression: &ast.ObjectExpression{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 106,
@@ -10095,10 +11255,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										Line:   59,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Properties: []*ast.Property{&ast.Property{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 45,
@@ -10111,10 +11273,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   59,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Key: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 12,
@@ -10127,13 +11291,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   59,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "input",
 								},
 								Value: &ast.CallExpression{
 									Arguments: []ast.Expression{&ast.ObjectExpression{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 44,
@@ -10146,10 +11312,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   59,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Properties: []*ast.Property{&ast.Property{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 44,
@@ -10162,10 +11330,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   59,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Key: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 37,
@@ -10178,12 +11348,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   59,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "csv",
 											},
 											Value: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 44,
@@ -10196,6 +11368,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   59,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "input",
 											},
@@ -10203,7 +11376,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										With: nil,
 									}},
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 45,
@@ -10216,10 +11390,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   59,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Callee: &ast.MemberExpression{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 33,
@@ -10232,10 +11408,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   59,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Object: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 21,
@@ -10248,12 +11426,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   59,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "testing",
 										},
 										Property: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 33,
@@ -10266,6 +11446,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   59,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "loadStorage",
 										},
@@ -10273,7 +11454,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								},
 							}, &ast.Property{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 81,
@@ -10286,10 +11468,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   59,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Key: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 51,
@@ -10302,13 +11486,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   59,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "want",
 								},
 								Value: &ast.CallExpression{
 									Arguments: []ast.Expression{&ast.ObjectExpression{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 80,
@@ -10321,10 +11507,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   59,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Properties: []*ast.Property{&ast.Property{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 80,
@@ -10337,10 +11525,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   59,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Key: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 72,
@@ -10353,12 +11543,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   59,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "csv",
 											},
 											Value: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 80,
@@ -10371,6 +11563,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   59,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "output",
 											},
@@ -10378,7 +11571,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										With: nil,
 									}},
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 81,
@@ -10391,10 +11585,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   59,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Callee: &ast.MemberExpression{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 68,
@@ -10407,10 +11603,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   59,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Object: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 60,
@@ -10423,12 +11621,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   59,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "testing",
 										},
 										Property: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 68,
@@ -10441,6 +11641,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   59,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "loadMem",
 										},
@@ -10448,7 +11649,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								},
 							}, &ast.Property{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 105,
@@ -10461,10 +11663,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   59,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Key: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 85,
@@ -10477,12 +11681,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   59,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "fn",
 								},
 								Value: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 105,
@@ -10495,6 +11701,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   59,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "show_tag_values_fn",
 								},
@@ -10506,7 +11713,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 				},
 			},
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 107,
@@ -10519,12 +11727,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   58,
 					},
 				},
+				TrailingComment: nil,
 			},
 		}},
+		Eof: nil,
 		Imports: []*ast.ImportDeclaration{&ast.ImportDeclaration{
 			As: nil,
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 17,
@@ -10537,10 +11748,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   3,
 					},
 				},
+				TrailingComment: nil,
 			},
 			Path: &ast.StringLiteral{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 17,
@@ -10553,6 +11766,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   3,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Value: "testing",
 			},
@@ -10561,7 +11775,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 		Name:     "show_tag_values_test.flux",
 		Package: &ast.PackageClause{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 16,
@@ -10574,10 +11789,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   1,
 					},
 				},
+				TrailingComment: nil,
 			},
 			Name: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 16,
@@ -10590,6 +11807,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   1,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "v1_test",
 			},
@@ -0,0 +1,1403 @@
+// DO NOT EDIT: This file is autogenerated via the builtin command.
+
+package influxql
+
+import (
+	ast "github.com/influxdata/flux/ast"
+	parser "github.com/influxdata/flux/internal/parser"
+)
+
+var FluxTestPackages = []*ast.Package{&ast.Package{
+	BaseNode: ast.BaseNode{
+		Comments:        nil,
+		Errors:          nil,
+		Loc:             nil,
+		TrailingComment: nil,
+	},
+	Files: []*ast.File{&ast.File{
+		BaseNode: ast.BaseNode{
+			Comments: nil,
+			Errors:   nil,
+			Loc: &ast.SourceLocation{
+				End: ast.Position{
+					Column: 94,
+					Line:   38,
+				},
+				File:   "slimit_test.flux",
+				Source: "package influxql_test\n\nimport \"internal/influxql\"\nimport \"testing\"\n\ninData = \"\n#datatype,string,long,dateTime:RFC3339,string,string,string,double\n#group,false,false,false,true,true,true,false\n#default,_result,,,,,,\n,result,table,_time,_measurement,_field,host,_value\n,,0,2018-12-19T22:13:30Z,cpu,usage,c,1\n,,0,2018-12-19T22:13:40Z,cpu,usage,c,2\n,,1,2018-12-19T22:13:30Z,cpu,usage,a,3\n,,1,2018-12-19T22:13:40Z,cpu,usage,a,4\n,,2,2018-12-19T22:13:30Z,cpu,usage,d,5\n,,2,2018-12-19T22:13:40Z,cpu,usage,d,6\n,,3,2018-12-19T22:13:30Z,cpu,usage,b,7\n,,3,2018-12-19T22:13:40Z,cpu,usage,b,8\n\"\n\noutData = \"\n#datatype,string,long,dateTime:RFC3339,dateTime:RFC3339,dateTime:RFC3339,string,string,string,double\n#group,false,false,true,true,false,true,true,true,false\n#default,_result,,,,,,,,\n,result,table,_start,_stop,_time,_measurement,_field,host,_value\n,,0,2018-12-19T00:00:00Z,2018-12-20T00:00:00Z,2018-12-19T22:13:30Z,cpu,usage,b,7\n,,0,2018-12-19T00:00:00Z,2018-12-20T00:00:00Z,2018-12-19T22:13:40Z,cpu,usage,b,8\n,,1,2018-12-19T00:00:00Z,2018-12-20T00:00:00Z,2018-12-19T22:13:30Z,cpu,usage,c,1\n,,1,2018-12-19T00:00:00Z,2018-12-20T00:00:00Z,2018-12-19T22:13:40Z,cpu,usage,c,2\n\"\n\nslimit = (table=<-) =>\n    table\n        |> range(start: 2018-12-19T00:00:00Z, stop: 2018-12-20T00:00:00Z)\n        |> influxql.slimit(n: 2, offset: 1)\n\ntest _slimit = () =>\n\t({input: testing.loadStorage(csv: inData), want: testing.loadMem(csv: outData), fn: slimit})",
+				Start: ast.Position{
+					Column: 1,
+					Line:   1,
+				},
+			},
+			TrailingComment: nil,
+		},
+		Body: []ast.Statement{&ast.VariableAssignment{
+			BaseNode: ast.BaseNode{
+				Comments: nil,
+				Errors:   nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 2,
+						Line:   19,
+					},
+					File:   "slimit_test.flux",
+					Source: "inData = \"\n#datatype,string,long,dateTime:RFC3339,string,string,string,double\n#group,false,false,false,true,true,true,false\n#default,_result,,,,,,\n,result,table,_time,_measurement,_field,host,_value\n,,0,2018-12-19T22:13:30Z,cpu,usage,c,1\n,,0,2018-12-19T22:13:40Z,cpu,usage,c,2\n,,1,2018-12-19T22:13:30Z,cpu,usage,a,3\n,,1,2018-12-19T22:13:40Z,cpu,usage,a,4\n,,2,2018-12-19T22:13:30Z,cpu,usage,d,5\n,,2,2018-12-19T22:13:40Z,cpu,usage,d,6\n,,3,2018-12-19T22:13:30Z,cpu,usage,b,7\n,,3,2018-12-19T22:13:40Z,cpu,usage,b,8\n\"",
+					Start: ast.Position{
+						Column: 1,
+						Line:   6,
+					},
+				},
+				TrailingComment: nil,
+			},
+			ID: &ast.Identifier{
+				BaseNode: ast.BaseNode{
+					Comments: nil,
+					Errors:   nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 7,
+							Line:   6,
+						},
+						File:   "slimit_test.flux",
+						Source: "inData",
+						Start: ast.Position{
+							Column: 1,
+							Line:   6,
+						},
+					},
+					TrailingComment: nil,
+				},
+				Name: "inData",
+			},
+			Init: &ast.StringLiteral{
+				BaseNode: ast.BaseNode{
+					Comments: nil,
+					Errors:   nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 2,
+							Line:   19,
+						},
+						File:   "slimit_test.flux",
+						Source: "\"\n#datatype,string,long,dateTime:RFC3339,string,string,string,double\n#group,false,false,false,true,true,true,false\n#default,_result,,,,,,\n,result,table,_time,_measurement,_field,host,_value\n,,0,2018-12-19T22:13:30Z,cpu,usage,c,1\n,,0,2018-12-19T22:13:40Z,cpu,usage,c,2\n,,1,2018-12-19T22:13:30Z,cpu,usage,a,3\n,,1,2018-12-19T22:13:40Z,cpu,usage,a,4\n,,2,2018-12-19T22:13:30Z,cpu,usage,d,5\n,,2,2018-12-19T22:13:40Z,cpu,usage,d,6\n,,3,2018-12-19T22:13:30Z,cpu,usage,b,7\n,,3,2018-12-19T22:13:40Z,cpu,usage,b,8\n\"",
+						Start: ast.Position{
+							Column: 10,
+							Line:   6,
+						},
+					},
+					TrailingComment: nil,
+				},
+				Value: "\n#datatype,string,long,dateTime:RFC3339,string,string,string,double\n#group,false,false,false,true,true,true,false\n#default,_result,,,,,,\n,result,table,_time,_measurement,_field,host,_value\n,,0,2018-12-19T22:13:30Z,cpu,usage,c,1\n,,0,2018-12-19T22:13:40Z,cpu,usage,c,2\n,,1,2018-12-19T22:13:30Z,cpu,usage,a,3\n,,1,2018-12-19T22:13:40Z,cpu,usage,a,4\n,,2,2018-12-19T22:13:30Z,cpu,usage,d,5\n,,2,2018-12-19T22:13:40Z,cpu,usage,d,6\n,,3,2018-12-19T22:13:30Z,cpu,usage,b,7\n,,3,2018-12-19T22:13:40Z,cpu,usage,b,8\n",
+			},
+		}, &ast.VariableAssignment{
+			BaseNode: ast.BaseNode{
+				Comments: nil,
+				Errors:   nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 2,
+						Line:   30,
+					},
+					File:   "slimit_test.flux",
+					Source: "outData = \"\n#datatype,string,long,dateTime:RFC3339,dateTime:RFC3339,dateTime:RFC3339,string,string,string,double\n#group,false,false,true,true,false,true,true,true,false\n#default,_result,,,,,,,,\n,result,table,_start,_stop,_time,_measurement,_field,host,_value\n,,0,2018-12-19T00:00:00Z,2018-12-20T00:00:00Z,2018-12-19T22:13:30Z,cpu,usage,b,7\n,,0,2018-12-19T00:00:00Z,2018-12-20T00:00:00Z,2018-12-19T22:13:40Z,cpu,usage,b,8\n,,1,2018-12-19T00:00:00Z,2018-12-20T00:00:00Z,2018-12-19T22:13:30Z,cpu,usage,c,1\n,,1,2018-12-19T00:00:00Z,2018-12-20T00:00:00Z,2018-12-19T22:13:40Z,cpu,usage,c,2\n\"",
+					Start: ast.Position{
+						Column: 1,
+						Line:   21,
+					},
+				},
+				TrailingComment: nil,
+			},
+			ID: &ast.Identifier{
+				BaseNode: ast.BaseNode{
+					Comments: nil,
+					Errors:   nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 8,
+							Line:   21,
+						},
+						File:   "slimit_test.flux",
+						Source: "outData",
+						Start: ast.Position{
+							Column: 1,
+							Line:   21,
+						},
+					},
+					TrailingComment: nil,
+				},
+				Name: "outData",
+			},
+			Init: &ast.StringLiteral{
+				BaseNode: ast.BaseNode{
+					Comments: nil,
+					Errors:   nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 2,
+							Line:   30,
+						},
+						File:   "slimit_test.flux",
+						Source: "\"\n#datatype,string,long,dateTime:RFC3339,dateTime:RFC3339,dateTime:RFC3339,string,string,string,double\n#group,false,false,true,true,false,true,true,true,false\n#default,_result,,,,,,,,\n,result,table,_start,_stop,_time,_measurement,_field,host,_value\n,,0,2018-12-19T00:00:00Z,2018-12-20T00:00:00Z,2018-12-19T22:13:30Z,cpu,usage,b,7\n,,0,2018-12-19T00:00:00Z,2018-12-20T00:00:00Z,2018-12-19T22:13:40Z,cpu,usage,b,8\n,,1,2018-12-19T00:00:00Z,2018-12-20T00:00:00Z,2018-12-19T22:13:30Z,cpu,usage,c,1\n,,1,2018-12-19T00:00:00Z,2018-12-20T00:00:00Z,2018-12-19T22:13:40Z,cpu,usage,c,2\n\"",
+						Start: ast.Position{
+							Column: 11,
+							Line:   21,
+						},
+					},
+					TrailingComment: nil,
+				},
+				Value: "\n#datatype,string,long,dateTime:RFC3339,dateTime:RFC3339,dateTime:RFC3339,string,string,string,double\n#group,false,false,true,true,false,true,true,true,false\n#default,_result,,,,,,,,\n,result,table,_start,_stop,_time,_measurement,_field,host,_value\n,,0,2018-12-19T00:00:00Z,2018-12-20T00:00:00Z,2018-12-19T22:13:30Z,cpu,usage,b,7\n,,0,2018-12-19T00:00:00Z,2018-12-20T00:00:00Z,2018-12-19T22:13:40Z,cpu,usage,b,8\n,,1,2018-12-19T00:00:00Z,2018-12-20T00:00:00Z,2018-12-19T22:13:30Z,cpu,usage,c,1\n,,1,2018-12-19T00:00:00Z,2018-12-20T00:00:00Z,2018-12-19T22:13:40Z,cpu,usage,c,2\n",
+			},
+		}, &ast.VariableAssignment{
+			BaseNode: ast.BaseNode{
+				Comments: nil,
+				Errors:   nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 44,
+						Line:   35,
+					},
+					File:   "slimit_test.flux",
+					Source: "slimit = (table=<-) =>\n    table\n        |> range(start: 2018-12-19T00:00:00Z, stop: 2018-12-20T00:00:00Z)\n        |> influxql.slimit(n: 2, offset: 1)",
+					Start: ast.Position{
+						Column: 1,
+						Line:   32,
+					},
+				},
+				TrailingComment: nil,
+			},
+			ID: &ast.Identifier{
+				BaseNode: ast.BaseNode{
+					Comments: nil,
+					Errors:   nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 7,
+							Line:   32,
+						},
+						File:   "slimit_test.flux",
+						Source: "slimit",
+						Start: ast.Position{
+							Column: 1,
+							Line:   32,
+						},
+					},
+					TrailingComment: nil,
+				},
+				Name: "slimit",
+			},
+			Init: &ast.FunctionExpression{
+				BaseNode: ast.BaseNode{
+					Comments: nil,
+					Errors:   nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 44,
+							Line:   35,
+						},
+						File:   "slimit_test.flux",
+						Source: "(table=<-) =>\n    table\n        |> range(start: 2018-12-19T00:00:00Z, stop: 2018-12-20T00:00:00Z)\n        |> influxql.slimit(n: 2, offset: 1)",
+						Start: ast.Position{
+							Column: 10,
+							Line:   32,
+						},
+					},
+					TrailingComment: nil,
+				},
+				Body: &ast.PipeExpression{
+					Argument: &ast.PipeExpression{
+						Argument: &ast.Identifier{
+							BaseNode: ast.BaseNode{
+								Comments: nil,
+								Errors:   nil,
+								Loc: &ast.SourceLocation{
+									End: ast.Position{
+										Column: 10,
+										Line:   33,
+									},
+									File:   "slimit_test.flux",
+									Source: "table",
+									Start: ast.Position{
+										Column: 5,
+										Line:   33,
+									},
+								},
+								TrailingComment: nil,
+							},
+							Name: "table",
+						},
+						BaseNode: ast.BaseNode{
+							Comments: nil,
+							Errors:   nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 74,
+									Line:   34,
+								},
+								File:   "slimit_test.flux",
+								Source: "table\n        |> range(start: 2018-12-19T00:00:00Z, stop: 2018-12-20T00:00:00Z)",
+								Start: ast.Position{
+									Column: 5,
+									Line:   33,
+								},
+							},
+							TrailingComment: nil,
+						},
+						Call: &ast.CallExpression{
+							Arguments: []ast.Expression{&ast.ObjectExpression{
+								BaseNode: ast.BaseNode{
+									Comments: nil,
+									Errors:   nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 73,
+											Line:   34,
+										},
+										File:   "slimit_test.flux",
+										Source: "start: 2018-12-19T00:00:00Z, stop: 2018-12-20T00:00:00Z",
+										Start: ast.Position{
+											Column: 18,
+											Line:   34,
+										},
+									},
+									TrailingComment: nil,
+								},
+								Properties: []*ast.Property{&ast.Property{
+									BaseNode: ast.BaseNode{
+										Comments: nil,
+										Errors:   nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 45,
+												Line:   34,
+											},
+											File:   "slimit_test.flux",
+											Source: "start: 2018-12-19T00:00:00Z",
+											Start: ast.Position{
+												Column: 18,
+												Line:   34,
+											},
+										},
+										TrailingComment: nil,
+									},
+									Key: &ast.Identifier{
+										BaseNode: ast.BaseNode{
+											Comments: nil,
+											Errors:   nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 23,
+													Line:   34,
+												},
+												File:   "slimit_test.flux",
+												Source: "start",
+												Start: ast.Position{
+													Column: 18,
+													Line:   34,
+												},
+											},
+											TrailingComment: nil,
+										},
+										Name: "start",
+									},
+									Value: &ast.DateTimeLiteral{
+										BaseNode: ast.BaseNode{
+											Comments: nil,
+											Errors:   nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 45,
+													Line:   34,
+												},
+												File:   "slimit_test.flux",
+												Source: "2018-12-19T00:00:00Z",
+												Start: ast.Position{
+													Column: 25,
+													Line:   34,
+												},
+											},
+											TrailingComment: nil,
+										},
+										Value: parser.MustParseTime("2018-12-19T00:00:00Z"),
+									},
+								}, &ast.Property{
+									BaseNode: ast.BaseNode{
+										Comments: nil,
+										Errors:   nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 73,
+												Line:   34,
+											},
+											File:   "slimit_test.flux",
+											Source: "stop: 2018-12-20T00:00:00Z",
+											Start: ast.Position{
+												Column: 47,
+												Line:   34,
+											},
+										},
+										TrailingComment: nil,
+									},
+									Key: &ast.Identifier{
+										BaseNode: ast.BaseNode{
+											Comments: nil,
+											Errors:   nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 51,
+													Line:   34,
+												},
+												File:   "slimit_test.flux",
+												Source: "stop",
+												Start: ast.Position{
+													Column: 47,
+													Line:   34,
+												},
+											},
+											TrailingComment: nil,
+										},
+										Name: "stop",
+									},
+									Value: &ast.DateTimeLiteral{
+										BaseNode: ast.BaseNode{
+											Comments: nil,
+											Errors:   nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 73,
+													Line:   34,
+												},
+												File:   "slimit_test.flux",
+												Source: "2018-12-20T00:00:00Z",
+												Start: ast.Position{
+													Column: 53,
+													Line:   34,
+												},
+											},
+											TrailingComment: nil,
+										},
+										Value: parser.MustParseTime("2018-12-20T00:00:00Z"),
+									},
+								}},
+								With: nil,
+							}},
+							BaseNode: ast.BaseNode{
+								Comments: nil,
+								Errors:   nil,
+								Loc: &ast.SourceLocation{
+									End: ast.Position{
+										Column: 74,
+										Line:   34,
+									},
+									File:   "slimit_test.flux",
+									Source: "range(start: 2018-12-19T00:00:00Z, stop: 2018-12-20T00:00:00Z)",
+									Start: ast.Position{
+										Column: 12,
+										Line:   34,
+									},
+								},
+								TrailingComment: nil,
+							},
+							Callee: &ast.Identifier{
+								BaseNode: ast.BaseNode{
+									Comments: nil,
+									Errors:   nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 17,
+											Line:   34,
+										},
+										File:   "slimit_test.flux",
+										Source: "range",
+										Start: ast.Position{
+											Column: 12,
+											Line:   34,
+										},
+									},
+									TrailingComment: nil,
+								},
+								Name: "range",
+							},
+						},
+					},
+					BaseNode: ast.BaseNode{
+						Comments: nil,
+						Errors:   nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 44,
+								Line:   35,
+							},
+							File:   "slimit_test.flux",
+							Source: "table\n        |> range(start: 2018-12-19T00:00:00Z, stop: 2018-12-20T00:00:00Z)\n        |> influxql.slimit(n: 2, offset: 1)",
+							Start: ast.Position{
+								Column: 5,
+								Line:   33,
+							},
+						},
+						TrailingComment: nil,
+					},
+					Call: &ast.CallExpression{
+						Arguments: []ast.Expression{&ast.ObjectExpression{
+							BaseNode: ast.BaseNode{
+								Comments: nil,
+								Errors:   nil,
+								Loc: &ast.SourceLocation{
+									End: ast.Position{
+										Column: 43,
+										Line:   35,
+									},
+									File:   "slimit_test.flux",
+									Source: "n: 2, offset: 1",
+									Start: ast.Position{
+										Column: 28,
+										Line:   35,
+									},
+								},
+								TrailingComment: nil,
+							},
+							Properties: []*ast.Property{&ast.Property{
+								BaseNode: ast.BaseNode{
+									Comments: nil,
+									Errors:   nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 32,
+											Line:   35,
+										},
+										File:   "slimit_test.flux",
+										Source: "n: 2",
+										Start: ast.Position{
+											Column: 28,
+											Line:   35,
+										},
+									},
+									TrailingComment: nil,
+								},
+								Key: &ast.Identifier{
+									BaseNode: ast.BaseNode{
+										Comments: nil,
+										Errors:   nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 29,
+												Line:   35,
+											},
+											File:   "slimit_test.flux",
+											Source: "n",
+											Start: ast.Position{
+												Column: 28,
+												Line:   35,
+											},
+										},
+										TrailingComment: nil,
+									},
+									Name: "n",
+								},
+								Value: &ast.IntegerLiteral{
+									BaseNode: ast.BaseNode{
+										Comments: nil,
+										Errors:   nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 32,
+												Line:   35,
+											},
+											File:   "slimit_test.flux",
+											Source: "2",
+											Start: ast.Position{
+												Column: 31,
+												Line:   35,
+											},
+										},
+										TrailingComment: nil,
+									},
+									Value: int64(2),
+								},
+							}, &ast.Property{
+								BaseNode: ast.BaseNode{
+									Comments: nil,
+									Errors:   nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 43,
+											Line:   35,
+										},
+										File:   "slimit_test.flux",
+										Source: "offset: 1",
+										Start: ast.Position{
+											Column: 34,
+											Line:   35,
+										},
+									},
+									TrailingComment: nil,
+								},
+								Key: &ast.Identifier{
+									BaseNode: ast.BaseNode{
+										Comments: nil,
+										Errors:   nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 40,
+												Line:   35,
+											},
+											File:   "slimit_test.flux",
+											Source: "offset",
+											Start: ast.Position{
+												Column: 34,
+												Line:   35,
+											},
+										},
+										TrailingComment: nil,
+									},
+									Name: "offset",
+								},
+								Value: &ast.IntegerLiteral{
+									BaseNode: ast.BaseNode{
+										Comments: nil,
+										Errors:   nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 43,
+												Line:   35,
+											},
+											File:   "slimit_test.flux",
+											Source: "1",
+											Start: ast.Position{
+												Column: 42,
+												Line:   35,
+											},
+										},
+										TrailingComment: nil,
+									},
+									Value: int64(1),
+								},
+							}},
+							With: nil,
+						}},
+						BaseNode: ast.BaseNode{
+							Comments: nil,
+							Errors:   nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 44,
+									Line:   35,
+								},
+								File:   "slimit_test.flux",
+								Source: "influxql.slimit(n: 2, offset: 1)",
+								Start: ast.Position{
+									Column: 12,
+									Line:   35,
+								},
+							},
+							TrailingComment: nil,
+						},
+						Callee: &ast.MemberExpression{
+							BaseNode: ast.BaseNode{
+								Comments: nil,
+								Errors:   nil,
+								Loc: &ast.SourceLocation{
+									End: ast.Position{
+										Column: 27,
+										Line:   35,
+									},
+									File:   "slimit_test.flux",
+									Source: "influxql.slimit",
+									Start: ast.Position{
+										Column: 12,
+										Line:   35,
+									},
+								},
+								TrailingComment: nil,
+							},
+							Object: &ast.Identifier{
+								BaseNode: ast.BaseNode{
+									Comments: nil,
+									Errors:   nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 20,
+											Line:   35,
+										},
+										File:   "slimit_test.flux",
+										Source: "influxql",
+										Start: ast.Position{
+											Column: 12,
+											Line:   35,
+										},
+									},
+									TrailingComment: nil,
+								},
+								Name: "influxql",
+							},
+							Property: &ast.Identifier{
+								BaseNode: ast.BaseNode{
+									Comments: nil,
+									Errors:   nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 27,
+											Line:   35,
+										},
+										File:   "slimit_test.flux",
+										Source: "slimit",
+										Start: ast.Position{
+											Column: 21,
+											Line:   35,
+										},
+									},
+									TrailingComment: nil,
+								},
+								Name: "slimit",
+							},
+						},
+					},
+				},
+				Params: []*ast.Property{&ast.Property{
+					BaseNode: ast.BaseNode{
+						Comments: nil,
+						Errors:   nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 19,
+								Line:   32,
+							},
+							File:   "slimit_test.flux",
+							Source: "table=<-",
+							Start: ast.Position{
+								Column: 11,
+								Line:   32,
+							},
+						},
+						TrailingComment: nil,
+					},
+					Key: &ast.Identifier{
+						BaseNode: ast.BaseNode{
+							Comments: nil,
+							Errors:   nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 16,
+									Line:   32,
+								},
+								File:   "slimit_test.flux",
+								Source: "table",
+								Start: ast.Position{
+									Column: 11,
+									Line:   32,
+								},
+							},
+							TrailingComment: nil,
+						},
+						Name: "table",
+					},
+					Value: &ast.PipeLiteral{BaseNode: ast.BaseNode{
+						Comments: nil,
+						Errors:   nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 19,
+								Line:   32,
+							},
+							File:   "slimit_test.flux",
+							Source: "<-",
+							Start: ast.Position{
+								Column: 17,
+								Line:   32,
+							},
+						},
+						TrailingComment: nil,
+					}},
+				}},
+			},
+		}, &ast.TestStatement{
+			Assignment: &ast.VariableAssignment{
+				BaseNode: ast.BaseNode{
+					Comments: nil,
+					Errors:   nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 94,
+							Line:   38,
+						},
+						File:   "slimit_test.flux",
+						Source: "_slimit = () =>\n\t({input: testing.loadStorage(csv: inData), want: testing.loadMem(csv: outData), fn: slimit})",
+						Start: ast.Position{
+							Column: 6,
+							Line:   37,
+						},
+					},
+					TrailingComment: nil,
+				},
+				ID: &ast.Identifier{
+					BaseNode: ast.BaseNode{
+						Comments: nil,
+						Errors:   nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 13,
+								Line:   37,
+							},
+							File:   "slimit_test.flux",
+							Source: "_slimit",
+							Start: ast.Position{
+								Column: 6,
+								Line:   37,
+							},
+						},
+						TrailingComment: nil,
+					},
+					Name: "_slimit",
+				},
+				Init: &ast.FunctionExpression{
+					BaseNode: ast.BaseNode{
+						Comments: nil,
+						Errors:   nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 94,
+								Line:   38,
+							},
+							File:   "slimit_test.flux",
+							Source: "() =>\n\t({input: testing.loadStorage(csv: inData), want: testing.loadMem(csv: outData), fn: slimit})",
+							Start: ast.Position{
+								Column: 16,
+								Line:   37,
+							},
+						},
+						TrailingComment: nil,
+					},
+					Body: &ast.ParenExpression{
+						BaseNode: ast.BaseNode{
+							Comments: nil,
+							Errors:   nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 94,
+									Line:   38,
+								},
+								File:   "slimit_test.flux",
+								Source: "({input: testing.loadStorage(csv: inData), want: testing.loadMem(csv: outData), fn: slimit})",
+								Start: ast.Position{
+									Column: 2,
+									Line:   38,
+								},
+							},
+							TrailingComment: nil,
+						},
+						Expression: &ast.ObjectExpression{
+							BaseNode: ast.BaseNode{
+								Comments: nil,
+								Errors:   nil,
+								Loc: &ast.SourceLocation{
+									End: ast.Position{
+										Column: 93,
+										Line:   38,
+									},
+									File:   "slimit_test.flux",
+									Source: "{input: testing.loadStorage(csv: inData), want: testing.loadMem(csv: outData), fn: slimit}",
+									Start: ast.Position{
+										Column: 3,
+										Line:   38,
+									},
+								},
+								TrailingComment: nil,
+							},
+							Properties: []*ast.Property{&ast.Property{
+								BaseNode: ast.BaseNode{
+									Comments: nil,
+									Errors:   nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 43,
+											Line:   38,
+										},
+										File:   "slimit_test.flux",
+										Source: "input: testing.loadStorage(csv: inData)",
+										Start: ast.Position{
+											Column: 4,
+											Line:   38,
+										},
+									},
+									TrailingComment: nil,
+								},
+								Key: &ast.Identifier{
+									BaseNode: ast.BaseNode{
+										Comments: nil,
+										Errors:   nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 9,
+												Line:   38,
+											},
+											File:   "slimit_test.flux",
+											Source: "input",
+											Start: ast.Position{
+												Column: 4,
+												Line:   38,
+											},
+										},
+										TrailingComment: nil,
+									},
+									Name: "input",
+								},
+								Value: &ast.CallExpression{
+									Arguments: []ast.Expression{&ast.ObjectExpression{
+										BaseNode: ast.BaseNode{
+											Comments: nil,
+											Errors:   nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 42,
+													Line:   38,
+												},
+												File:   "slimit_test.flux",
+												Source: "csv: inData",
+												Start: ast.Position{
+													Column: 31,
+													Line:   38,
+												},
+											},
+											TrailingComment: nil,
+										},
+										Properties: []*ast.Property{&ast.Property{
+											BaseNode: ast.BaseNode{
+												Comments: nil,
+												Errors:   nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 42,
+														Line:   38,
+													},
+													File:   "slimit_test.flux",
+													Source: "csv: inData",
+													Start: ast.Position{
+														Column: 31,
+														Line:   38,
+													},
+												},
+												TrailingComment: nil,
+											},
+											Key: &ast.Identifier{
+												BaseNode: ast.BaseNode{
+													Comments: nil,
+													Errors:   nil,
+													Loc: &ast.SourceLocation{
+														End: ast.Position{
+															Column: 34,
+															Line:   38,
+														},
+														File:   "slimit_test.flux",
+														Source: "csv",
+														Start: ast.Position{
+															Column: 31,
+															Line:   38,
+														},
+													},
+													TrailingComment: nil,
+												},
+												Name: "csv",
+											},
+											Value: &ast.Identifier{
+												BaseNode: ast.BaseNode{
+													Comments: nil,
+													Errors:   nil,
+													Loc: &ast.SourceLocation{
+														End: ast.Position{
+															Column: 42,
+															Line:   38,
+														},
+														File:   "slimit_test.flux",
+														Source: "inData",
+														Start: ast.Position{
+															Column: 36,
+															Line:   38,
+														},
+													},
+													TrailingComment: nil,
+												},
+												Name: "inData",
+											},
+										}},
+										With: nil,
+									}},
+									BaseNode: ast.BaseNode{
+										Comments: nil,
+										Errors:   nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 43,
+												Line:   38,
+											},
+											File:   "slimit_test.flux",
+											Source: "testing.loadStorage(csv: inData)",
+											Start: ast.Position{
+												Column: 11,
+												Line:   38,
+											},
+										},
+										TrailingComment: nil,
+									},
+									Callee: &ast.MemberExpression{
+										BaseNode: ast.BaseNode{
+											Comments: nil,
+											Errors:   nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 30,
+													Line:   38,
+												},
+												File:   "slimit_test.flux",
+												Source: "testing.loadStorage",
+												Start: ast.Position{
+													Column: 11,
+													Line:   38,
+												},
+											},
+											TrailingComment: nil,
+										},
+										Object: &ast.Identifier{
+											BaseNode: ast.BaseNode{
+												Comments: nil,
+												Errors:   nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 18,
+														Line:   38,
+													},
+													File:   "slimit_test.flux",
+													Source: "testing",
+													Start: ast.Position{
+														Column: 11,
+														Line:   38,
+													},
+												},
+												TrailingComment: nil,
+											},
+											Name: "testing",
+										},
+										Property: &ast.Identifier{
+											BaseNode: ast.BaseNode{
+												Comments: nil,
+												Errors:   nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 30,
+														Line:   38,
+													},
+													File:   "slimit_test.flux",
+													Source: "loadStorage",
+													Start: ast.Position{
+														Column: 19,
+														Line:   38,
+													},
+												},
+												TrailingComment: nil,
+											},
+											Name: "loadStorage",
+										},
+									},
+								},
+							}, &ast.Property{
+								BaseNode: ast.BaseNode{
+									Comments: nil,
+									Errors:   nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 80,
+											Line:   38,
+										},
+										File:   "slimit_test.flux",
+										Source: "want: testing.loadMem(csv: outData)",
+										Start: ast.Position{
+											Column: 45,
+											Line:   38,
+										},
+									},
+									TrailingComment: nil,
+								},
+								Key: &ast.Identifier{
+									BaseNode: ast.BaseNode{
+										Comments: nil,
+										Errors:   nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 49,
+												Line:   38,
+											},
+											File:   "slimit_test.flux",
+											Source: "want",
+											Start: ast.Position{
+												Column: 45,
+												Line:   38,
+											},
+										},
+										TrailingComment: nil,
+									},
+									Name: "want",
+								},
+								Value: &ast.CallExpression{
+									Arguments: []ast.Expression{&ast.ObjectExpression{
+										BaseNode: ast.BaseNode{
+											Comments: nil,
+											Errors:   nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 79,
+													Line:   38,
+												},
+												File:   "slimit_test.flux",
+												Source: "csv: outData",
+												Start: ast.Position{
+													Column: 67,
+													Line:   38,
+												},
+											},
+											TrailingComment: nil,
+										},
+										Properties: []*ast.Property{&ast.Property{
+											BaseNode: ast.BaseNode{
+												Comments: nil,
+												Errors:   nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 79,
+														Line:   38,
+													},
+													File:   "slimit_test.flux",
+													Source: "csv: outData",
+													Start: ast.Position{
+														Column: 67,
+														Line:   38,
+													},
+												},
+												TrailingComment: nil,
+											},
+											Key: &ast.Identifier{
+												BaseNode: ast.BaseNode{
+													Comments: nil,
+													Errors:   nil,
+													Loc: &ast.SourceLocation{
+														End: ast.Position{
+															Column: 70,
+															Line:   38,
+														},
+														File:   "slimit_test.flux",
+														Source: "csv",
+														Start: ast.Position{
+															Column: 67,
+															Line:   38,
+														},
+													},
+													TrailingComment: nil,
+												},
+												Name: "csv",
+											},
+											Value: &ast.Identifier{
+												BaseNode: ast.BaseNode{
+													Comments: nil,
+													Errors:   nil,
+													Loc: &ast.SourceLocation{
+														End: ast.Position{
+															Column: 79,
+															Line:   38,
+														},
+														File:   "slimit_test.flux",
+														Source: "outData",
+														Start: ast.Position{
+															Column: 72,
+															Line:   38,
+														},
+													},
+													TrailingComment: nil,
+												},
+												Name: "outData",
+											},
+										}},
+										With: nil,
+									}},
+									BaseNode: ast.BaseNode{
+										Comments: nil,
+										Errors:   nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 80,
+												Line:   38,
+											},
+											File:   "slimit_test.flux",
+											Source: "testing.loadMem(csv: outData)",
+											Start: ast.Position{
+												Column: 51,
+												Line:   38,
+											},
+										},
+										TrailingComment: nil,
+									},
+									Callee: &ast.MemberExpression{
+										BaseNode: ast.BaseNode{
+											Comments: nil,
+											Errors:   nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 66,
+													Line:   38,
+												},
+												File:   "slimit_test.flux",
+												Source: "testing.loadMem",
+												Start: ast.Position{
+													Column: 51,
+													Line:   38,
+												},
+											},
+											TrailingComment: nil,
+										},
+										Object: &ast.Identifier{
+											BaseNode: ast.BaseNode{
+												Comments: nil,
+												Errors:   nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 58,
+														Line:   38,
+													},
+													File:   "slimit_test.flux",
+													Source: "testing",
+													Start: ast.Position{
+														Column: 51,
+														Line:   38,
+													},
+												},
+												TrailingComment: nil,
+											},
+											Name: "testing",
+										},
+										Property: &ast.Identifier{
+											BaseNode: ast.BaseNode{
+												Comments: nil,
+												Errors:   nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 66,
+														Line:   38,
+													},
+													File:   "slimit_test.flux",
+													Source: "loadMem",
+													Start: ast.Position{
+														Column: 59,
+														Line:   38,
+													},
+												},
+												TrailingComment: nil,
+											},
+											Name: "loadMem",
+										},
+									},
+								},
+							}, &ast.Property{
+								BaseNode: ast.BaseNode{
+									Comments: nil,
+									Errors:   nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 92,
+											Line:   38,
+										},
+										File:   "slimit_test.flux",
+										Source: "fn: slimit",
+										Start: ast.Position{
+											Column: 82,
+											Line:   38,
+										},
+									},
+									TrailingComment: nil,
+								},
+								Key: &ast.Identifier{
+									BaseNode: ast.BaseNode{
+										Comments: nil,
+										Errors:   nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 84,
+												Line:   38,
+											},
+											File:   "slimit_test.flux",
+											Source: "fn",
+											Start: ast.Position{
+												Column: 82,
+												Line:   38,
+											},
+										},
+										TrailingComment: nil,
+									},
+									Name: "fn",
+								},
+								Value: &ast.Identifier{
+									BaseNode: ast.BaseNode{
+										Comments: nil,
+										Errors:   nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 92,
+												Line:   38,
+											},
+											File:   "slimit_test.flux",
+											Source: "slimit",
+											Start: ast.Position{
+												Column: 86,
+												Line:   38,
+											},
+										},
+										TrailingComment: nil,
+									},
+									Name: "slimit",
+								},
+							}},
+							With: nil,
+						},
+					},
+					Params: nil,
+				},
+			},
+			BaseNode: ast.BaseNode{
+				Comments: nil,
+				Errors:   nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 94,
+						Line:   38,
+					},
+					File:   "slimit_test.flux",
+					Source: "test _slimit = () =>\n\t({input: testing.loadStorage(csv: inData), want: testing.loadMem(csv: outData), fn: slimit})",
+					Start: ast.Position{
+						Column: 1,
+						Line:   37,
+					},
+				},
+				TrailingComment: nil,
+			},
+		}},
+		Eof: nil,
+		Imports: []*ast.ImportDeclaration{&ast.ImportDeclaration{
+			As: nil,
+			BaseNode: ast.BaseNode{
+				Comments: nil,
+				Errors:   nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 27,
+						Line:   3,
+					},
+					File:   "slimit_test.flux",
+					Source: "import \"internal/influxql\"",
+					Start: ast.Position{
+						Column: 1,
+						Line:   3,
+					},
+				},
+				TrailingComment: nil,
+			},
+			Path: &ast.StringLiteral{
+				BaseNode: ast.BaseNode{
+					Comments: nil,
+					Errors:   nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 27,
+							Line:   3,
+						},
+						File:   "slimit_test.flux",
+						Source: "\"internal/influxql\"",
+						Start: ast.Position{
+							Column: 8,
+							Line:   3,
+						},
+					},
+					TrailingComment: nil,
+				},
+				Value: "internal/influxql",
+			},
+		}, &ast.ImportDeclaration{
+			As: nil,
+			BaseNode: ast.BaseNode{
+				Comments: nil,
+				Errors:   nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 17,
+						Line:   4,
+					},
+					File:   "slimit_test.flux",
+					Source: "import \"testing\"",
+					Start: ast.Position{
+						Column: 1,
+						Line:   4,
+					},
+				},
+				TrailingComment: nil,
+			},
+			Path: &ast.StringLiteral{
+				BaseNode: ast.BaseNode{
+					Comments: nil,
+					Errors:   nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 17,
+							Line:   4,
+						},
+						File:   "slimit_test.flux",
+						Source: "\"testing\"",
+						Start: ast.Position{
+							Column: 8,
+							Line:   4,
+						},
+					},
+					TrailingComment: nil,
+				},
+				Value: "testing",
+			},
+		}},
+		Metadata: "parser-type=go",
+		Name:     "slimit_test.flux",
+		Package: &ast.PackageClause{
+			BaseNode: ast.BaseNode{
+				Comments: nil,
+				Errors:   nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 22,
+						Line:   1,
+					},
+					File:   "slimit_test.flux",
+					Source: "package influxql_test",
+					Start: ast.Position{
+						Column: 1,
+						Line:   1,
+					},
+				},
+				TrailingComment: nil,
+			},
+			Name: &ast.Identifier{
+				BaseNode: ast.BaseNode{
+					Comments: nil,
+					Errors:   nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 22,
+							Line:   1,
+						},
+						File:   "slimit_test.flux",
+						Source: "influxql_test",
+						Start: ast.Position{
+							Column: 9,
+							Line:   1,
+						},
+					},
+					TrailingComment: nil,
+				},
+				Name: "influxql_test",
+			},
+		},
+	}},
+	Package: "influxql_test",
+	Path:    "internal/influxql",
+}}
@@ -0,0 +1,167 @@
+package influxql
+
+import (
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/codes"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/internal/errors"
+	"github.com/influxdata/flux/plan"
+	"github.com/influxdata/flux/semantic"
+)
+
+const SLimitKind = "influxql.slimit"
+
+// SLimitOpSpec limits the number of tables returned.
+// It implements the SLIMIT and SOFFSET clauses of InfluxQL.
+type SLimitOpSpec struct {
+	N      int64 `json:"n"`
+	Offset int64 `json:"offset"`
+}
+
+func init() {
+	slimitSignature := flux.FunctionSignature(
+		map[string]semantic.PolyType{
+			"n":      semantic.Int,
+			"offset": semantic.Int,
+		},
+		nil,
+	)
+
+	flux.RegisterPackageValue("internal/influxql", "slimit", flux.FunctionValue("slimit", createSLimitOpSpec, slimitSignature))
+	flux.RegisterOpSpec(SLimitKind, newSLimitOp)
+	plan.RegisterProcedureSpec(SLimitKind, newSLimitProcedure, SLimitKind)
+	execute.RegisterTransformation(SLimitKind, createSLimitTransformation)
+}
+
+func createSLimitOpSpec(args flux.Arguments, a *flux.Administration) (flux.OperationSpec, error) {
+	if err := a.AddParentFromArgs(args); err != nil {
+		return nil, err
+	}
+
+	spec := &SLimitOpSpec{N: -1}
+	if n, ok, err := args.GetInt("n"); err != nil {
+		return nil, err
+	} else if ok {
+		if n < 0 {
+			return nil, errors.New(codes.Invalid, "n must not be negative")
+		}
+		spec.N = n
+	}
+
+	if offset, ok, err := args.GetInt("offset"); err != nil {
+		return nil, err
+	} else if ok {
+		if offset < 0 {
+			return nil, errors.New(codes.Invalid, "offset must not be negative")
+		}
+		spec.Offset = offset
+	}
+	return spec, nil
+}
+
+func newSLimitOp() flux.OperationSpec {
+	return new(SLimitOpSpec)
+}
+
+func (s *SLimitOpSpec) Kind() flux.OperationKind {
+	return SLimitKind
+}
+
+// SLimitProcedureSpec keeps the tables in the range [Offset, Offset+N)
+// when the tables are sorted by their group key. A negative N keeps
+// every table after the offset.
+type SLimitProcedureSpec struct {
+	plan.DefaultCost
+	N      int64 `json:"n"`
+	Offset int64 `json:"offset"`
+}
+
+func newSLimitProcedure(qs flux.OperationSpec, pa plan.Administration) (plan.ProcedureSpec, error) {
+	spec, ok := qs.(*SLimitOpSpec)
+	if !ok {
+		return nil, errors.Newf(codes.Internal, "invalid spec type %T", qs)
+	}
+	return &SLimitProcedureSpec{
+		N:      spec.N,
+		Offset: spec.Offset,
+	}, nil
+}
+
+func (s *SLimitProcedureSpec) Kind() plan.ProcedureKind {
+	return SLimitKind
+}
+
+func (s *SLimitProcedureSpec) Copy() plan.ProcedureSpec {
+	ns := new(SLimitProcedureSpec)
+	*ns = *s
+	return ns
+}
+
+func createSLimitTransformation(id execute.DatasetID, mode execute.AccumulationMode, spec plan.ProcedureSpec, a execute.Administration) (execute.Transformation, execute.Dataset, error) {
+	s, ok := spec.(*SLimitProcedureSpec)
+	if !ok {
+		return nil, nil, errors.Newf(codes.Internal, "invalid spec type %T", spec)
+	}
+	cache := execute.NewTableBuilderCache(a.Allocator())
+	d := execute.NewDataset(id, mode, cache)
+	t := NewSLimitTransformation(d, cache, s)
+	return t, d, nil
+}
+
+type slimitTransformation struct {
+	d     execute.Dataset
+	cache execute.DataCache
+
+	n, offset int64
+}
+
+// NewSLimitTransformation creates a transformation that buffers every table
+// and, once its input is finished, only keeps the tables selected by the spec.
+func NewSLimitTransformation(d execute.Dataset, cache execute.DataCache, spec *SLimitProcedureSpec) *slimitTransformation {
+	return &slimitTransformation{
+		d:      d,
+		cache:  cache,
+		n:      spec.N,
+		offset: spec.Offset,
+	}
+}
+
+func (t *slimitTransformation) RetractTable(id execute.DatasetID, key flux.GroupKey) error {
+	return t.d.RetractTable(key)
+}
+
+func (t *slimitTransformation) Process(id execute.DatasetID, tbl flux.Table) error {
+	// Tables with the same group key are merged into one table.
+	builder, _ := t.cache.(execute.TableBuilderCache).TableBuilder(tbl.Key())
+	colMap, err := execute.AddNewTableCols(tbl, builder, nil)
+	if err != nil {
+		return err
+	}
+	return execute.AppendMappedTable(tbl, builder, colMap)
+}
+
+func (t *slimitTransformation) UpdateWatermark(id execute.DatasetID, mark execute.Time) error {
+	return t.d.UpdateWatermark(mark)
+}
+
+func (t *slimitTransformation) UpdateProcessingTime(id execute.DatasetID, pt execute.Time) error {
+	return t.d.UpdateProcessingTime(pt)
+}
+
+func (t *slimitTransformation) Finish(id execute.DatasetID, err error) {
+	if err == nil {
+		// The cache iterates over the tables in group key order.
+		var expired []flux.GroupKey
+		i := int64(0)
+		t.cache.ForEach(func(key flux.GroupKey) {
+			if i < t.offset || (t.n >= 0 && i >= t.offset+t.n) {
+				expired = append(expired, key)
+			}
+			i++
+		})
+		for _, key := range expired {
+			t.cache.ExpireTable(key)
+		}
+	}
+	t.d.Finish(err)
+}
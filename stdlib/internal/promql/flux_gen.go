@@ -13,12 +13,15 @@ func init() {
 
 var pkgAST = &ast.Package{
 	BaseNode: ast.BaseNode{
-		Errors: nil,
-		Loc:    nil,
+		Comments:        nil,
+		Errors:          nil,
+		Loc:             nil,
+		TrailingComment: nil,
 	},
 	Files: []*ast.File{&ast.File{
 		BaseNode: ast.BaseNode{
-			Errors: nil,
+			Comments: nil,
+			Errors:   nil,
 			Loc: &ast.SourceLocation{
 				End: ast.Position{
 					Column: 13,
@@ -31,10 +34,12 @@ var pkgAST = &ast.Package{
 					Line:   2,
 				},
 			},
+			TrailingComment: nil,
 		},
 		Body: []ast.Statement{&ast.BuiltinStatement{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: []ast.Comment{ast.Comment{Text: "// changes() implements functionality equivalent to PromQL's changes() function:"}, ast.Comment{Text: "//"}, ast.Comment{Text: "// https://prometheus.io/docs/prometheus/latest/querying/functions/#changes"}},
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 16,
@@ -47,10 +52,12 @@ var pkgAST = &ast.Package{
 						Line:   10,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 16,
@@ -63,12 +70,14 @@ var pkgAST = &ast.Package{
 							Line:   10,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "changes",
 			},
 		}, &ast.BuiltinStatement{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: []ast.Comment{ast.Comment{Text: "// promqlDayOfMonth() implements functionality equivalent to PromQL's day_of_month() function:"}, ast.Comment{Text: "//"}, ast.Comment{Text: "// https://prometheus.io/docs/prometheus/latest/querying/functions/#day_of_month"}},
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 25,
@@ -81,10 +90,12 @@ var pkgAST = &ast.Package{
 						Line:   15,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 25,
@@ -97,12 +108,14 @@ var pkgAST = &ast.Package{
 							Line:   15,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "promqlDayOfMonth",
 			},
 		}, &ast.BuiltinStatement{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: []ast.Comment{ast.Comment{Text: "// promqlDayOfWeek() implements functionality equivalent to PromQL's day_of_week() function:"}, ast.Comment{Text: "//"}, ast.Comment{Text: "// https://prometheus.io/docs/prometheus/latest/querying/functions/#day_of_week"}},
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 24,
@@ -115,10 +128,12 @@ var pkgAST = &ast.Package{
 						Line:   20,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 24,
@@ -131,12 +146,14 @@ var pkgAST = &ast.Package{
 							Line:   20,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "promqlDayOfWeek",
 			},
 		}, &ast.BuiltinStatement{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: []ast.Comment{ast.Comment{Text: "// promqlDaysInMonth() implements functionality equivalent to PromQL's days_in_month() function:"}, ast.Comment{Text: "//"}, ast.Comment{Text: "// https://prometheus.io/docs/prometheus/latest/querying/functions/#days_in_month"}},
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 26,
@@ -149,10 +166,12 @@ var pkgAST = &ast.Package{
 						Line:   25,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 26,
@@ -165,12 +184,14 @@ var pkgAST = &ast.Package{
 							Line:   25,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "promqlDaysInMonth",
 			},
 		}, &ast.BuiltinStatement{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: []ast.Comment{ast.Comment{Text: "// emptyTable() returns an empty table, which is used as a helper function to implement"}, ast.Comment{Text: "// PromQL's time() and vector() functions:"}, ast.Comment{Text: "//"}, ast.Comment{Text: "// https://prometheus.io/docs/prometheus/latest/querying/functions/#time"}, ast.Comment{Text: "// https://prometheus.io/docs/prometheus/latest/querying/functions/#vector"}},
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 19,
@@ -183,10 +204,12 @@ var pkgAST = &ast.Package{
 						Line:   32,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 19,
@@ -199,12 +222,14 @@ var pkgAST = &ast.Package{
 							Line:   32,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "emptyTable",
 			},
 		}, &ast.BuiltinStatement{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: []ast.Comment{ast.Comment{Text: "// extrapolatedRate() is a helper function that calculates extrapolated rates over"}, ast.Comment{Text: "// counters and is used to implement PromQL's rate(), delta(), and increase() functions."}, ast.Comment{Text: "//"}, ast.Comment{Text: "// https://prometheus.io/docs/prometheus/latest/querying/functions/#rate"}, ast.Comment{Text: "// https://prometheus.io/docs/prometheus/latest/querying/functions/#increase"}, ast.Comment{Text: "// https://prometheus.io/docs/prometheus/latest/querying/functions/#delta"}},
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 25,
@@ -217,10 +242,12 @@ var pkgAST = &ast.Package{
 						Line:   40,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 25,
@@ -233,12 +260,14 @@ var pkgAST = &ast.Package{
 							Line:   40,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "extrapolatedRate",
 			},
 		}, &ast.BuiltinStatement{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: []ast.Comment{ast.Comment{Text: "// holtWinters() implements functionality equivalent to PromQL's holt_winters()"}, ast.Comment{Text: "// function:"}, ast.Comment{Text: "//"}, ast.Comment{Text: "// https://prometheus.io/docs/prometheus/latest/querying/functions/#holt_winters"}},
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 20,
@@ -251,10 +280,12 @@ var pkgAST = &ast.Package{
 						Line:   46,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 20,
@@ -267,12 +298,14 @@ var pkgAST = &ast.Package{
 							Line:   46,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "holtWinters",
 			},
 		}, &ast.BuiltinStatement{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: []ast.Comment{ast.Comment{Text: "// promqlHour() implements functionality equivalent to PromQL's hour() function:"}, ast.Comment{Text: "//"}, ast.Comment{Text: "// https://prometheus.io/docs/prometheus/latest/querying/functions/#hour"}},
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 19,
@@ -285,10 +318,12 @@ var pkgAST = &ast.Package{
 						Line:   51,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 19,
@@ -301,12 +336,14 @@ var pkgAST = &ast.Package{
 							Line:   51,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "promqlHour",
 			},
 		}, &ast.BuiltinStatement{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: []ast.Comment{ast.Comment{Text: "// instantRate() is a helper function that calculates instant rates over"}, ast.Comment{Text: "// counters and is used to implement PromQL's irate() and idelta() functions."}, ast.Comment{Text: "//"}, ast.Comment{Text: "// https://prometheus.io/docs/prometheus/latest/querying/functions/#irate"}, ast.Comment{Text: "// https://prometheus.io/docs/prometheus/latest/querying/functions/#idelta"}},
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 20,
@@ -319,10 +356,12 @@ var pkgAST = &ast.Package{
 						Line:   58,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 20,
@@ -335,12 +374,14 @@ var pkgAST = &ast.Package{
 							Line:   58,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "instantRate",
 			},
 		}, &ast.BuiltinStatement{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: []ast.Comment{ast.Comment{Text: "// labelReplace implements functionality equivalent to PromQL's label_replace() function:"}, ast.Comment{Text: "//"}, ast.Comment{Text: "// https://prometheus.io/docs/prometheus/latest/querying/functions/#label_replace"}},
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 21,
@@ -353,10 +394,12 @@ var pkgAST = &ast.Package{
 						Line:   63,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 21,
@@ -369,12 +412,14 @@ var pkgAST = &ast.Package{
 							Line:   63,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "labelReplace",
 			},
 		}, &ast.BuiltinStatement{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: []ast.Comment{ast.Comment{Text: "// linearRegression implements linear regression functionality required to implement"}, ast.Comment{Text: "// PromQL's deriv() and predict_linear() functions:"}, ast.Comment{Text: "//"}, ast.Comment{Text: "// https://prometheus.io/docs/prometheus/latest/querying/functions/#deriv"}, ast.Comment{Text: "// https://prometheus.io/docs/prometheus/latest/querying/functions/#predict_linear"}},
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 25,
@@ -387,10 +432,12 @@ var pkgAST = &ast.Package{
 						Line:   70,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 25,
@@ -403,12 +450,14 @@ var pkgAST = &ast.Package{
 							Line:   70,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "linearRegression",
 			},
 		}, &ast.BuiltinStatement{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: []ast.Comment{ast.Comment{Text: "// promqlMinute() implements functionality equivalent to PromQL's minute() function:"}, ast.Comment{Text: "//"}, ast.Comment{Text: "// https://prometheus.io/docs/prometheus/latest/querying/functions/#minute"}},
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 21,
@@ -421,10 +470,12 @@ var pkgAST = &ast.Package{
 						Line:   75,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 21,
@@ -437,12 +488,14 @@ var pkgAST = &ast.Package{
 							Line:   75,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "promqlMinute",
 			},
 		}, &ast.BuiltinStatement{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: []ast.Comment{ast.Comment{Text: "// promqlMonth() implements functionality equivalent to PromQL's month() function:"}, ast.Comment{Text: "//"}, ast.Comment{Text: "// https://prometheus.io/docs/prometheus/latest/querying/functions/#month"}},
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 20,
@@ -455,10 +508,12 @@ var pkgAST = &ast.Package{
 						Line:   80,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 20,
@@ -471,12 +526,14 @@ var pkgAST = &ast.Package{
 							Line:   80,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "promqlMonth",
 			},
 		}, &ast.BuiltinStatement{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: []ast.Comment{ast.Comment{Text: "// promHistogramQuantile() implements functionality equivalent to PromQL's"}, ast.Comment{Text: "// histogram_quantile() function:"}, ast.Comment{Text: "//"}, ast.Comment{Text: "// https://prometheus.io/docs/prometheus/latest/querying/functions/#histogram_quantile"}},
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 30,
@@ -489,10 +546,12 @@ var pkgAST = &ast.Package{
 						Line:   86,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 30,
@@ -505,12 +564,14 @@ var pkgAST = &ast.Package{
 							Line:   86,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "promHistogramQuantile",
 			},
 		}, &ast.BuiltinStatement{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: []ast.Comment{ast.Comment{Text: "// resets() implements functionality equivalent to PromQL's resets() function:"}, ast.Comment{Text: "//"}, ast.Comment{Text: "// https://prometheus.io/docs/prometheus/latest/querying/functions/#resets"}},
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 15,
@@ -523,10 +584,12 @@ var pkgAST = &ast.Package{
 						Line:   91,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 15,
@@ -539,12 +602,14 @@ var pkgAST = &ast.Package{
 							Line:   91,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "resets",
 			},
 		}, &ast.BuiltinStatement{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: []ast.Comment{ast.Comment{Text: "// timestamp() implements functionality equivalent to PromQL's timestamp() function:"}, ast.Comment{Text: "//"}, ast.Comment{Text: "// https://prometheus.io/docs/prometheus/latest/querying/functions/#timestamp"}},
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 18,
@@ -557,10 +622,12 @@ var pkgAST = &ast.Package{
 						Line:   96,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 18,
@@ -573,12 +640,14 @@ var pkgAST = &ast.Package{
 							Line:   96,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "timestamp",
 			},
 		}, &ast.BuiltinStatement{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: []ast.Comment{ast.Comment{Text: "// promqlYear() implements functionality equivalent to PromQL's year() function:"}, ast.Comment{Text: "//"}, ast.Comment{Text: "// https://prometheus.io/docs/prometheus/latest/querying/functions/#year"}},
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 19,
@@ -591,10 +660,12 @@ var pkgAST = &ast.Package{
 						Line:   101,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 19,
@@ -607,12 +678,14 @@ var pkgAST = &ast.Package{
 							Line:   101,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "promqlYear",
 			},
 		}, &ast.VariableAssignment{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: []ast.Comment{ast.Comment{Text: "// quantile() accounts checks for quantile values that are out of range, above 1.0 or "}, ast.Comment{Text: "// below 0.0, by either returning positive infinity or negative infinity in the `_value` "}, ast.Comment{Text: "// column respectively. q must be a float "}},
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 96,
@@ -625,10 +698,12 @@ var pkgAST = &ast.Package{
 						Line:   107,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 9,
@@ -641,12 +716,14 @@ var pkgAST = &ast.Package{
 							Line:   107,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "quantile",
 			},
 			Init: &ast.FunctionExpression{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 96,
@@ -659,12 +736,14 @@ var pkgAST = &ast.Package{
 							Line:   107,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Body: &ast.ConditionalExpression{
 					Alternate: &ast.ConditionalExpression{
 						Alternate: &ast.ParenExpression{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 96,
@@ -677,11 +756,13 @@ var pkgAST = &ast.Package{
 										Line:   116,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Expression: &ast.PipeExpression{
 								Argument: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 12,
@@ -694,11 +775,13 @@ var pkgAST = &ast.Package{
 												Line:   116,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "tables",
 								},
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 95,
@@ -711,11 +794,13 @@ var pkgAST = &ast.Package{
 											Line:   116,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Call: &ast.CallExpression{
 									Arguments: []ast.Expression{&ast.ObjectExpression{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 94,
@@ -728,10 +813,12 @@ var pkgAST = &ast.Package{
 													Line:   117,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Properties: []*ast.Property{&ast.Property{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 57,
@@ -744,10 +831,12 @@ var pkgAST = &ast.Package{
 														Line:   117,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Key: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 27,
@@ -760,12 +849,14 @@ var pkgAST = &ast.Package{
 															Line:   117,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "identity",
 											},
 											Value: &ast.ObjectExpression{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 57,
@@ -778,10 +869,12 @@ var pkgAST = &ast.Package{
 															Line:   117,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Properties: []*ast.Property{&ast.Property{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 56,
@@ -794,10 +887,12 @@ var pkgAST = &ast.Package{
 																Line:   117,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Key: &ast.Identifier{
 														BaseNode: ast.BaseNode{
-															Errors: nil,
+															Comments: nil,
+															Errors:   nil,
 															Loc: &ast.SourceLocation{
 																End: ast.Position{
 																	Column: 36,
@@ -810,13 +905,15 @@ var pkgAST = &ast.Package{
 																	Line:   117,
 																},
 															},
+															TrailingComment: nil,
 														},
 														Name: "_value",
 													},
 													Value: &ast.CallExpression{
 														Arguments: []ast.Expression{&ast.ObjectExpression{
 															BaseNode: ast.BaseNode{
-																Errors: nil,
+																Comments: nil,
+																Errors:   nil,
 																Loc: &ast.SourceLocation{
 																	End: ast.Position{
 																		Column: 55,
@@ -829,10 +926,12 @@ var pkgAST = &ast.Package{
 																		Line:   117,
 																	},
 																},
+																TrailingComment: nil,
 															},
 															Properties: []*ast.Property{&ast.Property{
 																BaseNode: ast.BaseNode{
-																	Errors: nil,
+																	Comments: nil,
+																	Errors:   nil,
 																	Loc: &ast.SourceLocation{
 																		End: ast.Position{
 																			Column: 55,
@@ -845,10 +944,12 @@ var pkgAST = &ast.Package{
 																			Line:   117,
 																		},
 																	},
+																	TrailingComment: nil,
 																},
 																Key: &ast.Identifier{
 																	BaseNode: ast.BaseNode{
-																		Errors: nil,
+																		Comments: nil,
+																		Errors:   nil,
 																		Loc: &ast.SourceLocation{
 																			End: ast.Position{
 																				Column: 52,
@@ -861,12 +962,14 @@ var pkgAST = &ast.Package{
 																				Line:   117,
 																			},
 																		},
+																		TrailingComment: nil,
 																	},
 																	Name: "sign",
 																},
 																Value: &ast.IntegerLiteral{
 																	BaseNode: ast.BaseNode{
-																		Errors: nil,
+																		Comments: nil,
+																		Errors:   nil,
 																		Loc: &ast.SourceLocation{
 																			End: ast.Position{
 																				Column: 55,
@@ -879,6 +982,7 @@ var pkgAST = &ast.Package{
 																				Line:   117,
 																			},
 																		},
+																		TrailingComment: nil,
 																	},
 																	Value: int64(1),
 																},
@@ -886,7 +990,8 @@ var pkgAST = &ast.Package{
 															With: nil,
 														}},
 														BaseNode: ast.BaseNode{
-															Errors: nil,
+															Comments: nil,
+															Errors:   nil,
 															Loc: &ast.SourceLocation{
 																End: ast.Position{
 																	Column: 56,
@@ -899,10 +1004,12 @@ var pkgAST = &ast.Package{
 																	Line:   117,
 																},
 															},
+															TrailingComment: nil,
 														},
 														Callee: &ast.MemberExpression{
 															BaseNode: ast.BaseNode{
-																Errors: nil,
+																Comments: nil,
+																Errors:   nil,
 																Loc: &ast.SourceLocation{
 																	End: ast.Position{
 																		Column: 47,
@@ -915,10 +1022,12 @@ var pkgAST = &ast.Package{
 																		Line:   117,
 																	},
 																},
+																TrailingComment: nil,
 															},
 															Object: &ast.Identifier{
 																BaseNode: ast.BaseNode{
-																	Errors: nil,
+																	Comments: nil,
+																	Errors:   nil,
 																	Loc: &ast.SourceLocation{
 																		End: ast.Position{
 																			Column: 42,
@@ -931,12 +1040,14 @@ var pkgAST = &ast.Package{
 																			Line:   117,
 																		},
 																	},
+																	TrailingComment: nil,
 																},
 																Name: "math",
 															},
 															Property: &ast.Identifier{
 																BaseNode: ast.BaseNode{
-																	Errors: nil,
+																	Comments: nil,
+																	Errors:   nil,
 																	Loc: &ast.SourceLocation{
 																		End: ast.Position{
 																			Column: 47,
@@ -949,6 +1060,7 @@ var pkgAST = &ast.Package{
 																			Line:   117,
 																		},
 																	},
+																	TrailingComment: nil,
 																},
 																Name: "mInf",
 															},
@@ -959,7 +1071,8 @@ var pkgAST = &ast.Package{
 											},
 										}, &ast.Property{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 94,
@@ -972,10 +1085,12 @@ var pkgAST = &ast.Package{
 														Line:   117,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Key: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 61,
@@ -988,12 +1103,14 @@ var pkgAST = &ast.Package{
 															Line:   117,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "fn",
 											},
 											Value: &ast.FunctionExpression{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 94,
@@ -1006,10 +1123,12 @@ var pkgAST = &ast.Package{
 															Line:   117,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Body: &ast.Identifier{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 94,
@@ -1022,12 +1141,14 @@ var pkgAST = &ast.Package{
 																Line:   117,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Name: "accumulator",
 												},
 												Params: []*ast.Property{&ast.Property{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 65,
@@ -1040,10 +1161,12 @@ var pkgAST = &ast.Package{
 																Line:   117,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Key: &ast.Identifier{
 														BaseNode: ast.BaseNode{
-															Errors: nil,
+															Comments: nil,
+															Errors:   nil,
 															Loc: &ast.SourceLocation{
 																End: ast.Position{
 																	Column: 65,
@@ -1056,13 +1179,15 @@ var pkgAST = &ast.Package{
 																	Line:   117,
 																},
 															},
+															TrailingComment: nil,
 														},
 														Name: "r",
 													},
 													Value: nil,
 												}, &ast.Property{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 78,
@@ -1075,10 +1200,12 @@ var pkgAST = &ast.Package{
 																Line:   117,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Key: &ast.Identifier{
 														BaseNode: ast.BaseNode{
-															Errors: nil,
+															Comments: nil,
+															Errors:   nil,
 															Loc: &ast.SourceLocation{
 																End: ast.Position{
 																	Column: 78,
@@ -1091,6 +1218,7 @@ var pkgAST = &ast.Package{
 																	Line:   117,
 																},
 															},
+															TrailingComment: nil,
 														},
 														Name: "accumulator",
 													},
@@ -1101,7 +1229,8 @@ var pkgAST = &ast.Package{
 										With: nil,
 									}},
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 95,
@@ -1114,10 +1243,12 @@ var pkgAST = &ast.Package{
 												Line:   117,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Callee: &ast.Identifier{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 18,
@@ -1130,6 +1261,7 @@ var pkgAST = &ast.Package{
 													Line:   117,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Name: "reduce",
 									},
@@ -1137,7 +1269,8 @@ var pkgAST = &ast.Package{
 							},
 						},
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 96,
@@ -1150,10 +1283,12 @@ var pkgAST = &ast.Package{
 									Line:   112,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Consequent: &ast.ParenExpression{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 97,
@@ -1166,11 +1301,13 @@ var pkgAST = &ast.Package{
 										Line:   113,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Expression: &ast.PipeExpression{
 								Argument: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 12,
@@ -1183,11 +1320,13 @@ var pkgAST = &ast.Package{
 												Line:   113,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "tables",
 								},
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 96,
@@ -1200,11 +1339,13 @@ var pkgAST = &ast.Package{
 											Line:   113,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Call: &ast.CallExpression{
 									Arguments: []ast.Expression{&ast.ObjectExpression{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 95,
@@ -1217,10 +1358,12 @@ var pkgAST = &ast.Package{
 													Line:   114,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Properties: []*ast.Property{&ast.Property{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 58,
@@ -1233,10 +1376,12 @@ var pkgAST = &ast.Package{
 														Line:   114,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Key: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 27,
@@ -1249,12 +1394,14 @@ var pkgAST = &ast.Package{
 															Line:   114,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "identity",
 											},
 											Value: &ast.ObjectExpression{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 58,
@@ -1267,10 +1414,12 @@ var pkgAST = &ast.Package{
 															Line:   114,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Properties: []*ast.Property{&ast.Property{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 57,
@@ -1283,10 +1432,12 @@ var pkgAST = &ast.Package{
 																Line:   114,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Key: &ast.Identifier{
 														BaseNode: ast.BaseNode{
-															Errors: nil,
+															Comments: nil,
+															Errors:   nil,
 															Loc: &ast.SourceLocation{
 																End: ast.Position{
 																	Column: 36,
@@ -1299,13 +1450,15 @@ var pkgAST = &ast.Package{
 																	Line:   114,
 																},
 															},
+															TrailingComment: nil,
 														},
 														Name: "_value",
 													},
 													Value: &ast.CallExpression{
 														Arguments: []ast.Expression{&ast.ObjectExpression{
 															BaseNode: ast.BaseNode{
-																Errors: nil,
+																Comments: nil,
+																Errors:   nil,
 																Loc: &ast.SourceLocation{
 																	End: ast.Position{
 																		Column: 56,
@@ -1318,10 +1471,12 @@ var pkgAST = &ast.Package{
 																		Line:   114,
 																	},
 																},
+																TrailingComment: nil,
 															},
 															Properties: []*ast.Property{&ast.Property{
 																BaseNode: ast.BaseNode{
-																	Errors: nil,
+																	Comments: nil,
+																	Errors:   nil,
 																	Loc: &ast.SourceLocation{
 																		End: ast.Position{
 																			Column: 56,
@@ -1334,10 +1489,12 @@ var pkgAST = &ast.Package{
 																			Line:   114,
 																		},
 																	},
+																	TrailingComment: nil,
 																},
 																Key: &ast.Identifier{
 																	BaseNode: ast.BaseNode{
-																		Errors: nil,
+																		Comments: nil,
+																		Errors:   nil,
 																		Loc: &ast.SourceLocation{
 																			End: ast.Position{
 																				Column: 52,
@@ -1350,13 +1507,15 @@ var pkgAST = &ast.Package{
 																				Line:   114,
 																			},
 																		},
+																		TrailingComment: nil,
 																	},
 																	Name: "sign",
 																},
 																Value: &ast.UnaryExpression{
 																	Argument: &ast.IntegerLiteral{
 																		BaseNode: ast.BaseNode{
-																			Errors: nil,
+																			Comments: nil,
+																			Errors:   nil,
 																			Loc: &ast.SourceLocation{
 																				End: ast.Position{
 																					Column: 56,
@@ -1369,11 +1528,13 @@ var pkgAST = &ast.Package{
 																					Line:   114,
 																				},
 																			},
+																			TrailingComment: nil,
 																		},
 																		Value: int64(1),
 																	},
 																	BaseNode: ast.BaseNode{
-																		Errors: nil,
+																		Comments: nil,
+																		Errors:   nil,
 																		Loc: &ast.SourceLocation{
 																			End: ast.Position{
 																				Column: 56,
@@ -1386,6 +1547,7 @@ var pkgAST = &ast.Package{
 																				Line:   114,
 																			},
 																		},
+																		TrailingComment: nil,
 																	},
 																	Operator: 6,
 																},
@@ -1393,7 +1555,8 @@ var pkgAST = &ast.Package{
 															With: nil,
 														}},
 														BaseNode: ast.BaseNode{
-															Errors: nil,
+															Comments: nil,
+															Errors:   nil,
 															Loc: &ast.SourceLocation{
 																End: ast.Position{
 																	Column: 57,
@@ -1406,10 +1569,12 @@ var pkgAST = &ast.Package{
 																	Line:   114,
 																},
 															},
+															TrailingComment: nil,
 														},
 														Callee: &ast.MemberExpression{
 															BaseNode: ast.BaseNode{
-																Errors: nil,
+																Comments: nil,
+																Errors:   nil,
 																Loc: &ast.SourceLocation{
 																	End: ast.Position{
 																		Column: 47,
@@ -1422,10 +1587,12 @@ var pkgAST = &ast.Package{
 																		Line:   114,
 																	},
 																},
+																TrailingComment: nil,
 															},
 															Object: &ast.Identifier{
 																BaseNode: ast.BaseNode{
-																	Errors: nil,
+																	Comments: nil,
+																	Errors:   nil,
 																	Loc: &ast.SourceLocation{
 																		End: ast.Position{
 																			Column: 42,
@@ -1438,12 +1605,14 @@ var pkgAST = &ast.Package{
 																			Line:   114,
 																		},
 																	},
+																	TrailingComment: nil,
 																},
 																Name: "math",
 															},
 															Property: &ast.Identifier{
 																BaseNode: ast.BaseNode{
-																	Errors: nil,
+																	Comments: nil,
+																	Errors:   nil,
 																	Loc: &ast.SourceLocation{
 																		End: ast.Position{
 																			Column: 47,
@@ -1456,6 +1625,7 @@ var pkgAST = &ast.Package{
 																			Line:   114,
 																		},
 																	},
+																	TrailingComment: nil,
 																},
 																Name: "mInf",
 															},
@@ -1466,7 +1636,8 @@ var pkgAST = &ast.Package{
 											},
 										}, &ast.Property{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 95,
@@ -1479,10 +1650,12 @@ var pkgAST = &ast.Package{
 														Line:   114,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Key: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 62,
@@ -1495,12 +1668,14 @@ var pkgAST = &ast.Package{
 															Line:   114,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "fn",
 											},
 											Value: &ast.FunctionExpression{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 95,
@@ -1513,10 +1688,12 @@ var pkgAST = &ast.Package{
 															Line:   114,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Body: &ast.Identifier{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 95,
@@ -1529,12 +1706,14 @@ var pkgAST = &ast.Package{
 																Line:   114,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Name: "accumulator",
 												},
 												Params: []*ast.Property{&ast.Property{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 66,
@@ -1547,10 +1726,12 @@ var pkgAST = &ast.Package{
 																Line:   114,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Key: &ast.Identifier{
 														BaseNode: ast.BaseNode{
-															Errors: nil,
+															Comments: nil,
+															Errors:   nil,
 															Loc: &ast.SourceLocation{
 																End: ast.Position{
 																	Column: 66,
@@ -1563,13 +1744,15 @@ var pkgAST = &ast.Package{
 																	Line:   114,
 																},
 															},
+															TrailingComment: nil,
 														},
 														Name: "r",
 													},
 													Value: nil,
 												}, &ast.Property{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 79,
@@ -1582,10 +1765,12 @@ var pkgAST = &ast.Package{
 																Line:   114,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Key: &ast.Identifier{
 														BaseNode: ast.BaseNode{
-															Errors: nil,
+															Comments: nil,
+															Errors:   nil,
 															Loc: &ast.SourceLocation{
 																End: ast.Position{
 																	Column: 79,
@@ -1598,6 +1783,7 @@ var pkgAST = &ast.Package{
 																	Line:   114,
 																},
 															},
+															TrailingComment: nil,
 														},
 														Name: "accumulator",
 													},
@@ -1608,7 +1794,8 @@ var pkgAST = &ast.Package{
 										With: nil,
 									}},
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 96,
@@ -1621,10 +1808,12 @@ var pkgAST = &ast.Package{
 												Line:   114,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Callee: &ast.Identifier{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 18,
@@ -1637,6 +1826,7 @@ var pkgAST = &ast.Package{
 													Line:   114,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Name: "reduce",
 									},
@@ -1645,7 +1835,8 @@ var pkgAST = &ast.Package{
 						},
 						Test: &ast.BinaryExpression{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 20,
@@ -1658,10 +1849,12 @@ var pkgAST = &ast.Package{
 										Line:   112,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Left: &ast.Identifier{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 14,
@@ -1674,13 +1867,15 @@ var pkgAST = &ast.Package{
 											Line:   112,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Name: "q",
 							},
 							Operator: 8,
 							Right: &ast.FloatLiteral{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 20,
@@ -1693,13 +1888,15 @@ var pkgAST = &ast.Package{
 											Line:   112,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Value: 0.0,
 							},
 						},
 					},
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: []ast.Comment{ast.Comment{Text: "// value is in normal range. We can use the normal quantile function"}},
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 96,
@@ -1712,10 +1909,12 @@ var pkgAST = &ast.Package{
 								Line:   109,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Consequent: &ast.ParenExpression{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 52,
@@ -1728,11 +1927,13 @@ var pkgAST = &ast.Package{
 									Line:   110,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Expression: &ast.PipeExpression{
 							Argument: &ast.Identifier{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 12,
@@ -1745,11 +1946,13 @@ var pkgAST = &ast.Package{
 											Line:   110,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Name: "tables",
 							},
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 51,
@@ -1762,11 +1965,13 @@ var pkgAST = &ast.Package{
 										Line:   110,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Call: &ast.CallExpression{
 								Arguments: []ast.Expression{&ast.ObjectExpression{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 50,
@@ -1779,10 +1984,12 @@ var pkgAST = &ast.Package{
 												Line:   111,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Properties: []*ast.Property{&ast.Property{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 34,
@@ -1795,10 +2002,12 @@ var pkgAST = &ast.Package{
 													Line:   111,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Key: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 31,
@@ -1811,12 +2020,14 @@ var pkgAST = &ast.Package{
 														Line:   111,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "q",
 										},
 										Value: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 34,
@@ -1829,12 +2040,14 @@ var pkgAST = &ast.Package{
 														Line:   111,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "q",
 										},
 									}, &ast.Property{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 50,
@@ -1847,10 +2060,12 @@ var pkgAST = &ast.Package{
 													Line:   111,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Key: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 42,
@@ -1863,12 +2078,14 @@ var pkgAST = &ast.Package{
 														Line:   111,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "method",
 										},
 										Value: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 50,
@@ -1881,6 +2098,7 @@ var pkgAST = &ast.Package{
 														Line:   111,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "method",
 										},
@@ -1888,7 +2106,8 @@ var pkgAST = &ast.Package{
 									With: nil,
 								}},
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 51,
@@ -1901,10 +2120,12 @@ var pkgAST = &ast.Package{
 											Line:   111,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Callee: &ast.MemberExpression{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 29,
@@ -1917,10 +2138,12 @@ var pkgAST = &ast.Package{
 												Line:   111,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Object: &ast.Identifier{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 20,
@@ -1933,12 +2156,14 @@ var pkgAST = &ast.Package{
 													Line:   111,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Name: "universe",
 									},
 									Property: &ast.Identifier{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 29,
@@ -1951,6 +2176,7 @@ var pkgAST = &ast.Package{
 													Line:   111,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Name: "quantile",
 									},
@@ -1960,7 +2186,8 @@ var pkgAST = &ast.Package{
 					},
 					Test: &ast.LogicalExpression{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 29,
@@ -1973,10 +2200,12 @@ var pkgAST = &ast.Package{
 									Line:   109,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Left: &ast.BinaryExpression{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 16,
@@ -1989,10 +2218,12 @@ var pkgAST = &ast.Package{
 										Line:   109,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Left: &ast.Identifier{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 9,
@@ -2005,13 +2236,15 @@ var pkgAST = &ast.Package{
 											Line:   109,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Name: "q",
 							},
 							Operator: 7,
 							Right: &ast.FloatLiteral{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 16,
@@ -2024,6 +2257,7 @@ var pkgAST = &ast.Package{
 											Line:   109,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Value: 1.0,
 							},
@@ -2031,7 +2265,8 @@ var pkgAST = &ast.Package{
 						Operator: 1,
 						Right: &ast.BinaryExpression{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 29,
@@ -2044,10 +2279,12 @@ var pkgAST = &ast.Package{
 										Line:   109,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Left: &ast.Identifier{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 22,
@@ -2060,13 +2297,15 @@ var pkgAST = &ast.Package{
 											Line:   109,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Name: "q",
 							},
 							Operator: 9,
 							Right: &ast.FloatLiteral{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 29,
@@ -2079,6 +2318,7 @@ var pkgAST = &ast.Package{
 											Line:   109,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Value: 0.0,
 							},
@@ -2087,7 +2327,8 @@ var pkgAST = &ast.Package{
 				},
 				Params: []*ast.Property{&ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 14,
@@ -2100,10 +2341,12 @@ var pkgAST = &ast.Package{
 								Line:   107,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 14,
@@ -2116,13 +2359,15 @@ var pkgAST = &ast.Package{
 									Line:   107,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Name: "q",
 					},
 					Value: nil,
 				}, &ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 25,
@@ -2135,10 +2380,12 @@ var pkgAST = &ast.Package{
 								Line:   107,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 22,
@@ -2151,11 +2398,13 @@ var pkgAST = &ast.Package{
 									Line:   107,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Name: "tables",
 					},
 					Value: &ast.PipeLiteral{BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 25,
@@ -2168,10 +2417,12 @@ var pkgAST = &ast.Package{
 								Line:   107,
 							},
 						},
+						TrailingComment: nil,
 					}},
 				}, &ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 46,
@@ -2184,10 +2435,12 @@ var pkgAST = &ast.Package{
 								Line:   107,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 33,
@@ -2200,12 +2453,14 @@ var pkgAST = &ast.Package{
 									Line:   107,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Name: "method",
 					},
 					Value: &ast.StringLiteral{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 46,
@@ -2218,6 +2473,7 @@ var pkgAST = &ast.Package{
 									Line:   107,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Value: "exact_mean",
 					},
@@ -2225,7 +2481,8 @@ var pkgAST = &ast.Package{
 			},
 		}, &ast.BuiltinStatement{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 13,
@@ -2238,10 +2495,12 @@ var pkgAST = &ast.Package{
 						Line:   119,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 13,
@@ -2254,14 +2513,17 @@ var pkgAST = &ast.Package{
 							Line:   119,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "join",
 			},
 		}},
+		Eof: nil,
 		Imports: []*ast.ImportDeclaration{&ast.ImportDeclaration{
 			As: nil,
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 14,
@@ -2274,10 +2536,12 @@ var pkgAST = &ast.Package{
 						Line:   4,
 					},
 				},
+				TrailingComment: nil,
 			},
 			Path: &ast.StringLiteral{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 14,
@@ -2290,13 +2554,15 @@ var pkgAST = &ast.Package{
 							Line:   4,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Value: "math",
 			},
 		}, &ast.ImportDeclaration{
 			As: nil,
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 18,
@@ -2309,10 +2575,12 @@ var pkgAST = &ast.Package{
 						Line:   5,
 					},
 				},
+				TrailingComment: nil,
 			},
 			Path: &ast.StringLiteral{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 18,
@@ -2325,6 +2593,7 @@ var pkgAST = &ast.Package{
 							Line:   5,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Value: "universe",
 			},
@@ -2333,7 +2602,8 @@ var pkgAST = &ast.Package{
 		Name:     "promql.flux",
 		Package: &ast.PackageClause{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: []ast.Comment{ast.Comment{Text: "// THIS PACKAGE IS NOT MEANT FOR EXTERNAL USE."}},
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 15,
@@ -2346,10 +2616,12 @@ var pkgAST = &ast.Package{
 						Line:   2,
 					},
 				},
+				TrailingComment: nil,
 			},
 			Name: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 15,
@@ -2362,6 +2634,7 @@ var pkgAST = &ast.Package{
 							Line:   2,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "promql",
 			},
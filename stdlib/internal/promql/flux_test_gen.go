@@ -9,12 +9,15 @@ import (
 
 var FluxTestPackages = []*ast.Package{&ast.Package{
 	BaseNode: ast.BaseNode{
-		Errors: nil,
-		Loc:    nil,
+		Comments:        nil,
+		Errors:          nil,
+		Loc:             nil,
+		TrailingComment: nil,
 	},
 	Files: []*ast.File{&ast.File{
 		BaseNode: ast.BaseNode{
-			Errors: nil,
+			Comments: nil,
+			Errors:   nil,
 			Loc: &ast.SourceLocation{
 				End: ast.Position{
 					Column: 100,
@@ -27,10 +30,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 					Line:   1,
 				},
 			},
+			TrailingComment: nil,
 		},
 		Body: []ast.Statement{&ast.VariableAssignment{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 2,
@@ -43,10 +48,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   6,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 7,
@@ -59,12 +66,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   6,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "inData",
 			},
 			Init: &ast.StringLiteral{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 2,
@@ -77,12 +86,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   6,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Value: "\n#datatype,string,long,dateTime:RFC3339,string,string,string,double\n#group,false,false,false,true,true,true,false\n#default,_result,,,,,,\n,result,table,_time,_measurement,_field,tag0,_value\n,,0,2018-12-19T22:13:30Z,_m,a,t,1\n,,0,2018-12-19T22:13:40Z,_m,a,t,2\n,,0,2018-12-19T22:13:50Z,_m,a,t,3\n,,0,2018-12-19T22:14:00Z,_m,a,t,4\n,,0,2018-12-19T22:14:10Z,_m,a,t,5\n,,0,2018-12-19T22:14:20Z,_m,a,t,6\n,,1,2018-12-19T22:13:30Z,_m,a,g,2\n,,1,2018-12-19T22:13:40Z,_m,a,g,3\n,,1,2018-12-19T22:13:50Z,_m,a,g,4\n,,1,2018-12-19T22:14:00Z,_m,a,g,5\n,,1,2018-12-19T22:14:10Z,_m,a,g,6\n,,1,2018-12-19T22:14:20Z,_m,a,g,7\n\n#datatype,string,long,dateTime:RFC3339,string,string,string,double\n#group,false,false,false,true,true,true,false\n#default,_result,,,,,,\n,result,table,_time,_measurement,_field,tag1,_value\n,,2,2018-12-19T22:13:30Z,_m,a,t,1\n,,2,2018-12-19T22:13:40Z,_m,a,t,2\n,,2,2018-12-19T22:13:50Z,_m,a,t,3\n,,2,2018-12-19T22:14:00Z,_m,a,t,4\n,,2,2018-12-19T22:14:10Z,_m,a,t,5\n,,2,2018-12-19T22:14:20Z,_m,a,t,6\n,,3,2018-12-19T22:13:30Z,_m,a,g,1\n,,3,2018-12-19T22:13:40Z,_m,a,g,2\n,,3,2018-12-19T22:13:50Z,_m,a,g,3\n,,3,2018-12-19T22:14:00Z,_m,a,g,4\n,,3,2018-12-19T22:14:10Z,_m,a,g,5\n,,3,2018-12-19T22:14:20Z,_m,a,g,6\n\n#datatype,string,long,dateTime:RFC3339,string,string,string,double\n#group,false,false,false,true,true,true,false\n#default,_result,,,,,,\n,result,table,_time,_measurement,_field,tag0,_value\n,,4,2018-12-19T22:13:30Z,_m,b,s,1\n,,4,2018-12-19T22:13:40Z,_m,b,s,2\n,,4,2018-12-19T22:13:50Z,_m,b,s,3\n,,4,2018-12-19T22:14:00Z,_m,b,s,4\n,,4,2018-12-19T22:14:10Z,_m,b,s,5\n,,4,2018-12-19T22:14:20Z,_m,b,s,6\n,,5,2018-12-19T22:13:30Z,_m,b,g,1\n,,5,2018-12-19T22:13:40Z,_m,b,g,2\n,,5,2018-12-19T22:13:50Z,_m,b,g,3\n,,5,2018-12-19T22:14:00Z,_m,b,g,4\n,,5,2018-12-19T22:14:10Z,_m,b,g,5\n,,5,2018-12-19T22:14:20Z,_m,b,g,6\n\n#datatype,string,long,dateTime:RFC3339,string,string,string,double\n#group,false,false,false,true,true,true,false\n#default,_result,,,,,,\n,result,table,_time,_measurement,_field,tag1,_value\n,,6,2018-12-19T22:13:30Z,_m,b,s,1\n,,6,2018-12-19T22:13:40Z,_m,b,s,2\n,,6,2018-12-19T22:13:50Z,_m,b,s,3\n,,6,2018-12-19T22:14:00Z,_m,b,s,4\n,,6,2018-12-19T22:14:10Z,_m,b,s,5\n,,6,2018-12-19T22:14:20Z,_m,b,s,6\n,,7,2018-12-19T22:13:30Z,_m,b,p,1\n,,7,2018-12-19T22:13:40Z,_m,b,p,2\n,,7,2018-12-19T22:13:50Z,_m,b,p,3\n,,7,2018-12-19T22:14:00Z,_m,b,p,4\n,,7,2018-12-19T22:14:10Z,_m,b,p,5\n,,7,2018-12-19T22:14:20Z,_m,b,p,6\n",
 			},
 		}, &ast.VariableAssignment{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 2,
@@ -95,10 +106,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   76,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 8,
@@ -111,12 +124,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   76,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "outData",
 			},
 			Init: &ast.StringLiteral{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 2,
@@ -129,12 +144,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   76,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Value: "\n#datatype,string,long,dateTime:RFC3339,dateTime:RFC3339,dateTime:RFC3339,string,string,double,double\n#group,false,false,true,true,false,true,true,false,false\n#default,_result,,,,,,,,\n,result,table,_start,_stop,_time,_measurement,tag0,value_a,value_b\n,,0,2018-12-19T00:00:00Z,2018-12-20T00:00:00Z,2018-12-19T22:13:30Z,_m,g,2,1\n,,0,2018-12-19T00:00:00Z,2018-12-20T00:00:00Z,2018-12-19T22:13:40Z,_m,g,3,2\n,,0,2018-12-19T00:00:00Z,2018-12-20T00:00:00Z,2018-12-19T22:13:50Z,_m,g,4,3\n,,0,2018-12-19T00:00:00Z,2018-12-20T00:00:00Z,2018-12-19T22:14:00Z,_m,g,5,4\n,,0,2018-12-19T00:00:00Z,2018-12-20T00:00:00Z,2018-12-19T22:14:10Z,_m,g,6,5\n,,0,2018-12-19T00:00:00Z,2018-12-20T00:00:00Z,2018-12-19T22:14:20Z,_m,g,7,6\n",
 			},
 		}, &ast.VariableAssignment{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 2,
@@ -147,10 +164,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   89,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 13,
@@ -163,12 +182,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   89,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "join_test_fn",
 			},
 			Init: &ast.FunctionExpression{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 2,
@@ -181,10 +202,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   89,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Body: &ast.Block{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 2,
@@ -197,10 +220,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   89,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Body: []ast.Statement{&ast.VariableAssignment{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 48,
@@ -213,10 +238,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Line:   90,
 								},
 							},
+							TrailingComment: nil,
 						},
 						ID: &ast.Identifier{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 6,
@@ -229,6 +256,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										Line:   90,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Name: "a",
 						},
@@ -238,7 +266,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Argument: &ast.PipeExpression{
 										Argument: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 14,
@@ -251,11 +280,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   90,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "table",
 										},
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 74,
@@ -268,11 +299,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   90,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Call: &ast.CallExpression{
 											Arguments: []ast.Expression{&ast.ObjectExpression{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 73,
@@ -285,10 +318,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   91,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Properties: []*ast.Property{&ast.Property{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 45,
@@ -301,10 +336,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																Line:   91,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Key: &ast.Identifier{
 														BaseNode: ast.BaseNode{
-															Errors: nil,
+															Comments: nil,
+															Errors:   nil,
 															Loc: &ast.SourceLocation{
 																End: ast.Position{
 																	Column: 23,
@@ -317,12 +354,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																	Line:   91,
 																},
 															},
+															TrailingComment: nil,
 														},
 														Name: "start",
 													},
 													Value: &ast.DateTimeLiteral{
 														BaseNode: ast.BaseNode{
-															Errors: nil,
+															Comments: nil,
+															Errors:   nil,
 															Loc: &ast.SourceLocation{
 																End: ast.Position{
 																	Column: 45,
@@ -335,12 +374,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																	Line:   91,
 																},
 															},
+															TrailingComment: nil,
 														},
 														Value: parser.MustParseTime("2018-12-19T00:00:00Z"),
 													},
 												}, &ast.Property{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 73,
@@ -353,10 +394,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																Line:   91,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Key: &ast.Identifier{
 														BaseNode: ast.BaseNode{
-															Errors: nil,
+															Comments: nil,
+															Errors:   nil,
 															Loc: &ast.SourceLocation{
 																End: ast.Position{
 																	Column: 51,
@@ -369,12 +412,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																	Line:   91,
 																},
 															},
+															TrailingComment: nil,
 														},
 														Name: "stop",
 													},
 													Value: &ast.DateTimeLiteral{
 														BaseNode: ast.BaseNode{
-															Errors: nil,
+															Comments: nil,
+															Errors:   nil,
 															Loc: &ast.SourceLocation{
 																End: ast.Position{
 																	Column: 73,
@@ -387,6 +432,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																	Line:   91,
 																},
 															},
+															TrailingComment: nil,
 														},
 														Value: parser.MustParseTime("2018-12-20T00:00:00Z"),
 													},
@@ -394,7 +440,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												With: nil,
 											}},
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 74,
@@ -407,10 +454,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   91,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Callee: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 17,
@@ -423,13 +472,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   91,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "range",
 											},
 										},
 									},
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 46,
@@ -442,11 +493,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   90,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Call: &ast.CallExpression{
 										Arguments: []ast.Expression{&ast.ObjectExpression{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 45,
@@ -459,10 +512,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   92,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Properties: []*ast.Property{&ast.Property{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 45,
@@ -475,10 +530,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   92,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Key: &ast.Identifier{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 21,
@@ -491,12 +548,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																Line:   92,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Name: "fn",
 												},
 												Value: &ast.FunctionExpression{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 45,
@@ -509,10 +568,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																Line:   92,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Body: &ast.BinaryExpression{
 														BaseNode: ast.BaseNode{
-															Errors: nil,
+															Comments: nil,
+															Errors:   nil,
 															Loc: &ast.SourceLocation{
 																End: ast.Position{
 																	Column: 45,
@@ -525,10 +586,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																	Line:   92,
 																},
 															},
+															TrailingComment: nil,
 														},
 														Left: &ast.MemberExpression{
 															BaseNode: ast.BaseNode{
-																Errors: nil,
+																Comments: nil,
+																Errors:   nil,
 																Loc: &ast.SourceLocation{
 																	End: ast.Position{
 																		Column: 38,
@@ -541,10 +604,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																		Line:   92,
 																	},
 																},
+																TrailingComment: nil,
 															},
 															Object: &ast.Identifier{
 																BaseNode: ast.BaseNode{
-																	Errors: nil,
+																	Comments: nil,
+																	Errors:   nil,
 																	Loc: &ast.SourceLocation{
 																		End: ast.Position{
 																			Column: 31,
@@ -557,12 +622,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																			Line:   92,
 																		},
 																	},
+																	TrailingComment: nil,
 																},
 																Name: "r",
 															},
 															Property: &ast.Identifier{
 																BaseNode: ast.BaseNode{
-																	Errors: nil,
+																	Comments: nil,
+																	Errors:   nil,
 																	Loc: &ast.SourceLocation{
 																		End: ast.Position{
 																			Column: 38,
@@ -575,6 +642,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																			Line:   92,
 																		},
 																	},
+																	TrailingComment: nil,
 																},
 																Name: "_field",
 															},
@@ -582,7 +650,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Operator: 17,
 														Right: &ast.StringLiteral{
 															BaseNode: ast.BaseNode{
-																Errors: nil,
+																Comments: nil,
+																Errors:   nil,
 																Loc: &ast.SourceLocation{
 																	End: ast.Position{
 																		Column: 45,
@@ -595,13 +664,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																		Line:   92,
 																	},
 																},
+																TrailingComment: nil,
 															},
 															Value: "a",
 														},
 													},
 													Params: []*ast.Property{&ast.Property{
 														BaseNode: ast.BaseNode{
-															Errors: nil,
+															Comments: nil,
+															Errors:   nil,
 															Loc: &ast.SourceLocation{
 																End: ast.Position{
 																	Column: 25,
@@ -614,10 +685,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																	Line:   92,
 																},
 															},
+															TrailingComment: nil,
 														},
 														Key: &ast.Identifier{
 															BaseNode: ast.BaseNode{
-																Errors: nil,
+																Comments: nil,
+																Errors:   nil,
 																Loc: &ast.SourceLocation{
 																	End: ast.Position{
 																		Column: 25,
@@ -630,6 +703,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																		Line:   92,
 																	},
 																},
+																TrailingComment: nil,
 															},
 															Name: "r",
 														},
@@ -640,7 +714,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											With: nil,
 										}},
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 46,
@@ -653,10 +728,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   92,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Callee: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 18,
@@ -669,13 +746,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   92,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "filter",
 										},
 									},
 								},
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 37,
@@ -688,11 +767,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   90,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Call: &ast.CallExpression{
 									Arguments: []ast.Expression{&ast.ObjectExpression{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 36,
@@ -705,10 +786,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   93,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Properties: []*ast.Property{&ast.Property{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 36,
@@ -721,10 +804,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   93,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Key: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 24,
@@ -737,12 +822,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   93,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "columns",
 											},
 											Value: &ast.ArrayExpression{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 36,
@@ -755,10 +842,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   93,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Elements: []ast.Expression{&ast.StringLiteral{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 35,
@@ -771,6 +860,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																Line:   93,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Value: "_field",
 												}},
@@ -779,7 +869,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										With: nil,
 									}},
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 37,
@@ -792,10 +883,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   93,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Callee: &ast.Identifier{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 16,
@@ -808,13 +901,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   93,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Name: "drop",
 									},
 								},
 							},
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 48,
@@ -827,11 +922,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										Line:   90,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Call: &ast.CallExpression{
 								Arguments: []ast.Expression{&ast.ObjectExpression{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 47,
@@ -844,10 +941,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   94,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Properties: []*ast.Property{&ast.Property{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 47,
@@ -860,10 +959,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   94,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Key: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 26,
@@ -876,12 +977,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   94,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "columns",
 										},
 										Value: &ast.ObjectExpression{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 47,
@@ -894,10 +997,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   94,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Properties: []*ast.Property{&ast.Property{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 46,
@@ -910,10 +1015,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   94,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Key: &ast.Identifier{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 35,
@@ -926,12 +1033,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																Line:   94,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Name: "_value",
 												},
 												Value: &ast.StringLiteral{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 46,
@@ -944,6 +1053,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																Line:   94,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Value: "value_a",
 												},
@@ -954,7 +1064,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									With: nil,
 								}},
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 48,
@@ -967,10 +1078,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   94,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Callee: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 18,
@@ -983,6 +1096,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   94,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "rename",
 								},
@@ -990,7 +1104,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						},
 					}, &ast.VariableAssignment{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 48,
@@ -1003,10 +1118,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Line:   96,
 								},
 							},
+							TrailingComment: nil,
 						},
 						ID: &ast.Identifier{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 6,
@@ -1019,6 +1136,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										Line:   96,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Name: "b",
 						},
@@ -1028,7 +1146,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Argument: &ast.PipeExpression{
 										Argument: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 14,
@@ -1041,11 +1160,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   96,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "table",
 										},
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 74,
@@ -1058,11 +1179,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   96,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Call: &ast.CallExpression{
 											Arguments: []ast.Expression{&ast.ObjectExpression{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 73,
@@ -1075,10 +1198,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   97,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Properties: []*ast.Property{&ast.Property{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 45,
@@ -1091,10 +1216,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																Line:   97,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Key: &ast.Identifier{
 														BaseNode: ast.BaseNode{
-															Errors: nil,
+															Comments: nil,
+															Errors:   nil,
 															Loc: &ast.SourceLocation{
 																End: ast.Position{
 																	Column: 23,
@@ -1107,12 +1234,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																	Line:   97,
 																},
 															},
+															TrailingComment: nil,
 														},
 														Name: "start",
 													},
 													Value: &ast.DateTimeLiteral{
 														BaseNode: ast.BaseNode{
-															Errors: nil,
+															Comments: nil,
+															Errors:   nil,
 															Loc: &ast.SourceLocation{
 																End: ast.Position{
 																	Column: 45,
@@ -1125,12 +1254,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																	Line:   97,
 																},
 															},
+															TrailingComment: nil,
 														},
 														Value: parser.MustParseTime("2018-12-19T00:00:00Z"),
 													},
 												}, &ast.Property{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 73,
@@ -1143,10 +1274,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																Line:   97,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Key: &ast.Identifier{
 														BaseNode: ast.BaseNode{
-															Errors: nil,
+															Comments: nil,
+															Errors:   nil,
 															Loc: &ast.SourceLocation{
 																End: ast.Position{
 																	Column: 51,
@@ -1159,12 +1292,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																	Line:   97,
 																},
 															},
+															TrailingComment: nil,
 														},
 														Name: "stop",
 													},
 													Value: &ast.DateTimeLiteral{
 														BaseNode: ast.BaseNode{
-															Errors: nil,
+															Comments: nil,
+															Errors:   nil,
 															Loc: &ast.SourceLocation{
 																End: ast.Position{
 																	Column: 73,
@@ -1177,6 +1312,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																	Line:   97,
 																},
 															},
+															TrailingComment: nil,
 														},
 														Value: parser.MustParseTime("2018-12-20T00:00:00Z"),
 													},
@@ -1184,7 +1320,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												With: nil,
 											}},
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 74,
@@ -1197,10 +1334,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   97,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Callee: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 17,
@@ -1213,13 +1352,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   97,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "range",
 											},
 										},
 									},
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 46,
@@ -1232,11 +1373,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   96,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Call: &ast.CallExpression{
 										Arguments: []ast.Expression{&ast.ObjectExpression{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 45,
@@ -1249,10 +1392,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   98,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Properties: []*ast.Property{&ast.Property{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 45,
@@ -1265,10 +1410,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   98,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Key: &ast.Identifier{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 21,
@@ -1281,12 +1428,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																Line:   98,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Name: "fn",
 												},
 												Value: &ast.FunctionExpression{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 45,
@@ -1299,10 +1448,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																Line:   98,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Body: &ast.BinaryExpression{
 														BaseNode: ast.BaseNode{
-															Errors: nil,
+															Comments: nil,
+															Errors:   nil,
 															Loc: &ast.SourceLocation{
 																End: ast.Position{
 																	Column: 45,
@@ -1315,10 +1466,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																	Line:   98,
 																},
 															},
+															TrailingComment: nil,
 														},
 														Left: &ast.MemberExpression{
 															BaseNode: ast.BaseNode{
-																Errors: nil,
+																Comments: nil,
+																Errors:   nil,
 																Loc: &ast.SourceLocation{
 																	End: ast.Position{
 																		Column: 38,
@@ -1331,10 +1484,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																		Line:   98,
 																	},
 																},
+																TrailingComment: nil,
 															},
 															Object: &ast.Identifier{
 																BaseNode: ast.BaseNode{
-																	Errors: nil,
+																	Comments: nil,
+																	Errors:   nil,
 																	Loc: &ast.SourceLocation{
 																		End: ast.Position{
 																			Column: 31,
@@ -1347,12 +1502,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																			Line:   98,
 																		},
 																	},
+																	TrailingComment: nil,
 																},
 																Name: "r",
 															},
 															Property: &ast.Identifier{
 																BaseNode: ast.BaseNode{
-																	Errors: nil,
+																	Comments: nil,
+																	Errors:   nil,
 																	Loc: &ast.SourceLocation{
 																		End: ast.Position{
 																			Column: 38,
@@ -1365,6 +1522,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																			Line:   98,
 																		},
 																	},
+																	TrailingComment: nil,
 																},
 																Name: "_field",
 															},
@@ -1372,7 +1530,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Operator: 17,
 														Right: &ast.StringLiteral{
 															BaseNode: ast.BaseNode{
-																Errors: nil,
+																Comments: nil,
+																Errors:   nil,
 																Loc: &ast.SourceLocation{
 																	End: ast.Position{
 																		Column: 45,
@@ -1385,13 +1544,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																		Line:   98,
 																	},
 																},
+																TrailingComment: nil,
 															},
 															Value: "b",
 														},
 													},
 													Params: []*ast.Property{&ast.Property{
 														BaseNode: ast.BaseNode{
-															Errors: nil,
+															Comments: nil,
+															Errors:   nil,
 															Loc: &ast.SourceLocation{
 																End: ast.Position{
 																	Column: 25,
@@ -1404,10 +1565,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																	Line:   98,
 																},
 															},
+															TrailingComment: nil,
 														},
 														Key: &ast.Identifier{
 															BaseNode: ast.BaseNode{
-																Errors: nil,
+																Comments: nil,
+																Errors:   nil,
 																Loc: &ast.SourceLocation{
 																	End: ast.Position{
 																		Column: 25,
@@ -1420,6 +1583,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																		Line:   98,
 																	},
 																},
+																TrailingComment: nil,
 															},
 															Name: "r",
 														},
@@ -1430,7 +1594,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											With: nil,
 										}},
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 46,
@@ -1443,10 +1608,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   98,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Callee: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 18,
@@ -1459,13 +1626,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   98,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "filter",
 										},
 									},
 								},
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 37,
@@ -1478,11 +1647,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   96,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Call: &ast.CallExpression{
 									Arguments: []ast.Expression{&ast.ObjectExpression{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 36,
@@ -1495,10 +1666,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   99,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Properties: []*ast.Property{&ast.Property{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 36,
@@ -1511,10 +1684,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   99,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Key: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 24,
@@ -1527,12 +1702,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   99,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "columns",
 											},
 											Value: &ast.ArrayExpression{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 36,
@@ -1545,10 +1722,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   99,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Elements: []ast.Expression{&ast.StringLiteral{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 35,
@@ -1561,6 +1740,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																Line:   99,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Value: "_field",
 												}},
@@ -1569,7 +1749,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										With: nil,
 									}},
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 37,
@@ -1582,10 +1763,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   99,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Callee: &ast.Identifier{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 16,
@@ -1598,13 +1781,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   99,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Name: "drop",
 									},
 								},
 							},
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 48,
@@ -1617,11 +1802,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										Line:   96,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Call: &ast.CallExpression{
 								Arguments: []ast.Expression{&ast.ObjectExpression{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 47,
@@ -1634,10 +1821,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   100,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Properties: []*ast.Property{&ast.Property{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 47,
@@ -1650,10 +1839,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   100,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Key: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 26,
@@ -1666,12 +1857,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   100,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "columns",
 										},
 										Value: &ast.ObjectExpression{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 47,
@@ -1684,10 +1877,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   100,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Properties: []*ast.Property{&ast.Property{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 46,
@@ -1700,10 +1895,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   100,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Key: &ast.Identifier{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 35,
@@ -1716,12 +1913,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																Line:   100,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Name: "_value",
 												},
 												Value: &ast.StringLiteral{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 46,
@@ -1734,6 +1933,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																Line:   100,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Value: "value_b",
 												},
@@ -1744,7 +1944,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									With: nil,
 								}},
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 48,
@@ -1757,10 +1958,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   100,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Callee: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 18,
@@ -1773,6 +1976,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   100,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "rename",
 								},
@@ -1782,7 +1986,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Argument: &ast.CallExpression{
 							Arguments: []ast.Expression{&ast.ObjectExpression{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 97,
@@ -1795,10 +2000,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   102,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Properties: []*ast.Property{&ast.Property{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 30,
@@ -1811,10 +2018,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   102,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Key: &ast.Identifier{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 28,
@@ -1827,12 +2036,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   102,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Name: "left",
 									},
 									Value: &ast.Identifier{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 30,
@@ -1845,12 +2056,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   102,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Name: "a",
 									},
 								}, &ast.Property{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 39,
@@ -1863,10 +2076,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   102,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Key: &ast.Identifier{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 37,
@@ -1879,12 +2094,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   102,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Name: "right",
 									},
 									Value: &ast.Identifier{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 39,
@@ -1897,12 +2114,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   102,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Name: "b",
 									},
 								}, &ast.Property{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 97,
@@ -1915,10 +2134,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   102,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Key: &ast.Identifier{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 43,
@@ -1931,12 +2152,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   102,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Name: "fn",
 									},
 									Value: &ast.FunctionExpression{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 97,
@@ -1949,10 +2172,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   102,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Body: &ast.ParenExpression{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 97,
@@ -1965,10 +2190,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   102,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Expression: &ast.ObjectExpression{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 96,
@@ -1981,10 +2208,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   102,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Properties: []*ast.Property{&ast.Property{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 95,
@@ -1997,10 +2226,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																Line:   102,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Key: &ast.Identifier{
 														BaseNode: ast.BaseNode{
-															Errors: nil,
+															Comments: nil,
+															Errors:   nil,
 															Loc: &ast.SourceLocation{
 																End: ast.Position{
 																	Column: 80,
@@ -2013,12 +2244,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																	Line:   102,
 																},
 															},
+															TrailingComment: nil,
 														},
 														Name: "value_b",
 													},
 													Value: &ast.MemberExpression{
 														BaseNode: ast.BaseNode{
-															Errors: nil,
+															Comments: nil,
+															Errors:   nil,
 															Loc: &ast.SourceLocation{
 																End: ast.Position{
 																	Column: 95,
@@ -2031,10 +2264,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																	Line:   102,
 																},
 															},
+															TrailingComment: nil,
 														},
 														Object: &ast.Identifier{
 															BaseNode: ast.BaseNode{
-																Errors: nil,
+																Comments: nil,
+																Errors:   nil,
 																Loc: &ast.SourceLocation{
 																	End: ast.Position{
 																		Column: 87,
@@ -2047,12 +2282,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																		Line:   102,
 																	},
 																},
+																TrailingComment: nil,
 															},
 															Name: "right",
 														},
 														Property: &ast.Identifier{
 															BaseNode: ast.BaseNode{
-																Errors: nil,
+																Comments: nil,
+																Errors:   nil,
 																Loc: &ast.SourceLocation{
 																	End: ast.Position{
 																		Column: 95,
@@ -2065,6 +2302,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																		Line:   102,
 																	},
 																},
+																TrailingComment: nil,
 															},
 															Name: "value_b",
 														},
@@ -2072,7 +2310,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												}},
 												With: &ast.Identifier{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 67,
@@ -2085,6 +2324,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																Line:   102,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Name: "left",
 												},
@@ -2092,7 +2332,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										},
 										Params: []*ast.Property{&ast.Property{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 49,
@@ -2105,10 +2346,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   102,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Key: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 49,
@@ -2121,13 +2364,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   102,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "left",
 											},
 											Value: nil,
 										}, &ast.Property{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 56,
@@ -2140,10 +2385,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   102,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Key: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 56,
@@ -2156,6 +2403,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   102,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "right",
 											},
@@ -2166,7 +2414,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								With: nil,
 							}},
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 98,
@@ -2179,10 +2428,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										Line:   102,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Callee: &ast.MemberExpression{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 23,
@@ -2195,10 +2446,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   102,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Object: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 18,
@@ -2211,12 +2464,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   102,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "promql",
 								},
 								Property: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 23,
@@ -2229,13 +2484,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   102,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "join",
 								},
 							},
 						},
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 98,
@@ -2248,12 +2505,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Line:   102,
 								},
 							},
+							TrailingComment: nil,
 						},
 					}},
 				},
 				Params: []*ast.Property{&ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 25,
@@ -2266,10 +2525,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   89,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 22,
@@ -2282,11 +2543,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Line:   89,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Name: "table",
 					},
 					Value: &ast.PipeLiteral{BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 25,
@@ -2299,13 +2562,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   89,
 							},
 						},
+						TrailingComment: nil,
 					}},
 				}},
 			},
 		}, &ast.TestStatement{
 			Assignment: &ast.VariableAssignment{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 100,
@@ -2318,10 +2583,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   105,
 						},
 					},
+					TrailingComment: nil,
 				},
 				ID: &ast.Identifier{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 15,
@@ -2334,12 +2601,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   105,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Name: "prom_join",
 				},
 				Init: &ast.FunctionExpression{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 100,
@@ -2352,10 +2621,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   105,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Body: &ast.ParenExpression{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 100,
@@ -2368,10 +2639,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Line:   106,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Expression: &ast.ObjectExpression{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 99,
@@ -2384,10 +2657,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										Line:   106,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Properties: []*ast.Property{&ast.Property{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 43,
@@ -2400,10 +2675,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   106,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Key: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 9,
@@ -2416,13 +2693,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   106,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "input",
 								},
 								Value: &ast.CallExpression{
 									Arguments: []ast.Expression{&ast.ObjectExpression{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 42,
@@ -2435,10 +2714,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   106,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Properties: []*ast.Property{&ast.Property{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 42,
@@ -2451,10 +2732,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   106,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Key: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 34,
@@ -2467,12 +2750,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   106,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "csv",
 											},
 											Value: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 42,
@@ -2485,6 +2770,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   106,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "inData",
 											},
@@ -2492,7 +2778,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										With: nil,
 									}},
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 43,
@@ -2505,10 +2792,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   106,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Callee: &ast.MemberExpression{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 30,
@@ -2521,10 +2810,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   106,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Object: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 18,
@@ -2537,12 +2828,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   106,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "testing",
 										},
 										Property: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 30,
@@ -2555,6 +2848,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   106,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "loadStorage",
 										},
@@ -2562,7 +2856,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								},
 							}, &ast.Property{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 80,
@@ -2575,10 +2870,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   106,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Key: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 49,
@@ -2591,13 +2888,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   106,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "want",
 								},
 								Value: &ast.CallExpression{
 									Arguments: []ast.Expression{&ast.ObjectExpression{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 79,
@@ -2610,10 +2909,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   106,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Properties: []*ast.Property{&ast.Property{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 79,
@@ -2626,10 +2927,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   106,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Key: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 70,
@@ -2642,12 +2945,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   106,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "csv",
 											},
 											Value: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 79,
@@ -2660,6 +2965,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   106,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "outData",
 											},
@@ -2667,7 +2973,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										With: nil,
 									}},
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 80,
@@ -2680,10 +2987,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   106,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Callee: &ast.MemberExpression{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 66,
@@ -2696,10 +3005,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   106,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Object: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 58,
@@ -2712,12 +3023,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   106,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "testing",
 										},
 										Property: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 66,
@@ -2730,6 +3043,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   106,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "loadMem",
 										},
@@ -2737,7 +3051,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								},
 							}, &ast.Property{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 98,
@@ -2750,10 +3065,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   106,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Key: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 84,
@@ -2766,12 +3083,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   106,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "fn",
 								},
 								Value: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 98,
@@ -2784,6 +3103,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   106,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "join_test_fn",
 								},
@@ -2795,7 +3115,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 				},
 			},
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 100,
@@ -2808,12 +3129,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   105,
 					},
 				},
+				TrailingComment: nil,
 			},
 		}},
+		Eof: nil,
 		Imports: []*ast.ImportDeclaration{&ast.ImportDeclaration{
 			As: nil,
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 25,
@@ -2826,10 +3150,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   3,
 					},
 				},
+				TrailingComment: nil,
 			},
 			Path: &ast.StringLiteral{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 25,
@@ -2842,13 +3168,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   3,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Value: "internal/promql",
 			},
 		}, &ast.ImportDeclaration{
 			As: nil,
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 17,
@@ -2861,10 +3189,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   4,
 					},
 				},
+				TrailingComment: nil,
 			},
 			Path: &ast.StringLiteral{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 17,
@@ -2877,6 +3207,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   4,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Value: "testing",
 			},
@@ -2885,7 +3216,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 		Name:     "join_test.flux",
 		Package: &ast.PackageClause{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 20,
@@ -2898,10 +3230,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   1,
 					},
 				},
+				TrailingComment: nil,
 			},
 			Name: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 20,
@@ -2914,6 +3248,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   1,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "promql_test",
 			},
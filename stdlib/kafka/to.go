@@ -4,6 +4,7 @@ import (
 	"bufio"
 	"context"
 	"encoding/binary"
+	"encoding/hex"
 	"io"
 	"net/url"
 	"sort"
@@ -12,6 +13,7 @@ import (
 	"github.com/cespare/xxhash"
 	"github.com/influxdata/flux"
 	"github.com/influxdata/flux/codes"
+	"github.com/influxdata/flux/dependencies/sideeffect"
 	"github.com/influxdata/flux/execute"
 	"github.com/influxdata/flux/internal/errors"
 	"github.com/influxdata/flux/internal/pkg/syncutil"
@@ -70,6 +72,30 @@ type KafkaWriter interface {
 	WriteMessages(context.Context, ...kafka.Message) error
 }
 
+// recordingWriter records the messages with a side effect recorder instead of sending them.
+// The message key is a hash of the group key and is recorded as hex.
+type recordingWriter struct {
+	r     *sideeffect.Recorder
+	topic string
+}
+
+func (w recordingWriter) WriteMessages(ctx context.Context, msgs ...kafka.Message) error {
+	for _, m := range msgs {
+		w.r.Record(sideeffect.Effect{
+			Kind:    "kafka",
+			Method:  "produce",
+			Target:  w.topic,
+			Headers: map[string]string{"key": hex.EncodeToString(m.Key)},
+			Data:    string(m.Value),
+		})
+	}
+	return nil
+}
+
+func (w recordingWriter) Close() error {
+	return nil
+}
+
 // ReadArgs loads a flux.Arguments into ToKafkaOpSpec.  It sets several default values.
 // If the time_column isn't set, it defaults to execute.TimeColLabel.
 // If the value_column isn't set it defaults to a []string{execute.DefaultValueColLabel}.
@@ -226,13 +252,18 @@ func createToKafkaTransformation(id execute.DatasetID, mode execute.Accumulation
 	d := execute.NewDataset(id, mode, cache)
 	deps := flux.GetDependencies(a.Context())
 	t, err := NewToKafkaTransformation(d, deps, cache, s)
-	return t, d, err
+	if err != nil {
+		return nil, nil, err
+	}
+	t.recorder = sideeffect.FromContext(a.Context())
+	return t, d, nil
 }
 
 type ToKafkaTransformation struct {
-	d     execute.Dataset
-	cache execute.TableBuilderCache
-	spec  *ToKafkaProcedureSpec
+	d        execute.Dataset
+	cache    execute.TableBuilderCache
+	spec     *ToKafkaProcedureSpec
+	recorder *sideeffect.Recorder
 }
 
 func (t *ToKafkaTransformation) RetractTable(id execute.DatasetID, key flux.GroupKey) error {
@@ -289,13 +320,18 @@ type idxType struct {
 }
 
 func (t *ToKafkaTransformation) Process(id execute.DatasetID, tbl flux.Table) (err error) {
-	w := DefaultKafkaWriterFactory(kafka.WriterConfig{
-		Brokers:       t.spec.Spec.Brokers,
-		Topic:         t.spec.Spec.Topic,
-		Balancer:      t.spec.balancer,
-		BatchSize:     t.spec.Spec.MsgBufSize,
-		QueueCapacity: t.spec.Spec.MsgBufSize,
-	})
+	var w KafkaWriter
+	if t.recorder != nil {
+		w = recordingWriter{r: t.recorder, topic: t.spec.Spec.Topic}
+	} else {
+		w = DefaultKafkaWriterFactory(kafka.WriterConfig{
+			Brokers:       t.spec.Spec.Brokers,
+			Topic:         t.spec.Spec.Topic,
+			Balancer:      t.spec.balancer,
+			BatchSize:     t.spec.Spec.MsgBufSize,
+			QueueCapacity: t.spec.Spec.MsgBufSize,
+		})
+	}
 
 	defer func() {
 		err2 := w.Close()
@@ -3,8 +3,10 @@
 package stdlib
 
 import (
+	_ "github.com/influxdata/flux/stdlib/arrow"
 	_ "github.com/influxdata/flux/stdlib/csv"
 	_ "github.com/influxdata/flux/stdlib/date"
+	_ "github.com/influxdata/flux/stdlib/dict"
 	_ "github.com/influxdata/flux/stdlib/experimental"
 	_ "github.com/influxdata/flux/stdlib/experimental/bigtable"
 	_ "github.com/influxdata/flux/stdlib/experimental/http"
@@ -23,6 +25,7 @@ import (
 	_ "github.com/influxdata/flux/stdlib/kafka"
 	_ "github.com/influxdata/flux/stdlib/math"
 	_ "github.com/influxdata/flux/stdlib/pagerduty"
+	_ "github.com/influxdata/flux/stdlib/parquet"
 	_ "github.com/influxdata/flux/stdlib/planner"
 	_ "github.com/influxdata/flux/stdlib/regexp"
 	_ "github.com/influxdata/flux/stdlib/runtime"
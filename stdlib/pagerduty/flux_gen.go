@@ -13,12 +13,15 @@ func init() {
 
 var pkgAST = &ast.Package{
 	BaseNode: ast.BaseNode{
-		Errors: nil,
-		Loc:    nil,
+		Comments:        nil,
+		Errors:          nil,
+		Loc:             nil,
+		TrailingComment: nil,
 	},
 	Files: []*ast.File{&ast.File{
 		BaseNode: ast.BaseNode{
-			Errors: nil,
+			Comments: nil,
+			Errors:   nil,
 			Loc: &ast.SourceLocation{
 				End: ast.Position{
 					Column: 15,
@@ -31,10 +34,12 @@ var pkgAST = &ast.Package{
 					Line:   1,
 				},
 			},
+			TrailingComment: nil,
 		},
 		Body: []ast.Statement{&ast.BuiltinStatement{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: []ast.Comment{ast.Comment{Text: "// `dedupKey` - adds a newline concatinated value of the sorted group key that is then sha256-hashed and hex-encoded to a column with the key `_pagerdutyDedupKey`."}},
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 17,
@@ -47,10 +52,12 @@ var pkgAST = &ast.Package{
 						Line:   8,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 17,
@@ -63,13 +70,15 @@ var pkgAST = &ast.Package{
 							Line:   8,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "dedupKey",
 			},
 		}, &ast.OptionStatement{
 			Assignment: &ast.VariableAssignment{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 62,
@@ -82,10 +91,12 @@ var pkgAST = &ast.Package{
 							Line:   10,
 						},
 					},
+					TrailingComment: nil,
 				},
 				ID: &ast.Identifier{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 18,
@@ -98,12 +109,14 @@ var pkgAST = &ast.Package{
 								Line:   10,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Name: "defaultURL",
 				},
 				Init: &ast.StringLiteral{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 62,
@@ -116,12 +129,14 @@ var pkgAST = &ast.Package{
 								Line:   10,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Value: "https://events.pagerduty.com/v2/enqueue",
 				},
 			},
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 62,
@@ -134,10 +149,12 @@ var pkgAST = &ast.Package{
 						Line:   10,
 					},
 				},
+				TrailingComment: nil,
 			},
 		}, &ast.VariableAssignment{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: []ast.Comment{ast.Comment{Text: "// severity levels on status objects can be one of the following: ok,info,warn,crit,unknown"}, ast.Comment{Text: "// but pagerduty only accepts critical, error, warning or info."}, ast.Comment{Text: "// severityFromLevel turns a level from the status object into a pagerduty severity"}},
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 2,
@@ -150,10 +167,12 @@ var pkgAST = &ast.Package{
 						Line:   16,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 18,
@@ -166,12 +185,14 @@ var pkgAST = &ast.Package{
 							Line:   16,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "severityFromLevel",
 			},
 			Init: &ast.FunctionExpression{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 2,
@@ -184,10 +205,12 @@ var pkgAST = &ast.Package{
 							Line:   16,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Body: &ast.Block{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 2,
@@ -200,10 +223,12 @@ var pkgAST = &ast.Package{
 								Line:   16,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Body: []ast.Statement{&ast.VariableAssignment{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 35,
@@ -216,10 +241,12 @@ var pkgAST = &ast.Package{
 									Line:   17,
 								},
 							},
+							TrailingComment: nil,
 						},
 						ID: &ast.Identifier{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 8,
@@ -232,13 +259,15 @@ var pkgAST = &ast.Package{
 										Line:   17,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Name: "lvl",
 						},
 						Init: &ast.CallExpression{
 							Arguments: []ast.Expression{&ast.ObjectExpression{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 34,
@@ -251,10 +280,12 @@ var pkgAST = &ast.Package{
 											Line:   17,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Properties: []*ast.Property{&ast.Property{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 34,
@@ -267,10 +298,12 @@ var pkgAST = &ast.Package{
 												Line:   17,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Key: &ast.Identifier{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 28,
@@ -283,12 +316,14 @@ var pkgAST = &ast.Package{
 													Line:   17,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Name: "v",
 									},
 									Value: &ast.Identifier{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 34,
@@ -301,6 +336,7 @@ var pkgAST = &ast.Package{
 													Line:   17,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Name: "level",
 									},
@@ -308,7 +344,8 @@ var pkgAST = &ast.Package{
 								With: nil,
 							}},
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 35,
@@ -321,10 +358,12 @@ var pkgAST = &ast.Package{
 										Line:   17,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Callee: &ast.MemberExpression{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 26,
@@ -337,10 +376,12 @@ var pkgAST = &ast.Package{
 											Line:   17,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Object: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 18,
@@ -353,12 +394,14 @@ var pkgAST = &ast.Package{
 												Line:   17,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "strings",
 								},
 								Property: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 26,
@@ -371,6 +414,7 @@ var pkgAST = &ast.Package{
 												Line:   17,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "toLower",
 								},
@@ -378,7 +422,8 @@ var pkgAST = &ast.Package{
 						},
 					}, &ast.VariableAssignment{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 21,
@@ -391,10 +436,12 @@ var pkgAST = &ast.Package{
 									Line:   18,
 								},
 							},
+							TrailingComment: nil,
 						},
 						ID: &ast.Identifier{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 8,
@@ -407,6 +454,7 @@ var pkgAST = &ast.Package{
 										Line:   18,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Name: "sev",
 						},
@@ -416,7 +464,8 @@ var pkgAST = &ast.Package{
 									Alternate: &ast.ConditionalExpression{
 										Alternate: &ast.StringLiteral{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 21,
@@ -429,11 +478,13 @@ var pkgAST = &ast.Package{
 														Line:   22,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Value: "error",
 										},
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 21,
@@ -446,10 +497,12 @@ var pkgAST = &ast.Package{
 													Line:   21,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Consequent: &ast.StringLiteral{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 40,
@@ -462,12 +515,14 @@ var pkgAST = &ast.Package{
 														Line:   21,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Value: "info",
 										},
 										Test: &ast.BinaryExpression{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 28,
@@ -480,10 +535,12 @@ var pkgAST = &ast.Package{
 														Line:   21,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Left: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 20,
@@ -496,13 +553,15 @@ var pkgAST = &ast.Package{
 															Line:   21,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "lvl",
 											},
 											Operator: 17,
 											Right: &ast.StringLiteral{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 28,
@@ -515,13 +574,15 @@ var pkgAST = &ast.Package{
 															Line:   21,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Value: "ok",
 											},
 										},
 									},
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 21,
@@ -534,10 +595,12 @@ var pkgAST = &ast.Package{
 												Line:   20,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Consequent: &ast.StringLiteral{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 42,
@@ -550,12 +613,14 @@ var pkgAST = &ast.Package{
 													Line:   20,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Value: "info",
 									},
 									Test: &ast.BinaryExpression{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 30,
@@ -568,10 +633,12 @@ var pkgAST = &ast.Package{
 													Line:   20,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Left: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 20,
@@ -584,13 +651,15 @@ var pkgAST = &ast.Package{
 														Line:   20,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "lvl",
 										},
 										Operator: 17,
 										Right: &ast.StringLiteral{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 30,
@@ -603,13 +672,15 @@ var pkgAST = &ast.Package{
 														Line:   20,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Value: "info",
 										},
 									},
 								},
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 21,
@@ -622,10 +693,12 @@ var pkgAST = &ast.Package{
 											Line:   19,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Consequent: &ast.StringLiteral{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 46,
@@ -638,12 +711,14 @@ var pkgAST = &ast.Package{
 												Line:   19,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Value: "critical",
 								},
 								Test: &ast.BinaryExpression{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 30,
@@ -656,10 +731,12 @@ var pkgAST = &ast.Package{
 												Line:   19,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Left: &ast.Identifier{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 20,
@@ -672,13 +749,15 @@ var pkgAST = &ast.Package{
 													Line:   19,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Name: "lvl",
 									},
 									Operator: 17,
 									Right: &ast.StringLiteral{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 30,
@@ -691,13 +770,15 @@ var pkgAST = &ast.Package{
 													Line:   19,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Value: "crit",
 									},
 								},
 							},
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 21,
@@ -710,10 +791,12 @@ var pkgAST = &ast.Package{
 										Line:   18,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Consequent: &ast.StringLiteral{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 42,
@@ -726,12 +809,14 @@ var pkgAST = &ast.Package{
 											Line:   18,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Value: "warning",
 							},
 							Test: &ast.BinaryExpression{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 27,
@@ -744,10 +829,12 @@ var pkgAST = &ast.Package{
 											Line:   18,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Left: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 17,
@@ -760,13 +847,15 @@ var pkgAST = &ast.Package{
 												Line:   18,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "lvl",
 								},
 								Operator: 17,
 								Right: &ast.StringLiteral{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 27,
@@ -779,6 +868,7 @@ var pkgAST = &ast.Package{
 												Line:   18,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Value: "warn",
 								},
@@ -787,7 +877,8 @@ var pkgAST = &ast.Package{
 					}, &ast.ReturnStatement{
 						Argument: &ast.Identifier{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 15,
@@ -800,11 +891,13 @@ var pkgAST = &ast.Package{
 										Line:   23,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Name: "sev",
 						},
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 15,
@@ -817,12 +910,14 @@ var pkgAST = &ast.Package{
 									Line:   23,
 								},
 							},
+							TrailingComment: nil,
 						},
 					}},
 				},
 				Params: []*ast.Property{&ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 27,
@@ -835,10 +930,12 @@ var pkgAST = &ast.Package{
 								Line:   16,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 27,
@@ -851,6 +948,7 @@ var pkgAST = &ast.Package{
 									Line:   16,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Name: "level",
 					},
@@ -859,7 +957,8 @@ var pkgAST = &ast.Package{
 			},
 		}, &ast.VariableAssignment{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: []ast.Comment{ast.Comment{Text: "// `actionFromLevel` converts a monitoring level to an action; \"ok\" becomes \"resolve\" everything else converts to \"trigger\"."}},
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 94,
@@ -872,10 +971,12 @@ var pkgAST = &ast.Package{
 						Line:   27,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 16,
@@ -888,12 +989,14 @@ var pkgAST = &ast.Package{
 							Line:   27,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "actionFromLevel",
 			},
 			Init: &ast.FunctionExpression{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 94,
@@ -906,11 +1009,13 @@ var pkgAST = &ast.Package{
 							Line:   27,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Body: &ast.ConditionalExpression{
 					Alternate: &ast.StringLiteral{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 94,
@@ -923,11 +1028,13 @@ var pkgAST = &ast.Package{
 									Line:   27,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Value: "trigger",
 					},
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 94,
@@ -940,10 +1047,12 @@ var pkgAST = &ast.Package{
 								Line:   27,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Consequent: &ast.StringLiteral{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 79,
@@ -956,12 +1065,14 @@ var pkgAST = &ast.Package{
 									Line:   27,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Value: "resolve",
 					},
 					Test: &ast.BinaryExpression{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 64,
@@ -974,11 +1085,13 @@ var pkgAST = &ast.Package{
 									Line:   27,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Left: &ast.CallExpression{
 							Arguments: []ast.Expression{&ast.ObjectExpression{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 55,
@@ -991,10 +1104,12 @@ var pkgAST = &ast.Package{
 											Line:   27,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Properties: []*ast.Property{&ast.Property{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 55,
@@ -1007,10 +1122,12 @@ var pkgAST = &ast.Package{
 												Line:   27,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Key: &ast.Identifier{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 49,
@@ -1023,12 +1140,14 @@ var pkgAST = &ast.Package{
 													Line:   27,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Name: "v",
 									},
 									Value: &ast.Identifier{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 55,
@@ -1041,6 +1160,7 @@ var pkgAST = &ast.Package{
 													Line:   27,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Name: "level",
 									},
@@ -1048,7 +1168,8 @@ var pkgAST = &ast.Package{
 								With: nil,
 							}},
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 56,
@@ -1061,10 +1182,12 @@ var pkgAST = &ast.Package{
 										Line:   27,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Callee: &ast.MemberExpression{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 47,
@@ -1077,10 +1200,12 @@ var pkgAST = &ast.Package{
 											Line:   27,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Object: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 39,
@@ -1093,12 +1218,14 @@ var pkgAST = &ast.Package{
 												Line:   27,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "strings",
 								},
 								Property: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 47,
@@ -1111,6 +1238,7 @@ var pkgAST = &ast.Package{
 												Line:   27,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "toLower",
 								},
@@ -1119,7 +1247,8 @@ var pkgAST = &ast.Package{
 						Operator: 17,
 						Right: &ast.StringLiteral{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 64,
@@ -1132,6 +1261,7 @@ var pkgAST = &ast.Package{
 										Line:   27,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Value: "ok",
 						},
@@ -1139,7 +1269,8 @@ var pkgAST = &ast.Package{
 				},
 				Params: []*ast.Property{&ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 25,
@@ -1152,10 +1283,12 @@ var pkgAST = &ast.Package{
 								Line:   27,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 25,
@@ -1168,6 +1301,7 @@ var pkgAST = &ast.Package{
 									Line:   27,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Name: "level",
 					},
@@ -1176,7 +1310,8 @@ var pkgAST = &ast.Package{
 			},
 		}, &ast.VariableAssignment{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: []ast.Comment{ast.Comment{Text: "// `sendEvent` sends an event to PagerDuty, the description of some of these parameters taken from the pagerduty documentation at https://v2.developer.pagerduty.com/docs/send-an-event-events-api-v2"}, ast.Comment{Text: "// `pagerdutyURL` - sring - URL of the pagerduty endpoint.  Defaults to: `option defaultURL = \"https://events.pagerduty.com/v2/enqueue\"`"}, ast.Comment{Text: "// `routingKey` - string - routingKey."}, ast.Comment{Text: "// `client` - string - name of the client sending the alert."}, ast.Comment{Text: "// `clientURL` - string - url of the client sending the alert."}, ast.Comment{Text: "// `dedupkey` - string - a per alert ID. It acts as deduplication key, that allows you to ack or change the severity of previous messages. Supports a maximum of 255 characters."}, ast.Comment{Text: "// `class` - string - The class/type of the event, for example ping failure or cpu load."}, ast.Comment{Text: "// `group` - string - Logical grouping of components of a service, for example app-stack."}, ast.Comment{Text: "// `severity` - string - The perceived severity of the status the event is describing with respect to the affected system. This can be critical, error, warning or info."}, ast.Comment{Text: "// `eventAction` - string - The type of event to send to PagerDuty (ex. trigger, resolve, acknowledge)"}, ast.Comment{Text: "// `component` - string - Component of the source machine that is responsible for the event, for example mysql or eth0."}, ast.Comment{Text: "// `source` - string - The unique location of the affected system, preferably a hostname or FQDN."}, ast.Comment{Text: "// `summary` - string - A brief text summary of the event, used to generate the summaries/titles of any associated alerts. The maximum permitted length of this property is 1024 characters."}, ast.Comment{Text: "// `timestamp` - string - The time at which the emitting tool detected or generated the event, in RFC 3339 nano format."}},
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 2,
@@ -1189,10 +1324,12 @@ var pkgAST = &ast.Package{
 						Line:   43,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 10,
@@ -1205,12 +1342,14 @@ var pkgAST = &ast.Package{
 							Line:   43,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "sendEvent",
 			},
 			Init: &ast.FunctionExpression{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 2,
@@ -1223,10 +1362,12 @@ var pkgAST = &ast.Package{
 							Line:   43,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Body: &ast.Block{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 2,
@@ -1239,10 +1380,12 @@ var pkgAST = &ast.Package{
 								Line:   55,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Body: []ast.Statement{&ast.VariableAssignment{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 6,
@@ -1255,10 +1398,12 @@ var pkgAST = &ast.Package{
 									Line:   57,
 								},
 							},
+							TrailingComment: nil,
 						},
 						ID: &ast.Identifier{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 12,
@@ -1271,12 +1416,14 @@ var pkgAST = &ast.Package{
 										Line:   57,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Name: "payload",
 						},
 						Init: &ast.ObjectExpression{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 6,
@@ -1289,10 +1436,12 @@ var pkgAST = &ast.Package{
 										Line:   57,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Properties: []*ast.Property{&ast.Property{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 29,
@@ -1305,10 +1454,12 @@ var pkgAST = &ast.Package{
 											Line:   58,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Key: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 20,
@@ -1321,12 +1472,14 @@ var pkgAST = &ast.Package{
 												Line:   58,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "summary",
 								},
 								Value: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 29,
@@ -1339,12 +1492,14 @@ var pkgAST = &ast.Package{
 												Line:   58,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "summary",
 								},
 							}, &ast.Property{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 33,
@@ -1357,10 +1512,12 @@ var pkgAST = &ast.Package{
 											Line:   59,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Key: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 22,
@@ -1373,12 +1530,14 @@ var pkgAST = &ast.Package{
 												Line:   59,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "timestamp",
 								},
 								Value: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 33,
@@ -1391,12 +1550,14 @@ var pkgAST = &ast.Package{
 												Line:   59,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "timestamp",
 								},
 							}, &ast.Property{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 27,
@@ -1409,10 +1570,12 @@ var pkgAST = &ast.Package{
 											Line:   60,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Key: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 19,
@@ -1425,12 +1588,14 @@ var pkgAST = &ast.Package{
 												Line:   60,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "source",
 								},
 								Value: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 27,
@@ -1443,12 +1608,14 @@ var pkgAST = &ast.Package{
 												Line:   60,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "source",
 								},
 							}, &ast.Property{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 31,
@@ -1461,10 +1628,12 @@ var pkgAST = &ast.Package{
 											Line:   61,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Key: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 21,
@@ -1477,12 +1646,14 @@ var pkgAST = &ast.Package{
 												Line:   61,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "severity",
 								},
 								Value: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 31,
@@ -1495,12 +1666,14 @@ var pkgAST = &ast.Package{
 												Line:   61,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "severity",
 								},
 							}, &ast.Property{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 33,
@@ -1513,10 +1686,12 @@ var pkgAST = &ast.Package{
 											Line:   62,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Key: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 22,
@@ -1529,12 +1704,14 @@ var pkgAST = &ast.Package{
 												Line:   62,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "component",
 								},
 								Value: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 33,
@@ -1547,12 +1724,14 @@ var pkgAST = &ast.Package{
 												Line:   62,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "component",
 								},
 							}, &ast.Property{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 25,
@@ -1565,10 +1744,12 @@ var pkgAST = &ast.Package{
 											Line:   63,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Key: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 18,
@@ -1581,12 +1762,14 @@ var pkgAST = &ast.Package{
 												Line:   63,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "group",
 								},
 								Value: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 25,
@@ -1599,12 +1782,14 @@ var pkgAST = &ast.Package{
 												Line:   63,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "group",
 								},
 							}, &ast.Property{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 25,
@@ -1617,10 +1802,12 @@ var pkgAST = &ast.Package{
 											Line:   64,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Key: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 18,
@@ -1633,12 +1820,14 @@ var pkgAST = &ast.Package{
 												Line:   64,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "class",
 								},
 								Value: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 25,
@@ -1651,6 +1840,7 @@ var pkgAST = &ast.Package{
 												Line:   64,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "class",
 								},
@@ -1659,7 +1849,8 @@ var pkgAST = &ast.Package{
 						},
 					}, &ast.VariableAssignment{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 6,
@@ -1672,10 +1863,12 @@ var pkgAST = &ast.Package{
 									Line:   66,
 								},
 							},
+							TrailingComment: nil,
 						},
 						ID: &ast.Identifier{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 9,
@@ -1688,12 +1881,14 @@ var pkgAST = &ast.Package{
 										Line:   66,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Name: "data",
 						},
 						Init: &ast.ObjectExpression{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 6,
@@ -1706,10 +1901,12 @@ var pkgAST = &ast.Package{
 										Line:   66,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Properties: []*ast.Property{&ast.Property{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 25,
@@ -1722,10 +1919,12 @@ var pkgAST = &ast.Package{
 											Line:   67,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Key: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 16,
@@ -1738,12 +1937,14 @@ var pkgAST = &ast.Package{
 												Line:   67,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "payload",
 								},
 								Value: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 25,
@@ -1756,12 +1957,14 @@ var pkgAST = &ast.Package{
 												Line:   67,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "payload",
 								},
 							}, &ast.Property{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 32,
@@ -1774,10 +1977,12 @@ var pkgAST = &ast.Package{
 											Line:   68,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Key: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 20,
@@ -1790,12 +1995,14 @@ var pkgAST = &ast.Package{
 												Line:   68,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "routing_key",
 								},
 								Value: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 32,
@@ -1808,12 +2015,14 @@ var pkgAST = &ast.Package{
 												Line:   68,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "routingKey",
 								},
 							}, &ast.Property{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 28,
@@ -1826,10 +2035,12 @@ var pkgAST = &ast.Package{
 											Line:   69,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Key: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 18,
@@ -1842,12 +2053,14 @@ var pkgAST = &ast.Package{
 												Line:   69,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "dedup_key",
 								},
 								Value: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 28,
@@ -1860,12 +2073,14 @@ var pkgAST = &ast.Package{
 												Line:   69,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "dedupKey",
 								},
 							}, &ast.Property{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 34,
@@ -1878,10 +2093,12 @@ var pkgAST = &ast.Package{
 											Line:   70,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Key: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 21,
@@ -1894,12 +2111,14 @@ var pkgAST = &ast.Package{
 												Line:   70,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "event_action",
 								},
 								Value: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 34,
@@ -1912,12 +2131,14 @@ var pkgAST = &ast.Package{
 												Line:   70,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "eventAction",
 								},
 							}, &ast.Property{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 23,
@@ -1930,10 +2151,12 @@ var pkgAST = &ast.Package{
 											Line:   71,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Key: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 15,
@@ -1946,12 +2169,14 @@ var pkgAST = &ast.Package{
 												Line:   71,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "client",
 								},
 								Value: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 23,
@@ -1964,12 +2189,14 @@ var pkgAST = &ast.Package{
 												Line:   71,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "client",
 								},
 							}, &ast.Property{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 30,
@@ -1982,10 +2209,12 @@ var pkgAST = &ast.Package{
 											Line:   72,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Key: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 19,
@@ -1998,12 +2227,14 @@ var pkgAST = &ast.Package{
 												Line:   72,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "client_url",
 								},
 								Value: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 30,
@@ -2016,6 +2247,7 @@ var pkgAST = &ast.Package{
 												Line:   72,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "clientURL",
 								},
@@ -2024,7 +2256,8 @@ var pkgAST = &ast.Package{
 						},
 					}, &ast.VariableAssignment{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 6,
@@ -2037,10 +2270,12 @@ var pkgAST = &ast.Package{
 									Line:   75,
 								},
 							},
+							TrailingComment: nil,
 						},
 						ID: &ast.Identifier{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 12,
@@ -2053,12 +2288,14 @@ var pkgAST = &ast.Package{
 										Line:   75,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Name: "headers",
 						},
 						Init: &ast.ObjectExpression{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 6,
@@ -2071,10 +2308,12 @@ var pkgAST = &ast.Package{
 										Line:   75,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Properties: []*ast.Property{&ast.Property{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 61,
@@ -2087,10 +2326,12 @@ var pkgAST = &ast.Package{
 											Line:   76,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Key: &ast.StringLiteral{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 17,
@@ -2103,12 +2344,14 @@ var pkgAST = &ast.Package{
 												Line:   76,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Value: "Accept",
 								},
 								Value: &ast.StringLiteral{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 61,
@@ -2121,12 +2364,14 @@ var pkgAST = &ast.Package{
 												Line:   76,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Value: "application/vnd.pagerduty+json;version=2",
 								},
 							}, &ast.Property{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 43,
@@ -2139,10 +2384,12 @@ var pkgAST = &ast.Package{
 											Line:   77,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Key: &ast.StringLiteral{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 23,
@@ -2155,12 +2402,14 @@ var pkgAST = &ast.Package{
 												Line:   77,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Value: "Content-Type",
 								},
 								Value: &ast.StringLiteral{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 43,
@@ -2173,6 +2422,7 @@ var pkgAST = &ast.Package{
 												Line:   77,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Value: "application/json",
 								},
@@ -2181,7 +2431,8 @@ var pkgAST = &ast.Package{
 						},
 					}, &ast.VariableAssignment{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 31,
@@ -2194,10 +2445,12 @@ var pkgAST = &ast.Package{
 									Line:   79,
 								},
 							},
+							TrailingComment: nil,
 						},
 						ID: &ast.Identifier{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 8,
@@ -2210,13 +2463,15 @@ var pkgAST = &ast.Package{
 										Line:   79,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Name: "enc",
 						},
 						Init: &ast.CallExpression{
 							Arguments: []ast.Expression{&ast.ObjectExpression{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 30,
@@ -2229,10 +2484,12 @@ var pkgAST = &ast.Package{
 											Line:   79,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Properties: []*ast.Property{&ast.Property{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 30,
@@ -2245,10 +2502,12 @@ var pkgAST = &ast.Package{
 												Line:   79,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Key: &ast.Identifier{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 24,
@@ -2261,12 +2520,14 @@ var pkgAST = &ast.Package{
 													Line:   79,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Name: "v",
 									},
 									Value: &ast.Identifier{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 30,
@@ -2279,6 +2540,7 @@ var pkgAST = &ast.Package{
 													Line:   79,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Name: "data",
 									},
@@ -2286,7 +2548,8 @@ var pkgAST = &ast.Package{
 								With: nil,
 							}},
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 31,
@@ -2299,10 +2562,12 @@ var pkgAST = &ast.Package{
 										Line:   79,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Callee: &ast.MemberExpression{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 22,
@@ -2315,10 +2580,12 @@ var pkgAST = &ast.Package{
 											Line:   79,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Object: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 15,
@@ -2331,12 +2598,14 @@ var pkgAST = &ast.Package{
 												Line:   79,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "json",
 								},
 								Property: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 22,
@@ -2349,6 +2618,7 @@ var pkgAST = &ast.Package{
 												Line:   79,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "encode",
 								},
@@ -2358,7 +2628,8 @@ var pkgAST = &ast.Package{
 						Argument: &ast.CallExpression{
 							Arguments: []ast.Expression{&ast.ObjectExpression{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 68,
@@ -2371,10 +2642,12 @@ var pkgAST = &ast.Package{
 											Line:   80,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Properties: []*ast.Property{&ast.Property{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 38,
@@ -2387,10 +2660,12 @@ var pkgAST = &ast.Package{
 												Line:   80,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Key: &ast.Identifier{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 29,
@@ -2403,12 +2678,14 @@ var pkgAST = &ast.Package{
 													Line:   80,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Name: "headers",
 									},
 									Value: &ast.Identifier{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 38,
@@ -2421,12 +2698,14 @@ var pkgAST = &ast.Package{
 													Line:   80,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Name: "headers",
 									},
 								}, &ast.Property{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 57,
@@ -2439,10 +2718,12 @@ var pkgAST = &ast.Package{
 												Line:   80,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Key: &ast.Identifier{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 43,
@@ -2455,12 +2736,14 @@ var pkgAST = &ast.Package{
 													Line:   80,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Name: "url",
 									},
 									Value: &ast.Identifier{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 57,
@@ -2473,12 +2756,14 @@ var pkgAST = &ast.Package{
 													Line:   80,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Name: "pagerdutyURL",
 									},
 								}, &ast.Property{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 68,
@@ -2491,10 +2776,12 @@ var pkgAST = &ast.Package{
 												Line:   80,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Key: &ast.Identifier{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 63,
@@ -2507,12 +2794,14 @@ var pkgAST = &ast.Package{
 													Line:   80,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Name: "data",
 									},
 									Value: &ast.Identifier{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 68,
@@ -2525,6 +2814,7 @@ var pkgAST = &ast.Package{
 													Line:   80,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Name: "enc",
 									},
@@ -2532,7 +2822,8 @@ var pkgAST = &ast.Package{
 								With: nil,
 							}},
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 69,
@@ -2545,10 +2836,12 @@ var pkgAST = &ast.Package{
 										Line:   80,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Callee: &ast.MemberExpression{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 21,
@@ -2561,10 +2854,12 @@ var pkgAST = &ast.Package{
 											Line:   80,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Object: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 16,
@@ -2577,12 +2872,14 @@ var pkgAST = &ast.Package{
 												Line:   80,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "http",
 								},
 								Property: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 21,
@@ -2595,13 +2892,15 @@ var pkgAST = &ast.Package{
 												Line:   80,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "post",
 								},
 							},
 						},
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 69,
@@ -2614,12 +2913,14 @@ var pkgAST = &ast.Package{
 									Line:   80,
 								},
 							},
+							TrailingComment: nil,
 						},
 					}},
 				},
 				Params: []*ast.Property{&ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 37,
@@ -2632,10 +2933,12 @@ var pkgAST = &ast.Package{
 								Line:   43,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 26,
@@ -2648,12 +2951,14 @@ var pkgAST = &ast.Package{
 									Line:   43,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Name: "pagerdutyURL",
 					},
 					Value: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 37,
@@ -2666,12 +2971,14 @@ var pkgAST = &ast.Package{
 									Line:   43,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Name: "defaultURL",
 					},
 				}, &ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 15,
@@ -2684,10 +2991,12 @@ var pkgAST = &ast.Package{
 								Line:   44,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 15,
@@ -2700,13 +3009,15 @@ var pkgAST = &ast.Package{
 									Line:   44,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Name: "routingKey",
 					},
 					Value: nil,
 				}, &ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 11,
@@ -2719,10 +3030,12 @@ var pkgAST = &ast.Package{
 								Line:   45,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 11,
@@ -2735,13 +3048,15 @@ var pkgAST = &ast.Package{
 									Line:   45,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Name: "client",
 					},
 					Value: nil,
 				}, &ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 14,
@@ -2754,10 +3069,12 @@ var pkgAST = &ast.Package{
 								Line:   46,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 14,
@@ -2770,13 +3087,15 @@ var pkgAST = &ast.Package{
 									Line:   46,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Name: "clientURL",
 					},
 					Value: nil,
 				}, &ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 13,
@@ -2789,10 +3108,12 @@ var pkgAST = &ast.Package{
 								Line:   47,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 13,
@@ -2805,13 +3126,15 @@ var pkgAST = &ast.Package{
 									Line:   47,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Name: "dedupKey",
 					},
 					Value: nil,
 				}, &ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 10,
@@ -2824,10 +3147,12 @@ var pkgAST = &ast.Package{
 								Line:   48,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 10,
@@ -2840,13 +3165,15 @@ var pkgAST = &ast.Package{
 									Line:   48,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Name: "class",
 					},
 					Value: nil,
 				}, &ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 10,
@@ -2859,10 +3186,12 @@ var pkgAST = &ast.Package{
 								Line:   49,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 10,
@@ -2875,13 +3204,15 @@ var pkgAST = &ast.Package{
 									Line:   49,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Name: "group",
 					},
 					Value: nil,
 				}, &ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 13,
@@ -2894,10 +3225,12 @@ var pkgAST = &ast.Package{
 								Line:   50,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 13,
@@ -2910,13 +3243,15 @@ var pkgAST = &ast.Package{
 									Line:   50,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Name: "severity",
 					},
 					Value: nil,
 				}, &ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 16,
@@ -2929,10 +3264,12 @@ var pkgAST = &ast.Package{
 								Line:   51,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 16,
@@ -2945,13 +3282,15 @@ var pkgAST = &ast.Package{
 									Line:   51,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Name: "eventAction",
 					},
 					Value: nil,
 				}, &ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 14,
@@ -2964,10 +3303,12 @@ var pkgAST = &ast.Package{
 								Line:   52,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 14,
@@ -2980,13 +3321,15 @@ var pkgAST = &ast.Package{
 									Line:   52,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Name: "component",
 					},
 					Value: nil,
 				}, &ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 11,
@@ -2999,10 +3342,12 @@ var pkgAST = &ast.Package{
 								Line:   53,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 11,
@@ -3015,13 +3360,15 @@ var pkgAST = &ast.Package{
 									Line:   53,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Name: "source",
 					},
 					Value: nil,
 				}, &ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 12,
@@ -3034,10 +3381,12 @@ var pkgAST = &ast.Package{
 								Line:   54,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 12,
@@ -3050,13 +3399,15 @@ var pkgAST = &ast.Package{
 									Line:   54,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Name: "summary",
 					},
 					Value: nil,
 				}, &ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 14,
@@ -3069,10 +3420,12 @@ var pkgAST = &ast.Package{
 								Line:   55,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 14,
@@ -3085,6 +3438,7 @@ var pkgAST = &ast.Package{
 									Line:   55,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Name: "timestamp",
 					},
@@ -3093,7 +3447,8 @@ var pkgAST = &ast.Package{
 			},
 		}, &ast.VariableAssignment{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: []ast.Comment{ast.Comment{Text: "// `endpoint` creates the endpoint for the PagerDuty external service."}, ast.Comment{Text: "// `url` - string - URL of the Pagerduty endpoint. Defaults to: \"https://events.pagerduty.com/v2/enqueue\"."}, ast.Comment{Text: "// The returned factory function accepts a `mapFn` parameter."}, ast.Comment{Text: "// The `mapFn` parameter must be a function that returns an object with `routingKey`, `client`, `client_url`, `class`, `group`, `severity`, `eventAction`, `component`, `source`, `summary`, and `timestamp` as defined in the sendEvent function."}, ast.Comment{Text: "// Note that while sendEvent accepts a dedup key, endpoint gets the dedupkey from the groupkey of the input table instead of it being handled by the `mapFn`."}},
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 15,
@@ -3106,10 +3461,12 @@ var pkgAST = &ast.Package{
 						Line:   88,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 9,
@@ -3122,12 +3479,14 @@ var pkgAST = &ast.Package{
 							Line:   88,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "endpoint",
 			},
 			Init: &ast.FunctionExpression{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 15,
@@ -3140,10 +3499,12 @@ var pkgAST = &ast.Package{
 							Line:   88,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Body: &ast.FunctionExpression{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 15,
@@ -3156,10 +3517,12 @@ var pkgAST = &ast.Package{
 								Line:   89,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Body: &ast.FunctionExpression{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 15,
@@ -3172,12 +3535,14 @@ var pkgAST = &ast.Package{
 									Line:   90,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Body: &ast.PipeExpression{
 							Argument: &ast.PipeExpression{
 								Argument: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 30,
@@ -3190,11 +3555,13 @@ var pkgAST = &ast.Package{
 												Line:   90,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "tables",
 								},
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 26,
@@ -3207,11 +3574,13 @@ var pkgAST = &ast.Package{
 											Line:   90,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Call: &ast.CallExpression{
 									Arguments: nil,
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 26,
@@ -3224,10 +3593,12 @@ var pkgAST = &ast.Package{
 												Line:   91,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Callee: &ast.Identifier{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 24,
@@ -3240,13 +3611,15 @@ var pkgAST = &ast.Package{
 													Line:   91,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Name: "dedupKey",
 									},
 								},
 							},
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 15,
@@ -3259,11 +3632,13 @@ var pkgAST = &ast.Package{
 										Line:   90,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Call: &ast.CallExpression{
 								Arguments: []ast.Expression{&ast.ObjectExpression{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 14,
@@ -3276,10 +3651,12 @@ var pkgAST = &ast.Package{
 												Line:   92,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Properties: []*ast.Property{&ast.Property{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 14,
@@ -3292,10 +3669,12 @@ var pkgAST = &ast.Package{
 													Line:   92,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Key: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 22,
@@ -3308,12 +3687,14 @@ var pkgAST = &ast.Package{
 														Line:   92,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "fn",
 										},
 										Value: &ast.FunctionExpression{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 14,
@@ -3326,10 +3707,12 @@ var pkgAST = &ast.Package{
 														Line:   92,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Body: &ast.Block{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 14,
@@ -3342,10 +3725,12 @@ var pkgAST = &ast.Package{
 															Line:   92,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Body: []ast.Statement{&ast.VariableAssignment{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 34,
@@ -3358,10 +3743,12 @@ var pkgAST = &ast.Package{
 																Line:   93,
 															},
 														},
+														TrailingComment: nil,
 													},
 													ID: &ast.Identifier{
 														BaseNode: ast.BaseNode{
-															Errors: nil,
+															Comments: nil,
+															Errors:   nil,
 															Loc: &ast.SourceLocation{
 																End: ast.Position{
 																	Column: 20,
@@ -3374,13 +3761,15 @@ var pkgAST = &ast.Package{
 																	Line:   93,
 																},
 															},
+															TrailingComment: nil,
 														},
 														Name: "obj",
 													},
 													Init: &ast.CallExpression{
 														Arguments: []ast.Expression{&ast.ObjectExpression{
 															BaseNode: ast.BaseNode{
-																Errors: nil,
+																Comments: nil,
+																Errors:   nil,
 																Loc: &ast.SourceLocation{
 																	End: ast.Position{
 																		Column: 33,
@@ -3393,10 +3782,12 @@ var pkgAST = &ast.Package{
 																		Line:   93,
 																	},
 																},
+																TrailingComment: nil,
 															},
 															Properties: []*ast.Property{&ast.Property{
 																BaseNode: ast.BaseNode{
-																	Errors: nil,
+																	Comments: nil,
+																	Errors:   nil,
 																	Loc: &ast.SourceLocation{
 																		End: ast.Position{
 																			Column: 33,
@@ -3409,10 +3800,12 @@ var pkgAST = &ast.Package{
 																			Line:   93,
 																		},
 																	},
+																	TrailingComment: nil,
 																},
 																Key: &ast.Identifier{
 																	BaseNode: ast.BaseNode{
-																		Errors: nil,
+																		Comments: nil,
+																		Errors:   nil,
 																		Loc: &ast.SourceLocation{
 																			End: ast.Position{
 																				Column: 30,
@@ -3425,12 +3818,14 @@ var pkgAST = &ast.Package{
 																				Line:   93,
 																			},
 																		},
+																		TrailingComment: nil,
 																	},
 																	Name: "r",
 																},
 																Value: &ast.Identifier{
 																	BaseNode: ast.BaseNode{
-																		Errors: nil,
+																		Comments: nil,
+																		Errors:   nil,
 																		Loc: &ast.SourceLocation{
 																			End: ast.Position{
 																				Column: 33,
@@ -3443,6 +3838,7 @@ var pkgAST = &ast.Package{
 																				Line:   93,
 																			},
 																		},
+																		TrailingComment: nil,
 																	},
 																	Name: "r",
 																},
@@ -3450,7 +3846,8 @@ var pkgAST = &ast.Package{
 															With: nil,
 														}},
 														BaseNode: ast.BaseNode{
-															Errors: nil,
+															Comments: nil,
+															Errors:   nil,
 															Loc: &ast.SourceLocation{
 																End: ast.Position{
 																	Column: 34,
@@ -3463,10 +3860,12 @@ var pkgAST = &ast.Package{
 																	Line:   93,
 																},
 															},
+															TrailingComment: nil,
 														},
 														Callee: &ast.Identifier{
 															BaseNode: ast.BaseNode{
-																Errors: nil,
+																Comments: nil,
+																Errors:   nil,
 																Loc: &ast.SourceLocation{
 																	End: ast.Position{
 																		Column: 28,
@@ -3479,6 +3878,7 @@ var pkgAST = &ast.Package{
 																		Line:   93,
 																	},
 																},
+																TrailingComment: nil,
 															},
 															Name: "mapFn",
 														},
@@ -3486,7 +3886,8 @@ var pkgAST = &ast.Package{
 												}, &ast.ReturnStatement{
 													Argument: &ast.ObjectExpression{
 														BaseNode: ast.BaseNode{
-															Errors: nil,
+															Comments: nil,
+															Errors:   nil,
 															Loc: &ast.SourceLocation{
 																End: ast.Position{
 																	Column: 27,
@@ -3499,10 +3900,12 @@ var pkgAST = &ast.Package{
 																	Line:   95,
 																},
 															},
+															TrailingComment: nil,
 														},
 														Properties: []*ast.Property{&ast.Property{
 															BaseNode: ast.BaseNode{
-																Errors: nil,
+																Comments: nil,
+																Errors:   nil,
 																Loc: &ast.SourceLocation{
 																	End: ast.Position{
 																		Column: 26,
@@ -3515,10 +3918,12 @@ var pkgAST = &ast.Package{
 																		Line:   95,
 																	},
 																},
+																TrailingComment: nil,
 															},
 															Key: &ast.Identifier{
 																BaseNode: ast.BaseNode{
-																	Errors: nil,
+																	Comments: nil,
+																	Errors:   nil,
 																	Loc: &ast.SourceLocation{
 																		End: ast.Position{
 																			Column: 37,
@@ -3531,13 +3936,15 @@ var pkgAST = &ast.Package{
 																			Line:   95,
 																		},
 																	},
+																	TrailingComment: nil,
 																},
 																Name: "_sent",
 															},
 															Value: &ast.CallExpression{
 																Arguments: []ast.Expression{&ast.ObjectExpression{
 																	BaseNode: ast.BaseNode{
-																		Errors: nil,
+																		Comments: nil,
+																		Errors:   nil,
 																		Loc: &ast.SourceLocation{
 																			End: ast.Position{
 																				Column: 25,
@@ -3550,10 +3957,12 @@ var pkgAST = &ast.Package{
 																				Line:   95,
 																			},
 																		},
+																		TrailingComment: nil,
 																	},
 																	Properties: []*ast.Property{&ast.Property{
 																		BaseNode: ast.BaseNode{
-																			Errors: nil,
+																			Comments: nil,
+																			Errors:   nil,
 																			Loc: &ast.SourceLocation{
 																				End: ast.Position{
 																					Column: 25,
@@ -3566,10 +3975,12 @@ var pkgAST = &ast.Package{
 																					Line:   95,
 																				},
 																			},
+																			TrailingComment: nil,
 																		},
 																		Key: &ast.Identifier{
 																			BaseNode: ast.BaseNode{
-																				Errors: nil,
+																				Comments: nil,
+																				Errors:   nil,
 																				Loc: &ast.SourceLocation{
 																					End: ast.Position{
 																						Column: 47,
@@ -3582,12 +3993,14 @@ var pkgAST = &ast.Package{
 																						Line:   95,
 																					},
 																				},
+																				TrailingComment: nil,
 																			},
 																			Name: "v",
 																		},
 																		Value: &ast.BinaryExpression{
 																			BaseNode: ast.BaseNode{
-																				Errors: nil,
+																				Comments: nil,
+																				Errors:   nil,
 																				Loc: &ast.SourceLocation{
 																					End: ast.Position{
 																						Column: 25,
@@ -3600,10 +4013,12 @@ var pkgAST = &ast.Package{
 																						Line:   95,
 																					},
 																				},
+																				TrailingComment: nil,
 																			},
 																			Left: &ast.IntegerLiteral{
 																				BaseNode: ast.BaseNode{
-																					Errors: nil,
+																					Comments: nil,
+																					Errors:   nil,
 																					Loc: &ast.SourceLocation{
 																						End: ast.Position{
 																							Column: 50,
@@ -3616,13 +4031,15 @@ var pkgAST = &ast.Package{
 																							Line:   95,
 																						},
 																					},
+																					TrailingComment: nil,
 																				},
 																				Value: int64(2),
 																			},
 																			Operator: 17,
 																			Right: &ast.ParenExpression{
 																				BaseNode: ast.BaseNode{
-																					Errors: nil,
+																					Comments: nil,
+																					Errors:   nil,
 																					Loc: &ast.SourceLocation{
 																						End: ast.Position{
 																							Column: 25,
@@ -3635,10 +4052,12 @@ var pkgAST = &ast.Package{
 																							Line:   95,
 																						},
 																					},
+																					TrailingComment: nil,
 																				},
 																				Expression: &ast.BinaryExpression{
 																					BaseNode: ast.BaseNode{
-																						Errors: nil,
+																						Comments: nil,
+																						Errors:   nil,
 																						Loc: &ast.SourceLocation{
 																							End: ast.Position{
 																								Column: 24,
@@ -3651,11 +4070,13 @@ var pkgAST = &ast.Package{
 																								Line:   95,
 																							},
 																						},
+																						TrailingComment: nil,
 																					},
 																					Left: &ast.CallExpression{
 																						Arguments: []ast.Expression{&ast.ObjectExpression{
 																							BaseNode: ast.BaseNode{
-																								Errors: nil,
+																								Comments: nil,
+																								Errors:   nil,
 																								Loc: &ast.SourceLocation{
 																									End: ast.Position{
 																										Column: 45,
@@ -3668,10 +4089,12 @@ var pkgAST = &ast.Package{
 																										Line:   95,
 																									},
 																								},
+																								TrailingComment: nil,
 																							},
 																							Properties: []*ast.Property{&ast.Property{
 																								BaseNode: ast.BaseNode{
-																									Errors: nil,
+																									Comments: nil,
+																									Errors:   nil,
 																									Loc: &ast.SourceLocation{
 																										End: ast.Position{
 																											Column: 82,
@@ -3684,10 +4107,12 @@ var pkgAST = &ast.Package{
 																											Line:   95,
 																										},
 																									},
+																									TrailingComment: nil,
 																								},
 																								Key: &ast.Identifier{
 																									BaseNode: ast.BaseNode{
-																										Errors: nil,
+																										Comments: nil,
+																										Errors:   nil,
 																										Loc: &ast.SourceLocation{
 																											End: ast.Position{
 																												Column: 77,
@@ -3700,12 +4125,14 @@ var pkgAST = &ast.Package{
 																												Line:   95,
 																											},
 																										},
+																										TrailingComment: nil,
 																									},
 																									Name: "pagerdutyURL",
 																								},
 																								Value: &ast.Identifier{
 																									BaseNode: ast.BaseNode{
-																										Errors: nil,
+																										Comments: nil,
+																										Errors:   nil,
 																										Loc: &ast.SourceLocation{
 																											End: ast.Position{
 																												Column: 82,
@@ -3718,12 +4145,14 @@ var pkgAST = &ast.Package{
 																												Line:   95,
 																											},
 																										},
+																										TrailingComment: nil,
 																									},
 																									Name: "url",
 																								},
 																							}, &ast.Property{
 																								BaseNode: ast.BaseNode{
-																									Errors: nil,
+																									Comments: nil,
+																									Errors:   nil,
 																									Loc: &ast.SourceLocation{
 																										End: ast.Position{
 																											Column: 47,
@@ -3736,10 +4165,12 @@ var pkgAST = &ast.Package{
 																											Line:   96,
 																										},
 																									},
+																									TrailingComment: nil,
 																								},
 																								Key: &ast.Identifier{
 																									BaseNode: ast.BaseNode{
-																										Errors: nil,
+																										Comments: nil,
+																										Errors:   nil,
 																										Loc: &ast.SourceLocation{
 																											End: ast.Position{
 																												Column: 31,
@@ -3752,12 +4183,14 @@ var pkgAST = &ast.Package{
 																												Line:   96,
 																											},
 																										},
+																										TrailingComment: nil,
 																									},
 																									Name: "routingKey",
 																								},
 																								Value: &ast.MemberExpression{
 																									BaseNode: ast.BaseNode{
-																										Errors: nil,
+																										Comments: nil,
+																										Errors:   nil,
 																										Loc: &ast.SourceLocation{
 																											End: ast.Position{
 																												Column: 47,
@@ -3770,10 +4203,12 @@ var pkgAST = &ast.Package{
 																												Line:   96,
 																											},
 																										},
+																										TrailingComment: nil,
 																									},
 																									Object: &ast.Identifier{
 																										BaseNode: ast.BaseNode{
-																											Errors: nil,
+																											Comments: nil,
+																											Errors:   nil,
 																											Loc: &ast.SourceLocation{
 																												End: ast.Position{
 																													Column: 36,
@@ -3786,12 +4221,14 @@ var pkgAST = &ast.Package{
 																													Line:   96,
 																												},
 																											},
+																											TrailingComment: nil,
 																										},
 																										Name: "obj",
 																									},
 																									Property: &ast.Identifier{
 																										BaseNode: ast.BaseNode{
-																											Errors: nil,
+																											Comments: nil,
+																											Errors:   nil,
 																											Loc: &ast.SourceLocation{
 																												End: ast.Position{
 																													Column: 47,
@@ -3804,13 +4241,15 @@ var pkgAST = &ast.Package{
 																													Line:   96,
 																												},
 																											},
+																											TrailingComment: nil,
 																										},
 																										Name: "routingKey",
 																									},
 																								},
 																							}, &ast.Property{
 																								BaseNode: ast.BaseNode{
-																									Errors: nil,
+																									Comments: nil,
+																									Errors:   nil,
 																									Loc: &ast.SourceLocation{
 																										End: ast.Position{
 																											Column: 39,
@@ -3823,10 +4262,12 @@ var pkgAST = &ast.Package{
 																											Line:   97,
 																										},
 																									},
+																									TrailingComment: nil,
 																								},
 																								Key: &ast.Identifier{
 																									BaseNode: ast.BaseNode{
-																										Errors: nil,
+																										Comments: nil,
+																										Errors:   nil,
 																										Loc: &ast.SourceLocation{
 																											End: ast.Position{
 																												Column: 27,
@@ -3839,12 +4280,14 @@ var pkgAST = &ast.Package{
 																												Line:   97,
 																											},
 																										},
+																										TrailingComment: nil,
 																									},
 																									Name: "client",
 																								},
 																								Value: &ast.MemberExpression{
 																									BaseNode: ast.BaseNode{
-																										Errors: nil,
+																										Comments: nil,
+																										Errors:   nil,
 																										Loc: &ast.SourceLocation{
 																											End: ast.Position{
 																												Column: 39,
@@ -3857,10 +4300,12 @@ var pkgAST = &ast.Package{
 																												Line:   97,
 																											},
 																										},
+																										TrailingComment: nil,
 																									},
 																									Object: &ast.Identifier{
 																										BaseNode: ast.BaseNode{
-																											Errors: nil,
+																											Comments: nil,
+																											Errors:   nil,
 																											Loc: &ast.SourceLocation{
 																												End: ast.Position{
 																													Column: 32,
@@ -3873,12 +4318,14 @@ var pkgAST = &ast.Package{
 																													Line:   97,
 																												},
 																											},
+																											TrailingComment: nil,
 																										},
 																										Name: "obj",
 																									},
 																									Property: &ast.Identifier{
 																										BaseNode: ast.BaseNode{
-																											Errors: nil,
+																											Comments: nil,
+																											Errors:   nil,
 																											Loc: &ast.SourceLocation{
 																												End: ast.Position{
 																													Column: 39,
@@ -3891,13 +4338,15 @@ var pkgAST = &ast.Package{
 																													Line:   97,
 																												},
 																											},
+																											TrailingComment: nil,
 																										},
 																										Name: "client",
 																									},
 																								},
 																							}, &ast.Property{
 																								BaseNode: ast.BaseNode{
-																									Errors: nil,
+																									Comments: nil,
+																									Errors:   nil,
 																									Loc: &ast.SourceLocation{
 																										End: ast.Position{
 																											Column: 45,
@@ -3910,10 +4359,12 @@ var pkgAST = &ast.Package{
 																											Line:   98,
 																										},
 																									},
+																									TrailingComment: nil,
 																								},
 																								Key: &ast.Identifier{
 																									BaseNode: ast.BaseNode{
-																										Errors: nil,
+																										Comments: nil,
+																										Errors:   nil,
 																										Loc: &ast.SourceLocation{
 																											End: ast.Position{
 																												Column: 30,
@@ -3926,12 +4377,14 @@ var pkgAST = &ast.Package{
 																												Line:   98,
 																											},
 																										},
+																										TrailingComment: nil,
 																									},
 																									Name: "clientURL",
 																								},
 																								Value: &ast.MemberExpression{
 																									BaseNode: ast.BaseNode{
-																										Errors: nil,
+																										Comments: nil,
+																										Errors:   nil,
 																										Loc: &ast.SourceLocation{
 																											End: ast.Position{
 																												Column: 45,
@@ -3944,10 +4397,12 @@ var pkgAST = &ast.Package{
 																												Line:   98,
 																											},
 																										},
+																										TrailingComment: nil,
 																									},
 																									Object: &ast.Identifier{
 																										BaseNode: ast.BaseNode{
-																											Errors: nil,
+																											Comments: nil,
+																											Errors:   nil,
 																											Loc: &ast.SourceLocation{
 																												End: ast.Position{
 																													Column: 35,
@@ -3960,12 +4415,14 @@ var pkgAST = &ast.Package{
 																													Line:   98,
 																												},
 																											},
+																											TrailingComment: nil,
 																										},
 																										Name: "obj",
 																									},
 																									Property: &ast.Identifier{
 																										BaseNode: ast.BaseNode{
-																											Errors: nil,
+																											Comments: nil,
+																											Errors:   nil,
 																											Loc: &ast.SourceLocation{
 																												End: ast.Position{
 																													Column: 45,
@@ -3978,13 +4435,15 @@ var pkgAST = &ast.Package{
 																													Line:   98,
 																												},
 																											},
+																											TrailingComment: nil,
 																										},
 																										Name: "clientURL",
 																									},
 																								},
 																							}, &ast.Property{
 																								BaseNode: ast.BaseNode{
-																									Errors: nil,
+																									Comments: nil,
+																									Errors:   nil,
 																									Loc: &ast.SourceLocation{
 																										End: ast.Position{
 																											Column: 51,
@@ -3997,10 +4456,12 @@ var pkgAST = &ast.Package{
 																											Line:   99,
 																										},
 																									},
+																									TrailingComment: nil,
 																								},
 																								Key: &ast.Identifier{
 																									BaseNode: ast.BaseNode{
-																										Errors: nil,
+																										Comments: nil,
+																										Errors:   nil,
 																										Loc: &ast.SourceLocation{
 																											End: ast.Position{
 																												Column: 29,
@@ -4013,12 +4474,14 @@ var pkgAST = &ast.Package{
 																												Line:   99,
 																											},
 																										},
+																										TrailingComment: nil,
 																									},
 																									Name: "dedupKey",
 																								},
 																								Value: &ast.MemberExpression{
 																									BaseNode: ast.BaseNode{
-																										Errors: nil,
+																										Comments: nil,
+																										Errors:   nil,
 																										Loc: &ast.SourceLocation{
 																											End: ast.Position{
 																												Column: 51,
@@ -4031,10 +4494,12 @@ var pkgAST = &ast.Package{
 																												Line:   99,
 																											},
 																										},
+																										TrailingComment: nil,
 																									},
 																									Object: &ast.Identifier{
 																										BaseNode: ast.BaseNode{
-																											Errors: nil,
+																											Comments: nil,
+																											Errors:   nil,
 																											Loc: &ast.SourceLocation{
 																												End: ast.Position{
 																													Column: 32,
@@ -4047,12 +4512,14 @@ var pkgAST = &ast.Package{
 																													Line:   99,
 																												},
 																											},
+																											TrailingComment: nil,
 																										},
 																										Name: "r",
 																									},
 																									Property: &ast.Identifier{
 																										BaseNode: ast.BaseNode{
-																											Errors: nil,
+																											Comments: nil,
+																											Errors:   nil,
 																											Loc: &ast.SourceLocation{
 																												End: ast.Position{
 																													Column: 51,
@@ -4065,13 +4532,15 @@ var pkgAST = &ast.Package{
 																													Line:   99,
 																												},
 																											},
+																											TrailingComment: nil,
 																										},
 																										Name: "_pagerdutyDedupKey",
 																									},
 																								},
 																							}, &ast.Property{
 																								BaseNode: ast.BaseNode{
-																									Errors: nil,
+																									Comments: nil,
+																									Errors:   nil,
 																									Loc: &ast.SourceLocation{
 																										End: ast.Position{
 																											Column: 37,
@@ -4084,10 +4553,12 @@ var pkgAST = &ast.Package{
 																											Line:   100,
 																										},
 																									},
+																									TrailingComment: nil,
 																								},
 																								Key: &ast.Identifier{
 																									BaseNode: ast.BaseNode{
-																										Errors: nil,
+																										Comments: nil,
+																										Errors:   nil,
 																										Loc: &ast.SourceLocation{
 																											End: ast.Position{
 																												Column: 26,
@@ -4100,12 +4571,14 @@ var pkgAST = &ast.Package{
 																												Line:   100,
 																											},
 																										},
+																										TrailingComment: nil,
 																									},
 																									Name: "class",
 																								},
 																								Value: &ast.MemberExpression{
 																									BaseNode: ast.BaseNode{
-																										Errors: nil,
+																										Comments: nil,
+																										Errors:   nil,
 																										Loc: &ast.SourceLocation{
 																											End: ast.Position{
 																												Column: 37,
@@ -4118,10 +4591,12 @@ var pkgAST = &ast.Package{
 																												Line:   100,
 																											},
 																										},
+																										TrailingComment: nil,
 																									},
 																									Object: &ast.Identifier{
 																										BaseNode: ast.BaseNode{
-																											Errors: nil,
+																											Comments: nil,
+																											Errors:   nil,
 																											Loc: &ast.SourceLocation{
 																												End: ast.Position{
 																													Column: 31,
@@ -4134,12 +4609,14 @@ var pkgAST = &ast.Package{
 																													Line:   100,
 																												},
 																											},
+																											TrailingComment: nil,
 																										},
 																										Name: "obj",
 																									},
 																									Property: &ast.Identifier{
 																										BaseNode: ast.BaseNode{
-																											Errors: nil,
+																											Comments: nil,
+																											Errors:   nil,
 																											Loc: &ast.SourceLocation{
 																												End: ast.Position{
 																													Column: 37,
@@ -4152,13 +4629,15 @@ var pkgAST = &ast.Package{
 																													Line:   100,
 																												},
 																											},
+																											TrailingComment: nil,
 																										},
 																										Name: "class",
 																									},
 																								},
 																							}, &ast.Property{
 																								BaseNode: ast.BaseNode{
-																									Errors: nil,
+																									Comments: nil,
+																									Errors:   nil,
 																									Loc: &ast.SourceLocation{
 																										End: ast.Position{
 																											Column: 37,
@@ -4171,10 +4650,12 @@ var pkgAST = &ast.Package{
 																											Line:   101,
 																										},
 																									},
+																									TrailingComment: nil,
 																								},
 																								Key: &ast.Identifier{
 																									BaseNode: ast.BaseNode{
-																										Errors: nil,
+																										Comments: nil,
+																										Errors:   nil,
 																										Loc: &ast.SourceLocation{
 																											End: ast.Position{
 																												Column: 26,
@@ -4187,12 +4668,14 @@ var pkgAST = &ast.Package{
 																												Line:   101,
 																											},
 																										},
+																										TrailingComment: nil,
 																									},
 																									Name: "group",
 																								},
 																								Value: &ast.MemberExpression{
 																									BaseNode: ast.BaseNode{
-																										Errors: nil,
+																										Comments: nil,
+																										Errors:   nil,
 																										Loc: &ast.SourceLocation{
 																											End: ast.Position{
 																												Column: 37,
@@ -4205,10 +4688,12 @@ var pkgAST = &ast.Package{
 																												Line:   101,
 																											},
 																										},
+																										TrailingComment: nil,
 																									},
 																									Object: &ast.Identifier{
 																										BaseNode: ast.BaseNode{
-																											Errors: nil,
+																											Comments: nil,
+																											Errors:   nil,
 																											Loc: &ast.SourceLocation{
 																												End: ast.Position{
 																													Column: 31,
@@ -4221,12 +4706,14 @@ var pkgAST = &ast.Package{
 																													Line:   101,
 																												},
 																											},
+																											TrailingComment: nil,
 																										},
 																										Name: "obj",
 																									},
 																									Property: &ast.Identifier{
 																										BaseNode: ast.BaseNode{
-																											Errors: nil,
+																											Comments: nil,
+																											Errors:   nil,
 																											Loc: &ast.SourceLocation{
 																												End: ast.Position{
 																													Column: 37,
@@ -4239,13 +4726,15 @@ var pkgAST = &ast.Package{
 																													Line:   101,
 																												},
 																											},
+																											TrailingComment: nil,
 																										},
 																										Name: "group",
 																									},
 																								},
 																							}, &ast.Property{
 																								BaseNode: ast.BaseNode{
-																									Errors: nil,
+																									Comments: nil,
+																									Errors:   nil,
 																									Loc: &ast.SourceLocation{
 																										End: ast.Position{
 																											Column: 43,
@@ -4258,10 +4747,12 @@ var pkgAST = &ast.Package{
 																											Line:   102,
 																										},
 																									},
+																									TrailingComment: nil,
 																								},
 																								Key: &ast.Identifier{
 																									BaseNode: ast.BaseNode{
-																										Errors: nil,
+																										Comments: nil,
+																										Errors:   nil,
 																										Loc: &ast.SourceLocation{
 																											End: ast.Position{
 																												Column: 29,
@@ -4274,12 +4765,14 @@ var pkgAST = &ast.Package{
 																												Line:   102,
 																											},
 																										},
+																										TrailingComment: nil,
 																									},
 																									Name: "severity",
 																								},
 																								Value: &ast.MemberExpression{
 																									BaseNode: ast.BaseNode{
-																										Errors: nil,
+																										Comments: nil,
+																										Errors:   nil,
 																										Loc: &ast.SourceLocation{
 																											End: ast.Position{
 																												Column: 43,
@@ -4292,10 +4785,12 @@ var pkgAST = &ast.Package{
 																												Line:   102,
 																											},
 																										},
+																										TrailingComment: nil,
 																									},
 																									Object: &ast.Identifier{
 																										BaseNode: ast.BaseNode{
-																											Errors: nil,
+																											Comments: nil,
+																											Errors:   nil,
 																											Loc: &ast.SourceLocation{
 																												End: ast.Position{
 																													Column: 34,
@@ -4308,12 +4803,14 @@ var pkgAST = &ast.Package{
 																													Line:   102,
 																												},
 																											},
+																											TrailingComment: nil,
 																										},
 																										Name: "obj",
 																									},
 																									Property: &ast.Identifier{
 																										BaseNode: ast.BaseNode{
-																											Errors: nil,
+																											Comments: nil,
+																											Errors:   nil,
 																											Loc: &ast.SourceLocation{
 																												End: ast.Position{
 																													Column: 43,
@@ -4326,13 +4823,15 @@ var pkgAST = &ast.Package{
 																													Line:   102,
 																												},
 																											},
+																											TrailingComment: nil,
 																										},
 																										Name: "severity",
 																									},
 																								},
 																							}, &ast.Property{
 																								BaseNode: ast.BaseNode{
-																									Errors: nil,
+																									Comments: nil,
+																									Errors:   nil,
 																									Loc: &ast.SourceLocation{
 																										End: ast.Position{
 																											Column: 49,
@@ -4345,10 +4844,12 @@ var pkgAST = &ast.Package{
 																											Line:   103,
 																										},
 																									},
+																									TrailingComment: nil,
 																								},
 																								Key: &ast.Identifier{
 																									BaseNode: ast.BaseNode{
-																										Errors: nil,
+																										Comments: nil,
+																										Errors:   nil,
 																										Loc: &ast.SourceLocation{
 																											End: ast.Position{
 																												Column: 32,
@@ -4361,12 +4862,14 @@ var pkgAST = &ast.Package{
 																												Line:   103,
 																											},
 																										},
+																										TrailingComment: nil,
 																									},
 																									Name: "eventAction",
 																								},
 																								Value: &ast.MemberExpression{
 																									BaseNode: ast.BaseNode{
-																										Errors: nil,
+																										Comments: nil,
+																										Errors:   nil,
 																										Loc: &ast.SourceLocation{
 																											End: ast.Position{
 																												Column: 49,
@@ -4379,10 +4882,12 @@ var pkgAST = &ast.Package{
 																												Line:   103,
 																											},
 																										},
+																										TrailingComment: nil,
 																									},
 																									Object: &ast.Identifier{
 																										BaseNode: ast.BaseNode{
-																											Errors: nil,
+																											Comments: nil,
+																											Errors:   nil,
 																											Loc: &ast.SourceLocation{
 																												End: ast.Position{
 																													Column: 37,
@@ -4395,12 +4900,14 @@ var pkgAST = &ast.Package{
 																													Line:   103,
 																												},
 																											},
+																											TrailingComment: nil,
 																										},
 																										Name: "obj",
 																									},
 																									Property: &ast.Identifier{
 																										BaseNode: ast.BaseNode{
-																											Errors: nil,
+																											Comments: nil,
+																											Errors:   nil,
 																											Loc: &ast.SourceLocation{
 																												End: ast.Position{
 																													Column: 49,
@@ -4413,13 +4920,15 @@ var pkgAST = &ast.Package{
 																													Line:   103,
 																												},
 																											},
+																											TrailingComment: nil,
 																										},
 																										Name: "eventAction",
 																									},
 																								},
 																							}, &ast.Property{
 																								BaseNode: ast.BaseNode{
-																									Errors: nil,
+																									Comments: nil,
+																									Errors:   nil,
 																									Loc: &ast.SourceLocation{
 																										End: ast.Position{
 																											Column: 45,
@@ -4432,10 +4941,12 @@ var pkgAST = &ast.Package{
 																											Line:   104,
 																										},
 																									},
+																									TrailingComment: nil,
 																								},
 																								Key: &ast.Identifier{
 																									BaseNode: ast.BaseNode{
-																										Errors: nil,
+																										Comments: nil,
+																										Errors:   nil,
 																										Loc: &ast.SourceLocation{
 																											End: ast.Position{
 																												Column: 30,
@@ -4448,12 +4959,14 @@ var pkgAST = &ast.Package{
 																												Line:   104,
 																											},
 																										},
+																										TrailingComment: nil,
 																									},
 																									Name: "component",
 																								},
 																								Value: &ast.MemberExpression{
 																									BaseNode: ast.BaseNode{
-																										Errors: nil,
+																										Comments: nil,
+																										Errors:   nil,
 																										Loc: &ast.SourceLocation{
 																											End: ast.Position{
 																												Column: 45,
@@ -4466,10 +4979,12 @@ var pkgAST = &ast.Package{
 																												Line:   104,
 																											},
 																										},
+																										TrailingComment: nil,
 																									},
 																									Object: &ast.Identifier{
 																										BaseNode: ast.BaseNode{
-																											Errors: nil,
+																											Comments: nil,
+																											Errors:   nil,
 																											Loc: &ast.SourceLocation{
 																												End: ast.Position{
 																													Column: 35,
@@ -4482,12 +4997,14 @@ var pkgAST = &ast.Package{
 																													Line:   104,
 																												},
 																											},
+																											TrailingComment: nil,
 																										},
 																										Name: "obj",
 																									},
 																									Property: &ast.Identifier{
 																										BaseNode: ast.BaseNode{
-																											Errors: nil,
+																											Comments: nil,
+																											Errors:   nil,
 																											Loc: &ast.SourceLocation{
 																												End: ast.Position{
 																													Column: 45,
@@ -4500,13 +5017,15 @@ var pkgAST = &ast.Package{
 																													Line:   104,
 																												},
 																											},
+																											TrailingComment: nil,
 																										},
 																										Name: "component",
 																									},
 																								},
 																							}, &ast.Property{
 																								BaseNode: ast.BaseNode{
-																									Errors: nil,
+																									Comments: nil,
+																									Errors:   nil,
 																									Loc: &ast.SourceLocation{
 																										End: ast.Position{
 																											Column: 39,
@@ -4519,10 +5038,12 @@ var pkgAST = &ast.Package{
 																											Line:   105,
 																										},
 																									},
+																									TrailingComment: nil,
 																								},
 																								Key: &ast.Identifier{
 																									BaseNode: ast.BaseNode{
-																										Errors: nil,
+																										Comments: nil,
+																										Errors:   nil,
 																										Loc: &ast.SourceLocation{
 																											End: ast.Position{
 																												Column: 27,
@@ -4535,12 +5056,14 @@ var pkgAST = &ast.Package{
 																												Line:   105,
 																											},
 																										},
+																										TrailingComment: nil,
 																									},
 																									Name: "source",
 																								},
 																								Value: &ast.MemberExpression{
 																									BaseNode: ast.BaseNode{
-																										Errors: nil,
+																										Comments: nil,
+																										Errors:   nil,
 																										Loc: &ast.SourceLocation{
 																											End: ast.Position{
 																												Column: 39,
@@ -4553,10 +5076,12 @@ var pkgAST = &ast.Package{
 																												Line:   105,
 																											},
 																										},
+																										TrailingComment: nil,
 																									},
 																									Object: &ast.Identifier{
 																										BaseNode: ast.BaseNode{
-																											Errors: nil,
+																											Comments: nil,
+																											Errors:   nil,
 																											Loc: &ast.SourceLocation{
 																												End: ast.Position{
 																													Column: 32,
@@ -4569,12 +5094,14 @@ var pkgAST = &ast.Package{
 																													Line:   105,
 																												},
 																											},
+																											TrailingComment: nil,
 																										},
 																										Name: "obj",
 																									},
 																									Property: &ast.Identifier{
 																										BaseNode: ast.BaseNode{
-																											Errors: nil,
+																											Comments: nil,
+																											Errors:   nil,
 																											Loc: &ast.SourceLocation{
 																												End: ast.Position{
 																													Column: 39,
@@ -4587,13 +5114,15 @@ var pkgAST = &ast.Package{
 																													Line:   105,
 																												},
 																											},
+																											TrailingComment: nil,
 																										},
 																										Name: "source",
 																									},
 																								},
 																							}, &ast.Property{
 																								BaseNode: ast.BaseNode{
-																									Errors: nil,
+																									Comments: nil,
+																									Errors:   nil,
 																									Loc: &ast.SourceLocation{
 																										End: ast.Position{
 																											Column: 41,
@@ -4606,10 +5135,12 @@ var pkgAST = &ast.Package{
 																											Line:   106,
 																										},
 																									},
+																									TrailingComment: nil,
 																								},
 																								Key: &ast.Identifier{
 																									BaseNode: ast.BaseNode{
-																										Errors: nil,
+																										Comments: nil,
+																										Errors:   nil,
 																										Loc: &ast.SourceLocation{
 																											End: ast.Position{
 																												Column: 28,
@@ -4622,12 +5153,14 @@ var pkgAST = &ast.Package{
 																												Line:   106,
 																											},
 																										},
+																										TrailingComment: nil,
 																									},
 																									Name: "summary",
 																								},
 																								Value: &ast.MemberExpression{
 																									BaseNode: ast.BaseNode{
-																										Errors: nil,
+																										Comments: nil,
+																										Errors:   nil,
 																										Loc: &ast.SourceLocation{
 																											End: ast.Position{
 																												Column: 41,
@@ -4640,10 +5173,12 @@ var pkgAST = &ast.Package{
 																												Line:   106,
 																											},
 																										},
+																										TrailingComment: nil,
 																									},
 																									Object: &ast.Identifier{
 																										BaseNode: ast.BaseNode{
-																											Errors: nil,
+																											Comments: nil,
+																											Errors:   nil,
 																											Loc: &ast.SourceLocation{
 																												End: ast.Position{
 																													Column: 33,
@@ -4656,12 +5191,14 @@ var pkgAST = &ast.Package{
 																													Line:   106,
 																												},
 																											},
+																											TrailingComment: nil,
 																										},
 																										Name: "obj",
 																									},
 																									Property: &ast.Identifier{
 																										BaseNode: ast.BaseNode{
-																											Errors: nil,
+																											Comments: nil,
+																											Errors:   nil,
 																											Loc: &ast.SourceLocation{
 																												End: ast.Position{
 																													Column: 41,
@@ -4674,13 +5211,15 @@ var pkgAST = &ast.Package{
 																													Line:   106,
 																												},
 																											},
+																											TrailingComment: nil,
 																										},
 																										Name: "summary",
 																									},
 																								},
 																							}, &ast.Property{
 																								BaseNode: ast.BaseNode{
-																									Errors: nil,
+																									Comments: nil,
+																									Errors:   nil,
 																									Loc: &ast.SourceLocation{
 																										End: ast.Position{
 																											Column: 45,
@@ -4693,10 +5232,12 @@ var pkgAST = &ast.Package{
 																											Line:   107,
 																										},
 																									},
+																									TrailingComment: nil,
 																								},
 																								Key: &ast.Identifier{
 																									BaseNode: ast.BaseNode{
-																										Errors: nil,
+																										Comments: nil,
+																										Errors:   nil,
 																										Loc: &ast.SourceLocation{
 																											End: ast.Position{
 																												Column: 30,
@@ -4709,12 +5250,14 @@ var pkgAST = &ast.Package{
 																												Line:   107,
 																											},
 																										},
+																										TrailingComment: nil,
 																									},
 																									Name: "timestamp",
 																								},
 																								Value: &ast.MemberExpression{
 																									BaseNode: ast.BaseNode{
-																										Errors: nil,
+																										Comments: nil,
+																										Errors:   nil,
 																										Loc: &ast.SourceLocation{
 																											End: ast.Position{
 																												Column: 45,
@@ -4727,10 +5270,12 @@ var pkgAST = &ast.Package{
 																												Line:   107,
 																											},
 																										},
+																										TrailingComment: nil,
 																									},
 																									Object: &ast.Identifier{
 																										BaseNode: ast.BaseNode{
-																											Errors: nil,
+																											Comments: nil,
+																											Errors:   nil,
 																											Loc: &ast.SourceLocation{
 																												End: ast.Position{
 																													Column: 35,
@@ -4743,12 +5288,14 @@ var pkgAST = &ast.Package{
 																													Line:   107,
 																												},
 																											},
+																											TrailingComment: nil,
 																										},
 																										Name: "obj",
 																									},
 																									Property: &ast.Identifier{
 																										BaseNode: ast.BaseNode{
-																											Errors: nil,
+																											Comments: nil,
+																											Errors:   nil,
 																											Loc: &ast.SourceLocation{
 																												End: ast.Position{
 																													Column: 45,
@@ -4761,6 +5308,7 @@ var pkgAST = &ast.Package{
 																													Line:   107,
 																												},
 																											},
+																											TrailingComment: nil,
 																										},
 																										Name: "timestamp",
 																									},
@@ -4769,7 +5317,8 @@ var pkgAST = &ast.Package{
 																							With: nil,
 																						}},
 																						BaseNode: ast.BaseNode{
-																							Errors: nil,
+																							Comments: nil,
+																							Errors:   nil,
 																							Loc: &ast.SourceLocation{
 																								End: ast.Position{
 																									Column: 18,
@@ -4782,10 +5331,12 @@ var pkgAST = &ast.Package{
 																									Line:   95,
 																								},
 																							},
+																							TrailingComment: nil,
 																						},
 																						Callee: &ast.Identifier{
 																							BaseNode: ast.BaseNode{
-																								Errors: nil,
+																								Comments: nil,
+																								Errors:   nil,
 																								Loc: &ast.SourceLocation{
 																									End: ast.Position{
 																										Column: 64,
@@ -4798,6 +5349,7 @@ var pkgAST = &ast.Package{
 																										Line:   95,
 																									},
 																								},
+																								TrailingComment: nil,
 																							},
 																							Name: "sendEvent",
 																						},
@@ -4805,7 +5357,8 @@ var pkgAST = &ast.Package{
 																					Operator: 2,
 																					Right: &ast.IntegerLiteral{
 																						BaseNode: ast.BaseNode{
-																							Errors: nil,
+																							Comments: nil,
+																							Errors:   nil,
 																							Loc: &ast.SourceLocation{
 																								End: ast.Position{
 																									Column: 24,
@@ -4818,6 +5371,7 @@ var pkgAST = &ast.Package{
 																									Line:   108,
 																								},
 																							},
+																							TrailingComment: nil,
 																						},
 																						Value: int64(100),
 																					},
@@ -4828,7 +5382,8 @@ var pkgAST = &ast.Package{
 																	With: nil,
 																}},
 																BaseNode: ast.BaseNode{
-																	Errors: nil,
+																	Comments: nil,
+																	Errors:   nil,
 																	Loc: &ast.SourceLocation{
 																		End: ast.Position{
 																			Column: 26,
@@ -4841,10 +5396,12 @@ var pkgAST = &ast.Package{
 																			Line:   95,
 																		},
 																	},
+																	TrailingComment: nil,
 																},
 																Callee: &ast.Identifier{
 																	BaseNode: ast.BaseNode{
-																		Errors: nil,
+																		Comments: nil,
+																		Errors:   nil,
 																		Loc: &ast.SourceLocation{
 																			End: ast.Position{
 																				Column: 45,
@@ -4857,6 +5414,7 @@ var pkgAST = &ast.Package{
 																				Line:   95,
 																			},
 																		},
+																		TrailingComment: nil,
 																	},
 																	Name: "string",
 																},
@@ -4864,7 +5422,8 @@ var pkgAST = &ast.Package{
 														}},
 														With: &ast.Identifier{
 															BaseNode: ast.BaseNode{
-																Errors: nil,
+																Comments: nil,
+																Errors:   nil,
 																Loc: &ast.SourceLocation{
 																	End: ast.Position{
 																		Column: 26,
@@ -4877,12 +5436,14 @@ var pkgAST = &ast.Package{
 																		Line:   95,
 																	},
 																},
+																TrailingComment: nil,
 															},
 															Name: "r",
 														},
 													},
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 27,
@@ -4895,12 +5456,14 @@ var pkgAST = &ast.Package{
 																Line:   95,
 															},
 														},
+														TrailingComment: nil,
 													},
 												}},
 											},
 											Params: []*ast.Property{&ast.Property{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 26,
@@ -4913,10 +5476,12 @@ var pkgAST = &ast.Package{
 															Line:   92,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Key: &ast.Identifier{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 26,
@@ -4929,6 +5494,7 @@ var pkgAST = &ast.Package{
 																Line:   92,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Name: "r",
 												},
@@ -4939,7 +5505,8 @@ var pkgAST = &ast.Package{
 									With: nil,
 								}},
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 15,
@@ -4952,10 +5519,12 @@ var pkgAST = &ast.Package{
 											Line:   92,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Callee: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 19,
@@ -4968,6 +5537,7 @@ var pkgAST = &ast.Package{
 												Line:   92,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "map",
 								},
@@ -4975,7 +5545,8 @@ var pkgAST = &ast.Package{
 						},
 						Params: []*ast.Property{&ast.Property{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 19,
@@ -4988,10 +5559,12 @@ var pkgAST = &ast.Package{
 										Line:   90,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Key: &ast.Identifier{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 16,
@@ -5004,11 +5577,13 @@ var pkgAST = &ast.Package{
 											Line:   90,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Name: "tables",
 							},
 							Value: &ast.PipeLiteral{BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 19,
@@ -5021,12 +5596,14 @@ var pkgAST = &ast.Package{
 										Line:   90,
 									},
 								},
+								TrailingComment: nil,
 							}},
 						}},
 					},
 					Params: []*ast.Property{&ast.Property{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 11,
@@ -5039,10 +5616,12 @@ var pkgAST = &ast.Package{
 									Line:   89,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Key: &ast.Identifier{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 11,
@@ -5055,6 +5634,7 @@ var pkgAST = &ast.Package{
 										Line:   89,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Name: "mapFn",
 						},
@@ -5063,7 +5643,8 @@ var pkgAST = &ast.Package{
 				},
 				Params: []*ast.Property{&ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 27,
@@ -5076,10 +5657,12 @@ var pkgAST = &ast.Package{
 								Line:   88,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 16,
@@ -5092,12 +5675,14 @@ var pkgAST = &ast.Package{
 									Line:   88,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Name: "url",
 					},
 					Value: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 27,
@@ -5110,16 +5695,19 @@ var pkgAST = &ast.Package{
 									Line:   88,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Name: "defaultURL",
 					},
 				}},
 			},
 		}},
+		Eof: nil,
 		Imports: []*ast.ImportDeclaration{&ast.ImportDeclaration{
 			As: nil,
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 14,
@@ -5132,10 +5720,12 @@ var pkgAST = &ast.Package{
 						Line:   3,
 					},
 				},
+				TrailingComment: nil,
 			},
 			Path: &ast.StringLiteral{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 14,
@@ -5148,13 +5738,15 @@ var pkgAST = &ast.Package{
 							Line:   3,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Value: "http",
 			},
 		}, &ast.ImportDeclaration{
 			As: nil,
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 14,
@@ -5167,10 +5759,12 @@ var pkgAST = &ast.Package{
 						Line:   4,
 					},
 				},
+				TrailingComment: nil,
 			},
 			Path: &ast.StringLiteral{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 14,
@@ -5183,13 +5777,15 @@ var pkgAST = &ast.Package{
 							Line:   4,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Value: "json",
 			},
 		}, &ast.ImportDeclaration{
 			As: nil,
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 17,
@@ -5202,10 +5798,12 @@ var pkgAST = &ast.Package{
 						Line:   5,
 					},
 				},
+				TrailingComment: nil,
 			},
 			Path: &ast.StringLiteral{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 17,
@@ -5218,6 +5816,7 @@ var pkgAST = &ast.Package{
 							Line:   5,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Value: "strings",
 			},
@@ -5226,7 +5825,8 @@ var pkgAST = &ast.Package{
 		Name:     "pagerduty.flux",
 		Package: &ast.PackageClause{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 18,
@@ -5239,10 +5839,12 @@ var pkgAST = &ast.Package{
 						Line:   1,
 					},
 				},
+				TrailingComment: nil,
 			},
 			Name: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 18,
@@ -5255,6 +5857,7 @@ var pkgAST = &ast.Package{
 							Line:   1,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "pagerduty",
 			},
@@ -0,0 +1,226 @@
+package parquet
+
+import (
+	"context"
+
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/arrow"
+	"github.com/influxdata/flux/codes"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/internal/columnar"
+	"github.com/influxdata/flux/internal/errors"
+	"github.com/influxdata/flux/internal/parquet"
+	"github.com/influxdata/flux/interpreter"
+	"github.com/influxdata/flux/memory"
+	"github.com/influxdata/flux/plan"
+	"github.com/influxdata/flux/semantic"
+)
+
+const FromParquetKind = "fromParquet"
+
+type FromParquetOpSpec struct {
+	File    string   `json:"file"`
+	Columns []string `json:"columns,omitempty"`
+	// GroupKey is the list of columns the tables are grouped by.
+	// When it is nil, the group key stored in the file is used.
+	GroupKey []string `json:"groupKey"`
+}
+
+func init() {
+	fromParquetSignature := semantic.FunctionPolySignature{
+		Parameters: map[string]semantic.PolyType{
+			"file":     semantic.String,
+			"columns":  semantic.NewArrayPolyType(semantic.String),
+			"groupKey": semantic.NewArrayPolyType(semantic.String),
+		},
+		Required: semantic.LabelSet{"file"},
+		Return:   flux.TableObjectType,
+	}
+	flux.RegisterPackageValue("parquet", "from", flux.FunctionValue(FromParquetKind, createFromParquetOpSpec, fromParquetSignature))
+	flux.RegisterOpSpec(FromParquetKind, newFromParquetOp)
+	plan.RegisterProcedureSpec(FromParquetKind, newFromParquetProcedure, FromParquetKind)
+	plan.RegisterPhysicalRules(columnar.KeepRule{RuleName: "MergeParquetKeepRule", Source: FromParquetKind})
+	execute.RegisterSource(FromParquetKind, createFromParquetSource)
+}
+
+func createFromParquetOpSpec(args flux.Arguments, a *flux.Administration) (flux.OperationSpec, error) {
+	spec := new(FromParquetOpSpec)
+
+	file, err := args.GetRequiredString("file")
+	if err != nil {
+		return nil, err
+	}
+	if file == "" {
+		return nil, errors.New(codes.Invalid, "must provide a file name")
+	}
+	spec.File = file
+
+	if cols, ok, err := args.GetArray("columns", semantic.String); err != nil {
+		return nil, err
+	} else if ok {
+		if spec.Columns, err = interpreter.ToStringArray(cols); err != nil {
+			return nil, err
+		}
+	}
+
+	if key, ok, err := args.GetArray("groupKey", semantic.String); err != nil {
+		return nil, err
+	} else if ok {
+		if spec.GroupKey, err = interpreter.ToStringArray(key); err != nil {
+			return nil, err
+		}
+		if spec.GroupKey == nil {
+			spec.GroupKey = []string{}
+		}
+	}
+	return spec, nil
+}
+
+func newFromParquetOp() flux.OperationSpec {
+	return new(FromParquetOpSpec)
+}
+
+func (s *FromParquetOpSpec) Kind() flux.OperationKind {
+	return FromParquetKind
+}
+
+type FromParquetProcedureSpec struct {
+	plan.DefaultCost
+	File     string
+	Columns  []string
+	GroupKey []string
+}
+
+func newFromParquetProcedure(qs flux.OperationSpec, pa plan.Administration) (plan.ProcedureSpec, error) {
+	spec, ok := qs.(*FromParquetOpSpec)
+	if !ok {
+		return nil, errors.Newf(codes.Internal, "invalid spec type %T", qs)
+	}
+	return &FromParquetProcedureSpec{
+		File:     spec.File,
+		Columns:  spec.Columns,
+		GroupKey: spec.GroupKey,
+	}, nil
+}
+
+func (s *FromParquetProcedureSpec) Kind() plan.ProcedureKind {
+	return FromParquetKind
+}
+
+func (s *FromParquetProcedureSpec) Copy() plan.ProcedureSpec {
+	ns := *s
+	ns.Columns = append([]string(nil), s.Columns...)
+	if s.GroupKey != nil {
+		ns.GroupKey = append([]string{}, s.GroupKey...)
+	}
+	return &ns
+}
+
+// Project implements columnar.Projector.
+func (s *FromParquetProcedureSpec) Project(columns []string) (plan.PhysicalProcedureSpec, bool) {
+	cols, ok := columnar.ProjectColumns(s.Columns, s.GroupKey, columns)
+	if !ok {
+		return nil, false
+	}
+	ns := s.Copy().(*FromParquetProcedureSpec)
+	ns.Columns = cols
+	return ns, true
+}
+
+func createFromParquetSource(prSpec plan.ProcedureSpec, dsid execute.DatasetID, a execute.Administration) (execute.Source, error) {
+	spec, ok := prSpec.(*FromParquetProcedureSpec)
+	if !ok {
+		return nil, errors.Newf(codes.Internal, "invalid spec type %T", prSpec)
+	}
+	iterator := &parquetIterator{
+		spec:  spec,
+		deps:  flux.GetDependencies(a.Context()),
+		alloc: a.Allocator(),
+	}
+	return execute.CreateSourceFromIterator(iterator, dsid)
+}
+
+var _ execute.SourceIterator = (*parquetIterator)(nil)
+
+// parquetIterator reads the tables of a parquet file
+// through the filesystem service.
+type parquetIterator struct {
+	spec  *FromParquetProcedureSpec
+	deps  flux.Dependencies
+	alloc *memory.Allocator
+}
+
+func (s *parquetIterator) Do(ctx context.Context, f func(flux.Table) error) error {
+	tables, err := s.readTables()
+	if err != nil {
+		return errors.Wrap(err, codes.Inherit, "error in parquet.from()")
+	}
+	for i, tbl := range tables {
+		if err := f(tbl); err != nil {
+			// The tables that were not processed are still owned by the source.
+			for _, tbl := range tables[i+1:] {
+				tbl.Done()
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *parquetIterator) readTables() ([]flux.Table, error) {
+	fs, err := s.deps.FilesystemService()
+	if err != nil {
+		return nil, err
+	}
+	f, size, err := columnar.OpenFile(fs, s.spec.File)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = f.Close() }()
+
+	pf, err := parquet.Open(columnar.NewReaderAt(f), size)
+	if err != nil {
+		return nil, errors.Wrapf(err, codes.Inherit, "failed to read %q", s.spec.File)
+	}
+
+	names := make([]string, len(pf.Columns()))
+	for i, c := range pf.Columns() {
+		names[i] = c.Name
+	}
+	idx := columnar.Project(names, s.spec.Columns)
+	cols := make([]flux.ColMeta, len(idx))
+	for i, j := range idx {
+		c := pf.Columns()[j]
+		if c.Type == flux.TInvalid {
+			return nil, errors.Newf(codes.Unimplemented, "column %q has a parquet type that is not supported; use columns to skip it", c.Name)
+		}
+		cols[i] = flux.ColMeta{Label: c.Name, Type: c.Type}
+	}
+	md, hasMD := pf.Metadata(columnar.GroupKeyMetadataKey)
+	key, err := columnar.GroupKeyColumns(cols, s.spec.GroupKey, s.spec.GroupKey != nil, md, hasMD)
+	if err != nil {
+		return nil, err
+	}
+
+	buffers := make([]*arrow.TableBuffer, 0, pf.NumRowGroups())
+	for rg := 0; rg < pf.NumRowGroups(); rg++ {
+		buf := &arrow.TableBuffer{
+			Columns: cols,
+			Values:  make([]array.Interface, 0, len(cols)),
+		}
+		for _, j := range idx {
+			vs, err := pf.ReadColumn(rg, j, s.alloc)
+			if err != nil {
+				buf.Release()
+				for _, buf := range buffers {
+					buf.Release()
+				}
+				return nil, err
+			}
+			buf.Values = append(buf.Values, vs)
+		}
+		buffers = append(buffers, buf)
+	}
+	return columnar.Tables(buffers, cols, key, s.alloc)
+}
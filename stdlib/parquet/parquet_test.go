@@ -0,0 +1,337 @@
+package parquet_test
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/influxdata/flux"
+	_ "github.com/influxdata/flux/builtin"
+	"github.com/influxdata/flux/dependencies/dependenciestest"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/execute/executetest"
+	"github.com/influxdata/flux/internal/columnar"
+	"github.com/influxdata/flux/interpreter"
+	"github.com/influxdata/flux/lang/langtest"
+	"github.com/influxdata/flux/plan"
+	"github.com/influxdata/flux/plan/plantest"
+	"github.com/influxdata/flux/semantic"
+	"github.com/influxdata/flux/stdlib/parquet"
+	"github.com/influxdata/flux/stdlib/universe"
+	"github.com/influxdata/flux/values"
+)
+
+const data = `
+#datatype,string,long,dateTime:RFC3339,string,string,double,long
+#group,false,false,false,true,true,false,false
+#default,_result,,,,,,
+,result,table,_time,_measurement,host,_value,count
+,,0,2019-11-01T00:00:00Z,cpu,a,1.5,1
+,,0,2019-11-01T00:00:10Z,cpu,a,2.5,
+,,1,2019-11-01T00:00:00Z,cpu,b,3.5,3
+`
+
+// runQuery executes the script and returns the tables for every result.
+func runQuery(t *testing.T, script string) ([]*executetest.Table, error) {
+	t.Helper()
+	ctx := dependenciestest.Default().Inject(context.Background())
+	tables, _, err := langtest.RunScript(ctx, script, time.Now())
+	return tables, err
+}
+
+func TestParquet_RoundTrip(t *testing.T) {
+	dir, err := ioutil.TempDir("", "flux-parquet")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = os.RemoveAll(dir) }()
+	file := strconv.Quote(filepath.Join(dir, "data.parquet"))
+
+	written, err := runQuery(t, `
+import "csv"
+import "parquet"
+
+csv.from(csv: `+strconv.Quote(data)+`) |> parquet.to(file: `+file+`)`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(written) != 2 {
+		t.Fatalf("parquet.to should pass its tables through, got %d tables", len(written))
+	}
+
+	cols := []flux.ColMeta{
+		{Label: "_time", Type: flux.TTime},
+		{Label: "_measurement", Type: flux.TString},
+		{Label: "host", Type: flux.TString},
+		{Label: "_value", Type: flux.TFloat},
+		{Label: "count", Type: flux.TInt},
+	}
+	ts := func(sec int64) values.Time {
+		return values.Time(time.Date(2019, 11, 1, 0, 0, 0, 0, time.UTC).UnixNano() + sec*1e9)
+	}
+	for _, tc := range []struct {
+		name    string
+		script  string
+		want    []*executetest.Table
+		wantErr string
+	}{
+		{
+			name:   "stored group key",
+			script: `parquet.from(file: ` + file + `)`,
+			want: []*executetest.Table{
+				{
+					KeyCols: []string{"_measurement", "host"},
+					ColMeta: cols,
+					Data: [][]interface{}{
+						{ts(0), "cpu", "a", 1.5, int64(1)},
+						{ts(10), "cpu", "a", 2.5, nil},
+					},
+				},
+				{
+					KeyCols: []string{"_measurement", "host"},
+					ColMeta: cols,
+					Data: [][]interface{}{
+						{ts(0), "cpu", "b", 3.5, int64(3)},
+					},
+				},
+			},
+		},
+		{
+			name:   "columns and group key",
+			script: `parquet.from(file: ` + file + `, columns: ["_measurement", "_value"], groupKey: ["_measurement"])`,
+			want: []*executetest.Table{
+				{
+					KeyCols: []string{"_measurement"},
+					ColMeta: []flux.ColMeta{
+						{Label: "_measurement", Type: flux.TString},
+						{Label: "_value", Type: flux.TFloat},
+					},
+					Data: [][]interface{}{
+						{"cpu", 1.5},
+						{"cpu", 2.5},
+						{"cpu", 3.5},
+					},
+				},
+			},
+		},
+		{
+			name:   "empty group key",
+			script: `parquet.from(file: ` + file + `, columns: ["host", "count"], groupKey: [])`,
+			want: []*executetest.Table{
+				{
+					ColMeta: []flux.ColMeta{
+						{Label: "host", Type: flux.TString},
+						{Label: "count", Type: flux.TInt},
+					},
+					Data: [][]interface{}{
+						{"a", int64(1)},
+						{"a", nil},
+						{"b", int64(3)},
+					},
+				},
+			},
+		},
+		{
+			name:   "keep is pushed into the source",
+			script: `parquet.from(file: ` + file + `) |> keep(columns: ["host", "_value"])`,
+			want: []*executetest.Table{
+				{
+					KeyCols: []string{"host"},
+					ColMeta: []flux.ColMeta{
+						{Label: "host", Type: flux.TString},
+						{Label: "_value", Type: flux.TFloat},
+					},
+					Data: [][]interface{}{
+						{"a", 1.5},
+						{"a", 2.5},
+					},
+				},
+				{
+					KeyCols: []string{"host"},
+					ColMeta: []flux.ColMeta{
+						{Label: "host", Type: flux.TString},
+						{Label: "_value", Type: flux.TFloat},
+					},
+					Data: [][]interface{}{
+						{"b", 3.5},
+					},
+				},
+			},
+		},
+		{
+			name:    "missing group key column",
+			script:  `parquet.from(file: ` + file + `, columns: ["_value"], groupKey: ["host"])`,
+			wantErr: `error in parquet.from(): group key column "host" does not exist`,
+		},
+		{
+			name:    "missing file",
+			script:  `parquet.from(file: ` + strconv.Quote(filepath.Join(dir, "missing.parquet")) + `)`,
+			wantErr: "error in parquet.from(): failed to open",
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := runQuery(t, "import \"parquet\"\n"+tc.script)
+			if tc.wantErr != "" {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				if got := err.Error(); len(got) < len(tc.wantErr) || got[:len(tc.wantErr)] != tc.wantErr {
+					t.Fatalf("unexpected error -want/+got:\n\t- %s\n\t+ %s", tc.wantErr, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			executetest.NormalizeTables(tc.want)
+			if !cmp.Equal(tc.want, got) {
+				t.Errorf("unexpected tables -want/+got:\n%s", cmp.Diff(tc.want, got))
+			}
+		})
+	}
+}
+
+func TestMergeParquetKeepRule(t *testing.T) {
+	from := func(columns, groupKey []string) *parquet.FromParquetProcedureSpec {
+		return &parquet.FromParquetProcedureSpec{
+			File:     "data.parquet",
+			Columns:  columns,
+			GroupKey: groupKey,
+		}
+	}
+	keep := func(columns ...string) *universe.SchemaMutationProcedureSpec {
+		return &universe.SchemaMutationProcedureSpec{
+			Mutations: []universe.SchemaMutation{
+				&universe.KeepOpSpec{Columns: columns},
+			},
+		}
+	}
+	drop := &universe.SchemaMutationProcedureSpec{
+		Mutations: []universe.SchemaMutation{
+			&universe.DropOpSpec{Columns: []string{"host"}},
+		},
+	}
+	keepFn := &universe.SchemaMutationProcedureSpec{
+		Mutations: []universe.SchemaMutation{
+			&universe.KeepOpSpec{
+				Columns: []string{},
+				Predicate: interpreter.ResolvedFunction{
+					Fn: &semantic.FunctionExpression{Block: &semantic.FunctionBlock{Body: &semantic.BooleanLiteral{Value: true}}},
+				},
+			},
+		},
+	}
+	count := &universe.CountProcedureSpec{
+		AggregateConfig: execute.DefaultAggregateConfig,
+	}
+	rules := []plan.Rule{columnar.KeepRule{RuleName: "MergeParquetKeepRule", Source: parquet.FromParquetKind}}
+
+	tests := []plantest.RuleTestCase{
+		{
+			Name:  "keep",
+			Rules: rules,
+			Before: &plantest.PlanSpec{
+				Nodes: []plan.Node{
+					plan.CreatePhysicalNode("from", from(nil, nil)),
+					plan.CreatePhysicalNode("keep", keep("host", "_value")),
+				},
+				Edges: [][2]int{{0, 1}},
+			},
+			After: &plantest.PlanSpec{
+				Nodes: []plan.Node{
+					plan.CreatePhysicalNode("merged_from_keep", from([]string{"host", "_value"}, nil)),
+				},
+			},
+		},
+		{
+			Name:  "keep with columns",
+			Rules: rules,
+			Before: &plantest.PlanSpec{
+				Nodes: []plan.Node{
+					plan.CreatePhysicalNode("from", from([]string{"_time", "host", "_value"}, []string{"host"})),
+					plan.CreatePhysicalNode("keep", keep("host", "_value", "other")),
+				},
+				Edges: [][2]int{{0, 1}},
+			},
+			After: &plantest.PlanSpec{
+				Nodes: []plan.Node{
+					plan.CreatePhysicalNode("merged_from_keep", from([]string{"host", "_value"}, []string{"host"})),
+				},
+			},
+		},
+		{
+			Name:  "keep drops group key",
+			Rules: rules,
+			Before: &plantest.PlanSpec{
+				Nodes: []plan.Node{
+					plan.CreatePhysicalNode("from", from(nil, []string{"host"})),
+					plan.CreatePhysicalNode("keep", keep("_value")),
+				},
+				Edges: [][2]int{{0, 1}},
+			},
+			NoChange: true,
+		},
+		{
+			Name:  "keep nothing",
+			Rules: rules,
+			Before: &plantest.PlanSpec{
+				Nodes: []plan.Node{
+					plan.CreatePhysicalNode("from", from([]string{"_value"}, nil)),
+					plan.CreatePhysicalNode("keep", keep("host")),
+				},
+				Edges: [][2]int{{0, 1}},
+			},
+			NoChange: true,
+		},
+		{
+			Name:  "keep predicate",
+			Rules: rules,
+			Before: &plantest.PlanSpec{
+				Nodes: []plan.Node{
+					plan.CreatePhysicalNode("from", from(nil, nil)),
+					plan.CreatePhysicalNode("keep", keepFn),
+				},
+				Edges: [][2]int{{0, 1}},
+			},
+			NoChange: true,
+		},
+		{
+			Name:  "drop",
+			Rules: rules,
+			Before: &plantest.PlanSpec{
+				Nodes: []plan.Node{
+					plan.CreatePhysicalNode("from", from(nil, nil)),
+					plan.CreatePhysicalNode("drop", drop),
+				},
+				Edges: [][2]int{{0, 1}},
+			},
+			NoChange: true,
+		},
+		{
+			Name:  "multiple successors",
+			Rules: rules,
+			Before: &plantest.PlanSpec{
+				Nodes: []plan.Node{
+					plan.CreatePhysicalNode("from", from(nil, nil)),
+					plan.CreatePhysicalNode("keep", keep("host")),
+					plan.CreatePhysicalNode("count", count),
+				},
+				Edges: [][2]int{{0, 1}, {0, 2}},
+			},
+			NoChange: true,
+		},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			plantest.PhysicalRuleTestHelper(t, &tc)
+		})
+	}
+}
@@ -0,0 +1,98 @@
+package parquet
+
+import (
+	"io"
+
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/codes"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/internal/columnar"
+	"github.com/influxdata/flux/internal/errors"
+	"github.com/influxdata/flux/internal/parquet"
+	"github.com/influxdata/flux/memory"
+	"github.com/influxdata/flux/plan"
+	"github.com/influxdata/flux/semantic"
+)
+
+const ToParquetKind = "toParquet"
+
+type ToParquetOpSpec struct {
+	File string `json:"file"`
+}
+
+func init() {
+	toParquetSignature := flux.FunctionSignature(
+		map[string]semantic.PolyType{
+			"file": semantic.String,
+		},
+		[]string{"file"},
+	)
+	flux.RegisterPackageValue("parquet", "to", flux.FunctionValueWithSideEffect(ToParquetKind, createToParquetOpSpec, toParquetSignature))
+	flux.RegisterOpSpec(ToParquetKind, func() flux.OperationSpec { return &ToParquetOpSpec{} })
+	plan.RegisterProcedureSpecWithSideEffect(ToParquetKind, newToParquetProcedure, ToParquetKind)
+	execute.RegisterTransformation(ToParquetKind, createToParquetTransformation)
+}
+
+func createToParquetOpSpec(args flux.Arguments, a *flux.Administration) (flux.OperationSpec, error) {
+	if err := a.AddParentFromArgs(args); err != nil {
+		return nil, err
+	}
+	file, err := args.GetRequiredString("file")
+	if err != nil {
+		return nil, err
+	}
+	if file == "" {
+		return nil, errors.New(codes.Invalid, "must provide a file name")
+	}
+	return &ToParquetOpSpec{File: file}, nil
+}
+
+func (ToParquetOpSpec) Kind() flux.OperationKind {
+	return ToParquetKind
+}
+
+type ToParquetProcedureSpec struct {
+	plan.DefaultCost
+	File string
+}
+
+func newToParquetProcedure(qs flux.OperationSpec, a plan.Administration) (plan.ProcedureSpec, error) {
+	spec, ok := qs.(*ToParquetOpSpec)
+	if !ok {
+		return nil, errors.Newf(codes.Internal, "invalid spec type %T", qs)
+	}
+	return &ToParquetProcedureSpec{File: spec.File}, nil
+}
+
+func (s *ToParquetProcedureSpec) Kind() plan.ProcedureKind {
+	return ToParquetKind
+}
+
+func (s *ToParquetProcedureSpec) Copy() plan.ProcedureSpec {
+	ns := *s
+	return &ns
+}
+
+func createToParquetTransformation(id execute.DatasetID, mode execute.AccumulationMode, spec plan.ProcedureSpec, a execute.Administration) (execute.Transformation, execute.Dataset, error) {
+	s, ok := spec.(*ToParquetProcedureSpec)
+	if !ok {
+		return nil, nil, errors.Newf(codes.Internal, "invalid spec type %T", spec)
+	}
+	deps := flux.GetDependencies(a.Context())
+	t, d := columnar.NewWriteTransformation(id, deps, a.Allocator(), s.File, writeParquet)
+	return t, d, nil
+}
+
+// writeParquet writes each table as a row group of the file.
+func writeParquet(w io.WriteSeeker, c *columnar.Collector, mem *memory.Allocator) error {
+	pw, err := parquet.NewWriter(w, c.Columns(), map[string]string{
+		columnar.GroupKeyMetadataKey: columnar.EncodeGroupKey(c.GroupKey()),
+	})
+	if err != nil {
+		return err
+	}
+	if err := c.Tables(mem, pw.WriteRowGroup); err != nil {
+		return err
+	}
+	return pw.Close()
+}
@@ -13,12 +13,15 @@ func init() {
 
 var pkgAST = &ast.Package{
 	BaseNode: ast.BaseNode{
-		Errors: nil,
-		Loc:    nil,
+		Comments:        nil,
+		Errors:          nil,
+		Loc:             nil,
+		TrailingComment: nil,
 	},
 	Files: []*ast.File{&ast.File{
 		BaseNode: ast.BaseNode{
-			Errors: nil,
+			Comments: nil,
+			Errors:   nil,
 			Loc: &ast.SourceLocation{
 				End: ast.Position{
 					Column: 15,
@@ -31,10 +34,12 @@ var pkgAST = &ast.Package{
 					Line:   1,
 				},
 			},
+			TrailingComment: nil,
 		},
 		Body: []ast.Statement{&ast.BuiltinStatement{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 28,
@@ -47,10 +52,12 @@ var pkgAST = &ast.Package{
 						Line:   6,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 28,
@@ -63,13 +70,15 @@ var pkgAST = &ast.Package{
 							Line:   6,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "validateColorString",
 			},
 		}, &ast.OptionStatement{
 			Assignment: &ast.VariableAssignment{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 61,
@@ -82,10 +91,12 @@ var pkgAST = &ast.Package{
 							Line:   8,
 						},
 					},
+					TrailingComment: nil,
 				},
 				ID: &ast.Identifier{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 18,
@@ -98,12 +109,14 @@ var pkgAST = &ast.Package{
 								Line:   8,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Name: "defaultURL",
 				},
 				Init: &ast.StringLiteral{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 61,
@@ -116,12 +129,14 @@ var pkgAST = &ast.Package{
 								Line:   8,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Value: "https://slack.com/api/chat.postMessage",
 				},
 			},
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 61,
@@ -134,10 +149,12 @@ var pkgAST = &ast.Package{
 						Line:   8,
 					},
 				},
+				TrailingComment: nil,
 			},
 		}, &ast.VariableAssignment{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: []ast.Comment{ast.Comment{Text: "// `message` sends a single message to a Slack channel. It will work either with the chat.postMessage API or with a slack webhook."}, ast.Comment{Text: "// `url` - string - URL of the slack endpoint. Defaults to: \"https://slack.com/api/chat.postMessage\", if one uses the webhook api this must be acquired as part of the slack API setup. This URL will be secret. Don't worry about secrets for the initial implementation."}, ast.Comment{Text: "// `token` - string - the api token string.  Defaults to: \"\", and can be ignored if one uses the webhook api URL."}, ast.Comment{Text: "// `username` - string - Username posting the message."}, ast.Comment{Text: "// `channel` - string - Name of channel in which to post the message. No default."}, ast.Comment{Text: "// `workspace` - string - Name of the slack workspace to use if there are multiple. Defaults to empty string."}, ast.Comment{Text: "// `text` - string - The text to display."}, ast.Comment{Text: "// `iconEmoji` - string - Name of : enclose emoji to use as image of user when posting message, will not show as the avatar icon with a slack webhook."}, ast.Comment{Text: "// `color` - string - Color to give message: one of good, warning, and danger, or any hex rgb color value ex. #439FE0."}},
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 2,
@@ -150,10 +167,12 @@ var pkgAST = &ast.Package{
 						Line:   19,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 8,
@@ -166,12 +185,14 @@ var pkgAST = &ast.Package{
 							Line:   19,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "message",
 			},
 			Init: &ast.FunctionExpression{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 2,
@@ -184,10 +205,12 @@ var pkgAST = &ast.Package{
 							Line:   19,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Body: &ast.Block{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 2,
@@ -200,10 +223,12 @@ var pkgAST = &ast.Package{
 								Line:   19,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Body: []ast.Statement{&ast.VariableAssignment{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 7,
@@ -216,10 +241,12 @@ var pkgAST = &ast.Package{
 									Line:   20,
 								},
 							},
+							TrailingComment: nil,
 						},
 						ID: &ast.Identifier{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 16,
@@ -232,12 +259,14 @@ var pkgAST = &ast.Package{
 										Line:   20,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Name: "attachments",
 						},
 						Init: &ast.ArrayExpression{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 7,
@@ -250,10 +279,12 @@ var pkgAST = &ast.Package{
 										Line:   20,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Elements: []ast.Expression{&ast.ObjectExpression{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 6,
@@ -266,10 +297,12 @@ var pkgAST = &ast.Package{
 											Line:   20,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Properties: []*ast.Property{&ast.Property{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 42,
@@ -282,10 +315,12 @@ var pkgAST = &ast.Package{
 												Line:   21,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Key: &ast.Identifier{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 14,
@@ -298,13 +333,15 @@ var pkgAST = &ast.Package{
 													Line:   21,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Name: "color",
 									},
 									Value: &ast.CallExpression{
 										Arguments: []ast.Expression{&ast.ObjectExpression{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 41,
@@ -317,10 +354,12 @@ var pkgAST = &ast.Package{
 														Line:   21,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Properties: []*ast.Property{&ast.Property{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 41,
@@ -333,10 +372,12 @@ var pkgAST = &ast.Package{
 															Line:   21,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Key: &ast.Identifier{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 41,
@@ -349,6 +390,7 @@ var pkgAST = &ast.Package{
 																Line:   21,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Name: "color",
 												},
@@ -357,7 +399,8 @@ var pkgAST = &ast.Package{
 											With: nil,
 										}},
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 42,
@@ -370,10 +413,12 @@ var pkgAST = &ast.Package{
 													Line:   21,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Callee: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 35,
@@ -386,13 +431,15 @@ var pkgAST = &ast.Package{
 														Line:   21,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "validateColorString",
 										},
 									},
 								}, &ast.Property{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 30,
@@ -405,10 +452,12 @@ var pkgAST = &ast.Package{
 												Line:   22,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Key: &ast.Identifier{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 13,
@@ -421,13 +470,15 @@ var pkgAST = &ast.Package{
 													Line:   22,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Name: "text",
 									},
 									Value: &ast.CallExpression{
 										Arguments: []ast.Expression{&ast.ObjectExpression{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 29,
@@ -440,10 +491,12 @@ var pkgAST = &ast.Package{
 														Line:   22,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Properties: []*ast.Property{&ast.Property{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 29,
@@ -456,10 +509,12 @@ var pkgAST = &ast.Package{
 															Line:   22,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Key: &ast.Identifier{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 23,
@@ -472,12 +527,14 @@ var pkgAST = &ast.Package{
 																Line:   22,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Name: "v",
 												},
 												Value: &ast.Identifier{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 29,
@@ -490,6 +547,7 @@ var pkgAST = &ast.Package{
 																Line:   22,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Name: "text",
 												},
@@ -497,7 +555,8 @@ var pkgAST = &ast.Package{
 											With: nil,
 										}},
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 30,
@@ -510,10 +569,12 @@ var pkgAST = &ast.Package{
 													Line:   22,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Callee: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 21,
@@ -526,13 +587,15 @@ var pkgAST = &ast.Package{
 														Line:   22,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "string",
 										},
 									},
 								}, &ast.Property{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 28,
@@ -545,10 +608,12 @@ var pkgAST = &ast.Package{
 												Line:   23,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Key: &ast.Identifier{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 18,
@@ -561,12 +626,14 @@ var pkgAST = &ast.Package{
 													Line:   23,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Name: "mrkdwn_in",
 									},
 									Value: &ast.ArrayExpression{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 28,
@@ -579,10 +646,12 @@ var pkgAST = &ast.Package{
 													Line:   23,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Elements: []ast.Expression{&ast.StringLiteral{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 27,
@@ -595,6 +664,7 @@ var pkgAST = &ast.Package{
 														Line:   23,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Value: "text",
 										}},
@@ -605,7 +675,8 @@ var pkgAST = &ast.Package{
 						},
 					}, &ast.VariableAssignment{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 6,
@@ -618,10 +689,12 @@ var pkgAST = &ast.Package{
 									Line:   26,
 								},
 							},
+							TrailingComment: nil,
 						},
 						ID: &ast.Identifier{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 9,
@@ -634,12 +707,14 @@ var pkgAST = &ast.Package{
 										Line:   26,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Name: "data",
 						},
 						Init: &ast.ObjectExpression{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 6,
@@ -652,10 +727,12 @@ var pkgAST = &ast.Package{
 										Line:   26,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Properties: []*ast.Property{&ast.Property{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 27,
@@ -668,10 +745,12 @@ var pkgAST = &ast.Package{
 											Line:   27,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Key: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 17,
@@ -684,12 +763,14 @@ var pkgAST = &ast.Package{
 												Line:   27,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "username",
 								},
 								Value: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 27,
@@ -702,12 +783,14 @@ var pkgAST = &ast.Package{
 												Line:   27,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "username",
 								},
 							}, &ast.Property{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 25,
@@ -720,10 +803,12 @@ var pkgAST = &ast.Package{
 											Line:   28,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Key: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 16,
@@ -736,12 +821,14 @@ var pkgAST = &ast.Package{
 												Line:   28,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "channel",
 								},
 								Value: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 25,
@@ -754,12 +841,14 @@ var pkgAST = &ast.Package{
 												Line:   28,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "channel",
 								},
 							}, &ast.Property{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 29,
@@ -772,10 +861,12 @@ var pkgAST = &ast.Package{
 											Line:   29,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Key: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 18,
@@ -788,12 +879,14 @@ var pkgAST = &ast.Package{
 												Line:   29,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "workspace",
 								},
 								Value: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 29,
@@ -806,12 +899,14 @@ var pkgAST = &ast.Package{
 												Line:   29,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "workspace",
 								},
 							}, &ast.Property{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 33,
@@ -824,10 +919,12 @@ var pkgAST = &ast.Package{
 											Line:   30,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Key: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 20,
@@ -840,12 +937,14 @@ var pkgAST = &ast.Package{
 												Line:   30,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "attachments",
 								},
 								Value: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 33,
@@ -858,12 +957,14 @@ var pkgAST = &ast.Package{
 												Line:   30,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "attachments",
 								},
 							}, &ast.Property{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 23,
@@ -876,10 +977,12 @@ var pkgAST = &ast.Package{
 											Line:   31,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Key: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 16,
@@ -892,12 +995,14 @@ var pkgAST = &ast.Package{
 												Line:   31,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "as_user",
 								},
 								Value: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 23,
@@ -910,12 +1015,14 @@ var pkgAST = &ast.Package{
 												Line:   31,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "false",
 								},
 							}, &ast.Property{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 30,
@@ -928,10 +1035,12 @@ var pkgAST = &ast.Package{
 											Line:   32,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Key: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 19,
@@ -944,12 +1053,14 @@ var pkgAST = &ast.Package{
 												Line:   32,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "icon_emoji",
 								},
 								Value: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 30,
@@ -962,6 +1073,7 @@ var pkgAST = &ast.Package{
 												Line:   32,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "iconEmoji",
 								},
@@ -970,7 +1082,8 @@ var pkgAST = &ast.Package{
 						},
 					}, &ast.VariableAssignment{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 6,
@@ -983,10 +1096,12 @@ var pkgAST = &ast.Package{
 									Line:   35,
 								},
 							},
+							TrailingComment: nil,
 						},
 						ID: &ast.Identifier{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 12,
@@ -999,12 +1114,14 @@ var pkgAST = &ast.Package{
 										Line:   35,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Name: "headers",
 						},
 						Init: &ast.ObjectExpression{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 6,
@@ -1017,10 +1134,12 @@ var pkgAST = &ast.Package{
 										Line:   35,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Properties: []*ast.Property{&ast.Property{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 43,
@@ -1033,10 +1152,12 @@ var pkgAST = &ast.Package{
 											Line:   36,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Key: &ast.StringLiteral{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 24,
@@ -1049,12 +1170,14 @@ var pkgAST = &ast.Package{
 												Line:   36,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Value: "Authorization",
 								},
 								Value: &ast.BinaryExpression{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 43,
@@ -1067,10 +1190,12 @@ var pkgAST = &ast.Package{
 												Line:   36,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Left: &ast.StringLiteral{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 35,
@@ -1083,13 +1208,15 @@ var pkgAST = &ast.Package{
 													Line:   36,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Value: "Bearer ",
 									},
 									Operator: 5,
 									Right: &ast.Identifier{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 43,
@@ -1102,13 +1229,15 @@ var pkgAST = &ast.Package{
 													Line:   36,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Name: "token",
 									},
 								},
 							}, &ast.Property{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 43,
@@ -1121,10 +1250,12 @@ var pkgAST = &ast.Package{
 											Line:   37,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Key: &ast.StringLiteral{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 23,
@@ -1137,12 +1268,14 @@ var pkgAST = &ast.Package{
 												Line:   37,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Value: "Content-Type",
 								},
 								Value: &ast.StringLiteral{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 43,
@@ -1155,6 +1288,7 @@ var pkgAST = &ast.Package{
 												Line:   37,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Value: "application/json",
 								},
@@ -1163,7 +1297,8 @@ var pkgAST = &ast.Package{
 						},
 					}, &ast.VariableAssignment{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 30,
@@ -1176,10 +1311,12 @@ var pkgAST = &ast.Package{
 									Line:   39,
 								},
 							},
+							TrailingComment: nil,
 						},
 						ID: &ast.Identifier{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 8,
@@ -1192,13 +1329,15 @@ var pkgAST = &ast.Package{
 										Line:   39,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Name: "enc",
 						},
 						Init: &ast.CallExpression{
 							Arguments: []ast.Expression{&ast.ObjectExpression{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 29,
@@ -1211,10 +1350,12 @@ var pkgAST = &ast.Package{
 											Line:   39,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Properties: []*ast.Property{&ast.Property{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 29,
@@ -1227,10 +1368,12 @@ var pkgAST = &ast.Package{
 												Line:   39,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Key: &ast.Identifier{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 24,
@@ -1243,12 +1386,14 @@ var pkgAST = &ast.Package{
 													Line:   39,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Name: "v",
 									},
 									Value: &ast.Identifier{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 29,
@@ -1261,6 +1406,7 @@ var pkgAST = &ast.Package{
 													Line:   39,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Name: "data",
 									},
@@ -1268,7 +1414,8 @@ var pkgAST = &ast.Package{
 								With: nil,
 							}},
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 30,
@@ -1281,10 +1428,12 @@ var pkgAST = &ast.Package{
 										Line:   39,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Callee: &ast.MemberExpression{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 22,
@@ -1297,10 +1446,12 @@ var pkgAST = &ast.Package{
 											Line:   39,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Object: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 15,
@@ -1313,12 +1464,14 @@ var pkgAST = &ast.Package{
 												Line:   39,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "json",
 								},
 								Property: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 22,
@@ -1331,6 +1484,7 @@ var pkgAST = &ast.Package{
 												Line:   39,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "encode",
 								},
@@ -1340,7 +1494,8 @@ var pkgAST = &ast.Package{
 						Argument: &ast.CallExpression{
 							Arguments: []ast.Expression{&ast.ObjectExpression{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 59,
@@ -1353,10 +1508,12 @@ var pkgAST = &ast.Package{
 											Line:   40,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Properties: []*ast.Property{&ast.Property{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 38,
@@ -1369,10 +1526,12 @@ var pkgAST = &ast.Package{
 												Line:   40,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Key: &ast.Identifier{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 29,
@@ -1385,12 +1544,14 @@ var pkgAST = &ast.Package{
 													Line:   40,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Name: "headers",
 									},
 									Value: &ast.Identifier{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 38,
@@ -1403,12 +1564,14 @@ var pkgAST = &ast.Package{
 													Line:   40,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Name: "headers",
 									},
 								}, &ast.Property{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 48,
@@ -1421,10 +1584,12 @@ var pkgAST = &ast.Package{
 												Line:   40,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Key: &ast.Identifier{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 43,
@@ -1437,12 +1602,14 @@ var pkgAST = &ast.Package{
 													Line:   40,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Name: "url",
 									},
 									Value: &ast.Identifier{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 48,
@@ -1455,12 +1622,14 @@ var pkgAST = &ast.Package{
 													Line:   40,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Name: "url",
 									},
 								}, &ast.Property{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 59,
@@ -1473,10 +1642,12 @@ var pkgAST = &ast.Package{
 												Line:   40,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Key: &ast.Identifier{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 54,
@@ -1489,12 +1660,14 @@ var pkgAST = &ast.Package{
 													Line:   40,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Name: "data",
 									},
 									Value: &ast.Identifier{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 59,
@@ -1507,6 +1680,7 @@ var pkgAST = &ast.Package{
 													Line:   40,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Name: "enc",
 									},
@@ -1514,7 +1688,8 @@ var pkgAST = &ast.Package{
 								With: nil,
 							}},
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 60,
@@ -1527,10 +1702,12 @@ var pkgAST = &ast.Package{
 										Line:   40,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Callee: &ast.MemberExpression{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 21,
@@ -1543,10 +1720,12 @@ var pkgAST = &ast.Package{
 											Line:   40,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Object: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 16,
@@ -1559,12 +1738,14 @@ var pkgAST = &ast.Package{
 												Line:   40,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "http",
 								},
 								Property: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 21,
@@ -1577,13 +1758,15 @@ var pkgAST = &ast.Package{
 												Line:   40,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "post",
 								},
 							},
 						},
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 60,
@@ -1596,12 +1779,14 @@ var pkgAST = &ast.Package{
 									Line:   40,
 								},
 							},
+							TrailingComment: nil,
 						},
 					}},
 				},
 				Params: []*ast.Property{&ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 26,
@@ -1614,10 +1799,12 @@ var pkgAST = &ast.Package{
 								Line:   19,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 15,
@@ -1630,12 +1817,14 @@ var pkgAST = &ast.Package{
 									Line:   19,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Name: "url",
 					},
 					Value: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 26,
@@ -1648,12 +1837,14 @@ var pkgAST = &ast.Package{
 									Line:   19,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Name: "defaultURL",
 					},
 				}, &ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 36,
@@ -1666,10 +1857,12 @@ var pkgAST = &ast.Package{
 								Line:   19,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 33,
@@ -1682,12 +1875,14 @@ var pkgAST = &ast.Package{
 									Line:   19,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Name: "token",
 					},
 					Value: &ast.StringLiteral{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 36,
@@ -1700,12 +1895,14 @@ var pkgAST = &ast.Package{
 									Line:   19,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Value: "",
 					},
 				}, &ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 46,
@@ -1718,10 +1915,12 @@ var pkgAST = &ast.Package{
 								Line:   19,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 46,
@@ -1734,13 +1933,15 @@ var pkgAST = &ast.Package{
 									Line:   19,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Name: "username",
 					},
 					Value: nil,
 				}, &ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 55,
@@ -1753,10 +1954,12 @@ var pkgAST = &ast.Package{
 								Line:   19,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 55,
@@ -1769,13 +1972,15 @@ var pkgAST = &ast.Package{
 									Line:   19,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Name: "channel",
 					},
 					Value: nil,
 				}, &ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 66,
@@ -1788,10 +1993,12 @@ var pkgAST = &ast.Package{
 								Line:   19,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 66,
@@ -1804,13 +2011,15 @@ var pkgAST = &ast.Package{
 									Line:   19,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Name: "workspace",
 					},
 					Value: nil,
 				}, &ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 72,
@@ -1823,10 +2032,12 @@ var pkgAST = &ast.Package{
 								Line:   19,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 72,
@@ -1839,13 +2050,15 @@ var pkgAST = &ast.Package{
 									Line:   19,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Name: "text",
 					},
 					Value: nil,
 				}, &ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 83,
@@ -1858,10 +2071,12 @@ var pkgAST = &ast.Package{
 								Line:   19,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 83,
@@ -1874,13 +2089,15 @@ var pkgAST = &ast.Package{
 									Line:   19,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Name: "iconEmoji",
 					},
 					Value: nil,
 				}, &ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 90,
@@ -1893,10 +2110,12 @@ var pkgAST = &ast.Package{
 								Line:   19,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 90,
@@ -1909,6 +2128,7 @@ var pkgAST = &ast.Package{
 									Line:   19,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Name: "color",
 					},
@@ -1917,7 +2137,8 @@ var pkgAST = &ast.Package{
 			},
 		}, &ast.VariableAssignment{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: []ast.Comment{ast.Comment{Text: "// `endpoint` creates the endpoint for the Slack external service."}, ast.Comment{Text: "// `url` - string - URL of the slack endpoint. Defaults to: \"https://slack.com/api/chat.postMessage\", if one uses the webhook api this must be acquired as part of the slack API setup, and this URL will be secret."}, ast.Comment{Text: "// `token` - string - token for the slack endpoint.  This can be ignored if one uses the webhook url acquired as part of the slack API setup, but must be supplied if the chat.postMessage API is used."}, ast.Comment{Text: "// The returned factory function accepts a `mapFn` parameter."}, ast.Comment{Text: "// The `mapFn` must return an object with `username`, `channel`, `workspace`, `text`, `iconEmoji`, and `color` fields as defined in the `message` function arguments."}},
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 15,
@@ -1930,10 +2151,12 @@ var pkgAST = &ast.Package{
 						Line:   48,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 9,
@@ -1946,12 +2169,14 @@ var pkgAST = &ast.Package{
 							Line:   48,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "endpoint",
 			},
 			Init: &ast.FunctionExpression{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 15,
@@ -1964,10 +2189,12 @@ var pkgAST = &ast.Package{
 							Line:   48,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Body: &ast.FunctionExpression{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 15,
@@ -1980,10 +2207,12 @@ var pkgAST = &ast.Package{
 								Line:   49,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Body: &ast.FunctionExpression{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 15,
@@ -1996,11 +2225,13 @@ var pkgAST = &ast.Package{
 									Line:   50,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Body: &ast.PipeExpression{
 							Argument: &ast.Identifier{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 30,
@@ -2013,11 +2244,13 @@ var pkgAST = &ast.Package{
 											Line:   50,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Name: "tables",
 							},
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 15,
@@ -2030,11 +2263,13 @@ var pkgAST = &ast.Package{
 										Line:   50,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Call: &ast.CallExpression{
 								Arguments: []ast.Expression{&ast.ObjectExpression{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 14,
@@ -2047,10 +2282,12 @@ var pkgAST = &ast.Package{
 												Line:   51,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Properties: []*ast.Property{&ast.Property{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 14,
@@ -2063,10 +2300,12 @@ var pkgAST = &ast.Package{
 													Line:   51,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Key: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 22,
@@ -2079,12 +2318,14 @@ var pkgAST = &ast.Package{
 														Line:   51,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "fn",
 										},
 										Value: &ast.FunctionExpression{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 14,
@@ -2097,10 +2338,12 @@ var pkgAST = &ast.Package{
 														Line:   51,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Body: &ast.Block{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 14,
@@ -2113,10 +2356,12 @@ var pkgAST = &ast.Package{
 															Line:   51,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Body: []ast.Statement{&ast.VariableAssignment{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 34,
@@ -2129,10 +2374,12 @@ var pkgAST = &ast.Package{
 																Line:   52,
 															},
 														},
+														TrailingComment: nil,
 													},
 													ID: &ast.Identifier{
 														BaseNode: ast.BaseNode{
-															Errors: nil,
+															Comments: nil,
+															Errors:   nil,
 															Loc: &ast.SourceLocation{
 																End: ast.Position{
 																	Column: 20,
@@ -2145,13 +2392,15 @@ var pkgAST = &ast.Package{
 																	Line:   52,
 																},
 															},
+															TrailingComment: nil,
 														},
 														Name: "obj",
 													},
 													Init: &ast.CallExpression{
 														Arguments: []ast.Expression{&ast.ObjectExpression{
 															BaseNode: ast.BaseNode{
-																Errors: nil,
+																Comments: nil,
+																Errors:   nil,
 																Loc: &ast.SourceLocation{
 																	End: ast.Position{
 																		Column: 33,
@@ -2164,10 +2413,12 @@ var pkgAST = &ast.Package{
 																		Line:   52,
 																	},
 																},
+																TrailingComment: nil,
 															},
 															Properties: []*ast.Property{&ast.Property{
 																BaseNode: ast.BaseNode{
-																	Errors: nil,
+																	Comments: nil,
+																	Errors:   nil,
 																	Loc: &ast.SourceLocation{
 																		End: ast.Position{
 																			Column: 33,
@@ -2180,10 +2431,12 @@ var pkgAST = &ast.Package{
 																			Line:   52,
 																		},
 																	},
+																	TrailingComment: nil,
 																},
 																Key: &ast.Identifier{
 																	BaseNode: ast.BaseNode{
-																		Errors: nil,
+																		Comments: nil,
+																		Errors:   nil,
 																		Loc: &ast.SourceLocation{
 																			End: ast.Position{
 																				Column: 30,
@@ -2196,12 +2449,14 @@ var pkgAST = &ast.Package{
 																				Line:   52,
 																			},
 																		},
+																		TrailingComment: nil,
 																	},
 																	Name: "r",
 																},
 																Value: &ast.Identifier{
 																	BaseNode: ast.BaseNode{
-																		Errors: nil,
+																		Comments: nil,
+																		Errors:   nil,
 																		Loc: &ast.SourceLocation{
 																			End: ast.Position{
 																				Column: 33,
@@ -2214,6 +2469,7 @@ var pkgAST = &ast.Package{
 																				Line:   52,
 																			},
 																		},
+																		TrailingComment: nil,
 																	},
 																	Name: "r",
 																},
@@ -2221,7 +2477,8 @@ var pkgAST = &ast.Package{
 															With: nil,
 														}},
 														BaseNode: ast.BaseNode{
-															Errors: nil,
+															Comments: nil,
+															Errors:   nil,
 															Loc: &ast.SourceLocation{
 																End: ast.Position{
 																	Column: 34,
@@ -2234,10 +2491,12 @@ var pkgAST = &ast.Package{
 																	Line:   52,
 																},
 															},
+															TrailingComment: nil,
 														},
 														Callee: &ast.Identifier{
 															BaseNode: ast.BaseNode{
-																Errors: nil,
+																Comments: nil,
+																Errors:   nil,
 																Loc: &ast.SourceLocation{
 																	End: ast.Position{
 																		Column: 28,
@@ -2250,6 +2509,7 @@ var pkgAST = &ast.Package{
 																		Line:   52,
 																	},
 																},
+																TrailingComment: nil,
 															},
 															Name: "mapFn",
 														},
@@ -2257,7 +2517,8 @@ var pkgAST = &ast.Package{
 												}, &ast.ReturnStatement{
 													Argument: &ast.ObjectExpression{
 														BaseNode: ast.BaseNode{
-															Errors: nil,
+															Comments: nil,
+															Errors:   nil,
 															Loc: &ast.SourceLocation{
 																End: ast.Position{
 																	Column: 26,
@@ -2270,10 +2531,12 @@ var pkgAST = &ast.Package{
 																	Line:   53,
 																},
 															},
+															TrailingComment: nil,
 														},
 														Properties: []*ast.Property{&ast.Property{
 															BaseNode: ast.BaseNode{
-																Errors: nil,
+																Comments: nil,
+																Errors:   nil,
 																Loc: &ast.SourceLocation{
 																	End: ast.Position{
 																		Column: 25,
@@ -2286,10 +2549,12 @@ var pkgAST = &ast.Package{
 																		Line:   53,
 																	},
 																},
+																TrailingComment: nil,
 															},
 															Key: &ast.Identifier{
 																BaseNode: ast.BaseNode{
-																	Errors: nil,
+																	Comments: nil,
+																	Errors:   nil,
 																	Loc: &ast.SourceLocation{
 																		End: ast.Position{
 																			Column: 37,
@@ -2302,13 +2567,15 @@ var pkgAST = &ast.Package{
 																			Line:   53,
 																		},
 																	},
+																	TrailingComment: nil,
 																},
 																Name: "_sent",
 															},
 															Value: &ast.CallExpression{
 																Arguments: []ast.Expression{&ast.ObjectExpression{
 																	BaseNode: ast.BaseNode{
-																		Errors: nil,
+																		Comments: nil,
+																		Errors:   nil,
 																		Loc: &ast.SourceLocation{
 																			End: ast.Position{
 																				Column: 24,
@@ -2321,10 +2588,12 @@ var pkgAST = &ast.Package{
 																				Line:   53,
 																			},
 																		},
+																		TrailingComment: nil,
 																	},
 																	Properties: []*ast.Property{&ast.Property{
 																		BaseNode: ast.BaseNode{
-																			Errors: nil,
+																			Comments: nil,
+																			Errors:   nil,
 																			Loc: &ast.SourceLocation{
 																				End: ast.Position{
 																					Column: 24,
@@ -2337,10 +2606,12 @@ var pkgAST = &ast.Package{
 																					Line:   53,
 																				},
 																			},
+																			TrailingComment: nil,
 																		},
 																		Key: &ast.Identifier{
 																			BaseNode: ast.BaseNode{
-																				Errors: nil,
+																				Comments: nil,
+																				Errors:   nil,
 																				Loc: &ast.SourceLocation{
 																					End: ast.Position{
 																						Column: 47,
@@ -2353,12 +2624,14 @@ var pkgAST = &ast.Package{
 																						Line:   53,
 																					},
 																				},
+																				TrailingComment: nil,
 																			},
 																			Name: "v",
 																		},
 																		Value: &ast.BinaryExpression{
 																			BaseNode: ast.BaseNode{
-																				Errors: nil,
+																				Comments: nil,
+																				Errors:   nil,
 																				Loc: &ast.SourceLocation{
 																					End: ast.Position{
 																						Column: 24,
@@ -2371,10 +2644,12 @@ var pkgAST = &ast.Package{
 																						Line:   53,
 																					},
 																				},
+																				TrailingComment: nil,
 																			},
 																			Left: &ast.IntegerLiteral{
 																				BaseNode: ast.BaseNode{
-																					Errors: nil,
+																					Comments: nil,
+																					Errors:   nil,
 																					Loc: &ast.SourceLocation{
 																						End: ast.Position{
 																							Column: 50,
@@ -2387,13 +2662,15 @@ var pkgAST = &ast.Package{
 																							Line:   53,
 																						},
 																					},
+																					TrailingComment: nil,
 																				},
 																				Value: int64(2),
 																			},
 																			Operator: 17,
 																			Right: &ast.BinaryExpression{
 																				BaseNode: ast.BaseNode{
-																					Errors: nil,
+																					Comments: nil,
+																					Errors:   nil,
 																					Loc: &ast.SourceLocation{
 																						End: ast.Position{
 																							Column: 24,
@@ -2406,11 +2683,13 @@ var pkgAST = &ast.Package{
 																							Line:   53,
 																						},
 																					},
+																					TrailingComment: nil,
 																				},
 																				Left: &ast.CallExpression{
 																					Arguments: []ast.Expression{&ast.ObjectExpression{
 																						BaseNode: ast.BaseNode{
-																							Errors: nil,
+																							Comments: nil,
+																							Errors:   nil,
 																							Loc: &ast.SourceLocation{
 																								End: ast.Position{
 																									Column: 37,
@@ -2423,10 +2702,12 @@ var pkgAST = &ast.Package{
 																									Line:   54,
 																								},
 																							},
+																							TrailingComment: nil,
 																						},
 																						Properties: []*ast.Property{&ast.Property{
 																							BaseNode: ast.BaseNode{
-																								Errors: nil,
+																								Comments: nil,
+																								Errors:   nil,
 																								Loc: &ast.SourceLocation{
 																									End: ast.Position{
 																										Column: 29,
@@ -2439,10 +2720,12 @@ var pkgAST = &ast.Package{
 																										Line:   54,
 																									},
 																								},
+																								TrailingComment: nil,
 																							},
 																							Key: &ast.Identifier{
 																								BaseNode: ast.BaseNode{
-																									Errors: nil,
+																									Comments: nil,
+																									Errors:   nil,
 																									Loc: &ast.SourceLocation{
 																										End: ast.Position{
 																											Column: 24,
@@ -2455,12 +2738,14 @@ var pkgAST = &ast.Package{
 																											Line:   54,
 																										},
 																									},
+																									TrailingComment: nil,
 																								},
 																								Name: "url",
 																							},
 																							Value: &ast.Identifier{
 																								BaseNode: ast.BaseNode{
-																									Errors: nil,
+																									Comments: nil,
+																									Errors:   nil,
 																									Loc: &ast.SourceLocation{
 																										End: ast.Position{
 																											Column: 29,
@@ -2473,12 +2758,14 @@ var pkgAST = &ast.Package{
 																											Line:   54,
 																										},
 																									},
+																									TrailingComment: nil,
 																								},
 																								Name: "url",
 																							},
 																						}, &ast.Property{
 																							BaseNode: ast.BaseNode{
-																								Errors: nil,
+																								Comments: nil,
+																								Errors:   nil,
 																								Loc: &ast.SourceLocation{
 																									End: ast.Position{
 																										Column: 33,
@@ -2491,10 +2778,12 @@ var pkgAST = &ast.Package{
 																										Line:   55,
 																									},
 																								},
+																								TrailingComment: nil,
 																							},
 																							Key: &ast.Identifier{
 																								BaseNode: ast.BaseNode{
-																									Errors: nil,
+																									Comments: nil,
+																									Errors:   nil,
 																									Loc: &ast.SourceLocation{
 																										End: ast.Position{
 																											Column: 26,
@@ -2507,12 +2796,14 @@ var pkgAST = &ast.Package{
 																											Line:   55,
 																										},
 																									},
+																									TrailingComment: nil,
 																								},
 																								Name: "token",
 																							},
 																							Value: &ast.Identifier{
 																								BaseNode: ast.BaseNode{
-																									Errors: nil,
+																									Comments: nil,
+																									Errors:   nil,
 																									Loc: &ast.SourceLocation{
 																										End: ast.Position{
 																											Column: 33,
@@ -2525,12 +2816,14 @@ var pkgAST = &ast.Package{
 																											Line:   55,
 																										},
 																									},
+																									TrailingComment: nil,
 																								},
 																								Name: "token",
 																							},
 																						}, &ast.Property{
 																							BaseNode: ast.BaseNode{
-																								Errors: nil,
+																								Comments: nil,
+																								Errors:   nil,
 																								Loc: &ast.SourceLocation{
 																									End: ast.Position{
 																										Column: 43,
@@ -2543,10 +2836,12 @@ var pkgAST = &ast.Package{
 																										Line:   56,
 																									},
 																								},
+																								TrailingComment: nil,
 																							},
 																							Key: &ast.Identifier{
 																								BaseNode: ast.BaseNode{
-																									Errors: nil,
+																									Comments: nil,
+																									Errors:   nil,
 																									Loc: &ast.SourceLocation{
 																										End: ast.Position{
 																											Column: 29,
@@ -2559,12 +2854,14 @@ var pkgAST = &ast.Package{
 																											Line:   56,
 																										},
 																									},
+																									TrailingComment: nil,
 																								},
 																								Name: "username",
 																							},
 																							Value: &ast.MemberExpression{
 																								BaseNode: ast.BaseNode{
-																									Errors: nil,
+																									Comments: nil,
+																									Errors:   nil,
 																									Loc: &ast.SourceLocation{
 																										End: ast.Position{
 																											Column: 43,
@@ -2577,10 +2874,12 @@ var pkgAST = &ast.Package{
 																											Line:   56,
 																										},
 																									},
+																									TrailingComment: nil,
 																								},
 																								Object: &ast.Identifier{
 																									BaseNode: ast.BaseNode{
-																										Errors: nil,
+																										Comments: nil,
+																										Errors:   nil,
 																										Loc: &ast.SourceLocation{
 																											End: ast.Position{
 																												Column: 34,
@@ -2593,12 +2892,14 @@ var pkgAST = &ast.Package{
 																												Line:   56,
 																											},
 																										},
+																										TrailingComment: nil,
 																									},
 																									Name: "obj",
 																								},
 																								Property: &ast.Identifier{
 																									BaseNode: ast.BaseNode{
-																										Errors: nil,
+																										Comments: nil,
+																										Errors:   nil,
 																										Loc: &ast.SourceLocation{
 																											End: ast.Position{
 																												Column: 43,
@@ -2611,13 +2912,15 @@ var pkgAST = &ast.Package{
 																												Line:   56,
 																											},
 																										},
+																										TrailingComment: nil,
 																									},
 																									Name: "username",
 																								},
 																							},
 																						}, &ast.Property{
 																							BaseNode: ast.BaseNode{
-																								Errors: nil,
+																								Comments: nil,
+																								Errors:   nil,
 																								Loc: &ast.SourceLocation{
 																									End: ast.Position{
 																										Column: 41,
@@ -2630,10 +2933,12 @@ var pkgAST = &ast.Package{
 																										Line:   57,
 																									},
 																								},
+																								TrailingComment: nil,
 																							},
 																							Key: &ast.Identifier{
 																								BaseNode: ast.BaseNode{
-																									Errors: nil,
+																									Comments: nil,
+																									Errors:   nil,
 																									Loc: &ast.SourceLocation{
 																										End: ast.Position{
 																											Column: 28,
@@ -2646,12 +2951,14 @@ var pkgAST = &ast.Package{
 																											Line:   57,
 																										},
 																									},
+																									TrailingComment: nil,
 																								},
 																								Name: "channel",
 																							},
 																							Value: &ast.MemberExpression{
 																								BaseNode: ast.BaseNode{
-																									Errors: nil,
+																									Comments: nil,
+																									Errors:   nil,
 																									Loc: &ast.SourceLocation{
 																										End: ast.Position{
 																											Column: 41,
@@ -2664,10 +2971,12 @@ var pkgAST = &ast.Package{
 																											Line:   57,
 																										},
 																									},
+																									TrailingComment: nil,
 																								},
 																								Object: &ast.Identifier{
 																									BaseNode: ast.BaseNode{
-																										Errors: nil,
+																										Comments: nil,
+																										Errors:   nil,
 																										Loc: &ast.SourceLocation{
 																											End: ast.Position{
 																												Column: 33,
@@ -2680,12 +2989,14 @@ var pkgAST = &ast.Package{
 																												Line:   57,
 																											},
 																										},
+																										TrailingComment: nil,
 																									},
 																									Name: "obj",
 																								},
 																								Property: &ast.Identifier{
 																									BaseNode: ast.BaseNode{
-																										Errors: nil,
+																										Comments: nil,
+																										Errors:   nil,
 																										Loc: &ast.SourceLocation{
 																											End: ast.Position{
 																												Column: 41,
@@ -2698,13 +3009,15 @@ var pkgAST = &ast.Package{
 																												Line:   57,
 																											},
 																										},
+																										TrailingComment: nil,
 																									},
 																									Name: "channel",
 																								},
 																							},
 																						}, &ast.Property{
 																							BaseNode: ast.BaseNode{
-																								Errors: nil,
+																								Comments: nil,
+																								Errors:   nil,
 																								Loc: &ast.SourceLocation{
 																									End: ast.Position{
 																										Column: 45,
@@ -2717,10 +3030,12 @@ var pkgAST = &ast.Package{
 																										Line:   58,
 																									},
 																								},
+																								TrailingComment: nil,
 																							},
 																							Key: &ast.Identifier{
 																								BaseNode: ast.BaseNode{
-																									Errors: nil,
+																									Comments: nil,
+																									Errors:   nil,
 																									Loc: &ast.SourceLocation{
 																										End: ast.Position{
 																											Column: 30,
@@ -2733,12 +3048,14 @@ var pkgAST = &ast.Package{
 																											Line:   58,
 																										},
 																									},
+																									TrailingComment: nil,
 																								},
 																								Name: "workspace",
 																							},
 																							Value: &ast.MemberExpression{
 																								BaseNode: ast.BaseNode{
-																									Errors: nil,
+																									Comments: nil,
+																									Errors:   nil,
 																									Loc: &ast.SourceLocation{
 																										End: ast.Position{
 																											Column: 45,
@@ -2751,10 +3068,12 @@ var pkgAST = &ast.Package{
 																											Line:   58,
 																										},
 																									},
+																									TrailingComment: nil,
 																								},
 																								Object: &ast.Identifier{
 																									BaseNode: ast.BaseNode{
-																										Errors: nil,
+																										Comments: nil,
+																										Errors:   nil,
 																										Loc: &ast.SourceLocation{
 																											End: ast.Position{
 																												Column: 35,
@@ -2767,12 +3086,14 @@ var pkgAST = &ast.Package{
 																												Line:   58,
 																											},
 																										},
+																										TrailingComment: nil,
 																									},
 																									Name: "obj",
 																								},
 																								Property: &ast.Identifier{
 																									BaseNode: ast.BaseNode{
-																										Errors: nil,
+																										Comments: nil,
+																										Errors:   nil,
 																										Loc: &ast.SourceLocation{
 																											End: ast.Position{
 																												Column: 45,
@@ -2785,13 +3106,15 @@ var pkgAST = &ast.Package{
 																												Line:   58,
 																											},
 																										},
+																										TrailingComment: nil,
 																									},
 																									Name: "workspace",
 																								},
 																							},
 																						}, &ast.Property{
 																							BaseNode: ast.BaseNode{
-																								Errors: nil,
+																								Comments: nil,
+																								Errors:   nil,
 																								Loc: &ast.SourceLocation{
 																									End: ast.Position{
 																										Column: 35,
@@ -2804,10 +3127,12 @@ var pkgAST = &ast.Package{
 																										Line:   59,
 																									},
 																								},
+																								TrailingComment: nil,
 																							},
 																							Key: &ast.Identifier{
 																								BaseNode: ast.BaseNode{
-																									Errors: nil,
+																									Comments: nil,
+																									Errors:   nil,
 																									Loc: &ast.SourceLocation{
 																										End: ast.Position{
 																											Column: 25,
@@ -2820,12 +3145,14 @@ var pkgAST = &ast.Package{
 																											Line:   59,
 																										},
 																									},
+																									TrailingComment: nil,
 																								},
 																								Name: "text",
 																							},
 																							Value: &ast.MemberExpression{
 																								BaseNode: ast.BaseNode{
-																									Errors: nil,
+																									Comments: nil,
+																									Errors:   nil,
 																									Loc: &ast.SourceLocation{
 																										End: ast.Position{
 																											Column: 35,
@@ -2838,10 +3165,12 @@ var pkgAST = &ast.Package{
 																											Line:   59,
 																										},
 																									},
+																									TrailingComment: nil,
 																								},
 																								Object: &ast.Identifier{
 																									BaseNode: ast.BaseNode{
-																										Errors: nil,
+																										Comments: nil,
+																										Errors:   nil,
 																										Loc: &ast.SourceLocation{
 																											End: ast.Position{
 																												Column: 30,
@@ -2854,12 +3183,14 @@ var pkgAST = &ast.Package{
 																												Line:   59,
 																											},
 																										},
+																										TrailingComment: nil,
 																									},
 																									Name: "obj",
 																								},
 																								Property: &ast.Identifier{
 																									BaseNode: ast.BaseNode{
-																										Errors: nil,
+																										Comments: nil,
+																										Errors:   nil,
 																										Loc: &ast.SourceLocation{
 																											End: ast.Position{
 																												Column: 35,
@@ -2872,13 +3203,15 @@ var pkgAST = &ast.Package{
 																												Line:   59,
 																											},
 																										},
+																										TrailingComment: nil,
 																									},
 																									Name: "text",
 																								},
 																							},
 																						}, &ast.Property{
 																							BaseNode: ast.BaseNode{
-																								Errors: nil,
+																								Comments: nil,
+																								Errors:   nil,
 																								Loc: &ast.SourceLocation{
 																									End: ast.Position{
 																										Column: 45,
@@ -2891,10 +3224,12 @@ var pkgAST = &ast.Package{
 																										Line:   60,
 																									},
 																								},
+																								TrailingComment: nil,
 																							},
 																							Key: &ast.Identifier{
 																								BaseNode: ast.BaseNode{
-																									Errors: nil,
+																									Comments: nil,
+																									Errors:   nil,
 																									Loc: &ast.SourceLocation{
 																										End: ast.Position{
 																											Column: 30,
@@ -2907,12 +3242,14 @@ var pkgAST = &ast.Package{
 																											Line:   60,
 																										},
 																									},
+																									TrailingComment: nil,
 																								},
 																								Name: "iconEmoji",
 																							},
 																							Value: &ast.MemberExpression{
 																								BaseNode: ast.BaseNode{
-																									Errors: nil,
+																									Comments: nil,
+																									Errors:   nil,
 																									Loc: &ast.SourceLocation{
 																										End: ast.Position{
 																											Column: 45,
@@ -2925,10 +3262,12 @@ var pkgAST = &ast.Package{
 																											Line:   60,
 																										},
 																									},
+																									TrailingComment: nil,
 																								},
 																								Object: &ast.Identifier{
 																									BaseNode: ast.BaseNode{
-																										Errors: nil,
+																										Comments: nil,
+																										Errors:   nil,
 																										Loc: &ast.SourceLocation{
 																											End: ast.Position{
 																												Column: 35,
@@ -2941,12 +3280,14 @@ var pkgAST = &ast.Package{
 																												Line:   60,
 																											},
 																										},
+																										TrailingComment: nil,
 																									},
 																									Name: "obj",
 																								},
 																								Property: &ast.Identifier{
 																									BaseNode: ast.BaseNode{
-																										Errors: nil,
+																										Comments: nil,
+																										Errors:   nil,
 																										Loc: &ast.SourceLocation{
 																											End: ast.Position{
 																												Column: 45,
@@ -2959,13 +3300,15 @@ var pkgAST = &ast.Package{
 																												Line:   60,
 																											},
 																										},
+																										TrailingComment: nil,
 																									},
 																									Name: "iconEmoji",
 																								},
 																							},
 																						}, &ast.Property{
 																							BaseNode: ast.BaseNode{
-																								Errors: nil,
+																								Comments: nil,
+																								Errors:   nil,
 																								Loc: &ast.SourceLocation{
 																									End: ast.Position{
 																										Column: 37,
@@ -2978,10 +3321,12 @@ var pkgAST = &ast.Package{
 																										Line:   61,
 																									},
 																								},
+																								TrailingComment: nil,
 																							},
 																							Key: &ast.Identifier{
 																								BaseNode: ast.BaseNode{
-																									Errors: nil,
+																									Comments: nil,
+																									Errors:   nil,
 																									Loc: &ast.SourceLocation{
 																										End: ast.Position{
 																											Column: 26,
@@ -2994,12 +3339,14 @@ var pkgAST = &ast.Package{
 																											Line:   61,
 																										},
 																									},
+																									TrailingComment: nil,
 																								},
 																								Name: "color",
 																							},
 																							Value: &ast.MemberExpression{
 																								BaseNode: ast.BaseNode{
-																									Errors: nil,
+																									Comments: nil,
+																									Errors:   nil,
 																									Loc: &ast.SourceLocation{
 																										End: ast.Position{
 																											Column: 37,
@@ -3012,10 +3359,12 @@ var pkgAST = &ast.Package{
 																											Line:   61,
 																										},
 																									},
+																									TrailingComment: nil,
 																								},
 																								Object: &ast.Identifier{
 																									BaseNode: ast.BaseNode{
-																										Errors: nil,
+																										Comments: nil,
+																										Errors:   nil,
 																										Loc: &ast.SourceLocation{
 																											End: ast.Position{
 																												Column: 31,
@@ -3028,12 +3377,14 @@ var pkgAST = &ast.Package{
 																												Line:   61,
 																											},
 																										},
+																										TrailingComment: nil,
 																									},
 																									Name: "obj",
 																								},
 																								Property: &ast.Identifier{
 																									BaseNode: ast.BaseNode{
-																										Errors: nil,
+																										Comments: nil,
+																										Errors:   nil,
 																										Loc: &ast.SourceLocation{
 																											End: ast.Position{
 																												Column: 37,
@@ -3046,6 +3397,7 @@ var pkgAST = &ast.Package{
 																												Line:   61,
 																											},
 																										},
+																										TrailingComment: nil,
 																									},
 																									Name: "color",
 																								},
@@ -3054,7 +3406,8 @@ var pkgAST = &ast.Package{
 																						With: nil,
 																					}},
 																					BaseNode: ast.BaseNode{
-																						Errors: nil,
+																						Comments: nil,
+																						Errors:   nil,
 																						Loc: &ast.SourceLocation{
 																							End: ast.Position{
 																								Column: 18,
@@ -3067,10 +3420,12 @@ var pkgAST = &ast.Package{
 																								Line:   53,
 																							},
 																						},
+																						TrailingComment: nil,
 																					},
 																					Callee: &ast.Identifier{
 																						BaseNode: ast.BaseNode{
-																							Errors: nil,
+																							Comments: nil,
+																							Errors:   nil,
 																							Loc: &ast.SourceLocation{
 																								End: ast.Position{
 																									Column: 61,
@@ -3083,6 +3438,7 @@ var pkgAST = &ast.Package{
 																									Line:   53,
 																								},
 																							},
+																							TrailingComment: nil,
 																						},
 																						Name: "message",
 																					},
@@ -3090,7 +3446,8 @@ var pkgAST = &ast.Package{
 																				Operator: 2,
 																				Right: &ast.IntegerLiteral{
 																					BaseNode: ast.BaseNode{
-																						Errors: nil,
+																						Comments: nil,
+																						Errors:   nil,
 																						Loc: &ast.SourceLocation{
 																							End: ast.Position{
 																								Column: 24,
@@ -3103,6 +3460,7 @@ var pkgAST = &ast.Package{
 																								Line:   62,
 																							},
 																						},
+																						TrailingComment: nil,
 																					},
 																					Value: int64(100),
 																				},
@@ -3112,7 +3470,8 @@ var pkgAST = &ast.Package{
 																	With: nil,
 																}},
 																BaseNode: ast.BaseNode{
-																	Errors: nil,
+																	Comments: nil,
+																	Errors:   nil,
 																	Loc: &ast.SourceLocation{
 																		End: ast.Position{
 																			Column: 25,
@@ -3125,10 +3484,12 @@ var pkgAST = &ast.Package{
 																			Line:   53,
 																		},
 																	},
+																	TrailingComment: nil,
 																},
 																Callee: &ast.Identifier{
 																	BaseNode: ast.BaseNode{
-																		Errors: nil,
+																		Comments: nil,
+																		Errors:   nil,
 																		Loc: &ast.SourceLocation{
 																			End: ast.Position{
 																				Column: 45,
@@ -3141,6 +3502,7 @@ var pkgAST = &ast.Package{
 																				Line:   53,
 																			},
 																		},
+																		TrailingComment: nil,
 																	},
 																	Name: "string",
 																},
@@ -3148,7 +3510,8 @@ var pkgAST = &ast.Package{
 														}},
 														With: &ast.Identifier{
 															BaseNode: ast.BaseNode{
-																Errors: nil,
+																Comments: nil,
+																Errors:   nil,
 																Loc: &ast.SourceLocation{
 																	End: ast.Position{
 																		Column: 26,
@@ -3161,12 +3524,14 @@ var pkgAST = &ast.Package{
 																		Line:   53,
 																	},
 																},
+																TrailingComment: nil,
 															},
 															Name: "r",
 														},
 													},
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 26,
@@ -3179,12 +3544,14 @@ var pkgAST = &ast.Package{
 																Line:   53,
 															},
 														},
+														TrailingComment: nil,
 													},
 												}},
 											},
 											Params: []*ast.Property{&ast.Property{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 26,
@@ -3197,10 +3564,12 @@ var pkgAST = &ast.Package{
 															Line:   51,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Key: &ast.Identifier{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 26,
@@ -3213,6 +3582,7 @@ var pkgAST = &ast.Package{
 																Line:   51,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Name: "r",
 												},
@@ -3223,7 +3593,8 @@ var pkgAST = &ast.Package{
 									With: nil,
 								}},
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 15,
@@ -3236,10 +3607,12 @@ var pkgAST = &ast.Package{
 											Line:   51,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Callee: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 19,
@@ -3252,6 +3625,7 @@ var pkgAST = &ast.Package{
 												Line:   51,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "map",
 								},
@@ -3259,7 +3633,8 @@ var pkgAST = &ast.Package{
 						},
 						Params: []*ast.Property{&ast.Property{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 19,
@@ -3272,10 +3647,12 @@ var pkgAST = &ast.Package{
 										Line:   50,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Key: &ast.Identifier{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 16,
@@ -3288,11 +3665,13 @@ var pkgAST = &ast.Package{
 											Line:   50,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Name: "tables",
 							},
 							Value: &ast.PipeLiteral{BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 19,
@@ -3305,12 +3684,14 @@ var pkgAST = &ast.Package{
 										Line:   50,
 									},
 								},
+								TrailingComment: nil,
 							}},
 						}},
 					},
 					Params: []*ast.Property{&ast.Property{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 11,
@@ -3323,10 +3704,12 @@ var pkgAST = &ast.Package{
 									Line:   49,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Key: &ast.Identifier{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 11,
@@ -3339,6 +3722,7 @@ var pkgAST = &ast.Package{
 										Line:   49,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Name: "mapFn",
 						},
@@ -3347,7 +3731,8 @@ var pkgAST = &ast.Package{
 				},
 				Params: []*ast.Property{&ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 27,
@@ -3360,10 +3745,12 @@ var pkgAST = &ast.Package{
 								Line:   48,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 16,
@@ -3376,12 +3763,14 @@ var pkgAST = &ast.Package{
 									Line:   48,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Name: "url",
 					},
 					Value: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 27,
@@ -3394,12 +3783,14 @@ var pkgAST = &ast.Package{
 									Line:   48,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Name: "defaultURL",
 					},
 				}, &ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 37,
@@ -3412,10 +3803,12 @@ var pkgAST = &ast.Package{
 								Line:   48,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 34,
@@ -3428,12 +3821,14 @@ var pkgAST = &ast.Package{
 									Line:   48,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Name: "token",
 					},
 					Value: &ast.StringLiteral{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 37,
@@ -3446,16 +3841,19 @@ var pkgAST = &ast.Package{
 									Line:   48,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Value: "",
 					},
 				}},
 			},
 		}},
+		Eof: nil,
 		Imports: []*ast.ImportDeclaration{&ast.ImportDeclaration{
 			As: nil,
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 14,
@@ -3468,10 +3866,12 @@ var pkgAST = &ast.Package{
 						Line:   3,
 					},
 				},
+				TrailingComment: nil,
 			},
 			Path: &ast.StringLiteral{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 14,
@@ -3484,13 +3884,15 @@ var pkgAST = &ast.Package{
 							Line:   3,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Value: "http",
 			},
 		}, &ast.ImportDeclaration{
 			As: nil,
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 14,
@@ -3503,10 +3905,12 @@ var pkgAST = &ast.Package{
 						Line:   4,
 					},
 				},
+				TrailingComment: nil,
 			},
 			Path: &ast.StringLiteral{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 14,
@@ -3519,6 +3923,7 @@ var pkgAST = &ast.Package{
 							Line:   4,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Value: "json",
 			},
@@ -3527,7 +3932,8 @@ var pkgAST = &ast.Package{
 		Name:     "slack.flux",
 		Package: &ast.PackageClause{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 14,
@@ -3540,10 +3946,12 @@ var pkgAST = &ast.Package{
 						Line:   1,
 					},
 				},
+				TrailingComment: nil,
 			},
 			Name: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 14,
@@ -3556,6 +3964,7 @@ var pkgAST = &ast.Package{
 							Line:   1,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "slack",
 			},
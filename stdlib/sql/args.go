@@ -0,0 +1,71 @@
+package sql
+
+import (
+	"github.com/influxdata/flux/codes"
+	"github.com/influxdata/flux/internal/errors"
+	"github.com/influxdata/flux/semantic"
+	"github.com/influxdata/flux/values"
+)
+
+// bindArgs translates the values of the args parameter of sql.from
+// into the values that the driver binds to the placeholders of the query.
+func bindArgs(driverName string, args values.Array) ([]interface{}, error) {
+	driver, err := lookupDriver(driverName)
+	if err != nil {
+		return nil, err
+	}
+	bound := make([]interface{}, 0, args.Len())
+	args.Range(func(i int, v values.Value) {
+		if err != nil {
+			return
+		}
+		var arg interface{}
+		if arg, err = bindArg(driverName, driver, v); err != nil {
+			err = errors.Wrapf(err, codes.Invalid, "invalid argument %d", i)
+			return
+		}
+		bound = append(bound, arg)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return bound, nil
+}
+
+func bindArg(driverName string, driver Driver, v values.Value) (interface{}, error) {
+	if v.IsNull() {
+		return nil, nil
+	}
+	switch v.Type().Nature() {
+	case semantic.String:
+		return v.Str(), nil
+	case semantic.Int:
+		return v.Int(), nil
+	case semantic.UInt:
+		return v.UInt(), nil
+	case semantic.Float:
+		return v.Float(), nil
+	case semantic.Bool:
+		return v.Bool(), nil
+	case semantic.Bytes:
+		return v.Bytes(), nil
+	case semantic.Time:
+		return v.Time().Time().UTC(), nil
+	case semantic.Duration:
+		if b, ok := driver.(DurationBinder); ok {
+			return b.BindDuration(v.Duration())
+		}
+		return bindNanoseconds(driverName, v.Duration())
+	default:
+		return nil, errors.Newf(codes.Invalid, "cannot bind a value of type %v", v.Type())
+	}
+}
+
+// bindNanoseconds binds the number of nanoseconds of a duration
+// for the databases that have no type for durations, such as SQLite.
+func bindNanoseconds(driverName string, d values.Duration) (interface{}, error) {
+	if d.Months() != 0 {
+		return nil, errors.Newf(codes.Invalid, "duration %v with months cannot be bound for sql driver %s", d, driverName)
+	}
+	return int64(d.Duration()), nil
+}
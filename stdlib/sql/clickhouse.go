@@ -0,0 +1,117 @@
+package sql
+
+import (
+	"database/sql"
+	neturl "net/url"
+	"strings"
+
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/codes"
+	"github.com/influxdata/flux/dependencies/url"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/internal/errors"
+)
+
+// clickHouseDriver is the driver of ClickHouse databases.
+// The database/sql driver is registered as clickhouse by github.com/ClickHouse/clickhouse-go,
+// which the program that embeds Flux imports.
+type clickHouseDriver struct{}
+
+func (clickHouseDriver) ValidateDataSource(validator url.Validator, dataSourceName string) error {
+	// an example is: tcp://localhost:9000?username=user&password=secret&database=db&alt_hosts=host2:9000
+	u, err := parseURL(dataSourceName)
+	if err != nil {
+		return err
+	}
+	if err := validateURL(validator, u); err != nil {
+		return err
+	}
+	// The driver connects to the alternative hosts when the host is not available.
+	if altHosts := u.Query().Get("alt_hosts"); altHosts != "" {
+		for _, host := range strings.Split(altHosts, ",") {
+			if err := validateURL(validator, &neturl.URL{Scheme: u.Scheme, Host: host}); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (clickHouseDriver) NewRowReader(rows *sql.Rows) (execute.RowReader, error) {
+	return NewColumnTypeRowReader(rows, clickHouseColumnType)
+}
+
+// clickHouseColumnType returns the flux type of a ClickHouse column.
+func clickHouseColumnType(typ *sql.ColumnType) flux.ColType {
+	name := typ.DatabaseTypeName()
+	// Nullable and LowCardinality columns store the values of the type they wrap.
+	for _, wrapper := range []string{"Nullable(", "LowCardinality("} {
+		if strings.HasPrefix(name, wrapper) && strings.HasSuffix(name, ")") {
+			name = name[len(wrapper) : len(name)-1]
+		}
+	}
+	switch {
+	case strings.HasPrefix(name, "Int"):
+		return flux.TInt
+	case strings.HasPrefix(name, "UInt"):
+		return flux.TUInt
+	case strings.HasPrefix(name, "Float"), strings.HasPrefix(name, "Decimal"):
+		return flux.TFloat
+	case name == "Bool":
+		return flux.TBool
+	case strings.HasPrefix(name, "Date"):
+		return flux.TTime
+	default:
+		return flux.TString
+	}
+}
+
+func (clickHouseDriver) TranslateColumn(typ flux.ColType, name string) (string, error) {
+	return ClickHouseColumnTranslateFunc()(typ, name)
+}
+
+func (clickHouseDriver) Placeholder(int) string {
+	return "?"
+}
+
+func (clickHouseDriver) QuoteIdentifier(name string) string {
+	return quoteWith("`")(name)
+}
+
+// ClickHouse matches regular expressions with the re2 syntax, as Flux does.
+func (clickHouseDriver) RegexpMatch(column, param string, not bool) string {
+	expr := "match(" + column + ", " + param + ")"
+	if not {
+		return "NOT " + expr
+	}
+	return expr
+}
+
+// ClickHouseColumnTranslateFunc translates flux colTypes into their corresponding ClickHouse column type
+func ClickHouseColumnTranslateFunc() translationFunc {
+	// The columns of ClickHouse cannot store nulls unless they are nullable.
+	c := map[string]string{
+		flux.TFloat.String():  "Nullable(Float64)",
+		flux.TInt.String():    "Nullable(Int64)",
+		flux.TUInt.String():   "Nullable(UInt64)",
+		flux.TString.String(): "Nullable(String)",
+		flux.TTime.String():   "Nullable(DateTime64(9))",
+		flux.TBool.String():   "Nullable(Bool)",
+	}
+	return func(f flux.ColType, colName string) (string, error) {
+		s, found := c[f.String()]
+		if !found {
+			return "", errors.Newf(codes.Internal, "ClickHouse does not support column type %s", f.String())
+		}
+		return colName + " " + s, nil
+	}
+}
+
+func (clickHouseDriver) TruncateTable(table string) string {
+	return "TRUNCATE TABLE " + table
+}
+
+// The rows of a ClickHouse table are stored by a table engine.
+func (clickHouseDriver) CreateTable(table string, cols []string) string {
+	return createTableStatement(table, cols) + " ENGINE = MergeTree() ORDER BY tuple()"
+}
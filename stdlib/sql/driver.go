@@ -0,0 +1,169 @@
+package sql
+
+import (
+	"context"
+	"database/sql"
+	neturl "net/url"
+	"sync"
+
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/codes"
+	"github.com/influxdata/flux/dependencies/url"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/internal/errors"
+	"github.com/influxdata/flux/values"
+)
+
+// Driver describes how sql.from and sql.to use a database/sql driver.
+// A driver is registered with RegisterDriver under the name of the
+// database/sql driver, which is the driverName parameter of the functions.
+type Driver interface {
+	// ValidateDataSource validates the data source name
+	// with the validator before the database is opened.
+	ValidateDataSource(validator url.Validator, dataSourceName string) error
+	// NewRowReader returns the reader of the rows of a query.
+	NewRowReader(rows *sql.Rows) (execute.RowReader, error)
+	// TranslateColumn returns the definition of a column
+	// of the type in the statement that creates a table.
+	TranslateColumn(typ flux.ColType, name string) (string, error)
+	// Placeholder returns the placeholder of the nth bound parameter of a statement.
+	// The parameters are counted from 1.
+	Placeholder(n int) string
+}
+
+// QueryDialect is implemented by the drivers of the databases that sql.from
+// pushes ranges, filters, projections and limits to. The query of the user
+// is read from a subquery with a WHERE, LIMIT and OFFSET clause.
+type QueryDialect interface {
+	// QuoteIdentifier quotes the name of a column.
+	QuoteIdentifier(name string) string
+}
+
+// RegexpMatcher is implemented by the query dialects of the databases
+// that match regular expressions with the syntax of Flux.
+type RegexpMatcher interface {
+	// RegexpMatch returns the condition that matches the column
+	// to the pattern of the parameter, or does not match it if not is true.
+	RegexpMatch(column, param string, not bool) string
+}
+
+// TextTimes is implemented by the query dialects of the databases
+// that store times as text, such as SQLite. The text of a time can be
+// in any format, so it does not compare like the time that it holds.
+// Ranges and filters that compare times are not pushed to these databases.
+type TextTimes interface {
+	// TimesAsText reports whether the database stores times as text.
+	TimesAsText() bool
+}
+
+// Truncater is implemented by the drivers of the databases that empty
+// a table with a statement other than DELETE FROM in the truncate mode of sql.to.
+type Truncater interface {
+	// TruncateTable returns the statement that deletes the rows of the table.
+	TruncateTable(table string) string
+}
+
+// TableCreator is implemented by the drivers of the databases that cannot
+// create a table with CREATE TABLE IF NOT EXISTS and the columns alone.
+type TableCreator interface {
+	// CreateTable returns the statement that creates the table
+	// with the column definitions when it does not exist.
+	CreateTable(table string, cols []string) string
+}
+
+// DefinitionCommitter is implemented by the drivers of the databases
+// that commit the transaction before a statement that defines a table.
+// sql.to creates the table outside of the transaction for these databases.
+type DefinitionCommitter interface {
+	// CommitsDefinitions reports whether the database commits the transaction
+	// before it executes a statement that defines a table.
+	CommitsDefinitions() bool
+}
+
+// Upserter is implemented by the drivers of the databases that update
+// the existing rows that conflict with the rows sql.to inserts.
+// Upserts are not supported for the other drivers.
+type Upserter interface {
+	// UpsertClause returns the clause of the insert statement that updates
+	// the columns of the rows that conflict with the keys.
+	UpsertClause(keys, colNames []string) string
+}
+
+// KeyTranslator is implemented by the drivers of the databases that
+// cannot index every type of column that TranslateColumn defines.
+type KeyTranslator interface {
+	// TranslateKeyColumn returns the definition of a column
+	// of the type that is part of the primary key of a table.
+	TranslateKeyColumn(typ flux.ColType, name string) (string, error)
+}
+
+// DurationBinder is implemented by the drivers of the databases
+// that have a type for durations. The other drivers bind the
+// number of nanoseconds of the durations without months.
+type DurationBinder interface {
+	// BindDuration translates the duration into the value of the type.
+	BindDuration(d values.Duration) (interface{}, error)
+}
+
+// Explainer is implemented by the drivers of the databases that
+// estimate the number of rows of a query without running it.
+type Explainer interface {
+	// Explain returns the number of rows the database expects the query to return.
+	Explain(ctx context.Context, db *sql.DB, query string, args []interface{}) (int64, error)
+}
+
+var (
+	driversMu sync.RWMutex
+	drivers   = make(map[string]Driver)
+)
+
+// RegisterDriver makes the driver available to sql.from and sql.to
+// for the database/sql driver with the name.
+// It panics if the driver is nil or a driver is already registered for the name.
+func RegisterDriver(name string, driver Driver) {
+	driversMu.Lock()
+	defer driversMu.Unlock()
+	if driver == nil {
+		panic("sql: RegisterDriver driver is nil")
+	}
+	if _, dup := drivers[name]; dup {
+		panic("sql: RegisterDriver called twice for driver " + name)
+	}
+	drivers[name] = driver
+}
+
+func lookupDriver(name string) (Driver, error) {
+	driversMu.RLock()
+	defer driversMu.RUnlock()
+	driver, ok := drivers[name]
+	if !ok {
+		return nil, errors.Newf(codes.Invalid, "sql driver %s not supported", name)
+	}
+	return driver, nil
+}
+
+func init() {
+	RegisterDriver("mysql", mysqlDriver{})
+	RegisterDriver("postgres", postgresDriver{})
+	RegisterDriver("sqlmock", postgresDriver{})
+	RegisterDriver("sqlite3", sqliteDriver{})
+	RegisterDriver("sqlserver", sqlServerDriver{})
+	RegisterDriver("clickhouse", clickHouseDriver{})
+}
+
+// parseURL parses a data source name that follows the URI semantics.
+func parseURL(dataSourceName string) (*neturl.URL, error) {
+	u, err := neturl.Parse(dataSourceName)
+	if err != nil {
+		return nil, errors.Newf(codes.Invalid, "invalid data source url: %v", err)
+	}
+	return u, nil
+}
+
+// validateURL validates the url of a data source with the validator.
+func validateURL(validator url.Validator, u *neturl.URL) error {
+	if err := validator.Validate(u); err != nil {
+		return errors.Newf(codes.Invalid, "data source did not pass url validation: %v", err)
+	}
+	return nil
+}
@@ -0,0 +1,191 @@
+package sql_test
+
+import (
+	"database/sql"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/codes"
+	"github.com/influxdata/flux/dependencies/url"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/execute/executetest"
+	"github.com/influxdata/flux/internal/errors"
+	"github.com/influxdata/flux/plan"
+	fsql "github.com/influxdata/flux/stdlib/sql"
+	"github.com/mattn/go-sqlite3"
+)
+
+// numberedDriver is the driver of a database/sql driver that Flux does not know.
+// It numbers the parameters of the statements of SQLite.
+type numberedDriver struct{}
+
+func (numberedDriver) ValidateDataSource(validator url.Validator, dataSourceName string) error {
+	return nil
+}
+
+func (numberedDriver) NewRowReader(rows *sql.Rows) (execute.RowReader, error) {
+	return fsql.NewColumnTypeRowReader(rows, func(typ *sql.ColumnType) flux.ColType {
+		switch typ.DatabaseTypeName() {
+		case "INTEGER":
+			return flux.TInt
+		case "REAL":
+			return flux.TFloat
+		default:
+			return flux.TString
+		}
+	})
+}
+
+func (numberedDriver) TranslateColumn(typ flux.ColType, name string) (string, error) {
+	switch typ {
+	case flux.TInt:
+		return name + " INTEGER", nil
+	case flux.TFloat:
+		return name + " REAL", nil
+	case flux.TString:
+		return name + " TEXT", nil
+	default:
+		return "", errors.Newf(codes.Invalid, "unsupported column type %s", typ)
+	}
+}
+
+func (numberedDriver) Placeholder(n int) string {
+	return "?" + strconv.Itoa(n)
+}
+
+func (numberedDriver) QuoteIdentifier(name string) string {
+	return `"` + name + `"`
+}
+
+// SQLite updates the rows that conflict with the keys with the excluded values.
+func (numberedDriver) UpsertClause(keys, colNames []string) string {
+	var updates []string
+	for _, col := range colNames {
+		updates = append(updates, col+"=excluded."+col)
+	}
+	return " ON CONFLICT (" + strings.Join(keys, ",") + ") DO UPDATE SET " + strings.Join(updates, ",")
+}
+
+func init() {
+	sql.Register("sqlite3-numbered", &sqlite3.SQLiteDriver{})
+	fsql.RegisterDriver("sqlite3-numbered", numberedDriver{})
+}
+
+func TestRegisterDriver(t *testing.T) {
+	// The shared cache keeps the in-memory database
+	// while the connection of the test is open.
+	dsn := "file:registry?mode=memory&cache=shared"
+	db, err := sql.Open("sqlite3-numbered", dsn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = db.Close() }()
+	if err := db.Ping(); err != nil {
+		t.Fatal(err)
+	}
+
+	runPushdownScript(t, `
+import "csv"
+import "sql"
+
+data = "
+#datatype,string,long,string,long
+#group,false,false,false,false
+#default,_result,,,
+,result,table,host,value
+,,0,a,1
+,,0,b,2
+,,0,c,3
+"
+
+csv.from(csv: data)
+	|> sql.to(driverName: "sqlite3-numbered", dataSourceName: "`+dsn+`", table: "hosts")
+`)
+
+	got, ps := runPushdownScript(t, `
+import "sql"
+
+sql.from(driverName: "sqlite3-numbered", dataSourceName: "`+dsn+`", query: "SELECT host, value FROM hosts")
+	|> filter(fn: (r) => r.value > 1 and r.host != "c")
+`)
+	want := []*executetest.Table{{
+		ColMeta: []flux.ColMeta{
+			{Label: "host", Type: flux.TString},
+			{Label: "value", Type: flux.TInt},
+		},
+		Data: [][]interface{}{
+			{"b", int64(2)},
+		},
+	}}
+	executetest.NormalizeTables(want)
+	if !cmp.Equal(want, got) {
+		t.Errorf("unexpected tables -want/+got:\n%s", cmp.Diff(want, got))
+	}
+	if want, got := []plan.ProcedureKind{fsql.FromSQLKind}, planKinds(ps); !cmp.Equal(want, got) {
+		t.Errorf("unexpected plan -want/+got:\n%s", cmp.Diff(want, got))
+	}
+}
+
+func TestRegisterDriver_Upsert(t *testing.T) {
+	dsn := "file:registry_upsert?mode=memory&cache=shared"
+	db, err := sql.Open("sqlite3-numbered", dsn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = db.Close() }()
+	if err := db.Ping(); err != nil {
+		t.Fatal(err)
+	}
+
+	write := func(rows string) string {
+		return `
+import "csv"
+import "sql"
+
+data = "
+#datatype,string,long,string,long
+#group,false,false,false,false
+#default,_result,,,
+,result,table,host,value
+` + rows + `
+"
+
+csv.from(csv: data)
+	|> sql.to(driverName: "sqlite3-numbered", dataSourceName: "` + dsn + `", table: "hosts", upsert: {keys: ["host"]})
+`
+	}
+	runPushdownScript(t, write(",,0,a,1\n,,0,b,2"))
+	runPushdownScript(t, write(",,0,b,3"))
+
+	got, _ := runPushdownScript(t, `
+import "sql"
+
+sql.from(driverName: "sqlite3-numbered", dataSourceName: "`+dsn+`", query: "SELECT host, value FROM hosts ORDER BY host")
+`)
+	want := []*executetest.Table{{
+		ColMeta: []flux.ColMeta{
+			{Label: "host", Type: flux.TString},
+			{Label: "value", Type: flux.TInt},
+		},
+		Data: [][]interface{}{
+			{"a", int64(1)},
+			{"b", int64(3)},
+		},
+	}}
+	executetest.NormalizeTables(want)
+	if !cmp.Equal(want, got) {
+		t.Errorf("unexpected tables -want/+got:\n%s", cmp.Diff(want, got))
+	}
+}
+
+func TestRegisterDriver_Duplicate(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("expected registering a driver twice to panic")
+		}
+	}()
+	fsql.RegisterDriver("postgres", numberedDriver{})
+}
@@ -0,0 +1,74 @@
+package sql
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/plan"
+)
+
+// explainTimeout bounds the time the planner waits for
+// the database to explain a query.
+const explainTimeout = 5 * time.Second
+
+// SetEstimationContext implements plan.StatisticsEstimator.
+func (s *FromSQLProcedureSpec) SetEstimationContext(ctx context.Context) {
+	s.estimationCtx = ctx
+}
+
+// resetStatistics discards the statistics of the spec
+// so they are estimated again for a modified query.
+func (s *FromSQLProcedureSpec) resetStatistics() {
+	s.Statistics = plan.UnknownStatistics()
+	s.estimated = false
+}
+
+// EstimateStatistics implements plan.StatisticsEstimator.
+// It asks the database for the number of rows it expects the query to return.
+// Drivers that do not report row estimates leave the statistics unknown.
+func (s *FromSQLProcedureSpec) EstimateStatistics() {
+	if s.estimationCtx == nil || s.estimated {
+		return
+	}
+	s.estimated = true
+	ctx := s.estimationCtx
+
+	driver, err := lookupDriver(s.DriverName)
+	if err != nil {
+		return
+	}
+	explainer, ok := driver.(Explainer)
+	if !ok {
+		return
+	}
+
+	// The data source must pass the same validation as
+	// when the query is executed before we connect to it.
+	validator, err := flux.GetDependencies(ctx).URLValidator()
+	if err != nil {
+		return
+	}
+	if err := validateDataSource(validator, s.DriverName, s.DataSourceName); err != nil {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, explainTimeout)
+	defer cancel()
+
+	db, err := sql.Open(s.DriverName, s.DataSourceName)
+	if err != nil {
+		return
+	}
+	defer func() { _ = db.Close() }()
+
+	n, err := explainer.Explain(ctx, db, s.Query, s.Args)
+	if err != nil {
+		return
+	}
+	s.Statistics = plan.Statistics{
+		Cardinality:      n,
+		GroupCardinality: 1,
+	}
+}
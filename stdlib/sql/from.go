@@ -23,6 +23,12 @@ type FromSQLOpSpec struct {
 	DriverName     string `json:"driverName,omitempty"`
 	DataSourceName string `json:"dataSourceName,omitempty"`
 	Query          string `json:"query,omitempty"`
+	// Args are bound to the placeholders of the query.
+	// They are translated to the values that the driver expects.
+	Args []interface{} `json:"args,omitempty"`
+	// Schema forces the types of the columns whose type the driver
+	// cannot report unambiguously.
+	Schema map[string]flux.ColType `json:"schema,omitempty"`
 }
 
 func init() {
@@ -31,6 +37,8 @@ func init() {
 			"driverName":     semantic.String,
 			"dataSourceName": semantic.String,
 			"query":          semantic.String,
+			"args":           semantic.NewArrayPolyType(semantic.Tvar(1)),
+			"schema":         semantic.Object,
 		},
 		Required: semantic.LabelSet{"driverName", "dataSourceName", "query"},
 		Return:   flux.TableObjectType,
@@ -38,6 +46,12 @@ func init() {
 	flux.RegisterPackageValue("sql", "from", flux.FunctionValue(FromSQLKind, createFromSQLOpSpec, fromSQLSignature))
 	flux.RegisterOpSpec(FromSQLKind, newFromSQLOp)
 	plan.RegisterProcedureSpec(FromSQLKind, newFromSQLProcedure, FromSQLKind)
+	plan.RegisterPhysicalRules(
+		SQLRangeRewriteRule{},
+		SQLFilterRewriteRule{},
+		SQLKeepDropRewriteRule{},
+		SQLLimitRewriteRule{},
+	)
 	execute.RegisterSource(FromSQLKind, createFromSQLSource)
 }
 
@@ -59,6 +73,23 @@ func createFromSQLOpSpec(args flux.Arguments, administration *flux.Administratio
 	} else {
 		spec.Query = query
 	}
+	if args, ok := args.Get("args"); ok {
+		if args.Type().Nature() != semantic.Array {
+			return nil, errors.Newf(codes.Invalid, "args must be an array, got %v", args.Type())
+		}
+		bound, err := bindArgs(spec.DriverName, args.Array())
+		if err != nil {
+			return nil, err
+		}
+		spec.Args = bound
+	}
+	if schema, ok, err := args.GetObject("schema"); err != nil {
+		return nil, err
+	} else if ok {
+		if spec.Schema, err = readSchema(schema); err != nil {
+			return nil, err
+		}
+	}
 	return spec, nil
 }
 
@@ -71,10 +102,27 @@ func (s *FromSQLOpSpec) Kind() flux.OperationKind {
 }
 
 type FromSQLProcedureSpec struct {
-	plan.DefaultCost
 	DriverName     string
 	DataSourceName string
 	Query          string
+	Args           []interface{}
+	Schema         map[string]flux.ColType
+
+	// Statistics are estimated by asking the database to explain
+	// the query. They are unknown until EstimateStatistics is called
+	// with an estimation context set by the planner.
+	Statistics    plan.Statistics
+	estimationCtx context.Context
+	estimated     bool
+
+	// The operations that are pushed into the query by the planner.
+	// Filters are pushed as conditions and DropEmptyTables is set
+	// when a filter drops the tables that it empties.
+	Range           *Range
+	Conditions      []*Condition
+	Projections     []Projection
+	Limit           *Limit
+	DropEmptyTables bool
 }
 
 func newFromSQLProcedure(qs flux.OperationSpec, pa plan.Administration) (plan.ProcedureSpec, error) {
@@ -87,6 +135,9 @@ func newFromSQLProcedure(qs flux.OperationSpec, pa plan.Administration) (plan.Pr
 		DriverName:     spec.DriverName,
 		DataSourceName: spec.DataSourceName,
 		Query:          spec.Query,
+		Args:           spec.Args,
+		Schema:         spec.Schema,
+		Statistics:     plan.UnknownStatistics(),
 	}, nil
 }
 
@@ -99,9 +150,45 @@ func (s *FromSQLProcedureSpec) Copy() plan.ProcedureSpec {
 	ns.DriverName = s.DriverName
 	ns.DataSourceName = s.DataSourceName
 	ns.Query = s.Query
+	// Args and the schema are not modified once they are read.
+	ns.Args = s.Args
+	ns.Schema = s.Schema
+	ns.Statistics = s.Statistics
+	ns.estimationCtx = s.estimationCtx
+	ns.estimated = s.estimated
+	if s.Range != nil {
+		r := *s.Range
+		ns.Range = &r
+	}
+	// Conditions and projections are not modified once they are pushed.
+	ns.Conditions = append([]*Condition(nil), s.Conditions...)
+	ns.Projections = append([]Projection(nil), s.Projections...)
+	if s.Limit != nil {
+		l := *s.Limit
+		ns.Limit = &l
+	}
+	ns.DropEmptyTables = s.DropEmptyTables
 	return ns
 }
 
+// TimeBounds implements plan.BoundsAwareProcedureSpec.
+// The bounds are known when a range is pushed into the query.
+func (s *FromSQLProcedureSpec) TimeBounds(predecessorBounds *plan.Bounds) *plan.Bounds {
+	if s.Range == nil {
+		return predecessorBounds
+	}
+	return &plan.Bounds{
+		Start: s.Range.Start,
+		Stop:  s.Range.Stop,
+	}
+}
+
+// Cost reports the statistics estimated by the database.
+// The rows are read over the network from the database.
+func (s *FromSQLProcedureSpec) Cost(inStats []plan.Statistics) (plan.Cost, plan.Statistics) {
+	return plan.Cost{NET: s.Statistics.Rows()}, s.Statistics
+}
+
 func createFromSQLSource(prSpec plan.ProcedureSpec, dsid execute.DatasetID, a execute.Administration) (execute.Source, error) {
 	spec, ok := prSpec.(*FromSQLProcedureSpec)
 	if !ok {
@@ -119,25 +206,30 @@ func createFromSQLSource(prSpec plan.ProcedureSpec, dsid execute.DatasetID, a ex
 	}
 
 	// Retrieve the row reader implementation for the driver.
-	var newRowReader func(rows *sql.Rows) (execute.RowReader, error)
-	switch spec.DriverName {
-	case "mysql":
-		newRowReader = NewMySQLRowReader
-	case "sqlite3":
-		newRowReader = NewSqliteRowReader
-	case "postgres", "sqlmock":
-		newRowReader = NewPostgresRowReader
-	default:
-		return nil, errors.Newf(codes.Invalid, "sql driver %s not supported", spec.DriverName)
+	driver, err := lookupDriver(spec.DriverName)
+	if err != nil {
+		return nil, err
 	}
 
 	readFn := func(ctx context.Context, rows *sql.Rows) (flux.Table, error) {
-		reader, err := newRowReader(rows)
+		reader, err := driver.NewRowReader(rows)
 		if err != nil {
 			_ = rows.Close()
 			return nil, err
 		}
-		return read(ctx, reader, a.Allocator())
+		if len(spec.Schema) > 0 {
+			reader = newSchemaRowReader(reader, spec.Schema)
+		}
+		key := execute.NewGroupKey(nil, nil)
+		if spec.Range != nil || len(spec.Projections) > 0 {
+			pr, err := newPushdownRowReader(spec, reader)
+			if err != nil {
+				_ = reader.Close()
+				return nil, err
+			}
+			reader, key = pr, pr.key
+		}
+		return read(ctx, reader, key, a.Allocator())
 	}
 	iterator := &sqlIterator{spec: spec, id: dsid, read: readFn}
 	return execute.CreateSourceFromIterator(iterator, dsid)
@@ -171,7 +263,11 @@ func (c *sqlIterator) Do(ctx context.Context, f func(flux.Table) error) error {
 	}
 	defer func() { _ = db.Close() }()
 
-	rows, err := db.QueryContext(ctx, c.spec.Query)
+	query, args, err := c.spec.buildQuery(ctx, db)
+	if err != nil {
+		return err
+	}
+	rows, err := db.QueryContext(ctx, query, args...)
 	if err != nil {
 		return err
 	}
@@ -181,16 +277,20 @@ func (c *sqlIterator) Do(ctx context.Context, f func(flux.Table) error) error {
 	if err != nil {
 		return err
 	}
+	if c.spec.DropEmptyTables && table.Empty() {
+		table.Done()
+		return nil
+	}
 	return f(table)
 }
 
 // read will use the RowReader to construct a flux.Table.
-func read(ctx context.Context, reader execute.RowReader, alloc *memory.Allocator) (flux.Table, error) {
+// The group key holds the columns that have the same value in every row.
+func read(ctx context.Context, reader execute.RowReader, groupKey flux.GroupKey, alloc *memory.Allocator) (flux.Table, error) {
 	// Ensure that the reader is always freed so the underlying
 	// cursor can be returned.
 	defer func() { _ = reader.Close() }()
 
-	groupKey := execute.NewGroupKey(nil, nil)
 	builder := execute.NewColListTableBuilder(groupKey, alloc)
 	for i, dataType := range reader.ColumnTypes() {
 		if _, err := builder.AddCol(flux.ColMeta{Label: reader.ColumnNames()[i], Type: dataType}); err != nil {
@@ -0,0 +1,113 @@
+package sql_test
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/execute/executetest"
+	"github.com/influxdata/flux/querytest"
+	fsql "github.com/influxdata/flux/stdlib/sql"
+)
+
+func TestSqlFrom(t *testing.T) {
+	tests := []querytest.NewQueryTestCase{
+		{
+			Name: "from with args and schema",
+			Raw: `import "sql"
+sql.from(
+	driverName: "postgres",
+	dataSourceName: "postgres://localhost/db",
+	query: "SELECT * FROM t WHERE _time >= $1 AND _time < $2",
+	args: [2020-01-01T00:00:00Z, 2020-01-02T00:00:00Z],
+	schema: {value: "float", count: "uint"},
+)`,
+			Want: &flux.Spec{
+				Operations: []*flux.Operation{
+					{
+						ID: "fromSQL0",
+						Spec: &fsql.FromSQLOpSpec{
+							DriverName:     "postgres",
+							DataSourceName: "postgres://localhost/db",
+							Query:          "SELECT * FROM t WHERE _time >= $1 AND _time < $2",
+							Args: []interface{}{
+								time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+								time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC),
+							},
+							Schema: map[string]flux.ColType{
+								"value": flux.TFloat,
+								"count": flux.TUInt,
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			Name: "unknown schema type",
+			Raw: `import "sql"
+sql.from(driverName: "postgres", dataSourceName: "postgres://localhost/db", query: "SELECT * FROM t", schema: {value: "decimal"})`,
+			WantErr: true,
+		},
+		{
+			Name: "duration with months",
+			Raw: `import "sql"
+sql.from(driverName: "mysql", dataSourceName: "root@/db", query: "SELECT * FROM t WHERE d < ?", args: [1mo])`,
+			WantErr: true,
+		},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			querytest.NewQueryTestHelper(t, tc)
+		})
+	}
+}
+
+func TestFromSQL_ArgsSchema(t *testing.T) {
+	// The shared cache keeps the in-memory database
+	// while the connection of the test is open.
+	dsn := "file:args?mode=memory&cache=shared"
+	db, err := sql.Open("sqlite3", dsn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = db.Close() }()
+	if _, err := db.Exec(`CREATE TABLE prices (host TEXT, price NUMERIC)`); err != nil {
+		t.Fatal(err)
+	}
+	// SQLite stores the integral values of the NUMERIC column as integers.
+	if _, err := db.Exec(`INSERT INTO prices VALUES ('a', 1), ('a', 2.5), ('b', 3), ('a', NULL), ('a', 4)`); err != nil {
+		t.Fatal(err)
+	}
+
+	got, _ := runPushdownScript(t, `
+import "sql"
+
+sql.from(
+	driverName: "sqlite3",
+	dataSourceName: "`+dsn+`",
+	query: "SELECT host, price FROM prices WHERE host = ?",
+	args: ["a"],
+	schema: {price: "float"},
+)
+	|> filter(fn: (r) => r.price > 1.0)
+`)
+	want := []*executetest.Table{{
+		ColMeta: []flux.ColMeta{
+			{Label: "host", Type: flux.TString},
+			{Label: "price", Type: flux.TFloat},
+		},
+		Data: [][]interface{}{
+			{"a", 2.5},
+			{"a", 4.0},
+		},
+	}}
+	executetest.NormalizeTables(want)
+	if !cmp.Equal(want, got) {
+		t.Errorf("unexpected tables -want/+got:\n%s", cmp.Diff(want, got))
+	}
+}
@@ -1,13 +1,18 @@
 package sql
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
+	neturl "net/url"
 	"strconv"
+	"strings"
 	"time"
 
+	"github.com/go-sql-driver/mysql"
 	"github.com/influxdata/flux"
 	"github.com/influxdata/flux/codes"
+	"github.com/influxdata/flux/dependencies/url"
 	"github.com/influxdata/flux/execute"
 	"github.com/influxdata/flux/internal/errors"
 	"github.com/influxdata/flux/values"
@@ -173,6 +178,10 @@ func NewMySQLRowReader(r *sql.Rows) (execute.RowReader, error) {
 	return reader, nil
 }
 
+// mysqlKeyStringType is the type of the string columns of a primary key.
+// MySQL cannot index the whole value of a TEXT column.
+const mysqlKeyStringType = "VARCHAR(255)"
+
 // MysqlTranslateColumn translates flux colTypes into their corresponding MySQL column type
 func MysqlColumnTranslateFunc() translationFunc {
 	c := map[string]string{
@@ -193,3 +202,146 @@ func MysqlColumnTranslateFunc() translationFunc {
 		return colName + " " + s, nil
 	}
 }
+
+// mysqlDriver is the driver of MySQL databases.
+type mysqlDriver struct{}
+
+func (mysqlDriver) ValidateDataSource(validator url.Validator, dataSourceName string) error {
+	// an example is: username:password@tcp(localhost:3306)/dbname?param=value
+	cfg, err := mysql.ParseDSN(dataSourceName)
+	if err != nil {
+		return errors.Newf(codes.Invalid, "invalid data source dsn: %v", err)
+	}
+	return validateURL(validator, &neturl.URL{
+		Scheme: cfg.Net,
+		User:   neturl.UserPassword(cfg.User, cfg.Passwd),
+		Host:   cfg.Addr,
+	})
+}
+
+func (mysqlDriver) NewRowReader(rows *sql.Rows) (execute.RowReader, error) {
+	return NewMySQLRowReader(rows)
+}
+
+func (mysqlDriver) TranslateColumn(typ flux.ColType, name string) (string, error) {
+	return MysqlColumnTranslateFunc()(typ, name)
+}
+
+func (mysqlDriver) Placeholder(int) string {
+	return "?"
+}
+
+func (mysqlDriver) QuoteIdentifier(name string) string {
+	return quoteWith("`")(name)
+}
+
+// The regular expression functions of MySQL are available since 8.0.
+// The match type is case sensitive to match the regular expressions of Flux.
+func (mysqlDriver) RegexpMatch(column, param string, not bool) string {
+	expr := "REGEXP_LIKE(" + column + ", " + param + ", 'c')"
+	if not {
+		return "NOT " + expr
+	}
+	return expr
+}
+
+func (mysqlDriver) UpsertClause(keys, colNames []string) string {
+	isKey := make(map[string]bool, len(keys))
+	for _, key := range keys {
+		isKey[key] = true
+	}
+	var updates []string
+	for _, col := range colNames {
+		if !isKey[col] {
+			updates = append(updates, fmt.Sprintf("%s=VALUES(%s)", col, col))
+		}
+	}
+	if len(updates) == 0 {
+		// There is nothing to update, so the key is assigned to itself.
+		updates = append(updates, fmt.Sprintf("%s=%s", keys[0], keys[0]))
+	}
+	return " ON DUPLICATE KEY UPDATE " + strings.Join(updates, ",")
+}
+
+// MySQL cannot index the whole value of a TEXT column.
+func (m mysqlDriver) TranslateKeyColumn(typ flux.ColType, name string) (string, error) {
+	if typ == flux.TString {
+		return name + " " + mysqlKeyStringType, nil
+	}
+	return m.TranslateColumn(typ, name)
+}
+
+// MySQL commits the transaction before it executes a statement that defines a table.
+func (mysqlDriver) CommitsDefinitions() bool {
+	return true
+}
+
+// MySQL has no type for durations with months, so they are bound as a TIME value.
+// The fractional seconds of a TIME value have a precision of microseconds.
+func (mysqlDriver) BindDuration(d values.Duration) (interface{}, error) {
+	// The months and nanoseconds of a duration are positive.
+	months, ns := d.Months(), d.Nanoseconds()
+	if months != 0 {
+		return nil, errors.Newf(codes.Invalid, "duration %v with months cannot be bound for sql driver mysql", d)
+	}
+	if ns%int64(time.Microsecond) != 0 {
+		return nil, errors.Newf(codes.Invalid, "duration %v is more precise than a mysql time", d)
+	}
+	sign := ""
+	if d.IsNegative() {
+		sign = "-"
+	}
+	h, ns := ns/int64(time.Hour), ns%int64(time.Hour)
+	m, ns := ns/int64(time.Minute), ns%int64(time.Minute)
+	s, ns := ns/int64(time.Second), ns%int64(time.Second)
+	return fmt.Sprintf("%s%02d:%02d:%02d.%06d", sign, h, m, s, ns/int64(time.Microsecond)), nil
+}
+
+// Explain reads the rows column of each table in the plan of the query
+// and uses the largest of them as the estimate.
+func (mysqlDriver) Explain(ctx context.Context, db *sql.DB, query string, args []interface{}) (int64, error) {
+	rows, err := db.QueryContext(ctx, "EXPLAIN "+query, args...)
+	if err != nil {
+		return 0, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	cols, err := rows.Columns()
+	if err != nil {
+		return 0, err
+	}
+	idx := -1
+	for i, col := range cols {
+		if col == "rows" {
+			idx = i
+			break
+		}
+	}
+	if idx < 0 {
+		return 0, errors.New(codes.Internal, "explain did not return a rows column")
+	}
+
+	var max int64
+	values := make([]sql.RawBytes, len(cols))
+	dest := make([]interface{}, len(cols))
+	for i := range values {
+		dest[i] = &values[i]
+	}
+	for rows.Next() {
+		if err := rows.Scan(dest...); err != nil {
+			return 0, err
+		}
+		// The rows column is null for tables that are not read.
+		if values[idx] == nil {
+			continue
+		}
+		n, err := strconv.ParseInt(string(values[idx]), 10, 64)
+		if err != nil {
+			return 0, err
+		}
+		if n > max {
+			max = n
+		}
+	}
+	return max, rows.Err()
+}
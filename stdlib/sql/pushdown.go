@@ -0,0 +1,686 @@
+package sql
+
+import (
+	"context"
+	"database/sql"
+	"regexp/syntax"
+	"strings"
+
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/ast"
+	"github.com/influxdata/flux/codes"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/internal/errors"
+	"github.com/influxdata/flux/plan"
+	"github.com/influxdata/flux/semantic"
+	"github.com/influxdata/flux/stdlib/universe"
+	"github.com/influxdata/flux/values"
+)
+
+// The rules in this file push range, filter, keep, drop and limit
+// into the query of sql.from. The query of the user is wrapped in
+// a generated query that selects from it as a subquery:
+//
+//   SELECT <columns> FROM (<query>) AS flux_subquery WHERE <conditions> LIMIT <n> OFFSET <offset>
+//
+// All values are passed to the database as bound parameters
+// that follow the args of the query.
+
+// Range is a range that is pushed into the query.
+// The database filters the rows by the time column
+// and the start and stop columns are added when the rows are read.
+type Range struct {
+	Start       values.Time
+	Stop        values.Time
+	TimeColumn  string
+	StartColumn string
+	StopColumn  string
+}
+
+// Condition is a filter predicate that is pushed into the query.
+// The AND and OR operators combine the operands of the condition,
+// the other operators compare the column to the value.
+type Condition struct {
+	Operator string
+	Column   string
+	Value    interface{}
+	Operands []*Condition
+}
+
+// The operators of a condition that match a regular expression.
+const (
+	regexpMatchOperator    = "=~"
+	notRegexpMatchOperator = "!~"
+)
+
+// Projection is a keep or drop that is pushed into the query.
+type Projection struct {
+	Columns []string
+	Drop    bool
+}
+
+// apply returns the columns that remain after the projection.
+// Like keep and drop, columns that do not exist are ignored.
+func (p Projection) apply(cols []string) []string {
+	set := make(map[string]bool, len(p.Columns))
+	for _, c := range p.Columns {
+		set[c] = true
+	}
+	remaining := make([]string, 0, len(cols))
+	for _, c := range cols {
+		if set[c] != p.Drop {
+			remaining = append(remaining, c)
+		}
+	}
+	return remaining
+}
+
+// Limit is a limit that is pushed into the query.
+type Limit struct {
+	N      int64
+	Offset int64
+}
+
+// dialect describes how a database quotes identifiers,
+// numbers bound parameters and matches regular expressions.
+type dialect struct {
+	quote       func(name string) string
+	placeholder func(n int) string
+	// regexp returns the expression that matches the column to the parameter
+	// with the pattern. It is nil if the database cannot match regular expressions.
+	regexp func(column, param string, not bool) string
+	// compareTimes is false if the database cannot compare
+	// its time columns to bound times.
+	compareTimes bool
+}
+
+func quoteWith(q string) func(string) string {
+	return func(name string) string {
+		return q + strings.Replace(name, q, q+q, -1) + q
+	}
+}
+
+// dialectFor returns the dialect of the driver or nil
+// if queries for the driver cannot be generated.
+func dialectFor(driverName string) *dialect {
+	driver, err := lookupDriver(driverName)
+	if err != nil {
+		return nil
+	}
+	q, ok := driver.(QueryDialect)
+	if !ok {
+		return nil
+	}
+	d := &dialect{
+		quote:        q.QuoteIdentifier,
+		placeholder:  driver.Placeholder,
+		compareTimes: true,
+	}
+	if m, ok := driver.(RegexpMatcher); ok {
+		d.regexp = m.RegexpMatch
+	}
+	if tt, ok := driver.(TextTimes); ok && tt.TimesAsText() {
+		d.compareTimes = false
+	}
+	return d
+}
+
+// pushedDown reports whether any operation is pushed into the query.
+func (s *FromSQLProcedureSpec) pushedDown() bool {
+	return s.Range != nil || len(s.Conditions) > 0 || len(s.Projections) > 0 || s.Limit != nil
+}
+
+// readable reports whether a filter reads the column from the query.
+// This is not the case for the start and stop columns of a pushed range
+// and the columns that are removed by a pushed projection.
+func (s *FromSQLProcedureSpec) readable(col string) bool {
+	if s.Range != nil && (col == s.Range.StartColumn || col == s.Range.StopColumn) {
+		return false
+	}
+	for _, p := range s.Projections {
+		if len(p.apply([]string{col})) == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// subquery returns the query of the user so that it can be used as a subquery.
+func (s *FromSQLProcedureSpec) subquery() string {
+	q := strings.TrimRight(strings.TrimSpace(s.Query), ";")
+	return "(" + q + ") AS flux_subquery"
+}
+
+// buildQuery returns the query that is sent to the database and its parameters.
+// The columns of the query are read from the database when a projection is pushed,
+// so that the projection ignores missing columns like keep and drop.
+func (s *FromSQLProcedureSpec) buildQuery(ctx context.Context, db *sql.DB) (string, []interface{}, error) {
+	if !s.pushedDown() {
+		return s.Query, s.Args, nil
+	}
+	d := dialectFor(s.DriverName)
+	if d == nil {
+		return "", nil, errors.Newf(codes.Internal, "cannot push operations into a query for sql driver %s", s.DriverName)
+	}
+
+	// The args of the query are bound before the parameters of the pushed operations.
+	b := &queryBuilder{d: d, args: append([]interface{}(nil), s.Args...)}
+	b.WriteString("SELECT ")
+	if cols, err := s.selectColumns(ctx, db); err != nil {
+		return "", nil, err
+	} else if len(cols) > 0 {
+		for i, c := range cols {
+			if i > 0 {
+				b.WriteString(", ")
+			}
+			b.WriteString(d.quote(c))
+		}
+	} else {
+		b.WriteString("*")
+	}
+	b.WriteString(" FROM ")
+	b.WriteString(s.subquery())
+
+	conds := s.Conditions
+	if s.Range != nil {
+		conds = append([]*Condition{
+			{Operator: ">=", Column: s.Range.TimeColumn, Value: s.Range.Start.Time().UTC()},
+			{Operator: "<", Column: s.Range.TimeColumn, Value: s.Range.Stop.Time().UTC()},
+		}, conds...)
+	}
+	for i, c := range conds {
+		if i == 0 {
+			b.WriteString(" WHERE ")
+		} else {
+			b.WriteString(" AND ")
+		}
+		if err := b.writeCondition(c); err != nil {
+			return "", nil, err
+		}
+	}
+
+	if s.Limit != nil {
+		b.WriteString(" LIMIT ")
+		b.WriteString(b.param(s.Limit.N))
+		if s.Limit.Offset > 0 {
+			b.WriteString(" OFFSET ")
+			b.WriteString(b.param(s.Limit.Offset))
+		}
+	}
+	return b.String(), b.args, nil
+}
+
+// selectColumns returns the columns of the query that remain after the projections.
+// It returns no columns when all of the columns are selected.
+func (s *FromSQLProcedureSpec) selectColumns(ctx context.Context, db *sql.DB) ([]string, error) {
+	if len(s.Projections) == 0 {
+		return nil, nil
+	}
+	rows, err := db.QueryContext(ctx, "SELECT * FROM "+s.subquery()+" WHERE 1 = 0", s.Args...)
+	if err != nil {
+		return nil, err
+	}
+	names, err := rows.Columns()
+	_ = rows.Close()
+	if err != nil {
+		return nil, err
+	}
+	isQueryColumn := make(map[string]bool, len(names))
+	for _, name := range names {
+		isQueryColumn[name] = true
+	}
+
+	var cols []string
+	for _, c := range s.outputColumns(names) {
+		if isQueryColumn[c] {
+			cols = append(cols, c)
+		}
+	}
+	return cols, nil
+}
+
+// outputColumns returns the columns of the table when the query returns
+// the given columns. The range adds the start and stop columns in front
+// of the columns of the query, if they do not exist, like range does.
+func (s *FromSQLProcedureSpec) outputColumns(names []string) []string {
+	cols := make([]string, 0, len(names)+2)
+	if r := s.Range; r != nil {
+		for _, c := range []string{r.StartColumn, r.StopColumn} {
+			if execute.ContainsStr(names, c) {
+				continue
+			}
+			cols = append(cols, c)
+		}
+	}
+	cols = append(cols, names...)
+	for _, p := range s.Projections {
+		cols = p.apply(cols)
+	}
+	return cols
+}
+
+type queryBuilder struct {
+	strings.Builder
+	d    *dialect
+	args []interface{}
+}
+
+// param adds a bound parameter and returns its placeholder.
+func (b *queryBuilder) param(v interface{}) string {
+	b.args = append(b.args, v)
+	return b.d.placeholder(len(b.args))
+}
+
+func (b *queryBuilder) writeCondition(c *Condition) error {
+	switch c.Operator {
+	case "AND", "OR":
+		b.WriteString("(")
+		for i, op := range c.Operands {
+			if i > 0 {
+				b.WriteString(" " + c.Operator + " ")
+			}
+			if err := b.writeCondition(op); err != nil {
+				return err
+			}
+		}
+		b.WriteString(")")
+	case regexpMatchOperator, notRegexpMatchOperator:
+		if b.d.regexp == nil {
+			return errors.New(codes.Internal, "sql dialect does not support regular expressions")
+		}
+		b.WriteString(b.d.regexp(b.d.quote(c.Column), b.param(c.Value), c.Operator == notRegexpMatchOperator))
+	default:
+		b.WriteString(b.d.quote(c.Column) + " " + c.Operator + " " + b.param(c.Value))
+	}
+	return nil
+}
+
+// pushdownRowReader adds the start and stop columns of a pushed range
+// to the rows and removes the columns of pushed projections.
+type pushdownRowReader struct {
+	execute.RowReader
+	key   flux.GroupKey
+	names []string
+	types []flux.ColType
+	// values holds the value of the range columns and is nil for the columns of the reader.
+	values []values.Value
+	// indexes are the indexes of the columns in the reader.
+	indexes []int
+}
+
+func newPushdownRowReader(spec *FromSQLProcedureSpec, reader execute.RowReader) (*pushdownRowReader, error) {
+	names, types := reader.ColumnNames(), reader.ColumnTypes()
+	rangeValues := make(map[string]values.Value, 2)
+	if r := spec.Range; r != nil {
+		rangeValues[r.StartColumn] = values.NewTime(r.Start)
+		rangeValues[r.StopColumn] = values.NewTime(r.Stop)
+		for i, name := range names {
+			if _, ok := rangeValues[name]; ok && types[i] != flux.TTime {
+				return nil, errors.Newf(codes.FailedPrecondition, "range error: provided %s column is not of type time", name)
+			}
+		}
+	}
+
+	rr := &pushdownRowReader{RowReader: reader}
+	var keyCols []flux.ColMeta
+	var keyValues []values.Value
+	for _, name := range spec.outputColumns(names) {
+		if v, ok := rangeValues[name]; ok {
+			rr.names = append(rr.names, name)
+			rr.types = append(rr.types, flux.TTime)
+			rr.values = append(rr.values, v)
+			rr.indexes = append(rr.indexes, -1)
+			keyCols = append(keyCols, flux.ColMeta{Label: name, Type: flux.TTime})
+			keyValues = append(keyValues, v)
+			continue
+		}
+		idx := execute.ColIdx(name, toColMeta(names, types))
+		rr.names = append(rr.names, name)
+		rr.types = append(rr.types, types[idx])
+		rr.values = append(rr.values, nil)
+		rr.indexes = append(rr.indexes, idx)
+	}
+	rr.key = execute.NewGroupKey(keyCols, keyValues)
+	return rr, nil
+}
+
+func toColMeta(names []string, types []flux.ColType) []flux.ColMeta {
+	cols := make([]flux.ColMeta, len(names))
+	for i := range names {
+		cols[i] = flux.ColMeta{Label: names[i], Type: types[i]}
+	}
+	return cols
+}
+
+func (m *pushdownRowReader) GetNextRow() ([]values.Value, error) {
+	in, err := m.RowReader.GetNextRow()
+	if err != nil {
+		return nil, err
+	}
+	row := make([]values.Value, len(m.indexes))
+	for i, idx := range m.indexes {
+		if idx < 0 {
+			row[i] = m.values[i]
+		} else {
+			row[i] = in[idx]
+		}
+	}
+	return row, nil
+}
+
+func (m *pushdownRowReader) ColumnNames() []string {
+	return m.names
+}
+
+func (m *pushdownRowReader) ColumnTypes() []flux.ColType {
+	return m.types
+}
+
+// mergeIntoFromSQL merges the node into its sql.from predecessor with the new spec.
+func mergeIntoFromSQL(node, from plan.Node, spec *FromSQLProcedureSpec) (plan.Node, bool, error) {
+	// The statistics of the original query do not apply to the merged query.
+	spec.resetStatistics()
+	merged, err := plan.MergeToPhysicalNode(node, from, spec)
+	if err != nil {
+		return nil, false, err
+	}
+	return merged, true, nil
+}
+
+// pushable reports whether operations can be merged into the sql.from node.
+func pushable(from plan.Node) (*FromSQLProcedureSpec, bool) {
+	spec := from.ProcedureSpec().(*FromSQLProcedureSpec)
+	return spec, len(from.Successors()) == 1 && dialectFor(spec.DriverName) != nil
+}
+
+// SQLRangeRewriteRule pushes a range into the query of sql.from.
+type SQLRangeRewriteRule struct{}
+
+func (r SQLRangeRewriteRule) Name() string {
+	return "SQLRangeRewriteRule"
+}
+
+func (r SQLRangeRewriteRule) Pattern() plan.Pattern {
+	return plan.Pat(universe.RangeKind, plan.Pat(FromSQLKind))
+}
+
+func (r SQLRangeRewriteRule) Rewrite(node plan.Node) (plan.Node, bool, error) {
+	from := node.Predecessors()[0]
+	fromSpec, ok := pushable(from)
+	// A range after a filter that drops empty tables would keep the tables
+	// that it empties, which cannot be expressed by the query.
+	if !ok || !dialectFor(fromSpec.DriverName).compareTimes || fromSpec.Range != nil || len(fromSpec.Projections) > 0 || fromSpec.Limit != nil || fromSpec.DropEmptyTables {
+		return node, false, nil
+	}
+
+	rangeSpec := node.ProcedureSpec().(*universe.RangeProcedureSpec)
+	bounds := rangeSpec.TimeBounds(nil)
+	newSpec := fromSpec.Copy().(*FromSQLProcedureSpec)
+	newSpec.Range = &Range{
+		Start:       bounds.Start,
+		Stop:        bounds.Stop,
+		TimeColumn:  rangeSpec.TimeColumn,
+		StartColumn: rangeSpec.StartColumn,
+		StopColumn:  rangeSpec.StopColumn,
+	}
+	return mergeIntoFromSQL(node, from, newSpec)
+}
+
+// SQLFilterRewriteRule pushes a filter into the query of sql.from
+// if the database can evaluate the whole predicate.
+type SQLFilterRewriteRule struct{}
+
+func (r SQLFilterRewriteRule) Name() string {
+	return "SQLFilterRewriteRule"
+}
+
+func (r SQLFilterRewriteRule) Pattern() plan.Pattern {
+	return plan.Pat(universe.FilterKind, plan.Pat(FromSQLKind))
+}
+
+func (r SQLFilterRewriteRule) Rewrite(node plan.Node) (plan.Node, bool, error) {
+	from := node.Predecessors()[0]
+	fromSpec, ok := pushable(from)
+	if !ok || fromSpec.Limit != nil {
+		return node, false, nil
+	}
+
+	filterSpec := node.ProcedureSpec().(*universe.FilterProcedureSpec)
+	if fromSpec.DropEmptyTables && filterSpec.KeepEmptyTables {
+		return node, false, nil
+	}
+	fn := filterSpec.Fn.Fn
+	if fn == nil || fn.Block == nil || fn.Block.Parameters == nil || len(fn.Block.Parameters.List) != 1 {
+		return node, false, nil
+	}
+	body, ok := fn.Block.Body.(semantic.Expression)
+	if !ok {
+		return node, false, nil
+	}
+	t := &conditionTranslator{
+		spec:  fromSpec,
+		d:     dialectFor(fromSpec.DriverName),
+		param: fn.Block.Parameters.List[0].Key.Name,
+	}
+	cond, ok := t.translate(body)
+	if !ok {
+		return node, false, nil
+	}
+
+	newSpec := fromSpec.Copy().(*FromSQLProcedureSpec)
+	newSpec.Conditions = append(newSpec.Conditions, cond)
+	newSpec.DropEmptyTables = newSpec.DropEmptyTables || !filterSpec.KeepEmptyTables
+	return mergeIntoFromSQL(node, from, newSpec)
+}
+
+// conditionTranslator translates the body of a filter function into a condition.
+type conditionTranslator struct {
+	spec  *FromSQLProcedureSpec
+	d     *dialect
+	param string
+}
+
+var comparisonOperators = map[ast.OperatorKind]string{
+	ast.EqualOperator:            "=",
+	ast.NotEqualOperator:         "<>",
+	ast.LessThanOperator:         "<",
+	ast.LessThanEqualOperator:    "<=",
+	ast.GreaterThanOperator:      ">",
+	ast.GreaterThanEqualOperator: ">=",
+	ast.RegexpMatchOperator:      regexpMatchOperator,
+	ast.NotRegexpMatchOperator:   notRegexpMatchOperator,
+}
+
+// flippedOperators are the operators of a comparison
+// when the value is on the left of the column.
+var flippedOperators = map[string]string{
+	"=":  "=",
+	"<>": "<>",
+	"<":  ">",
+	"<=": ">=",
+	">":  "<",
+	">=": "<=",
+}
+
+// translate returns the condition of the expression.
+// Comparisons return null for null values in Flux and in SQL,
+// so a row is filtered by the condition exactly when it is filtered by the predicate.
+// Negations are not translated because they do not preserve this.
+func (t *conditionTranslator) translate(expr semantic.Expression) (*Condition, bool) {
+	switch e := expr.(type) {
+	case *semantic.LogicalExpression:
+		left, ok := t.translate(e.Left)
+		if !ok {
+			return nil, false
+		}
+		right, ok := t.translate(e.Right)
+		if !ok {
+			return nil, false
+		}
+		op := "AND"
+		if e.Operator == ast.OrOperator {
+			op = "OR"
+		}
+		return &Condition{Operator: op, Operands: []*Condition{left, right}}, true
+	case *semantic.BinaryExpression:
+		op, ok := comparisonOperators[e.Operator]
+		if !ok {
+			return nil, false
+		}
+		if col, ok := t.column(e.Left); ok {
+			return t.compare(op, col, e.Right)
+		}
+		if col, ok := t.column(e.Right); ok {
+			if op, ok = flippedOperators[op]; ok {
+				return t.compare(op, col, e.Left)
+			}
+		}
+	}
+	return nil, false
+}
+
+// column returns the column of a member expression of the record.
+func (t *conditionTranslator) column(expr semantic.Expression) (string, bool) {
+	m, ok := expr.(*semantic.MemberExpression)
+	if !ok {
+		return "", false
+	}
+	obj, ok := m.Object.(*semantic.IdentifierExpression)
+	if !ok || obj.Name != t.param || !t.spec.readable(m.Property) {
+		return "", false
+	}
+	return m.Property, true
+}
+
+func (t *conditionTranslator) compare(op, col string, expr semantic.Expression) (*Condition, bool) {
+	isRegexpOp := op == regexpMatchOperator || op == notRegexpMatchOperator
+	if re, ok := expr.(*semantic.RegexpLiteral); ok || isRegexpOp {
+		if !ok || !isRegexpOp || t.d.regexp == nil || !portableRegexp(re.Value.String()) {
+			return nil, false
+		}
+		return &Condition{Operator: op, Column: col, Value: re.Value.String()}, true
+	}
+
+	var v interface{}
+	switch lit := expr.(type) {
+	case *semantic.StringLiteral:
+		v = lit.Value
+	case *semantic.IntegerLiteral:
+		v = lit.Value
+	case *semantic.FloatLiteral:
+		v = lit.Value
+	case *semantic.BooleanLiteral:
+		if op != "=" && op != "<>" {
+			return nil, false
+		}
+		v = lit.Value
+	case *semantic.DateTimeLiteral:
+		if !t.d.compareTimes {
+			return nil, false
+		}
+		v = lit.Value.UTC()
+	default:
+		return nil, false
+	}
+	return &Condition{Operator: op, Column: col, Value: v}, true
+}
+
+// portableRegexp reports whether the pattern only uses the syntax that
+// the regular expressions of the databases interpret like Go does.
+func portableRegexp(pattern string) bool {
+	re, err := syntax.Parse(pattern, syntax.Perl)
+	if err != nil {
+		return false
+	}
+	var portable func(re *syntax.Regexp) bool
+	portable = func(re *syntax.Regexp) bool {
+		if re.Flags&syntax.FoldCase != 0 {
+			return false
+		}
+		switch re.Op {
+		case syntax.OpLiteral, syntax.OpCharClass, syntax.OpAnyCharNotNL, syntax.OpEmptyMatch,
+			syntax.OpBeginText, syntax.OpEndText, syntax.OpCapture, syntax.OpStar, syntax.OpPlus,
+			syntax.OpQuest, syntax.OpRepeat, syntax.OpConcat, syntax.OpAlternate:
+		default:
+			return false
+		}
+		for _, sub := range re.Sub {
+			if !portable(sub) {
+				return false
+			}
+		}
+		return true
+	}
+	return portable(re)
+}
+
+// SQLKeepDropRewriteRule pushes keep and drop with a list of columns into the query of sql.from.
+type SQLKeepDropRewriteRule struct{}
+
+func (r SQLKeepDropRewriteRule) Name() string {
+	return "SQLKeepDropRewriteRule"
+}
+
+func (r SQLKeepDropRewriteRule) Pattern() plan.Pattern {
+	return plan.Pat(universe.SchemaMutationKind, plan.Pat(FromSQLKind))
+}
+
+func (r SQLKeepDropRewriteRule) Rewrite(node plan.Node) (plan.Node, bool, error) {
+	from := node.Predecessors()[0]
+	fromSpec, ok := pushable(from)
+	if !ok {
+		return node, false, nil
+	}
+
+	mutations := node.ProcedureSpec().(*universe.SchemaMutationProcedureSpec).Mutations
+	projections := make([]Projection, 0, len(mutations))
+	for _, m := range mutations {
+		switch m := m.(type) {
+		case *universe.KeepOpSpec:
+			if m.Predicate.Fn != nil {
+				return node, false, nil
+			}
+			projections = append(projections, Projection{Columns: m.Columns})
+		case *universe.DropOpSpec:
+			if m.Predicate.Fn != nil {
+				return node, false, nil
+			}
+			projections = append(projections, Projection{Columns: m.Columns, Drop: true})
+		default:
+			return node, false, nil
+		}
+	}
+
+	newSpec := fromSpec.Copy().(*FromSQLProcedureSpec)
+	newSpec.Projections = append(newSpec.Projections, projections...)
+	return mergeIntoFromSQL(node, from, newSpec)
+}
+
+// SQLLimitRewriteRule pushes a limit into the query of sql.from.
+type SQLLimitRewriteRule struct{}
+
+func (r SQLLimitRewriteRule) Name() string {
+	return "SQLLimitRewriteRule"
+}
+
+func (r SQLLimitRewriteRule) Pattern() plan.Pattern {
+	return plan.Pat(universe.LimitKind, plan.Pat(FromSQLKind))
+}
+
+func (r SQLLimitRewriteRule) Rewrite(node plan.Node) (plan.Node, bool, error) {
+	from := node.Predecessors()[0]
+	fromSpec, ok := pushable(from)
+	if !ok || fromSpec.Limit != nil {
+		return node, false, nil
+	}
+
+	limitSpec := node.ProcedureSpec().(*universe.LimitProcedureSpec)
+	// A limit that empties the table would keep it,
+	// but a pushed filter drops the empty table.
+	if fromSpec.DropEmptyTables && (limitSpec.N <= 0 || limitSpec.Offset > 0) {
+		return node, false, nil
+	}
+	newSpec := fromSpec.Copy().(*FromSQLProcedureSpec)
+	newSpec.Limit = &Limit{N: limitSpec.N, Offset: limitSpec.Offset}
+	return mergeIntoFromSQL(node, from, newSpec)
+}
@@ -0,0 +1,276 @@
+package sql_test
+
+import (
+	"context"
+	"database/sql"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/go-cmp/cmp"
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/execute/executetest"
+	"github.com/influxdata/flux/lang"
+	"github.com/influxdata/flux/lang/langtest"
+	"github.com/influxdata/flux/plan"
+	fsql "github.com/influxdata/flux/stdlib/sql"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+var withoutPushdown = lang.WithPhysPlanOpts(plan.RemovePhysicalRules(
+	fsql.SQLRangeRewriteRule{}.Name(),
+	fsql.SQLFilterRewriteRule{}.Name(),
+	fsql.SQLKeepDropRewriteRule{}.Name(),
+	fsql.SQLLimitRewriteRule{}.Name(),
+))
+
+func runPushdownScript(t *testing.T, script string, opts ...lang.CompileOption) ([]*executetest.Table, *plan.Spec) {
+	t.Helper()
+	ctx := executetest.NewTestExecuteDependencies().Inject(context.Background())
+	tables, ps, err := langtest.RunScript(ctx, script, time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC), opts...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return tables, ps
+}
+
+// planKinds returns the kinds of the procedures of the plan from the sources to the yields,
+// without the yields.
+func planKinds(ps *plan.Spec) []plan.ProcedureKind {
+	var kinds []plan.ProcedureKind
+	_ = ps.BottomUpWalk(func(node plan.Node) error {
+		if _, ok := node.ProcedureSpec().(plan.YieldProcedureSpec); ok {
+			return nil
+		}
+		kinds = append(kinds, node.Kind())
+		return nil
+	})
+	return kinds
+}
+
+func TestFromSQLPushdown_Sqlite(t *testing.T) {
+	// The shared cache keeps the in-memory database
+	// while the connection of the test is open.
+	dsn := "file:pushdown?mode=memory&cache=shared"
+	db, err := sql.Open("sqlite3", dsn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = db.Close() }()
+	if _, err := db.Exec(`CREATE TABLE cpu (_time DATETIME, host TEXT, region TEXT, usage FLOAT)`); err != nil {
+		t.Fatal(err)
+	}
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	for i, row := range []struct {
+		host, region string
+		usage        float64
+	}{
+		{"a", "east", 1},
+		{"b", "east", 2},
+		{"a", "west", 3},
+		{"b", "west", 4},
+		{"a", "east", 5},
+		{"c", "east", 6},
+	} {
+		ts := start.Add(time.Duration(i) * time.Hour)
+		if _, err := db.Exec(`INSERT INTO cpu VALUES (?, ?, ?, ?)`, ts, row.host, row.region, row.usage); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	from := `import "sql"
+sql.from(driverName: "sqlite3", dataSourceName: "` + dsn + `", query: "SELECT * FROM cpu")`
+	for _, tc := range []struct {
+		name     string
+		pipeline string
+		want     []plan.ProcedureKind
+	}{
+		{
+			name: "all",
+			pipeline: `
+	|> filter(fn: (r) => r.host == "a" or (r.usage >= 4.0 and r.region != "east"))
+	|> keep(columns: ["_time", "usage"])
+	|> limit(n: 2)`,
+			want: []plan.ProcedureKind{fsql.FromSQLKind},
+		},
+		{
+			// SQLite stores times as text, which does not compare like times.
+			name: "range",
+			pipeline: `
+	|> range(start: 2020-01-01T01:00:00Z, stop: 2020-01-01T05:00:00Z)`,
+			want: []plan.ProcedureKind{fsql.FromSQLKind, "range"},
+		},
+		{
+			name: "time filter",
+			pipeline: `
+	|> filter(fn: (r) => r._time >= 2020-01-01T01:00:00Z and r.host == "a")`,
+			want: []plan.ProcedureKind{fsql.FromSQLKind, "filter"},
+		},
+		{
+			name: "reversed comparison",
+			pipeline: `
+	|> filter(fn: (r) => 3.0 < r.usage)`,
+			want: []plan.ProcedureKind{fsql.FromSQLKind},
+		},
+		{
+			name: "empty table",
+			pipeline: `
+	|> filter(fn: (r) => r.host == "z")`,
+			want: []plan.ProcedureKind{fsql.FromSQLKind},
+		},
+		{
+			name: "keep empty table",
+			pipeline: `
+	|> filter(fn: (r) => r.host == "z", onEmpty: "keep")`,
+			want: []plan.ProcedureKind{fsql.FromSQLKind},
+		},
+		{
+			name: "drop",
+			pipeline: `
+	|> drop(columns: ["region", "missing"])`,
+			want: []plan.ProcedureKind{fsql.FromSQLKind},
+		},
+		{
+			// SQLite cannot match regular expressions.
+			name: "regexp",
+			pipeline: `
+	|> filter(fn: (r) => r.host =~ /a/)`,
+			want: []plan.ProcedureKind{fsql.FromSQLKind, "filter"},
+		},
+		{
+			name: "filter on dropped column",
+			pipeline: `
+	|> drop(columns: ["region"])
+	|> filter(fn: (r) => r.usage > 1.0 and r.region == "east")`,
+			want: []plan.ProcedureKind{fsql.FromSQLKind, "filter"},
+		},
+		{
+			// The offset may empty the table, which the filter would drop.
+			name: "limit with offset after filter",
+			pipeline: `
+	|> filter(fn: (r) => r.host == "a")
+	|> limit(n: 2, offset: 5)`,
+			want: []plan.ProcedureKind{fsql.FromSQLKind, "limit"},
+		},
+		{
+			name: "filter after limit",
+			pipeline: `
+	|> limit(n: 3)
+	|> filter(fn: (r) => r.usage > 1.0)`,
+			want: []plan.ProcedureKind{fsql.FromSQLKind, "filter"},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			script := from + tc.pipeline
+			want, _ := runPushdownScript(t, script, withoutPushdown)
+			got, ps := runPushdownScript(t, script)
+			if !cmp.Equal(want, got) {
+				t.Errorf("unexpected tables -want/+got:\n%s", cmp.Diff(want, got))
+			}
+			if kinds := planKinds(ps); !cmp.Equal(tc.want, kinds) {
+				t.Errorf("unexpected plan -want/+got:\n%s", cmp.Diff(tc.want, kinds))
+			}
+		})
+	}
+}
+
+func TestFromSQLPushdown_SqliteTextTimes(t *testing.T) {
+	dsn := "file:pushdown_text_times?mode=memory&cache=shared"
+	db, err := sql.Open("sqlite3", dsn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = db.Close() }()
+	// The times are inserted as literal RFC3339 strings, which sort
+	// differently than the text the driver binds for a time.
+	if _, err := db.Exec(`CREATE TABLE cpu (_time DATETIME, usage FLOAT)`); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.Exec(`INSERT INTO cpu VALUES
+		('2020-01-01T00:00:00Z', 1),
+		('2020-01-01T01:00:00Z', 2),
+		('2020-01-01T02:00:00Z', 3)`); err != nil {
+		t.Fatal(err)
+	}
+
+	got, _ := runPushdownScript(t, `
+import "sql"
+
+sql.from(driverName: "sqlite3", dataSourceName: "`+dsn+`", query: "SELECT * FROM cpu")
+	|> range(start: 2020-01-01T01:00:00Z, stop: 2020-01-01T02:00:00Z)
+	|> filter(fn: (r) => r._time > 2020-01-01T00:30:00Z)
+`)
+	want := []*executetest.Table{{
+		KeyCols: []string{"_start", "_stop"},
+		ColMeta: []flux.ColMeta{
+			{Label: "_start", Type: flux.TTime},
+			{Label: "_stop", Type: flux.TTime},
+			{Label: "_time", Type: flux.TTime},
+			{Label: "usage", Type: flux.TFloat},
+		},
+		Data: [][]interface{}{
+			{
+				execute.Time(time.Date(2020, 1, 1, 1, 0, 0, 0, time.UTC).UnixNano()),
+				execute.Time(time.Date(2020, 1, 1, 2, 0, 0, 0, time.UTC).UnixNano()),
+				execute.Time(time.Date(2020, 1, 1, 1, 0, 0, 0, time.UTC).UnixNano()),
+				2.0,
+			},
+		},
+	}}
+	executetest.NormalizeTables(want)
+	if !cmp.Equal(want, got) {
+		t.Errorf("unexpected tables -want/+got:\n%s", cmp.Diff(want, got))
+	}
+}
+
+func TestFromSQLPushdown_Query(t *testing.T) {
+	dsn := "sqlmock://pushdown"
+	_, mock, err := sqlmock.NewWithDSN(dsn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM (SELECT * FROM cpu) AS flux_subquery WHERE 1 = 0`)).
+		WillReturnRows(sqlmock.NewRows([]string{"_time", "host", "usage"}))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT "host" FROM (SELECT * FROM cpu) AS flux_subquery `+
+		`WHERE "_time" >= $1 AND "_time" < $2 AND ("host" = $3 OR "host" ~ $4) LIMIT $5 OFFSET $6`)).
+		WithArgs(
+			time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+			time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC),
+			"a",
+			"^b",
+			int64(10),
+			int64(5),
+		).
+		WillReturnRows(sqlmock.NewRows([]string{"host"}).AddRow("a").AddRow("b"))
+
+	got, _ := runPushdownScript(t, `
+import "sql"
+
+sql.from(driverName: "sqlmock", dataSourceName: "`+dsn+`", query: "SELECT * FROM cpu;")
+	|> range(start: 2020-01-01T00:00:00Z)
+	|> filter(fn: (r) => r.host == "a" or r.host =~ /^b/, onEmpty: "keep")
+	|> keep(columns: ["_start", "_stop", "host"])
+	|> limit(n: 10, offset: 5)
+`)
+	want := []*executetest.Table{{
+		KeyCols: []string{"_start", "_stop"},
+		ColMeta: []flux.ColMeta{
+			{Label: "_start", Type: flux.TTime},
+			{Label: "_stop", Type: flux.TTime},
+			{Label: "host", Type: flux.TString},
+		},
+		Data: [][]interface{}{
+			{execute.Time(1577836800000000000), execute.Time(1577923200000000000), "a"},
+			{execute.Time(1577836800000000000), execute.Time(1577923200000000000), "b"},
+		},
+	}}
+	executetest.NormalizeTables(want)
+	if !cmp.Equal(want, got) {
+		t.Errorf("unexpected tables -want/+got:\n%s", cmp.Diff(want, got))
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
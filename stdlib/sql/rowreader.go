@@ -0,0 +1,137 @@
+package sql
+
+import (
+	"database/sql"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/codes"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/internal/errors"
+	"github.com/influxdata/flux/values"
+)
+
+// ColumnTypeRowReader reads the rows of a query for the drivers that scan typed values.
+// The type of each column is given by a function of the type of the database column
+// and the values that the driver scans are converted to the type of their column.
+type ColumnTypeRowReader struct {
+	Cursor      *sql.Rows
+	columns     []interface{}
+	columnTypes []flux.ColType
+	columnNames []string
+	err         error
+}
+
+// NewColumnTypeRowReader creates a reader of the rows
+// that gives the columns the types that columnType returns.
+func NewColumnTypeRowReader(r *sql.Rows, columnType func(*sql.ColumnType) flux.ColType) (execute.RowReader, error) {
+	cols, err := r.Columns()
+	if err != nil {
+		return nil, err
+	}
+	types, err := r.ColumnTypes()
+	if err != nil {
+		return nil, err
+	}
+	reader := &ColumnTypeRowReader{
+		Cursor:      r,
+		columnNames: cols,
+		columnTypes: make([]flux.ColType, len(types)),
+	}
+	for i, typ := range types {
+		reader.columnTypes[i] = columnType(typ)
+	}
+	return reader, nil
+}
+
+func (m *ColumnTypeRowReader) Next() bool {
+	if m.err != nil || !m.Cursor.Next() {
+		return false
+	}
+	m.columns = make([]interface{}, len(m.columnNames))
+	columnPointers := make([]interface{}, len(m.columnNames))
+	for i := range m.columns {
+		columnPointers[i] = &m.columns[i]
+	}
+	// The error is returned when the reader is closed.
+	if m.err = m.Cursor.Scan(columnPointers...); m.err != nil {
+		return false
+	}
+	return true
+}
+
+func (m *ColumnTypeRowReader) GetNextRow() ([]values.Value, error) {
+	row := make([]values.Value, len(m.columns))
+	for i, col := range m.columns {
+		if col == nil {
+			row[i] = values.NewNull(flux.SemanticType(m.columnTypes[i]))
+			continue
+		}
+		v, err := scannedValue(col)
+		if err != nil {
+			return nil, errors.Wrapf(err, codes.Inherit, "column %q", m.columnNames[i])
+		}
+		if row[i], err = convertValue(v, m.columnTypes[i]); err != nil {
+			return nil, errors.Wrapf(err, codes.Inherit, "column %q", m.columnNames[i])
+		}
+	}
+	return row, nil
+}
+
+// scannedValue returns the value of a non-null value that a driver scanned.
+func scannedValue(col interface{}) (values.Value, error) {
+	switch col := col.(type) {
+	case time.Time:
+		return values.NewTime(values.ConvertTime(col)), nil
+	case []byte:
+		return values.NewString(string(col)), nil
+	}
+
+	v := reflect.ValueOf(col)
+	switch v.Kind() {
+	case reflect.Bool:
+		return values.NewBool(v.Bool()), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return values.NewInt(v.Int()), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return values.NewUInt(v.Uint()), nil
+	case reflect.Float32, reflect.Float64:
+		return values.NewFloat(v.Float()), nil
+	case reflect.String:
+		return values.NewString(v.String()), nil
+	}
+	// Drivers scan values such as decimals into types that format them.
+	if s, ok := col.(fmt.Stringer); ok {
+		return values.NewString(s.String()), nil
+	}
+	return nil, errors.Newf(codes.Internal, "cannot read a value of type %T", col)
+}
+
+func (m *ColumnTypeRowReader) ColumnNames() []string {
+	return m.columnNames
+}
+
+func (m *ColumnTypeRowReader) ColumnTypes() []flux.ColType {
+	return m.columnTypes
+}
+
+func (m *ColumnTypeRowReader) SetColumnTypes(types []flux.ColType) {
+	m.columnTypes = types
+}
+
+func (m *ColumnTypeRowReader) SetColumns(i []interface{}) {
+	m.columns = i
+}
+
+func (m *ColumnTypeRowReader) Close() error {
+	if m.err != nil {
+		_ = m.Cursor.Close()
+		return m.err
+	}
+	if err := m.Cursor.Err(); err != nil {
+		return err
+	}
+	return m.Cursor.Close()
+}
@@ -0,0 +1,202 @@
+package sql
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/codes"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/internal/errors"
+	"github.com/influxdata/flux/semantic"
+	"github.com/influxdata/flux/values"
+)
+
+// schemaTypes are the names of the column types in the schema parameter of sql.from.
+var schemaTypes = map[string]flux.ColType{
+	"bool":   flux.TBool,
+	"int":    flux.TInt,
+	"uint":   flux.TUInt,
+	"float":  flux.TFloat,
+	"string": flux.TString,
+	"time":   flux.TTime,
+}
+
+// readSchema reads the column types from the schema parameter of sql.from.
+func readSchema(obj values.Object) (map[string]flux.ColType, error) {
+	schema := make(map[string]flux.ColType, obj.Len())
+	var err error
+	obj.Range(func(name string, v values.Value) {
+		if err != nil {
+			return
+		}
+		if v.Type().Nature() != semantic.String {
+			err = errors.Newf(codes.Invalid, "schema type of column %q must be a string, got %v", name, v.Type())
+			return
+		}
+		typ, ok := schemaTypes[v.Str()]
+		if !ok {
+			err = errors.Newf(codes.Invalid, "unknown schema type %q for column %q", v.Str(), name)
+			return
+		}
+		schema[name] = typ
+	})
+	if err != nil {
+		return nil, err
+	}
+	return schema, nil
+}
+
+// schemaRowReader forces the types of the columns in the schema
+// and converts the values that the driver reads for them.
+type schemaRowReader struct {
+	execute.RowReader
+	names []string
+	types []flux.ColType
+	// forced is true for the columns with a type from the schema.
+	forced []bool
+}
+
+func newSchemaRowReader(reader execute.RowReader, schema map[string]flux.ColType) *schemaRowReader {
+	names := reader.ColumnNames()
+	rr := &schemaRowReader{
+		RowReader: reader,
+		names:     names,
+		types:     make([]flux.ColType, len(names)),
+		forced:    make([]bool, len(names)),
+	}
+	copy(rr.types, reader.ColumnTypes())
+	for i, name := range names {
+		if typ, ok := schema[name]; ok {
+			rr.types[i] = typ
+			rr.forced[i] = true
+		}
+	}
+
+	// The readers parse the raw bytes of a value with the type of its column.
+	if r, ok := reader.(interface{ SetColumnTypes([]flux.ColType) }); ok {
+		r.SetColumnTypes(rr.types)
+	}
+	return rr
+}
+
+func (m *schemaRowReader) GetNextRow() ([]values.Value, error) {
+	row, err := m.RowReader.GetNextRow()
+	if err != nil {
+		return nil, err
+	}
+	for i, v := range row {
+		if !m.forced[i] {
+			continue
+		}
+		if row[i], err = convertValue(v, m.types[i]); err != nil {
+			return nil, errors.Wrapf(err, codes.Invalid, "column %q", m.names[i])
+		}
+	}
+	return row, nil
+}
+
+func (m *schemaRowReader) ColumnTypes() []flux.ColType {
+	return m.types
+}
+
+// convertValue converts a value that is read from the database to the type.
+func convertValue(v values.Value, typ flux.ColType) (values.Value, error) {
+	if v.IsNull() {
+		return values.NewNull(flux.SemanticType(typ)), nil
+	}
+	from := v.Type().Nature()
+	if from == flux.SemanticType(typ).Nature() {
+		return v, nil
+	}
+
+	switch typ {
+	case flux.TFloat:
+		switch from {
+		case semantic.Int:
+			return values.NewFloat(float64(v.Int())), nil
+		case semantic.UInt:
+			return values.NewFloat(float64(v.UInt())), nil
+		case semantic.String:
+			f, err := strconv.ParseFloat(v.Str(), 64)
+			if err != nil {
+				return nil, err
+			}
+			return values.NewFloat(f), nil
+		}
+	case flux.TInt:
+		switch from {
+		case semantic.UInt:
+			if u := v.UInt(); u <= 1<<63-1 {
+				return values.NewInt(int64(u)), nil
+			}
+		case semantic.Float:
+			if f := v.Float(); f >= -1<<63 && f < 1<<63 && f == float64(int64(f)) {
+				return values.NewInt(int64(f)), nil
+			}
+		case semantic.Bool:
+			if v.Bool() {
+				return values.NewInt(1), nil
+			}
+			return values.NewInt(0), nil
+		case semantic.String:
+			i, err := strconv.ParseInt(v.Str(), 10, 64)
+			if err != nil {
+				return nil, err
+			}
+			return values.NewInt(i), nil
+		}
+	case flux.TUInt:
+		switch from {
+		case semantic.Int:
+			if i := v.Int(); i >= 0 {
+				return values.NewUInt(uint64(i)), nil
+			}
+		case semantic.Float:
+			if f := v.Float(); f >= 0 && f < 1<<64 && f == float64(uint64(f)) {
+				return values.NewUInt(uint64(f)), nil
+			}
+		case semantic.String:
+			u, err := strconv.ParseUint(v.Str(), 10, 64)
+			if err != nil {
+				return nil, err
+			}
+			return values.NewUInt(u), nil
+		}
+	case flux.TBool:
+		switch from {
+		case semantic.Int:
+			return values.NewBool(v.Int() != 0), nil
+		case semantic.UInt:
+			return values.NewBool(v.UInt() != 0), nil
+		case semantic.String:
+			b, err := strconv.ParseBool(v.Str())
+			if err != nil {
+				return nil, err
+			}
+			return values.NewBool(b), nil
+		}
+	case flux.TString:
+		switch from {
+		case semantic.Int:
+			return values.NewString(strconv.FormatInt(v.Int(), 10)), nil
+		case semantic.UInt:
+			return values.NewString(strconv.FormatUint(v.UInt(), 10)), nil
+		case semantic.Float:
+			return values.NewString(strconv.FormatFloat(v.Float(), 'f', -1, 64)), nil
+		case semantic.Bool:
+			return values.NewString(strconv.FormatBool(v.Bool())), nil
+		case semantic.Time:
+			return values.NewString(v.Time().Time().Format(time.RFC3339Nano)), nil
+		}
+	case flux.TTime:
+		if from == semantic.String {
+			for _, l := range []string{time.RFC3339Nano, layout} {
+				if t, err := time.Parse(l, v.Str()); err == nil {
+					return values.NewTime(values.ConvertTime(t)), nil
+				}
+			}
+		}
+	}
+	return nil, errors.Newf(codes.Invalid, "cannot convert a value of type %v to %v", v.Type(), typ)
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/influxdata/flux"
 	"github.com/influxdata/flux/codes"
+	"github.com/influxdata/flux/dependencies/url"
 	"github.com/influxdata/flux/execute"
 	"github.com/influxdata/flux/internal/errors"
 	"github.com/influxdata/flux/values"
@@ -166,3 +167,52 @@ func SqliteColumnTranslateFunc() translationFunc {
 		return colName + " " + s, nil
 	}
 }
+
+// sqliteDriver is the driver of SQLite databases.
+// SQLite has no regular expression function unless one is registered with the driver
+// and it stores DATETIME columns as text in the format they were inserted with.
+type sqliteDriver struct{}
+
+func (sqliteDriver) ValidateDataSource(validator url.Validator, dataSourceName string) error {
+	/*
+		example SQLite is: file:test.db?cache=shared&mode=memory
+		SQLite supports a superset of DSNs, including several special cases that net/url will flag as errors:
+		:memory:
+		file::memory:
+
+		so we need to check for these, otherwise will flag as an error
+	*/
+	if dataSourceName == ":memory:" || dataSourceName == "file::memory:" {
+		return nil
+	}
+	// we have a dsn that MIGHT be valid, so need to parse it - if it fails here, it is likely to be invalid
+	u, err := parseURL(dataSourceName)
+	if err != nil {
+		return err
+	}
+	return validateURL(validator, u)
+}
+
+func (sqliteDriver) NewRowReader(rows *sql.Rows) (execute.RowReader, error) {
+	return NewSqliteRowReader(rows)
+}
+
+func (sqliteDriver) TranslateColumn(typ flux.ColType, name string) (string, error) {
+	return SqliteColumnTranslateFunc()(typ, name)
+}
+
+func (sqliteDriver) Placeholder(int) string {
+	return "?"
+}
+
+func (sqliteDriver) QuoteIdentifier(name string) string {
+	return quoteWith(`"`)(name)
+}
+
+func (sqliteDriver) TimesAsText() bool {
+	return true
+}
+
+func (sqliteDriver) UpsertClause(keys, colNames []string) string {
+	return onConflictClause(keys, colNames)
+}
@@ -7,8 +7,10 @@ import (
 
 	"github.com/influxdata/flux"
 	"github.com/influxdata/flux/codes"
+	"github.com/influxdata/flux/dependencies/sideeffect"
 	"github.com/influxdata/flux/execute"
 	"github.com/influxdata/flux/internal/errors"
+	"github.com/influxdata/flux/interpreter"
 	"github.com/influxdata/flux/plan"
 	"github.com/influxdata/flux/semantic"
 	"github.com/influxdata/flux/values"
@@ -19,11 +21,23 @@ const (
 	DefaultBatchSize = 10000 //TODO: decide if this should be kept low enough for the lowest (SQLite), or not.
 )
 
+// The modes of sql.to. The append mode adds the rows to the table
+// and the truncate mode replaces the rows of the table.
+const (
+	AppendMode   = "append"
+	TruncateMode = "truncate"
+)
+
 type ToSQLOpSpec struct {
 	DriverName     string `json:"driverName,omitempty"`
 	DataSourceName string `json:"dataSourcename,omitempty"`
 	Table          string `json:"table,omitempty"`
 	BatchSize      int    `json:"batchSize,omitempty"`
+	CreateTable    bool   `json:"createTable,omitempty"`
+	Mode           string `json:"mode,omitempty"`
+	// UpsertKeys are the columns that identify a row. When they are set,
+	// rows that conflict with an existing row update it instead.
+	UpsertKeys []string `json:"upsertKeys,omitempty"`
 }
 
 func init() {
@@ -33,6 +47,9 @@ func init() {
 			"dataSourceName": semantic.String,
 			"table":          semantic.String,
 			"batchSize":      semantic.Int,
+			"createTable":    semantic.Bool,
+			"upsert":         semantic.Object,
+			"mode":           semantic.String,
 		},
 		[]string{"driverName", "dataSourceName", "table"},
 	)
@@ -43,7 +60,10 @@ func init() {
 }
 
 func (o *ToSQLOpSpec) ReadArgs(args flux.Arguments) error {
-	var err error
+	var (
+		ok  bool
+		err error
+	)
 
 	o.DriverName, err = args.GetRequiredString("driverName")
 	if err != nil {
@@ -80,7 +100,46 @@ func (o *ToSQLOpSpec) ReadArgs(args flux.Arguments) error {
 		o.BatchSize = int(b)
 	}
 
-	return err
+	// The table is created when it does not exist unless told otherwise.
+	if o.CreateTable, ok, err = args.GetBool("createTable"); err != nil {
+		return err
+	} else if !ok {
+		o.CreateTable = true
+	}
+
+	if o.Mode, ok, err = args.GetString("mode"); err != nil {
+		return err
+	} else if !ok {
+		o.Mode = AppendMode
+	}
+	if o.Mode != AppendMode && o.Mode != TruncateMode {
+		return errors.Newf(codes.Invalid, "invalid mode %q, must be %q or %q", o.Mode, AppendMode, TruncateMode)
+	}
+
+	if upsert, ok, err := args.GetObject("upsert"); err != nil {
+		return err
+	} else if ok {
+		if o.UpsertKeys, err = readUpsertKeys(upsert); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// readUpsertKeys reads the key columns from the upsert parameter of sql.to.
+func readUpsertKeys(upsert values.Object) ([]string, error) {
+	keys, err := interpreter.NewArguments(upsert).GetRequiredArray("keys", semantic.String)
+	if err != nil {
+		return nil, err
+	}
+	if keys.Len() == 0 {
+		return nil, errors.New(codes.Invalid, "upsert requires at least one key column")
+	}
+	cols := make([]string, 0, keys.Len())
+	keys.Range(func(i int, v values.Value) {
+		cols = append(cols, v.Str())
+	})
+	return cols, nil
 }
 
 func createToSQLOpSpec(args flux.Arguments, a *flux.Administration) (flux.OperationSpec, error) {
@@ -115,6 +174,9 @@ func (o *ToSQLProcedureSpec) Copy() plan.ProcedureSpec {
 			DataSourceName: s.DataSourceName,
 			Table:          s.Table,
 			BatchSize:      s.BatchSize,
+			CreateTable:    s.CreateTable,
+			Mode:           s.Mode,
+			UpsertKeys:     s.UpsertKeys,
 		},
 	}
 	return res
@@ -136,7 +198,7 @@ func createToSQLTransformation(id execute.DatasetID, mode execute.AccumulationMo
 	cache := execute.NewTableBuilderCache(a.Allocator())
 	d := execute.NewDataset(id, mode, cache)
 	deps := flux.GetDependencies(a.Context())
-	t, err := NewToSQLTransformation(d, deps, cache, s)
+	t, err := newToSQLTransformation(d, deps, cache, s, sideeffect.FromContext(a.Context()))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -144,11 +206,17 @@ func createToSQLTransformation(id execute.DatasetID, mode execute.AccumulationMo
 }
 
 type ToSQLTransformation struct {
-	d     execute.Dataset
-	cache execute.TableBuilderCache
-	spec  *ToSQLProcedureSpec
-	db    *sql.DB
-	tx    *sql.Tx
+	d      execute.Dataset
+	cache  execute.TableBuilderCache
+	spec   *ToSQLProcedureSpec
+	driver Driver
+	db     *sql.DB
+	tx     *sql.Tx
+
+	// created and truncated are set once the table
+	// has been created and truncated.
+	created   bool
+	truncated bool
 }
 
 func (t *ToSQLTransformation) RetractTable(id execute.DatasetID, key flux.GroupKey) error {
@@ -156,6 +224,12 @@ func (t *ToSQLTransformation) RetractTable(id execute.DatasetID, key flux.GroupK
 }
 
 func NewToSQLTransformation(d execute.Dataset, deps flux.Dependencies, cache execute.TableBuilderCache, spec *ToSQLProcedureSpec) (*ToSQLTransformation, error) {
+	return newToSQLTransformation(d, deps, cache, spec, nil)
+}
+
+// newToSQLTransformation creates the transformation. When a recorder is given,
+// the statements are recorded with it instead of being sent to the database.
+func newToSQLTransformation(d execute.Dataset, deps flux.Dependencies, cache execute.TableBuilderCache, spec *ToSQLProcedureSpec, recorder *sideeffect.Recorder) (*ToSQLTransformation, error) {
 	validator, err := deps.URLValidator()
 	if err != nil {
 		return nil, err
@@ -163,10 +237,19 @@ func NewToSQLTransformation(d execute.Dataset, deps flux.Dependencies, cache exe
 	if err := validateDataSource(validator, spec.Spec.DriverName, spec.Spec.DataSourceName); err != nil {
 		return nil, err
 	}
+	driver, err := lookupDriver(spec.Spec.DriverName)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := driver.(Upserter); len(spec.Spec.UpsertKeys) > 0 && !ok {
+		return nil, errors.Newf(codes.Invalid, "upsert is not supported for sql driver %s", spec.Spec.DriverName)
+	}
 
 	// validate the data driver name and source name.
-	db, err := sql.Open(spec.Spec.DriverName, spec.Spec.DataSourceName)
-	if err != nil {
+	var db *sql.DB
+	if recorder != nil {
+		db = sql.OpenDB(recorder.SQLConnector(spec.Spec.DriverName))
+	} else if db, err = sql.Open(spec.Spec.DriverName, spec.Spec.DataSourceName); err != nil {
 		return nil, err
 	}
 	var tx *sql.Tx
@@ -177,11 +260,12 @@ func NewToSQLTransformation(d execute.Dataset, deps flux.Dependencies, cache exe
 		}
 	}
 	return &ToSQLTransformation{
-		d:     d,
-		cache: cache,
-		spec:  spec,
-		db:    db,
-		tx:    tx,
+		d:      d,
+		cache:  cache,
+		spec:   spec,
+		driver: driver,
+		db:     db,
+		tx:     tx,
 	}, nil
 }
 
@@ -195,6 +279,9 @@ func (t *ToSQLTransformation) Process(id execute.DatasetID, tbl flux.Table) (err
 	if err != nil {
 		return err
 	}
+	if err := t.truncate(); err != nil {
+		return err
+	}
 	for i := range valStrings {
 		if err := ExecuteQueries(t.tx, t.spec.Spec, colNames, &valStrings[i], &valArgs[i]); err != nil {
 			return err
@@ -212,7 +299,13 @@ func (t *ToSQLTransformation) UpdateProcessingTime(id execute.DatasetID, pt exec
 	return t.d.UpdateProcessingTime(pt)
 }
 
+// Finish commits all of the rows that were written in a single transaction,
+// or rolls the transaction back when the query failed.
 func (t *ToSQLTransformation) Finish(id execute.DatasetID, err error) {
+	if err == nil {
+		// The table is emptied in the truncate mode even when there are no rows to write.
+		err = t.truncate()
+	}
 	if t.spec.Spec.DriverName != "sqlmock" {
 		var txErr error
 		if err == nil {
@@ -230,6 +323,52 @@ func (t *ToSQLTransformation) Finish(id execute.DatasetID, err error) {
 	t.d.Finish(err)
 }
 
+// truncate deletes the rows of the table once, before the first rows are written.
+// The rows are deleted within the transaction, so they are kept if the query fails.
+func (t *ToSQLTransformation) truncate() error {
+	if t.spec.Spec.Mode != TruncateMode || t.truncated {
+		return nil
+	}
+	t.truncated = true
+	// The statements are not executed without a transaction.
+	if t.tx == nil {
+		return nil
+	}
+	q := "DELETE FROM " + t.spec.Spec.Table
+	if tr, ok := t.driver.(Truncater); ok {
+		q = tr.TruncateTable(t.spec.Spec.Table)
+	}
+	_, err := t.tx.Exec(q)
+	return err
+}
+
+// createTable creates the table with the columns once, when it does not exist.
+func (t *ToSQLTransformation) createTable(cols []string) error {
+	if !t.spec.Spec.CreateTable || t.created {
+		return nil
+	}
+	t.created = true
+	// The statements are not executed without a transaction.
+	if t.tx == nil {
+		return nil
+	}
+	if keys := t.spec.Spec.UpsertKeys; len(keys) > 0 {
+		cols = append(cols, fmt.Sprintf("PRIMARY KEY (%s)", strings.Join(keys, ",")))
+	}
+	q := createTableStatement(t.spec.Spec.Table, cols)
+	if c, ok := t.driver.(TableCreator); ok {
+		q = c.CreateTable(t.spec.Spec.Table, cols)
+	}
+	// The table is created outside of the transaction
+	// when the database would commit the transaction.
+	if c, ok := t.driver.(DefinitionCommitter); ok && c.CommitsDefinitions() {
+		_, err := t.db.Exec(q)
+		return err
+	}
+	_, err := t.tx.Exec(q)
+	return err
+}
+
 type translationFunc func(f flux.ColType, colname string) (string, error)
 
 func correctBatchSize(batchSize, numberCols int) int {
@@ -266,24 +405,28 @@ func correctBatchSize(batchSize, numberCols int) int {
 }
 
 func getTranslationFunc(driverName string) (func() translationFunc, error) {
-	// simply return the translationFunc that corresponds to the driver type
-	switch driverName {
-	case "sqlite3":
-		return SqliteColumnTranslateFunc, nil
-	case "postgres", "sqlmock":
-		return PostgresColumnTranslateFunc, nil
-	case "mysql":
-		return MysqlColumnTranslateFunc, nil
-	default:
+	// simply return the translationFunc of the registered driver
+	driver, err := lookupDriver(driverName)
+	if err != nil {
 		return nil, errors.Newf(codes.Internal, "invalid driverName: %s", driverName)
 	}
-
+	return func() translationFunc {
+		return driver.TranslateColumn
+	}, nil
 }
 
 func CreateInsertComponents(t *ToSQLTransformation, tbl flux.Table) (colNames []string, valStringArray [][]string, valArgsArray [][]interface{}, err error) {
 	cols := tbl.Cols()
 	batchSize := correctBatchSize(t.spec.Spec.BatchSize, len(cols))
 
+	keys := make(map[string]bool, len(t.spec.Spec.UpsertKeys))
+	for _, key := range t.spec.Spec.UpsertKeys {
+		if execute.ColIdx(key, cols) < 0 {
+			return nil, nil, nil, errors.Newf(codes.Invalid, "upsert key column %q does not exist", key)
+		}
+		keys[key] = true
+	}
+
 	labels := make(map[string]idxType, len(cols))
 	var questionMarks, newSQLTableCols []string
 	for i, col := range cols {
@@ -304,6 +447,11 @@ func CreateInsertComponents(t *ToSQLTransformation, tbl flux.Table) (colNames []
 			if err != nil {
 				return nil, nil, nil, err
 			}
+			if kt, ok := t.driver.(KeyTranslator); ok && keys[col.Label] {
+				if v, err = kt.TranslateKeyColumn(col.Type, col.Label); err != nil {
+					return nil, nil, nil, err
+				}
+			}
 			newSQLTableCols = append(newSQLTableCols, v)
 		default:
 			return nil, nil, nil, errors.Newf(codes.Internal, "invalid type for column %s", col.Label)
@@ -314,6 +462,10 @@ func CreateInsertComponents(t *ToSQLTransformation, tbl flux.Table) (colNames []
 	// eg: (?,?)
 	valuePlaceHolders := fmt.Sprintf("(%s)", strings.Join(questionMarks, ","))
 
+	if err := t.createTable(newSQLTableCols); err != nil {
+		return nil, nil, nil, err
+	}
+
 	builder, new := t.cache.TableBuilder(tbl.Key())
 	if new {
 		if err := execute.AddTableCols(tbl, builder); err != nil {
@@ -329,14 +481,6 @@ func CreateInsertComponents(t *ToSQLTransformation, tbl flux.Table) (colNames []
 		// valueArgs holds all the values to pass into the query
 		valueArgs := make([]interface{}, 0, l*len(cols))
 
-		if t.spec.Spec.DriverName != "sqlmock" {
-			q := fmt.Sprintf("CREATE TABLE IF NOT EXISTS %s (%s)", t.spec.Spec.Table, strings.Join(newSQLTableCols, ","))
-			_, err = t.tx.Exec(q)
-			if err != nil {
-				return err
-			}
-		}
-
 		for i := 0; i < l; i++ {
 			valueStrings = append(valueStrings, valuePlaceHolders)
 			for j, col := range er.Cols() {
@@ -409,24 +553,56 @@ func CreateInsertComponents(t *ToSQLTransformation, tbl flux.Table) (colNames []
 func ExecuteQueries(tx *sql.Tx, s *ToSQLOpSpec, colNames []string, valueStrings *[]string, valueArgs *[]interface{}) (err error) {
 	concatValueStrings := strings.Join(*valueStrings, ",")
 
-	// PostgreSQL uses $n instead of ? for placeholders
-	if s.DriverName == "postgres" {
-		for pqCounter := 1; strings.Contains(concatValueStrings, "?"); pqCounter++ {
-			concatValueStrings = strings.Replace(concatValueStrings, "?", fmt.Sprintf("$%v", pqCounter), 1)
+	// The placeholders are numbered for the drivers that do not use ?
+	driver, err := lookupDriver(s.DriverName)
+	if err != nil {
+		return err
+	}
+	parts := strings.Split(concatValueStrings, "?")
+	var b strings.Builder
+	for i, part := range parts {
+		if i > 0 {
+			b.WriteString(driver.Placeholder(i))
 		}
+		b.WriteString(part)
 	}
+	concatValueStrings = b.String()
 
 	query := fmt.Sprintf("INSERT INTO %s (%s) VALUES %s", s.Table, strings.Join(colNames, ","), concatValueStrings)
+	if u, ok := driver.(Upserter); ok && len(s.UpsertKeys) > 0 {
+		query += u.UpsertClause(s.UpsertKeys, colNames)
+	}
 	if s.DriverName != "sqlmock" {
-		_, err := tx.Exec(query, *valueArgs...)
-		if err != nil {
-			// this err which is extremely helpful as it comes from the SQL driver should be
-			// bubbled up further up the stack so user can see the issue
-			if rbErr := tx.Rollback(); rbErr != nil {
-				return errors.Newf(codes.Aborted, "transaction failed (%s) while recovering from %s", err, rbErr)
-			}
-			return err
-		}
+		// this err which is extremely helpful as it comes from the SQL driver should be
+		// bubbled up further up the stack so user can see the issue.
+		// The transaction is rolled back when the transformation finishes.
+		_, err = tx.Exec(query, *valueArgs...)
 	}
 	return err
 }
+
+// createTableStatement returns the statement that creates
+// the table with the columns when it does not exist.
+func createTableStatement(table string, cols []string) string {
+	return fmt.Sprintf("CREATE TABLE IF NOT EXISTS %s (%s)", table, strings.Join(cols, ","))
+}
+
+// onConflictClause returns the ON CONFLICT clause of the insert statement
+// that updates the columns of the rows that conflict with the keys.
+func onConflictClause(keys, colNames []string) string {
+	isKey := make(map[string]bool, len(keys))
+	for _, key := range keys {
+		isKey[key] = true
+	}
+	var updates []string
+	for _, col := range colNames {
+		if !isKey[col] {
+			updates = append(updates, fmt.Sprintf("%s=EXCLUDED.%s", col, col))
+		}
+	}
+	clause := fmt.Sprintf(" ON CONFLICT (%s) DO ", strings.Join(keys, ","))
+	if len(updates) == 0 {
+		return clause + "NOTHING"
+	}
+	return clause + "UPDATE SET " + strings.Join(updates, ",")
+}
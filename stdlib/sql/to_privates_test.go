@@ -166,3 +166,64 @@ func TestMysqlTranslation(t *testing.T) {
 		}
 	}
 }
+
+func TestSQLServerTranslation(t *testing.T) {
+	sqlServerTypeTranslations := map[string]flux.ColType{
+		"FLOAT":         flux.TFloat,
+		"BIGINT":        flux.TInt,
+		"NVARCHAR(MAX)": flux.TString,
+		"DATETIME2":     flux.TTime,
+		"BIT":           flux.TBool,
+	}
+
+	columnLabel := "apples"
+	// verify that valid returns expected happiness for SQL Server
+	sqlT, err := getTranslationFunc("sqlserver")
+	if !cmp.Equal(nil, err) {
+		t.Log(cmp.Diff(nil, err))
+		t.Fail()
+	}
+
+	for dbTypeString, fluxType := range sqlServerTypeTranslations {
+		v, err := sqlT()(fluxType, columnLabel)
+		if !cmp.Equal(nil, err) {
+			t.Log(cmp.Diff(nil, err))
+			t.Fail()
+		}
+		if !cmp.Equal(columnLabel+" "+dbTypeString, v) {
+			t.Log(cmp.Diff(columnLabel+" "+dbTypeString, v))
+			t.Fail()
+		}
+	}
+}
+
+func TestClickHouseTranslation(t *testing.T) {
+	clickHouseTypeTranslations := map[string]flux.ColType{
+		"Nullable(Float64)":       flux.TFloat,
+		"Nullable(Int64)":         flux.TInt,
+		"Nullable(UInt64)":        flux.TUInt,
+		"Nullable(String)":        flux.TString,
+		"Nullable(DateTime64(9))": flux.TTime,
+		"Nullable(Bool)":          flux.TBool,
+	}
+
+	columnLabel := "apples"
+	// verify that valid returns expected happiness for ClickHouse
+	sqlT, err := getTranslationFunc("clickhouse")
+	if !cmp.Equal(nil, err) {
+		t.Log(cmp.Diff(nil, err))
+		t.Fail()
+	}
+
+	for dbTypeString, fluxType := range clickHouseTypeTranslations {
+		v, err := sqlT()(fluxType, columnLabel)
+		if !cmp.Equal(nil, err) {
+			t.Log(cmp.Diff(nil, err))
+			t.Fail()
+		}
+		if !cmp.Equal(columnLabel+" "+dbTypeString, v) {
+			t.Log(cmp.Diff(columnLabel+" "+dbTypeString, v))
+			t.Fail()
+		}
+	}
+}
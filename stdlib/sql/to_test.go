@@ -1,7 +1,10 @@
 package sql_test
 
 import (
+	"context"
+	"database/sql"
 	"testing"
+	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/google/go-cmp/cmp"
@@ -12,6 +15,8 @@ import (
 	"github.com/influxdata/flux/dependencies/url"
 	"github.com/influxdata/flux/execute"
 	"github.com/influxdata/flux/execute/executetest"
+	"github.com/influxdata/flux/lang"
+	"github.com/influxdata/flux/memory"
 	"github.com/influxdata/flux/plan"
 	"github.com/influxdata/flux/querytest"
 	"github.com/influxdata/flux/stdlib/influxdata/influxdb"
@@ -40,6 +45,8 @@ func TestSqlTo(t *testing.T) {
 							DataSourceName: "root@/db",
 							Table:          "TestTable",
 							BatchSize:      fsql.DefaultBatchSize,
+							CreateTable:    true,
+							Mode:           fsql.AppendMode,
 						},
 					},
 				},
@@ -48,6 +55,44 @@ func TestSqlTo(t *testing.T) {
 				},
 			},
 		},
+		{
+			Name: "upsert and truncate",
+			Raw:  `import "sql" from(bucket: "mybucket") |> sql.to(driverName:"sqlmock", dataSourceName:"root@/db", table:"TestTable", createTable: false, upsert: {keys: ["host", "_time"]}, mode: "truncate")`,
+			Want: &flux.Spec{
+				Operations: []*flux.Operation{
+					{
+						ID: "from0",
+						Spec: &influxdb.FromOpSpec{
+							Bucket: "mybucket",
+						},
+					},
+					{
+						ID: "toSQL1",
+						Spec: &fsql.ToSQLOpSpec{
+							DriverName:     "sqlmock",
+							DataSourceName: "root@/db",
+							Table:          "TestTable",
+							BatchSize:      fsql.DefaultBatchSize,
+							Mode:           fsql.TruncateMode,
+							UpsertKeys:     []string{"host", "_time"},
+						},
+					},
+				},
+				Edges: []flux.Edge{
+					{Parent: "from0", Child: "toSQL1"},
+				},
+			},
+		},
+		{
+			Name:    "invalid mode",
+			Raw:     `import "sql" from(bucket: "mybucket") |> sql.to(driverName:"sqlmock", dataSourceName:"root@/db", table:"TestTable", mode: "replace")`,
+			WantErr: true,
+		},
+		{
+			Name:    "upsert without keys",
+			Raw:     `import "sql" from(bucket: "mybucket") |> sql.to(driverName:"sqlmock", dataSourceName:"root@/db", table:"TestTable", upsert: {keys: []})`,
+			WantErr: true,
+		},
 	}
 	for _, tc := range tests {
 		tc := tc
@@ -387,6 +432,8 @@ func TestSqlite3To(t *testing.T) {
 							DataSourceName: "file::memory:",
 							Table:          "TestTable",
 							BatchSize:      10000,
+							CreateTable:    true,
+							Mode:           fsql.AppendMode,
 						},
 					},
 				},
@@ -670,3 +717,93 @@ func TestToSQLite3_Process(t *testing.T) {
 		})
 	}
 }
+
+func TestToSQL_Sqlite3Modes(t *testing.T) {
+	// The shared cache keeps the in-memory database
+	// while the connection of the test is open.
+	dsn := "file:to?mode=memory&cache=shared"
+	db, err := sql.Open("sqlite3", dsn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = db.Close() }()
+	if err := db.Ping(); err != nil {
+		t.Fatal(err)
+	}
+
+	write := func(data, options string) string {
+		return `
+import "csv"
+import "sql"
+
+data = "
+#datatype,string,long,string,long
+#group,false,false,true,false
+#default,_result,,,
+,result,table,host,value
+` + data + `"
+
+csv.from(csv: data)
+	|> drop(columns: ["result", "table"])
+	|> sql.to(driverName: "sqlite3", dataSourceName: "` + dsn + `", table: "hosts"` + options + `)
+`
+	}
+	read := func(t *testing.T) [][]interface{} {
+		t.Helper()
+		rows, err := db.Query(`SELECT host, value FROM hosts ORDER BY host`)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer func() { _ = rows.Close() }()
+		var got [][]interface{}
+		for rows.Next() {
+			var host string
+			var value int64
+			if err := rows.Scan(&host, &value); err != nil {
+				t.Fatal(err)
+			}
+			got = append(got, []interface{}{host, value})
+		}
+		if err := rows.Err(); err != nil {
+			t.Fatal(err)
+		}
+		return got
+	}
+
+	// The table is created with the upsert key as its primary key.
+	runPushdownScript(t, write(",,0,a,1\n,,1,b,2", `, upsert: {keys: ["host"]}`))
+	runPushdownScript(t, write(",,0,a,3\n,,1,c,4", `, upsert: {keys: ["host"]}`))
+	want := [][]interface{}{{"a", int64(3)}, {"b", int64(2)}, {"c", int64(4)}}
+	if got := read(t); !cmp.Equal(want, got) {
+		t.Errorf("unexpected rows after upsert -want/+got:\n%s", cmp.Diff(want, got))
+	}
+
+	runPushdownScript(t, write(",,0,d,5", `, mode: "truncate"`))
+	want = [][]interface{}{{"d", int64(5)}}
+	if got := read(t); !cmp.Equal(want, got) {
+		t.Errorf("unexpected rows after truncate -want/+got:\n%s", cmp.Diff(want, got))
+	}
+
+	// The rows conflict with the primary key, so the truncate is rolled back.
+	program, err := lang.Compile(write(",,0,e,6\n,,1,e,7", `, mode: "truncate", createTable: false`), time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := executetest.NewTestExecuteDependencies().Inject(context.Background())
+	q, err := program.Start(ctx, &memory.Allocator{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for res := range q.Results() {
+		if err = res.Tables().Do(func(flux.Table) error { return nil }); err != nil {
+			break
+		}
+	}
+	q.Done()
+	if err == nil && q.Err() == nil {
+		t.Error("expected the conflicting insert to fail")
+	}
+	if got := read(t); !cmp.Equal(want, got) {
+		t.Errorf("unexpected rows after rollback -want/+got:\n%s", cmp.Diff(want, got))
+	}
+}
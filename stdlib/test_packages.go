@@ -5,16 +5,18 @@ package stdlib
 import (
 	ast "github.com/influxdata/flux/ast"
 	date "github.com/influxdata/flux/stdlib/date"
+	dict "github.com/influxdata/flux/stdlib/dict"
 	experimental "github.com/influxdata/flux/stdlib/experimental"
 	http "github.com/influxdata/flux/stdlib/http"
 	monitor "github.com/influxdata/flux/stdlib/influxdata/influxdb/monitor"
 	secrets "github.com/influxdata/flux/stdlib/influxdata/influxdb/secrets"
 	v1 "github.com/influxdata/flux/stdlib/influxdata/influxdb/v1"
+	influxql "github.com/influxdata/flux/stdlib/internal/influxql"
 	promql "github.com/influxdata/flux/stdlib/internal/promql"
 	regexp "github.com/influxdata/flux/stdlib/regexp"
 	strings "github.com/influxdata/flux/stdlib/strings"
 	chronograf "github.com/influxdata/flux/stdlib/testing/chronograf"
-	influxql "github.com/influxdata/flux/stdlib/testing/influxql"
+	influxql1 "github.com/influxdata/flux/stdlib/testing/influxql"
 	kapacitor "github.com/influxdata/flux/stdlib/testing/kapacitor"
 	pandas "github.com/influxdata/flux/stdlib/testing/pandas"
 	prometheus "github.com/influxdata/flux/stdlib/testing/prometheus"
@@ -26,16 +28,18 @@ import (
 var FluxTestPackages = func() []*ast.Package {
 	var pkgs []*ast.Package
 	pkgs = append(pkgs, date.FluxTestPackages...)
+	pkgs = append(pkgs, dict.FluxTestPackages...)
 	pkgs = append(pkgs, experimental.FluxTestPackages...)
 	pkgs = append(pkgs, http.FluxTestPackages...)
 	pkgs = append(pkgs, monitor.FluxTestPackages...)
 	pkgs = append(pkgs, secrets.FluxTestPackages...)
 	pkgs = append(pkgs, v1.FluxTestPackages...)
+	pkgs = append(pkgs, influxql.FluxTestPackages...)
 	pkgs = append(pkgs, promql.FluxTestPackages...)
 	pkgs = append(pkgs, regexp.FluxTestPackages...)
 	pkgs = append(pkgs, strings.FluxTestPackages...)
 	pkgs = append(pkgs, chronograf.FluxTestPackages...)
-	pkgs = append(pkgs, influxql.FluxTestPackages...)
+	pkgs = append(pkgs, influxql1.FluxTestPackages...)
 	pkgs = append(pkgs, kapacitor.FluxTestPackages...)
 	pkgs = append(pkgs, pandas.FluxTestPackages...)
 	pkgs = append(pkgs, prometheus.FluxTestPackages...)
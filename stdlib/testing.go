@@ -1,6 +1,15 @@
 package stdlib
 
-import ast "github.com/influxdata/flux/ast"
+import (
+	"regexp"
+
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/ast"
+	"github.com/influxdata/flux/codes"
+	"github.com/influxdata/flux/internal/errors"
+	"github.com/influxdata/flux/semantic"
+	"github.com/influxdata/flux/values"
+)
 
 // TestingRunCalls constructs an ast.File that calls testing.run for each test case within the package.
 func TestingRunCalls(pkg *ast.Package) *ast.File {
@@ -17,6 +26,82 @@ func TestingBenchmarkCalls(pkg *ast.Package) *ast.File {
 	return genCalls(pkg, "benchmark")
 }
 
+// TestingTypeError type checks a test package the same way it is checked before it is evaluated.
+// A type error stops the whole package from being evaluated, so it cannot be caught
+// by testing.shouldError and the test cases that expect it are checked here instead.
+//
+// It reports whether the package has a type error. When it does, the returned error
+// is nil if every test case of the package sets an expectError that matches it
+// and an expectCode, if one is set, that is the code of the error.
+// The imports of the package are resolved with the importer.
+func TestingTypeError(pkg *ast.Package, importer semantic.Importer) (bool, error) {
+	sem, err := semantic.New(pkg)
+	if err == nil {
+		extern := values.BuildExternAssignments(sem, flux.Prelude())
+		_, err = semantic.InferTypes(extern, importer)
+	}
+	if err == nil {
+		return false, nil
+	}
+
+	var cases []*ast.TestStatement
+	ast.Walk(testStmtVisitor{
+		fn: func(tc *ast.TestStatement) {
+			cases = append(cases, tc)
+		},
+	}, pkg)
+	if len(cases) == 0 {
+		return true, err
+	}
+	for _, tc := range cases {
+		want, code, ok := expectedError(tc)
+		if !ok {
+			return true, err
+		}
+		if !want.MatchString(err.Error()) {
+			return true, errors.Newf(codes.Aborted, "test %s: expected an error matching %v, got: %v", tc.Assignment.ID.Name, want, err)
+		}
+		if gotCode := errors.Code(err); code != "" && gotCode.String() != code {
+			return true, errors.Newf(codes.Aborted, "test %s: expected an error with code %s, got code %s: %v", tc.Assignment.ID.Name, code, gotCode, err)
+		}
+	}
+	return true, nil
+}
+
+// expectedError returns the expectError and expectCode literals
+// of the object that the test case returns.
+func expectedError(tc *ast.TestStatement) (want *regexp.Regexp, code string, ok bool) {
+	fn, isFn := tc.Assignment.Init.(*ast.FunctionExpression)
+	if !isFn {
+		return nil, "", false
+	}
+	body := fn.Body
+	for {
+		paren, isParen := body.(*ast.ParenExpression)
+		if !isParen {
+			break
+		}
+		body = paren.Expression
+	}
+	obj, isObj := body.(*ast.ObjectExpression)
+	if !isObj {
+		return nil, "", false
+	}
+	for _, p := range obj.Properties {
+		switch v := p.Value.(type) {
+		case *ast.RegexpLiteral:
+			if p.Key.Key() == "expectError" {
+				want = v.Value
+			}
+		case *ast.StringLiteral:
+			if p.Key.Key() == "expectCode" {
+				code = v.Value
+			}
+		}
+	}
+	return want, code, want != nil
+}
+
 func genCalls(pkg *ast.Package, fn string) *ast.File {
 	callFile := new(ast.File)
 	callFile.Imports = []*ast.ImportDeclaration{{
@@ -13,28 +13,33 @@ func init() {
 
 var pkgAST = &ast.Package{
 	BaseNode: ast.BaseNode{
-		Errors: nil,
-		Loc:    nil,
+		Comments:        nil,
+		Errors:          nil,
+		Loc:             nil,
+		TrailingComment: nil,
 	},
 	Files: []*ast.File{&ast.File{
 		BaseNode: ast.BaseNode{
-			Errors: nil,
+			Comments: nil,
+			Errors:   nil,
 			Loc: &ast.SourceLocation{
 				End: ast.Position{
 					Column: 2,
-					Line:   32,
+					Line:   46,
 				},
 				File:   "testing.flux",
-				Source: "package testing\n\nimport c \"csv\"\n\nbuiltin assertEquals\nbuiltin assertEmpty\nbuiltin diff\n\noption loadStorage = (csv) => c.from(csv: csv)\noption loadMem = (csv) => c.from(csv: csv)\n\ninspect = (case) => {\n    tc = case()\n    got = tc.input |> tc.fn()\n    dif = got |> diff(want: tc.want)\n    return {\n        fn:    tc.fn,\n        input: tc.input,\n        want:  tc.want |> yield(name: \"want\"),\n        got:   got |> yield(name: \"got\"),\n        diff:  dif |> yield(name: \"diff\"),\n    }\n}\n\nrun = (case) => {\n    return inspect(case: case).diff |> assertEmpty()\n}\n\nbenchmark = (case) => {\n\ttc = case()\n\treturn tc.input |> tc.fn()\n}",
+				Source: "package testing\n\nimport c \"csv\"\n\nbuiltin assertEquals\nbuiltin assertEmpty\nbuiltin diff\nbuiltin shouldError\nbuiltin sideEffects\n\noption loadStorage = (csv) => c.from(csv: csv)\noption loadMem = (csv) => c.from(csv: csv)\n\ninspect = (case) => {\n    tc = case()\n    got = tc.input |> tc.fn()\n    dif = got |> diff(want: tc.want)\n    return {\n        fn:    tc.fn,\n        input: tc.input,\n        want:  tc.want |> yield(name: \"want\"),\n        got:   got |> yield(name: \"got\"),\n        diff:  dif |> yield(name: \"diff\"),\n    }\n}\n\n// run checks that the result of a test case is equal to its want stream.\n// A test case with an expectError regular expression instead passes when\n// evaluating it fails with a matching error and, if expectCode is set,\n// with the named error code.\nrun = (case) => {\n    tc = case()\n    return if exists tc.expectError then\n        shouldError(\n            fn: () => tc.input |> tc.fn(),\n            want: tc.expectError,\n            code: if exists tc.expectCode then tc.expectCode else \"\",\n        )\n    else\n        inspect(case: case).diff |> assertEmpty()\n}\n\nbenchmark = (case) => {\n\ttc = case()\n\treturn tc.input |> tc.fn()\n}",
 				Start: ast.Position{
 					Column: 1,
 					Line:   1,
 				},
 			},
+			TrailingComment: nil,
 		},
 		Body: []ast.Statement{&ast.BuiltinStatement{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 21,
@@ -47,10 +52,12 @@ var pkgAST = &ast.Package{
 						Line:   5,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 21,
@@ -63,12 +70,14 @@ var pkgAST = &ast.Package{
 							Line:   5,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "assertEquals",
 			},
 		}, &ast.BuiltinStatement{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 20,
@@ -81,10 +90,12 @@ var pkgAST = &ast.Package{
 						Line:   6,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 20,
@@ -97,12 +108,14 @@ var pkgAST = &ast.Package{
 							Line:   6,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "assertEmpty",
 			},
 		}, &ast.BuiltinStatement{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 13,
@@ -115,10 +128,12 @@ var pkgAST = &ast.Package{
 						Line:   7,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 13,
@@ -131,126 +146,217 @@ var pkgAST = &ast.Package{
 							Line:   7,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "diff",
 			},
+		}, &ast.BuiltinStatement{
+			BaseNode: ast.BaseNode{
+				Comments: nil,
+				Errors:   nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 20,
+						Line:   8,
+					},
+					File:   "testing.flux",
+					Source: "builtin shouldError",
+					Start: ast.Position{
+						Column: 1,
+						Line:   8,
+					},
+				},
+				TrailingComment: nil,
+			},
+			ID: &ast.Identifier{
+				BaseNode: ast.BaseNode{
+					Comments: nil,
+					Errors:   nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 20,
+							Line:   8,
+						},
+						File:   "testing.flux",
+						Source: "shouldError",
+						Start: ast.Position{
+							Column: 9,
+							Line:   8,
+						},
+					},
+					TrailingComment: nil,
+				},
+				Name: "shouldError",
+			},
+		}, &ast.BuiltinStatement{
+			BaseNode: ast.BaseNode{
+				Comments: nil,
+				Errors:   nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 20,
+						Line:   9,
+					},
+					File:   "testing.flux",
+					Source: "builtin sideEffects",
+					Start: ast.Position{
+						Column: 1,
+						Line:   9,
+					},
+				},
+				TrailingComment: nil,
+			},
+			ID: &ast.Identifier{
+				BaseNode: ast.BaseNode{
+					Comments: nil,
+					Errors:   nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 20,
+							Line:   9,
+						},
+						File:   "testing.flux",
+						Source: "sideEffects",
+						Start: ast.Position{
+							Column: 9,
+							Line:   9,
+						},
+					},
+					TrailingComment: nil,
+				},
+				Name: "sideEffects",
+			},
 		}, &ast.OptionStatement{
 			Assignment: &ast.VariableAssignment{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 47,
-							Line:   9,
+							Line:   11,
 						},
 						File:   "testing.flux",
 						Source: "loadStorage = (csv) => c.from(csv: csv)",
 						Start: ast.Position{
 							Column: 8,
-							Line:   9,
+							Line:   11,
 						},
 					},
+					TrailingComment: nil,
 				},
 				ID: &ast.Identifier{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 19,
-								Line:   9,
+								Line:   11,
 							},
 							File:   "testing.flux",
 							Source: "loadStorage",
 							Start: ast.Position{
 								Column: 8,
-								Line:   9,
+								Line:   11,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Name: "loadStorage",
 				},
 				Init: &ast.FunctionExpression{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 47,
-								Line:   9,
+								Line:   11,
 							},
 							File:   "testing.flux",
 							Source: "(csv) => c.from(csv: csv)",
 							Start: ast.Position{
 								Column: 22,
-								Line:   9,
+								Line:   11,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Body: &ast.CallExpression{
 						Arguments: []ast.Expression{&ast.ObjectExpression{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 46,
-										Line:   9,
+										Line:   11,
 									},
 									File:   "testing.flux",
 									Source: "csv: csv",
 									Start: ast.Position{
 										Column: 38,
-										Line:   9,
+										Line:   11,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Properties: []*ast.Property{&ast.Property{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 46,
-											Line:   9,
+											Line:   11,
 										},
 										File:   "testing.flux",
 										Source: "csv: csv",
 										Start: ast.Position{
 											Column: 38,
-											Line:   9,
+											Line:   11,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Key: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 41,
-												Line:   9,
+												Line:   11,
 											},
 											File:   "testing.flux",
 											Source: "csv",
 											Start: ast.Position{
 												Column: 38,
-												Line:   9,
+												Line:   11,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "csv",
 								},
 								Value: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 46,
-												Line:   9,
+												Line:   11,
 											},
 											File:   "testing.flux",
 											Source: "csv",
 											Start: ast.Position{
 												Column: 43,
-												Line:   9,
+												Line:   11,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "csv",
 								},
@@ -258,69 +364,77 @@ var pkgAST = &ast.Package{
 							With: nil,
 						}},
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 47,
-									Line:   9,
+									Line:   11,
 								},
 								File:   "testing.flux",
 								Source: "c.from(csv: csv)",
 								Start: ast.Position{
 									Column: 31,
-									Line:   9,
+									Line:   11,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Callee: &ast.MemberExpression{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 37,
-										Line:   9,
+										Line:   11,
 									},
 									File:   "testing.flux",
 									Source: "c.from",
 									Start: ast.Position{
 										Column: 31,
-										Line:   9,
+										Line:   11,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Object: &ast.Identifier{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 32,
-											Line:   9,
+											Line:   11,
 										},
 										File:   "testing.flux",
 										Source: "c",
 										Start: ast.Position{
 											Column: 31,
-											Line:   9,
+											Line:   11,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Name: "c",
 							},
 							Property: &ast.Identifier{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 37,
-											Line:   9,
+											Line:   11,
 										},
 										File:   "testing.flux",
 										Source: "from",
 										Start: ast.Position{
 											Column: 33,
-											Line:   9,
+											Line:   11,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Name: "from",
 							},
@@ -328,35 +442,39 @@ var pkgAST = &ast.Package{
 					},
 					Params: []*ast.Property{&ast.Property{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 26,
-									Line:   9,
+									Line:   11,
 								},
 								File:   "testing.flux",
 								Source: "csv",
 								Start: ast.Position{
 									Column: 23,
-									Line:   9,
+									Line:   11,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Key: &ast.Identifier{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 26,
-										Line:   9,
+										Line:   11,
 									},
 									File:   "testing.flux",
 									Source: "csv",
 									Start: ast.Position{
 										Column: 23,
-										Line:   9,
+										Line:   11,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Name: "csv",
 						},
@@ -365,137 +483,153 @@ var pkgAST = &ast.Package{
 				},
 			},
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 47,
-						Line:   9,
+						Line:   11,
 					},
 					File:   "testing.flux",
 					Source: "option loadStorage = (csv) => c.from(csv: csv)",
 					Start: ast.Position{
 						Column: 1,
-						Line:   9,
+						Line:   11,
 					},
 				},
+				TrailingComment: nil,
 			},
 		}, &ast.OptionStatement{
 			Assignment: &ast.VariableAssignment{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 43,
-							Line:   10,
+							Line:   12,
 						},
 						File:   "testing.flux",
 						Source: "loadMem = (csv) => c.from(csv: csv)",
 						Start: ast.Position{
 							Column: 8,
-							Line:   10,
+							Line:   12,
 						},
 					},
+					TrailingComment: nil,
 				},
 				ID: &ast.Identifier{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 15,
-								Line:   10,
+								Line:   12,
 							},
 							File:   "testing.flux",
 							Source: "loadMem",
 							Start: ast.Position{
 								Column: 8,
-								Line:   10,
+								Line:   12,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Name: "loadMem",
 				},
 				Init: &ast.FunctionExpression{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 43,
-								Line:   10,
+								Line:   12,
 							},
 							File:   "testing.flux",
 							Source: "(csv) => c.from(csv: csv)",
 							Start: ast.Position{
 								Column: 18,
-								Line:   10,
+								Line:   12,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Body: &ast.CallExpression{
 						Arguments: []ast.Expression{&ast.ObjectExpression{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 42,
-										Line:   10,
+										Line:   12,
 									},
 									File:   "testing.flux",
 									Source: "csv: csv",
 									Start: ast.Position{
 										Column: 34,
-										Line:   10,
+										Line:   12,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Properties: []*ast.Property{&ast.Property{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 42,
-											Line:   10,
+											Line:   12,
 										},
 										File:   "testing.flux",
 										Source: "csv: csv",
 										Start: ast.Position{
 											Column: 34,
-											Line:   10,
+											Line:   12,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Key: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 37,
-												Line:   10,
+												Line:   12,
 											},
 											File:   "testing.flux",
 											Source: "csv",
 											Start: ast.Position{
 												Column: 34,
-												Line:   10,
+												Line:   12,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "csv",
 								},
 								Value: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 42,
-												Line:   10,
+												Line:   12,
 											},
 											File:   "testing.flux",
 											Source: "csv",
 											Start: ast.Position{
 												Column: 39,
-												Line:   10,
+												Line:   12,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "csv",
 								},
@@ -503,69 +637,77 @@ var pkgAST = &ast.Package{
 							With: nil,
 						}},
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 43,
-									Line:   10,
+									Line:   12,
 								},
 								File:   "testing.flux",
 								Source: "c.from(csv: csv)",
 								Start: ast.Position{
 									Column: 27,
-									Line:   10,
+									Line:   12,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Callee: &ast.MemberExpression{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 33,
-										Line:   10,
+										Line:   12,
 									},
 									File:   "testing.flux",
 									Source: "c.from",
 									Start: ast.Position{
 										Column: 27,
-										Line:   10,
+										Line:   12,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Object: &ast.Identifier{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 28,
-											Line:   10,
+											Line:   12,
 										},
 										File:   "testing.flux",
 										Source: "c",
 										Start: ast.Position{
 											Column: 27,
-											Line:   10,
+											Line:   12,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Name: "c",
 							},
 							Property: &ast.Identifier{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 33,
-											Line:   10,
+											Line:   12,
 										},
 										File:   "testing.flux",
 										Source: "from",
 										Start: ast.Position{
 											Column: 29,
-											Line:   10,
+											Line:   12,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Name: "from",
 							},
@@ -573,35 +715,39 @@ var pkgAST = &ast.Package{
 					},
 					Params: []*ast.Property{&ast.Property{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 22,
-									Line:   10,
+									Line:   12,
 								},
 								File:   "testing.flux",
 								Source: "csv",
 								Start: ast.Position{
 									Column: 19,
-									Line:   10,
+									Line:   12,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Key: &ast.Identifier{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 22,
-										Line:   10,
+										Line:   12,
 									},
 									File:   "testing.flux",
 									Source: "csv",
 									Start: ast.Position{
 										Column: 19,
-										Line:   10,
+										Line:   12,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Name: "csv",
 						},
@@ -610,325 +756,363 @@ var pkgAST = &ast.Package{
 				},
 			},
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 43,
-						Line:   10,
+						Line:   12,
 					},
 					File:   "testing.flux",
 					Source: "option loadMem = (csv) => c.from(csv: csv)",
 					Start: ast.Position{
 						Column: 1,
-						Line:   10,
+						Line:   12,
 					},
 				},
+				TrailingComment: nil,
 			},
 		}, &ast.VariableAssignment{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 2,
-						Line:   23,
+						Line:   25,
 					},
 					File:   "testing.flux",
 					Source: "inspect = (case) => {\n    tc = case()\n    got = tc.input |> tc.fn()\n    dif = got |> diff(want: tc.want)\n    return {\n        fn:    tc.fn,\n        input: tc.input,\n        want:  tc.want |> yield(name: \"want\"),\n        got:   got |> yield(name: \"got\"),\n        diff:  dif |> yield(name: \"diff\"),\n    }\n}",
 					Start: ast.Position{
 						Column: 1,
-						Line:   12,
+						Line:   14,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 8,
-							Line:   12,
+							Line:   14,
 						},
 						File:   "testing.flux",
 						Source: "inspect",
 						Start: ast.Position{
 							Column: 1,
-							Line:   12,
+							Line:   14,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "inspect",
 			},
 			Init: &ast.FunctionExpression{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 2,
-							Line:   23,
+							Line:   25,
 						},
 						File:   "testing.flux",
 						Source: "(case) => {\n    tc = case()\n    got = tc.input |> tc.fn()\n    dif = got |> diff(want: tc.want)\n    return {\n        fn:    tc.fn,\n        input: tc.input,\n        want:  tc.want |> yield(name: \"want\"),\n        got:   got |> yield(name: \"got\"),\n        diff:  dif |> yield(name: \"diff\"),\n    }\n}",
 						Start: ast.Position{
 							Column: 11,
-							Line:   12,
+							Line:   14,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Body: &ast.Block{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 2,
-								Line:   23,
+								Line:   25,
 							},
 							File:   "testing.flux",
 							Source: "{\n    tc = case()\n    got = tc.input |> tc.fn()\n    dif = got |> diff(want: tc.want)\n    return {\n        fn:    tc.fn,\n        input: tc.input,\n        want:  tc.want |> yield(name: \"want\"),\n        got:   got |> yield(name: \"got\"),\n        diff:  dif |> yield(name: \"diff\"),\n    }\n}",
 							Start: ast.Position{
 								Column: 21,
-								Line:   12,
+								Line:   14,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Body: []ast.Statement{&ast.VariableAssignment{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 16,
-									Line:   13,
+									Line:   15,
 								},
 								File:   "testing.flux",
 								Source: "tc = case()",
 								Start: ast.Position{
 									Column: 5,
-									Line:   13,
+									Line:   15,
 								},
 							},
+							TrailingComment: nil,
 						},
 						ID: &ast.Identifier{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 7,
-										Line:   13,
+										Line:   15,
 									},
 									File:   "testing.flux",
 									Source: "tc",
 									Start: ast.Position{
 										Column: 5,
-										Line:   13,
+										Line:   15,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Name: "tc",
 						},
 						Init: &ast.CallExpression{
 							Arguments: nil,
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 16,
-										Line:   13,
+										Line:   15,
 									},
 									File:   "testing.flux",
 									Source: "case()",
 									Start: ast.Position{
 										Column: 10,
-										Line:   13,
+										Line:   15,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Callee: &ast.Identifier{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 14,
-											Line:   13,
+											Line:   15,
 										},
 										File:   "testing.flux",
 										Source: "case",
 										Start: ast.Position{
 											Column: 10,
-											Line:   13,
+											Line:   15,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Name: "case",
 							},
 						},
 					}, &ast.VariableAssignment{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 30,
-									Line:   14,
+									Line:   16,
 								},
 								File:   "testing.flux",
 								Source: "got = tc.input |> tc.fn()",
 								Start: ast.Position{
 									Column: 5,
-									Line:   14,
+									Line:   16,
 								},
 							},
+							TrailingComment: nil,
 						},
 						ID: &ast.Identifier{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 8,
-										Line:   14,
+										Line:   16,
 									},
 									File:   "testing.flux",
 									Source: "got",
 									Start: ast.Position{
 										Column: 5,
-										Line:   14,
+										Line:   16,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Name: "got",
 						},
 						Init: &ast.PipeExpression{
 							Argument: &ast.MemberExpression{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 19,
-											Line:   14,
+											Line:   16,
 										},
 										File:   "testing.flux",
 										Source: "tc.input",
 										Start: ast.Position{
 											Column: 11,
-											Line:   14,
+											Line:   16,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Object: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 13,
-												Line:   14,
+												Line:   16,
 											},
 											File:   "testing.flux",
 											Source: "tc",
 											Start: ast.Position{
 												Column: 11,
-												Line:   14,
+												Line:   16,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "tc",
 								},
 								Property: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 19,
-												Line:   14,
+												Line:   16,
 											},
 											File:   "testing.flux",
 											Source: "input",
 											Start: ast.Position{
 												Column: 14,
-												Line:   14,
+												Line:   16,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "input",
 								},
 							},
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 30,
-										Line:   14,
+										Line:   16,
 									},
 									File:   "testing.flux",
 									Source: "tc.input |> tc.fn()",
 									Start: ast.Position{
 										Column: 11,
-										Line:   14,
+										Line:   16,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Call: &ast.CallExpression{
 								Arguments: nil,
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 30,
-											Line:   14,
+											Line:   16,
 										},
 										File:   "testing.flux",
 										Source: "tc.fn()",
 										Start: ast.Position{
 											Column: 23,
-											Line:   14,
+											Line:   16,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Callee: &ast.MemberExpression{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 28,
-												Line:   14,
+												Line:   16,
 											},
 											File:   "testing.flux",
 											Source: "tc.fn",
 											Start: ast.Position{
 												Column: 23,
-												Line:   14,
+												Line:   16,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Object: &ast.Identifier{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 25,
-													Line:   14,
+													Line:   16,
 												},
 												File:   "testing.flux",
 												Source: "tc",
 												Start: ast.Position{
 													Column: 23,
-													Line:   14,
+													Line:   16,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Name: "tc",
 									},
 									Property: &ast.Identifier{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 28,
-													Line:   14,
+													Line:   16,
 												},
 												File:   "testing.flux",
 												Source: "fn",
 												Start: ast.Position{
 													Column: 26,
-													Line:   14,
+													Line:   16,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Name: "fn",
 									},
@@ -937,172 +1121,192 @@ var pkgAST = &ast.Package{
 						},
 					}, &ast.VariableAssignment{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 37,
-									Line:   15,
+									Line:   17,
 								},
 								File:   "testing.flux",
 								Source: "dif = got |> diff(want: tc.want)",
 								Start: ast.Position{
 									Column: 5,
-									Line:   15,
+									Line:   17,
 								},
 							},
+							TrailingComment: nil,
 						},
 						ID: &ast.Identifier{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 8,
-										Line:   15,
+										Line:   17,
 									},
 									File:   "testing.flux",
 									Source: "dif",
 									Start: ast.Position{
 										Column: 5,
-										Line:   15,
+										Line:   17,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Name: "dif",
 						},
 						Init: &ast.PipeExpression{
 							Argument: &ast.Identifier{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 14,
-											Line:   15,
+											Line:   17,
 										},
 										File:   "testing.flux",
 										Source: "got",
 										Start: ast.Position{
 											Column: 11,
-											Line:   15,
+											Line:   17,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Name: "got",
 							},
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 37,
-										Line:   15,
+										Line:   17,
 									},
 									File:   "testing.flux",
 									Source: "got |> diff(want: tc.want)",
 									Start: ast.Position{
 										Column: 11,
-										Line:   15,
+										Line:   17,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Call: &ast.CallExpression{
 								Arguments: []ast.Expression{&ast.ObjectExpression{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 36,
-												Line:   15,
+												Line:   17,
 											},
 											File:   "testing.flux",
 											Source: "want: tc.want",
 											Start: ast.Position{
 												Column: 23,
-												Line:   15,
+												Line:   17,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Properties: []*ast.Property{&ast.Property{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 36,
-													Line:   15,
+													Line:   17,
 												},
 												File:   "testing.flux",
 												Source: "want: tc.want",
 												Start: ast.Position{
 													Column: 23,
-													Line:   15,
+													Line:   17,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Key: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 27,
-														Line:   15,
+														Line:   17,
 													},
 													File:   "testing.flux",
 													Source: "want",
 													Start: ast.Position{
 														Column: 23,
-														Line:   15,
+														Line:   17,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "want",
 										},
 										Value: &ast.MemberExpression{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 36,
-														Line:   15,
+														Line:   17,
 													},
 													File:   "testing.flux",
 													Source: "tc.want",
 													Start: ast.Position{
 														Column: 29,
-														Line:   15,
+														Line:   17,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Object: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 31,
-															Line:   15,
+															Line:   17,
 														},
 														File:   "testing.flux",
 														Source: "tc",
 														Start: ast.Position{
 															Column: 29,
-															Line:   15,
+															Line:   17,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "tc",
 											},
 											Property: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 36,
-															Line:   15,
+															Line:   17,
 														},
 														File:   "testing.flux",
 														Source: "want",
 														Start: ast.Position{
 															Column: 32,
-															Line:   15,
+															Line:   17,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "want",
 											},
@@ -1111,35 +1315,39 @@ var pkgAST = &ast.Package{
 									With: nil,
 								}},
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 37,
-											Line:   15,
+											Line:   17,
 										},
 										File:   "testing.flux",
 										Source: "diff(want: tc.want)",
 										Start: ast.Position{
 											Column: 18,
-											Line:   15,
+											Line:   17,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Callee: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 22,
-												Line:   15,
+												Line:   17,
 											},
 											File:   "testing.flux",
 											Source: "diff",
 											Start: ast.Position{
 												Column: 18,
-												Line:   15,
+												Line:   17,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "diff",
 								},
@@ -1148,363 +1356,405 @@ var pkgAST = &ast.Package{
 					}, &ast.ReturnStatement{
 						Argument: &ast.ObjectExpression{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 6,
-										Line:   22,
+										Line:   24,
 									},
 									File:   "testing.flux",
 									Source: "{\n        fn:    tc.fn,\n        input: tc.input,\n        want:  tc.want |> yield(name: \"want\"),\n        got:   got |> yield(name: \"got\"),\n        diff:  dif |> yield(name: \"diff\"),\n    }",
 									Start: ast.Position{
 										Column: 12,
-										Line:   16,
+										Line:   18,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Properties: []*ast.Property{&ast.Property{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 21,
-											Line:   17,
+											Line:   19,
 										},
 										File:   "testing.flux",
 										Source: "fn:    tc.fn",
 										Start: ast.Position{
 											Column: 9,
-											Line:   17,
+											Line:   19,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Key: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 11,
-												Line:   17,
+												Line:   19,
 											},
 											File:   "testing.flux",
 											Source: "fn",
 											Start: ast.Position{
 												Column: 9,
-												Line:   17,
+												Line:   19,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "fn",
 								},
 								Value: &ast.MemberExpression{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 21,
-												Line:   17,
+												Line:   19,
 											},
 											File:   "testing.flux",
 											Source: "tc.fn",
 											Start: ast.Position{
 												Column: 16,
-												Line:   17,
+												Line:   19,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Object: &ast.Identifier{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 18,
-													Line:   17,
+													Line:   19,
 												},
 												File:   "testing.flux",
 												Source: "tc",
 												Start: ast.Position{
 													Column: 16,
-													Line:   17,
+													Line:   19,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Name: "tc",
 									},
 									Property: &ast.Identifier{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 21,
-													Line:   17,
+													Line:   19,
 												},
 												File:   "testing.flux",
 												Source: "fn",
 												Start: ast.Position{
 													Column: 19,
-													Line:   17,
+													Line:   19,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Name: "fn",
 									},
 								},
 							}, &ast.Property{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 24,
-											Line:   18,
+											Line:   20,
 										},
 										File:   "testing.flux",
 										Source: "input: tc.input",
 										Start: ast.Position{
 											Column: 9,
-											Line:   18,
+											Line:   20,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Key: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 14,
-												Line:   18,
+												Line:   20,
 											},
 											File:   "testing.flux",
 											Source: "input",
 											Start: ast.Position{
 												Column: 9,
-												Line:   18,
+												Line:   20,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "input",
 								},
 								Value: &ast.MemberExpression{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 24,
-												Line:   18,
+												Line:   20,
 											},
 											File:   "testing.flux",
 											Source: "tc.input",
 											Start: ast.Position{
 												Column: 16,
-												Line:   18,
+												Line:   20,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Object: &ast.Identifier{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 18,
-													Line:   18,
+													Line:   20,
 												},
 												File:   "testing.flux",
 												Source: "tc",
 												Start: ast.Position{
 													Column: 16,
-													Line:   18,
+													Line:   20,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Name: "tc",
 									},
 									Property: &ast.Identifier{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 24,
-													Line:   18,
+													Line:   20,
 												},
 												File:   "testing.flux",
 												Source: "input",
 												Start: ast.Position{
 													Column: 19,
-													Line:   18,
+													Line:   20,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Name: "input",
 									},
 								},
 							}, &ast.Property{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 46,
-											Line:   19,
+											Line:   21,
 										},
 										File:   "testing.flux",
 										Source: "want:  tc.want |> yield(name: \"want\")",
 										Start: ast.Position{
 											Column: 9,
-											Line:   19,
+											Line:   21,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Key: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 13,
-												Line:   19,
+												Line:   21,
 											},
 											File:   "testing.flux",
 											Source: "want",
 											Start: ast.Position{
 												Column: 9,
-												Line:   19,
+												Line:   21,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "want",
 								},
 								Value: &ast.PipeExpression{
 									Argument: &ast.MemberExpression{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 23,
-													Line:   19,
+													Line:   21,
 												},
 												File:   "testing.flux",
 												Source: "tc.want",
 												Start: ast.Position{
 													Column: 16,
-													Line:   19,
+													Line:   21,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Object: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 18,
-														Line:   19,
+														Line:   21,
 													},
 													File:   "testing.flux",
 													Source: "tc",
 													Start: ast.Position{
 														Column: 16,
-														Line:   19,
+														Line:   21,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "tc",
 										},
 										Property: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 23,
-														Line:   19,
+														Line:   21,
 													},
 													File:   "testing.flux",
 													Source: "want",
 													Start: ast.Position{
 														Column: 19,
-														Line:   19,
+														Line:   21,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "want",
 										},
 									},
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 46,
-												Line:   19,
+												Line:   21,
 											},
 											File:   "testing.flux",
 											Source: "tc.want |> yield(name: \"want\")",
 											Start: ast.Position{
 												Column: 16,
-												Line:   19,
+												Line:   21,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Call: &ast.CallExpression{
 										Arguments: []ast.Expression{&ast.ObjectExpression{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 45,
-														Line:   19,
+														Line:   21,
 													},
 													File:   "testing.flux",
 													Source: "name: \"want\"",
 													Start: ast.Position{
 														Column: 33,
-														Line:   19,
+														Line:   21,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Properties: []*ast.Property{&ast.Property{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 45,
-															Line:   19,
+															Line:   21,
 														},
 														File:   "testing.flux",
 														Source: "name: \"want\"",
 														Start: ast.Position{
 															Column: 33,
-															Line:   19,
+															Line:   21,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Key: &ast.Identifier{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 37,
-																Line:   19,
+																Line:   21,
 															},
 															File:   "testing.flux",
 															Source: "name",
 															Start: ast.Position{
 																Column: 33,
-																Line:   19,
+																Line:   21,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Name: "name",
 												},
 												Value: &ast.StringLiteral{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 45,
-																Line:   19,
+																Line:   21,
 															},
 															File:   "testing.flux",
 															Source: "\"want\"",
 															Start: ast.Position{
 																Column: 39,
-																Line:   19,
+																Line:   21,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Value: "want",
 												},
@@ -1512,35 +1762,39 @@ var pkgAST = &ast.Package{
 											With: nil,
 										}},
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 46,
-													Line:   19,
+													Line:   21,
 												},
 												File:   "testing.flux",
 												Source: "yield(name: \"want\")",
 												Start: ast.Position{
 													Column: 27,
-													Line:   19,
+													Line:   21,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Callee: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 32,
-														Line:   19,
+														Line:   21,
 													},
 													File:   "testing.flux",
 													Source: "yield",
 													Start: ast.Position{
 														Column: 27,
-														Line:   19,
+														Line:   21,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "yield",
 										},
@@ -1548,138 +1802,154 @@ var pkgAST = &ast.Package{
 								},
 							}, &ast.Property{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 41,
-											Line:   20,
+											Line:   22,
 										},
 										File:   "testing.flux",
 										Source: "got:   got |> yield(name: \"got\")",
 										Start: ast.Position{
 											Column: 9,
-											Line:   20,
+											Line:   22,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Key: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 12,
-												Line:   20,
+												Line:   22,
 											},
 											File:   "testing.flux",
 											Source: "got",
 											Start: ast.Position{
 												Column: 9,
-												Line:   20,
+												Line:   22,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "got",
 								},
 								Value: &ast.PipeExpression{
 									Argument: &ast.Identifier{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 19,
-													Line:   20,
+													Line:   22,
 												},
 												File:   "testing.flux",
 												Source: "got",
 												Start: ast.Position{
 													Column: 16,
-													Line:   20,
+													Line:   22,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Name: "got",
 									},
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 41,
-												Line:   20,
+												Line:   22,
 											},
 											File:   "testing.flux",
 											Source: "got |> yield(name: \"got\")",
 											Start: ast.Position{
 												Column: 16,
-												Line:   20,
+												Line:   22,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Call: &ast.CallExpression{
 										Arguments: []ast.Expression{&ast.ObjectExpression{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 40,
-														Line:   20,
+														Line:   22,
 													},
 													File:   "testing.flux",
 													Source: "name: \"got\"",
 													Start: ast.Position{
 														Column: 29,
-														Line:   20,
+														Line:   22,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Properties: []*ast.Property{&ast.Property{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 40,
-															Line:   20,
+															Line:   22,
 														},
 														File:   "testing.flux",
 														Source: "name: \"got\"",
 														Start: ast.Position{
 															Column: 29,
-															Line:   20,
+															Line:   22,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Key: &ast.Identifier{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 33,
-																Line:   20,
+																Line:   22,
 															},
 															File:   "testing.flux",
 															Source: "name",
 															Start: ast.Position{
 																Column: 29,
-																Line:   20,
+																Line:   22,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Name: "name",
 												},
 												Value: &ast.StringLiteral{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 40,
-																Line:   20,
+																Line:   22,
 															},
 															File:   "testing.flux",
 															Source: "\"got\"",
 															Start: ast.Position{
 																Column: 35,
-																Line:   20,
+																Line:   22,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Value: "got",
 												},
@@ -1687,35 +1957,39 @@ var pkgAST = &ast.Package{
 											With: nil,
 										}},
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 41,
-													Line:   20,
+													Line:   22,
 												},
 												File:   "testing.flux",
 												Source: "yield(name: \"got\")",
 												Start: ast.Position{
 													Column: 23,
-													Line:   20,
+													Line:   22,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Callee: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 28,
-														Line:   20,
+														Line:   22,
 													},
 													File:   "testing.flux",
 													Source: "yield",
 													Start: ast.Position{
 														Column: 23,
-														Line:   20,
+														Line:   22,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "yield",
 										},
@@ -1723,138 +1997,154 @@ var pkgAST = &ast.Package{
 								},
 							}, &ast.Property{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 42,
-											Line:   21,
+											Line:   23,
 										},
 										File:   "testing.flux",
 										Source: "diff:  dif |> yield(name: \"diff\")",
 										Start: ast.Position{
 											Column: 9,
-											Line:   21,
+											Line:   23,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Key: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 13,
-												Line:   21,
+												Line:   23,
 											},
 											File:   "testing.flux",
 											Source: "diff",
 											Start: ast.Position{
 												Column: 9,
-												Line:   21,
+												Line:   23,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "diff",
 								},
 								Value: &ast.PipeExpression{
 									Argument: &ast.Identifier{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 19,
-													Line:   21,
+													Line:   23,
 												},
 												File:   "testing.flux",
 												Source: "dif",
 												Start: ast.Position{
 													Column: 16,
-													Line:   21,
+													Line:   23,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Name: "dif",
 									},
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 42,
-												Line:   21,
+												Line:   23,
 											},
 											File:   "testing.flux",
 											Source: "dif |> yield(name: \"diff\")",
 											Start: ast.Position{
 												Column: 16,
-												Line:   21,
+												Line:   23,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Call: &ast.CallExpression{
 										Arguments: []ast.Expression{&ast.ObjectExpression{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 41,
-														Line:   21,
+														Line:   23,
 													},
 													File:   "testing.flux",
 													Source: "name: \"diff\"",
 													Start: ast.Position{
 														Column: 29,
-														Line:   21,
+														Line:   23,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Properties: []*ast.Property{&ast.Property{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 41,
-															Line:   21,
+															Line:   23,
 														},
 														File:   "testing.flux",
 														Source: "name: \"diff\"",
 														Start: ast.Position{
 															Column: 29,
-															Line:   21,
+															Line:   23,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Key: &ast.Identifier{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 33,
-																Line:   21,
+																Line:   23,
 															},
 															File:   "testing.flux",
 															Source: "name",
 															Start: ast.Position{
 																Column: 29,
-																Line:   21,
+																Line:   23,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Name: "name",
 												},
 												Value: &ast.StringLiteral{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 41,
-																Line:   21,
+																Line:   23,
 															},
 															File:   "testing.flux",
 															Source: "\"diff\"",
 															Start: ast.Position{
 																Column: 35,
-																Line:   21,
+																Line:   23,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Value: "diff",
 												},
@@ -1862,35 +2152,39 @@ var pkgAST = &ast.Package{
 											With: nil,
 										}},
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 42,
-													Line:   21,
+													Line:   23,
 												},
 												File:   "testing.flux",
 												Source: "yield(name: \"diff\")",
 												Start: ast.Position{
 													Column: 23,
-													Line:   21,
+													Line:   23,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Callee: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 28,
-														Line:   21,
+														Line:   23,
 													},
 													File:   "testing.flux",
 													Source: "yield",
 													Start: ast.Position{
 														Column: 23,
-														Line:   21,
+														Line:   23,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "yield",
 										},
@@ -1900,53 +2194,59 @@ var pkgAST = &ast.Package{
 							With: nil,
 						},
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 6,
-									Line:   22,
+									Line:   24,
 								},
 								File:   "testing.flux",
 								Source: "return {\n        fn:    tc.fn,\n        input: tc.input,\n        want:  tc.want |> yield(name: \"want\"),\n        got:   got |> yield(name: \"got\"),\n        diff:  dif |> yield(name: \"diff\"),\n    }",
 								Start: ast.Position{
 									Column: 5,
-									Line:   16,
+									Line:   18,
 								},
 							},
+							TrailingComment: nil,
 						},
 					}},
 				},
 				Params: []*ast.Property{&ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 16,
-								Line:   12,
+								Line:   14,
 							},
 							File:   "testing.flux",
 							Source: "case",
 							Start: ast.Position{
 								Column: 12,
-								Line:   12,
+								Line:   14,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 16,
-									Line:   12,
+									Line:   14,
 								},
 								File:   "testing.flux",
 								Source: "case",
 								Start: ast.Position{
 									Column: 12,
-									Line:   12,
+									Line:   14,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Name: "case",
 					},
@@ -1955,313 +2255,1112 @@ var pkgAST = &ast.Package{
 			},
 		}, &ast.VariableAssignment{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: []ast.Comment{ast.Comment{Text: "// run checks that the result of a test case is equal to its want stream."}, ast.Comment{Text: "// A test case with an expectError regular expression instead passes when"}, ast.Comment{Text: "// evaluating it fails with a matching error and, if expectCode is set,"}, ast.Comment{Text: "// with the named error code."}},
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 2,
-						Line:   27,
+						Line:   41,
 					},
 					File:   "testing.flux",
-					Source: "run = (case) => {\n    return inspect(case: case).diff |> assertEmpty()\n}",
+					Source: "run = (case) => {\n    tc = case()\n    return if exists tc.expectError then\n        shouldError(\n            fn: () => tc.input |> tc.fn(),\n            want: tc.expectError,\n            code: if exists tc.expectCode then tc.expectCode else \"\",\n        )\n    else\n        inspect(case: case).diff |> assertEmpty()\n}",
 					Start: ast.Position{
 						Column: 1,
-						Line:   25,
+						Line:   31,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 4,
-							Line:   25,
+							Line:   31,
 						},
 						File:   "testing.flux",
 						Source: "run",
 						Start: ast.Position{
 							Column: 1,
-							Line:   25,
+							Line:   31,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "run",
 			},
 			Init: &ast.FunctionExpression{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 2,
-							Line:   27,
+							Line:   41,
 						},
 						File:   "testing.flux",
-						Source: "(case) => {\n    return inspect(case: case).diff |> assertEmpty()\n}",
+						Source: "(case) => {\n    tc = case()\n    return if exists tc.expectError then\n        shouldError(\n            fn: () => tc.input |> tc.fn(),\n            want: tc.expectError,\n            code: if exists tc.expectCode then tc.expectCode else \"\",\n        )\n    else\n        inspect(case: case).diff |> assertEmpty()\n}",
 						Start: ast.Position{
 							Column: 7,
-							Line:   25,
+							Line:   31,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Body: &ast.Block{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 2,
-								Line:   27,
+								Line:   41,
 							},
 							File:   "testing.flux",
-							Source: "{\n    return inspect(case: case).diff |> assertEmpty()\n}",
+							Source: "{\n    tc = case()\n    return if exists tc.expectError then\n        shouldError(\n            fn: () => tc.input |> tc.fn(),\n            want: tc.expectError,\n            code: if exists tc.expectCode then tc.expectCode else \"\",\n        )\n    else\n        inspect(case: case).diff |> assertEmpty()\n}",
 							Start: ast.Position{
 								Column: 17,
-								Line:   25,
+								Line:   31,
 							},
 						},
+						TrailingComment: nil,
 					},
-					Body: []ast.Statement{&ast.ReturnStatement{
-						Argument: &ast.PipeExpression{
-							Argument: &ast.MemberExpression{
-								BaseNode: ast.BaseNode{
-									Errors: nil,
-									Loc: &ast.SourceLocation{
-										End: ast.Position{
-											Column: 36,
-											Line:   26,
-										},
-										File:   "testing.flux",
-										Source: "inspect(case: case).diff",
-										Start: ast.Position{
-											Column: 12,
-											Line:   26,
-										},
+					Body: []ast.Statement{&ast.VariableAssignment{
+						BaseNode: ast.BaseNode{
+							Comments: nil,
+							Errors:   nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 16,
+									Line:   32,
+								},
+								File:   "testing.flux",
+								Source: "tc = case()",
+								Start: ast.Position{
+									Column: 5,
+									Line:   32,
+								},
+							},
+							TrailingComment: nil,
+						},
+						ID: &ast.Identifier{
+							BaseNode: ast.BaseNode{
+								Comments: nil,
+								Errors:   nil,
+								Loc: &ast.SourceLocation{
+									End: ast.Position{
+										Column: 7,
+										Line:   32,
+									},
+									File:   "testing.flux",
+									Source: "tc",
+									Start: ast.Position{
+										Column: 5,
+										Line:   32,
 									},
 								},
-								Object: &ast.CallExpression{
-									Arguments: []ast.Expression{&ast.ObjectExpression{
-										BaseNode: ast.BaseNode{
-											Errors: nil,
-											Loc: &ast.SourceLocation{
-												End: ast.Position{
-													Column: 30,
-													Line:   26,
-												},
-												File:   "testing.flux",
-												Source: "case: case",
-												Start: ast.Position{
-													Column: 20,
-													Line:   26,
-												},
+								TrailingComment: nil,
+							},
+							Name: "tc",
+						},
+						Init: &ast.CallExpression{
+							Arguments: nil,
+							BaseNode: ast.BaseNode{
+								Comments: nil,
+								Errors:   nil,
+								Loc: &ast.SourceLocation{
+									End: ast.Position{
+										Column: 16,
+										Line:   32,
+									},
+									File:   "testing.flux",
+									Source: "case()",
+									Start: ast.Position{
+										Column: 10,
+										Line:   32,
+									},
+								},
+								TrailingComment: nil,
+							},
+							Callee: &ast.Identifier{
+								BaseNode: ast.BaseNode{
+									Comments: nil,
+									Errors:   nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 14,
+											Line:   32,
+										},
+										File:   "testing.flux",
+										Source: "case",
+										Start: ast.Position{
+											Column: 10,
+											Line:   32,
+										},
+									},
+									TrailingComment: nil,
+								},
+								Name: "case",
+							},
+						},
+					}, &ast.ReturnStatement{
+						Argument: &ast.ConditionalExpression{
+							Alternate: &ast.PipeExpression{
+								Argument: &ast.MemberExpression{
+									BaseNode: ast.BaseNode{
+										Comments: nil,
+										Errors:   nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 33,
+												Line:   40,
+											},
+											File:   "testing.flux",
+											Source: "inspect(case: case).diff",
+											Start: ast.Position{
+												Column: 9,
+												Line:   40,
 											},
 										},
-										Properties: []*ast.Property{&ast.Property{
+										TrailingComment: nil,
+									},
+									Object: &ast.CallExpression{
+										Arguments: []ast.Expression{&ast.ObjectExpression{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
-														Column: 30,
-														Line:   26,
+														Column: 27,
+														Line:   40,
 													},
 													File:   "testing.flux",
 													Source: "case: case",
 													Start: ast.Position{
-														Column: 20,
-														Line:   26,
+														Column: 17,
+														Line:   40,
 													},
 												},
+												TrailingComment: nil,
 											},
-											Key: &ast.Identifier{
+											Properties: []*ast.Property{&ast.Property{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
-															Column: 24,
-															Line:   26,
+															Column: 27,
+															Line:   40,
 														},
 														File:   "testing.flux",
-														Source: "case",
+														Source: "case: case",
 														Start: ast.Position{
-															Column: 20,
-															Line:   26,
+															Column: 17,
+															Line:   40,
 														},
 													},
+													TrailingComment: nil,
 												},
-												Name: "case",
-											},
-											Value: &ast.Identifier{
-												BaseNode: ast.BaseNode{
-													Errors: nil,
-													Loc: &ast.SourceLocation{
-														End: ast.Position{
-															Column: 30,
-															Line:   26,
+												Key: &ast.Identifier{
+													BaseNode: ast.BaseNode{
+														Comments: nil,
+														Errors:   nil,
+														Loc: &ast.SourceLocation{
+															End: ast.Position{
+																Column: 21,
+																Line:   40,
+															},
+															File:   "testing.flux",
+															Source: "case",
+															Start: ast.Position{
+																Column: 17,
+																Line:   40,
+															},
 														},
-														File:   "testing.flux",
-														Source: "case",
-														Start: ast.Position{
-															Column: 26,
-															Line:   26,
+														TrailingComment: nil,
+													},
+													Name: "case",
+												},
+												Value: &ast.Identifier{
+													BaseNode: ast.BaseNode{
+														Comments: nil,
+														Errors:   nil,
+														Loc: &ast.SourceLocation{
+															End: ast.Position{
+																Column: 27,
+																Line:   40,
+															},
+															File:   "testing.flux",
+															Source: "case",
+															Start: ast.Position{
+																Column: 23,
+																Line:   40,
+															},
 														},
+														TrailingComment: nil,
 													},
+													Name: "case",
 												},
-												Name: "case",
-											},
+											}},
+											With: nil,
 										}},
-										With: nil,
-									}},
-									BaseNode: ast.BaseNode{
-										Errors: nil,
-										Loc: &ast.SourceLocation{
-											End: ast.Position{
-												Column: 31,
-												Line:   26,
+										BaseNode: ast.BaseNode{
+											Comments: nil,
+											Errors:   nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 28,
+													Line:   40,
+												},
+												File:   "testing.flux",
+												Source: "inspect(case: case)",
+												Start: ast.Position{
+													Column: 9,
+													Line:   40,
+												},
 											},
-											File:   "testing.flux",
-											Source: "inspect(case: case)",
-											Start: ast.Position{
-												Column: 12,
-												Line:   26,
+											TrailingComment: nil,
+										},
+										Callee: &ast.Identifier{
+											BaseNode: ast.BaseNode{
+												Comments: nil,
+												Errors:   nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 16,
+														Line:   40,
+													},
+													File:   "testing.flux",
+													Source: "inspect",
+													Start: ast.Position{
+														Column: 9,
+														Line:   40,
+													},
+												},
+												TrailingComment: nil,
 											},
+											Name: "inspect",
 										},
 									},
-									Callee: &ast.Identifier{
+									Property: &ast.Identifier{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
-													Column: 19,
-													Line:   26,
+													Column: 33,
+													Line:   40,
 												},
 												File:   "testing.flux",
-												Source: "inspect",
+												Source: "diff",
 												Start: ast.Position{
-													Column: 12,
-													Line:   26,
+													Column: 29,
+													Line:   40,
 												},
 											},
+											TrailingComment: nil,
 										},
-										Name: "inspect",
+										Name: "diff",
 									},
 								},
-								Property: &ast.Identifier{
+								BaseNode: ast.BaseNode{
+									Comments: nil,
+									Errors:   nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 50,
+											Line:   40,
+										},
+										File:   "testing.flux",
+										Source: "inspect(case: case).diff |> assertEmpty()",
+										Start: ast.Position{
+											Column: 9,
+											Line:   40,
+										},
+									},
+									TrailingComment: nil,
+								},
+								Call: &ast.CallExpression{
+									Arguments: nil,
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
-												Column: 36,
-												Line:   26,
+												Column: 50,
+												Line:   40,
 											},
 											File:   "testing.flux",
-											Source: "diff",
+											Source: "assertEmpty()",
 											Start: ast.Position{
-												Column: 32,
-												Line:   26,
+												Column: 37,
+												Line:   40,
 											},
 										},
+										TrailingComment: nil,
+									},
+									Callee: &ast.Identifier{
+										BaseNode: ast.BaseNode{
+											Comments: nil,
+											Errors:   nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 48,
+													Line:   40,
+												},
+												File:   "testing.flux",
+												Source: "assertEmpty",
+												Start: ast.Position{
+													Column: 37,
+													Line:   40,
+												},
+											},
+											TrailingComment: nil,
+										},
+										Name: "assertEmpty",
 									},
-									Name: "diff",
 								},
 							},
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
-										Column: 53,
-										Line:   26,
+										Column: 50,
+										Line:   40,
 									},
 									File:   "testing.flux",
-									Source: "inspect(case: case).diff |> assertEmpty()",
+									Source: "if exists tc.expectError then\n        shouldError(\n            fn: () => tc.input |> tc.fn(),\n            want: tc.expectError,\n            code: if exists tc.expectCode then tc.expectCode else \"\",\n        )\n    else\n        inspect(case: case).diff |> assertEmpty()",
 									Start: ast.Position{
 										Column: 12,
-										Line:   26,
+										Line:   33,
 									},
 								},
+								TrailingComment: nil,
 							},
-							Call: &ast.CallExpression{
-								Arguments: nil,
+							Consequent: &ast.CallExpression{
+								Arguments: []ast.Expression{&ast.ObjectExpression{
+									BaseNode: ast.BaseNode{
+										Comments: nil,
+										Errors:   nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 69,
+												Line:   37,
+											},
+											File:   "testing.flux",
+											Source: "fn: () => tc.input |> tc.fn(),\n            want: tc.expectError,\n            code: if exists tc.expectCode then tc.expectCode else \"\"",
+											Start: ast.Position{
+												Column: 13,
+												Line:   35,
+											},
+										},
+										TrailingComment: nil,
+									},
+									Properties: []*ast.Property{&ast.Property{
+										BaseNode: ast.BaseNode{
+											Comments: nil,
+											Errors:   nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 42,
+													Line:   35,
+												},
+												File:   "testing.flux",
+												Source: "fn: () => tc.input |> tc.fn()",
+												Start: ast.Position{
+													Column: 13,
+													Line:   35,
+												},
+											},
+											TrailingComment: nil,
+										},
+										Key: &ast.Identifier{
+											BaseNode: ast.BaseNode{
+												Comments: nil,
+												Errors:   nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 15,
+														Line:   35,
+													},
+													File:   "testing.flux",
+													Source: "fn",
+													Start: ast.Position{
+														Column: 13,
+														Line:   35,
+													},
+												},
+												TrailingComment: nil,
+											},
+											Name: "fn",
+										},
+										Value: &ast.FunctionExpression{
+											BaseNode: ast.BaseNode{
+												Comments: nil,
+												Errors:   nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 42,
+														Line:   35,
+													},
+													File:   "testing.flux",
+													Source: "() => tc.input |> tc.fn()",
+													Start: ast.Position{
+														Column: 17,
+														Line:   35,
+													},
+												},
+												TrailingComment: nil,
+											},
+											Body: &ast.PipeExpression{
+												Argument: &ast.MemberExpression{
+													BaseNode: ast.BaseNode{
+														Comments: nil,
+														Errors:   nil,
+														Loc: &ast.SourceLocation{
+															End: ast.Position{
+																Column: 31,
+																Line:   35,
+															},
+															File:   "testing.flux",
+															Source: "tc.input",
+															Start: ast.Position{
+																Column: 23,
+																Line:   35,
+															},
+														},
+														TrailingComment: nil,
+													},
+													Object: &ast.Identifier{
+														BaseNode: ast.BaseNode{
+															Comments: nil,
+															Errors:   nil,
+															Loc: &ast.SourceLocation{
+																End: ast.Position{
+																	Column: 25,
+																	Line:   35,
+																},
+																File:   "testing.flux",
+																Source: "tc",
+																Start: ast.Position{
+																	Column: 23,
+																	Line:   35,
+																},
+															},
+															TrailingComment: nil,
+														},
+														Name: "tc",
+													},
+													Property: &ast.Identifier{
+														BaseNode: ast.BaseNode{
+															Comments: nil,
+															Errors:   nil,
+															Loc: &ast.SourceLocation{
+																End: ast.Position{
+																	Column: 31,
+																	Line:   35,
+																},
+																File:   "testing.flux",
+																Source: "input",
+																Start: ast.Position{
+																	Column: 26,
+																	Line:   35,
+																},
+															},
+															TrailingComment: nil,
+														},
+														Name: "input",
+													},
+												},
+												BaseNode: ast.BaseNode{
+													Comments: nil,
+													Errors:   nil,
+													Loc: &ast.SourceLocation{
+														End: ast.Position{
+															Column: 42,
+															Line:   35,
+														},
+														File:   "testing.flux",
+														Source: "tc.input |> tc.fn()",
+														Start: ast.Position{
+															Column: 23,
+															Line:   35,
+														},
+													},
+													TrailingComment: nil,
+												},
+												Call: &ast.CallExpression{
+													Arguments: nil,
+													BaseNode: ast.BaseNode{
+														Comments: nil,
+														Errors:   nil,
+														Loc: &ast.SourceLocation{
+															End: ast.Position{
+																Column: 42,
+																Line:   35,
+															},
+															File:   "testing.flux",
+															Source: "tc.fn()",
+															Start: ast.Position{
+																Column: 35,
+																Line:   35,
+															},
+														},
+														TrailingComment: nil,
+													},
+													Callee: &ast.MemberExpression{
+														BaseNode: ast.BaseNode{
+															Comments: nil,
+															Errors:   nil,
+															Loc: &ast.SourceLocation{
+																End: ast.Position{
+																	Column: 40,
+																	Line:   35,
+																},
+																File:   "testing.flux",
+																Source: "tc.fn",
+																Start: ast.Position{
+																	Column: 35,
+																	Line:   35,
+																},
+															},
+															TrailingComment: nil,
+														},
+														Object: &ast.Identifier{
+															BaseNode: ast.BaseNode{
+																Comments: nil,
+																Errors:   nil,
+																Loc: &ast.SourceLocation{
+																	End: ast.Position{
+																		Column: 37,
+																		Line:   35,
+																	},
+																	File:   "testing.flux",
+																	Source: "tc",
+																	Start: ast.Position{
+																		Column: 35,
+																		Line:   35,
+																	},
+																},
+																TrailingComment: nil,
+															},
+															Name: "tc",
+														},
+														Property: &ast.Identifier{
+															BaseNode: ast.BaseNode{
+																Comments: nil,
+																Errors:   nil,
+																Loc: &ast.SourceLocation{
+																	End: ast.Position{
+																		Column: 40,
+																		Line:   35,
+																	},
+																	File:   "testing.flux",
+																	Source: "fn",
+																	Start: ast.Position{
+																		Column: 38,
+																		Line:   35,
+																	},
+																},
+																TrailingComment: nil,
+															},
+															Name: "fn",
+														},
+													},
+												},
+											},
+											Params: nil,
+										},
+									}, &ast.Property{
+										BaseNode: ast.BaseNode{
+											Comments: nil,
+											Errors:   nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 33,
+													Line:   36,
+												},
+												File:   "testing.flux",
+												Source: "want: tc.expectError",
+												Start: ast.Position{
+													Column: 13,
+													Line:   36,
+												},
+											},
+											TrailingComment: nil,
+										},
+										Key: &ast.Identifier{
+											BaseNode: ast.BaseNode{
+												Comments: nil,
+												Errors:   nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 17,
+														Line:   36,
+													},
+													File:   "testing.flux",
+													Source: "want",
+													Start: ast.Position{
+														Column: 13,
+														Line:   36,
+													},
+												},
+												TrailingComment: nil,
+											},
+											Name: "want",
+										},
+										Value: &ast.MemberExpression{
+											BaseNode: ast.BaseNode{
+												Comments: nil,
+												Errors:   nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 33,
+														Line:   36,
+													},
+													File:   "testing.flux",
+													Source: "tc.expectError",
+													Start: ast.Position{
+														Column: 19,
+														Line:   36,
+													},
+												},
+												TrailingComment: nil,
+											},
+											Object: &ast.Identifier{
+												BaseNode: ast.BaseNode{
+													Comments: nil,
+													Errors:   nil,
+													Loc: &ast.SourceLocation{
+														End: ast.Position{
+															Column: 21,
+															Line:   36,
+														},
+														File:   "testing.flux",
+														Source: "tc",
+														Start: ast.Position{
+															Column: 19,
+															Line:   36,
+														},
+													},
+													TrailingComment: nil,
+												},
+												Name: "tc",
+											},
+											Property: &ast.Identifier{
+												BaseNode: ast.BaseNode{
+													Comments: nil,
+													Errors:   nil,
+													Loc: &ast.SourceLocation{
+														End: ast.Position{
+															Column: 33,
+															Line:   36,
+														},
+														File:   "testing.flux",
+														Source: "expectError",
+														Start: ast.Position{
+															Column: 22,
+															Line:   36,
+														},
+													},
+													TrailingComment: nil,
+												},
+												Name: "expectError",
+											},
+										},
+									}, &ast.Property{
+										BaseNode: ast.BaseNode{
+											Comments: nil,
+											Errors:   nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 69,
+													Line:   37,
+												},
+												File:   "testing.flux",
+												Source: "code: if exists tc.expectCode then tc.expectCode else \"\"",
+												Start: ast.Position{
+													Column: 13,
+													Line:   37,
+												},
+											},
+											TrailingComment: nil,
+										},
+										Key: &ast.Identifier{
+											BaseNode: ast.BaseNode{
+												Comments: nil,
+												Errors:   nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 17,
+														Line:   37,
+													},
+													File:   "testing.flux",
+													Source: "code",
+													Start: ast.Position{
+														Column: 13,
+														Line:   37,
+													},
+												},
+												TrailingComment: nil,
+											},
+											Name: "code",
+										},
+										Value: &ast.ConditionalExpression{
+											Alternate: &ast.StringLiteral{
+												BaseNode: ast.BaseNode{
+													Comments: nil,
+													Errors:   nil,
+													Loc: &ast.SourceLocation{
+														End: ast.Position{
+															Column: 69,
+															Line:   37,
+														},
+														File:   "testing.flux",
+														Source: "\"\"",
+														Start: ast.Position{
+															Column: 67,
+															Line:   37,
+														},
+													},
+													TrailingComment: nil,
+												},
+												Value: "",
+											},
+											BaseNode: ast.BaseNode{
+												Comments: nil,
+												Errors:   nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 69,
+														Line:   37,
+													},
+													File:   "testing.flux",
+													Source: "if exists tc.expectCode then tc.expectCode else \"\"",
+													Start: ast.Position{
+														Column: 19,
+														Line:   37,
+													},
+												},
+												TrailingComment: nil,
+											},
+											Consequent: &ast.MemberExpression{
+												BaseNode: ast.BaseNode{
+													Comments: nil,
+													Errors:   nil,
+													Loc: &ast.SourceLocation{
+														End: ast.Position{
+															Column: 61,
+															Line:   37,
+														},
+														File:   "testing.flux",
+														Source: "tc.expectCode",
+														Start: ast.Position{
+															Column: 48,
+															Line:   37,
+														},
+													},
+													TrailingComment: nil,
+												},
+												Object: &ast.Identifier{
+													BaseNode: ast.BaseNode{
+														Comments: nil,
+														Errors:   nil,
+														Loc: &ast.SourceLocation{
+															End: ast.Position{
+																Column: 50,
+																Line:   37,
+															},
+															File:   "testing.flux",
+															Source: "tc",
+															Start: ast.Position{
+																Column: 48,
+																Line:   37,
+															},
+														},
+														TrailingComment: nil,
+													},
+													Name: "tc",
+												},
+												Property: &ast.Identifier{
+													BaseNode: ast.BaseNode{
+														Comments: nil,
+														Errors:   nil,
+														Loc: &ast.SourceLocation{
+															End: ast.Position{
+																Column: 61,
+																Line:   37,
+															},
+															File:   "testing.flux",
+															Source: "expectCode",
+															Start: ast.Position{
+																Column: 51,
+																Line:   37,
+															},
+														},
+														TrailingComment: nil,
+													},
+													Name: "expectCode",
+												},
+											},
+											Test: &ast.UnaryExpression{
+												Argument: &ast.MemberExpression{
+													BaseNode: ast.BaseNode{
+														Comments: nil,
+														Errors:   nil,
+														Loc: &ast.SourceLocation{
+															End: ast.Position{
+																Column: 42,
+																Line:   37,
+															},
+															File:   "testing.flux",
+															Source: "tc.expectCode",
+															Start: ast.Position{
+																Column: 29,
+																Line:   37,
+															},
+														},
+														TrailingComment: nil,
+													},
+													Object: &ast.Identifier{
+														BaseNode: ast.BaseNode{
+															Comments: nil,
+															Errors:   nil,
+															Loc: &ast.SourceLocation{
+																End: ast.Position{
+																	Column: 31,
+																	Line:   37,
+																},
+																File:   "testing.flux",
+																Source: "tc",
+																Start: ast.Position{
+																	Column: 29,
+																	Line:   37,
+																},
+															},
+															TrailingComment: nil,
+														},
+														Name: "tc",
+													},
+													Property: &ast.Identifier{
+														BaseNode: ast.BaseNode{
+															Comments: nil,
+															Errors:   nil,
+															Loc: &ast.SourceLocation{
+																End: ast.Position{
+																	Column: 42,
+																	Line:   37,
+																},
+																File:   "testing.flux",
+																Source: "expectCode",
+																Start: ast.Position{
+																	Column: 32,
+																	Line:   37,
+																},
+															},
+															TrailingComment: nil,
+														},
+														Name: "expectCode",
+													},
+												},
+												BaseNode: ast.BaseNode{
+													Comments: nil,
+													Errors:   nil,
+													Loc: &ast.SourceLocation{
+														End: ast.Position{
+															Column: 42,
+															Line:   37,
+														},
+														File:   "testing.flux",
+														Source: "exists tc.expectCode",
+														Start: ast.Position{
+															Column: 22,
+															Line:   37,
+														},
+													},
+													TrailingComment: nil,
+												},
+												Operator: 14,
+											},
+										},
+									}},
+									With: nil,
+								}},
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
-											Column: 53,
-											Line:   26,
+											Column: 10,
+											Line:   38,
 										},
 										File:   "testing.flux",
-										Source: "assertEmpty()",
+										Source: "shouldError(\n            fn: () => tc.input |> tc.fn(),\n            want: tc.expectError,\n            code: if exists tc.expectCode then tc.expectCode else \"\",\n        )",
 										Start: ast.Position{
-											Column: 40,
-											Line:   26,
+											Column: 9,
+											Line:   34,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Callee: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 20,
+												Line:   34,
+											},
+											File:   "testing.flux",
+											Source: "shouldError",
+											Start: ast.Position{
+												Column: 9,
+												Line:   34,
+											},
+										},
+										TrailingComment: nil,
+									},
+									Name: "shouldError",
+								},
+							},
+							Test: &ast.UnaryExpression{
+								Argument: &ast.MemberExpression{
+									BaseNode: ast.BaseNode{
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
-												Column: 51,
-												Line:   26,
+												Column: 36,
+												Line:   33,
 											},
 											File:   "testing.flux",
-											Source: "assertEmpty",
+											Source: "tc.expectError",
 											Start: ast.Position{
-												Column: 40,
-												Line:   26,
+												Column: 22,
+												Line:   33,
+											},
+										},
+										TrailingComment: nil,
+									},
+									Object: &ast.Identifier{
+										BaseNode: ast.BaseNode{
+											Comments: nil,
+											Errors:   nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 24,
+													Line:   33,
+												},
+												File:   "testing.flux",
+												Source: "tc",
+												Start: ast.Position{
+													Column: 22,
+													Line:   33,
+												},
 											},
+											TrailingComment: nil,
 										},
+										Name: "tc",
+									},
+									Property: &ast.Identifier{
+										BaseNode: ast.BaseNode{
+											Comments: nil,
+											Errors:   nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 36,
+													Line:   33,
+												},
+												File:   "testing.flux",
+												Source: "expectError",
+												Start: ast.Position{
+													Column: 25,
+													Line:   33,
+												},
+											},
+											TrailingComment: nil,
+										},
+										Name: "expectError",
 									},
-									Name: "assertEmpty",
 								},
+								BaseNode: ast.BaseNode{
+									Comments: nil,
+									Errors:   nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 36,
+											Line:   33,
+										},
+										File:   "testing.flux",
+										Source: "exists tc.expectError",
+										Start: ast.Position{
+											Column: 15,
+											Line:   33,
+										},
+									},
+									TrailingComment: nil,
+								},
+								Operator: 14,
 							},
 						},
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
-									Column: 53,
-									Line:   26,
+									Column: 50,
+									Line:   40,
 								},
 								File:   "testing.flux",
-								Source: "return inspect(case: case).diff |> assertEmpty()",
+								Source: "return if exists tc.expectError then\n        shouldError(\n            fn: () => tc.input |> tc.fn(),\n            want: tc.expectError,\n            code: if exists tc.expectCode then tc.expectCode else \"\",\n        )\n    else\n        inspect(case: case).diff |> assertEmpty()",
 								Start: ast.Position{
 									Column: 5,
-									Line:   26,
+									Line:   33,
 								},
 							},
+							TrailingComment: nil,
 						},
 					}},
 				},
 				Params: []*ast.Property{&ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 12,
-								Line:   25,
+								Line:   31,
 							},
 							File:   "testing.flux",
 							Source: "case",
 							Start: ast.Position{
 								Column: 8,
-								Line:   25,
+								Line:   31,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 12,
-									Line:   25,
+									Line:   31,
 								},
 								File:   "testing.flux",
 								Source: "case",
 								Start: ast.Position{
 									Column: 8,
-									Line:   25,
+									Line:   31,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Name: "case",
 					},
@@ -2270,136 +3369,152 @@ var pkgAST = &ast.Package{
 			},
 		}, &ast.VariableAssignment{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 2,
-						Line:   32,
+						Line:   46,
 					},
 					File:   "testing.flux",
 					Source: "benchmark = (case) => {\n\ttc = case()\n\treturn tc.input |> tc.fn()\n}",
 					Start: ast.Position{
 						Column: 1,
-						Line:   29,
+						Line:   43,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 10,
-							Line:   29,
+							Line:   43,
 						},
 						File:   "testing.flux",
 						Source: "benchmark",
 						Start: ast.Position{
 							Column: 1,
-							Line:   29,
+							Line:   43,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "benchmark",
 			},
 			Init: &ast.FunctionExpression{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 2,
-							Line:   32,
+							Line:   46,
 						},
 						File:   "testing.flux",
 						Source: "(case) => {\n\ttc = case()\n\treturn tc.input |> tc.fn()\n}",
 						Start: ast.Position{
 							Column: 13,
-							Line:   29,
+							Line:   43,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Body: &ast.Block{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 2,
-								Line:   32,
+								Line:   46,
 							},
 							File:   "testing.flux",
 							Source: "{\n\ttc = case()\n\treturn tc.input |> tc.fn()\n}",
 							Start: ast.Position{
 								Column: 23,
-								Line:   29,
+								Line:   43,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Body: []ast.Statement{&ast.VariableAssignment{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 13,
-									Line:   30,
+									Line:   44,
 								},
 								File:   "testing.flux",
 								Source: "tc = case()",
 								Start: ast.Position{
 									Column: 2,
-									Line:   30,
+									Line:   44,
 								},
 							},
+							TrailingComment: nil,
 						},
 						ID: &ast.Identifier{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 4,
-										Line:   30,
+										Line:   44,
 									},
 									File:   "testing.flux",
 									Source: "tc",
 									Start: ast.Position{
 										Column: 2,
-										Line:   30,
+										Line:   44,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Name: "tc",
 						},
 						Init: &ast.CallExpression{
 							Arguments: nil,
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 13,
-										Line:   30,
+										Line:   44,
 									},
 									File:   "testing.flux",
 									Source: "case()",
 									Start: ast.Position{
 										Column: 7,
-										Line:   30,
+										Line:   44,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Callee: &ast.Identifier{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 11,
-											Line:   30,
+											Line:   44,
 										},
 										File:   "testing.flux",
 										Source: "case",
 										Start: ast.Position{
 											Column: 7,
-											Line:   30,
+											Line:   44,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Name: "case",
 							},
@@ -2408,138 +3523,154 @@ var pkgAST = &ast.Package{
 						Argument: &ast.PipeExpression{
 							Argument: &ast.MemberExpression{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 17,
-											Line:   31,
+											Line:   45,
 										},
 										File:   "testing.flux",
 										Source: "tc.input",
 										Start: ast.Position{
 											Column: 9,
-											Line:   31,
+											Line:   45,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Object: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 11,
-												Line:   31,
+												Line:   45,
 											},
 											File:   "testing.flux",
 											Source: "tc",
 											Start: ast.Position{
 												Column: 9,
-												Line:   31,
+												Line:   45,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "tc",
 								},
 								Property: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 17,
-												Line:   31,
+												Line:   45,
 											},
 											File:   "testing.flux",
 											Source: "input",
 											Start: ast.Position{
 												Column: 12,
-												Line:   31,
+												Line:   45,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "input",
 								},
 							},
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 28,
-										Line:   31,
+										Line:   45,
 									},
 									File:   "testing.flux",
 									Source: "tc.input |> tc.fn()",
 									Start: ast.Position{
 										Column: 9,
-										Line:   31,
+										Line:   45,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Call: &ast.CallExpression{
 								Arguments: nil,
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 28,
-											Line:   31,
+											Line:   45,
 										},
 										File:   "testing.flux",
 										Source: "tc.fn()",
 										Start: ast.Position{
 											Column: 21,
-											Line:   31,
+											Line:   45,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Callee: &ast.MemberExpression{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 26,
-												Line:   31,
+												Line:   45,
 											},
 											File:   "testing.flux",
 											Source: "tc.fn",
 											Start: ast.Position{
 												Column: 21,
-												Line:   31,
+												Line:   45,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Object: &ast.Identifier{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 23,
-													Line:   31,
+													Line:   45,
 												},
 												File:   "testing.flux",
 												Source: "tc",
 												Start: ast.Position{
 													Column: 21,
-													Line:   31,
+													Line:   45,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Name: "tc",
 									},
 									Property: &ast.Identifier{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 26,
-													Line:   31,
+													Line:   45,
 												},
 												File:   "testing.flux",
 												Source: "fn",
 												Start: ast.Position{
 													Column: 24,
-													Line:   31,
+													Line:   45,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Name: "fn",
 									},
@@ -2547,53 +3678,59 @@ var pkgAST = &ast.Package{
 							},
 						},
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 28,
-									Line:   31,
+									Line:   45,
 								},
 								File:   "testing.flux",
 								Source: "return tc.input |> tc.fn()",
 								Start: ast.Position{
 									Column: 2,
-									Line:   31,
+									Line:   45,
 								},
 							},
+							TrailingComment: nil,
 						},
 					}},
 				},
 				Params: []*ast.Property{&ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 18,
-								Line:   29,
+								Line:   43,
 							},
 							File:   "testing.flux",
 							Source: "case",
 							Start: ast.Position{
 								Column: 14,
-								Line:   29,
+								Line:   43,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 18,
-									Line:   29,
+									Line:   43,
 								},
 								File:   "testing.flux",
 								Source: "case",
 								Start: ast.Position{
 									Column: 14,
-									Line:   29,
+									Line:   43,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Name: "case",
 					},
@@ -2601,10 +3738,12 @@ var pkgAST = &ast.Package{
 				}},
 			},
 		}},
+		Eof: nil,
 		Imports: []*ast.ImportDeclaration{&ast.ImportDeclaration{
 			As: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 9,
@@ -2617,11 +3756,13 @@ var pkgAST = &ast.Package{
 							Line:   3,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "c",
 			},
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 15,
@@ -2634,10 +3775,12 @@ var pkgAST = &ast.Package{
 						Line:   3,
 					},
 				},
+				TrailingComment: nil,
 			},
 			Path: &ast.StringLiteral{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 15,
@@ -2650,6 +3793,7 @@ var pkgAST = &ast.Package{
 							Line:   3,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Value: "csv",
 			},
@@ -2658,7 +3802,8 @@ var pkgAST = &ast.Package{
 		Name:     "testing.flux",
 		Package: &ast.PackageClause{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 16,
@@ -2671,10 +3816,12 @@ var pkgAST = &ast.Package{
 						Line:   1,
 					},
 				},
+				TrailingComment: nil,
 			},
 			Name: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 16,
@@ -2687,6 +3834,7 @@ var pkgAST = &ast.Package{
 							Line:   1,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "testing",
 			},
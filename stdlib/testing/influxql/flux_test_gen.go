@@ -10,12 +10,15 @@ import (
 
 var FluxTestPackages = []*ast.Package{&ast.Package{
 	BaseNode: ast.BaseNode{
-		Errors: nil,
-		Loc:    nil,
+		Comments:        nil,
+		Errors:          nil,
+		Loc:             nil,
+		TrailingComment: nil,
 	},
 	Files: []*ast.File{&ast.File{
 		BaseNode: ast.BaseNode{
-			Errors: nil,
+			Comments: nil,
+			Errors:   nil,
 			Loc: &ast.SourceLocation{
 				End: ast.Position{
 					Column: 3,
@@ -28,10 +31,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 					Line:   1,
 				},
 			},
+			TrailingComment: nil,
 		},
 		Body: []ast.Statement{&ast.VariableAssignment{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 2,
@@ -44,10 +49,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   6,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 7,
@@ -60,12 +67,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   6,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "inData",
 			},
 			Init: &ast.StringLiteral{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 2,
@@ -78,12 +87,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   6,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Value: "\n#datatype,string,long,dateTime:RFC3339,string,string,string,double\n#group,false,false,false,true,true,true,false\n#default,0,,,,,,\n,result,table,_time,_measurement,t,_field,_value\n,,0,1970-01-01T00:00:00Z,m,0,f,0.2128911717061432\n,,0,1970-01-01T01:00:00Z,m,0,f,0.07279997497030395\n,,0,1970-01-01T02:00:00Z,m,0,f,0.5685247543217412\n,,0,1970-01-01T03:00:00Z,m,0,f,0.29096443393390425\n,,0,1970-01-01T04:00:00Z,m,0,f,0.4356705625292191\n,,0,1970-01-01T05:00:00Z,m,0,f,0.9377323443071505\n,,0,1970-01-01T06:00:00Z,m,0,f,0.011686627909299956\n,,0,1970-01-01T07:00:00Z,m,0,f,0.4011512684588352\n,,0,1970-01-01T08:00:00Z,m,0,f,0.2467000604224705\n,,0,1970-01-01T09:00:00Z,m,0,f,0.035457662801040575\n,,0,1970-01-01T10:00:00Z,m,0,f,0.34069878765762024\n,,0,1970-01-01T11:00:00Z,m,0,f,0.0956150560348323\n,,0,1970-01-01T12:00:00Z,m,0,f,0.6807780291957698\n,,0,1970-01-01T13:00:00Z,m,0,f,0.5337946188653447\n,,0,1970-01-01T14:00:00Z,m,0,f,0.20254189091820943\n,,0,1970-01-01T15:00:00Z,m,0,f,0.9364775207397588\n,,0,1970-01-01T16:00:00Z,m,0,f,0.7339764257047982\n,,0,1970-01-01T17:00:00Z,m,0,f,0.5833903306697613\n,,0,1970-01-01T18:00:00Z,m,0,f,0.3640275286497918\n,,0,1970-01-01T19:00:00Z,m,0,f,0.6631189097103277\n,,1,1970-01-01T00:00:00Z,m,1,f,0.6769161010724867\n,,1,1970-01-01T01:00:00Z,m,1,f,0.6543314107501336\n,,1,1970-01-01T02:00:00Z,m,1,f,0.6291463500252206\n,,1,1970-01-01T03:00:00Z,m,1,f,0.3152301292534112\n,,1,1970-01-01T04:00:00Z,m,1,f,0.03392415665887264\n,,1,1970-01-01T05:00:00Z,m,1,f,0.5082438395428922\n,,1,1970-01-01T06:00:00Z,m,1,f,0.10525600178569848\n,,1,1970-01-01T07:00:00Z,m,1,f,0.09521084883546317\n,,1,1970-01-01T08:00:00Z,m,1,f,0.865591485631552\n,,1,1970-01-01T09:00:00Z,m,1,f,0.4959724763808837\n,,1,1970-01-01T10:00:00Z,m,1,f,0.05679627383763615\n,,1,1970-01-01T11:00:00Z,m,1,f,0.1220798530727814\n,,1,1970-01-01T12:00:00Z,m,1,f,0.41254260137685644\n,,1,1970-01-01T13:00:00Z,m,1,f,0.07588672428630415\n,,1,1970-01-01T14:00:00Z,m,1,f,0.24813765601579021\n,,1,1970-01-01T15:00:00Z,m,1,f,0.087428932094856\n,,1,1970-01-01T16:00:00Z,m,1,f,0.768343862050663\n,,1,1970-01-01T17:00:00Z,m,1,f,0.9683960475385988\n,,1,1970-01-01T18:00:00Z,m,1,f,0.024459901010390767\n,,1,1970-01-01T19:00:00Z,m,1,f,0.16444015119704355\n,,2,1970-01-01T00:00:00Z,m,2,f,0.15676131286844733\n,,2,1970-01-01T01:00:00Z,m,2,f,0.6400877570876031\n,,2,1970-01-01T02:00:00Z,m,2,f,0.893878275849246\n,,2,1970-01-01T03:00:00Z,m,2,f,0.7997870081362324\n,,2,1970-01-01T04:00:00Z,m,2,f,0.08663328673289308\n,,2,1970-01-01T05:00:00Z,m,2,f,0.8342029060820773\n,,2,1970-01-01T06:00:00Z,m,2,f,0.11628112203352979\n,,2,1970-01-01T07:00:00Z,m,2,f,0.2623494322713019\n,,2,1970-01-01T08:00:00Z,m,2,f,0.2803453288904869\n,,2,1970-01-01T09:00:00Z,m,2,f,0.1117401998642663\n,,2,1970-01-01T10:00:00Z,m,2,f,0.3250295694300974\n,,2,1970-01-01T11:00:00Z,m,2,f,0.6078411671373538\n,,2,1970-01-01T12:00:00Z,m,2,f,0.2512356097508373\n,,2,1970-01-01T13:00:00Z,m,2,f,0.4438471268050767\n,,2,1970-01-01T14:00:00Z,m,2,f,0.4961524472008469\n,,2,1970-01-01T15:00:00Z,m,2,f,0.27020729375557506\n,,2,1970-01-01T16:00:00Z,m,2,f,0.08718790189805248\n,,2,1970-01-01T17:00:00Z,m,2,f,0.9737141084388389\n,,2,1970-01-01T18:00:00Z,m,2,f,0.07166549074370027\n,,2,1970-01-01T19:00:00Z,m,2,f,0.26943653430867687\n,,3,1970-01-01T00:00:00Z,m,3,f,0.14437387897405465\n,,3,1970-01-01T01:00:00Z,m,3,f,0.21057568804660023\n,,3,1970-01-01T02:00:00Z,m,3,f,0.1100457949346592\n,,3,1970-01-01T03:00:00Z,m,3,f,0.21141077792261312\n,,3,1970-01-01T04:00:00Z,m,3,f,0.19825711934860404\n,,3,1970-01-01T05:00:00Z,m,3,f,0.8253021658464177\n,,3,1970-01-01T06:00:00Z,m,3,f,0.7540703924924461\n,,3,1970-01-01T07:00:00Z,m,3,f,0.9503578735213787\n,,3,1970-01-01T08:00:00Z,m,3,f,0.0355914513304335\n,,3,1970-01-01T09:00:00Z,m,3,f,0.2745746957286906\n,,3,1970-01-01T10:00:00Z,m,3,f,0.5443182488460032\n,,3,1970-01-01T11:00:00Z,m,3,f,0.44666597783400147\n,,3,1970-01-01T12:00:00Z,m,3,f,0.8093304994650494\n,,3,1970-01-01T13:00:00Z,m,3,f,0.4029418644675999\n,,3,1970-01-01T14:00:00Z,m,3,f,0.8576441137558692\n,,3,1970-01-01T15:00:00Z,m,3,f,0.5687663277465801\n,,3,1970-01-01T16:00:00Z,m,3,f,0.7196911732820932\n,,3,1970-01-01T17:00:00Z,m,3,f,0.029762405134454425\n,,3,1970-01-01T18:00:00Z,m,3,f,0.04147327683312637\n,,3,1970-01-01T19:00:00Z,m,3,f,0.9540246504017378\n,,4,1970-01-01T00:00:00Z,m,4,f,0.5897122797730547\n,,4,1970-01-01T01:00:00Z,m,4,f,0.34947791862749694\n,,4,1970-01-01T02:00:00Z,m,4,f,0.0348965616058906\n,,4,1970-01-01T03:00:00Z,m,4,f,0.5410660442822854\n,,4,1970-01-01T04:00:00Z,m,4,f,0.5905190211554855\n,,4,1970-01-01T05:00:00Z,m,4,f,0.26747573301173966\n,,4,1970-01-01T06:00:00Z,m,4,f,0.9013278299551851\n,,4,1970-01-01T07:00:00Z,m,4,f,0.020645183363589\n,,4,1970-01-01T08:00:00Z,m,4,f,0.9444715783077008\n,,4,1970-01-01T09:00:00Z,m,4,f,0.9249340696871452\n,,4,1970-01-01T10:00:00Z,m,4,f,0.895407127686278\n,,4,1970-01-01T11:00:00Z,m,4,f,0.09158865660821072\n,,4,1970-01-01T12:00:00Z,m,4,f,0.26170334389270705\n,,4,1970-01-01T13:00:00Z,m,4,f,0.04047303046439957\n,,4,1970-01-01T14:00:00Z,m,4,f,0.6890175711780648\n,,4,1970-01-01T15:00:00Z,m,4,f,0.169203543095355\n,,4,1970-01-01T16:00:00Z,m,4,f,0.30352036330937937\n,,4,1970-01-01T17:00:00Z,m,4,f,0.7227629347521738\n,,4,1970-01-01T18:00:00Z,m,4,f,0.23071325246204685\n,,4,1970-01-01T19:00:00Z,m,4,f,0.5423975878441447\n,,5,1970-01-01T00:00:00Z,m,5,f,0.6159810548328785\n,,5,1970-01-01T01:00:00Z,m,5,f,0.5286371352099966\n,,5,1970-01-01T02:00:00Z,m,5,f,0.27037470564664345\n,,5,1970-01-01T03:00:00Z,m,5,f,0.9821155970111088\n,,5,1970-01-01T04:00:00Z,m,5,f,0.14128644025052767\n,,5,1970-01-01T05:00:00Z,m,5,f,0.15532267675041508\n,,5,1970-01-01T06:00:00Z,m,5,f,0.06470957508645864\n,,5,1970-01-01T07:00:00Z,m,5,f,0.852695602303644\n,,5,1970-01-01T08:00:00Z,m,5,f,0.9769235734819968\n,,5,1970-01-01T09:00:00Z,m,5,f,0.569772655210167\n,,5,1970-01-01T10:00:00Z,m,5,f,0.17839244855342468\n,,5,1970-01-01T11:00:00Z,m,5,f,0.5601900079688499\n,,5,1970-01-01T12:00:00Z,m,5,f,0.7674758179196\n,,5,1970-01-01T13:00:00Z,m,5,f,0.2698164186565066\n,,5,1970-01-01T14:00:00Z,m,5,f,0.019420090923226472\n,,5,1970-01-01T15:00:00Z,m,5,f,0.2671021005213226\n,,5,1970-01-01T16:00:00Z,m,5,f,0.2470396837146283\n,,5,1970-01-01T17:00:00Z,m,5,f,0.20522314571010808\n,,5,1970-01-01T18:00:00Z,m,5,f,0.47998413047851307\n,,5,1970-01-01T19:00:00Z,m,5,f,0.48347993430331904\n,,6,1970-01-01T00:00:00Z,m,6,f,0.6342972876998171\n,,6,1970-01-01T01:00:00Z,m,6,f,0.6576024605878982\n,,6,1970-01-01T02:00:00Z,m,6,f,0.6447000482746346\n,,6,1970-01-01T03:00:00Z,m,6,f,0.21678100598418243\n,,6,1970-01-01T04:00:00Z,m,6,f,0.023408287556663337\n,,6,1970-01-01T05:00:00Z,m,6,f,0.9076309223942498\n,,6,1970-01-01T06:00:00Z,m,6,f,0.5817502777240137\n,,6,1970-01-01T07:00:00Z,m,6,f,0.052824998643205875\n,,6,1970-01-01T08:00:00Z,m,6,f,0.3809330972535029\n,,6,1970-01-01T09:00:00Z,m,6,f,0.7671686650796129\n,,6,1970-01-01T10:00:00Z,m,6,f,0.07979065684298921\n,,6,1970-01-01T11:00:00Z,m,6,f,0.3998772862617565\n,,6,1970-01-01T12:00:00Z,m,6,f,0.1155491863199121\n,,6,1970-01-01T13:00:00Z,m,6,f,0.7386676189881027\n,,6,1970-01-01T14:00:00Z,m,6,f,0.34629618068681484\n,,6,1970-01-01T15:00:00Z,m,6,f,0.5525776529918931\n,,6,1970-01-01T16:00:00Z,m,6,f,0.332627727109297\n,,6,1970-01-01T17:00:00Z,m,6,f,0.5131953610405185\n,,6,1970-01-01T18:00:00Z,m,6,f,0.6424768197911961\n,,6,1970-01-01T19:00:00Z,m,6,f,0.7165748159282228\n,,7,1970-01-01T00:00:00Z,m,7,f,0.2455426279704188\n,,7,1970-01-01T01:00:00Z,m,7,f,0.40163124489623003\n,,7,1970-01-01T02:00:00Z,m,7,f,0.5975613157276424\n,,7,1970-01-01T03:00:00Z,m,7,f,0.24368494285955775\n,,7,1970-01-01T04:00:00Z,m,7,f,0.5064758608991188\n,,7,1970-01-01T05:00:00Z,m,7,f,0.8752680628605042\n,,7,1970-01-01T06:00:00Z,m,7,f,0.6073886500434565\n,,7,1970-01-01T07:00:00Z,m,7,f,0.38193775998692464\n,,7,1970-01-01T08:00:00Z,m,7,f,0.39598277949908883\n,,7,1970-01-01T09:00:00Z,m,7,f,0.15966785725202795\n,,7,1970-01-01T10:00:00Z,m,7,f,0.629484974659171\n,,7,1970-01-01T11:00:00Z,m,7,f,0.8986665286614761\n,,7,1970-01-01T12:00:00Z,m,7,f,0.8351669026338405\n,,7,1970-01-01T13:00:00Z,m,7,f,0.7207824488925798\n,,7,1970-01-01T14:00:00Z,m,7,f,0.5707878569829702\n,,7,1970-01-01T15:00:00Z,m,7,f,0.8091445743234214\n,,7,1970-01-01T16:00:00Z,m,7,f,0.3371203534810527\n,,7,1970-01-01T17:00:00Z,m,7,f,0.1741931226422866\n,,7,1970-01-01T18:00:00Z,m,7,f,0.7377303139536953\n,,7,1970-01-01T19:00:00Z,m,7,f,0.6414830272020358\n,,8,1970-01-01T00:00:00Z,m,8,f,0.507272964067779\n,,8,1970-01-01T01:00:00Z,m,8,f,0.7119639952021554\n,,8,1970-01-01T02:00:00Z,m,8,f,0.811656300965649\n,,8,1970-01-01T03:00:00Z,m,8,f,0.42116179653493335\n,,8,1970-01-01T04:00:00Z,m,8,f,0.43222575065281893\n,,8,1970-01-01T05:00:00Z,m,8,f,0.5074074618881453\n,,8,1970-01-01T06:00:00Z,m,8,f,0.5122144565697357\n,,8,1970-01-01T07:00:00Z,m,8,f,0.40715470738979853\n,,8,1970-01-01T08:00:00Z,m,8,f,0.8185589852847821\n,,8,1970-01-01T09:00:00Z,m,8,f,0.06615362288768847\n,,8,1970-01-01T10:00:00Z,m,8,f,0.95397955896684\n,,8,1970-01-01T11:00:00Z,m,8,f,0.3012853054957797\n,,8,1970-01-01T12:00:00Z,m,8,f,0.6957865828883222\n,,8,1970-01-01T13:00:00Z,m,8,f,0.18349784531489438\n,,8,1970-01-01T14:00:00Z,m,8,f,0.5326579864159198\n,,8,1970-01-01T15:00:00Z,m,8,f,0.16264304295239912\n,,8,1970-01-01T16:00:00Z,m,8,f,0.39612399186342956\n,,8,1970-01-01T17:00:00Z,m,8,f,0.8801431671211721\n,,8,1970-01-01T18:00:00Z,m,8,f,0.8905454115467667\n,,8,1970-01-01T19:00:00Z,m,8,f,0.37008895622309546\n,,9,1970-01-01T00:00:00Z,m,9,f,0.5943978030122283\n,,9,1970-01-01T01:00:00Z,m,9,f,0.9729045142730391\n,,9,1970-01-01T02:00:00Z,m,9,f,0.29963260412871184\n,,9,1970-01-01T03:00:00Z,m,9,f,0.13549246068853443\n,,9,1970-01-01T04:00:00Z,m,9,f,0.4985352282527366\n,,9,1970-01-01T05:00:00Z,m,9,f,0.70041974640892\n,,9,1970-01-01T06:00:00Z,m,9,f,0.5505166729368712\n,,9,1970-01-01T07:00:00Z,m,9,f,0.529948574619832\n,,9,1970-01-01T08:00:00Z,m,9,f,0.591106104564076\n,,9,1970-01-01T09:00:00Z,m,9,f,0.0635615209104685\n,,9,1970-01-01T10:00:00Z,m,9,f,0.49844667852584\n,,9,1970-01-01T11:00:00Z,m,9,f,0.8229130635259402\n,,9,1970-01-01T12:00:00Z,m,9,f,0.5768314206131357\n,,9,1970-01-01T13:00:00Z,m,9,f,0.7964902809518639\n,,9,1970-01-01T14:00:00Z,m,9,f,0.8577185880621226\n,,9,1970-01-01T15:00:00Z,m,9,f,0.8046611697264398\n,,9,1970-01-01T16:00:00Z,m,9,f,0.9035778001333393\n,,9,1970-01-01T17:00:00Z,m,9,f,0.25310651193805\n,,9,1970-01-01T18:00:00Z,m,9,f,0.9644663191492964\n,,9,1970-01-01T19:00:00Z,m,9,f,0.41841409390509593\n,,10,1970-01-01T00:00:00Z,m,a,f,0.18615629501863318\n,,10,1970-01-01T01:00:00Z,m,a,f,0.07946862666753376\n,,10,1970-01-01T02:00:00Z,m,a,f,0.33322548107896877\n,,10,1970-01-01T03:00:00Z,m,a,f,0.6074227273766635\n,,10,1970-01-01T04:00:00Z,m,a,f,0.8355600086380185\n,,10,1970-01-01T05:00:00Z,m,a,f,0.0696815215736273\n,,10,1970-01-01T06:00:00Z,m,a,f,0.9745054585018766\n,,10,1970-01-01T07:00:00Z,m,a,f,0.2845881026557506\n,,10,1970-01-01T08:00:00Z,m,a,f,0.38922817710857965\n,,10,1970-01-01T09:00:00Z,m,a,f,0.5614403393810139\n,,10,1970-01-01T10:00:00Z,m,a,f,0.5197270817554469\n,,10,1970-01-01T11:00:00Z,m,a,f,0.021532590173884557\n,,10,1970-01-01T12:00:00Z,m,a,f,0.16056999815441234\n,,10,1970-01-01T13:00:00Z,m,a,f,0.9518781786152178\n,,10,1970-01-01T14:00:00Z,m,a,f,0.27274707738681897\n,,10,1970-01-01T15:00:00Z,m,a,f,0.3202684728841677\n,,10,1970-01-01T16:00:00Z,m,a,f,0.30600536680315443\n,,10,1970-01-01T17:00:00Z,m,a,f,0.7144229319519285\n,,10,1970-01-01T18:00:00Z,m,a,f,0.8195988405538475\n,,10,1970-01-01T19:00:00Z,m,a,f,0.6833069146305664\n,,11,1970-01-01T00:00:00Z,m,b,f,0.08212464969207754\n,,11,1970-01-01T01:00:00Z,m,b,f,0.7049389293987478\n,,11,1970-01-01T02:00:00Z,m,b,f,0.46150233142660235\n,,11,1970-01-01T03:00:00Z,m,b,f,0.3061287983538073\n,,11,1970-01-01T04:00:00Z,m,b,f,0.5095622098330529\n,,11,1970-01-01T05:00:00Z,m,b,f,0.24885143749146582\n,,11,1970-01-01T06:00:00Z,m,b,f,0.2720705701013104\n,,11,1970-01-01T07:00:00Z,m,b,f,0.831725266287822\n,,11,1970-01-01T08:00:00Z,m,b,f,0.2980839741707451\n,,11,1970-01-01T09:00:00Z,m,b,f,0.4667397329742896\n,,11,1970-01-01T10:00:00Z,m,b,f,0.7604282480092655\n,,11,1970-01-01T11:00:00Z,m,b,f,0.08125558409370949\n,,11,1970-01-01T12:00:00Z,m,b,f,0.9673492809150086\n,,11,1970-01-01T13:00:00Z,m,b,f,0.7485101477006051\n,,11,1970-01-01T14:00:00Z,m,b,f,0.7826905277143607\n,,11,1970-01-01T15:00:00Z,m,b,f,0.002832539681341695\n,,11,1970-01-01T16:00:00Z,m,b,f,0.5904945620548707\n,,11,1970-01-01T17:00:00Z,m,b,f,0.19377318954716558\n,,11,1970-01-01T18:00:00Z,m,b,f,0.32112472445570694\n,,11,1970-01-01T19:00:00Z,m,b,f,0.8156620813866876\n,,12,1970-01-01T00:00:00Z,m,c,f,0.45823190604583425\n,,12,1970-01-01T01:00:00Z,m,c,f,0.7041395377467482\n,,12,1970-01-01T02:00:00Z,m,c,f,0.7164281522457248\n,,12,1970-01-01T03:00:00Z,m,c,f,0.664229117231648\n,,12,1970-01-01T04:00:00Z,m,c,f,0.2167198068478531\n,,12,1970-01-01T05:00:00Z,m,c,f,0.4781537327645974\n,,12,1970-01-01T06:00:00Z,m,c,f,0.915856481062239\n,,12,1970-01-01T07:00:00Z,m,c,f,0.7488973719504495\n,,12,1970-01-01T08:00:00Z,m,c,f,0.8415102413049199\n,,12,1970-01-01T09:00:00Z,m,c,f,0.9749127169681439\n,,12,1970-01-01T10:00:00Z,m,c,f,0.5203067180352847\n,,12,1970-01-01T11:00:00Z,m,c,f,0.8077896981284608\n,,12,1970-01-01T12:00:00Z,m,c,f,0.3140632603734003\n,,12,1970-01-01T13:00:00Z,m,c,f,0.4942913283054576\n,,12,1970-01-01T14:00:00Z,m,c,f,0.7803195300512884\n,,12,1970-01-01T15:00:00Z,m,c,f,0.29988813201194514\n,,12,1970-01-01T16:00:00Z,m,c,f,0.9275317190485068\n,,12,1970-01-01T17:00:00Z,m,c,f,0.8532168145174167\n,,12,1970-01-01T18:00:00Z,m,c,f,0.29567768993242205\n,,12,1970-01-01T19:00:00Z,m,c,f,0.148869586329582\n,,13,1970-01-01T00:00:00Z,m,d,f,0.8734370766635879\n,,13,1970-01-01T01:00:00Z,m,d,f,0.7450766293779155\n,,13,1970-01-01T02:00:00Z,m,d,f,0.9454605686978569\n,,13,1970-01-01T03:00:00Z,m,d,f,0.20587883115663197\n,,13,1970-01-01T04:00:00Z,m,d,f,0.5495265257832065\n,,13,1970-01-01T05:00:00Z,m,d,f,0.9985101041430109\n,,13,1970-01-01T06:00:00Z,m,d,f,0.606668520981593\n,,13,1970-01-01T07:00:00Z,m,d,f,0.1520034163165451\n,,13,1970-01-01T08:00:00Z,m,d,f,0.8960639437047637\n,,13,1970-01-01T09:00:00Z,m,d,f,0.40243673434606525\n,,13,1970-01-01T10:00:00Z,m,d,f,0.8559633842216021\n,,13,1970-01-01T11:00:00Z,m,d,f,0.8049602305582066\n,,13,1970-01-01T12:00:00Z,m,d,f,0.7472498943010795\n,,13,1970-01-01T13:00:00Z,m,d,f,0.19955816724612416\n,,13,1970-01-01T14:00:00Z,m,d,f,0.6398800958352263\n,,13,1970-01-01T15:00:00Z,m,d,f,0.9121026864646193\n,,13,1970-01-01T16:00:00Z,m,d,f,0.5138919840212206\n,,13,1970-01-01T17:00:00Z,m,d,f,0.8090917843470073\n,,13,1970-01-01T18:00:00Z,m,d,f,0.7569845252265965\n,,13,1970-01-01T19:00:00Z,m,d,f,0.28321607497510914\n,,14,1970-01-01T00:00:00Z,m,e,f,0.015015322322770903\n,,14,1970-01-01T01:00:00Z,m,e,f,0.9355541025597022\n,,14,1970-01-01T02:00:00Z,m,e,f,0.04136655165051055\n,,14,1970-01-01T03:00:00Z,m,e,f,0.5818917517534496\n,,14,1970-01-01T04:00:00Z,m,e,f,0.5003101543125776\n,,14,1970-01-01T05:00:00Z,m,e,f,0.9100689489842115\n,,14,1970-01-01T06:00:00Z,m,e,f,0.16048736645104625\n,,14,1970-01-01T07:00:00Z,m,e,f,0.9463952890222139\n,,14,1970-01-01T08:00:00Z,m,e,f,0.9639066785470444\n,,14,1970-01-01T09:00:00Z,m,e,f,0.5265981043058684\n,,14,1970-01-01T10:00:00Z,m,e,f,0.1618974442468811\n,,14,1970-01-01T11:00:00Z,m,e,f,0.23732885629224745\n,,14,1970-01-01T12:00:00Z,m,e,f,0.6209913661783305\n,,14,1970-01-01T13:00:00Z,m,e,f,0.37725772933735735\n,,14,1970-01-01T14:00:00Z,m,e,f,0.8852154063171275\n,,14,1970-01-01T15:00:00Z,m,e,f,0.7614955446339434\n,,14,1970-01-01T16:00:00Z,m,e,f,0.8089264477070236\n,,14,1970-01-01T17:00:00Z,m,e,f,0.8491087096738495\n,,14,1970-01-01T18:00:00Z,m,e,f,0.051500691978027605\n,,14,1970-01-01T19:00:00Z,m,e,f,0.16249922364133557\n",
 			},
 		}, &ast.VariableAssignment{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 2,
@@ -96,10 +107,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   313,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 8,
@@ -112,12 +125,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   313,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "outData",
 			},
 			Init: &ast.StringLiteral{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 2,
@@ -130,12 +145,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   313,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Value: "\n#datatype,string,long,dateTime:RFC3339,string,double\n#group,false,false,false,true,false\n#default,0,,,,\n,result,table,time,_measurement,sum\n,,0,1970-01-01T00:00:00Z,m,33.802561295632636\n,,0,1970-01-01T05:00:00Z,m,38.603075816590454\n,,0,1970-01-01T10:00:00Z,m,36.99580140544222\n,,0,1970-01-01T15:00:00Z,m,37.63380091958056\n,,0,1970-01-01T20:00:00Z,m,\n",
 			},
 		}, &ast.VariableAssignment{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: []ast.Comment{ast.Comment{Text: "// SELECT sum(f) FROM m WHERE time >= 0 AND time <= 20h GROUP BY time(5h)"}},
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 49,
@@ -148,10 +165,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   326,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 26,
@@ -164,12 +183,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   326,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "t_aggregate_group_by_time",
 			},
 			Init: &ast.FunctionExpression{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 49,
@@ -182,6 +203,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   326,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Body: &ast.PipeExpression{
 					Argument: &ast.PipeExpression{
@@ -192,7 +214,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										Argument: &ast.PipeExpression{
 											Argument: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 50,
@@ -205,11 +228,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   326,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "tables",
 											},
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 77,
@@ -222,11 +247,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   326,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Call: &ast.CallExpression{
 												Arguments: []ast.Expression{&ast.ObjectExpression{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 76,
@@ -239,10 +266,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																Line:   327,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Properties: []*ast.Property{&ast.Property{
 														BaseNode: ast.BaseNode{
-															Errors: nil,
+															Comments: nil,
+															Errors:   nil,
 															Loc: &ast.SourceLocation{
 																End: ast.Position{
 																	Column: 38,
@@ -255,10 +284,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																	Line:   327,
 																},
 															},
+															TrailingComment: nil,
 														},
 														Key: &ast.Identifier{
 															BaseNode: ast.BaseNode{
-																Errors: nil,
+																Comments: nil,
+																Errors:   nil,
 																Loc: &ast.SourceLocation{
 																	End: ast.Position{
 																		Column: 16,
@@ -271,12 +302,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																		Line:   327,
 																	},
 																},
+																TrailingComment: nil,
 															},
 															Name: "start",
 														},
 														Value: &ast.DateTimeLiteral{
 															BaseNode: ast.BaseNode{
-																Errors: nil,
+																Comments: nil,
+																Errors:   nil,
 																Loc: &ast.SourceLocation{
 																	End: ast.Position{
 																		Column: 38,
@@ -289,12 +322,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																		Line:   327,
 																	},
 																},
+																TrailingComment: nil,
 															},
 															Value: parser.MustParseTime("1970-01-01T00:00:00Z"),
 														},
 													}, &ast.Property{
 														BaseNode: ast.BaseNode{
-															Errors: nil,
+															Comments: nil,
+															Errors:   nil,
 															Loc: &ast.SourceLocation{
 																End: ast.Position{
 																	Column: 76,
@@ -307,10 +342,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																	Line:   327,
 																},
 															},
+															TrailingComment: nil,
 														},
 														Key: &ast.Identifier{
 															BaseNode: ast.BaseNode{
-																Errors: nil,
+																Comments: nil,
+																Errors:   nil,
 																Loc: &ast.SourceLocation{
 																	End: ast.Position{
 																		Column: 44,
@@ -323,12 +360,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																		Line:   327,
 																	},
 																},
+																TrailingComment: nil,
 															},
 															Name: "stop",
 														},
 														Value: &ast.DateTimeLiteral{
 															BaseNode: ast.BaseNode{
-																Errors: nil,
+																Comments: nil,
+																Errors:   nil,
 																Loc: &ast.SourceLocation{
 																	End: ast.Position{
 																		Column: 76,
@@ -341,6 +380,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																		Line:   327,
 																	},
 																},
+																TrailingComment: nil,
 															},
 															Value: parser.MustParseTime("1970-01-01T20:00:00.000000001Z"),
 														},
@@ -348,7 +388,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													With: nil,
 												}},
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 77,
@@ -361,10 +402,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   327,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Callee: &ast.Identifier{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 10,
@@ -377,13 +420,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																Line:   327,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Name: "range",
 												},
 											},
 										},
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 45,
@@ -396,11 +441,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   326,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Call: &ast.CallExpression{
 											Arguments: []ast.Expression{&ast.ObjectExpression{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 44,
@@ -413,10 +460,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   328,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Properties: []*ast.Property{&ast.Property{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 44,
@@ -429,10 +478,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																Line:   328,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Key: &ast.Identifier{
 														BaseNode: ast.BaseNode{
-															Errors: nil,
+															Comments: nil,
+															Errors:   nil,
 															Loc: &ast.SourceLocation{
 																End: ast.Position{
 																	Column: 14,
@@ -445,12 +496,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																	Line:   328,
 																},
 															},
+															TrailingComment: nil,
 														},
 														Name: "fn",
 													},
 													Value: &ast.FunctionExpression{
 														BaseNode: ast.BaseNode{
-															Errors: nil,
+															Comments: nil,
+															Errors:   nil,
 															Loc: &ast.SourceLocation{
 																End: ast.Position{
 																	Column: 44,
@@ -463,10 +516,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																	Line:   328,
 																},
 															},
+															TrailingComment: nil,
 														},
 														Body: &ast.BinaryExpression{
 															BaseNode: ast.BaseNode{
-																Errors: nil,
+																Comments: nil,
+																Errors:   nil,
 																Loc: &ast.SourceLocation{
 																	End: ast.Position{
 																		Column: 44,
@@ -479,10 +534,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																		Line:   328,
 																	},
 																},
+																TrailingComment: nil,
 															},
 															Left: &ast.MemberExpression{
 																BaseNode: ast.BaseNode{
-																	Errors: nil,
+																	Comments: nil,
+																	Errors:   nil,
 																	Loc: &ast.SourceLocation{
 																		End: ast.Position{
 																			Column: 37,
@@ -495,10 +552,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																			Line:   328,
 																		},
 																	},
+																	TrailingComment: nil,
 																},
 																Object: &ast.Identifier{
 																	BaseNode: ast.BaseNode{
-																		Errors: nil,
+																		Comments: nil,
+																		Errors:   nil,
 																		Loc: &ast.SourceLocation{
 																			End: ast.Position{
 																				Column: 24,
@@ -511,12 +570,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																				Line:   328,
 																			},
 																		},
+																		TrailingComment: nil,
 																	},
 																	Name: "r",
 																},
 																Property: &ast.Identifier{
 																	BaseNode: ast.BaseNode{
-																		Errors: nil,
+																		Comments: nil,
+																		Errors:   nil,
 																		Loc: &ast.SourceLocation{
 																			End: ast.Position{
 																				Column: 37,
@@ -529,6 +590,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																				Line:   328,
 																			},
 																		},
+																		TrailingComment: nil,
 																	},
 																	Name: "_measurement",
 																},
@@ -536,7 +598,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Operator: 17,
 															Right: &ast.StringLiteral{
 																BaseNode: ast.BaseNode{
-																	Errors: nil,
+																	Comments: nil,
+																	Errors:   nil,
 																	Loc: &ast.SourceLocation{
 																		End: ast.Position{
 																			Column: 44,
@@ -549,13 +612,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																			Line:   328,
 																		},
 																	},
+																	TrailingComment: nil,
 																},
 																Value: "m",
 															},
 														},
 														Params: []*ast.Property{&ast.Property{
 															BaseNode: ast.BaseNode{
-																Errors: nil,
+																Comments: nil,
+																Errors:   nil,
 																Loc: &ast.SourceLocation{
 																	End: ast.Position{
 																		Column: 18,
@@ -568,10 +633,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																		Line:   328,
 																	},
 																},
+																TrailingComment: nil,
 															},
 															Key: &ast.Identifier{
 																BaseNode: ast.BaseNode{
-																	Errors: nil,
+																	Comments: nil,
+																	Errors:   nil,
 																	Loc: &ast.SourceLocation{
 																		End: ast.Position{
 																			Column: 18,
@@ -584,6 +651,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																			Line:   328,
 																		},
 																	},
+																	TrailingComment: nil,
 																},
 																Name: "r",
 															},
@@ -594,7 +662,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												With: nil,
 											}},
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 45,
@@ -607,10 +676,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   328,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Callee: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 11,
@@ -623,13 +694,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   328,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "filter",
 											},
 										},
 									},
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 39,
@@ -642,11 +715,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   326,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Call: &ast.CallExpression{
 										Arguments: []ast.Expression{&ast.ObjectExpression{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 38,
@@ -659,10 +734,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   329,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Properties: []*ast.Property{&ast.Property{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 38,
@@ -675,10 +752,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   329,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Key: &ast.Identifier{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 14,
@@ -691,12 +770,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																Line:   329,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Name: "fn",
 												},
 												Value: &ast.FunctionExpression{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 38,
@@ -709,10 +790,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																Line:   329,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Body: &ast.BinaryExpression{
 														BaseNode: ast.BaseNode{
-															Errors: nil,
+															Comments: nil,
+															Errors:   nil,
 															Loc: &ast.SourceLocation{
 																End: ast.Position{
 																	Column: 38,
@@ -725,10 +808,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																	Line:   329,
 																},
 															},
+															TrailingComment: nil,
 														},
 														Left: &ast.MemberExpression{
 															BaseNode: ast.BaseNode{
-																Errors: nil,
+																Comments: nil,
+																Errors:   nil,
 																Loc: &ast.SourceLocation{
 																	End: ast.Position{
 																		Column: 31,
@@ -741,10 +826,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																		Line:   329,
 																	},
 																},
+																TrailingComment: nil,
 															},
 															Object: &ast.Identifier{
 																BaseNode: ast.BaseNode{
-																	Errors: nil,
+																	Comments: nil,
+																	Errors:   nil,
 																	Loc: &ast.SourceLocation{
 																		End: ast.Position{
 																			Column: 24,
@@ -757,12 +844,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																			Line:   329,
 																		},
 																	},
+																	TrailingComment: nil,
 																},
 																Name: "r",
 															},
 															Property: &ast.Identifier{
 																BaseNode: ast.BaseNode{
-																	Errors: nil,
+																	Comments: nil,
+																	Errors:   nil,
 																	Loc: &ast.SourceLocation{
 																		End: ast.Position{
 																			Column: 31,
@@ -775,6 +864,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																			Line:   329,
 																		},
 																	},
+																	TrailingComment: nil,
 																},
 																Name: "_field",
 															},
@@ -782,7 +872,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Operator: 17,
 														Right: &ast.StringLiteral{
 															BaseNode: ast.BaseNode{
-																Errors: nil,
+																Comments: nil,
+																Errors:   nil,
 																Loc: &ast.SourceLocation{
 																	End: ast.Position{
 																		Column: 38,
@@ -795,13 +886,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																		Line:   329,
 																	},
 																},
+																TrailingComment: nil,
 															},
 															Value: "f",
 														},
 													},
 													Params: []*ast.Property{&ast.Property{
 														BaseNode: ast.BaseNode{
-															Errors: nil,
+															Comments: nil,
+															Errors:   nil,
 															Loc: &ast.SourceLocation{
 																End: ast.Position{
 																	Column: 18,
@@ -814,10 +907,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																	Line:   329,
 																},
 															},
+															TrailingComment: nil,
 														},
 														Key: &ast.Identifier{
 															BaseNode: ast.BaseNode{
-																Errors: nil,
+																Comments: nil,
+																Errors:   nil,
 																Loc: &ast.SourceLocation{
 																	End: ast.Position{
 																		Column: 18,
@@ -830,6 +925,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																		Line:   329,
 																	},
 																},
+																TrailingComment: nil,
 															},
 															Name: "r",
 														},
@@ -840,7 +936,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											With: nil,
 										}},
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 39,
@@ -853,10 +950,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   329,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Callee: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 11,
@@ -869,13 +968,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   329,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "filter",
 										},
 									},
 								},
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 47,
@@ -888,11 +989,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   326,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Call: &ast.CallExpression{
 									Arguments: []ast.Expression{&ast.ObjectExpression{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 46,
@@ -905,10 +1008,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   330,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Properties: []*ast.Property{&ast.Property{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 46,
@@ -921,10 +1026,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   330,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Key: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 18,
@@ -937,12 +1044,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   330,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "columns",
 											},
 											Value: &ast.ArrayExpression{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 46,
@@ -955,10 +1064,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   330,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Elements: []ast.Expression{&ast.StringLiteral{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 35,
@@ -971,11 +1082,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																Line:   330,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Value: "_measurement",
 												}, &ast.StringLiteral{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 45,
@@ -988,6 +1101,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																Line:   330,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Value: "_field",
 												}},
@@ -996,7 +1110,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										With: nil,
 									}},
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 47,
@@ -1009,10 +1124,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   330,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Callee: &ast.Identifier{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 10,
@@ -1025,13 +1142,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   330,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Name: "group",
 									},
 								},
 							},
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 59,
@@ -1044,11 +1163,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										Line:   326,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Call: &ast.CallExpression{
 								Arguments: []ast.Expression{&ast.ObjectExpression{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 58,
@@ -1061,10 +1182,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   331,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Properties: []*ast.Property{&ast.Property{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 30,
@@ -1077,10 +1200,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   331,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Key: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 26,
@@ -1093,12 +1218,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   331,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "every",
 										},
 										Value: &ast.DurationLiteral{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 30,
@@ -1111,6 +1238,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   331,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Values: []ast.Duration{ast.Duration{
 												Magnitude: int64(5),
@@ -1119,7 +1247,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										},
 									}, &ast.Property{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 39,
@@ -1132,10 +1261,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   331,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Key: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 34,
@@ -1148,12 +1279,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   331,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "fn",
 										},
 										Value: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 39,
@@ -1166,12 +1299,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   331,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "sum",
 										},
 									}, &ast.Property{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 58,
@@ -1184,10 +1319,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   331,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Key: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 48,
@@ -1200,12 +1337,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   331,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "timeSrc",
 										},
 										Value: &ast.StringLiteral{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 58,
@@ -1218,6 +1357,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   331,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Value: "_start",
 										},
@@ -1225,7 +1365,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									With: nil,
 								}},
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 59,
@@ -1238,10 +1379,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   331,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Callee: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 20,
@@ -1254,13 +1397,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   331,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "aggregateWindow",
 								},
 							},
 						},
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 52,
@@ -1273,11 +1418,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Line:   326,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Call: &ast.CallExpression{
 							Arguments: []ast.Expression{&ast.ObjectExpression{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 51,
@@ -1290,10 +1437,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   332,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Properties: []*ast.Property{&ast.Property{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 51,
@@ -1306,10 +1455,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   332,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Key: &ast.Identifier{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 19,
@@ -1322,12 +1473,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   332,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Name: "columns",
 									},
 									Value: &ast.ObjectExpression{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 51,
@@ -1340,10 +1493,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   332,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Properties: []*ast.Property{&ast.Property{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 35,
@@ -1356,10 +1511,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   332,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Key: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 27,
@@ -1372,12 +1529,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   332,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "_time",
 											},
 											Value: &ast.StringLiteral{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 35,
@@ -1390,12 +1549,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   332,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Value: "time",
 											},
 										}, &ast.Property{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 50,
@@ -1408,10 +1569,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   332,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Key: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 43,
@@ -1424,12 +1587,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   332,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "_value",
 											},
 											Value: &ast.StringLiteral{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 50,
@@ -1442,6 +1607,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   332,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Value: "sum",
 											},
@@ -1452,7 +1618,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								With: nil,
 							}},
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 52,
@@ -1465,10 +1632,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										Line:   332,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Callee: &ast.Identifier{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 11,
@@ -1481,13 +1650,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   332,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Name: "rename",
 							},
 						},
 					},
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 49,
@@ -1500,11 +1671,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   326,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Call: &ast.CallExpression{
 						Arguments: []ast.Expression{&ast.ObjectExpression{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 48,
@@ -1517,10 +1690,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										Line:   333,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Properties: []*ast.Property{&ast.Property{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 48,
@@ -1533,10 +1708,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   333,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Key: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 17,
@@ -1549,12 +1726,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   333,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "columns",
 								},
 								Value: &ast.ArrayExpression{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 48,
@@ -1567,10 +1746,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   333,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Elements: []ast.Expression{&ast.StringLiteral{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 28,
@@ -1583,11 +1764,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   333,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Value: "_field",
 									}, &ast.StringLiteral{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 38,
@@ -1600,11 +1783,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   333,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Value: "_start",
 									}, &ast.StringLiteral{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 47,
@@ -1617,6 +1802,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   333,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Value: "_stop",
 									}},
@@ -1625,7 +1811,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							With: nil,
 						}},
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 49,
@@ -1638,10 +1825,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Line:   333,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Callee: &ast.Identifier{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 9,
@@ -1654,6 +1843,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										Line:   333,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Name: "drop",
 						},
@@ -1661,7 +1851,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 				},
 				Params: []*ast.Property{&ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 39,
@@ -1674,10 +1865,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   326,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 36,
@@ -1690,11 +1883,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Line:   326,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Name: "tables",
 					},
 					Value: &ast.PipeLiteral{BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 39,
@@ -1707,13 +1902,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   326,
 							},
 						},
+						TrailingComment: nil,
 					}},
 				}},
 			},
 		}, &ast.TestStatement{
 			Assignment: &ast.VariableAssignment{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 3,
@@ -1726,10 +1923,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   335,
 						},
 					},
+					TrailingComment: nil,
 				},
 				ID: &ast.Identifier{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 30,
@@ -1742,12 +1941,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   335,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Name: "_aggregate_group_by_time",
 				},
 				Init: &ast.FunctionExpression{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 3,
@@ -1760,10 +1961,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   335,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Body: &ast.ParenExpression{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 3,
@@ -1776,10 +1979,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Line:   335,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Expression: &ast.ObjectExpression{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 2,
@@ -1792,10 +1997,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										Line:   335,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Properties: []*ast.Property{&ast.Property{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 41,
@@ -1808,10 +2015,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   336,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Key: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 7,
@@ -1824,13 +2033,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   336,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "input",
 								},
 								Value: &ast.CallExpression{
 									Arguments: []ast.Expression{&ast.ObjectExpression{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 40,
@@ -1843,10 +2054,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   336,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Properties: []*ast.Property{&ast.Property{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 40,
@@ -1859,10 +2072,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   336,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Key: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 32,
@@ -1875,12 +2090,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   336,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "csv",
 											},
 											Value: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 40,
@@ -1893,6 +2110,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   336,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "inData",
 											},
@@ -1900,7 +2118,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										With: nil,
 									}},
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 41,
@@ -1913,10 +2132,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   336,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Callee: &ast.MemberExpression{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 28,
@@ -1929,10 +2150,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   336,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Object: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 16,
@@ -1945,12 +2168,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   336,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "testing",
 										},
 										Property: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 28,
@@ -1963,6 +2188,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   336,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "loadStorage",
 										},
@@ -1970,7 +2196,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								},
 							}, &ast.Property{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 37,
@@ -1983,10 +2210,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   337,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Key: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 6,
@@ -1999,13 +2228,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   337,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "want",
 								},
 								Value: &ast.CallExpression{
 									Arguments: []ast.Expression{&ast.ObjectExpression{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 36,
@@ -2018,10 +2249,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   337,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Properties: []*ast.Property{&ast.Property{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 36,
@@ -2034,10 +2267,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   337,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Key: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 27,
@@ -2050,12 +2285,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   337,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "csv",
 											},
 											Value: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 36,
@@ -2068,6 +2305,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   337,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "outData",
 											},
@@ -2075,7 +2313,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										With: nil,
 									}},
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 37,
@@ -2088,10 +2327,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   337,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Callee: &ast.MemberExpression{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 23,
@@ -2104,10 +2345,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   337,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Object: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 15,
@@ -2120,12 +2363,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   337,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "testing",
 										},
 										Property: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 23,
@@ -2138,6 +2383,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   337,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "loadMem",
 										},
@@ -2145,7 +2391,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								},
 							}, &ast.Property{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 31,
@@ -2158,10 +2405,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   338,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Key: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 4,
@@ -2174,12 +2423,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   338,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "fn",
 								},
 								Value: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 31,
@@ -2192,6 +2443,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   338,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "t_aggregate_group_by_time",
 								},
@@ -2203,7 +2455,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 				},
 			},
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 3,
@@ -2216,12 +2469,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   335,
 					},
 				},
+				TrailingComment: nil,
 			},
 		}},
+		Eof: nil,
 		Imports: []*ast.ImportDeclaration{&ast.ImportDeclaration{
 			As: nil,
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 17,
@@ -2234,10 +2490,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   3,
 					},
 				},
+				TrailingComment: nil,
 			},
 			Path: &ast.StringLiteral{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 17,
@@ -2250,13 +2508,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   3,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Value: "testing",
 			},
 		}, &ast.ImportDeclaration{
 			As: nil,
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 27,
@@ -2269,10 +2529,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   4,
 					},
 				},
+				TrailingComment: nil,
 			},
 			Path: &ast.StringLiteral{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 27,
@@ -2285,6 +2547,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   4,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Value: "internal/influxql",
 			},
@@ -2293,7 +2556,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 		Name:     "aggregate_group_by_time_test.flux",
 		Package: &ast.PackageClause{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 22,
@@ -2306,10 +2570,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   1,
 					},
 				},
+				TrailingComment: nil,
 			},
 			Name: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 22,
@@ -2322,13 +2588,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   1,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "influxql_test",
 			},
 		},
 	}, &ast.File{
 		BaseNode: ast.BaseNode{
-			Errors: nil,
+			Comments: nil,
+			Errors:   nil,
 			Loc: &ast.SourceLocation{
 				End: ast.Position{
 					Column: 3,
@@ -2341,10 +2609,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 					Line:   1,
 				},
 			},
+			TrailingComment: nil,
 		},
 		Body: []ast.Statement{&ast.VariableAssignment{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 2,
@@ -2357,10 +2627,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   6,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 7,
@@ -2373,12 +2645,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   6,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "inData",
 			},
 			Init: &ast.StringLiteral{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 2,
@@ -2391,12 +2665,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   6,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Value: "\n#datatype,string,long,dateTime:RFC3339,string,string,string,double\n#group,false,false,false,true,true,true,false\n#default,0,,,,,,\n,result,table,_time,_measurement,t,_field,_value\n,,0,1970-01-01T00:00:00Z,hex,0x0,n,0\n,,1,1970-01-01T00:00:00.000000001Z,hex,0x1,n,1\n,,2,1970-01-01T00:00:00.000000016Z,hex,0x10,n,16\n,,3,1970-01-01T00:00:00.000000017Z,hex,0x11,n,17\n,,4,1970-01-01T00:00:00.000000018Z,hex,0x12,n,18\n,,5,1970-01-01T00:00:00.000000019Z,hex,0x13,n,19\n,,6,1970-01-01T00:00:00.00000002Z,hex,0x14,n,20\n,,7,1970-01-01T00:00:00.000000021Z,hex,0x15,n,21\n,,8,1970-01-01T00:00:00.000000022Z,hex,0x16,n,22\n,,9,1970-01-01T00:00:00.000000023Z,hex,0x17,n,23\n,,10,1970-01-01T00:00:00.000000024Z,hex,0x18,n,24\n,,11,1970-01-01T00:00:00.000000025Z,hex,0x19,n,25\n,,12,1970-01-01T00:00:00.000000026Z,hex,0x1a,n,26\n,,13,1970-01-01T00:00:00.000000027Z,hex,0x1b,n,27\n,,14,1970-01-01T00:00:00.000000028Z,hex,0x1c,n,28\n,,15,1970-01-01T00:00:00.000000029Z,hex,0x1d,n,29\n,,16,1970-01-01T00:00:00.00000003Z,hex,0x1e,n,30\n,,17,1970-01-01T00:00:00.000000031Z,hex,0x1f,n,31\n,,18,1970-01-01T00:00:00.000000002Z,hex,0x2,n,2\n,,19,1970-01-01T00:00:00.000000032Z,hex,0x20,n,32\n,,20,1970-01-01T00:00:00.000000033Z,hex,0x21,n,33\n,,21,1970-01-01T00:00:00.000000034Z,hex,0x22,n,34\n,,22,1970-01-01T00:00:00.000000035Z,hex,0x23,n,35\n,,23,1970-01-01T00:00:00.000000036Z,hex,0x24,n,36\n,,24,1970-01-01T00:00:00.000000037Z,hex,0x25,n,37\n,,25,1970-01-01T00:00:00.000000038Z,hex,0x26,n,38\n,,26,1970-01-01T00:00:00.000000039Z,hex,0x27,n,39\n,,27,1970-01-01T00:00:00.00000004Z,hex,0x28,n,40\n,,28,1970-01-01T00:00:00.000000041Z,hex,0x29,n,41\n,,29,1970-01-01T00:00:00.000000042Z,hex,0x2a,n,42\n,,30,1970-01-01T00:00:00.000000043Z,hex,0x2b,n,43\n,,31,1970-01-01T00:00:00.000000044Z,hex,0x2c,n,44\n,,32,1970-01-01T00:00:00.000000045Z,hex,0x2d,n,45\n,,33,1970-01-01T00:00:00.000000046Z,hex,0x2e,n,46\n,,34,1970-01-01T00:00:00.000000047Z,hex,0x2f,n,47\n,,35,1970-01-01T00:00:00.000000003Z,hex,0x3,n,3\n,,36,1970-01-01T00:00:00.000000048Z,hex,0x30,n,48\n,,37,1970-01-01T00:00:00.000000049Z,hex,0x31,n,49\n,,38,1970-01-01T00:00:00.00000005Z,hex,0x32,n,50\n,,39,1970-01-01T00:00:00.000000051Z,hex,0x33,n,51\n,,40,1970-01-01T00:00:00.000000052Z,hex,0x34,n,52\n,,41,1970-01-01T00:00:00.000000053Z,hex,0x35,n,53\n,,42,1970-01-01T00:00:00.000000054Z,hex,0x36,n,54\n,,43,1970-01-01T00:00:00.000000055Z,hex,0x37,n,55\n,,44,1970-01-01T00:00:00.000000056Z,hex,0x38,n,56\n,,45,1970-01-01T00:00:00.000000057Z,hex,0x39,n,57\n,,46,1970-01-01T00:00:00.000000058Z,hex,0x3a,n,58\n,,47,1970-01-01T00:00:00.000000059Z,hex,0x3b,n,59\n,,48,1970-01-01T00:00:00.00000006Z,hex,0x3c,n,60\n,,49,1970-01-01T00:00:00.000000061Z,hex,0x3d,n,61\n,,50,1970-01-01T00:00:00.000000062Z,hex,0x3e,n,62\n,,51,1970-01-01T00:00:00.000000063Z,hex,0x3f,n,63\n,,52,1970-01-01T00:00:00.000000004Z,hex,0x4,n,4\n,,53,1970-01-01T00:00:00.000000064Z,hex,0x40,n,64\n,,54,1970-01-01T00:00:00.000000065Z,hex,0x41,n,65\n,,55,1970-01-01T00:00:00.000000066Z,hex,0x42,n,66\n,,56,1970-01-01T00:00:00.000000067Z,hex,0x43,n,67\n,,57,1970-01-01T00:00:00.000000068Z,hex,0x44,n,68\n,,58,1970-01-01T00:00:00.000000069Z,hex,0x45,n,69\n,,59,1970-01-01T00:00:00.00000007Z,hex,0x46,n,70\n,,60,1970-01-01T00:00:00.000000071Z,hex,0x47,n,71\n,,61,1970-01-01T00:00:00.000000072Z,hex,0x48,n,72\n,,62,1970-01-01T00:00:00.000000073Z,hex,0x49,n,73\n,,63,1970-01-01T00:00:00.000000074Z,hex,0x4a,n,74\n,,64,1970-01-01T00:00:00.000000075Z,hex,0x4b,n,75\n,,65,1970-01-01T00:00:00.000000076Z,hex,0x4c,n,76\n,,66,1970-01-01T00:00:00.000000077Z,hex,0x4d,n,77\n,,67,1970-01-01T00:00:00.000000078Z,hex,0x4e,n,78\n,,68,1970-01-01T00:00:00.000000079Z,hex,0x4f,n,79\n,,69,1970-01-01T00:00:00.000000005Z,hex,0x5,n,5\n,,70,1970-01-01T00:00:00.00000008Z,hex,0x50,n,80\n,,71,1970-01-01T00:00:00.000000081Z,hex,0x51,n,81\n,,72,1970-01-01T00:00:00.000000082Z,hex,0x52,n,82\n,,73,1970-01-01T00:00:00.000000083Z,hex,0x53,n,83\n,,74,1970-01-01T00:00:00.000000084Z,hex,0x54,n,84\n,,75,1970-01-01T00:00:00.000000085Z,hex,0x55,n,85\n,,76,1970-01-01T00:00:00.000000086Z,hex,0x56,n,86\n,,77,1970-01-01T00:00:00.000000087Z,hex,0x57,n,87\n,,78,1970-01-01T00:00:00.000000088Z,hex,0x58,n,88\n,,79,1970-01-01T00:00:00.000000089Z,hex,0x59,n,89\n,,80,1970-01-01T00:00:00.00000009Z,hex,0x5a,n,90\n,,81,1970-01-01T00:00:00.000000091Z,hex,0x5b,n,91\n,,82,1970-01-01T00:00:00.000000092Z,hex,0x5c,n,92\n,,83,1970-01-01T00:00:00.000000093Z,hex,0x5d,n,93\n,,84,1970-01-01T00:00:00.000000094Z,hex,0x5e,n,94\n,,85,1970-01-01T00:00:00.000000095Z,hex,0x5f,n,95\n,,86,1970-01-01T00:00:00.000000006Z,hex,0x6,n,6\n,,87,1970-01-01T00:00:00.000000096Z,hex,0x60,n,96\n,,88,1970-01-01T00:00:00.000000097Z,hex,0x61,n,97\n,,89,1970-01-01T00:00:00.000000098Z,hex,0x62,n,98\n,,90,1970-01-01T00:00:00.000000099Z,hex,0x63,n,99\n,,91,1970-01-01T00:00:00.0000001Z,hex,0x64,n,100\n,,92,1970-01-01T00:00:00.000000101Z,hex,0x65,n,101\n,,93,1970-01-01T00:00:00.000000102Z,hex,0x66,n,102\n,,94,1970-01-01T00:00:00.000000103Z,hex,0x67,n,103\n,,95,1970-01-01T00:00:00.000000104Z,hex,0x68,n,104\n,,96,1970-01-01T00:00:00.000000105Z,hex,0x69,n,105\n,,97,1970-01-01T00:00:00.000000106Z,hex,0x6a,n,106\n,,98,1970-01-01T00:00:00.000000107Z,hex,0x6b,n,107\n,,99,1970-01-01T00:00:00.000000108Z,hex,0x6c,n,108\n,,100,1970-01-01T00:00:00.000000109Z,hex,0x6d,n,109\n,,101,1970-01-01T00:00:00.00000011Z,hex,0x6e,n,110\n,,102,1970-01-01T00:00:00.000000111Z,hex,0x6f,n,111\n,,103,1970-01-01T00:00:00.000000007Z,hex,0x7,n,7\n,,104,1970-01-01T00:00:00.000000112Z,hex,0x70,n,112\n,,105,1970-01-01T00:00:00.000000113Z,hex,0x71,n,113\n,,106,1970-01-01T00:00:00.000000114Z,hex,0x72,n,114\n,,107,1970-01-01T00:00:00.000000115Z,hex,0x73,n,115\n,,108,1970-01-01T00:00:00.000000116Z,hex,0x74,n,116\n,,109,1970-01-01T00:00:00.000000117Z,hex,0x75,n,117\n,,110,1970-01-01T00:00:00.000000118Z,hex,0x76,n,118\n,,111,1970-01-01T00:00:00.000000119Z,hex,0x77,n,119\n,,112,1970-01-01T00:00:00.00000012Z,hex,0x78,n,120\n,,113,1970-01-01T00:00:00.000000121Z,hex,0x79,n,121\n,,114,1970-01-01T00:00:00.000000122Z,hex,0x7a,n,122\n,,115,1970-01-01T00:00:00.000000123Z,hex,0x7b,n,123\n,,116,1970-01-01T00:00:00.000000124Z,hex,0x7c,n,124\n,,117,1970-01-01T00:00:00.000000125Z,hex,0x7d,n,125\n,,118,1970-01-01T00:00:00.000000126Z,hex,0x7e,n,126\n,,119,1970-01-01T00:00:00.000000127Z,hex,0x7f,n,127\n,,120,1970-01-01T00:00:00.000000008Z,hex,0x8,n,8\n,,121,1970-01-01T00:00:00.000000128Z,hex,0x80,n,128\n,,122,1970-01-01T00:00:00.000000129Z,hex,0x81,n,129\n,,123,1970-01-01T00:00:00.00000013Z,hex,0x82,n,130\n,,124,1970-01-01T00:00:00.000000131Z,hex,0x83,n,131\n,,125,1970-01-01T00:00:00.000000132Z,hex,0x84,n,132\n,,126,1970-01-01T00:00:00.000000133Z,hex,0x85,n,133\n,,127,1970-01-01T00:00:00.000000134Z,hex,0x86,n,134\n,,128,1970-01-01T00:00:00.000000135Z,hex,0x87,n,135\n,,129,1970-01-01T00:00:00.000000136Z,hex,0x88,n,136\n,,130,1970-01-01T00:00:00.000000137Z,hex,0x89,n,137\n,,131,1970-01-01T00:00:00.000000138Z,hex,0x8a,n,138\n,,132,1970-01-01T00:00:00.000000139Z,hex,0x8b,n,139\n,,133,1970-01-01T00:00:00.00000014Z,hex,0x8c,n,140\n,,134,1970-01-01T00:00:00.000000141Z,hex,0x8d,n,141\n,,135,1970-01-01T00:00:00.000000142Z,hex,0x8e,n,142\n,,136,1970-01-01T00:00:00.000000143Z,hex,0x8f,n,143\n,,137,1970-01-01T00:00:00.000000009Z,hex,0x9,n,9\n,,138,1970-01-01T00:00:00.000000144Z,hex,0x90,n,144\n,,139,1970-01-01T00:00:00.000000145Z,hex,0x91,n,145\n,,140,1970-01-01T00:00:00.000000146Z,hex,0x92,n,146\n,,141,1970-01-01T00:00:00.000000147Z,hex,0x93,n,147\n,,142,1970-01-01T00:00:00.000000148Z,hex,0x94,n,148\n,,143,1970-01-01T00:00:00.000000149Z,hex,0x95,n,149\n,,144,1970-01-01T00:00:00.00000015Z,hex,0x96,n,150\n,,145,1970-01-01T00:00:00.000000151Z,hex,0x97,n,151\n,,146,1970-01-01T00:00:00.000000152Z,hex,0x98,n,152\n,,147,1970-01-01T00:00:00.000000153Z,hex,0x99,n,153\n,,148,1970-01-01T00:00:00.000000154Z,hex,0x9a,n,154\n,,149,1970-01-01T00:00:00.000000155Z,hex,0x9b,n,155\n,,150,1970-01-01T00:00:00.000000156Z,hex,0x9c,n,156\n,,151,1970-01-01T00:00:00.000000157Z,hex,0x9d,n,157\n,,152,1970-01-01T00:00:00.000000158Z,hex,0x9e,n,158\n,,153,1970-01-01T00:00:00.000000159Z,hex,0x9f,n,159\n,,154,1970-01-01T00:00:00.00000001Z,hex,0xa,n,10\n,,155,1970-01-01T00:00:00.00000016Z,hex,0xa0,n,160\n,,156,1970-01-01T00:00:00.000000161Z,hex,0xa1,n,161\n,,157,1970-01-01T00:00:00.000000162Z,hex,0xa2,n,162\n,,158,1970-01-01T00:00:00.000000163Z,hex,0xa3,n,163\n,,159,1970-01-01T00:00:00.000000164Z,hex,0xa4,n,164\n,,160,1970-01-01T00:00:00.000000165Z,hex,0xa5,n,165\n,,161,1970-01-01T00:00:00.000000166Z,hex,0xa6,n,166\n,,162,1970-01-01T00:00:00.000000167Z,hex,0xa7,n,167\n,,163,1970-01-01T00:00:00.000000168Z,hex,0xa8,n,168\n,,164,1970-01-01T00:00:00.000000169Z,hex,0xa9,n,169\n,,165,1970-01-01T00:00:00.00000017Z,hex,0xaa,n,170\n,,166,1970-01-01T00:00:00.000000171Z,hex,0xab,n,171\n,,167,1970-01-01T00:00:00.000000172Z,hex,0xac,n,172\n,,168,1970-01-01T00:00:00.000000173Z,hex,0xad,n,173\n,,169,1970-01-01T00:00:00.000000174Z,hex,0xae,n,174\n,,170,1970-01-01T00:00:00.000000175Z,hex,0xaf,n,175\n,,171,1970-01-01T00:00:00.000000011Z,hex,0xb,n,11\n,,172,1970-01-01T00:00:00.000000176Z,hex,0xb0,n,176\n,,173,1970-01-01T00:00:00.000000177Z,hex,0xb1,n,177\n,,174,1970-01-01T00:00:00.000000178Z,hex,0xb2,n,178\n,,175,1970-01-01T00:00:00.000000179Z,hex,0xb3,n,179\n,,176,1970-01-01T00:00:00.00000018Z,hex,0xb4,n,180\n,,177,1970-01-01T00:00:00.000000181Z,hex,0xb5,n,181\n,,178,1970-01-01T00:00:00.000000182Z,hex,0xb6,n,182\n,,179,1970-01-01T00:00:00.000000183Z,hex,0xb7,n,183\n,,180,1970-01-01T00:00:00.000000184Z,hex,0xb8,n,184\n,,181,1970-01-01T00:00:00.000000185Z,hex,0xb9,n,185\n,,182,1970-01-01T00:00:00.000000186Z,hex,0xba,n,186\n,,183,1970-01-01T00:00:00.000000187Z,hex,0xbb,n,187\n,,184,1970-01-01T00:00:00.000000188Z,hex,0xbc,n,188\n,,185,1970-01-01T00:00:00.000000189Z,hex,0xbd,n,189\n,,186,1970-01-01T00:00:00.00000019Z,hex,0xbe,n,190\n,,187,1970-01-01T00:00:00.000000191Z,hex,0xbf,n,191\n,,188,1970-01-01T00:00:00.000000012Z,hex,0xc,n,12\n,,189,1970-01-01T00:00:00.000000192Z,hex,0xc0,n,192\n,,190,1970-01-01T00:00:00.000000193Z,hex,0xc1,n,193\n,,191,1970-01-01T00:00:00.000000194Z,hex,0xc2,n,194\n,,192,1970-01-01T00:00:00.000000195Z,hex,0xc3,n,195\n,,193,1970-01-01T00:00:00.000000196Z,hex,0xc4,n,196\n,,194,1970-01-01T00:00:00.000000197Z,hex,0xc5,n,197\n,,195,1970-01-01T00:00:00.000000198Z,hex,0xc6,n,198\n,,196,1970-01-01T00:00:00.000000199Z,hex,0xc7,n,199\n,,197,1970-01-01T00:00:00.000000013Z,hex,0xd,n,13\n,,198,1970-01-01T00:00:00.000000014Z,hex,0xe,n,14\n,,199,1970-01-01T00:00:00.000000015Z,hex,0xf,n,15\n",
 			},
 		}, &ast.VariableAssignment{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 2,
@@ -2409,10 +2685,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   213,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 8,
@@ -2425,12 +2703,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   213,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "outData",
 			},
 			Init: &ast.StringLiteral{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 2,
@@ -2443,12 +2723,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   213,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Value: "\n#datatype,string,long,dateTime:RFC3339,string,double\n#group,false,false,false,true,false\n#default,0,,,,\n,result,table,time,_measurement,n\n,,0,1970-01-01T00:00:00.000000003Z,hex,3\n,,0,1970-01-01T00:00:00.00000002Z,hex,20\n,,0,1970-01-01T00:00:00.000000025Z,hex,25\n,,0,1970-01-01T00:00:00.000000041Z,hex,41\n,,0,1970-01-01T00:00:00.000000085Z,hex,85\n,,0,1970-01-01T00:00:00.000000086Z,hex,86\n,,0,1970-01-01T00:00:00.00000009Z,hex,90\n,,0,1970-01-01T00:00:00.000000101Z,hex,101\n,,0,1970-01-01T00:00:00.000000112Z,hex,112\n,,0,1970-01-01T00:00:00.000000123Z,hex,123\n,,0,1970-01-01T00:00:00.000000125Z,hex,125\n,,0,1970-01-01T00:00:00.000000129Z,hex,129\n,,0,1970-01-01T00:00:00.00000013Z,hex,130\n,,0,1970-01-01T00:00:00.000000137Z,hex,137\n,,0,1970-01-01T00:00:00.00000016Z,hex,160\n,,0,1970-01-01T00:00:00.000000163Z,hex,163\n,,0,1970-01-01T00:00:00.000000183Z,hex,183\n,,0,1970-01-01T00:00:00.000000185Z,hex,185\n,,0,1970-01-01T00:00:00.000000193Z,hex,193\n,,0,1970-01-01T00:00:00.000000199Z,hex,199\n",
 			},
 		}, &ast.VariableAssignment{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: []ast.Comment{ast.Comment{Text: "// SELECT n FROM hex WHERE t =~ /^(0x7b|0x70|0x55|0x19|0x65|0xa3|0x89|0xc1|0x3|0x14|0x29|0x81|0xb7|0xb9|0x82|0x56|0xa0|0xc7|0x5a|0x7d)$/"}},
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 50,
@@ -2461,10 +2743,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   241,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 22,
@@ -2477,12 +2761,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   241,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "t_filter_by_regex_tag",
 			},
 			Init: &ast.FunctionExpression{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 50,
@@ -2495,6 +2781,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   241,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Body: &ast.PipeExpression{
 					Argument: &ast.PipeExpression{
@@ -2506,7 +2793,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Argument: &ast.PipeExpression{
 												Argument: &ast.Identifier{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 46,
@@ -2519,11 +2807,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																Line:   241,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Name: "tables",
 												},
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 59,
@@ -2536,11 +2826,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   241,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Call: &ast.CallExpression{
 													Arguments: []ast.Expression{&ast.ObjectExpression{
 														BaseNode: ast.BaseNode{
-															Errors: nil,
+															Comments: nil,
+															Errors:   nil,
 															Loc: &ast.SourceLocation{
 																End: ast.Position{
 																	Column: 58,
@@ -2553,10 +2845,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																	Line:   242,
 																},
 															},
+															TrailingComment: nil,
 														},
 														Properties: []*ast.Property{&ast.Property{
 															BaseNode: ast.BaseNode{
-																Errors: nil,
+																Comments: nil,
+																Errors:   nil,
 																Loc: &ast.SourceLocation{
 																	End: ast.Position{
 																		Column: 34,
@@ -2569,10 +2863,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																		Line:   242,
 																	},
 																},
+																TrailingComment: nil,
 															},
 															Key: &ast.Identifier{
 																BaseNode: ast.BaseNode{
-																	Errors: nil,
+																	Comments: nil,
+																	Errors:   nil,
 																	Loc: &ast.SourceLocation{
 																		End: ast.Position{
 																			Column: 16,
@@ -2585,12 +2881,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																			Line:   242,
 																		},
 																	},
+																	TrailingComment: nil,
 																},
 																Name: "start",
 															},
 															Value: &ast.MemberExpression{
 																BaseNode: ast.BaseNode{
-																	Errors: nil,
+																	Comments: nil,
+																	Errors:   nil,
 																	Loc: &ast.SourceLocation{
 																		End: ast.Position{
 																			Column: 34,
@@ -2603,10 +2901,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																			Line:   242,
 																		},
 																	},
+																	TrailingComment: nil,
 																},
 																Object: &ast.Identifier{
 																	BaseNode: ast.BaseNode{
-																		Errors: nil,
+																		Comments: nil,
+																		Errors:   nil,
 																		Loc: &ast.SourceLocation{
 																			End: ast.Position{
 																				Column: 26,
@@ -2619,12 +2919,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																				Line:   242,
 																			},
 																		},
+																		TrailingComment: nil,
 																	},
 																	Name: "influxql",
 																},
 																Property: &ast.Identifier{
 																	BaseNode: ast.BaseNode{
-																		Errors: nil,
+																		Comments: nil,
+																		Errors:   nil,
 																		Loc: &ast.SourceLocation{
 																			End: ast.Position{
 																				Column: 34,
@@ -2637,13 +2939,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																				Line:   242,
 																			},
 																		},
+																		TrailingComment: nil,
 																	},
 																	Name: "minTime",
 																},
 															},
 														}, &ast.Property{
 															BaseNode: ast.BaseNode{
-																Errors: nil,
+																Comments: nil,
+																Errors:   nil,
 																Loc: &ast.SourceLocation{
 																	End: ast.Position{
 																		Column: 58,
@@ -2656,10 +2960,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																		Line:   242,
 																	},
 																},
+																TrailingComment: nil,
 															},
 															Key: &ast.Identifier{
 																BaseNode: ast.BaseNode{
-																	Errors: nil,
+																	Comments: nil,
+																	Errors:   nil,
 																	Loc: &ast.SourceLocation{
 																		End: ast.Position{
 																			Column: 40,
@@ -2672,12 +2978,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																			Line:   242,
 																		},
 																	},
+																	TrailingComment: nil,
 																},
 																Name: "stop",
 															},
 															Value: &ast.MemberExpression{
 																BaseNode: ast.BaseNode{
-																	Errors: nil,
+																	Comments: nil,
+																	Errors:   nil,
 																	Loc: &ast.SourceLocation{
 																		End: ast.Position{
 																			Column: 58,
@@ -2690,10 +2998,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																			Line:   242,
 																		},
 																	},
+																	TrailingComment: nil,
 																},
 																Object: &ast.Identifier{
 																	BaseNode: ast.BaseNode{
-																		Errors: nil,
+																		Comments: nil,
+																		Errors:   nil,
 																		Loc: &ast.SourceLocation{
 																			End: ast.Position{
 																				Column: 50,
@@ -2706,12 +3016,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																				Line:   242,
 																			},
 																		},
+																		TrailingComment: nil,
 																	},
 																	Name: "influxql",
 																},
 																Property: &ast.Identifier{
 																	BaseNode: ast.BaseNode{
-																		Errors: nil,
+																		Comments: nil,
+																		Errors:   nil,
 																		Loc: &ast.SourceLocation{
 																			End: ast.Position{
 																				Column: 58,
@@ -2724,6 +3036,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																				Line:   242,
 																			},
 																		},
+																		TrailingComment: nil,
 																	},
 																	Name: "maxTime",
 																},
@@ -2732,7 +3045,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														With: nil,
 													}},
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 59,
@@ -2745,10 +3059,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																Line:   242,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Callee: &ast.Identifier{
 														BaseNode: ast.BaseNode{
-															Errors: nil,
+															Comments: nil,
+															Errors:   nil,
 															Loc: &ast.SourceLocation{
 																End: ast.Position{
 																	Column: 10,
@@ -2761,13 +3077,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																	Line:   242,
 																},
 															},
+															TrailingComment: nil,
 														},
 														Name: "range",
 													},
 												},
 											},
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 47,
@@ -2780,11 +3098,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   241,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Call: &ast.CallExpression{
 												Arguments: []ast.Expression{&ast.ObjectExpression{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 46,
@@ -2797,10 +3117,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																Line:   243,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Properties: []*ast.Property{&ast.Property{
 														BaseNode: ast.BaseNode{
-															Errors: nil,
+															Comments: nil,
+															Errors:   nil,
 															Loc: &ast.SourceLocation{
 																End: ast.Position{
 																	Column: 46,
@@ -2813,10 +3135,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																	Line:   243,
 																},
 															},
+															TrailingComment: nil,
 														},
 														Key: &ast.Identifier{
 															BaseNode: ast.BaseNode{
-																Errors: nil,
+																Comments: nil,
+																Errors:   nil,
 																Loc: &ast.SourceLocation{
 																	End: ast.Position{
 																		Column: 14,
@@ -2829,12 +3153,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																		Line:   243,
 																	},
 																},
+																TrailingComment: nil,
 															},
 															Name: "fn",
 														},
 														Value: &ast.FunctionExpression{
 															BaseNode: ast.BaseNode{
-																Errors: nil,
+																Comments: nil,
+																Errors:   nil,
 																Loc: &ast.SourceLocation{
 																	End: ast.Position{
 																		Column: 46,
@@ -2847,10 +3173,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																		Line:   243,
 																	},
 																},
+																TrailingComment: nil,
 															},
 															Body: &ast.BinaryExpression{
 																BaseNode: ast.BaseNode{
-																	Errors: nil,
+																	Comments: nil,
+																	Errors:   nil,
 																	Loc: &ast.SourceLocation{
 																		End: ast.Position{
 																			Column: 46,
@@ -2863,10 +3191,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																			Line:   243,
 																		},
 																	},
+																	TrailingComment: nil,
 																},
 																Left: &ast.MemberExpression{
 																	BaseNode: ast.BaseNode{
-																		Errors: nil,
+																		Comments: nil,
+																		Errors:   nil,
 																		Loc: &ast.SourceLocation{
 																			End: ast.Position{
 																				Column: 37,
@@ -2879,10 +3209,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																				Line:   243,
 																			},
 																		},
+																		TrailingComment: nil,
 																	},
 																	Object: &ast.Identifier{
 																		BaseNode: ast.BaseNode{
-																			Errors: nil,
+																			Comments: nil,
+																			Errors:   nil,
 																			Loc: &ast.SourceLocation{
 																				End: ast.Position{
 																					Column: 24,
@@ -2895,12 +3227,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																					Line:   243,
 																				},
 																			},
+																			TrailingComment: nil,
 																		},
 																		Name: "r",
 																	},
 																	Property: &ast.Identifier{
 																		BaseNode: ast.BaseNode{
-																			Errors: nil,
+																			Comments: nil,
+																			Errors:   nil,
 																			Loc: &ast.SourceLocation{
 																				End: ast.Position{
 																					Column: 37,
@@ -2913,6 +3247,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																					Line:   243,
 																				},
 																			},
+																			TrailingComment: nil,
 																		},
 																		Name: "_measurement",
 																	},
@@ -2920,7 +3255,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																Operator: 17,
 																Right: &ast.StringLiteral{
 																	BaseNode: ast.BaseNode{
-																		Errors: nil,
+																		Comments: nil,
+																		Errors:   nil,
 																		Loc: &ast.SourceLocation{
 																			End: ast.Position{
 																				Column: 46,
@@ -2933,13 +3269,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																				Line:   243,
 																			},
 																		},
+																		TrailingComment: nil,
 																	},
 																	Value: "hex",
 																},
 															},
 															Params: []*ast.Property{&ast.Property{
 																BaseNode: ast.BaseNode{
-																	Errors: nil,
+																	Comments: nil,
+																	Errors:   nil,
 																	Loc: &ast.SourceLocation{
 																		End: ast.Position{
 																			Column: 18,
@@ -2952,10 +3290,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																			Line:   243,
 																		},
 																	},
+																	TrailingComment: nil,
 																},
 																Key: &ast.Identifier{
 																	BaseNode: ast.BaseNode{
-																		Errors: nil,
+																		Comments: nil,
+																		Errors:   nil,
 																		Loc: &ast.SourceLocation{
 																			End: ast.Position{
 																				Column: 18,
@@ -2968,6 +3308,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																				Line:   243,
 																			},
 																		},
+																		TrailingComment: nil,
 																	},
 																	Name: "r",
 																},
@@ -2978,7 +3319,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													With: nil,
 												}},
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 47,
@@ -2991,10 +3333,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   243,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Callee: &ast.Identifier{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 11,
@@ -3007,13 +3351,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																Line:   243,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Name: "filter",
 												},
 											},
 										},
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 39,
@@ -3026,11 +3372,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   241,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Call: &ast.CallExpression{
 											Arguments: []ast.Expression{&ast.ObjectExpression{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 38,
@@ -3043,10 +3391,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   244,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Properties: []*ast.Property{&ast.Property{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 38,
@@ -3059,10 +3409,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																Line:   244,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Key: &ast.Identifier{
 														BaseNode: ast.BaseNode{
-															Errors: nil,
+															Comments: nil,
+															Errors:   nil,
 															Loc: &ast.SourceLocation{
 																End: ast.Position{
 																	Column: 14,
@@ -3075,12 +3427,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																	Line:   244,
 																},
 															},
+															TrailingComment: nil,
 														},
 														Name: "fn",
 													},
 													Value: &ast.FunctionExpression{
 														BaseNode: ast.BaseNode{
-															Errors: nil,
+															Comments: nil,
+															Errors:   nil,
 															Loc: &ast.SourceLocation{
 																End: ast.Position{
 																	Column: 38,
@@ -3093,10 +3447,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																	Line:   244,
 																},
 															},
+															TrailingComment: nil,
 														},
 														Body: &ast.BinaryExpression{
 															BaseNode: ast.BaseNode{
-																Errors: nil,
+																Comments: nil,
+																Errors:   nil,
 																Loc: &ast.SourceLocation{
 																	End: ast.Position{
 																		Column: 38,
@@ -3109,10 +3465,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																		Line:   244,
 																	},
 																},
+																TrailingComment: nil,
 															},
 															Left: &ast.MemberExpression{
 																BaseNode: ast.BaseNode{
-																	Errors: nil,
+																	Comments: nil,
+																	Errors:   nil,
 																	Loc: &ast.SourceLocation{
 																		End: ast.Position{
 																			Column: 31,
@@ -3125,10 +3483,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																			Line:   244,
 																		},
 																	},
+																	TrailingComment: nil,
 																},
 																Object: &ast.Identifier{
 																	BaseNode: ast.BaseNode{
-																		Errors: nil,
+																		Comments: nil,
+																		Errors:   nil,
 																		Loc: &ast.SourceLocation{
 																			End: ast.Position{
 																				Column: 24,
@@ -3141,12 +3501,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																				Line:   244,
 																			},
 																		},
+																		TrailingComment: nil,
 																	},
 																	Name: "r",
 																},
 																Property: &ast.Identifier{
 																	BaseNode: ast.BaseNode{
-																		Errors: nil,
+																		Comments: nil,
+																		Errors:   nil,
 																		Loc: &ast.SourceLocation{
 																			End: ast.Position{
 																				Column: 31,
@@ -3159,6 +3521,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																				Line:   244,
 																			},
 																		},
+																		TrailingComment: nil,
 																	},
 																	Name: "_field",
 																},
@@ -3166,7 +3529,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Operator: 17,
 															Right: &ast.StringLiteral{
 																BaseNode: ast.BaseNode{
-																	Errors: nil,
+																	Comments: nil,
+																	Errors:   nil,
 																	Loc: &ast.SourceLocation{
 																		End: ast.Position{
 																			Column: 38,
@@ -3179,13 +3543,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																			Line:   244,
 																		},
 																	},
+																	TrailingComment: nil,
 																},
 																Value: "n",
 															},
 														},
 														Params: []*ast.Property{&ast.Property{
 															BaseNode: ast.BaseNode{
-																Errors: nil,
+																Comments: nil,
+																Errors:   nil,
 																Loc: &ast.SourceLocation{
 																	End: ast.Position{
 																		Column: 18,
@@ -3198,10 +3564,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																		Line:   244,
 																	},
 																},
+																TrailingComment: nil,
 															},
 															Key: &ast.Identifier{
 																BaseNode: ast.BaseNode{
-																	Errors: nil,
+																	Comments: nil,
+																	Errors:   nil,
 																	Loc: &ast.SourceLocation{
 																		End: ast.Position{
 																			Column: 18,
@@ -3214,6 +3582,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																			Line:   244,
 																		},
 																	},
+																	TrailingComment: nil,
 																},
 																Name: "r",
 															},
@@ -3224,7 +3593,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												With: nil,
 											}},
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 39,
@@ -3237,10 +3607,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   244,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Callee: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 11,
@@ -3253,13 +3625,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   244,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "filter",
 											},
 										},
 									},
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 135,
@@ -3272,11 +3646,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   241,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Call: &ast.CallExpression{
 										Arguments: []ast.Expression{&ast.ObjectExpression{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 134,
@@ -3289,10 +3665,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   245,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Properties: []*ast.Property{&ast.Property{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 134,
@@ -3305,10 +3683,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   245,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Key: &ast.Identifier{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 14,
@@ -3321,12 +3701,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																Line:   245,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Name: "fn",
 												},
 												Value: &ast.FunctionExpression{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 134,
@@ -3339,10 +3721,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																Line:   245,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Body: &ast.BinaryExpression{
 														BaseNode: ast.BaseNode{
-															Errors: nil,
+															Comments: nil,
+															Errors:   nil,
 															Loc: &ast.SourceLocation{
 																End: ast.Position{
 																	Column: 134,
@@ -3355,10 +3739,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																	Line:   245,
 																},
 															},
+															TrailingComment: nil,
 														},
 														Left: &ast.MemberExpression{
 															BaseNode: ast.BaseNode{
-																Errors: nil,
+																Comments: nil,
+																Errors:   nil,
 																Loc: &ast.SourceLocation{
 																	End: ast.Position{
 																		Column: 26,
@@ -3371,10 +3757,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																		Line:   245,
 																	},
 																},
+																TrailingComment: nil,
 															},
 															Object: &ast.Identifier{
 																BaseNode: ast.BaseNode{
-																	Errors: nil,
+																	Comments: nil,
+																	Errors:   nil,
 																	Loc: &ast.SourceLocation{
 																		End: ast.Position{
 																			Column: 24,
@@ -3387,12 +3775,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																			Line:   245,
 																		},
 																	},
+																	TrailingComment: nil,
 																},
 																Name: "r",
 															},
 															Property: &ast.Identifier{
 																BaseNode: ast.BaseNode{
-																	Errors: nil,
+																	Comments: nil,
+																	Errors:   nil,
 																	Loc: &ast.SourceLocation{
 																		End: ast.Position{
 																			Column: 26,
@@ -3405,6 +3795,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																			Line:   245,
 																		},
 																	},
+																	TrailingComment: nil,
 																},
 																Name: "t",
 															},
@@ -3412,7 +3803,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Operator: 19,
 														Right: &ast.RegexpLiteral{
 															BaseNode: ast.BaseNode{
-																Errors: nil,
+																Comments: nil,
+																Errors:   nil,
 																Loc: &ast.SourceLocation{
 																	End: ast.Position{
 																		Column: 134,
@@ -3425,13 +3817,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																		Line:   245,
 																	},
 																},
+																TrailingComment: nil,
 															},
 															Value: regexp.MustCompile("^(0x7b|0x70|0x55|0x19|0x65|0xa3|0x89|0xc1|0x3|0x14|0x29|0x81|0xb7|0xb9|0x82|0x56|0xa0|0xc7|0x5a|0x7d)$"),
 														},
 													},
 													Params: []*ast.Property{&ast.Property{
 														BaseNode: ast.BaseNode{
-															Errors: nil,
+															Comments: nil,
+															Errors:   nil,
 															Loc: &ast.SourceLocation{
 																End: ast.Position{
 																	Column: 18,
@@ -3444,10 +3838,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																	Line:   245,
 																},
 															},
+															TrailingComment: nil,
 														},
 														Key: &ast.Identifier{
 															BaseNode: ast.BaseNode{
-																Errors: nil,
+																Comments: nil,
+																Errors:   nil,
 																Loc: &ast.SourceLocation{
 																	End: ast.Position{
 																		Column: 18,
@@ -3460,6 +3856,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																		Line:   245,
 																	},
 																},
+																TrailingComment: nil,
 															},
 															Name: "r",
 														},
@@ -3470,7 +3867,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											With: nil,
 										}},
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 135,
@@ -3483,10 +3881,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   245,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Callee: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 11,
@@ -3499,13 +3899,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   245,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "filter",
 										},
 									},
 								},
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 47,
@@ -3518,11 +3920,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   241,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Call: &ast.CallExpression{
 									Arguments: []ast.Expression{&ast.ObjectExpression{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 46,
@@ -3535,10 +3939,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   246,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Properties: []*ast.Property{&ast.Property{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 46,
@@ -3551,10 +3957,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   246,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Key: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 18,
@@ -3567,12 +3975,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   246,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "columns",
 											},
 											Value: &ast.ArrayExpression{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 46,
@@ -3585,10 +3995,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   246,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Elements: []ast.Expression{&ast.StringLiteral{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 35,
@@ -3601,11 +4013,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																Line:   246,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Value: "_measurement",
 												}, &ast.StringLiteral{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 45,
@@ -3618,6 +4032,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																Line:   246,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Value: "_field",
 												}},
@@ -3626,7 +4041,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										With: nil,
 									}},
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 47,
@@ -3639,10 +4055,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   246,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Callee: &ast.Identifier{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 10,
@@ -3655,13 +4073,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   246,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Name: "group",
 									},
 								},
 							},
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 29,
@@ -3674,11 +4094,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										Line:   241,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Call: &ast.CallExpression{
 								Arguments: []ast.Expression{&ast.ObjectExpression{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 28,
@@ -3691,10 +4113,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   247,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Properties: []*ast.Property{&ast.Property{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 28,
@@ -3707,10 +4131,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   247,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Key: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 17,
@@ -3723,12 +4149,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   247,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "columns",
 										},
 										Value: &ast.ArrayExpression{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 28,
@@ -3741,10 +4169,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   247,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Elements: []ast.Expression{&ast.StringLiteral{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 27,
@@ -3757,6 +4187,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   247,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Value: "_time",
 											}},
@@ -3765,7 +4196,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									With: nil,
 								}},
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 29,
@@ -3778,10 +4210,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   247,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Callee: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 9,
@@ -3794,13 +4228,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   247,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "sort",
 								},
 							},
 						},
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 55,
@@ -3813,11 +4249,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Line:   241,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Call: &ast.CallExpression{
 							Arguments: []ast.Expression{&ast.ObjectExpression{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 54,
@@ -3830,10 +4268,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   248,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Properties: []*ast.Property{&ast.Property{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 54,
@@ -3846,10 +4286,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   248,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Key: &ast.Identifier{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 17,
@@ -3862,12 +4304,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   248,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Name: "columns",
 									},
 									Value: &ast.ArrayExpression{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 54,
@@ -3880,10 +4324,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   248,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Elements: []ast.Expression{&ast.StringLiteral{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 27,
@@ -3896,11 +4342,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   248,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Value: "_time",
 										}, &ast.StringLiteral{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 37,
@@ -3913,11 +4361,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   248,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Value: "_value",
 										}, &ast.StringLiteral{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 53,
@@ -3930,6 +4380,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   248,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Value: "_measurement",
 										}},
@@ -3938,7 +4389,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								With: nil,
 							}},
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 55,
@@ -3951,10 +4403,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										Line:   248,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Callee: &ast.Identifier{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 9,
@@ -3967,13 +4421,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   248,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Name: "keep",
 							},
 						},
 					},
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 50,
@@ -3986,11 +4442,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   241,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Call: &ast.CallExpression{
 						Arguments: []ast.Expression{&ast.ObjectExpression{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 49,
@@ -4003,10 +4461,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										Line:   249,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Properties: []*ast.Property{&ast.Property{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 49,
@@ -4019,10 +4479,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   249,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Key: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 19,
@@ -4035,12 +4497,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   249,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "columns",
 								},
 								Value: &ast.ObjectExpression{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 49,
@@ -4053,10 +4517,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   249,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Properties: []*ast.Property{&ast.Property{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 35,
@@ -4069,10 +4535,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   249,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Key: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 27,
@@ -4085,12 +4553,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   249,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "_time",
 										},
 										Value: &ast.StringLiteral{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 35,
@@ -4103,12 +4573,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   249,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Value: "time",
 										},
 									}, &ast.Property{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 48,
@@ -4121,10 +4593,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   249,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Key: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 43,
@@ -4137,12 +4611,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   249,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "_value",
 										},
 										Value: &ast.StringLiteral{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 48,
@@ -4155,6 +4631,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   249,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Value: "n",
 										},
@@ -4165,7 +4642,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							With: nil,
 						}},
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 50,
@@ -4178,10 +4656,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Line:   249,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Callee: &ast.Identifier{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 11,
@@ -4194,6 +4674,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										Line:   249,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Name: "rename",
 						},
@@ -4201,7 +4682,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 				},
 				Params: []*ast.Property{&ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 35,
@@ -4214,10 +4696,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   241,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 32,
@@ -4230,11 +4714,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Line:   241,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Name: "tables",
 					},
 					Value: &ast.PipeLiteral{BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 35,
@@ -4247,13 +4733,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   241,
 							},
 						},
+						TrailingComment: nil,
 					}},
 				}},
 			},
 		}, &ast.TestStatement{
 			Assignment: &ast.VariableAssignment{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 3,
@@ -4266,10 +4754,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   251,
 						},
 					},
+					TrailingComment: nil,
 				},
 				ID: &ast.Identifier{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 26,
@@ -4282,12 +4772,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   251,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Name: "_filter_by_regex_tag",
 				},
 				Init: &ast.FunctionExpression{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 3,
@@ -4300,10 +4792,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   251,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Body: &ast.ParenExpression{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 3,
@@ -4316,10 +4810,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Line:   251,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Expression: &ast.ObjectExpression{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 2,
@@ -4332,10 +4828,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										Line:   251,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Properties: []*ast.Property{&ast.Property{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 41,
@@ -4348,10 +4846,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   252,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Key: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 7,
@@ -4364,13 +4864,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   252,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "input",
 								},
 								Value: &ast.CallExpression{
 									Arguments: []ast.Expression{&ast.ObjectExpression{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 40,
@@ -4383,10 +4885,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   252,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Properties: []*ast.Property{&ast.Property{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 40,
@@ -4399,10 +4903,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   252,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Key: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 32,
@@ -4415,12 +4921,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   252,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "csv",
 											},
 											Value: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 40,
@@ -4433,6 +4941,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   252,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "inData",
 											},
@@ -4440,7 +4949,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										With: nil,
 									}},
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 41,
@@ -4453,10 +4963,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   252,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Callee: &ast.MemberExpression{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 28,
@@ -4469,10 +4981,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   252,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Object: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 16,
@@ -4485,12 +4999,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   252,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "testing",
 										},
 										Property: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 28,
@@ -4503,6 +5019,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   252,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "loadStorage",
 										},
@@ -4510,7 +5027,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								},
 							}, &ast.Property{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 37,
@@ -4523,10 +5041,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   253,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Key: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 6,
@@ -4539,13 +5059,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   253,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "want",
 								},
 								Value: &ast.CallExpression{
 									Arguments: []ast.Expression{&ast.ObjectExpression{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 36,
@@ -4558,10 +5080,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   253,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Properties: []*ast.Property{&ast.Property{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 36,
@@ -4574,10 +5098,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   253,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Key: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 27,
@@ -4590,12 +5116,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   253,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "csv",
 											},
 											Value: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 36,
@@ -4608,6 +5136,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   253,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "outData",
 											},
@@ -4615,7 +5144,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										With: nil,
 									}},
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 37,
@@ -4628,10 +5158,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   253,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Callee: &ast.MemberExpression{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 23,
@@ -4644,10 +5176,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   253,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Object: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 15,
@@ -4660,12 +5194,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   253,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "testing",
 										},
 										Property: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 23,
@@ -4678,6 +5214,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   253,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "loadMem",
 										},
@@ -4685,7 +5222,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								},
 							}, &ast.Property{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 27,
@@ -4698,10 +5236,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   254,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Key: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 4,
@@ -4714,12 +5254,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   254,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "fn",
 								},
 								Value: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 27,
@@ -4732,6 +5274,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   254,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "t_filter_by_regex_tag",
 								},
@@ -4743,7 +5286,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 				},
 			},
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 3,
@@ -4756,12 +5300,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   251,
 					},
 				},
+				TrailingComment: nil,
 			},
 		}},
+		Eof: nil,
 		Imports: []*ast.ImportDeclaration{&ast.ImportDeclaration{
 			As: nil,
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 17,
@@ -4774,10 +5321,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   3,
 					},
 				},
+				TrailingComment: nil,
 			},
 			Path: &ast.StringLiteral{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 17,
@@ -4790,13 +5339,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   3,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Value: "testing",
 			},
 		}, &ast.ImportDeclaration{
 			As: nil,
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 27,
@@ -4809,10 +5360,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   4,
 					},
 				},
+				TrailingComment: nil,
 			},
 			Path: &ast.StringLiteral{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 27,
@@ -4825,6 +5378,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   4,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Value: "internal/influxql",
 			},
@@ -4833,7 +5387,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 		Name:     "filter_by_regex_tag_test.flux",
 		Package: &ast.PackageClause{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 22,
@@ -4846,10 +5401,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   1,
 					},
 				},
+				TrailingComment: nil,
 			},
 			Name: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 22,
@@ -4862,13 +5419,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   1,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "influxql_test",
 			},
 		},
 	}, &ast.File{
 		BaseNode: ast.BaseNode{
-			Errors: nil,
+			Comments: nil,
+			Errors:   nil,
 			Loc: &ast.SourceLocation{
 				End: ast.Position{
 					Column: 3,
@@ -4881,10 +5440,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 					Line:   1,
 				},
 			},
+			TrailingComment: nil,
 		},
 		Body: []ast.Statement{&ast.VariableAssignment{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 2,
@@ -4897,10 +5458,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   6,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 7,
@@ -4913,12 +5476,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   6,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "inData",
 			},
 			Init: &ast.StringLiteral{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 2,
@@ -4931,12 +5496,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   6,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Value: "\n#datatype,string,long,dateTime:RFC3339,string,string,double\n#group,false,false,false,true,true,false\n#default,0,,,,,\n,result,table,_time,_measurement,_field,_value\n,,0,1970-01-01T00:00:00Z,ctr,n,0\n,,0,1970-01-01T00:00:00.000000001Z,ctr,n,1\n,,0,1970-01-01T00:00:00.000000002Z,ctr,n,2\n,,0,1970-01-01T00:00:00.000000003Z,ctr,n,3\n,,0,1970-01-01T00:00:00.000000004Z,ctr,n,4\n,,0,1970-01-01T00:00:00.000000005Z,ctr,n,5\n,,0,1970-01-01T00:00:00.000000006Z,ctr,n,6\n,,0,1970-01-01T00:00:00.000000007Z,ctr,n,7\n,,0,1970-01-01T00:00:00.000000008Z,ctr,n,8\n,,0,1970-01-01T00:00:00.000000009Z,ctr,n,9\n,,0,1970-01-01T00:00:00.00000001Z,ctr,n,10\n,,0,1970-01-01T00:00:00.000000011Z,ctr,n,11\n,,0,1970-01-01T00:00:00.000000012Z,ctr,n,12\n,,0,1970-01-01T00:00:00.000000013Z,ctr,n,13\n,,0,1970-01-01T00:00:00.000000014Z,ctr,n,14\n,,0,1970-01-01T00:00:00.000000015Z,ctr,n,15\n,,0,1970-01-01T00:00:00.000000016Z,ctr,n,16\n,,0,1970-01-01T00:00:00.000000017Z,ctr,n,17\n,,0,1970-01-01T00:00:00.000000018Z,ctr,n,18\n,,0,1970-01-01T00:00:00.000000019Z,ctr,n,19\n",
 			},
 		}, &ast.VariableAssignment{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 2,
@@ -4949,10 +5516,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   33,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 8,
@@ -4965,12 +5534,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   33,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "outData",
 			},
 			Init: &ast.StringLiteral{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 2,
@@ -4983,12 +5554,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   33,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Value: "\n#datatype,string,long,dateTime:RFC3339,string,double\n#group,false,false,false,true,false\n#default,0,,,,\n,result,table,time,_measurement,n\n,,0,1970-01-01T00:00:00.000000008Z,ctr,8\n,,0,1970-01-01T00:00:00.000000009Z,ctr,9\n,,0,1970-01-01T00:00:00.00000001Z,ctr,10\n,,0,1970-01-01T00:00:00.000000011Z,ctr,11\n,,0,1970-01-01T00:00:00.000000012Z,ctr,12\n,,0,1970-01-01T00:00:00.000000013Z,ctr,13\n,,0,1970-01-01T00:00:00.000000014Z,ctr,14\n",
 			},
 		}, &ast.VariableAssignment{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: []ast.Comment{ast.Comment{Text: "// SELECT n FROM ctr WHERE n >= 8 AND n <= 14"}},
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 50,
@@ -5001,10 +5574,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   48,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 28,
@@ -5017,12 +5592,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   48,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "t_filter_by_values_with_and",
 			},
 			Init: &ast.FunctionExpression{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 50,
@@ -5035,6 +5612,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   48,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Body: &ast.PipeExpression{
 					Argument: &ast.PipeExpression{
@@ -5046,7 +5624,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Argument: &ast.PipeExpression{
 												Argument: &ast.Identifier{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 52,
@@ -5059,11 +5638,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																Line:   48,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Name: "tables",
 												},
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 59,
@@ -5076,11 +5657,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   48,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Call: &ast.CallExpression{
 													Arguments: []ast.Expression{&ast.ObjectExpression{
 														BaseNode: ast.BaseNode{
-															Errors: nil,
+															Comments: nil,
+															Errors:   nil,
 															Loc: &ast.SourceLocation{
 																End: ast.Position{
 																	Column: 58,
@@ -5093,10 +5676,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																	Line:   49,
 																},
 															},
+															TrailingComment: nil,
 														},
 														Properties: []*ast.Property{&ast.Property{
 															BaseNode: ast.BaseNode{
-																Errors: nil,
+																Comments: nil,
+																Errors:   nil,
 																Loc: &ast.SourceLocation{
 																	End: ast.Position{
 																		Column: 34,
@@ -5109,10 +5694,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																		Line:   49,
 																	},
 																},
+																TrailingComment: nil,
 															},
 															Key: &ast.Identifier{
 																BaseNode: ast.BaseNode{
-																	Errors: nil,
+																	Comments: nil,
+																	Errors:   nil,
 																	Loc: &ast.SourceLocation{
 																		End: ast.Position{
 																			Column: 16,
@@ -5125,12 +5712,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																			Line:   49,
 																		},
 																	},
+																	TrailingComment: nil,
 																},
 																Name: "start",
 															},
 															Value: &ast.MemberExpression{
 																BaseNode: ast.BaseNode{
-																	Errors: nil,
+																	Comments: nil,
+																	Errors:   nil,
 																	Loc: &ast.SourceLocation{
 																		End: ast.Position{
 																			Column: 34,
@@ -5143,10 +5732,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																			Line:   49,
 																		},
 																	},
+																	TrailingComment: nil,
 																},
 																Object: &ast.Identifier{
 																	BaseNode: ast.BaseNode{
-																		Errors: nil,
+																		Comments: nil,
+																		Errors:   nil,
 																		Loc: &ast.SourceLocation{
 																			End: ast.Position{
 																				Column: 26,
@@ -5159,12 +5750,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																				Line:   49,
 																			},
 																		},
+																		TrailingComment: nil,
 																	},
 																	Name: "influxql",
 																},
 																Property: &ast.Identifier{
 																	BaseNode: ast.BaseNode{
-																		Errors: nil,
+																		Comments: nil,
+																		Errors:   nil,
 																		Loc: &ast.SourceLocation{
 																			End: ast.Position{
 																				Column: 34,
@@ -5177,13 +5770,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																				Line:   49,
 																			},
 																		},
+																		TrailingComment: nil,
 																	},
 																	Name: "minTime",
 																},
 															},
 														}, &ast.Property{
 															BaseNode: ast.BaseNode{
-																Errors: nil,
+																Comments: nil,
+																Errors:   nil,
 																Loc: &ast.SourceLocation{
 																	End: ast.Position{
 																		Column: 58,
@@ -5196,10 +5791,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																		Line:   49,
 																	},
 																},
+																TrailingComment: nil,
 															},
 															Key: &ast.Identifier{
 																BaseNode: ast.BaseNode{
-																	Errors: nil,
+																	Comments: nil,
+																	Errors:   nil,
 																	Loc: &ast.SourceLocation{
 																		End: ast.Position{
 																			Column: 40,
@@ -5212,12 +5809,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																			Line:   49,
 																		},
 																	},
+																	TrailingComment: nil,
 																},
 																Name: "stop",
 															},
 															Value: &ast.MemberExpression{
 																BaseNode: ast.BaseNode{
-																	Errors: nil,
+																	Comments: nil,
+																	Errors:   nil,
 																	Loc: &ast.SourceLocation{
 																		End: ast.Position{
 																			Column: 58,
@@ -5230,10 +5829,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																			Line:   49,
 																		},
 																	},
+																	TrailingComment: nil,
 																},
 																Object: &ast.Identifier{
 																	BaseNode: ast.BaseNode{
-																		Errors: nil,
+																		Comments: nil,
+																		Errors:   nil,
 																		Loc: &ast.SourceLocation{
 																			End: ast.Position{
 																				Column: 50,
@@ -5246,12 +5847,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																				Line:   49,
 																			},
 																		},
+																		TrailingComment: nil,
 																	},
 																	Name: "influxql",
 																},
 																Property: &ast.Identifier{
 																	BaseNode: ast.BaseNode{
-																		Errors: nil,
+																		Comments: nil,
+																		Errors:   nil,
 																		Loc: &ast.SourceLocation{
 																			End: ast.Position{
 																				Column: 58,
@@ -5264,6 +5867,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																				Line:   49,
 																			},
 																		},
+																		TrailingComment: nil,
 																	},
 																	Name: "maxTime",
 																},
@@ -5272,7 +5876,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														With: nil,
 													}},
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 59,
@@ -5285,10 +5890,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																Line:   49,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Callee: &ast.Identifier{
 														BaseNode: ast.BaseNode{
-															Errors: nil,
+															Comments: nil,
+															Errors:   nil,
 															Loc: &ast.SourceLocation{
 																End: ast.Position{
 																	Column: 10,
@@ -5301,13 +5908,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																	Line:   49,
 																},
 															},
+															TrailingComment: nil,
 														},
 														Name: "range",
 													},
 												},
 											},
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 47,
@@ -5320,11 +5929,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   48,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Call: &ast.CallExpression{
 												Arguments: []ast.Expression{&ast.ObjectExpression{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 46,
@@ -5337,10 +5948,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																Line:   50,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Properties: []*ast.Property{&ast.Property{
 														BaseNode: ast.BaseNode{
-															Errors: nil,
+															Comments: nil,
+															Errors:   nil,
 															Loc: &ast.SourceLocation{
 																End: ast.Position{
 																	Column: 46,
@@ -5353,10 +5966,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																	Line:   50,
 																},
 															},
+															TrailingComment: nil,
 														},
 														Key: &ast.Identifier{
 															BaseNode: ast.BaseNode{
-																Errors: nil,
+																Comments: nil,
+																Errors:   nil,
 																Loc: &ast.SourceLocation{
 																	End: ast.Position{
 																		Column: 14,
@@ -5369,12 +5984,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																		Line:   50,
 																	},
 																},
+																TrailingComment: nil,
 															},
 															Name: "fn",
 														},
 														Value: &ast.FunctionExpression{
 															BaseNode: ast.BaseNode{
-																Errors: nil,
+																Comments: nil,
+																Errors:   nil,
 																Loc: &ast.SourceLocation{
 																	End: ast.Position{
 																		Column: 46,
@@ -5387,10 +6004,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																		Line:   50,
 																	},
 																},
+																TrailingComment: nil,
 															},
 															Body: &ast.BinaryExpression{
 																BaseNode: ast.BaseNode{
-																	Errors: nil,
+																	Comments: nil,
+																	Errors:   nil,
 																	Loc: &ast.SourceLocation{
 																		End: ast.Position{
 																			Column: 46,
@@ -5403,10 +6022,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																			Line:   50,
 																		},
 																	},
+																	TrailingComment: nil,
 																},
 																Left: &ast.MemberExpression{
 																	BaseNode: ast.BaseNode{
-																		Errors: nil,
+																		Comments: nil,
+																		Errors:   nil,
 																		Loc: &ast.SourceLocation{
 																			End: ast.Position{
 																				Column: 37,
@@ -5419,10 +6040,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																				Line:   50,
 																			},
 																		},
+																		TrailingComment: nil,
 																	},
 																	Object: &ast.Identifier{
 																		BaseNode: ast.BaseNode{
-																			Errors: nil,
+																			Comments: nil,
+																			Errors:   nil,
 																			Loc: &ast.SourceLocation{
 																				End: ast.Position{
 																					Column: 24,
@@ -5435,12 +6058,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																					Line:   50,
 																				},
 																			},
+																			TrailingComment: nil,
 																		},
 																		Name: "r",
 																	},
 																	Property: &ast.Identifier{
 																		BaseNode: ast.BaseNode{
-																			Errors: nil,
+																			Comments: nil,
+																			Errors:   nil,
 																			Loc: &ast.SourceLocation{
 																				End: ast.Position{
 																					Column: 37,
@@ -5453,6 +6078,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																					Line:   50,
 																				},
 																			},
+																			TrailingComment: nil,
 																		},
 																		Name: "_measurement",
 																	},
@@ -5460,7 +6086,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																Operator: 17,
 																Right: &ast.StringLiteral{
 																	BaseNode: ast.BaseNode{
-																		Errors: nil,
+																		Comments: nil,
+																		Errors:   nil,
 																		Loc: &ast.SourceLocation{
 																			End: ast.Position{
 																				Column: 46,
@@ -5473,13 +6100,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																				Line:   50,
 																			},
 																		},
+																		TrailingComment: nil,
 																	},
 																	Value: "ctr",
 																},
 															},
 															Params: []*ast.Property{&ast.Property{
 																BaseNode: ast.BaseNode{
-																	Errors: nil,
+																	Comments: nil,
+																	Errors:   nil,
 																	Loc: &ast.SourceLocation{
 																		End: ast.Position{
 																			Column: 18,
@@ -5492,10 +6121,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																			Line:   50,
 																		},
 																	},
+																	TrailingComment: nil,
 																},
 																Key: &ast.Identifier{
 																	BaseNode: ast.BaseNode{
-																		Errors: nil,
+																		Comments: nil,
+																		Errors:   nil,
 																		Loc: &ast.SourceLocation{
 																			End: ast.Position{
 																				Column: 18,
@@ -5508,6 +6139,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																				Line:   50,
 																			},
 																		},
+																		TrailingComment: nil,
 																	},
 																	Name: "r",
 																},
@@ -5518,7 +6150,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													With: nil,
 												}},
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 47,
@@ -5531,10 +6164,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   50,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Callee: &ast.Identifier{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 11,
@@ -5547,13 +6182,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																Line:   50,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Name: "filter",
 												},
 											},
 										},
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 39,
@@ -5566,11 +6203,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   48,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Call: &ast.CallExpression{
 											Arguments: []ast.Expression{&ast.ObjectExpression{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 38,
@@ -5583,10 +6222,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   51,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Properties: []*ast.Property{&ast.Property{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 38,
@@ -5599,10 +6240,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																Line:   51,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Key: &ast.Identifier{
 														BaseNode: ast.BaseNode{
-															Errors: nil,
+															Comments: nil,
+															Errors:   nil,
 															Loc: &ast.SourceLocation{
 																End: ast.Position{
 																	Column: 14,
@@ -5615,12 +6258,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																	Line:   51,
 																},
 															},
+															TrailingComment: nil,
 														},
 														Name: "fn",
 													},
 													Value: &ast.FunctionExpression{
 														BaseNode: ast.BaseNode{
-															Errors: nil,
+															Comments: nil,
+															Errors:   nil,
 															Loc: &ast.SourceLocation{
 																End: ast.Position{
 																	Column: 38,
@@ -5633,10 +6278,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																	Line:   51,
 																},
 															},
+															TrailingComment: nil,
 														},
 														Body: &ast.BinaryExpression{
 															BaseNode: ast.BaseNode{
-																Errors: nil,
+																Comments: nil,
+																Errors:   nil,
 																Loc: &ast.SourceLocation{
 																	End: ast.Position{
 																		Column: 38,
@@ -5649,10 +6296,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																		Line:   51,
 																	},
 																},
+																TrailingComment: nil,
 															},
 															Left: &ast.MemberExpression{
 																BaseNode: ast.BaseNode{
-																	Errors: nil,
+																	Comments: nil,
+																	Errors:   nil,
 																	Loc: &ast.SourceLocation{
 																		End: ast.Position{
 																			Column: 31,
@@ -5665,10 +6314,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																			Line:   51,
 																		},
 																	},
+																	TrailingComment: nil,
 																},
 																Object: &ast.Identifier{
 																	BaseNode: ast.BaseNode{
-																		Errors: nil,
+																		Comments: nil,
+																		Errors:   nil,
 																		Loc: &ast.SourceLocation{
 																			End: ast.Position{
 																				Column: 24,
@@ -5681,12 +6332,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																				Line:   51,
 																			},
 																		},
+																		TrailingComment: nil,
 																	},
 																	Name: "r",
 																},
 																Property: &ast.Identifier{
 																	BaseNode: ast.BaseNode{
-																		Errors: nil,
+																		Comments: nil,
+																		Errors:   nil,
 																		Loc: &ast.SourceLocation{
 																			End: ast.Position{
 																				Column: 31,
@@ -5699,6 +6352,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																				Line:   51,
 																			},
 																		},
+																		TrailingComment: nil,
 																	},
 																	Name: "_field",
 																},
@@ -5706,7 +6360,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Operator: 17,
 															Right: &ast.StringLiteral{
 																BaseNode: ast.BaseNode{
-																	Errors: nil,
+																	Comments: nil,
+																	Errors:   nil,
 																	Loc: &ast.SourceLocation{
 																		End: ast.Position{
 																			Column: 38,
@@ -5719,13 +6374,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																			Line:   51,
 																		},
 																	},
+																	TrailingComment: nil,
 																},
 																Value: "n",
 															},
 														},
 														Params: []*ast.Property{&ast.Property{
 															BaseNode: ast.BaseNode{
-																Errors: nil,
+																Comments: nil,
+																Errors:   nil,
 																Loc: &ast.SourceLocation{
 																	End: ast.Position{
 																		Column: 18,
@@ -5738,10 +6395,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																		Line:   51,
 																	},
 																},
+																TrailingComment: nil,
 															},
 															Key: &ast.Identifier{
 																BaseNode: ast.BaseNode{
-																	Errors: nil,
+																	Comments: nil,
+																	Errors:   nil,
 																	Loc: &ast.SourceLocation{
 																		End: ast.Position{
 																			Column: 18,
@@ -5754,6 +6413,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																			Line:   51,
 																		},
 																	},
+																	TrailingComment: nil,
 																},
 																Name: "r",
 															},
@@ -5764,7 +6424,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												With: nil,
 											}},
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 39,
@@ -5777,10 +6438,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   51,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Callee: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 11,
@@ -5793,13 +6456,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   51,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "filter",
 											},
 										},
 									},
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 56,
@@ -5812,11 +6477,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   48,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Call: &ast.CallExpression{
 										Arguments: []ast.Expression{&ast.ObjectExpression{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 55,
@@ -5829,10 +6496,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   52,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Properties: []*ast.Property{&ast.Property{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 55,
@@ -5845,10 +6514,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   52,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Key: &ast.Identifier{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 14,
@@ -5861,12 +6532,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																Line:   52,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Name: "fn",
 												},
 												Value: &ast.FunctionExpression{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 55,
@@ -5879,10 +6552,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																Line:   52,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Body: &ast.LogicalExpression{
 														BaseNode: ast.BaseNode{
-															Errors: nil,
+															Comments: nil,
+															Errors:   nil,
 															Loc: &ast.SourceLocation{
 																End: ast.Position{
 																	Column: 55,
@@ -5895,10 +6570,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																	Line:   52,
 																},
 															},
+															TrailingComment: nil,
 														},
 														Left: &ast.BinaryExpression{
 															BaseNode: ast.BaseNode{
-																Errors: nil,
+																Comments: nil,
+																Errors:   nil,
 																Loc: &ast.SourceLocation{
 																	End: ast.Position{
 																		Column: 36,
@@ -5911,10 +6588,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																		Line:   52,
 																	},
 																},
+																TrailingComment: nil,
 															},
 															Left: &ast.MemberExpression{
 																BaseNode: ast.BaseNode{
-																	Errors: nil,
+																	Comments: nil,
+																	Errors:   nil,
 																	Loc: &ast.SourceLocation{
 																		End: ast.Position{
 																			Column: 31,
@@ -5927,10 +6606,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																			Line:   52,
 																		},
 																	},
+																	TrailingComment: nil,
 																},
 																Object: &ast.Identifier{
 																	BaseNode: ast.BaseNode{
-																		Errors: nil,
+																		Comments: nil,
+																		Errors:   nil,
 																		Loc: &ast.SourceLocation{
 																			End: ast.Position{
 																				Column: 24,
@@ -5943,12 +6624,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																				Line:   52,
 																			},
 																		},
+																		TrailingComment: nil,
 																	},
 																	Name: "r",
 																},
 																Property: &ast.Identifier{
 																	BaseNode: ast.BaseNode{
-																		Errors: nil,
+																		Comments: nil,
+																		Errors:   nil,
 																		Loc: &ast.SourceLocation{
 																			End: ast.Position{
 																				Column: 31,
@@ -5961,6 +6644,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																				Line:   52,
 																			},
 																		},
+																		TrailingComment: nil,
 																	},
 																	Name: "_value",
 																},
@@ -5968,7 +6652,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Operator: 9,
 															Right: &ast.IntegerLiteral{
 																BaseNode: ast.BaseNode{
-																	Errors: nil,
+																	Comments: nil,
+																	Errors:   nil,
 																	Loc: &ast.SourceLocation{
 																		End: ast.Position{
 																			Column: 36,
@@ -5981,6 +6666,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																			Line:   52,
 																		},
 																	},
+																	TrailingComment: nil,
 																},
 																Value: int64(8),
 															},
@@ -5988,7 +6674,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Operator: 1,
 														Right: &ast.BinaryExpression{
 															BaseNode: ast.BaseNode{
-																Errors: nil,
+																Comments: nil,
+																Errors:   nil,
 																Loc: &ast.SourceLocation{
 																	End: ast.Position{
 																		Column: 55,
@@ -6001,10 +6688,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																		Line:   52,
 																	},
 																},
+																TrailingComment: nil,
 															},
 															Left: &ast.MemberExpression{
 																BaseNode: ast.BaseNode{
-																	Errors: nil,
+																	Comments: nil,
+																	Errors:   nil,
 																	Loc: &ast.SourceLocation{
 																		End: ast.Position{
 																			Column: 49,
@@ -6017,10 +6706,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																			Line:   52,
 																		},
 																	},
+																	TrailingComment: nil,
 																},
 																Object: &ast.Identifier{
 																	BaseNode: ast.BaseNode{
-																		Errors: nil,
+																		Comments: nil,
+																		Errors:   nil,
 																		Loc: &ast.SourceLocation{
 																			End: ast.Position{
 																				Column: 42,
@@ -6033,12 +6724,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																				Line:   52,
 																			},
 																		},
+																		TrailingComment: nil,
 																	},
 																	Name: "r",
 																},
 																Property: &ast.Identifier{
 																	BaseNode: ast.BaseNode{
-																		Errors: nil,
+																		Comments: nil,
+																		Errors:   nil,
 																		Loc: &ast.SourceLocation{
 																			End: ast.Position{
 																				Column: 49,
@@ -6051,6 +6744,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																				Line:   52,
 																			},
 																		},
+																		TrailingComment: nil,
 																	},
 																	Name: "_value",
 																},
@@ -6058,7 +6752,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Operator: 7,
 															Right: &ast.IntegerLiteral{
 																BaseNode: ast.BaseNode{
-																	Errors: nil,
+																	Comments: nil,
+																	Errors:   nil,
 																	Loc: &ast.SourceLocation{
 																		End: ast.Position{
 																			Column: 55,
@@ -6071,6 +6766,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																			Line:   52,
 																		},
 																	},
+																	TrailingComment: nil,
 																},
 																Value: int64(14),
 															},
@@ -6078,7 +6774,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													},
 													Params: []*ast.Property{&ast.Property{
 														BaseNode: ast.BaseNode{
-															Errors: nil,
+															Comments: nil,
+															Errors:   nil,
 															Loc: &ast.SourceLocation{
 																End: ast.Position{
 																	Column: 18,
@@ -6091,10 +6788,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																	Line:   52,
 																},
 															},
+															TrailingComment: nil,
 														},
 														Key: &ast.Identifier{
 															BaseNode: ast.BaseNode{
-																Errors: nil,
+																Comments: nil,
+																Errors:   nil,
 																Loc: &ast.SourceLocation{
 																	End: ast.Position{
 																		Column: 18,
@@ -6107,6 +6806,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																		Line:   52,
 																	},
 																},
+																TrailingComment: nil,
 															},
 															Name: "r",
 														},
@@ -6117,7 +6817,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											With: nil,
 										}},
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 56,
@@ -6130,10 +6831,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   52,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Callee: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 11,
@@ -6146,13 +6849,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   52,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "filter",
 										},
 									},
 								},
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 47,
@@ -6165,11 +6870,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   48,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Call: &ast.CallExpression{
 									Arguments: []ast.Expression{&ast.ObjectExpression{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 46,
@@ -6182,10 +6889,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   53,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Properties: []*ast.Property{&ast.Property{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 46,
@@ -6198,10 +6907,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   53,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Key: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 18,
@@ -6214,12 +6925,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   53,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "columns",
 											},
 											Value: &ast.ArrayExpression{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 46,
@@ -6232,10 +6945,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   53,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Elements: []ast.Expression{&ast.StringLiteral{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 35,
@@ -6248,11 +6963,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																Line:   53,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Value: "_measurement",
 												}, &ast.StringLiteral{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 45,
@@ -6265,6 +6982,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																Line:   53,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Value: "_field",
 												}},
@@ -6273,7 +6991,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										With: nil,
 									}},
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 47,
@@ -6286,10 +7005,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   53,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Callee: &ast.Identifier{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 10,
@@ -6302,13 +7023,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   53,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Name: "group",
 									},
 								},
 							},
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 29,
@@ -6321,11 +7044,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										Line:   48,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Call: &ast.CallExpression{
 								Arguments: []ast.Expression{&ast.ObjectExpression{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 28,
@@ -6338,10 +7063,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   54,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Properties: []*ast.Property{&ast.Property{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 28,
@@ -6354,10 +7081,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   54,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Key: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 17,
@@ -6370,12 +7099,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   54,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "columns",
 										},
 										Value: &ast.ArrayExpression{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 28,
@@ -6388,10 +7119,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   54,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Elements: []ast.Expression{&ast.StringLiteral{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 27,
@@ -6404,6 +7137,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   54,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Value: "_time",
 											}},
@@ -6412,7 +7146,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									With: nil,
 								}},
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 29,
@@ -6425,10 +7160,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   54,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Callee: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 9,
@@ -6441,13 +7178,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   54,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "sort",
 								},
 							},
 						},
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 55,
@@ -6460,11 +7199,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Line:   48,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Call: &ast.CallExpression{
 							Arguments: []ast.Expression{&ast.ObjectExpression{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 54,
@@ -6477,10 +7218,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   55,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Properties: []*ast.Property{&ast.Property{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 54,
@@ -6493,10 +7236,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   55,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Key: &ast.Identifier{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 17,
@@ -6509,12 +7254,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   55,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Name: "columns",
 									},
 									Value: &ast.ArrayExpression{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 54,
@@ -6527,10 +7274,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   55,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Elements: []ast.Expression{&ast.StringLiteral{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 27,
@@ -6543,11 +7292,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   55,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Value: "_time",
 										}, &ast.StringLiteral{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 37,
@@ -6560,11 +7311,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   55,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Value: "_value",
 										}, &ast.StringLiteral{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 53,
@@ -6577,6 +7330,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   55,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Value: "_measurement",
 										}},
@@ -6585,7 +7339,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								With: nil,
 							}},
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 55,
@@ -6598,10 +7353,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										Line:   55,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Callee: &ast.Identifier{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 9,
@@ -6614,13 +7371,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   55,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Name: "keep",
 							},
 						},
 					},
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 50,
@@ -6633,11 +7392,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   48,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Call: &ast.CallExpression{
 						Arguments: []ast.Expression{&ast.ObjectExpression{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 49,
@@ -6650,10 +7411,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										Line:   56,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Properties: []*ast.Property{&ast.Property{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 49,
@@ -6666,10 +7429,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   56,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Key: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 19,
@@ -6682,12 +7447,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   56,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "columns",
 								},
 								Value: &ast.ObjectExpression{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 49,
@@ -6700,10 +7467,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   56,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Properties: []*ast.Property{&ast.Property{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 35,
@@ -6716,10 +7485,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   56,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Key: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 27,
@@ -6732,12 +7503,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   56,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "_time",
 										},
 										Value: &ast.StringLiteral{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 35,
@@ -6750,12 +7523,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   56,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Value: "time",
 										},
 									}, &ast.Property{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 48,
@@ -6768,10 +7543,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   56,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Key: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 43,
@@ -6784,12 +7561,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   56,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "_value",
 										},
 										Value: &ast.StringLiteral{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 48,
@@ -6802,6 +7581,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   56,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Value: "n",
 										},
@@ -6812,7 +7592,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							With: nil,
 						}},
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 50,
@@ -6825,10 +7606,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Line:   56,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Callee: &ast.Identifier{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 11,
@@ -6841,6 +7624,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										Line:   56,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Name: "rename",
 						},
@@ -6848,7 +7632,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 				},
 				Params: []*ast.Property{&ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 41,
@@ -6861,10 +7646,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   48,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 38,
@@ -6877,11 +7664,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Line:   48,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Name: "tables",
 					},
 					Value: &ast.PipeLiteral{BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 41,
@@ -6894,13 +7683,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   48,
 							},
 						},
+						TrailingComment: nil,
 					}},
 				}},
 			},
 		}, &ast.TestStatement{
 			Assignment: &ast.VariableAssignment{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 3,
@@ -6913,10 +7704,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   58,
 						},
 					},
+					TrailingComment: nil,
 				},
 				ID: &ast.Identifier{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 32,
@@ -6929,12 +7722,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   58,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Name: "_filter_by_values_with_and",
 				},
 				Init: &ast.FunctionExpression{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 3,
@@ -6947,10 +7742,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   58,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Body: &ast.ParenExpression{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 3,
@@ -6963,10 +7760,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Line:   58,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Expression: &ast.ObjectExpression{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 2,
@@ -6979,10 +7778,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										Line:   58,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Properties: []*ast.Property{&ast.Property{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 41,
@@ -6995,10 +7796,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   59,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Key: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 7,
@@ -7011,13 +7814,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   59,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "input",
 								},
 								Value: &ast.CallExpression{
 									Arguments: []ast.Expression{&ast.ObjectExpression{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 40,
@@ -7030,10 +7835,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   59,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Properties: []*ast.Property{&ast.Property{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 40,
@@ -7046,10 +7853,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   59,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Key: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 32,
@@ -7062,12 +7871,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   59,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "csv",
 											},
 											Value: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 40,
@@ -7080,6 +7891,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   59,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "inData",
 											},
@@ -7087,7 +7899,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										With: nil,
 									}},
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 41,
@@ -7100,10 +7913,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   59,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Callee: &ast.MemberExpression{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 28,
@@ -7116,10 +7931,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   59,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Object: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 16,
@@ -7132,12 +7949,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   59,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "testing",
 										},
 										Property: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 28,
@@ -7150,6 +7969,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   59,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "loadStorage",
 										},
@@ -7157,7 +7977,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								},
 							}, &ast.Property{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 37,
@@ -7170,10 +7991,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   60,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Key: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 6,
@@ -7186,13 +8009,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   60,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "want",
 								},
 								Value: &ast.CallExpression{
 									Arguments: []ast.Expression{&ast.ObjectExpression{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 36,
@@ -7205,10 +8030,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   60,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Properties: []*ast.Property{&ast.Property{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 36,
@@ -7221,10 +8048,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   60,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Key: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 27,
@@ -7237,12 +8066,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   60,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "csv",
 											},
 											Value: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 36,
@@ -7255,6 +8086,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   60,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "outData",
 											},
@@ -7262,7 +8094,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										With: nil,
 									}},
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 37,
@@ -7275,10 +8108,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   60,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Callee: &ast.MemberExpression{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 23,
@@ -7291,10 +8126,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   60,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Object: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 15,
@@ -7307,12 +8144,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   60,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "testing",
 										},
 										Property: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 23,
@@ -7325,6 +8164,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   60,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "loadMem",
 										},
@@ -7332,7 +8172,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								},
 							}, &ast.Property{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 33,
@@ -7345,10 +8186,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   61,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Key: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 4,
@@ -7361,12 +8204,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   61,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "fn",
 								},
 								Value: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 33,
@@ -7379,6 +8224,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   61,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "t_filter_by_values_with_and",
 								},
@@ -7390,7 +8236,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 				},
 			},
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 3,
@@ -7403,12 +8250,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   58,
 					},
 				},
+				TrailingComment: nil,
 			},
 		}},
+		Eof: nil,
 		Imports: []*ast.ImportDeclaration{&ast.ImportDeclaration{
 			As: nil,
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 17,
@@ -7421,10 +8271,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   3,
 					},
 				},
+				TrailingComment: nil,
 			},
 			Path: &ast.StringLiteral{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 17,
@@ -7437,13 +8289,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   3,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Value: "testing",
 			},
 		}, &ast.ImportDeclaration{
 			As: nil,
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 27,
@@ -7456,10 +8310,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   4,
 					},
 				},
+				TrailingComment: nil,
 			},
 			Path: &ast.StringLiteral{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 27,
@@ -7472,6 +8328,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   4,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Value: "internal/influxql",
 			},
@@ -7480,7 +8337,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 		Name:     "filter_by_values_with_and_test.flux",
 		Package: &ast.PackageClause{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 22,
@@ -7493,10 +8351,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   1,
 					},
 				},
+				TrailingComment: nil,
 			},
 			Name: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 22,
@@ -7509,13 +8369,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   1,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "influxql_test",
 			},
 		},
 	}, &ast.File{
 		BaseNode: ast.BaseNode{
-			Errors: nil,
+			Comments: nil,
+			Errors:   nil,
 			Loc: &ast.SourceLocation{
 				End: ast.Position{
 					Column: 3,
@@ -7528,10 +8390,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 					Line:   1,
 				},
 			},
+			TrailingComment: nil,
 		},
 		Body: []ast.Statement{&ast.VariableAssignment{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 2,
@@ -7544,10 +8408,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   6,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 7,
@@ -7560,12 +8426,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   6,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "inData",
 			},
 			Init: &ast.StringLiteral{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 2,
@@ -7578,12 +8446,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   6,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Value: "\n#datatype,string,long,dateTime:RFC3339,string,string,double\n#group,false,false,false,true,true,false\n#default,0,,,,,\n,result,table,_time,_measurement,_field,_value\n,,0,1970-01-01T00:00:00Z,m_0,n,0\n,,1,1970-01-01T00:00:00.000000001Z,m_1,n,1\n,,2,1970-01-01T00:00:00.00000001Z,m_10,n,10\n,,3,1970-01-01T00:00:00.000000011Z,m_11,n,11\n,,4,1970-01-01T00:00:00.000000012Z,m_12,n,12\n,,5,1970-01-01T00:00:00.000000013Z,m_13,n,13\n,,6,1970-01-01T00:00:00.000000014Z,m_14,n,14\n,,7,1970-01-01T00:00:00.000000015Z,m_15,n,15\n,,8,1970-01-01T00:00:00.000000016Z,m_16,n,16\n,,9,1970-01-01T00:00:00.000000017Z,m_17,n,17\n,,10,1970-01-01T00:00:00.000000018Z,m_18,n,18\n,,11,1970-01-01T00:00:00.000000019Z,m_19,n,19\n,,12,1970-01-01T00:00:00.000000002Z,m_2,n,2\n,,13,1970-01-01T00:00:00.000000003Z,m_3,n,3\n,,14,1970-01-01T00:00:00.000000004Z,m_4,n,4\n,,15,1970-01-01T00:00:00.000000005Z,m_5,n,5\n,,16,1970-01-01T00:00:00.000000006Z,m_6,n,6\n,,17,1970-01-01T00:00:00.000000007Z,m_7,n,7\n,,18,1970-01-01T00:00:00.000000008Z,m_8,n,8\n,,19,1970-01-01T00:00:00.000000009Z,m_9,n,9\n",
 			},
 		}, &ast.VariableAssignment{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 2,
@@ -7596,10 +8466,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   33,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 8,
@@ -7612,12 +8484,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   33,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "outData",
 			},
 			Init: &ast.StringLiteral{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 2,
@@ -7630,12 +8504,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   33,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Value: "\n#datatype,string,long,dateTime:RFC3339,string,double\n#group,false,false,false,true,false\n#default,0,,,,\n,result,table,time,_measurement,n\n,,0,1970-01-01T00:00:00Z,m_0,0\n,,1,1970-01-01T00:00:00.000000001Z,m_1,1\n,,2,1970-01-01T00:00:00.00000001Z,m_10,10\n,,3,1970-01-01T00:00:00.000000011Z,m_11,11\n,,4,1970-01-01T00:00:00.000000012Z,m_12,12\n,,5,1970-01-01T00:00:00.000000013Z,m_13,13\n,,6,1970-01-01T00:00:00.000000014Z,m_14,14\n,,7,1970-01-01T00:00:00.000000015Z,m_15,15\n,,8,1970-01-01T00:00:00.000000016Z,m_16,16\n,,9,1970-01-01T00:00:00.000000017Z,m_17,17\n,,10,1970-01-01T00:00:00.000000018Z,m_18,18\n,,11,1970-01-01T00:00:00.000000019Z,m_19,19\n,,12,1970-01-01T00:00:00.000000002Z,m_2,2\n,,13,1970-01-01T00:00:00.000000003Z,m_3,3\n,,14,1970-01-01T00:00:00.000000004Z,m_4,4\n,,15,1970-01-01T00:00:00.000000005Z,m_5,5\n,,16,1970-01-01T00:00:00.000000006Z,m_6,6\n,,17,1970-01-01T00:00:00.000000007Z,m_7,7\n,,18,1970-01-01T00:00:00.000000008Z,m_8,8\n,,19,1970-01-01T00:00:00.000000009Z,m_9,9\n",
 			},
 		}, &ast.VariableAssignment{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: []ast.Comment{ast.Comment{Text: "// SELECT n FROM /^m/"}},
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 50,
@@ -7648,10 +8524,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   61,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 20,
@@ -7664,12 +8542,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   61,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "t_regex_measurement",
 			},
 			Init: &ast.FunctionExpression{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 50,
@@ -7682,6 +8562,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   61,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Body: &ast.PipeExpression{
 					Argument: &ast.PipeExpression{
@@ -7692,7 +8573,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										Argument: &ast.PipeExpression{
 											Argument: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 44,
@@ -7705,11 +8587,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   61,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "tables",
 											},
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 59,
@@ -7722,11 +8606,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   61,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Call: &ast.CallExpression{
 												Arguments: []ast.Expression{&ast.ObjectExpression{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 58,
@@ -7739,10 +8625,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																Line:   62,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Properties: []*ast.Property{&ast.Property{
 														BaseNode: ast.BaseNode{
-															Errors: nil,
+															Comments: nil,
+															Errors:   nil,
 															Loc: &ast.SourceLocation{
 																End: ast.Position{
 																	Column: 34,
@@ -7755,10 +8643,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																	Line:   62,
 																},
 															},
+															TrailingComment: nil,
 														},
 														Key: &ast.Identifier{
 															BaseNode: ast.BaseNode{
-																Errors: nil,
+																Comments: nil,
+																Errors:   nil,
 																Loc: &ast.SourceLocation{
 																	End: ast.Position{
 																		Column: 16,
@@ -7771,12 +8661,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																		Line:   62,
 																	},
 																},
+																TrailingComment: nil,
 															},
 															Name: "start",
 														},
 														Value: &ast.MemberExpression{
 															BaseNode: ast.BaseNode{
-																Errors: nil,
+																Comments: nil,
+																Errors:   nil,
 																Loc: &ast.SourceLocation{
 																	End: ast.Position{
 																		Column: 34,
@@ -7789,10 +8681,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																		Line:   62,
 																	},
 																},
+																TrailingComment: nil,
 															},
 															Object: &ast.Identifier{
 																BaseNode: ast.BaseNode{
-																	Errors: nil,
+																	Comments: nil,
+																	Errors:   nil,
 																	Loc: &ast.SourceLocation{
 																		End: ast.Position{
 																			Column: 26,
@@ -7805,12 +8699,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																			Line:   62,
 																		},
 																	},
+																	TrailingComment: nil,
 																},
 																Name: "influxql",
 															},
 															Property: &ast.Identifier{
 																BaseNode: ast.BaseNode{
-																	Errors: nil,
+																	Comments: nil,
+																	Errors:   nil,
 																	Loc: &ast.SourceLocation{
 																		End: ast.Position{
 																			Column: 34,
@@ -7823,13 +8719,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																			Line:   62,
 																		},
 																	},
+																	TrailingComment: nil,
 																},
 																Name: "minTime",
 															},
 														},
 													}, &ast.Property{
 														BaseNode: ast.BaseNode{
-															Errors: nil,
+															Comments: nil,
+															Errors:   nil,
 															Loc: &ast.SourceLocation{
 																End: ast.Position{
 																	Column: 58,
@@ -7842,10 +8740,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																	Line:   62,
 																},
 															},
+															TrailingComment: nil,
 														},
 														Key: &ast.Identifier{
 															BaseNode: ast.BaseNode{
-																Errors: nil,
+																Comments: nil,
+																Errors:   nil,
 																Loc: &ast.SourceLocation{
 																	End: ast.Position{
 																		Column: 40,
@@ -7858,12 +8758,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																		Line:   62,
 																	},
 																},
+																TrailingComment: nil,
 															},
 															Name: "stop",
 														},
 														Value: &ast.MemberExpression{
 															BaseNode: ast.BaseNode{
-																Errors: nil,
+																Comments: nil,
+																Errors:   nil,
 																Loc: &ast.SourceLocation{
 																	End: ast.Position{
 																		Column: 58,
@@ -7876,10 +8778,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																		Line:   62,
 																	},
 																},
+																TrailingComment: nil,
 															},
 															Object: &ast.Identifier{
 																BaseNode: ast.BaseNode{
-																	Errors: nil,
+																	Comments: nil,
+																	Errors:   nil,
 																	Loc: &ast.SourceLocation{
 																		End: ast.Position{
 																			Column: 50,
@@ -7892,12 +8796,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																			Line:   62,
 																		},
 																	},
+																	TrailingComment: nil,
 																},
 																Name: "influxql",
 															},
 															Property: &ast.Identifier{
 																BaseNode: ast.BaseNode{
-																	Errors: nil,
+																	Comments: nil,
+																	Errors:   nil,
 																	Loc: &ast.SourceLocation{
 																		End: ast.Position{
 																			Column: 58,
@@ -7910,6 +8816,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																			Line:   62,
 																		},
 																	},
+																	TrailingComment: nil,
 																},
 																Name: "maxTime",
 															},
@@ -7918,7 +8825,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													With: nil,
 												}},
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 59,
@@ -7931,10 +8839,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   62,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Callee: &ast.Identifier{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 10,
@@ -7947,13 +8857,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																Line:   62,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Name: "range",
 												},
 											},
 										},
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 46,
@@ -7966,11 +8878,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   61,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Call: &ast.CallExpression{
 											Arguments: []ast.Expression{&ast.ObjectExpression{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 45,
@@ -7983,10 +8897,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   63,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Properties: []*ast.Property{&ast.Property{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 45,
@@ -7999,10 +8915,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																Line:   63,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Key: &ast.Identifier{
 														BaseNode: ast.BaseNode{
-															Errors: nil,
+															Comments: nil,
+															Errors:   nil,
 															Loc: &ast.SourceLocation{
 																End: ast.Position{
 																	Column: 14,
@@ -8015,12 +8933,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																	Line:   63,
 																},
 															},
+															TrailingComment: nil,
 														},
 														Name: "fn",
 													},
 													Value: &ast.FunctionExpression{
 														BaseNode: ast.BaseNode{
-															Errors: nil,
+															Comments: nil,
+															Errors:   nil,
 															Loc: &ast.SourceLocation{
 																End: ast.Position{
 																	Column: 45,
@@ -8033,10 +8953,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																	Line:   63,
 																},
 															},
+															TrailingComment: nil,
 														},
 														Body: &ast.BinaryExpression{
 															BaseNode: ast.BaseNode{
-																Errors: nil,
+																Comments: nil,
+																Errors:   nil,
 																Loc: &ast.SourceLocation{
 																	End: ast.Position{
 																		Column: 45,
@@ -8049,10 +8971,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																		Line:   63,
 																	},
 																},
+																TrailingComment: nil,
 															},
 															Left: &ast.MemberExpression{
 																BaseNode: ast.BaseNode{
-																	Errors: nil,
+																	Comments: nil,
+																	Errors:   nil,
 																	Loc: &ast.SourceLocation{
 																		End: ast.Position{
 																			Column: 37,
@@ -8065,10 +8989,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																			Line:   63,
 																		},
 																	},
+																	TrailingComment: nil,
 																},
 																Object: &ast.Identifier{
 																	BaseNode: ast.BaseNode{
-																		Errors: nil,
+																		Comments: nil,
+																		Errors:   nil,
 																		Loc: &ast.SourceLocation{
 																			End: ast.Position{
 																				Column: 24,
@@ -8081,12 +9007,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																				Line:   63,
 																			},
 																		},
+																		TrailingComment: nil,
 																	},
 																	Name: "r",
 																},
 																Property: &ast.Identifier{
 																	BaseNode: ast.BaseNode{
-																		Errors: nil,
+																		Comments: nil,
+																		Errors:   nil,
 																		Loc: &ast.SourceLocation{
 																			End: ast.Position{
 																				Column: 37,
@@ -8099,6 +9027,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																				Line:   63,
 																			},
 																		},
+																		TrailingComment: nil,
 																	},
 																	Name: "_measurement",
 																},
@@ -8106,7 +9035,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Operator: 19,
 															Right: &ast.RegexpLiteral{
 																BaseNode: ast.BaseNode{
-																	Errors: nil,
+																	Comments: nil,
+																	Errors:   nil,
 																	Loc: &ast.SourceLocation{
 																		End: ast.Position{
 																			Column: 45,
@@ -8119,13 +9049,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																			Line:   63,
 																		},
 																	},
+																	TrailingComment: nil,
 																},
 																Value: regexp.MustCompile("^m"),
 															},
 														},
 														Params: []*ast.Property{&ast.Property{
 															BaseNode: ast.BaseNode{
-																Errors: nil,
+																Comments: nil,
+																Errors:   nil,
 																Loc: &ast.SourceLocation{
 																	End: ast.Position{
 																		Column: 18,
@@ -8138,10 +9070,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																		Line:   63,
 																	},
 																},
+																TrailingComment: nil,
 															},
 															Key: &ast.Identifier{
 																BaseNode: ast.BaseNode{
-																	Errors: nil,
+																	Comments: nil,
+																	Errors:   nil,
 																	Loc: &ast.SourceLocation{
 																		End: ast.Position{
 																			Column: 18,
@@ -8154,6 +9088,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																			Line:   63,
 																		},
 																	},
+																	TrailingComment: nil,
 																},
 																Name: "r",
 															},
@@ -8164,7 +9099,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												With: nil,
 											}},
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 46,
@@ -8177,10 +9113,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   63,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Callee: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 11,
@@ -8193,13 +9131,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   63,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "filter",
 											},
 										},
 									},
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 39,
@@ -8212,11 +9152,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   61,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Call: &ast.CallExpression{
 										Arguments: []ast.Expression{&ast.ObjectExpression{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 38,
@@ -8229,10 +9171,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   64,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Properties: []*ast.Property{&ast.Property{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 38,
@@ -8245,10 +9189,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   64,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Key: &ast.Identifier{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 14,
@@ -8261,12 +9207,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																Line:   64,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Name: "fn",
 												},
 												Value: &ast.FunctionExpression{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 38,
@@ -8279,10 +9227,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																Line:   64,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Body: &ast.BinaryExpression{
 														BaseNode: ast.BaseNode{
-															Errors: nil,
+															Comments: nil,
+															Errors:   nil,
 															Loc: &ast.SourceLocation{
 																End: ast.Position{
 																	Column: 38,
@@ -8295,10 +9245,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																	Line:   64,
 																},
 															},
+															TrailingComment: nil,
 														},
 														Left: &ast.MemberExpression{
 															BaseNode: ast.BaseNode{
-																Errors: nil,
+																Comments: nil,
+																Errors:   nil,
 																Loc: &ast.SourceLocation{
 																	End: ast.Position{
 																		Column: 31,
@@ -8311,10 +9263,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																		Line:   64,
 																	},
 																},
+																TrailingComment: nil,
 															},
 															Object: &ast.Identifier{
 																BaseNode: ast.BaseNode{
-																	Errors: nil,
+																	Comments: nil,
+																	Errors:   nil,
 																	Loc: &ast.SourceLocation{
 																		End: ast.Position{
 																			Column: 24,
@@ -8327,12 +9281,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																			Line:   64,
 																		},
 																	},
+																	TrailingComment: nil,
 																},
 																Name: "r",
 															},
 															Property: &ast.Identifier{
 																BaseNode: ast.BaseNode{
-																	Errors: nil,
+																	Comments: nil,
+																	Errors:   nil,
 																	Loc: &ast.SourceLocation{
 																		End: ast.Position{
 																			Column: 31,
@@ -8345,6 +9301,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																			Line:   64,
 																		},
 																	},
+																	TrailingComment: nil,
 																},
 																Name: "_field",
 															},
@@ -8352,7 +9309,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Operator: 17,
 														Right: &ast.StringLiteral{
 															BaseNode: ast.BaseNode{
-																Errors: nil,
+																Comments: nil,
+																Errors:   nil,
 																Loc: &ast.SourceLocation{
 																	End: ast.Position{
 																		Column: 38,
@@ -8365,13 +9323,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																		Line:   64,
 																	},
 																},
+																TrailingComment: nil,
 															},
 															Value: "n",
 														},
 													},
 													Params: []*ast.Property{&ast.Property{
 														BaseNode: ast.BaseNode{
-															Errors: nil,
+															Comments: nil,
+															Errors:   nil,
 															Loc: &ast.SourceLocation{
 																End: ast.Position{
 																	Column: 18,
@@ -8384,10 +9344,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																	Line:   64,
 																},
 															},
+															TrailingComment: nil,
 														},
 														Key: &ast.Identifier{
 															BaseNode: ast.BaseNode{
-																Errors: nil,
+																Comments: nil,
+																Errors:   nil,
 																Loc: &ast.SourceLocation{
 																	End: ast.Position{
 																		Column: 18,
@@ -8400,6 +9362,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																		Line:   64,
 																	},
 																},
+																TrailingComment: nil,
 															},
 															Name: "r",
 														},
@@ -8410,7 +9373,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											With: nil,
 										}},
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 39,
@@ -8423,10 +9387,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   64,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Callee: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 11,
@@ -8439,13 +9405,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   64,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "filter",
 										},
 									},
 								},
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 47,
@@ -8458,11 +9426,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   61,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Call: &ast.CallExpression{
 									Arguments: []ast.Expression{&ast.ObjectExpression{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 46,
@@ -8475,10 +9445,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   65,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Properties: []*ast.Property{&ast.Property{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 46,
@@ -8491,10 +9463,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   65,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Key: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 18,
@@ -8507,12 +9481,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   65,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "columns",
 											},
 											Value: &ast.ArrayExpression{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 46,
@@ -8525,10 +9501,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   65,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Elements: []ast.Expression{&ast.StringLiteral{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 35,
@@ -8541,11 +9519,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																Line:   65,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Value: "_measurement",
 												}, &ast.StringLiteral{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 45,
@@ -8558,6 +9538,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																Line:   65,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Value: "_field",
 												}},
@@ -8566,7 +9547,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										With: nil,
 									}},
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 47,
@@ -8579,10 +9561,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   65,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Callee: &ast.Identifier{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 10,
@@ -8595,13 +9579,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   65,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Name: "group",
 									},
 								},
 							},
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 29,
@@ -8614,11 +9600,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										Line:   61,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Call: &ast.CallExpression{
 								Arguments: []ast.Expression{&ast.ObjectExpression{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 28,
@@ -8631,10 +9619,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   66,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Properties: []*ast.Property{&ast.Property{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 28,
@@ -8647,10 +9637,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   66,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Key: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 17,
@@ -8663,12 +9655,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   66,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "columns",
 										},
 										Value: &ast.ArrayExpression{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 28,
@@ -8681,10 +9675,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   66,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Elements: []ast.Expression{&ast.StringLiteral{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 27,
@@ -8697,6 +9693,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   66,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Value: "_time",
 											}},
@@ -8705,7 +9702,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									With: nil,
 								}},
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 29,
@@ -8718,10 +9716,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   66,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Callee: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 9,
@@ -8734,13 +9734,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   66,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "sort",
 								},
 							},
 						},
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 55,
@@ -8753,11 +9755,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Line:   61,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Call: &ast.CallExpression{
 							Arguments: []ast.Expression{&ast.ObjectExpression{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 54,
@@ -8770,10 +9774,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   67,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Properties: []*ast.Property{&ast.Property{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 54,
@@ -8786,10 +9792,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   67,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Key: &ast.Identifier{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 17,
@@ -8802,12 +9810,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   67,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Name: "columns",
 									},
 									Value: &ast.ArrayExpression{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 54,
@@ -8820,10 +9830,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   67,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Elements: []ast.Expression{&ast.StringLiteral{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 27,
@@ -8836,11 +9848,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   67,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Value: "_time",
 										}, &ast.StringLiteral{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 37,
@@ -8853,11 +9867,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   67,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Value: "_value",
 										}, &ast.StringLiteral{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 53,
@@ -8870,6 +9886,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   67,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Value: "_measurement",
 										}},
@@ -8878,7 +9895,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								With: nil,
 							}},
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 55,
@@ -8891,10 +9909,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										Line:   67,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Callee: &ast.Identifier{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 9,
@@ -8907,13 +9927,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   67,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Name: "keep",
 							},
 						},
 					},
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 50,
@@ -8926,11 +9948,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   61,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Call: &ast.CallExpression{
 						Arguments: []ast.Expression{&ast.ObjectExpression{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 49,
@@ -8943,10 +9967,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										Line:   68,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Properties: []*ast.Property{&ast.Property{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 49,
@@ -8959,10 +9985,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   68,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Key: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 19,
@@ -8975,12 +10003,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   68,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "columns",
 								},
 								Value: &ast.ObjectExpression{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 49,
@@ -8993,10 +10023,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   68,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Properties: []*ast.Property{&ast.Property{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 35,
@@ -9009,10 +10041,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   68,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Key: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 27,
@@ -9025,12 +10059,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   68,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "_time",
 										},
 										Value: &ast.StringLiteral{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 35,
@@ -9043,12 +10079,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   68,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Value: "time",
 										},
 									}, &ast.Property{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 48,
@@ -9061,10 +10099,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   68,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Key: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 43,
@@ -9077,12 +10117,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   68,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "_value",
 										},
 										Value: &ast.StringLiteral{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 48,
@@ -9095,6 +10137,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   68,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Value: "n",
 										},
@@ -9105,7 +10148,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							With: nil,
 						}},
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 50,
@@ -9118,10 +10162,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Line:   68,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Callee: &ast.Identifier{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 11,
@@ -9134,6 +10180,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										Line:   68,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Name: "rename",
 						},
@@ -9141,7 +10188,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 				},
 				Params: []*ast.Property{&ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 33,
@@ -9154,10 +10202,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   61,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 30,
@@ -9170,11 +10220,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Line:   61,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Name: "tables",
 					},
 					Value: &ast.PipeLiteral{BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 33,
@@ -9187,13 +10239,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   61,
 							},
 						},
+						TrailingComment: nil,
 					}},
 				}},
 			},
 		}, &ast.TestStatement{
 			Assignment: &ast.VariableAssignment{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 3,
@@ -9206,10 +10260,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   70,
 						},
 					},
+					TrailingComment: nil,
 				},
 				ID: &ast.Identifier{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 24,
@@ -9222,12 +10278,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   70,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Name: "_regex_measurement",
 				},
 				Init: &ast.FunctionExpression{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 3,
@@ -9240,10 +10298,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   70,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Body: &ast.ParenExpression{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 3,
@@ -9256,10 +10316,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Line:   70,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Expression: &ast.ObjectExpression{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 2,
@@ -9272,10 +10334,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										Line:   70,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Properties: []*ast.Property{&ast.Property{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 41,
@@ -9288,10 +10352,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   71,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Key: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 7,
@@ -9304,13 +10370,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   71,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "input",
 								},
 								Value: &ast.CallExpression{
 									Arguments: []ast.Expression{&ast.ObjectExpression{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 40,
@@ -9323,10 +10391,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   71,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Properties: []*ast.Property{&ast.Property{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 40,
@@ -9339,10 +10409,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   71,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Key: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 32,
@@ -9355,12 +10427,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   71,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "csv",
 											},
 											Value: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 40,
@@ -9373,6 +10447,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   71,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "inData",
 											},
@@ -9380,7 +10455,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										With: nil,
 									}},
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 41,
@@ -9393,10 +10469,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   71,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Callee: &ast.MemberExpression{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 28,
@@ -9409,10 +10487,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   71,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Object: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 16,
@@ -9425,12 +10505,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   71,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "testing",
 										},
 										Property: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 28,
@@ -9443,6 +10525,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   71,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "loadStorage",
 										},
@@ -9450,7 +10533,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								},
 							}, &ast.Property{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 37,
@@ -9463,10 +10547,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   72,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Key: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 6,
@@ -9479,13 +10565,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   72,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "want",
 								},
 								Value: &ast.CallExpression{
 									Arguments: []ast.Expression{&ast.ObjectExpression{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 36,
@@ -9498,10 +10586,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   72,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Properties: []*ast.Property{&ast.Property{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 36,
@@ -9514,10 +10604,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   72,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Key: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 27,
@@ -9530,12 +10622,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   72,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "csv",
 											},
 											Value: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 36,
@@ -9548,6 +10642,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   72,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "outData",
 											},
@@ -9555,7 +10650,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										With: nil,
 									}},
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 37,
@@ -9568,10 +10664,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   72,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Callee: &ast.MemberExpression{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 23,
@@ -9584,10 +10682,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   72,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Object: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 15,
@@ -9600,12 +10700,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   72,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "testing",
 										},
 										Property: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 23,
@@ -9618,6 +10720,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   72,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "loadMem",
 										},
@@ -9625,7 +10728,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								},
 							}, &ast.Property{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 25,
@@ -9638,10 +10742,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   73,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Key: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 4,
@@ -9654,12 +10760,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   73,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "fn",
 								},
 								Value: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 25,
@@ -9672,6 +10780,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   73,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "t_regex_measurement",
 								},
@@ -9683,7 +10792,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 				},
 			},
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 3,
@@ -9696,12 +10806,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   70,
 					},
 				},
+				TrailingComment: nil,
 			},
 		}},
+		Eof: nil,
 		Imports: []*ast.ImportDeclaration{&ast.ImportDeclaration{
 			As: nil,
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 17,
@@ -9714,10 +10827,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   3,
 					},
 				},
+				TrailingComment: nil,
 			},
 			Path: &ast.StringLiteral{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 17,
@@ -9730,13 +10845,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   3,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Value: "testing",
 			},
 		}, &ast.ImportDeclaration{
 			As: nil,
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 27,
@@ -9749,10 +10866,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   4,
 					},
 				},
+				TrailingComment: nil,
 			},
 			Path: &ast.StringLiteral{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 27,
@@ -9765,6 +10884,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   4,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Value: "internal/influxql",
 			},
@@ -9773,7 +10893,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 		Name:     "regex_measurement_test.flux",
 		Package: &ast.PackageClause{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 22,
@@ -9786,10 +10907,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   1,
 					},
 				},
+				TrailingComment: nil,
 			},
 			Name: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 22,
@@ -9802,13 +10925,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   1,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "influxql_test",
 			},
 		},
 	}, &ast.File{
 		BaseNode: ast.BaseNode{
-			Errors: nil,
+			Comments: nil,
+			Errors:   nil,
 			Loc: &ast.SourceLocation{
 				End: ast.Position{
 					Column: 3,
@@ -9821,10 +10946,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 					Line:   1,
 				},
 			},
+			TrailingComment: nil,
 		},
 		Body: []ast.Statement{&ast.VariableAssignment{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 2,
@@ -9837,10 +10964,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   6,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 7,
@@ -9853,12 +10982,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   6,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "inData",
 			},
 			Init: &ast.StringLiteral{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 2,
@@ -9871,12 +11002,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   6,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Value: "\n#datatype,string,long,dateTime:RFC3339,string,string,string,string,double\n#group,false,false,false,true,true,true,true,false\n#default,0,,,,,,,\n,result,table,_time,_measurement,t0,t1,_field,_value\n,,0,1970-01-01T00:00:00Z,m,0,0,f,0.21546887461084024\n,,0,1970-01-01T00:00:01Z,m,0,0,f,0.9576896132790585\n,,0,1970-01-01T00:00:02Z,m,0,0,f,0.294953913000311\n,,0,1970-01-01T00:00:03Z,m,0,0,f,0.4651741324883778\n,,0,1970-01-01T00:00:04Z,m,0,0,f,0.9873388815871567\n,,0,1970-01-01T00:00:05Z,m,0,0,f,0.3845474109517986\n,,0,1970-01-01T00:00:06Z,m,0,0,f,0.2922442980858412\n,,0,1970-01-01T00:00:07Z,m,0,0,f,0.03298588199059829\n,,0,1970-01-01T00:00:08Z,m,0,0,f,0.969396406468683\n,,0,1970-01-01T00:00:09Z,m,0,0,f,0.8126386582005671\n,,0,1970-01-01T00:00:10Z,m,0,0,f,0.875468209815408\n,,0,1970-01-01T00:00:11Z,m,0,0,f,0.43242435584494165\n,,0,1970-01-01T00:00:12Z,m,0,0,f,0.43936224189298456\n,,0,1970-01-01T00:00:13Z,m,0,0,f,0.1224409595139043\n,,0,1970-01-01T00:00:14Z,m,0,0,f,0.15733684152804783\n,,0,1970-01-01T00:00:15Z,m,0,0,f,0.08882282140312904\n,,0,1970-01-01T00:00:16Z,m,0,0,f,0.23989257176325227\n,,0,1970-01-01T00:00:17Z,m,0,0,f,0.6955232509082638\n,,0,1970-01-01T00:00:18Z,m,0,0,f,0.43554475339119303\n,,0,1970-01-01T00:00:19Z,m,0,0,f,0.3051713218684253\n,,0,1970-01-01T00:00:20Z,m,0,0,f,0.7413025816537797\n,,0,1970-01-01T00:00:21Z,m,0,0,f,0.24567297998270615\n,,0,1970-01-01T00:00:22Z,m,0,0,f,0.491391504478891\n,,0,1970-01-01T00:00:23Z,m,0,0,f,0.13872180750181634\n,,0,1970-01-01T00:00:24Z,m,0,0,f,0.06729135892978601\n,,0,1970-01-01T00:00:25Z,m,0,0,f,0.2711347220286289\n,,0,1970-01-01T00:00:26Z,m,0,0,f,0.5465962906385142\n,,0,1970-01-01T00:00:27Z,m,0,0,f,0.1721498986023557\n,,0,1970-01-01T00:00:28Z,m,0,0,f,0.928541805026285\n,,0,1970-01-01T00:00:29Z,m,0,0,f,0.4390512841392946\n,,0,1970-01-01T00:00:30Z,m,0,0,f,0.7891509564074856\n,,0,1970-01-01T00:00:31Z,m,0,0,f,0.03752404112396554\n,,0,1970-01-01T00:00:32Z,m,0,0,f,0.8731292945164265\n,,0,1970-01-01T00:00:33Z,m,0,0,f,0.6590129312109282\n,,0,1970-01-01T00:00:34Z,m,0,0,f,0.7298034951937612\n,,0,1970-01-01T00:00:35Z,m,0,0,f,0.6880331199538888\n,,0,1970-01-01T00:00:36Z,m,0,0,f,0.7884092917020722\n,,0,1970-01-01T00:00:37Z,m,0,0,f,0.9071621838398441\n,,0,1970-01-01T00:00:38Z,m,0,0,f,0.5029003668295414\n,,0,1970-01-01T00:00:39Z,m,0,0,f,0.5545818527629861\n,,0,1970-01-01T00:00:40Z,m,0,0,f,0.763728196635538\n,,0,1970-01-01T00:00:41Z,m,0,0,f,0.5870046094520823\n,,0,1970-01-01T00:00:42Z,m,0,0,f,0.7675553560334312\n,,0,1970-01-01T00:00:43Z,m,0,0,f,0.8279726730049255\n,,0,1970-01-01T00:00:44Z,m,0,0,f,0.7013474149025897\n,,0,1970-01-01T00:00:45Z,m,0,0,f,0.08556981440432106\n,,0,1970-01-01T00:00:46Z,m,0,0,f,0.8520957093766447\n,,0,1970-01-01T00:00:47Z,m,0,0,f,0.41873957390346783\n,,0,1970-01-01T00:00:48Z,m,0,0,f,0.04405459160245573\n,,0,1970-01-01T00:00:49Z,m,0,0,f,0.8184927094237151\n,,0,1970-01-01T00:00:50Z,m,0,0,f,0.0975526753791771\n,,0,1970-01-01T00:00:51Z,m,0,0,f,0.4984015942759995\n,,0,1970-01-01T00:00:52Z,m,0,0,f,0.24094630162586889\n,,0,1970-01-01T00:00:53Z,m,0,0,f,0.1461722759564162\n,,0,1970-01-01T00:00:54Z,m,0,0,f,0.0008451156568219057\n,,0,1970-01-01T00:00:55Z,m,0,0,f,0.4633414547017063\n,,0,1970-01-01T00:00:56Z,m,0,0,f,0.4539668492775038\n,,0,1970-01-01T00:00:57Z,m,0,0,f,0.4868916379116324\n,,0,1970-01-01T00:00:58Z,m,0,0,f,0.9566203795860617\n,,0,1970-01-01T00:00:59Z,m,0,0,f,0.9599106283927733\n,,0,1970-01-01T00:01:00Z,m,0,0,f,0.7293729603954808\n,,0,1970-01-01T00:01:01Z,m,0,0,f,0.6455698152977222\n,,0,1970-01-01T00:01:02Z,m,0,0,f,0.11441321827059112\n,,0,1970-01-01T00:01:03Z,m,0,0,f,0.9955326395256039\n,,0,1970-01-01T00:01:04Z,m,0,0,f,0.44266439346958053\n,,0,1970-01-01T00:01:05Z,m,0,0,f,0.7183012898949253\n,,0,1970-01-01T00:01:06Z,m,0,0,f,0.30706108459030473\n,,0,1970-01-01T00:01:07Z,m,0,0,f,0.5034183578538529\n,,0,1970-01-01T00:01:08Z,m,0,0,f,0.945541035399725\n,,0,1970-01-01T00:01:09Z,m,0,0,f,0.4233995128157775\n,,0,1970-01-01T00:01:10Z,m,0,0,f,0.7647066005216012\n,,0,1970-01-01T00:01:11Z,m,0,0,f,0.4427721542156412\n,,0,1970-01-01T00:01:12Z,m,0,0,f,0.5759588898144714\n,,0,1970-01-01T00:01:13Z,m,0,0,f,0.4891738037219912\n,,0,1970-01-01T00:01:14Z,m,0,0,f,0.3162573404966396\n,,0,1970-01-01T00:01:15Z,m,0,0,f,0.12429098278245032\n,,0,1970-01-01T00:01:16Z,m,0,0,f,0.5500314687416078\n,,0,1970-01-01T00:01:17Z,m,0,0,f,0.07874290942037632\n,,0,1970-01-01T00:01:18Z,m,0,0,f,0.2432131181375912\n,,0,1970-01-01T00:01:19Z,m,0,0,f,0.2059157686630176\n,,0,1970-01-01T00:01:20Z,m,0,0,f,0.44865547217512164\n,,0,1970-01-01T00:01:21Z,m,0,0,f,0.7168101661064027\n,,0,1970-01-01T00:01:22Z,m,0,0,f,0.36652553198536764\n,,0,1970-01-01T00:01:23Z,m,0,0,f,0.12875338574773973\n,,0,1970-01-01T00:01:24Z,m,0,0,f,0.14050907817041347\n,,0,1970-01-01T00:01:25Z,m,0,0,f,0.4095172637990756\n,,0,1970-01-01T00:01:26Z,m,0,0,f,0.2460700738777719\n,,0,1970-01-01T00:01:27Z,m,0,0,f,0.7823912602040078\n,,0,1970-01-01T00:01:28Z,m,0,0,f,0.707534534477093\n,,0,1970-01-01T00:01:29Z,m,0,0,f,0.6714337668672199\n,,0,1970-01-01T00:01:30Z,m,0,0,f,0.6443730852735031\n,,0,1970-01-01T00:01:31Z,m,0,0,f,0.8349467641212396\n,,0,1970-01-01T00:01:32Z,m,0,0,f,0.7443365385220384\n,,0,1970-01-01T00:01:33Z,m,0,0,f,0.778092873581952\n,,0,1970-01-01T00:01:34Z,m,0,0,f,0.21451835990529106\n,,0,1970-01-01T00:01:35Z,m,0,0,f,0.15132579382756906\n,,0,1970-01-01T00:01:36Z,m,0,0,f,0.889690688725347\n,,0,1970-01-01T00:01:37Z,m,0,0,f,0.08177608166572663\n,,0,1970-01-01T00:01:38Z,m,0,0,f,0.6156947898336163\n,,0,1970-01-01T00:01:39Z,m,0,0,f,0.8839098227070676\n,,1,1970-01-01T00:00:00Z,m,0,1,f,0.47284307199688513\n,,1,1970-01-01T00:00:01Z,m,0,1,f,0.6115110431660992\n,,1,1970-01-01T00:00:02Z,m,0,1,f,0.9139676390179812\n,,1,1970-01-01T00:00:03Z,m,0,1,f,0.4419580502994864\n,,1,1970-01-01T00:00:04Z,m,0,1,f,0.22346720477114235\n,,1,1970-01-01T00:00:05Z,m,0,1,f,0.01657253263970824\n,,1,1970-01-01T00:00:06Z,m,0,1,f,0.5275526538985256\n,,1,1970-01-01T00:00:07Z,m,0,1,f,0.2801453905589357\n,,1,1970-01-01T00:00:08Z,m,0,1,f,0.40358058571546174\n,,1,1970-01-01T00:00:09Z,m,0,1,f,0.5581225312763497\n,,1,1970-01-01T00:00:10Z,m,0,1,f,0.5618381020173508\n,,1,1970-01-01T00:00:11Z,m,0,1,f,0.08048303365885615\n,,1,1970-01-01T00:00:12Z,m,0,1,f,0.5001751201461243\n,,1,1970-01-01T00:00:13Z,m,0,1,f,0.22639175489524663\n,,1,1970-01-01T00:00:14Z,m,0,1,f,0.26537476142069744\n,,1,1970-01-01T00:00:15Z,m,0,1,f,0.8045352065828273\n,,1,1970-01-01T00:00:16Z,m,0,1,f,0.401634967963577\n,,1,1970-01-01T00:00:17Z,m,0,1,f,0.9411501472896155\n,,1,1970-01-01T00:00:18Z,m,0,1,f,0.2930734491556474\n,,1,1970-01-01T00:00:19Z,m,0,1,f,0.18157543568371715\n,,1,1970-01-01T00:00:20Z,m,0,1,f,0.9385325130161203\n,,1,1970-01-01T00:00:21Z,m,0,1,f,0.17010332650185725\n,,1,1970-01-01T00:00:22Z,m,0,1,f,0.04213339793024455\n,,1,1970-01-01T00:00:23Z,m,0,1,f,0.5626619227163632\n,,1,1970-01-01T00:00:24Z,m,0,1,f,0.6941739177125473\n,,1,1970-01-01T00:00:25Z,m,0,1,f,0.5438842736369963\n,,1,1970-01-01T00:00:26Z,m,0,1,f,0.6524346931171858\n,,1,1970-01-01T00:00:27Z,m,0,1,f,0.062106354006262784\n,,1,1970-01-01T00:00:28Z,m,0,1,f,0.6808062354975885\n,,1,1970-01-01T00:00:29Z,m,0,1,f,0.4566938577876695\n,,1,1970-01-01T00:00:30Z,m,0,1,f,0.15426646385258916\n,,1,1970-01-01T00:00:31Z,m,0,1,f,0.7378414694167669\n,,1,1970-01-01T00:00:32Z,m,0,1,f,0.35905015546070745\n,,1,1970-01-01T00:00:33Z,m,0,1,f,0.25717348995611955\n,,1,1970-01-01T00:00:34Z,m,0,1,f,0.8669066045043076\n,,1,1970-01-01T00:00:35Z,m,0,1,f,0.7414665987538746\n,,1,1970-01-01T00:00:36Z,m,0,1,f,0.7580463272135385\n,,1,1970-01-01T00:00:37Z,m,0,1,f,0.223202540983848\n,,1,1970-01-01T00:00:38Z,m,0,1,f,0.09675623584194015\n,,1,1970-01-01T00:00:39Z,m,0,1,f,0.33037602371875235\n,,1,1970-01-01T00:00:40Z,m,0,1,f,0.02419699334564844\n,,1,1970-01-01T00:00:41Z,m,0,1,f,0.30660540046813134\n,,1,1970-01-01T00:00:42Z,m,0,1,f,0.28087743747358407\n,,1,1970-01-01T00:00:43Z,m,0,1,f,0.8125957553254125\n,,1,1970-01-01T00:00:44Z,m,0,1,f,0.3996499465775914\n,,1,1970-01-01T00:00:45Z,m,0,1,f,0.002859922694346698\n,,1,1970-01-01T00:00:46Z,m,0,1,f,0.7743871384683348\n,,1,1970-01-01T00:00:47Z,m,0,1,f,0.3428194666142575\n,,1,1970-01-01T00:00:48Z,m,0,1,f,0.24529106535786452\n,,1,1970-01-01T00:00:49Z,m,0,1,f,0.42074581063787847\n,,1,1970-01-01T00:00:50Z,m,0,1,f,0.8230512029974123\n,,1,1970-01-01T00:00:51Z,m,0,1,f,0.7612451595826552\n,,1,1970-01-01T00:00:52Z,m,0,1,f,0.0025044233308020394\n,,1,1970-01-01T00:00:53Z,m,0,1,f,0.8123608833291784\n,,1,1970-01-01T00:00:54Z,m,0,1,f,0.094280039506472\n,,1,1970-01-01T00:00:55Z,m,0,1,f,0.7414773533860608\n,,1,1970-01-01T00:00:56Z,m,0,1,f,0.048248944868655844\n,,1,1970-01-01T00:00:57Z,m,0,1,f,0.7876232215876143\n,,1,1970-01-01T00:00:58Z,m,0,1,f,0.7708955207540708\n,,1,1970-01-01T00:00:59Z,m,0,1,f,0.3210082428062905\n,,1,1970-01-01T00:01:00Z,m,0,1,f,0.6199485490487467\n,,1,1970-01-01T00:01:01Z,m,0,1,f,0.4526111772487005\n,,1,1970-01-01T00:01:02Z,m,0,1,f,0.06993036738408297\n,,1,1970-01-01T00:01:03Z,m,0,1,f,0.5391803940621971\n,,1,1970-01-01T00:01:04Z,m,0,1,f,0.3786026404218388\n,,1,1970-01-01T00:01:05Z,m,0,1,f,0.16987447951514412\n,,1,1970-01-01T00:01:06Z,m,0,1,f,0.9622624203254517\n,,1,1970-01-01T00:01:07Z,m,0,1,f,0.10609876802280566\n,,1,1970-01-01T00:01:08Z,m,0,1,f,0.34039196604520483\n,,1,1970-01-01T00:01:09Z,m,0,1,f,0.326997943237989\n,,1,1970-01-01T00:01:10Z,m,0,1,f,0.40582069426239586\n,,1,1970-01-01T00:01:11Z,m,0,1,f,0.09664389869310906\n,,1,1970-01-01T00:01:12Z,m,0,1,f,0.0874716642419619\n,,1,1970-01-01T00:01:13Z,m,0,1,f,0.9574787428982809\n,,1,1970-01-01T00:01:14Z,m,0,1,f,0.792171281216902\n,,1,1970-01-01T00:01:15Z,m,0,1,f,0.8154053514727819\n,,1,1970-01-01T00:01:16Z,m,0,1,f,0.9446634309508735\n,,1,1970-01-01T00:01:17Z,m,0,1,f,0.7914039734656017\n,,1,1970-01-01T00:01:18Z,m,0,1,f,0.5642005948380394\n,,1,1970-01-01T00:01:19Z,m,0,1,f,0.9394901508564378\n,,1,1970-01-01T00:01:20Z,m,0,1,f,0.09420964672484634\n,,1,1970-01-01T00:01:21Z,m,0,1,f,0.8997154088951347\n,,1,1970-01-01T00:01:22Z,m,0,1,f,0.8929163087698091\n,,1,1970-01-01T00:01:23Z,m,0,1,f,0.14602512562046865\n,,1,1970-01-01T00:01:24Z,m,0,1,f,0.061755078411980135\n,,1,1970-01-01T00:01:25Z,m,0,1,f,0.050027231315704974\n,,1,1970-01-01T00:01:26Z,m,0,1,f,0.06579399435541186\n,,1,1970-01-01T00:01:27Z,m,0,1,f,0.5485533330294929\n,,1,1970-01-01T00:01:28Z,m,0,1,f,0.08600793471366114\n,,1,1970-01-01T00:01:29Z,m,0,1,f,0.0048224932897884395\n,,1,1970-01-01T00:01:30Z,m,0,1,f,0.031000679866955753\n,,1,1970-01-01T00:01:31Z,m,0,1,f,0.7590758510991269\n,,1,1970-01-01T00:01:32Z,m,0,1,f,0.28752964131696107\n,,1,1970-01-01T00:01:33Z,m,0,1,f,0.0803113942730073\n,,1,1970-01-01T00:01:34Z,m,0,1,f,0.7653660195907919\n,,1,1970-01-01T00:01:35Z,m,0,1,f,0.169201547040183\n,,1,1970-01-01T00:01:36Z,m,0,1,f,0.2812417370494343\n,,1,1970-01-01T00:01:37Z,m,0,1,f,0.5556525309491438\n,,1,1970-01-01T00:01:38Z,m,0,1,f,0.21336394958285926\n,,1,1970-01-01T00:01:39Z,m,0,1,f,0.843202199200085\n,,2,1970-01-01T00:00:00Z,m,1,0,f,0.6745411981120504\n,,2,1970-01-01T00:00:01Z,m,1,0,f,0.4341136360856983\n,,2,1970-01-01T00:00:02Z,m,1,0,f,0.0779873994184798\n,,2,1970-01-01T00:00:03Z,m,1,0,f,0.6045688060594187\n,,2,1970-01-01T00:00:04Z,m,1,0,f,0.609806908577383\n,,2,1970-01-01T00:00:05Z,m,1,0,f,0.2371373109677929\n,,2,1970-01-01T00:00:06Z,m,1,0,f,0.15959047192822226\n,,2,1970-01-01T00:00:07Z,m,1,0,f,0.7696930667476671\n,,2,1970-01-01T00:00:08Z,m,1,0,f,0.44489788239949923\n,,2,1970-01-01T00:00:09Z,m,1,0,f,0.20113730484499945\n,,2,1970-01-01T00:00:10Z,m,1,0,f,0.9004310672214374\n,,2,1970-01-01T00:00:11Z,m,1,0,f,0.08071979045152104\n,,2,1970-01-01T00:00:12Z,m,1,0,f,0.35878401311181407\n,,2,1970-01-01T00:00:13Z,m,1,0,f,0.8046013839899406\n,,2,1970-01-01T00:00:14Z,m,1,0,f,0.09869242829873062\n,,2,1970-01-01T00:00:15Z,m,1,0,f,0.27053244466215826\n,,2,1970-01-01T00:00:16Z,m,1,0,f,0.6672055373259661\n,,2,1970-01-01T00:00:17Z,m,1,0,f,0.9015798497859395\n,,2,1970-01-01T00:00:18Z,m,1,0,f,0.6514438661906353\n,,2,1970-01-01T00:00:19Z,m,1,0,f,0.03319201114385362\n,,2,1970-01-01T00:00:20Z,m,1,0,f,0.44109087427118215\n,,2,1970-01-01T00:00:21Z,m,1,0,f,0.1441063884747634\n,,2,1970-01-01T00:00:22Z,m,1,0,f,0.23335939084421864\n,,2,1970-01-01T00:00:23Z,m,1,0,f,0.6904277645853616\n,,2,1970-01-01T00:00:24Z,m,1,0,f,0.5145930899531316\n,,2,1970-01-01T00:00:25Z,m,1,0,f,0.4299752694354613\n,,2,1970-01-01T00:00:26Z,m,1,0,f,0.9207494524068397\n,,2,1970-01-01T00:00:27Z,m,1,0,f,0.4990764483657634\n,,2,1970-01-01T00:00:28Z,m,1,0,f,0.7370053493218158\n,,2,1970-01-01T00:00:29Z,m,1,0,f,0.8159190359865772\n,,2,1970-01-01T00:00:30Z,m,1,0,f,0.5730300999100897\n,,2,1970-01-01T00:00:31Z,m,1,0,f,0.4957548727598841\n,,2,1970-01-01T00:00:32Z,m,1,0,f,0.4475722509767004\n,,2,1970-01-01T00:00:33Z,m,1,0,f,0.09000105562869058\n,,2,1970-01-01T00:00:34Z,m,1,0,f,0.5765896961954948\n,,2,1970-01-01T00:00:35Z,m,1,0,f,0.007292186311595296\n,,2,1970-01-01T00:00:36Z,m,1,0,f,0.6862338192326899\n,,2,1970-01-01T00:00:37Z,m,1,0,f,0.6323091325867545\n,,2,1970-01-01T00:00:38Z,m,1,0,f,0.22250144688828086\n,,2,1970-01-01T00:00:39Z,m,1,0,f,0.7767158293696542\n,,2,1970-01-01T00:00:40Z,m,1,0,f,0.5040765046136644\n,,2,1970-01-01T00:00:41Z,m,1,0,f,0.7198824794590694\n,,2,1970-01-01T00:00:42Z,m,1,0,f,0.16487220863546403\n,,2,1970-01-01T00:00:43Z,m,1,0,f,0.6185190195253291\n,,2,1970-01-01T00:00:44Z,m,1,0,f,0.417935209198883\n,,2,1970-01-01T00:00:45Z,m,1,0,f,0.143322367253724\n,,2,1970-01-01T00:00:46Z,m,1,0,f,0.7110860020844423\n,,2,1970-01-01T00:00:47Z,m,1,0,f,0.5190433935276061\n,,2,1970-01-01T00:00:48Z,m,1,0,f,0.5947710020498977\n,,2,1970-01-01T00:00:49Z,m,1,0,f,0.18632874860445664\n,,2,1970-01-01T00:00:50Z,m,1,0,f,0.050671657609869296\n,,2,1970-01-01T00:00:51Z,m,1,0,f,0.336667976831678\n,,2,1970-01-01T00:00:52Z,m,1,0,f,0.16893598340949662\n,,2,1970-01-01T00:00:53Z,m,1,0,f,0.6319794509787114\n,,2,1970-01-01T00:00:54Z,m,1,0,f,0.3434433122927547\n,,2,1970-01-01T00:00:55Z,m,1,0,f,0.13766344408813833\n,,2,1970-01-01T00:00:56Z,m,1,0,f,0.7028890267599247\n,,2,1970-01-01T00:00:57Z,m,1,0,f,0.5893915586856076\n,,2,1970-01-01T00:00:58Z,m,1,0,f,0.08495375348679511\n,,2,1970-01-01T00:00:59Z,m,1,0,f,0.5635570663754376\n,,2,1970-01-01T00:01:00Z,m,1,0,f,0.06973804413592974\n,,2,1970-01-01T00:01:01Z,m,1,0,f,0.4594087627832006\n,,2,1970-01-01T00:01:02Z,m,1,0,f,0.9484143072574632\n,,2,1970-01-01T00:01:03Z,m,1,0,f,0.7210862651644585\n,,2,1970-01-01T00:01:04Z,m,1,0,f,0.4306492881221061\n,,2,1970-01-01T00:01:05Z,m,1,0,f,0.9768511587696722\n,,2,1970-01-01T00:01:06Z,m,1,0,f,0.036770411149115535\n,,2,1970-01-01T00:01:07Z,m,1,0,f,0.199704171721732\n,,2,1970-01-01T00:01:08Z,m,1,0,f,0.044989678879272736\n,,2,1970-01-01T00:01:09Z,m,1,0,f,0.4204918747032285\n,,2,1970-01-01T00:01:10Z,m,1,0,f,0.7660528673315015\n,,2,1970-01-01T00:01:11Z,m,1,0,f,0.07495082447510862\n,,2,1970-01-01T00:01:12Z,m,1,0,f,0.979672949703\n,,2,1970-01-01T00:01:13Z,m,1,0,f,0.43531431314587743\n,,2,1970-01-01T00:01:14Z,m,1,0,f,0.16473009865933294\n,,2,1970-01-01T00:01:15Z,m,1,0,f,0.9714924938553514\n,,2,1970-01-01T00:01:16Z,m,1,0,f,0.8548205740914873\n,,2,1970-01-01T00:01:17Z,m,1,0,f,0.988621458104506\n,,2,1970-01-01T00:01:18Z,m,1,0,f,0.42316749552422783\n,,2,1970-01-01T00:01:19Z,m,1,0,f,0.5599137447927957\n,,2,1970-01-01T00:01:20Z,m,1,0,f,0.7513515954882367\n,,2,1970-01-01T00:01:21Z,m,1,0,f,0.07681127373236643\n,,2,1970-01-01T00:01:22Z,m,1,0,f,0.04219934813632237\n,,2,1970-01-01T00:01:23Z,m,1,0,f,0.27672511415229256\n,,2,1970-01-01T00:01:24Z,m,1,0,f,0.6618414211834359\n,,2,1970-01-01T00:01:25Z,m,1,0,f,0.04819580958061359\n,,2,1970-01-01T00:01:26Z,m,1,0,f,0.8514613397306017\n,,2,1970-01-01T00:01:27Z,m,1,0,f,0.654705748814002\n,,2,1970-01-01T00:01:28Z,m,1,0,f,0.9967833661484294\n,,2,1970-01-01T00:01:29Z,m,1,0,f,0.9631421129969118\n,,2,1970-01-01T00:01:30Z,m,1,0,f,0.6286421005881492\n,,2,1970-01-01T00:01:31Z,m,1,0,f,0.3783501632738452\n,,2,1970-01-01T00:01:32Z,m,1,0,f,0.05114898778086843\n,,2,1970-01-01T00:01:33Z,m,1,0,f,0.2473880323048304\n,,2,1970-01-01T00:01:34Z,m,1,0,f,0.7842674808782694\n,,2,1970-01-01T00:01:35Z,m,1,0,f,0.6130952139646441\n,,2,1970-01-01T00:01:36Z,m,1,0,f,0.9762618521418323\n,,2,1970-01-01T00:01:37Z,m,1,0,f,0.9219480325346383\n,,2,1970-01-01T00:01:38Z,m,1,0,f,0.7986205925631757\n,,2,1970-01-01T00:01:39Z,m,1,0,f,0.578541588985068\n,,3,1970-01-01T00:00:00Z,m,1,1,f,0.3609497652786835\n,,3,1970-01-01T00:00:01Z,m,1,1,f,0.6431495269328852\n,,3,1970-01-01T00:00:02Z,m,1,1,f,0.30119517109360755\n,,3,1970-01-01T00:00:03Z,m,1,1,f,0.029905756669452933\n,,3,1970-01-01T00:00:04Z,m,1,1,f,0.32578997668820153\n,,3,1970-01-01T00:00:05Z,m,1,1,f,0.7482046757377168\n,,3,1970-01-01T00:00:06Z,m,1,1,f,0.42006674019623874\n,,3,1970-01-01T00:00:07Z,m,1,1,f,0.8892383923700209\n,,3,1970-01-01T00:00:08Z,m,1,1,f,0.2734890146915862\n,,3,1970-01-01T00:00:09Z,m,1,1,f,0.2126705472958595\n,,3,1970-01-01T00:00:10Z,m,1,1,f,0.4081541720871348\n,,3,1970-01-01T00:00:11Z,m,1,1,f,0.7517886726430452\n,,3,1970-01-01T00:00:12Z,m,1,1,f,0.6344255763748975\n,,3,1970-01-01T00:00:13Z,m,1,1,f,0.13439033950657941\n,,3,1970-01-01T00:00:14Z,m,1,1,f,0.13080770333361982\n,,3,1970-01-01T00:00:15Z,m,1,1,f,0.42098106260813917\n,,3,1970-01-01T00:00:16Z,m,1,1,f,0.6126625007965338\n,,3,1970-01-01T00:00:17Z,m,1,1,f,0.6566130686317417\n,,3,1970-01-01T00:00:18Z,m,1,1,f,0.8724405943016941\n,,3,1970-01-01T00:00:19Z,m,1,1,f,0.5240118690102152\n,,3,1970-01-01T00:00:20Z,m,1,1,f,0.16295208705669978\n,,3,1970-01-01T00:00:21Z,m,1,1,f,0.3087465430934554\n,,3,1970-01-01T00:00:22Z,m,1,1,f,0.5285274343484349\n,,3,1970-01-01T00:00:23Z,m,1,1,f,0.634731960510953\n,,3,1970-01-01T00:00:24Z,m,1,1,f,0.21258839107347696\n,,3,1970-01-01T00:00:25Z,m,1,1,f,0.418565981182859\n,,3,1970-01-01T00:00:26Z,m,1,1,f,0.2537565365571897\n,,3,1970-01-01T00:00:27Z,m,1,1,f,0.5464331287426728\n,,3,1970-01-01T00:00:28Z,m,1,1,f,0.9960454475764904\n,,3,1970-01-01T00:00:29Z,m,1,1,f,0.09275146190386824\n,,3,1970-01-01T00:00:30Z,m,1,1,f,0.6976442897720185\n,,3,1970-01-01T00:00:31Z,m,1,1,f,0.74713521249196\n,,3,1970-01-01T00:00:32Z,m,1,1,f,0.984508958500529\n,,3,1970-01-01T00:00:33Z,m,1,1,f,0.735978145078593\n,,3,1970-01-01T00:00:34Z,m,1,1,f,0.03272325327489153\n,,3,1970-01-01T00:00:35Z,m,1,1,f,0.2789090231376286\n,,3,1970-01-01T00:00:36Z,m,1,1,f,0.9009986444969635\n,,3,1970-01-01T00:00:37Z,m,1,1,f,0.848311973911401\n,,3,1970-01-01T00:00:38Z,m,1,1,f,0.3433130690616337\n,,3,1970-01-01T00:00:39Z,m,1,1,f,0.9705860405696857\n,,3,1970-01-01T00:00:40Z,m,1,1,f,0.4971554061394775\n,,3,1970-01-01T00:00:41Z,m,1,1,f,0.5010737989466268\n,,3,1970-01-01T00:00:42Z,m,1,1,f,0.6786336325659156\n,,3,1970-01-01T00:00:43Z,m,1,1,f,0.45685893681365386\n,,3,1970-01-01T00:00:44Z,m,1,1,f,0.06785712875301617\n,,3,1970-01-01T00:00:45Z,m,1,1,f,0.3686928354464234\n,,3,1970-01-01T00:00:46Z,m,1,1,f,0.16238519747752908\n,,3,1970-01-01T00:00:47Z,m,1,1,f,0.09616346590744834\n,,3,1970-01-01T00:00:48Z,m,1,1,f,0.982361090570932\n,,3,1970-01-01T00:00:49Z,m,1,1,f,0.24546880258756468\n,,3,1970-01-01T00:00:50Z,m,1,1,f,0.4063470659819713\n,,3,1970-01-01T00:00:51Z,m,1,1,f,0.02333966735385356\n,,3,1970-01-01T00:00:52Z,m,1,1,f,0.7485740576779872\n,,3,1970-01-01T00:00:53Z,m,1,1,f,0.6166837184691856\n,,3,1970-01-01T00:00:54Z,m,1,1,f,0.05978509722242629\n,,3,1970-01-01T00:00:55Z,m,1,1,f,0.8745680789623674\n,,3,1970-01-01T00:00:56Z,m,1,1,f,0.7043364028176561\n,,3,1970-01-01T00:00:57Z,m,1,1,f,0.5100762819992395\n,,3,1970-01-01T00:00:58Z,m,1,1,f,0.16311060736490562\n,,3,1970-01-01T00:00:59Z,m,1,1,f,0.8629619678924975\n,,3,1970-01-01T00:01:00Z,m,1,1,f,0.10822795841933747\n,,3,1970-01-01T00:01:01Z,m,1,1,f,0.009391242035550616\n,,3,1970-01-01T00:01:02Z,m,1,1,f,0.8963338627277064\n,,3,1970-01-01T00:01:03Z,m,1,1,f,0.2741500937920746\n,,3,1970-01-01T00:01:04Z,m,1,1,f,0.8919325188107933\n,,3,1970-01-01T00:01:05Z,m,1,1,f,0.6654225234319311\n,,3,1970-01-01T00:01:06Z,m,1,1,f,0.02781722451099708\n,,3,1970-01-01T00:01:07Z,m,1,1,f,0.1620103430803485\n,,3,1970-01-01T00:01:08Z,m,1,1,f,0.4825820756588489\n,,3,1970-01-01T00:01:09Z,m,1,1,f,0.6564731088934671\n,,3,1970-01-01T00:01:10Z,m,1,1,f,0.5500077260845426\n,,3,1970-01-01T00:01:11Z,m,1,1,f,0.40462752766482185\n,,3,1970-01-01T00:01:12Z,m,1,1,f,0.8674131498299248\n,,3,1970-01-01T00:01:13Z,m,1,1,f,0.8902851603994412\n,,3,1970-01-01T00:01:14Z,m,1,1,f,0.1599747356552478\n,,3,1970-01-01T00:01:15Z,m,1,1,f,0.4023835778260672\n,,3,1970-01-01T00:01:16Z,m,1,1,f,0.8892986579330658\n,,3,1970-01-01T00:01:17Z,m,1,1,f,0.05870852811550652\n,,3,1970-01-01T00:01:18Z,m,1,1,f,0.08810359195444939\n,,3,1970-01-01T00:01:19Z,m,1,1,f,0.5799459169235229\n,,3,1970-01-01T00:01:20Z,m,1,1,f,0.675990461828967\n,,3,1970-01-01T00:01:21Z,m,1,1,f,0.680028234810394\n,,3,1970-01-01T00:01:22Z,m,1,1,f,0.3828707005637953\n,,3,1970-01-01T00:01:23Z,m,1,1,f,0.369157111114499\n,,3,1970-01-01T00:01:24Z,m,1,1,f,0.12328872455169967\n,,3,1970-01-01T00:01:25Z,m,1,1,f,0.43126638642422993\n,,3,1970-01-01T00:01:26Z,m,1,1,f,0.24418662053793608\n,,3,1970-01-01T00:01:27Z,m,1,1,f,0.22094836458502065\n,,3,1970-01-01T00:01:28Z,m,1,1,f,0.10278220106833619\n,,3,1970-01-01T00:01:29Z,m,1,1,f,0.7194160988953583\n,,3,1970-01-01T00:01:30Z,m,1,1,f,0.9646344422230495\n,,3,1970-01-01T00:01:31Z,m,1,1,f,0.462370535565091\n,,3,1970-01-01T00:01:32Z,m,1,1,f,0.9386791098643801\n,,3,1970-01-01T00:01:33Z,m,1,1,f,0.03801280884674329\n,,3,1970-01-01T00:01:34Z,m,1,1,f,0.35603844514090255\n,,3,1970-01-01T00:01:35Z,m,1,1,f,0.5083881660913203\n,,3,1970-01-01T00:01:36Z,m,1,1,f,0.4326239900843389\n,,3,1970-01-01T00:01:37Z,m,1,1,f,0.09453891565081506\n,,3,1970-01-01T00:01:38Z,m,1,1,f,0.023503857583366802\n,,3,1970-01-01T00:01:39Z,m,1,1,f,0.9492834672803911\n",
 			},
 		}, &ast.VariableAssignment{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 2,
@@ -9889,10 +11022,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   413,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 8,
@@ -9905,12 +11040,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   413,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "outData",
 			},
 			Init: &ast.StringLiteral{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 2,
@@ -9923,12 +11060,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   413,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Value: "\n#datatype,string,long,dateTime:RFC3339,string,double\n#group,false,false,false,true,false\n#default,0,,,,\n,result,table,time,_measurement,max\n,,0,1970-01-01T00:01:28Z,m,0.9967833661484294\n",
 			},
 		}, &ast.VariableAssignment{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: []ast.Comment{ast.Comment{Text: "// SELECT max(f) FROM m"}},
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 52,
@@ -9941,10 +11080,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   422,
 					},
 				},
+				TrailingComment: nil,
 			},
 			ID: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 11,
@@ -9957,12 +11098,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   422,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "t_selector",
 			},
 			Init: &ast.FunctionExpression{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 52,
@@ -9975,6 +11118,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   422,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Body: &ast.PipeExpression{
 					Argument: &ast.PipeExpression{
@@ -9985,7 +11129,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										Argument: &ast.PipeExpression{
 											Argument: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 35,
@@ -9998,11 +11143,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   422,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "tables",
 											},
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 59,
@@ -10015,11 +11162,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   422,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Call: &ast.CallExpression{
 												Arguments: []ast.Expression{&ast.ObjectExpression{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 58,
@@ -10032,10 +11181,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																Line:   423,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Properties: []*ast.Property{&ast.Property{
 														BaseNode: ast.BaseNode{
-															Errors: nil,
+															Comments: nil,
+															Errors:   nil,
 															Loc: &ast.SourceLocation{
 																End: ast.Position{
 																	Column: 34,
@@ -10048,10 +11199,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																	Line:   423,
 																},
 															},
+															TrailingComment: nil,
 														},
 														Key: &ast.Identifier{
 															BaseNode: ast.BaseNode{
-																Errors: nil,
+																Comments: nil,
+																Errors:   nil,
 																Loc: &ast.SourceLocation{
 																	End: ast.Position{
 																		Column: 16,
@@ -10064,12 +11217,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																		Line:   423,
 																	},
 																},
+																TrailingComment: nil,
 															},
 															Name: "start",
 														},
 														Value: &ast.MemberExpression{
 															BaseNode: ast.BaseNode{
-																Errors: nil,
+																Comments: nil,
+																Errors:   nil,
 																Loc: &ast.SourceLocation{
 																	End: ast.Position{
 																		Column: 34,
@@ -10082,10 +11237,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																		Line:   423,
 																	},
 																},
+																TrailingComment: nil,
 															},
 															Object: &ast.Identifier{
 																BaseNode: ast.BaseNode{
-																	Errors: nil,
+																	Comments: nil,
+																	Errors:   nil,
 																	Loc: &ast.SourceLocation{
 																		End: ast.Position{
 																			Column: 26,
@@ -10098,12 +11255,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																			Line:   423,
 																		},
 																	},
+																	TrailingComment: nil,
 																},
 																Name: "influxql",
 															},
 															Property: &ast.Identifier{
 																BaseNode: ast.BaseNode{
-																	Errors: nil,
+																	Comments: nil,
+																	Errors:   nil,
 																	Loc: &ast.SourceLocation{
 																		End: ast.Position{
 																			Column: 34,
@@ -10116,13 +11275,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																			Line:   423,
 																		},
 																	},
+																	TrailingComment: nil,
 																},
 																Name: "minTime",
 															},
 														},
 													}, &ast.Property{
 														BaseNode: ast.BaseNode{
-															Errors: nil,
+															Comments: nil,
+															Errors:   nil,
 															Loc: &ast.SourceLocation{
 																End: ast.Position{
 																	Column: 58,
@@ -10135,10 +11296,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																	Line:   423,
 																},
 															},
+															TrailingComment: nil,
 														},
 														Key: &ast.Identifier{
 															BaseNode: ast.BaseNode{
-																Errors: nil,
+																Comments: nil,
+																Errors:   nil,
 																Loc: &ast.SourceLocation{
 																	End: ast.Position{
 																		Column: 40,
@@ -10151,12 +11314,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																		Line:   423,
 																	},
 																},
+																TrailingComment: nil,
 															},
 															Name: "stop",
 														},
 														Value: &ast.MemberExpression{
 															BaseNode: ast.BaseNode{
-																Errors: nil,
+																Comments: nil,
+																Errors:   nil,
 																Loc: &ast.SourceLocation{
 																	End: ast.Position{
 																		Column: 58,
@@ -10169,10 +11334,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																		Line:   423,
 																	},
 																},
+																TrailingComment: nil,
 															},
 															Object: &ast.Identifier{
 																BaseNode: ast.BaseNode{
-																	Errors: nil,
+																	Comments: nil,
+																	Errors:   nil,
 																	Loc: &ast.SourceLocation{
 																		End: ast.Position{
 																			Column: 50,
@@ -10185,12 +11352,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																			Line:   423,
 																		},
 																	},
+																	TrailingComment: nil,
 																},
 																Name: "influxql",
 															},
 															Property: &ast.Identifier{
 																BaseNode: ast.BaseNode{
-																	Errors: nil,
+																	Comments: nil,
+																	Errors:   nil,
 																	Loc: &ast.SourceLocation{
 																		End: ast.Position{
 																			Column: 58,
@@ -10203,6 +11372,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																			Line:   423,
 																		},
 																	},
+																	TrailingComment: nil,
 																},
 																Name: "maxTime",
 															},
@@ -10211,7 +11381,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													With: nil,
 												}},
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 59,
@@ -10224,10 +11395,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   423,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Callee: &ast.Identifier{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 10,
@@ -10240,13 +11413,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																Line:   423,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Name: "range",
 												},
 											},
 										},
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 45,
@@ -10259,11 +11434,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   422,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Call: &ast.CallExpression{
 											Arguments: []ast.Expression{&ast.ObjectExpression{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 44,
@@ -10276,10 +11453,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   424,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Properties: []*ast.Property{&ast.Property{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 44,
@@ -10292,10 +11471,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																Line:   424,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Key: &ast.Identifier{
 														BaseNode: ast.BaseNode{
-															Errors: nil,
+															Comments: nil,
+															Errors:   nil,
 															Loc: &ast.SourceLocation{
 																End: ast.Position{
 																	Column: 14,
@@ -10308,12 +11489,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																	Line:   424,
 																},
 															},
+															TrailingComment: nil,
 														},
 														Name: "fn",
 													},
 													Value: &ast.FunctionExpression{
 														BaseNode: ast.BaseNode{
-															Errors: nil,
+															Comments: nil,
+															Errors:   nil,
 															Loc: &ast.SourceLocation{
 																End: ast.Position{
 																	Column: 44,
@@ -10326,10 +11509,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																	Line:   424,
 																},
 															},
+															TrailingComment: nil,
 														},
 														Body: &ast.BinaryExpression{
 															BaseNode: ast.BaseNode{
-																Errors: nil,
+																Comments: nil,
+																Errors:   nil,
 																Loc: &ast.SourceLocation{
 																	End: ast.Position{
 																		Column: 44,
@@ -10342,10 +11527,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																		Line:   424,
 																	},
 																},
+																TrailingComment: nil,
 															},
 															Left: &ast.MemberExpression{
 																BaseNode: ast.BaseNode{
-																	Errors: nil,
+																	Comments: nil,
+																	Errors:   nil,
 																	Loc: &ast.SourceLocation{
 																		End: ast.Position{
 																			Column: 37,
@@ -10358,10 +11545,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																			Line:   424,
 																		},
 																	},
+																	TrailingComment: nil,
 																},
 																Object: &ast.Identifier{
 																	BaseNode: ast.BaseNode{
-																		Errors: nil,
+																		Comments: nil,
+																		Errors:   nil,
 																		Loc: &ast.SourceLocation{
 																			End: ast.Position{
 																				Column: 24,
@@ -10374,12 +11563,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																				Line:   424,
 																			},
 																		},
+																		TrailingComment: nil,
 																	},
 																	Name: "r",
 																},
 																Property: &ast.Identifier{
 																	BaseNode: ast.BaseNode{
-																		Errors: nil,
+																		Comments: nil,
+																		Errors:   nil,
 																		Loc: &ast.SourceLocation{
 																			End: ast.Position{
 																				Column: 37,
@@ -10392,6 +11583,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																				Line:   424,
 																			},
 																		},
+																		TrailingComment: nil,
 																	},
 																	Name: "_measurement",
 																},
@@ -10399,7 +11591,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Operator: 17,
 															Right: &ast.StringLiteral{
 																BaseNode: ast.BaseNode{
-																	Errors: nil,
+																	Comments: nil,
+																	Errors:   nil,
 																	Loc: &ast.SourceLocation{
 																		End: ast.Position{
 																			Column: 44,
@@ -10412,13 +11605,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																			Line:   424,
 																		},
 																	},
+																	TrailingComment: nil,
 																},
 																Value: "m",
 															},
 														},
 														Params: []*ast.Property{&ast.Property{
 															BaseNode: ast.BaseNode{
-																Errors: nil,
+																Comments: nil,
+																Errors:   nil,
 																Loc: &ast.SourceLocation{
 																	End: ast.Position{
 																		Column: 18,
@@ -10431,10 +11626,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																		Line:   424,
 																	},
 																},
+																TrailingComment: nil,
 															},
 															Key: &ast.Identifier{
 																BaseNode: ast.BaseNode{
-																	Errors: nil,
+																	Comments: nil,
+																	Errors:   nil,
 																	Loc: &ast.SourceLocation{
 																		End: ast.Position{
 																			Column: 18,
@@ -10447,6 +11644,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																			Line:   424,
 																		},
 																	},
+																	TrailingComment: nil,
 																},
 																Name: "r",
 															},
@@ -10457,7 +11655,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												With: nil,
 											}},
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 45,
@@ -10470,10 +11669,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   424,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Callee: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 11,
@@ -10486,13 +11687,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   424,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "filter",
 											},
 										},
 									},
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 39,
@@ -10505,11 +11708,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   422,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Call: &ast.CallExpression{
 										Arguments: []ast.Expression{&ast.ObjectExpression{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 38,
@@ -10522,10 +11727,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   425,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Properties: []*ast.Property{&ast.Property{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 38,
@@ -10538,10 +11745,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   425,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Key: &ast.Identifier{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 14,
@@ -10554,12 +11763,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																Line:   425,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Name: "fn",
 												},
 												Value: &ast.FunctionExpression{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 38,
@@ -10572,10 +11783,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																Line:   425,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Body: &ast.BinaryExpression{
 														BaseNode: ast.BaseNode{
-															Errors: nil,
+															Comments: nil,
+															Errors:   nil,
 															Loc: &ast.SourceLocation{
 																End: ast.Position{
 																	Column: 38,
@@ -10588,10 +11801,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																	Line:   425,
 																},
 															},
+															TrailingComment: nil,
 														},
 														Left: &ast.MemberExpression{
 															BaseNode: ast.BaseNode{
-																Errors: nil,
+																Comments: nil,
+																Errors:   nil,
 																Loc: &ast.SourceLocation{
 																	End: ast.Position{
 																		Column: 31,
@@ -10604,10 +11819,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																		Line:   425,
 																	},
 																},
+																TrailingComment: nil,
 															},
 															Object: &ast.Identifier{
 																BaseNode: ast.BaseNode{
-																	Errors: nil,
+																	Comments: nil,
+																	Errors:   nil,
 																	Loc: &ast.SourceLocation{
 																		End: ast.Position{
 																			Column: 24,
@@ -10620,12 +11837,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																			Line:   425,
 																		},
 																	},
+																	TrailingComment: nil,
 																},
 																Name: "r",
 															},
 															Property: &ast.Identifier{
 																BaseNode: ast.BaseNode{
-																	Errors: nil,
+																	Comments: nil,
+																	Errors:   nil,
 																	Loc: &ast.SourceLocation{
 																		End: ast.Position{
 																			Column: 31,
@@ -10638,6 +11857,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																			Line:   425,
 																		},
 																	},
+																	TrailingComment: nil,
 																},
 																Name: "_field",
 															},
@@ -10645,7 +11865,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Operator: 17,
 														Right: &ast.StringLiteral{
 															BaseNode: ast.BaseNode{
-																Errors: nil,
+																Comments: nil,
+																Errors:   nil,
 																Loc: &ast.SourceLocation{
 																	End: ast.Position{
 																		Column: 38,
@@ -10658,13 +11879,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																		Line:   425,
 																	},
 																},
+																TrailingComment: nil,
 															},
 															Value: "f",
 														},
 													},
 													Params: []*ast.Property{&ast.Property{
 														BaseNode: ast.BaseNode{
-															Errors: nil,
+															Comments: nil,
+															Errors:   nil,
 															Loc: &ast.SourceLocation{
 																End: ast.Position{
 																	Column: 18,
@@ -10677,10 +11900,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																	Line:   425,
 																},
 															},
+															TrailingComment: nil,
 														},
 														Key: &ast.Identifier{
 															BaseNode: ast.BaseNode{
-																Errors: nil,
+																Comments: nil,
+																Errors:   nil,
 																Loc: &ast.SourceLocation{
 																	End: ast.Position{
 																		Column: 18,
@@ -10693,6 +11918,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																		Line:   425,
 																	},
 																},
+																TrailingComment: nil,
 															},
 															Name: "r",
 														},
@@ -10703,7 +11929,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											With: nil,
 										}},
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 39,
@@ -10716,10 +11943,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   425,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Callee: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 11,
@@ -10732,13 +11961,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   425,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "filter",
 										},
 									},
 								},
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 47,
@@ -10751,11 +11982,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   422,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Call: &ast.CallExpression{
 									Arguments: []ast.Expression{&ast.ObjectExpression{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 46,
@@ -10768,10 +12001,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   426,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Properties: []*ast.Property{&ast.Property{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 46,
@@ -10784,10 +12019,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   426,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Key: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 18,
@@ -10800,12 +12037,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   426,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "columns",
 											},
 											Value: &ast.ArrayExpression{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 46,
@@ -10818,10 +12057,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   426,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Elements: []ast.Expression{&ast.StringLiteral{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 35,
@@ -10834,11 +12075,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																Line:   426,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Value: "_measurement",
 												}, &ast.StringLiteral{
 													BaseNode: ast.BaseNode{
-														Errors: nil,
+														Comments: nil,
+														Errors:   nil,
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 45,
@@ -10851,6 +12094,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 																Line:   426,
 															},
 														},
+														TrailingComment: nil,
 													},
 													Value: "_field",
 												}},
@@ -10859,7 +12103,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										With: nil,
 									}},
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 47,
@@ -10872,10 +12117,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   426,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Callee: &ast.Identifier{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 10,
@@ -10888,13 +12135,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   426,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Name: "group",
 									},
 								},
 							},
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 10,
@@ -10907,11 +12156,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										Line:   422,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Call: &ast.CallExpression{
 								Arguments: nil,
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 10,
@@ -10924,10 +12175,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   427,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Callee: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 8,
@@ -10940,13 +12193,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   427,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "max",
 								},
 							},
 						},
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 55,
@@ -10959,11 +12214,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Line:   422,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Call: &ast.CallExpression{
 							Arguments: []ast.Expression{&ast.ObjectExpression{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 54,
@@ -10976,10 +12233,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   428,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Properties: []*ast.Property{&ast.Property{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 54,
@@ -10992,10 +12251,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   428,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Key: &ast.Identifier{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 17,
@@ -11008,12 +12269,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   428,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Name: "columns",
 									},
 									Value: &ast.ArrayExpression{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 54,
@@ -11026,10 +12289,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   428,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Elements: []ast.Expression{&ast.StringLiteral{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 27,
@@ -11042,11 +12307,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   428,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Value: "_time",
 										}, &ast.StringLiteral{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 37,
@@ -11059,11 +12326,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   428,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Value: "_value",
 										}, &ast.StringLiteral{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 53,
@@ -11076,6 +12345,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   428,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Value: "_measurement",
 										}},
@@ -11084,7 +12354,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								With: nil,
 							}},
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 55,
@@ -11097,10 +12368,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										Line:   428,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Callee: &ast.Identifier{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 9,
@@ -11113,13 +12386,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   428,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Name: "keep",
 							},
 						},
 					},
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 52,
@@ -11132,11 +12407,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   422,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Call: &ast.CallExpression{
 						Arguments: []ast.Expression{&ast.ObjectExpression{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 51,
@@ -11149,10 +12426,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										Line:   429,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Properties: []*ast.Property{&ast.Property{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 51,
@@ -11165,10 +12444,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   429,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Key: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 19,
@@ -11181,12 +12462,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   429,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "columns",
 								},
 								Value: &ast.ObjectExpression{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 51,
@@ -11199,10 +12482,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   429,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Properties: []*ast.Property{&ast.Property{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 35,
@@ -11215,10 +12500,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   429,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Key: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 27,
@@ -11231,12 +12518,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   429,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "_time",
 										},
 										Value: &ast.StringLiteral{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 35,
@@ -11249,12 +12538,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   429,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Value: "time",
 										},
 									}, &ast.Property{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 50,
@@ -11267,10 +12558,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   429,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Key: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 43,
@@ -11283,12 +12576,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   429,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "_value",
 										},
 										Value: &ast.StringLiteral{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 50,
@@ -11301,6 +12596,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   429,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Value: "max",
 										},
@@ -11311,7 +12607,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							With: nil,
 						}},
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 52,
@@ -11324,10 +12621,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Line:   429,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Callee: &ast.Identifier{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 11,
@@ -11340,6 +12639,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										Line:   429,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Name: "rename",
 						},
@@ -11347,7 +12647,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 				},
 				Params: []*ast.Property{&ast.Property{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 24,
@@ -11360,10 +12661,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   422,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Key: &ast.Identifier{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 21,
@@ -11376,11 +12679,13 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Line:   422,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Name: "tables",
 					},
 					Value: &ast.PipeLiteral{BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 24,
@@ -11393,13 +12698,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   422,
 							},
 						},
+						TrailingComment: nil,
 					}},
 				}},
 			},
 		}, &ast.TestStatement{
 			Assignment: &ast.VariableAssignment{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 3,
@@ -11412,10 +12719,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   431,
 						},
 					},
+					TrailingComment: nil,
 				},
 				ID: &ast.Identifier{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 15,
@@ -11428,12 +12737,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   431,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Name: "_selector",
 				},
 				Init: &ast.FunctionExpression{
 					BaseNode: ast.BaseNode{
-						Errors: nil,
+						Comments: nil,
+						Errors:   nil,
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 3,
@@ -11446,10 +12757,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								Line:   431,
 							},
 						},
+						TrailingComment: nil,
 					},
 					Body: &ast.ParenExpression{
 						BaseNode: ast.BaseNode{
-							Errors: nil,
+							Comments: nil,
+							Errors:   nil,
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 3,
@@ -11462,10 +12775,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 									Line:   431,
 								},
 							},
+							TrailingComment: nil,
 						},
 						Expression: &ast.ObjectExpression{
 							BaseNode: ast.BaseNode{
-								Errors: nil,
+								Comments: nil,
+								Errors:   nil,
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 2,
@@ -11478,10 +12793,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										Line:   431,
 									},
 								},
+								TrailingComment: nil,
 							},
 							Properties: []*ast.Property{&ast.Property{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 41,
@@ -11494,10 +12811,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   432,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Key: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 7,
@@ -11510,13 +12829,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   432,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "input",
 								},
 								Value: &ast.CallExpression{
 									Arguments: []ast.Expression{&ast.ObjectExpression{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 40,
@@ -11529,10 +12850,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   432,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Properties: []*ast.Property{&ast.Property{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 40,
@@ -11545,10 +12868,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   432,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Key: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 32,
@@ -11561,12 +12886,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   432,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "csv",
 											},
 											Value: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 40,
@@ -11579,6 +12906,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   432,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "inData",
 											},
@@ -11586,7 +12914,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										With: nil,
 									}},
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 41,
@@ -11599,10 +12928,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   432,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Callee: &ast.MemberExpression{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 28,
@@ -11615,10 +12946,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   432,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Object: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 16,
@@ -11631,12 +12964,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   432,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "testing",
 										},
 										Property: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 28,
@@ -11649,6 +12984,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   432,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "loadStorage",
 										},
@@ -11656,7 +12992,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								},
 							}, &ast.Property{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 37,
@@ -11669,10 +13006,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   433,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Key: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 6,
@@ -11685,13 +13024,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   433,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "want",
 								},
 								Value: &ast.CallExpression{
 									Arguments: []ast.Expression{&ast.ObjectExpression{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 36,
@@ -11704,10 +13045,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   433,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Properties: []*ast.Property{&ast.Property{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 36,
@@ -11720,10 +13063,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   433,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Key: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 27,
@@ -11736,12 +13081,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   433,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "csv",
 											},
 											Value: &ast.Identifier{
 												BaseNode: ast.BaseNode{
-													Errors: nil,
+													Comments: nil,
+													Errors:   nil,
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 36,
@@ -11754,6 +13101,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 															Line:   433,
 														},
 													},
+													TrailingComment: nil,
 												},
 												Name: "outData",
 											},
@@ -11761,7 +13109,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 										With: nil,
 									}},
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 37,
@@ -11774,10 +13123,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   433,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Callee: &ast.MemberExpression{
 										BaseNode: ast.BaseNode{
-											Errors: nil,
+											Comments: nil,
+											Errors:   nil,
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 23,
@@ -11790,10 +13141,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 													Line:   433,
 												},
 											},
+											TrailingComment: nil,
 										},
 										Object: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 15,
@@ -11806,12 +13159,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   433,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "testing",
 										},
 										Property: &ast.Identifier{
 											BaseNode: ast.BaseNode{
-												Errors: nil,
+												Comments: nil,
+												Errors:   nil,
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 23,
@@ -11824,6 +13179,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 														Line:   433,
 													},
 												},
+												TrailingComment: nil,
 											},
 											Name: "loadMem",
 										},
@@ -11831,7 +13187,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 								},
 							}, &ast.Property{
 								BaseNode: ast.BaseNode{
-									Errors: nil,
+									Comments: nil,
+									Errors:   nil,
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 16,
@@ -11844,10 +13201,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 											Line:   434,
 										},
 									},
+									TrailingComment: nil,
 								},
 								Key: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 4,
@@ -11860,12 +13219,14 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   434,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "fn",
 								},
 								Value: &ast.Identifier{
 									BaseNode: ast.BaseNode{
-										Errors: nil,
+										Comments: nil,
+										Errors:   nil,
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 16,
@@ -11878,6 +13239,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 												Line:   434,
 											},
 										},
+										TrailingComment: nil,
 									},
 									Name: "t_selector",
 								},
@@ -11889,7 +13251,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 				},
 			},
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 3,
@@ -11902,12 +13265,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   431,
 					},
 				},
+				TrailingComment: nil,
 			},
 		}},
+		Eof: nil,
 		Imports: []*ast.ImportDeclaration{&ast.ImportDeclaration{
 			As: nil,
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 17,
@@ -11920,10 +13286,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   3,
 					},
 				},
+				TrailingComment: nil,
 			},
 			Path: &ast.StringLiteral{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 17,
@@ -11936,13 +13304,15 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   3,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Value: "testing",
 			},
 		}, &ast.ImportDeclaration{
 			As: nil,
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 27,
@@ -11955,10 +13325,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   4,
 					},
 				},
+				TrailingComment: nil,
 			},
 			Path: &ast.StringLiteral{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 27,
@@ -11971,6 +13343,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   4,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Value: "internal/influxql",
 			},
@@ -11979,7 +13352,8 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 		Name:     "selector_test.flux",
 		Package: &ast.PackageClause{
 			BaseNode: ast.BaseNode{
-				Errors: nil,
+				Comments: nil,
+				Errors:   nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 22,
@@ -11992,10 +13366,12 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 						Line:   1,
 					},
 				},
+				TrailingComment: nil,
 			},
 			Name: &ast.Identifier{
 				BaseNode: ast.BaseNode{
-					Errors: nil,
+					Comments: nil,
+					Errors:   nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 22,
@@ -12008,6 +13384,7 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 							Line:   1,
 						},
 					},
+					TrailingComment: nil,
 				},
 				Name: "influxql_test",
 			},
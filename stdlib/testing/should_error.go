@@ -0,0 +1,244 @@
+package testing
+
+import (
+	"context"
+	"time"
+
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/codes"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/internal/errors"
+	"github.com/influxdata/flux/interpreter"
+	"github.com/influxdata/flux/lang"
+	"github.com/influxdata/flux/memory"
+	"github.com/influxdata/flux/plan"
+	"github.com/influxdata/flux/semantic"
+	"github.com/influxdata/flux/values"
+)
+
+const ShouldErrorKind = "shouldError"
+
+const (
+	shouldErrorFnArg   = "fn"
+	shouldErrorWantArg = "want"
+	shouldErrorCodeArg = "code"
+)
+
+func init() {
+	flux.RegisterPackageValue("testing", ShouldErrorKind, NewShouldErrorFunction())
+	flux.RegisterOpSpec(ShouldErrorKind, newShouldErrorOp)
+	plan.RegisterProcedureSpec(ShouldErrorKind, newShouldErrorProcedure, ShouldErrorKind)
+	execute.RegisterSource(ShouldErrorKind, createShouldErrorSource)
+}
+
+// NewShouldErrorFunction returns the testing.shouldError function.
+// It calls fn and, when fn returns a table stream, executes it.
+// The function fails unless an error occurs whose message matches want
+// and, when code is not empty, whose code is the named codes.Code.
+// It returns a table stream with a single row that holds
+// the message and code of the error.
+func NewShouldErrorFunction() values.Value {
+	return values.NewFunction(ShouldErrorKind,
+		semantic.NewFunctionPolyType(semantic.FunctionPolySignature{
+			Parameters: map[string]semantic.PolyType{
+				shouldErrorFnArg: semantic.NewFunctionPolyType(semantic.FunctionPolySignature{
+					Return: semantic.Tvar(1),
+				}),
+				shouldErrorWantArg: semantic.Regexp,
+				shouldErrorCodeArg: semantic.String,
+			},
+			Required: semantic.LabelSet{shouldErrorFnArg, shouldErrorWantArg},
+			Return:   flux.TableObjectType,
+		}),
+		shouldErrorCall,
+		false)
+}
+
+func shouldErrorCall(ctx context.Context, args values.Object) (values.Value, error) {
+	arguments := interpreter.NewArguments(args)
+	fn, err := arguments.GetRequiredFunction(shouldErrorFnArg)
+	if err != nil {
+		return nil, err
+	}
+	want, err := arguments.GetRequired(shouldErrorWantArg)
+	if err != nil {
+		return nil, err
+	} else if want.Type() != semantic.Regexp {
+		return nil, errors.Newf(codes.Invalid, "unexpected type for %v: want %v, got %v", shouldErrorWantArg, semantic.Regexp, want.Type())
+	}
+	code, _, err := arguments.GetString(shouldErrorCodeArg)
+	if err != nil {
+		return nil, err
+	}
+
+	got := captureError(ctx, fn)
+	if got == nil {
+		return nil, errors.Newf(codes.Aborted, "expected an error matching %v, but no error occurred", want.Regexp())
+	}
+	if !want.Regexp().MatchString(got.Error()) {
+		return nil, errors.Newf(codes.Aborted, "expected an error matching %v, got: %v", want.Regexp(), got)
+	}
+	gotCode := errors.Code(got)
+	if code != "" && gotCode.String() != code {
+		return nil, errors.Newf(codes.Aborted, "expected an error with code %s, got code %s: %v", code, gotCode, got)
+	}
+
+	return shouldErrorResult.Function().Call(ctx, values.NewObjectWithValues(map[string]values.Value{
+		"error": values.NewString(got.Error()),
+		"code":  values.NewString(gotCode.String()),
+	}))
+}
+
+// captureError calls fn and returns the error that occurred while evaluating it.
+// A table stream that is returned by fn is executed so errors
+// that occur while compiling or running the query are returned too.
+func captureError(ctx context.Context, fn values.Function) error {
+	v, err := fn.Call(ctx, nil)
+	if err != nil {
+		return err
+	}
+	to, ok := v.(*flux.TableObject)
+	if !ok {
+		return nil
+	}
+
+	c := lang.TableObjectCompiler{
+		Tables: to,
+		Now:    time.Now(),
+	}
+	p, err := c.Compile(ctx)
+	if err != nil {
+		return err
+	}
+	deps := lang.GetExecutionDependencies(ctx)
+	if p, ok := p.(lang.LoggingProgram); ok {
+		p.SetLogger(deps.Logger)
+	}
+	q, err := p.Start(ctx, deps.Allocator)
+	if err != nil {
+		return err
+	}
+	defer q.Done()
+
+	for res := range q.Results() {
+		if err := res.Tables().Do(func(tbl flux.Table) error {
+			return tbl.Do(func(flux.ColReader) error { return nil })
+		}); err != nil {
+			return err
+		}
+	}
+	q.Done()
+	return q.Err()
+}
+
+// shouldErrorResult creates the table stream that is returned
+// by testing.shouldError. It is not exposed as a package value.
+var shouldErrorResult = flux.FunctionValue(ShouldErrorKind, createShouldErrorOpSpec, semantic.FunctionPolySignature{
+	Parameters: map[string]semantic.PolyType{
+		"error": semantic.String,
+		"code":  semantic.String,
+	},
+	Required: semantic.LabelSet{"error", "code"},
+	Return:   flux.TableObjectType,
+})
+
+type ShouldErrorOpSpec struct {
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+func (s *ShouldErrorOpSpec) Kind() flux.OperationKind {
+	return ShouldErrorKind
+}
+
+func createShouldErrorOpSpec(args flux.Arguments, a *flux.Administration) (flux.OperationSpec, error) {
+	spec := new(ShouldErrorOpSpec)
+	var err error
+	if spec.Error, err = args.GetRequiredString("error"); err != nil {
+		return nil, err
+	}
+	if spec.Code, err = args.GetRequiredString("code"); err != nil {
+		return nil, err
+	}
+	return spec, nil
+}
+
+func newShouldErrorOp() flux.OperationSpec {
+	return new(ShouldErrorOpSpec)
+}
+
+type ShouldErrorProcedureSpec struct {
+	plan.DefaultCost
+	Error string
+	Code  string
+}
+
+func (s *ShouldErrorProcedureSpec) Kind() plan.ProcedureKind {
+	return ShouldErrorKind
+}
+
+func (s *ShouldErrorProcedureSpec) Copy() plan.ProcedureSpec {
+	ns := *s
+	return &ns
+}
+
+func newShouldErrorProcedure(qs flux.OperationSpec, pa plan.Administration) (plan.ProcedureSpec, error) {
+	spec, ok := qs.(*ShouldErrorOpSpec)
+	if !ok {
+		return nil, errors.Newf(codes.Internal, "invalid spec type %T", qs)
+	}
+	return &ShouldErrorProcedureSpec{
+		Error: spec.Error,
+		Code:  spec.Code,
+	}, nil
+}
+
+func createShouldErrorSource(prSpec plan.ProcedureSpec, dsid execute.DatasetID, a execute.Administration) (execute.Source, error) {
+	spec, ok := prSpec.(*ShouldErrorProcedureSpec)
+	if !ok {
+		return nil, errors.Newf(codes.Internal, "invalid spec type %T", prSpec)
+	}
+	return execute.CreateSourceFromDecoder(&shouldErrorDecoder{
+		spec:  spec,
+		alloc: a.Allocator(),
+	}, dsid, a)
+}
+
+// shouldErrorDecoder produces a single table with the error that was captured.
+type shouldErrorDecoder struct {
+	spec  *ShouldErrorProcedureSpec
+	alloc *memory.Allocator
+	done  bool
+}
+
+func (d *shouldErrorDecoder) Connect(ctx context.Context) error {
+	return nil
+}
+
+func (d *shouldErrorDecoder) Fetch(ctx context.Context) (bool, error) {
+	return !d.done, nil
+}
+
+func (d *shouldErrorDecoder) Decode(ctx context.Context) (flux.Table, error) {
+	d.done = true
+	b := execute.NewColListTableBuilder(execute.NewGroupKey(nil, nil), d.alloc)
+	for _, c := range []flux.ColMeta{
+		{Label: "code", Type: flux.TString},
+		{Label: "error", Type: flux.TString},
+	} {
+		if _, err := b.AddCol(c); err != nil {
+			return nil, err
+		}
+	}
+	if err := b.AppendString(0, d.spec.Code); err != nil {
+		return nil, err
+	}
+	if err := b.AppendString(1, d.spec.Error); err != nil {
+		return nil, err
+	}
+	return b.Table()
+}
+
+func (d *shouldErrorDecoder) Close() error {
+	return nil
+}
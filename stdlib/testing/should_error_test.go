@@ -0,0 +1,96 @@
+package testing_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/influxdata/flux"
+	_ "github.com/influxdata/flux/builtin"
+	"github.com/influxdata/flux/execute/executetest"
+	"github.com/influxdata/flux/lang"
+	"github.com/influxdata/flux/memory"
+)
+
+const shouldErrorData = `
+import "csv"
+import "testing"
+
+data = "
+#datatype,string,long,dateTime:RFC3339,double,string
+#group,false,false,false,false,true
+#default,_result,,,,
+,result,table,_time,x,_measurement
+,,0,2018-05-22T19:53:26Z,0,cpu
+,,0,2018-05-22T19:53:36Z,2,cpu
+"
+`
+
+func TestShouldError(t *testing.T) {
+	testCases := []struct {
+		name    string
+		script  string
+		wantErr string
+	}{
+		{
+			name:   "runtime error",
+			script: `testing.shouldError(fn: () => csv.from(csv: data) |> covariance(columns: ["x", "y"]), want: /column does not exist/)`,
+		},
+		{
+			name:   "runtime error with code",
+			script: `testing.shouldError(fn: () => csv.from(csv: data) |> rename(columns: {y: "z"}), want: /doesn't exist/, code: "failed precondition")`,
+		},
+		{
+			name:   "function call error",
+			script: `testing.shouldError(fn: () => csv.from(csv: data) |> covariance(columns: ["x"]), want: /exactly two columns/, code: "invalid")`,
+		},
+		{
+			name:    "no error",
+			script:  `testing.shouldError(fn: () => csv.from(csv: data) |> count(column: "x"), want: /column/)`,
+			wantErr: "expected an error matching column, but no error occurred",
+		},
+		{
+			name:    "unexpected message",
+			script:  `testing.shouldError(fn: () => csv.from(csv: data) |> covariance(columns: ["x", "y"]), want: /^unknown$/)`,
+			wantErr: "expected an error matching ^unknown$, got: specified column does not exist in table: y",
+		},
+		{
+			name:    "unexpected code",
+			script:  `testing.shouldError(fn: () => csv.from(csv: data) |> covariance(columns: ["x"]), want: /two columns/, code: "internal")`,
+			wantErr: "expected an error with code internal, got code invalid",
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			program, err := lang.Compile(shouldErrorData+tc.script, time.Now())
+			if err != nil {
+				t.Fatal(err)
+			}
+			ctx := executetest.NewTestExecuteDependencies().Inject(context.Background())
+			q, err := program.Start(ctx, &memory.Allocator{})
+			if err == nil {
+				for res := range q.Results() {
+					if err := res.Tables().Do(func(flux.Table) error { return nil }); err != nil {
+						t.Fatal(err)
+					}
+				}
+				q.Done()
+				err = q.Err()
+			}
+
+			if tc.wantErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatalf("expected error %q", tc.wantErr)
+			} else if !strings.Contains(err.Error(), tc.wantErr) {
+				t.Fatalf("unexpected error -want/+got:\n\t- %s\n\t+ %s", tc.wantErr, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,150 @@
+package testing
+
+import (
+	"encoding/json"
+
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/codes"
+	"github.com/influxdata/flux/dependencies/sideeffect"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/internal/errors"
+	"github.com/influxdata/flux/plan"
+)
+
+const SideEffectsKind = "sideEffects"
+
+type SideEffectsOpSpec struct{}
+
+func (s *SideEffectsOpSpec) Kind() flux.OperationKind {
+	return SideEffectsKind
+}
+
+func init() {
+	sideEffectsSignature := flux.FunctionSignature(nil, nil)
+
+	flux.RegisterPackageValue("testing", SideEffectsKind, flux.FunctionValue(SideEffectsKind, createSideEffectsOpSpec, sideEffectsSignature))
+	flux.RegisterOpSpec(SideEffectsKind, newSideEffectsOp)
+	plan.RegisterProcedureSpec(SideEffectsKind, newSideEffectsProcedure, SideEffectsKind)
+	execute.RegisterTransformation(SideEffectsKind, createSideEffectsTransformation)
+}
+
+func createSideEffectsOpSpec(args flux.Arguments, a *flux.Administration) (flux.OperationSpec, error) {
+	if err := a.AddParentFromArgs(args); err != nil {
+		return nil, err
+	}
+	return &SideEffectsOpSpec{}, nil
+}
+
+func newSideEffectsOp() flux.OperationSpec {
+	return new(SideEffectsOpSpec)
+}
+
+type SideEffectsProcedureSpec struct {
+	plan.DefaultCost
+}
+
+func (s *SideEffectsProcedureSpec) Kind() plan.ProcedureKind {
+	return SideEffectsKind
+}
+
+func (s *SideEffectsProcedureSpec) Copy() plan.ProcedureSpec {
+	ns := *s
+	return &ns
+}
+
+func newSideEffectsProcedure(qs flux.OperationSpec, pa plan.Administration) (plan.ProcedureSpec, error) {
+	if _, ok := qs.(*SideEffectsOpSpec); !ok {
+		return nil, errors.Newf(codes.Internal, "invalid spec type %T", qs)
+	}
+	return &SideEffectsProcedureSpec{}, nil
+}
+
+func createSideEffectsTransformation(id execute.DatasetID, mode execute.AccumulationMode, spec plan.ProcedureSpec, a execute.Administration) (execute.Transformation, execute.Dataset, error) {
+	if _, ok := spec.(*SideEffectsProcedureSpec); !ok {
+		return nil, nil, errors.Newf(codes.Internal, "invalid spec type %T", spec)
+	}
+	r := sideeffect.FromContext(a.Context())
+	if r == nil {
+		return nil, nil, errors.New(codes.FailedPrecondition, "side effects are only recorded when running tests")
+	}
+	cache := execute.NewTableBuilderCache(a.Allocator())
+	dataset := execute.NewDataset(id, mode, cache)
+	transform := NewSideEffectsTransformation(dataset, cache, r)
+	return transform, dataset, nil
+}
+
+// SideEffectsTransformation consumes its input and, once the input
+// is finished, produces a table with the side effects that were recorded.
+// Each row holds the kind, method, target, headers, data and args of an effect.
+// The headers and args are encoded as JSON.
+type SideEffectsTransformation struct {
+	d        execute.Dataset
+	cache    execute.TableBuilderCache
+	recorder *sideeffect.Recorder
+}
+
+func NewSideEffectsTransformation(d execute.Dataset, cache execute.TableBuilderCache, r *sideeffect.Recorder) *SideEffectsTransformation {
+	return &SideEffectsTransformation{
+		d:        d,
+		cache:    cache,
+		recorder: r,
+	}
+}
+
+func (t *SideEffectsTransformation) RetractTable(id execute.DatasetID, key flux.GroupKey) error {
+	return t.d.RetractTable(key)
+}
+
+func (t *SideEffectsTransformation) Process(id execute.DatasetID, tbl flux.Table) error {
+	return tbl.Do(func(cr flux.ColReader) error {
+		return nil
+	})
+}
+
+func (t *SideEffectsTransformation) UpdateWatermark(id execute.DatasetID, mark execute.Time) error {
+	return t.d.UpdateWatermark(mark)
+}
+
+func (t *SideEffectsTransformation) UpdateProcessingTime(id execute.DatasetID, mark execute.Time) error {
+	return t.d.UpdateProcessingTime(mark)
+}
+
+func (t *SideEffectsTransformation) Finish(id execute.DatasetID, err error) {
+	if err == nil {
+		err = t.buildTable()
+	}
+	t.d.Finish(err)
+}
+
+func (t *SideEffectsTransformation) buildTable() error {
+	builder, _ := t.cache.TableBuilder(execute.NewGroupKey(nil, nil))
+	for _, label := range []string{"kind", "method", "target", "headers", "data", "args"} {
+		if _, err := builder.AddCol(flux.ColMeta{Label: label, Type: flux.TString}); err != nil {
+			return err
+		}
+	}
+	for _, e := range t.recorder.Effects() {
+		headers := e.Headers
+		if headers == nil {
+			headers = map[string]string{}
+		}
+		h, err := json.Marshal(headers)
+		if err != nil {
+			return err
+		}
+		args := e.Args
+		if args == nil {
+			args = []interface{}{}
+		}
+		a, err := json.Marshal(args)
+		if err != nil {
+			return err
+		}
+		for j, v := range []string{e.Kind, e.Method, e.Target, string(h), e.Data, string(a)} {
+			if err := builder.AppendString(j, v); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,171 @@
+package testing_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/dependencies/sideeffect"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/execute/executetest"
+	"github.com/influxdata/flux/lang"
+	"github.com/influxdata/flux/memory"
+)
+
+const sideEffectsData = `
+data = "
+#datatype,string,long,dateTime:RFC3339,double,string,string
+#group,false,false,false,false,true,true
+#default,_result,,,,,
+,result,table,_time,_value,_field,_measurement
+,,0,2018-05-22T19:53:26Z,1.5,usage,cpu
+"
+`
+
+func sideEffectsImports(pkgs ...string) string {
+	var sb strings.Builder
+	for _, pkg := range append([]string{"csv", "testing"}, pkgs...) {
+		sb.WriteString("import \"" + pkg + "\"\n")
+	}
+	return sb.String()
+}
+
+func TestSideEffects(t *testing.T) {
+	testCases := []struct {
+		name   string
+		pkg    string
+		script string
+		want   [][]string
+	}{
+		{
+			name: "http.post",
+			pkg:  "http",
+			script: `
+csv.from(csv: data)
+	|> map(fn: (r) => ({r with status: http.post(url: "http://example.com/a", headers: {"X-Test": "yes"}, data: bytes(v: "payload"))}))
+	|> testing.sideEffects()`,
+			want: [][]string{
+				{"http", "POST", "http://example.com/a", `{"X-Test":"yes"}`, "payload", "[]"},
+			},
+		},
+		{
+			name: "http.get",
+			pkg:  "experimental/http",
+			script: `
+csv.from(csv: data)
+	|> map(fn: (r) => ({r with status: http.get(url: "http://example.com/b").statusCode}))
+	|> testing.sideEffects()`,
+			want: [][]string{
+				{"http", "GET", "http://example.com/b", `{}`, "", "[]"},
+			},
+		},
+		{
+			name: "slack.message",
+			pkg:  "slack",
+			script: `
+csv.from(csv: data)
+	|> map(fn: (r) => ({r with status: slack.message(url: "http://slack.example.com", token: "t", username: "u", channel: "#c", workspace: "", text: "hi", iconEmoji: "", color: "good")}))
+	|> testing.sideEffects()`,
+			want: [][]string{
+				{"http", "POST", "http://slack.example.com", `{"Authorization":"Bearer t","Content-Type":"application/json"}`, `{"as_user":false,"attachments":[{"color":"good","mrkdwn_in":["text"],"text":"hi"}],"channel":"#c","icon_emoji":"","username":"u","workspace":""}`, "[]"},
+			},
+		},
+		{
+			name: "kafka.to",
+			pkg:  "kafka",
+			script: `
+csv.from(csv: data)
+	|> kafka.to(brokers: ["localhost:9092"], topic: "metrics", tagColumns: ["_field"])
+	|> testing.sideEffects()`,
+			want: [][]string{
+				{"kafka", "produce", "metrics", `{"key":"f54f8c07267791d2"}`, "cpu,_field=usage _value=1.5 1527018806000000000", "[]"},
+			},
+		},
+		{
+			name: "mqtt.to",
+			pkg:  "experimental/mqtt",
+			script: `
+csv.from(csv: data)
+	|> mqtt.to(broker: "tcp://localhost:1883", topic: "metrics", tagColumns: ["_field"], valueColumns: ["_value"])
+	|> testing.sideEffects()`,
+			want: [][]string{
+				{"mqtt", "publish", "metrics", `{"broker":"tcp://localhost:1883"}`, "cpu,_field=usage _value=1.5 1527018806000000000\n", "[]"},
+			},
+		},
+		{
+			name: "sql.to",
+			pkg:  "sql",
+			script: `
+csv.from(csv: data)
+	|> drop(columns: ["_field", "_measurement"])
+	|> sql.to(driverName: "sqlite3", dataSourceName: "file:test.db", table: "metrics")
+	|> testing.sideEffects()`,
+			want: [][]string{
+				{"sql", "exec", "sqlite3", `{}`, "CREATE TABLE IF NOT EXISTS metrics (_time DATETIME,_value FLOAT)", "[]"},
+				{"sql", "exec", "sqlite3", `{}`, "INSERT INTO metrics (_time,_value) VALUES (?,?)", `["2018-05-22T19:53:26Z",1.5]`},
+			},
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			program, err := lang.Compile(sideEffectsImports(tc.pkg)+sideEffectsData+tc.script, time.Now())
+			if err != nil {
+				t.Fatal(err)
+			}
+			ctx := executetest.NewTestExecuteDependencies().Inject(context.Background())
+			ctx = sideeffect.NewRecorder().Inject(ctx)
+			q, err := program.Start(ctx, &memory.Allocator{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer q.Done()
+
+			var got [][]string
+			for res := range q.Results() {
+				if err := res.Tables().Do(func(tbl flux.Table) error {
+					return tbl.Do(func(cr flux.ColReader) error {
+						for i := 0; i < cr.Len(); i++ {
+							row := make([]string, len(cr.Cols()))
+							for j := range cr.Cols() {
+								row[j] = execute.ValueForRow(cr, i, j).Str()
+							}
+							got = append(got, row)
+						}
+						return nil
+					})
+				}); err != nil {
+					t.Fatal(err)
+				}
+			}
+			q.Done()
+			if err := q.Err(); err != nil {
+				t.Fatal(err)
+			}
+
+			if len(got) != len(tc.want) {
+				t.Fatalf("unexpected number of side effects -want/+got:\n\t- %v\n\t+ %v", tc.want, got)
+			}
+			for i := range tc.want {
+				if strings.Join(got[i], "|") != strings.Join(tc.want[i], "|") {
+					t.Errorf("unexpected side effect -want/+got:\n\t- %q\n\t+ %q", tc.want[i], got[i])
+				}
+			}
+		})
+	}
+}
+
+func TestSideEffects_NotRecorded(t *testing.T) {
+	program, err := lang.Compile(sideEffectsImports()+sideEffectsData+`csv.from(csv: data) |> testing.sideEffects()`, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := executetest.NewTestExecuteDependencies().Inject(context.Background())
+	if _, err := program.Start(ctx, &memory.Allocator{}); err == nil {
+		t.Fatal("expected an error when side effects are not recorded")
+	} else if want := "side effects are only recorded when running tests"; !strings.Contains(err.Error(), want) {
+		t.Fatalf("unexpected error -want/+got:\n\t- %s\n\t+ %s", want, err)
+	}
+}
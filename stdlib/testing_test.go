@@ -0,0 +1,76 @@
+package stdlib_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/ast"
+	"github.com/influxdata/flux/parser"
+	"github.com/influxdata/flux/stdlib"
+)
+
+func TestTestingTypeError(t *testing.T) {
+	const prefix = `
+import "testing"
+
+`
+	testCases := []struct {
+		name    string
+		script  string
+		failed  bool
+		wantErr string
+	}{
+		{
+			name:   "no type error",
+			script: `test _limit = () => ({input: testing.loadMem(csv: ""), expectError: /int/, fn: (table=<-) => table |> limit(n: 1)})`,
+		},
+		{
+			name:   "expected",
+			script: `test _limit = () => ({input: testing.loadMem(csv: ""), expectError: /int != string/, expectCode: "invalid", fn: (table=<-) => table |> limit(n: 1 + "a")})`,
+			failed: true,
+		},
+		{
+			name:    "unexpected",
+			script:  `test _limit = () => ({input: testing.loadMem(csv: ""), want: testing.loadMem(csv: ""), fn: (table=<-) => table |> limit(n: 1 + "a")})`,
+			failed:  true,
+			wantErr: "int != string",
+		},
+		{
+			name:    "wrong message",
+			script:  `test _limit = () => ({input: testing.loadMem(csv: ""), expectError: /float/, fn: (table=<-) => table |> limit(n: 1 + "a")})`,
+			failed:  true,
+			wantErr: "test _limit: expected an error matching float",
+		},
+		{
+			name:    "wrong code",
+			script:  `test _limit = () => ({input: testing.loadMem(csv: ""), expectError: /int/, expectCode: "internal", fn: (table=<-) => table |> limit(n: 1 + "a")})`,
+			failed:  true,
+			wantErr: "expected an error with code internal, got code invalid",
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			pkg := parser.ParseSource(prefix + tc.script)
+			if ast.Check(pkg) > 0 {
+				t.Fatal(ast.GetError(pkg))
+			}
+			failed, err := stdlib.TestingTypeError(pkg, flux.StdLib())
+			if failed != tc.failed {
+				t.Fatalf("unexpected type error: %v", err)
+			}
+			if tc.wantErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatalf("expected error %q", tc.wantErr)
+			} else if !strings.Contains(err.Error(), tc.wantErr) {
+				t.Fatalf("unexpected error -want/+got:\n\t- %s\n\t+ %s", tc.wantErr, err)
+			}
+		})
+	}
+}
@@ -93,7 +93,6 @@ func (s *FilterOpSpec) Kind() flux.OperationKind {
 }
 
 type FilterProcedureSpec struct {
-	plan.DefaultCost
 	Fn              interpreter.ResolvedFunction
 	KeepEmptyTables bool
 }
@@ -121,9 +120,23 @@ func (s *FilterProcedureSpec) Kind() plan.ProcedureKind {
 func (s *FilterProcedureSpec) Copy() plan.ProcedureSpec {
 	ns := new(FilterProcedureSpec)
 	ns.Fn = s.Fn.Copy()
+	ns.KeepEmptyTables = s.KeepEmptyTables
 	return ns
 }
 
+// A filter is assumed to keep one of every filterSelectivity rows it visits.
+const filterSelectivity = 3
+
+// Cost assumes that a filter keeps a fraction of the rows it visits.
+func (s *FilterProcedureSpec) Cost(inStats []plan.Statistics) (plan.Cost, plan.Statistics) {
+	stats := plan.SumStatistics(inStats)
+	cost := plan.Cost{CPU: stats.Rows()}
+	if stats.IsKnown() {
+		stats.Cardinality = (stats.Cardinality + filterSelectivity - 1) / filterSelectivity
+	}
+	return cost, stats
+}
+
 // TriggerSpec implements plan.TriggerAwareProcedureSpec
 func (s *FilterProcedureSpec) TriggerSpec() plan.TriggerSpec {
 	return plan.NarrowTransformationTriggerSpec{}
@@ -265,6 +278,12 @@ func (t *filterTransformation) filterTable(in flux.Table, record values.Object,
 }
 
 func (t *filterTransformation) filter(cr flux.ColReader, record values.Object, properties map[string]semantic.Type) (*arrowmem.Buffer, error) {
+	if t.fn.Vectorized() {
+		if bitset, ok := t.filterColumns(cr); ok {
+			return bitset, nil
+		}
+	}
+
 	cols, l := cr.Cols(), cr.Len()
 	bitset := arrowmem.NewResizableBuffer(t.alloc)
 	bitset.Resize(l)
@@ -286,6 +305,25 @@ func (t *filterTransformation) filter(cr flux.ColReader, record values.Object, p
 	return bitset, nil
 }
 
+// filterColumns evaluates the predicate for all of the rows at once.
+// It reports false when the rows must be evaluated one at a time
+// to find the row that caused an error.
+func (t *filterTransformation) filterColumns(cr flux.ColReader) (*arrowmem.Buffer, bool) {
+	vs, err := t.fn.EvalColumns(t.ctx, cr, t.alloc)
+	if err != nil {
+		return nil, false
+	}
+	defer vs.Release()
+
+	l := cr.Len()
+	bitset := arrowmem.NewResizableBuffer(t.alloc)
+	bitset.Resize(l)
+	for i := 0; i < l; i++ {
+		bitutil.SetBitTo(bitset.Buf(), i, vs.IsValid(i) && vs.Value(i))
+	}
+	return bitset, true
+}
+
 func (t *filterTransformation) UpdateWatermark(id execute.DatasetID, mark execute.Time) error {
 	return t.d.UpdateWatermark(mark)
 }
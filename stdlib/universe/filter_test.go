@@ -1069,6 +1069,68 @@ func TestFilter_Process(t *testing.T) {
 				},
 			}},
 		},
+		{
+			// The division by zero is skipped when each row is evaluated
+			// on its own, but not when the columns are evaluated at once.
+			name: `guarded division by zero`,
+			spec: &universe.FilterProcedureSpec{
+				Fn: interpreter.ResolvedFunction{
+					Fn: &semantic.FunctionExpression{
+						Block: &semantic.FunctionBlock{
+							Parameters: &semantic.FunctionParameters{
+								List: []*semantic.FunctionParameter{{Key: &semantic.Identifier{Name: "r"}}},
+							},
+							Body: &semantic.ConditionalExpression{
+								Test: &semantic.BinaryExpression{
+									Operator: ast.NotEqualOperator,
+									Left: &semantic.MemberExpression{
+										Object:   &semantic.IdentifierExpression{Name: "r"},
+										Property: "_value",
+									},
+									Right: &semantic.FloatLiteral{Value: 0},
+								},
+								Consequent: &semantic.BinaryExpression{
+									Operator: ast.GreaterThanOperator,
+									Left: &semantic.BinaryExpression{
+										Operator: ast.DivisionOperator,
+										Left:     &semantic.FloatLiteral{Value: 10},
+										Right: &semantic.MemberExpression{
+											Object:   &semantic.IdentifierExpression{Name: "r"},
+											Property: "_value",
+										},
+									},
+									Right: &semantic.FloatLiteral{Value: 2},
+								},
+								Alternate: &semantic.BooleanLiteral{Value: false},
+							},
+						},
+					},
+					Scope: valuestest.NowScope(),
+				},
+			},
+			data: []flux.Table{&executetest.Table{
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_value", Type: flux.TFloat},
+				},
+				Data: [][]interface{}{
+					{execute.Time(1), 1.0},
+					{execute.Time(2), 0.0},
+					{execute.Time(3), 10.0},
+					{execute.Time(4), 2.0},
+				},
+			}},
+			want: []*executetest.Table{{
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_value", Type: flux.TFloat},
+				},
+				Data: [][]interface{}{
+					{execute.Time(1), 1.0},
+					{execute.Time(4), 2.0},
+				},
+			}},
+		},
 	}
 	for _, tc := range testCases {
 		tc := tc
@@ -139,29 +139,37 @@ func createGroupTransformation(id execute.DatasetID, mode execute.AccumulationMo
 	if !ok {
 		return nil, nil, errors.Newf(codes.Internal, "invalid spec type %T", spec)
 	}
-	t, d := NewGroupTransformation(s, id, a.Allocator())
+	t, d := newGroupTransformation(s, id, a.Allocator(), table.NewSpiller(a))
 	return t, d, nil
 }
 
 type groupTransformation struct {
-	d     execute.Dataset
-	cache table.BuilderCache
-	mem   *memory.Allocator
+	d       execute.Dataset
+	cache   table.BuilderCache
+	mem     *memory.Allocator
+	spiller *table.Spiller
 
 	mode flux.GroupMode
 	keys []string
 }
 
 func NewGroupTransformation(spec *GroupProcedureSpec, id execute.DatasetID, mem *memory.Allocator) (execute.Transformation, execute.Dataset) {
+	return newGroupTransformation(spec, id, mem, nil)
+}
+
+func newGroupTransformation(spec *GroupProcedureSpec, id execute.DatasetID, mem *memory.Allocator, spiller *table.Spiller) (*groupTransformation, execute.Dataset) {
 	t := &groupTransformation{
 		cache: table.BuilderCache{
 			New: func(key flux.GroupKey) table.Builder {
-				return table.NewBufferedBuilder(key, mem)
+				b := table.NewBufferedBuilder(key, mem)
+				b.Spiller = spiller
+				return b
 			},
 		},
-		mem:  mem,
-		mode: spec.GroupMode,
-		keys: spec.GroupKeys,
+		mem:     mem,
+		spiller: spiller,
+		mode:    spec.GroupMode,
+		keys:    spec.GroupKeys,
 	}
 	t.d = table.NewDataset(id, &t.cache)
 	sort.Strings(t.keys)
@@ -241,7 +249,25 @@ func (t *groupTransformation) getTableKey(tbl flux.Table) (flux.GroupKey, bool,
 
 func (t *groupTransformation) appendTable(ab *table.BufferedBuilder, tbl flux.Table) error {
 	// Read the table and append each of the columns.
-	return tbl.Do(ab.AppendBuffer)
+	if t.spiller == nil {
+		return tbl.Do(ab.AppendBuffer)
+	}
+	return tbl.Do(func(cr flux.ColReader) error {
+		if err := ab.AppendBuffer(cr); err != nil {
+			return err
+		}
+		if t.spiller.ShouldSpill() {
+			return t.spill()
+		}
+		return nil
+	})
+}
+
+// spill writes the buffered tables to disk to release memory.
+func (t *groupTransformation) spill() error {
+	return t.cache.ForEach(func(key flux.GroupKey, builder table.Builder) error {
+		return builder.(*table.BufferedBuilder).Spill()
+	})
 }
 
 // groupByRow will determine which table each row belongs to
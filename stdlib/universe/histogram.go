@@ -313,6 +313,9 @@ func (b linearBins) Regexp() *regexp.Regexp {
 func (b linearBins) Array() values.Array {
 	panic(values.UnexpectedKind(semantic.Array, semantic.Function))
 }
+func (b linearBins) Dict() values.Dictionary {
+	panic(values.UnexpectedKind(semantic.Array, semantic.Dictionary))
+}
 
 func (b linearBins) Object() values.Object {
 	panic(values.UnexpectedKind(semantic.Object, semantic.Function))
@@ -448,6 +451,9 @@ func (b logarithmicBins) Regexp() *regexp.Regexp {
 func (b logarithmicBins) Array() values.Array {
 	panic(values.UnexpectedKind(semantic.Array, semantic.Function))
 }
+func (b logarithmicBins) Dict() values.Dictionary {
+	panic(values.UnexpectedKind(semantic.Array, semantic.Dictionary))
+}
 
 func (b logarithmicBins) Object() values.Object {
 	panic(values.UnexpectedKind(semantic.Object, semantic.Function))
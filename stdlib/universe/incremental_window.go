@@ -0,0 +1,557 @@
+package universe
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sync"
+
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/codes"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/internal/errors"
+	"github.com/influxdata/flux/internal/speckey"
+	"github.com/influxdata/flux/plan"
+	"github.com/influxdata/flux/semantic"
+	"github.com/influxdata/flux/values"
+)
+
+const IncrementalWindowKind = "incrementalWindow"
+
+func init() {
+	execute.RegisterTransformation(IncrementalWindowKind, createIncrementalWindowTransformation)
+}
+
+// IncrementalWindows returns a physical plan option that runs
+// aggregateWindow-shaped plans incrementally. The aggregates of the windows
+// that are closed, that is, windows that stop before now, are saved in the store.
+// When the plan is run again, the saved windows that are still within the range
+// are reused and only the other windows are read and aggregated.
+//
+// A plan is incremental when a range is followed by a window with the same
+// every and period that does not create empty windows, and the window is
+// followed by an aggregate or selector. Only filter, map and the schema
+// functions may be placed between range and window.
+// Data that is written into a window after it is closed is not read.
+func IncrementalWindows(store WindowStore) plan.PhysicalOption {
+	return plan.AddPhysicalRules(IncrementalWindowRule{Store: store})
+}
+
+// WindowStore saves the closed windows of incremental plans between runs.
+// Implementations must be safe for concurrent use.
+type WindowStore interface {
+	// Load returns the windows that were saved with the key.
+	Load(key string) (*WindowState, bool)
+	// Save replaces the windows that are saved with the key.
+	Save(key string, state *WindowState)
+}
+
+// WindowState holds the aggregated tables of the closed windows of a plan.
+// It is not modified after it is saved.
+type WindowState struct {
+	// Bounds contains the saved windows. Every window within
+	// the bounds was closed and aggregated when it was saved.
+	Bounds execute.Bounds
+
+	tables []*windowTable
+}
+
+// windowTable is the output of the aggregate for a single window.
+type windowTable struct {
+	key    flux.GroupKey
+	bounds execute.Bounds
+	cols   []flux.ColMeta
+	rows   [][]values.Value
+}
+
+// memoryWindowStore is a WindowStore that keeps the windows in memory.
+type memoryWindowStore struct {
+	mu     sync.Mutex
+	states map[string]*WindowState
+}
+
+// NewMemoryWindowStore creates a WindowStore that keeps the windows in memory.
+// The windows of a plan are replaced each time the plan is run
+// so the store holds only the windows within the last range of each plan.
+func NewMemoryWindowStore() WindowStore {
+	return &memoryWindowStore{
+		states: make(map[string]*WindowState),
+	}
+}
+
+func (s *memoryWindowStore) Load(key string) (*WindowState, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	state, ok := s.states[key]
+	return state, ok
+}
+
+func (s *memoryWindowStore) Save(key string, state *WindowState) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.states[key] = state
+}
+
+// incrementalAggregates are the kinds whose output for a window
+// depends only on the rows within the window.
+var incrementalAggregates = map[plan.ProcedureKind]bool{
+	CountKind:               true,
+	SumKind:                 true,
+	MeanKind:                true,
+	MinKind:                 true,
+	MaxKind:                 true,
+	FirstKind:               true,
+	LastKind:                true,
+	SpreadKind:              true,
+	StddevKind:              true,
+	SkewKind:                true,
+	QuantileKind:            true,
+	ExactQuantileAggKind:    true,
+	ExactQuantileSelectKind: true,
+}
+
+// incrementalRowKinds are the kinds that can be placed between range and window,
+// because they transform each row on its own.
+var incrementalRowKinds = map[plan.ProcedureKind]bool{
+	FilterKind:         true,
+	MapKind:            true,
+	SchemaMutationKind: true,
+}
+
+// IncrementalWindowRule inserts an incremental window node after
+// the aggregate of an aggregateWindow-shaped plan and narrows
+// the range so that the windows in the store are not read.
+type IncrementalWindowRule struct {
+	Store WindowStore
+}
+
+func (IncrementalWindowRule) Name() string {
+	return "IncrementalWindowRule"
+}
+
+// Pattern matches any node because the rule rewrites the successor
+// of the aggregate, which can be of any kind.
+func (IncrementalWindowRule) Pattern() plan.Pattern {
+	return plan.Any()
+}
+
+func (r IncrementalWindowRule) Rewrite(node plan.Node) (plan.Node, bool, error) {
+	if node.Kind() == IncrementalWindowKind {
+		return node, false, nil
+	}
+	for i, pred := range node.Predecessors() {
+		m, ok := matchWindowAggregate(pred)
+		if !ok {
+			continue
+		}
+		changed, err := r.rewrite(node, i, m)
+		if err != nil {
+			return nil, false, err
+		}
+		return node, changed, nil
+	}
+	return node, false, nil
+}
+
+// windowAggregate is the part of a plan that is run incrementally.
+//
+//   source -> head... -> range -> rows... -> window -> aggregate
+type windowAggregate struct {
+	aggregate *plan.PhysicalPlanNode
+	window    *plan.PhysicalPlanNode
+	rows      []*plan.PhysicalPlanNode
+	rng       *plan.PhysicalPlanNode
+	// head holds the nodes from the source to the range.
+	// It is nil when they cannot be copied.
+	head []*plan.PhysicalPlanNode
+}
+
+func matchWindowAggregate(node plan.Node) (*windowAggregate, bool) {
+	m := new(windowAggregate)
+	var ok bool
+	if m.aggregate, ok = linearNode(node); !ok || !incrementalAggregates[node.Kind()] {
+		return nil, false
+	}
+	if m.window, ok = linearNode(node.Predecessors()[0]); !ok || m.window.Kind() != WindowKind {
+		return nil, false
+	}
+	ws := m.window.Spec.(*WindowProcedureSpec)
+	if ws.CreateEmpty || ws.Window.Every != ws.Window.Period || ws.Window.Every == infinityVar.Duration() {
+		return nil, false
+	}
+
+	node = m.window.Predecessors()[0]
+	for incrementalRowKinds[node.Kind()] {
+		n, ok := linearNode(node)
+		if !ok {
+			return nil, false
+		}
+		m.rows = append([]*plan.PhysicalPlanNode{n}, m.rows...)
+		node = n.Predecessors()[0]
+	}
+	if m.rng, ok = linearNode(node); !ok || m.rng.Kind() != RangeKind {
+		return nil, false
+	}
+
+	for node = m.rng.Predecessors()[0]; ; node = node.Predecessors()[0] {
+		n, ok := node.(*plan.PhysicalPlanNode)
+		if !ok || len(n.Successors()) != 1 || len(n.Predecessors()) > 1 {
+			m.head = nil
+			break
+		}
+		m.head = append([]*plan.PhysicalPlanNode{n}, m.head...)
+		if len(n.Predecessors()) == 0 {
+			break
+		}
+	}
+	return m, true
+}
+
+// linearNode returns the physical node when it has
+// a single predecessor and a single successor.
+func linearNode(node plan.Node) (*plan.PhysicalPlanNode, bool) {
+	n, ok := node.(*plan.PhysicalPlanNode)
+	if !ok || len(n.Predecessors()) != 1 || len(n.Successors()) != 1 {
+		return nil, false
+	}
+	return n, true
+}
+
+// key identifies the windows of the plan in the store.
+// It does not depend on the bounds of the range.
+func (m *windowAggregate) key() (string, bool) {
+	h := sha256.New()
+	write := func(spec plan.ProcedureSpec) bool {
+		if err := speckey.Write(h, spec); err != nil {
+			return false
+		}
+		fmt.Fprintln(h)
+		return true
+	}
+
+	if m.head == nil {
+		// The source cannot be copied, but it still identifies the plan.
+		for node := m.rng.Predecessors()[0]; ; node = node.Predecessors()[0] {
+			if !write(node.ProcedureSpec()) {
+				return "", false
+			}
+			if len(node.Predecessors()) != 1 {
+				break
+			}
+		}
+	}
+	for _, n := range m.head {
+		if !write(n.Spec) {
+			return "", false
+		}
+	}
+	rs := m.rng.Spec.(*RangeProcedureSpec)
+	fmt.Fprintf(h, "%s %s %s %s\n", RangeKind, rs.TimeColumn, rs.StartColumn, rs.StopColumn)
+	for _, n := range m.rows {
+		if !write(n.Spec) {
+			return "", false
+		}
+	}
+	if !write(m.window.Spec) || !write(m.aggregate.Spec) {
+		return "", false
+	}
+	return hex.EncodeToString(h.Sum(nil)), true
+}
+
+func (r IncrementalWindowRule) rewrite(succ plan.Node, i int, m *windowAggregate) (bool, error) {
+	key, ok := m.key()
+	if !ok {
+		return false, nil
+	}
+	rs := m.rng.Spec.(*RangeProcedureSpec)
+	ws := m.window.Spec.(*WindowProcedureSpec)
+	w, err := execute.NewWindowInLocation(ws.Window.Every, ws.Window.Period, ws.Window.Offset, ws.Window.Location)
+	if err != nil {
+		return false, err
+	}
+
+	pb := rs.TimeBounds(nil)
+	bounds := execute.Bounds{Start: pb.Start, Stop: pb.Stop}
+	if bounds.IsEmpty() {
+		return false, nil
+	}
+
+	// The windows that stop before now are closed and saved.
+	// The first window is only saved when it is not clipped by the range.
+	closeAt := values.ConvertTime(rs.Bounds.Now)
+	if bounds.Stop < closeAt {
+		closeAt = bounds.Stop
+	}
+	first := w.GetEarliestBounds(bounds.Start)
+	if first.Start < bounds.Start {
+		first = w.GetEarliestBounds(first.Stop)
+	}
+	save := execute.Bounds{
+		Start: first.Start,
+		Stop:  w.GetEarliestBounds(closeAt).Start,
+	}
+	if save.Stop < save.Start {
+		save.Stop = save.Start
+	}
+
+	// The saved windows that are within the closed windows are reused.
+	var reuse execute.Bounds
+	state, ok := r.Store.Load(key)
+	if ok && !save.IsEmpty() {
+		reuse = save
+		if state.Bounds.Start > reuse.Start {
+			reuse.Start = state.Bounds.Start
+		}
+		if state.Bounds.Stop < reuse.Stop {
+			reuse.Stop = state.Bounds.Stop
+		}
+		// Without a copy of the source, the windows before the reused
+		// windows cannot be read separately so nothing is reused.
+		if reuse.IsEmpty() || reuse.Start > bounds.Start && reuse.Stop < bounds.Stop && m.head == nil {
+			reuse = execute.Bounds{}
+		}
+	}
+
+	if !reuse.IsEmpty() {
+		before := execute.Bounds{Start: bounds.Start, Stop: reuse.Start}
+		after := execute.Bounds{Start: reuse.Stop, Stop: bounds.Stop}
+		switch {
+		case !before.IsEmpty() && !after.IsEmpty():
+			narrowRange(m.rng, after)
+			m.readBefore(before)
+		case !before.IsEmpty():
+			narrowRange(m.rng, before)
+		default:
+			narrowRange(m.rng, after)
+		}
+	}
+
+	n := plan.CreatePhysicalNode(m.aggregate.ID()+"_incremental", &IncrementalWindowProcedureSpec{
+		Key:         key,
+		Bounds:      bounds,
+		Save:        save,
+		Reuse:       reuse,
+		StartColumn: ws.StartColumn,
+		StopColumn:  ws.StopColumn,
+		Store:       r.Store,
+		State:       state,
+	})
+	m.aggregate.ClearSuccessors()
+	m.aggregate.AddSuccessors(n)
+	n.AddPredecessors(m.aggregate)
+	n.AddSuccessors(succ)
+	succ.Predecessors()[i] = n
+	return true, nil
+}
+
+// narrowRange replaces the bounds of the range.
+func narrowRange(node *plan.PhysicalPlanNode, bounds execute.Bounds) {
+	rs := node.Spec.Copy().(*RangeProcedureSpec)
+	rs.Bounds.Start = flux.Time{Absolute: bounds.Start.Time()}
+	rs.Bounds.Stop = flux.Time{Absolute: bounds.Stop.Time()}
+	node.Spec = rs
+}
+
+// readBefore reads the windows before the reused windows with a copy
+// of the source and the range, and unions them with the range.
+func (m *windowAggregate) readBefore(bounds execute.Bounds) {
+	var pred plan.Node
+	for _, n := range m.head {
+		c := plan.CreatePhysicalNode(n.ID()+"_before", n.Spec.Copy().(plan.PhysicalProcedureSpec))
+		if pred != nil {
+			pred.AddSuccessors(c)
+			c.AddPredecessors(pred)
+		}
+		pred = c
+	}
+	rng := plan.CreatePhysicalNode(m.rng.ID()+"_before", m.rng.Spec.Copy().(plan.PhysicalProcedureSpec))
+	narrowRange(rng, bounds)
+	pred.AddSuccessors(rng)
+	rng.AddPredecessors(pred)
+
+	union := plan.CreatePhysicalNode(m.rng.ID()+"_union", &UnionProcedureSpec{})
+	succ := m.rng.Successors()[0]
+	for j, p := range succ.Predecessors() {
+		if p == m.rng {
+			succ.Predecessors()[j] = union
+		}
+	}
+	m.rng.ClearSuccessors()
+	m.rng.AddSuccessors(union)
+	rng.AddSuccessors(union)
+	union.AddPredecessors(rng, m.rng)
+	union.AddSuccessors(succ)
+}
+
+// IncrementalWindowProcedureSpec passes on the aggregated windows,
+// adds the reused windows from the store and saves the closed windows.
+type IncrementalWindowProcedureSpec struct {
+	plan.DefaultCost
+	// Key identifies the windows in the store.
+	Key string
+	// Bounds are the bounds of the range before it was narrowed.
+	Bounds execute.Bounds
+	// Save contains the closed windows that are saved.
+	Save execute.Bounds
+	// Reuse contains the windows that are read from the state.
+	Reuse execute.Bounds
+	StartColumn,
+	StopColumn string
+
+	Store WindowStore  `json:"-"`
+	State *WindowState `json:"-"`
+}
+
+func (s *IncrementalWindowProcedureSpec) Kind() plan.ProcedureKind {
+	return IncrementalWindowKind
+}
+
+func (s *IncrementalWindowProcedureSpec) Copy() plan.ProcedureSpec {
+	ns := *s
+	return &ns
+}
+
+// TimeBounds restores the bounds of the range so that the
+// successors see the same bounds as without the store.
+func (s *IncrementalWindowProcedureSpec) TimeBounds(predecessorBounds *plan.Bounds) *plan.Bounds {
+	return &plan.Bounds{
+		Start: s.Bounds.Start,
+		Stop:  s.Bounds.Stop,
+	}
+}
+
+func createIncrementalWindowTransformation(id execute.DatasetID, mode execute.AccumulationMode, spec plan.ProcedureSpec, a execute.Administration) (execute.Transformation, execute.Dataset, error) {
+	s, ok := spec.(*IncrementalWindowProcedureSpec)
+	if !ok {
+		return nil, nil, errors.Newf(codes.Internal, "invalid spec type %T", spec)
+	}
+	cache := execute.NewTableBuilderCache(a.Allocator())
+	d := execute.NewDataset(id, mode, cache)
+	t := NewIncrementalWindowTransformation(d, cache, s)
+	return t, d, nil
+}
+
+type incrementalWindowTransformation struct {
+	d     execute.Dataset
+	cache execute.TableBuilderCache
+	spec  *IncrementalWindowProcedureSpec
+
+	// tables are the closed windows that are aggregated in this run.
+	tables []*windowTable
+}
+
+func NewIncrementalWindowTransformation(d execute.Dataset, cache execute.TableBuilderCache, spec *IncrementalWindowProcedureSpec) execute.Transformation {
+	return &incrementalWindowTransformation{
+		d:     d,
+		cache: cache,
+		spec:  spec,
+	}
+}
+
+func (t *incrementalWindowTransformation) RetractTable(id execute.DatasetID, key flux.GroupKey) error {
+	return t.d.RetractTable(key)
+}
+
+func (t *incrementalWindowTransformation) Process(id execute.DatasetID, tbl flux.Table) error {
+	builder, created := t.cache.TableBuilder(tbl.Key())
+	if created {
+		if err := execute.AddTableCols(tbl, builder); err != nil {
+			return err
+		}
+	}
+
+	bounds, ok := t.windowBounds(tbl.Key())
+	if !ok || bounds.Start < t.spec.Save.Start || bounds.Stop > t.spec.Save.Stop {
+		return execute.AppendTable(tbl, builder)
+	}
+
+	wt := &windowTable{
+		key:    tbl.Key(),
+		bounds: bounds,
+		cols:   tbl.Cols(),
+	}
+	if err := tbl.Do(func(cr flux.ColReader) error {
+		for i := 0; i < cr.Len(); i++ {
+			row := make([]values.Value, len(wt.cols))
+			for j := range wt.cols {
+				row[j] = execute.ValueForRow(cr, i, j)
+			}
+			wt.rows = append(wt.rows, row)
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+	t.tables = append(t.tables, wt)
+	return appendWindowTable(builder, wt)
+}
+
+// windowBounds returns the bounds of the window from the group key.
+func (t *incrementalWindowTransformation) windowBounds(key flux.GroupKey) (execute.Bounds, bool) {
+	start, stop := key.LabelValue(t.spec.StartColumn), key.LabelValue(t.spec.StopColumn)
+	if start == nil || stop == nil || start.Type() != semantic.Time || stop.Type() != semantic.Time {
+		return execute.Bounds{}, false
+	}
+	return execute.Bounds{Start: start.Time(), Stop: stop.Time()}, true
+}
+
+func appendWindowTable(builder execute.TableBuilder, wt *windowTable) error {
+	cols := builder.Cols()
+	for _, row := range wt.rows {
+		for j, c := range wt.cols {
+			idx := execute.ColIdx(c.Label, cols)
+			if idx < 0 {
+				return errors.Newf(codes.Internal, "missing column %q in saved window", c.Label)
+			}
+			if err := builder.AppendValue(idx, row[j]); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (t *incrementalWindowTransformation) UpdateWatermark(id execute.DatasetID, mark execute.Time) error {
+	return t.d.UpdateWatermark(mark)
+}
+
+func (t *incrementalWindowTransformation) UpdateProcessingTime(id execute.DatasetID, pt execute.Time) error {
+	return t.d.UpdateProcessingTime(pt)
+}
+
+func (t *incrementalWindowTransformation) Finish(id execute.DatasetID, err error) {
+	if err == nil {
+		err = t.finish()
+	}
+	t.d.Finish(err)
+}
+
+// finish adds the reused windows to the output and saves the closed windows.
+func (t *incrementalWindowTransformation) finish() error {
+	if !t.spec.Reuse.IsEmpty() && t.spec.State != nil {
+		for _, wt := range t.spec.State.tables {
+			if wt.bounds.Start < t.spec.Reuse.Start || wt.bounds.Stop > t.spec.Reuse.Stop {
+				continue
+			}
+			builder, created := t.cache.TableBuilder(wt.key)
+			if created {
+				for _, c := range wt.cols {
+					if _, err := builder.AddCol(c); err != nil {
+						return err
+					}
+				}
+			}
+			if err := appendWindowTable(builder, wt); err != nil {
+				return err
+			}
+			t.tables = append(t.tables, wt)
+		}
+	}
+	if !t.spec.Save.IsEmpty() {
+		t.spec.Store.Save(t.spec.Key, &WindowState{
+			Bounds: t.spec.Save,
+			tables: t.tables,
+		})
+	}
+	return nil
+}
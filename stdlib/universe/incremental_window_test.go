@@ -0,0 +1,154 @@
+package universe_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/execute/executetest"
+	"github.com/influxdata/flux/lang"
+	"github.com/influxdata/flux/lang/langtest"
+	"github.com/influxdata/flux/plan"
+	"github.com/influxdata/flux/stdlib/universe"
+)
+
+const incrementalWindowScript = `
+import "csv"
+
+data = "
+#datatype,string,long,dateTime:RFC3339,string,string,double
+#group,false,false,false,true,true,false
+#default,_result,,,,,
+,result,table,_time,_measurement,host,_value
+,,0,2020-01-01T00:06:00Z,cpu,a,1.0
+,,0,2020-01-01T00:12:00Z,cpu,a,2.0
+,,0,2020-01-01T00:15:00Z,cpu,a,3.0
+,,0,2020-01-01T00:22:00Z,cpu,a,4.0
+,,0,2020-01-01T00:31:00Z,cpu,a,5.0
+,,0,2020-01-01T00:38:00Z,cpu,a,6.0
+,,1,2020-01-01T00:25:00Z,cpu,b,10.0
+,,2,2020-01-01T00:25:00Z,mem,a,100.0
+"
+
+csv.from(csv: data)
+	|> range(start: -30m)
+	|> filter(fn: (r) => r._measurement == "cpu")
+	|> aggregateWindow(every: 10m, fn: mean, createEmpty: false)
+`
+
+func runIncrementalWindowScript(t *testing.T, script string, now time.Time, opts ...lang.CompileOption) ([]*executetest.Table, *plan.Spec) {
+	t.Helper()
+	ctx := executetest.NewTestExecuteDependencies().Inject(context.Background())
+	tables, ps, err := langtest.RunScript(ctx, script, now, opts...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return tables, ps
+}
+
+// rangeBounds returns the bounds of the ranges in the plan.
+func rangeBounds(ps *plan.Spec) []execute.Bounds {
+	var bounds []execute.Bounds
+	_ = ps.BottomUpWalk(func(node plan.Node) error {
+		if s, ok := node.ProcedureSpec().(*universe.RangeProcedureSpec); ok {
+			b := s.TimeBounds(nil)
+			bounds = append(bounds, execute.Bounds{Start: b.Start, Stop: b.Stop})
+		}
+		return nil
+	})
+	return bounds
+}
+
+func TestIncrementalWindows(t *testing.T) {
+	store := universe.NewMemoryWindowStore()
+	incremental := lang.WithPhysPlanOpts(universe.IncrementalWindows(store))
+	ts := func(s string) execute.Time {
+		tm, err := time.Parse(time.RFC3339, s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return execute.Time(tm.UnixNano())
+	}
+
+	// The runs share the store so each run reuses
+	// the windows that are saved by the previous run.
+	for _, tc := range []struct {
+		name string
+		now  time.Time
+		want []execute.Bounds
+	}{
+		{
+			// Nothing is saved yet, so the whole range is read.
+			// The windows from 00:10 to 00:30 are closed and saved.
+			name: "first run",
+			now:  time.Date(2020, 1, 1, 0, 35, 0, 0, time.UTC),
+			want: []execute.Bounds{
+				{Start: ts("2020-01-01T00:05:00Z"), Stop: ts("2020-01-01T00:35:00Z")},
+			},
+		},
+		{
+			// The window from 00:20 to 00:30 is reused,
+			// the clipped first window and the new windows are read.
+			name: "sliding range",
+			now:  time.Date(2020, 1, 1, 0, 41, 0, 0, time.UTC),
+			want: []execute.Bounds{
+				{Start: ts("2020-01-01T00:11:00Z"), Stop: ts("2020-01-01T00:20:00Z")},
+				{Start: ts("2020-01-01T00:30:00Z"), Stop: ts("2020-01-01T00:41:00Z")},
+			},
+		},
+		{
+			// The range starts at a window boundary,
+			// so only the windows after the reused windows are read.
+			name: "aligned range",
+			now:  time.Date(2020, 1, 1, 0, 50, 0, 0, time.UTC),
+			want: []execute.Bounds{
+				{Start: ts("2020-01-01T00:40:00Z"), Stop: ts("2020-01-01T00:50:00Z")},
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			want, _ := runIncrementalWindowScript(t, incrementalWindowScript, tc.now)
+			got, ps := runIncrementalWindowScript(t, incrementalWindowScript, tc.now, incremental)
+			if !cmp.Equal(want, got) {
+				t.Errorf("unexpected tables -want/+got:\n%s", cmp.Diff(want, got))
+			}
+			if bounds := rangeBounds(ps); !cmp.Equal(tc.want, bounds) {
+				t.Errorf("unexpected range bounds -want/+got:\n%s", cmp.Diff(tc.want, bounds))
+			}
+		})
+	}
+}
+
+func TestIncrementalWindows_FunctionScope(t *testing.T) {
+	store := universe.NewMemoryWindowStore()
+	incremental := lang.WithPhysPlanOpts(universe.IncrementalWindows(store))
+	now := time.Date(2020, 1, 1, 0, 35, 0, 0, time.UTC)
+
+	// The scripts differ only in a function in the scope of the map function,
+	// so they must not share the windows in the store.
+	for _, factor := range []string{"2.0", "3.0"} {
+		script := strings.Replace(incrementalWindowScript,
+			`|> aggregateWindow(`,
+			`|> map(fn: (r) => ({r with _value: scale(v: r._value)}))
+	|> aggregateWindow(`, 1)
+		script = strings.Replace(script, "csv.from(", "scale = (v) => v * "+factor+"\n\ncsv.from(", 1)
+
+		want, _ := runIncrementalWindowScript(t, script, now)
+		got, _ := runIncrementalWindowScript(t, script, now, incremental)
+		if !cmp.Equal(want, got) {
+			t.Errorf("unexpected tables with factor %s -want/+got:\n%s", factor, cmp.Diff(want, got))
+		}
+		got, ps := runIncrementalWindowScript(t, script, now.Add(time.Minute), incremental)
+		want, _ = runIncrementalWindowScript(t, script, now.Add(time.Minute))
+		if !cmp.Equal(want, got) {
+			t.Errorf("unexpected tables of the second run with factor %s -want/+got:\n%s", factor, cmp.Diff(want, got))
+		}
+		// The saved windows are reused, so the range is split around them.
+		if bounds := rangeBounds(ps); len(bounds) != 2 {
+			t.Errorf("expected the second run with factor %s to reuse windows, got range bounds %v", factor, bounds)
+		}
+	}
+}
@@ -7,9 +7,11 @@ import (
 	"sync"
 
 	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/arrow"
 	"github.com/influxdata/flux/codes"
 	"github.com/influxdata/flux/execute"
 	"github.com/influxdata/flux/internal/errors"
+	"github.com/influxdata/flux/internal/execute/table"
 	"github.com/influxdata/flux/interpreter"
 	"github.com/influxdata/flux/memory"
 	"github.com/influxdata/flux/plan"
@@ -40,6 +42,9 @@ func init() {
 // All supported join types in Flux
 var methods = map[string]bool{
 	"inner": true,
+	"left":  true,
+	"right": true,
+	"full":  true,
 }
 
 // JoinOpSpec specifies a particular join operation
@@ -167,9 +172,9 @@ func (s *JoinOpSpec) Kind() flux.OperationKind {
 }
 
 type MergeJoinProcedureSpec struct {
-	plan.DefaultCost
 	TableNames []string `json:"table_names"`
 	On         []string `json:"keys"`
+	Method     string   `json:"method"`
 }
 
 func newMergeJoinProcedure(qs flux.OperationSpec, pa plan.Administration) (plan.ProcedureSpec, error) {
@@ -196,6 +201,7 @@ func newMergeJoinProcedure(qs flux.OperationSpec, pa plan.Administration) (plan.
 	return &MergeJoinProcedureSpec{
 		On:         on,
 		TableNames: tableNames,
+		Method:     spec.Method,
 	}, nil
 }
 
@@ -208,9 +214,39 @@ func (s *MergeJoinProcedureSpec) Copy() plan.ProcedureSpec {
 	ns.On = make([]string, len(s.On))
 	copy(ns.On, s.On)
 
+	ns.TableNames = make([]string, len(s.TableNames))
+	copy(ns.TableNames, s.TableNames)
+
+	ns.Method = s.Method
+
 	return ns
 }
 
+// Cost buffers both sides of the join in memory. Without knowing
+// how the values of the join columns are distributed, the join is
+// assumed to produce as many rows as its larger input.
+func (s *MergeJoinProcedureSpec) Cost(inStats []plan.Statistics) (plan.Cost, plan.Statistics) {
+	var rows int64
+	for _, in := range inStats {
+		rows += in.Rows()
+	}
+	cost := plan.Cost{CPU: rows, MEM: rows}
+
+	stats := plan.Statistics{}
+	for _, in := range inStats {
+		if !in.IsKnown() {
+			return cost, plan.UnknownStatistics()
+		}
+		if in.Cardinality > stats.Cardinality {
+			stats.Cardinality = in.Cardinality
+		}
+		if in.GroupCardinality > stats.GroupCardinality {
+			stats.GroupCardinality = in.GroupCardinality
+		}
+	}
+	return cost, stats
+}
+
 func createMergeJoinTransformation(id execute.DatasetID, mode execute.AccumulationMode, spec plan.ProcedureSpec, a execute.Administration) (execute.Transformation, execute.Dataset, error) {
 	s, ok := spec.(*MergeJoinProcedureSpec)
 	if !ok {
@@ -227,7 +263,8 @@ func createMergeJoinTransformation(id execute.DatasetID, mode execute.Accumulati
 		tableNames[parents[i]] = name
 	}
 
-	cache := NewMergeJoinCache(a.Allocator(), parents, tableNames, s.On)
+	cache := NewMergeJoinCache(a.Allocator(), parents, tableNames, s.On, s.Method)
+	cache.setSpiller(table.NewSpiller(a))
 	d := execute.NewDataset(id, mode, cache)
 	t := NewMergeJoinTransformation(d, cache, s, parents, tableNames)
 	return t, d, nil
@@ -323,7 +360,12 @@ func (t *mergeJoinTransformation) UpdateWatermark(id execute.DatasetID, mark exe
 		}
 	}
 
-	return t.d.UpdateWatermark(min)
+	if err := t.d.UpdateWatermark(min); err != nil {
+		return err
+	}
+	// Errors that occur while the triggered tables are joined
+	// are recorded by the cache.
+	return t.cache.err
 }
 
 func (t *mergeJoinTransformation) UpdateProcessingTime(id execute.DatasetID, pt execute.Time) error {
@@ -338,7 +380,10 @@ func (t *mergeJoinTransformation) UpdateProcessingTime(id execute.DatasetID, pt
 		}
 	}
 
-	return t.d.UpdateProcessingTime(min)
+	if err := t.d.UpdateProcessingTime(min); err != nil {
+		return err
+	}
+	return t.cache.err
 }
 
 func (t *mergeJoinTransformation) Finish(id execute.DatasetID, err error) {
@@ -357,6 +402,14 @@ func (t *mergeJoinTransformation) Finish(id execute.DatasetID, err error) {
 	}
 
 	if finished {
+		if t.err == nil {
+			t.err = t.cache.err
+		}
+		// The tables of an outer join are built before the dataset
+		// iterates over them so that an error can be reported.
+		if t.err == nil && t.cache.isOuter() && !t.cache.outerBuilt {
+			t.err = t.cache.buildOuterTables()
+		}
 		t.d.Finish(t.err)
 	}
 }
@@ -378,10 +431,17 @@ func (t *mergeJoinTransformation) Finish(id execute.DatasetID, err error) {
 //
 // tables:          All output tables are materialized and stored in this
 //                  map before being sent to downstream operators.
+//
+// method:          The join method. Outer joins (left, right and full)
+//                  materialize all of their output tables at once, after
+//                  both input streams have finished.
 type MergeJoinCache struct {
 	leftID  execute.DatasetID
 	rightID execute.DatasetID
 
+	method     string
+	outerBuilt bool
+
 	names   map[execute.DatasetID]string
 	schemas map[execute.DatasetID]schema
 	buffers map[execute.DatasetID]*streamBuffer
@@ -398,8 +458,15 @@ type MergeJoinCache struct {
 	reverseLookup map[flux.GroupKey]preJoinGroupKeys
 
 	tables      map[flux.GroupKey]flux.Table
+	inputRows   map[flux.GroupKey]int
 	alloc       *memory.Allocator
+	spiller     *table.Spiller
 	triggerSpec plan.TriggerSpec
+
+	// err is the first error that occurred while joining the tables
+	// in ForEach or ForEachWithContext. Those methods cannot return it,
+	// so it is returned by Table and by the join transformation instead.
+	err error
 }
 
 type streamBuffer struct {
@@ -409,6 +476,12 @@ type streamBuffer struct {
 	stale    map[flux.GroupKey]bool
 	last     values.Value
 	alloc    *memory.Allocator
+
+	// spilled holds the tables that have been written to disk.
+	// A spilled table is read back into a new builder each time it is needed
+	// and its builder in data only holds its columns.
+	spiller *table.Spiller
+	spilled map[flux.GroupKey]*table.Run
 }
 
 func newStreamBuffer(alloc *memory.Allocator) *streamBuffer {
@@ -418,11 +491,51 @@ func newStreamBuffer(alloc *memory.Allocator) *streamBuffer {
 		ready:    make(map[values.Value]bool),
 		stale:    make(map[flux.GroupKey]bool),
 		alloc:    alloc,
+		spilled:  make(map[flux.GroupKey]*table.Run),
 	}
 }
 
-func (buf *streamBuffer) table(key flux.GroupKey) *execute.ColListTableBuilder {
-	return buf.data[key]
+// table returns the builder for the buffered table with the group key,
+// or nil if there is no such table. A spilled table is read back into
+// a new builder so that only the tables that are being joined are held
+// in memory. The returned function must be called once the caller is
+// done with the builder to release the memory of a spilled table.
+func (buf *streamBuffer) table(key flux.GroupKey) (*execute.ColListTableBuilder, func(), error) {
+	builder := buf.data[key]
+	run, ok := buf.spilled[key]
+	if !ok {
+		return builder, func() {}, nil
+	}
+
+	b := execute.NewColListTableBuilder(key, buf.alloc)
+	for _, col := range builder.Cols() {
+		if _, err := b.AddCol(col); err != nil {
+			return nil, nil, err
+		}
+	}
+	if err := run.Do(buf.alloc, func(cr *arrow.TableBuffer) error {
+		return execute.AppendCols(cr, b)
+	}); err != nil {
+		b.Release()
+		return nil, nil, err
+	}
+	return b, b.Release, nil
+}
+
+// spill writes the buffered tables to disk and releases their memory.
+func (buf *streamBuffer) spill() error {
+	for key, builder := range buf.data {
+		if builder.NRows() == 0 {
+			continue
+		}
+		run, err := buf.spiller.WriteBuilder(builder)
+		if err != nil {
+			return err
+		}
+		builder.Release()
+		buf.spilled[key] = run
+	}
+	return nil
 }
 
 func (buf *streamBuffer) insert(table flux.Table) error {
@@ -474,6 +587,10 @@ func (buf *streamBuffer) evict(key flux.GroupKey) {
 		builder.ClearData()
 		delete(buf.data, key)
 	}
+	if run, ok := buf.spilled[key]; ok {
+		_ = run.Remove()
+		delete(buf.spilled, key)
+	}
 }
 
 func (buf *streamBuffer) clear(f func(flux.GroupKey) bool) {
@@ -516,8 +633,9 @@ func (s schema) Swap(i int, j int) {
 	s.columns[i], s.columns[j] = s.columns[j], s.columns[i]
 }
 
-// NewMergeJoinCache constructs a new instance of a MergeJoinCache
-func NewMergeJoinCache(alloc *memory.Allocator, datasetIDs []execute.DatasetID, tableNames map[execute.DatasetID]string, key []string) *MergeJoinCache {
+// NewMergeJoinCache constructs a new instance of a MergeJoinCache.
+// An empty method is treated as an inner join.
+func NewMergeJoinCache(alloc *memory.Allocator, datasetIDs []execute.DatasetID, tableNames map[execute.DatasetID]string, key []string, method string) *MergeJoinCache {
 	// Join currently only accepts two data sources(streams) as input
 	if len(datasetIDs) != 2 {
 		panic("Join only accepts two data sources")
@@ -540,7 +658,12 @@ func NewMergeJoinCache(alloc *memory.Allocator, datasetIDs []execute.DatasetID,
 		intersection[k] = true
 	}
 
+	if method == "" {
+		method = "inner"
+	}
+
 	return &MergeJoinCache{
+		method:        method,
 		on:            on,
 		order:         key,
 		intersection:  intersection,
@@ -552,61 +675,87 @@ func NewMergeJoinCache(alloc *memory.Allocator, datasetIDs []execute.DatasetID,
 		reverseLookup: make(map[flux.GroupKey]preJoinGroupKeys),
 		postJoinKeys:  execute.NewGroupLookup(),
 		tables:        make(map[flux.GroupKey]flux.Table),
+		inputRows:     make(map[flux.GroupKey]int),
 		alloc:         alloc,
 	}
 }
 
 // Table joins the two tables associated with a single output group key and returns the resulting table
 func (c *MergeJoinCache) Table(key flux.GroupKey) (flux.Table, error) {
-	preJoinGroupKeys, ok := c.reverseLookup[key]
-
-	if !ok {
-		return nil, errors.Newf(codes.FailedPrecondition, "no table exists with group key: %v", key)
+	if c.err != nil {
+		return nil, c.err
 	}
+	table, _, err := c.joinTable(key)
+	return table, err
+}
 
-	if _, ok := c.tables[key]; !ok {
+// joinTable joins the two tables associated with a single output group key
+// unless they have already been joined. It returns the joined table and
+// the number of rows in the two tables that were joined.
+func (c *MergeJoinCache) joinTable(key flux.GroupKey) (flux.Table, int, error) {
+	if table, ok := c.tables[key]; ok {
+		return table, c.inputRows[key], nil
+	}
 
-		left := c.buffers[c.leftID].table(preJoinGroupKeys.left)
-		if left == nil {
-			return nil, errors.Newf(codes.FailedPrecondition, "no table in left join buffer with key: %v", key)
-		}
+	preJoinGroupKeys, ok := c.reverseLookup[key]
+	if !ok {
+		return nil, 0, errors.Newf(codes.FailedPrecondition, "no table exists with group key: %v", key)
+	}
 
-		right := c.buffers[c.rightID].table(preJoinGroupKeys.right)
-		if right == nil {
-			return nil, errors.Newf(codes.FailedPrecondition, "no table in right join buffer with key: %v", key)
-		}
+	left, releaseLeft, err := c.buffers[c.leftID].table(preJoinGroupKeys.left)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer releaseLeft()
+	if left == nil {
+		return nil, 0, errors.Newf(codes.FailedPrecondition, "no table in left join buffer with key: %v", key)
+	}
 
-		table, err := c.join(left, right)
-		if err != nil {
-			return nil, errors.Newf(codes.NotFound, "table with group key (%v) could not be fetched", key)
-		}
+	right, releaseRight, err := c.buffers[c.rightID].table(preJoinGroupKeys.right)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer releaseRight()
+	if right == nil {
+		return nil, 0, errors.Newf(codes.FailedPrecondition, "no table in right join buffer with key: %v", key)
+	}
 
-		c.tables[key] = table
+	table, err := c.join(left, right)
+	if err != nil {
+		return nil, 0, errors.Wrapf(err, codes.Inherit, "table with group key (%v) could not be joined", key)
 	}
-	return c.tables[key], nil
+
+	c.tables[key] = table
+	c.inputRows[key] = left.NRows() + right.NRows()
+	return table, c.inputRows[key], nil
 }
 
 // ForEach iterates over each table in the output stream
 func (c *MergeJoinCache) ForEach(f func(flux.GroupKey)) {
-	c.postJoinKeys.Range(func(key flux.GroupKey, value interface{}) {
-
-		if _, ok := c.tables[key]; !ok {
-
-			preJoinGroupKeys := c.reverseLookup[key]
-
-			leftKey := preJoinGroupKeys.left
-			rightKey := preJoinGroupKeys.right
-
-			leftBuilder := c.buffers[c.leftID].table(leftKey)
-			rightBuilder := c.buffers[c.rightID].table(rightKey)
-
-			table, err := c.join(leftBuilder, rightBuilder)
-			if err != nil || table.Empty() {
-				c.DiscardTable(key)
-				return
-			}
+	if c.err != nil {
+		return
+	}
+	if c.isOuter() && !c.outerBuilt {
+		if err := c.buildOuterTables(); err != nil {
+			c.err = err
+			return
+		}
+	}
 
-			c.tables[key] = table
+	c.postJoinKeys.Range(func(key flux.GroupKey, value interface{}) {
+		if c.err != nil {
+			return
+		}
+		table, _, err := c.joinTable(key)
+		if err != nil {
+			// The error is returned when f asks for the table.
+			c.err = err
+			f(key)
+			return
+		}
+		if table.Empty() {
+			c.DiscardTable(key)
+			return
 		}
 		f(key)
 	})
@@ -614,36 +763,32 @@ func (c *MergeJoinCache) ForEach(f func(flux.GroupKey)) {
 
 // ForEachWithContext iterates over each table in the output stream
 func (c *MergeJoinCache) ForEachWithContext(f func(flux.GroupKey, execute.Trigger, execute.TableContext)) {
+	// An outer join cannot know whether a row is unmatched until
+	// both input streams have finished, so tables are never
+	// triggered early. They are all produced by ForEach instead.
+	if c.isOuter() {
+		return
+	}
+
 	trigger := execute.NewTriggerFromSpec(c.triggerSpec)
 
 	c.postJoinKeys.Range(func(key flux.GroupKey, value interface{}) {
-
-		preJoinGroupKeys := c.reverseLookup[key]
-
-		leftKey := preJoinGroupKeys.left
-		rightKey := preJoinGroupKeys.right
-
-		leftBuilder := c.buffers[c.leftID].table(leftKey)
-		rightBuilder := c.buffers[c.rightID].table(rightKey)
-
-		if _, ok := c.tables[key]; !ok {
-
-			table, err := c.join(leftBuilder, rightBuilder)
-
-			if err != nil || table.Empty() {
-				c.DiscardTable(key)
-				return
-			}
-
-			c.tables[key] = table
+		if c.err != nil {
+			return
+		}
+		table, rows, err := c.joinTable(key)
+		if err != nil {
+			c.err = err
+			return
+		}
+		if table.Empty() {
+			c.DiscardTable(key)
+			return
 		}
-
-		leftsize := leftBuilder.NRows()
-		rightsize := rightBuilder.NRows()
 
 		ctx := execute.TableContext{
 			Key:   key,
-			Count: leftsize + rightsize,
+			Count: rows,
 		}
 
 		f(key, trigger, ctx)
@@ -653,6 +798,7 @@ func (c *MergeJoinCache) ForEachWithContext(f func(flux.GroupKey, execute.Trigge
 // DiscardTable removes a table from the output buffer
 func (c *MergeJoinCache) DiscardTable(key flux.GroupKey) {
 	delete(c.tables, key)
+	delete(c.inputRows, key)
 }
 
 // ExpireTable removes the a key from the set of postJoinKeys.
@@ -663,6 +809,7 @@ func (c *MergeJoinCache) ExpireTable(key flux.GroupKey) {
 	// Remove this group key from the cache
 	c.postJoinKeys.Delete(key)
 	delete(c.tables, key)
+	delete(c.inputRows, key)
 
 	// Clear any stale data
 	preJoinGroupKeys := c.reverseLookup[key]
@@ -670,8 +817,14 @@ func (c *MergeJoinCache) ExpireTable(key flux.GroupKey) {
 	leftBuffer := c.buffers[c.leftID]
 	rightBuffer := c.buffers[c.rightID]
 
-	leftBuffer.expire(preJoinGroupKeys.left)
-	rightBuffer.expire(preJoinGroupKeys.right)
+	// Tables holding only unmatched rows of an outer join
+	// have a single pre-join group key.
+	if preJoinGroupKeys.left != nil {
+		leftBuffer.expire(preJoinGroupKeys.left)
+	}
+	if preJoinGroupKeys.right != nil {
+		rightBuffer.expire(preJoinGroupKeys.right)
+	}
 
 	if c.canEvictTables() {
 
@@ -742,7 +895,26 @@ func (c *MergeJoinCache) insertIntoBuffer(id execute.DatasetID, tbl flux.Table)
 			}
 		}
 	}
-	return c.buffers[id].insert(tbl)
+	if err := c.buffers[id].insert(tbl); err != nil {
+		return err
+	}
+	if c.spiller.ShouldSpill() {
+		for _, buf := range c.buffers {
+			if err := buf.spill(); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// setSpiller enables the join buffers to be spilled to disk
+// when the query is close to its memory limit.
+func (c *MergeJoinCache) setSpiller(spiller *table.Spiller) {
+	c.spiller = spiller
+	for _, buf := range c.buffers {
+		buf.spiller = spiller
+	}
 }
 
 // registerKey takes a group key from the input stream associated with id and joins
@@ -863,16 +1035,6 @@ func equalJoinkeys(left, right flux.GroupKey) bool {
 }
 
 func (c *MergeJoinCache) join(left, right *execute.ColListTableBuilder) (flux.Table, error) {
-	// Sort input tables
-	left.Sort(c.order, false)
-	right.Sort(c.order, false)
-
-	var leftSet, rightSet subset
-	var leftKey, rightKey flux.GroupKey
-
-	leftSet, leftKey = c.advance(leftSet.Stop, left)
-	rightSet, rightKey = c.advance(rightSet.Stop, right)
-
 	keys := map[execute.DatasetID]flux.GroupKey{
 		c.leftID:  left.Key(),
 		c.rightID: right.Key(),
@@ -880,14 +1042,47 @@ func (c *MergeJoinCache) join(left, right *execute.ColListTableBuilder) (flux.Ta
 
 	// Instantiate a builder for the output table
 	groupKey := c.postJoinGroupKey(keys)
-	builder := execute.NewColListTableBuilder(groupKey, c.alloc)
+	builder, err := c.newOutputBuilder(groupKey)
+	if err != nil {
+		return nil, err
+	}
 
+	if err := c.joinInto(builder, left, right); err != nil {
+		return nil, err
+	}
+	return builder.Table()
+}
+
+// newOutputBuilder constructs a table builder with the post-join schema
+func (c *MergeJoinCache) newOutputBuilder(key flux.GroupKey) (*execute.ColListTableBuilder, error) {
+	builder := execute.NewColListTableBuilder(key, c.alloc)
 	for _, column := range c.schema.columns {
 		_, err := builder.AddCol(column)
 		if err != nil {
 			return nil, err
 		}
 	}
+	return builder, nil
+}
+
+// joinInto appends every pair of matching rows from left and right to builder
+func (c *MergeJoinCache) joinInto(builder, left, right *execute.ColListTableBuilder) error {
+	// Sort input tables
+	left.Sort(c.order, false)
+	right.Sort(c.order, false)
+
+	var leftSet, rightSet subset
+	var leftKey, rightKey flux.GroupKey
+
+	leftSet, leftKey = c.advance(leftSet.Stop, left)
+	rightSet, rightKey = c.advance(rightSet.Stop, right)
+
+	var err error
+	appendValue := func(j int, v values.Value) {
+		if err == nil {
+			err = appendJoinValue(builder, j, v)
+		}
+	}
 
 	// Perform sort merge join
 	for !leftSet.Empty() && !rightSet.Empty() {
@@ -906,7 +1101,7 @@ func (c *MergeJoinCache) join(left, right *execute.ColListTableBuilder) (flux.Ta
 						}
 						newColumn := c.schemaMap[column]
 						newColumnIdx := c.colIndex[newColumn]
-						_ = builder.AppendValue(newColumnIdx, columnVal)
+						appendValue(newColumnIdx, columnVal)
 					})
 
 					rightRecord.Range(func(columnName string, columnVal values.Value) {
@@ -920,9 +1115,12 @@ func (c *MergeJoinCache) join(left, right *execute.ColListTableBuilder) (flux.Ta
 						// No need to append value if column is part of the join key.
 						// Because value already appended when iterating over left record.
 						if !c.on[newColumn.Label] {
-							_ = builder.AppendValue(newColumnIdx, columnVal)
+							appendValue(newColumnIdx, columnVal)
 						}
 					})
+					if err != nil {
+						return err
+					}
 				}
 			}
 			leftSet, leftKey = c.advance(leftSet.Stop, left)
@@ -933,8 +1131,302 @@ func (c *MergeJoinCache) join(left, right *execute.ColListTableBuilder) (flux.Ta
 			rightSet, rightKey = c.advance(rightSet.Stop, right)
 		}
 	}
+	return nil
+}
 
-	return builder.Table()
+// appendJoinValue appends a value to a column of an output table.
+// The output schema is built from the first table of each stream, so a later
+// table that has a different type for a column cannot be joined.
+func appendJoinValue(b *execute.ColListTableBuilder, j int, v values.Value) error {
+	if col := b.Cols()[j]; !v.IsNull() && flux.ColumnType(v.Type()) != col.Type {
+		return errors.Newf(codes.Invalid, "cannot join column %q: expected type %v but got %v", col.Label, col.Type, flux.ColumnType(v.Type()))
+	}
+	return b.AppendValue(j, v)
+}
+
+// preserves reports whether every row of the stream associated with id
+// must be present in the output, whether or not it has a match.
+func (c *MergeJoinCache) preserves(id execute.DatasetID) bool {
+	switch c.method {
+	case "left":
+		return id == c.leftID
+	case "right":
+		return id == c.rightID
+	case "full":
+		return true
+	}
+	return false
+}
+
+func (c *MergeJoinCache) isOuter() bool {
+	return c.preserves(c.leftID) || c.preserves(c.rightID)
+}
+
+func (c *MergeJoinCache) opposing(id execute.DatasetID) execute.DatasetID {
+	if id == c.leftID {
+		return c.rightID
+	}
+	return c.leftID
+}
+
+// buildOuterTables materializes every output table of an outer join.
+// Matching rows are joined exactly as they are for an inner join. Rows
+// from a preserved stream that match no row in the opposing stream are
+// then added to the table for their group key, with nulls in all of the
+// columns that come from the opposing stream.
+func (c *MergeJoinCache) buildOuterTables() error {
+	c.outerBuilt = true
+	if !c.postJoinSchemaBuilt() {
+		// One of the streams produced no tables
+		c.buildPostJoinSchema()
+	}
+
+	builders := execute.NewGroupLookup()
+	lookupBuilder := func(key flux.GroupKey) (*execute.ColListTableBuilder, error) {
+		if b, ok := builders.Lookup(key); ok {
+			return b.(*execute.ColListTableBuilder), nil
+		}
+		b, err := c.newOutputBuilder(key)
+		if err != nil {
+			return nil, err
+		}
+		builders.Set(key, b)
+		return b, nil
+	}
+
+	var err error
+	c.postJoinKeys.Range(func(key flux.GroupKey, value interface{}) {
+		if err != nil {
+			return
+		}
+		err = c.joinOuter(key, lookupBuilder)
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, id := range []execute.DatasetID{c.leftID, c.rightID} {
+		if !c.preserves(id) {
+			continue
+		}
+		id := id
+		c.buffers[id].iterate(func(key flux.GroupKey) {
+			if err != nil {
+				return
+			}
+			outputKey := c.outerGroupKey(id, key)
+			err = c.appendUnmatched(id, key, func() (*execute.ColListTableBuilder, error) {
+				builder, err := lookupBuilder(outputKey)
+				if err != nil {
+					return nil, err
+				}
+				if _, ok := c.reverseLookup[outputKey]; !ok {
+					keys := preJoinGroupKeys{}
+					if id == c.leftID {
+						keys.left = key
+					} else {
+						keys.right = key
+					}
+					c.reverseLookup[outputKey] = keys
+				}
+				return builder, nil
+			})
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	// Only keep the output keys that have rows
+	var empty struct{}
+	c.postJoinKeys = execute.NewGroupLookup()
+	builders.Range(func(key flux.GroupKey, value interface{}) {
+		if err != nil {
+			return
+		}
+		var table flux.Table
+		table, err = value.(*execute.ColListTableBuilder).Table()
+		if err != nil || table.Empty() {
+			return
+		}
+		c.tables[key] = table
+		c.postJoinKeys.Set(key, empty)
+	})
+	return err
+}
+
+// joinOuter joins the matching rows of the two tables associated with
+// the output group key of an outer join into the builder for the key.
+func (c *MergeJoinCache) joinOuter(key flux.GroupKey, lookupBuilder func(flux.GroupKey) (*execute.ColListTableBuilder, error)) error {
+	preJoinGroupKeys := c.reverseLookup[key]
+	left, releaseLeft, err := c.buffers[c.leftID].table(preJoinGroupKeys.left)
+	if err != nil {
+		return err
+	}
+	defer releaseLeft()
+	right, releaseRight, err := c.buffers[c.rightID].table(preJoinGroupKeys.right)
+	if err != nil {
+		return err
+	}
+	defer releaseRight()
+	if left == nil || right == nil {
+		return nil
+	}
+	builder, err := lookupBuilder(key)
+	if err != nil {
+		return err
+	}
+	return c.joinInto(builder, left, right)
+}
+
+// appendUnmatched appends the rows of a buffered table that have no match
+// in any of the tables of the opposing stream it was paired with.
+// The builder function is only called if there is at least one such row.
+// The tables are read one at a time so that at most one spilled table
+// is held in memory.
+func (c *MergeJoinCache) appendUnmatched(id execute.DatasetID, key flux.GroupKey, builder func() (*execute.ColListTableBuilder, error)) error {
+	other := c.opposing(id)
+
+	// Collect the join key of every row that this table could have matched
+	matches := execute.NewGroupLookup()
+	for _, keys := range c.reverseLookup {
+		own, opposing := keys.left, keys.right
+		if id == c.rightID {
+			own, opposing = keys.right, keys.left
+		}
+		if own == nil || opposing == nil || !own.Equal(key) {
+			continue
+		}
+		table, release, err := c.buffers[other].table(opposing)
+		if err != nil {
+			return err
+		}
+		if table == nil {
+			continue
+		}
+		tbl, err := table.Table()
+		if err != nil {
+			release()
+			return err
+		}
+		cr := tbl.(flux.ColReader)
+		for i := 0; i < cr.Len(); i++ {
+			if rowKey, ok := c.joinKeyForRow(i, cr); ok {
+				matches.Set(rowKey, true)
+			}
+		}
+		tbl.Done()
+		release()
+	}
+
+	table, release, err := c.buffers[id].table(key)
+	if err != nil {
+		return err
+	}
+	defer release()
+	tbl, err := table.Table()
+	if err != nil {
+		return err
+	}
+	defer tbl.Done()
+	cr := tbl.(flux.ColReader)
+
+	var b *execute.ColListTableBuilder
+	for i := 0; i < cr.Len(); i++ {
+		if rowKey, ok := c.joinKeyForRow(i, cr); ok {
+			if _, found := matches.Lookup(rowKey); found {
+				continue
+			}
+		}
+		if b == nil {
+			if b, err = builder(); err != nil {
+				return err
+			}
+		}
+
+		appended := make([]bool, len(c.schema.columns))
+		for j, column := range cr.Cols() {
+			newColumn, ok := c.schemaMap[tableCol{
+				table: c.names[id],
+				col:   column.Label,
+			}]
+			if !ok {
+				continue
+			}
+			newColumnIdx := c.colIndex[newColumn]
+			if err := appendJoinValue(b, newColumnIdx, execute.ValueForRow(cr, i, j)); err != nil {
+				return err
+			}
+			appended[newColumnIdx] = true
+		}
+		for j, ok := range appended {
+			if !ok {
+				if err := b.AppendNil(j); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// joinKeyForRow returns the values of the join columns for a single row,
+// ordered by column label. It returns false if any of them is null,
+// since a null value never matches another value in a join.
+func (c *MergeJoinCache) joinKeyForRow(i int, cr flux.ColReader) (flux.GroupKey, bool) {
+	cols := make([]flux.ColMeta, 0, len(c.order))
+	vals := make([]values.Value, 0, len(c.order))
+	for _, label := range c.order {
+		j := execute.ColIdx(label, cr.Cols())
+		if j < 0 {
+			return nil, false
+		}
+		v := execute.ValueForRow(cr, i, j)
+		if v.IsNull() {
+			return nil, false
+		}
+		cols = append(cols, cr.Cols()[j])
+		vals = append(vals, v)
+	}
+	return execute.NewGroupKey(cols, vals), true
+}
+
+// outerGroupKey produces the group key for the unmatched rows of a table from
+// the stream associated with id. The group key columns of the opposing stream
+// are part of the output group key, but are null.
+func (c *MergeJoinCache) outerGroupKey(id execute.DatasetID, key flux.GroupKey) flux.GroupKey {
+	other := c.opposing(id)
+	outputKey := groupKey{
+		cols: make([]flux.ColMeta, 0, len(key.Cols())+len(c.schemas[other].key)),
+		vals: make([]values.Value, 0, len(key.Cols())+len(c.schemas[other].key)),
+	}
+
+	added := make(map[string]bool, cap(outputKey.cols))
+	for j, column := range key.Cols() {
+		colMeta := c.schemaMap[tableCol{
+			table: c.names[id],
+			col:   column.Label,
+		}]
+		if !added[colMeta.Label] {
+			outputKey.cols = append(outputKey.cols, colMeta)
+			outputKey.vals = append(outputKey.vals, key.Value(j))
+		}
+		added[colMeta.Label] = true
+	}
+	for _, column := range c.schemas[other].key {
+		colMeta := c.schemaMap[tableCol{
+			table: c.names[other],
+			col:   column.Label,
+		}]
+		if !added[colMeta.Label] {
+			outputKey.cols = append(outputKey.cols, colMeta)
+			outputKey.vals = append(outputKey.vals, values.NewNull(flux.SemanticType(colMeta.Type)))
+		}
+		added[colMeta.Label] = true
+	}
+
+	sort.Sort(outputKey)
+	return execute.NewGroupKey(outputKey.cols, outputKey.vals)
 }
 
 // postJoinGroupKey produces a new group key value from a left and a right group key value
@@ -0,0 +1,8 @@
+package universe
+
+import "github.com/influxdata/flux/internal/execute/table"
+
+// SetSpiller is exposed so the tests can make the join spill its buffers.
+func (c *MergeJoinCache) SetSpiller(spiller *table.Spiller) {
+	c.setSpiller(spiller)
+}
@@ -2,14 +2,22 @@ package universe_test
 
 import (
 	"errors"
+	"io/ioutil"
+	"math"
+	"os"
+	"path/filepath"
 	"sort"
 	"testing"
 	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/codes"
+	"github.com/influxdata/flux/dependencies/filesystem"
 	"github.com/influxdata/flux/execute"
 	"github.com/influxdata/flux/execute/executetest"
+	"github.com/influxdata/flux/internal/execute/table"
+	"github.com/influxdata/flux/memory"
 	"github.com/influxdata/flux/plan"
 	"github.com/influxdata/flux/querytest"
 	"github.com/influxdata/flux/stdlib/influxdata/influxdb"
@@ -171,6 +179,15 @@ func TestJoin_NewQuery(t *testing.T) {
 			`,
 			WantErr: true,
 		},
+		{
+			Name: "unknown method",
+			Raw: `
+				a = from(bucket:"flux") |> range(start:-1h)
+				b = from(bucket:"flux") |> range(start:-1h)
+				join(tables:{a:a,b:b}, on: ["_time"], method: "outer")
+			`,
+			WantErr: true,
+		},
 	}
 	for _, tc := range tests {
 		tc := tc
@@ -1473,6 +1490,351 @@ func TestMergeJoin_Process(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "simple left",
+			spec: &universe.MergeJoinProcedureSpec{
+				On:         []string{"_time"},
+				TableNames: tableNames,
+				Method:     "left",
+			},
+			data0: []*executetest.Table{
+				{
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value", Type: flux.TFloat},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), 1.0},
+						{execute.Time(2), 2.0},
+						{execute.Time(3), 3.0},
+					},
+				},
+			},
+			data1: []*executetest.Table{
+				{
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value", Type: flux.TFloat},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), 10.0},
+						{execute.Time(3), 30.0},
+						{execute.Time(4), 40.0},
+					},
+				},
+			},
+			want: []*executetest.Table{
+				{
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value_a", Type: flux.TFloat},
+						{Label: "_value_b", Type: flux.TFloat},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), 1.0, 10.0},
+						{execute.Time(3), 3.0, 30.0},
+						{execute.Time(2), 2.0, nil},
+					},
+				},
+			},
+		},
+		{
+			name: "simple right",
+			spec: &universe.MergeJoinProcedureSpec{
+				On:         []string{"_time"},
+				TableNames: tableNames,
+				Method:     "right",
+			},
+			data0: []*executetest.Table{
+				{
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value", Type: flux.TFloat},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), 1.0},
+						{execute.Time(2), 2.0},
+						{execute.Time(3), 3.0},
+					},
+				},
+			},
+			data1: []*executetest.Table{
+				{
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value", Type: flux.TFloat},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), 10.0},
+						{execute.Time(3), 30.0},
+						{execute.Time(4), 40.0},
+					},
+				},
+			},
+			want: []*executetest.Table{
+				{
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value_a", Type: flux.TFloat},
+						{Label: "_value_b", Type: flux.TFloat},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), 1.0, 10.0},
+						{execute.Time(3), 3.0, 30.0},
+						{execute.Time(4), nil, 40.0},
+					},
+				},
+			},
+		},
+		{
+			name: "full with nulls in join columns",
+			spec: &universe.MergeJoinProcedureSpec{
+				On:         []string{"_time"},
+				TableNames: tableNames,
+				Method:     "full",
+			},
+			data0: []*executetest.Table{
+				{
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value", Type: flux.TFloat},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), 1.0},
+						{nil, 2.0},
+					},
+				},
+			},
+			data1: []*executetest.Table{
+				{
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value", Type: flux.TFloat},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), 10.0},
+						{nil, 20.0},
+					},
+				},
+			},
+			want: []*executetest.Table{
+				{
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value_a", Type: flux.TFloat},
+						{Label: "_value_b", Type: flux.TFloat},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), 1.0, 10.0},
+						{nil, 2.0, nil},
+						{nil, nil, 20.0},
+					},
+				},
+			},
+		},
+		{
+			name: "full with heterogeneous schemas",
+			spec: &universe.MergeJoinProcedureSpec{
+				On:         []string{"_time"},
+				TableNames: tableNames,
+				Method:     "full",
+			},
+			data0: []*executetest.Table{
+				{
+					KeyCols: []string{"host"},
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value", Type: flux.TFloat},
+						{Label: "host", Type: flux.TString},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), 1.0, "a"},
+						{execute.Time(2), 2.0, "a"},
+					},
+				},
+			},
+			data1: []*executetest.Table{
+				{
+					KeyCols: []string{"region"},
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value", Type: flux.TInt},
+						{Label: "region", Type: flux.TString},
+						{Label: "status", Type: flux.TString},
+					},
+					Data: [][]interface{}{
+						{execute.Time(2), int64(20), "x", "ok"},
+						{execute.Time(3), int64(30), "x", "crit"},
+					},
+				},
+			},
+			want: []*executetest.Table{
+				{
+					KeyCols: []string{"host", "region"},
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value_a", Type: flux.TFloat},
+						{Label: "_value_b", Type: flux.TInt},
+						{Label: "host", Type: flux.TString},
+						{Label: "region", Type: flux.TString},
+						{Label: "status", Type: flux.TString},
+					},
+					Data: [][]interface{}{
+						{execute.Time(2), 2.0, int64(20), "a", "x", "ok"},
+					},
+				},
+				{
+					KeyCols:   []string{"host", "region"},
+					KeyValues: []interface{}{"a", nil},
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value_a", Type: flux.TFloat},
+						{Label: "_value_b", Type: flux.TInt},
+						{Label: "host", Type: flux.TString},
+						{Label: "region", Type: flux.TString},
+						{Label: "status", Type: flux.TString},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), 1.0, nil, "a", nil, nil},
+					},
+				},
+				{
+					KeyCols:   []string{"host", "region"},
+					KeyValues: []interface{}{nil, "x"},
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value_a", Type: flux.TFloat},
+						{Label: "_value_b", Type: flux.TInt},
+						{Label: "host", Type: flux.TString},
+						{Label: "region", Type: flux.TString},
+						{Label: "status", Type: flux.TString},
+					},
+					Data: [][]interface{}{
+						{execute.Time(3), nil, int64(30), nil, "x", "crit"},
+					},
+				},
+			},
+		},
+		{
+			name: "left with multiple tables in right stream",
+			spec: &universe.MergeJoinProcedureSpec{
+				On:         []string{"_time"},
+				TableNames: tableNames,
+				Method:     "left",
+			},
+			data0: []*executetest.Table{
+				{
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value", Type: flux.TFloat},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), 1.0},
+						{execute.Time(2), 2.0},
+						{execute.Time(3), 3.0},
+					},
+				},
+			},
+			data1: []*executetest.Table{
+				{
+					KeyCols: []string{"region"},
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value", Type: flux.TFloat},
+						{Label: "region", Type: flux.TString},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), 10.0, "x"},
+					},
+				},
+				{
+					KeyCols: []string{"region"},
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value", Type: flux.TFloat},
+						{Label: "region", Type: flux.TString},
+					},
+					Data: [][]interface{}{
+						{execute.Time(2), 20.0, "y"},
+					},
+				},
+			},
+			want: []*executetest.Table{
+				{
+					KeyCols: []string{"region"},
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value_a", Type: flux.TFloat},
+						{Label: "_value_b", Type: flux.TFloat},
+						{Label: "region", Type: flux.TString},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), 1.0, 10.0, "x"},
+					},
+				},
+				{
+					KeyCols: []string{"region"},
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value_a", Type: flux.TFloat},
+						{Label: "_value_b", Type: flux.TFloat},
+						{Label: "region", Type: flux.TString},
+					},
+					Data: [][]interface{}{
+						{execute.Time(2), 2.0, 20.0, "y"},
+					},
+				},
+				{
+					KeyCols:   []string{"region"},
+					KeyValues: []interface{}{nil},
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value_a", Type: flux.TFloat},
+						{Label: "_value_b", Type: flux.TFloat},
+						{Label: "region", Type: flux.TString},
+					},
+					Data: [][]interface{}{
+						{execute.Time(3), 3.0, nil, nil},
+					},
+				},
+			},
+		},
+		{
+			name: "left with empty right stream",
+			spec: &universe.MergeJoinProcedureSpec{
+				On:         []string{"_time"},
+				TableNames: tableNames,
+				Method:     "left",
+			},
+			data0: []*executetest.Table{
+				{
+					KeyCols: []string{"host"},
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value", Type: flux.TFloat},
+						{Label: "host", Type: flux.TString},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), 1.0, "a"},
+						{execute.Time(2), 2.0, "a"},
+					},
+				},
+			},
+			want: []*executetest.Table{
+				{
+					KeyCols: []string{"host"},
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value", Type: flux.TFloat},
+						{Label: "host", Type: flux.TString},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), 1.0, "a"},
+						{execute.Time(2), 2.0, "a"},
+					},
+				},
+			},
+		},
 		{
 			name: "two failures",
 			spec: &universe.MergeJoinProcedureSpec{
@@ -1520,7 +1882,7 @@ func TestMergeJoin_Process(t *testing.T) {
 			}
 
 			d := executetest.NewDataset(executetest.RandomDatasetID())
-			c := universe.NewMergeJoinCache(executetest.UnlimitedAllocator, parents, tableNames, tc.spec.On)
+			c := universe.NewMergeJoinCache(executetest.UnlimitedAllocator, parents, tableNames, tc.spec.On, tc.spec.Method)
 			c.SetTriggerSpec(plan.DefaultTriggerSpec)
 			jt := universe.NewMergeJoinTransformation(d, c, tc.spec, parents, tableNames)
 
@@ -1561,3 +1923,257 @@ func TestMergeJoin_Process(t *testing.T) {
 		})
 	}
 }
+
+func TestMergeJoin_Spill(t *testing.T) {
+	// Tables can only be read once, so each test case creates its own.
+	left := func() *executetest.Table {
+		return &executetest.Table{
+			ColMeta: []flux.ColMeta{
+				{Label: "_time", Type: flux.TTime},
+				{Label: "_value", Type: flux.TFloat},
+			},
+			Data: [][]interface{}{
+				{execute.Time(1), 1.0},
+				{execute.Time(2), 2.0},
+			},
+		}
+	}
+	right := func() *executetest.Table {
+		return &executetest.Table{
+			ColMeta: []flux.ColMeta{
+				{Label: "_time", Type: flux.TTime},
+				{Label: "_value", Type: flux.TFloat},
+			},
+			Data: [][]interface{}{
+				{execute.Time(2), 20.0},
+				{execute.Time(3), 30.0},
+			},
+		}
+	}
+
+	for _, tt := range []struct {
+		name   string
+		method string
+		// remove removes the spill files before the join finishes.
+		remove bool
+		want   []*executetest.Table
+	}{
+		{
+			name:   "inner",
+			method: "inner",
+			want: []*executetest.Table{{
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_value_a", Type: flux.TFloat},
+					{Label: "_value_b", Type: flux.TFloat},
+				},
+				Data: [][]interface{}{
+					{execute.Time(2), 2.0, 20.0},
+				},
+			}},
+		},
+		{
+			name:   "left",
+			method: "left",
+			want: []*executetest.Table{{
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_value_a", Type: flux.TFloat},
+					{Label: "_value_b", Type: flux.TFloat},
+				},
+				Data: [][]interface{}{
+					{execute.Time(2), 2.0, 20.0},
+					{execute.Time(1), 1.0, nil},
+				},
+			}},
+		},
+		{
+			name:   "inner read error",
+			method: "inner",
+			remove: true,
+		},
+		{
+			name:   "left read error",
+			method: "left",
+			remove: true,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			dir, err := ioutil.TempDir("", "flux-join-spill")
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer func() { _ = os.RemoveAll(dir) }()
+
+			// The smallest threshold makes the join spill after every table.
+			limit := int64(1 << 30)
+			alloc := &memory.Allocator{Limit: &limit}
+			parents := []execute.DatasetID{executetest.RandomDatasetID(), executetest.RandomDatasetID()}
+			tableNames := map[execute.DatasetID]string{parents[0]: "a", parents[1]: "b"}
+			spec := &universe.MergeJoinProcedureSpec{
+				On:         []string{"_time"},
+				TableNames: []string{"a", "b"},
+				Method:     tt.method,
+			}
+			c := universe.NewMergeJoinCache(alloc, parents, tableNames, spec.On, spec.Method)
+			c.SetSpiller(&table.Spiller{
+				FS:        filesystem.SystemFS,
+				Dir:       dir,
+				Allocator: alloc,
+				Threshold: math.SmallestNonzeroFloat64,
+			})
+			c.SetTriggerSpec(plan.DefaultTriggerSpec)
+			d := execute.NewDataset(executetest.RandomDatasetID(), execute.DiscardingMode, c)
+			store := executetest.NewDataStore()
+			d.AddTransformation(store)
+			jt := universe.NewMergeJoinTransformation(d, c, spec, parents, tableNames)
+
+			if err := jt.Process(parents[0], left()); err != nil {
+				t.Fatal(err)
+			}
+			if err := jt.Process(parents[1], right()); err != nil {
+				t.Fatal(err)
+			}
+
+			files, err := filepath.Glob(filepath.Join(dir, "*"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(files) == 0 {
+				t.Fatal("expected the join to spill its buffers")
+			}
+			if tt.remove {
+				for _, f := range files {
+					if err := os.Remove(f); err != nil {
+						t.Fatal(err)
+					}
+				}
+			}
+
+			jt.Finish(parents[0], nil)
+			jt.Finish(parents[1], nil)
+
+			if tt.remove {
+				if store.Err() == nil {
+					t.Fatal("expected an error reading the spilled tables")
+				}
+				return
+			}
+			if err := store.Err(); err != nil {
+				t.Fatal(err)
+			}
+			got, err := executetest.TablesFromCache(store)
+			if err != nil {
+				t.Fatal(err)
+			}
+			executetest.NormalizeTables(got)
+			executetest.NormalizeTables(tt.want)
+			if !cmp.Equal(tt.want, got) {
+				t.Errorf("unexpected tables -want/+got\n%s", cmp.Diff(tt.want, got))
+			}
+		})
+	}
+}
+
+func TestMergeJoin_AppendError(t *testing.T) {
+	// The second table of the left stream has an int value, but the
+	// post-join schema is built from the first table, which has a float value.
+	left := func() []*executetest.Table {
+		return []*executetest.Table{
+			{
+				KeyCols: []string{"t0"},
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_value", Type: flux.TFloat},
+					{Label: "t0", Type: flux.TString},
+				},
+				Data: [][]interface{}{
+					{execute.Time(1), 1.0, "a"},
+				},
+			},
+			{
+				KeyCols: []string{"t0"},
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_value", Type: flux.TInt},
+					{Label: "t0", Type: flux.TString},
+				},
+				Data: [][]interface{}{
+					{execute.Time(1), int64(2), "b"},
+				},
+			},
+		}
+	}
+	right := func(tags ...string) []*executetest.Table {
+		tables := make([]*executetest.Table, 0, len(tags))
+		for _, tag := range tags {
+			tables = append(tables, &executetest.Table{
+				KeyCols: []string{"t0"},
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_value", Type: flux.TFloat},
+					{Label: "t0", Type: flux.TString},
+				},
+				Data: [][]interface{}{
+					{execute.Time(1), 10.0, tag},
+				},
+			})
+		}
+		return tables
+	}
+
+	for _, tt := range []struct {
+		name   string
+		method string
+		right  []*executetest.Table
+	}{
+		{
+			name:   "inner",
+			method: "inner",
+			right:  right("a", "b"),
+		},
+		{
+			name:   "left matched",
+			method: "left",
+			right:  right("a", "b"),
+		},
+		{
+			name:   "left unmatched",
+			method: "left",
+			right:  right("a"),
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			parents := []execute.DatasetID{executetest.RandomDatasetID(), executetest.RandomDatasetID()}
+			tableNames := map[execute.DatasetID]string{parents[0]: "a", parents[1]: "b"}
+			spec := &universe.MergeJoinProcedureSpec{
+				On:         []string{"_time", "t0"},
+				TableNames: []string{"a", "b"},
+				Method:     tt.method,
+			}
+			c := universe.NewMergeJoinCache(executetest.UnlimitedAllocator, parents, tableNames, spec.On, spec.Method)
+			c.SetTriggerSpec(plan.DefaultTriggerSpec)
+			d := execute.NewDataset(executetest.RandomDatasetID(), execute.DiscardingMode, c)
+			store := executetest.NewDataStore()
+			d.AddTransformation(store)
+			jt := universe.NewMergeJoinTransformation(d, c, spec, parents, tableNames)
+
+			for _, tbl := range left() {
+				if err := jt.Process(parents[0], tbl); err != nil {
+					t.Fatal(err)
+				}
+			}
+			for _, tbl := range tt.right {
+				if err := jt.Process(parents[1], tbl); err != nil {
+					t.Fatal(err)
+				}
+			}
+			jt.Finish(parents[0], nil)
+			jt.Finish(parents[1], nil)
+
+			if want, got := codes.Invalid, flux.ErrorCode(store.Err()); want != got {
+				t.Errorf("unexpected error code -want/+got:\n\t- %s\n\t+ %s", want, got)
+			}
+		})
+	}
+}
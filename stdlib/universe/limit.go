@@ -69,7 +69,6 @@ func (s *LimitOpSpec) Kind() flux.OperationKind {
 }
 
 type LimitProcedureSpec struct {
-	plan.DefaultCost
 	N      int64 `json:"n"`
 	Offset int64 `json:"offset"`
 }
@@ -94,6 +93,35 @@ func (s *LimitProcedureSpec) Copy() plan.ProcedureSpec {
 	return ns
 }
 
+// Cost reports that at most n rows are kept for each table.
+func (s *LimitProcedureSpec) Cost(inStats []plan.Statistics) (plan.Cost, plan.Statistics) {
+	stats := limitStatistics(plan.SumStatistics(inStats), s.N, s.Offset)
+	return plan.Cost{CPU: stats.Rows()}, stats
+}
+
+// limitStatistics estimates the rows that remain when n rows
+// are kept from each table after skipping offset rows.
+func limitStatistics(stats plan.Statistics, n, offset int64) plan.Statistics {
+	if !stats.IsKnown() {
+		// The number of rows is still bounded when the number
+		// of tables is known.
+		if stats.GroupCardinality >= 0 {
+			stats.Cardinality = n * stats.GroupCardinality
+		}
+		return stats
+	}
+	groups := stats.Groups()
+	rows := stats.Cardinality - offset*groups
+	if rows < 0 {
+		rows = 0
+	}
+	if max := n * groups; rows > max {
+		rows = max
+	}
+	stats.Cardinality = rows
+	return stats
+}
+
 // TriggerSpec implements plan.TriggerAwareProcedureSpec
 func (s *LimitProcedureSpec) TriggerSpec() plan.TriggerSpec {
 	return plan.NarrowTransformationTriggerSpec{}
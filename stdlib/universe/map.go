@@ -4,12 +4,15 @@ import (
 	"context"
 	"sort"
 
+	"github.com/apache/arrow/go/arrow/array"
 	"github.com/influxdata/flux"
 	"github.com/influxdata/flux/codes"
 	"github.com/influxdata/flux/compiler"
 	"github.com/influxdata/flux/execute"
 	"github.com/influxdata/flux/internal/errors"
+	"github.com/influxdata/flux/internal/execute/table"
 	"github.com/influxdata/flux/interpreter"
+	"github.com/influxdata/flux/memory"
 	"github.com/influxdata/flux/plan"
 	"github.com/influxdata/flux/semantic"
 	"github.com/influxdata/flux/values"
@@ -114,7 +117,7 @@ func createMapTransformation(id execute.DatasetID, mode execute.AccumulationMode
 	}
 	cache := execute.NewTableBuilderCache(a.Allocator())
 	d := execute.NewDataset(id, mode, cache)
-	t, err := NewMapTransformation(a.Context(), s, d, cache)
+	t, err := NewMapTransformation(a.Context(), s, d, cache, a.Allocator())
 
 	if err != nil {
 		return nil, nil, err
@@ -128,9 +131,10 @@ type mapTransformation struct {
 	ctx      context.Context
 	fn       *execute.RowMapFn
 	mergeKey bool
+	alloc    *memory.Allocator
 }
 
-func NewMapTransformation(ctx context.Context, spec *MapProcedureSpec, d execute.Dataset, cache execute.TableBuilderCache) (*mapTransformation, error) {
+func NewMapTransformation(ctx context.Context, spec *MapProcedureSpec, d execute.Dataset, cache execute.TableBuilderCache, alloc *memory.Allocator) (*mapTransformation, error) {
 	fn, err := execute.NewRowMapFn(spec.Fn.Fn, compiler.ToScope(spec.Fn.Scope))
 	if err != nil {
 		return nil, err
@@ -141,6 +145,7 @@ func NewMapTransformation(ctx context.Context, spec *MapProcedureSpec, d execute
 		fn:       fn,
 		ctx:      ctx,
 		mergeKey: spec.MergeKey,
+		alloc:    alloc,
 	}, nil
 }
 
@@ -168,6 +173,12 @@ func (t *mapTransformation) Process(id execute.DatasetID, tbl flux.Table) error
 
 	var on map[string]bool
 	return tbl.Do(func(cr flux.ColReader) error {
+		if t.fn.Vectorized() {
+			if ok, err := t.mapColumns(tbl, cr, properties, &on); err != nil || ok {
+				return err
+			}
+		}
+
 		l := cr.Len()
 		for i := 0; i < l; i++ {
 			m, err := t.fn.Eval(t.ctx, i, cr)
@@ -196,52 +207,14 @@ func (t *mapTransformation) Process(id execute.DatasetID, tbl flux.Table) error
 
 			// If we haven't determined the columns to group on, do that now.
 			if on == nil {
-				on = make(map[string]bool, len(tbl.Key().Cols()))
-				for _, c := range tbl.Key().Cols() {
-					if !t.mergeKey {
-						// If the label isn't included in the properties,
-						// then it wasn't returned by the eval.
-						if _, ok := properties[c.Label]; !ok {
-							continue
-						}
-					}
-					on[c.Label] = true
-				}
+				on = t.groupOn(tbl, properties)
 			}
 
 			key := groupKeyForObject(i, cr, m, on)
 			builder, created := t.cache.TableBuilder(key)
 			if created {
-				if t.mergeKey {
-					if err := execute.AddTableKeyCols(tbl.Key(), builder); err != nil {
-						return err
-					}
-				}
-
-				// Add columns from function in sorted order.
-				keys := make([]string, 0, len(properties))
-				for k := range properties {
-					keys = append(keys, k)
-				}
-				sort.Strings(keys)
-
-				for _, k := range keys {
-					if t.mergeKey && tbl.Key().HasCol(k) {
-						continue
-					}
-
-					n := properties[k].Nature()
-					if n == semantic.Nil {
-						// If the column is null, then do not add it as a column.
-						continue
-					}
-
-					if _, err := builder.AddCol(flux.ColMeta{
-						Label: k,
-						Type:  execute.ConvertFromKind(n),
-					}); err != nil {
-						return err
-					}
+				if err := t.addCols(tbl, builder, properties); err != nil {
+					return err
 				}
 			}
 			for j, c := range builder.Cols() {
@@ -263,6 +236,146 @@ func (t *mapTransformation) Process(id execute.DatasetID, tbl flux.Table) error
 	})
 }
 
+// mapColumns evaluates the function for all of the rows of the column reader at once.
+// It reports false when the rows must be evaluated one at a time instead,
+// either because evaluating a row failed or because the function
+// modifies a column that is part of the group key.
+func (t *mapTransformation) mapColumns(tbl flux.Table, cr flux.ColReader, properties map[string]semantic.Type, on *map[string]bool) (bool, error) {
+	if cr.Len() == 0 {
+		return true, nil
+	}
+	cols, arrs, err := t.fn.EvalColumns(t.ctx, cr, t.alloc)
+	if err != nil {
+		return false, nil
+	}
+	defer func() {
+		for _, arr := range arrs {
+			arr.Release()
+		}
+	}()
+
+	for _, c := range cols {
+		properties[c.Label] = execute.ConvertToKind(c.Type)
+	}
+	if *on == nil {
+		*on = t.groupOn(tbl, properties)
+	}
+
+	// The rows share the group key of the table as long as
+	// the function passes the key columns through unchanged.
+	keyCols := make([]flux.ColMeta, 0, len(*on))
+	keyValues := make([]values.Value, 0, len(*on))
+	for _, c := range cr.Cols() {
+		if !(*on)[c.Label] {
+			continue
+		}
+		if j := execute.ColIdx(c.Label, cols); j >= 0 && arrs[j] != table.Values(cr, execute.ColIdx(c.Label, cr.Cols())) {
+			return false, nil
+		}
+		keyCols = append(keyCols, c)
+		keyValues = append(keyValues, tbl.Key().LabelValue(c.Label))
+	}
+
+	builder, created := t.cache.TableBuilder(execute.NewGroupKey(keyCols, keyValues))
+	if created {
+		if err := t.addCols(tbl, builder, properties); err != nil {
+			return false, err
+		}
+	}
+	for j, c := range builder.Cols() {
+		idx := execute.ColIdx(c.Label, cols)
+		if idx < 0 {
+			if idx := execute.ColIdx(c.Label, tbl.Key().Cols()); t.mergeKey && idx >= 0 {
+				v := tbl.Key().Value(idx)
+				for i, l := 0, cr.Len(); i < l; i++ {
+					if err := builder.AppendValue(j, v); err != nil {
+						return false, err
+					}
+				}
+				continue
+			}
+			// This should be unreachable
+			return false, errors.Newf(codes.Internal, "could not find value for column %q", c.Label)
+		}
+		if err := appendArray(builder, j, c.Type, arrs[idx]); err != nil {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+// groupOn determines the columns of the group key that are
+// used to group the records returned by the function.
+func (t *mapTransformation) groupOn(tbl flux.Table, properties map[string]semantic.Type) map[string]bool {
+	on := make(map[string]bool, len(tbl.Key().Cols()))
+	for _, c := range tbl.Key().Cols() {
+		if !t.mergeKey {
+			// If the label isn't included in the properties,
+			// then it wasn't returned by the eval.
+			if _, ok := properties[c.Label]; !ok {
+				continue
+			}
+		}
+		on[c.Label] = true
+	}
+	return on
+}
+
+// addCols adds the columns for the properties to a new table builder.
+func (t *mapTransformation) addCols(tbl flux.Table, builder execute.TableBuilder, properties map[string]semantic.Type) error {
+	if t.mergeKey {
+		if err := execute.AddTableKeyCols(tbl.Key(), builder); err != nil {
+			return err
+		}
+	}
+
+	// Add columns from function in sorted order.
+	keys := make([]string, 0, len(properties))
+	for k := range properties {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		if t.mergeKey && tbl.Key().HasCol(k) {
+			continue
+		}
+
+		n := properties[k].Nature()
+		if n == semantic.Nil {
+			// If the column is null, then do not add it as a column.
+			continue
+		}
+
+		if _, err := builder.AddCol(flux.ColMeta{
+			Label: k,
+			Type:  execute.ConvertFromKind(n),
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func appendArray(builder execute.TableBuilder, j int, typ flux.ColType, arr array.Interface) error {
+	switch typ {
+	case flux.TBool:
+		return builder.AppendBools(j, arr.(*array.Boolean))
+	case flux.TInt:
+		return builder.AppendInts(j, arr.(*array.Int64))
+	case flux.TUInt:
+		return builder.AppendUInts(j, arr.(*array.Uint64))
+	case flux.TFloat:
+		return builder.AppendFloats(j, arr.(*array.Float64))
+	case flux.TString:
+		return builder.AppendStrings(j, arr.(*array.Binary))
+	case flux.TTime:
+		return builder.AppendTimes(j, arr.(*array.Int64))
+	default:
+		return errors.Newf(codes.Internal, "invalid column type %s", typ)
+	}
+}
+
 func groupKeyForObject(i int, cr flux.ColReader, obj values.Object, on map[string]bool) flux.GroupKey {
 	cols := make([]flux.ColMeta, 0, len(on))
 	vs := make([]values.Value, 0, len(on))
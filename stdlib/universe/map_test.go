@@ -10,7 +10,9 @@ import (
 	"github.com/influxdata/flux/dependencies/dependenciestest"
 	"github.com/influxdata/flux/execute"
 	"github.com/influxdata/flux/execute/executetest"
+	"github.com/influxdata/flux/internal/gen"
 	"github.com/influxdata/flux/interpreter"
+	"github.com/influxdata/flux/memory"
 	"github.com/influxdata/flux/querytest"
 	"github.com/influxdata/flux/semantic"
 	"github.com/influxdata/flux/stdlib/influxdata/influxdb"
@@ -1374,7 +1376,7 @@ func TestMap_Process(t *testing.T) {
 				tc.wantErr,
 				func(d execute.Dataset, c execute.TableBuilderCache) execute.Transformation {
 					ctx := dependenciestest.Default().Inject(context.Background())
-					f, err := universe.NewMapTransformation(ctx, tc.spec, d, c)
+					f, err := universe.NewMapTransformation(ctx, tc.spec, d, c, executetest.UnlimitedAllocator)
 					if err != nil {
 						t.Fatal(err)
 					}
@@ -1384,3 +1386,69 @@ func TestMap_Process(t *testing.T) {
 		})
 	}
 }
+
+func BenchmarkMap_Values(b *testing.B) {
+	b.Run("1000", func(b *testing.B) {
+		// fn: (r) => ({r with _value: r._value * 2.0})
+		benchmarkMap(b, 1000, &semantic.FunctionExpression{
+			Block: &semantic.FunctionBlock{
+				Parameters: &semantic.FunctionParameters{
+					List: []*semantic.FunctionParameter{
+						{Key: &semantic.Identifier{Name: "r"}},
+					},
+				},
+				Body: &semantic.ObjectExpression{
+					With: &semantic.IdentifierExpression{Name: "r"},
+					Properties: []*semantic.Property{
+						{
+							Key: &semantic.Identifier{Name: "_value"},
+							Value: &semantic.BinaryExpression{
+								Operator: ast.MultiplicationOperator,
+								Left: &semantic.MemberExpression{
+									Object:   &semantic.IdentifierExpression{Name: "r"},
+									Property: "_value",
+								},
+								Right: &semantic.FloatLiteral{Value: 2.0},
+							},
+						},
+					},
+				},
+			},
+		})
+	})
+}
+
+func benchmarkMap(b *testing.B, n int, fn *semantic.FunctionExpression) {
+	b.ReportAllocs()
+	spec := &universe.MapProcedureSpec{
+		Fn: interpreter.ResolvedFunction{
+			Fn:    fn,
+			Scope: values.NewScope(),
+		},
+		MergeKey: true,
+	}
+	executetest.ProcessBenchmarkHelper(b,
+		func(alloc *memory.Allocator) (flux.TableIterator, error) {
+			schema := gen.Schema{
+				NumPoints: n,
+				Alloc:     alloc,
+				Tags: []gen.Tag{
+					{Name: "_measurement", Cardinality: 1},
+					{Name: "_field", Cardinality: 6},
+					{Name: "t0", Cardinality: 100},
+					{Name: "t1", Cardinality: 50},
+				},
+			}
+			return gen.Input(schema)
+		},
+		func(id execute.DatasetID, alloc *memory.Allocator) (execute.Transformation, execute.Dataset) {
+			cache := execute.NewTableBuilderCache(alloc)
+			d := execute.NewDataset(id, execute.DiscardingMode, cache)
+			t, err := universe.NewMapTransformation(context.Background(), spec, d, cache, alloc)
+			if err != nil {
+				b.Fatal(err)
+			}
+			return t, d
+		},
+	)
+}
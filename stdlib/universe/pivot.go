@@ -180,6 +180,16 @@ func createPivotTransformation(id execute.DatasetID, mode execute.AccumulationMo
 	}
 
 	cache := execute.NewTableBuilderCache(a.Allocator())
+	if spiller := table.NewSpiller(a); spiller != nil {
+		// Spilled rows are sorted by the row key so that
+		// rows with the same row key can be combined when
+		// they are read back.
+		spillCache := table.NewSpillCache(cache, spiller, s.RowKey, false, true)
+		d := execute.NewDataset(id, mode, spillCache)
+		t := NewPivotTransformation(d, spillCache, s)
+		t.spill = spillCache
+		return t, d, nil
+	}
 	d := execute.NewDataset(id, mode, cache)
 	t := NewPivotTransformation(d, cache, s)
 	return t, d, nil
@@ -193,6 +203,7 @@ type rowCol struct {
 type pivotTransformation struct {
 	d     execute.Dataset
 	cache execute.TableBuilderCache
+	spill *table.SpillCache
 	spec  PivotProcedureSpec
 	// for each table, we need to store a map to keep track of which rows/columns have already been created.
 	colKeyMaps map[string]map[string]int
@@ -352,10 +363,28 @@ func (t *pivotTransformation) Process(id execute.DatasetID, tbl flux.Table) erro
 			}
 
 		}
+		if t.spill != nil && t.spill.ShouldSpill() {
+			return t.spillTables()
+		}
 		return nil
 	})
 }
 
+// spillTables writes the pivoted rows to disk to release memory.
+// The columns that have been created are kept, but the row keys are
+// forgotten so that new rows are created for any later values.
+// The rows with the same row key are combined when the table is read.
+func (t *pivotTransformation) spillTables() error {
+	if err := t.spill.Spill(); err != nil {
+		return err
+	}
+	for k := range t.rowKeyMaps {
+		t.rowKeyMaps[k] = make(map[string]int)
+		t.nextRowCol[k] = rowCol{nextCol: t.nextRowCol[k].nextCol}
+	}
+	return nil
+}
+
 func growColumn(builder execute.TableBuilder, colIdx, nRows int) error {
 	colType := builder.Cols()[colIdx].Type
 	switch colType {
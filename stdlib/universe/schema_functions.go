@@ -423,7 +423,7 @@ func (s *SchemaMutationProcedureSpec) Kind() plan.ProcedureKind {
 
 func (s *SchemaMutationProcedureSpec) Copy() plan.ProcedureSpec {
 	newMutations := make([]SchemaMutation, len(s.Mutations))
-	for i, m := range newMutations {
+	for i, m := range s.Mutations {
 		newMutations[i] = m.Copy()
 	}
 
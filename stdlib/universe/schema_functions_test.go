@@ -1573,6 +1573,34 @@ func TestDropRenameKeep_Process(t *testing.T) {
 	}
 }
 
+func TestRename_DoesNotModifyInput(t *testing.T) {
+	tbl := &executetest.Table{
+		ColMeta: []flux.ColMeta{
+			{Label: "a", Type: flux.TFloat},
+			{Label: "b", Type: flux.TFloat},
+		},
+		Data: [][]interface{}{
+			{1.0, 2.0},
+		},
+	}
+	m, err := universe.NewRenameMutator(&universe.RenameOpSpec{
+		Columns: map[string]string{"a": "_value"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	bctx := universe.NewBuilderContext(tbl)
+	if err := m.Mutate(context.Background(), bctx); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := bctx.Cols()[0].Label, "_value"; got != want {
+		t.Errorf("unexpected renamed column: got %q want %q", got, want)
+	}
+	if got, want := tbl.Cols()[0].Label, "a"; got != want {
+		t.Errorf("input column was modified: got %q want %q", got, want)
+	}
+}
+
 // TODO: determine SchemaMutationProcedureSpec pushdown/rewrite rules
 /*
 func TestRenameDrop_PushDown(t *testing.T) {
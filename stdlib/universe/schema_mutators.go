@@ -24,8 +24,13 @@ func NewBuilderContext(tbl flux.Table) *BuilderContext {
 		colMap[i] = i
 	}
 
+	// The columns are copied since the mutators modify them in place
+	// and the table may be shared with other transformations.
+	cols := make([]flux.ColMeta, len(tbl.Cols()))
+	copy(cols, tbl.Cols())
+
 	return &BuilderContext{
-		TableColumns: tbl.Cols(),
+		TableColumns: cols,
 		TableKey:     tbl.Key(),
 		ColIdxMap:    colMap,
 	}
@@ -5,6 +5,7 @@ import (
 	"github.com/influxdata/flux/codes"
 	"github.com/influxdata/flux/execute"
 	"github.com/influxdata/flux/internal/errors"
+	"github.com/influxdata/flux/internal/execute/table"
 	"github.com/influxdata/flux/interpreter"
 	"github.com/influxdata/flux/plan"
 	"github.com/influxdata/flux/semantic"
@@ -70,7 +71,6 @@ func (s *SortOpSpec) Kind() flux.OperationKind {
 }
 
 type SortProcedureSpec struct {
-	plan.DefaultCost
 	Columns []string
 	Desc    bool
 }
@@ -100,6 +100,13 @@ func (s *SortProcedureSpec) Copy() plan.ProcedureSpec {
 	return ns
 }
 
+// Cost reports that each table is buffered and sorted in memory.
+func (s *SortProcedureSpec) Cost(inStats []plan.Statistics) (plan.Cost, plan.Statistics) {
+	stats := plan.SumStatistics(inStats)
+	rows := stats.Rows()
+	return plan.Cost{CPU: plan.NLogN(rows), MEM: rows}, stats
+}
+
 // TriggerSpec implements plan.TriggerAwareProcedureSpec
 func (s *SortProcedureSpec) TriggerSpec() plan.TriggerSpec {
 	return plan.NarrowTransformationTriggerSpec{}
@@ -111,6 +118,13 @@ func createSortTransformation(id execute.DatasetID, mode execute.AccumulationMod
 		return nil, nil, errors.Newf(codes.Internal, "invalid spec type %T", spec)
 	}
 	cache := execute.NewTableBuilderCache(a.Allocator())
+	if spiller := table.NewSpiller(a); spiller != nil {
+		spillCache := table.NewSpillCache(cache, spiller, s.Columns, s.Desc, false)
+		d := execute.NewDataset(id, mode, spillCache)
+		t := NewSortTransformation(d, spillCache, s)
+		t.spill = spillCache
+		return t, d, nil
+	}
 	d := execute.NewDataset(id, mode, cache)
 	t := NewSortTransformation(d, cache, s)
 	return t, d, nil
@@ -119,6 +133,7 @@ func createSortTransformation(id execute.DatasetID, mode execute.AccumulationMod
 type sortTransformation struct {
 	d     execute.Dataset
 	cache execute.TableBuilderCache
+	spill *table.SpillCache
 
 	cols []string
 	desc bool
@@ -138,14 +153,7 @@ func (t *sortTransformation) RetractTable(id execute.DatasetID, key flux.GroupKe
 }
 
 func (t *sortTransformation) Process(id execute.DatasetID, tbl flux.Table) error {
-	key := tbl.Key()
-	for _, label := range t.cols {
-		if key.HasCol(label) {
-			key = t.sortedKey(key)
-			break
-		}
-	}
-
+	key := sortedKey(tbl.Key(), t.cols)
 	builder, created := t.cache.TableBuilder(key)
 	if !created {
 		return errors.Newf(codes.FailedPrecondition, "sort found duplicate table with key: %v", tbl.Key())
@@ -153,7 +161,7 @@ func (t *sortTransformation) Process(id execute.DatasetID, tbl flux.Table) error
 	if err := execute.AddTableCols(tbl, builder); err != nil {
 		return err
 	}
-	if err := execute.AppendTable(tbl, builder); err != nil {
+	if err := t.appendTable(tbl, builder); err != nil {
 		return err
 	}
 
@@ -161,6 +169,24 @@ func (t *sortTransformation) Process(id execute.DatasetID, tbl flux.Table) error
 	return nil
 }
 
+// appendTable appends the table to the builder. If the query is
+// close to its memory limit, the rows that have been appended are
+// sorted and spilled to disk so they can be merged back later.
+func (t *sortTransformation) appendTable(tbl flux.Table, builder execute.TableBuilder) error {
+	if t.spill == nil {
+		return execute.AppendTable(tbl, builder)
+	}
+	return tbl.Do(func(cr flux.ColReader) error {
+		if err := execute.AppendCols(cr, builder); err != nil {
+			return err
+		}
+		if t.spill.ShouldSpill() {
+			return t.spill.Spill()
+		}
+		return nil
+	})
+}
+
 func (t *sortTransformation) UpdateWatermark(id execute.DatasetID, mark execute.Time) error {
 	return t.d.UpdateWatermark(mark)
 }
@@ -171,11 +197,24 @@ func (t *sortTransformation) Finish(id execute.DatasetID, err error) {
 	t.d.Finish(err)
 }
 
-func (t *sortTransformation) sortedKey(key flux.GroupKey) flux.GroupKey {
+// sortedKey reorders the group key so the columns that are sorted
+// come first in the order they are sorted by.
+func sortedKey(key flux.GroupKey, sortCols []string) flux.GroupKey {
+	found := false
+	for _, label := range sortCols {
+		if key.HasCol(label) {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return key
+	}
+
 	cols := make([]flux.ColMeta, len(key.Cols()))
 	vs := make([]values.Value, len(key.Cols()))
 	j := 0
-	for _, label := range t.cols {
+	for _, label := range sortCols {
 		idx := execute.ColIdx(label, key.Cols())
 		if idx >= 0 {
 			cols[j] = key.Cols()[idx]
@@ -184,7 +223,7 @@ func (t *sortTransformation) sortedKey(key flux.GroupKey) flux.GroupKey {
 		}
 	}
 	for idx, c := range key.Cols() {
-		if !execute.ContainsStr(t.cols, c.Label) {
+		if !execute.ContainsStr(sortCols, c.Label) {
 			cols[j] = c
 			vs[j] = key.Value(idx)
 			j++
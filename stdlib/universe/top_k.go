@@ -0,0 +1,197 @@
+package universe
+
+import (
+	"math"
+
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/codes"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/internal/errors"
+	"github.com/influxdata/flux/plan"
+)
+
+// TopKKind is the kind for a sort followed by a limit
+// that only buffers the rows that can be part of the result.
+const TopKKind = "topK"
+
+func init() {
+	execute.RegisterTransformation(TopKKind, createTopKTransformation)
+	plan.RegisterPhysicalRules(SortLimitRule{})
+}
+
+// TopKProcedureSpec sorts each table and keeps n rows after skipping offset rows.
+// It is only created by the SortLimitRule.
+type TopKProcedureSpec struct {
+	Columns []string
+	Desc    bool
+	N       int64
+	Offset  int64
+}
+
+func (s *TopKProcedureSpec) Kind() plan.ProcedureKind {
+	return TopKKind
+}
+
+func (s *TopKProcedureSpec) Copy() plan.ProcedureSpec {
+	ns := new(TopKProcedureSpec)
+	*ns = *s
+	ns.Columns = make([]string, len(s.Columns))
+	copy(ns.Columns, s.Columns)
+	return ns
+}
+
+// Cost reports that a table is sorted each time twice as many rows
+// as are needed have been buffered.
+func (s *TopKProcedureSpec) Cost(inStats []plan.Statistics) (plan.Cost, plan.Statistics) {
+	in := plan.SumStatistics(inStats)
+	rows := in.Rows()
+	k := s.N + s.Offset
+	cost := plan.Cost{
+		CPU: rows,
+		MEM: rows,
+	}
+	if k > 0 {
+		cost.CPU = int64(2 * float64(rows) * math.Log2(float64(2*k)))
+	}
+	if buffered := 2 * k * in.Groups(); buffered < rows {
+		cost.MEM = buffered
+	}
+	return cost, limitStatistics(in, s.N, s.Offset)
+}
+
+// TriggerSpec implements plan.TriggerAwareProcedureSpec
+func (s *TopKProcedureSpec) TriggerSpec() plan.TriggerSpec {
+	return plan.NarrowTransformationTriggerSpec{}
+}
+
+// SortLimitRule replaces a sort followed by a limit with a top-k
+// when it is estimated to be cheaper.
+type SortLimitRule struct{}
+
+func (SortLimitRule) Name() string {
+	return "SortLimitRule"
+}
+
+func (SortLimitRule) Pattern() plan.Pattern {
+	return plan.Pat(LimitKind, plan.Pat(SortKind, plan.Any()))
+}
+
+func (SortLimitRule) Rewrite(node plan.Node) (plan.Node, bool, error) {
+	sortNode := node.Predecessors()[0]
+	sortSpec := sortNode.ProcedureSpec().(*SortProcedureSpec)
+	limitSpec := node.ProcedureSpec().(*LimitProcedureSpec)
+	topKSpec := &TopKProcedureSpec{
+		Columns: sortSpec.Columns,
+		Desc:    sortSpec.Desc,
+		N:       limitSpec.N,
+		Offset:  limitSpec.Offset,
+	}
+
+	preds := sortNode.Predecessors()
+	inStats := make([]plan.Statistics, len(preds))
+	for i, pred := range preds {
+		_, inStats[i] = plan.Estimate(pred)
+	}
+	sortCost, sortStats := sortSpec.Cost(inStats)
+	limitCost, _ := limitSpec.Cost([]plan.Statistics{sortStats})
+	topKCost, _ := topKSpec.Cost(inStats)
+	if topKCost.Total() >= plan.Add(sortCost, limitCost).Total() {
+		return node, false, nil
+	}
+
+	mergedNode, err := plan.MergeToPhysicalNode(node, sortNode, topKSpec.Copy().(*TopKProcedureSpec))
+	if err != nil {
+		return nil, false, err
+	}
+	return mergedNode, true, nil
+}
+
+func createTopKTransformation(id execute.DatasetID, mode execute.AccumulationMode, spec plan.ProcedureSpec, a execute.Administration) (execute.Transformation, execute.Dataset, error) {
+	s, ok := spec.(*TopKProcedureSpec)
+	if !ok {
+		return nil, nil, errors.Newf(codes.Internal, "invalid spec type %T", spec)
+	}
+	cache := execute.NewTableBuilderCache(a.Allocator())
+	d := execute.NewDataset(id, mode, cache)
+	t := NewTopKTransformation(d, cache, s)
+	return t, d, nil
+}
+
+type topKTransformation struct {
+	d     execute.Dataset
+	cache execute.TableBuilderCache
+
+	cols      []string
+	desc      bool
+	n, offset int64
+}
+
+// NewTopKTransformation creates a transformation that produces the same tables
+// as a sort followed by a limit while buffering fewer rows.
+func NewTopKTransformation(d execute.Dataset, cache execute.TableBuilderCache, spec *TopKProcedureSpec) *topKTransformation {
+	return &topKTransformation{
+		d:      d,
+		cache:  cache,
+		cols:   spec.Columns,
+		desc:   spec.Desc,
+		n:      spec.N,
+		offset: spec.Offset,
+	}
+}
+
+func (t *topKTransformation) RetractTable(id execute.DatasetID, key flux.GroupKey) error {
+	return t.d.RetractTable(key)
+}
+
+func (t *topKTransformation) Process(id execute.DatasetID, tbl flux.Table) error {
+	key := sortedKey(tbl.Key(), t.cols)
+	tb, created := t.cache.TableBuilder(key)
+	if !created {
+		return errors.Newf(codes.FailedPrecondition, "top-k found duplicate table with key: %v", tbl.Key())
+	}
+	builder, ok := tb.(*execute.ColListTableBuilder)
+	if !ok {
+		return errors.Newf(codes.Internal, "unexpected table builder type %T", tb)
+	}
+	if err := execute.AddTableCols(tbl, builder); err != nil {
+		return err
+	}
+
+	k := int(t.n + t.offset)
+	if err := tbl.Do(func(cr flux.ColReader) error {
+		if err := execute.AppendCols(cr, builder); err != nil {
+			return err
+		}
+		// Once twice as many rows as needed have been buffered,
+		// sort them and discard the rows that cannot be part of the result.
+		if builder.NRows()/2 >= k {
+			builder.Sort(t.cols, t.desc)
+			return builder.SliceColumns(0, k)
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	builder.Sort(t.cols, t.desc)
+	start, stop := int(t.offset), k
+	if nrows := builder.NRows(); stop > nrows {
+		stop = nrows
+	}
+	if start > stop {
+		start = stop
+	}
+	return builder.SliceColumns(start, stop)
+}
+
+func (t *topKTransformation) UpdateWatermark(id execute.DatasetID, mark execute.Time) error {
+	return t.d.UpdateWatermark(mark)
+}
+
+func (t *topKTransformation) UpdateProcessingTime(id execute.DatasetID, pt execute.Time) error {
+	return t.d.UpdateProcessingTime(pt)
+}
+
+func (t *topKTransformation) Finish(id execute.DatasetID, err error) {
+	t.d.Finish(err)
+}
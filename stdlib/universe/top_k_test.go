@@ -0,0 +1,197 @@
+package universe_test
+
+import (
+	"testing"
+
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/execute/executetest"
+	"github.com/influxdata/flux/plan"
+	"github.com/influxdata/flux/plan/plantest"
+	"github.com/influxdata/flux/stdlib/generate"
+	"github.com/influxdata/flux/stdlib/influxdata/influxdb"
+	"github.com/influxdata/flux/stdlib/universe"
+)
+
+func TestSortLimitRule(t *testing.T) {
+	var (
+		from  = &influxdb.FromProcedureSpec{Bucket: "my-bucket"}
+		small = &generate.FromGeneratorProcedureSpec{Count: 5}
+		sort  = &universe.SortProcedureSpec{Columns: []string{"_value"}, Desc: true}
+		limit = &universe.LimitProcedureSpec{N: 2, Offset: 1}
+		topK  = &universe.TopKProcedureSpec{Columns: []string{"_value"}, Desc: true, N: 2, Offset: 1}
+	)
+
+	tests := []plantest.RuleTestCase{
+		{
+			Name:  "unknown cardinality",
+			Rules: []plan.Rule{universe.SortLimitRule{}},
+			Before: &plantest.PlanSpec{
+				Nodes: []plan.Node{
+					plan.CreatePhysicalNode("from", from),
+					plan.CreatePhysicalNode("sort", sort),
+					plan.CreatePhysicalNode("limit", limit),
+				},
+				Edges: [][2]int{{0, 1}, {1, 2}},
+			},
+			After: &plantest.PlanSpec{
+				Nodes: []plan.Node{
+					plan.CreatePhysicalNode("from", from),
+					plan.CreatePhysicalNode("merged_sort_limit", topK),
+				},
+				Edges: [][2]int{{0, 1}},
+			},
+		},
+		{
+			Name:  "small input",
+			Rules: []plan.Rule{universe.SortLimitRule{}},
+			Before: &plantest.PlanSpec{
+				Nodes: []plan.Node{
+					plan.CreatePhysicalNode("generate", small),
+					plan.CreatePhysicalNode("sort", sort),
+					plan.CreatePhysicalNode("limit", limit),
+				},
+				Edges: [][2]int{{0, 1}, {1, 2}},
+			},
+			NoChange: true,
+		},
+		{
+			Name:  "sort used elsewhere",
+			Rules: []plan.Rule{universe.SortLimitRule{}},
+			Before: &plantest.PlanSpec{
+				Nodes: []plan.Node{
+					plan.CreatePhysicalNode("from", from),
+					plan.CreatePhysicalNode("sort", sort),
+					plan.CreatePhysicalNode("limit", limit),
+					plantest.CreatePhysicalMockNode("mock"),
+				},
+				Edges: [][2]int{{0, 1}, {1, 2}, {1, 3}},
+			},
+			NoChange: true,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			plantest.PhysicalRuleTestHelper(t, &tc)
+		})
+	}
+}
+
+func TestTopK_Process(t *testing.T) {
+	testCases := []struct {
+		name string
+		spec *universe.TopKProcedureSpec
+		data []flux.Table
+		want []*executetest.Table
+	}{
+		{
+			name: "one table",
+			spec: &universe.TopKProcedureSpec{
+				Columns: []string{"_value"},
+				N:       2,
+			},
+			data: []flux.Table{&executetest.Table{
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_value", Type: flux.TFloat},
+				},
+				Data: [][]interface{}{
+					{execute.Time(1), 5.0},
+					{execute.Time(2), 3.0},
+					{execute.Time(3), nil},
+					{execute.Time(4), 4.0},
+					{execute.Time(5), 1.0},
+					{execute.Time(6), 2.0},
+				},
+			}},
+			want: []*executetest.Table{{
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_value", Type: flux.TFloat},
+				},
+				Data: [][]interface{}{
+					{execute.Time(3), nil},
+					{execute.Time(5), 1.0},
+				},
+			}},
+		},
+		{
+			name: "descending with offset",
+			spec: &universe.TopKProcedureSpec{
+				Columns: []string{"_value"},
+				Desc:    true,
+				N:       2,
+				Offset:  1,
+			},
+			data: []flux.Table{&executetest.Table{
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_value", Type: flux.TFloat},
+				},
+				Data: [][]interface{}{
+					{execute.Time(1), 5.0},
+					{execute.Time(2), 3.0},
+					{execute.Time(3), 6.0},
+					{execute.Time(4), 4.0},
+					{execute.Time(5), 1.0},
+					{execute.Time(6), 2.0},
+					{execute.Time(7), 0.0},
+				},
+			}},
+			want: []*executetest.Table{{
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_value", Type: flux.TFloat},
+				},
+				Data: [][]interface{}{
+					{execute.Time(1), 5.0},
+					{execute.Time(4), 4.0},
+				},
+			}},
+		},
+		{
+			name: "offset past end",
+			spec: &universe.TopKProcedureSpec{
+				Columns: []string{"_value"},
+				N:       2,
+				Offset:  3,
+			},
+			data: []flux.Table{&executetest.Table{
+				KeyCols: []string{"t1"},
+				ColMeta: []flux.ColMeta{
+					{Label: "t1", Type: flux.TString},
+					{Label: "_value", Type: flux.TInt},
+				},
+				Data: [][]interface{}{
+					{"a", int64(2)},
+					{"a", int64(1)},
+				},
+			}},
+			want: []*executetest.Table{{
+				KeyCols:   []string{"t1"},
+				KeyValues: []interface{}{"a"},
+				ColMeta: []flux.ColMeta{
+					{Label: "t1", Type: flux.TString},
+					{Label: "_value", Type: flux.TInt},
+				},
+			}},
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			executetest.ProcessTestHelper(
+				t,
+				tc.data,
+				tc.want,
+				nil,
+				func(d execute.Dataset, c execute.TableBuilderCache) execute.Transformation {
+					return universe.NewTopKTransformation(d, c, tc.spec)
+				},
+			)
+		})
+	}
+}
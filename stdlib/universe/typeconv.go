@@ -80,6 +80,9 @@ func (c *stringConv) Regexp() *regexp.Regexp {
 func (c *stringConv) Array() values.Array {
 	panic(values.UnexpectedKind(semantic.Float, semantic.Array))
 }
+func (c *stringConv) Dict() values.Dictionary {
+	panic(values.UnexpectedKind(semantic.Float, semantic.Dictionary))
+}
 func (c *stringConv) Object() values.Object {
 	panic(values.UnexpectedKind(semantic.Float, semantic.Object))
 }
@@ -181,6 +184,9 @@ func (c *intConv) Regexp() *regexp.Regexp {
 func (c *intConv) Array() values.Array {
 	panic(values.UnexpectedKind(semantic.Float, semantic.Array))
 }
+func (c *intConv) Dict() values.Dictionary {
+	panic(values.UnexpectedKind(semantic.Float, semantic.Dictionary))
+}
 func (c *intConv) Object() values.Object {
 	panic(values.UnexpectedKind(semantic.Float, semantic.Object))
 }
@@ -277,6 +283,9 @@ func (c *uintConv) Regexp() *regexp.Regexp {
 func (c *uintConv) Array() values.Array {
 	panic(values.UnexpectedKind(semantic.Float, semantic.Array))
 }
+func (c *uintConv) Dict() values.Dictionary {
+	panic(values.UnexpectedKind(semantic.Float, semantic.Dictionary))
+}
 func (c *uintConv) Object() values.Object {
 	panic(values.UnexpectedKind(semantic.Float, semantic.Object))
 }
@@ -373,6 +382,9 @@ func (c *floatConv) Regexp() *regexp.Regexp {
 func (c *floatConv) Array() values.Array {
 	panic(values.UnexpectedKind(semantic.Float, semantic.Array))
 }
+func (c *floatConv) Dict() values.Dictionary {
+	panic(values.UnexpectedKind(semantic.Float, semantic.Dictionary))
+}
 func (c *floatConv) Object() values.Object {
 	panic(values.UnexpectedKind(semantic.Float, semantic.Object))
 }
@@ -465,6 +477,9 @@ func (c *boolConv) Regexp() *regexp.Regexp {
 func (c *boolConv) Array() values.Array {
 	panic(values.UnexpectedKind(semantic.Float, semantic.Array))
 }
+func (c *boolConv) Dict() values.Dictionary {
+	panic(values.UnexpectedKind(semantic.Float, semantic.Dictionary))
+}
 func (c *boolConv) Object() values.Object {
 	panic(values.UnexpectedKind(semantic.Float, semantic.Object))
 }
@@ -577,6 +592,9 @@ func (c *timeConv) Regexp() *regexp.Regexp {
 func (c *timeConv) Array() values.Array {
 	panic(values.UnexpectedKind(semantic.Float, semantic.Array))
 }
+func (c *timeConv) Dict() values.Dictionary {
+	panic(values.UnexpectedKind(semantic.Float, semantic.Dictionary))
+}
 func (c *timeConv) Object() values.Object {
 	panic(values.UnexpectedKind(semantic.Float, semantic.Object))
 }
@@ -663,6 +681,9 @@ func (c *durationConv) Regexp() *regexp.Regexp {
 func (c *durationConv) Array() values.Array {
 	panic(values.UnexpectedKind(semantic.Float, semantic.Array))
 }
+func (c *durationConv) Dict() values.Dictionary {
+	panic(values.UnexpectedKind(semantic.Float, semantic.Dictionary))
+}
 func (c *durationConv) Object() values.Object {
 	panic(values.UnexpectedKind(semantic.Float, semantic.Object))
 }
@@ -18,6 +18,7 @@ type WindowOpSpec struct {
 	Every       flux.Duration `json:"every"`
 	Period      flux.Duration `json:"period"`
 	Offset      flux.Duration `json:"offset"`
+	Location    flux.Location `json:"location"`
 	TimeColumn  string        `json:"timeColumn"`
 	StopColumn  string        `json:"stopColumn"`
 	StartColumn string        `json:"startColumn"`
@@ -32,6 +33,7 @@ func init() {
 			"every":       semantic.Duration,
 			"period":      semantic.Duration,
 			"offset":      semantic.Duration,
+			"location":    flux.LocationPolyType,
 			"timeColumn":  semantic.String,
 			"startColumn": semantic.String,
 			"stopColumn":  semantic.String,
@@ -40,7 +42,9 @@ func init() {
 		nil,
 	)
 
-	flux.RegisterPackageValue("universe", WindowKind, flux.FunctionValue(WindowKind, createWindowOpSpec, windowSignature))
+	// The window function is defined in Flux so that the location
+	// defaults to the location option. It calls this builtin.
+	flux.RegisterPackageValue("universe", "_window", flux.FunctionValue(WindowKind, createWindowOpSpec, windowSignature))
 	flux.RegisterOpSpec(WindowKind, newWindowOp)
 	flux.RegisterPackageValue("universe", "inf", infinityVar)
 	plan.RegisterProcedureSpec(WindowKind, newWindowProcedure, WindowKind)
@@ -54,10 +58,13 @@ func createWindowOpSpec(args flux.Arguments, a *flux.Administration) (flux.Opera
 	}
 
 	spec := new(WindowOpSpec)
+	// A zero period is the same as not setting it,
+	// since the Flux window function defaults it to zero.
 	every, everySet, err := args.GetDuration("every")
 	if err != nil {
 		return nil, err
 	}
+	everySet = everySet && !every.IsZero()
 	if everySet {
 		spec.Every = every
 	}
@@ -65,6 +72,7 @@ func createWindowOpSpec(args flux.Arguments, a *flux.Administration) (flux.Opera
 	if err != nil {
 		return nil, err
 	}
+	periodSet = periodSet && !period.IsZero()
 	if periodSet {
 		spec.Period = period
 	}
@@ -73,6 +81,11 @@ func createWindowOpSpec(args flux.Arguments, a *flux.Administration) (flux.Opera
 	} else if ok {
 		spec.Offset = offset
 	}
+	location, _, err := args.GetLocation("location")
+	if err != nil {
+		return nil, err
+	}
+	spec.Location = location
 
 	if !everySet && !periodSet {
 		return nil, errors.New(codes.Invalid, `window function requires at least one of "every" or "period" to be set`)
@@ -141,9 +154,10 @@ func newWindowProcedure(qs flux.OperationSpec, pa plan.Administration) (plan.Pro
 	}
 	p := &WindowProcedureSpec{
 		Window: plan.WindowSpec{
-			Every:  s.Every,
-			Period: s.Period,
-			Offset: s.Offset,
+			Every:    s.Every,
+			Period:   s.Period,
+			Offset:   s.Offset,
+			Location: s.Location,
 		},
 		TimeColumn:  s.TimeColumn,
 		StartColumn: s.StartColumn,
@@ -175,10 +189,11 @@ func createWindowTransformation(id execute.DatasetID, mode execute.AccumulationM
 		return nil, nil, errors.New(codes.Invalid, "nil bounds passed to window")
 	}
 
-	w, err := execute.NewWindow(
+	w, err := execute.NewWindowInLocation(
 		s.Window.Every,
 		s.Window.Period,
 		s.Window.Offset,
+		s.Window.Location,
 	)
 	if err != nil {
 		return nil, nil, err
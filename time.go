@@ -85,6 +85,9 @@ func (t Time) MarshalText() ([]byte, error) {
 // Duration is a marshalable duration type.
 type Duration = values.Duration
 
+// Location is a marshalable location type.
+type Location = values.Location
+
 // ConvertDuration will convert a time.Duration into a flux.Duration.
 func ConvertDuration(v time.Duration) Duration {
 	return values.ConvertDuration(v)
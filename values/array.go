@@ -127,6 +127,9 @@ func (a *array) Regexp() *regexp.Regexp {
 func (a *array) Array() Array {
 	return a
 }
+func (a *array) Dict() Dictionary {
+	panic(UnexpectedKind(semantic.Array, semantic.Dictionary))
+}
 func (a *array) Object() Object {
 	panic(UnexpectedKind(semantic.Array, semantic.Object))
 }
@@ -0,0 +1,315 @@
+package values
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/influxdata/flux/codes"
+	"github.com/influxdata/flux/internal/errors"
+	"github.com/influxdata/flux/semantic"
+)
+
+// Dictionary represents an immutable mapping of keys to values.
+// All keys must be the same type and all values must be the same type.
+// Operations that modify the dictionary return a new dictionary
+// and leave the original unchanged.
+type Dictionary interface {
+	Value
+	// Get returns the value for the key or the default
+	// if the key is not present in the dictionary.
+	Get(key, def Value) Value
+	// Lookup returns the value for the key and reports
+	// whether the key is present in the dictionary.
+	Lookup(key Value) (Value, bool)
+	// Insert returns a new dictionary with the key set to the value.
+	Insert(key, value Value) (Dictionary, error)
+	// Remove returns a new dictionary without the key.
+	Remove(key Value) (Dictionary, error)
+	// Range calls f for each entry in the dictionary
+	// in ascending order of the keys.
+	Range(f func(key, value Value))
+	// Len returns the number of entries in the dictionary.
+	Len() int
+}
+
+type dictEntry struct {
+	key   Value
+	value Value
+}
+
+type dict struct {
+	t       semantic.Type
+	entries []dictEntry
+}
+
+// NewEmptyDict returns an empty dictionary of the given dictionary type.
+func NewEmptyDict(dictType semantic.Type) Dictionary {
+	CheckKind(dictType.Nature(), semantic.Dictionary)
+	return &dict{t: dictType}
+}
+
+// DictionaryBuilder constructs a Dictionary
+// by inserting entries in any order.
+type DictionaryBuilder struct {
+	t       semantic.Type
+	entries []dictEntry
+}
+
+// NewDictBuilder returns a builder for a dictionary of the given type.
+func NewDictBuilder(dictType semantic.Type) DictionaryBuilder {
+	CheckKind(dictType.Nature(), semantic.Dictionary)
+	return DictionaryBuilder{t: dictType}
+}
+
+// Insert sets the key to the value. If the key has
+// already been inserted, its value is replaced.
+func (b *DictionaryBuilder) Insert(key, value Value) error {
+	entries, err := insertEntry(b.entries, key, value, false)
+	if err != nil {
+		return err
+	}
+	b.entries = entries
+	return nil
+}
+
+// Dict returns the constructed dictionary.
+// The builder should not be used afterwards.
+func (b *DictionaryBuilder) Dict() Dictionary {
+	d := &dict{t: b.t, entries: b.entries}
+	b.entries = nil
+	return d
+}
+
+// insertEntry inserts the key and value into the sorted entries.
+// If copyOnWrite is true, the entries are copied instead of modified.
+func insertEntry(entries []dictEntry, key, value Value, copyOnWrite bool) ([]dictEntry, error) {
+	if err := validateDictKey(key); err != nil {
+		return nil, err
+	}
+	i, found := searchEntries(entries, key)
+	if found {
+		if copyOnWrite {
+			entries = append([]dictEntry(nil), entries...)
+		}
+		entries[i].value = value
+		return entries, nil
+	}
+
+	var ne []dictEntry
+	if copyOnWrite {
+		ne = make([]dictEntry, len(entries)+1)
+		copy(ne, entries[:i])
+		copy(ne[i+1:], entries[i:])
+	} else {
+		ne = append(entries, dictEntry{})
+		copy(ne[i+1:], ne[i:])
+	}
+	ne[i] = dictEntry{key: key, value: value}
+	return ne, nil
+}
+
+// searchEntries finds the index of the key within the sorted entries.
+// If the key is not present, the index is where it would be inserted.
+func searchEntries(entries []dictEntry, key Value) (int, bool) {
+	i := sort.Search(len(entries), func(i int) bool {
+		return compareDictKeys(entries[i].key, key) >= 0
+	})
+	return i, i < len(entries) && compareDictKeys(entries[i].key, key) == 0
+}
+
+func validateDictKey(key Value) error {
+	if key.IsNull() {
+		return errors.New(codes.Invalid, "dictionary key cannot be null")
+	}
+	switch n := key.Type().Nature(); n {
+	case semantic.String, semantic.Int, semantic.UInt, semantic.Float,
+		semantic.Bool, semantic.Time, semantic.Duration:
+		return nil
+	default:
+		return errors.Newf(codes.Invalid, "dictionary key must be a basic type, got %s", n)
+	}
+}
+
+// compareDictKeys compares two keys of the same type.
+func compareDictKeys(l, r Value) int {
+	switch l.Type().Nature() {
+	case semantic.String:
+		return strings.Compare(l.Str(), r.Str())
+	case semantic.Int:
+		return compareDictOrdered(l.Int() < r.Int(), l.Int() > r.Int())
+	case semantic.UInt:
+		return compareDictOrdered(l.UInt() < r.UInt(), l.UInt() > r.UInt())
+	case semantic.Float:
+		return compareDictOrdered(l.Float() < r.Float(), l.Float() > r.Float())
+	case semantic.Bool:
+		return compareDictOrdered(!l.Bool() && r.Bool(), l.Bool() && !r.Bool())
+	case semantic.Time:
+		return compareDictOrdered(l.Time() < r.Time(), l.Time() > r.Time())
+	case semantic.Duration:
+		return compareDictDurations(l.Duration(), r.Duration())
+	default:
+		panic(errors.Newf(codes.Internal, "unsupported dictionary key type %s", l.Type()))
+	}
+}
+
+// compareDictDurations orders durations by their approximate length.
+// Durations with the same approximate length are ordered by their
+// components so that distinct durations are never considered equal.
+func compareDictDurations(l, r Duration) int {
+	ld, rd := l.Duration(), r.Duration()
+	if cmp := compareDictOrdered(ld < rd, ld > rd); cmp != 0 {
+		return cmp
+	}
+	if cmp := compareDictOrdered(l.months < r.months, l.months > r.months); cmp != 0 {
+		return cmp
+	}
+	if cmp := compareDictOrdered(l.nsecs < r.nsecs, l.nsecs > r.nsecs); cmp != 0 {
+		return cmp
+	}
+	return compareDictOrdered(!l.negative && r.negative, l.negative && !r.negative)
+}
+
+func compareDictOrdered(less, greater bool) int {
+	switch {
+	case less:
+		return -1
+	case greater:
+		return 1
+	default:
+		return 0
+	}
+}
+
+func (d *dict) IsNull() bool {
+	return false
+}
+func (d *dict) String() string {
+	if len(d.entries) == 0 {
+		return "[:]"
+	}
+	b := new(strings.Builder)
+	b.WriteString("[")
+	for i, e := range d.entries {
+		if i != 0 {
+			b.WriteString(", ")
+		}
+		fmt.Fprintf(b, "%v: %v", e.key, e.value)
+	}
+	b.WriteString("]")
+	return b.String()
+}
+
+func (d *dict) Type() semantic.Type {
+	return d.t
+}
+func (d *dict) PolyType() semantic.PolyType {
+	return d.t.PolyType()
+}
+
+func (d *dict) Get(key, def Value) Value {
+	if v, ok := d.Lookup(key); ok {
+		return v
+	}
+	return def
+}
+
+func (d *dict) Lookup(key Value) (Value, bool) {
+	if validateDictKey(key) != nil {
+		return nil, false
+	}
+	i, ok := searchEntries(d.entries, key)
+	if !ok {
+		return nil, false
+	}
+	return d.entries[i].value, true
+}
+
+func (d *dict) Insert(key, value Value) (Dictionary, error) {
+	entries, err := insertEntry(d.entries, key, value, true)
+	if err != nil {
+		return nil, err
+	}
+	return &dict{t: d.t, entries: entries}, nil
+}
+
+func (d *dict) Remove(key Value) (Dictionary, error) {
+	if err := validateDictKey(key); err != nil {
+		return nil, err
+	}
+	i, ok := searchEntries(d.entries, key)
+	if !ok {
+		return d, nil
+	}
+	entries := make([]dictEntry, 0, len(d.entries)-1)
+	entries = append(entries, d.entries[:i]...)
+	entries = append(entries, d.entries[i+1:]...)
+	return &dict{t: d.t, entries: entries}, nil
+}
+
+func (d *dict) Range(f func(key, value Value)) {
+	for _, e := range d.entries {
+		f(e.key, e.value)
+	}
+}
+
+func (d *dict) Len() int {
+	return len(d.entries)
+}
+
+func (d *dict) Str() string {
+	panic(UnexpectedKind(semantic.Dictionary, semantic.String))
+}
+func (d *dict) Bytes() []byte {
+	panic(UnexpectedKind(semantic.Dictionary, semantic.Bytes))
+}
+func (d *dict) Int() int64 {
+	panic(UnexpectedKind(semantic.Dictionary, semantic.Int))
+}
+func (d *dict) UInt() uint64 {
+	panic(UnexpectedKind(semantic.Dictionary, semantic.UInt))
+}
+func (d *dict) Float() float64 {
+	panic(UnexpectedKind(semantic.Dictionary, semantic.Float))
+}
+func (d *dict) Bool() bool {
+	panic(UnexpectedKind(semantic.Dictionary, semantic.Bool))
+}
+func (d *dict) Time() Time {
+	panic(UnexpectedKind(semantic.Dictionary, semantic.Time))
+}
+func (d *dict) Duration() Duration {
+	panic(UnexpectedKind(semantic.Dictionary, semantic.Duration))
+}
+func (d *dict) Regexp() *regexp.Regexp {
+	panic(UnexpectedKind(semantic.Dictionary, semantic.Regexp))
+}
+func (d *dict) Array() Array {
+	panic(UnexpectedKind(semantic.Dictionary, semantic.Array))
+}
+func (d *dict) Dict() Dictionary {
+	return d
+}
+func (d *dict) Object() Object {
+	panic(UnexpectedKind(semantic.Dictionary, semantic.Object))
+}
+func (d *dict) Function() Function {
+	panic(UnexpectedKind(semantic.Dictionary, semantic.Function))
+}
+func (d *dict) Equal(rhs Value) bool {
+	if d.Type() != rhs.Type() {
+		return false
+	}
+	r := rhs.Dict()
+	if d.Len() != r.Len() {
+		return false
+	}
+	for _, e := range d.entries {
+		v, ok := r.Lookup(e.key)
+		if !ok || !e.value.Equal(v) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,77 @@
+package values_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/influxdata/flux/semantic"
+	"github.com/influxdata/flux/values"
+)
+
+func TestDict(t *testing.T) {
+	dictType := semantic.NewDictType(semantic.String, semantic.Int)
+	b := values.NewDictBuilder(dictType)
+	for _, k := range []string{"c", "a", "b", "a"} {
+		if err := b.Insert(values.NewString(k), values.NewInt(int64(len(k)))); err != nil {
+			t.Fatal(err)
+		}
+	}
+	d := b.Dict()
+
+	if got, want := d.Len(), 3; got != want {
+		t.Fatalf("unexpected length -want/+got:\n\t- %d\n\t+ %d", want, got)
+	}
+	if got, want := fmt.Sprint(d), "[a: 1, b: 1, c: 1]"; got != want {
+		t.Fatalf("unexpected string -want/+got:\n\t- %s\n\t+ %s", want, got)
+	}
+
+	def := values.NewInt(-1)
+	if got := d.Get(values.NewString("b"), def); !got.Equal(values.NewInt(1)) {
+		t.Fatalf("unexpected value for key b: %v", got)
+	}
+	if got := d.Get(values.NewString("d"), def); !got.Equal(def) {
+		t.Fatalf("expected default value for key d, got %v", got)
+	}
+
+	inserted, err := d.Insert(values.NewString("d"), values.NewInt(4))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := d.Lookup(values.NewString("d")); ok {
+		t.Fatal("insert modified the original dictionary")
+	}
+	if v, ok := inserted.Lookup(values.NewString("d")); !ok || !v.Equal(values.NewInt(4)) {
+		t.Fatalf("unexpected value for key d: %v", v)
+	}
+
+	removed, err := inserted.Remove(values.NewString("a"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := inserted.Lookup(values.NewString("a")); !ok {
+		t.Fatal("remove modified the original dictionary")
+	}
+	if _, ok := removed.Lookup(values.NewString("a")); ok {
+		t.Fatal("expected key a to be removed")
+	}
+
+	if d.Equal(inserted) {
+		t.Fatal("expected dictionaries to be unequal")
+	}
+	other := values.NewEmptyDict(dictType)
+	for _, k := range []string{"a", "b", "c"} {
+		if other, err = other.Insert(values.NewString(k), values.NewInt(1)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if !d.Equal(other) {
+		t.Fatal("expected dictionaries to be equal")
+	}
+}
+
+func TestDict_InvalidKey(t *testing.T) {
+	b := values.NewDictBuilder(semantic.NewDictType(semantic.String, semantic.Int))
+	if err := b.Insert(values.NewNull(semantic.String), values.NewInt(1)); err == nil {
+		t.Fatal("expected error for null key")
+	}
+}
@@ -90,6 +90,9 @@ func (f *function) Regexp() *regexp.Regexp {
 func (f *function) Array() Array {
 	panic(UnexpectedKind(semantic.Function, semantic.Function))
 }
+func (f *function) Dict() Dictionary {
+	panic(UnexpectedKind(semantic.Function, semantic.Dictionary))
+}
 
 func (f *function) Object() Object {
 	panic(UnexpectedKind(semantic.Function, semantic.Object))
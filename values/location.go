@@ -0,0 +1,147 @@
+package values
+
+import (
+	"sync"
+	"time"
+
+	"github.com/influxdata/flux/codes"
+	"github.com/influxdata/flux/internal/errors"
+)
+
+// Location specifies a time zone and a fixed offset from it.
+// It is used to align calendar computations, such as truncating
+// a time to the start of a day, with the civil time of a place.
+//
+// The zero value represents UTC.
+type Location struct {
+	// Name is the IANA time zone name of the location.
+	// An empty name is the same as UTC.
+	Name string `json:"zone"`
+
+	// Offset is a fixed duration that is added to the
+	// offset of the time zone. It cannot contain months.
+	Offset Duration `json:"offset"`
+}
+
+// UTC is the location used when no location has been specified.
+var UTC = Location{Name: "UTC"}
+
+var locations = struct {
+	sync.RWMutex
+	m map[string]*time.Location
+}{
+	m: make(map[string]*time.Location),
+}
+
+// LoadLocation returns the location with the given zone name and offset.
+// It returns an error if the zone name is unknown or if the offset
+// is not a fixed duration.
+func LoadLocation(name string, offset Duration) (Location, error) {
+	l := Location{Name: name, Offset: offset}
+	if err := l.Validate(); err != nil {
+		return Location{}, err
+	}
+	return l, nil
+}
+
+// Validate checks that the zone name is known and that the
+// offset is a fixed duration.
+func (l Location) Validate() error {
+	if l.Offset.Months() != 0 {
+		return errors.Newf(codes.Invalid, "location offset %v cannot contain months", l.Offset)
+	}
+	_, err := l.zone()
+	return err
+}
+
+// IsUTC reports whether the civil time of the location is always UTC.
+func (l Location) IsUTC() bool {
+	return (l.Name == "" || l.Name == "UTC") && l.Offset.IsZero()
+}
+
+// Equal reports whether the two locations are the same.
+func (l Location) Equal(other Location) bool {
+	if l.IsUTC() && other.IsUTC() {
+		return true
+	}
+	return l.Name == other.Name && l.Offset.Equal(other.Offset)
+}
+
+// ToCivil converts t to the civil time of the location.
+// The civil time is represented as if it were a time in UTC
+// so that it can be truncated or broken down into its date components.
+func (l Location) ToCivil(t Time) Time {
+	if l.IsUTC() {
+		return t
+	}
+	loc, err := l.zone()
+	if err != nil {
+		panic(err)
+	}
+	_, offset := t.Time().In(loc).Zone()
+	return t + Time(time.Duration(offset)*time.Second+l.Offset.Duration())
+}
+
+// FromCivil converts a civil time in the location, as returned
+// by ToCivil, back to a point in time.
+//
+// A civil time that is skipped or repeated by a daylight saving time
+// transition is ambiguous and is converted as described by time.Date.
+func (l Location) FromCivil(t Time) Time {
+	if l.IsUTC() {
+		return t
+	}
+	loc, err := l.zone()
+	if err != nil {
+		panic(err)
+	}
+	ts := (t - Time(l.Offset.Duration())).Time()
+	year, month, day := ts.Date()
+	hour, min, sec := ts.Clock()
+	return ConvertTime(time.Date(year, month, day, hour, min, sec, ts.Nanosecond(), loc))
+}
+
+// Time returns t as a time.Time in the location. Its zone offset
+// includes the fixed offset so that formatting it shows the civil time.
+func (l Location) Time(t Time) time.Time {
+	ts := t.Time()
+	if l.IsUTC() {
+		return ts
+	}
+	loc, err := l.zone()
+	if err != nil {
+		panic(err)
+	}
+	ts = ts.In(loc)
+	if l.Offset.IsZero() {
+		return ts
+	}
+	_, offset := ts.Zone()
+	offset += int(l.Offset.Duration() / time.Second)
+	return ts.In(time.FixedZone("", offset))
+}
+
+// zone loads the time zone database entry for the location.
+// Zones are cached since loading them reads from disk.
+func (l Location) zone() (*time.Location, error) {
+	if l.Name == "" || l.Name == "UTC" {
+		return time.UTC, nil
+	}
+
+	locations.RLock()
+	loc, ok := locations.m[l.Name]
+	locations.RUnlock()
+	if ok {
+		return loc, nil
+	}
+
+	loc, err := time.LoadLocation(l.Name)
+	if err != nil {
+		return nil, errors.Wrapf(err, codes.Invalid, "unknown time zone %q", l.Name)
+	}
+
+	locations.Lock()
+	locations.m[l.Name] = loc
+	locations.Unlock()
+	return loc, nil
+}
@@ -181,6 +181,9 @@ func (o *object) Regexp() *regexp.Regexp {
 func (o *object) Array() Array {
 	panic(UnexpectedKind(semantic.Object, semantic.Array))
 }
+func (o *object) Dict() Dictionary {
+	panic(UnexpectedKind(semantic.Object, semantic.Dictionary))
+}
 func (o *object) Object() Object {
 	return o
 }
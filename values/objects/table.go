@@ -136,6 +136,9 @@ func (t *Table) Regexp() *regexp.Regexp {
 func (t *Table) Array() values.Array {
 	panic(values.UnexpectedKind(semantic.Object, semantic.Array))
 }
+func (t *Table) Dict() values.Dictionary {
+	panic(values.UnexpectedKind(semantic.Object, semantic.Dictionary))
+}
 
 func (t *Table) Object() values.Object {
 	return t
@@ -31,6 +31,7 @@ type Value interface {
 	Duration() Duration
 	Regexp() *regexp.Regexp
 	Array() Array
+	Dict() Dictionary
 	Object() Object
 	Function() Function
 	Equal(Value) bool
@@ -94,6 +95,10 @@ func (v value) Array() Array {
 	CheckKind(v.t.Nature(), semantic.Array)
 	return v.v.(Array)
 }
+func (v value) Dict() Dictionary {
+	CheckKind(v.t.Nature(), semantic.Dictionary)
+	return v.v.(Dictionary)
+}
 func (v value) Object() Object {
 	CheckKind(v.t.Nature(), semantic.Object)
 	return v.v.(Object)
@@ -134,6 +139,8 @@ func (v value) Equal(r Value) bool {
 		return v.Object().Equal(r.Object())
 	case semantic.Array:
 		return v.Array().Equal(r.Array())
+	case semantic.Dictionary:
+		return v.Dict().Equal(r.Dict())
 	case semantic.Function:
 		return v.Function().Equal(r.Function())
 	default:
@@ -301,6 +308,12 @@ func AssignableTo(V, T semantic.Type) bool {
 		}
 		// Exact match is required at the moment.
 		return V.ElementType() == T.ElementType()
+	case semantic.Dictionary:
+		if V.Nature() != semantic.Dictionary {
+			return false
+		}
+		// Exact match is required at the moment.
+		return V.KeyType() == T.KeyType() && V.ValueType() == T.ValueType()
 	case semantic.Object:
 		if V.Nature() != semantic.Object {
 			return false